@@ -12,3615 +12,3687 @@ func init() {
 			"cipd.Storage", "cipd.Repository",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 109, 116, 100, 73,
-			117, 32, 168, 247, 94, 188, 204, 84, 232, 43, 21, 82, 87, 169,
-			178, 190, 162, 178, 170, 90, 82, 85, 42, 235, 163, 171, 187, 233,
-			42, 154, 182, 74, 82, 87, 171, 169, 146, 202, 146, 138, 118, 227,
-			67, 139, 151, 153, 33, 233, 117, 101, 190, 151, 188, 247, 178, 212,
-			234, 129, 177, 23, 6, 60, 192, 97, 217, 198, 13, 205, 96, 236,
-			182, 49, 216, 128, 207, 26, 175, 193, 172, 25, 251, 216, 107, 123,
-			247, 176, 158, 1, 123, 176, 141, 199, 198, 115, 206, 236, 193, 140,
-			23, 99, 108, 3, 30, 140, 49, 102, 216, 115, 111, 124, 188, 151,
-			41, 85, 211, 124, 180, 231, 199, 78, 157, 58, 71, 121, 227, 69,
-			220, 136, 184, 113, 227, 198, 141, 27, 55, 110, 208, 63, 120, 163,
-			67, 249, 102, 24, 110, 54, 197, 153, 118, 20, 38, 97, 173, 179,
-			113, 166, 33, 226, 122, 228, 183, 147, 48, 170, 98, 26, 27, 145,
-			57, 170, 58, 71, 249, 26, 29, 125, 208, 111, 138, 121, 147, 113,
-			85, 36, 236, 69, 148, 108, 248, 77, 49, 97, 113, 103, 106, 224,
-			252, 137, 106, 79, 161, 106, 119, 137, 235, 144, 188, 130, 37, 202,
-			159, 35, 116, 108, 143, 175, 140, 81, 18, 120, 45, 192, 104, 77,
-			245, 175, 224, 111, 54, 65, 243, 109, 175, 126, 211, 219, 20, 19,
-			54, 38, 107, 144, 29, 161, 180, 33, 218, 34, 104, 136, 160, 190,
-			51, 225, 112, 103, 170, 127, 37, 147, 194, 78, 211, 209, 118, 167,
-			214, 244, 235, 235, 153, 108, 148, 59, 83, 238, 74, 81, 126, 152,
-			79, 51, 79, 210, 145, 109, 225, 221, 204, 102, 29, 192, 172, 195,
-			144, 156, 201, 56, 71, 7, 91, 34, 142, 189, 77, 177, 158, 236,
-			180, 197, 4, 193, 222, 243, 93, 189, 239, 237, 249, 128, 42, 181,
-			182, 211, 22, 108, 150, 246, 139, 160, 211, 146, 24, 220, 219, 208,
-			111, 33, 232, 180, 122, 177, 20, 160, 152, 66, 145, 143, 69, 116,
-			203, 175, 139, 137, 28, 34, 152, 220, 133, 96, 85, 126, 239, 197,
-			161, 203, 177, 57, 218, 47, 158, 72, 68, 16, 251, 97, 48, 145,
-			71, 36, 39, 247, 24, 69, 209, 108, 244, 162, 72, 203, 177, 123,
-			104, 62, 108, 39, 126, 24, 196, 19, 5, 110, 77, 13, 156, 63,
-			180, 39, 35, 44, 203, 60, 43, 58, 51, 91, 164, 197, 56, 236,
-			68, 117, 177, 94, 15, 27, 98, 221, 15, 54, 194, 137, 126, 68,
-			112, 116, 119, 71, 48, 227, 92, 216, 16, 139, 193, 70, 184, 50,
-			28, 119, 193, 108, 31, 205, 197, 59, 65, 226, 61, 49, 49, 136,
-			28, 162, 160, 242, 199, 114, 116, 228, 249, 176, 216, 37, 234, 110,
-			64, 47, 39, 236, 239, 132, 6, 178, 76, 55, 17, 115, 223, 37,
-			17, 103, 233, 64, 32, 226, 68, 52, 36, 71, 56, 207, 147, 167,
-			168, 44, 180, 155, 165, 200, 119, 197, 82, 63, 68, 71, 76, 147,
-			214, 35, 47, 216, 212, 188, 121, 230, 219, 181, 164, 186, 160, 203,
-			173, 64, 177, 149, 97, 209, 5, 179, 121, 74, 195, 64, 132, 27,
-			235, 13, 81, 111, 78, 20, 110, 67, 165, 101, 200, 178, 139, 74,
-			161, 76, 173, 55, 217, 125, 41, 171, 229, 111, 195, 41, 215, 228,
-			36, 219, 197, 109, 55, 232, 112, 36, 128, 239, 69, 67, 245, 172,
-			31, 27, 81, 253, 182, 61, 91, 81, 197, 100, 199, 134, 162, 44,
-			200, 142, 83, 147, 176, 142, 108, 69, 81, 10, 13, 234, 196, 37,
-			175, 37, 74, 79, 210, 225, 110, 242, 176, 113, 234, 198, 137, 23,
-			37, 200, 133, 238, 138, 4, 88, 145, 58, 34, 104, 160, 148, 115,
-			87, 224, 39, 251, 129, 180, 195, 14, 118, 248, 206, 221, 35, 218,
-			133, 185, 183, 223, 165, 123, 233, 80, 87, 7, 158, 111, 213, 229,
-			87, 211, 59, 246, 68, 205, 126, 136, 142, 119, 2, 63, 72, 68,
-			212, 142, 4, 112, 172, 172, 106, 226, 255, 205, 223, 134, 231, 110,
-			100, 115, 75, 44, 43, 99, 157, 221, 137, 167, 250, 11, 159, 207,
-			23, 127, 244, 71, 127, 244, 71, 237, 242, 111, 229, 232, 248, 94,
-			115, 102, 207, 233, 187, 143, 230, 130, 78, 171, 38, 34, 36, 146,
-			187, 162, 32, 54, 75, 221, 166, 87, 19, 205, 9, 194, 173, 169,
-			225, 243, 167, 159, 215, 172, 172, 94, 133, 34, 43, 178, 36, 123,
-			9, 37, 74, 68, 3, 134, 83, 207, 15, 3, 204, 165, 21, 44,
-			199, 14, 210, 126, 248, 43, 121, 35, 135, 109, 46, 64, 2, 240,
-			5, 43, 209, 2, 78, 147, 134, 208, 75, 155, 129, 129, 177, 26,
-			98, 195, 235, 52, 147, 245, 91, 94, 179, 35, 144, 225, 251, 87,
-			6, 85, 226, 203, 32, 141, 29, 165, 3, 114, 86, 249, 65, 67,
-			60, 129, 210, 211, 93, 145, 19, 109, 17, 82, 160, 250, 199, 227,
-			48, 208, 172, 137, 85, 64, 2, 86, 127, 111, 175, 224, 62, 188,
-			119, 247, 118, 205, 165, 73, 58, 130, 57, 238, 82, 67, 239, 53,
-			39, 70, 185, 53, 85, 88, 25, 150, 201, 203, 42, 181, 252, 81,
-			155, 18, 20, 44, 35, 116, 96, 237, 209, 235, 11, 235, 243, 203,
-			55, 46, 95, 93, 40, 90, 108, 152, 82, 76, 120, 240, 234, 242,
-			236, 90, 209, 54, 240, 226, 210, 218, 61, 23, 138, 142, 41, 112,
-			67, 38, 144, 108, 134, 187, 206, 23, 93, 86, 164, 131, 18, 193,
-			226, 15, 45, 204, 223, 115, 161, 152, 235, 78, 185, 235, 124, 49,
-			207, 134, 104, 63, 166, 92, 94, 94, 190, 90, 44, 24, 156, 171,
-			107, 43, 139, 75, 87, 138, 253, 6, 231, 149, 149, 229, 27, 215,
-			139, 212, 96, 184, 182, 176, 186, 58, 123, 101, 161, 56, 96, 114,
-			92, 126, 116, 109, 97, 181, 56, 216, 213, 172, 187, 206, 23, 135,
-			76, 21, 11, 75, 55, 174, 21, 135, 217, 40, 29, 146, 85, 232,
-			70, 140, 244, 36, 221, 115, 161, 88, 76, 27, 34, 177, 140, 118,
-			37, 220, 115, 161, 200, 202, 115, 212, 69, 54, 100, 140, 14, 95,
-			157, 189, 188, 112, 117, 125, 249, 250, 218, 226, 242, 210, 236, 213,
-			162, 149, 166, 173, 44, 252, 224, 141, 197, 149, 133, 249, 162, 157,
-			77, 187, 190, 48, 187, 182, 48, 95, 116, 202, 117, 58, 190, 151,
-			64, 221, 115, 10, 101, 120, 193, 190, 13, 47, 32, 174, 94, 94,
-			40, 255, 185, 77, 199, 246, 88, 84, 246, 172, 228, 1, 234, 74,
-			94, 150, 203, 236, 244, 158, 171, 19, 114, 246, 174, 165, 22, 203,
-			101, 85, 13, 231, 54, 170, 6, 160, 216, 197, 176, 175, 216, 37,
-			252, 229, 250, 120, 207, 243, 89, 31, 49, 237, 59, 91, 4, 220,
-			61, 22, 129, 75, 116, 116, 23, 162, 231, 45, 140, 95, 103, 209,
-			137, 219, 17, 231, 219, 136, 68, 187, 75, 36, 94, 234, 165, 224,
-			177, 219, 15, 194, 174, 177, 254, 121, 139, 238, 219, 91, 165, 220,
-			179, 13, 47, 161, 185, 150, 72, 182, 66, 173, 86, 237, 94, 187,
-			174, 225, 231, 222, 193, 86, 165, 178, 171, 189, 115, 59, 189, 80,
-			182, 102, 87, 75, 223, 104, 211, 59, 246, 68, 190, 103, 67, 15,
-			83, 234, 7, 237, 78, 34, 85, 39, 41, 137, 251, 49, 5, 133,
-			23, 72, 217, 78, 98, 190, 59, 248, 157, 202, 36, 204, 240, 162,
-			180, 161, 4, 27, 122, 228, 54, 61, 221, 197, 152, 103, 105, 177,
-			222, 244, 69, 144, 172, 199, 73, 36, 188, 150, 31, 108, 226, 82,
-			83, 184, 232, 110, 120, 205, 88, 172, 140, 200, 207, 171, 250, 43,
-			148, 64, 6, 138, 50, 37, 114, 93, 37, 228, 103, 83, 162, 252,
-			84, 63, 29, 200, 40, 224, 236, 24, 29, 124, 220, 187, 229, 173,
-			235, 77, 149, 164, 196, 0, 164, 93, 87, 27, 171, 179, 116, 28,
-			179, 132, 157, 68, 68, 235, 245, 166, 23, 199, 72, 180, 2, 102,
-			101, 240, 109, 25, 62, 205, 233, 47, 236, 110, 58, 134, 37, 90,
-			157, 102, 226, 183, 155, 98, 29, 182, 121, 49, 46, 57, 166, 101,
-			163, 144, 227, 154, 202, 0, 45, 138, 217, 60, 61, 140, 197, 54,
-			69, 32, 34, 47, 17, 235, 226, 85, 29, 175, 25, 175, 123, 65,
-			99, 125, 203, 139, 183, 38, 198, 1, 193, 101, 123, 194, 90, 57,
-			0, 25, 175, 168, 124, 11, 152, 109, 54, 104, 60, 228, 197, 91,
-			236, 34, 221, 135, 88, 226, 36, 242, 131, 205, 245, 250, 150, 168,
-			223, 92, 239, 36, 27, 47, 154, 56, 152, 173, 31, 91, 184, 138,
-			121, 230, 32, 203, 141, 100, 227, 69, 108, 149, 14, 194, 96, 180,
-			252, 39, 197, 250, 70, 24, 225, 26, 58, 188, 135, 104, 202, 80,
-			176, 186, 172, 10, 92, 11, 27, 226, 162, 187, 122, 125, 97, 97,
-			126, 101, 64, 99, 121, 48, 140, 128, 161, 54, 67, 67, 224, 1,
-			201, 80, 155, 161, 38, 239, 221, 116, 172, 94, 151, 125, 246, 235,
-			235, 106, 51, 22, 79, 20, 187, 136, 85, 175, 95, 145, 25, 20,
-			143, 199, 236, 62, 122, 71, 74, 172, 108, 193, 209, 93, 189, 236,
-			45, 122, 55, 29, 107, 239, 236, 46, 200, 186, 106, 108, 239, 244,
-			22, 187, 151, 142, 183, 183, 218, 187, 203, 157, 202, 150, 99, 237,
-			173, 118, 111, 193, 147, 184, 51, 143, 68, 221, 75, 68, 99, 98,
-			127, 54, 123, 230, 3, 171, 210, 98, 189, 190, 46, 2, 175, 214,
-			20, 235, 94, 36, 2, 47, 158, 56, 138, 153, 73, 18, 117, 196,
-			202, 112, 189, 190, 128, 31, 103, 241, 27, 59, 69, 71, 195, 218,
-			227, 117, 201, 145, 235, 237, 72, 108, 248, 79, 76, 156, 64, 242,
-			142, 192, 7, 228, 199, 235, 152, 204, 166, 105, 177, 30, 111, 121,
-			81, 27, 69, 114, 220, 246, 234, 98, 226, 164, 204, 42, 211, 151,
-			116, 50, 204, 136, 120, 219, 223, 72, 52, 198, 73, 57, 35, 48,
-			77, 97, 155, 162, 69, 160, 68, 87, 197, 83, 152, 109, 184, 189,
-			213, 206, 214, 123, 156, 14, 65, 206, 180, 210, 105, 169, 184, 181,
-			183, 50, 53, 94, 160, 251, 32, 83, 75, 36, 94, 195, 75, 188,
-			76, 238, 10, 230, 6, 178, 95, 83, 31, 187, 218, 25, 117, 106,
-			59, 134, 177, 102, 100, 59, 33, 77, 179, 214, 11, 166, 156, 151,
-			47, 210, 193, 44, 223, 179, 126, 42, 57, 191, 104, 129, 18, 52,
-			183, 60, 15, 234, 203, 203, 23, 138, 54, 168, 81, 87, 23, 215,
-			22, 214, 87, 110, 44, 173, 45, 94, 91, 40, 58, 25, 197, 254,
-			97, 82, 184, 179, 56, 9, 90, 195, 112, 247, 78, 141, 189, 152,
-			238, 215, 102, 149, 88, 36, 235, 219, 126, 132, 19, 178, 229, 201,
-			197, 209, 240, 207, 184, 202, 181, 42, 146, 71, 252, 8, 166, 91,
-			203, 75, 216, 85, 122, 52, 8, 215, 227, 196, 11, 26, 94, 212,
-			88, 79, 13, 90, 235, 94, 189, 46, 226, 56, 148, 11, 161, 193,
-			114, 40, 8, 87, 85, 230, 116, 133, 152, 85, 89, 123, 216, 215,
-			185, 29, 251, 30, 164, 253, 45, 175, 189, 46, 130, 36, 218, 65,
-			253, 188, 176, 82, 104, 121, 237, 5, 128, 255, 89, 182, 73, 15,
-			147, 2, 41, 186, 15, 147, 130, 91, 204, 61, 76, 10, 185, 98,
-			254, 97, 82, 40, 20, 251, 31, 38, 133, 254, 34, 45, 191, 141,
-			208, 193, 172, 6, 15, 27, 162, 58, 174, 97, 22, 74, 185, 227,
-			207, 169, 239, 87, 231, 96, 113, 187, 152, 147, 234, 242, 138, 44,
-			9, 138, 5, 176, 159, 144, 234, 73, 97, 69, 65, 236, 10, 205,
-			61, 30, 35, 238, 28, 226, 222, 203, 26, 152, 193, 253, 240, 42,
-			34, 239, 127, 120, 117, 125, 105, 121, 229, 218, 236, 213, 21, 85,
-			156, 29, 160, 164, 233, 61, 185, 211, 189, 12, 98, 18, 171, 210,
-			145, 78, 112, 75, 68, 254, 134, 47, 26, 235, 152, 107, 36, 155,
-			107, 56, 253, 122, 21, 242, 63, 207, 97, 60, 64, 201, 182, 240,
-			110, 118, 47, 86, 152, 244, 2, 78, 167, 51, 212, 69, 250, 50,
-			74, 21, 133, 139, 125, 172, 64, 201, 220, 242, 10, 76, 169, 34,
-			29, 148, 169, 235, 215, 23, 23, 230, 22, 138, 118, 249, 110, 154,
-			147, 68, 131, 233, 102, 200, 86, 236, 83, 160, 194, 97, 233, 175,
-			55, 174, 93, 94, 88, 41, 218, 187, 152, 165, 28, 211, 193, 172,
-			38, 255, 207, 179, 157, 255, 63, 44, 58, 144, 209, 204, 65, 165,
-			242, 154, 205, 112, 123, 221, 107, 250, 94, 172, 88, 137, 98, 210,
-			44, 164, 60, 223, 161, 251, 103, 154, 100, 110, 49, 87, 254, 41,
-			139, 22, 123, 85, 227, 158, 102, 90, 255, 61, 155, 89, 126, 183,
-			69, 135, 187, 245, 225, 158, 230, 29, 251, 239, 218, 188, 255, 199,
-			166, 67, 93, 90, 240, 243, 109, 221, 171, 232, 168, 223, 16, 173,
-			118, 152, 136, 160, 190, 179, 222, 20, 183, 68, 115, 162, 140, 66,
-			102, 183, 89, 178, 171, 134, 234, 98, 90, 238, 42, 20, 187, 56,
-			182, 56, 191, 112, 237, 250, 242, 218, 194, 210, 220, 163, 235, 55,
-			150, 94, 186, 180, 252, 200, 210, 74, 209, 239, 201, 246, 2, 78,
-			251, 235, 180, 216, 219, 40, 182, 159, 238, 213, 172, 98, 31, 27,
-			163, 35, 75, 203, 235, 171, 139, 243, 11, 235, 11, 15, 62, 184,
-			48, 183, 182, 42, 45, 39, 38, 247, 90, 215, 4, 47, 191, 203,
-			161, 99, 123, 180, 132, 205, 170, 61, 143, 220, 134, 205, 60, 159,
-			214, 87, 65, 235, 184, 238, 69, 137, 218, 34, 77, 83, 160, 82,
-			144, 128, 112, 141, 148, 69, 74, 110, 132, 70, 210, 116, 105, 148,
-			170, 80, 214, 14, 99, 63, 241, 111, 137, 117, 63, 208, 230, 43,
-			216, 24, 145, 149, 162, 254, 178, 24, 36, 38, 119, 32, 54, 189,
-			158, 220, 32, 252, 157, 149, 162, 254, 98, 114, 31, 163, 131, 141,
-			176, 3, 218, 162, 204, 7, 107, 141, 181, 50, 32, 211, 76, 22,
-			181, 15, 72, 237, 102, 131, 43, 3, 50, 77, 102, 153, 164, 35,
-			222, 230, 102, 4, 200, 53, 34, 185, 179, 25, 54, 201, 152, 177,
-			244, 48, 45, 104, 58, 192, 98, 15, 148, 88, 111, 203, 237, 186,
-			61, 213, 191, 82, 8, 244, 199, 99, 116, 208, 143, 215, 211, 99,
-			0, 155, 219, 83, 133, 149, 1, 63, 54, 38, 212, 242, 207, 219,
-			116, 184, 251, 24, 131, 205, 211, 66, 51, 172, 123, 200, 90, 242,
-			12, 109, 234, 219, 156, 124, 84, 175, 170, 252, 43, 166, 100, 233,
-			247, 44, 90, 208, 201, 108, 31, 37, 109, 47, 217, 66, 116, 238,
-			101, 187, 104, 173, 32, 12, 233, 113, 219, 11, 144, 5, 84, 58,
-			192, 48, 174, 77, 225, 53, 112, 219, 20, 182, 90, 34, 72, 98,
-			61, 174, 42, 125, 78, 37, 179, 211, 116, 52, 137, 60, 191, 217,
-			149, 151, 96, 222, 162, 254, 96, 50, 95, 164, 7, 52, 222, 134,
-			72, 188, 250, 150, 104, 164, 133, 114, 104, 30, 217, 175, 50, 204,
-			171, 239, 186, 108, 249, 83, 22, 29, 213, 27, 189, 134, 33, 214,
-			53, 74, 189, 32, 8, 147, 44, 185, 118, 179, 242, 174, 114, 213,
-			89, 83, 104, 37, 131, 160, 212, 162, 52, 253, 114, 91, 178, 29,
-			165, 3, 234, 140, 10, 15, 58, 165, 105, 128, 202, 36, 216, 17,
-			178, 113, 234, 214, 196, 166, 31, 40, 203, 179, 4, 180, 1, 135,
-			24, 3, 206, 229, 127, 73, 199, 234, 97, 171, 183, 185, 151, 139,
-			61, 230, 137, 248, 33, 235, 229, 51, 42, 211, 102, 216, 244, 130,
-			205, 106, 24, 109, 166, 7, 181, 160, 33, 197, 153, 227, 218, 118,
-			237, 31, 44, 235, 61, 182, 115, 229, 250, 229, 15, 218, 165, 43,
-			178, 224, 117, 77, 140, 21, 177, 209, 20, 117, 232, 224, 195, 127,
-			246, 73, 155, 230, 153, 59, 217, 247, 206, 188, 69, 63, 56, 66,
-			173, 65, 230, 76, 246, 177, 243, 191, 53, 200, 49, 123, 61, 108,
-			242, 203, 157, 141, 13, 17, 197, 124, 134, 75, 68, 147, 49, 135,
-			253, 7, 71, 225, 80, 223, 242, 130, 77, 193, 165, 70, 78, 249,
-			92, 216, 222, 137, 252, 205, 173, 132, 159, 63, 123, 246, 69, 170,
-			0, 95, 12, 234, 85, 206, 103, 155, 77, 142, 223, 98, 174, 45,
-			96, 85, 202, 183, 146, 164, 29, 95, 60, 115, 166, 1, 50, 47,
-			108, 139, 40, 214, 212, 168, 135, 45, 217, 195, 122, 216, 156, 169,
-			201, 70, 156, 161, 148, 175, 136, 134, 15, 211, 182, 214, 129, 46,
-			112, 47, 104, 240, 78, 44, 184, 31, 112, 57, 0, 152, 82, 243,
-			3, 47, 218, 193, 118, 197, 21, 190, 237, 39, 91, 60, 140, 240,
-			111, 216, 73, 40, 111, 133, 13, 127, 195, 151, 147, 163, 194, 189,
-			72, 240, 182, 136, 90, 126, 146, 136, 6, 111, 71, 225, 45, 191,
-			33, 26, 60, 217, 242, 18, 158, 108, 65, 239, 64, 3, 241, 131,
-			77, 94, 15, 131, 134, 143, 139, 8, 20, 162, 188, 37, 146, 139,
-			148, 114, 248, 119, 170, 167, 97, 49, 15, 55, 116, 139, 234, 97,
-			67, 240, 86, 39, 78, 120, 36, 18, 207, 15, 16, 171, 87, 11,
-			111, 193, 39, 69, 49, 202, 131, 48, 241, 235, 162, 194, 147, 45,
-			63, 230, 77, 63, 78, 0, 67, 182, 198, 160, 209, 211, 156, 134,
-			31, 215, 155, 158, 223, 18, 81, 245, 118, 141, 240, 131, 44, 45,
-			116, 35, 218, 81, 216, 232, 212, 69, 218, 14, 154, 54, 228, 123,
-			106, 7, 229, 170, 119, 141, 176, 222, 129, 121, 235, 233, 65, 58,
-			19, 70, 60, 76, 182, 68, 196, 91, 94, 34, 34, 223, 107, 198,
-			41, 169, 113, 128, 146, 45, 65, 121, 182, 245, 166, 83, 75, 194,
-			199, 146, 128, 24, 132, 43, 52, 40, 203, 91, 65, 152, 126, 67,
-			186, 251, 73, 12, 61, 10, 36, 170, 48, 138, 121, 203, 219, 225,
-			53, 1, 156, 210, 224, 73, 200, 69, 208, 8, 163, 88, 0, 83,
-			180, 163, 176, 21, 38, 130, 75, 154, 36, 49, 111, 136, 200, 191,
-			37, 26, 124, 35, 10, 91, 84, 82, 33, 14, 55, 146, 109, 96,
-			19, 197, 65, 60, 110, 139, 58, 112, 16, 111, 71, 62, 48, 86,
-			4, 188, 19, 72, 46, 138, 99, 108, 59, 229, 107, 15, 45, 174,
-			242, 213, 229, 7, 215, 30, 153, 93, 89, 224, 139, 171, 252, 250,
-			202, 242, 203, 22, 231, 23, 230, 249, 229, 71, 249, 218, 67, 11,
-			124, 110, 249, 250, 163, 43, 139, 87, 30, 90, 227, 15, 45, 95,
-			157, 95, 88, 89, 229, 179, 75, 243, 124, 110, 121, 105, 109, 101,
-			241, 242, 141, 181, 229, 149, 85, 202, 203, 179, 171, 124, 113, 181,
-			140, 95, 102, 151, 30, 229, 11, 63, 116, 125, 101, 97, 117, 149,
-			47, 175, 240, 197, 107, 215, 175, 46, 46, 204, 243, 71, 102, 87,
-			86, 102, 151, 214, 22, 23, 86, 43, 124, 113, 105, 238, 234, 141,
-			249, 197, 165, 43, 21, 126, 249, 198, 26, 95, 90, 94, 163, 252,
-			234, 226, 181, 197, 181, 133, 121, 190, 182, 92, 193, 106, 119, 151,
-			227, 203, 15, 242, 107, 11, 43, 115, 15, 205, 46, 173, 205, 94,
-			94, 188, 186, 184, 246, 40, 86, 248, 224, 226, 218, 18, 84, 246,
-			224, 242, 10, 229, 179, 252, 250, 236, 202, 218, 226, 220, 141, 171,
-			179, 43, 252, 250, 141, 149, 235, 203, 171, 11, 28, 122, 54, 191,
-			184, 58, 119, 117, 118, 241, 218, 194, 124, 149, 47, 46, 241, 165,
-			101, 190, 240, 178, 133, 165, 53, 190, 250, 208, 236, 213, 171, 221,
-			29, 165, 124, 249, 145, 165, 133, 21, 104, 125, 182, 155, 252, 242,
-			2, 191, 186, 56, 123, 249, 234, 2, 84, 133, 253, 156, 95, 92,
-			89, 152, 91, 131, 14, 165, 191, 230, 22, 231, 23, 150, 214, 102,
-			175, 86, 40, 95, 189, 190, 48, 183, 56, 123, 181, 194, 23, 126,
-			104, 225, 218, 245, 171, 179, 43, 143, 86, 20, 210, 213, 133, 31,
-			188, 177, 176, 180, 182, 56, 123, 149, 207, 207, 94, 155, 189, 178,
-			176, 202, 167, 190, 29, 85, 174, 175, 44, 207, 221, 88, 89, 184,
-			6, 173, 94, 126, 144, 175, 222, 184, 188, 186, 182, 184, 118, 99,
-			109, 129, 95, 89, 94, 158, 71, 98, 175, 46, 172, 188, 108, 113,
-			110, 97, 245, 18, 191, 186, 188, 138, 4, 187, 177, 186, 80, 161,
-			124, 126, 118, 109, 22, 171, 190, 190, 178, 252, 224, 226, 218, 234,
-			37, 248, 125, 249, 198, 234, 34, 18, 110, 113, 105, 109, 97, 101,
-			229, 6, 158, 210, 76, 243, 135, 150, 31, 89, 120, 217, 194, 10,
-			159, 155, 189, 177, 186, 48, 143, 20, 94, 94, 130, 222, 2, 175,
-			44, 44, 175, 60, 10, 104, 129, 14, 56, 2, 21, 254, 200, 67,
-			11, 107, 15, 45, 172, 0, 81, 145, 90, 179, 64, 6, 216, 210,
-			205, 173, 101, 179, 45, 175, 240, 181, 229, 149, 53, 154, 233, 39,
-			95, 90, 184, 114, 117, 241, 202, 194, 210, 220, 2, 124, 94, 6,
-			52, 143, 44, 174, 46, 76, 243, 217, 149, 197, 85, 200, 176, 136,
-			21, 243, 71, 102, 31, 229, 203, 55, 176, 215, 48, 80, 55, 86,
-			23, 168, 252, 157, 97, 221, 10, 142, 39, 95, 124, 144, 207, 206,
-			191, 108, 17, 90, 174, 114, 95, 95, 94, 93, 93, 84, 236, 130,
-			100, 155, 123, 72, 209, 188, 74, 207, 255, 153, 205, 103, 59, 201,
-			86, 24, 93, 228, 55, 69, 144, 132, 193, 15, 164, 130, 157, 79,
-			189, 20, 147, 248, 203, 188, 168, 225, 77, 83, 206, 47, 123, 48,
-			51, 195, 128, 135, 145, 191, 233, 7, 94, 115, 247, 2, 212, 16,
-			177, 191, 25, 240, 218, 14, 229, 124, 213, 11, 30, 247, 118, 248,
-			149, 45, 209, 242, 182, 189, 164, 194, 31, 22, 27, 27, 124, 94,
-			120, 32, 206, 131, 134, 148, 52, 49, 78, 194, 45, 193, 149, 109,
-			40, 150, 194, 201, 143, 57, 44, 219, 92, 174, 151, 53, 41, 5,
-			27, 98, 195, 15, 148, 128, 219, 8, 59, 65, 3, 242, 202, 21,
-			25, 115, 199, 85, 152, 0, 183, 188, 166, 223, 200, 38, 243, 186,
-			23, 128, 92, 73, 34, 47, 136, 155, 160, 97, 240, 134, 31, 137,
-			122, 210, 220, 1, 49, 227, 241, 61, 220, 153, 168, 145, 34, 94,
-			176, 163, 100, 162, 31, 200, 37, 20, 132, 229, 148, 168, 110, 86,
-			77, 158, 72, 170, 67, 32, 210, 184, 223, 106, 135, 81, 18, 79,
-			87, 41, 45, 80, 203, 102, 206, 116, 223, 4, 252, 42, 48, 231,
-			116, 223, 60, 237, 167, 118, 97, 64, 254, 148, 137, 149, 190, 10,
-			38, 90, 242, 167, 76, 156, 233, 59, 135, 137, 234, 167, 76, 172,
-			246, 221, 139, 137, 39, 229, 79, 153, 120, 166, 239, 24, 38, 158,
-			144, 63, 101, 226, 217, 190, 163, 152, 120, 84, 254, 148, 137, 23,
-			250, 14, 209, 31, 161, 118, 161, 31, 127, 150, 18, 222, 235, 58,
-			38, 23, 158, 154, 224, 218, 30, 222, 128, 245, 8, 196, 168, 104,
-			240, 154, 168, 123, 176, 132, 71, 70, 49, 153, 169, 1, 59, 80,
-			238, 53, 55, 195, 200, 79, 182, 90, 49, 111, 132, 193, 100, 194,
-			183, 195, 232, 38, 111, 116, 64, 105, 231, 181, 48, 76, 226, 36,
-			242, 218, 109, 63, 216, 172, 82, 250, 56, 181, 73, 31, 35, 47,
-			234, 187, 104, 149, 30, 195, 113, 215, 234, 3, 175, 135, 173, 182,
-			223, 20, 17, 14, 151, 60, 162, 217, 53, 54, 171, 34, 193, 37,
-			195, 243, 3, 192, 14, 76, 33, 219, 78, 37, 3, 112, 63, 225,
-			109, 47, 138, 129, 21, 40, 165, 14, 233, 179, 152, 243, 162, 194,
-			1, 58, 64, 9, 233, 179, 251, 152, 115, 159, 61, 69, 7, 169,
-			11, 0, 1, 136, 106, 40, 199, 156, 251, 6, 142, 104, 200, 98,
-			206, 125, 71, 143, 107, 200, 97, 206, 125, 119, 78, 210, 51, 212,
-			38, 22, 35, 247, 247, 189, 220, 42, 29, 231, 243, 138, 53, 99,
-			238, 97, 219, 155, 34, 17, 89, 182, 83, 45, 176, 44, 230, 220,
-			95, 56, 72, 239, 163, 132, 88, 208, 130, 151, 216, 7, 203, 21,
-			201, 152, 176, 22, 86, 120, 36, 154, 184, 85, 2, 102, 140, 194,
-			48, 201, 40, 37, 73, 36, 132, 108, 161, 133, 237, 125, 137, 109,
-			32, 151, 57, 47, 25, 24, 213, 144, 197, 156, 151, 176, 125, 26,
-			114, 152, 243, 146, 3, 37, 122, 10, 171, 180, 152, 243, 128, 125,
-			164, 124, 152, 35, 203, 150, 55, 194, 176, 92, 193, 63, 213, 154,
-			23, 149, 43, 92, 36, 245, 170, 198, 106, 17, 200, 108, 32, 151,
-			57, 15, 152, 58, 160, 35, 15, 176, 3, 26, 114, 152, 243, 192,
-			161, 195, 244, 2, 214, 97, 51, 231, 178, 125, 172, 52, 201, 151,
-			244, 234, 174, 134, 3, 39, 3, 176, 207, 78, 58, 169, 77, 109,
-			54, 129, 98, 6, 114, 153, 115, 217, 212, 6, 205, 190, 204, 14,
-			105, 200, 97, 206, 229, 163, 156, 254, 32, 214, 230, 48, 103, 222,
-			158, 42, 205, 115, 244, 148, 144, 245, 1, 43, 72, 199, 192, 180,
-			82, 213, 6, 165, 236, 24, 55, 64, 169, 47, 161, 74, 101, 154,
-			226, 16, 192, 105, 32, 151, 57, 243, 3, 69, 13, 89, 204, 153,
-			31, 45, 107, 8, 106, 63, 57, 73, 159, 196, 166, 16, 230, 92,
-			177, 239, 44, 181, 122, 155, 178, 45, 188, 155, 207, 175, 33, 85,
-			202, 31, 12, 35, 165, 42, 205, 160, 194, 14, 146, 181, 229, 111,
-			70, 82, 212, 132, 65, 115, 167, 202, 231, 67, 208, 249, 64, 55,
-			50, 109, 38, 88, 185, 129, 92, 230, 92, 49, 109, 38, 22, 115,
-			174, 140, 114, 13, 57, 204, 185, 114, 252, 36, 189, 7, 219, 236,
-			50, 231, 97, 187, 82, 154, 70, 109, 63, 9, 219, 51, 104, 151,
-			233, 146, 174, 89, 25, 108, 234, 115, 9, 20, 52, 80, 142, 57,
-			15, 15, 148, 52, 100, 49, 231, 225, 131, 147, 26, 114, 152, 243,
-			240, 169, 211, 56, 235, 44, 59, 199, 156, 151, 218, 51, 234, 83,
-			142, 0, 164, 145, 228, 224, 155, 154, 117, 150, 157, 179, 152, 243,
-			210, 163, 83, 26, 114, 152, 243, 210, 211, 21, 133, 36, 207, 156,
-			171, 118, 85, 125, 202, 19, 128, 52, 146, 124, 142, 57, 87, 7,
-			142, 105, 200, 98, 206, 213, 242, 180, 134, 28, 230, 92, 173, 204,
-			40, 36, 5, 230, 92, 51, 72, 10, 4, 32, 141, 164, 144, 99,
-			206, 181, 129, 163, 26, 178, 152, 115, 141, 107, 36, 5, 135, 57,
-			215, 12, 146, 126, 230, 44, 219, 199, 213, 167, 126, 2, 144, 70,
-			210, 159, 99, 206, 242, 128, 158, 134, 253, 22, 115, 150, 247, 235,
-			206, 245, 59, 204, 89, 62, 86, 166, 255, 213, 66, 44, 148, 57,
-			55, 236, 51, 165, 191, 176, 248, 154, 36, 180, 104, 54, 180, 104,
-			139, 185, 118, 206, 233, 90, 115, 188, 26, 172, 53, 192, 66, 102,
-			253, 205, 236, 93, 170, 148, 63, 26, 118, 80, 135, 142, 189, 13,
-			209, 220, 225, 145, 104, 193, 238, 5, 7, 82, 4, 137, 31, 9,
-			85, 141, 94, 182, 182, 188, 168, 5, 98, 52, 234, 4, 137, 223,
-			18, 148, 111, 116, 130, 186, 172, 216, 79, 118, 52, 43, 167, 203,
-			68, 204, 103, 102, 48, 41, 219, 42, 63, 230, 129, 16, 13, 84,
-			12, 154, 59, 184, 242, 171, 125, 34, 108, 45, 120, 18, 134, 205,
-			216, 176, 16, 37, 208, 109, 3, 229, 152, 115, 99, 64, 75, 20,
-			106, 49, 231, 70, 233, 148, 134, 28, 230, 220, 152, 169, 210, 87,
-			32, 181, 6, 152, 243, 168, 125, 164, 116, 29, 87, 12, 233, 33,
-			106, 38, 125, 70, 224, 202, 207, 157, 182, 154, 118, 104, 31, 194,
-			93, 32, 47, 99, 182, 243, 101, 84, 60, 36, 112, 87, 217, 52,
-			107, 128, 0, 126, 3, 185, 204, 121, 212, 8, 162, 1, 139, 57,
-			143, 178, 9, 13, 57, 204, 121, 244, 224, 97, 58, 73, 109, 98,
-			51, 242, 138, 190, 215, 88, 165, 131, 93, 75, 129, 210, 99, 56,
-			236, 247, 213, 18, 0, 178, 236, 21, 133, 253, 200, 63, 54, 44,
-			1, 143, 217, 7, 17, 159, 141, 66, 253, 49, 85, 179, 141, 66,
-			253, 49, 85, 179, 141, 66, 253, 49, 37, 212, 109, 20, 234, 143,
-			29, 40, 41, 36, 22, 115, 94, 105, 159, 82, 159, 64, 106, 191,
-			210, 32, 177, 114, 204, 121, 165, 226, 100, 27, 165, 246, 43, 249,
-			73, 13, 57, 204, 121, 229, 212, 180, 66, 98, 51, 199, 83, 211,
-			193, 70, 97, 236, 25, 36, 48, 105, 61, 131, 4, 170, 243, 212,
-			116, 176, 81, 24, 123, 106, 58, 32, 80, 183, 79, 171, 79, 32,
-			70, 235, 6, 137, 147, 99, 78, 93, 137, 8, 27, 197, 104, 253,
-			224, 157, 26, 130, 114, 211, 167, 20, 18, 194, 156, 134, 18, 17,
-			54, 202, 181, 134, 65, 66, 114, 204, 105, 40, 17, 97, 163, 92,
-			107, 40, 17, 97, 163, 92, 107, 156, 174, 208, 65, 64, 226, 244,
-			49, 178, 97, 223, 116, 228, 55, 7, 168, 183, 65, 39, 232, 65,
-			154, 3, 8, 200, 190, 73, 14, 151, 7, 97, 55, 218, 236, 196,
-			62, 10, 255, 97, 154, 151, 31, 9, 124, 29, 76, 97, 151, 57,
-			155, 67, 44, 133, 45, 230, 108, 142, 77, 164, 176, 195, 156, 205,
-			131, 135, 12, 114, 139, 57, 91, 228, 96, 121, 144, 47, 60, 177,
-			27, 57, 12, 207, 86, 6, 57, 44, 171, 91, 25, 228, 48, 68,
-			91, 99, 251, 82, 216, 97, 206, 214, 129, 18, 29, 82, 200, 109,
-			230, 60, 78, 206, 152, 207, 64, 172, 199, 51, 232, 96, 168, 30,
-			31, 42, 167, 176, 197, 156, 199, 143, 159, 74, 97, 135, 57, 143,
-			207, 84, 21, 165, 93, 230, 52, 205, 152, 131, 68, 111, 26, 74,
-			131, 68, 111, 170, 233, 104, 163, 68, 111, 150, 244, 152, 131, 68,
-			111, 154, 49, 207, 49, 39, 176, 207, 168, 79, 32, 209, 3, 131,
-			4, 36, 122, 96, 24, 7, 36, 122, 192, 53, 159, 130, 68, 15,
-			76, 75, 242, 204, 105, 219, 154, 29, 64, 162, 183, 13, 18, 144,
-			232, 109, 211, 18, 144, 232, 237, 210, 49, 13, 57, 204, 105, 159,
-			56, 73, 63, 104, 225, 160, 91, 140, 116, 236, 39, 156, 210, 79,
-			88, 28, 253, 178, 64, 44, 104, 211, 21, 79, 188, 77, 46, 189,
-			167, 226, 42, 95, 217, 35, 21, 197, 37, 172, 171, 218, 236, 0,
-			226, 11, 133, 100, 204, 195, 136, 27, 171, 48, 71, 215, 51, 179,
-			132, 199, 94, 203, 108, 88, 50, 136, 85, 166, 150, 183, 131, 134,
-			34, 30, 222, 18, 81, 211, 107, 43, 49, 99, 59, 48, 208, 29,
-			186, 95, 113, 13, 42, 131, 183, 110, 195, 146, 82, 221, 187, 101,
-			134, 89, 42, 124, 183, 12, 215, 72, 149, 239, 150, 97, 73, 169,
-			244, 221, 50, 44, 137, 106, 223, 246, 109, 88, 82, 234, 121, 219,
-			25, 228, 192, 146, 219, 25, 228, 208, 210, 109, 195, 146, 82, 219,
-			219, 54, 194, 167, 192, 156, 29, 187, 162, 198, 3, 150, 209, 29,
-			51, 114, 176, 140, 238, 12, 76, 104, 200, 98, 206, 206, 129, 73,
-			13, 57, 204, 217, 57, 117, 154, 190, 22, 71, 14, 214, 209, 87,
-			219, 39, 75, 157, 148, 126, 114, 117, 66, 3, 81, 133, 111, 111,
-			249, 245, 173, 61, 198, 71, 15, 207, 94, 67, 1, 219, 191, 77,
-			255, 150, 8, 164, 5, 10, 10, 203, 69, 73, 164, 60, 17, 6,
-			117, 173, 210, 216, 184, 124, 191, 218, 52, 190, 223, 101, 206, 171,
-			141, 248, 133, 229, 251, 213, 76, 115, 50, 44, 223, 175, 46, 159,
-			160, 3, 20, 164, 142, 251, 35, 125, 111, 176, 44, 20, 238, 32,
-			214, 126, 164, 112, 152, 46, 83, 66, 28, 187, 143, 145, 255, 201,
-			178, 47, 150, 102, 229, 222, 6, 182, 34, 17, 143, 147, 48, 18,
-			122, 81, 199, 61, 74, 35, 20, 49, 108, 149, 34, 81, 15, 55,
-			3, 255, 73, 193, 183, 68, 36, 170, 124, 85, 8, 163, 152, 14,
-			81, 23, 16, 18, 196, 104, 192, 28, 128, 3, 71, 52, 104, 1,
-			120, 244, 46, 13, 58, 0, 222, 115, 31, 125, 57, 52, 204, 101,
-			228, 245, 150, 125, 160, 116, 141, 207, 161, 39, 90, 140, 59, 43,
-			84, 243, 4, 175, 119, 226, 36, 108, 165, 109, 10, 82, 102, 87,
-			74, 172, 31, 167, 44, 158, 109, 23, 8, 91, 199, 237, 3, 228,
-			67, 19, 178, 98, 23, 218, 241, 122, 107, 104, 84, 131, 54, 128,
-			119, 76, 208, 187, 40, 72, 243, 220, 27, 173, 190, 191, 182, 172,
-			210, 201, 174, 133, 50, 213, 69, 252, 32, 93, 55, 171, 148, 14,
-			80, 135, 192, 62, 235, 141, 86, 225, 16, 29, 166, 132, 16, 210,
-			199, 114, 111, 178, 236, 15, 88, 14, 86, 64, 96, 91, 71, 222,
-			100, 229, 7, 232, 42, 205, 17, 185, 179, 35, 111, 177, 200, 120,
-			105, 142, 159, 5, 141, 196, 140, 54, 108, 96, 69, 20, 133, 81,
-			92, 165, 124, 57, 106, 192, 54, 62, 230, 219, 194, 143, 228, 183,
-			45, 31, 6, 199, 175, 123, 77, 216, 196, 199, 97, 0, 186, 202,
-			8, 205, 75, 164, 22, 98, 29, 73, 19, 108, 72, 96, 99, 116,
-			88, 85, 107, 49, 242, 63, 91, 100, 204, 100, 176, 100, 194, 112,
-			154, 96, 67, 194, 40, 163, 219, 170, 132, 205, 200, 83, 22, 25,
-			43, 109, 242, 165, 48, 225, 47, 247, 55, 95, 238, 109, 114, 17,
-			128, 6, 215, 168, 114, 190, 164, 142, 205, 140, 128, 74, 188, 155,
-			130, 159, 59, 203, 107, 59, 137, 136, 171, 156, 223, 136, 5, 207,
-			56, 28, 115, 127, 131, 114, 125, 214, 150, 85, 120, 154, 254, 77,
-			209, 220, 201, 116, 6, 218, 250, 84, 182, 105, 178, 41, 163, 204,
-			116, 198, 97, 228, 109, 22, 25, 55, 25, 64, 182, 190, 45, 219,
-			125, 199, 134, 4, 54, 102, 58, 67, 24, 121, 251, 247, 173, 51,
-			119, 157, 127, 254, 157, 1, 246, 120, 123, 182, 51, 160, 140, 189,
-			61, 219, 25, 151, 145, 119, 88, 228, 14, 147, 193, 181, 48, 161,
-			152, 38, 216, 144, 48, 54, 110, 74, 228, 24, 121, 38, 91, 34,
-			103, 97, 66, 90, 34, 103, 67, 66, 166, 68, 158, 145, 119, 90,
-			132, 153, 12, 121, 11, 19, 134, 210, 4, 27, 18, 138, 163, 166,
-			68, 129, 145, 127, 147, 37, 113, 193, 194, 132, 148, 196, 5, 27,
-			18, 216, 24, 253, 172, 165, 138, 244, 51, 242, 83, 192, 217, 159,
-			178, 248, 154, 183, 57, 211, 16, 77, 191, 229, 131, 118, 107, 14,
-			60, 171, 148, 95, 137, 194, 78, 27, 85, 78, 96, 239, 244, 4,
-			30, 213, 93, 144, 159, 169, 82, 236, 7, 82, 101, 190, 171, 202,
-			31, 10, 183, 197, 45, 17, 85, 164, 25, 239, 46, 10, 27, 214,
-			166, 48, 39, 2, 49, 143, 183, 194, 78, 179, 193, 227, 196, 111,
-			54, 65, 136, 122, 181, 38, 90, 41, 80, 174, 161, 248, 221, 196,
-			138, 183, 113, 111, 129, 91, 2, 168, 145, 242, 36, 18, 94, 162,
-			62, 42, 121, 237, 197, 188, 19, 220, 12, 194, 237, 64, 165, 100,
-			134, 179, 223, 194, 78, 166, 195, 217, 111, 67, 194, 232, 24, 157,
-			81, 84, 160, 140, 60, 107, 145, 125, 229, 195, 252, 170, 8, 54,
-			147, 173, 189, 233, 96, 202, 83, 11, 243, 167, 99, 71, 109, 72,
-			24, 187, 131, 30, 87, 8, 7, 24, 121, 15, 144, 117, 140, 47,
-			137, 109, 32, 202, 45, 17, 225, 74, 127, 62, 131, 102, 192, 194,
-			92, 105, 187, 6, 108, 72, 24, 77, 5, 192, 32, 35, 63, 155,
-			101, 154, 65, 11, 19, 210, 1, 29, 180, 33, 129, 165, 76, 51,
-			196, 200, 123, 179, 34, 99, 200, 194, 132, 148, 105, 134, 108, 72,
-			40, 166, 172, 60, 204, 200, 251, 44, 178, 223, 100, 24, 182, 48,
-			97, 52, 77, 176, 33, 97, 124, 159, 41, 49, 194, 200, 207, 101,
-			75, 140, 88, 152, 144, 150, 24, 177, 33, 97, 124, 31, 157, 84,
-			37, 138, 140, 252, 188, 69, 238, 40, 239, 135, 57, 25, 119, 77,
-			101, 105, 184, 211, 37, 139, 22, 230, 76, 59, 88, 180, 33, 129,
-			141, 27, 84, 163, 140, 188, 255, 121, 161, 26, 181, 48, 103, 138,
-			106, 212, 134, 4, 164, 21, 8, 125, 139, 229, 126, 193, 178, 127,
-			201, 8, 125, 16, 174, 191, 96, 229, 7, 233, 41, 172, 9, 244,
-			39, 242, 191, 90, 100, 127, 169, 116, 91, 161, 175, 43, 67, 117,
-			9, 50, 179, 52, 193, 134, 132, 59, 52, 209, 64, 97, 34, 191,
-			152, 18, 13, 117, 32, 72, 72, 75, 128, 44, 255, 197, 108, 9,
-			155, 145, 15, 101, 75, 0, 138, 15, 101, 75, 200, 28, 119, 236,
-			195, 53, 147, 64, 123, 127, 217, 178, 15, 202, 238, 224, 202, 254,
-			203, 122, 101, 39, 160, 223, 145, 95, 182, 6, 70, 53, 104, 1,
-			200, 246, 105, 208, 1, 240, 64, 73, 97, 178, 24, 249, 176, 101,
-			31, 82, 31, 45, 130, 160, 198, 100, 185, 0, 14, 20, 53, 136,
-			153, 71, 247, 107, 208, 1, 176, 116, 80, 97, 178, 25, 249, 72,
-			218, 38, 144, 232, 31, 73, 49, 129, 76, 252, 72, 138, 9, 170,
-			253, 136, 53, 170, 219, 4, 11, 198, 71, 160, 77, 239, 182, 16,
-			149, 195, 200, 175, 130, 190, 241, 22, 139, 47, 110, 112, 115, 119,
-			10, 134, 38, 22, 137, 58, 171, 12, 132, 104, 104, 141, 46, 22,
-			73, 149, 67, 222, 90, 136, 71, 139, 190, 58, 183, 212, 37, 41,
-			10, 255, 180, 172, 177, 79, 7, 168, 230, 155, 219, 59, 21, 158,
-			189, 251, 3, 138, 123, 122, 55, 168, 170, 251, 226, 16, 108, 158,
-			1, 115, 0, 14, 140, 104, 208, 2, 176, 56, 174, 65, 236, 203,
-			254, 9, 250, 191, 219, 216, 53, 194, 200, 111, 90, 54, 47, 189,
-			223, 70, 99, 157, 222, 227, 67, 107, 69, 208, 105, 97, 147, 99,
-			213, 74, 63, 238, 58, 3, 133, 223, 104, 11, 192, 158, 234, 47,
-			148, 227, 125, 145, 88, 158, 170, 122, 124, 178, 58, 89, 1, 197,
-			208, 143, 249, 70, 167, 217, 220, 153, 121, 85, 199, 107, 162, 59,
-			100, 149, 243, 229, 100, 75, 68, 219, 126, 44, 42, 124, 238, 244,
-			233, 25, 88, 13, 121, 92, 15, 219, 126, 176, 73, 121, 212, 105,
-			170, 85, 82, 159, 155, 110, 248, 234, 240, 23, 215, 131, 41, 191,
-			42, 170, 124, 195, 143, 98, 105, 59, 146, 215, 78, 101, 139, 181,
-			246, 5, 237, 166, 105, 175, 144, 232, 94, 84, 223, 18, 13, 232,
-			147, 8, 210, 124, 168, 211, 138, 32, 169, 240, 48, 224, 176, 228,
-			132, 152, 24, 133, 97, 66, 185, 113, 133, 158, 54, 84, 39, 146,
-			114, 6, 116, 1, 52, 76, 14, 203, 248, 111, 90, 76, 115, 31,
-			113, 0, 60, 114, 148, 254, 75, 36, 186, 203, 200, 239, 88, 246,
-			209, 82, 27, 105, 158, 170, 167, 207, 77, 103, 94, 19, 126, 176,
-			201, 213, 109, 60, 32, 223, 34, 144, 149, 130, 120, 8, 155, 183,
-			228, 10, 152, 238, 28, 188, 32, 16, 17, 44, 78, 134, 235, 76,
-			219, 93, 130, 13, 48, 32, 182, 199, 180, 29, 20, 138, 223, 177,
-			88, 73, 131, 14, 128, 135, 143, 208, 167, 36, 199, 228, 24, 249,
-			93, 203, 62, 81, 122, 173, 228, 152, 160, 211, 18, 145, 95, 215,
-			140, 98, 12, 125, 93, 214, 188, 68, 60, 33, 15, 254, 69, 108,
-			78, 229, 85, 207, 80, 25, 82, 166, 226, 90, 24, 54, 133, 7,
-			116, 40, 39, 81, 71, 148, 129, 225, 203, 232, 124, 87, 86, 57,
-			164, 199, 84, 111, 61, 234, 254, 161, 172, 6, 190, 224, 158, 96,
-			10, 38, 163, 136, 235, 94, 91, 146, 198, 11, 118, 248, 182, 183,
-			51, 173, 43, 3, 85, 173, 7, 209, 156, 201, 47, 155, 37, 253,
-			70, 48, 39, 127, 201, 253, 252, 220, 249, 23, 33, 15, 169, 76,
-			134, 158, 57, 130, 52, 49, 160, 11, 160, 161, 39, 168, 91, 191,
-			107, 177, 163, 26, 116, 0, 44, 31, 167, 255, 74, 10, 151, 60,
-			35, 159, 180, 236, 99, 165, 91, 48, 139, 80, 36, 192, 70, 47,
-			86, 230, 200, 134, 64, 123, 160, 199, 241, 2, 165, 30, 225, 236,
-			73, 209, 78, 91, 76, 198, 60, 189, 200, 76, 165, 241, 157, 103,
-			205, 175, 190, 180, 223, 129, 174, 42, 233, 238, 37, 178, 132, 233,
-			66, 158, 96, 51, 12, 232, 2, 104, 228, 35, 232, 127, 159, 180,
-			70, 181, 88, 206, 59, 0, 30, 229, 244, 31, 101, 23, 10, 140,
-			252, 33, 116, 225, 175, 44, 254, 240, 234, 242, 82, 134, 115, 117,
-			11, 170, 184, 107, 68, 162, 42, 169, 9, 27, 222, 93, 39, 100,
-			85, 37, 73, 40, 76, 249, 136, 111, 121, 50, 171, 199, 203, 230,
-			198, 104, 89, 237, 236, 96, 154, 166, 248, 43, 170, 79, 248, 105,
-			50, 150, 53, 81, 190, 173, 212, 59, 16, 32, 213, 172, 180, 241,
-			147, 73, 208, 38, 27, 157, 186, 114, 181, 144, 62, 37, 128, 106,
-			50, 150, 237, 175, 237, 0, 153, 111, 137, 40, 65, 105, 228, 39,
-			32, 18, 234, 94, 75, 52, 231, 188, 56, 157, 75, 5, 130, 157,
-			55, 160, 11, 160, 25, 123, 80, 131, 255, 48, 149, 3, 5, 7,
-			192, 35, 92, 45, 81, 253, 140, 124, 218, 178, 79, 168, 143, 253,
-			4, 65, 141, 169, 63, 7, 224, 128, 94, 221, 64, 147, 252, 180,
-			53, 161, 185, 168, 223, 1, 176, 124, 156, 126, 184, 31, 81, 81,
-			70, 190, 104, 217, 39, 75, 239, 237, 71, 18, 70, 29, 145, 10,
-			19, 79, 233, 197, 188, 172, 173, 239, 229, 42, 127, 4, 164, 159,
-			249, 98, 216, 68, 231, 0, 18, 129, 182, 235, 213, 111, 198, 92,
-			206, 219, 186, 224, 160, 142, 70, 141, 166, 136, 213, 73, 24, 20,
-			82, 230, 96, 137, 176, 231, 10, 46, 138, 178, 180, 45, 178, 128,
-			94, 236, 106, 162, 25, 2, 3, 135, 134, 187, 147, 144, 242, 216,
-			223, 68, 137, 17, 242, 176, 217, 208, 205, 171, 171, 109, 62, 14,
-			178, 105, 13, 34, 71, 79, 127, 84, 140, 186, 185, 13, 4, 163,
-			154, 51, 49, 151, 218, 185, 7, 164, 40, 199, 59, 65, 178, 37,
-			18, 191, 94, 150, 223, 43, 202, 165, 104, 87, 251, 252, 36, 230,
-			113, 216, 68, 71, 43, 156, 57, 83, 194, 171, 111, 233, 38, 153,
-			46, 202, 66, 155, 34, 137, 177, 4, 84, 100, 170, 144, 53, 76,
-			87, 249, 170, 78, 81, 141, 138, 185, 120, 194, 143, 147, 244, 228,
-			76, 159, 68, 160, 89, 71, 54, 169, 33, 15, 198, 244, 53, 52,
-			148, 95, 179, 215, 23, 247, 66, 102, 244, 135, 168, 33, 34, 216,
-			57, 108, 36, 32, 251, 155, 77, 94, 142, 132, 215, 84, 61, 69,
-			143, 132, 236, 42, 47, 183, 43, 149, 93, 163, 166, 141, 56, 117,
-			216, 236, 200, 138, 99, 209, 242, 2, 232, 145, 244, 185, 171, 192,
-			64, 193, 24, 4, 97, 48, 19, 137, 182, 192, 61, 89, 55, 94,
-			238, 53, 183, 189, 29, 53, 70, 102, 212, 204, 230, 12, 102, 20,
-			238, 191, 40, 136, 57, 31, 118, 117, 82, 232, 153, 170, 26, 34,
-			241, 252, 38, 112, 218, 246, 150, 48, 46, 88, 40, 25, 182, 163,
-			48, 17, 25, 126, 134, 149, 34, 8, 19, 60, 40, 241, 99, 237,
-			23, 209, 137, 197, 70, 167, 137, 204, 17, 133, 157, 160, 49, 147,
-			68, 62, 158, 215, 103, 206, 215, 229, 1, 11, 146, 165, 30, 6,
-			177, 31, 163, 19, 52, 223, 22, 20, 229, 240, 174, 62, 245, 14,
-			46, 247, 154, 113, 88, 225, 226, 150, 128, 161, 12, 59, 155, 91,
-			74, 219, 129, 177, 139, 196, 171, 58, 126, 36, 96, 223, 24, 238,
-			162, 195, 154, 154, 158, 2, 221, 185, 188, 102, 115, 71, 157, 171,
-			122, 65, 98, 188, 18, 146, 212, 216, 86, 247, 130, 73, 152, 147,
-			162, 217, 228, 254, 134, 177, 51, 249, 217, 179, 153, 48, 226, 94,
-			128, 154, 91, 133, 199, 33, 180, 4, 89, 67, 141, 132, 30, 79,
-			218, 219, 9, 96, 12, 220, 42, 159, 239, 225, 235, 88, 115, 33,
-			136, 97, 57, 71, 154, 222, 102, 37, 219, 188, 29, 238, 53, 35,
-			225, 53, 118, 204, 48, 210, 20, 9, 42, 129, 175, 236, 190, 45,
-			254, 74, 35, 59, 41, 65, 169, 101, 64, 23, 64, 163, 185, 194,
-			86, 247, 139, 86, 81, 75, 60, 234, 0, 88, 62, 65, 203, 20,
-			180, 173, 220, 151, 172, 190, 191, 179, 172, 210, 120, 151, 233, 77,
-			247, 102, 128, 58, 4, 244, 152, 47, 89, 133, 67, 40, 108, 93,
-			216, 163, 124, 89, 239, 7, 92, 220, 163, 124, 89, 87, 237, 226,
-			30, 229, 203, 90, 108, 187, 184, 71, 249, 178, 222, 163, 184, 184,
-			71, 249, 178, 222, 163, 184, 176, 89, 248, 138, 22, 219, 46, 238,
-			81, 190, 146, 98, 178, 114, 0, 42, 177, 237, 226, 30, 229, 43,
-			90, 108, 187, 184, 71, 249, 10, 136, 237, 73, 106, 147, 28, 203,
-			253, 189, 213, 247, 191, 216, 86, 233, 64, 182, 19, 65, 170, 121,
-			171, 158, 128, 6, 241, 247, 86, 65, 238, 108, 114, 208, 147, 175,
-			233, 158, 228, 176, 39, 95, 211, 245, 231, 176, 39, 95, 211, 61,
-			201, 97, 79, 190, 166, 123, 146, 195, 158, 124, 77, 247, 36, 7,
-			61, 249, 186, 101, 87, 213, 71, 232, 201, 215, 83, 76, 208, 147,
-			175, 91, 3, 199, 53, 136, 153, 79, 76, 107, 208, 1, 176, 50,
-			163, 48, 217, 140, 124, 195, 178, 117, 94, 216, 86, 124, 35, 197,
-			4, 58, 227, 55, 172, 1, 221, 8, 168, 246, 27, 214, 254, 35,
-			26, 116, 0, 60, 86, 166, 31, 2, 5, 51, 231, 244, 177, 220,
-			235, 108, 251, 13, 182, 83, 250, 41, 123, 143, 163, 15, 173, 112,
-			74, 43, 90, 230, 144, 66, 153, 213, 246, 58, 248, 16, 65, 18,
-			249, 61, 167, 28, 64, 228, 61, 143, 56, 122, 78, 56, 248, 18,
-			136, 26, 229, 142, 43, 103, 108, 195, 143, 19, 63, 168, 39, 82,
-			115, 120, 206, 216, 44, 178, 74, 47, 193, 229, 20, 74, 235, 3,
-			17, 30, 119, 234, 91, 250, 19, 10, 44, 175, 221, 142, 194, 118,
-			228, 123, 137, 60, 227, 86, 218, 50, 182, 88, 157, 114, 251, 65,
-			114, 215, 121, 202, 27, 97, 203, 243, 3, 53, 141, 114, 14, 140,
-			241, 235, 108, 122, 144, 30, 162, 57, 0, 129, 65, 254, 149, 189,
-			235, 252, 5, 246, 238, 57, 121, 36, 8, 159, 7, 211, 4, 23,
-			18, 134, 88, 154, 96, 65, 194, 216, 68, 154, 224, 64, 194, 193,
-			67, 166, 6, 139, 145, 215, 219, 120, 8, 179, 87, 13, 192, 72,
-			175, 207, 214, 0, 27, 247, 215, 103, 107, 176, 16, 193, 216, 190,
-			52, 193, 129, 132, 3, 37, 121, 250, 149, 3, 158, 120, 179, 109,
-			159, 221, 251, 244, 235, 182, 44, 208, 253, 161, 151, 21, 40, 232,
-			116, 56, 183, 212, 247, 94, 118, 224, 160, 63, 123, 210, 59, 229,
-			182, 172, 65, 51, 188, 33, 25, 24, 54, 224, 111, 182, 13, 183,
-			195, 6, 252, 205, 246, 192, 33, 13, 90, 0, 30, 62, 173, 65,
-			236, 89, 245, 12, 109, 98, 63, 9, 35, 111, 181, 237, 19, 165,
-			199, 210, 234, 210, 22, 222, 246, 196, 40, 18, 82, 163, 221, 243,
-			80, 136, 238, 117, 42, 36, 43, 39, 178, 58, 3, 186, 0, 26,
-			89, 1, 155, 214, 183, 218, 106, 163, 146, 195, 77, 235, 91, 237,
-			242, 113, 122, 142, 218, 36, 207, 114, 63, 110, 247, 189, 211, 238,
-			245, 15, 147, 237, 212, 103, 29, 82, 116, 41, 169, 5, 155, 134,
-			31, 183, 11, 82, 217, 205, 3, 83, 62, 109, 43, 169, 149, 71,
-			38, 124, 90, 183, 36, 143, 44, 248, 180, 110, 73, 30, 25, 240,
-			105, 91, 73, 173, 60, 178, 223, 211, 182, 146, 90, 121, 96, 190,
-			183, 219, 202, 70, 148, 71, 102, 123, 123, 138, 9, 88, 237, 237,
-			182, 218, 185, 228, 145, 209, 222, 110, 43, 27, 81, 30, 217, 236,
-			237, 182, 178, 17, 229, 65, 106, 61, 99, 219, 83, 234, 35, 12,
-			198, 51, 41, 38, 180, 155, 219, 3, 186, 197, 80, 237, 51, 246,
-			161, 227, 26, 116, 0, 188, 115, 146, 158, 160, 54, 41, 176, 220,
-			79, 216, 125, 63, 109, 91, 165, 125, 93, 212, 81, 151, 211, 21,
-			65, 96, 51, 240, 19, 118, 225, 8, 86, 94, 0, 130, 188, 91,
-			19, 164, 128, 4, 121, 183, 174, 188, 128, 4, 121, 183, 38, 72,
-			1, 9, 242, 110, 77, 144, 2, 18, 228, 221, 154, 32, 5, 104,
-			217, 79, 218, 118, 69, 125, 4, 130, 252, 100, 138, 9, 196, 248,
-			79, 218, 3, 92, 131, 152, 249, 216, 164, 6, 29, 0, 79, 157,
-			86, 152, 108, 70, 158, 181, 237, 59, 213, 71, 32, 200, 179, 41,
-			38, 32, 200, 179, 246, 192, 1, 13, 90, 0, 150, 142, 105, 208,
-			1, 240, 196, 73, 122, 150, 218, 164, 159, 229, 126, 214, 238, 251,
-			223, 108, 171, 84, 238, 241, 33, 73, 182, 194, 134, 220, 209, 118,
-			19, 7, 246, 55, 63, 107, 23, 14, 99, 67, 250, 129, 56, 239,
-			213, 196, 233, 71, 226, 188, 87, 55, 164, 31, 137, 243, 94, 77,
-			156, 126, 36, 206, 123, 53, 113, 250, 145, 56, 239, 5, 226, 60,
-			99, 33, 42, 139, 145, 247, 219, 246, 177, 210, 191, 182, 248, 98,
-			128, 190, 151, 65, 67, 187, 97, 162, 93, 5, 103, 25, 110, 152,
-			69, 44, 141, 68, 123, 90, 84, 182, 189, 29, 238, 197, 148, 239,
-			25, 94, 201, 24, 89, 42, 188, 214, 73, 244, 77, 135, 13, 208,
-			89, 195, 221, 190, 51, 178, 161, 48, 80, 239, 79, 123, 5, 156,
-			251, 254, 180, 87, 22, 182, 155, 29, 210, 160, 3, 224, 81, 174,
-			232, 99, 51, 242, 1, 219, 46, 171, 143, 48, 80, 31, 72, 49,
-			217, 46, 128, 6, 19, 80, 224, 3, 54, 59, 172, 65, 7, 64,
-			126, 76, 97, 114, 24, 249, 5, 219, 62, 169, 62, 130, 44, 251,
-			133, 20, 19, 200, 178, 95, 176, 7, 38, 52, 104, 1, 120, 128,
-			107, 16, 203, 30, 63, 65, 23, 16, 19, 97, 228, 23, 109, 251,
-			222, 210, 189, 124, 81, 95, 191, 139, 65, 83, 149, 187, 55, 46,
-			99, 131, 192, 102, 69, 70, 219, 208, 233, 218, 63, 90, 215, 73,
-			36, 30, 3, 186, 0, 42, 173, 176, 31, 133, 212, 47, 218, 69,
-			221, 4, 16, 82, 191, 8, 77, 80, 96, 1, 192, 147, 247, 104,
-			48, 15, 224, 217, 187, 85, 3, 93, 70, 126, 105, 175, 6, 202,
-			232, 36, 187, 27, 168, 210, 123, 27, 232, 18, 196, 99, 64, 68,
-			107, 26, 8, 106, 231, 47, 165, 13, 116, 29, 0, 77, 3, 221,
-			2, 128, 166, 129, 110, 30, 192, 179, 119, 211, 15, 13, 83, 155,
-			80, 150, 251, 35, 187, 239, 247, 29, 235, 252, 18, 191, 255, 123,
-			255, 71, 185, 186, 115, 74, 207, 255, 135, 33, 190, 0, 155, 87,
-			227, 249, 150, 122, 68, 202, 155, 64, 176, 120, 108, 121, 183, 204,
-			158, 42, 46, 115, 47, 145, 119, 224, 178, 51, 131, 242, 199, 113,
-			63, 97, 110, 169, 197, 153, 197, 73, 110, 9, 240, 186, 81, 18,
-			194, 34, 164, 183, 174, 13, 30, 55, 253, 205, 173, 164, 185, 195,
-			27, 254, 198, 134, 136, 68, 144, 192, 58, 5, 91, 81, 111, 71,
-			91, 179, 248, 150, 15, 123, 252, 13, 220, 137, 53, 148, 202, 213,
-			242, 2, 191, 221, 105, 226, 230, 208, 152, 139, 244, 128, 128, 118,
-			166, 61, 0, 0, 209, 222, 30, 0, 94, 188, 203, 3, 64, 240,
-			83, 138, 52, 25, 92, 170, 151, 153, 188, 122, 197, 221, 17, 184,
-			234, 42, 43, 130, 116, 196, 198, 93, 164, 15, 243, 61, 14, 123,
-			118, 105, 168, 36, 160, 107, 68, 106, 225, 212, 202, 70, 171, 202,
-			249, 98, 16, 39, 194, 107, 200, 13, 48, 58, 80, 192, 7, 52,
-			81, 234, 61, 93, 208, 213, 200, 212, 130, 93, 247, 154, 77, 209,
-			224, 123, 93, 4, 174, 102, 13, 126, 40, 127, 112, 60, 141, 248,
-			82, 231, 14, 245, 40, 140, 99, 52, 13, 236, 38, 1, 127, 68,
-			72, 171, 184, 220, 218, 25, 108, 73, 200, 219, 161, 28, 5, 105,
-			157, 203, 208, 104, 27, 173, 232, 130, 215, 58, 126, 179, 193, 189,
-			140, 41, 163, 2, 164, 146, 220, 209, 14, 253, 32, 193, 74, 113,
-			12, 99, 217, 180, 154, 16, 1, 149, 116, 147, 231, 185, 113, 136,
-			121, 50, 216, 65, 24, 35, 217, 97, 168, 205, 189, 85, 115, 236,
-			142, 188, 210, 61, 218, 234, 170, 85, 125, 43, 140, 5, 154, 124,
-			228, 69, 177, 248, 34, 229, 167, 112, 151, 175, 51, 202, 150, 161,
-			133, 80, 187, 210, 224, 126, 193, 248, 109, 192, 16, 55, 5, 168,
-			229, 77, 117, 89, 143, 135, 17, 229, 156, 135, 209, 166, 23, 248,
-			79, 170, 251, 123, 97, 36, 143, 229, 158, 104, 139, 200, 199, 35,
-			230, 166, 174, 163, 130, 132, 84, 206, 63, 170, 201, 119, 159, 61,
-			123, 246, 44, 96, 73, 182, 34, 180, 22, 220, 7, 255, 180, 49,
-			95, 157, 60, 236, 132, 29, 121, 79, 44, 238, 68, 106, 34, 64,
-			82, 195, 184, 74, 203, 97, 224, 106, 100, 17, 53, 182, 194, 136,
-			46, 213, 130, 234, 115, 244, 186, 166, 252, 202, 227, 45, 69, 126,
-			236, 190, 116, 53, 71, 127, 87, 131, 13, 106, 242, 3, 237, 222,
-			157, 224, 110, 36, 241, 65, 51, 21, 51, 45, 207, 87, 163, 90,
-			235, 108, 204, 108, 54, 195, 154, 215, 156, 49, 35, 56, 19, 137,
-			77, 63, 78, 162, 157, 204, 245, 27, 236, 124, 168, 53, 212, 140,
-			43, 154, 113, 104, 91, 245, 91, 237, 230, 142, 190, 160, 7, 93,
-			199, 139, 114, 143, 139, 122, 34, 85, 93, 188, 164, 2, 120, 150,
-			107, 144, 232, 223, 18, 51, 115, 188, 221, 236, 108, 250, 193, 52,
-			118, 165, 171, 200, 182, 168, 197, 126, 34, 248, 148, 191, 193, 189,
-			91, 158, 223, 244, 106, 77, 49, 173, 92, 126, 35, 49, 25, 243,
-			32, 4, 100, 120, 130, 7, 100, 127, 162, 221, 68, 121, 20, 110,
-			35, 217, 97, 174, 5, 248, 69, 81, 190, 85, 229, 55, 226, 14,
-			90, 108, 224, 59, 178, 15, 22, 14, 3, 164, 85, 111, 151, 170,
-			232, 192, 44, 125, 148, 96, 131, 33, 118, 141, 147, 186, 36, 10,
-			136, 194, 32, 67, 19, 108, 150, 28, 222, 218, 14, 111, 119, 146,
-			68, 89, 175, 148, 184, 136, 59, 181, 153, 46, 95, 38, 60, 197,
-			144, 51, 66, 79, 239, 88, 222, 106, 225, 225, 134, 100, 59, 188,
-			30, 25, 43, 166, 245, 90, 237, 166, 128, 201, 193, 191, 211, 203,
-			176, 128, 68, 166, 30, 15, 181, 152, 82, 39, 129, 126, 204, 147,
-			78, 4, 194, 182, 147, 168, 165, 64, 202, 15, 144, 9, 48, 28,
-			90, 247, 51, 140, 24, 139, 132, 119, 218, 138, 51, 188, 78, 18,
-			182, 188, 196, 175, 35, 133, 189, 24, 47, 94, 41, 171, 191, 230,
-			17, 169, 52, 82, 139, 145, 63, 178, 11, 99, 210, 247, 156, 130,
-			214, 248, 25, 219, 62, 94, 250, 47, 22, 95, 21, 137, 52, 39,
-			62, 236, 221, 242, 184, 138, 78, 4, 210, 42, 2, 197, 195, 139,
-			99, 17, 103, 214, 39, 117, 4, 224, 199, 218, 42, 168, 26, 70,
-			121, 187, 233, 213, 113, 13, 188, 188, 163, 207, 153, 42, 25, 247,
-			108, 141, 24, 166, 110, 44, 26, 82, 251, 211, 118, 130, 112, 35,
-			1, 9, 231, 7, 153, 173, 189, 49, 157, 117, 149, 55, 118, 182,
-			32, 140, 90, 216, 109, 60, 69, 149, 108, 81, 243, 234, 55, 183,
-			189, 168, 17, 235, 157, 191, 210, 88, 165, 2, 66, 81, 59, 254,
-			140, 214, 71, 40, 106, 199, 159, 209, 218, 31, 69, 237, 248, 51,
-			54, 59, 162, 65, 7, 192, 99, 101, 250, 101, 27, 73, 102, 49,
-			242, 89, 219, 62, 93, 250, 172, 205, 231, 194, 32, 137, 194, 230,
-			238, 211, 199, 237, 200, 107, 183, 69, 36, 73, 137, 196, 203, 146,
-			78, 93, 127, 173, 246, 248, 171, 123, 137, 202, 138, 164, 212, 22,
-			72, 181, 212, 247, 20, 152, 4, 132, 73, 170, 83, 79, 77, 235,
-			157, 2, 40, 220, 219, 2, 16, 196, 104, 135, 78, 111, 117, 100,
-			86, 9, 185, 238, 155, 101, 179, 187, 37, 139, 27, 124, 143, 40,
-			112, 202, 140, 3, 146, 64, 31, 5, 195, 210, 131, 167, 150, 104,
-			106, 214, 60, 162, 15, 135, 104, 215, 197, 59, 205, 183, 234, 204,
-			217, 15, 98, 191, 161, 214, 90, 185, 106, 104, 146, 97, 180, 58,
-			137, 204, 140, 23, 232, 253, 159, 77, 199, 11, 244, 254, 207, 166,
-			227, 101, 225, 136, 176, 59, 53, 232, 0, 56, 125, 138, 254, 156,
-			131, 227, 101, 51, 242, 5, 219, 190, 84, 122, 198, 129, 158, 201,
-			48, 101, 186, 7, 134, 221, 81, 127, 82, 67, 132, 55, 207, 155,
-			205, 204, 57, 2, 143, 69, 219, 195, 159, 85, 160, 140, 188, 97,
-			38, 229, 1, 232, 136, 41, 137, 149, 100, 169, 40, 131, 50, 46,
-			209, 106, 226, 238, 73, 114, 170, 140, 233, 124, 109, 171, 131, 135,
-			217, 160, 79, 233, 243, 120, 208, 56, 130, 48, 57, 181, 55, 217,
-			52, 189, 144, 82, 242, 192, 29, 125, 98, 247, 10, 249, 87, 229,
-			169, 131, 215, 174, 178, 178, 38, 237, 216, 69, 51, 140, 154, 132,
-			93, 236, 247, 237, 248, 46, 203, 118, 244, 121, 241, 157, 186, 83,
-			36, 199, 13, 118, 67, 95, 72, 7, 25, 246, 30, 95, 208, 155,
-			4, 138, 91, 178, 47, 216, 197, 227, 26, 116, 0, 188, 115, 74,
-			131, 5, 0, 167, 47, 106, 48, 15, 224, 133, 251, 232, 52, 114,
-			128, 195, 200, 95, 219, 246, 66, 233, 160, 84, 248, 148, 96, 108,
-			132, 2, 207, 28, 182, 164, 43, 146, 44, 9, 187, 185, 191, 78,
-			27, 225, 184, 0, 154, 70, 192, 110, 238, 175, 237, 226, 140, 6,
-			17, 241, 217, 243, 26, 44, 0, 120, 215, 60, 58, 5, 1, 136,
-			159, 47, 204, 209, 191, 144, 130, 131, 48, 242, 85, 219, 126, 73,
-			233, 63, 218, 234, 224, 218, 156, 244, 101, 56, 241, 252, 115, 179,
-			162, 209, 243, 41, 170, 67, 219, 210, 250, 253, 68, 93, 200, 62,
-			129, 110, 137, 71, 69, 30, 168, 221, 137, 104, 181, 81, 71, 106,
-			121, 114, 135, 161, 86, 6, 15, 15, 161, 110, 172, 61, 56, 243,
-			34, 138, 103, 245, 60, 22, 175, 234, 224, 9, 33, 238, 189, 165,
-			207, 128, 58, 25, 164, 92, 133, 120, 203, 220, 247, 148, 141, 106,
-			132, 70, 87, 174, 210, 148, 193, 188, 140, 255, 176, 82, 229, 36,
-			115, 121, 117, 104, 102, 156, 86, 222, 93, 119, 172, 207, 162, 212,
-			248, 108, 121, 144, 149, 139, 141, 13, 80, 73, 84, 231, 82, 141,
-			19, 235, 110, 130, 150, 162, 238, 44, 153, 49, 36, 146, 208, 6,
-			116, 1, 52, 99, 8, 219, 225, 175, 218, 69, 45, 45, 96, 59,
-			252, 85, 123, 250, 180, 6, 11, 0, 86, 238, 215, 96, 30, 192,
-			123, 95, 76, 175, 194, 8, 146, 62, 150, 251, 186, 109, 255, 55,
-			219, 41, 189, 152, 155, 24, 37, 70, 240, 169, 51, 180, 189, 174,
-			204, 234, 29, 90, 236, 63, 105, 218, 137, 158, 203, 95, 183, 243,
-			99, 116, 30, 248, 69, 122, 46, 255, 163, 77, 134, 203, 23, 12,
-			242, 244, 54, 41, 22, 7, 132, 106, 43, 85, 1, 193, 226, 123,
-			77, 173, 85, 75, 91, 50, 85, 174, 202, 255, 104, 147, 254, 52,
-			193, 134, 132, 193, 33, 122, 69, 213, 99, 49, 242, 79, 54, 97,
-			165, 156, 188, 240, 89, 62, 131, 222, 183, 105, 156, 145, 229, 118,
-			12, 140, 96, 92, 64, 149, 92, 146, 19, 93, 59, 107, 82, 229,
-			227, 252, 79, 182, 114, 88, 164, 202, 199, 249, 159, 236, 226, 168,
-			169, 202, 102, 228, 155, 54, 185, 163, 124, 111, 182, 75, 13, 216,
-			56, 0, 135, 41, 206, 186, 234, 39, 194, 132, 169, 232, 25, 82,
-			141, 25, 26, 253, 77, 91, 185, 113, 82, 229, 179, 252, 77, 123,
-			108, 28, 141, 50, 20, 164, 197, 183, 108, 251, 146, 26, 55, 151,
-			32, 168, 89, 192, 205, 1, 168, 142, 152, 40, 26, 28, 190, 101,
-			79, 156, 208, 160, 3, 224, 164, 150, 37, 110, 1, 64, 35, 75,
-			220, 60, 128, 23, 238, 163, 159, 149, 179, 56, 199, 200, 27, 28,
-			187, 92, 250, 67, 59, 213, 152, 174, 132, 61, 250, 82, 156, 68,
-			24, 155, 226, 59, 209, 151, 22, 55, 120, 40, 99, 154, 84, 118,
-			33, 85, 139, 103, 54, 212, 69, 119, 76, 15, 212, 71, 103, 208,
-			103, 164, 230, 197, 34, 171, 139, 24, 133, 11, 143, 74, 121, 219,
-			75, 182, 42, 220, 223, 48, 209, 60, 170, 178, 104, 198, 215, 35,
-			91, 42, 78, 188, 68, 50, 193, 110, 93, 65, 161, 193, 67, 151,
-			189, 177, 244, 182, 165, 171, 176, 190, 123, 104, 164, 133, 153, 193,
-			57, 130, 68, 54, 160, 11, 160, 89, 239, 115, 22, 128, 202, 206,
-			71, 209, 61, 232, 13, 206, 209, 99, 244, 143, 8, 14, 80, 158,
-			145, 183, 59, 246, 165, 210, 255, 69, 248, 170, 116, 90, 86, 145,
-			79, 245, 82, 28, 119, 91, 90, 252, 64, 174, 226, 77, 47, 216,
-			236, 120, 155, 226, 1, 206, 203, 42, 18, 106, 217, 20, 145, 254,
-			135, 120, 174, 171, 67, 137, 128, 168, 12, 118, 96, 62, 38, 126,
-			29, 84, 117, 190, 114, 125, 142, 199, 59, 113, 130, 54, 139, 53,
-			60, 232, 141, 178, 53, 225, 205, 103, 65, 57, 106, 164, 221, 98,
-			62, 222, 213, 12, 62, 101, 194, 14, 52, 100, 40, 23, 175, 169,
-			118, 108, 241, 116, 149, 242, 43, 189, 157, 218, 22, 202, 124, 130,
-			155, 162, 155, 126, 128, 86, 99, 173, 126, 168, 154, 64, 176, 166,
-			14, 224, 120, 210, 231, 69, 205, 29, 237, 242, 140, 214, 158, 222,
-			104, 76, 116, 143, 202, 36, 53, 182, 165, 7, 128, 116, 161, 200,
-			184, 154, 251, 1, 223, 240, 110, 133, 232, 58, 37, 103, 186, 106,
-			56, 149, 91, 243, 238, 229, 44, 75, 81, 185, 13, 189, 61, 73,
-			35, 177, 17, 70, 162, 66, 149, 72, 50, 126, 108, 33, 71, 175,
-			183, 42, 231, 203, 120, 121, 182, 33, 212, 198, 93, 238, 193, 99,
-			88, 63, 118, 177, 129, 244, 105, 167, 184, 125, 245, 235, 126, 2,
-			155, 8, 60, 181, 23, 45, 233, 126, 208, 73, 23, 149, 60, 65,
-			182, 50, 160, 11, 160, 89, 84, 242, 22, 128, 70, 59, 201, 59,
-			0, 26, 237, 36, 95, 0, 208, 72, 148, 60, 114, 232, 133, 251,
-			148, 228, 42, 48, 242, 14, 199, 214, 43, 78, 129, 32, 168, 235,
-			41, 184, 0, 154, 122, 10, 22, 128, 197, 147, 26, 116, 0, 156,
-			58, 165, 65, 68, 117, 250, 197, 26, 204, 3, 120, 207, 37, 85,
-			79, 63, 35, 207, 56, 70, 66, 246, 19, 4, 117, 61, 253, 46,
-			128, 166, 158, 126, 11, 64, 211, 159, 126, 7, 64, 211, 159, 254,
-			2, 128, 166, 63, 253, 121, 0, 77, 127, 40, 35, 239, 116, 108,
-			221, 8, 74, 16, 212, 245, 80, 23, 64, 83, 15, 108, 71, 223,
-			233, 20, 181, 36, 166, 14, 128, 147, 211, 26, 44, 0, 120, 74,
-			55, 153, 230, 1, 188, 251, 34, 253, 59, 185, 119, 29, 96, 228,
-			167, 28, 251, 124, 233, 115, 22, 95, 140, 187, 2, 133, 104, 70,
-			124, 128, 114, 249, 228, 15, 176, 96, 40, 229, 87, 226, 69, 155,
-			34, 1, 169, 155, 108, 132, 81, 75, 57, 90, 193, 218, 45, 90,
-			126, 2, 249, 211, 11, 19, 198, 102, 75, 165, 178, 127, 75, 68,
-			59, 168, 47, 102, 21, 88, 52, 104, 249, 137, 17, 210, 122, 201,
-			110, 238, 112, 127, 51, 8, 35, 209, 184, 164, 179, 67, 121, 202,
-			155, 194, 139, 147, 172, 179, 25, 94, 151, 208, 43, 57, 214, 164,
-			187, 32, 213, 176, 102, 102, 235, 58, 64, 176, 215, 6, 116, 1,
-			52, 244, 28, 176, 0, 44, 150, 52, 232, 0, 120, 248, 168, 6,
-			11, 0, 242, 115, 26, 204, 3, 120, 250, 44, 253, 17, 36, 231,
-			32, 35, 239, 113, 236, 123, 75, 175, 226, 50, 90, 115, 172, 157,
-			135, 240, 240, 9, 67, 55, 155, 173, 171, 10, 18, 178, 87, 156,
-			22, 101, 85, 69, 35, 160, 64, 151, 50, 25, 91, 101, 115, 151,
-			190, 4, 200, 230, 78, 159, 54, 93, 27, 36, 216, 2, 3, 186,
-			0, 154, 174, 13, 90, 0, 170, 83, 2, 106, 15, 58, 0, 30,
-			215, 51, 97, 176, 0, 224, 157, 247, 104, 48, 15, 224, 185, 187,
-			233, 219, 36, 171, 12, 49, 242, 243, 142, 61, 93, 122, 93, 198,
-			204, 17, 106, 75, 24, 175, 171, 141, 144, 140, 8, 173, 4, 135,
-			143, 48, 26, 240, 112, 39, 4, 155, 221, 76, 17, 186, 87, 135,
-			122, 214, 248, 170, 148, 88, 210, 183, 73, 203, 42, 211, 221, 33,
-			130, 141, 50, 160, 11, 160, 89, 228, 134, 44, 0, 153, 158, 129,
-			67, 14, 128, 119, 78, 209, 69, 236, 207, 48, 35, 31, 116, 236,
-			169, 210, 37, 110, 226, 74, 35, 57, 119, 181, 233, 146, 174, 54,
-			214, 254, 217, 106, 97, 55, 205, 24, 38, 136, 203, 128, 46, 128,
-			166, 25, 195, 22, 128, 172, 172, 65, 7, 192, 147, 147, 210, 181,
-			153, 218, 35, 140, 252, 146, 35, 93, 155, 83, 107, 15, 95, 221,
-			246, 55, 146, 236, 218, 134, 83, 3, 111, 124, 237, 182, 3, 129,
-			222, 55, 167, 157, 68, 209, 233, 35, 18, 160, 18, 1, 235, 79,
-			86, 39, 165, 174, 223, 9, 26, 34, 138, 235, 97, 36, 76, 0,
-			57, 233, 95, 18, 234, 65, 211, 62, 223, 241, 153, 120, 167, 85,
-			11, 155, 49, 213, 155, 77, 229, 185, 153, 164, 91, 139, 88, 14,
-			174, 84, 127, 42, 210, 37, 11, 155, 104, 172, 248, 202, 239, 86,
-			30, 59, 208, 231, 170, 198, 212, 162, 9, 56, 66, 144, 38, 6,
-			116, 1, 52, 244, 28, 177, 0, 52, 182, 165, 17, 7, 192, 99,
-			199, 209, 205, 157, 218, 69, 70, 62, 2, 195, 218, 78, 185, 180,
-			189, 213, 126, 190, 220, 9, 89, 119, 49, 0, 221, 131, 43, 231,
-			213, 56, 249, 49, 135, 29, 226, 142, 105, 123, 145, 96, 3, 12,
-			232, 2, 104, 218, 94, 180, 0, 52, 188, 80, 116, 0, 60, 57,
-			73, 127, 67, 206, 177, 81, 70, 62, 230, 216, 39, 75, 31, 178,
-			112, 59, 145, 161, 55, 26, 18, 100, 228, 65, 19, 246, 13, 89,
-			54, 220, 216, 187, 209, 166, 137, 52, 109, 227, 174, 81, 52, 159,
-			186, 21, 86, 84, 55, 179, 110, 204, 48, 49, 128, 25, 18, 17,
-			181, 210, 88, 65, 166, 17, 166, 243, 163, 4, 59, 96, 64, 23,
-			64, 211, 249, 81, 11, 64, 166, 69, 233, 168, 3, 96, 249, 4,
-			253, 119, 178, 243, 140, 145, 223, 112, 236, 106, 233, 215, 190, 135,
-			206, 235, 56, 242, 134, 10, 116, 247, 72, 125, 91, 42, 164, 22,
-			183, 44, 33, 168, 161, 196, 243, 32, 4, 35, 216, 25, 3, 186,
-			0, 26, 66, 48, 11, 64, 166, 117, 1, 230, 0, 120, 122, 134,
-			254, 182, 36, 196, 24, 35, 191, 3, 18, 225, 195, 223, 142, 16,
-			122, 188, 194, 13, 30, 117, 106, 59, 223, 3, 19, 40, 111, 203,
-			239, 138, 13, 176, 234, 94, 145, 56, 70, 176, 19, 6, 116, 1,
-			52, 4, 24, 179, 0, 52, 83, 120, 204, 1, 240, 216, 113, 250,
-			106, 236, 255, 56, 35, 255, 183, 99, 95, 44, 5, 223, 213, 173,
-			111, 106, 142, 36, 186, 3, 50, 234, 117, 183, 188, 172, 207, 154,
-			245, 25, 69, 246, 138, 56, 181, 199, 9, 86, 111, 192, 28, 128,
-			3, 186, 169, 227, 22, 128, 234, 138, 56, 181, 199, 29, 0, 239,
-			185, 143, 254, 152, 69, 29, 2, 154, 217, 39, 28, 251, 64, 233,
-			201, 239, 249, 142, 248, 119, 223, 11, 212, 32, 221, 62, 104, 137,
-			186, 80, 78, 241, 66, 249, 39, 28, 117, 161, 156, 226, 133, 242,
-			79, 56, 119, 76, 200, 227, 140, 126, 70, 126, 207, 177, 135, 101,
-			193, 254, 62, 128, 6, 134, 100, 206, 126, 40, 152, 5, 109, 5,
-			14, 82, 155, 12, 176, 220, 167, 156, 190, 31, 39, 22, 162, 1,
-			181, 233, 83, 78, 97, 31, 253, 19, 151, 18, 50, 96, 247, 49,
-			242, 57, 199, 126, 73, 233, 119, 93, 16, 196, 184, 17, 200, 28,
-			102, 165, 126, 246, 231, 180, 5, 3, 114, 101, 111, 190, 110, 116,
-			221, 82, 210, 134, 173, 204, 170, 131, 57, 204, 49, 197, 12, 232,
-			140, 94, 226, 215, 124, 140, 182, 99, 204, 91, 61, 216, 169, 66,
-			95, 229, 120, 60, 166, 174, 228, 166, 7, 156, 190, 212, 169, 210,
-			48, 117, 242, 30, 251, 69, 206, 23, 147, 201, 152, 55, 69, 28,
-			83, 46, 54, 54, 252, 186, 143, 247, 182, 182, 64, 163, 19, 219,
-			34, 226, 27, 194, 75, 58, 145, 136, 165, 201, 26, 134, 18, 150,
-			90, 212, 100, 209, 65, 185, 209, 19, 154, 207, 248, 207, 107, 131,
-			174, 120, 194, 195, 32, 122, 93, 199, 199, 220, 100, 127, 48, 12,
-			249, 191, 144, 225, 65, 213, 188, 189, 205, 43, 17, 252, 126, 164,
-			246, 37, 153, 55, 195, 99, 23, 96, 0, 90, 222, 19, 248, 229,
-			53, 221, 62, 166, 34, 115, 218, 15, 58, 187, 60, 44, 7, 50,
-			232, 230, 73, 215, 236, 75, 25, 130, 198, 202, 243, 15, 179, 102,
-			135, 138, 226, 13, 166, 110, 238, 198, 93, 41, 122, 62, 233, 126,
-			195, 166, 22, 55, 168, 90, 7, 190, 36, 99, 170, 161, 46, 161,
-			61, 26, 106, 202, 255, 52, 150, 231, 1, 49, 238, 20, 122, 125,
-			255, 177, 202, 203, 198, 59, 92, 237, 9, 244, 241, 157, 20, 106,
-			210, 227, 36, 217, 198, 51, 224, 36, 242, 235, 38, 166, 45, 142,
-			190, 8, 54, 194, 168, 174, 246, 243, 201, 94, 129, 244, 148, 128,
-			24, 192, 147, 175, 207, 105, 1, 49, 128, 39, 95, 159, 211, 58,
-			246, 0, 154, 14, 63, 231, 20, 39, 53, 232, 0, 120, 234, 180,
-			6, 11, 0, 42, 219, 232, 128, 221, 151, 7, 240, 222, 23, 211,
-			191, 178, 112, 210, 88, 140, 252, 165, 99, 63, 88, 250, 79, 22,
-			159, 151, 231, 68, 82, 131, 201, 88, 30, 148, 21, 72, 63, 240,
-			193, 203, 141, 204, 65, 66, 153, 235, 71, 62, 180, 255, 102, 221,
-			11, 48, 44, 236, 70, 211, 175, 39, 250, 122, 164, 180, 40, 107,
-			76, 218, 79, 67, 223, 222, 66, 41, 228, 97, 176, 41, 222, 2,
-			133, 211, 4, 79, 83, 138, 144, 154, 183, 194, 139, 125, 17, 93,
-			226, 129, 216, 86, 70, 8, 57, 153, 188, 91, 161, 175, 217, 69,
-			157, 169, 100, 26, 89, 54, 116, 180, 8, 246, 214, 128, 46, 128,
-			134, 142, 22, 210, 162, 120, 70, 131, 14, 128, 231, 239, 210, 96,
-			1, 192, 11, 11, 26, 204, 3, 248, 192, 60, 253, 27, 73, 71,
-			155, 145, 191, 117, 236, 115, 165, 255, 156, 110, 107, 53, 123, 191,
-			96, 59, 219, 204, 28, 250, 14, 183, 179, 106, 55, 75, 159, 247,
-			118, 54, 195, 246, 178, 255, 54, 193, 14, 27, 208, 5, 208, 144,
-			18, 216, 234, 111, 245, 142, 118, 0, 207, 125, 254, 214, 57, 124,
-			68, 131, 5, 0, 143, 158, 213, 96, 30, 192, 83, 103, 164, 80,
-			239, 103, 228, 203, 142, 61, 134, 107, 195, 0, 172, 13, 95, 118,
-			6, 6, 101, 78, 92, 27, 178, 160, 173, 64, 153, 23, 63, 14,
-			171, 38, 244, 91, 61, 160, 173, 64, 153, 23, 161, 81, 166, 62,
-			218, 86, 55, 168, 191, 254, 116, 14, 199, 214, 97, 228, 95, 19,
-			251, 72, 233, 169, 28, 104, 50, 230, 134, 142, 30, 95, 57, 243,
-			187, 79, 243, 51, 250, 161, 215, 70, 191, 252, 29, 41, 138, 212,
-			184, 81, 72, 215, 215, 184, 244, 117, 37, 147, 34, 197, 48, 135,
-			148, 23, 191, 84, 236, 172, 237, 180, 69, 133, 99, 88, 124, 248,
-			249, 18, 72, 95, 151, 51, 234, 126, 126, 238, 18, 77, 149, 150,
-			70, 246, 138, 85, 51, 12, 111, 198, 24, 122, 67, 163, 83, 13,
-			190, 230, 181, 209, 185, 19, 31, 203, 209, 18, 62, 43, 229, 245,
-			195, 58, 221, 114, 61, 205, 225, 53, 185, 106, 22, 191, 41, 118,
-			84, 35, 118, 101, 49, 13, 86, 27, 179, 251, 249, 121, 149, 237,
-			53, 242, 143, 17, 170, 221, 13, 234, 233, 29, 229, 139, 61, 81,
-			45, 208, 153, 110, 43, 12, 99, 41, 72, 51, 102, 10, 57, 46,
-			186, 249, 247, 163, 10, 96, 102, 72, 173, 147, 160, 70, 205, 61,
-			30, 200, 8, 37, 48, 54, 126, 215, 52, 52, 118, 220, 36, 228,
-			91, 160, 49, 192, 183, 155, 98, 71, 94, 108, 87, 142, 248, 146,
-			224, 153, 131, 181, 217, 235, 139, 168, 93, 225, 93, 135, 93, 49,
-			56, 240, 24, 77, 59, 221, 96, 108, 85, 47, 166, 220, 223, 72,
-			239, 98, 202, 25, 184, 247, 253, 50, 188, 154, 177, 188, 182, 112,
-			81, 71, 144, 84, 198, 78, 163, 74, 247, 68, 208, 229, 179, 210,
-			5, 65, 171, 61, 200, 85, 50, 226, 30, 213, 123, 99, 121, 77,
-			85, 33, 80, 50, 84, 59, 135, 249, 173, 46, 179, 170, 180, 126,
-			171, 29, 138, 94, 152, 148, 55, 91, 186, 64, 57, 4, 167, 136,
-			1, 93, 0, 141, 52, 112, 44, 0, 139, 7, 52, 136, 243, 233,
-			208, 97, 122, 66, 205, 247, 55, 17, 123, 168, 188, 31, 207, 192,
-			155, 126, 2, 218, 133, 58, 35, 171, 53, 5, 85, 19, 214, 129,
-			108, 102, 230, 3, 198, 44, 104, 43, 240, 168, 194, 248, 102, 192,
-			200, 16, 99, 224, 5, 225, 186, 23, 175, 3, 102, 141, 140, 64,
-			14, 83, 154, 88, 221, 160, 173, 192, 101, 156, 252, 132, 145, 183,
-			146, 239, 95, 64, 168, 1, 121, 57, 33, 165, 21, 201, 1, 56,
-			160, 101, 35, 94, 78, 32, 71, 245, 170, 131, 151, 19, 136, 10,
-			8, 53, 224, 50, 242, 54, 242, 194, 4, 132, 26, 0, 253, 253,
-			109, 68, 233, 239, 3, 168, 191, 191, 141, 40, 253, 125, 0, 245,
-			247, 183, 145, 59, 38, 80, 13, 31, 100, 185, 183, 147, 190, 111,
-			40, 53, 124, 208, 98, 228, 237, 164, 48, 78, 127, 214, 166, 132,
-			12, 130, 26, 254, 46, 98, 87, 75, 79, 219, 72, 49, 124, 186,
-			42, 101, 86, 125, 198, 134, 14, 92, 167, 79, 247, 158, 155, 43,
-			125, 221, 75, 29, 104, 233, 109, 46, 212, 43, 151, 205, 45, 47,
-			0, 242, 27, 127, 162, 109, 96, 230, 42, 55, 123, 25, 125, 76,
-			65, 13, 97, 106, 162, 25, 110, 107, 205, 163, 123, 63, 186, 35,
-			146, 116, 250, 166, 14, 15, 97, 91, 232, 119, 15, 160, 53, 176,
-			118, 10, 62, 51, 195, 227, 48, 138, 118, 42, 124, 91, 76, 54,
-			155, 28, 37, 124, 40, 47, 62, 53, 4, 94, 142, 68, 71, 215,
-			14, 168, 232, 250, 164, 230, 152, 28, 245, 65, 212, 232, 222, 165,
-			153, 96, 16, 163, 130, 189, 139, 168, 219, 28, 131, 168, 209, 189,
-			139, 168, 56, 29, 131, 168, 209, 189, 139, 28, 40, 105, 176, 0,
-			224, 193, 25, 13, 230, 1, 188, 179, 130, 65, 87, 6, 73, 31,
-			203, 253, 4, 177, 223, 67, 100, 208, 149, 65, 60, 175, 254, 9,
-			146, 135, 185, 145, 3, 16, 198, 231, 39, 9, 25, 41, 141, 24,
-			59, 69, 11, 163, 143, 226, 25, 237, 160, 58, 138, 254, 73, 66,
-			50, 9, 54, 36, 12, 13, 163, 139, 196, 160, 60, 138, 126, 150,
-			168, 3, 228, 65, 117, 162, 252, 44, 33, 133, 52, 193, 134, 132,
-			129, 65, 83, 194, 102, 228, 103, 136, 10, 179, 51, 168, 14, 134,
-			127, 134, 168, 131, 225, 65, 117, 48, 252, 51, 100, 108, 156, 126,
-			70, 114, 145, 197, 200, 251, 137, 125, 176, 244, 239, 109, 53, 239,
-			240, 254, 179, 26, 46, 117, 102, 175, 188, 130, 228, 197, 87, 45,
-			60, 219, 145, 223, 194, 103, 110, 180, 62, 136, 14, 166, 40, 74,
-			184, 39, 119, 72, 102, 51, 181, 139, 181, 228, 120, 195, 206, 166,
-			202, 87, 60, 181, 210, 123, 129, 193, 14, 123, 143, 237, 200, 215,
-			222, 137, 24, 137, 80, 71, 60, 73, 61, 139, 132, 100, 160, 74,
-			246, 74, 155, 23, 69, 222, 14, 154, 68, 100, 116, 45, 92, 3,
-			140, 243, 109, 179, 55, 38, 82, 173, 25, 214, 170, 124, 81, 223,
-			52, 175, 72, 241, 172, 143, 188, 64, 50, 39, 50, 94, 57, 94,
-			38, 199, 83, 52, 229, 128, 134, 170, 176, 58, 190, 147, 68, 203,
-			68, 238, 145, 28, 131, 23, 50, 82, 230, 195, 11, 25, 90, 90,
-			15, 202, 11, 25, 164, 168, 153, 15, 47, 100, 0, 243, 189, 203,
-			197, 129, 177, 25, 249, 21, 98, 223, 85, 122, 179, 139, 3, 35,
-			95, 142, 51, 222, 57, 202, 80, 35, 82, 159, 196, 85, 84, 71,
-			36, 133, 140, 57, 75, 249, 138, 135, 42, 10, 130, 186, 205, 158,
-			93, 149, 96, 215, 109, 94, 11, 193, 206, 67, 185, 123, 46, 240,
-			26, 206, 172, 68, 108, 70, 94, 19, 105, 191, 225, 63, 161, 195,
-			161, 80, 62, 229, 7, 201, 61, 23, 42, 188, 163, 254, 198, 234,
-			47, 102, 194, 4, 245, 107, 186, 202, 249, 108, 38, 132, 157, 238,
-			136, 121, 206, 141, 202, 136, 68, 138, 63, 112, 192, 178, 253, 145,
-			222, 54, 122, 207, 131, 84, 143, 121, 51, 148, 17, 3, 64, 111,
-			246, 209, 147, 70, 58, 253, 0, 191, 110, 121, 109, 16, 35, 219,
-			50, 30, 65, 19, 212, 141, 52, 80, 132, 138, 192, 160, 172, 194,
-			124, 163, 25, 74, 181, 91, 58, 148, 167, 213, 86, 41, 95, 69,
-			129, 182, 3, 95, 205, 91, 115, 102, 55, 160, 58, 129, 219, 210,
-			174, 125, 156, 104, 100, 27, 175, 238, 46, 80, 163, 36, 100, 190,
-			149, 165, 235, 105, 89, 199, 188, 65, 231, 4, 177, 229, 221, 242,
-			195, 40, 115, 175, 2, 5, 135, 28, 43, 202, 205, 147, 120, 120,
-			245, 179, 75, 255, 49, 209, 178, 19, 105, 140, 232, 146, 185, 230,
-			14, 112, 168, 6, 59, 123, 106, 46, 29, 234, 164, 91, 173, 215,
-			64, 155, 191, 116, 135, 222, 12, 195, 205, 106, 203, 75, 182, 170,
-			139, 192, 7, 70, 13, 25, 196, 77, 201, 175, 164, 140, 109, 231,
-			0, 84, 38, 192, 65, 148, 57, 191, 66, 216, 132, 6, 29, 0,
-			15, 30, 210, 96, 1, 192, 195, 231, 53, 152, 7, 112, 234, 156,
-			146, 170, 22, 203, 125, 148, 216, 191, 105, 164, 42, 76, 146, 143,
-			146, 252, 16, 6, 205, 26, 148, 161, 172, 126, 149, 16, 86, 218,
-			175, 12, 168, 153, 83, 110, 121, 23, 74, 10, 58, 25, 199, 234,
-			87, 83, 225, 41, 227, 88, 253, 42, 41, 142, 210, 105, 133, 202,
-			98, 228, 215, 0, 213, 1, 68, 181, 139, 231, 226, 12, 50, 75,
-			230, 77, 145, 129, 224, 253, 181, 44, 50, 155, 145, 223, 216, 19,
-			89, 234, 97, 172, 203, 66, 197, 191, 145, 69, 38, 11, 23, 71,
-			233, 31, 35, 17, 128, 96, 127, 78, 236, 211, 165, 143, 15, 107,
-			31, 141, 204, 229, 137, 154, 217, 130, 52, 189, 39, 253, 230, 206,
-			3, 156, 95, 245, 158, 220, 209, 71, 138, 230, 68, 81, 105, 32,
-			51, 64, 22, 29, 170, 85, 94, 11, 104, 9, 47, 80, 177, 47,
-			182, 181, 115, 157, 244, 43, 205, 236, 180, 240, 62, 15, 174, 244,
-			178, 182, 138, 148, 32, 62, 70, 1, 82, 249, 38, 227, 52, 58,
-			14, 10, 69, 117, 157, 84, 181, 175, 214, 73, 180, 34, 44, 21,
-			57, 233, 77, 34, 229, 178, 180, 214, 41, 190, 239, 194, 170, 228,
-			107, 61, 145, 30, 241, 41, 62, 108, 172, 10, 253, 132, 81, 154,
-			164, 69, 68, 155, 223, 148, 143, 52, 116, 223, 195, 187, 63, 208,
-			219, 158, 61, 130, 23, 9, 190, 17, 9, 33, 173, 237, 184, 179,
-			49, 177, 32, 80, 35, 162, 92, 120, 155, 34, 130, 93, 126, 19,
-			168, 170, 175, 230, 116, 71, 42, 49, 215, 112, 140, 190, 167, 239,
-			204, 24, 71, 63, 106, 214, 142, 46, 35, 63, 108, 148, 226, 206,
-			230, 166, 136, 117, 248, 145, 46, 139, 148, 135, 111, 140, 128, 234,
-			228, 11, 25, 180, 199, 195, 189, 20, 224, 233, 106, 79, 87, 180,
-			26, 140, 212, 25, 70, 202, 44, 154, 153, 218, 181, 48, 188, 121,
-			83, 8, 25, 255, 42, 188, 37, 162, 45, 24, 139, 100, 167, 173,
-			118, 207, 42, 30, 121, 151, 55, 155, 191, 75, 128, 104, 87, 80,
-			238, 73, 103, 195, 36, 243, 130, 64, 144, 136, 104, 67, 157, 215,
-			120, 65, 215, 57, 69, 216, 128, 13, 173, 215, 108, 106, 31, 88,
-			12, 197, 130, 134, 84, 30, 137, 150, 151, 185, 11, 89, 229, 252,
-			193, 78, 4, 195, 0, 122, 3, 176, 90, 36, 188, 198, 76, 236,
-			109, 8, 19, 94, 157, 102, 42, 243, 179, 237, 201, 60, 154, 32,
-			27, 124, 9, 253, 110, 18, 237, 145, 167, 43, 3, 108, 40, 140,
-			161, 239, 210, 220, 101, 174, 77, 200, 10, 145, 157, 235, 157, 72,
-			222, 39, 195, 53, 167, 41, 67, 146, 116, 35, 4, 166, 247, 131,
-			142, 160, 242, 222, 9, 198, 228, 224, 66, 71, 15, 86, 108, 89,
-			165, 93, 119, 253, 123, 119, 171, 187, 247, 214, 248, 164, 183, 9,
-			241, 161, 181, 42, 121, 125, 136, 226, 82, 246, 228, 78, 247, 5,
-			13, 233, 21, 239, 199, 21, 236, 18, 176, 197, 98, 188, 40, 231,
-			173, 255, 164, 104, 76, 77, 107, 69, 171, 107, 118, 83, 172, 59,
-			18, 73, 39, 82, 12, 137, 193, 70, 212, 62, 185, 123, 42, 110,
-			121, 49, 199, 55, 160, 112, 10, 116, 181, 44, 99, 182, 15, 4,
-			116, 216, 139, 118, 204, 101, 132, 80, 59, 186, 237, 129, 19, 119,
-			14, 234, 50, 89, 40, 125, 252, 228, 252, 246, 3, 25, 112, 70,
-			45, 83, 248, 196, 23, 70, 167, 2, 194, 84, 64, 206, 11, 207,
-			104, 132, 237, 78, 212, 14, 165, 247, 5, 16, 134, 234, 153, 1,
-			234, 70, 208, 187, 54, 42, 131, 41, 146, 59, 126, 78, 122, 83,
-			99, 195, 54, 209, 91, 18, 245, 158, 128, 159, 100, 41, 174, 143,
-			18, 50, 222, 88, 153, 177, 209, 210, 178, 187, 25, 136, 90, 189,
-			254, 117, 74, 222, 96, 56, 165, 154, 225, 227, 67, 114, 93, 77,
-			65, 83, 227, 41, 116, 88, 62, 69, 159, 43, 91, 183, 108, 210,
-			242, 76, 70, 176, 233, 50, 160, 109, 129, 46, 92, 19, 34, 80,
-			20, 71, 115, 62, 22, 58, 127, 246, 252, 185, 138, 228, 48, 53,
-			219, 121, 61, 140, 34, 81, 79, 2, 64, 139, 149, 199, 154, 155,
-			164, 95, 50, 94, 110, 85, 175, 215, 100, 169, 143, 76, 1, 28,
-			214, 68, 89, 19, 242, 122, 228, 197, 91, 242, 98, 44, 168, 145,
-			82, 49, 131, 45, 170, 124, 127, 40, 139, 205, 143, 97, 165, 10,
-			50, 203, 14, 239, 180, 195, 32, 157, 81, 124, 109, 121, 126, 121,
-			170, 118, 230, 252, 185, 115, 247, 157, 189, 231, 220, 185, 187, 166,
-			47, 114, 229, 144, 35, 159, 51, 50, 251, 11, 236, 139, 137, 159,
-			42, 117, 13, 135, 224, 194, 106, 64, 23, 64, 163, 143, 59, 22,
-			128, 42, 178, 225, 32, 90, 79, 254, 156, 236, 215, 90, 140, 83,
-			0, 240, 192, 41, 13, 230, 1, 60, 49, 77, 63, 100, 225, 146,
-			77, 24, 249, 60, 177, 239, 45, 253, 180, 197, 123, 158, 111, 254,
-			206, 8, 170, 252, 131, 180, 239, 93, 247, 77, 66, 116, 151, 65,
-			156, 168, 73, 203, 106, 234, 230, 117, 137, 118, 20, 110, 249, 53,
-			181, 39, 11, 35, 61, 135, 188, 64, 110, 167, 117, 208, 102, 217,
-			1, 34, 91, 108, 64, 23, 64, 67, 11, 98, 1, 168, 98, 229,
-			12, 162, 117, 228, 243, 164, 124, 66, 131, 5, 0, 213, 165, 227,
-			65, 116, 3, 255, 60, 57, 123, 55, 253, 188, 164, 133, 203, 200,
-			223, 16, 251, 92, 233, 79, 179, 158, 103, 176, 76, 189, 96, 6,
-			122, 125, 52, 18, 127, 119, 230, 121, 21, 214, 241, 121, 121, 155,
-			117, 49, 148, 75, 176, 171, 6, 196, 158, 27, 34, 186, 22, 128,
-			69, 109, 78, 112, 29, 0, 149, 113, 126, 16, 29, 169, 255, 134,
-			40, 227, 252, 32, 58, 82, 255, 13, 57, 117, 134, 62, 128, 52,
-			204, 49, 242, 101, 98, 87, 74, 231, 190, 243, 55, 111, 36, 190,
-			28, 65, 12, 6, 116, 1, 52, 77, 203, 89, 0, 26, 94, 207,
-			57, 0, 238, 63, 160, 193, 2, 128, 165, 211, 26, 204, 3, 120,
-			242, 20, 26, 234, 6, 65, 97, 255, 234, 247, 209, 80, 55, 136,
-			206, 163, 95, 77, 155, 154, 207, 1, 56, 160, 233, 148, 183, 0,
-			84, 134, 186, 65, 116, 30, 253, 170, 54, 212, 13, 186, 140, 252,
-			195, 11, 100, 168, 27, 116, 251, 0, 185, 50, 212, 13, 162, 161,
-			238, 31, 180, 161, 110, 16, 13, 117, 255, 64, 238, 152, 160, 135,
-			160, 29, 253, 140, 252, 35, 177, 135, 202, 35, 234, 45, 153, 6,
-			127, 28, 245, 110, 137, 169, 191, 15, 62, 43, 195, 231, 32, 158,
-			174, 100, 65, 91, 129, 131, 212, 38, 67, 44, 247, 77, 210, 247,
-			58, 87, 154, 252, 134, 44, 70, 190, 73, 10, 227, 72, 249, 33,
-			216, 251, 124, 235, 251, 72, 249, 33, 180, 142, 125, 75, 83, 126,
-			8, 173, 99, 223, 210, 148, 31, 194, 29, 212, 183, 52, 229, 135,
-			208, 58, 246, 45, 77, 249, 33, 151, 145, 215, 186, 47, 12, 229,
-			135, 128, 242, 175, 117, 21, 229, 135, 144, 242, 175, 117, 21, 229,
-			135, 144, 242, 175, 117, 149, 137, 116, 152, 229, 94, 239, 246, 189,
-			83, 209, 107, 216, 98, 228, 245, 110, 97, 140, 190, 146, 18, 50,
-			12, 244, 122, 163, 107, 243, 210, 138, 244, 83, 232, 118, 182, 209,
-			110, 11, 248, 32, 59, 111, 201, 135, 215, 82, 115, 168, 124, 252,
-			2, 31, 233, 74, 210, 155, 60, 84, 199, 23, 197, 182, 12, 35,
-			1, 223, 232, 218, 6, 116, 1, 84, 179, 108, 24, 9, 248, 70,
-			183, 120, 80, 131, 14, 128, 71, 142, 210, 255, 98, 97, 243, 44,
-			70, 222, 234, 218, 231, 74, 127, 156, 202, 73, 21, 53, 232, 5,
-			60, 199, 148, 87, 239, 94, 88, 41, 137, 199, 249, 134, 70, 22,
-			193, 126, 26, 208, 5, 208, 208, 200, 66, 42, 40, 33, 57, 140,
-			86, 176, 183, 186, 74, 72, 14, 227, 97, 240, 91, 93, 37, 36,
-			135, 241, 48, 248, 173, 238, 169, 51, 120, 254, 48, 220, 207, 200,
-			83, 238, 115, 156, 63, 12, 195, 188, 123, 202, 85, 19, 109, 24,
-			231, 93, 22, 180, 21, 184, 140, 163, 97, 51, 242, 180, 251, 253,
-			155, 92, 195, 104, 36, 121, 58, 237, 55, 136, 244, 167, 221, 1,
-			221, 51, 24, 253, 167, 93, 53, 185, 134, 209, 72, 242, 180, 171,
-			38, 215, 176, 203, 200, 51, 47, 208, 228, 26, 134, 201, 245, 140,
-			158, 92, 195, 56, 185, 158, 209, 147, 107, 24, 39, 215, 51, 122,
-			114, 141, 176, 220, 187, 220, 190, 159, 83, 147, 107, 196, 98, 228,
-			93, 110, 97, 130, 126, 13, 216, 119, 4, 102, 215, 179, 192, 190,
-			159, 239, 97, 95, 185, 49, 126, 193, 153, 88, 214, 243, 66, 159,
-			199, 103, 194, 123, 169, 129, 29, 193, 73, 255, 172, 30, 216, 17,
-			156, 244, 207, 106, 134, 30, 193, 73, 255, 172, 102, 232, 17, 156,
-			244, 207, 106, 134, 30, 193, 51, 133, 103, 53, 67, 143, 224, 153,
-			194, 179, 192, 208, 203, 72, 82, 139, 145, 247, 124, 31, 121, 112,
-			4, 231, 222, 123, 210, 166, 90, 57, 0, 7, 116, 99, 44, 172,
-			79, 241, 224, 8, 206, 189, 247, 104, 30, 28, 113, 25, 121, 223,
-			11, 196, 131, 35, 192, 131, 239, 211, 60, 56, 130, 60, 248, 62,
-			205, 131, 35, 200, 131, 239, 211, 60, 88, 100, 185, 247, 187, 125,
-			255, 86, 241, 96, 209, 98, 228, 253, 110, 97, 31, 253, 83, 135,
-			18, 82, 4, 30, 252, 176, 107, 159, 207, 122, 131, 164, 55, 138,
-			95, 64, 6, 84, 149, 188, 208, 220, 167, 239, 226, 84, 233, 249,
-			207, 91, 104, 76, 184, 200, 101, 188, 40, 19, 255, 227, 156, 9,
-			252, 113, 215, 121, 29, 107, 42, 13, 238, 111, 30, 57, 55, 170,
-			227, 202, 245, 57, 202, 57, 223, 136, 188, 150, 216, 14, 163, 155,
-			85, 206, 31, 17, 220, 107, 135, 205, 112, 19, 56, 9, 223, 122,
-			9, 189, 168, 161, 54, 218, 113, 230, 81, 146, 144, 135, 157, 40,
-			22, 205, 91, 34, 86, 167, 252, 156, 111, 11, 121, 181, 74, 7,
-			237, 148, 182, 42, 188, 36, 132, 225, 120, 107, 120, 37, 9, 178,
-			53, 68, 221, 87, 214, 38, 125, 182, 167, 95, 197, 5, 68, 234,
-			97, 92, 197, 186, 69, 156, 101, 31, 214, 172, 91, 196, 89, 246,
-			97, 61, 203, 138, 56, 203, 62, 172, 103, 89, 17, 103, 217, 135,
-			93, 117, 149, 163, 136, 179, 236, 195, 174, 186, 202, 81, 196, 89,
-			246, 97, 247, 244, 89, 156, 101, 69, 152, 101, 31, 253, 62, 206,
-			178, 34, 206, 178, 143, 166, 77, 133, 89, 246, 81, 61, 203, 138,
-			56, 203, 62, 170, 103, 89, 17, 103, 217, 71, 245, 44, 43, 186,
-			140, 124, 236, 5, 154, 101, 69, 152, 101, 31, 211, 179, 172, 136,
-			179, 236, 99, 122, 150, 21, 113, 150, 125, 76, 207, 178, 81, 150,
-			251, 117, 183, 239, 79, 213, 44, 27, 181, 24, 249, 117, 183, 112,
-			7, 253, 99, 152, 101, 163, 48, 203, 62, 14, 179, 236, 63, 101,
-			125, 174, 208, 164, 247, 2, 187, 92, 65, 29, 47, 188, 199, 149,
-			186, 183, 251, 255, 183, 41, 54, 138, 83, 236, 227, 154, 111, 71,
-			113, 138, 125, 92, 79, 177, 81, 156, 98, 31, 215, 83, 108, 20,
-			167, 216, 199, 245, 20, 27, 197, 41, 246, 113, 61, 197, 70, 113,
-			138, 125, 28, 166, 216, 151, 64, 57, 24, 37, 125, 44, 247, 9,
-			215, 254, 15, 174, 211, 237, 168, 167, 236, 192, 13, 49, 35, 47,
-			174, 207, 160, 49, 126, 42, 140, 164, 117, 214, 15, 248, 67, 107,
-			107, 215, 97, 78, 54, 189, 160, 46, 166, 229, 224, 55, 68, 171,
-			29, 38, 34, 128, 97, 13, 35, 30, 72, 19, 218, 3, 50, 47,
-			62, 174, 140, 55, 33, 123, 173, 109, 169, 133, 245, 202, 194, 26,
-			48, 71, 77, 222, 57, 247, 54, 48, 6, 55, 14, 187, 116, 233,
-			189, 126, 35, 243, 61, 173, 206, 24, 124, 245, 41, 70, 207, 177,
-			220, 245, 229, 213, 53, 77, 76, 60, 255, 255, 132, 155, 223, 143,
-			71, 241, 163, 242, 252, 255, 147, 46, 57, 132, 167, 60, 163, 234,
-			184, 255, 147, 174, 122, 5, 101, 84, 29, 247, 127, 210, 45, 29,
-			164, 39, 84, 9, 139, 145, 223, 115, 201, 68, 121, 92, 58, 7,
-			137, 56, 211, 22, 106, 138, 89, 50, 219, 88, 154, 96, 67, 194,
-			190, 253, 244, 94, 133, 199, 102, 228, 247, 93, 50, 86, 158, 204,
-			146, 78, 198, 17, 212, 97, 217, 48, 80, 134, 28, 131, 56, 69,
-			13, 45, 248, 125, 87, 61, 227, 51, 170, 188, 5, 126, 223, 197,
-			215, 162, 64, 16, 88, 44, 247, 41, 215, 254, 3, 247, 78, 53,
-			234, 32, 248, 62, 149, 50, 16, 8, 190, 79, 185, 42, 194, 229,
-			40, 54, 244, 83, 238, 161, 25, 13, 58, 0, 170, 120, 16, 163,
-			168, 218, 255, 129, 155, 59, 169, 193, 60, 128, 163, 39, 80, 70,
-			143, 66, 189, 159, 254, 62, 202, 232, 81, 212, 198, 63, 157, 54,
-			21, 180, 241, 79, 107, 25, 61, 138, 29, 255, 180, 150, 209, 163,
-			168, 141, 127, 90, 203, 232, 81, 151, 145, 63, 121, 129, 100, 244,
-			40, 200, 232, 63, 209, 50, 122, 20, 101, 244, 159, 104, 25, 61,
-			138, 50, 250, 79, 64, 70, 191, 217, 161, 54, 97, 44, 247, 89,
-			183, 239, 239, 93, 171, 244, 79, 54, 159, 53, 86, 93, 115, 118,
-			14, 82, 193, 51, 59, 220, 148, 108, 230, 252, 198, 80, 73, 89,
-			25, 165, 143, 183, 215, 110, 11, 79, 94, 177, 214, 189, 80, 81,
-			213, 100, 124, 111, 125, 51, 219, 120, 165, 93, 188, 120, 93, 197,
-			166, 147, 97, 104, 178, 17, 139, 195, 176, 169, 163, 93, 198, 74,
-			182, 161, 193, 23, 195, 185, 65, 3, 231, 51, 161, 233, 241, 190,
-			96, 92, 237, 186, 185, 220, 211, 4, 63, 232, 10, 102, 47, 75,
-			168, 71, 229, 229, 17, 134, 108, 95, 138, 246, 226, 69, 133, 98,
-			106, 90, 138, 139, 118, 20, 202, 119, 25, 122, 178, 205, 133, 237,
-			157, 181, 112, 106, 122, 90, 157, 93, 98, 100, 16, 156, 28, 55,
-			178, 225, 240, 76, 204, 60, 29, 112, 79, 134, 138, 98, 22, 35,
-			159, 117, 11, 7, 233, 191, 183, 41, 33, 204, 233, 99, 185, 207,
-			187, 246, 95, 185, 78, 233, 223, 74, 79, 154, 236, 197, 254, 174,
-			248, 122, 233, 89, 33, 134, 80, 84, 225, 68, 204, 40, 202, 56,
-			175, 155, 42, 168, 0, 229, 30, 111, 132, 201, 140, 14, 177, 211,
-			208, 254, 220, 126, 188, 158, 198, 17, 81, 207, 34, 112, 127, 99,
-			35, 83, 58, 139, 50, 200, 4, 217, 227, 83, 13, 17, 132, 137,
-			142, 20, 34, 223, 169, 129, 161, 234, 226, 129, 184, 45, 234, 113,
-			175, 215, 227, 116, 149, 242, 133, 234, 102, 181, 242, 47, 248, 15,
-			171, 7, 210, 209, 39, 230, 21, 21, 254, 195, 229, 154, 23, 85,
-			107, 222, 147, 229, 10, 54, 6, 147, 90, 153, 44, 252, 53, 153,
-			22, 81, 249, 176, 250, 148, 42, 51, 93, 133, 156, 106, 178, 50,
-			140, 64, 253, 121, 151, 202, 151, 189, 152, 140, 64, 253, 151, 46,
-			41, 163, 92, 98, 42, 230, 244, 95, 186, 42, 34, 52, 83, 49,
-			167, 255, 210, 29, 26, 75, 19, 44, 72, 24, 63, 156, 38, 56,
-			144, 192, 143, 25, 156, 22, 35, 95, 112, 201, 113, 147, 1, 132,
-			217, 23, 178, 56, 45, 23, 18, 134, 70, 211, 4, 44, 194, 142,
-			164, 9, 14, 36, 28, 43, 227, 92, 102, 208, 202, 47, 186, 182,
-			140, 139, 202, 176, 141, 95, 212, 18, 135, 161, 113, 237, 139, 238,
-			192, 152, 6, 45, 0, 199, 39, 52, 232, 0, 120, 240, 144, 124,
-			34, 138, 65, 227, 190, 228, 218, 147, 165, 183, 88, 153, 151, 78,
-			158, 131, 155, 42, 48, 82, 219, 91, 94, 130, 92, 140, 206, 41,
-			168, 129, 133, 55, 5, 76, 248, 136, 194, 82, 32, 67, 148, 98,
-			160, 72, 47, 86, 71, 55, 233, 201, 205, 130, 186, 164, 162, 30,
-			141, 146, 147, 87, 57, 173, 170, 55, 168, 116, 95, 128, 84, 95,
-			74, 187, 6, 132, 250, 146, 171, 252, 63, 24, 146, 233, 75, 174,
-			186, 9, 201, 144, 72, 95, 114, 79, 222, 169, 136, 100, 51, 242,
-			101, 215, 158, 86, 31, 65, 44, 127, 57, 197, 100, 187, 0, 26,
-			76, 232, 47, 238, 178, 19, 26, 116, 0, 156, 156, 82, 152, 28,
-			70, 190, 226, 170, 104, 203, 12, 15, 119, 190, 146, 98, 114, 92,
-			0, 149, 167, 31, 195, 195, 157, 175, 184, 163, 199, 53, 136, 101,
-			239, 156, 84, 152, 8, 35, 127, 231, 218, 250, 35, 145, 160, 198,
-			68, 92, 0, 77, 155, 136, 5, 160, 186, 224, 198, 240, 104, 228,
-			239, 82, 22, 112, 25, 249, 175, 174, 173, 187, 238, 18, 4, 53,
-			38, 23, 191, 154, 54, 185, 22, 128, 163, 154, 95, 92, 7, 64,
-			21, 71, 151, 193, 2, 245, 85, 87, 133, 78, 102, 104, 206, 255,
-			106, 138, 41, 231, 2, 104, 218, 148, 179, 0, 100, 199, 52, 232,
-			0, 120, 226, 36, 253, 140, 69, 109, 50, 198, 114, 223, 116, 251,
-			254, 91, 206, 42, 61, 206, 23, 130, 186, 215, 142, 85, 252, 211,
-			231, 247, 100, 186, 12, 230, 21, 133, 45, 237, 130, 69, 249, 131,
-			126, 83, 244, 4, 44, 230, 219, 94, 38, 158, 75, 149, 158, 127,
-			229, 247, 51, 226, 108, 247, 3, 238, 216, 112, 41, 133, 199, 44,
-			70, 190, 233, 22, 246, 209, 215, 142, 82, 66, 198, 96, 6, 126,
-			32, 103, 31, 43, 253, 93, 145, 207, 242, 171, 161, 62, 27, 75,
-			163, 243, 122, 188, 237, 11, 233, 174, 208, 141, 145, 123, 221, 1,
-			217, 160, 175, 84, 158, 217, 197, 237, 48, 144, 254, 141, 94, 246,
-			184, 55, 13, 122, 107, 174, 215, 116, 191, 246, 46, 227, 60, 170,
-			215, 58, 210, 231, 67, 146, 144, 47, 206, 47, 224, 83, 81, 13,
-			245, 36, 147, 136, 226, 74, 207, 109, 192, 244, 126, 184, 10, 148,
-			230, 183, 252, 166, 23, 81, 243, 78, 188, 122, 235, 11, 3, 46,
-			86, 120, 236, 237, 192, 6, 64, 94, 237, 146, 93, 48, 23, 18,
-			110, 123, 205, 12, 200, 170, 130, 89, 133, 161, 185, 93, 240, 26,
-			202, 175, 10, 188, 17, 23, 134, 55, 185, 151, 200, 16, 189, 169,
-			43, 112, 218, 111, 196, 254, 92, 168, 30, 83, 87, 21, 30, 123,
-			204, 252, 129, 255, 143, 61, 6, 31, 61, 245, 177, 86, 199, 63,
-			13, 193, 249, 6, 231, 155, 91, 62, 133, 237, 146, 9, 54, 107,
-			130, 248, 240, 166, 26, 79, 121, 107, 35, 110, 123, 1, 231, 24,
-			176, 135, 119, 255, 203, 174, 50, 156, 255, 176, 87, 241, 167, 57,
-			255, 97, 126, 161, 194, 207, 86, 248, 249, 10, 63, 203, 95, 129,
-			249, 64, 176, 110, 111, 133, 205, 221, 29, 171, 170, 130, 181, 158,
-			130, 21, 126, 1, 202, 66, 193, 166, 87, 19, 77, 62, 165, 123,
-			63, 45, 139, 212, 43, 141, 93, 69, 238, 214, 69, 228, 43, 115,
-			146, 76, 42, 191, 168, 108, 236, 202, 127, 78, 231, 151, 161, 73,
-			55, 194, 80, 101, 222, 172, 108, 237, 202, 124, 151, 201, 44, 163,
-			122, 78, 157, 155, 214, 111, 76, 0, 153, 102, 248, 172, 33, 155,
-			114, 251, 48, 49, 196, 141, 39, 175, 242, 33, 74, 98, 209, 220,
-			80, 175, 224, 41, 23, 9, 12, 85, 199, 179, 76, 47, 95, 16,
-			83, 49, 109, 253, 100, 58, 115, 179, 76, 159, 81, 171, 16, 107,
-			120, 171, 33, 220, 208, 78, 186, 177, 12, 247, 204, 57, 108, 130,
-			165, 247, 145, 8, 234, 205, 80, 57, 126, 24, 15, 93, 121, 221,
-			76, 106, 48, 85, 222, 205, 228, 232, 198, 152, 248, 81, 26, 20,
-			21, 29, 120, 235, 55, 249, 84, 59, 140, 99, 191, 214, 220, 201,
-			62, 101, 102, 188, 123, 82, 205, 39, 19, 168, 90, 170, 125, 24,
-			168, 84, 222, 135, 84, 94, 50, 134, 92, 219, 91, 176, 117, 68,
-			254, 66, 170, 153, 131, 160, 114, 170, 225, 151, 13, 21, 113, 199,
-			111, 188, 92, 49, 48, 81, 32, 169, 85, 133, 97, 184, 166, 219,
-			98, 152, 56, 221, 139, 153, 171, 122, 80, 151, 38, 168, 116, 99,
-			141, 181, 31, 171, 164, 78, 134, 126, 217, 183, 37, 240, 9, 180,
-			118, 132, 59, 84, 168, 88, 198, 100, 54, 221, 199, 16, 90, 42,
-			32, 61, 111, 133, 49, 26, 21, 194, 218, 45, 63, 236, 196, 154,
-			184, 250, 145, 64, 217, 183, 70, 89, 209, 213, 219, 244, 252, 192,
-			68, 182, 213, 193, 144, 179, 113, 124, 179, 195, 208, 253, 2, 70,
-			92, 15, 219, 162, 34, 61, 181, 209, 47, 197, 68, 10, 222, 163,
-			215, 221, 172, 58, 25, 203, 233, 173, 253, 188, 228, 77, 27, 12,
-			79, 171, 184, 202, 79, 98, 165, 184, 170, 188, 146, 87, 100, 139,
-			20, 187, 100, 250, 35, 68, 89, 122, 168, 117, 241, 66, 74, 64,
-			189, 7, 146, 254, 120, 128, 165, 38, 54, 253, 0, 217, 72, 105,
-			93, 189, 148, 145, 55, 129, 227, 45, 47, 146, 91, 139, 158, 72,
-			211, 218, 111, 75, 70, 200, 197, 50, 216, 201, 135, 165, 123, 145,
-			116, 147, 242, 246, 234, 113, 182, 155, 113, 216, 210, 1, 68, 123,
-			114, 2, 102, 179, 177, 107, 9, 207, 60, 11, 35, 81, 192, 166,
-			73, 4, 13, 111, 143, 73, 196, 203, 248, 220, 112, 89, 237, 96,
-			81, 72, 226, 187, 162, 158, 148, 80, 208, 179, 76, 224, 112, 51,
-			51, 187, 30, 51, 73, 67, 114, 166, 28, 13, 136, 210, 5, 211,
-			151, 111, 177, 233, 144, 32, 202, 133, 28, 144, 170, 88, 118, 126,
-			132, 59, 202, 48, 72, 253, 71, 205, 75, 40, 124, 134, 207, 165,
-			81, 170, 228, 99, 59, 120, 233, 67, 233, 189, 153, 105, 164, 52,
-			212, 118, 20, 214, 188, 154, 244, 150, 105, 136, 216, 223, 12, 208,
-			14, 134, 161, 166, 209, 76, 200, 19, 156, 207, 154, 74, 218, 114,
-			32, 99, 167, 36, 94, 208, 168, 128, 82, 140, 247, 22, 164, 71,
-			116, 184, 145, 169, 165, 46, 253, 113, 184, 124, 11, 165, 30, 70,
-			141, 76, 156, 78, 188, 122, 162, 148, 227, 49, 212, 251, 63, 144,
-			179, 13, 152, 3, 80, 233, 253, 99, 168, 247, 127, 32, 55, 126,
-			72, 131, 14, 128, 71, 57, 26, 88, 198, 96, 23, 249, 193, 156,
-			253, 205, 156, 116, 127, 30, 195, 141, 208, 7, 115, 148, 209, 55,
-			230, 105, 14, 96, 208, 112, 126, 59, 71, 42, 165, 127, 200, 101,
-			31, 29, 80, 97, 209, 189, 40, 209, 236, 122, 59, 29, 77, 223,
-			249, 86, 207, 15, 81, 211, 71, 140, 136, 158, 185, 85, 161, 108,
-			167, 25, 75, 168, 122, 13, 76, 10, 53, 21, 194, 13, 157, 244,
-			60, 41, 37, 65, 73, 148, 55, 172, 163, 48, 76, 246, 108, 129,
-			142, 178, 3, 18, 73, 249, 51, 37, 93, 239, 7, 240, 176, 94,
-			239, 224, 27, 244, 93, 76, 139, 86, 20, 168, 4, 151, 126, 92,
-			2, 239, 194, 37, 240, 94, 92, 47, 105, 42, 161, 47, 74, 65,
-			220, 20, 85, 125, 135, 30, 70, 115, 234, 174, 105, 206, 207, 156,
-			193, 114, 250, 6, 101, 21, 251, 54, 117, 239, 180, 81, 28, 206,
-			156, 65, 148, 38, 3, 44, 192, 83, 211, 25, 205, 226, 204, 25,
-			126, 46, 117, 123, 212, 179, 120, 143, 142, 118, 85, 46, 35, 9,
-			100, 9, 121, 1, 91, 105, 22, 224, 94, 42, 117, 21, 190, 159,
-			95, 184, 68, 113, 206, 244, 214, 33, 81, 238, 66, 126, 190, 27,
-			249, 94, 207, 139, 112, 125, 237, 243, 188, 66, 189, 231, 27, 36,
-			168, 127, 236, 66, 127, 110, 79, 173, 15, 243, 170, 107, 164, 169,
-			104, 144, 55, 243, 145, 57, 210, 71, 72, 205, 2, 27, 166, 143,
-			206, 41, 163, 198, 226, 6, 40, 177, 202, 133, 70, 178, 82, 211,
-			139, 19, 205, 146, 187, 6, 31, 70, 222, 176, 70, 207, 42, 221,
-			173, 217, 165, 162, 126, 202, 132, 135, 52, 34, 158, 234, 73, 35,
-			149, 58, 45, 181, 240, 134, 141, 62, 136, 107, 249, 245, 176, 25,
-			6, 211, 202, 143, 127, 76, 25, 33, 126, 59, 167, 12, 6, 99,
-			202, 8, 241, 219, 57, 245, 44, 213, 152, 50, 66, 252, 118, 110,
-			108, 127, 154, 224, 64, 66, 233, 96, 154, 80, 128, 132, 67, 167,
-			105, 145, 22, 84, 130, 13, 41, 135, 79, 209, 63, 176, 213, 148,
-			183, 24, 249, 93, 152, 242, 191, 101, 235, 59, 167, 91, 248, 6,
-			133, 220, 174, 39, 91, 145, 16, 242, 213, 128, 78, 100, 180, 173,
-			139, 42, 224, 119, 211, 15, 96, 119, 128, 191, 235, 97, 179, 211,
-			10, 42, 148, 195, 130, 13, 31, 82, 245, 181, 146, 241, 216, 245,
-			226, 184, 211, 18, 13, 185, 56, 123, 113, 6, 209, 116, 5, 139,
-			74, 60, 230, 129, 11, 47, 50, 215, 198, 252, 64, 70, 200, 149,
-			11, 134, 164, 61, 222, 214, 82, 23, 193, 234, 59, 85, 158, 241,
-			137, 6, 156, 146, 255, 36, 74, 115, 12, 2, 40, 159, 20, 81,
-			56, 35, 141, 251, 160, 127, 24, 159, 245, 157, 176, 35, 23, 139,
-			109, 21, 200, 192, 107, 52, 40, 63, 135, 215, 207, 64, 124, 169,
-			211, 144, 134, 31, 183, 155, 222, 142, 175, 95, 4, 237, 200, 107,
-			180, 154, 238, 22, 65, 162, 166, 99, 103, 185, 144, 144, 25, 59,
-			11, 201, 158, 25, 59, 203, 129, 132, 204, 216, 89, 5, 72, 200,
-			140, 157, 5, 99, 247, 187, 48, 118, 239, 27, 84, 99, 103, 51,
-			242, 143, 57, 50, 93, 122, 122, 208, 132, 178, 95, 197, 125, 39,
-			44, 111, 139, 193, 70, 216, 109, 248, 51, 1, 109, 51, 28, 171,
-			3, 95, 123, 24, 222, 107, 7, 54, 165, 45, 165, 81, 163, 214,
-			226, 167, 71, 64, 184, 116, 227, 91, 157, 82, 170, 166, 92, 175,
-			246, 238, 88, 64, 187, 93, 235, 7, 89, 52, 195, 103, 23, 120,
-			74, 249, 44, 198, 208, 85, 11, 33, 154, 186, 119, 215, 27, 6,
-			232, 39, 45, 234, 29, 244, 58, 133, 108, 177, 12, 93, 202, 131,
-			80, 242, 20, 149, 70, 168, 158, 82, 114, 49, 54, 249, 229, 209,
-			94, 162, 236, 205, 248, 176, 170, 217, 15, 96, 165, 208, 160, 166,
-			240, 64, 171, 88, 111, 8, 217, 238, 117, 211, 32, 68, 112, 83,
-			136, 54, 44, 129, 222, 102, 228, 181, 183, 176, 217, 38, 3, 178,
-			155, 108, 0, 213, 196, 154, 170, 117, 18, 212, 158, 234, 97, 16,
-			200, 123, 4, 73, 56, 45, 45, 221, 242, 14, 128, 158, 77, 85,
-			185, 48, 26, 220, 120, 185, 67, 219, 99, 107, 59, 50, 250, 82,
-			111, 103, 194, 148, 98, 233, 146, 42, 21, 134, 116, 207, 96, 110,
-			158, 47, 227, 173, 157, 173, 180, 136, 186, 88, 147, 141, 138, 115,
-			201, 124, 108, 121, 209, 77, 152, 39, 210, 14, 126, 230, 204, 180,
-			220, 92, 197, 248, 30, 169, 192, 93, 128, 82, 251, 164, 178, 170,
-			233, 80, 209, 52, 4, 126, 72, 84, 164, 45, 100, 154, 128, 123,
-			113, 34, 34, 63, 190, 153, 190, 114, 108, 208, 237, 150, 152, 184,
-			177, 195, 120, 172, 192, 24, 97, 122, 129, 83, 154, 9, 162, 56,
-			169, 82, 190, 36, 182, 145, 38, 200, 185, 234, 190, 110, 122, 215,
-			23, 31, 204, 146, 207, 176, 232, 71, 35, 186, 22, 22, 140, 13,
-			99, 172, 9, 184, 246, 206, 169, 238, 103, 25, 119, 35, 12, 113,
-			119, 124, 155, 207, 53, 47, 170, 238, 129, 182, 230, 69, 114, 245,
-			219, 107, 45, 171, 121, 79, 242, 251, 249, 93, 151, 158, 19, 237,
-			147, 186, 214, 217, 64, 233, 231, 64, 137, 93, 121, 158, 3, 71,
-			203, 180, 252, 219, 97, 210, 57, 51, 15, 234, 118, 106, 77, 216,
-			203, 133, 82, 61, 80, 8, 230, 213, 196, 48, 124, 34, 223, 62,
-			138, 54, 51, 175, 195, 2, 195, 107, 38, 8, 35, 158, 68, 158,
-			143, 55, 71, 52, 139, 40, 84, 178, 86, 174, 203, 103, 31, 104,
-			141, 164, 40, 170, 53, 189, 224, 166, 100, 122, 61, 27, 212, 77,
-			89, 169, 7, 34, 26, 216, 87, 84, 191, 125, 243, 210, 169, 197,
-			207, 87, 247, 28, 19, 153, 237, 126, 126, 183, 28, 149, 83, 252,
-			114, 150, 177, 13, 181, 80, 117, 59, 37, 67, 243, 99, 183, 249,
-			85, 213, 87, 205, 222, 177, 202, 162, 153, 92, 41, 27, 85, 126,
-			234, 204, 115, 98, 86, 123, 9, 126, 138, 111, 70, 24, 168, 82,
-			21, 232, 97, 44, 249, 145, 223, 207, 239, 49, 163, 162, 188, 20,
-			120, 163, 167, 251, 113, 102, 57, 178, 9, 174, 19, 233, 114, 100,
-			187, 144, 160, 206, 51, 48, 193, 130, 132, 241, 19, 105, 130, 3,
-			9, 147, 83, 120, 158, 1, 9, 14, 35, 223, 200, 145, 83, 38,
-			131, 67, 48, 33, 197, 233, 184, 144, 144, 193, 233, 88, 144, 48,
-			126, 50, 77, 64, 28, 83, 211, 6, 39, 97, 228, 159, 114, 228,
-			188, 201, 64, 100, 66, 138, 147, 184, 144, 144, 193, 73, 44, 72,
-			24, 159, 73, 19, 28, 72, 56, 123, 142, 254, 141, 69, 109, 50,
-			206, 114, 175, 203, 247, 189, 51, 111, 149, 254, 179, 149, 121, 201,
-			79, 74, 197, 166, 220, 108, 109, 249, 109, 94, 19, 201, 182, 16,
-			65, 207, 125, 50, 185, 235, 78, 226, 94, 35, 181, 126, 40, 98,
-			54, 141, 59, 111, 214, 215, 108, 244, 152, 56, 14, 235, 190, 103,
-			78, 190, 204, 251, 56, 166, 22, 154, 181, 122, 167, 71, 230, 250,
-			157, 7, 212, 48, 49, 26, 63, 176, 88, 234, 43, 172, 10, 117,
-			197, 7, 145, 6, 234, 113, 139, 145, 215, 229, 11, 7, 232, 19,
-			148, 144, 113, 216, 189, 189, 33, 111, 159, 44, 61, 206, 103, 3,
-			62, 107, 156, 94, 244, 18, 20, 203, 13, 63, 90, 2, 64, 37,
-			21, 79, 224, 154, 209, 67, 4, 52, 204, 233, 53, 133, 106, 107,
-			136, 14, 36, 21, 108, 118, 63, 88, 130, 59, 201, 113, 84, 86,
-			223, 144, 183, 13, 152, 3, 112, 224, 14, 13, 90, 0, 238, 227,
-			26, 116, 0, 60, 126, 2, 119, 165, 227, 176, 43, 253, 177, 188,
-			253, 76, 94, 238, 74, 199, 113, 87, 250, 99, 121, 58, 78, 223,
-			106, 209, 28, 192, 208, 175, 55, 229, 73, 165, 244, 35, 217, 77,
-			41, 122, 106, 118, 175, 124, 189, 135, 11, 93, 111, 102, 167, 239,
-			141, 161, 151, 177, 180, 165, 227, 178, 172, 77, 69, 94, 175, 246,
-			84, 213, 54, 254, 170, 180, 34, 33, 215, 141, 43, 221, 252, 77,
-			121, 197, 168, 227, 74, 55, 127, 83, 94, 233, 119, 227, 74, 55,
-			127, 83, 94, 233, 119, 227, 74, 55, 127, 83, 94, 233, 119, 227,
-			74, 55, 127, 83, 94, 233, 119, 227, 90, 55, 127, 83, 254, 240,
-			41, 186, 172, 250, 109, 49, 242, 150, 60, 57, 81, 122, 160, 183,
-			223, 200, 3, 24, 153, 91, 238, 77, 148, 138, 181, 119, 255, 51,
-			237, 6, 189, 244, 45, 217, 118, 131, 94, 250, 150, 188, 154, 96,
-			227, 74, 47, 125, 75, 126, 252, 104, 154, 224, 64, 66, 249, 56,
-			221, 86, 141, 178, 25, 121, 42, 79, 14, 151, 54, 123, 27, 133,
-			154, 188, 92, 189, 55, 98, 129, 131, 82, 219, 73, 210, 135, 107,
-			187, 249, 76, 69, 35, 199, 105, 153, 122, 193, 103, 142, 17, 229,
-			105, 124, 166, 241, 32, 46, 158, 202, 54, 30, 164, 216, 83, 89,
-			162, 3, 193, 158, 202, 171, 151, 128, 199, 149, 20, 123, 42, 127,
-			240, 16, 253, 51, 205, 74, 14, 35, 239, 200, 147, 131, 165, 127,
-			103, 237, 226, 37, 229, 92, 246, 124, 26, 175, 110, 206, 62, 71,
-			227, 17, 139, 12, 208, 35, 2, 13, 103, 206, 59, 65, 46, 180,
-			189, 56, 201, 108, 67, 35, 209, 20, 183, 240, 53, 245, 157, 68,
-			240, 41, 245, 80, 159, 140, 41, 161, 183, 144, 56, 103, 239, 71,
-			148, 51, 82, 95, 154, 206, 80, 8, 100, 242, 59, 178, 20, 2,
-			153, 252, 142, 44, 133, 64, 38, 191, 35, 175, 94, 50, 30, 87,
-			50, 249, 29, 249, 3, 37, 250, 111, 6, 232, 153, 205, 176, 90,
-			223, 138, 194, 150, 223, 105, 85, 195, 104, 243, 76, 179, 83, 247,
-			207, 192, 122, 18, 6, 103, 106, 175, 58, 211, 174, 157, 209, 15,
-			181, 33, 99, 177, 66, 237, 85, 113, 125, 75, 180, 188, 146, 138,
-			91, 127, 70, 199, 173, 63, 147, 186, 85, 200, 188, 229, 73, 58,
-			136, 102, 0, 229, 240, 192, 246, 211, 124, 237, 85, 104, 131, 152,
-			176, 184, 53, 213, 191, 146, 171, 189, 106, 109, 167, 45, 46, 254,
-			32, 205, 171, 90, 216, 225, 106, 111, 56, 252, 44, 138, 137, 79,
-			124, 156, 112, 107, 106, 224, 252, 190, 170, 110, 71, 215, 247, 21,
-			141, 231, 242, 241, 151, 31, 251, 182, 93, 123, 248, 157, 253, 52,
-			199, 200, 72, 223, 164, 69, 191, 69, 168, 53, 200, 156, 145, 62,
-			86, 186, 143, 207, 163, 245, 40, 216, 204, 154, 95, 253, 56, 19,
-			200, 223, 87, 225, 61, 206, 171, 248, 76, 242, 214, 20, 61, 255,
-			49, 194, 231, 194, 246, 78, 228, 111, 110, 37, 120, 5, 18, 185,
-			225, 234, 141, 185, 69, 62, 139, 23, 177, 241, 156, 237, 170, 95,
-			23, 1, 94, 69, 12, 26, 106, 103, 53, 219, 134, 149, 92, 127,
-			169, 240, 151, 201, 168, 51, 252, 124, 245, 44, 159, 66, 99, 177,
-			250, 84, 158, 190, 68, 113, 199, 170, 159, 126, 204, 188, 132, 136,
-			7, 19, 117, 209, 70, 86, 150, 193, 36, 189, 160, 46, 210, 248,
-			150, 10, 71, 149, 98, 60, 75, 124, 142, 185, 134, 203, 16, 236,
-			19, 219, 250, 94, 180, 206, 198, 189, 132, 42, 203, 213, 86, 146,
-			180, 47, 158, 57, 179, 189, 189, 93, 245, 176, 165, 146, 160, 50,
-			95, 124, 230, 234, 226, 220, 194, 210, 234, 194, 204, 249, 234, 89,
-			74, 249, 141, 0, 111, 142, 154, 91, 165, 181, 29, 253, 68, 33,
-			168, 152, 77, 111, 27, 205, 126, 155, 145, 138, 171, 229, 7, 58,
-			48, 75, 133, 199, 225, 70, 178, 141, 59, 143, 134, 15, 122, 90,
-			173, 147, 116, 145, 73, 183, 76, 61, 113, 174, 51, 132, 120, 255,
-			179, 60, 187, 202, 23, 87, 203, 252, 242, 236, 234, 226, 106, 133,
-			242, 71, 22, 215, 30, 90, 190, 177, 198, 31, 153, 93, 89, 153,
-			93, 90, 91, 92, 88, 229, 203, 43, 124, 110, 121, 105, 126, 113,
-			109, 113, 121, 105, 149, 47, 63, 200, 103, 151, 30, 229, 47, 93,
-			92, 154, 175, 232, 91, 180, 226, 9, 216, 60, 199, 232, 37, 136,
-			46, 115, 141, 76, 120, 85, 93, 189, 113, 218, 214, 47, 41, 152,
-			224, 99, 155, 225, 45, 17, 33, 235, 96, 88, 140, 88, 61, 18,
-			25, 52, 40, 199, 0, 49, 202, 248, 187, 171, 71, 85, 74, 11,
-			212, 178, 153, 51, 218, 55, 74, 251, 169, 237, 244, 49, 103, 172,
-			239, 20, 36, 22, 152, 115, 71, 223, 139, 32, 177, 48, 32, 127,
-			82, 106, 147, 62, 70, 246, 247, 149, 44, 74, 169, 67, 250, 44,
-			230, 236, 47, 140, 211, 151, 81, 130, 94, 130, 206, 1, 251, 72,
-			105, 145, 95, 246, 55, 127, 176, 35, 162, 29, 29, 112, 9, 93,
-			56, 100, 148, 38, 217, 129, 244, 182, 127, 183, 55, 98, 121, 179,
-			131, 81, 16, 202, 32, 178, 208, 175, 204, 37, 184, 0, 58, 7,
-			108, 3, 185, 204, 57, 48, 48, 170, 33, 139, 57, 7, 216, 1,
-			13, 57, 204, 57, 112, 232, 48, 253, 138, 69, 173, 60, 35, 39,
-			251, 38, 173, 210, 95, 88, 106, 86, 105, 67, 29, 214, 172, 94,
-			172, 210, 30, 66, 202, 116, 30, 134, 200, 52, 122, 134, 119, 218,
-			13, 47, 65, 91, 11, 191, 1, 138, 151, 220, 214, 233, 247, 85,
-			202, 223, 161, 4, 43, 75, 53, 218, 104, 113, 38, 136, 30, 198,
-			155, 193, 23, 83, 227, 196, 107, 181, 97, 147, 200, 127, 120, 202,
-			136, 25, 133, 100, 186, 170, 68, 24, 191, 159, 151, 215, 22, 175,
-			45, 172, 174, 205, 94, 187, 94, 126, 133, 140, 143, 247, 26, 10,
-			227, 148, 239, 99, 206, 157, 246, 20, 140, 77, 190, 207, 102, 206,
-			201, 252, 113, 249, 155, 64, 58, 149, 191, 115, 204, 185, 115, 96,
-			191, 252, 109, 49, 231, 206, 137, 163, 242, 183, 195, 156, 59, 203,
-			147, 244, 175, 15, 209, 123, 246, 238, 153, 223, 110, 156, 241, 218,
-			234, 199, 173, 115, 103, 228, 85, 213, 245, 102, 184, 169, 68, 52,
-			129, 47, 165, 239, 84, 178, 151, 191, 72, 232, 240, 44, 226, 186,
-			26, 110, 98, 60, 63, 182, 143, 230, 164, 195, 170, 150, 215, 18,
-			98, 51, 180, 223, 208, 105, 194, 230, 214, 148, 115, 121, 228, 179,
-			191, 126, 98, 128, 246, 27, 138, 172, 164, 57, 216, 4, 205, 171,
-			144, 207, 19, 14, 226, 209, 32, 43, 209, 2, 240, 30, 72, 169,
-			9, 130, 159, 12, 12, 165, 84, 236, 173, 9, 87, 150, 82, 32,
-			99, 148, 36, 222, 102, 60, 145, 227, 206, 84, 255, 10, 254, 6,
-			76, 58, 158, 247, 68, 30, 211, 13, 204, 198, 169, 187, 209, 132,
-			2, 5, 252, 32, 1, 118, 156, 14, 213, 189, 102, 115, 93, 46,
-			225, 201, 206, 68, 63, 214, 50, 8, 137, 139, 42, 13, 50, 181,
-			133, 136, 210, 76, 84, 102, 130, 68, 147, 105, 63, 205, 203, 76,
-			237, 137, 1, 73, 39, 252, 220, 102, 135, 41, 237, 196, 34, 90,
-			247, 54, 69, 144, 76, 12, 226, 183, 126, 72, 153, 133, 4, 54,
-			73, 71, 212, 125, 140, 117, 221, 211, 33, 204, 51, 172, 146, 213,
-			42, 0, 120, 218, 81, 136, 195, 236, 55, 38, 134, 37, 30, 149,
-			178, 216, 128, 207, 32, 108, 69, 156, 192, 231, 17, 249, 89, 165,
-			44, 54, 216, 17, 58, 224, 117, 146, 173, 245, 70, 109, 61, 18,
-			183, 38, 138, 48, 94, 43, 253, 144, 52, 95, 91, 17, 183, 160,
-			143, 242, 132, 45, 22, 235, 160, 233, 76, 140, 202, 62, 234, 68,
-			80, 141, 217, 49, 106, 224, 117, 17, 69, 19, 12, 243, 12, 232,
-			180, 133, 40, 98, 21, 202, 76, 22, 24, 252, 245, 78, 44, 234,
-			19, 99, 88, 93, 81, 127, 89, 243, 91, 226, 70, 44, 234, 151,
-			79, 191, 124, 250, 121, 241, 247, 37, 175, 237, 63, 252, 31, 247,
-			193, 66, 61, 220, 119, 193, 162, 255, 167, 92, 168, 135, 251, 216,
-			255, 88, 109, 255, 199, 106, 251, 61, 174, 182, 197, 190, 33, 181,
-			196, 178, 190, 31, 208, 75, 172, 250, 9, 75, 240, 120, 223, 125,
-			244, 235, 182, 92, 110, 15, 247, 93, 176, 74, 95, 180, 121, 183,
-			140, 212, 103, 222, 220, 227, 114, 161, 192, 230, 190, 82, 10, 229,
-			87, 166, 107, 112, 130, 195, 33, 99, 42, 227, 55, 222, 12, 55,
-			227, 108, 156, 33, 143, 215, 68, 156, 112, 177, 177, 1, 203, 90,
-			51, 220, 52, 79, 90, 235, 168, 112, 126, 48, 211, 18, 173, 48,
-			218, 225, 234, 137, 219, 42, 95, 133, 221, 189, 8, 228, 121, 118,
-			203, 219, 193, 32, 216, 141, 40, 68, 43, 171, 191, 193, 61, 174,
-			196, 132, 9, 154, 161, 108, 213, 126, 194, 55, 154, 29, 76, 65,
-			59, 44, 34, 68, 39, 48, 163, 44, 196, 153, 200, 37, 50, 92,
-			186, 238, 129, 233, 213, 106, 18, 70, 48, 16, 143, 68, 126, 34,
-			248, 236, 245, 197, 10, 143, 133, 160, 230, 165, 222, 122, 51, 236,
-			52, 178, 143, 244, 214, 252, 205, 87, 65, 73, 249, 56, 47, 112,
-			162, 152, 241, 218, 254, 113, 144, 211, 184, 172, 174, 203, 128, 106,
-			56, 146, 212, 104, 55, 135, 11, 251, 232, 172, 214, 110, 142, 216,
-			227, 229, 11, 216, 234, 149, 235, 115, 230, 78, 15, 26, 174, 203,
-			40, 53, 170, 43, 162, 29, 198, 126, 18, 70, 59, 103, 174, 250,
-			113, 114, 29, 95, 242, 40, 103, 85, 151, 35, 118, 33, 163, 186,
-			28, 233, 31, 201, 168, 46, 71, 216, 24, 157, 196, 202, 44, 230,
-			28, 181, 103, 203, 37, 222, 242, 235, 81, 24, 139, 58, 186, 237,
-			197, 62, 76, 115, 209, 14, 235, 91, 26, 165, 229, 66, 206, 188,
-			134, 160, 92, 65, 235, 70, 150, 195, 156, 163, 227, 119, 104, 168,
-			192, 156, 163, 251, 126, 128, 22, 105, 63, 66, 159, 248, 56, 129,
-			220, 251, 31, 160, 47, 194, 42, 109, 230, 112, 251, 142, 242, 105,
-			62, 183, 120, 125, 62, 125, 180, 33, 50, 63, 213, 179, 36, 83,
-			254, 134, 124, 119, 124, 90, 183, 193, 118, 161, 168, 238, 22, 180,
-			157, 247, 23, 53, 228, 48, 135, 143, 141, 211, 83, 88, 135, 195,
-			156, 99, 246, 190, 242, 97, 89, 135, 94, 112, 249, 226, 252, 30,
-			88, 29, 23, 50, 107, 172, 142, 197, 156, 99, 253, 186, 103, 14,
-			32, 26, 191, 131, 86, 17, 43, 97, 78, 217, 190, 163, 124, 172,
-			187, 229, 106, 44, 247, 192, 76, 92, 40, 160, 49, 3, 21, 202,
-			166, 189, 196, 97, 78, 121, 108, 156, 86, 16, 179, 203, 156, 19,
-			246, 193, 242, 81, 101, 212, 195, 117, 13, 164, 153, 183, 25, 239,
-			129, 215, 37, 144, 221, 64, 80, 216, 232, 169, 174, 197, 156, 19,
-			108, 159, 134, 28, 230, 156, 56, 80, 162, 119, 99, 45, 57, 230,
-			156, 180, 143, 150, 167, 122, 106, 49, 175, 132, 96, 44, 225, 221,
-			213, 229, 8, 148, 51, 144, 203, 156, 147, 166, 186, 156, 197, 156,
-			147, 172, 164, 33, 135, 57, 39, 15, 31, 161, 247, 98, 117, 121,
-			208, 5, 15, 149, 79, 153, 32, 143, 176, 30, 212, 194, 176, 41,
-			188, 128, 163, 134, 146, 30, 238, 96, 91, 116, 21, 121, 165, 69,
-			42, 200, 5, 61, 82, 87, 152, 7, 77, 146, 237, 215, 16, 232,
-			146, 165, 131, 244, 60, 86, 88, 96, 206, 148, 125, 176, 124, 146,
-			107, 93, 70, 250, 215, 201, 231, 172, 183, 194, 200, 127, 82, 138,
-			93, 208, 127, 52, 246, 130, 11, 133, 244, 24, 21, 44, 230, 76,
-			245, 107, 234, 21, 28, 230, 76, 29, 40, 209, 123, 16, 123, 63,
-			115, 166, 237, 67, 229, 233, 20, 123, 40, 111, 14, 200, 187, 179,
-			104, 248, 145, 207, 162, 202, 121, 171, 107, 232, 119, 161, 160, 174,
-			161, 223, 98, 206, 180, 169, 161, 223, 97, 206, 244, 129, 131, 106,
-			50, 82, 230, 156, 178, 247, 149, 75, 124, 241, 58, 247, 26, 141,
-			40, 19, 57, 13, 154, 44, 34, 141, 146, 186, 144, 83, 163, 164,
-			22, 115, 78, 25, 198, 162, 14, 115, 78, 141, 221, 65, 79, 35,
-			202, 1, 230, 156, 182, 15, 148, 143, 164, 222, 240, 229, 27, 177,
-			136, 102, 80, 61, 43, 243, 45, 225, 53, 82, 180, 3, 46, 228,
-			214, 104, 7, 44, 230, 156, 238, 31, 211, 144, 195, 156, 211, 251,
-			38, 232, 37, 68, 59, 200, 156, 138, 125, 164, 92, 229, 87, 102,
-			23, 96, 49, 150, 17, 168, 213, 68, 64, 74, 108, 121, 65, 163,
-			169, 236, 144, 61, 195, 59, 232, 66, 105, 93, 205, 160, 197, 156,
-			74, 255, 132, 134, 28, 230, 84, 14, 30, 166, 247, 97, 53, 67,
-			204, 153, 177, 15, 148, 43, 169, 17, 202, 132, 121, 172, 135, 65,
-			61, 18, 137, 192, 39, 47, 68, 208, 208, 171, 128, 174, 100, 200,
-			133, 178, 186, 146, 33, 139, 57, 51, 255, 31, 123, 255, 2, 30,
-			199, 113, 29, 136, 194, 232, 199, 12, 129, 2, 8, 2, 77, 144,
-			0, 7, 148, 88, 26, 61, 8, 80, 131, 1, 1, 234, 97, 81,
-			15, 107, 8, 12, 201, 145, 32, 0, 30, 0, 86, 36, 69, 38,
-			27, 51, 61, 64, 47, 7, 221, 147, 238, 30, 66, 35, 173, 254,
-			127, 215, 155, 216, 215, 241, 58, 241, 107, 179, 246, 181, 157, 236,
-			141, 63, 191, 125, 189, 142, 227, 216, 217, 27, 95, 71, 235, 216,
-			201, 238, 58, 78, 190, 221, 108, 226, 181, 157, 216, 113, 94, 206,
-			218, 73, 236, 117, 226, 205, 250, 102, 243, 229, 126, 231, 156, 170,
-			234, 158, 7, 72, 73, 150, 28, 231, 46, 249, 217, 159, 80, 61,
-			221, 85, 167, 78, 157, 58, 117, 206, 169, 243, 80, 115, 217, 111,
-			88, 198, 204, 225, 9, 177, 247, 134, 45, 35, 175, 31, 201, 30,
-			227, 11, 192, 202, 249, 122, 96, 19, 167, 16, 3, 117, 0, 63,
-			156, 130, 215, 101, 191, 195, 154, 101, 228, 85, 191, 195, 134, 101,
-			228, 15, 79, 8, 224, 15, 88, 198, 44, 2, 15, 146, 218, 226,
-			25, 30, 56, 151, 41, 239, 230, 213, 137, 242, 64, 10, 190, 149,
-			204, 246, 128, 102, 25, 179, 253, 114, 144, 3, 134, 101, 204, 30,
-			158, 16, 123, 108, 196, 50, 230, 112, 143, 85, 108, 207, 247, 208,
-			201, 113, 11, 206, 12, 41, 140, 146, 97, 143, 238, 60, 179, 43,
-			15, 102, 213, 166, 30, 73, 193, 151, 114, 30, 35, 154, 101, 204,
-			41, 170, 28, 49, 44, 99, 238, 200, 36, 59, 137, 67, 140, 90,
-			198, 188, 62, 153, 189, 81, 96, 67, 244, 235, 4, 129, 159, 72,
-			126, 136, 153, 236, 100, 223, 163, 41, 248, 68, 246, 61, 170, 89,
-			198, 252, 128, 60, 29, 70, 13, 203, 152, 159, 200, 176, 251, 176,
-			111, 203, 50, 78, 233, 60, 59, 135, 74, 41, 136, 63, 158, 160,
-			28, 85, 77, 135, 176, 15, 140, 34, 121, 66, 201, 145, 172, 20,
-			116, 32, 17, 101, 105, 150, 113, 170, 95, 50, 35, 203, 176, 140,
-			83, 215, 29, 219, 76, 83, 154, 90, 246, 204, 131, 123, 217, 2,
-			59, 245, 205, 138, 29, 38, 21, 205, 236, 195, 108, 96, 5, 237,
-			182, 101, 167, 102, 221, 202, 6, 182, 237, 112, 251, 130, 93, 223,
-			242, 81, 105, 28, 158, 31, 206, 227, 153, 124, 222, 14, 183, 11,
-			245, 45, 191, 220, 191, 45, 254, 2, 189, 101, 219, 121, 226, 66,
-			213, 221, 114, 194, 8, 245, 200, 129, 242, 192, 182, 243, 196, 34,
-			62, 200, 94, 98, 7, 207, 57, 17, 245, 189, 81, 94, 42, 211,
-			100, 173, 227, 44, 77, 118, 98, 236, 127, 112, 254, 0, 245, 175,
-			96, 40, 139, 159, 173, 91, 217, 104, 213, 223, 245, 234, 190, 93,
-			197, 146, 234, 158, 189, 227, 136, 81, 70, 228, 15, 103, 197, 243,
-			236, 9, 57, 139, 141, 242, 18, 0, 70, 254, 126, 23, 154, 65,
-			93, 232, 190, 3, 244, 100, 35, 168, 103, 255, 17, 179, 206, 56,
-			91, 174, 183, 209, 128, 46, 158, 7, 92, 9, 28, 233, 87, 198,
-			81, 182, 201, 14, 158, 117, 61, 55, 220, 110, 31, 44, 207, 14,
-			54, 241, 193, 5, 191, 33, 170, 125, 128, 238, 71, 160, 142, 210,
-			79, 43, 242, 151, 82, 213, 202, 51, 134, 53, 75, 46, 64, 199,
-			56, 104, 15, 0, 7, 240, 21, 0, 34, 91, 100, 7, 23, 64,
-			40, 168, 127, 79, 195, 102, 63, 167, 177, 3, 27, 237, 79, 65,
-			147, 236, 241, 241, 160, 159, 128, 22, 244, 102, 26, 6, 240, 47,
-			8, 131, 158, 108, 4, 117, 235, 4, 75, 135, 145, 29, 53, 67,
-			52, 39, 12, 207, 91, 52, 17, 26, 104, 13, 127, 41, 139, 55,
-			18, 171, 98, 94, 121, 85, 110, 100, 251, 113, 215, 94, 16, 187,
-			86, 24, 29, 134, 240, 161, 168, 99, 115, 226, 110, 214, 47, 215,
-			200, 58, 194, 14, 157, 47, 172, 157, 191, 80, 88, 58, 183, 114,
-			97, 99, 121, 109, 181, 184, 80, 58, 91, 42, 46, 142, 244, 89,
-			253, 204, 92, 59, 95, 152, 27, 209, 44, 198, 210, 107, 231, 11,
-			243, 183, 223, 49, 162, 159, 104, 178, 161, 36, 136, 214, 117, 236,
-			200, 198, 234, 210, 74, 97, 241, 194, 218, 122, 97, 125, 99, 173,
-			163, 147, 253, 108, 128, 126, 46, 45, 159, 27, 209, 160, 249, 242,
-			98, 185, 116, 246, 17, 104, 234, 208, 92, 221, 56, 179, 84, 90,
-			59, 95, 92, 28, 49, 172, 65, 182, 175, 88, 46, 175, 148, 139,
-			139, 35, 166, 53, 196, 250, 23, 10, 203, 11, 197, 165, 226, 226,
-			72, 106, 254, 53, 58, 219, 39, 228, 116, 235, 52, 27, 74, 110,
-			41, 235, 8, 97, 163, 199, 54, 203, 180, 33, 10, 222, 189, 143,
-			13, 38, 168, 222, 154, 160, 223, 187, 55, 66, 230, 80, 114, 57,
-			226, 117, 191, 159, 13, 37, 41, 89, 142, 221, 131, 186, 175, 208,
-			67, 146, 40, 101, 15, 61, 8, 117, 143, 30, 158, 155, 209, 225,
-			93, 5, 182, 207, 74, 13, 247, 125, 69, 187, 146, 213, 97, 238,
-			206, 107, 86, 135, 107, 86, 135, 23, 198, 234, 240, 93, 131, 233,
-			233, 62, 203, 60, 214, 247, 168, 150, 249, 51, 131, 23, 159, 104,
-			96, 126, 81, 225, 186, 53, 35, 196, 94, 196, 113, 40, 84, 111,
-			148, 39, 69, 28, 123, 148, 40, 200, 39, 125, 6, 176, 188, 86,
-			155, 74, 38, 21, 190, 144, 79, 81, 78, 100, 222, 216, 110, 133,
-			40, 29, 81, 72, 174, 76, 73, 225, 86, 85, 233, 93, 55, 100,
-			114, 196, 156, 42, 29, 47, 59, 12, 148, 186, 205, 81, 83, 162,
-			74, 17, 152, 150, 23, 35, 189, 148, 147, 3, 165, 152, 16, 85,
-			179, 220, 128, 211, 201, 15, 64, 62, 228, 135, 202, 209, 92, 26,
-			21, 164, 238, 80, 88, 45, 169, 104, 32, 153, 18, 163, 222, 146,
-			49, 228, 82, 194, 69, 252, 183, 7, 11, 196, 230, 1, 242, 24,
-			43, 44, 44, 201, 148, 244, 232, 229, 185, 227, 122, 210, 183, 144,
-			246, 20, 93, 77, 48, 158, 228, 73, 194, 191, 63, 164, 180, 110,
-			194, 17, 75, 228, 207, 172, 186, 129, 131, 46, 179, 155, 45, 94,
-			17, 145, 252, 232, 124, 224, 97, 190, 92, 198, 233, 204, 226, 234,
-			108, 19, 190, 175, 113, 4, 188, 128, 62, 143, 166, 142, 116, 31,
-			26, 14, 14, 176, 31, 213, 152, 153, 70, 91, 199, 141, 250, 61,
-			153, 93, 190, 74, 1, 231, 228, 196, 136, 113, 8, 27, 229, 37,
-			2, 76, 20, 84, 144, 82, 115, 205, 137, 42, 219, 24, 122, 36,
-			175, 210, 121, 25, 131, 218, 67, 190, 188, 178, 126, 225, 236, 202,
-			198, 242, 34, 167, 163, 81, 68, 11, 214, 200, 3, 235, 56, 102,
-			20, 197, 50, 56, 234, 211, 33, 150, 74, 147, 129, 228, 198, 180,
-			37, 91, 186, 101, 220, 120, 240, 22, 217, 50, 44, 227, 198, 185,
-			211, 236, 153, 126, 4, 88, 179, 140, 211, 250, 253, 153, 143, 244,
-			115, 74, 26, 28, 57, 152, 41, 190, 19, 11, 228, 158, 72, 228,
-			32, 127, 84, 9, 194, 165, 123, 6, 225, 147, 82, 249, 163, 84,
-			141, 230, 39, 216, 149, 244, 133, 144, 129, 153, 0, 55, 246, 177,
-			222, 105, 248, 129, 29, 180, 98, 175, 240, 41, 85, 4, 240, 178,
-			107, 243, 227, 177, 100, 113, 92, 56, 177, 170, 43, 122, 81, 182,
-			211, 78, 66, 13, 152, 118, 61, 145, 148, 69, 209, 102, 217, 9,
-			155, 59, 184, 15, 5, 149, 200, 132, 219, 211, 68, 136, 53, 164,
-			32, 41, 167, 139, 174, 68, 69, 53, 204, 22, 29, 249, 109, 84,
-			150, 75, 70, 51, 213, 237, 166, 87, 217, 102, 50, 3, 77, 156,
-			146, 85, 108, 16, 154, 241, 113, 244, 218, 222, 150, 169, 113, 48,
-			129, 76, 128, 105, 56, 0, 191, 165, 90, 18, 139, 151, 60, 127,
-			151, 216, 3, 125, 146, 236, 7, 83, 225, 216, 130, 116, 5, 164,
-			34, 49, 76, 14, 235, 3, 3, 137, 9, 20, 194, 187, 136, 68,
-			1, 130, 172, 255, 80, 242, 36, 53, 134, 49, 142, 40, 58, 176,
-			81, 111, 113, 60, 43, 25, 183, 119, 237, 150, 224, 87, 49, 157,
-			169, 36, 52, 206, 19, 110, 24, 133, 176, 49, 2, 39, 106, 34,
-			127, 45, 44, 149, 139, 133, 197, 71, 46, 20, 127, 168, 180, 182,
-			190, 38, 232, 150, 33, 225, 118, 207, 81, 198, 223, 192, 92, 227,
-			169, 182, 156, 136, 79, 53, 156, 96, 219, 110, 132, 50, 35, 181,
-			194, 223, 166, 95, 109, 97, 138, 224, 216, 213, 67, 96, 179, 86,
-			111, 77, 195, 236, 69, 13, 168, 10, 22, 8, 164, 52, 195, 157,
-			212, 76, 193, 180, 176, 173, 153, 72, 185, 43, 151, 29, 152, 161,
-			40, 161, 42, 88, 66, 7, 65, 36, 249, 215, 46, 141, 83, 175,
-			160, 201, 151, 156, 216, 101, 74, 109, 82, 45, 155, 117, 116, 181,
-			233, 94, 65, 228, 242, 30, 239, 16, 118, 218, 178, 89, 36, 179,
-			229, 8, 223, 179, 80, 186, 81, 227, 138, 108, 5, 182, 23, 49,
-			193, 209, 20, 25, 144, 165, 58, 242, 249, 238, 182, 143, 65, 152,
-			13, 63, 12, 157, 80, 148, 168, 216, 129, 195, 28, 145, 164, 124,
-			92, 98, 135, 107, 172, 145, 226, 84, 2, 39, 162, 36, 37, 157,
-			208, 193, 78, 198, 216, 40, 215, 171, 249, 138, 217, 104, 192, 66,
-			210, 163, 178, 165, 91, 198, 105, 235, 38, 217, 50, 44, 227, 244,
-			236, 125, 236, 99, 41, 100, 54, 186, 101, 60, 168, 159, 201, 188,
-			55, 37, 54, 146, 60, 255, 132, 83, 79, 231, 50, 229, 4, 62,
-			200, 165, 52, 196, 74, 132, 68, 80, 128, 62, 153, 135, 197, 198,
-			188, 235, 13, 233, 188, 45, 246, 241, 222, 187, 95, 229, 217, 71,
-			23, 101, 229, 9, 46, 179, 167, 39, 183, 120, 158, 159, 181, 221,
-			122, 51, 192, 75, 248, 170, 15, 168, 19, 107, 46, 107, 121, 16,
-			200, 44, 65, 90, 88, 1, 167, 202, 155, 88, 236, 12, 5, 124,
-			132, 217, 81, 247, 6, 200, 75, 220, 100, 90, 31, 225, 209, 136,
-			5, 107, 212, 68, 112, 111, 138, 41, 38, 92, 219, 125, 132, 146,
-			225, 235, 72, 80, 45, 21, 3, 177, 107, 187, 81, 91, 114, 58,
-			10, 242, 69, 118, 212, 234, 226, 36, 4, 185, 83, 101, 87, 60,
-			119, 196, 246, 83, 236, 184, 107, 39, 201, 45, 140, 172, 0, 61,
-			185, 65, 249, 10, 133, 211, 149, 10, 43, 144, 163, 145, 56, 140,
-			155, 216, 166, 242, 169, 59, 77, 143, 234, 167, 170, 4, 91, 199,
-			105, 141, 143, 179, 246, 66, 150, 221, 212, 65, 86, 125, 196, 129,
-			172, 243, 169, 142, 247, 182, 241, 129, 95, 128, 100, 1, 168, 39,
-			135, 206, 192, 150, 245, 30, 122, 117, 45, 226, 160, 21, 137, 195,
-			41, 249, 160, 58, 79, 145, 140, 213, 121, 170, 27, 150, 241, 224,
-			220, 253, 236, 127, 24, 72, 226, 134, 101, 60, 162, 159, 201, 124,
-			221, 224, 73, 29, 135, 219, 155, 126, 16, 93, 153, 218, 145, 61,
-			70, 124, 199, 191, 236, 132, 84, 107, 136, 75, 149, 144, 40, 8,
-			22, 3, 25, 63, 154, 153, 149, 162, 41, 126, 196, 8, 154, 58,
-			86, 245, 104, 54, 24, 166, 165, 143, 15, 214, 45, 59, 216, 196,
-			220, 67, 114, 133, 35, 17, 5, 204, 3, 167, 130, 206, 171, 216,
-			71, 27, 166, 147, 68, 61, 165, 178, 138, 184, 145, 8, 0, 198,
-			16, 243, 69, 17, 124, 138, 199, 166, 100, 213, 49, 22, 67, 117,
-			82, 84, 16, 25, 192, 172, 3, 94, 179, 221, 58, 229, 157, 23,
-			208, 96, 72, 215, 243, 167, 57, 206, 249, 217, 66, 105, 169, 184,
-			120, 97, 181, 92, 84, 122, 132, 236, 166, 123, 109, 17, 125, 74,
-			19, 7, 136, 148, 150, 46, 244, 42, 194, 6, 69, 193, 19, 59,
-			33, 200, 55, 29, 202, 255, 77, 178, 98, 149, 123, 254, 238, 180,
-			34, 18, 67, 179, 140, 71, 20, 145, 24, 186, 101, 60, 162, 136,
-			196, 0, 178, 152, 187, 159, 189, 90, 103, 122, 170, 207, 50, 171,
-			125, 219, 90, 230, 125, 26, 95, 83, 238, 105, 176, 53, 209, 69,
-			189, 190, 229, 7, 110, 180, 189, 35, 164, 103, 149, 118, 105, 79,
-			117, 130, 84, 215, 200, 9, 236, 186, 200, 85, 140, 161, 22, 232,
-			106, 99, 123, 81, 215, 54, 145, 114, 39, 150, 179, 0, 169, 191,
-			131, 85, 50, 116, 86, 13, 19, 114, 123, 158, 205, 175, 204, 190,
-			160, 255, 80, 118, 78, 129, 152, 90, 77, 237, 103, 131, 204, 76,
-			161, 232, 236, 232, 71, 1, 97, 41, 146, 96, 29, 125, 92, 182,
-			116, 203, 112, 50, 147, 226, 69, 205, 50, 106, 234, 69, 13, 91,
-			105, 217, 210, 45, 163, 166, 94, 212, 45, 99, 75, 189, 8, 159,
-			109, 145, 13, 55, 69, 123, 120, 43, 51, 201, 30, 160, 139, 226,
-			122, 159, 175, 101, 238, 227, 133, 164, 222, 227, 199, 50, 185, 188,
-			214, 185, 242, 42, 200, 155, 207, 122, 255, 40, 38, 112, 199, 41,
-			237, 232, 71, 178, 243, 24, 90, 35, 23, 86, 20, 233, 81, 172,
-			17, 153, 33, 150, 213, 164, 4, 27, 176, 134, 234, 222, 51, 13,
-			61, 176, 196, 189, 231, 206, 224, 88, 226, 222, 115, 103, 124, 66,
-			92, 178, 106, 150, 225, 233, 71, 196, 37, 171, 39, 252, 164, 65,
-			251, 9, 80, 192, 219, 118, 158, 80, 85, 180, 208, 118, 126, 220,
-			222, 172, 84, 157, 90, 62, 159, 63, 158, 188, 17, 245, 148, 149,
-			27, 16, 235, 169, 155, 0, 56, 200, 189, 241, 9, 244, 99, 211,
-			44, 51, 232, 123, 146, 252, 216, 224, 173, 160, 127, 146, 45, 48,
-			19, 235, 50, 25, 145, 62, 158, 185, 163, 19, 145, 9, 201, 53,
-			33, 253, 41, 9, 22, 53, 32, 225, 180, 166, 225, 156, 35, 125,
-			80, 182, 52, 203, 136, 134, 44, 217, 50, 44, 35, 58, 116, 152,
-			253, 130, 134, 227, 105, 150, 209, 210, 143, 101, 222, 163, 129, 92,
-			41, 34, 232, 114, 66, 246, 18, 130, 20, 232, 2, 42, 150, 137,
-			18, 54, 162, 76, 191, 32, 150, 114, 209, 13, 81, 19, 6, 206,
-			32, 46, 143, 132, 250, 72, 177, 74, 162, 176, 151, 123, 25, 37,
-			66, 178, 60, 231, 145, 79, 219, 151, 156, 144, 111, 6, 254, 110,
-			232, 4, 33, 15, 85, 170, 18, 56, 224, 148, 33, 129, 137, 79,
-			241, 51, 57, 67, 64, 116, 75, 32, 26, 203, 74, 25, 173, 129,
-			140, 108, 25, 150, 209, 186, 238, 122, 54, 200, 116, 83, 183, 82,
-			79, 247, 189, 74, 35, 76, 195, 100, 159, 238, 31, 101, 191, 160,
-			51, 211, 212, 245, 62, 203, 252, 49, 77, 159, 200, 188, 91, 231,
-			5, 169, 97, 158, 95, 95, 95, 93, 35, 61, 211, 239, 150, 154,
-			209, 83, 0, 48, 45, 34, 116, 226, 180, 121, 84, 187, 198, 110,
-			70, 219, 142, 23, 9, 197, 37, 207, 139, 79, 52, 220, 0, 184,
-			9, 198, 18, 98, 76, 66, 211, 19, 166, 21, 12, 146, 216, 246,
-			131, 136, 219, 59, 126, 211, 35, 59, 128, 43, 48, 211, 45, 198,
-			224, 93, 159, 16, 99, 64, 114, 2, 225, 101, 103, 199, 169, 130,
-			234, 88, 111, 201, 194, 233, 168, 182, 33, 72, 91, 77, 27, 100,
-			91, 97, 137, 82, 165, 202, 219, 82, 144, 136, 60, 155, 202, 112,
-			33, 11, 32, 96, 72, 163, 179, 211, 192, 3, 20, 147, 223, 81,
-			53, 163, 253, 44, 5, 104, 75, 33, 222, 250, 101, 83, 131, 230,
-			192, 65, 217, 52, 160, 121, 120, 156, 61, 206, 244, 148, 102, 165,
-			255, 185, 214, 247, 102, 77, 123, 225, 153, 223, 32, 51, 82, 154,
-			102, 153, 255, 92, 75, 129, 220, 108, 166, 176, 164, 217, 235, 52,
-			29, 99, 47, 82, 84, 183, 236, 117, 154, 62, 41, 155, 58, 52,
-			175, 63, 198, 242, 248, 174, 102, 153, 63, 169, 233, 35, 89, 174,
-			84, 22, 138, 68, 143, 165, 10, 58, 155, 152, 248, 92, 163, 15,
-			6, 101, 83, 135, 230, 240, 1, 118, 39, 246, 166, 91, 230, 235,
-			161, 183, 233, 30, 138, 170, 234, 86, 86, 51, 73, 118, 11, 112,
-			188, 62, 238, 150, 58, 26, 62, 192, 94, 130, 221, 26, 150, 249,
-			6, 232, 246, 68, 114, 247, 111, 219, 161, 168, 61, 211, 220, 172,
-			211, 177, 42, 88, 235, 66, 97, 77, 246, 107, 104, 248, 169, 236,
-			215, 208, 161, 57, 124, 0, 120, 106, 74, 211, 77, 203, 124, 35,
-			244, 59, 39, 194, 208, 48, 43, 106, 205, 142, 236, 58, 93, 225,
-			169, 156, 102, 180, 25, 61, 149, 244, 85, 118, 15, 252, 235, 141,
-			154, 62, 32, 155, 58, 52, 135, 15, 176, 187, 177, 251, 148, 101,
-			190, 9, 186, 159, 233, 144, 101, 64, 234, 82, 66, 12, 232, 211,
-			73, 241, 78, 118, 157, 210, 240, 107, 213, 212, 161, 137, 101, 176,
-			117, 211, 176, 210, 255, 82, 235, 251, 63, 53, 202, 174, 11, 115,
-			252, 151, 90, 127, 134, 253, 51, 147, 153, 166, 1, 203, 255, 78,
-			77, 31, 207, 252, 149, 241, 28, 121, 103, 163, 169, 78, 39, 101,
-			236, 147, 14, 15, 66, 225, 222, 91, 115, 207, 161, 84, 140, 219,
-			82, 104, 77, 200, 27, 247, 208, 225, 201, 124, 110, 239, 218, 45,
-			165, 201, 39, 139, 143, 201, 18, 81, 83, 174, 71, 70, 133, 103,
-			163, 232, 79, 231, 88, 242, 189, 135, 54, 214, 214, 209, 103, 191,
-			222, 226, 234, 194, 141, 134, 200, 193, 108, 235, 226, 140, 148, 26,
-			56, 246, 183, 139, 81, 30, 93, 2, 84, 66, 203, 149, 254, 211,
-			82, 87, 71, 215, 141, 36, 161, 75, 22, 164, 148, 121, 18, 200,
-			18, 1, 130, 82, 47, 183, 43, 151, 228, 170, 8, 152, 237, 80,
-			230, 220, 96, 93, 26, 243, 84, 187, 213, 101, 26, 196, 185, 54,
-			75, 37, 176, 70, 193, 156, 12, 140, 199, 122, 167, 32, 124, 104,
-			106, 208, 28, 178, 100, 211, 128, 230, 161, 195, 236, 239, 52, 36,
-			25, 205, 50, 63, 168, 233, 71, 50, 223, 210, 120, 33, 41, 94,
-			8, 127, 113, 208, 88, 157, 192, 189, 156, 216, 210, 40, 22, 136,
-			221, 65, 124, 34, 140, 139, 255, 225, 86, 97, 61, 141, 77, 113,
-			189, 94, 69, 86, 42, 37, 155, 91, 139, 45, 75, 24, 166, 140,
-			135, 176, 32, 16, 204, 40, 163, 126, 6, 13, 229, 184, 90, 213,
-			227, 148, 51, 64, 30, 218, 226, 173, 124, 226, 119, 36, 134, 29,
-			59, 170, 108, 179, 228, 119, 10, 93, 90, 26, 49, 160, 154, 136,
-			144, 193, 49, 217, 52, 160, 57, 78, 169, 173, 77, 43, 253, 97,
-			173, 239, 255, 22, 155, 15, 56, 192, 135, 181, 254, 73, 246, 50,
-			102, 154, 38, 236, 189, 143, 104, 250, 13, 153, 5, 44, 26, 214,
-			230, 152, 209, 203, 118, 148, 180, 209, 36, 174, 210, 96, 43, 9,
-			208, 76, 60, 102, 62, 34, 143, 25, 19, 87, 242, 35, 218, 192,
-			81, 217, 52, 160, 121, 140, 179, 255, 174, 35, 0, 154, 101, 126,
-			66, 211, 39, 51, 95, 211, 113, 187, 58, 81, 46, 81, 187, 89,
-			26, 48, 34, 159, 135, 151, 220, 70, 188, 123, 218, 204, 140, 128,
-			91, 52, 220, 83, 226, 6, 18, 61, 212, 89, 144, 224, 190, 177,
-			52, 19, 211, 125, 34, 117, 87, 183, 177, 94, 154, 244, 201, 194,
-			234, 55, 34, 119, 39, 153, 69, 27, 118, 58, 81, 17, 218, 36,
-			162, 160, 25, 10, 61, 87, 141, 142, 7, 212, 84, 45, 153, 202,
-			69, 86, 113, 148, 150, 228, 72, 104, 220, 211, 20, 11, 46, 206,
-			118, 41, 55, 0, 161, 84, 29, 207, 69, 175, 74, 244, 173, 75,
-			242, 158, 60, 47, 196, 7, 190, 144, 46, 200, 244, 70, 249, 90,
-			87, 139, 229, 135, 74, 107, 107, 165, 149, 229, 11, 139, 197, 229,
-			82, 113, 81, 173, 18, 16, 208, 39, 228, 126, 51, 145, 128, 62,
-			161, 13, 29, 150, 77, 3, 154, 71, 50, 72, 64, 41, 43, 253,
-			140, 214, 247, 43, 130, 128, 128, 207, 63, 35, 9, 40, 133, 233,
-			120, 94, 80, 2, 74, 81, 22, 17, 73, 64, 41, 202, 33, 34,
-			9, 40, 69, 25, 68, 128, 128, 0, 180, 180, 149, 254, 85, 13,
-			111, 60, 1, 180, 180, 102, 153, 191, 170, 245, 143, 179, 159, 5,
-			46, 145, 6, 216, 62, 171, 233, 153, 204, 155, 144, 75, 248, 13,
-			251, 71, 154, 142, 140, 167, 38, 57, 55, 25, 95, 7, 100, 208,
-			219, 44, 97, 3, 45, 98, 78, 134, 216, 170, 32, 253, 125, 164,
-			225, 235, 146, 67, 52, 209, 198, 225, 96, 86, 124, 61, 112, 48,
-			227, 18, 19, 105, 29, 200, 200, 72, 147, 77, 227, 100, 63, 43,
-			39, 155, 198, 201, 126, 86, 27, 56, 36, 155, 6, 52, 39, 142,
-			176, 47, 209, 140, 52, 203, 252, 79, 32, 249, 254, 186, 38, 174,
-			84, 20, 39, 78, 112, 251, 231, 96, 254, 167, 218, 188, 177, 117,
-			183, 75, 104, 94, 246, 59, 100, 99, 186, 225, 16, 215, 168, 177,
-			5, 30, 233, 92, 216, 155, 0, 52, 105, 106, 181, 235, 161, 175,
-			138, 185, 117, 101, 184, 104, 71, 133, 150, 194, 217, 73, 84, 104,
-			56, 89, 33, 159, 166, 145, 36, 255, 19, 200, 167, 179, 136, 9,
-			221, 50, 127, 27, 214, 246, 6, 78, 238, 9, 123, 89, 202, 84,
-			239, 152, 240, 90, 211, 135, 101, 83, 131, 230, 1, 137, 104, 76,
-			120, 13, 136, 126, 134, 16, 109, 88, 230, 151, 64, 38, 249, 57,
-			13, 179, 72, 196, 22, 19, 18, 8, 164, 162, 213, 33, 171, 196,
-			146, 93, 108, 87, 148, 44, 198, 245, 146, 50, 188, 72, 133, 25,
-			223, 15, 37, 78, 163, 61, 143, 33, 70, 103, 16, 111, 122, 145,
-			91, 239, 184, 141, 146, 166, 26, 53, 95, 35, 141, 83, 24, 148,
-			77, 13, 154, 226, 64, 77, 99, 80, 229, 151, 224, 64, 93, 192,
-			233, 154, 150, 249, 101, 224, 194, 183, 227, 108, 133, 107, 134, 154,
-			171, 205, 183, 155, 59, 88, 156, 220, 174, 34, 21, 181, 249, 139,
-			169, 17, 205, 20, 246, 34, 215, 15, 78, 154, 47, 107, 3, 135,
-			101, 211, 128, 230, 145, 140, 114, 225, 250, 187, 81, 54, 217, 25,
-			147, 137, 137, 68, 132, 187, 214, 129, 142, 200, 202, 236, 62, 150,
-			42, 194, 239, 103, 158, 102, 7, 43, 254, 78, 103, 228, 229, 25,
-			134, 191, 98, 234, 166, 85, 237, 81, 249, 243, 150, 95, 183, 189,
-			45, 116, 14, 80, 195, 160, 187, 248, 44, 34, 147, 134, 108, 108,
-			254, 15, 77, 251, 89, 221, 56, 183, 122, 230, 253, 250, 245, 180,
-			123, 242, 171, 50, 164, 243, 97, 167, 94, 127, 16, 94, 94, 135,
-			239, 30, 248, 228, 8, 75, 91, 230, 245, 125, 243, 35, 236, 215,
-			135, 208, 207, 226, 250, 62, 107, 254, 223, 14, 169, 82, 3, 178,
-			206, 0, 159, 137, 139, 35, 144, 166, 2, 39, 76, 101, 219, 246,
-			182, 28, 17, 221, 205, 218, 156, 51, 78, 190, 68, 238, 220, 146,
-			87, 201, 115, 188, 11, 199, 223, 66, 85, 114, 33, 31, 123, 171,
-			87, 157, 203, 78, 29, 8, 61, 76, 186, 172, 203, 173, 61, 35,
-			156, 238, 103, 209, 214, 168, 92, 19, 228, 129, 41, 24, 133, 8,
-			195, 134, 39, 155, 174, 7, 140, 13, 224, 146, 215, 204, 126, 32,
-			147, 57, 50, 190, 227, 87, 213, 145, 155, 35, 249, 69, 149, 77,
-			87, 230, 74, 85, 208, 55, 78, 90, 90, 241, 61, 42, 199, 43,
-			18, 96, 238, 56, 145, 136, 136, 227, 39, 58, 0, 11, 59, 179,
-			210, 238, 208, 117, 124, 36, 75, 229, 82, 58, 173, 138, 196, 24,
-			131, 77, 129, 85, 104, 144, 237, 212, 93, 186, 148, 79, 142, 40,
-			174, 254, 99, 112, 170, 110, 88, 169, 219, 238, 142, 67, 105, 81,
-			122, 1, 225, 122, 73, 92, 72, 32, 68, 22, 245, 24, 14, 22,
-			3, 242, 61, 193, 33, 19, 171, 119, 228, 191, 181, 189, 234, 172,
-			31, 136, 212, 50, 59, 118, 228, 4, 174, 93, 143, 115, 225, 40,
-			15, 154, 132, 95, 138, 76, 220, 10, 147, 90, 22, 206, 35, 81,
-			34, 19, 123, 146, 182, 60, 63, 254, 45, 20, 41, 20, 24, 26,
-			249, 176, 43, 208, 37, 69, 178, 75, 105, 51, 117, 188, 170, 31,
-			132, 142, 200, 40, 191, 227, 71, 142, 200, 44, 31, 133, 66, 218,
-			174, 170, 12, 120, 110, 168, 252, 102, 84, 58, 80, 229, 153, 210,
-			8, 92, 32, 172, 0, 104, 199, 75, 56, 165, 32, 183, 60, 95,
-			90, 227, 107, 43, 103, 215, 31, 46, 148, 139, 188, 180, 198, 87,
-			203, 43, 47, 47, 45, 22, 23, 249, 153, 71, 248, 250, 249, 34,
-			95, 88, 89, 125, 164, 92, 58, 119, 126, 157, 159, 95, 89, 90,
-			44, 150, 215, 120, 97, 121, 145, 47, 172, 44, 175, 151, 75, 103,
-			54, 214, 87, 202, 107, 76, 121, 221, 192, 47, 133, 229, 71, 120,
-			241, 135, 86, 203, 197, 53, 116, 181, 41, 61, 180, 186, 84, 42,
-			46, 38, 28, 112, 114, 188, 180, 188, 176, 180, 177, 88, 90, 62,
-			151, 227, 103, 54, 214, 249, 242, 202, 58, 227, 75, 165, 135, 74,
-			235, 197, 69, 190, 190, 146, 195, 97, 187, 191, 227, 43, 103, 249,
-			67, 197, 242, 194, 249, 194, 242, 122, 225, 76, 105, 169, 180, 254,
-			8, 14, 120, 182, 180, 190, 12, 131, 157, 93, 41, 51, 94, 224,
-			171, 133, 242, 122, 105, 97, 99, 169, 80, 230, 171, 27, 229, 213,
-			149, 181, 34, 135, 153, 45, 150, 214, 22, 150, 10, 165, 135, 138,
-			139, 121, 94, 90, 230, 203, 43, 188, 248, 242, 226, 242, 58, 95,
-			59, 95, 88, 90, 106, 159, 40, 227, 43, 15, 47, 23, 203, 194,
-			81, 72, 77, 147, 159, 41, 242, 165, 82, 225, 204, 82, 17, 134,
-			194, 121, 46, 150, 202, 197, 133, 117, 152, 80, 252, 215, 66, 105,
-			177, 184, 188, 94, 88, 202, 49, 142, 222, 122, 133, 165, 28, 47,
-			254, 80, 241, 161, 213, 165, 66, 249, 145, 156, 232, 116, 173, 248,
-			178, 141, 226, 242, 122, 169, 176, 196, 23, 11, 15, 21, 206, 21,
-			215, 248, 212, 213, 176, 178, 90, 94, 89, 216, 40, 23, 31, 2,
-			168, 87, 206, 242, 181, 141, 51, 107, 235, 165, 245, 141, 245, 34,
-			63, 183, 178, 178, 136, 200, 94, 43, 150, 95, 94, 90, 40, 174,
-			221, 205, 151, 86, 214, 16, 97, 27, 107, 197, 28, 227, 139, 133,
-			245, 2, 14, 189, 90, 94, 57, 91, 90, 95, 187, 27, 254, 62,
-			179, 177, 86, 66, 196, 149, 150, 215, 139, 229, 242, 198, 234, 122,
-			105, 101, 121, 154, 159, 95, 121, 184, 248, 242, 98, 153, 47, 20,
-			54, 214, 138, 139, 136, 225, 149, 101, 152, 45, 208, 74, 113, 165,
-			252, 8, 116, 11, 120, 192, 21, 200, 241, 135, 207, 23, 215, 207,
-			23, 203, 128, 84, 196, 86, 1, 208, 176, 182, 94, 46, 45, 172,
-			39, 95, 91, 41, 243, 245, 149, 242, 58, 75, 204, 147, 47, 23,
-			207, 45, 149, 206, 21, 151, 23, 138, 240, 243, 10, 116, 243, 112,
-			105, 173, 56, 205, 11, 229, 210, 26, 188, 80, 194, 129, 249, 195,
-			133, 71, 248, 202, 6, 206, 26, 22, 106, 99, 173, 200, 232, 239,
-			4, 233, 230, 112, 61, 121, 233, 44, 47, 44, 190, 188, 4, 144,
-			139, 183, 87, 87, 214, 214, 74, 130, 92, 16, 109, 11, 231, 5,
-			206, 149, 191, 21, 239, 155, 16, 254, 86, 217, 190, 187, 209, 223,
-			234, 102, 250, 147, 30, 222, 216, 87, 148, 78, 88, 240, 39, 61,
-			188, 169, 47, 135, 15, 53, 250, 147, 30, 222, 220, 119, 43, 62,
-			20, 127, 210, 195, 91, 250, 178, 248, 144, 209, 159, 244, 240, 120,
-			223, 13, 248, 240, 38, 250, 147, 30, 78, 245, 29, 195, 135, 199,
-			232, 207, 95, 162, 192, 50, 99, 190, 111, 36, 243, 175, 117, 94,
-			32, 47, 4, 183, 34, 178, 127, 73, 239, 113, 60, 2, 90, 126,
-			19, 157, 16, 2, 103, 70, 232, 227, 246, 101, 223, 149, 25, 166,
-			129, 253, 53, 209, 251, 14, 51, 206, 180, 125, 143, 236, 183, 229,
-			55, 3, 94, 88, 45, 133, 121, 94, 144, 153, 239, 218, 18, 245,
-			42, 101, 199, 14, 219, 252, 250, 185, 224, 106, 202, 179, 29, 67,
-			159, 73, 23, 41, 172, 150, 132, 75, 65, 30, 165, 28, 233, 87,
-			38, 79, 35, 169, 236, 37, 178, 132, 115, 30, 52, 42, 252, 140,
-			29, 76, 117, 102, 121, 64, 81, 99, 90, 168, 51, 33, 223, 227,
-			119, 138, 178, 230, 79, 179, 248, 130, 100, 190, 127, 127, 44, 1,
-			101, 217, 209, 78, 9, 136, 84, 223, 189, 68, 160, 183, 104, 44,
-			189, 134, 111, 88, 119, 179, 52, 21, 227, 157, 208, 184, 49, 53,
-			56, 127, 99, 87, 38, 10, 122, 145, 18, 78, 132, 24, 253, 87,
-			22, 159, 100, 94, 198, 6, 19, 143, 173, 17, 102, 92, 114, 90,
-			194, 181, 25, 254, 180, 114, 44, 133, 65, 38, 194, 247, 250, 112,
-			87, 231, 47, 135, 95, 203, 244, 210, 105, 253, 37, 90, 246, 35,
-			58, 75, 225, 67, 235, 110, 198, 188, 102, 189, 126, 129, 58, 32,
-			175, 250, 76, 87, 7, 203, 205, 122, 29, 223, 63, 223, 87, 30,
-			240, 100, 195, 186, 145, 13, 81, 18, 196, 11, 241, 248, 218, 249,
-			190, 242, 32, 61, 85, 47, 145, 26, 39, 94, 194, 48, 109, 120,
-			137, 158, 210, 75, 199, 24, 219, 244, 125, 9, 134, 201, 181, 169,
-			126, 24, 10, 158, 209, 11, 247, 96, 47, 205, 74, 36, 94, 73,
-			225, 84, 199, 247, 192, 163, 232, 190, 89, 137, 212, 44, 225, 160,
-			23, 223, 166, 241, 219, 238, 89, 46, 185, 97, 164, 102, 89, 151,
-			141, 51, 105, 102, 94, 114, 189, 106, 246, 110, 54, 160, 222, 176,
-			242, 44, 77, 133, 22, 197, 138, 238, 133, 116, 241, 214, 137, 73,
-			54, 160, 144, 104, 13, 51, 182, 188, 177, 180, 116, 225, 229, 133,
-			165, 141, 226, 72, 223, 153, 255, 127, 111, 25, 121, 144, 38, 35,
-			133, 228, 217, 103, 41, 36, 211, 196, 159, 147, 148, 252, 191, 95,
-			71, 82, 242, 43, 180, 107, 82, 242, 53, 41, 249, 154, 148, 124,
-			77, 74, 190, 38, 37, 95, 147, 146, 191, 143, 82, 178, 18, 94,
-			225, 79, 41, 37, 159, 149, 162, 51, 252, 41, 165, 100, 37, 58,
-			223, 172, 68, 231, 91, 196, 67, 241, 167, 148, 146, 149, 232, 124,
-			92, 137, 206, 83, 177, 232, 12, 127, 254, 188, 65, 94, 53, 243,
-			125, 183, 107, 153, 119, 27, 252, 34, 29, 184, 23, 59, 234, 155,
-			201, 155, 123, 97, 57, 21, 165, 156, 43, 190, 23, 186, 161, 200,
-			80, 71, 22, 254, 144, 9, 87, 235, 29, 187, 129, 247, 90, 45,
-			207, 222, 17, 153, 160, 225, 132, 174, 202, 226, 204, 188, 228, 145,
-			163, 130, 12, 252, 8, 115, 106, 112, 198, 119, 144, 1, 111, 58,
-			137, 164, 96, 155, 45, 110, 115, 207, 198, 188, 197, 10, 56, 225,
-			255, 208, 150, 123, 222, 245, 24, 167, 84, 105, 88, 132, 70, 118,
-			143, 245, 117, 248, 3, 107, 106, 58, 100, 26, 22, 29, 73, 191,
-			116, 229, 98, 137, 119, 140, 85, 56, 165, 234, 194, 88, 107, 71,
-			29, 227, 138, 203, 14, 202, 137, 9, 220, 116, 203, 161, 4, 202,
-			42, 90, 136, 178, 37, 138, 57, 40, 127, 79, 9, 146, 188, 201,
-			124, 96, 109, 101, 185, 179, 107, 76, 115, 33, 215, 194, 13, 233,
-			29, 101, 165, 141, 165, 243, 97, 17, 240, 219, 103, 25, 183, 233,
-			60, 147, 227, 27, 158, 31, 84, 29, 88, 41, 88, 1, 191, 182,
-			247, 10, 36, 29, 151, 110, 211, 199, 18, 142, 75, 183, 29, 154,
-			76, 56, 46, 221, 118, 253, 49, 246, 105, 157, 156, 137, 238, 237,
-			123, 72, 203, 124, 92, 231, 23, 81, 138, 235, 160, 146, 61, 70,
-			18, 4, 33, 66, 41, 232, 4, 99, 28, 68, 232, 28, 172, 40,
-			202, 201, 57, 90, 21, 244, 121, 178, 101, 156, 126, 14, 139, 222,
-			84, 154, 65, 8, 107, 46, 22, 45, 174, 34, 110, 51, 117, 40,
-			75, 154, 42, 200, 226, 129, 129, 122, 218, 238, 226, 226, 83, 149,
-			155, 68, 197, 52, 198, 47, 219, 129, 107, 123, 17, 124, 190, 25,
-			162, 121, 28, 21, 176, 150, 252, 129, 187, 94, 21, 117, 63, 188,
-			85, 67, 91, 242, 85, 87, 78, 160, 71, 46, 28, 66, 34, 214,
-			77, 211, 44, 227, 222, 254, 253, 44, 203, 76, 83, 235, 239, 179,
-			204, 151, 234, 75, 70, 102, 12, 251, 3, 33, 91, 65, 46, 125,
-			144, 250, 97, 73, 94, 218, 63, 132, 129, 250, 232, 184, 85, 48,
-			175, 203, 100, 120, 57, 137, 123, 64, 103, 251, 103, 184, 174, 5,
-			115, 127, 194, 57, 171, 48, 60, 145, 112, 206, 42, 76, 30, 101,
-			211, 210, 55, 107, 193, 60, 154, 57, 218, 222, 165, 72, 90, 220,
-			222, 169, 150, 130, 119, 89, 194, 31, 106, 97, 112, 60, 225, 15,
-			181, 144, 153, 20, 157, 234, 150, 81, 236, 238, 84, 92, 103, 181,
-			119, 170, 167, 224, 93, 213, 210, 44, 163, 168, 58, 213, 13, 203,
-			40, 102, 38, 49, 183, 134, 6, 141, 115, 230, 68, 230, 186, 246,
-			78, 101, 94, 135, 246, 94, 141, 20, 188, 44, 93, 183, 12, 205,
-			50, 206, 9, 31, 57, 13, 157, 60, 207, 29, 30, 103, 51, 216,
-			171, 105, 25, 37, 243, 104, 134, 119, 129, 42, 153, 94, 123, 199,
-			102, 26, 222, 87, 45, 205, 50, 74, 10, 92, 211, 176, 140, 82,
-			102, 82, 116, 156, 178, 140, 7, 205, 235, 58, 59, 86, 165, 30,
-			4, 153, 168, 142, 83, 105, 120, 95, 174, 88, 74, 179, 140, 7,
-			213, 138, 165, 12, 203, 120, 112, 242, 40, 123, 159, 70, 110, 169,
-			107, 125, 47, 215, 50, 111, 215, 248, 69, 165, 83, 93, 20, 197,
-			104, 48, 15, 123, 228, 123, 88, 11, 63, 225, 234, 174, 232, 148,
-			68, 68, 69, 48, 146, 47, 49, 69, 182, 104, 133, 104, 122, 66,
-			122, 187, 50, 165, 183, 15, 143, 175, 93, 132, 190, 47, 230, 99,
-			199, 209, 181, 212, 48, 203, 72, 199, 209, 13, 125, 52, 179, 159,
-			47, 119, 208, 171, 240, 35, 221, 208, 135, 18, 126, 164, 27, 7,
-			70, 216, 43, 53, 244, 174, 51, 31, 235, 123, 133, 150, 185, 204,
-			47, 42, 253, 83, 76, 119, 55, 176, 27, 13, 39, 224, 118, 224,
-			55, 177, 16, 78, 71, 153, 172, 152, 59, 92, 109, 207, 182, 247,
-			140, 175, 217, 65, 128, 30, 52, 210, 169, 239, 177, 254, 81, 118,
-			90, 248, 244, 25, 143, 235, 71, 51, 51, 176, 178, 29, 195, 93,
-			133, 225, 234, 152, 234, 239, 113, 93, 181, 210, 150, 241, 248, 224,
-			136, 108, 105, 150, 241, 248, 232, 184, 108, 25, 150, 241, 120, 102,
-			82, 89, 96, 126, 109, 142, 29, 235, 180, 192, 168, 68, 112, 123,
-			25, 97, 238, 102, 3, 235, 201, 100, 113, 34, 141, 1, 90, 58,
-			140, 178, 108, 90, 99, 44, 229, 217, 158, 31, 162, 9, 35, 85,
-			166, 198, 153, 31, 211, 122, 43, 230, 195, 170, 75, 169, 155, 207,
-			63, 75, 221, 92, 193, 251, 156, 212, 243, 207, 204, 178, 125, 86,
-			234, 250, 190, 55, 106, 215, 244, 243, 107, 250, 249, 53, 253, 252,
-			154, 126, 126, 77, 63, 191, 166, 159, 255, 189, 235, 231, 165, 88,
-			63, 47, 93, 89, 63, 159, 141, 245, 243, 217, 231, 160, 159, 127,
-			249, 58, 212, 207, 211, 175, 214, 224, 232, 203, 252, 230, 117, 188,
-			192, 213, 209, 219, 174, 126, 53, 124, 87, 36, 238, 119, 119, 168,
-			78, 109, 195, 241, 170, 14, 121, 252, 99, 140, 25, 60, 127, 18,
-			149, 31, 42, 79, 88, 103, 188, 98, 215, 29, 175, 106, 7, 57,
-			74, 148, 38, 93, 157, 42, 50, 82, 64, 102, 229, 195, 106, 128,
-			129, 93, 137, 79, 12, 249, 3, 28, 8, 32, 42, 96, 155, 106,
-			201, 19, 83, 36, 47, 93, 236, 200, 21, 65, 134, 160, 199, 137,
-			106, 8, 13, 191, 178, 205, 237, 136, 111, 172, 47, 240, 29, 183,
-			234, 33, 71, 247, 61, 198, 31, 176, 189, 38, 28, 3, 115, 57,
-			62, 119, 215, 157, 39, 115, 146, 81, 55, 2, 191, 238, 52, 34,
-			183, 194, 207, 5, 206, 150, 15, 170, 153, 130, 94, 168, 152, 84,
-			241, 49, 36, 6, 221, 227, 173, 77, 187, 114, 105, 215, 14, 168,
-			54, 112, 203, 177, 3, 145, 129, 25, 143, 252, 29, 215, 107, 70,
-			34, 34, 235, 142, 147, 106, 126, 117, 223, 219, 202, 243, 37, 199,
-			110, 196, 83, 14, 28, 158, 13, 119, 28, 59, 112, 170, 89, 142,
-			89, 229, 109, 56, 141, 120, 221, 177, 27, 76, 188, 38, 82, 82,
-			186, 33, 247, 28, 7, 163, 41, 253, 32, 174, 135, 40, 14, 116,
-			74, 60, 105, 243, 199, 230, 111, 155, 217, 246, 155, 84, 74, 198,
-			14, 24, 199, 222, 31, 159, 186, 178, 208, 1, 235, 57, 139, 111,
-			78, 75, 225, 54, 64, 41, 199, 13, 169, 4, 208, 201, 147, 39,
-			231, 102, 240, 127, 235, 39, 79, 158, 198, 255, 61, 10, 83, 191,
-			235, 174, 187, 238, 154, 153, 155, 159, 57, 53, 183, 62, 127, 234,
-			244, 237, 119, 157, 190, 253, 174, 252, 93, 242, 223, 163, 121, 126,
-			166, 197, 96, 33, 163, 192, 173, 68, 170, 44, 164, 29, 81, 239,
-			57, 190, 235, 112, 199, 11, 49, 212, 22, 158, 238, 58, 168, 219,
-			83, 102, 73, 81, 134, 86, 212, 32, 122, 172, 124, 118, 129, 241,
-			83, 167, 78, 221, 21, 207, 101, 119, 119, 55, 239, 58, 81, 13,
-			37, 196, 160, 86, 129, 255, 195, 27, 249, 232, 137, 104, 26, 36,
-			54, 233, 243, 136, 18, 251, 141, 170, 220, 80, 92, 121, 136, 207,
-			157, 230, 11, 254, 78, 163, 25, 57, 137, 189, 128, 3, 174, 174,
-			172, 149, 126, 136, 95, 4, 204, 76, 77, 95, 204, 11, 145, 39,
-			126, 73, 9, 159, 226, 170, 51, 22, 158, 67, 39, 186, 32, 22,
-			120, 10, 63, 95, 222, 88, 90, 154, 158, 238, 249, 30, 210, 251,
-			212, 201, 233, 187, 19, 48, 205, 95, 13, 166, 45, 39, 130, 94,
-			252, 90, 213, 110, 37, 96, 19, 102, 13, 248, 233, 178, 93, 231,
-			209, 101, 49, 98, 219, 235, 183, 68, 151, 115, 28, 1, 186, 251,
-			249, 78, 233, 114, 62, 186, 12, 173, 43, 205, 136, 94, 106, 134,
-			78, 133, 159, 224, 115, 39, 79, 182, 207, 240, 212, 158, 51, 124,
-			216, 245, 78, 205, 243, 139, 231, 156, 104, 13, 75, 104, 192, 207,
-			133, 240, 172, 91, 199, 124, 196, 137, 201, 158, 45, 45, 21, 215,
-			75, 15, 21, 121, 45, 18, 96, 236, 245, 205, 45, 181, 72, 66,
-			186, 81, 90, 94, 199, 212, 225, 149, 75, 33, 191, 151, 79, 77,
-			77, 209, 147, 233, 90, 148, 175, 238, 158, 119, 183, 182, 23, 237,
-			8, 191, 154, 230, 247, 220, 195, 79, 205, 79, 243, 127, 204, 241,
-			183, 37, 127, 87, 254, 36, 241, 54, 59, 203, 11, 0, 111, 21,
-			51, 54, 184, 149, 75, 176, 89, 230, 78, 158, 76, 240, 176, 48,
-			175, 94, 32, 46, 53, 119, 71, 247, 54, 82, 189, 193, 231, 115,
-			119, 220, 118, 219, 109, 119, 158, 186, 227, 100, 204, 54, 68, 164,
-			250, 134, 231, 62, 33, 123, 185, 235, 206, 147, 157, 189, 228, 159,
-			223, 98, 78, 209, 252, 249, 212, 20, 33, 101, 22, 23, 11, 254,
-			77, 243, 153, 36, 56, 87, 161, 96, 232, 7, 208, 37, 251, 185,
-			57, 209, 15, 18, 192, 116, 27, 1, 220, 182, 39, 1, 60, 96,
-			95, 182, 249, 69, 90, 200, 188, 40, 198, 6, 175, 60, 228, 214,
-			235, 110, 152, 32, 0, 224, 166, 124, 7, 159, 242, 123, 249, 222,
-			31, 92, 129, 204, 249, 189, 241, 211, 188, 231, 236, 158, 105, 186,
-			245, 170, 19, 76, 77, 195, 196, 214, 4, 134, 196, 16, 132, 152,
-			105, 166, 10, 130, 194, 59, 203, 52, 119, 215, 139, 96, 230, 226,
-			77, 154, 186, 152, 54, 98, 96, 58, 191, 9, 61, 35, 44, 49,
-			14, 110, 191, 10, 14, 74, 232, 165, 17, 229, 61, 127, 55, 49,
-			109, 241, 148, 123, 254, 46, 191, 151, 183, 189, 115, 197, 153, 198,
-			128, 95, 125, 202, 158, 191, 155, 223, 114, 162, 34, 16, 27, 61,
-			155, 154, 78, 204, 188, 125, 246, 226, 101, 104, 76, 237, 49, 211,
-			59, 246, 156, 169, 172, 182, 39, 228, 12, 190, 218, 138, 182, 133,
-			169, 40, 73, 104, 201, 133, 154, 154, 238, 164, 194, 115, 78, 180,
-			16, 175, 251, 212, 52, 242, 122, 52, 186, 60, 100, 55, 26, 174,
-			183, 197, 24, 47, 121, 244, 132, 180, 118, 114, 98, 78, 224, 169,
-			213, 32, 235, 110, 82, 112, 17, 246, 69, 146, 25, 24, 30, 64,
-			207, 233, 252, 161, 161, 64, 118, 193, 180, 68, 57, 234, 134, 158,
-			194, 96, 217, 167, 64, 110, 120, 122, 230, 169, 29, 223, 139, 182,
-			159, 158, 121, 170, 106, 183, 158, 94, 127, 10, 14, 239, 167, 79,
-			63, 181, 227, 122, 79, 159, 126, 42, 116, 42, 79, 63, 150, 127,
-			10, 196, 37, 216, 178, 79, 63, 254, 104, 150, 137, 226, 185, 244,
-			53, 69, 219, 99, 101, 80, 145, 44, 75, 37, 161, 198, 162, 160,
-			85, 119, 203, 141, 176, 72, 112, 221, 225, 98, 164, 28, 199, 161,
-			114, 140, 211, 96, 57, 142, 163, 81, 186, 40, 28, 50, 174, 194,
-			217, 176, 171, 85, 17, 180, 188, 235, 203, 222, 28, 187, 178, 77,
-			50, 153, 148, 227, 64, 254, 19, 44, 37, 151, 48, 210, 111, 249,
-			188, 137, 119, 55, 119, 201, 79, 41, 186, 158, 30, 206, 245, 150,
-			246, 166, 115, 12, 199, 151, 229, 213, 104, 164, 236, 163, 89, 30,
-			54, 107, 53, 247, 137, 132, 17, 29, 243, 8, 185, 59, 14, 74,
-			162, 83, 217, 141, 245, 133, 236, 244, 221, 109, 79, 89, 210, 107,
-			95, 88, 245, 35, 255, 20, 17, 67, 136, 58, 185, 251, 164, 19,
-			200, 32, 2, 129, 202, 102, 232, 160, 52, 57, 133, 73, 23, 170,
-			34, 83, 197, 102, 139, 1, 24, 211, 228, 175, 222, 8, 92, 47,
-			146, 17, 135, 29, 164, 68, 117, 180, 147, 67, 97, 72, 106, 144,
-			76, 96, 132, 50, 29, 72, 56, 21, 76, 18, 135, 65, 83, 48,
-			38, 124, 43, 170, 42, 138, 57, 132, 93, 112, 96, 76, 14, 86,
-			45, 66, 113, 173, 237, 206, 42, 59, 127, 114, 238, 78, 56, 29,
-			230, 110, 95, 63, 57, 119, 250, 212, 201, 211, 115, 183, 231, 79,
-			206, 61, 154, 21, 212, 29, 114, 108, 171, 227, 133, 10, 29, 225,
-			155, 56, 190, 239, 197, 114, 243, 237, 57, 204, 155, 151, 23, 27,
-			200, 190, 108, 175, 225, 109, 88, 142, 74, 92, 38, 68, 53, 155,
-			47, 98, 42, 137, 68, 138, 39, 85, 199, 75, 208, 35, 146, 63,
-			150, 219, 182, 131, 42, 227, 143, 69, 126, 105, 109, 101, 13, 55,
-			217, 212, 116, 15, 1, 53, 191, 227, 63, 233, 214, 235, 54, 238,
-			46, 199, 155, 217, 88, 163, 92, 228, 15, 59, 155, 179, 49, 40,
-			179, 101, 25, 138, 48, 123, 174, 238, 111, 218, 245, 11, 148, 8,
-			49, 156, 5, 128, 102, 19, 131, 76, 51, 229, 4, 87, 146, 156,
-			134, 46, 139, 8, 36, 126, 17, 36, 70, 140, 103, 150, 127, 92,
-			148, 19, 18, 55, 78, 98, 182, 88, 160, 184, 199, 20, 25, 127,
-			236, 98, 24, 5, 53, 252, 52, 49, 35, 191, 18, 230, 27, 196,
-			217, 96, 46, 243, 179, 117, 119, 51, 176, 131, 22, 138, 221, 249,
-			237, 104, 167, 126, 35, 254, 37, 191, 157, 70, 155, 11, 83, 132,
-			44, 7, 9, 27, 78, 133, 31, 191, 249, 145, 153, 155, 119, 102,
-			110, 174, 174, 223, 124, 254, 244, 205, 15, 157, 190, 121, 45, 127,
-			115, 237, 209, 227, 121, 190, 228, 94, 114, 118, 221, 16, 175, 45,
-			113, 173, 226, 85, 106, 134, 14, 245, 246, 128, 95, 181, 145, 88,
-			143, 135, 252, 177, 139, 165, 181, 21, 41, 212, 156, 37, 102, 85,
-			21, 205, 169, 233, 139, 143, 79, 177, 100, 166, 193, 127, 228, 87,
-			105, 37, 224, 143, 25, 212, 23, 236, 134, 139, 11, 34, 159, 146,
-			22, 65, 176, 206, 118, 247, 141, 243, 148, 3, 220, 60, 191, 120,
-			243, 252, 34, 227, 211, 128, 72, 149, 247, 80, 214, 125, 11, 120,
-			197, 110, 224, 6, 241, 107, 201, 234, 118, 138, 231, 135, 50, 217,
-			157, 228, 178, 140, 81, 209, 61, 172, 79, 167, 245, 143, 178, 119,
-			104, 226, 50, 211, 124, 173, 166, 143, 101, 126, 66, 75, 94, 156,
-			72, 218, 247, 107, 72, 242, 148, 181, 23, 243, 101, 196, 82, 22,
-			235, 45, 102, 241, 135, 68, 170, 253, 43, 169, 69, 172, 151, 94,
-			244, 40, 37, 23, 8, 221, 203, 50, 112, 132, 74, 211, 189, 86,
-			211, 247, 201, 166, 6, 205, 254, 3, 178, 105, 64, 211, 58, 200,
-			254, 88, 19, 217, 30, 204, 55, 104, 186, 149, 249, 29, 141, 47,
-			251, 222, 140, 231, 108, 145, 30, 220, 166, 77, 219, 82, 107, 4,
-			69, 178, 183, 54, 189, 44, 62, 84, 10, 38, 221, 42, 144, 73,
-			50, 238, 12, 13, 167, 148, 86, 11, 131, 241, 189, 228, 152, 216,
-			181, 248, 80, 228, 78, 32, 5, 189, 230, 7, 160, 24, 75, 235,
-			65, 39, 194, 132, 210, 152, 19, 255, 103, 61, 144, 162, 165, 112,
-			158, 18, 41, 26, 78, 187, 127, 191, 108, 98, 136, 249, 200, 168,
-			186, 201, 248, 66, 142, 29, 233, 188, 201, 176, 189, 61, 99, 105,
-			238, 96, 70, 193, 107, 89, 71, 88, 63, 86, 49, 136, 243, 6,
-			239, 131, 246, 70, 80, 183, 198, 146, 30, 160, 67, 194, 211, 243,
-			204, 229, 222, 183, 23, 253, 5, 79, 5, 222, 228, 158, 229, 189,
-			133, 237, 181, 26, 155, 207, 246, 186, 226, 219, 39, 232, 186, 226,
-			29, 215, 174, 43, 174, 93, 87, 92, 187, 174, 184, 118, 93, 113,
-			237, 186, 226, 218, 117, 197, 247, 245, 186, 98, 33, 14, 186, 89,
-			184, 114, 208, 205, 116, 28, 116, 51, 253, 28, 130, 110, 254, 251,
-			8, 222, 76, 164, 254, 49, 28, 114, 153, 63, 29, 225, 23, 11,
-			94, 235, 98, 156, 254, 210, 246, 184, 29, 108, 186, 17, 134, 159,
-			43, 165, 45, 17, 203, 77, 103, 143, 10, 196, 177, 209, 54, 68,
-			73, 92, 89, 156, 129, 183, 218, 86, 115, 90, 198, 199, 136, 108,
-			7, 178, 207, 56, 180, 151, 203, 19, 153, 11, 113, 93, 50, 67,
-			85, 95, 148, 114, 13, 85, 46, 205, 54, 61, 248, 15, 47, 160,
-			167, 23, 138, 82, 130, 199, 2, 71, 199, 50, 205, 205, 200, 173,
-			187, 81, 139, 215, 154, 158, 20, 213, 2, 110, 87, 137, 97, 219,
-			245, 68, 94, 83, 153, 7, 89, 128, 6, 125, 2, 168, 249, 118,
-			163, 245, 42, 140, 135, 7, 43, 13, 109, 199, 53, 44, 60, 190,
-			112, 235, 173, 202, 76, 234, 251, 162, 144, 126, 62, 159, 23, 246,
-			59, 232, 210, 246, 90, 162, 5, 162, 18, 244, 118, 54, 240, 119,
-			166, 106, 190, 47, 173, 124, 249, 188, 96, 238, 110, 141, 79, 193,
-			75, 27, 56, 210, 186, 63, 117, 11, 188, 53, 29, 71, 10, 169,
-			55, 159, 110, 55, 98, 95, 17, 70, 80, 80, 158, 5, 144, 252,
-			94, 248, 43, 15, 223, 95, 17, 56, 55, 156, 58, 235, 251, 249,
-			74, 221, 14, 195, 36, 108, 212, 45, 188, 65, 64, 36, 222, 186,
-			187, 27, 232, 83, 87, 1, 186, 221, 60, 69, 125, 159, 245, 253,
-			169, 124, 62, 63, 173, 176, 73, 32, 79, 77, 183, 163, 23, 161,
-			239, 6, 30, 126, 46, 17, 236, 139, 197, 181, 133, 114, 105, 117,
-			125, 165, 60, 125, 90, 130, 31, 227, 61, 241, 61, 245, 208, 102,
-			76, 189, 34, 212, 231, 124, 153, 57, 30, 32, 62, 125, 47, 191,
-			165, 177, 153, 63, 235, 251, 79, 229, 243, 249, 167, 153, 26, 40,
-			199, 157, 32, 128, 159, 81, 60, 205, 47, 59, 187, 201, 49, 221,
-			26, 254, 124, 195, 189, 220, 115, 235, 49, 126, 227, 201, 112, 217,
-			85, 252, 164, 99, 184, 167, 219, 187, 162, 145, 242, 27, 222, 142,
-			29, 132, 219, 118, 125, 221, 167, 21, 190, 250, 56, 116, 65, 133,
-			19, 149, 187, 69, 201, 41, 155, 45, 222, 232, 220, 183, 148, 207,
-			7, 43, 192, 97, 137, 126, 84, 135, 143, 227, 174, 34, 73, 214,
-			110, 184, 33, 74, 179, 181, 102, 189, 158, 199, 31, 64, 80, 57,
-			46, 131, 236, 144, 87, 0, 31, 145, 130, 21, 161, 153, 197, 155,
-			213, 171, 183, 184, 204, 14, 11, 157, 180, 248, 143, 52, 237, 58,
-			37, 207, 194, 175, 41, 57, 31, 38, 85, 139, 100, 81, 233, 227,
-			179, 199, 149, 52, 38, 135, 200, 241, 68, 202, 20, 158, 173, 249,
-			126, 126, 211, 14, 16, 184, 39, 102, 91, 249, 39, 179, 52, 155,
-			150, 42, 254, 198, 168, 235, 44, 252, 6, 106, 48, 218, 155, 174,
-			224, 160, 70, 33, 129, 196, 97, 201, 105, 175, 25, 170, 50, 252,
-			91, 205, 186, 29, 176, 30, 159, 160, 192, 232, 196, 124, 50, 199,
-			157, 157, 77, 7, 109, 131, 130, 210, 132, 12, 111, 123, 44, 201,
-			215, 200, 149, 237, 226, 253, 0, 233, 69, 105, 25, 84, 105, 141,
-			19, 243, 206, 75, 114, 150, 210, 186, 204, 89, 31, 43, 92, 53,
-			183, 238, 136, 77, 43, 169, 123, 213, 9, 66, 223, 139, 201, 68,
-			216, 108, 49, 199, 239, 5, 196, 203, 189, 124, 238, 238, 142, 95,
-			1, 247, 242, 199, 249, 4, 23, 128, 127, 170, 167, 44, 130, 156,
-			61, 205, 179, 189, 232, 164, 29, 168, 60, 129, 145, 205, 169, 143,
-			17, 128, 101, 123, 7, 58, 184, 135, 134, 189, 47, 254, 21, 0,
-			232, 248, 49, 134, 66, 228, 202, 238, 68, 175, 178, 81, 239, 58,
-			245, 250, 12, 101, 195, 0, 106, 220, 166, 75, 246, 102, 24, 249,
-			59, 180, 244, 29, 171, 151, 235, 233, 111, 46, 83, 92, 169, 97,
-			96, 205, 240, 2, 89, 84, 225, 191, 120, 153, 252, 23, 105, 201,
-			182, 253, 58, 93, 134, 39, 71, 2, 210, 149, 75, 45, 243, 128,
-			136, 149, 102, 50, 73, 143, 228, 81, 152, 8, 72, 78, 229, 177,
-			206, 232, 190, 197, 38, 249, 150, 62, 254, 216, 227, 211, 167, 159,
-			239, 74, 180, 119, 149, 88, 12, 156, 9, 124, 63, 151, 159, 159,
-			155, 15, 179, 221, 161, 169, 255, 184, 127, 144, 125, 158, 73, 131,
-			209, 91, 53, 253, 112, 230, 223, 129, 52, 189, 81, 94, 154, 13,
-			28, 161, 34, 34, 197, 32, 46, 155, 158, 251, 35, 77, 167, 222,
-			106, 207, 162, 179, 151, 72, 193, 246, 146, 83, 242, 148, 33, 69,
-			80, 37, 234, 125, 98, 107, 112, 59, 226, 117, 7, 13, 179, 190,
-			231, 240, 236, 108, 150, 87, 182, 237, 192, 174, 68, 78, 64, 230,
-			112, 228, 31, 161, 179, 181, 35, 156, 50, 34, 202, 164, 120, 60,
-			196, 52, 174, 177, 18, 137, 75, 206, 122, 178, 37, 169, 166, 41,
-			200, 201, 202, 204, 248, 69, 232, 98, 79, 196, 94, 156, 38, 8,
-			240, 243, 56, 159, 56, 213, 45, 81, 213, 187, 72, 240, 193, 130,
-			93, 57, 152, 10, 18, 87, 54, 159, 149, 105, 197, 200, 234, 237,
-			84, 167, 133, 109, 185, 129, 86, 39, 212, 105, 29, 27, 179, 224,
-			54, 209, 235, 181, 17, 56, 21, 127, 167, 225, 214, 97, 4, 153,
-			58, 149, 180, 101, 76, 206, 143, 213, 47, 165, 25, 160, 197, 132,
-			127, 190, 72, 164, 44, 140, 14, 42, 135, 235, 19, 136, 42, 144,
-			39, 248, 121, 127, 215, 185, 236, 4, 196, 109, 55, 202, 75, 161,
-			32, 116, 193, 195, 25, 213, 8, 117, 248, 197, 237, 40, 106, 92,
-			204, 209, 127, 195, 139, 24, 45, 224, 249, 226, 215, 216, 192, 42,
-			239, 42, 234, 45, 140, 10, 104, 54, 184, 45, 152, 179, 204, 200,
-			77, 101, 245, 26, 161, 98, 120, 232, 57, 34, 119, 68, 85, 85,
-			220, 198, 116, 75, 164, 183, 135, 176, 21, 78, 0, 67, 80, 35,
-			82, 178, 53, 58, 234, 20, 80, 120, 7, 132, 9, 192, 170, 121,
-			248, 160, 224, 97, 78, 80, 126, 174, 184, 46, 179, 228, 195, 217,
-			133, 52, 65, 231, 134, 221, 189, 5, 215, 91, 13, 231, 241, 199,
-			30, 135, 189, 33, 130, 29, 218, 204, 18, 118, 148, 19, 154, 56,
-			90, 37, 226, 232, 5, 24, 143, 234, 201, 40, 163, 75, 178, 0,
-			71, 5, 43, 233, 212, 125, 255, 82, 179, 33, 243, 115, 241, 77,
-			59, 84, 9, 252, 97, 64, 60, 246, 252, 128, 172, 140, 88, 121,
-			36, 94, 246, 42, 173, 187, 45, 129, 81, 1, 245, 182, 215, 98,
-			120, 13, 12, 93, 35, 73, 6, 120, 81, 158, 147, 111, 202, 90,
-			168, 36, 120, 123, 142, 67, 206, 58, 155, 34, 253, 92, 112, 25,
-			182, 38, 7, 48, 200, 68, 134, 191, 34, 65, 229, 249, 212, 70,
-			168, 42, 98, 38, 79, 112, 90, 52, 219, 195, 132, 201, 156, 111,
-			6, 142, 125, 9, 173, 78, 212, 67, 126, 26, 19, 71, 69, 206,
-			105, 97, 156, 22, 210, 190, 141, 32, 8, 210, 23, 119, 158, 245,
-			22, 183, 47, 219, 110, 157, 124, 123, 104, 153, 252, 90, 205, 173,
-			184, 118, 157, 197, 50, 76, 224, 0, 35, 16, 181, 92, 92, 149,
-			150, 15, 173, 36, 232, 109, 175, 200, 105, 211, 217, 114, 61, 76,
-			49, 32, 110, 15, 186, 185, 37, 108, 182, 53, 36, 164, 80, 222,
-			49, 109, 131, 48, 208, 78, 228, 124, 74, 24, 107, 40, 61, 1,
-			81, 222, 180, 10, 164, 18, 181, 55, 240, 180, 119, 129, 185, 199,
-			70, 36, 101, 115, 9, 157, 29, 219, 139, 220, 74, 8, 18, 73,
-			108, 234, 126, 171, 204, 145, 68, 166, 238, 183, 106, 3, 163, 9,
-			83, 247, 91, 181, 177, 67, 236, 97, 105, 233, 126, 187, 166, 91,
-			153, 146, 178, 30, 99, 164, 152, 91, 189, 146, 250, 39, 120, 25,
-			89, 237, 84, 202, 90, 169, 61, 197, 182, 229, 183, 183, 219, 150,
-			223, 222, 110, 91, 126, 123, 210, 182, 252, 150, 17, 54, 46, 108,
-			203, 65, 163, 50, 43, 202, 16, 144, 101, 153, 137, 45, 20, 52,
-			42, 153, 189, 13, 208, 217, 26, 75, 139, 50, 98, 22, 51, 177,
-			106, 183, 134, 94, 241, 248, 183, 53, 193, 246, 201, 50, 102, 84,
-			61, 77, 54, 173, 60, 219, 39, 98, 198, 38, 12, 12, 138, 31,
-			235, 10, 138, 47, 120, 173, 178, 124, 233, 140, 205, 134, 19, 246,
-			233, 160, 81, 57, 51, 72, 227, 74, 235, 244, 157, 221, 214, 233,
-			45, 199, 195, 206, 102, 99, 50, 73, 204, 243, 110, 250, 15, 185,
-			214, 151, 87, 23, 30, 120, 229, 48, 21, 247, 206, 107, 236, 19,
-			113, 153, 173, 159, 235, 40, 238, 125, 82, 26, 254, 150, 150, 22,
-			254, 33, 214, 215, 186, 86, 94, 171, 109, 248, 23, 181, 188, 214,
-			65, 89, 191, 219, 234, 187, 81, 216, 134, 198, 98, 247, 216, 49,
-			229, 30, 123, 168, 111, 89, 26, 161, 224, 79, 122, 120, 56, 54,
-			45, 29, 86, 166, 165, 241, 56, 124, 117, 92, 89, 166, 38, 250,
-			142, 75, 115, 21, 252, 73, 15, 143, 196, 70, 40, 248, 243, 147,
-			34, 124, 53, 219, 151, 215, 50, 63, 103, 160, 140, 115, 145, 118,
-			144, 8, 99, 138, 11, 136, 215, 253, 45, 74, 204, 66, 217, 224,
-			252, 170, 83, 87, 213, 167, 194, 166, 75, 254, 154, 53, 63, 128,
-			165, 173, 225, 245, 116, 4, 220, 106, 43, 176, 119, 176, 230, 140,
-			227, 93, 118, 3, 223, 3, 198, 25, 230, 68, 206, 113, 172, 131,
-			85, 92, 91, 199, 236, 47, 200, 237, 203, 171, 11, 34, 21, 12,
-			166, 215, 102, 170, 52, 192, 99, 91, 229, 213, 133, 248, 126, 121,
-			203, 141, 182, 155, 155, 36, 13, 7, 141, 202, 116, 158, 23, 237,
-			202, 118, 12, 188, 20, 50, 164, 222, 5, 226, 96, 224, 56, 188,
-			225, 58, 21, 50, 198, 87, 237, 200, 62, 45, 102, 3, 108, 41,
-			215, 158, 231, 142, 14, 31, 122, 36, 216, 13, 156, 36, 143, 136,
-			84, 55, 53, 12, 53, 108, 70, 84, 37, 198, 222, 132, 63, 113,
-			147, 38, 209, 131, 186, 138, 191, 139, 21, 75, 252, 224, 146, 56,
-			62, 162, 216, 189, 166, 176, 90, 226, 139, 78, 232, 110, 121, 252,
-			92, 211, 173, 58, 241, 252, 186, 106, 147, 35, 159, 170, 226, 187,
-			179, 148, 253, 121, 58, 17, 201, 154, 237, 31, 102, 85, 25, 201,
-			122, 147, 62, 154, 121, 24, 215, 50, 81, 75, 43, 215, 89, 78,
-			10, 229, 25, 175, 185, 19, 151, 23, 126, 44, 230, 163, 249, 5,
-			191, 234, 60, 222, 245, 32, 159, 172, 82, 126, 147, 42, 222, 10,
-			16, 220, 212, 63, 148, 8, 122, 189, 233, 192, 8, 251, 134, 188,
-			192, 53, 166, 245, 67, 153, 223, 211, 120, 129, 171, 235, 180, 153,
-			154, 93, 65, 162, 104, 71, 121, 39, 136, 174, 199, 139, 222, 86,
-			221, 13, 183, 243, 32, 194, 34, 53, 244, 254, 54, 241, 17, 186,
-			102, 227, 97, 137, 181, 143, 28, 47, 129, 240, 196, 132, 136, 82,
-			242, 98, 109, 31, 191, 194, 79, 50, 43, 179, 116, 251, 198, 190,
-			101, 181, 10, 204, 199, 153, 79, 22, 22, 152, 110, 43, 44, 48,
-			173, 170, 59, 107, 134, 101, 76, 31, 28, 99, 45, 89, 74, 125,
-			70, 191, 53, 83, 231, 5, 117, 153, 165, 114, 34, 137, 84, 242,
-			65, 64, 99, 180, 19, 33, 39, 177, 143, 194, 247, 42, 254, 206,
-			14, 200, 32, 14, 37, 101, 78, 234, 205, 232, 38, 64, 27, 139,
-			212, 2, 5, 164, 110, 194, 216, 170, 149, 182, 140, 153, 193, 235,
-			19, 149, 217, 103, 142, 221, 146, 168, 204, 62, 51, 125, 66, 137,
-			6, 175, 255, 134, 206, 78, 62, 187, 66, 188, 129, 211, 240, 147,
-			149, 120, 51, 87, 202, 254, 152, 185, 98, 98, 164, 204, 213, 130,
-			246, 50, 123, 201, 43, 153, 231, 90, 53, 56, 123, 156, 237, 167,
-			130, 252, 178, 168, 236, 97, 150, 166, 162, 246, 226, 198, 92, 180,
-			178, 239, 208, 217, 48, 189, 249, 144, 168, 193, 190, 215, 171, 22,
-			103, 131, 53, 215, 219, 114, 2, 116, 159, 18, 50, 79, 242, 145,
-			117, 55, 27, 108, 54, 170, 118, 228, 92, 128, 153, 97, 130, 163,
-			94, 233, 133, 148, 199, 85, 153, 209, 235, 240, 192, 58, 166, 62,
-			134, 221, 129, 169, 143, 6, 228, 11, 27, 161, 19, 88, 57, 102,
-			218, 149, 122, 56, 145, 66, 145, 74, 148, 70, 109, 135, 61, 95,
-			88, 88, 42, 227, 91, 153, 34, 51, 10, 11, 75, 214, 245, 204,
-			12, 252, 186, 76, 232, 196, 232, 163, 178, 95, 119, 202, 248, 220,
-			186, 158, 49, 128, 189, 226, 54, 236, 122, 56, 161, 115, 3, 6,
-			141, 159, 100, 47, 176, 241, 146, 183, 237, 4, 110, 228, 84, 59,
-			240, 180, 200, 14, 54, 156, 224, 2, 97, 231, 130, 44, 97, 47,
-			210, 32, 141, 245, 2, 175, 60, 218, 112, 130, 246, 71, 217, 87,
-			107, 236, 16, 192, 19, 150, 60, 185, 98, 148, 5, 204, 186, 143,
-			165, 0, 68, 153, 88, 105, 42, 134, 189, 235, 93, 124, 170, 30,
-			210, 103, 153, 60, 27, 74, 62, 190, 26, 42, 178, 13, 54, 186,
-			228, 134, 209, 179, 162, 27, 235, 40, 27, 80, 65, 249, 72, 10,
-			253, 229, 248, 129, 117, 51, 27, 22, 213, 56, 46, 108, 187, 213,
-			170, 227, 33, 45, 244, 151, 247, 139, 167, 231, 241, 97, 118, 137,
-			89, 201, 17, 197, 188, 51, 172, 95, 24, 16, 105, 234, 3, 101,
-			213, 198, 223, 240, 109, 71, 46, 150, 106, 103, 79, 176, 225, 85,
-			122, 79, 2, 63, 193, 246, 137, 47, 165, 159, 136, 104, 102, 63,
-			174, 177, 254, 146, 72, 165, 182, 247, 107, 214, 173, 172, 95, 38,
-			92, 219, 171, 158, 179, 122, 193, 186, 145, 237, 15, 156, 45, 55,
-			140, 156, 192, 169, 94, 216, 108, 81, 130, 175, 242, 80, 252, 240,
-			76, 203, 122, 105, 219, 75, 81, 40, 42, 38, 95, 105, 147, 36,
-			58, 88, 15, 179, 31, 212, 216, 68, 89, 60, 144, 51, 80, 168,
-			59, 169, 138, 54, 211, 34, 139, 77, 66, 239, 147, 13, 168, 163,
-			116, 243, 137, 174, 25, 138, 50, 217, 170, 239, 120, 130, 243, 108,
-			64, 21, 166, 22, 155, 187, 119, 9, 224, 114, 191, 172, 82, 157,
-			173, 179, 49, 88, 98, 217, 91, 120, 213, 165, 177, 38, 217, 64,
-			195, 222, 114, 46, 132, 238, 147, 206, 196, 24, 234, 91, 253, 240,
-			96, 205, 125, 210, 177, 174, 99, 12, 127, 196, 212, 213, 19, 135,
-			168, 104, 53, 60, 89, 135, 7, 217, 29, 118, 168, 99, 52, 129,
-			152, 28, 27, 80, 21, 89, 197, 126, 234, 156, 103, 252, 130, 117,
-			11, 59, 224, 57, 79, 68, 23, 18, 67, 141, 225, 80, 251, 225,
-			241, 170, 26, 238, 117, 26, 59, 188, 230, 216, 65, 101, 251, 57,
-			204, 239, 58, 102, 70, 246, 22, 145, 239, 224, 252, 0, 65, 177,
-			110, 111, 149, 241, 241, 247, 52, 125, 159, 141, 119, 129, 243, 162,
-			34, 224, 147, 26, 51, 202, 78, 13, 212, 99, 44, 252, 78, 83,
-			197, 191, 147, 24, 208, 247, 222, 85, 198, 213, 118, 213, 49, 54,
-			72, 254, 68, 180, 167, 196, 177, 32, 31, 157, 105, 193, 161, 163,
-			94, 136, 66, 145, 15, 239, 138, 135, 142, 124, 125, 61, 204, 222,
-			207, 70, 22, 157, 186, 19, 57, 48, 168, 88, 186, 231, 52, 153,
-			236, 173, 236, 0, 144, 92, 217, 169, 93, 125, 237, 179, 115, 108,
-			36, 126, 89, 172, 204, 117, 204, 12, 156, 154, 92, 148, 1, 185,
-			99, 107, 101, 124, 156, 125, 173, 198, 140, 117, 123, 171, 71, 182,
-			195, 54, 95, 183, 1, 225, 235, 6, 248, 178, 163, 8, 116, 223,
-			4, 15, 98, 242, 17, 225, 75, 189, 240, 172, 248, 143, 250, 120,
-			61, 204, 182, 216, 104, 1, 91, 235, 246, 214, 179, 160, 245, 231,
-			196, 63, 229, 198, 48, 122, 110, 12, 24, 122, 209, 249, 251, 25,
-			250, 247, 52, 54, 34, 183, 137, 58, 254, 175, 178, 32, 210, 249,
-			208, 186, 129, 13, 137, 42, 100, 23, 64, 158, 21, 43, 50, 40,
-			158, 173, 183, 26, 78, 167, 100, 101, 118, 75, 86, 29, 171, 154,
-			186, 218, 170, 238, 149, 217, 177, 247, 170, 190, 81, 99, 135, 104,
-			89, 149, 164, 242, 194, 226, 119, 158, 245, 43, 249, 200, 16, 105,
-			34, 219, 152, 143, 26, 87, 189, 135, 64, 209, 130, 255, 0, 1,
-			245, 65, 141, 29, 132, 29, 252, 34, 129, 100, 49, 243, 146, 211,
-			34, 58, 28, 40, 227, 223, 223, 211, 129, 16, 208, 233, 27, 67,
-			43, 120, 78, 114, 234, 218, 179, 155, 250, 179, 62, 19, 30, 100,
-			135, 202, 78, 232, 215, 47, 59, 194, 54, 120, 117, 28, 77, 176,
-			125, 194, 96, 47, 185, 171, 104, 102, 95, 193, 14, 157, 115, 212,
-			121, 190, 81, 94, 122, 97, 17, 158, 125, 179, 206, 6, 86, 3,
-			191, 226, 132, 161, 31, 88, 195, 76, 119, 171, 162, 63, 221, 173,
-			90, 183, 178, 20, 22, 150, 196, 126, 134, 165, 176, 163, 222, 71,
-			5, 219, 41, 211, 59, 176, 3, 101, 57, 3, 216, 129, 207, 66,
-			249, 145, 175, 175, 135, 214, 44, 75, 211, 13, 139, 224, 199, 123,
-			229, 115, 45, 139, 215, 128, 215, 160, 62, 45, 88, 1, 53, 178,
-			103, 89, 10, 97, 178, 14, 177, 209, 181, 245, 194, 122, 241, 194,
-			198, 50, 250, 242, 157, 45, 21, 23, 71, 250, 172, 65, 182, 111,
-			181, 184, 188, 88, 90, 62, 55, 162, 89, 251, 217, 192, 218, 198,
-			194, 66, 177, 184, 88, 92, 28, 209, 45, 198, 210, 84, 148, 115,
-			196, 200, 126, 73, 99, 227, 139, 194, 229, 42, 22, 50, 95, 80,
-			82, 191, 145, 237, 151, 78, 93, 23, 240, 252, 35, 13, 97, 72,
-			62, 132, 51, 178, 237, 37, 100, 208, 102, 251, 75, 112, 20, 88,
-			179, 236, 160, 122, 169, 33, 215, 134, 4, 129, 254, 178, 37, 127,
-			82, 171, 22, 102, 127, 65, 99, 19, 221, 179, 19, 91, 35, 41,
-			16, 107, 87, 17, 136, 229, 209, 173, 247, 60, 186, 175, 114, 170,
-			88, 179, 160, 122, 42, 112, 77, 124, 233, 64, 7, 137, 149, 19,
-			175, 192, 102, 144, 96, 83, 41, 229, 23, 120, 51, 124, 64, 103,
-			135, 59, 7, 120, 30, 88, 201, 51, 70, 230, 36, 88, 215, 189,
-			70, 29, 168, 136, 1, 106, 214, 109, 108, 191, 120, 159, 110, 255,
-			122, 201, 132, 176, 243, 135, 232, 173, 51, 248, 18, 156, 136, 226,
-			43, 100, 140, 38, 166, 49, 18, 3, 35, 107, 60, 198, 6, 235,
-			206, 150, 93, 105, 93, 8, 183, 237, 57, 121, 100, 210, 163, 181,
-			109, 123, 206, 186, 151, 89, 49, 156, 23, 236, 186, 107, 135, 14,
-			156, 156, 70, 47, 120, 71, 20, 188, 5, 122, 49, 235, 177, 235,
-			37, 178, 206, 248, 126, 4, 250, 85, 227, 76, 211, 171, 214, 157,
-			171, 233, 206, 25, 214, 47, 179, 205, 73, 37, 86, 182, 147, 172,
-			208, 104, 103, 133, 159, 212, 217, 177, 61, 7, 20, 203, 84, 98,
-			169, 154, 27, 155, 12, 78, 209, 44, 174, 242, 85, 94, 61, 63,
-			235, 214, 157, 50, 245, 144, 249, 77, 141, 237, 111, 251, 225, 10,
-			84, 118, 66, 169, 157, 180, 218, 86, 190, 203, 10, 169, 20, 206,
-			231, 36, 252, 223, 200, 76, 128, 70, 48, 197, 174, 23, 241, 71,
-			37, 175, 167, 18, 242, 186, 197, 76, 36, 139, 52, 146, 5, 254,
-			125, 226, 165, 204, 44, 251, 117, 199, 26, 99, 35, 229, 149, 165,
-			78, 214, 200, 88, 186, 92, 44, 44, 22, 203, 35, 26, 252, 253,
-			112, 185, 180, 94, 44, 143, 232, 214, 0, 75, 161, 83, 245, 136,
-			113, 34, 100, 86, 183, 38, 109, 221, 200, 142, 149, 139, 231, 74,
-			107, 235, 229, 194, 122, 105, 101, 249, 2, 240, 221, 141, 181, 142,
-			222, 135, 25, 163, 151, 138, 229, 226, 226, 136, 102, 29, 102, 150,
-			172, 217, 151, 120, 174, 91, 35, 108, 104, 121, 101, 253, 2, 149,
-			138, 6, 86, 60, 255, 163, 251, 25, 43, 59, 88, 241, 212, 15,
-			90, 214, 125, 108, 244, 156, 19, 117, 88, 162, 14, 38, 141, 77,
-			130, 250, 50, 61, 45, 80, 214, 42, 203, 224, 129, 218, 219, 164,
-			213, 179, 163, 235, 228, 78, 239, 253, 205, 25, 54, 182, 129, 70,
-			186, 142, 231, 61, 199, 223, 3, 170, 69, 54, 114, 206, 137, 218,
-			76, 91, 189, 97, 153, 188, 130, 17, 204, 122, 41, 99, 177, 57,
-			201, 26, 167, 87, 187, 76, 90, 153, 137, 238, 31, 68, 7, 119,
-			179, 193, 243, 110, 213, 17, 86, 36, 53, 131, 54, 163, 82, 166,
-			59, 193, 57, 166, 205, 183, 238, 101, 251, 55, 188, 237, 239, 229,
-			115, 210, 68, 159, 223, 231, 103, 216, 72, 167, 85, 200, 234, 96,
-			209, 153, 235, 147, 214, 160, 30, 214, 163, 243, 108, 127, 155, 245,
-			196, 202, 196, 152, 234, 52, 112, 200, 149, 232, 109, 110, 89, 102,
-			7, 58, 12, 17, 214, 81, 122, 191, 183, 185, 68, 210, 216, 94,
-			214, 139, 60, 27, 88, 192, 26, 92, 112, 94, 196, 231, 236, 158,
-			216, 184, 155, 13, 40, 181, 222, 58, 44, 185, 96, 187, 158, 191,
-			231, 199, 119, 177, 126, 169, 164, 91, 135, 226, 89, 38, 52, 252,
-			204, 225, 206, 199, 2, 206, 123, 25, 139, 213, 99, 73, 129, 93,
-			10, 243, 21, 104, 128, 197, 42, 174, 252, 188, 75, 233, 221, 243,
-			243, 34, 27, 110, 215, 226, 172, 201, 36, 4, 29, 58, 203, 149,
-			186, 105, 215, 187, 100, 55, 61, 181, 177, 43, 116, 51, 148, 212,
-			61, 172, 35, 49, 206, 58, 187, 200, 244, 250, 73, 161, 116, 184,
-			93, 157, 144, 208, 244, 84, 50, 50, 29, 52, 111, 221, 199, 134,
-			219, 21, 8, 249, 121, 79, 181, 34, 211, 41, 116, 88, 47, 99,
-			35, 157, 162, 162, 117, 93, 251, 177, 218, 33, 32, 203, 109, 182,
-			167, 132, 249, 32, 224, 55, 41, 101, 197, 248, 237, 33, 220, 101,
-			142, 246, 254, 81, 116, 86, 139, 5, 245, 142, 227, 221, 186, 233,
-			42, 167, 63, 117, 127, 243, 179, 146, 17, 206, 220, 250, 232, 244,
-			179, 186, 131, 186, 219, 110, 184, 15, 188, 247, 163, 58, 219, 103,
-			165, 134, 251, 254, 106, 159, 198, 62, 29, 187, 155, 252, 155, 118,
-			119, 147, 185, 59, 241, 118, 119, 105, 99, 161, 196, 11, 88, 153,
-			48, 204, 255, 67, 244, 58, 185, 230, 118, 242, 125, 116, 59, 217,
-			47, 92, 64, 172, 190, 251, 165, 91, 137, 248, 211, 232, 179, 140,
-			177, 190, 155, 241, 79, 205, 50, 14, 245, 221, 130, 127, 234, 150,
-			113, 152, 162, 155, 12, 195, 50, 198, 201, 115, 196, 48, 45, 99,
-			162, 239, 46, 246, 95, 15, 50, 61, 221, 103, 165, 206, 247, 253,
-			146, 161, 101, 190, 116, 144, 63, 132, 14, 130, 33, 95, 40, 173,
-			46, 74, 63, 118, 21, 148, 233, 6, 220, 14, 67, 191, 226, 202,
-			104, 31, 186, 116, 100, 140, 23, 148, 207, 59, 222, 103, 131, 12,
-			90, 229, 21, 191, 94, 119, 42, 210, 13, 95, 100, 92, 67, 71,
-			11, 33, 211, 139, 160, 239, 173, 192, 111, 54, 40, 119, 122, 221,
-			9, 243, 201, 222, 132, 59, 128, 168, 38, 84, 143, 163, 44, 1,
-			199, 50, 203, 111, 28, 64, 9, 84, 237, 123, 232, 87, 28, 48,
-			116, 13, 193, 218, 180, 232, 181, 203, 43, 190, 183, 85, 247, 101,
-			46, 224, 124, 18, 102, 193, 162, 228, 101, 188, 87, 9, 156, 8,
-			30, 87, 236, 192, 163, 82, 220, 34, 60, 189, 89, 217, 150, 192,
-			159, 22, 46, 141, 54, 15, 28, 140, 22, 168, 183, 121, 58, 42,
-			255, 106, 164, 112, 55, 10, 249, 218, 249, 194, 252, 237, 119, 240,
-			41, 0, 109, 237, 124, 97, 14, 231, 224, 215, 97, 169, 37, 162,
-			167, 121, 213, 221, 114, 194, 40, 215, 150, 146, 125, 179, 197, 149,
-			204, 47, 146, 169, 75, 79, 8, 233, 71, 40, 215, 64, 149, 170,
-			148, 190, 134, 42, 10, 162, 187, 7, 116, 77, 149, 153, 98, 66,
-			170, 139, 73, 245, 65, 177, 232, 105, 86, 97, 165, 180, 152, 77,
-			76, 76, 6, 185, 138, 9, 182, 186, 176, 24, 242, 169, 29, 63,
-			140, 234, 45, 244, 200, 6, 42, 198, 96, 238, 154, 93, 1, 6,
-			212, 240, 195, 216, 237, 2, 169, 172, 226, 239, 236, 64, 247, 117,
-			215, 147, 142, 24, 57, 190, 217, 20, 101, 72, 161, 143, 246, 163,
-			142, 151, 87, 23, 40, 204, 232, 4, 166, 15, 33, 149, 22, 86,
-			33, 4, 146, 66, 212, 18, 26, 201, 165, 87, 102, 198, 65, 62,
-			87, 247, 119, 157, 0, 139, 125, 111, 59, 79, 208, 79, 192, 90,
-			136, 145, 145, 223, 138, 72, 164, 34, 61, 126, 93, 143, 223, 118,
-			18, 125, 216, 121, 97, 109, 161, 84, 146, 121, 217, 166, 243, 4,
-			194, 10, 50, 134, 94, 3, 82, 238, 144, 77, 59, 116, 238, 184,
-			109, 138, 94, 224, 183, 242, 199, 30, 223, 108, 69, 206, 83, 129,
-			83, 203, 159, 183, 195, 237, 66, 125, 203, 127, 122, 90, 1, 224,
-			4, 14, 63, 78, 159, 96, 121, 234, 141, 242, 210, 76, 104, 215,
-			28, 21, 33, 69, 17, 15, 94, 149, 31, 191, 21, 95, 0, 106,
-			181, 35, 199, 179, 19, 49, 205, 128, 21, 65, 109, 18, 46, 60,
-			3, 146, 83, 186, 173, 199, 148, 200, 99, 197, 241, 4, 174, 22,
-			200, 193, 93, 80, 1, 110, 78, 116, 113, 14, 157, 250, 101, 145,
-			34, 240, 73, 183, 193, 65, 100, 117, 47, 203, 28, 12, 88, 205,
-			32, 17, 69, 19, 115, 9, 56, 236, 90, 162, 134, 54, 186, 98,
-			211, 60, 42, 190, 135, 254, 223, 64, 19, 112, 136, 242, 133, 165,
-			146, 34, 3, 25, 180, 36, 203, 167, 19, 97, 49, 85, 158, 189,
-			98, 39, 220, 182, 128, 63, 201, 114, 208, 113, 222, 114, 12, 135,
-			20, 36, 74, 126, 208, 117, 223, 191, 68, 117, 16, 2, 223, 143,
-			240, 52, 194, 211, 49, 196, 68, 92, 24, 133, 16, 146, 255, 63,
-			207, 218, 179, 155, 179, 149, 44, 197, 13, 176, 206, 82, 201, 109,
-			76, 143, 168, 70, 70, 43, 96, 47, 194, 181, 251, 120, 200, 61,
-			31, 3, 223, 113, 43, 50, 158, 85, 105, 32, 113, 172, 44, 250,
-			36, 101, 243, 121, 250, 195, 246, 90, 194, 195, 57, 116, 119, 92,
-			216, 204, 128, 34, 42, 174, 157, 136, 95, 160, 211, 152, 81, 56,
-			5, 70, 78, 196, 220, 111, 219, 198, 33, 93, 15, 214, 53, 116,
-			43, 60, 116, 183, 60, 204, 73, 0, 43, 73, 103, 89, 142, 71,
-			219, 126, 115, 107, 91, 108, 107, 191, 22, 57, 30, 83, 187, 187,
-			234, 120, 126, 228, 192, 20, 235, 118, 132, 193, 253, 145, 136, 91,
-			19, 243, 5, 68, 123, 34, 163, 99, 91, 233, 9, 6, 156, 163,
-			22, 216, 179, 145, 239, 215, 67, 146, 139, 234, 174, 215, 124, 98,
-			198, 222, 169, 222, 113, 91, 118, 175, 78, 248, 18, 188, 196, 131,
-			38, 57, 130, 195, 161, 13, 239, 51, 162, 175, 200, 161, 197, 84,
-			149, 121, 169, 118, 56, 165, 181, 241, 68, 166, 133, 54, 55, 115,
-			199, 171, 249, 1, 16, 153, 7, 12, 23, 143, 212, 48, 242, 125,
-			197, 123, 4, 73, 97, 52, 162, 93, 217, 230, 14, 121, 131, 75,
-			41, 167, 145, 36, 153, 6, 240, 174, 41, 17, 185, 20, 242, 172,
-			252, 17, 209, 46, 60, 137, 96, 233, 0, 40, 198, 179, 226, 201,
-			52, 186, 24, 98, 100, 0, 238, 10, 185, 21, 184, 188, 122, 66,
-			54, 26, 229, 249, 130, 114, 171, 71, 175, 66, 116, 17, 161, 192,
-			60, 134, 1, 196, 149, 10, 208, 40, 102, 28, 240, 235, 228, 103,
-			54, 85, 88, 88, 154, 166, 253, 42, 221, 197, 67, 190, 139, 209,
-			82, 126, 93, 108, 206, 173, 192, 246, 68, 189, 7, 248, 133, 220,
-			238, 66, 37, 158, 80, 81, 117, 130, 149, 210, 62, 33, 217, 138,
-			35, 31, 17, 230, 57, 54, 186, 58, 118, 101, 106, 194, 174, 201,
-			237, 179, 176, 88, 44, 227, 160, 194, 63, 45, 184, 95, 188, 149,
-			175, 248, 59, 192, 106, 10, 11, 75, 73, 177, 129, 60, 252, 197,
-			176, 98, 127, 33, 44, 97, 247, 215, 177, 148, 68, 169, 251, 237,
-			54, 32, 89, 226, 200, 241, 107, 170, 47, 156, 138, 215, 138, 167,
-			178, 233, 212, 253, 93, 64, 109, 114, 79, 207, 86, 97, 87, 131,
-			232, 86, 246, 129, 104, 209, 135, 18, 80, 35, 221, 248, 16, 143,
-			34, 163, 168, 220, 195, 53, 215, 131, 211, 58, 134, 10, 240, 0,
-			231, 45, 198, 72, 120, 161, 91, 117, 218, 247, 9, 214, 136, 207,
-			113, 28, 215, 37, 79, 88, 160, 205, 134, 31, 134, 174, 76, 162,
-			85, 175, 147, 111, 41, 78, 15, 9, 37, 113, 142, 119, 30, 174,
-			185, 56, 205, 136, 45, 19, 100, 36, 81, 211, 134, 145, 4, 40,
-			228, 100, 154, 238, 211, 44, 227, 124, 191, 197, 126, 193, 96, 102,
-			26, 189, 76, 203, 250, 253, 153, 247, 24, 188, 44, 106, 34, 198,
-			52, 218, 190, 98, 93, 20, 147, 199, 100, 41, 40, 195, 139, 232,
-			58, 172, 125, 15, 192, 32, 197, 83, 38, 6, 164, 139, 154, 170,
-			239, 136, 42, 23, 134, 63, 224, 234, 75, 102, 87, 99, 48, 101,
-			242, 52, 38, 103, 38, 100, 132, 50, 52, 11, 9, 220, 174, 243,
-			45, 76, 146, 197, 179, 192, 82, 102, 228, 155, 51, 151, 93, 103,
-			215, 9, 194, 44, 137, 145, 57, 70, 188, 115, 215, 21, 17, 173,
-			0, 22, 175, 97, 253, 26, 156, 71, 87, 113, 253, 184, 150, 137,
-			8, 95, 20, 19, 1, 30, 74, 147, 72, 172, 55, 192, 131, 16,
-			119, 3, 188, 73, 103, 16, 235, 158, 166, 224, 198, 123, 162, 214,
-			21, 73, 253, 58, 64, 101, 124, 121, 101, 253, 194, 217, 149, 141,
-			229, 24, 198, 33, 150, 74, 147, 163, 110, 57, 61, 46, 91, 186,
-			101, 148, 39, 110, 150, 45, 195, 50, 202, 39, 239, 99, 31, 51,
-			113, 133, 53, 203, 120, 133, 94, 206, 188, 215, 124, 238, 43, 156,
-			224, 9, 109, 83, 253, 255, 224, 202, 47, 195, 89, 71, 94, 240,
-			93, 52, 208, 206, 131, 66, 161, 235, 41, 44, 170, 178, 33, 157,
-			115, 196, 53, 21, 1, 220, 84, 80, 223, 233, 248, 172, 7, 110,
-			115, 76, 36, 243, 18, 231, 24, 37, 77, 6, 46, 17, 134, 206,
-			14, 114, 12, 16, 48, 220, 164, 100, 165, 176, 44, 112, 59, 229,
-			70, 44, 62, 69, 92, 105, 182, 6, 169, 171, 225, 4, 17, 28,
-			20, 152, 207, 170, 199, 240, 211, 138, 196, 52, 32, 156, 52, 151,
-			45, 221, 50, 94, 113, 67, 94, 182, 12, 203, 120, 197, 93, 171,
-			236, 19, 251, 144, 196, 116, 203, 136, 244, 197, 204, 135, 246, 113,
-			178, 131, 163, 118, 92, 65, 115, 229, 15, 18, 63, 121, 190, 180,
-			129, 2, 7, 5, 203, 243, 202, 182, 83, 185, 20, 242, 227, 9,
-			215, 148, 227, 113, 201, 19, 232, 184, 221, 176, 175, 202, 104, 149,
-			132, 176, 214, 181, 102, 237, 27, 174, 14, 124, 188, 197, 157, 39,
-			220, 48, 10, 99, 233, 55, 49, 156, 210, 252, 196, 228, 149, 36,
-			188, 99, 71, 149, 109, 17, 230, 76, 137, 73, 19, 95, 229, 146,
-			248, 106, 99, 49, 116, 229, 125, 97, 181, 92, 84, 150, 143, 46,
-			134, 168, 128, 150, 99, 33, 124, 188, 5, 170, 53, 40, 182, 109,
-			224, 169, 120, 78, 17, 176, 136, 238, 225, 76, 104, 24, 121, 190,
-			230, 243, 134, 29, 134, 34, 62, 47, 249, 37, 102, 166, 68, 178,
-			161, 212, 165, 110, 228, 38, 137, 156, 48, 25, 118, 243, 105, 232,
-			206, 233, 209, 161, 226, 200, 9, 26, 108, 199, 47, 219, 3, 43,
-			92, 94, 77, 21, 127, 168, 180, 182, 190, 214, 139, 77, 68, 177,
-			76, 176, 107, 183, 72, 96, 174, 59, 81, 98, 44, 95, 236, 226,
-			93, 59, 20, 155, 161, 154, 231, 171, 118, 72, 201, 22, 9, 218,
-			158, 196, 130, 165, 146, 65, 62, 5, 160, 68, 8, 1, 102, 112,
-			172, 138, 52, 237, 43, 30, 15, 155, 36, 18, 202, 74, 198, 189,
-			59, 82, 251, 140, 156, 200, 171, 73, 236, 168, 141, 14, 39, 68,
-			148, 206, 200, 22, 108, 230, 201, 105, 217, 50, 44, 35, 186, 237,
-			12, 251, 9, 58, 75, 12, 203, 124, 165, 166, 159, 205, 252, 93,
-			44, 46, 216, 34, 102, 65, 72, 157, 29, 172, 83, 132, 134, 118,
-			110, 243, 13, 15, 149, 175, 174, 123, 60, 218, 149, 34, 228, 95,
-			112, 2, 121, 2, 36, 237, 101, 83, 146, 69, 50, 133, 0, 155,
-			215, 125, 132, 131, 194, 43, 48, 244, 150, 98, 127, 49, 43, 43,
-			239, 188, 93, 83, 89, 49, 1, 88, 74, 143, 106, 123, 45, 129,
-			224, 82, 36, 213, 5, 91, 38, 253, 119, 158, 104, 224, 223, 52,
-			77, 193, 83, 73, 30, 35, 217, 174, 141, 36, 93, 170, 161, 135,
-			44, 75, 110, 61, 208, 188, 19, 135, 129, 140, 150, 167, 123, 86,
-			138, 153, 34, 81, 122, 138, 18, 119, 144, 166, 44, 140, 140, 12,
-			148, 150, 77, 7, 179, 28, 120, 212, 239, 52, 49, 76, 154, 190,
-			136, 118, 77, 46, 69, 247, 65, 230, 249, 109, 88, 20, 249, 91,
-			195, 80, 90, 146, 136, 5, 69, 120, 46, 248, 1, 217, 186, 196,
-			211, 182, 141, 79, 1, 169, 64, 15, 26, 16, 68, 250, 176, 108,
-			234, 208, 28, 191, 73, 54, 145, 92, 102, 23, 217, 59, 233, 152,
-			48, 45, 243, 167, 52, 189, 144, 121, 243, 62, 190, 4, 219, 175,
-			221, 186, 24, 54, 55, 37, 175, 230, 177, 191, 119, 194, 18, 26,
-			147, 15, 125, 45, 12, 178, 152, 189, 164, 234, 6, 64, 239, 85,
-			39, 172, 56, 94, 213, 246, 34, 60, 130, 148, 59, 126, 157, 2,
-			238, 19, 63, 11, 117, 94, 172, 10, 166, 96, 195, 234, 138, 117,
-			231, 9, 183, 226, 111, 5, 118, 99, 155, 236, 141, 93, 138, 15,
-			240, 109, 5, 248, 99, 89, 59, 155, 67, 125, 130, 254, 3, 74,
-			133, 248, 195, 153, 173, 101, 31, 207, 161, 50, 33, 10, 67, 102,
-			237, 44, 147, 185, 43, 132, 245, 234, 41, 217, 211, 105, 232, 106,
-			118, 19, 190, 144, 88, 160, 71, 149, 236, 227, 79, 195, 82, 202,
-			126, 60, 223, 155, 73, 204, 43, 191, 71, 63, 123, 64, 211, 217,
-			183, 252, 149, 6, 17, 185, 201, 197, 72, 109, 163, 196, 148, 134,
-			248, 110, 91, 57, 181, 108, 151, 93, 165, 211, 196, 148, 39, 20,
-			118, 180, 240, 3, 151, 190, 236, 120, 140, 88, 189, 28, 200, 150,
-			68, 214, 77, 174, 98, 93, 73, 185, 17, 180, 234, 183, 215, 185,
-			100, 109, 203, 44, 251, 196, 45, 231, 251, 242, 252, 39, 180, 19,
-			175, 104, 215, 114, 219, 199, 100, 29, 67, 241, 41, 16, 219, 41,
-			171, 43, 194, 44, 24, 218, 182, 235, 4, 118, 80, 217, 110, 197,
-			27, 181, 238, 99, 130, 227, 233, 28, 78, 17, 177, 217, 61, 31,
-			177, 195, 219, 245, 9, 9, 43, 134, 74, 238, 250, 193, 37, 76,
-			163, 208, 182, 125, 25, 197, 42, 6, 14, 204, 113, 51, 240, 119,
-			133, 20, 19, 40, 191, 8, 153, 197, 84, 100, 167, 193, 85, 74,
-			104, 127, 130, 163, 84, 128, 43, 129, 24, 10, 235, 230, 249, 209,
-			54, 198, 223, 213, 67, 71, 109, 105, 83, 131, 93, 154, 30, 145,
-			77, 29, 154, 163, 89, 217, 52, 160, 57, 243, 82, 246, 74, 29,
-			183, 116, 202, 50, 127, 70, 211, 207, 100, 254, 82, 227, 9, 191,
-			1, 190, 99, 195, 36, 146, 122, 176, 29, 114, 138, 131, 81, 2,
-			85, 224, 236, 248, 160, 242, 187, 17, 9, 163, 116, 217, 33, 61,
-			18, 164, 165, 146, 206, 112, 201, 125, 98, 17, 206, 174, 213, 156,
-			10, 72, 185, 81, 200, 155, 24, 42, 55, 21, 171, 216, 34, 239,
-			107, 205, 137, 42, 219, 246, 102, 221, 153, 110, 19, 45, 123, 200,
-			145, 202, 154, 67, 44, 70, 209, 122, 151, 80, 152, 208, 48, 20,
-			198, 82, 26, 32, 33, 61, 42, 155, 58, 52, 45, 46, 155, 6,
-			52, 111, 189, 159, 253, 130, 134, 24, 75, 91, 230, 123, 52, 125,
-			49, 243, 110, 141, 183, 57, 75, 244, 198, 153, 220, 81, 246, 150,
-			237, 122, 116, 50, 29, 7, 212, 93, 118, 48, 187, 100, 45, 137,
-			245, 23, 117, 146, 105, 13, 224, 78, 31, 148, 77, 29, 154, 99,
-			146, 44, 210, 6, 52, 103, 206, 176, 159, 73, 225, 36, 247, 89,
-			230, 191, 129, 73, 190, 62, 197, 219, 92, 58, 212, 162, 39, 225,
-			153, 74, 102, 188, 171, 215, 113, 73, 149, 17, 35, 199, 35, 123,
-			43, 204, 241, 192, 169, 133, 140, 59, 81, 101, 58, 166, 150, 152,
-			248, 59, 140, 52, 77, 175, 234, 231, 249, 138, 87, 113, 58, 109,
-			150, 36, 163, 85, 115, 130, 78, 182, 124, 15, 241, 3, 248, 84,
-			132, 233, 203, 32, 30, 145, 158, 168, 225, 71, 142, 135, 162, 40,
-			200, 179, 129, 99, 95, 194, 138, 168, 126, 51, 228, 13, 215, 243,
-			156, 42, 223, 118, 195, 200, 15, 48, 168, 122, 161, 180, 186, 200,
-			100, 89, 24, 50, 152, 147, 12, 223, 12, 229, 85, 150, 231, 71,
-			234, 18, 171, 98, 83, 170, 152, 28, 109, 88, 215, 227, 206, 19,
-			81, 224, 236, 192, 190, 15, 241, 38, 108, 193, 71, 163, 18, 89,
-			22, 97, 15, 73, 238, 150, 180, 11, 1, 207, 66, 209, 167, 238,
-			144, 236, 172, 236, 117, 132, 39, 186, 23, 193, 220, 18, 36, 141,
-			196, 115, 84, 114, 150, 93, 221, 113, 165, 165, 241, 52, 129, 227,
-			239, 122, 78, 16, 162, 54, 213, 193, 108, 144, 181, 2, 75, 18,
-			66, 175, 228, 50, 137, 131, 2, 79, 184, 110, 173, 170, 75, 78,
-			162, 171, 57, 236, 16, 199, 195, 19, 173, 180, 252, 242, 194, 82,
-			105, 241, 66, 161, 124, 110, 3, 179, 102, 138, 207, 164, 168, 14,
-			10, 153, 93, 7, 249, 14, 239, 95, 121, 194, 80, 226, 182, 91,
-			111, 123, 11, 46, 251, 52, 160, 80, 69, 206, 251, 116, 104, 42,
-			114, 222, 103, 64, 115, 230, 12, 123, 127, 26, 201, 185, 223, 50,
-			127, 29, 200, 249, 109, 105, 46, 93, 135, 194, 164, 237, 95, 93,
-			30, 122, 157, 120, 154, 114, 107, 82, 5, 160, 128, 48, 81, 142,
-			128, 178, 201, 251, 1, 37, 124, 36, 157, 4, 232, 100, 215, 70,
-			96, 19, 242, 79, 203, 137, 166, 241, 178, 25, 213, 33, 44, 204,
-			196, 61, 103, 87, 116, 199, 226, 149, 4, 212, 47, 32, 86, 197,
-			29, 84, 162, 216, 175, 243, 132, 83, 105, 70, 194, 116, 160, 210,
-			234, 202, 171, 67, 92, 169, 185, 60, 47, 68, 17, 136, 145, 100,
-			77, 36, 0, 122, 205, 114, 179, 133, 171, 135, 182, 223, 14, 71,
-			42, 92, 138, 121, 216, 122, 60, 233, 196, 39, 194, 215, 115, 2,
-			232, 246, 245, 65, 181, 204, 171, 146, 73, 195, 125, 210, 145, 121,
-			117, 56, 151, 175, 199, 196, 74, 247, 105, 107, 2, 113, 229, 213,
-			5, 204, 80, 228, 138, 164, 204, 42, 52, 79, 177, 8, 209, 79,
-			32, 253, 60, 161, 125, 42, 193, 25, 186, 6, 64, 55, 9, 79,
-			102, 137, 67, 109, 164, 115, 142, 196, 139, 101, 130, 46, 55, 146,
-			219, 26, 53, 50, 105, 219, 16, 241, 250, 177, 103, 35, 247, 252,
-			93, 161, 185, 162, 88, 221, 118, 225, 44, 8, 32, 177, 234, 155,
-			45, 180, 3, 3, 123, 130, 211, 57, 39, 213, 28, 198, 187, 157,
-			38, 197, 96, 241, 197, 8, 238, 168, 68, 133, 102, 73, 21, 152,
-			67, 9, 77, 41, 164, 32, 8, 205, 195, 15, 196, 105, 113, 149,
-			131, 130, 245, 216, 205, 221, 7, 69, 191, 6, 155, 69, 169, 4,
-			253, 58, 52, 199, 143, 201, 166, 1, 205, 19, 103, 216, 151, 233,
-			52, 28, 176, 204, 255, 172, 233, 15, 100, 126, 83, 19, 66, 125,
-			155, 245, 90, 17, 95, 14, 175, 253, 64, 208, 35, 179, 70, 128,
-			91, 121, 207, 137, 73, 61, 202, 15, 136, 231, 77, 127, 175, 83,
-			147, 6, 135, 158, 76, 69, 45, 78, 204, 132, 20, 54, 6, 52,
-			152, 160, 226, 51, 3, 58, 52, 199, 166, 100, 211, 128, 230, 169,
-			243, 236, 83, 36, 77, 49, 203, 252, 138, 166, 175, 102, 126, 94,
-			87, 135, 115, 247, 213, 58, 202, 121, 56, 89, 160, 78, 210, 172,
-			225, 140, 84, 119, 74, 0, 239, 203, 154, 78, 224, 138, 27, 164,
-			118, 156, 238, 117, 189, 64, 181, 77, 162, 36, 9, 49, 105, 117,
-			253, 145, 166, 19, 180, 166, 255, 97, 96, 156, 105, 128, 196, 244,
-			33, 217, 212, 161, 121, 56, 39, 155, 6, 52, 239, 92, 102, 191,
-			66, 42, 233, 160, 101, 126, 71, 211, 111, 207, 124, 116, 31, 95,
-			16, 246, 74, 98, 175, 129, 83, 35, 95, 149, 203, 162, 220, 249,
-			19, 82, 143, 19, 149, 242, 240, 13, 116, 254, 216, 105, 82, 202,
-			20, 114, 121, 193, 234, 131, 100, 181, 68, 84, 171, 15, 187, 248,
-			104, 132, 55, 114, 194, 12, 129, 210, 0, 138, 27, 194, 185, 36,
-			113, 211, 220, 81, 215, 63, 91, 7, 56, 35, 188, 110, 204, 134,
-			56, 118, 150, 206, 221, 26, 113, 126, 117, 97, 89, 85, 80, 116,
-			47, 120, 158, 23, 241, 42, 76, 118, 134, 211, 241, 88, 172, 47,
-			100, 11, 116, 145, 150, 61, 147, 229, 210, 179, 6, 206, 122, 212,
-			182, 101, 94, 24, 0, 17, 237, 201, 64, 48, 120, 167, 45, 14,
-			28, 187, 178, 45, 196, 72, 188, 125, 247, 69, 185, 25, 27, 199,
-			161, 210, 135, 10, 17, 148, 62, 184, 94, 111, 241, 29, 251, 146,
-			16, 14, 213, 143, 217, 170, 27, 86, 252, 203, 78, 32, 230, 41,
-			164, 26, 180, 16, 129, 88, 35, 78, 220, 154, 68, 36, 213, 181,
-			175, 183, 200, 115, 172, 109, 211, 8, 155, 25, 166, 18, 100, 100,
-			53, 20, 215, 27, 126, 24, 205, 4, 9, 199, 118, 46, 98, 78,
-			92, 111, 235, 121, 75, 52, 130, 187, 182, 155, 230, 95, 96, 225,
-			70, 244, 157, 32, 41, 194, 5, 18, 33, 234, 175, 29, 226, 15,
-			231, 61, 237, 189, 162, 219, 228, 161, 68, 42, 20, 89, 156, 4,
-			54, 4, 52, 133, 51, 43, 229, 245, 120, 222, 234, 35, 144, 150,
-			145, 216, 106, 100, 127, 139, 227, 118, 122, 221, 119, 169, 180, 166,
-			157, 167, 161, 131, 154, 29, 105, 243, 155, 129, 127, 201, 161, 84,
-			161, 221, 194, 179, 218, 242, 131, 26, 236, 226, 244, 1, 217, 212,
-			161, 57, 34, 121, 238, 160, 1, 205, 235, 79, 177, 255, 70, 71,
-			206, 144, 101, 254, 29, 168, 172, 127, 160, 241, 178, 208, 70, 144,
-			42, 133, 121, 151, 219, 8, 63, 136, 239, 52, 65, 169, 84, 32,
-			160, 226, 189, 31, 88, 130, 232, 45, 237, 14, 105, 48, 103, 133,
-			160, 33, 29, 154, 35, 82, 67, 29, 50, 160, 121, 235, 253, 236,
-			187, 132, 160, 253, 150, 249, 106, 93, 191, 59, 243, 117, 121, 38,
-			131, 238, 37, 242, 71, 85, 233, 86, 174, 215, 177, 12, 219, 87,
-			132, 149, 199, 39, 244, 243, 66, 148, 56, 76, 254, 30, 16, 181,
-			95, 131, 185, 167, 135, 101, 83, 135, 230, 129, 235, 101, 211, 128,
-			230, 244, 93, 236, 27, 131, 136, 168, 97, 203, 124, 175, 174, 47,
-			102, 126, 119, 144, 147, 127, 57, 30, 184, 177, 143, 35, 158, 202,
-			237, 188, 14, 115, 24, 186, 117, 66, 87, 120, 201, 109, 196, 210,
-			95, 236, 18, 98, 83, 201, 207, 117, 60, 212, 3, 135, 103, 47,
-			57, 173, 211, 148, 7, 150, 55, 108, 183, 199, 126, 74, 184, 44,
-			118, 30, 51, 210, 235, 42, 121, 206, 0, 102, 241, 76, 39, 51,
-			159, 146, 58, 99, 126, 137, 2, 185, 31, 200, 195, 136, 37, 42,
-			240, 156, 198, 204, 223, 0, 37, 30, 127, 9, 71, 22, 152, 59,
-			22, 0, 67, 221, 209, 75, 176, 134, 54, 89, 78, 148, 134, 178,
-			183, 246, 72, 69, 235, 198, 82, 160, 48, 12, 87, 182, 241, 245,
-			206, 163, 210, 99, 28, 99, 214, 228, 205, 91, 194, 93, 81, 225,
-			47, 62, 91, 18, 249, 178, 196, 245, 20, 73, 55, 245, 86, 242,
-			192, 84, 86, 36, 58, 138, 66, 199, 195, 234, 83, 62, 225, 75,
-			93, 218, 246, 68, 154, 88, 10, 37, 146, 229, 184, 93, 9, 252,
-			16, 150, 184, 30, 31, 172, 211, 234, 86, 175, 197, 171, 62, 208,
-			32, 101, 14, 197, 187, 50, 194, 200, 105, 30, 98, 186, 94, 123,
-			75, 214, 21, 73, 160, 153, 241, 157, 102, 61, 114, 27, 245, 54,
-			127, 75, 153, 184, 23, 253, 194, 130, 100, 230, 54, 57, 44, 74,
-			45, 202, 43, 175, 222, 34, 203, 10, 159, 106, 210, 93, 12, 108,
-			242, 105, 186, 113, 144, 46, 89, 4, 146, 240, 228, 4, 37, 195,
-			173, 228, 232, 114, 154, 17, 248, 50, 207, 45, 29, 252, 177, 176,
-			17, 95, 51, 163, 155, 68, 135, 4, 43, 179, 131, 161, 27, 55,
-			117, 171, 228, 136, 88, 137, 137, 37, 22, 244, 172, 217, 105, 248,
-			1, 150, 142, 68, 174, 0, 128, 43, 74, 238, 65, 163, 56, 87,
-			68, 25, 9, 29, 128, 201, 23, 86, 232, 0, 8, 4, 61, 170,
-			181, 249, 95, 86, 236, 248, 95, 65, 200, 24, 214, 128, 219, 43,
-			187, 248, 176, 14, 77, 101, 23, 31, 54, 160, 57, 115, 134, 189,
-			146, 46, 74, 15, 88, 230, 199, 225, 104, 248, 75, 131, 83, 204,
-			80, 175, 163, 129, 230, 214, 226, 187, 78, 224, 180, 105, 111, 184,
-			7, 208, 248, 208, 195, 100, 24, 248, 205, 200, 245, 28, 216, 192,
-			116, 11, 26, 201, 115, 162, 237, 218, 93, 109, 173, 41, 101, 127,
-			132, 94, 170, 8, 141, 50, 138, 151, 200, 243, 179, 82, 177, 67,
-			153, 2, 186, 25, 58, 181, 38, 230, 192, 230, 53, 247, 9, 88,
-			153, 176, 18, 160, 193, 41, 20, 254, 162, 249, 206, 237, 144, 99,
-			60, 142, 209, 34, 139, 6, 229, 80, 198, 29, 177, 217, 34, 251,
-			194, 154, 56, 6, 132, 143, 10, 64, 67, 196, 186, 150, 67, 95,
-			92, 185, 101, 161, 147, 233, 231, 75, 255, 94, 167, 45, 227, 239,
-			129, 254, 5, 77, 28, 208, 128, 8, 20, 197, 28, 208, 161, 169,
-			40, 230, 128, 1, 205, 153, 51, 236, 153, 126, 164, 152, 17, 203,
-			252, 13, 93, 95, 202, 252, 92, 127, 111, 97, 66, 185, 21, 56,
-			94, 132, 170, 125, 183, 96, 81, 72, 176, 183, 182, 215, 91, 164,
-			168, 94, 114, 90, 51, 148, 35, 18, 164, 136, 156, 240, 53, 135,
-			153, 92, 114, 232, 21, 150, 240, 142, 23, 113, 1, 210, 3, 69,
-			228, 213, 238, 168, 103, 179, 89, 247, 55, 69, 65, 212, 219, 231,
-			230, 249, 131, 155, 88, 39, 205, 125, 82, 232, 200, 34, 58, 227,
-			146, 144, 63, 240, 160, 83, 167, 87, 124, 70, 137, 178, 51, 221,
-			27, 24, 237, 223, 74, 237, 36, 41, 161, 98, 215, 59, 102, 66,
-			212, 95, 117, 170, 77, 74, 229, 237, 84, 79, 139, 237, 132, 19,
-			32, 249, 138, 197, 124, 90, 225, 134, 34, 85, 124, 31, 51, 112,
-			55, 145, 108, 19, 238, 27, 181, 182, 243, 130, 208, 131, 192, 239,
-			216, 13, 137, 60, 68, 170, 72, 141, 238, 199, 190, 16, 116, 140,
-			139, 121, 17, 42, 186, 23, 164, 59, 62, 36, 225, 157, 113, 154,
-			219, 192, 235, 182, 69, 109, 52, 225, 224, 239, 84, 25, 14, 104,
-			203, 178, 135, 124, 42, 116, 28, 222, 153, 179, 68, 165, 143, 172,
-			197, 233, 38, 167, 241, 118, 49, 33, 66, 229, 88, 178, 88, 71,
-			14, 203, 1, 129, 218, 40, 8, 139, 192, 68, 183, 22, 64, 27,
-			172, 107, 123, 244, 227, 181, 19, 234, 7, 236, 132, 26, 209, 128,
-			133, 164, 199, 100, 83, 135, 230, 161, 19, 178, 105, 64, 243, 246,
-			7, 216, 47, 211, 21, 221, 168, 101, 126, 5, 248, 205, 135, 83,
-			189, 79, 168, 46, 126, 179, 231, 105, 85, 20, 47, 160, 236, 216,
-			70, 211, 20, 144, 145, 160, 106, 114, 187, 32, 102, 32, 220, 55,
-			200, 5, 7, 4, 61, 164, 110, 242, 75, 236, 216, 224, 237, 57,
-			90, 175, 78, 243, 76, 17, 125, 78, 126, 42, 42, 25, 76, 185,
-			181, 61, 191, 12, 133, 255, 54, 227, 61, 195, 124, 137, 123, 29,
-			191, 228, 180, 142, 83, 244, 13, 194, 119, 156, 55, 252, 6, 1,
-			79, 87, 213, 158, 31, 177, 118, 247, 64, 188, 190, 137, 108, 88,
-			65, 156, 93, 187, 89, 112, 207, 121, 160, 38, 34, 16, 34, 28,
-			203, 109, 222, 43, 64, 24, 207, 74, 2, 25, 75, 113, 200, 142,
-			168, 108, 88, 155, 195, 94, 204, 240, 254, 225, 31, 175, 163, 26,
-			80, 176, 34, 247, 81, 29, 154, 138, 220, 71, 13, 104, 222, 254,
-			0, 123, 11, 249, 185, 91, 150, 249, 13, 93, 63, 151, 121, 141,
-			33, 140, 26, 93, 244, 157, 212, 100, 59, 14, 214, 34, 145, 170,
-			114, 53, 107, 59, 38, 132, 7, 146, 236, 6, 55, 118, 236, 151,
-			122, 201, 105, 137, 144, 157, 186, 27, 70, 44, 225, 105, 36, 8,
-			179, 77, 254, 199, 90, 190, 152, 64, 184, 3, 56, 12, 241, 77,
-			24, 91, 24, 153, 88, 158, 183, 152, 244, 125, 177, 177, 60, 187,
-			117, 180, 52, 88, 154, 180, 37, 155, 58, 52, 15, 222, 34, 155,
-			6, 52, 231, 138, 236, 191, 210, 58, 30, 180, 204, 239, 234, 250,
-			75, 51, 95, 52, 248, 58, 234, 109, 177, 193, 60, 33, 176, 4,
-			20, 46, 24, 82, 52, 13, 158, 204, 87, 48, 144, 192, 241, 220,
-			209, 137, 84, 237, 208, 151, 88, 248, 106, 21, 196, 143, 51, 50,
-			186, 47, 97, 111, 16, 78, 128, 89, 123, 179, 82, 117, 106, 249,
-			124, 62, 159, 205, 203, 175, 2, 167, 134, 22, 6, 249, 142, 176,
-			184, 171, 223, 209, 82, 64, 63, 201, 192, 210, 185, 252, 220, 201,
-			252, 169, 236, 15, 226, 234, 182, 153, 67, 19, 43, 45, 64, 191,
-			218, 217, 10, 154, 184, 90, 157, 200, 239, 97, 203, 80, 132, 113,
-			80, 131, 181, 86, 27, 252, 160, 14, 77, 181, 193, 15, 26, 208,
-			188, 253, 94, 246, 163, 116, 119, 54, 102, 153, 255, 212, 208, 239,
-			207, 124, 71, 227, 171, 20, 92, 136, 190, 169, 238, 150, 231, 84,
-			227, 42, 138, 73, 19, 82, 228, 147, 135, 80, 151, 55, 133, 10,
-			118, 252, 129, 199, 127, 130, 134, 9, 43, 99, 26, 160, 65, 225,
-			108, 76, 135, 166, 194, 217, 152, 1, 205, 219, 239, 99, 159, 39,
-			45, 245, 144, 101, 190, 193, 208, 215, 50, 255, 46, 142, 13, 73,
-			56, 205, 138, 168, 202, 110, 15, 0, 58, 111, 26, 142, 87, 21,
-			97, 122, 232, 255, 30, 162, 240, 171, 92, 32, 112, 58, 241, 69,
-			157, 56, 144, 9, 108, 59, 36, 103, 58, 188, 161, 87, 24, 223,
-			181, 219, 28, 63, 177, 204, 114, 139, 239, 110, 251, 59, 228, 254,
-			67, 226, 169, 8, 119, 194, 136, 153, 228, 173, 39, 90, 198, 224,
-			41, 230, 162, 67, 233, 189, 94, 79, 10, 95, 226, 222, 212, 171,
-			162, 111, 112, 132, 162, 128, 237, 209, 181, 230, 67, 118, 139, 156,
-			114, 219, 110, 226, 66, 44, 16, 19, 3, 72, 241, 216, 21, 135,
-			130, 0, 114, 104, 7, 21, 94, 165, 152, 122, 237, 4, 147, 152,
-			104, 199, 2, 74, 70, 53, 187, 30, 58, 50, 12, 64, 253, 34,
-			156, 6, 66, 244, 202, 233, 68, 8, 57, 169, 255, 32, 18, 225,
-			213, 120, 250, 33, 13, 40, 75, 57, 1, 28, 210, 161, 57, 158,
-			151, 77, 3, 154, 119, 189, 140, 253, 83, 226, 233, 135, 45, 243,
-			237, 134, 254, 80, 230, 219, 250, 21, 201, 144, 66, 197, 73, 4,
-			140, 99, 218, 248, 70, 216, 153, 196, 159, 135, 78, 189, 54, 67,
-			46, 239, 68, 1, 85, 17, 5, 156, 196, 227, 53, 221, 33, 161,
-			59, 28, 214, 96, 13, 20, 223, 56, 172, 67, 83, 241, 141, 195,
-			6, 52, 111, 127, 144, 189, 153, 225, 130, 141, 91, 230, 255, 101,
-			232, 219, 153, 31, 99, 87, 88, 48, 76, 101, 135, 30, 191, 79,
-			68, 88, 252, 141, 42, 94, 243, 77, 153, 95, 165, 35, 210, 148,
-			73, 227, 177, 244, 242, 150, 150, 96, 155, 123, 110, 5, 131, 170,
-			147, 245, 168, 100, 57, 17, 252, 8, 19, 168, 80, 240, 118, 152,
-			136, 47, 160, 24, 234, 242, 234, 130, 42, 117, 9, 252, 19, 157,
-			255, 200, 229, 39, 54, 218, 111, 54, 189, 202, 118, 194, 92, 13,
-			140, 133, 97, 8, 71, 158, 23, 177, 74, 135, 52, 120, 73, 83,
-			183, 125, 165, 32, 77, 62, 149, 112, 203, 107, 139, 214, 65, 206,
-			178, 187, 237, 215, 29, 178, 182, 19, 131, 36, 150, 211, 149, 51,
-			46, 17, 81, 202, 120, 197, 15, 200, 145, 9, 217, 110, 91, 102,
-			58, 105, 84, 0, 29, 253, 202, 153, 238, 24, 95, 149, 145, 167,
-			228, 54, 228, 132, 152, 126, 161, 233, 145, 229, 132, 102, 185, 107,
-			183, 122, 239, 145, 88, 176, 149, 2, 147, 127, 197, 123, 181, 239,
-			113, 179, 160, 227, 155, 140, 231, 86, 101, 101, 187, 73, 72, 4,
-			45, 92, 117, 111, 125, 255, 182, 21, 160, 174, 120, 26, 5, 255,
-			100, 34, 21, 169, 135, 170, 210, 99, 33, 50, 234, 181, 117, 238,
-			120, 85, 50, 191, 136, 50, 163, 177, 160, 194, 200, 33, 15, 20,
-			129, 203, 34, 118, 141, 50, 39, 227, 245, 74, 247, 118, 162, 26,
-			148, 231, 138, 235, 124, 86, 253, 56, 123, 143, 248, 245, 190, 217,
-			91, 103, 239, 17, 107, 119, 31, 99, 98, 52, 244, 142, 71, 208,
-			184, 205, 79, 157, 156, 231, 129, 35, 60, 239, 201, 170, 69, 82,
-			84, 51, 168, 171, 211, 228, 34, 200, 71, 23, 21, 36, 157, 204,
-			117, 229, 193, 206, 51, 5, 78, 119, 89, 55, 17, 209, 54, 213,
-			112, 130, 109, 187, 33, 110, 122, 236, 122, 189, 53, 221, 193, 37,
-			219, 168, 207, 67, 227, 68, 39, 21, 198, 87, 45, 49, 193, 125,
-			223, 15, 72, 193, 37, 199, 53, 96, 139, 233, 235, 100, 83, 135,
-			230, 245, 247, 200, 166, 1, 205, 115, 53, 246, 13, 141, 233, 169,
-			62, 43, 253, 25, 163, 239, 63, 27, 90, 230, 181, 26, 134, 159,
-			139, 251, 44, 215, 235, 112, 152, 226, 133, 133, 37, 97, 65, 108,
-			134, 78, 16, 155, 81, 147, 182, 26, 114, 77, 192, 94, 40, 43,
-			78, 167, 211, 21, 26, 93, 182, 28, 140, 10, 149, 193, 160, 155,
-			20, 19, 147, 8, 187, 201, 179, 249, 149, 217, 23, 244, 31, 99,
-			131, 204, 72, 245, 105, 150, 249, 25, 35, 53, 192, 134, 152, 153,
-			194, 34, 165, 191, 106, 232, 227, 128, 151, 20, 149, 210, 251, 85,
-			67, 183, 100, 83, 135, 230, 161, 195, 236, 29, 26, 190, 172, 89,
-			230, 127, 48, 244, 253, 153, 159, 208, 120, 217, 177, 171, 78, 64,
-			215, 110, 36, 184, 119, 59, 199, 97, 56, 138, 116, 168, 151, 74,
-			52, 221, 233, 138, 168, 126, 150, 188, 136, 196, 104, 51, 244, 47,
-			167, 75, 221, 26, 90, 140, 200, 138, 35, 23, 160, 163, 27, 88,
-			143, 105, 90, 243, 20, 149, 224, 251, 15, 6, 213, 5, 76, 97,
-			148, 172, 249, 31, 140, 193, 33, 246, 191, 17, 244, 186, 101, 254,
-			6, 64, 255, 36, 127, 24, 144, 46, 128, 175, 250, 220, 185, 236,
-			4, 45, 138, 190, 144, 247, 40, 114, 110, 57, 222, 168, 55, 101,
-			196, 32, 250, 162, 201, 141, 157, 99, 210, 97, 181, 199, 157, 42,
-			201, 193, 98, 54, 59, 254, 101, 186, 209, 85, 128, 2, 34, 127,
-			35, 6, 148, 32, 27, 28, 98, 31, 37, 64, 13, 203, 252, 45,
-			67, 31, 202, 188, 75, 227, 43, 228, 244, 189, 7, 160, 187, 241,
-			60, 4, 160, 24, 48, 211, 137, 43, 52, 194, 245, 8, 47, 86,
-			17, 229, 9, 178, 163, 251, 112, 244, 29, 105, 237, 221, 81, 27,
-			157, 138, 89, 24, 26, 130, 189, 79, 54, 117, 104, 178, 65, 54,
-			132, 197, 208, 210, 159, 55, 250, 190, 104, 104, 64, 130, 38, 80,
-			217, 231, 141, 254, 67, 236, 94, 89, 39, 247, 11, 134, 62, 150,
-			153, 229, 133, 56, 64, 8, 102, 217, 225, 191, 173, 212, 122, 204,
-			43, 145, 79, 86, 131, 252, 130, 209, 86, 13, 242, 11, 198, 192,
-			129, 68, 53, 200, 47, 24, 214, 65, 246, 70, 141, 233, 166, 102,
-			165, 127, 223, 232, 251, 167, 166, 150, 249, 103, 90, 103, 148, 166,
-			155, 48, 67, 73, 255, 26, 59, 34, 145, 166, 203, 94, 17, 11,
-			70, 165, 72, 69, 83, 161, 47, 57, 150, 85, 109, 139, 215, 111,
-			195, 110, 123, 28, 23, 96, 3, 168, 246, 247, 141, 254, 195, 108,
-			152, 153, 166, 102, 244, 89, 233, 175, 26, 250, 159, 26, 6, 78,
-			64, 51, 96, 62, 95, 133, 198, 44, 75, 67, 19, 208, 245, 135,
-			134, 105, 101, 142, 33, 171, 146, 241, 175, 110, 136, 137, 61, 164,
-			250, 3, 203, 114, 128, 237, 163, 15, 210, 248, 69, 127, 252, 64,
-			131, 7, 3, 251, 227, 7, 6, 60, 24, 25, 101, 63, 174, 137,
-			65, 52, 203, 252, 154, 97, 222, 152, 121, 18, 36, 250, 128, 40,
-			5, 195, 250, 147, 46, 175, 209, 118, 178, 56, 38, 176, 188, 56,
-			123, 11, 138, 64, 20, 178, 129, 233, 106, 178, 247, 92, 114, 189,
-			234, 125, 167, 239, 65, 67, 237, 125, 89, 185, 152, 216, 233, 233,
-			124, 62, 79, 30, 148, 192, 86, 79, 147, 193, 70, 65, 167, 153,
-			8, 204, 80, 252, 32, 5, 15, 246, 31, 140, 31, 32, 184, 99,
-			215, 199, 15, 12, 120, 112, 67, 150, 189, 74, 3, 164, 2, 206,
-			254, 28, 72, 236, 9, 177, 232, 132, 176, 228, 213, 83, 188, 226,
-			93, 100, 166, 202, 176, 42, 117, 63, 145, 57, 134, 203, 120, 177,
-			28, 15, 125, 181, 97, 154, 97, 196, 61, 216, 173, 32, 54, 100,
-			37, 173, 106, 72, 171, 127, 46, 105, 85, 195, 133, 248, 115, 73,
-			171, 26, 46, 195, 159, 3, 173, 190, 155, 160, 214, 44, 243, 219,
-			134, 126, 36, 243, 47, 53, 94, 240, 184, 223, 176, 127, 164, 169,
-			110, 2, 41, 59, 66, 236, 169, 211, 38, 0, 183, 137, 131, 137,
-			137, 38, 53, 180, 94, 73, 114, 241, 218, 41, 112, 46, 3, 147,
-			160, 68, 55, 56, 130, 93, 231, 254, 101, 39, 64, 110, 131, 87,
-			96, 162, 68, 173, 156, 21, 172, 199, 183, 227, 89, 105, 8, 247,
-			192, 152, 108, 26, 208, 28, 159, 96, 255, 140, 102, 165, 91, 230,
-			95, 27, 122, 46, 211, 228, 15, 11, 45, 59, 198, 39, 200, 37,
-			202, 111, 45, 146, 197, 169, 35, 119, 199, 33, 195, 128, 103, 111,
-			197, 250, 37, 73, 111, 57, 238, 110, 121, 126, 0, 34, 35, 116,
-			39, 60, 58, 34, 191, 231, 4, 21, 200, 122, 26, 161, 152, 148,
-			77, 13, 154, 71, 143, 203, 166, 1, 205, 19, 183, 178, 159, 34,
-			144, 13, 203, 252, 159, 176, 16, 175, 214, 120, 9, 81, 30, 181,
-			228, 58, 248, 53, 208, 31, 112, 169, 219, 0, 79, 24, 254, 95,
-			188, 89, 24, 41, 4, 76, 34, 30, 56, 240, 255, 140, 17, 111,
-			32, 216, 227, 19, 236, 53, 52, 11, 211, 50, 255, 137, 169, 79,
-			100, 158, 194, 99, 147, 40, 8, 88, 87, 235, 89, 49, 46, 220,
-			202, 59, 118, 163, 129, 85, 241, 3, 127, 135, 97, 160, 144, 72,
-			154, 163, 210, 244, 52, 247, 102, 23, 110, 164, 0, 55, 9, 22,
-			213, 76, 67, 115, 112, 88, 54, 53, 104, 30, 56, 40, 155, 6,
-			52, 15, 143, 227, 73, 162, 91, 233, 31, 53, 251, 94, 107, 210,
-			73, 2, 235, 246, 163, 102, 255, 49, 246, 126, 157, 153, 166, 14,
-			251, 252, 199, 77, 125, 62, 243, 54, 157, 175, 58, 193, 76, 231,
-			249, 165, 166, 44, 175, 236, 85, 110, 16, 63, 168, 202, 80, 15,
-			241, 81, 221, 241, 182, 162, 237, 174, 128, 99, 92, 30, 33, 121,
-			182, 93, 240, 96, 36, 114, 50, 139, 210, 108, 53, 43, 15, 48,
-			244, 107, 106, 49, 21, 101, 46, 47, 18, 224, 155, 142, 152, 101,
-			241, 225, 20, 158, 126, 118, 68, 128, 81, 150, 33, 201, 146, 24,
-			250, 42, 54, 72, 64, 165, 234, 152, 162, 52, 190, 77, 194, 244,
-			182, 125, 153, 108, 239, 173, 238, 28, 87, 66, 90, 2, 92, 153,
-			136, 44, 213, 76, 67, 115, 240, 136, 108, 106, 208, 204, 204, 200,
-			166, 1, 205, 147, 115, 184, 6, 134, 149, 254, 9, 179, 239, 95,
-			136, 53, 0, 170, 251, 9, 179, 255, 58, 60, 191, 12, 56, 191,
-			126, 210, 212, 223, 96, 210, 249, 101, 224, 249, 245, 147, 38, 131,
-			227, 45, 13, 77, 88, 163, 215, 155, 166, 133, 188, 218, 16, 199,
-			211, 235, 77, 113, 60, 25, 226, 120, 122, 189, 41, 142, 39, 67,
-			28, 79, 175, 55, 71, 70, 217, 50, 140, 0, 29, 188, 217, 212,
-			179, 153, 251, 249, 134, 39, 215, 13, 175, 250, 131, 43, 166, 61,
-			232, 76, 79, 34, 16, 97, 32, 34, 222, 44, 17, 97, 32, 60,
-			111, 54, 7, 199, 101, 83, 131, 230, 196, 117, 178, 105, 64, 147,
-			223, 192, 30, 103, 64, 197, 233, 183, 153, 125, 239, 52, 181, 23,
-			69, 84, 55, 97, 31, 188, 205, 236, 63, 130, 2, 172, 105, 194,
-			196, 127, 218, 212, 199, 50, 79, 94, 69, 80, 2, 210, 174, 99,
-			100, 70, 219, 73, 198, 49, 17, 178, 252, 18, 29, 131, 66, 159,
-			41, 61, 16, 19, 104, 238, 56, 182, 23, 242, 44, 5, 0, 214,
-			58, 250, 149, 231, 152, 137, 231, 216, 79, 155, 130, 241, 152, 136,
-			163, 159, 54, 197, 57, 102, 34, 142, 126, 218, 180, 14, 178, 117,
-			4, 91, 179, 204, 127, 101, 234, 135, 50, 103, 121, 169, 70, 150,
-			215, 156, 96, 23, 123, 37, 11, 168, 37, 84, 192, 100, 170, 24,
-			180, 42, 136, 65, 224, 208, 249, 87, 166, 158, 150, 77, 28, 101,
-			223, 136, 108, 26, 208, 60, 56, 198, 94, 138, 32, 232, 150, 249,
-			179, 166, 158, 201, 204, 161, 201, 55, 104, 58, 178, 84, 174, 35,
-			2, 161, 99, 75, 147, 194, 102, 216, 172, 71, 106, 52, 61, 133,
-			61, 200, 209, 96, 78, 63, 107, 238, 59, 36, 155, 6, 52, 39,
-			142, 224, 238, 72, 89, 233, 119, 155, 125, 31, 16, 187, 35, 165,
-			89, 230, 187, 205, 254, 12, 43, 50, 211, 76, 193, 18, 190, 215,
-			212, 143, 101, 238, 228, 75, 157, 185, 13, 84, 210, 3, 193, 73,
-			69, 218, 66, 1, 22, 122, 197, 10, 112, 82, 72, 178, 239, 149,
-			36, 155, 194, 229, 120, 175, 57, 56, 42, 155, 26, 52, 173, 140,
-			108, 26, 208, 188, 238, 122, 44, 136, 158, 2, 208, 223, 15, 32,
-			148, 174, 14, 66, 101, 219, 173, 39, 50, 10, 76, 137, 92, 103,
-			60, 10, 108, 23, 67, 14, 143, 207, 30, 159, 86, 64, 129, 156,
-			246, 254, 24, 40, 88, 160, 247, 199, 64, 105, 56, 174, 2, 10,
-			22, 232, 253, 0, 212, 27, 64, 46, 79, 91, 233, 143, 152, 125,
-			31, 53, 181, 204, 60, 111, 207, 48, 47, 114, 68, 198, 90, 116,
-			119, 172, 252, 139, 179, 249, 210, 154, 101, 126, 196, 236, 63, 12,
-			122, 178, 153, 134, 133, 251, 121, 83, 167, 5, 79, 35, 194, 127,
-			94, 210, 127, 26, 17, 254, 243, 230, 192, 136, 108, 26, 208, 60,
-			56, 6, 106, 179, 158, 210, 172, 244, 47, 153, 125, 255, 214, 212,
-			50, 247, 241, 238, 122, 13, 210, 187, 22, 208, 221, 21, 220, 88,
-			94, 93, 72, 132, 75, 190, 56, 246, 0, 88, 151, 95, 50, 83,
-			227, 104, 15, 64, 73, 249, 19, 166, 142, 23, 190, 41, 18, 80,
-			63, 97, 234, 55, 200, 166, 14, 205, 155, 110, 102, 5, 124, 87,
-			179, 204, 79, 154, 250, 145, 236, 169, 118, 219, 30, 8, 113, 73,
-			55, 219, 228, 61, 18, 198, 77, 123, 254, 46, 19, 61, 106, 212,
-			199, 144, 108, 234, 208, 28, 159, 96, 119, 226, 0, 186, 101, 254,
-			50, 12, 48, 221, 62, 64, 103, 94, 38, 25, 39, 183, 242, 160,
-			236, 22, 64, 251, 101, 83, 31, 147, 77, 236, 104, 124, 130, 221,
-			143, 221, 26, 150, 249, 12, 116, 59, 223, 222, 109, 236, 147, 158,
-			140, 14, 150, 241, 173, 120, 197, 47, 251, 135, 51, 239, 25, 83,
-			31, 150, 77, 29, 154, 227, 19, 172, 200, 116, 115, 159, 149, 254,
-			140, 217, 247, 27, 166, 150, 185, 51, 206, 214, 234, 82, 236, 186,
-			138, 70, 75, 58, 179, 197, 113, 128, 242, 26, 5, 232, 111, 159,
-			102, 153, 159, 49, 251, 71, 216, 29, 204, 52, 247, 193, 186, 252,
-			26, 48, 209, 41, 94, 72, 230, 27, 141, 131, 63, 218, 115, 41,
-			138, 77, 185, 15, 41, 245, 215, 36, 165, 238, 195, 5, 253, 53,
-			73, 169, 251, 144, 82, 127, 13, 40, 245, 126, 28, 68, 179, 204,
-			127, 111, 234, 71, 50, 243, 116, 81, 238, 4, 120, 193, 38, 18,
-			136, 180, 93, 198, 74, 54, 41, 194, 166, 213, 112, 90, 26, 187,
-			24, 148, 77, 236, 113, 104, 76, 54, 13, 104, 142, 79, 32, 87,
-			222, 7, 171, 242, 89, 83, 159, 204, 204, 161, 146, 9, 114, 116,
-			146, 84, 218, 198, 156, 242, 155, 81, 163, 73, 71, 198, 180, 26,
-			13, 184, 242, 103, 227, 201, 1, 252, 159, 53, 7, 14, 203, 166,
-			1, 205, 35, 25, 49, 154, 97, 153, 159, 51, 245, 124, 102, 46,
-			214, 59, 246, 186, 237, 236, 61, 154, 145, 198, 30, 38, 101, 83,
-			131, 230, 209, 105, 217, 196, 254, 115, 51, 120, 6, 244, 91, 233,
-			255, 104, 246, 125, 69, 156, 1, 253, 154, 101, 254, 71, 179, 159,
-			179, 128, 153, 102, 63, 44, 229, 111, 155, 58, 207, 84, 249, 74,
-			51, 170, 248, 241, 90, 170, 200, 231, 156, 202, 107, 167, 210, 96,
-			82, 138, 74, 183, 78, 142, 249, 120, 231, 75, 37, 228, 49, 201,
-			40, 221, 173, 136, 251, 78, 74, 233, 139, 217, 170, 5, 236, 253,
-			40, 212, 252, 182, 216, 16, 208, 212, 160, 121, 104, 82, 54, 13,
-			104, 94, 127, 140, 253, 152, 134, 16, 106, 150, 249, 5, 208, 20,
-			118, 81, 246, 85, 87, 19, 137, 40, 103, 224, 194, 61, 130, 159,
-			85, 198, 19, 121, 195, 172, 114, 1, 199, 150, 190, 60, 6, 118,
-			129, 192, 6, 243, 234, 17, 153, 174, 128, 6, 98, 250, 130, 60,
-			80, 250, 145, 152, 190, 96, 14, 30, 148, 77, 3, 154, 135, 199,
-			217, 71, 8, 104, 221, 50, 191, 12, 104, 125, 167, 150, 128, 186,
-			189, 127, 5, 94, 50, 106, 63, 17, 112, 222, 233, 149, 192, 120,
-			228, 111, 57, 136, 91, 20, 192, 37, 47, 144, 113, 238, 145, 159,
-			12, 162, 111, 247, 86, 72, 206, 146, 201, 4, 194, 2, 147, 106,
-			134, 160, 134, 126, 217, 212, 71, 101, 83, 131, 166, 37, 151, 5,
-			72, 246, 203, 176, 44, 32, 107, 14, 88, 233, 63, 54, 251, 190,
-			245, 98, 201, 154, 3, 154, 101, 254, 177, 217, 127, 20, 56, 175,
-			57, 0, 52, 250, 53, 96, 55, 211, 124, 89, 48, 155, 248, 4,
-			22, 210, 101, 91, 36, 179, 152, 209, 0, 242, 155, 175, 201, 45,
-			57, 128, 132, 246, 53, 201, 111, 6, 144, 208, 190, 6, 252, 230,
-			1, 28, 69, 179, 204, 175, 155, 250, 120, 230, 30, 190, 220, 220,
-			217, 164, 44, 206, 137, 120, 13, 95, 230, 8, 242, 61, 180, 176,
-			55, 144, 209, 85, 157, 154, 45, 210, 68, 205, 157, 60, 169, 6,
-			6, 113, 227, 235, 38, 153, 31, 161, 137, 125, 247, 143, 202, 166,
-			1, 205, 177, 195, 108, 27, 7, 214, 45, 243, 155, 192, 232, 30,
-			229, 47, 151, 101, 250, 143, 119, 84, 19, 60, 30, 167, 54, 105,
-			4, 206, 101, 204, 48, 18, 159, 197, 140, 47, 180, 59, 175, 128,
-			188, 184, 227, 144, 41, 156, 34, 70, 104, 96, 96, 81, 223, 140,
-			241, 1, 83, 254, 166, 57, 112, 80, 54, 13, 104, 30, 158, 64,
-			166, 193, 172, 244, 95, 154, 125, 255, 143, 96, 26, 76, 179, 204,
-			191, 4, 181, 170, 204, 76, 147, 193, 130, 124, 7, 148, 158, 69,
-			149, 60, 59, 97, 110, 142, 157, 216, 186, 28, 216, 122, 7, 235,
-			195, 232, 12, 165, 200, 239, 200, 253, 197, 144, 71, 124, 71, 238,
-			47, 134, 75, 247, 29, 115, 236, 58, 217, 52, 160, 201, 111, 32,
-			30, 193, 96, 34, 223, 53, 245, 235, 51, 187, 2, 133, 145, 200,
-			36, 104, 135, 252, 120, 18, 139, 174, 199, 123, 21, 236, 233, 129,
-			51, 44, 18, 32, 82, 167, 137, 52, 248, 109, 78, 54, 120, 187,
-			34, 50, 17, 169, 57, 192, 178, 127, 87, 226, 151, 225, 178, 127,
-			215, 28, 152, 144, 77, 3, 154, 147, 215, 225, 14, 26, 180, 210,
-			255, 36, 213, 247, 166, 212, 139, 180, 131, 6, 53, 203, 252, 39,
-			169, 254, 235, 217, 2, 51, 205, 65, 88, 176, 87, 166, 244, 67,
-			153, 219, 123, 239, 32, 138, 96, 107, 75, 6, 48, 37, 107, 112,
-			200, 35, 103, 16, 119, 211, 43, 83, 98, 118, 131, 184, 36, 175,
-			76, 137, 221, 52, 136, 75, 242, 202, 212, 193, 49, 246, 151, 58,
-			14, 9, 42, 123, 74, 159, 200, 252, 145, 78, 225, 118, 176, 81,
-			125, 255, 18, 242, 217, 41, 202, 54, 210, 244, 146, 215, 116, 104,
-			150, 41, 44, 45, 1, 150, 67, 167, 221, 133, 135, 28, 89, 105,
-			97, 85, 22, 247, 68, 85, 12, 74, 93, 35, 166, 17, 18, 149,
-			57, 181, 154, 91, 65, 167, 15, 84, 237, 40, 71, 87, 196, 18,
-			41, 177, 97, 27, 139, 248, 175, 154, 91, 7, 97, 104, 179, 149,
-			231, 133, 122, 61, 153, 163, 94, 197, 208, 160, 107, 143, 8, 115,
-			101, 60, 116, 26, 118, 96, 71, 32, 116, 56, 13, 110, 215, 34,
-			71, 250, 134, 80, 110, 74, 132, 165, 43, 28, 134, 160, 8, 177,
-			146, 19, 154, 174, 128, 202, 148, 163, 27, 133, 254, 32, 51, 217,
-			116, 34, 153, 42, 0, 193, 37, 243, 28, 206, 11, 167, 141, 86,
-			87, 120, 61, 185, 137, 6, 81, 235, 249, 241, 148, 174, 154, 105,
-			104, 10, 83, 214, 32, 210, 227, 143, 167, 132, 41, 107, 16, 233,
-			241, 199, 83, 135, 199, 145, 255, 13, 2, 27, 122, 93, 234, 5,
-			225, 127, 131, 200, 104, 94, 151, 18, 252, 111, 16, 25, 205, 235,
-			82, 130, 255, 13, 34, 163, 121, 93, 74, 240, 191, 65, 104, 189,
-			49, 245, 125, 224, 127, 131, 104, 162, 124, 99, 76, 193, 32, 52,
-			189, 49, 53, 32, 241, 97, 32, 32, 130, 255, 13, 89, 233, 159,
-			74, 245, 189, 35, 69, 252, 111, 72, 179, 204, 159, 74, 245, 31,
-			67, 254, 55, 4, 219, 233, 45, 169, 23, 148, 255, 13, 33, 255,
-			123, 139, 92, 186, 33, 228, 127, 111, 73, 9, 254, 55, 132, 155,
-			237, 45, 41, 193, 255, 134, 112, 179, 189, 37, 197, 111, 96, 175,
-			214, 16, 32, 205, 50, 223, 158, 210, 175, 207, 180, 174, 206, 255,
-			122, 23, 25, 235, 129, 53, 198, 253, 224, 57, 114, 192, 33, 228,
-			128, 111, 151, 24, 30, 66, 138, 123, 123, 74, 112, 192, 33, 164,
-			184, 183, 167, 38, 175, 99, 191, 2, 122, 246, 126, 43, 253, 174,
-			84, 223, 207, 165, 180, 76, 149, 151, 159, 93, 202, 140, 158, 153,
-			50, 218, 133, 164, 189, 50, 101, 188, 72, 154, 249, 126, 205, 50,
-			223, 149, 234, 31, 100, 179, 204, 52, 247, 3, 101, 188, 39, 165,
-			91, 153, 27, 20, 163, 21, 137, 23, 186, 92, 124, 17, 37, 251,
-			145, 169, 190, 71, 34, 108, 63, 174, 243, 123, 82, 3, 251, 101,
-			211, 128, 230, 200, 40, 94, 78, 238, 135, 101, 126, 31, 176, 241,
-			217, 182, 222, 37, 82, 226, 160, 45, 145, 128, 68, 92, 35, 169,
-			177, 96, 113, 222, 23, 143, 165, 97, 119, 130, 129, 239, 199, 197,
-			121, 31, 48, 240, 211, 56, 150, 110, 153, 31, 128, 93, 153, 235,
-			85, 11, 39, 49, 72, 194, 245, 82, 13, 4, 146, 228, 7, 82,
-			66, 241, 218, 143, 219, 255, 3, 41, 161, 120, 237, 199, 237, 255,
-			129, 212, 248, 4, 59, 135, 3, 25, 150, 249, 33, 24, 232, 174,
-			88, 241, 74, 92, 94, 80, 241, 153, 246, 139, 139, 158, 42, 209,
-			126, 220, 221, 31, 138, 167, 7, 187, 251, 67, 169, 1, 57, 170,
-			129, 227, 140, 79, 176, 179, 56, 170, 105, 153, 31, 78, 233, 185,
-			204, 75, 98, 5, 12, 198, 217, 251, 206, 103, 143, 65, 205, 52,
-			118, 52, 41, 155, 26, 52, 197, 221, 205, 126, 188, 45, 248, 112,
-			234, 196, 173, 200, 82, 134, 173, 244, 71, 83, 125, 255, 70, 176,
-			148, 97, 205, 50, 63, 154, 234, 159, 64, 194, 25, 6, 194, 249,
-			216, 115, 32, 156, 97, 36, 156, 143, 201, 217, 14, 35, 225, 124,
-			76, 18, 206, 48, 18, 206, 199, 36, 225, 12, 195, 2, 252, 226,
-			243, 38, 156, 97, 36, 156, 95, 140, 199, 210, 176, 59, 65, 56,
-			195, 72, 56, 191, 8, 132, 3, 147, 60, 96, 165, 63, 145, 234,
-			251, 101, 49, 201, 3, 154, 101, 126, 34, 213, 63, 206, 230, 153,
-			105, 30, 128, 73, 126, 18, 192, 184, 233, 10, 130, 60, 250, 243,
-			42, 25, 254, 0, 206, 243, 147, 114, 236, 3, 56, 207, 79, 202,
-			177, 15, 224, 60, 63, 41, 199, 30, 177, 210, 159, 74, 245, 125,
-			70, 140, 61, 162, 89, 230, 167, 0, 193, 15, 51, 211, 28, 129,
-			177, 63, 13, 242, 72, 137, 199, 25, 7, 107, 109, 236, 154, 214,
-			189, 178, 7, 187, 198, 155, 169, 38, 250, 19, 39, 25, 247, 8,
-			50, 238, 79, 75, 198, 61, 130, 140, 251, 211, 242, 204, 29, 65,
-			120, 63, 45, 207, 220, 17, 132, 247, 211, 112, 230, 254, 152, 193,
-			116, 115, 212, 74, 255, 86, 170, 239, 247, 82, 90, 230, 93, 40,
-			37, 169, 96, 204, 211, 137, 8, 167, 100, 244, 9, 50, 193, 164,
-			179, 245, 131, 78, 43, 220, 43, 87, 64, 46, 78, 245, 41, 204,
-			183, 110, 32, 236, 74, 157, 121, 82, 73, 232, 128, 69, 87, 57,
-			213, 227, 136, 3, 145, 236, 71, 182, 231, 179, 93, 238, 213, 42,
-			193, 0, 107, 3, 110, 125, 27, 186, 139, 64, 84, 195, 251, 43,
-			73, 119, 32, 115, 77, 217, 97, 87, 246, 138, 233, 132, 213, 171,
-			78, 201, 135, 237, 136, 223, 118, 242, 36, 227, 155, 173, 232, 69,
-			243, 66, 50, 71, 53, 203, 252, 45, 224, 225, 247, 51, 211, 28,
-			69, 147, 72, 74, 31, 205, 204, 3, 118, 105, 73, 186, 98, 95,
-			49, 163, 58, 252, 241, 152, 61, 243, 228, 201, 153, 187, 46, 252,
-			240, 204, 227, 183, 10, 146, 24, 69, 154, 253, 109, 73, 179, 163,
-			100, 224, 72, 13, 12, 201, 166, 1, 205, 3, 35, 108, 5, 135,
-			211, 44, 243, 243, 41, 253, 96, 166, 32, 206, 110, 28, 176, 107,
-			152, 194, 204, 163, 52, 210, 77, 83, 211, 39, 110, 205, 253, 240,
-			76, 126, 246, 244, 221, 247, 220, 123, 223, 253, 63, 252, 195, 23,
-			158, 122, 250, 255, 199, 19, 163, 195, 110, 253, 124, 60, 186, 134,
-			3, 12, 12, 203, 166, 1, 205, 81, 11, 217, 252, 40, 176, 249,
-			47, 18, 155, 71, 238, 75, 151, 146, 106, 101, 129, 253, 226, 106,
-			245, 224, 125, 163, 40, 229, 125, 49, 30, 8, 102, 242, 69, 201,
-			112, 71, 145, 205, 127, 17, 24, 238, 75, 112, 32, 195, 50, 127,
-			23, 24, 238, 137, 152, 225, 66, 207, 232, 252, 39, 199, 235, 61,
-			140, 145, 198, 79, 39, 101, 83, 131, 166, 96, 177, 163, 200, 215,
-			127, 87, 178, 88, 203, 74, 255, 126, 170, 239, 107, 130, 3, 88,
-			154, 101, 254, 126, 170, 255, 8, 178, 124, 11, 214, 245, 15, 128,
-			251, 188, 4, 9, 83, 241, 29, 188, 18, 246, 235, 213, 142, 68,
-			15, 187, 78, 210, 113, 42, 62, 221, 44, 92, 221, 63, 144, 211,
-			182, 112, 117, 255, 64, 114, 36, 11, 87, 247, 15, 128, 35, 205,
-			226, 160, 154, 101, 254, 17, 224, 247, 6, 28, 244, 89, 244, 14,
-			50, 251, 31, 201, 179, 211, 194, 213, 251, 35, 121, 118, 90, 184,
-			122, 127, 4, 72, 61, 131, 189, 235, 150, 249, 39, 192, 212, 110,
-			227, 43, 189, 178, 203, 208, 8, 83, 120, 47, 153, 44, 6, 16,
-			57, 193, 180, 26, 16, 142, 194, 63, 145, 252, 203, 194, 179, 251,
-			79, 36, 255, 178, 112, 81, 255, 68, 242, 47, 11, 23, 245, 79,
-			82, 226, 250, 251, 160, 149, 254, 122, 170, 239, 191, 9, 108, 31,
-			212, 44, 243, 235, 128, 237, 243, 204, 52, 15, 2, 182, 255, 12,
-			176, 125, 250, 217, 98, 91, 68, 19, 147, 86, 19, 248, 59, 2,
-			192, 131, 136, 239, 63, 147, 248, 62, 136, 248, 254, 51, 137, 239,
-			131, 136, 239, 63, 3, 124, 207, 225, 176, 154, 101, 254, 5, 224,
-			251, 198, 46, 124, 239, 217, 63, 96, 252, 47, 36, 198, 15, 34,
-			198, 255, 66, 98, 252, 32, 98, 252, 47, 36, 198, 15, 2, 198,
-			191, 181, 55, 198, 197, 24, 87, 196, 248, 65, 196, 248, 183, 36,
-			198, 15, 34, 198, 191, 37, 49, 126, 16, 49, 254, 45, 137, 241,
-			131, 136, 241, 111, 1, 198, 31, 103, 186, 57, 102, 165, 255, 58,
-			213, 247, 207, 211, 47, 146, 213, 96, 76, 179, 204, 191, 134, 35,
-			19, 54, 204, 24, 44, 225, 119, 129, 17, 190, 228, 57, 50, 194,
-			167, 230, 114, 183, 157, 60, 249, 180, 152, 239, 24, 46, 224, 119,
-			229, 2, 142, 225, 2, 126, 87, 178, 195, 49, 92, 192, 239, 2,
-			59, 92, 196, 65, 53, 203, 252, 27, 16, 132, 238, 72, 176, 195,
-			182, 188, 2, 173, 200, 161, 228, 2, 225, 142, 168, 2, 178, 109,
-			123, 34, 199, 128, 26, 18, 120, 224, 223, 72, 5, 116, 12, 215,
-			244, 111, 82, 253, 251, 101, 211, 128, 230, 200, 40, 91, 195, 33,
-			117, 203, 252, 219, 148, 158, 201, 20, 249, 74, 67, 104, 253, 15,
-			149, 30, 42, 74, 23, 102, 30, 181, 26, 74, 102, 82, 62, 12,
-			120, 110, 229, 120, 35, 112, 119, 236, 192, 173, 83, 2, 160, 141,
-			146, 130, 0, 152, 227, 223, 198, 147, 134, 121, 253, 109, 106, 224,
-			144, 108, 26, 208, 156, 56, 194, 62, 172, 35, 8, 134, 101, 190,
-			42, 173, 31, 201, 252, 31, 58, 63, 155, 172, 152, 209, 145, 253,
-			168, 61, 85, 64, 156, 166, 3, 207, 120, 144, 74, 248, 220, 29,
-			116, 86, 2, 188, 84, 230, 111, 42, 123, 207, 37, 167, 21, 59,
-			187, 77, 131, 22, 216, 86, 234, 144, 169, 90, 135, 34, 215, 35,
-			137, 3, 167, 230, 59, 42, 34, 86, 182, 109, 12, 215, 8, 40,
-			9, 199, 170, 12, 111, 238, 136, 164, 118, 61, 225, 51, 75, 250,
-			121, 152, 231, 37, 225, 78, 228, 122, 188, 103, 185, 229, 28, 227,
-			126, 35, 74, 164, 25, 169, 118, 39, 21, 16, 175, 42, 252, 130,
-			180, 255, 170, 180, 194, 47, 156, 10, 175, 74, 139, 195, 103, 12,
-			79, 133, 87, 165, 197, 229, 206, 24, 108, 184, 215, 0, 122, 231,
-			246, 60, 229, 98, 95, 222, 30, 103, 208, 152, 110, 166, 176, 7,
-			57, 26, 136, 249, 175, 137, 71, 3, 49, 255, 53, 48, 218, 189,
-			56, 90, 202, 50, 95, 155, 214, 115, 153, 217, 61, 156, 202, 174,
-			120, 222, 141, 233, 169, 52, 126, 63, 41, 155, 26, 52, 197, 121,
-			55, 134, 89, 195, 95, 155, 22, 231, 221, 33, 43, 253, 147, 233,
-			190, 159, 74, 19, 7, 62, 164, 89, 230, 79, 166, 251, 175, 67,
-			131, 206, 33, 216, 190, 111, 72, 235, 135, 50, 247, 60, 199, 243,
-			46, 246, 80, 146, 167, 210, 33, 220, 194, 111, 144, 243, 63, 132,
-			91, 248, 13, 105, 193, 131, 15, 225, 22, 126, 67, 250, 224, 24,
-			59, 133, 3, 107, 150, 249, 38, 192, 246, 205, 123, 157, 121, 189,
-			70, 0, 46, 252, 166, 180, 224, 194, 135, 112, 199, 190, 41, 45,
-			184, 240, 33, 220, 177, 111, 74, 211, 93, 172, 121, 8, 118, 236,
-			191, 72, 235, 211, 153, 233, 54, 46, 220, 51, 17, 10, 142, 168,
-			70, 1, 74, 248, 23, 105, 93, 53, 211, 208, 28, 156, 148, 77,
-			13, 154, 71, 111, 146, 77, 3, 154, 199, 167, 16, 213, 135, 173,
-			244, 91, 211, 125, 255, 74, 160, 250, 176, 102, 153, 111, 5, 84,
-			47, 49, 211, 60, 12, 168, 126, 27, 160, 250, 190, 231, 120, 216,
-			197, 14, 91, 241, 129, 116, 24, 145, 253, 54, 137, 236, 195, 136,
-			236, 183, 73, 100, 31, 70, 100, 191, 13, 144, 125, 59, 14, 173,
-			89, 230, 59, 0, 217, 199, 247, 58, 240, 122, 143, 1, 232, 126,
-			135, 68, 247, 97, 68, 247, 59, 36, 186, 15, 35, 186, 223, 1,
-			232, 94, 197, 49, 116, 203, 252, 25, 64, 247, 25, 149, 209, 65,
-			157, 117, 34, 31, 120, 123, 213, 35, 63, 160, 108, 8, 183, 202,
-			76, 8, 118, 144, 140, 42, 131, 30, 77, 236, 82, 53, 211, 208,
-			20, 235, 112, 24, 215, 225, 103, 228, 58, 28, 198, 117, 248, 25,
-			185, 14, 227, 86, 250, 157, 233, 190, 127, 45, 214, 97, 92, 179,
-			204, 119, 166, 251, 39, 81, 232, 24, 135, 117, 120, 87, 250, 185,
-			8, 29, 168, 114, 38, 18, 187, 8, 0, 199, 113, 13, 222, 37,
-			215, 96, 28, 215, 224, 93, 114, 13, 198, 113, 13, 222, 149, 22,
-			66, 199, 56, 128, 251, 158, 116, 183, 208, 33, 85, 218, 94, 253,
-			3, 254, 223, 35, 241, 63, 142, 248, 127, 143, 196, 255, 56, 226,
-			255, 61, 128, 255, 117, 236, 95, 183, 204, 247, 165, 245, 201, 204,
-			89, 174, 156, 99, 47, 129, 226, 135, 3, 236, 184, 228, 247, 171,
-			234, 91, 248, 177, 225, 78, 66, 144, 204, 75, 160, 64, 128, 53,
-			120, 159, 92, 131, 113, 60, 176, 222, 151, 22, 46, 50, 227, 184,
-			6, 239, 75, 91, 135, 101, 211, 128, 230, 145, 12, 43, 33, 68,
-			134, 101, 126, 48, 173, 143, 103, 238, 78, 24, 139, 101, 5, 217,
-			158, 166, 226, 248, 16, 128, 115, 146, 82, 94, 83, 207, 192, 200,
-			63, 152, 22, 71, 245, 56, 50, 242, 15, 166, 251, 37, 24, 6,
-			142, 52, 118, 24, 69, 146, 113, 0, 249, 67, 128, 233, 151, 60,
-			111, 91, 49, 117, 11, 252, 252, 67, 241, 242, 2, 63, 255, 80,
-			90, 88, 130, 199, 145, 159, 127, 40, 45, 44, 193, 19, 86, 250,
-			35, 233, 190, 95, 20, 4, 55, 161, 89, 230, 71, 210, 253, 71,
-			217, 6, 51, 205, 9, 32, 184, 143, 194, 206, 56, 199, 23, 69,
-			94, 184, 100, 22, 161, 132, 35, 231, 113, 201, 244, 47, 68, 225,
-			241, 174, 164, 13, 113, 206, 6, 0, 96, 2, 109, 10, 31, 149,
-			75, 51, 129, 54, 133, 143, 202, 237, 49, 129, 196, 248, 81, 185,
-			61, 38, 144, 24, 63, 10, 219, 3, 141, 193, 19, 176, 112, 31,
-			79, 63, 59, 99, 112, 123, 210, 144, 43, 222, 133, 217, 222, 115,
-			52, 6, 79, 160, 16, 246, 113, 137, 228, 9, 164, 241, 143, 167,
-			133, 49, 120, 2, 105, 252, 227, 105, 113, 29, 118, 196, 74, 127,
-			50, 221, 247, 43, 47, 150, 96, 123, 68, 179, 204, 79, 2, 187,
-			6, 129, 239, 8, 172, 218, 51, 192, 38, 138, 221, 108, 66, 221,
-			202, 119, 29, 142, 52, 65, 191, 142, 238, 172, 177, 83, 187, 152,
-			237, 17, 228, 24, 207, 200, 217, 30, 193, 69, 122, 70, 114, 140,
-			35, 184, 72, 207, 0, 199, 88, 70, 8, 52, 203, 252, 20, 64,
-			112, 63, 66, 208, 145, 98, 130, 86, 192, 175, 95, 118, 200, 247,
-			162, 163, 196, 117, 34, 117, 147, 26, 28, 80, 253, 169, 120, 112,
-			13, 251, 87, 131, 3, 170, 63, 5, 131, 3, 170, 51, 86, 250,
-			223, 167, 251, 126, 227, 197, 66, 117, 70, 179, 204, 127, 47, 133,
-			144, 12, 160, 250, 179, 207, 73, 8, 217, 181, 41, 209, 212, 150,
-			19, 97, 114, 6, 133, 225, 12, 98, 248, 179, 114, 146, 25, 196,
-			240, 103, 229, 36, 51, 136, 225, 207, 74, 33, 36, 3, 24, 254,
-			92, 111, 33, 228, 10, 35, 0, 87, 254, 156, 228, 202, 25, 68,
-			227, 231, 36, 87, 206, 32, 26, 63, 7, 92, 249, 223, 106, 76,
-			55, 39, 173, 244, 239, 164, 251, 254, 52, 173, 101, 54, 249, 170,
-			12, 27, 141, 99, 94, 208, 136, 35, 242, 13, 208, 221, 197, 21,
-			114, 58, 226, 45, 34, 227, 13, 39, 160, 200, 65, 172, 112, 156,
-			204, 52, 243, 226, 172, 213, 164, 102, 153, 191, 3, 188, 118, 152,
-			153, 230, 36, 6, 70, 165, 245, 223, 77, 147, 183, 244, 36, 133,
-			70, 165, 247, 13, 161, 183, 244, 36, 5, 71, 253, 151, 180, 153,
-			65, 95, 232, 73, 17, 222, 244, 95, 210, 230, 161, 248, 129, 14,
-			15, 38, 142, 168, 47, 52, 203, 252, 66, 242, 11, 141, 30, 12,
-			198, 15, 116, 120, 144, 248, 66, 183, 204, 47, 38, 191, 64, 171,
-			82, 218, 220, 31, 63, 192, 55, 18, 95, 24, 150, 249, 165, 228,
-			23, 112, 130, 124, 41, 109, 178, 248, 129, 14, 15, 38, 142, 224,
-			237, 221, 36, 204, 226, 43, 105, 125, 36, 179, 200, 75, 242, 42,
-			185, 189, 118, 55, 29, 29, 98, 69, 165, 253, 189, 226, 54, 170,
-			23, 40, 139, 192, 5, 12, 96, 111, 157, 190, 60, 151, 37, 194,
-			153, 68, 210, 252, 138, 36, 205, 73, 68, 204, 87, 210, 3, 131,
-			178, 105, 64, 115, 248, 0, 218, 220, 38, 97, 70, 95, 77, 235,
-			86, 38, 135, 164, 169, 168, 68, 68, 61, 168, 113, 147, 110, 87,
-			121, 57, 16, 80, 232, 87, 229, 105, 57, 137, 248, 252, 106, 90,
-			40, 182, 147, 72, 161, 95, 77, 143, 140, 226, 230, 155, 4, 76,
-			253, 33, 40, 34, 247, 196, 138, 136, 234, 159, 215, 92, 207, 13,
-			65, 11, 145, 85, 181, 167, 218, 252, 36, 241, 148, 86, 71, 210,
-			36, 138, 104, 127, 40, 181, 146, 73, 92, 150, 63, 148, 90, 201,
-			36, 138, 7, 127, 8, 90, 201, 125, 56, 176, 97, 153, 127, 156,
-			214, 111, 202, 156, 164, 114, 243, 27, 11, 11, 197, 162, 244, 169,
-			194, 146, 21, 88, 91, 173, 29, 213, 241, 69, 237, 36, 154, 252,
-			254, 56, 173, 79, 200, 166, 6, 205, 35, 199, 100, 19, 187, 207,
-			222, 200, 110, 195, 193, 76, 203, 252, 90, 90, 63, 152, 185, 5,
-			7, 163, 160, 114, 57, 18, 226, 47, 8, 64, 67, 160, 228, 89,
-			106, 8, 144, 0, 190, 22, 175, 24, 72, 0, 95, 75, 11, 43,
-			233, 36, 74, 0, 95, 75, 143, 90, 40, 1, 28, 181, 210, 223,
-			72, 247, 253, 181, 144, 0, 142, 106, 150, 249, 141, 116, 255, 49,
-			148, 141, 143, 98, 52, 23, 48, 184, 51, 207, 135, 193, 209, 111,
-			53, 31, 11, 165, 19, 100, 71, 41, 46, 75, 66, 118, 148, 226,
-			178, 36, 155, 59, 74, 113, 89, 192, 230, 238, 194, 225, 53, 203,
-			252, 38, 176, 185, 91, 175, 200, 230, 122, 142, 3, 164, 244, 77,
-			201, 236, 142, 34, 41, 125, 83, 50, 187, 163, 72, 74, 223, 4,
-			102, 87, 192, 113, 116, 203, 252, 54, 140, 115, 10, 72, 9, 253,
-			199, 132, 99, 58, 202, 85, 210, 112, 47, 253, 216, 179, 129, 83,
-			11, 179, 228, 3, 168, 198, 3, 1, 243, 219, 105, 225, 182, 126,
-			20, 41, 232, 219, 233, 125, 114, 60, 160, 153, 111, 199, 227, 25,
-			150, 249, 87, 207, 97, 188, 200, 222, 234, 26, 15, 4, 203, 191,
-			138, 199, 3, 34, 250, 171, 120, 188, 255, 151, 189, 171, 139, 109,
-			35, 187, 206, 154, 185, 67, 14, 121, 41, 75, 242, 21, 37, 81,
-			180, 40, 94, 141, 214, 214, 143, 37, 202, 150, 157, 100, 189, 187,
-			222, 53, 45, 209, 182, 98, 175, 236, 72, 218, 252, 180, 40, 232,
-			33, 57, 162, 38, 38, 57, 44, 135, 178, 87, 251, 184, 125, 89,
-			4, 69, 139, 60, 4, 168, 129, 22, 8, 246, 161, 125, 105, 211,
-			110, 26, 4, 8, 176, 64, 31, 210, 54, 233, 79, 176, 64, 223,
-			138, 20, 40, 208, 135, 20, 200, 223, 166, 77, 145, 135, 116, 31,
-			138, 115, 238, 207, 12, 101, 121, 215, 41, 146, 183, 125, 227, 185,
-			188, 115, 206, 220, 59, 119, 238, 253, 238, 153, 115, 191, 67, 208,
-			130, 252, 10, 57, 3, 131, 232, 23, 73, 179, 152, 191, 242, 108,
-			246, 34, 158, 128, 227, 86, 97, 92, 253, 34, 178, 10, 227, 234,
-			23, 73, 59, 175, 68, 2, 98, 97, 22, 199, 85, 129, 37, 127,
-			153, 28, 250, 67, 91, 140, 171, 130, 193, 172, 95, 38, 83, 28,
-			95, 161, 2, 140, 171, 15, 146, 102, 46, 127, 225, 216, 131, 85,
-			185, 176, 164, 235, 64, 134, 89, 238, 251, 45, 145, 216, 82, 222,
-			71, 1, 49, 227, 7, 10, 66, 22, 112, 20, 125, 144, 148, 241,
-			4, 5, 28, 69, 31, 36, 39, 167, 232, 99, 3, 173, 25, 204,
-			122, 211, 54, 115, 249, 47, 25, 34, 89, 68, 156, 80, 39, 62,
-			128, 159, 253, 123, 25, 141, 168, 222, 239, 137, 248, 79, 153, 114,
-			100, 240, 188, 252, 129, 27, 70, 52, 57, 248, 237, 239, 234, 85,
-			60, 20, 161, 27, 98, 88, 120, 111, 90, 76, 130, 40, 189, 165,
-			5, 28, 181, 111, 218, 163, 170, 93, 48, 106, 223, 180, 39, 167,
-			48, 167, 154, 85, 128, 97, 251, 22, 180, 235, 79, 13, 73, 154,
-			126, 236, 163, 213, 73, 237, 82, 159, 38, 30, 120, 71, 162, 13,
-			154, 150, 187, 67, 245, 255, 126, 219, 11, 251, 110, 187, 203, 23,
-			79, 132, 241, 207, 222, 102, 116, 29, 31, 111, 51, 12, 199, 183,
-			162, 54, 195, 220, 251, 86, 212, 102, 120, 90, 111, 69, 109, 134,
-			119, 229, 45, 104, 243, 87, 69, 155, 9, 179, 254, 192, 54, 159,
-			203, 127, 217, 224, 187, 209, 218, 226, 61, 149, 241, 104, 48, 182,
-			89, 114, 31, 29, 235, 16, 191, 71, 37, 119, 250, 51, 55, 44,
-			102, 238, 120, 243, 136, 133, 183, 168, 197, 36, 136, 153, 172, 18,
-			13, 16, 39, 138, 74, 196, 246, 56, 243, 8, 94, 103, 89, 242,
-			43, 246, 208, 159, 216, 191, 33, 240, 58, 107, 48, 235, 43, 118,
-			170, 64, 187, 212, 178, 102, 225, 29, 124, 108, 155, 19, 249, 90,
-			196, 214, 49, 200, 50, 116, 124, 158, 197, 57, 22, 195, 140, 143,
-			157, 213, 227, 11, 126, 103, 191, 231, 174, 245, 131, 160, 21, 174,
-			1, 150, 88, 107, 249, 157, 195, 215, 87, 221, 118, 227, 147, 151,
-			23, 100, 207, 204, 226, 220, 255, 216, 150, 115, 255, 44, 190, 181,
-			143, 109, 57, 247, 207, 226, 91, 251, 216, 30, 207, 226, 183, 136,
-			89, 140, 250, 177, 205, 233, 252, 101, 188, 61, 121, 103, 31, 185,
-			4, 168, 219, 19, 42, 225, 117, 250, 99, 91, 46, 2, 179, 34,
-			96, 199, 150, 139, 192, 172, 8, 216, 177, 167, 196, 70, 184, 200,
-			146, 95, 181, 135, 190, 35, 167, 171, 162, 193, 172, 175, 218, 169,
-			89, 156, 174, 138, 208, 85, 111, 219, 255, 239, 233, 170, 136, 211,
-			213, 219, 106, 72, 20, 177, 225, 111, 219, 114, 186, 42, 98, 195,
-			223, 134, 33, 254, 103, 4, 173, 193, 118, 221, 54, 207, 228, 255,
-			136, 192, 116, 53, 120, 52, 32, 34, 21, 146, 61, 34, 208, 218,
-			147, 135, 4, 240, 78, 145, 73, 215, 109, 53, 3, 46, 88, 123,
-			91, 143, 220, 163, 80, 124, 213, 144, 135, 247, 240, 79, 244, 73,
-			99, 240, 158, 136, 177, 86, 169, 246, 132, 9, 77, 225, 175, 185,
-			225, 54, 176, 92, 39, 246, 19, 25, 215, 68, 160, 97, 167, 129,
-			31, 52, 180, 73, 149, 51, 250, 136, 55, 2, 60, 49, 25, 30,
-			118, 187, 65, 15, 105, 200, 104, 220, 175, 46, 82, 197, 53, 84,
-			34, 29, 85, 205, 85, 193, 130, 160, 114, 73, 50, 122, 72, 187,
-			93, 191, 254, 0, 85, 212, 188, 176, 79, 133, 81, 52, 165, 46,
-			70, 87, 137, 68, 181, 61, 111, 191, 138, 89, 19, 188, 80, 122,
-			141, 58, 13, 104, 56, 230, 225, 12, 122, 252, 161, 219, 242, 27,
-			58, 161, 28, 210, 34, 35, 100, 21, 57, 206, 101, 216, 101, 235,
-			136, 223, 248, 194, 150, 126, 172, 48, 188, 190, 166, 134, 87, 17,
-			135, 215, 215, 236, 225, 73, 37, 18, 16, 167, 243, 8, 204, 139,
-			48, 89, 191, 99, 155, 179, 249, 77, 190, 27, 145, 243, 29, 95,
-			137, 158, 249, 241, 10, 19, 48, 117, 190, 19, 221, 0, 140, 156,
-			119, 236, 225, 105, 37, 18, 16, 103, 10, 184, 103, 44, 130, 244,
-			13, 24, 196, 103, 249, 174, 255, 134, 158, 55, 159, 176, 35, 131,
-			17, 164, 14, 128, 25, 223, 176, 37, 34, 47, 226, 236, 245, 13,
-			59, 165, 70, 46, 65, 157, 147, 83, 244, 95, 12, 52, 97, 49,
-			235, 93, 120, 103, 191, 109, 240, 221, 91, 229, 139, 188, 225, 55,
-			189, 176, 127, 178, 169, 69, 55, 196, 239, 46, 194, 45, 176, 164,
-			15, 204, 43, 238, 244, 160, 165, 58, 65, 103, 219, 197, 169, 25,
-			135, 21, 60, 177, 154, 91, 127, 240, 200, 237, 53, 48, 67, 147,
-			219, 23, 71, 54, 142, 168, 74, 251, 136, 116, 2, 222, 35, 175,
-			167, 181, 32, 87, 192, 67, 175, 231, 239, 139, 52, 29, 193, 254,
-			73, 131, 67, 18, 88, 233, 30, 0, 200, 243, 174, 154, 180, 138,
-			8, 121, 222, 181, 229, 199, 145, 34, 66, 158, 119, 97, 14, 249,
-			145, 137, 61, 144, 96, 214, 223, 219, 230, 74, 254, 223, 76, 190,
-			161, 156, 45, 240, 22, 122, 225, 201, 189, 16, 113, 0, 203, 36,
-			127, 229, 59, 119, 112, 36, 135, 106, 20, 203, 28, 216, 177, 87,
-			69, 82, 33, 96, 220, 86, 76, 163, 158, 181, 99, 73, 23, 213,
-			68, 80, 151, 249, 203, 36, 203, 149, 152, 56, 101, 87, 60, 121,
-			83, 56, 109, 124, 212, 75, 22, 37, 10, 87, 70, 196, 107, 213,
-			231, 45, 207, 197, 51, 11, 136, 38, 67, 190, 16, 117, 242, 2,
-			247, 251, 161, 215, 218, 199, 7, 138, 35, 4, 77, 45, 170, 239,
-			172, 148, 183, 188, 166, 91, 63, 170, 134, 7, 238, 69, 241, 182,
-			11, 55, 35, 188, 128, 96, 48, 30, 185, 85, 52, 19, 22, 246,
-			182, 22, 147, 32, 102, 212, 163, 73, 24, 32, 78, 44, 40, 145,
-			128, 184, 124, 30, 87, 90, 206, 146, 223, 179, 135, 254, 243, 55,
-			181, 210, 114, 131, 89, 223, 179, 83, 231, 232, 231, 169, 101, 113,
-			88, 62, 222, 179, 205, 108, 254, 211, 3, 187, 40, 157, 252, 249,
-			184, 99, 238, 4, 166, 56, 193, 15, 166, 208, 135, 234, 1, 142,
-			43, 234, 123, 106, 112, 114, 92, 88, 222, 179, 229, 233, 96, 142,
-			11, 203, 123, 54, 27, 167, 127, 71, 240, 62, 12, 102, 125, 223,
-			54, 139, 249, 111, 18, 190, 181, 47, 242, 181, 172, 196, 34, 198,
-			189, 215, 221, 122, 31, 131, 201, 221, 126, 44, 127, 114, 128, 203,
-			188, 47, 242, 191, 85, 196, 207, 136, 138, 71, 28, 26, 149, 217,
-			189, 185, 116, 240, 184, 33, 119, 94, 18, 237, 123, 121, 237, 165,
-			135, 110, 207, 119, 59, 253, 151, 145, 225, 227, 186, 43, 94, 242,
-			39, 19, 196, 117, 64, 115, 19, 186, 226, 161, 215, 145, 129, 239,
-			247, 229, 181, 225, 125, 157, 116, 229, 145, 12, 162, 18, 78, 219,
-			206, 97, 91, 29, 41, 10, 246, 121, 183, 229, 246, 225, 22, 66,
-			73, 90, 244, 219, 78, 12, 135, 56, 43, 220, 105, 187, 117, 45,
-			148, 74, 165, 223, 89, 146, 41, 247, 208, 249, 43, 54, 212, 8,
-			132, 96, 110, 241, 58, 13, 209, 46, 213, 237, 131, 9, 180, 197,
-			105, 234, 214, 81, 148, 169, 93, 167, 146, 215, 20, 155, 146, 246,
-			178, 39, 28, 172, 157, 224, 24, 245, 223, 192, 217, 235, 24, 212,
-			164, 72, 216, 38, 57, 250, 142, 165, 250, 131, 199, 104, 225, 115,
-			212, 98, 2, 68, 249, 105, 131, 227, 42, 244, 125, 91, 158, 254,
-			229, 184, 10, 125, 223, 46, 204, 210, 255, 53, 113, 12, 152, 204,
-			250, 1, 160, 190, 31, 155, 3, 131, 81, 57, 105, 241, 211, 151,
-			104, 238, 51, 184, 103, 121, 57, 198, 240, 16, 239, 28, 29, 56,
-			113, 95, 52, 239, 62, 63, 31, 123, 150, 178, 211, 151, 221, 86,
-			107, 57, 186, 166, 229, 226, 76, 227, 135, 234, 102, 6, 250, 228,
-			105, 93, 2, 138, 112, 201, 136, 135, 80, 63, 77, 153, 223, 251,
-			48, 54, 65, 26, 113, 190, 127, 8, 161, 160, 164, 117, 131, 129,
-			129, 161, 132, 79, 146, 23, 134, 94, 31, 185, 235, 158, 124, 114,
-			176, 60, 252, 32, 122, 93, 49, 252, 72, 1, 96, 46, 194, 143,
-			108, 25, 238, 57, 199, 146, 63, 180, 135, 254, 71, 226, 209, 57,
-			131, 89, 63, 180, 83, 11, 232, 203, 156, 35, 67, 44, 249, 35,
-			219, 252, 111, 91, 248, 50, 231, 144, 249, 225, 71, 54, 157, 162,
-			87, 104, 18, 68, 140, 79, 178, 173, 41, 121, 156, 55, 242, 219,
-			200, 35, 165, 176, 68, 12, 210, 83, 10, 127, 34, 94, 154, 192,
-			107, 99, 5, 6, 20, 100, 88, 84, 64, 160, 96, 98, 146, 190,
-			32, 173, 25, 204, 250, 137, 109, 205, 57, 203, 136, 240, 238, 222,
-			198, 215, 91, 144, 71, 198, 114, 35, 10, 15, 160, 184, 149, 72,
-			59, 96, 168, 159, 216, 210, 203, 58, 39, 25, 134, 126, 98, 79,
-			206, 68, 5, 4, 10, 138, 28, 105, 153, 160, 192, 100, 214, 79,
-			109, 235, 140, 83, 84, 94, 12, 24, 160, 79, 146, 131, 69, 54,
-			0, 38, 253, 212, 150, 94, 86, 44, 48, 160, 96, 100, 50, 42,
-			32, 80, 48, 157, 215, 54, 8, 179, 222, 135, 14, 44, 30, 131,
-			101, 241, 19, 185, 27, 229, 221, 200, 6, 108, 243, 222, 143, 219,
-			0, 168, 244, 190, 61, 18, 117, 27, 65, 157, 19, 147, 244, 130,
-			180, 97, 49, 235, 103, 182, 149, 117, 56, 106, 139, 31, 53, 30,
-			180, 23, 25, 1, 44, 242, 179, 248, 179, 1, 52, 242, 51, 59,
-			51, 26, 21, 16, 40, 96, 227, 116, 94, 26, 73, 48, 235, 191,
-			108, 107, 220, 25, 199, 76, 27, 33, 32, 63, 5, 242, 34, 53,
-			9, 81, 43, 29, 21, 24, 80, 64, 71, 162, 2, 2, 5, 232,
-			50, 180, 172, 57, 24, 95, 63, 183, 205, 121, 49, 252, 240, 131,
-			222, 207, 213, 132, 52, 135, 187, 157, 159, 219, 153, 156, 18, 13,
-			16, 167, 103, 149, 72, 64, 156, 115, 106, 73, 204, 195, 124, 137,
-			126, 179, 72, 215, 155, 65, 169, 126, 208, 11, 218, 254, 97, 187,
-			20, 244, 154, 107, 173, 195, 186, 47, 54, 146, 110, 87, 254, 120,
-			120, 113, 13, 233, 146, 194, 18, 94, 199, 44, 40, 205, 95, 120,
-			182, 43, 123, 94, 55, 16, 215, 229, 139, 226, 133, 93, 67, 169,
-			118, 184, 191, 166, 189, 29, 162, 130, 243, 109, 66, 19, 21, 176,
-			196, 230, 169, 245, 192, 239, 52, 114, 6, 55, 22, 71, 214, 71,
-			75, 160, 173, 132, 127, 221, 246, 59, 141, 29, 252, 147, 141, 81,
-			242, 232, 32, 200, 153, 220, 88, 76, 239, 192, 79, 86, 162, 22,
-			108, 166, 114, 132, 27, 139, 153, 245, 124, 73, 206, 16, 202, 96,
-			105, 79, 25, 220, 193, 122, 44, 71, 109, 57, 118, 115, 22, 106,
-			81, 34, 203, 211, 148, 26, 205, 185, 4, 254, 165, 101, 176, 219,
-			243, 246, 115, 73, 97, 183, 231, 237, 67, 73, 223, 109, 230, 108,
-			81, 210, 119, 155, 236, 69, 58, 220, 236, 185, 157, 190, 215, 168,
-			246, 130, 150, 151, 75, 113, 178, 152, 89, 207, 137, 134, 28, 99,
-			58, 42, 111, 220, 217, 201, 200, 218, 59, 65, 203, 131, 139, 123,
-			222, 195, 224, 129, 186, 56, 253, 81, 23, 203, 218, 120, 241, 4,
-			77, 182, 27, 213, 7, 222, 81, 142, 226, 237, 36, 218, 141, 219,
-			222, 17, 155, 166, 169, 118, 163, 138, 113, 19, 185, 140, 104, 107,
-			187, 129, 31, 114, 217, 57, 58, 218, 110, 84, 101, 84, 90, 181,
-			127, 212, 245, 114, 195, 88, 227, 84, 187, 177, 33, 74, 247, 142,
-			186, 30, 59, 75, 71, 218, 141, 106, 44, 34, 35, 119, 74, 85,
-			139, 5, 151, 45, 191, 111, 210, 180, 126, 84, 44, 79, 39, 43,
-			159, 173, 108, 239, 85, 111, 111, 109, 111, 86, 95, 219, 222, 189,
-			87, 217, 216, 186, 177, 85, 217, 28, 27, 98, 147, 148, 221, 219,
-			169, 220, 216, 250, 124, 181, 188, 113, 167, 186, 113, 171, 188, 125,
-			179, 178, 57, 214, 96, 89, 58, 122, 175, 188, 113, 187, 124, 179,
-			82, 221, 216, 169, 148, 247, 42, 155, 99, 223, 53, 226, 165, 155,
-			149, 59, 21, 40, 253, 7, 131, 141, 211, 17, 85, 122, 107, 107,
-			115, 179, 178, 61, 246, 143, 6, 155, 160, 99, 170, 240, 181, 109,
-			89, 252, 79, 88, 188, 181, 189, 187, 87, 222, 222, 136, 20, 255,
-			185, 57, 80, 172, 52, 255, 197, 96, 241, 78, 229, 70, 117, 183,
-			178, 55, 246, 53, 147, 77, 81, 54, 80, 252, 218, 54, 252, 241,
-			151, 38, 203, 211, 9, 253, 199, 94, 249, 102, 181, 188, 183, 87,
-			222, 184, 85, 217, 28, 251, 171, 39, 255, 219, 172, 200, 255, 222,
-			49, 89, 145, 230, 245, 127, 175, 86, 246, 202, 155, 229, 189, 114,
-			116, 241, 215, 159, 82, 65, 107, 248, 107, 243, 250, 249, 223, 90,
-			122, 166, 151, 241, 69, 183, 235, 127, 250, 91, 83, 52, 201, 172,
-			145, 161, 187, 6, 253, 91, 139, 26, 195, 140, 140, 12, 177, 245,
-			111, 89, 124, 35, 232, 30, 245, 252, 230, 65, 159, 175, 95, 184,
-			40, 130, 153, 145, 229, 184, 124, 216, 63, 8, 122, 8, 58, 238,
-			248, 117, 175, 3, 40, 50, 2, 81, 229, 174, 91, 135, 154, 226,
-			159, 21, 174, 224, 202, 122, 233, 130, 8, 71, 112, 228, 95, 206,
-			210, 139, 148, 31, 5, 135, 152, 96, 16, 86, 171, 195, 208, 83,
-			27, 253, 22, 76, 188, 117, 175, 139, 108, 238, 152, 246, 215, 143,
-			220, 60, 253, 72, 125, 137, 242, 47, 72, 13, 65, 13, 89, 166,
-			92, 94, 15, 186, 71, 106, 246, 150, 213, 184, 219, 167, 146, 83,
-			247, 160, 223, 239, 190, 176, 182, 246, 232, 209, 163, 146, 139, 119,
-			42, 122, 71, 212, 11, 215, 238, 108, 109, 84, 182, 119, 43, 171,
-			235, 165, 11, 130, 196, 217, 11, 67, 174, 142, 168, 34, 97, 105,
-			23, 51, 60, 213, 90, 30, 111, 185, 143, 120, 208, 227, 110, 179,
-			231, 137, 101, 213, 239, 32, 57, 165, 223, 105, 174, 240, 48, 216,
-			239, 63, 194, 252, 130, 13, 31, 246, 213, 181, 195, 254, 64, 55,
-			169, 59, 243, 195, 129, 10, 226, 123, 172, 83, 222, 229, 91, 187,
-			14, 191, 94, 222, 221, 218, 93, 161, 252, 115, 91, 123, 183, 238,
-			190, 182, 199, 63, 87, 222, 217, 41, 111, 239, 109, 85, 118, 249,
-			221, 29, 174, 201, 140, 119, 249, 221, 27, 188, 188, 253, 5, 14,
-			175, 212, 138, 74, 107, 227, 189, 14, 251, 244, 16, 9, 140, 161,
-			3, 145, 210, 118, 87, 126, 235, 83, 230, 21, 243, 172, 220, 122,
-			212, 121, 203, 237, 52, 15, 97, 237, 110, 6, 15, 189, 30, 126,
-			175, 235, 122, 189, 182, 31, 194, 67, 196, 237, 63, 141, 31, 136,
-			125, 178, 69, 37, 74, 83, 212, 48, 25, 25, 27, 58, 5, 191,
-			82, 140, 176, 161, 107, 52, 77, 205, 84, 70, 255, 36, 67, 140,
-			100, 135, 94, 192, 159, 6, 35, 19, 67, 75, 148, 34, 39, 173,
-			149, 27, 90, 53, 40, 21, 148, 173, 36, 151, 24, 161, 25, 201,
-			216, 74, 166, 205, 2, 29, 86, 132, 173, 100, 218, 204, 41, 201,
-			100, 100, 250, 204, 12, 189, 34, 217, 90, 201, 140, 121, 38, 191,
-			162, 56, 15, 197, 66, 245, 194, 241, 4, 145, 130, 17, 89, 81,
-			98, 13, 43, 38, 85, 50, 99, 102, 149, 100, 50, 50, 51, 149,
-			71, 246, 20, 0, 58, 164, 136, 95, 137, 212, 161, 158, 39, 245,
-			70, 71, 140, 22, 99, 12, 219, 90, 59, 220, 89, 209, 60, 173,
-			36, 80, 56, 145, 147, 173, 35, 140, 112, 115, 90, 254, 5, 29,
-			194, 117, 69, 98, 50, 194, 117, 69, 139, 145, 57, 93, 209, 50,
-			64, 26, 83, 146, 201, 200, 156, 174, 152, 96, 196, 209, 21, 19,
-			6, 72, 76, 73, 38, 35, 206, 68, 142, 222, 194, 138, 73, 70,
-			206, 154, 243, 249, 23, 35, 214, 150, 147, 91, 246, 84, 158, 113,
-			221, 192, 164, 1, 170, 148, 149, 164, 201, 200, 217, 162, 35, 111,
-			199, 102, 228, 156, 57, 47, 255, 178, 13, 144, 84, 69, 219, 100,
-			228, 156, 174, 152, 98, 100, 65, 87, 76, 25, 32, 169, 138, 41,
-			147, 145, 5, 93, 49, 205, 200, 162, 174, 152, 54, 64, 82, 79,
-			46, 109, 50, 178, 168, 43, 82, 70, 150, 116, 69, 106, 128, 52,
-			165, 36, 147, 145, 37, 93, 49, 195, 200, 178, 174, 152, 49, 64,
-			82, 21, 51, 38, 35, 203, 186, 226, 48, 35, 231, 117, 197, 97,
-			3, 164, 25, 37, 153, 140, 156, 215, 21, 79, 49, 178, 162, 43,
-			158, 50, 64, 82, 21, 79, 153, 140, 172, 20, 29, 250, 216, 68,
-			162, 88, 235, 83, 67, 119, 141, 252, 239, 155, 28, 215, 73, 145,
-			225, 88, 102, 190, 15, 251, 189, 195, 122, 255, 16, 166, 31, 193,
-			81, 217, 10, 154, 50, 32, 91, 80, 1, 33, 136, 12, 250, 7,
-			252, 186, 223, 252, 12, 158, 76, 95, 68, 10, 230, 198, 65, 80,
-			199, 227, 225, 190, 23, 46, 161, 155, 7, 246, 82, 110, 223, 13,
-			251, 65, 207, 19, 149, 194, 131, 224, 17, 58, 123, 96, 234, 242,
-			106, 252, 181, 45, 25, 134, 128, 36, 125, 242, 99, 147, 252, 230,
-			233, 185, 112, 23, 226, 132, 188, 214, 162, 179, 175, 107, 183, 73,
-			232, 245, 124, 183, 229, 191, 225, 53, 100, 99, 220, 144, 251, 161,
-			100, 234, 141, 102, 231, 80, 80, 192, 80, 153, 117, 78, 120, 235,
-			97, 170, 234, 52, 188, 215, 197, 39, 122, 42, 88, 115, 201, 167,
-			82, 167, 160, 67, 49, 46, 132, 60, 111, 78, 64, 23, 90, 72,
-			161, 71, 158, 55, 51, 74, 50, 24, 121, 126, 120, 76, 73, 132,
-			145, 231, 199, 179, 72, 35, 132, 115, 194, 21, 243, 180, 179, 132,
-			161, 46, 131, 84, 150, 42, 234, 2, 41, 88, 95, 242, 218, 174,
-			223, 122, 217, 161, 82, 139, 145, 128, 11, 83, 74, 2, 53, 233,
-			97, 37, 17, 70, 174, 140, 142, 209, 47, 27, 104, 194, 100, 228,
-			170, 121, 54, 255, 123, 200, 17, 221, 18, 14, 65, 95, 50, 106,
-			227, 158, 24, 218, 38, 178, 20, 182, 253, 86, 203, 15, 189, 122,
-			32, 60, 169, 117, 31, 103, 214, 18, 223, 118, 59, 129, 40, 14,
-			69, 98, 90, 183, 166, 206, 153, 11, 199, 155, 120, 49, 185, 215,
-			246, 251, 138, 61, 91, 165, 55, 236, 247, 220, 78, 232, 214, 165,
-			207, 92, 220, 33, 188, 218, 87, 205, 51, 74, 50, 24, 185, 58,
-			195, 149, 68, 24, 185, 58, 255, 28, 70, 173, 227, 244, 243, 138,
-			57, 225, 172, 199, 166, 176, 142, 204, 226, 27, 37, 203, 132, 6,
-			72, 112, 182, 44, 111, 101, 73, 153, 34, 9, 208, 160, 58, 10,
-			38, 176, 87, 210, 234, 81, 16, 208, 62, 158, 165, 43, 104, 202,
-			98, 228, 154, 57, 233, 20, 7, 184, 160, 182, 54, 177, 123, 52,
-			166, 89, 86, 122, 173, 4, 84, 87, 122, 97, 190, 187, 150, 62,
-			173, 36, 194, 200, 181, 236, 132, 212, 155, 96, 164, 108, 158, 6,
-			189, 58, 217, 209, 160, 78, 64, 102, 90, 111, 2, 171, 43, 189,
-			48, 61, 150, 245, 131, 77, 16, 70, 202, 163, 99, 24, 0, 142,
-			211, 227, 117, 243, 180, 179, 44, 51, 248, 46, 250, 29, 190, 240,
-			224, 133, 135, 11, 232, 94, 91, 26, 52, 1, 56, 78, 155, 72,
-			38, 224, 74, 101, 2, 230, 198, 235, 218, 68, 146, 48, 114, 125,
-			116, 12, 15, 171, 226, 220, 120, 195, 188, 188, 126, 150, 151, 59,
-			130, 205, 216, 223, 223, 231, 177, 222, 142, 65, 97, 253, 108, 109,
-			11, 46, 210, 82, 146, 145, 27, 25, 71, 73, 6, 35, 55, 230,
-			215, 148, 68, 24, 185, 177, 126, 73, 190, 64, 41, 70, 110, 154,
-			151, 229, 95, 41, 11, 36, 165, 36, 149, 100, 228, 166, 86, 2,
-			83, 239, 77, 173, 36, 69, 24, 185, 185, 126, 9, 131, 208, 113,
-			234, 189, 109, 78, 56, 99, 220, 141, 34, 80, 31, 120, 71, 116,
-			125, 149, 223, 16, 47, 178, 78, 140, 252, 36, 76, 85, 99, 71,
-			55, 37, 157, 0, 117, 170, 163, 96, 38, 191, 157, 30, 85, 18,
-			97, 228, 54, 19, 196, 57, 56, 147, 223, 49, 167, 156, 171, 113,
-			187, 234, 116, 140, 62, 34, 162, 95, 54, 239, 117, 225, 178, 31,
-			56, 93, 19, 42, 171, 52, 1, 202, 148, 85, 88, 22, 238, 232,
-			145, 69, 9, 35, 119, 178, 147, 244, 28, 90, 205, 48, 242, 170,
-			57, 235, 76, 199, 173, 198, 85, 42, 141, 153, 4, 84, 84, 26,
-			97, 253, 120, 53, 157, 83, 18, 97, 228, 213, 51, 5, 122, 22,
-			53, 14, 51, 178, 109, 22, 156, 92, 92, 99, 108, 19, 165, 20,
-			14, 39, 160, 158, 82, 8, 235, 204, 118, 122, 74, 73, 132, 145,
-			237, 252, 140, 222, 184, 127, 105, 132, 94, 126, 198, 141, 187, 236,
-			150, 129, 173, 251, 71, 110, 196, 255, 213, 160, 153, 138, 188, 114,
-			207, 109, 178, 17, 106, 250, 98, 51, 158, 222, 49, 253, 198, 192,
-			238, 216, 60, 182, 59, 142, 237, 169, 201, 224, 158, 122, 140, 18,
-			216, 150, 138, 157, 54, 252, 100, 89, 154, 16, 59, 82, 177, 197,
-			22, 2, 43, 210, 140, 142, 106, 174, 29, 201, 125, 54, 85, 69,
-			215, 143, 216, 139, 177, 10, 253, 16, 183, 221, 31, 190, 219, 215,
-			23, 239, 133, 191, 218, 102, 233, 113, 70, 108, 150, 230, 63, 116,
-			179, 116, 229, 227, 205, 210, 199, 155, 165, 95, 243, 102, 105, 137,
-			254, 141, 33, 176, 226, 153, 161, 121, 35, 255, 117, 131, 199, 94,
-			72, 201, 164, 128, 193, 192, 245, 3, 175, 45, 152, 153, 23, 212,
-			203, 142, 193, 63, 213, 151, 190, 24, 212, 252, 198, 203, 11, 17,
-			80, 68, 10, 148, 48, 58, 222, 215, 141, 159, 180, 171, 124, 254,
-			222, 221, 157, 61, 88, 213, 118, 171, 123, 119, 171, 215, 63, 131,
-			68, 216, 94, 143, 127, 49, 168, 33, 156, 140, 103, 178, 19, 223,
-			237, 123, 126, 112, 24, 82, 9, 61, 221, 142, 219, 58, 10, 125,
-			241, 181, 213, 109, 134, 49, 68, 119, 38, 53, 78, 75, 10, 209,
-			205, 152, 99, 206, 28, 95, 236, 62, 104, 174, 32, 36, 88, 225,
-			126, 99, 9, 105, 2, 14, 69, 110, 12, 92, 92, 20, 176, 75,
-			192, 5, 169, 24, 232, 155, 73, 103, 98, 160, 111, 102, 100, 84,
-			106, 54, 24, 41, 152, 147, 206, 220, 49, 164, 161, 0, 95, 185,
-			113, 175, 126, 171, 84, 42, 13, 128, 189, 194, 0, 216, 43, 232,
-			21, 1, 192, 94, 33, 59, 65, 207, 43, 172, 55, 107, 78, 56,
-			179, 199, 224, 210, 32, 241, 176, 70, 97, 9, 168, 157, 138, 161,
-			176, 89, 13, 141, 0, 120, 205, 142, 103, 229, 178, 64, 112, 127,
-			232, 228, 48, 30, 12, 158, 91, 44, 183, 81, 223, 109, 198, 177,
-			86, 113, 0, 107, 21, 53, 176, 0, 172, 85, 28, 29, 163, 11,
-			10, 107, 113, 115, 220, 201, 203, 60, 205, 79, 87, 9, 48, 139,
-			15, 192, 44, 158, 30, 137, 193, 44, 126, 154, 201, 166, 39, 112,
-			255, 233, 204, 34, 123, 138, 219, 192, 20, 148, 253, 149, 167, 192,
-			231, 4, 214, 142, 163, 172, 185, 116, 54, 134, 178, 230, 166, 114,
-			148, 43, 148, 229, 152, 43, 206, 184, 34, 207, 18, 167, 23, 65,
-			189, 134, 83, 88, 229, 76, 12, 78, 57, 51, 11, 49, 56, 229,
-			44, 159, 215, 139, 225, 155, 121, 250, 210, 179, 45, 134, 248, 217,
-			93, 70, 22, 86, 97, 95, 21, 95, 20, 215, 78, 214, 17, 180,
-			219, 65, 103, 173, 246, 187, 107, 221, 218, 154, 56, 76, 42, 221,
-			224, 206, 119, 45, 154, 253, 108, 76, 227, 157, 160, 89, 233, 244,
-			123, 71, 108, 142, 14, 107, 186, 197, 170, 92, 55, 201, 78, 70,
-			151, 109, 53, 160, 138, 96, 244, 234, 139, 53, 78, 44, 162, 25,
-			93, 118, 253, 136, 113, 58, 220, 247, 218, 221, 106, 51, 172, 118,
-			221, 254, 129, 92, 76, 41, 148, 221, 12, 239, 185, 253, 3, 118,
-			129, 102, 69, 0, 144, 215, 168, 170, 33, 15, 246, 196, 2, 203,
-			212, 127, 106, 167, 191, 213, 128, 43, 68, 31, 28, 187, 66, 44,
-			191, 76, 253, 23, 187, 98, 149, 166, 195, 195, 154, 216, 130, 224,
-			74, 76, 174, 143, 254, 199, 187, 207, 101, 104, 122, 111, 235, 213,
-			202, 238, 94, 249, 213, 123, 59, 81, 13, 182, 68, 237, 176, 239,
-			194, 28, 132, 171, 242, 9, 149, 213, 255, 236, 60, 77, 169, 80,
-			241, 92, 234, 228, 186, 186, 2, 91, 160, 163, 146, 143, 178, 42,
-			191, 42, 230, 210, 120, 207, 35, 178, 88, 174, 164, 172, 64, 169,
-			12, 16, 132, 118, 9, 15, 120, 90, 150, 108, 53, 216, 52, 77,
-			245, 123, 174, 104, 180, 244, 130, 163, 188, 213, 96, 103, 104, 26,
-			214, 214, 106, 232, 191, 33, 252, 223, 4, 236, 183, 188, 93, 255,
-			13, 143, 173, 82, 54, 48, 120, 194, 174, 231, 53, 208, 253, 77,
-			118, 78, 199, 255, 217, 133, 63, 0, 3, 5, 130, 246, 52, 55,
-			34, 172, 72, 17, 16, 15, 126, 159, 203, 141, 10, 196, 131, 194,
-			175, 134, 73, 190, 148, 21, 152, 228, 19, 31, 134, 73, 214, 215,
-			63, 198, 36, 31, 99, 146, 95, 51, 38, 185, 66, 127, 76, 4,
-			38, 225, 67, 159, 48, 242, 255, 78, 248, 73, 243, 223, 201, 224,
-			228, 126, 252, 45, 185, 127, 12, 147, 72, 72, 210, 243, 234, 65,
-			175, 113, 156, 88, 23, 3, 149, 226, 87, 3, 34, 9, 87, 68,
-			230, 50, 237, 167, 236, 7, 188, 30, 180, 90, 94, 29, 83, 142,
-			69, 169, 33, 193, 54, 140, 161, 240, 133, 181, 181, 122, 175, 118,
-			216, 44, 213, 131, 246, 218, 197, 245, 79, 94, 188, 242, 252, 243,
-			131, 73, 31, 107, 158, 160, 119, 12, 122, 232, 117, 139, 193, 35,
-			17, 32, 230, 119, 86, 219, 94, 59, 232, 29, 241, 218, 225, 254,
-			190, 215, 11, 75, 124, 23, 51, 171, 203, 216, 5, 76, 127, 81,
-			243, 120, 163, 23, 136, 204, 21, 200, 67, 37, 230, 30, 94, 239,
-			137, 88, 180, 154, 183, 31, 244, 68, 236, 99, 235, 48, 84, 193,
-			160, 66, 33, 6, 247, 98, 212, 35, 110, 94, 69, 216, 92, 205,
-			211, 97, 118, 45, 249, 66, 233, 206, 83, 92, 189, 152, 235, 139,
-			151, 239, 109, 97, 228, 8, 141, 26, 220, 10, 14, 27, 106, 95,
-			4, 237, 174, 249, 77, 100, 144, 92, 107, 4, 245, 112, 13, 147,
-			221, 172, 186, 93, 127, 30, 54, 165, 248, 229, 175, 138, 65, 64,
-			56, 3, 132, 17, 128, 227, 169, 25, 186, 164, 0, 28, 32, 130,
-			25, 29, 202, 250, 26, 198, 187, 221, 85, 107, 91, 105, 107, 51,
-			142, 221, 230, 76, 59, 134, 221, 230, 82, 217, 24, 118, 3, 60,
-			160, 177, 155, 99, 230, 157, 185, 184, 115, 46, 12, 218, 94, 208,
-			241, 174, 201, 64, 103, 184, 251, 1, 236, 230, 12, 96, 55, 39,
-			61, 17, 195, 110, 78, 110, 90, 186, 186, 76, 70, 230, 205, 188,
-			36, 65, 191, 185, 203, 131, 218, 23, 189, 122, 63, 10, 234, 116,
-			155, 152, 68, 164, 231, 185, 50, 208, 75, 45, 129, 113, 60, 55,
-			63, 128, 231, 230, 181, 41, 128, 112, 243, 185, 105, 186, 168, 240,
-			220, 89, 211, 113, 206, 224, 228, 86, 243, 228, 169, 86, 204, 35,
-			216, 231, 15, 58, 193, 163, 78, 28, 210, 157, 29, 128, 116, 103,
-			211, 133, 24, 164, 59, 203, 231, 232, 178, 130, 116, 231, 76, 199,
-			41, 168, 248, 225, 184, 223, 67, 209, 207, 199, 81, 221, 185, 1,
-			84, 119, 78, 107, 5, 84, 119, 142, 207, 73, 160, 152, 96, 100,
-			209, 44, 59, 121, 222, 246, 235, 61, 237, 131, 12, 125, 252, 26,
-			208, 13, 234, 7, 113, 68, 183, 168, 159, 32, 32, 186, 197, 212,
-			233, 24, 162, 91, 204, 170, 174, 72, 164, 24, 89, 156, 188, 70,
-			199, 104, 26, 165, 127, 254, 14, 220, 192, 226, 212, 43, 210, 100,
-			146, 145, 37, 243, 149, 103, 48, 153, 76, 64, 77, 59, 6, 252,
-			150, 82, 163, 49, 224, 183, 196, 198, 149, 148, 98, 100, 41, 251,
-			178, 52, 153, 148, 38, 151, 38, 174, 74, 147, 54, 35, 203, 230,
-			181, 103, 48, 105, 39, 160, 166, 29, 115, 167, 45, 167, 198, 98,
-			238, 180, 229, 113, 53, 106, 237, 20, 35, 203, 19, 175, 72, 147,
-			182, 52, 185, 60, 249, 50, 114, 38, 160, 187, 109, 197, 44, 56,
-			139, 252, 102, 185, 162, 249, 179, 85, 124, 151, 24, 219, 151, 47,
-			174, 95, 90, 21, 57, 219, 245, 112, 78, 37, 224, 186, 84, 204,
-			21, 183, 162, 93, 73, 41, 194, 200, 138, 248, 218, 37, 92, 113,
-			171, 230, 180, 179, 114, 194, 193, 66, 157, 189, 77, 5, 208, 201,
-			137, 39, 238, 119, 91, 29, 240, 187, 173, 166, 199, 99, 126, 183,
-			213, 201, 156, 244, 173, 82, 70, 74, 230, 148, 83, 228, 27, 48,
-			123, 240, 61, 192, 71, 124, 107, 243, 88, 42, 204, 184, 103, 173,
-			52, 224, 89, 43, 13, 120, 214, 74, 217, 73, 249, 64, 50, 140,
-			92, 48, 167, 156, 188, 228, 110, 67, 208, 112, 44, 42, 38, 114,
-			173, 93, 208, 15, 36, 99, 48, 114, 65, 15, 187, 12, 97, 228,
-			66, 118, 18, 201, 191, 208, 181, 118, 209, 228, 206, 121, 174, 145,
-			27, 95, 227, 141, 67, 69, 48, 175, 20, 195, 138, 200, 197, 8,
-			136, 123, 219, 46, 106, 27, 195, 6, 35, 23, 83, 249, 152, 183,
-			237, 98, 161, 40, 223, 235, 83, 140, 92, 50, 39, 157, 51, 24,
-			147, 87, 119, 195, 146, 152, 241, 100, 196, 153, 215, 57, 108, 43,
-			157, 167, 18, 80, 85, 117, 197, 41, 131, 145, 75, 122, 239, 119,
-			138, 48, 114, 105, 124, 130, 62, 135, 58, 71, 24, 185, 108, 102,
-			157, 169, 193, 51, 132, 34, 191, 119, 71, 111, 126, 71, 18, 80,
-			77, 233, 27, 49, 24, 185, 172, 247, 105, 35, 132, 145, 203, 167,
-			199, 213, 38, 232, 255, 2, 0, 0, 255, 255, 118, 102, 178, 234,
-			201, 41, 2, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 253, 123, 120, 28, 201,
+			117, 31, 12, 163, 47, 51, 24, 20, 64, 96, 80, 192, 146, 224,
+			112, 73, 22, 135, 228, 2, 32, 129, 225, 101, 111, 90, 174, 119,
+			37, 16, 0, 185, 88, 145, 4, 61, 0, 181, 94, 249, 145, 160,
+			198, 76, 3, 232, 229, 160, 123, 212, 221, 67, 16, 27, 43, 246,
+			103, 69, 178, 37, 71, 223, 70, 178, 164, 85, 228, 139, 18, 249,
+			38, 201, 177, 236, 88, 182, 62, 59, 246, 231, 216, 242, 251, 70,
+			175, 98, 217, 142, 148, 40, 113, 236, 60, 201, 251, 200, 78, 34,
+			59, 178, 173, 139, 101, 203, 146, 21, 189, 207, 239, 212, 165, 123,
+			0, 112, 119, 37, 107, 147, 63, 94, 239, 254, 65, 156, 154, 234,
+			83, 85, 167, 78, 157, 58, 231, 212, 169, 83, 236, 147, 111, 114,
+			152, 88, 143, 162, 245, 150, 127, 166, 29, 71, 105, 180, 218, 89,
+			59, 211, 244, 147, 70, 28, 180, 211, 40, 174, 81, 25, 31, 146,
+			53, 106, 186, 70, 245, 42, 27, 190, 20, 180, 252, 57, 83, 113,
+			201, 79, 249, 75, 152, 187, 22, 180, 252, 49, 75, 56, 19, 253,
+			231, 79, 212, 118, 124, 84, 235, 254, 226, 58, 138, 235, 244, 69,
+			245, 143, 92, 54, 178, 199, 175, 156, 51, 55, 244, 54, 129, 209,
+			154, 232, 171, 211, 223, 124, 140, 245, 182, 189, 198, 77, 111, 221,
+			31, 179, 169, 88, 131, 252, 8, 99, 77, 191, 237, 135, 77, 63,
+			108, 108, 143, 57, 194, 153, 232, 171, 231, 74, 248, 105, 54, 220,
+			238, 172, 182, 130, 198, 74, 174, 26, 19, 206, 68, 161, 94, 150,
+			63, 204, 101, 149, 199, 217, 208, 150, 239, 221, 204, 87, 237, 167,
+			170, 131, 40, 206, 85, 156, 101, 3, 155, 126, 146, 120, 235, 254,
+			74, 186, 221, 246, 199, 92, 26, 189, 216, 53, 250, 157, 35, 239,
+			87, 95, 45, 111, 183, 125, 62, 195, 250, 252, 176, 179, 41, 49,
+			20, 238, 64, 191, 249, 176, 179, 185, 19, 75, 9, 159, 41, 20,
+			189, 137, 31, 223, 10, 26, 254, 88, 145, 16, 140, 239, 66, 176,
+			36, 127, 223, 137, 67, 127, 199, 103, 89, 159, 127, 59, 245, 195,
+			36, 136, 194, 177, 94, 66, 114, 114, 23, 146, 75, 129, 223, 106,
+			238, 68, 145, 125, 199, 31, 96, 189, 81, 59, 13, 162, 48, 25,
+			43, 9, 107, 162, 255, 252, 221, 123, 160, 104, 249, 139, 178, 78,
+			93, 87, 230, 11, 172, 156, 68, 157, 184, 225, 175, 52, 162, 166,
+			191, 18, 132, 107, 209, 88, 31, 33, 56, 186, 11, 193, 18, 85,
+			156, 141, 154, 254, 66, 184, 22, 213, 7, 147, 46, 152, 239, 103,
+			197, 100, 59, 76, 189, 219, 99, 3, 196, 33, 10, 170, 254, 114,
+			145, 13, 189, 16, 22, 123, 152, 21, 214, 48, 202, 49, 251, 27,
+			161, 129, 252, 166, 155, 136, 197, 111, 146, 136, 51, 172, 63, 244,
+			147, 212, 111, 74, 142, 112, 94, 32, 79, 49, 249, 209, 110, 150,
+			114, 191, 41, 150, 250, 14, 54, 100, 186, 180, 18, 123, 225, 186,
+			230, 205, 51, 207, 215, 147, 218, 188, 254, 174, 142, 207, 234, 131,
+			6, 15, 193, 124, 142, 177, 40, 244, 163, 181, 149, 166, 223, 104,
+			141, 149, 238, 64, 165, 69, 84, 217, 217, 189, 62, 250, 112, 206,
+			111, 180, 248, 67, 25, 171, 245, 222, 129, 83, 174, 202, 69, 182,
+			139, 219, 110, 176, 193, 216, 7, 223, 251, 77, 53, 178, 62, 234,
+			68, 237, 121, 71, 86, 87, 159, 209, 64, 234, 251, 52, 22, 2,
+			249, 113, 102, 10, 86, 32, 173, 72, 188, 244, 213, 7, 116, 225,
+			53, 111, 211, 175, 60, 205, 6, 187, 201, 195, 71, 89, 33, 73,
+			189, 56, 37, 65, 87, 168, 75, 128, 151, 153, 227, 135, 77, 146,
+			114, 133, 58, 254, 228, 47, 203, 6, 236, 208, 128, 239, 217, 213,
+			221, 110, 204, 59, 199, 93, 121, 144, 237, 235, 26, 192, 11, 109,
+			186, 250, 93, 236, 174, 61, 81, 243, 239, 96, 163, 157, 48, 8,
+			83, 63, 110, 199, 62, 56, 86, 246, 112, 236, 191, 247, 222, 129,
+			231, 110, 228, 107, 75, 44, 245, 145, 46, 20, 178, 240, 84, 95,
+			233, 51, 189, 229, 239, 249, 158, 239, 249, 30, 187, 250, 27, 69,
+			54, 186, 215, 154, 217, 115, 249, 238, 103, 197, 176, 179, 185, 234,
+			199, 68, 164, 66, 93, 65, 124, 134, 21, 90, 222, 170, 223, 26,
+			115, 133, 53, 49, 120, 254, 244, 11, 90, 149, 181, 43, 248, 164,
+			46, 191, 228, 143, 50, 87, 137, 104, 96, 56, 245, 194, 48, 96,
+			57, 214, 233, 59, 126, 136, 245, 225, 95, 201, 27, 69, 234, 115,
+			9, 5, 224, 11, 94, 97, 37, 90, 38, 77, 95, 111, 109, 6,
+			6, 99, 53, 253, 53, 175, 211, 74, 87, 110, 121, 173, 142, 79,
+			12, 223, 87, 31, 80, 133, 175, 64, 25, 63, 202, 250, 105, 113,
+			172, 4, 97, 211, 191, 77, 210, 179, 80, 151, 11, 109, 1, 37,
+			104, 254, 169, 36, 10, 53, 107, 2, 67, 9, 5, 212, 252, 131,
+			25, 115, 73, 193, 125, 120, 239, 225, 237, 228, 41, 62, 206, 134,
+			168, 198, 189, 106, 234, 189, 214, 216, 176, 176, 38, 74, 245, 65,
+			89, 188, 168, 74, 171, 31, 182, 153, 11, 98, 240, 33, 214, 191,
+			252, 228, 245, 249, 149, 185, 197, 27, 23, 175, 204, 151, 45, 62,
+			200, 24, 21, 92, 186, 178, 56, 179, 92, 182, 13, 188, 112, 109,
+			249, 129, 251, 202, 142, 249, 224, 134, 44, 112, 243, 21, 238, 61,
+			95, 46, 240, 50, 27, 32, 248, 210, 194, 119, 204, 207, 61, 112,
+			95, 185, 216, 93, 114, 239, 249, 114, 47, 223, 199, 250, 168, 228,
+			226, 226, 226, 149, 114, 201, 224, 92, 90, 174, 47, 92, 187, 92,
+			238, 51, 56, 47, 215, 23, 111, 92, 47, 51, 131, 225, 234, 252,
+			210, 210, 204, 229, 249, 114, 191, 169, 113, 241, 201, 229, 249, 165,
+			242, 64, 87, 183, 238, 61, 95, 222, 103, 154, 152, 191, 118, 227,
+			106, 121, 144, 15, 179, 125, 4, 46, 233, 78, 12, 237, 40, 122,
+			224, 190, 114, 57, 235, 136, 196, 50, 220, 85, 240, 192, 125, 101,
+			94, 157, 101, 5, 98, 67, 206, 217, 224, 149, 153, 139, 243, 87,
+			86, 22, 175, 47, 47, 44, 94, 155, 185, 82, 182, 178, 178, 250,
+			252, 183, 223, 88, 168, 207, 207, 149, 237, 124, 217, 245, 249, 153,
+			229, 249, 185, 178, 83, 109, 176, 209, 189, 4, 234, 158, 75, 40,
+			199, 11, 246, 29, 120, 129, 112, 237, 228, 133, 234, 31, 218, 108,
+			100, 143, 77, 101, 207, 70, 94, 202, 10, 146, 151, 229, 54, 59,
+			185, 171, 9, 32, 34, 206, 222, 129, 173, 46, 191, 203, 171, 26,
+			206, 29, 84, 13, 160, 216, 197, 176, 175, 218, 37, 252, 229, 254,
+			248, 192, 158, 159, 239, 104, 156, 202, 190, 177, 77, 160, 176, 199,
+			38, 240, 48, 27, 222, 133, 232, 5, 11, 227, 215, 91, 108, 236,
+			78, 196, 121, 30, 145, 104, 119, 137, 196, 135, 119, 82, 240, 216,
+			158, 36, 160, 118, 118, 205, 245, 79, 89, 108, 255, 222, 42, 229,
+			158, 125, 120, 148, 21, 55, 253, 116, 35, 210, 106, 213, 238, 189,
+			235, 42, 253, 188, 3, 87, 93, 125, 149, 223, 237, 157, 59, 236,
+			246, 170, 55, 187, 122, 250, 38, 155, 221, 181, 39, 242, 61, 59,
+			122, 152, 177, 32, 108, 119, 82, 169, 58, 129, 96, 125, 245, 62,
+			42, 33, 225, 5, 41, 219, 73, 205, 239, 14, 253, 206, 100, 17,
+			85, 120, 73, 214, 81, 151, 58, 122, 228, 14, 35, 221, 217, 79,
+			126, 150, 149, 27, 173, 192, 15, 211, 149, 36, 141, 125, 111, 51,
+			8, 215, 105, 171, 41, 93, 40, 172, 121, 173, 196, 175, 15, 201,
+			159, 151, 244, 175, 248, 130, 24, 40, 206, 125, 81, 236, 250, 66,
+			254, 108, 190, 168, 190, 181, 143, 245, 231, 20, 112, 126, 140, 13,
+			60, 229, 221, 242, 86, 180, 81, 101, 145, 81, 213, 143, 178, 235,
+			202, 176, 58, 203, 70, 1, 174, 68, 157, 212, 143, 87, 26, 45,
+			47, 73, 64, 40, 210, 239, 251, 234, 28, 191, 45, 226, 167, 89,
+			253, 11, 191, 159, 141, 160, 116, 101, 179, 211, 74, 131, 118, 203,
+			95, 129, 153, 151, 140, 177, 124, 207, 134, 81, 227, 170, 170, 128,
+			30, 37, 124, 142, 29, 70, 225, 202, 186, 31, 250, 177, 151, 250,
+			43, 254, 107, 59, 94, 43, 89, 241, 194, 230, 202, 134, 151, 108,
+			140, 141, 2, 193, 69, 123, 204, 170, 31, 68, 197, 203, 170, 222,
+			60, 85, 155, 9, 155, 143, 121, 201, 6, 191, 192, 246, 227, 71,
+			80, 36, 8, 215, 87, 26, 27, 126, 227, 230, 74, 39, 93, 123,
+			201, 216, 161, 124, 251, 212, 195, 37, 170, 51, 139, 42, 55, 210,
+			181, 151, 240, 37, 54, 128, 185, 219, 12, 158, 246, 87, 214, 162,
+			152, 246, 208, 193, 243, 147, 207, 101, 194, 212, 22, 213, 7, 87,
+			163, 166, 127, 161, 176, 116, 125, 126, 126, 174, 222, 175, 177, 92,
+			138, 98, 126, 152, 177, 245, 200, 16, 184, 159, 168, 214, 183, 30,
+			105, 242, 222, 207, 70, 26, 13, 57, 230, 160, 177, 162, 140, 177,
+			100, 172, 220, 69, 172, 70, 131, 6, 27, 52, 20, 143, 39, 252,
+			33, 118, 87, 70, 172, 252, 135, 195, 249, 15, 71, 12, 157, 114,
+			159, 222, 207, 70, 218, 219, 187, 63, 228, 93, 45, 182, 183, 119,
+			126, 246, 32, 27, 109, 111, 180, 119, 127, 119, 42, 255, 29, 111,
+			111, 180, 119, 126, 120, 146, 44, 243, 216, 111, 120, 169, 223, 28,
+			59, 144, 175, 158, 251, 129, 215, 88, 185, 209, 88, 241, 67, 111,
+			181, 229, 175, 120, 177, 31, 122, 201, 216, 81, 170, 236, 166, 113,
+			199, 175, 15, 54, 26, 243, 244, 227, 12, 253, 198, 79, 177, 225,
+			104, 245, 169, 134, 228, 200, 149, 118, 236, 175, 5, 183, 199, 78,
+			16, 121, 135, 240, 3, 241, 227, 117, 42, 230, 147, 172, 220, 72,
+			54, 188, 184, 77, 34, 57, 105, 123, 13, 127, 236, 164, 172, 42,
+			203, 175, 233, 98, 172, 136, 100, 43, 88, 75, 53, 198, 113, 170,
+			214, 79, 101, 10, 219, 4, 43, 131, 18, 93, 13, 79, 80, 181,
+			193, 246, 70, 59, 223, 238, 113, 182, 175, 189, 145, 111, 116, 146,
+			170, 13, 180, 55, 114, 45, 222, 199, 246, 163, 210, 166, 159, 122,
+			77, 47, 245, 114, 181, 167, 168, 54, 200, 126, 85, 253, 216, 213,
+			207, 184, 179, 186, 109, 24, 107, 154, 234, 246, 163, 76, 179, 214,
+			139, 166, 156, 87, 47, 176, 129, 60, 223, 243, 62, 38, 57, 191,
+			108, 65, 9, 154, 93, 156, 155, 95, 89, 90, 120, 229, 124, 217,
+			134, 26, 117, 101, 97, 121, 126, 165, 126, 227, 218, 242, 194, 213,
+			249, 178, 147, 83, 236, 31, 119, 75, 247, 148, 199, 161, 53, 12,
+			118, 91, 106, 252, 219, 216, 1, 237, 86, 73, 252, 116, 101, 43,
+			136, 105, 65, 110, 122, 114, 115, 52, 252, 51, 170, 106, 45, 249,
+			233, 19, 65, 236, 95, 138, 226, 77, 47, 229, 87, 216, 209, 48,
+			90, 73, 82, 47, 108, 122, 113, 115, 37, 115, 104, 173, 120, 141,
+			134, 159, 36, 81, 60, 102, 231, 177, 220, 29, 70, 75, 170, 114,
+			182, 67, 204, 168, 170, 59, 216, 215, 185, 19, 251, 30, 98, 125,
+			155, 94, 123, 197, 15, 211, 120, 155, 244, 243, 82, 189, 180, 233,
+			181, 231, 1, 255, 47, 49, 147, 30, 119, 75, 110, 185, 240, 184,
+			91, 42, 148, 139, 143, 187, 165, 98, 185, 247, 113, 183, 84, 42,
+			247, 61, 238, 150, 250, 202, 172, 250, 54, 151, 13, 228, 53, 120,
+			62, 195, 10, 13, 218, 195, 64, 209, 193, 243, 199, 159, 83, 223,
+			175, 205, 98, 115, 187, 80, 148, 234, 114, 93, 126, 9, 197, 2,
+			236, 231, 99, 83, 135, 202, 175, 32, 126, 153, 21, 159, 74, 80,
+			131, 118, 163, 193, 243, 39, 158, 27, 247, 227, 75, 132, 188, 239,
+			241, 165, 149, 107, 139, 245, 171, 51, 87, 234, 234, 115, 126, 144,
+			185, 45, 239, 233, 237, 238, 109, 144, 138, 120, 141, 13, 117, 194,
+			91, 126, 28, 172, 5, 126, 115, 5, 69, 99, 67, 249, 90, 131,
+			217, 175, 87, 80, 255, 5, 78, 227, 65, 230, 194, 197, 215, 189,
+			89, 81, 209, 139, 184, 156, 206, 176, 2, 209, 151, 51, 166, 40,
+			92, 238, 225, 37, 230, 206, 46, 214, 231, 202, 22, 214, 144, 44,
+			93, 185, 190, 48, 63, 59, 95, 182, 171, 247, 179, 162, 36, 26,
+			150, 155, 33, 91, 185, 71, 129, 10, 135, 165, 127, 189, 113, 245,
+			226, 124, 189, 108, 239, 98, 150, 106, 194, 6, 242, 154, 252, 255,
+			18, 62, 173, 254, 186, 197, 250, 115, 154, 57, 12, 87, 175, 213,
+			138, 182, 86, 188, 86, 224, 37, 138, 149, 24, 21, 205, 160, 228,
+			133, 78, 221, 255, 138, 206, 203, 229, 85, 253, 81, 139, 149, 119,
+			170, 198, 59, 186, 105, 253, 239, 236, 102, 245, 135, 45, 54, 168,
+			54, 224, 189, 187, 119, 236, 127, 107, 247, 254, 111, 155, 237, 235,
+			210, 130, 95, 104, 239, 94, 203, 134, 131, 166, 191, 217, 142, 82,
+			184, 223, 87, 90, 254, 45, 191, 53, 86, 37, 33, 115, 230, 185,
+			245, 236, 218, 66, 246, 221, 21, 124, 118, 97, 100, 97, 110, 254,
+			234, 245, 197, 229, 249, 107, 179, 79, 174, 220, 184, 246, 242, 107,
+			139, 79, 92, 171, 151, 115, 232, 169, 218, 139, 71, 144, 234, 117,
+			86, 222, 217, 41, 126, 128, 237, 213, 173, 114, 15, 31, 97, 67,
+			215, 22, 87, 150, 22, 230, 230, 87, 230, 47, 93, 154, 159, 93,
+			94, 146, 158, 19, 83, 123, 185, 107, 129, 87, 223, 237, 176, 145,
+			61, 122, 194, 103, 148, 205, 35, 205, 176, 233, 23, 210, 251, 26,
+			180, 142, 235, 94, 156, 42, 19, 105, 146, 129, 74, 97, 10, 209,
+			27, 43, 143, 148, 67, 30, 169, 161, 172, 156, 150, 6, 159, 98,
+			188, 29, 37, 65, 26, 220, 130, 83, 95, 187, 175, 96, 24, 185,
+			245, 178, 254, 101, 33, 76, 77, 237, 208, 95, 247, 118, 212, 134,
+			240, 119, 234, 101, 253, 139, 169, 125, 140, 13, 52, 163, 14, 180,
+			69, 137, 21, 123, 141, 85, 239, 151, 101, 166, 138, 178, 3, 50,
+			191, 217, 64, 189, 95, 150, 201, 42, 227, 108, 200, 91, 95, 143,
+			129, 92, 35, 146, 150, 205, 160, 41, 166, 138, 149, 199, 89, 73,
+			211, 1, 174, 52, 80, 98, 165, 45, 205, 117, 27, 174, 180, 80,
+			255, 120, 140, 13, 4, 201, 138, 241, 121, 143, 217, 194, 158, 40,
+			213, 251, 131, 196, 184, 80, 171, 63, 101, 179, 193, 238, 99, 12,
+			62, 199, 74, 173, 168, 225, 129, 222, 234, 12, 109, 226, 121, 78,
+			62, 106, 87, 84, 253, 186, 249, 178, 242, 59, 22, 43, 233, 98,
+			190, 159, 185, 109, 47, 221, 32, 116, 133, 139, 118, 217, 170, 19,
+			140, 242, 164, 237, 133, 99, 118, 86, 14, 24, 90, 114, 203, 247,
+			154, 100, 54, 69, 155, 155, 126, 152, 74, 99, 187, 175, 62, 164,
+			202, 103, 85, 49, 78, 211, 210, 216, 11, 90, 93, 117, 93, 170,
+			91, 214, 63, 152, 202, 23, 216, 65, 141, 183, 233, 167, 94, 99,
+			195, 111, 102, 31, 225, 144, 164, 175, 126, 64, 85, 152, 83, 191,
+			235, 111, 171, 159, 176, 216, 176, 54, 244, 154, 134, 88, 87, 25,
+			243, 194, 48, 74, 243, 228, 218, 205, 202, 187, 190, 171, 205, 152,
+			143, 234, 57, 4, 149, 77, 198, 178, 95, 238, 72, 182, 163, 172,
+			95, 157, 81, 193, 158, 85, 174, 1, 38, 139, 46, 5, 45, 114,
+			224, 172, 250, 235, 65, 168, 60, 207, 18, 208, 14, 28, 215, 56,
+			112, 46, 254, 125, 54, 210, 136, 54, 119, 118, 247, 98, 121, 135,
+			123, 34, 121, 204, 122, 229, 180, 170, 180, 30, 181, 188, 112, 189,
+			22, 197, 235, 217, 65, 45, 20, 172, 36, 119, 92, 219, 94, 253,
+			178, 101, 189, 215, 118, 46, 95, 191, 248, 1, 187, 114, 89, 126,
+			120, 93, 213, 174, 213, 253, 181, 150, 223, 192, 0, 31, 255, 131,
+			223, 182, 89, 47, 47, 140, 247, 188, 171, 215, 98, 31, 24, 98,
+			214, 0, 119, 198, 123, 248, 249, 223, 24, 16, 84, 189, 17, 181,
+			196, 197, 206, 218, 154, 31, 39, 98, 90, 72, 68, 227, 137, 128,
+			113, 34, 72, 160, 52, 54, 224, 234, 18, 82, 35, 103, 98, 54,
+			106, 111, 199, 193, 250, 70, 42, 206, 159, 61, 251, 18, 245, 129,
+			88, 8, 27, 53, 33, 102, 90, 45, 65, 191, 37, 66, 123, 192,
+			106, 76, 108, 164, 105, 59, 185, 112, 230, 76, 19, 50, 47, 106,
+			251, 113, 162, 169, 209, 136, 54, 229, 8, 27, 81, 107, 122, 85,
+			118, 226, 12, 99, 162, 238, 55, 3, 44, 219, 213, 14, 134, 32,
+			188, 176, 41, 58, 137, 47, 130, 80, 200, 9, 160, 146, 213, 32,
+			244, 226, 109, 234, 87, 50, 37, 182, 130, 116, 67, 68, 49, 253,
+			27, 117, 82, 38, 54, 163, 102, 176, 22, 200, 197, 49, 37, 188,
+			216, 23, 109, 63, 222, 12, 210, 212, 111, 138, 118, 28, 221, 10,
+			154, 126, 83, 164, 27, 94, 42, 210, 13, 95, 172, 69, 208, 64,
+			130, 112, 93, 52, 162, 176, 25, 224, 163, 4, 31, 49, 177, 233,
+			167, 23, 24, 19, 248, 239, 212, 142, 142, 37, 34, 90, 211, 61,
+			194, 73, 166, 216, 236, 36, 169, 136, 253, 212, 11, 66, 194, 234,
+			173, 70, 183, 240, 147, 162, 24, 19, 97, 148, 6, 13, 127, 74,
+			164, 27, 65, 34, 90, 65, 146, 2, 67, 190, 197, 176, 185, 163,
+			59, 205, 32, 105, 180, 188, 96, 211, 143, 107, 119, 234, 68, 16,
+			230, 105, 161, 59, 209, 142, 163, 102, 167, 225, 103, 253, 96, 89,
+			71, 254, 86, 253, 96, 66, 141, 174, 25, 53, 58, 88, 214, 158,
+			158, 164, 51, 81, 44, 162, 116, 195, 143, 197, 166, 151, 250, 113,
+			224, 181, 146, 140, 212, 152, 24, 224, 100, 34, 223, 123, 51, 168,
+			107, 126, 64, 95, 2, 49, 132, 43, 58, 148, 231, 173, 48, 202,
+			126, 35, 186, 7, 105, 130, 17, 133, 18, 85, 20, 39, 98, 211,
+			219, 22, 171, 62, 56, 165, 41, 210, 72, 248, 97, 51, 138, 19,
+			31, 76, 209, 142, 163, 205, 40, 245, 209, 153, 102, 167, 145, 38,
+			162, 233, 199, 193, 45, 191, 41, 214, 226, 104, 147, 73, 42, 36,
+			209, 90, 186, 5, 54, 81, 28, 36, 146, 182, 223, 0, 7, 137,
+			118, 28, 128, 177, 98, 240, 78, 40, 185, 40, 193, 121, 103, 141,
+			49, 177, 252, 216, 194, 146, 88, 90, 188, 180, 252, 196, 76, 125,
+			94, 44, 44, 137, 235, 245, 197, 87, 44, 204, 205, 207, 137, 139,
+			79, 138, 229, 199, 230, 197, 236, 226, 245, 39, 235, 11, 151, 31,
+			91, 22, 143, 45, 94, 153, 155, 175, 47, 137, 153, 107, 115, 98,
+			118, 241, 218, 114, 125, 225, 226, 141, 229, 197, 250, 18, 19, 213,
+			153, 37, 177, 176, 84, 165, 95, 102, 174, 61, 41, 230, 191, 227,
+			122, 125, 126, 105, 73, 44, 214, 197, 194, 213, 235, 87, 22, 230,
+			231, 196, 19, 51, 245, 250, 204, 181, 229, 133, 249, 165, 41, 177,
+			112, 109, 246, 202, 141, 185, 133, 107, 151, 167, 196, 197, 27, 203,
+			226, 218, 226, 50, 19, 87, 22, 174, 46, 44, 207, 207, 137, 229,
+			197, 41, 106, 118, 247, 119, 98, 241, 146, 184, 58, 95, 159, 125,
+			108, 230, 218, 242, 204, 197, 133, 43, 11, 203, 79, 82, 131, 151,
+			22, 150, 175, 161, 177, 75, 139, 117, 38, 102, 196, 245, 153, 250,
+			242, 194, 236, 141, 43, 51, 117, 113, 253, 70, 253, 250, 226, 210,
+			188, 192, 200, 230, 22, 150, 102, 175, 204, 44, 92, 157, 159, 171,
+			137, 133, 107, 226, 218, 162, 152, 127, 197, 252, 181, 101, 177, 244,
+			216, 204, 149, 43, 221, 3, 101, 98, 241, 137, 107, 243, 117, 244,
+			62, 63, 76, 113, 113, 94, 92, 89, 152, 185, 120, 101, 94, 92,
+			90, 172, 211, 56, 231, 22, 234, 243, 179, 203, 24, 80, 246, 215,
+			236, 194, 220, 252, 181, 229, 153, 43, 83, 76, 44, 93, 159, 159,
+			93, 152, 185, 50, 37, 230, 191, 99, 254, 234, 245, 43, 51, 245,
+			39, 167, 20, 210, 165, 249, 111, 191, 49, 127, 109, 121, 97, 230,
+			138, 152, 155, 185, 58, 115, 121, 126, 73, 76, 60, 31, 85, 174,
+			215, 23, 103, 111, 212, 231, 175, 162, 215, 139, 151, 196, 210, 141,
+			139, 75, 203, 11, 203, 55, 150, 231, 197, 229, 197, 197, 57, 34,
+			246, 210, 124, 253, 21, 11, 179, 243, 75, 15, 139, 43, 139, 32,
+			255, 37, 113, 99, 105, 126, 138, 137, 185, 153, 229, 25, 106, 250,
+			122, 125, 241, 210, 194, 242, 210, 195, 248, 251, 226, 141, 165, 5,
+			34, 220, 194, 181, 229, 249, 122, 253, 6, 157, 210, 76, 138, 199,
+			22, 159, 152, 127, 197, 124, 93, 204, 206, 220, 88, 154, 159, 35,
+			10, 47, 94, 195, 104, 193, 43, 243, 139, 245, 39, 129, 22, 116,
+			160, 25, 152, 18, 79, 60, 54, 191, 252, 216, 124, 29, 68, 37,
+			106, 205, 128, 12, 48, 233, 102, 151, 243, 213, 22, 235, 98, 121,
+			177, 190, 204, 114, 227, 20, 215, 230, 47, 95, 89, 184, 60, 127,
+			109, 118, 30, 253, 89, 4, 154, 39, 22, 150, 230, 39, 197, 76,
+			125, 97, 9, 21, 22, 168, 97, 241, 196, 204, 147, 98, 241, 6,
+			141, 26, 19, 117, 99, 105, 158, 201, 191, 115, 172, 59, 69, 243,
+			41, 22, 46, 137, 153, 185, 87, 44, 160, 231, 170, 246, 245, 197,
+			165, 165, 5, 197, 46, 68, 182, 217, 199, 20, 205, 107, 236, 252,
+			31, 216, 98, 166, 147, 110, 68, 241, 5, 113, 211, 15, 211, 40,
+			124, 89, 38, 216, 197, 196, 203, 169, 72, 188, 194, 139, 155, 222,
+			36, 19, 226, 162, 135, 149, 25, 133, 34, 138, 131, 245, 32, 244,
+			90, 187, 55, 160, 166, 159, 4, 235, 161, 88, 221, 102, 66, 44,
+			121, 225, 83, 222, 182, 184, 188, 225, 111, 122, 91, 94, 58, 37,
+			30, 247, 215, 214, 196, 156, 239, 133, 83, 36, 159, 72, 210, 36,
+			180, 8, 55, 124, 161, 124, 67, 36, 13, 105, 61, 99, 219, 22,
+			114, 191, 92, 149, 82, 176, 233, 175, 5, 161, 18, 112, 107, 81,
+			39, 108, 162, 174, 84, 32, 168, 118, 82, 195, 2, 184, 229, 181,
+			130, 102, 190, 88, 52, 188, 80, 0, 69, 236, 133, 73, 11, 22,
+			139, 104, 6, 177, 223, 72, 91, 219, 16, 51, 158, 216, 35, 156,
+			137, 25, 41, 226, 133, 219, 74, 38, 34, 204, 5, 91, 40, 132,
+			229, 132, 95, 91, 175, 153, 58, 177, 84, 135, 68, 144, 38, 34,
+			216, 108, 71, 113, 154, 76, 214, 24, 43, 49, 203, 230, 206, 100,
+			207, 24, 254, 42, 113, 231, 116, 207, 28, 235, 99, 118, 169, 95,
+			254, 41, 11, 167, 122, 166, 168, 208, 146, 127, 202, 194, 233, 158,
+			115, 84, 168, 254, 148, 133, 181, 158, 7, 169, 240, 164, 252, 83,
+			22, 158, 233, 57, 70, 133, 39, 228, 159, 178, 240, 108, 207, 81,
+			42, 60, 42, 255, 148, 133, 247, 245, 220, 205, 190, 155, 217, 165,
+			62, 250, 179, 146, 138, 157, 161, 99, 114, 227, 89, 245, 133, 246,
+			135, 55, 177, 31, 65, 140, 250, 77, 177, 234, 55, 60, 108, 225,
+			177, 81, 76, 166, 87, 193, 14, 76, 120, 173, 245, 40, 14, 210,
+			141, 205, 68, 52, 163, 112, 60, 21, 91, 81, 124, 83, 52, 59,
+			80, 218, 197, 106, 20, 165, 73, 26, 123, 237, 118, 16, 174, 215,
+			24, 123, 138, 217, 110, 15, 119, 95, 210, 115, 193, 170, 188, 90,
+			44, 111, 144, 72, 151, 44, 212, 136, 54, 219, 65, 203, 143, 105,
+			186, 228, 17, 205, 174, 185, 89, 242, 83, 218, 50, 188, 32, 4,
+			118, 48, 133, 156, 104, 38, 25, 64, 4, 169, 104, 123, 113, 226,
+			131, 173, 24, 115, 220, 30, 139, 59, 47, 41, 29, 100, 253, 204,
+			117, 123, 236, 30, 238, 60, 100, 79, 176, 1, 86, 0, 224, 2,
+			98, 26, 42, 114, 231, 161, 254, 35, 26, 178, 184, 243, 208, 209,
+			227, 26, 114, 184, 243, 208, 61, 227, 236, 12, 179, 93, 139, 187,
+			143, 244, 188, 210, 170, 28, 23, 115, 138, 53, 19, 225, 81, 223,
+			91, 126, 170, 123, 67, 157, 81, 61, 176, 44, 238, 60, 82, 58,
+			196, 30, 98, 174, 107, 161, 7, 143, 218, 135, 170, 83, 84, 131,
+			246, 194, 41, 17, 251, 45, 50, 162, 192, 140, 113, 20, 165, 57,
+			165, 36, 141, 125, 95, 246, 208, 162, 254, 62, 170, 250, 107, 217,
+			61, 5, 238, 60, 218, 63, 172, 33, 139, 59, 143, 242, 253, 26,
+			114, 184, 243, 232, 193, 10, 59, 69, 77, 90, 220, 121, 169, 125,
+			164, 122, 88, 16, 203, 86, 215, 162, 168, 58, 69, 255, 212, 86,
+			189, 184, 58, 37, 252, 180, 81, 211, 88, 45, 23, 149, 13, 84,
+			224, 206, 75, 77, 27, 24, 200, 75, 249, 65, 13, 57, 220, 121,
+			233, 221, 135, 217, 125, 212, 134, 205, 157, 139, 246, 177, 202, 184,
+			184, 166, 119, 119, 53, 29, 180, 24, 192, 62, 219, 217, 162, 54,
+			173, 217, 46, 62, 51, 80, 129, 59, 23, 77, 107, 182, 197, 157,
+			139, 252, 110, 13, 57, 220, 185, 120, 84, 176, 111, 167, 214, 28,
+			238, 204, 217, 19, 149, 57, 65, 145, 18, 178, 61, 176, 130, 12,
+			12, 204, 26, 85, 125, 80, 202, 142, 9, 3, 148, 122, 27, 169,
+			118, 166, 43, 142, 11, 156, 6, 42, 112, 103, 174, 191, 172, 33,
+			139, 59, 115, 195, 85, 13, 161, 245, 147, 227, 236, 105, 234, 138,
+			203, 157, 203, 246, 61, 149, 205, 157, 93, 129, 11, 242, 133, 117,
+			164, 198, 196, 165, 40, 86, 170, 210, 52, 41, 236, 144, 172, 155,
+			193, 122, 44, 69, 77, 20, 182, 182, 107, 98, 46, 130, 206, 7,
+			221, 200, 244, 217, 165, 198, 13, 84, 224, 206, 101, 211, 103, 215,
+			226, 206, 229, 97, 161, 33, 135, 59, 151, 143, 159, 100, 15, 80,
+			159, 11, 220, 121, 220, 158, 170, 76, 146, 182, 159, 70, 237, 105,
+			242, 203, 116, 73, 215, 188, 12, 54, 237, 21, 92, 124, 104, 160,
+			34, 119, 30, 239, 175, 104, 200, 226, 206, 227, 135, 198, 53, 228,
+			112, 231, 241, 83, 167, 105, 213, 89, 118, 145, 59, 47, 183, 167,
+			213, 79, 69, 23, 144, 70, 82, 196, 111, 106, 213, 89, 118, 209,
+			226, 206, 203, 143, 78, 104, 200, 225, 206, 203, 79, 79, 41, 36,
+			189, 220, 185, 98, 215, 212, 79, 189, 46, 32, 141, 164, 183, 200,
+			157, 43, 253, 199, 52, 100, 113, 231, 74, 117, 82, 67, 14, 119,
+			174, 76, 77, 43, 36, 37, 238, 92, 53, 72, 74, 46, 32, 141,
+			164, 84, 228, 206, 213, 254, 163, 26, 178, 184, 115, 85, 104, 36,
+			37, 135, 59, 87, 13, 146, 62, 238, 44, 218, 199, 21, 146, 62,
+			23, 144, 70, 210, 87, 228, 206, 98, 191, 94, 134, 125, 22, 119,
+			22, 15, 232, 193, 245, 57, 220, 89, 60, 86, 101, 127, 97, 17,
+			22, 198, 157, 27, 246, 153, 202, 127, 179, 196, 178, 36, 180, 223,
+			106, 106, 209, 150, 8, 29, 156, 211, 181, 231, 120, 171, 208, 106,
+			193, 93, 102, 255, 205, 217, 46, 53, 38, 158, 140, 58, 164, 67,
+			39, 222, 154, 223, 218, 22, 177, 191, 9, 235, 133, 214, 29, 252,
+			60, 177, 175, 154, 209, 219, 214, 134, 23, 227, 16, 90, 196, 157,
+			48, 13, 54, 125, 38, 214, 58, 33, 137, 118, 175, 21, 164, 219,
+			154, 149, 179, 109, 34, 17, 211, 211, 196, 194, 249, 94, 5, 137,
+			8, 125, 31, 6, 2, 24, 149, 118, 126, 101, 39, 194, 180, 16,
+			105, 20, 181, 18, 195, 66, 204, 197, 176, 13, 84, 228, 206, 141,
+			126, 45, 81, 152, 197, 157, 27, 149, 83, 26, 114, 184, 115, 99,
+			186, 198, 94, 69, 212, 234, 231, 206, 147, 246, 145, 202, 117, 218,
+			49, 100, 132, 168, 238, 94, 94, 224, 202, 159, 59, 109, 181, 236,
+			200, 63, 68, 86, 160, 168, 82, 181, 243, 85, 82, 60, 36, 112,
+			111, 213, 116, 171, 223, 5, 126, 3, 21, 184, 243, 164, 17, 68,
+			253, 22, 119, 158, 228, 99, 26, 114, 184, 243, 228, 161, 195, 108,
+			156, 217, 174, 205, 221, 87, 245, 188, 206, 170, 28, 234, 218, 10,
+			148, 30, 35, 96, 239, 171, 45, 0, 178, 236, 85, 165, 3, 196,
+			63, 54, 182, 128, 87, 219, 135, 8, 159, 77, 66, 253, 213, 170,
+			101, 155, 132, 250, 171, 85, 203, 54, 9, 245, 87, 43, 161, 110,
+			147, 80, 127, 245, 193, 138, 66, 98, 113, 231, 53, 246, 41, 133,
+			4, 82, 251, 53, 6, 137, 85, 228, 206, 107, 20, 39, 219, 36,
+			181, 95, 35, 78, 106, 200, 225, 206, 107, 38, 38, 21, 18, 155,
+			59, 158, 90, 14, 54, 9, 99, 207, 32, 193, 162, 245, 12, 18,
+			12, 192, 83, 203, 193, 38, 97, 236, 169, 229, 64, 64, 195, 62,
+			173, 126, 130, 24, 109, 24, 36, 78, 145, 59, 13, 37, 34, 108,
+			18, 163, 141, 67, 247, 104, 8, 223, 77, 158, 82, 72, 92, 238,
+			52, 149, 136, 176, 73, 174, 53, 13, 18, 183, 200, 157, 166, 18,
+			17, 54, 246, 95, 167, 169, 68, 132, 77, 114, 173, 121, 122, 138,
+			13, 0, 137, 211, 195, 221, 53, 251, 166, 35, 127, 115, 64, 189,
+			53, 54, 198, 14, 177, 34, 32, 144, 125, 221, 61, 92, 29, 128,
+			53, 218, 234, 36, 193, 45, 191, 198, 216, 32, 235, 149, 63, 186,
+			248, 117, 32, 131, 11, 220, 89, 223, 199, 51, 216, 226, 206, 250,
+			200, 88, 6, 59, 220, 89, 63, 116, 183, 65, 110, 113, 103, 195,
+			61, 84, 29, 16, 243, 183, 119, 35, 199, 244, 108, 228, 144, 91,
+			5, 238, 108, 228, 144, 99, 138, 54, 70, 246, 103, 176, 195, 157,
+			141, 131, 21, 182, 79, 33, 183, 185, 243, 148, 123, 198, 252, 140,
+			137, 122, 42, 135, 14, 83, 245, 212, 190, 106, 6, 91, 220, 121,
+			234, 248, 169, 12, 118, 184, 243, 212, 116, 77, 81, 186, 192, 157,
+			150, 153, 115, 72, 244, 150, 161, 116, 161, 200, 157, 150, 90, 142,
+			54, 73, 244, 86, 69, 207, 121, 193, 225, 78, 203, 204, 121, 145,
+			59, 161, 125, 70, 253, 4, 137, 30, 26, 36, 144, 232, 161, 97,
+			28, 72, 244, 80, 104, 62, 45, 58, 220, 9, 77, 79, 122, 185,
+			211, 182, 53, 59, 64, 162, 183, 13, 18, 72, 244, 182, 233, 73,
+			175, 197, 157, 118, 229, 152, 234, 73, 175, 195, 157, 246, 137, 147,
+			236, 3, 144, 163, 96, 42, 183, 99, 223, 118, 42, 63, 100, 137,
+			58, 185, 185, 162, 53, 227, 186, 18, 169, 183, 46, 100, 44, 85,
+			82, 19, 245, 61, 74, 73, 92, 98, 95, 213, 110, 7, 136, 47,
+			18, 146, 9, 220, 14, 198, 43, 44, 98, 224, 54, 91, 120, 226,
+			109, 26, 131, 37, 135, 88, 85, 218, 244, 182, 201, 81, 36, 162,
+			91, 126, 220, 242, 218, 74, 204, 216, 14, 38, 186, 195, 14, 40,
+			174, 33, 101, 240, 214, 29, 88, 82, 170, 123, 183, 204, 52, 75,
+			133, 239, 150, 225, 26, 139, 88, 242, 150, 97, 73, 139, 88, 242,
+			150, 97, 73, 11, 75, 118, 235, 14, 44, 41, 245, 188, 173, 28,
+			114, 176, 228, 86, 14, 57, 122, 186, 101, 88, 210, 34, 150, 220,
+			50, 194, 167, 196, 157, 109, 123, 74, 9, 31, 108, 163, 219, 102,
+			230, 176, 141, 110, 247, 143, 105, 200, 226, 206, 246, 193, 113, 13,
+			57, 220, 217, 62, 117, 154, 125, 47, 205, 156, 221, 199, 157, 239,
+			178, 79, 86, 58, 25, 253, 136, 240, 164, 20, 195, 31, 184, 17,
+			52, 54, 246, 152, 31, 61, 61, 123, 77, 5, 204, 191, 245, 224,
+			150, 31, 18, 18, 250, 88, 110, 74, 126, 198, 19, 81, 216, 208,
+			42, 141, 77, 219, 247, 119, 153, 206, 247, 21, 184, 243, 93, 70,
+			252, 98, 251, 254, 46, 174, 57, 25, 219, 247, 119, 85, 79, 176,
+			126, 6, 169, 83, 248, 238, 158, 55, 90, 22, 9, 119, 136, 181,
+			239, 46, 29, 102, 139, 204, 117, 29, 187, 135, 187, 255, 31, 203,
+			190, 80, 153, 161, 173, 136, 76, 145, 88, 36, 105, 20, 251, 122,
+			83, 39, 27, 165, 25, 249, 9, 76, 165, 216, 111, 68, 235, 97,
+			240, 180, 47, 54, 252, 216, 175, 137, 37, 95, 249, 250, 106, 140,
+			237, 99, 5, 32, 116, 9, 163, 1, 139, 0, 251, 143, 104, 208,
+			2, 120, 244, 94, 13, 58, 0, 31, 120, 136, 189, 18, 29, 43,
+			112, 247, 13, 150, 125, 176, 114, 85, 204, 82, 36, 90, 66, 150,
+			21, 169, 121, 190, 104, 116, 146, 52, 218, 204, 250, 20, 102, 204,
+			174, 148, 216, 32, 201, 88, 60, 223, 47, 8, 91, 167, 208, 3,
+			228, 251, 198, 100, 195, 133, 30, 139, 192, 97, 13, 218, 0, 239,
+			26, 99, 247, 50, 72, 243, 226, 155, 172, 158, 63, 181, 172, 202,
+			201, 174, 141, 50, 211, 69, 130, 48, 219, 55, 107, 140, 245, 51,
+			199, 133, 157, 245, 38, 171, 116, 55, 27, 100, 174, 235, 186, 61,
+			188, 248, 102, 203, 126, 191, 229, 80, 3, 46, 204, 58, 247, 205,
+			86, 111, 63, 91, 98, 69, 252, 12, 186, 191, 197, 114, 71, 43,
+			179, 226, 172, 8, 50, 231, 53, 25, 176, 126, 28, 71, 49, 124,
+			3, 139, 113, 19, 102, 124, 34, 182, 252, 32, 150, 198, 237, 70,
+			128, 201, 9, 26, 94, 75, 196, 190, 151, 224, 228, 145, 177, 33,
+			214, 43, 145, 90, 132, 117, 40, 43, 176, 81, 192, 71, 216, 160,
+			106, 214, 226, 238, 255, 215, 114, 71, 76, 5, 75, 22, 12, 102,
+			5, 54, 10, 134, 57, 219, 82, 95, 216, 220, 125, 171, 229, 142,
+			84, 214, 197, 181, 40, 21, 175, 12, 214, 95, 233, 173, 11, 63,
+			108, 68, 77, 191, 89, 19, 226, 154, 58, 54, 51, 2, 42, 245,
+			110, 250, 226, 220, 89, 177, 186, 157, 250, 73, 77, 136, 27, 137,
+			47, 114, 1, 199, 34, 88, 99, 66, 159, 181, 229, 21, 158, 86,
+			112, 211, 111, 109, 231, 6, 99, 91, 212, 114, 214, 53, 217, 149,
+			97, 110, 6, 227, 112, 247, 109, 150, 59, 106, 42, 64, 182, 190,
+			45, 63, 124, 199, 70, 1, 31, 49, 131, 113, 185, 251, 142, 111,
+			217, 96, 238, 61, 255, 194, 7, 3, 246, 120, 71, 126, 48, 80,
+			198, 222, 145, 31, 76, 129, 187, 239, 180, 220, 187, 76, 133, 130,
+			69, 5, 229, 172, 192, 70, 193, 200, 168, 249, 162, 200, 221, 103,
+			243, 95, 20, 45, 42, 200, 190, 40, 218, 40, 200, 125, 209, 203,
+			221, 119, 89, 46, 55, 21, 122, 45, 42, 216, 151, 21, 216, 40,
+			40, 15, 155, 47, 74, 220, 253, 199, 121, 18, 151, 44, 42, 200,
+			72, 92, 178, 81, 192, 71, 216, 167, 45, 245, 73, 31, 119, 127,
+			20, 156, 253, 9, 75, 44, 123, 235, 211, 77, 191, 21, 108, 6,
+			48, 42, 205, 129, 103, 141, 137, 203, 113, 212, 105, 147, 202, 9,
+			246, 206, 78, 224, 73, 221, 197, 86, 148, 41, 197, 65, 40, 93,
+			46, 247, 214, 196, 99, 209, 150, 127, 203, 143, 167, 164, 27, 239,
+			94, 38, 2, 120, 49, 204, 137, 64, 34, 146, 141, 168, 211, 106,
+			138, 36, 13, 90, 45, 8, 81, 196, 243, 193, 75, 65, 114, 141,
+			180, 239, 117, 106, 24, 17, 95, 234, 124, 9, 45, 50, 129, 8,
+			214, 84, 253, 168, 228, 181, 151, 136, 78, 120, 51, 140, 182, 66,
+			37, 193, 115, 211, 217, 103, 209, 32, 179, 233, 236, 179, 81, 48,
+			60, 194, 166, 21, 21, 24, 119, 223, 99, 185, 251, 171, 135, 197,
+			21, 63, 92, 79, 55, 246, 166, 131, 249, 158, 89, 84, 63, 155,
+			59, 102, 163, 96, 228, 46, 118, 92, 33, 236, 231, 238, 123, 65,
+			214, 17, 113, 205, 223, 130, 248, 187, 229, 199, 16, 126, 226, 124,
+			14, 77, 191, 69, 181, 178, 126, 245, 219, 40, 24, 206, 4, 192,
+			0, 119, 127, 44, 207, 52, 3, 22, 21, 100, 19, 58, 96, 163,
+			128, 103, 76, 179, 143, 187, 63, 158, 23, 25, 251, 44, 42, 200,
+			152, 102, 159, 141, 130, 114, 198, 202, 131, 220, 253, 9, 203, 61,
+			96, 42, 12, 90, 84, 48, 156, 21, 216, 40, 24, 221, 111, 190,
+			24, 226, 238, 79, 230, 191, 24, 178, 168, 32, 251, 98, 200, 70,
+			193, 232, 126, 54, 174, 190, 40, 115, 247, 167, 44, 247, 174, 234,
+			1, 8, 152, 164, 107, 41, 75, 199, 157, 254, 178, 108, 81, 205,
+			108, 128, 101, 27, 5, 124, 212, 160, 26, 230, 238, 251, 94, 16,
+			170, 97, 139, 106, 102, 168, 134, 109, 20, 16, 173, 32, 244, 45,
+			94, 252, 105, 203, 254, 57, 35, 244, 33, 92, 127, 218, 234, 29,
+			96, 167, 168, 37, 232, 79, 238, 207, 88, 238, 129, 74, 229, 142,
+			66, 95, 55, 70, 234, 146, 251, 51, 217, 98, 181, 72, 150, 255,
+			140, 117, 151, 38, 26, 20, 38, 247, 131, 25, 209, 44, 88, 78,
+			238, 7, 243, 95, 88, 54, 119, 63, 152, 255, 194, 230, 238, 207,
+			230, 191, 0, 138, 159, 205, 127, 1, 17, 251, 179, 248, 2, 123,
+			166, 139, 254, 254, 188, 101, 31, 146, 195, 161, 157, 253, 231, 245,
+			206, 238, 66, 191, 115, 127, 222, 234, 31, 214, 160, 133, 202, 124,
+			191, 6, 29, 128, 7, 43, 10, 147, 197, 221, 15, 89, 246, 221,
+			234, 71, 203, 37, 80, 99, 178, 10, 0, 251, 203, 26, 164, 202,
+			195, 7, 52, 232, 0, 172, 28, 82, 152, 108, 238, 254, 66, 214,
+			39, 219, 37, 80, 99, 178, 139, 0, 13, 38, 140, 239, 23, 172,
+			97, 221, 39, 219, 1, 120, 176, 194, 126, 24, 10, 157, 11, 240,
+			151, 160, 111, 188, 197, 18, 11, 107, 36, 140, 40, 56, 22, 83,
+			147, 248, 169, 58, 171, 132, 175, 64, 107, 116, 137, 159, 214, 4,
+			234, 174, 70, 116, 180, 24, 168, 115, 75, 253, 37, 35, 225, 159,
+			125, 107, 252, 211, 161, 15, 21, 197, 220, 222, 153, 18, 249, 187,
+			63, 80, 220, 179, 187, 65, 74, 145, 114, 109, 199, 165, 238, 25,
+			176, 8, 176, 127, 72, 131, 22, 192, 242, 168, 6, 105, 44, 7,
+			198, 216, 255, 207, 166, 161, 185, 220, 253, 151, 150, 45, 42, 239,
+			179, 201, 89, 167, 116, 21, 234, 45, 46, 110, 210, 96, 19, 213,
+			203, 32, 49, 231, 156, 218, 71, 129, 159, 229, 72, 245, 47, 76,
+			208, 229, 145, 132, 142, 16, 132, 39, 198, 107, 227, 83, 80, 12,
+			225, 10, 234, 180, 90, 219, 211, 175, 237, 120, 45, 68, 236, 64,
+			41, 88, 76, 55, 252, 120, 43, 72, 252, 41, 49, 123, 250, 244,
+			52, 182, 118, 145, 52, 34, 184, 212, 153, 136, 59, 45, 181, 75,
+			234, 115, 211, 181, 64, 29, 254, 162, 89, 49, 17, 212, 252, 154,
+			88, 11, 226, 68, 250, 142, 228, 181, 83, 217, 99, 173, 125, 161,
+			223, 44, 27, 21, 17, 221, 139, 17, 249, 129, 49, 249, 97, 86,
+			207, 135, 123, 221, 15, 211, 41, 17, 133, 2, 91, 78, 132, 10,
+			228, 182, 102, 194, 132, 66, 79, 26, 170, 187, 146, 114, 6, 44,
+			0, 52, 76, 142, 109, 252, 95, 90, 92, 115, 159, 235, 0, 60,
+			114, 148, 253, 125, 34, 122, 129, 187, 191, 105, 217, 71, 43, 109,
+			113, 41, 111, 139, 61, 15, 157, 197, 170, 15, 159, 150, 186, 141,
+			7, 242, 45, 128, 172, 12, 58, 97, 212, 186, 229, 55, 187, 45,
+			7, 47, 12, 253, 88, 120, 73, 198, 117, 166, 239, 5, 151, 58,
+			96, 64, 234, 143, 233, 59, 20, 138, 223, 180, 120, 69, 131, 14,
+			192, 195, 71, 216, 91, 37, 199, 20, 185, 251, 49, 203, 62, 81,
+			249, 94, 201, 49, 97, 103, 19, 113, 248, 154, 81, 140, 163, 175,
+			203, 155, 151, 250, 183, 97, 18, 180, 33, 200, 244, 169, 188, 26,
+			25, 41, 67, 202, 85, 188, 26, 69, 45, 223, 11, 147, 41, 81,
+			77, 227, 142, 95, 5, 195, 87, 41, 248, 174, 170, 106, 32, 184,
+			32, 92, 223, 217, 142, 186, 127, 40, 155, 65, 15, 200, 38, 152,
+			192, 98, 244, 147, 134, 215, 150, 164, 193, 121, 215, 150, 183, 61,
+			169, 27, 219, 78, 119, 117, 120, 214, 212, 151, 221, 146, 113, 35,
+			164, 212, 137, 71, 31, 17, 231, 206, 191, 132, 120, 72, 85, 50,
+			244, 44, 186, 68, 19, 3, 22, 0, 26, 122, 66, 221, 250, 152,
+			197, 143, 106, 208, 1, 88, 61, 206, 254, 129, 20, 46, 189, 220,
+			253, 109, 203, 62, 86, 185, 133, 85, 68, 34, 1, 134, 94, 162,
+			220, 145, 77, 255, 54, 214, 154, 39, 34, 196, 199, 234, 25, 86,
+			157, 6, 59, 128, 238, 227, 137, 200, 46, 50, 51, 58, 5, 168,
+			137, 188, 251, 21, 242, 71, 108, 250, 208, 85, 129, 141, 98, 75,
+			232, 11, 51, 132, 94, 151, 186, 97, 192, 2, 64, 35, 31, 161,
+			255, 253, 182, 53, 172, 197, 114, 175, 3, 240, 168, 96, 95, 145,
+			67, 40, 113, 247, 223, 98, 8, 255, 195, 18, 143, 47, 45, 94,
+			203, 113, 174, 238, 65, 141, 172, 70, 34, 170, 146, 154, 48, 120,
+			119, 157, 144, 213, 148, 36, 97, 240, 89, 196, 98, 195, 147, 85,
+			61, 81, 53, 55, 70, 171, 202, 178, 19, 145, 241, 228, 251, 173,
+			230, 148, 26, 19, 253, 52, 158, 72, 123, 1, 71, 157, 82, 189,
+			131, 0, 169, 229, 165, 77, 144, 34, 144, 200, 111, 118, 26, 42,
+			212, 130, 200, 77, 196, 26, 79, 100, 255, 87, 183, 69, 35, 66,
+			236, 118, 74, 210, 40, 72, 33, 18, 26, 222, 166, 223, 154, 245,
+			146, 108, 45, 149, 92, 26, 188, 1, 11, 0, 205, 220, 67, 13,
+			254, 183, 153, 28, 40, 57, 0, 143, 8, 181, 69, 245, 113, 247,
+			83, 150, 125, 66, 253, 216, 231, 18, 168, 49, 245, 21, 1, 246,
+			235, 221, 13, 154, 228, 167, 172, 49, 205, 69, 125, 14, 192, 234,
+			113, 246, 161, 62, 154, 2, 198, 221, 207, 90, 246, 201, 202, 143,
+			247, 17, 9, 227, 142, 14, 161, 161, 169, 39, 66, 223, 43, 170,
+			218, 251, 94, 173, 137, 39, 54, 252, 48, 251, 197, 176, 137, 174,
+			1, 18, 65, 219, 245, 26, 55, 17, 41, 131, 117, 219, 128, 175,
+			97, 221, 139, 155, 45, 63, 81, 39, 97, 248, 72, 185, 131, 37,
+			194, 29, 87, 112, 49, 215, 185, 190, 200, 86, 244, 102, 183, 234,
+			183, 34, 48, 112, 100, 184, 27, 167, 157, 56, 101, 135, 196, 136,
+			68, 212, 106, 234, 238, 53, 148, 153, 79, 147, 108, 122, 67, 200,
+			41, 238, 159, 20, 163, 172, 9, 112, 27, 4, 163, 90, 51, 137,
+			144, 218, 185, 7, 82, 84, 225, 100, 223, 240, 211, 160, 81, 149,
+			139, 102, 74, 133, 20, 237, 234, 31, 206, 188, 147, 168, 229, 51,
+			189, 114, 38, 124, 175, 177, 161, 187, 100, 134, 40, 7, 181, 238,
+			227, 132, 60, 77, 4, 26, 50, 77, 200, 22, 38, 107, 98, 73,
+			151, 168, 78, 37, 194, 191, 141, 179, 186, 32, 220, 113, 18, 65,
+			110, 29, 217, 165, 166, 60, 24, 211, 215, 208, 72, 126, 205, 92,
+			95, 216, 11, 153, 238, 114, 4, 63, 0, 44, 135, 181, 20, 178,
+			191, 213, 18, 213, 216, 247, 90, 106, 164, 240, 0, 116, 237, 242,
+			212, 245, 100, 106, 215, 172, 105, 39, 78, 3, 198, 142, 108, 56,
+			241, 55, 189, 16, 35, 146, 49, 119, 83, 152, 168, 176, 225, 139,
+			48, 10, 167, 99, 191, 141, 122, 205, 29, 120, 133, 215, 218, 242,
+			182, 213, 28, 25, 30, 50, 198, 25, 148, 2, 178, 191, 16, 181,
+			213, 12, 16, 87, 141, 9, 246, 179, 166, 154, 8, 87, 107, 129,
+			211, 182, 54, 124, 19, 130, 69, 146, 97, 43, 142, 82, 63, 199,
+			207, 216, 41, 194, 40, 165, 131, 146, 32, 209, 113, 17, 157, 196,
+			95, 235, 180, 136, 57, 98, 68, 85, 76, 167, 113, 208, 110, 119,
+			159, 175, 211, 121, 117, 141, 200, 210, 136, 194, 36, 72, 40, 8,
+			90, 108, 249, 140, 228, 240, 174, 49, 237, 156, 92, 225, 181, 146,
+			104, 74, 248, 240, 205, 225, 240, 105, 125, 67, 105, 59, 152, 187,
+			216, 127, 109, 39, 136, 17, 75, 144, 70, 187, 232, 176, 172, 150,
+			167, 79, 225, 92, 94, 171, 181, 173, 206, 85, 189, 48, 53, 81,
+			9, 74, 49, 193, 152, 27, 94, 56, 158, 50, 145, 250, 173, 150,
+			8, 214, 132, 215, 45, 219, 165, 222, 22, 197, 194, 11, 73, 115,
+			155, 18, 73, 132, 158, 16, 107, 168, 153, 208, 243, 201, 118, 14,
+			2, 140, 113, 29, 212, 56, 191, 131, 175, 19, 205, 133, 16, 195,
+			114, 141, 180, 188, 245, 169, 124, 247, 182, 133, 215, 66, 152, 200,
+			182, 153, 70, 150, 33, 129, 122, 37, 94, 211, 125, 91, 252, 53,
+			70, 118, 50, 151, 164, 150, 1, 11, 0, 141, 230, 10, 83, 247,
+			179, 86, 89, 75, 60, 230, 0, 172, 158, 96, 85, 102, 187, 5,
+			94, 252, 156, 213, 243, 69, 203, 170, 140, 118, 185, 222, 244, 104,
+			224, 105, 131, 30, 243, 57, 120, 218, 32, 108, 11, 176, 81, 62,
+			175, 237, 129, 2, 217, 40, 159, 215, 77, 23, 200, 70, 249, 188,
+			22, 219, 5, 178, 169, 62, 175, 109, 148, 2, 217, 40, 159, 215,
+			54, 74, 1, 198, 194, 23, 180, 216, 46, 192, 227, 236, 126, 33,
+			195, 100, 21, 241, 171, 18, 219, 5, 178, 81, 190, 160, 197, 118,
+			129, 108, 148, 47, 88, 213, 227, 116, 204, 86, 228, 197, 191, 180,
+			122, 254, 145, 109, 85, 14, 230, 7, 33, 231, 79, 203, 85, 140,
+			4, 26, 196, 95, 90, 37, 105, 217, 20, 49, 146, 191, 210, 35,
+			41, 210, 72, 254, 74, 183, 95, 164, 145, 252, 149, 30, 73, 145,
+			70, 242, 87, 122, 36, 69, 26, 201, 95, 233, 145, 20, 49, 146,
+			191, 182, 236, 154, 250, 17, 35, 249, 235, 12, 19, 70, 242, 215,
+			86, 255, 113, 13, 82, 229, 19, 147, 26, 116, 0, 78, 77, 43,
+			76, 54, 119, 191, 106, 217, 186, 46, 172, 173, 175, 102, 152, 96,
+			109, 125, 213, 234, 215, 157, 64, 179, 95, 181, 14, 28, 81, 205,
+			194, 188, 250, 170, 117, 172, 202, 126, 22, 10, 102, 209, 233, 225,
+			197, 215, 219, 246, 27, 109, 167, 242, 163, 246, 30, 71, 31, 90,
+			225, 164, 45, 62, 127, 250, 161, 220, 106, 123, 29, 124, 224, 54,
+			92, 176, 227, 148, 3, 68, 222, 243, 136, 99, 199, 9, 7, 220,
+			153, 190, 14, 199, 149, 43, 22, 97, 162, 65, 216, 72, 165, 230,
+			176, 35, 192, 170, 166, 81, 202, 174, 147, 132, 247, 82, 218, 78,
+			241, 181, 62, 16, 17, 73, 167, 177, 161, 127, 34, 129, 229, 181,
+			219, 113, 212, 142, 3, 47, 149, 103, 220, 74, 91, 198, 26, 211,
+			167, 220, 65, 152, 222, 123, 158, 137, 102, 180, 233, 5, 161, 90,
+			70, 69, 28, 8, 186, 175, 183, 217, 33, 118, 55, 43, 186, 69,
+			28, 226, 113, 247, 31, 216, 187, 206, 95, 96, 187, 211, 175, 46,
+			253, 60, 144, 21, 20, 80, 176, 143, 103, 5, 22, 10, 70, 198,
+			178, 2, 7, 5, 135, 238, 54, 45, 192, 43, 110, 187, 135, 238,
+			208, 2, 24, 233, 13, 249, 22, 96, 184, 191, 33, 223, 2, 150,
+			197, 27, 236, 145, 253, 89, 129, 131, 130, 131, 21, 121, 250, 85,
+			4, 79, 252, 128, 109, 159, 221, 251, 244, 235, 142, 44, 208, 253,
+			195, 206, 51, 22, 6, 157, 19, 211, 174, 127, 223, 201, 14, 162,
+			233, 55, 90, 94, 76, 246, 201, 29, 89, 131, 229, 120, 67, 50,
+			48, 12, 240, 31, 176, 13, 183, 59, 69, 128, 253, 119, 107, 208,
+			2, 120, 248, 180, 6, 105, 100, 181, 51, 172, 5, 102, 199, 66,
+			121, 198, 182, 79, 84, 94, 157, 53, 151, 245, 240, 142, 39, 70,
+			177, 143, 241, 212, 246, 62, 20, 98, 123, 157, 10, 201, 198, 97,
+			180, 62, 147, 245, 212, 45, 0, 52, 178, 2, 70, 235, 51, 182,
+			50, 84, 138, 100, 180, 62, 99, 87, 143, 179, 115, 204, 118, 123,
+			121, 241, 7, 237, 158, 119, 217, 59, 227, 195, 136, 146, 218, 138,
+			86, 162, 75, 73, 45, 24, 13, 63, 104, 151, 4, 73, 136, 94,
+			48, 229, 219, 109, 37, 181, 122, 73, 106, 189, 93, 247, 164, 151,
+			88, 240, 237, 186, 39, 189, 36, 181, 222, 110, 43, 169, 213, 75,
+			236, 247, 118, 91, 73, 173, 94, 136, 143, 119, 216, 202, 71, 212,
+			75, 242, 247, 29, 25, 38, 176, 218, 59, 108, 101, 185, 244, 146,
+			212, 122, 135, 173, 124, 68, 189, 196, 102, 239, 176, 149, 143, 168,
+			23, 94, 172, 103, 109, 123, 66, 253, 136, 201, 120, 54, 195, 4,
+			169, 245, 172, 221, 175, 123, 140, 102, 159, 181, 239, 62, 174, 154,
+			5, 135, 62, 107, 223, 51, 206, 78, 48, 219, 45, 241, 226, 15,
+			217, 61, 255, 196, 182, 42, 251, 187, 168, 163, 46, 167, 43, 130,
+			192, 24, 248, 33, 187, 132, 19, 127, 215, 45, 129, 32, 63, 172,
+			9, 82, 34, 130, 252, 176, 110, 188, 68, 4, 249, 97, 77, 144,
+			18, 17, 228, 135, 53, 65, 74, 68, 144, 31, 214, 4, 41, 161,
+			103, 63, 98, 219, 83, 234, 71, 16, 228, 71, 50, 76, 16, 227,
+			63, 98, 247, 11, 13, 82, 229, 99, 227, 26, 116, 0, 158, 58,
+			173, 48, 193, 153, 108, 219, 247, 168, 31, 65, 144, 247, 100, 152,
+			64, 144, 247, 216, 253, 7, 53, 8, 87, 180, 93, 57, 166, 65,
+			7, 224, 137, 147, 236, 44, 179, 221, 62, 94, 252, 49, 187, 231,
+			159, 219, 86, 165, 218, 69, 16, 153, 69, 3, 242, 124, 39, 113,
+			96, 223, 252, 152, 93, 58, 76, 29, 233, 3, 113, 126, 92, 19,
+			167, 143, 136, 243, 227, 186, 35, 125, 68, 156, 31, 215, 196, 233,
+			35, 226, 252, 184, 38, 78, 31, 17, 231, 199, 65, 156, 103, 33,
+			74, 250, 64, 157, 247, 217, 246, 177, 202, 247, 91, 98, 1, 249,
+			51, 72, 185, 86, 97, 152, 216, 100, 105, 245, 224, 208, 102, 121,
+			195, 79, 124, 50, 240, 247, 244, 168, 108, 121, 219, 194, 75, 152,
+			216, 51, 189, 146, 113, 178, 76, 137, 213, 142, 210, 186, 98, 127,
+			13, 58, 107, 148, 211, 207, 212, 166, 46, 59, 138, 137, 122, 95,
+			54, 42, 112, 238, 251, 178, 81, 129, 115, 223, 103, 243, 187, 53,
+			232, 0, 60, 42, 20, 125, 108, 238, 190, 223, 182, 171, 234, 71,
+			76, 212, 251, 51, 76, 118, 1, 160, 193, 4, 10, 188, 223, 230,
+			135, 53, 232, 0, 20, 199, 20, 38, 135, 187, 63, 109, 219, 39,
+			213, 143, 144, 101, 63, 157, 97, 130, 44, 251, 105, 187, 127, 76,
+			131, 112, 84, 219, 7, 133, 6, 233, 219, 227, 39, 216, 60, 17,
+			218, 229, 238, 7, 109, 251, 193, 202, 131, 98, 65, 95, 191, 75,
+			160, 169, 74, 235, 77, 200, 220, 32, 48, 86, 100, 182, 13, 93,
+			174, 104, 147, 232, 54, 33, 164, 62, 152, 117, 1, 66, 234, 131,
+			182, 210, 10, 251, 16, 39, 227, 126, 208, 46, 235, 46, 64, 72,
+			125, 208, 62, 126, 66, 131, 37, 128, 39, 31, 208, 96, 47, 192,
+			179, 247, 171, 14, 22, 184, 251, 115, 123, 117, 16, 156, 232, 199,
+			187, 59, 168, 202, 119, 118, 176, 224, 18, 30, 3, 18, 90, 211,
+			65, 168, 157, 63, 151, 117, 176, 224, 0, 52, 29, 44, 148, 0,
+			154, 14, 22, 122, 1, 158, 189, 159, 253, 236, 32, 179, 93, 198,
+			139, 255, 206, 238, 249, 93, 199, 58, 127, 77, 60, 242, 183, 255,
+			143, 9, 117, 231, 148, 157, 255, 55, 251, 196, 60, 140, 87, 229,
+			116, 203, 71, 68, 210, 233, 55, 109, 149, 27, 222, 45, 99, 83,
+			37, 85, 225, 165, 242, 14, 92, 126, 101, 48, 241, 20, 217, 19,
+			230, 150, 90, 146, 219, 156, 164, 197, 130, 3, 95, 48, 253, 170,
+			111, 76, 215, 166, 72, 90, 184, 220, 211, 218, 22, 205, 0, 225,
+			244, 126, 152, 98, 159, 138, 98, 249, 157, 244, 102, 137, 141, 0,
+			54, 62, 172, 53, 137, 3, 198, 254, 166, 23, 6, 237, 14, 98,
+			219, 147, 204, 93, 164, 39, 4, 218, 153, 142, 0, 0, 162, 189,
+			35, 0, 188, 100, 87, 4, 128, 47, 78, 41, 210, 24, 238, 35,
+			211, 17, 235, 63, 87, 87, 239, 184, 219, 62, 237, 186, 202, 139,
+			32, 3, 177, 3, 184, 46, 2, 68, 57, 39, 209, 14, 43, 141,
+			148, 4, 156, 190, 251, 153, 135, 83, 43, 27, 155, 112, 219, 134,
+			73, 234, 123, 77, 232, 125, 42, 128, 34, 221, 240, 55, 81, 193,
+			216, 116, 97, 87, 39, 51, 15, 118, 195, 107, 181, 252, 166, 216,
+			235, 34, 176, 50, 40, 115, 62, 12, 154, 79, 35, 190, 212, 185,
+			67, 35, 142, 146, 132, 92, 3, 187, 73, 32, 158, 160, 30, 135,
+			80, 156, 243, 30, 17, 28, 140, 70, 114, 22, 240, 59, 203, 211,
+			104, 11, 110, 159, 45, 95, 172, 118, 130, 86, 83, 120, 57, 87,
+			198, 148, 240, 82, 197, 29, 237, 40, 8, 83, 106, 148, 230, 48,
+			145, 93, 91, 245, 253, 144, 17, 49, 213, 121, 110, 18, 81, 157,
+			28, 118, 8, 99, 34, 59, 166, 218, 220, 91, 53, 199, 238, 196,
+			43, 221, 179, 173, 174, 90, 53, 54, 162, 4, 14, 174, 68, 93,
+			20, 75, 46, 48, 113, 138, 172, 124, 93, 81, 246, 108, 11, 7,
+			192, 58, 148, 6, 74, 149, 209, 101, 224, 224, 88, 111, 249, 194,
+			107, 183, 91, 234, 178, 158, 136, 98, 92, 10, 139, 226, 117, 47,
+			12, 158, 86, 247, 247, 162, 152, 28, 12, 254, 237, 182, 31, 7,
+			116, 196, 220, 210, 157, 153, 34, 66, 170, 224, 31, 213, 229, 251,
+			207, 158, 61, 123, 22, 88, 210, 141, 152, 188, 5, 15, 225, 63,
+			237, 204, 87, 39, 15, 219, 81, 7, 75, 200, 15, 147, 78, 172,
+			22, 2, 138, 148, 45, 174, 12, 111, 32, 145, 51, 75, 168, 169,
+			23, 70, 116, 169, 30, 212, 158, 99, 212, 171, 42, 174, 60, 217,
+			80, 228, 167, 225, 83, 81, 3, 244, 216, 54, 130, 16, 45, 193,
+			21, 45, 227, 204, 83, 178, 70, 210, 0, 154, 169, 63, 189, 9,
+			23, 141, 190, 11, 58, 189, 222, 138, 86, 189, 214, 180, 153, 193,
+			233, 216, 95, 199, 157, 186, 237, 220, 245, 27, 96, 195, 133, 0,
+			169, 239, 102, 188, 164, 73, 84, 19, 75, 56, 174, 223, 214, 23,
+			244, 196, 118, 212, 137, 1, 60, 229, 55, 82, 218, 172, 229, 37,
+			21, 224, 89, 92, 69, 97, 112, 203, 159, 158, 21, 237, 86, 103,
+			61, 8, 39, 105, 40, 93, 159, 108, 249, 171, 73, 144, 250, 98,
+			2, 94, 147, 91, 94, 208, 194, 105, 255, 164, 10, 249, 141, 125,
+			56, 124, 35, 32, 163, 19, 60, 144, 253, 118, 187, 133, 123, 146,
+			27, 209, 22, 218, 166, 187, 166, 56, 120, 138, 212, 186, 240, 55,
+			107, 226, 70, 210, 33, 143, 13, 126, 39, 246, 161, 143, 163, 208,
+			7, 162, 157, 67, 170, 81, 0, 179, 140, 81, 130, 129, 225, 239,
+			154, 39, 117, 73, 20, 136, 162, 48, 71, 19, 96, 83, 211, 11,
+			127, 121, 39, 77, 149, 247, 74, 137, 139, 164, 179, 58, 173, 150,
+			175, 140, 101, 194, 114, 159, 149, 43, 66, 47, 239, 4, 20, 194,
+			221, 166, 53, 96, 83, 215, 35, 19, 197, 180, 30, 2, 35, 176,
+			56, 196, 55, 122, 25, 22, 72, 100, 233, 113, 53, 8, 32, 89,
+			80, 94, 255, 180, 19, 67, 216, 66, 197, 162, 173, 64, 202, 143,
+			120, 74, 120, 98, 203, 95, 213, 186, 159, 97, 68, 184, 151, 58,
+			109, 197, 25, 94, 39, 141, 54, 189, 20, 177, 75, 45, 168, 92,
+			112, 9, 43, 66, 41, 98, 36, 74, 105, 132, 139, 232, 223, 217,
+			165, 17, 25, 123, 206, 160, 53, 254, 190, 109, 31, 175, 252, 87,
+			75, 44, 193, 33, 139, 193, 62, 238, 221, 242, 132, 202, 78, 36,
+			182, 16, 141, 38, 40, 99, 146, 159, 228, 246, 39, 117, 4, 16,
+			36, 218, 43, 168, 58, 198, 68, 187, 229, 53, 104, 15, 188, 184,
+			173, 207, 153, 166, 114, 225, 217, 26, 49, 150, 110, 130, 83, 197,
+			213, 78, 230, 39, 136, 214, 82, 72, 184, 32, 204, 153, 246, 198,
+			117, 214, 245, 189, 241, 179, 133, 136, 53, 1, 99, 209, 41, 170,
+			100, 139, 85, 175, 113, 115, 203, 139, 155, 137, 182, 252, 149, 198,
+			42, 21, 16, 70, 218, 241, 239, 107, 125, 132, 145, 118, 252, 251,
+			90, 251, 99, 164, 29, 255, 190, 205, 143, 104, 208, 1, 141, 142,
+			85, 217, 231, 225, 109, 97, 208, 13, 63, 109, 219, 167, 43, 159,
+			182, 197, 108, 20, 166, 113, 212, 218, 125, 250, 184, 133, 107, 78,
+			126, 44, 73, 73, 196, 203, 147, 78, 93, 127, 173, 237, 136, 87,
+			247, 82, 73, 103, 57, 199, 218, 3, 169, 182, 250, 29, 31, 140,
+			99, 46, 210, 76, 167, 158, 152, 212, 150, 2, 20, 238, 45, 191,
+			213, 130, 36, 135, 31, 58, 187, 213, 97, 214, 72, 162, 246, 253,
+			166, 216, 141, 184, 198, 112, 28, 178, 71, 22, 56, 229, 198, 129,
+			36, 208, 71, 193, 216, 122, 208, 47, 121, 39, 78, 243, 136, 62,
+			28, 98, 121, 180, 134, 111, 213, 153, 115, 16, 38, 16, 84, 248,
+			90, 237, 26, 154, 100, 148, 173, 78, 18, 66, 233, 253, 140, 44,
+			214, 79, 103, 243, 5, 189, 255, 211, 217, 124, 89, 52, 35, 252,
+			30, 13, 58, 0, 39, 79, 177, 159, 116, 104, 190, 108, 238, 254,
+			137, 109, 63, 92, 121, 214, 193, 200, 252, 48, 63, 2, 195, 238,
+			164, 63, 169, 41, 194, 5, 97, 244, 86, 207, 24, 164, 134, 223,
+			134, 151, 195, 23, 53, 80, 6, 113, 193, 45, 92, 236, 142, 5,
+			29, 112, 100, 36, 86, 146, 101, 74, 57, 148, 33, 88, 245, 194,
+			221, 147, 228, 76, 57, 211, 197, 242, 70, 135, 14, 179, 161, 79,
+			233, 243, 120, 104, 28, 97, 148, 158, 218, 155, 108, 154, 94, 68,
+			41, 121, 224, 222, 196, 22, 180, 87, 202, 191, 154, 200, 2, 188,
+			118, 125, 43, 7, 171, 3, 187, 88, 142, 81, 211, 168, 139, 253,
+			158, 143, 239, 242, 108, 199, 94, 16, 223, 169, 59, 69, 114, 222,
+			96, 146, 253, 73, 54, 201, 48, 201, 254, 68, 91, 49, 140, 108,
+			231, 63, 177, 203, 199, 53, 232, 224, 215, 123, 38, 244, 175, 37,
+			128, 147, 23, 52, 216, 11, 240, 190, 135, 216, 36, 113, 128, 195,
+			221, 63, 181, 237, 249, 202, 33, 169, 240, 41, 193, 136, 51, 27,
+			72, 144, 13, 25, 213, 36, 191, 132, 53, 247, 167, 89, 39, 156,
+			2, 64, 211, 9, 132, 65, 254, 169, 93, 158, 214, 32, 33, 62,
+			123, 94, 131, 37, 128, 247, 206, 81, 24, 17, 179, 29, 156, 93,
+			254, 169, 125, 223, 44, 251, 111, 82, 112, 184, 220, 253, 146, 109,
+			63, 90, 249, 15, 182, 58, 184, 54, 39, 125, 57, 78, 60, 255,
+			220, 172, 104, 244, 124, 220, 116, 143, 163, 45, 172, 113, 225, 223,
+			110, 248, 114, 76, 208, 45, 49, 205, 40, 245, 210, 212, 223, 108,
+			147, 142, 180, 233, 225, 171, 72, 239, 12, 30, 29, 66, 221, 88,
+			190, 52, 253, 18, 70, 167, 250, 34, 241, 95, 219, 161, 19, 66,
+			108, 38, 48, 235, 176, 105, 146, 26, 86, 99, 66, 165, 120, 203,
+			221, 247, 148, 157, 106, 70, 198, 212, 175, 177, 140, 193, 188, 92,
+			252, 176, 82, 135, 37, 115, 33, 135, 91, 59, 77, 178, 198, 187,
+			219, 78, 106, 172, 107, 126, 54, 60, 84, 21, 254, 218, 26, 180,
+			24, 53, 184, 76, 227, 164, 182, 91, 208, 82, 212, 157, 37, 51,
+			135, 48, 135, 191, 148, 205, 33, 204, 225, 47, 101, 115, 8, 115,
+			248, 75, 118, 89, 75, 11, 152, 195, 95, 178, 39, 79, 107, 176,
+			4, 112, 234, 17, 13, 246, 2, 124, 240, 219, 216, 21, 204, 32,
+			98, 143, 255, 218, 182, 255, 167, 237, 84, 190, 77, 152, 28, 37,
+			70, 240, 169, 51, 180, 189, 174, 204, 106, 11, 45, 9, 158, 54,
+			12, 79, 145, 203, 127, 109, 247, 142, 176, 57, 240, 139, 75, 94,
+			232, 175, 216, 238, 96, 245, 62, 131, 60, 187, 77, 74, 19, 15,
+			132, 202, 148, 154, 130, 70, 16, 120, 45, 173, 85, 75, 95, 50,
+			83, 161, 202, 95, 177, 221, 190, 172, 192, 230, 238, 87, 236, 129,
+			125, 236, 178, 106, 199, 226, 238, 223, 216, 46, 175, 20, 229, 133,
+			207, 234, 25, 68, 250, 137, 44, 207, 200, 98, 59, 1, 183, 152,
+			16, 80, 37, 151, 228, 6, 163, 163, 162, 153, 138, 113, 254, 27,
+			219, 221, 151, 21, 216, 40, 40, 15, 155, 166, 108, 238, 126, 205,
+			118, 239, 170, 62, 152, 31, 82, 19, 134, 3, 56, 76, 113, 214,
+			21, 204, 162, 78, 83, 177, 99, 74, 53, 102, 172, 255, 175, 217,
+			110, 217, 52, 5, 87, 228, 215, 236, 145, 81, 114, 202, 48, 72,
+			139, 175, 219, 246, 195, 106, 222, 224, 112, 248, 122, 198, 2, 133,
+			34, 64, 117, 196, 196, 112, 159, 197, 253, 186, 61, 118, 66, 131,
+			14, 126, 29, 215, 178, 4, 14, 135, 175, 103, 178, 4, 14, 135,
+			175, 219, 247, 61, 196, 62, 45, 87, 113, 145, 187, 111, 116, 236,
+			106, 229, 223, 218, 153, 198, 116, 57, 83, 107, 164, 190, 148, 164,
+			49, 229, 166, 248, 70, 244, 165, 133, 53, 17, 109, 34, 47, 69,
+			115, 106, 23, 82, 181, 121, 230, 83, 93, 116, 231, 244, 32, 125,
+			116, 26, 6, 185, 88, 245, 18, 63, 175, 139, 104, 36, 242, 168,
+			84, 180, 189, 116, 99, 10, 62, 28, 101, 44, 52, 41, 109, 199,
+			116, 62, 214, 35, 255, 85, 146, 122, 169, 100, 2, 37, 179, 85,
+			247, 33, 186, 21, 26, 58, 116, 217, 27, 203, 206, 190, 116, 125,
+			172, 239, 30, 26, 105, 97, 86, 48, 194, 131, 222, 232, 152, 233,
+			43, 22, 0, 154, 253, 30, 135, 123, 111, 116, 148, 159, 143, 81,
+			120, 208, 27, 157, 163, 199, 216, 191, 115, 105, 130, 122, 185, 251,
+			14, 199, 126, 184, 242, 127, 186, 98, 73, 6, 45, 171, 204, 167,
+			66, 103, 62, 237, 246, 180, 4, 161, 220, 197, 145, 167, 167, 227,
+			173, 251, 47, 21, 162, 170, 50, 161, 86, 205, 39, 12, 190, 28,
+			236, 22, 89, 42, 17, 136, 202, 112, 27, 214, 120, 26, 52, 160,
+			170, 139, 250, 245, 89, 145, 108, 39, 41, 204, 29, 76, 197, 54,
+			125, 148, 181, 68, 55, 159, 17, 29, 1, 91, 169, 91, 204, 39,
+			187, 186, 33, 38, 52, 125, 188, 102, 51, 192, 178, 244, 90, 202,
+			98, 67, 70, 1, 113, 121, 231, 160, 182, 124, 229, 62, 33, 235,
+			234, 38, 34, 255, 162, 53, 253, 179, 110, 9, 130, 53, 11, 0,
+			167, 147, 62, 47, 110, 109, 235, 144, 231, 4, 19, 165, 108, 24,
+			109, 162, 214, 216, 30, 141, 97, 96, 97, 180, 5, 253, 0, 138,
+			92, 236, 55, 243, 161, 230, 65, 40, 214, 188, 91, 112, 29, 172,
+			169, 149, 174, 58, 142, 93, 203, 203, 69, 104, 16, 17, 242, 20,
+			149, 102, 232, 157, 73, 26, 251, 107, 81, 236, 79, 49, 37, 146,
+			76, 28, 91, 36, 40, 234, 13, 17, 146, 116, 121, 182, 233, 43,
+			195, 93, 218, 224, 8, 247, 218, 205, 6, 50, 166, 29, 206, 25,
+			56, 44, 2, 36, 129, 160, 93, 25, 198, 34, 133, 31, 116, 50,
+			237, 4, 225, 94, 239, 200, 88, 178, 23, 135, 38, 142, 217, 84,
+			122, 113, 104, 226, 24, 237, 4, 225, 94, 239, 112, 140, 118, 210,
+			91, 2, 104, 36, 74, 47, 113, 232, 125, 15, 41, 201, 85, 226,
+			238, 59, 29, 91, 239, 56, 136, 142, 122, 103, 214, 78, 9, 151,
+			23, 178, 118, 112, 32, 242, 78, 167, 124, 82, 131, 14, 192, 137,
+			83, 26, 36, 84, 167, 191, 77, 131, 189, 0, 31, 120, 88, 181,
+			211, 199, 221, 103, 29, 35, 33, 17, 59, 245, 108, 214, 78, 95,
+			1, 160, 105, 7, 103, 11, 207, 102, 227, 65, 236, 212, 179, 217,
+			120, 250, 74, 0, 205, 120, 250, 122, 1, 154, 241, 48, 238, 190,
+			203, 177, 117, 39, 152, 75, 160, 110, 135, 21, 0, 154, 118, 96,
+			142, 190, 203, 41, 107, 73, 140, 136, 133, 119, 57, 227, 147, 26,
+			44, 1, 60, 165, 187, 204, 112, 201, 194, 185, 255, 2, 251, 162,
+			180, 93, 251, 185, 251, 163, 142, 125, 190, 242, 71, 150, 88, 72,
+			180, 247, 173, 229, 231, 24, 241, 165, 76, 200, 39, 127, 176, 217,
+			80, 248, 155, 47, 82, 47, 94, 247, 83, 88, 169, 41, 174, 41,
+			168, 64, 43, 236, 221, 254, 102, 144, 138, 57, 243, 113, 222, 103,
+			203, 104, 235, 133, 126, 179, 77, 250, 98, 94, 129, 157, 66, 40,
+			77, 144, 26, 33, 173, 183, 108, 68, 167, 172, 135, 81, 236, 55,
+			31, 214, 213, 241, 61, 19, 45, 223, 75, 116, 28, 51, 197, 162,
+			160, 31, 102, 39, 167, 150, 244, 16, 208, 148, 74, 103, 162, 136,
+			208, 239, 210, 168, 53, 61, 251, 11, 0, 13, 61, 251, 45, 128,
+			229, 138, 6, 29, 128, 135, 143, 106, 176, 4, 80, 156, 211, 96,
+			47, 192, 211, 103, 217, 119, 19, 57, 7, 184, 251, 94, 199, 126,
+			176, 242, 90, 49, 79, 54, 82, 162, 131, 135, 176, 138, 17, 233,
+			235, 37, 198, 116, 37, 217, 176, 119, 158, 22, 229, 85, 37, 39,
+			160, 79, 33, 101, 50, 183, 138, 94, 243, 205, 204, 80, 140, 98,
+			4, 49, 155, 161, 13, 184, 212, 3, 3, 22, 0, 154, 161, 225,
+			58, 197, 123, 29, 117, 74, 192, 236, 1, 7, 224, 113, 189, 18,
+			6, 74, 0, 239, 121, 64, 131, 189, 0, 207, 221, 207, 222, 38,
+			89, 101, 31, 119, 127, 202, 177, 39, 43, 175, 207, 185, 57, 34,
+			237, 9, 19, 13, 101, 8, 201, 140, 208, 74, 112, 4, 112, 159,
+			19, 243, 72, 75, 8, 198, 110, 238, 19, 182, 215, 128, 118, 236,
+			241, 32, 5, 132, 50, 197, 54, 105, 89, 101, 134, 187, 207, 165,
+			78, 25, 176, 0, 208, 108, 114, 184, 11, 242, 83, 14, 215, 43,
+			112, 159, 3, 240, 158, 9, 182, 64, 227, 25, 228, 238, 7, 28,
+			123, 162, 242, 176, 48, 121, 165, 137, 156, 187, 250, 244, 176, 110,
+			54, 209, 241, 217, 106, 99, 55, 221, 24, 116, 9, 151, 1, 11,
+			0, 77, 55, 112, 193, 228, 3, 14, 175, 106, 208, 1, 120, 114,
+			92, 134, 54, 51, 123, 136, 187, 63, 231, 200, 208, 230, 204, 219,
+			35, 150, 144, 114, 91, 211, 7, 123, 27, 45, 13, 186, 241, 181,
+			219, 15, 4, 189, 111, 86, 7, 137, 82, 208, 71, 236, 67, 37,
+			2, 235, 143, 215, 198, 165, 67, 167, 19, 54, 253, 56, 105, 224,
+			128, 64, 39, 144, 163, 141, 4, 126, 118, 57, 105, 58, 230, 59,
+			57, 147, 108, 111, 174, 70, 173, 132, 105, 99, 83, 69, 110, 166,
+			153, 105, 65, 203, 78, 171, 63, 88, 136, 254, 182, 92, 189, 198,
+			139, 175, 226, 110, 229, 177, 3, 123, 174, 102, 76, 43, 154, 128,
+			67, 46, 209, 196, 128, 5, 128, 134, 158, 184, 126, 243, 115, 142,
+			241, 45, 13, 225, 172, 203, 57, 118, 156, 194, 220, 153, 93, 230,
+			238, 47, 96, 90, 219, 25, 151, 182, 55, 218, 47, 148, 59, 81,
+			117, 23, 3, 176, 61, 184, 114, 78, 205, 19, 146, 65, 108, 182,
+			211, 109, 195, 11, 101, 220, 249, 200, 250, 94, 46, 0, 52, 125,
+			199, 125, 159, 95, 200, 120, 161, 140, 59, 31, 206, 201, 113, 246,
+			107, 114, 141, 13, 115, 247, 151, 29, 251, 100, 229, 103, 45, 50,
+			39, 114, 244, 70, 7, 85, 230, 65, 237, 40, 163, 84, 232, 16,
+			44, 123, 118, 218, 116, 145, 101, 125, 220, 53, 139, 230, 167, 110,
+			133, 21, 216, 141, 44, 38, 107, 113, 13, 135, 16, 77, 63, 69,
+			178, 55, 147, 43, 200, 116, 194, 12, 126, 216, 165, 1, 24, 176,
+			0, 208, 12, 30, 55, 148, 126, 217, 81, 161, 30, 204, 30, 118,
+			0, 86, 79, 176, 127, 45, 7, 207, 185, 251, 107, 142, 93, 171,
+			252, 202, 223, 98, 240, 58, 143, 188, 161, 2, 211, 100, 248, 6,
+			168, 96, 244, 235, 46, 66, 80, 120, 120, 83, 109, 45, 207, 71,
+			8, 238, 210, 96, 12, 88, 0, 104, 8, 193, 45, 128, 92, 235,
+			2, 220, 1, 120, 122, 154, 125, 68, 18, 98, 132, 187, 191, 9,
+			137, 240, 161, 231, 35, 132, 158, 47, 132, 41, 117, 86, 183, 255,
+			22, 76, 160, 162, 45, 191, 41, 54, 64, 214, 125, 253, 141, 33,
+			192, 8, 110, 123, 100, 4, 24, 193, 109, 143, 140, 0, 35, 184,
+			237, 145, 45, 225, 17, 220, 246, 192, 18, 254, 46, 26, 255, 40,
+			119, 255, 47, 199, 190, 80, 9, 191, 169, 91, 223, 204, 28, 73,
+			116, 39, 100, 212, 251, 110, 85, 29, 81, 84, 205, 25, 69, 254,
+			138, 56, 179, 71, 93, 106, 222, 128, 69, 128, 234, 138, 56, 179,
+			71, 45, 128, 234, 138, 56, 179, 71, 29, 128, 15, 60, 196, 190,
+			207, 98, 142, 11, 205, 236, 227, 142, 125, 176, 242, 244, 223, 250,
+			142, 248, 55, 63, 10, 210, 84, 113, 161, 252, 227, 142, 186, 80,
+			206, 10, 112, 147, 124, 220, 217, 167, 200, 79, 23, 202, 63, 238,
+			220, 53, 70, 1, 66, 172, 143, 187, 191, 227, 216, 131, 82, 245,
+			236, 235, 1, 212, 191, 79, 214, 236, 235, 177, 186, 65, 91, 129,
+			3, 204, 118, 251, 121, 241, 19, 78, 207, 15, 186, 22, 161, 129,
+			218, 244, 9, 167, 180, 159, 253, 94, 129, 185, 110, 63, 28, 49,
+			127, 228, 216, 143, 86, 62, 86, 128, 32, 38, 67, 32, 119, 152,
+			149, 197, 217, 159, 211, 30, 12, 212, 202, 223, 124, 93, 235, 186,
+			165, 164, 29, 91, 185, 93, 7, 21, 179, 99, 138, 105, 232, 140,
+			94, 26, 172, 6, 148, 109, 199, 184, 183, 118, 96, 103, 10, 125,
+			77, 208, 241, 152, 186, 146, 155, 29, 112, 66, 1, 142, 226, 92,
+			154, 58, 121, 143, 253, 2, 142, 74, 199, 19, 129, 91, 8, 12,
+			254, 180, 160, 129, 8, 128, 41, 186, 45, 178, 230, 111, 249, 177,
+			88, 243, 189, 180, 19, 251, 9, 188, 119, 77, 44, 183, 77, 108,
+			181, 232, 21, 157, 228, 250, 205, 29, 169, 249, 76, 252, 188, 218,
+			253, 132, 127, 219, 163, 36, 122, 93, 199, 199, 194, 84, 191, 20,
+			69, 226, 239, 193, 67, 32, 20, 11, 233, 95, 118, 190, 18, 33,
+			30, 33, 106, 63, 44, 235, 230, 120, 236, 62, 76, 192, 166, 119,
+			155, 126, 121, 93, 119, 140, 105, 214, 173, 6, 233, 236, 242, 176,
+			28, 135, 31, 186, 123, 228, 134, 76, 30, 206, 77, 87, 162, 34,
+			255, 168, 106, 126, 170, 152, 152, 233, 62, 81, 143, 214, 112, 166,
+			25, 83, 120, 177, 25, 55, 140, 90, 178, 249, 85, 203, 201, 195,
+			50, 167, 26, 233, 18, 58, 162, 97, 85, 197, 159, 226, 216, 55,
+			236, 108, 38, 100, 41, 236, 140, 253, 135, 106, 47, 46, 170, 35,
+			46, 99, 19, 232, 227, 187, 169, 44, 231, 171, 72, 183, 232, 12,
+			56, 141, 131, 134, 138, 211, 80, 46, 9, 31, 57, 152, 26, 202,
+			158, 55, 187, 64, 215, 53, 33, 41, 17, 250, 233, 228, 235, 143,
+			180, 128, 232, 167, 147, 175, 63, 210, 58, 118, 63, 185, 14, 255,
+			200, 41, 143, 107, 208, 193, 58, 56, 117, 90, 131, 37, 128, 202,
+			55, 218, 111, 247, 244, 2, 124, 240, 219, 216, 255, 128, 228, 239,
+			135, 131, 238, 143, 29, 251, 82, 229, 63, 89, 98, 46, 72, 50,
+			11, 66, 9, 246, 220, 13, 54, 253, 192, 135, 168, 102, 177, 15,
+			19, 147, 85, 161, 31, 249, 208, 241, 155, 13, 47, 164, 180, 176,
+			107, 173, 0, 135, 210, 88, 21, 58, 222, 35, 90, 235, 142, 211,
+			208, 183, 183, 192, 186, 62, 174, 3, 16, 183, 220, 244, 179, 228,
+			105, 74, 17, 82, 235, 214, 247, 146, 192, 143, 31, 22, 161, 191,
+			165, 60, 179, 114, 49, 121, 183, 162, 64, 179, 139, 58, 83, 201,
+			117, 178, 170, 4, 109, 63, 157, 72, 253, 113, 70, 71, 156, 72,
+			253, 113, 70, 71, 248, 69, 255, 216, 41, 159, 209, 160, 3, 240,
+			252, 189, 26, 44, 1, 188, 111, 94, 131, 189, 0, 95, 58, 199,
+			254, 76, 210, 209, 230, 238, 159, 59, 246, 185, 202, 127, 201, 204,
+			90, 197, 42, 47, 158, 101, 155, 91, 67, 223, 160, 57, 171, 172,
+			89, 246, 130, 205, 89, 213, 140, 182, 104, 251, 145, 109, 202, 253,
+			243, 140, 148, 240, 228, 254, 121, 70, 74, 176, 213, 159, 107, 139,
+			182, 159, 66, 241, 254, 220, 57, 124, 68, 131, 37, 252, 122, 244,
+			172, 6, 123, 1, 158, 58, 35, 133, 122, 31, 119, 63, 239, 216,
+			35, 180, 55, 244, 99, 111, 248, 188, 211, 63, 32, 107, 210, 222,
+			144, 7, 109, 5, 202, 186, 244, 227, 160, 234, 66, 159, 181, 3,
+			180, 21, 40, 235, 18, 52, 204, 213, 143, 182, 213, 13, 234, 95,
+			255, 73, 17, 149, 209, 251, 239, 119, 237, 35, 149, 183, 22, 197,
+			19, 185, 27, 58, 138, 38, 106, 229, 119, 159, 230, 103, 122, 209,
+			166, 215, 70, 32, 73, 188, 45, 69, 145, 154, 55, 184, 19, 219,
+			42, 238, 200, 92, 87, 50, 37, 50, 80, 65, 160, 206, 183, 189,
+			220, 223, 94, 222, 110, 251, 83, 130, 210, 226, 227, 207, 71, 81,
+			190, 66, 21, 197, 35, 226, 220, 195, 44, 83, 90, 154, 249, 43,
+			86, 173, 40, 186, 137, 220, 210, 55, 125, 141, 78, 117, 248, 170,
+			215, 166, 224, 78, 122, 44, 71, 75, 248, 188, 148, 215, 15, 235,
+			116, 203, 245, 172, 134, 215, 18, 170, 91, 226, 166, 191, 173, 58,
+			177, 171, 138, 233, 176, 138, 158, 126, 68, 156, 87, 213, 94, 39,
+			255, 49, 66, 181, 187, 67, 59, 70, 199, 196, 194, 142, 172, 22,
+			136, 125, 106, 108, 68, 81, 34, 5, 105, 206, 77, 33, 231, 69,
+			119, 255, 17, 108, 74, 122, 212, 20, 178, 64, 26, 181, 240, 68,
+			40, 51, 148, 96, 110, 130, 174, 101, 104, 252, 184, 105, 36, 54,
+			34, 92, 251, 219, 160, 49, 98, 138, 245, 213, 11, 149, 166, 46,
+			119, 176, 54, 115, 125, 129, 220, 41, 116, 215, 33, 216, 209, 91,
+			121, 140, 166, 131, 110, 40, 183, 42, 78, 251, 131, 53, 229, 139,
+			50, 151, 155, 118, 238, 49, 25, 119, 92, 91, 92, 158, 191, 160,
+			51, 72, 42, 103, 167, 162, 243, 174, 12, 186, 98, 70, 134, 32,
+			104, 181, 135, 248, 12, 247, 160, 188, 219, 76, 219, 198, 228, 226,
+			208, 8, 148, 12, 213, 193, 97, 193, 102, 151, 91, 117, 117, 59,
+			103, 161, 232, 141, 73, 69, 179, 101, 27, 20, 14, 96, 191, 223,
+			53, 210, 0, 7, 176, 223, 239, 26, 105, 128, 3, 216, 239, 119,
+			203, 7, 53, 72, 235, 233, 238, 195, 236, 132, 90, 239, 111, 118,
+			237, 125, 213, 3, 116, 6, 142, 115, 164, 21, 115, 70, 182, 218,
+			162, 228, 169, 88, 176, 14, 119, 223, 236, 154, 149, 239, 88, 221,
+			160, 173, 192, 163, 10, 227, 15, 0, 35, 39, 140, 161, 23, 70,
+			43, 94, 178, 2, 204, 26, 25, 46, 50, 100, 95, 187, 86, 55,
+			104, 43, 16, 9, 161, 250, 33, 231, 158, 113, 191, 117, 9, 161,
+			250, 145, 79, 201, 125, 38, 163, 149, 91, 68, 3, 74, 219, 239,
+			183, 209, 153, 103, 92, 165, 237, 247, 203, 203, 9, 174, 74, 8,
+			5, 183, 225, 219, 220, 23, 39, 33, 84, 63, 244, 247, 183, 185,
+			74, 127, 239, 39, 253, 253, 109, 174, 210, 223, 251, 73, 127, 127,
+			155, 123, 23, 242, 130, 217, 238, 0, 47, 190, 195, 237, 249, 170,
+			82, 195, 225, 226, 123, 135, 91, 26, 101, 63, 6, 239, 210, 0,
+			212, 240, 119, 187, 118, 173, 242, 118, 155, 40, 214, 32, 177, 103,
+			152, 85, 159, 177, 129, 53, 103, 79, 159, 222, 121, 110, 174, 244,
+			117, 47, 11, 160, 101, 119, 184, 80, 175, 66, 54, 55, 188, 80,
+			4, 185, 120, 162, 45, 48, 115, 77, 24, 91, 70, 31, 83, 232,
+			203, 122, 137, 88, 245, 91, 209, 150, 214, 60, 116, 191, 18, 19,
+			0, 107, 150, 111, 22, 240, 16, 181, 125, 253, 238, 1, 122, 131,
+			189, 211, 23, 211, 211, 34, 137, 226, 120, 123, 74, 108, 249, 227,
+			112, 148, 65, 194, 71, 242, 226, 83, 211, 71, 159, 40, 114, 109,
+			173, 3, 21, 93, 159, 212, 28, 147, 179, 62, 64, 26, 221, 187,
+			53, 19, 12, 32, 121, 177, 251, 110, 87, 221, 230, 24, 32, 141,
+			238, 221, 174, 202, 211, 49, 64, 145, 254, 239, 118, 41, 161, 32,
+			85, 46, 129, 194, 135, 166, 53, 216, 11, 240, 158, 41, 74, 186,
+			50, 128, 211, 238, 31, 114, 237, 247, 186, 50, 233, 202, 128, 11,
+			92, 63, 228, 246, 14, 176, 163, 172, 232, 14, 224, 96, 153, 187,
+			63, 226, 186, 67, 149, 33, 227, 167, 216, 164, 236, 163, 116, 70,
+			59, 160, 142, 162, 127, 196, 117, 115, 5, 54, 10, 246, 13, 82,
+			136, 4, 10, 112, 35, 194, 85, 7, 200, 3, 234, 68, 249, 61,
+			174, 91, 202, 10, 112, 193, 2, 43, 76, 127, 97, 115, 247, 159,
+			186, 42, 205, 206, 128, 58, 24, 254, 167, 174, 58, 24, 30, 80,
+			7, 195, 255, 212, 29, 25, 101, 191, 47, 185, 8, 183, 2, 92,
+			251, 80, 229, 183, 108, 181, 238, 232, 254, 179, 154, 46, 117, 102,
+			175, 162, 130, 200, 124, 50, 194, 179, 29, 35, 173, 16, 164, 187,
+			210, 7, 17, 233, 72, 21, 25, 46, 40, 192, 66, 50, 198, 212,
+			46, 214, 146, 243, 13, 203, 166, 38, 234, 158, 218, 233, 189, 208,
+			236, 84, 176, 61, 182, 226, 64, 71, 39, 82, 38, 66, 157, 241,
+			36, 139, 44, 242, 229, 110, 53, 149, 191, 210, 230, 197, 177, 183,
+			13, 62, 83, 217, 181, 232, 138, 133, 167, 195, 168, 90, 59, 115,
+			34, 173, 182, 162, 213, 154, 88, 208, 55, 205, 145, 173, 163, 181,
+			109, 142, 188, 32, 153, 169, 15, 116, 91, 159, 201, 83, 52, 21,
+			128, 70, 170, 176, 58, 190, 147, 151, 198, 115, 153, 123, 136, 37,
+			72, 13, 126, 95, 198, 124, 80, 131, 223, 167, 165, 245, 0, 221,
+			156, 121, 159, 91, 214, 204, 135, 171, 68, 239, 3, 243, 189, 27,
+			86, 246, 0, 230, 233, 23, 93, 251, 222, 202, 15, 20, 104, 98,
+			228, 203, 113, 122, 29, 105, 71, 141, 50, 34, 16, 164, 181, 68,
+			90, 184, 84, 126, 140, 59, 75, 197, 138, 71, 42, 11, 130, 186,
+			205, 158, 223, 149, 130, 36, 247, 90, 8, 13, 30, 228, 125, 224,
+			62, 177, 74, 43, 43, 245, 215, 99, 175, 69, 155, 242, 90, 112,
+			91, 167, 67, 97, 98, 34, 8, 211, 7, 238, 155, 18, 29, 245,
+			111, 162, 254, 165, 74, 248, 33, 81, 127, 77, 214, 132, 152, 81,
+			66, 132, 108, 20, 53, 16, 243, 156, 27, 57, 49, 13, 127, 64,
+			253, 78, 242, 227, 145, 209, 54, 218, 230, 33, 3, 36, 17, 45,
+			196, 156, 195, 67, 24, 251, 141, 0, 235, 93, 5, 253, 128, 95,
+			55, 16, 188, 165, 194, 97, 60, 209, 130, 186, 161, 244, 34, 168,
+			251, 50, 3, 131, 242, 10, 139, 181, 86, 36, 213, 110, 25, 80,
+			158, 53, 91, 99, 98, 137, 4, 218, 54, 126, 53, 111, 205, 25,
+			107, 64, 13, 130, 204, 210, 46, 59, 206, 111, 230, 59, 175, 238,
+			46, 48, 163, 36, 228, 126, 171, 202, 208, 211, 170, 206, 121, 131,
+			57, 89, 245, 55, 188, 91, 120, 130, 67, 201, 94, 125, 100, 32,
+			103, 157, 9, 243, 36, 30, 104, 214, 173, 255, 152, 108, 217, 105,
+			141, 117, 71, 10, 5, 185, 59, 192, 145, 154, 236, 252, 169, 57,
+			218, 79, 84, 88, 173, 215, 36, 159, 63, 140, 117, 70, 39, 221,
+			181, 77, 47, 221, 168, 45, 132, 169, 191, 110, 236, 228, 1, 50,
+			74, 126, 49, 99, 108, 92, 228, 250, 69, 87, 185, 0, 7, 40,
+			24, 237, 23, 93, 62, 166, 65, 7, 191, 30, 186, 91, 255, 90,
+			2, 120, 248, 188, 6, 123, 1, 78, 156, 83, 82, 213, 226, 197,
+			15, 187, 246, 191, 52, 82, 21, 139, 228, 195, 110, 239, 62, 74,
+			154, 53, 128, 148, 237, 220, 253, 37, 215, 229, 149, 3, 202, 129,
+			154, 59, 229, 6, 29, 181, 48, 149, 121, 172, 126, 41, 19, 158,
+			50, 143, 213, 47, 185, 229, 97, 54, 169, 80, 89, 220, 253, 21,
+			160, 58, 72, 168, 114, 83, 163, 178, 194, 228, 144, 161, 27, 191,
+			146, 71, 134, 20, 87, 191, 146, 71, 102, 115, 247, 215, 246, 68,
+			150, 69, 24, 235, 111, 65, 159, 95, 203, 35, 195, 90, 255, 53,
+			32, 251, 247, 68, 4, 216, 65, 127, 232, 218, 167, 43, 31, 29,
+			212, 49, 26, 234, 252, 16, 59, 241, 170, 49, 65, 90, 222, 211,
+			65, 107, 251, 165, 66, 224, 33, 72, 125, 164, 104, 78, 20, 149,
+			6, 50, 141, 233, 213, 169, 90, 229, 181, 128, 77, 228, 196, 161,
+			21, 35, 87, 9, 136, 40, 227, 74, 213, 55, 196, 93, 184, 207,
+			67, 59, 189, 108, 141, 132, 44, 20, 219, 48, 171, 55, 78, 11,
+			42, 37, 5, 137, 132, 162, 186, 78, 170, 250, 135, 208, 101, 165,
+			8, 203, 204, 158, 180, 209, 43, 185, 204, 176, 150, 84, 216, 66,
+			55, 86, 37, 95, 27, 169, 140, 136, 207, 240, 81, 103, 85, 234,
+			39, 202, 210, 36, 61, 34, 218, 253, 166, 98, 164, 49, 124, 143,
+			238, 254, 96, 180, 93, 107, 68, 102, 127, 90, 139, 161, 178, 68,
+			218, 178, 49, 185, 32, 72, 35, 66, 196, 199, 58, 178, 218, 196,
+			2, 111, 111, 234, 120, 178, 29, 153, 74, 204, 53, 28, 163, 239,
+			233, 59, 51, 38, 208, 143, 153, 189, 35, 239, 143, 39, 67, 41,
+			233, 172, 175, 251, 137, 78, 63, 210, 229, 145, 242, 232, 141, 17,
+			168, 78, 129, 47, 147, 246, 120, 41, 83, 65, 34, 93, 253, 233,
+			202, 86, 131, 113, 111, 69, 177, 114, 139, 230, 150, 246, 106, 20,
+			221, 188, 233, 251, 72, 81, 33, 175, 25, 111, 96, 46, 210, 237,
+			182, 178, 158, 85, 62, 242, 174, 104, 182, 96, 151, 0, 209, 161,
+			160, 194, 147, 193, 134, 249, 23, 4, 144, 133, 127, 77, 157, 215,
+			192, 165, 152, 137, 65, 204, 242, 195, 120, 244, 162, 165, 99, 96,
+			17, 119, 47, 29, 169, 200, 184, 174, 227, 102, 41, 64, 82, 136,
+			75, 157, 24, 106, 0, 60, 171, 96, 53, 100, 128, 152, 70, 122,
+			118, 147, 94, 157, 229, 26, 83, 106, 164, 236, 79, 238, 209, 4,
+			217, 225, 135, 193, 149, 73, 170, 154, 53, 141, 1, 27, 9, 99,
+			140, 93, 186, 187, 204, 181, 9, 217, 32, 177, 115, 163, 19, 67,
+			29, 70, 214, 148, 173, 13, 58, 41, 138, 194, 233, 110, 132, 96,
+			250, 32, 68, 66, 32, 186, 119, 66, 57, 57, 16, 102, 170, 110,
+			226, 75, 182, 172, 177, 174, 187, 254, 193, 243, 218, 214, 244, 164,
+			183, 73, 241, 161, 181, 42, 121, 125, 8, 42, 21, 205, 127, 215,
+			5, 13, 25, 21, 31, 32, 125, 149, 215, 194, 211, 116, 98, 33,
+			89, 144, 235, 54, 120, 218, 111, 78, 76, 138, 104, 143, 213, 13,
+			151, 8, 242, 1, 224, 74, 133, 100, 72, 74, 54, 162, 236, 228,
+			238, 165, 8, 31, 56, 189, 1, 21, 174, 239, 236, 89, 206, 109,
+			31, 250, 24, 176, 23, 111, 155, 203, 8, 145, 14, 116, 219, 3,
+			39, 89, 14, 210, 9, 77, 155, 142, 185, 178, 21, 224, 189, 28,
+			36, 158, 197, 129, 178, 31, 67, 62, 208, 231, 68, 152, 41, 200,
+			121, 223, 51, 26, 97, 187, 19, 183, 225, 152, 136, 214, 104, 161,
+			50, 189, 50, 160, 110, 132, 59, 247, 70, 229, 48, 37, 87, 70,
+			242, 156, 244, 102, 198, 135, 109, 178, 183, 164, 234, 61, 129, 32,
+			205, 83, 92, 31, 37, 228, 162, 177, 114, 115, 163, 165, 101, 119,
+			55, 8, 181, 122, 253, 235, 148, 188, 193, 112, 74, 117, 3, 201,
+			129, 118, 116, 133, 92, 141, 167, 66, 72, 147, 83, 236, 185, 170,
+			117, 203, 38, 45, 207, 100, 6, 155, 46, 7, 218, 6, 116, 97,
+			220, 146, 83, 20, 199, 66, 159, 33, 129, 118, 254, 236, 249, 115,
+			83, 68, 72, 189, 218, 69, 35, 138, 99, 191, 145, 134, 16, 121,
+			212, 120, 162, 185, 73, 198, 37, 211, 237, 91, 245, 122, 77, 158,
+			250, 36, 136, 193, 97, 120, 250, 144, 248, 58, 246, 146, 13, 121,
+			49, 22, 106, 36, 73, 113, 24, 147, 33, 188, 16, 77, 21, 229,
+			44, 177, 33, 201, 210, 90, 16, 230, 182, 29, 209, 105, 71, 97,
+			182, 162, 196, 242, 226, 220, 226, 196, 234, 153, 243, 231, 206, 61,
+			116, 246, 129, 115, 231, 238, 157, 188, 32, 84, 64, 14, 148, 188,
+			160, 105, 236, 11, 26, 139, 230, 84, 165, 107, 192, 123, 242, 135,
+			153, 218, 2, 239, 201, 31, 102, 250, 56, 124, 29, 127, 232, 170,
+			204, 134, 3, 20, 190, 254, 135, 238, 1, 173, 197, 56, 37, 252,
+			122, 240, 148, 254, 181, 23, 224, 137, 73, 246, 179, 112, 75, 15,
+			64, 33, 250, 140, 107, 63, 88, 249, 39, 150, 216, 241, 124, 243,
+			55, 70, 80, 21, 31, 164, 99, 239, 186, 111, 18, 194, 211, 33,
+			39, 9, 82, 1, 182, 174, 121, 247, 79, 157, 158, 109, 4, 171,
+			202, 38, 67, 44, 180, 92, 67, 94, 40, 205, 105, 157, 180, 89,
+			14, 0, 222, 145, 207, 100, 180, 64, 24, 248, 103, 50, 90, 192,
+			59, 242, 25, 87, 229, 202, 25, 32, 239, 200, 103, 220, 234, 9,
+			13, 150, 240, 171, 186, 116, 60, 64, 97, 224, 159, 113, 207, 222,
+			207, 62, 35, 105, 81, 224, 238, 159, 185, 246, 185, 202, 127, 204,
+			71, 158, 97, 155, 122, 209, 28, 244, 250, 104, 36, 249, 230, 220,
+			243, 42, 173, 227, 11, 138, 54, 235, 98, 40, 4, 82, 255, 89,
+			70, 196, 2, 141, 220, 16, 17, 129, 212, 127, 230, 42, 231, 252,
+			0, 37, 62, 252, 51, 87, 57, 231, 7, 236, 66, 9, 191, 42,
+			231, 252, 0, 5, 82, 255, 153, 123, 234, 12, 123, 41, 209, 176,
+			200, 221, 207, 187, 246, 84, 229, 220, 55, 254, 230, 141, 236, 75,
+			17, 9, 137, 178, 174, 33, 72, 248, 243, 217, 252, 34, 72, 248,
+			243, 25, 175, 23, 145, 144, 200, 61, 112, 80, 131, 37, 128, 149,
+			211, 26, 236, 5, 120, 242, 20, 101, 110, 31, 176, 17, 244, 255,
+			45, 116, 212, 13, 80, 240, 232, 151, 178, 174, 246, 22, 1, 42,
+			71, 221, 0, 158, 169, 113, 191, 164, 29, 117, 3, 20, 60, 250,
+			37, 237, 168, 27, 40, 112, 247, 203, 47, 146, 163, 110, 0, 142,
+			186, 47, 107, 71, 221, 0, 57, 234, 190, 172, 29, 117, 3, 228,
+			168, 251, 50, 28, 117, 119, 195, 53, 215, 199, 221, 175, 192, 21,
+			58, 164, 222, 146, 105, 138, 167, 72, 239, 150, 152, 112, 186, 242,
+			21, 237, 248, 28, 160, 211, 149, 60, 104, 43, 16, 46, 191, 125,
+			188, 248, 53, 183, 231, 245, 5, 233, 242, 67, 152, 219, 215, 224,
+			242, 3, 229, 247, 193, 246, 249, 250, 183, 144, 242, 251, 200, 59,
+			246, 117, 77, 249, 125, 228, 29, 251, 186, 166, 252, 62, 178, 160,
+			190, 174, 41, 191, 143, 188, 99, 95, 215, 148, 223, 87, 224, 238,
+			247, 22, 94, 28, 202, 239, 3, 229, 191, 183, 160, 40, 191, 143,
+			40, 255, 189, 5, 69, 249, 125, 68, 249, 239, 45, 40, 23, 233,
+			32, 47, 190, 161, 208, 243, 46, 69, 47, 196, 227, 189, 161, 80,
+			26, 97, 175, 97, 174, 59, 8, 122, 189, 169, 96, 139, 74, 93,
+			198, 41, 228, 84, 90, 21, 191, 172, 98, 195, 162, 45, 156, 101,
+			64, 75, 206, 220, 161, 228, 114, 194, 17, 167, 9, 13, 132, 162,
+			202, 116, 126, 81, 234, 203, 32, 17, 240, 77, 5, 69, 192, 65,
+			58, 48, 126, 83, 65, 173, 178, 65, 34, 224, 155, 10, 229, 67,
+			26, 116, 208, 159, 35, 71, 217, 127, 133, 156, 28, 132, 25, 248,
+			76, 193, 62, 87, 249, 247, 153, 156, 84, 89, 131, 94, 36, 49,
+			137, 207, 209, 194, 139, 45, 37, 209, 134, 22, 146, 131, 228, 5,
+			123, 38, 163, 17, 188, 96, 207, 100, 52, 130, 101, 253, 76, 65,
+			9, 201, 65, 242, 130, 61, 83, 80, 66, 114, 208, 182, 74, 0,
+			149, 144, 28, 164, 195, 224, 103, 10, 167, 206, 176, 163, 152, 236,
+			62, 238, 190, 181, 240, 28, 231, 15, 131, 88, 119, 111, 45, 168,
+			133, 54, 72, 235, 46, 15, 218, 10, 196, 226, 26, 132, 13, 254,
+			246, 194, 183, 110, 113, 13, 146, 147, 228, 237, 217, 184, 225, 36,
+			121, 123, 65, 45, 174, 65, 114, 146, 188, 189, 160, 22, 215, 32,
+			157, 220, 190, 189, 160, 22, 215, 32, 194, 205, 95, 164, 197, 53,
+			136, 197, 245, 172, 94, 92, 131, 180, 184, 158, 213, 139, 107, 144,
+			22, 215, 179, 122, 113, 13, 241, 226, 187, 11, 61, 63, 169, 22,
+			23, 130, 51, 223, 93, 40, 141, 177, 191, 2, 251, 14, 97, 117,
+			189, 7, 236, 251, 153, 29, 236, 75, 43, 228, 197, 103, 98, 185,
+			18, 95, 236, 243, 248, 172, 37, 205, 208, 67, 180, 232, 223, 163,
+			39, 118, 136, 22, 253, 123, 52, 67, 15, 209, 162, 127, 143, 102,
+			232, 33, 90, 244, 239, 209, 12, 61, 100, 247, 148, 64, 53, 197,
+			208, 67, 20, 37, 242, 30, 48, 52, 120, 112, 8, 60, 241, 222,
+			111, 33, 15, 14, 209, 218, 123, 111, 214, 85, 228, 110, 122, 175,
+			230, 193, 33, 242, 64, 191, 87, 243, 224, 16, 173, 189, 247, 106,
+			30, 28, 42, 112, 247, 39, 94, 36, 30, 28, 2, 15, 254, 132,
+			230, 193, 33, 226, 193, 159, 208, 60, 56, 68, 60, 248, 19, 154,
+			7, 203, 188, 248, 190, 66, 207, 191, 80, 60, 136, 32, 219, 247,
+			21, 74, 251, 217, 127, 116, 152, 235, 150, 193, 131, 31, 42, 216,
+			231, 243, 209, 32, 217, 141, 226, 23, 145, 1, 85, 35, 47, 54,
+			247, 169, 102, 146, 26, 59, 255, 25, 139, 156, 9, 23, 132, 204,
+			23, 165, 19, 23, 136, 115, 38, 241, 199, 189, 231, 117, 174, 169,
+			44, 185, 191, 121, 228, 220, 168, 142, 245, 235, 179, 8, 10, 88,
+			139, 189, 77, 31, 103, 228, 53, 129, 44, 45, 94, 59, 106, 69,
+			235, 144, 102, 248, 106, 35, 242, 226, 166, 50, 180, 147, 220, 163,
+			36, 145, 136, 58, 113, 226, 183, 110, 33, 20, 142, 78, 249, 133,
+			216, 194, 19, 142, 177, 111, 146, 118, 74, 95, 21, 37, 160, 161,
+			116, 188, 171, 116, 37, 9, 213, 154, 126, 35, 80, 222, 38, 125,
+			182, 167, 95, 197, 69, 143, 212, 195, 184, 138, 117, 203, 180, 202,
+			62, 164, 89, 183, 76, 171, 236, 67, 122, 149, 149, 105, 149, 125,
+			72, 175, 178, 50, 173, 178, 15, 21, 212, 85, 142, 50, 173, 178,
+			15, 21, 212, 85, 142, 50, 173, 178, 15, 21, 78, 159, 37, 5,
+			182, 140, 85, 246, 225, 111, 225, 42, 43, 211, 42, 251, 112, 214,
+			85, 172, 178, 15, 235, 85, 86, 166, 85, 246, 97, 189, 202, 202,
+			180, 202, 62, 172, 87, 89, 25, 97, 212, 47, 210, 42, 43, 99,
+			149, 253, 178, 94, 101, 101, 90, 101, 191, 172, 87, 89, 153, 86,
+			217, 47, 235, 85, 54, 204, 139, 191, 90, 232, 249, 143, 106, 149,
+			33, 154, 251, 87, 11, 165, 187, 216, 191, 199, 42, 27, 198, 42,
+			251, 40, 86, 217, 127, 202, 86, 153, 114, 233, 189, 168, 139, 76,
+			182, 241, 98, 175, 49, 229, 212, 251, 127, 223, 18, 27, 166, 37,
+			246, 81, 205, 183, 195, 180, 196, 62, 170, 151, 216, 48, 45, 177,
+			143, 234, 37, 54, 76, 75, 236, 163, 122, 137, 13, 211, 18, 251,
+			168, 94, 98, 195, 180, 196, 62, 138, 37, 246, 57, 40, 7, 195,
+			56, 29, 255, 120, 193, 254, 55, 5, 167, 59, 80, 79, 249, 129,
+			155, 254, 180, 188, 184, 62, 77, 206, 248, 9, 100, 226, 135, 119,
+			54, 8, 197, 99, 203, 203, 215, 161, 125, 181, 224, 182, 152, 148,
+			147, 223, 244, 55, 219, 17, 60, 113, 83, 12, 112, 40, 93, 104,
+			47, 149, 117, 113, 137, 182, 9, 154, 239, 242, 182, 101, 30, 214,
+			203, 243, 203, 96, 142, 85, 34, 61, 252, 192, 76, 79, 187, 12,
+			233, 189, 126, 35, 247, 123, 214, 156, 174, 100, 78, 49, 162, 181,
+			174, 19, 167, 235, 139, 75, 203, 154, 152, 116, 254, 255, 241, 66,
+			239, 1, 58, 188, 31, 198, 241, 62, 119, 127, 187, 224, 222, 77,
+			103, 72, 195, 234, 184, 255, 183, 11, 238, 129, 172, 192, 70, 65,
+			229, 16, 59, 161, 190, 176, 184, 251, 59, 5, 119, 172, 58, 74,
+			67, 161, 108, 119, 166, 47, 204, 124, 6, 121, 242, 59, 5, 245,
+			48, 206, 176, 10, 2, 248, 157, 194, 254, 3, 236, 65, 133, 199,
+			230, 238, 239, 22, 220, 145, 234, 120, 158, 116, 116, 28, 99, 210,
+			178, 37, 65, 211, 87, 201, 3, 146, 12, 53, 68, 227, 239, 22,
+			212, 107, 81, 195, 42, 90, 224, 119, 11, 244, 90, 20, 4, 129,
+			197, 139, 159, 40, 216, 159, 44, 220, 163, 102, 29, 130, 239, 19,
+			25, 3, 65, 240, 125, 162, 160, 50, 92, 14, 147, 224, 251, 68,
+			225, 238, 105, 13, 58, 248, 85, 229, 131, 24, 38, 213, 254, 147,
+			133, 226, 73, 13, 246, 114, 247, 147, 133, 225, 19, 36, 163, 135,
+			209, 238, 167, 190, 133, 50, 122, 152, 180, 241, 79, 101, 93, 133,
+			54, 254, 41, 45, 163, 135, 73, 27, 255, 148, 150, 209, 195, 164,
+			141, 127, 74, 203, 232, 225, 2, 119, 127, 239, 69, 146, 209, 195,
+			144, 209, 191, 167, 101, 244, 48, 201, 232, 223, 211, 50, 122, 152,
+			100, 244, 239, 65, 70, 255, 128, 131, 4, 25, 188, 248, 233, 66,
+			207, 95, 22, 172, 202, 223, 216, 98, 70, 35, 205, 98, 43, 32,
+			78, 60, 213, 19, 117, 219, 131, 200, 166, 28, 144, 57, 42, 41,
+			47, 35, 188, 139, 12, 7, 135, 190, 23, 83, 215, 245, 40, 232,
+			60, 74, 231, 247, 214, 55, 179, 77, 184, 244, 133, 11, 215, 85,
+			110, 58, 92, 69, 169, 49, 149, 145, 19, 177, 144, 215, 163, 168,
+			165, 223, 15, 73, 148, 108, 35, 135, 47, 165, 115, 67, 7, 179,
+			186, 234, 190, 96, 82, 235, 186, 185, 188, 163, 11, 65, 40, 154,
+			187, 190, 80, 143, 202, 203, 35, 12, 121, 252, 147, 161, 189, 112,
+			65, 161, 152, 152, 148, 226, 162, 29, 71, 205, 78, 99, 119, 181,
+			217, 168, 189, 189, 28, 77, 76, 78, 74, 111, 33, 57, 218, 229,
+			177, 196, 141, 124, 58, 60, 117, 27, 131, 169, 77, 102, 83, 165,
+			138, 194, 69, 159, 79, 23, 74, 135, 216, 111, 33, 142, 134, 35,
+			203, 244, 103, 10, 246, 255, 40, 56, 149, 127, 33, 35, 105, 242,
+			23, 251, 187, 242, 235, 169, 33, 214, 132, 76, 161, 168, 210, 137,
+			152, 89, 196, 142, 149, 248, 235, 42, 169, 0, 14, 126, 154, 81,
+			58, 173, 83, 236, 52, 117, 60, 119, 144, 172, 24, 70, 211, 207,
+			34, 136, 96, 109, 45, 247, 117, 30, 101, 200, 50, 190, 20, 19,
+			77, 63, 140, 82, 157, 41, 68, 190, 83, 3, 77, 175, 139, 7,
+			16, 208, 149, 236, 140, 122, 156, 172, 49, 49, 95, 91, 175, 77,
+			253, 61, 241, 157, 234, 129, 116, 138, 137, 121, 213, 148, 248, 206,
+			234, 170, 23, 215, 86, 189, 167, 171, 83, 228, 91, 161, 162, 205,
+			92, 21, 241, 186, 92, 143, 152, 124, 88, 125, 66, 125, 51, 89,
+			67, 77, 181, 88, 57, 101, 160, 254, 76, 129, 65, 236, 224, 209,
+			84, 146, 165, 127, 92, 112, 171, 36, 151, 184, 202, 57, 253, 199,
+			5, 149, 17, 154, 171, 156, 211, 127, 92, 216, 55, 146, 21, 32,
+			200, 188, 48, 122, 56, 43, 64, 152, 121, 65, 28, 51, 56, 145,
+			50, 167, 224, 30, 55, 21, 32, 204, 254, 36, 143, 19, 158, 138,
+			63, 193, 98, 52, 5, 244, 9, 63, 146, 21, 32, 207, 78, 225,
+			88, 149, 214, 50, 71, 47, 63, 91, 176, 101, 94, 84, 78, 187,
+			235, 103, 181, 196, 225, 228, 92, 251, 108, 161, 127, 68, 131, 200,
+			70, 95, 24, 29, 211, 32, 178, 209, 23, 14, 221, 45, 159, 136,
+			226, 144, 71, 159, 43, 216, 227, 149, 183, 88, 185, 151, 78, 158,
+			131, 155, 166, 48, 83, 91, 27, 94, 74, 92, 12, 223, 35, 85,
+			77, 163, 155, 126, 24, 60, 141, 72, 210, 64, 167, 40, 109, 66,
+			155, 242, 18, 117, 116, 147, 157, 220, 204, 171, 75, 42, 72, 24,
+			39, 91, 74, 76, 224, 191, 122, 131, 74, 143, 5, 164, 250, 92,
+			54, 52, 16, 234, 115, 5, 21, 255, 193, 73, 238, 127, 174, 160,
+			110, 66, 114, 34, 210, 231, 10, 39, 239, 81, 68, 66, 204, 119,
+			193, 158, 84, 63, 66, 44, 127, 62, 195, 132, 240, 246, 207, 103,
+			152, 64, 134, 207, 23, 248, 9, 13, 194, 77, 93, 24, 159, 80,
+			152, 144, 251, 190, 160, 178, 45, 115, 122, 182, 234, 11, 25, 38,
+			28, 238, 124, 161, 160, 34, 253, 56, 61, 91, 245, 133, 194, 240,
+			113, 13, 210, 183, 247, 140, 43, 76, 46, 119, 191, 88, 80, 217,
+			230, 57, 5, 142, 126, 49, 195, 132, 163, 145, 47, 102, 125, 194,
+			209, 200, 23, 11, 234, 130, 27, 167, 163, 145, 47, 102, 44, 80,
+			224, 238, 95, 20, 84, 30, 93, 78, 231, 3, 127, 145, 97, 194,
+			249, 192, 95, 100, 125, 194, 249, 192, 95, 20, 134, 53, 191, 224,
+			124, 224, 47, 192, 160, 18, 19, 188, 224, 5, 149, 58, 153, 147,
+			59, 255, 75, 25, 38, 184, 243, 191, 148, 245, 9, 238, 252, 47,
+			21, 248, 49, 13, 58, 0, 79, 156, 100, 191, 111, 49, 219, 29,
+			225, 197, 175, 21, 122, 254, 103, 209, 170, 60, 37, 230, 195, 134,
+			215, 78, 84, 254, 211, 23, 246, 100, 58, 214, 189, 60, 45, 87,
+			33, 88, 200, 88, 220, 242, 51, 73, 74, 26, 166, 216, 242, 114,
+			249, 92, 106, 236, 252, 107, 190, 149, 25, 103, 179, 222, 32, 170,
+			128, 58, 46, 165, 48, 110, 27, 126, 13, 254, 128, 239, 29, 102,
+			174, 59, 130, 21, 248, 254, 162, 125, 172, 242, 197, 178, 152, 17,
+			87, 34, 125, 54, 166, 89, 31, 178, 181, 29, 248, 50, 92, 161,
+			27, 163, 240, 242, 130, 78, 134, 155, 225, 122, 77, 28, 251, 73,
+			59, 66, 142, 12, 248, 138, 243, 199, 189, 89, 210, 91, 125, 234,
+			185, 227, 181, 119, 200, 252, 176, 169, 94, 235, 200, 158, 15, 73,
+			35, 177, 48, 55, 143, 67, 123, 217, 114, 211, 191, 237, 199, 201,
+			212, 142, 219, 128, 138, 150, 116, 166, 5, 21, 53, 9, 54, 131,
+			150, 23, 51, 243, 78, 188, 122, 235, 139, 18, 46, 78, 137, 196,
+			219, 134, 157, 64, 219, 23, 110, 1, 181, 252, 236, 66, 194, 29,
+			175, 153, 121, 45, 147, 204, 42, 138, 204, 237, 130, 215, 49, 113,
+			197, 199, 123, 70, 184, 215, 128, 156, 171, 148, 162, 55, 11, 5,
+			206, 198, 125, 129, 61, 15, 170, 87, 171, 171, 10, 175, 126, 181,
+			249, 231, 213, 248, 231, 213, 248, 208, 83, 63, 174, 54, 232, 159,
+			166, 47, 196, 154, 16, 235, 27, 1, 131, 71, 194, 36, 155, 53,
+			73, 124, 68, 75, 205, 103, 66, 237, 38, 109, 47, 20, 130, 18,
+			246, 40, 68, 250, 255, 252, 46, 35, 196, 119, 122, 83, 193, 164,
+			16, 223, 41, 238, 155, 18, 103, 167, 196, 249, 41, 113, 86, 188,
+			10, 213, 72, 176, 110, 109, 68, 173, 221, 3, 171, 169, 15, 87,
+			119, 124, 56, 37, 238, 195, 183, 248, 176, 229, 173, 250, 45, 49,
+			161, 71, 143, 141, 81, 136, 239, 108, 76, 53, 119, 125, 114, 191,
+			254, 132, 164, 242, 132, 36, 147, 170, 239, 79, 173, 237, 170, 127,
+			78, 215, 199, 78, 47, 38, 214, 162, 72, 85, 94, 159, 218, 216,
+			85, 249, 94, 83, 153, 140, 85, 49, 113, 110, 82, 191, 49, 1,
+			50, 77, 139, 25, 67, 54, 21, 246, 97, 114, 136, 155, 72, 94,
+			21, 67, 148, 38, 126, 107, 77, 189, 130, 167, 66, 36, 40, 85,
+			157, 200, 51, 61, 177, 171, 206, 105, 27, 164, 147, 134, 245, 19,
+			115, 70, 173, 82, 172, 97, 195, 192, 50, 83, 65, 186, 20, 123,
+			5, 100, 48, 130, 17, 129, 131, 167, 19, 26, 173, 72, 5, 126,
+			152, 8, 93, 90, 224, 74, 131, 169, 137, 110, 38, 135, 123, 152,
+			30, 174, 96, 250, 246, 35, 5, 240, 54, 110, 138, 137, 118, 148,
+			36, 193, 106, 203, 36, 127, 6, 191, 152, 232, 158, 76, 243, 201,
+			166, 56, 145, 106, 31, 48, 169, 251, 144, 42, 74, 198, 144, 107,
+			107, 3, 166, 35, 241, 23, 101, 94, 55, 7, 65, 85, 131, 47,
+			169, 154, 136, 101, 32, 202, 162, 92, 33, 76, 41, 198, 161, 233,
+			223, 174, 97, 26, 174, 234, 190, 104, 244, 73, 102, 139, 233, 195,
+			37, 226, 101, 77, 80, 25, 198, 170, 242, 33, 107, 234, 228, 232,
+			167, 82, 191, 26, 97, 147, 180, 17, 193, 132, 49, 232, 156, 204,
+			102, 248, 200, 23, 161, 19, 210, 139, 205, 40, 33, 167, 66, 180,
+			122, 43, 136, 58, 137, 38, 174, 126, 36, 80, 142, 173, 89, 85,
+			116, 245, 214, 189, 32, 52, 153, 109, 117, 50, 100, 141, 121, 231,
+			52, 232, 164, 212, 242, 226, 33, 30, 94, 244, 167, 100, 164, 118,
+			180, 150, 207, 20, 188, 199, 168, 187, 89, 117, 60, 145, 203, 91,
+			199, 121, 81, 168, 12, 52, 219, 206, 170, 226, 42, 196, 203, 72,
+			197, 85, 213, 149, 188, 34, 123, 164, 216, 37, 55, 30, 31, 143,
+			255, 173, 117, 39, 223, 203, 19, 80, 219, 64, 242, 194, 44, 176,
+			172, 250, 235, 65, 72, 23, 225, 163, 181, 60, 38, 67, 153, 144,
+			110, 19, 37, 27, 94, 44, 77, 139, 29, 153, 166, 117, 220, 150,
+			204, 144, 75, 223, 16, 35, 60, 14, 97, 170, 195, 164, 188, 189,
+			70, 156, 31, 102, 18, 109, 234, 4, 162, 59, 106, 2, 179, 49,
+			236, 54, 125, 47, 84, 97, 102, 20, 30, 41, 211, 102, 251, 97,
+			211, 219, 99, 17, 137, 42, 61, 55, 92, 85, 22, 108, 2, 76,
+			244, 174, 168, 71, 161, 200, 228, 16, 201, 37, 14, 55, 43, 51,
+			71, 175, 124, 74, 78, 51, 4, 66, 148, 109, 152, 32, 157, 90,
+			48, 6, 173, 68, 170, 114, 217, 5, 200, 250, 183, 217, 142, 194,
+			44, 126, 212, 188, 132, 34, 166, 197, 108, 150, 165, 74, 62, 182,
+			131, 40, 69, 173, 247, 102, 141, 106, 13, 181, 29, 71, 171, 30,
+			4, 0, 93, 187, 70, 168, 33, 249, 193, 208, 39, 233, 38, 20,
+			41, 173, 103, 77, 37, 237, 57, 144, 185, 83, 112, 229, 118, 10,
+			145, 231, 136, 63, 84, 17, 209, 209, 90, 174, 149, 6, 212, 96,
+			32, 163, 183, 80, 26, 136, 83, 203, 242, 116, 210, 213, 19, 101,
+			188, 140, 144, 222, 255, 254, 162, 82, 213, 70, 72, 239, 127, 127,
+			81, 233, 253, 35, 164, 247, 191, 191, 56, 122, 183, 6, 29, 104,
+			44, 71, 5, 57, 88, 70, 96, 69, 126, 160, 104, 127, 173, 40,
+			195, 159, 71, 200, 16, 250, 64, 145, 113, 246, 166, 94, 86, 116,
+			71, 96, 198, 112, 247, 35, 69, 119, 170, 242, 229, 98, 254, 209,
+			1, 149, 22, 29, 41, 126, 21, 187, 222, 73, 71, 211, 119, 190,
+			213, 243, 67, 204, 140, 17, 219, 198, 124, 238, 86, 133, 242, 157,
+			230, 60, 161, 234, 53, 48, 218, 2, 116, 10, 55, 10, 210, 67,
+			62, 228, 116, 131, 148, 68, 102, 94, 57, 221, 83, 75, 84, 12,
+			65, 18, 73, 197, 51, 41, 135, 154, 18, 203, 34, 106, 52, 58,
+			244, 6, 125, 23, 211, 166, 16, 137, 104, 132, 182, 126, 218, 203,
+			239, 165, 189, 252, 193, 41, 113, 78, 188, 138, 101, 18, 154, 42,
+			64, 255, 169, 41, 181, 103, 5, 179, 57, 113, 239, 164, 16, 103,
+			206, 208, 119, 250, 6, 101, 141, 184, 113, 226, 193, 73, 5, 83,
+			5, 160, 52, 21, 176, 1, 79, 100, 63, 83, 133, 115, 89, 216,
+			163, 94, 197, 123, 12, 180, 171, 113, 153, 73, 32, 79, 200, 251,
+			168, 151, 102, 3, 222, 73, 165, 174, 143, 31, 17, 247, 33, 140,
+			54, 220, 85, 77, 246, 127, 55, 242, 243, 221, 200, 247, 122, 94,
+			68, 125, 32, 47, 138, 122, 225, 222, 149, 104, 248, 187, 209, 159,
+			219, 83, 235, 163, 186, 234, 26, 105, 38, 26, 232, 64, 92, 50,
+			71, 246, 8, 169, 102, 56, 200, 55, 195, 96, 210, 169, 177, 176,
+			6, 37, 86, 133, 208, 72, 86, 106, 121, 136, 153, 148, 44, 185,
+			107, 242, 49, 243, 134, 53, 50, 30, 16, 233, 46, 205, 46, 19,
+			245, 19, 38, 61, 164, 17, 241, 76, 47, 26, 169, 212, 41, 4,
+			242, 134, 141, 62, 136, 219, 12, 26, 81, 43, 10, 39, 85, 28,
+			255, 136, 114, 66, 124, 164, 168, 28, 6, 35, 202, 9, 241, 145,
+			162, 122, 150, 106, 68, 57, 33, 62, 82, 28, 57, 144, 21, 56,
+			40, 168, 28, 202, 10, 74, 40, 184, 251, 52, 43, 179, 146, 42,
+			176, 81, 114, 248, 20, 251, 164, 173, 150, 60, 222, 123, 45, 186,
+			83, 149, 223, 176, 245, 157, 83, 76, 138, 206, 41, 145, 110, 192,
+			133, 78, 175, 6, 116, 98, 77, 169, 228, 130, 74, 248, 221, 10,
+			66, 127, 74, 253, 221, 136, 90, 157, 205, 112, 10, 207, 151, 53,
+			233, 135, 76, 125, 157, 202, 69, 236, 122, 73, 210, 217, 244, 155,
+			114, 115, 246, 146, 28, 162, 73, 100, 106, 64, 100, 55, 240, 152,
+			7, 46, 188, 216, 92, 27, 11, 194, 52, 202, 54, 12, 57, 181,
+			112, 185, 235, 139, 96, 141, 237, 154, 200, 197, 68, 3, 167, 228,
+			63, 137, 82, 177, 24, 169, 138, 226, 105, 63, 142, 166, 165, 115,
+			127, 122, 58, 23, 179, 142, 12, 249, 208, 220, 196, 150, 74, 100,
+			224, 53, 155, 12, 103, 52, 145, 84, 53, 212, 105, 72, 51, 72,
+			218, 45, 111, 91, 237, 66, 30, 180, 211, 56, 55, 119, 240, 96,
+			124, 44, 63, 119, 240, 97, 124, 44, 63, 119, 240, 98, 124, 44,
+			63, 119, 240, 99, 124, 44, 63, 119, 240, 96, 127, 44, 63, 119,
+			86, 201, 70, 201, 225, 83, 236, 39, 6, 212, 220, 33, 182, 171,
+			232, 78, 86, 222, 62, 96, 82, 217, 47, 145, 221, 137, 237, 109,
+			33, 92, 139, 114, 214, 138, 240, 204, 225, 86, 158, 99, 117, 226,
+			107, 16, 133, 20, 242, 70, 180, 169, 52, 106, 210, 90, 130, 236,
+			8, 8, 164, 148, 111, 117, 166, 27, 93, 56, 212, 6, 33, 213,
+			28, 29, 118, 173, 31, 100, 209, 12, 159, 171, 143, 205, 96, 6,
+			169, 32, 3, 181, 17, 130, 91, 246, 104, 23, 119, 4, 163, 48,
+			241, 27, 29, 138, 58, 197, 132, 38, 83, 100, 30, 136, 48, 146,
+			60, 5, 139, 245, 166, 31, 238, 248, 74, 110, 198, 166, 190, 60,
+			218, 75, 149, 191, 217, 75, 50, 38, 82, 141, 162, 67, 8, 40,
+			14, 194, 245, 21, 188, 227, 137, 126, 175, 152, 14, 17, 63, 224,
+			162, 3, 76, 21, 111, 61, 246, 218, 27, 212, 109, 83, 129, 216,
+			77, 118, 128, 105, 98, 77, 224, 64, 4, 134, 78, 35, 10, 67,
+			121, 143, 32, 141, 38, 65, 108, 161, 238, 0, 232, 213, 84, 147,
+			158, 90, 131, 123, 138, 153, 148, 231, 164, 250, 81, 246, 165, 157,
+			131, 137, 50, 138, 105, 60, 90, 97, 208, 178, 89, 93, 203, 193,
+			216, 22, 195, 150, 113, 178, 83, 85, 117, 177, 38, 159, 21, 231,
+			97, 243, 227, 166, 23, 223, 132, 168, 147, 126, 240, 51, 103, 38,
+			177, 98, 240, 82, 58, 222, 35, 133, 79, 176, 163, 213, 62, 82,
+			60, 245, 119, 201, 148, 166, 33, 248, 33, 85, 153, 182, 136, 105,
+			240, 180, 73, 234, 199, 65, 114, 51, 123, 229, 216, 160, 219, 45,
+			49, 65, 92, 90, 109, 24, 180, 82, 80, 211, 13, 79, 167, 125,
+			140, 147, 180, 198, 196, 53, 127, 11, 63, 203, 229, 172, 238, 235,
+			102, 119, 125, 233, 193, 44, 12, 125, 222, 60, 26, 209, 181, 177,
+			80, 110, 24, 227, 77, 160, 189, 119, 86, 13, 63, 207, 184, 240,
+			34, 51, 113, 199, 159, 225, 95, 222, 3, 237, 170, 23, 203, 221,
+			111, 175, 189, 108, 213, 123, 90, 60, 34, 238, 125, 248, 57, 209,
+			62, 173, 91, 157, 9, 149, 126, 14, 74, 236, 170, 243, 28, 56,
+			54, 77, 207, 159, 15, 147, 174, 105, 58, 218, 140, 58, 136, 120,
+			223, 36, 226, 220, 247, 48, 83, 8, 230, 212, 194, 48, 124, 2,
+			217, 219, 136, 226, 245, 220, 235, 176, 96, 120, 205, 4, 56, 103,
+			143, 189, 128, 110, 142, 104, 22, 81, 168, 100, 255, 132, 254, 222,
+			232, 58, 41, 233, 108, 152, 208, 213, 150, 23, 222, 164, 190, 38,
+			122, 53, 0, 79, 144, 42, 61, 144, 208, 192, 174, 168, 61, 127,
+			247, 178, 165, 37, 206, 215, 88, 215, 80, 213, 156, 200, 106, 143,
+			136, 251, 229, 172, 156, 18, 23, 243, 140, 109, 168, 133, 31, 197,
+			41, 208, 76, 13, 91, 92, 81, 99, 213, 236, 157, 168, 42, 154,
+			201, 149, 178, 81, 19, 167, 206, 60, 39, 102, 101, 75, 136, 83,
+			98, 61, 198, 225, 175, 254, 192, 116, 84, 242, 171, 252, 81, 60,
+			34, 30, 48, 179, 162, 162, 20, 132, 22, 91, 26, 181, 190, 18,
+			136, 157, 3, 110, 240, 175, 228, 183, 35, 56, 194, 191, 82, 84,
+			231, 25, 84, 128, 160, 225, 226, 232, 137, 172, 192, 65, 193, 248,
+			4, 157, 103, 160, 6, 158, 61, 45, 186, 167, 76, 5, 56, 196,
+			191, 154, 199, 9, 151, 248, 87, 243, 56, 225, 20, 255, 106, 113,
+			244, 100, 86, 64, 56, 38, 38, 13, 78, 151, 187, 127, 83, 116,
+			207, 155, 10, 174, 44, 200, 112, 194, 57, 254, 55, 121, 156, 174,
+			133, 130, 209, 233, 172, 192, 65, 193, 217, 115, 72, 224, 99, 187,
+			163, 188, 248, 250, 222, 158, 119, 245, 90, 136, 11, 144, 10, 237,
+			170, 210, 11, 99, 191, 69, 123, 79, 178, 17, 180, 197, 170, 159,
+			110, 249, 190, 113, 131, 170, 251, 100, 164, 43, 192, 244, 223, 225,
+			164, 214, 15, 69, 204, 152, 60, 234, 77, 179, 191, 42, 101, 26,
+			188, 239, 37, 73, 212, 8, 60, 115, 242, 101, 222, 199, 49, 173,
+			48, 237, 21, 6, 190, 236, 200, 92, 191, 243, 64, 26, 38, 101,
+			227, 7, 139, 101, 177, 194, 234, 163, 156, 3, 89, 191, 40, 131,
+			164, 114, 175, 239, 45, 29, 100, 183, 153, 235, 142, 194, 122, 123,
+			99, 175, 125, 178, 242, 20, 46, 41, 205, 152, 160, 23, 189, 5,
+			225, 129, 240, 77, 159, 236, 123, 72, 216, 212, 191, 141, 27, 148,
+			59, 137, 0, 157, 39, 212, 123, 10, 211, 222, 16, 85, 9, 107,
+			48, 215, 15, 101, 149, 142, 146, 85, 250, 198, 94, 101, 149, 142,
+			146, 85, 250, 198, 222, 254, 187, 52, 136, 164, 225, 189, 251, 133,
+			6, 145, 52, 188, 247, 248, 9, 178, 74, 71, 97, 149, 126, 95,
+			175, 253, 108, 175, 180, 74, 71, 201, 42, 253, 190, 94, 54, 202,
+			158, 177, 88, 209, 29, 149, 86, 233, 155, 123, 221, 169, 202, 119,
+			231, 141, 82, 76, 234, 142, 157, 111, 231, 225, 66, 190, 167, 185,
+			247, 198, 232, 78, 56, 221, 196, 160, 107, 233, 198, 85, 228, 237,
+			212, 158, 106, 218, 199, 95, 131, 145, 161, 22, 212, 168, 210, 205,
+			223, 220, 171, 24, 117, 84, 233, 230, 111, 238, 85, 250, 221, 168,
+			210, 205, 223, 220, 171, 244, 187, 81, 165, 155, 191, 185, 87, 233,
+			119, 163, 74, 55, 127, 115, 175, 210, 239, 70, 181, 110, 254, 230,
+			222, 195, 184, 9, 33, 199, 109, 113, 247, 45, 189, 238, 137, 202,
+			75, 119, 142, 27, 252, 35, 51, 115, 75, 219, 36, 141, 158, 99,
+			252, 185, 126, 67, 47, 125, 75, 190, 223, 208, 75, 223, 210, 171,
+			22, 216, 168, 210, 75, 223, 210, 59, 122, 52, 43, 112, 80, 80,
+			61, 206, 182, 84, 167, 16, 215, 220, 235, 30, 174, 172, 239, 236,
+			20, 105, 242, 96, 144, 104, 109, 13, 126, 180, 32, 164, 171, 71,
+			198, 109, 215, 205, 103, 42, 27, 57, 45, 203, 44, 10, 62, 119,
+			140, 40, 79, 227, 115, 157, 135, 20, 123, 107, 190, 243, 144, 98,
+			111, 205, 19, 29, 7, 122, 111, 237, 85, 47, 1, 143, 42, 41,
+			246, 214, 222, 67, 119, 179, 63, 208, 172, 132, 164, 221, 189, 238,
+			161, 202, 191, 182, 118, 118, 95, 7, 151, 189, 144, 206, 171, 155,
+			179, 207, 209, 121, 194, 130, 125, 145, 16, 107, 172, 217, 121, 39,
+			244, 183, 182, 151, 164, 57, 51, 20, 49, 85, 183, 96, 117, 128,
+			106, 98, 66, 61, 212, 39, 115, 74, 104, 19, 146, 214, 236, 35,
+			132, 114, 90, 234, 75, 218, 100, 28, 85, 50, 249, 157, 121, 10,
+			65, 38, 191, 51, 79, 33, 200, 228, 119, 246, 170, 151, 140, 71,
+			149, 76, 126, 103, 239, 193, 10, 251, 199, 253, 236, 204, 122, 84,
+			107, 108, 196, 209, 102, 208, 217, 172, 69, 241, 250, 153, 86, 167,
+			17, 156, 193, 126, 18, 133, 103, 86, 95, 123, 166, 189, 122, 70,
+			29, 223, 75, 198, 226, 165, 213, 215, 38, 141, 13, 127, 211, 171,
+			208, 109, 254, 150, 127, 70, 231, 173, 63, 147, 133, 85, 200, 186,
+			213, 113, 54, 64, 110, 0, 21, 240, 192, 15, 176, 222, 213, 215,
+			146, 3, 99, 204, 18, 214, 68, 95, 189, 184, 250, 218, 229, 237,
+			182, 127, 225, 219, 89, 175, 106, 133, 31, 174, 237, 76, 135, 159,
+			71, 49, 246, 241, 143, 186, 194, 154, 232, 63, 191, 191, 166, 251,
+			209, 245, 123, 93, 227, 185, 120, 252, 149, 199, 158, 119, 104, 143,
+			191, 171, 143, 21, 185, 59, 212, 51, 110, 177, 175, 187, 204, 26,
+			224, 206, 80, 15, 175, 60, 132, 244, 42, 50, 97, 107, 206, 253,
+			26, 36, 185, 68, 254, 129, 74, 239, 113, 158, 222, 169, 247, 110,
+			203, 91, 83, 236, 252, 47, 187, 2, 65, 32, 49, 222, 146, 164,
+			43, 144, 196, 13, 87, 110, 204, 46, 136, 25, 186, 136, 13, 41,
+			46, 174, 4, 13, 63, 76, 232, 149, 196, 166, 178, 172, 102, 218,
+			216, 201, 245, 47, 83, 226, 21, 50, 235, 140, 56, 95, 59, 43,
+			38, 80, 161, 170, 126, 170, 78, 62, 204, 144, 31, 209, 60, 182,
+			156, 165, 162, 196, 177, 170, 124, 154, 6, 28, 76, 134, 95, 128,
+			152, 183, 236, 249, 22, 133, 163, 198, 40, 159, 37, 48, 68, 171,
+			244, 220, 16, 236, 196, 182, 190, 23, 173, 171, 9, 47, 101, 202,
+			115, 133, 151, 215, 46, 156, 57, 179, 181, 181, 85, 243, 168, 167,
+			146, 87, 100, 189, 228, 204, 149, 133, 217, 249, 107, 75, 243, 211,
+			231, 107, 103, 25, 19, 55, 66, 186, 57, 106, 110, 149, 174, 170,
+			84, 2, 13, 164, 114, 17, 45, 111, 11, 74, 160, 183, 30, 171,
+			188, 90, 8, 51, 144, 137, 89, 240, 82, 229, 90, 186, 69, 150,
+			7, 94, 48, 143, 131, 213, 78, 218, 69, 38, 221, 179, 32, 233,
+			170, 128, 11, 157, 161, 168, 206, 44, 137, 133, 165, 170, 184, 56,
+			179, 180, 176, 52, 197, 196, 19, 11, 203, 143, 45, 222, 88, 22,
+			79, 204, 212, 235, 51, 215, 150, 23, 230, 151, 196, 98, 93, 204,
+			46, 94, 155, 91, 192, 59, 255, 75, 98, 241, 146, 152, 185, 246,
+			164, 120, 249, 194, 181, 185, 41, 125, 139, 214, 191, 13, 227, 25,
+			250, 128, 10, 153, 107, 230, 210, 171, 234, 230, 77, 208, 182, 126,
+			73, 193, 36, 31, 91, 135, 195, 153, 88, 135, 210, 98, 36, 234,
+			145, 200, 176, 201, 4, 37, 136, 81, 206, 223, 93, 35, 170, 49,
+			86, 98, 150, 205, 157, 225, 158, 97, 214, 199, 108, 167, 135, 59,
+			35, 61, 167, 80, 88, 226, 206, 93, 61, 47, 65, 97, 169, 95,
+			254, 201, 152, 237, 246, 112, 247, 64, 79, 197, 98, 140, 57, 8,
+			20, 116, 14, 148, 70, 217, 43, 152, 139, 32, 64, 238, 28, 180,
+			143, 84, 22, 196, 197, 96, 253, 219, 59, 8, 96, 85, 9, 151,
+			224, 56, 84, 89, 154, 228, 0, 204, 53, 202, 29, 209, 136, 213,
+			245, 14, 101, 65, 168, 66, 149, 161, 184, 178, 2, 240, 186, 64,
+			108, 160, 2, 119, 14, 246, 15, 107, 200, 226, 206, 65, 126, 80,
+			67, 14, 119, 14, 222, 125, 152, 125, 193, 98, 184, 95, 115, 178,
+			103, 220, 170, 252, 55, 75, 173, 42, 237, 168, 163, 78, 169, 23,
+			171, 212, 162, 213, 174, 243, 40, 34, 35, 88, 175, 240, 78, 187,
+			233, 165, 148, 43, 68, 220, 128, 11, 244, 130, 228, 74, 245, 190,
+			74, 245, 27, 148, 96, 85, 169, 70, 107, 151, 168, 62, 191, 22,
+			176, 227, 30, 184, 79, 164, 193, 166, 159, 164, 222, 102, 27, 70,
+			162, 248, 206, 9, 35, 102, 20, 146, 201, 154, 18, 97, 226, 17,
+			81, 93, 94, 184, 58, 191, 180, 60, 115, 245, 122, 245, 85, 100,
+			69, 136, 215, 49, 204, 83, 111, 15, 119, 238, 177, 39, 48, 55,
+			189, 61, 54, 119, 78, 246, 30, 151, 127, 187, 40, 103, 242, 239,
+			34, 119, 238, 233, 63, 32, 255, 182, 184, 115, 207, 216, 81, 249,
+			183, 195, 157, 123, 170, 227, 236, 79, 239, 102, 15, 236, 61, 178,
+			160, 221, 60, 227, 181, 213, 31, 183, 206, 157, 145, 87, 85, 87,
+			90, 209, 186, 18, 209, 46, 126, 169, 124, 163, 146, 189, 250, 89,
+			151, 13, 206, 16, 174, 43, 209, 58, 229, 243, 227, 251, 89, 81,
+			6, 172, 106, 121, 45, 33, 62, 205, 250, 12, 157, 198, 108, 97,
+			77, 56, 23, 135, 62, 253, 171, 39, 250, 89, 159, 161, 72, 61,
+			171, 193, 199, 88, 175, 74, 249, 60, 230, 16, 30, 13, 242, 10,
+			43, 129, 247, 32, 165, 198, 32, 216, 251, 234, 6, 198, 87, 42,
+			247, 214, 88, 129, 126, 210, 32, 231, 204, 77, 189, 245, 100, 172,
+			40, 156, 137, 190, 58, 253, 13, 76, 58, 159, 247, 88, 47, 149,
+			27, 152, 143, 178, 194, 90, 11, 31, 148, 232, 7, 9, 240, 227,
+			108, 31, 78, 141, 87, 164, 254, 145, 110, 143, 245, 81, 43, 3,
+			40, 92, 80, 101, 168, 212, 246, 253, 56, 171, 196, 100, 37, 20,
+			154, 74, 7, 88, 175, 172, 212, 30, 235, 167, 159, 139, 0, 23,
+			218, 252, 48, 99, 240, 18, 174, 120, 235, 126, 152, 142, 13, 208,
+			111, 125, 40, 153, 65, 1, 31, 103, 67, 8, 251, 14, 26, 254,
+			138, 30, 233, 62, 170, 51, 168, 138, 213, 46, 0, 60, 237, 56,
+			194, 212, 172, 4, 205, 177, 65, 170, 211, 167, 74, 22, 154, 248,
+			25, 194, 214, 79, 82, 252, 60, 36, 127, 86, 37, 11, 77, 126,
+			132, 245, 35, 11, 200, 74, 115, 117, 37, 246, 111, 141, 149, 49,
+			95, 245, 62, 20, 205, 173, 214, 253, 91, 24, 163, 12, 73, 73,
+			252, 21, 104, 58, 99, 195, 132, 97, 64, 23, 66, 53, 230, 199,
+			152, 129, 87, 252, 56, 30, 227, 84, 167, 95, 151, 205, 199, 49,
+			159, 98, 220, 84, 193, 228, 175, 116, 18, 191, 49, 54, 66, 205,
+			149, 245, 47, 203, 193, 166, 127, 35, 241, 27, 23, 79, 191, 114,
+			242, 5, 241, 247, 195, 94, 59, 120, 252, 63, 236, 199, 70, 61,
+			216, 115, 159, 197, 254, 15, 185, 81, 15, 246, 240, 191, 219, 109,
+			255, 110, 183, 253, 91, 238, 182, 229, 158, 125, 106, 139, 229, 61,
+			47, 211, 91, 172, 250, 19, 91, 240, 104, 207, 67, 236, 175, 109,
+			185, 221, 30, 238, 185, 207, 170, 124, 214, 22, 221, 50, 82, 159,
+			121, 195, 191, 76, 122, 49, 69, 252, 191, 70, 10, 229, 215, 100,
+			123, 112, 74, 202, 15, 105, 98, 242, 55, 209, 138, 214, 147, 124,
+			158, 33, 79, 172, 250, 56, 153, 90, 91, 139, 112, 204, 18, 173,
+			155, 39, 173, 117, 86, 184, 32, 156, 222, 244, 55, 35, 100, 69,
+			81, 247, 46, 196, 18, 142, 243, 145, 164, 22, 38, 205, 166, 183,
+			13, 95, 180, 104, 198, 17, 121, 89, 3, 28, 135, 41, 49, 97,
+			146, 102, 40, 95, 53, 188, 106, 173, 14, 149, 128, 182, 242, 205,
+			92, 116, 231, 146, 81, 22, 146, 92, 230, 18, 153, 46, 93, 143,
+			192, 140, 106, 41, 141, 98, 76, 196, 19, 49, 94, 145, 155, 185,
+			190, 48, 37, 18, 223, 103, 230, 165, 222, 70, 43, 234, 52, 181,
+			70, 223, 136, 54, 207, 172, 6, 235, 175, 197, 151, 242, 113, 94,
+			112, 162, 63, 237, 181, 131, 227, 144, 219, 180, 173, 174, 52, 162,
+			80, 9, 195, 132, 25, 237, 230, 112, 105, 63, 155, 209, 218, 205,
+			17, 123, 180, 122, 31, 77, 39, 238, 104, 232, 59, 61, 228, 184,
+			174, 146, 248, 168, 213, 253, 118, 148, 4, 105, 20, 111, 159, 185,
+			18, 36, 233, 117, 122, 201, 163, 154, 87, 93, 142, 216, 37, 13,
+			89, 220, 57, 210, 55, 164, 33, 135, 59, 71, 248, 8, 27, 167,
+			198, 44, 238, 28, 181, 103, 170, 21, 177, 25, 32, 2, 198, 111,
+			80, 216, 94, 18, 64, 169, 246, 219, 81, 99, 67, 163, 180, 10,
+			168, 217, 171, 33, 124, 87, 210, 186, 145, 229, 112, 231, 232, 232,
+			93, 26, 42, 113, 231, 232, 254, 151, 177, 50, 235, 35, 232, 227,
+			31, 117, 81, 251, 192, 75, 217, 75, 168, 73, 155, 59, 194, 190,
+			171, 122, 90, 204, 46, 92, 159, 211, 47, 37, 96, 125, 232, 63,
+			213, 179, 36, 120, 42, 154, 222, 29, 159, 212, 125, 176, 11, 248,
+			84, 15, 203, 182, 184, 35, 250, 202, 26, 114, 184, 35, 70, 70,
+			217, 41, 106, 195, 225, 206, 49, 123, 127, 245, 176, 108, 67, 111,
+			184, 98, 97, 110, 15, 172, 78, 1, 149, 53, 86, 199, 226, 206,
+			177, 62, 61, 50, 7, 136, 70, 239, 98, 53, 194, 234, 114, 167,
+			106, 223, 85, 61, 214, 221, 115, 53, 151, 123, 96, 118, 11, 248,
+			64, 99, 6, 21, 170, 166, 191, 174, 195, 157, 234, 200, 40, 155,
+			34, 204, 5, 238, 156, 176, 15, 85, 143, 42, 167, 30, 237, 107,
+			176, 29, 188, 245, 100, 15, 188, 5, 23, 213, 13, 132, 143, 141,
+			158, 90, 176, 184, 115, 130, 239, 215, 144, 195, 157, 19, 7, 43,
+			236, 126, 106, 165, 200, 157, 147, 246, 209, 234, 196, 142, 86, 180,
+			22, 33, 115, 9, 239, 110, 14, 225, 228, 39, 77, 115, 197, 2,
+			119, 78, 154, 230, 138, 22, 119, 78, 242, 138, 134, 28, 238, 156,
+			60, 124, 132, 61, 72, 205, 245, 66, 23, 188, 187, 122, 202, 36,
+			121, 132, 82, 188, 26, 69, 45, 68, 222, 144, 134, 162, 253, 16,
+			170, 47, 186, 137, 94, 165, 69, 42, 168, 0, 61, 82, 55, 216,
+			107, 113, 231, 30, 126, 64, 67, 208, 37, 43, 135, 216, 121, 106,
+			176, 196, 157, 9, 251, 80, 245, 164, 242, 89, 164, 219, 176, 4,
+			154, 234, 57, 235, 141, 40, 198, 93, 77, 52, 7, 253, 71, 99,
+			47, 21, 240, 145, 158, 163, 146, 197, 157, 137, 62, 77, 189, 146,
+			195, 157, 137, 131, 21, 246, 0, 97, 239, 227, 206, 164, 125, 119,
+			117, 50, 195, 78, 135, 241, 74, 145, 33, 231, 137, 216, 244, 212,
+			91, 186, 184, 50, 170, 176, 244, 21, 240, 161, 110, 161, 207, 226,
+			206, 164, 105, 161, 207, 225, 206, 228, 193, 67, 106, 49, 50, 238,
+			156, 178, 247, 87, 43, 98, 225, 58, 146, 13, 202, 125, 99, 205,
+			116, 217, 143, 53, 74, 86, 64, 77, 141, 146, 89, 220, 57, 101,
+			24, 139, 57, 220, 57, 53, 114, 23, 59, 77, 40, 251, 185, 115,
+			218, 62, 88, 61, 146, 69, 195, 87, 111, 36, 126, 60, 77, 234,
+			89, 85, 32, 183, 89, 134, 182, 191, 128, 218, 26, 109, 191, 197,
+			157, 211, 125, 35, 10, 109, 191, 195, 157, 211, 251, 199, 216, 195,
+			132, 118, 128, 59, 83, 246, 145, 106, 77, 92, 158, 153, 199, 102,
+			76, 78, 102, 189, 16, 136, 18, 27, 94, 216, 108, 249, 205, 189,
+			166, 119, 160, 128, 175, 117, 51, 3, 22, 119, 166, 250, 198, 84,
+			51, 3, 14, 119, 166, 14, 29, 102, 15, 81, 51, 251, 184, 51,
+			109, 31, 172, 78, 41, 146, 175, 5, 190, 73, 243, 216, 136, 194,
+			6, 174, 134, 208, 147, 23, 240, 28, 169, 93, 64, 55, 178, 175,
+			128, 111, 117, 35, 251, 44, 238, 76, 155, 177, 236, 115, 184, 51,
+			189, 127, 76, 173, 189, 65, 238, 212, 236, 131, 213, 163, 98, 22,
+			162, 92, 44, 199, 136, 179, 89, 152, 211, 13, 237, 232, 252, 96,
+			1, 213, 53, 222, 65, 139, 59, 53, 131, 119, 208, 225, 78, 109,
+			255, 152, 234, 252, 16, 119, 206, 80, 231, 161, 169, 205, 93, 20,
+			177, 127, 75, 230, 221, 124, 126, 166, 28, 42, 224, 91, 45, 108,
+			135, 44, 238, 156, 41, 233, 70, 134, 28, 238, 156, 217, 63, 166,
+			214, 88, 153, 59, 231, 104, 141, 53, 188, 48, 10, 113, 220, 47,
+			214, 177, 103, 104, 101, 84, 122, 191, 229, 153, 103, 117, 241, 229,
+			85, 179, 168, 203, 5, 124, 169, 199, 81, 182, 184, 115, 206, 112,
+			101, 217, 225, 206, 185, 131, 135, 216, 89, 106, 98, 152, 59, 231,
+			237, 67, 213, 227, 138, 26, 10, 175, 31, 199, 81, 150, 243, 12,
+			251, 112, 184, 173, 113, 15, 23, 240, 137, 198, 61, 108, 113, 231,
+			124, 159, 222, 29, 134, 29, 238, 156, 31, 171, 176, 71, 9, 55,
+			231, 206, 189, 182, 168, 158, 35, 163, 84, 36, 109, 184, 189, 137,
+			115, 212, 109, 77, 77, 125, 8, 138, 252, 14, 165, 91, 226, 5,
+			32, 208, 132, 226, 22, 119, 238, 45, 105, 97, 196, 29, 238, 220,
+			123, 248, 232, 106, 145, 108, 198, 123, 217, 199, 95, 206, 206, 188,
+			32, 125, 252, 76, 195, 83, 22, 163, 52, 52, 171, 79, 176, 190,
+			69, 242, 219, 214, 253, 53, 126, 154, 245, 109, 120, 201, 198, 138,
+			215, 90, 143, 200, 104, 28, 60, 63, 88, 67, 181, 218, 99, 94,
+			178, 49, 211, 90, 143, 234, 165, 13, 245, 23, 236, 150, 13, 255,
+			246, 74, 51, 64, 242, 66, 178, 35, 251, 234, 125, 27, 254, 237,
+			57, 42, 168, 222, 100, 35, 151, 253, 84, 226, 190, 81, 191, 82,
+			151, 131, 229, 227, 172, 40, 253, 196, 132, 191, 255, 252, 144, 196,
+			111, 250, 80, 87, 63, 243, 211, 108, 184, 25, 109, 133, 173, 200,
+			107, 174, 192, 123, 142, 136, 34, 213, 74, 89, 255, 112, 73, 149,
+			87, 79, 233, 81, 220, 168, 95, 65, 199, 100, 188, 223, 74, 39,
+			110, 41, 219, 183, 79, 150, 220, 136, 91, 213, 167, 24, 191, 8,
+			63, 236, 141, 54, 80, 124, 19, 253, 202, 209, 200, 126, 110, 26,
+			85, 59, 108, 228, 82, 16, 6, 201, 70, 119, 99, 53, 54, 210,
+			161, 130, 149, 168, 173, 94, 251, 128, 237, 103, 145, 237, 55, 44,
+			127, 90, 212, 191, 44, 52, 121, 141, 49, 122, 179, 100, 5, 45,
+			143, 217, 123, 119, 176, 143, 170, 96, 162, 170, 243, 108, 100, 22,
+			74, 65, 235, 111, 213, 108, 245, 183, 45, 54, 116, 163, 187, 20,
+			150, 228, 30, 31, 247, 155, 50, 105, 208, 170, 102, 64, 127, 197,
+			24, 178, 228, 70, 220, 226, 167, 88, 17, 207, 228, 118, 18, 114,
+			39, 12, 158, 231, 114, 32, 178, 161, 37, 250, 165, 174, 106, 228,
+			102, 197, 125, 238, 89, 57, 206, 246, 209, 170, 93, 81, 171, 86,
+			57, 29, 6, 168, 80, 189, 99, 115, 234, 50, 43, 233, 57, 226,
+			7, 217, 93, 143, 205, 44, 61, 182, 50, 115, 229, 242, 226, 202,
+			141, 107, 75, 215, 231, 103, 23, 46, 45, 204, 207, 149, 123, 120,
+			137, 185, 75, 143, 205, 156, 43, 91, 156, 177, 226, 210, 99, 51,
+			231, 239, 127, 160, 108, 227, 239, 139, 87, 102, 94, 62, 127, 111,
+			217, 57, 213, 97, 3, 249, 238, 242, 195, 236, 224, 141, 235, 87,
+			22, 103, 230, 86, 150, 150, 103, 150, 111, 44, 237, 64, 184, 143,
+			245, 201, 159, 23, 174, 93, 46, 91, 124, 31, 235, 123, 197, 124,
+			125, 225, 210, 147, 0, 109, 128, 215, 111, 92, 188, 178, 176, 244,
+			216, 252, 92, 217, 225, 253, 172, 119, 190, 94, 95, 172, 207, 207,
+			149, 93, 62, 192, 74, 179, 51, 215, 102, 231, 175, 204, 207, 149,
+			11, 231, 223, 100, 179, 94, 165, 179, 243, 11, 108, 32, 191, 188,
+			248, 65, 73, 197, 61, 150, 92, 165, 139, 104, 88, 32, 143, 178,
+			254, 220, 10, 224, 99, 242, 247, 221, 139, 162, 114, 87, 126, 106,
+			50, 30, 120, 25, 27, 200, 115, 181, 110, 123, 15, 78, 127, 14,
+			12, 121, 6, 213, 24, 246, 96, 218, 59, 96, 248, 198, 28, 16,
+			63, 49, 195, 122, 121, 97, 176, 231, 191, 88, 207, 229, 129, 56,
+			247, 224, 223, 121, 32, 254, 206, 3, 241, 173, 241, 64, 252, 181,
+			195, 236, 98, 15, 119, 143, 246, 188, 210, 170, 124, 214, 17, 243,
+			183, 219, 148, 107, 84, 133, 113, 77, 43, 21, 152, 220, 10, 137,
+			50, 195, 73, 183, 84, 119, 218, 209, 95, 109, 132, 233, 248, 1,
+			122, 106, 171, 203, 60, 211, 198, 95, 34, 38, 100, 126, 100, 209,
+			222, 216, 78, 72, 83, 162, 143, 38, 117, 122, 138, 160, 105, 158,
+			225, 69, 78, 77, 213, 226, 148, 14, 189, 55, 8, 99, 99, 122,
+			11, 178, 154, 228, 171, 17, 148, 162, 151, 110, 125, 153, 128, 7,
+			80, 99, 83, 191, 160, 21, 196, 66, 106, 1, 240, 64, 92, 141,
+			146, 84, 179, 166, 118, 48, 104, 59, 98, 230, 250, 130, 185, 25,
+			164, 211, 99, 180, 182, 245, 125, 114, 173, 237, 18, 253, 187, 47,
+			14, 100, 174, 2, 25, 61, 54, 51, 123, 69, 167, 167, 135, 5,
+			212, 220, 12, 66, 29, 103, 40, 215, 20, 86, 99, 180, 198, 68,
+			94, 38, 169, 27, 17, 9, 114, 16, 71, 91, 42, 40, 75, 229,
+			210, 108, 6, 200, 197, 41, 251, 210, 80, 183, 250, 41, 16, 33,
+			244, 90, 193, 211, 62, 19, 114, 255, 18, 102, 159, 83, 113, 176,
+			126, 115, 71, 239, 145, 230, 151, 57, 69, 184, 61, 142, 150, 134,
+			216, 63, 176, 152, 91, 164, 83, 157, 227, 246, 183, 85, 182, 196,
+			117, 121, 249, 92, 6, 52, 66, 35, 17, 55, 234, 87, 100, 199,
+			144, 68, 64, 167, 246, 68, 211, 126, 138, 8, 205, 80, 93, 114,
+			7, 105, 235, 116, 193, 61, 17, 215, 22, 151, 87, 46, 45, 222,
+			184, 54, 135, 62, 164, 157, 68, 221, 28, 36, 154, 199, 72, 61,
+			18, 70, 242, 73, 28, 243, 233, 0, 43, 160, 23, 22, 119, 142,
+			23, 185, 134, 108, 238, 28, 31, 185, 71, 67, 14, 119, 142, 159,
+			187, 192, 126, 189, 68, 29, 182, 184, 115, 193, 126, 89, 229, 231,
+			75, 66, 38, 16, 198, 161, 185, 23, 238, 162, 2, 122, 181, 168,
+			216, 65, 255, 104, 146, 133, 235, 80, 13, 73, 79, 76, 188, 210,
+			176, 201, 21, 133, 85, 41, 191, 80, 250, 48, 83, 221, 213, 135,
+			243, 41, 82, 93, 196, 200, 34, 108, 34, 196, 39, 204, 131, 128,
+			183, 2, 79, 140, 103, 90, 198, 184, 10, 104, 53, 199, 245, 234,
+			9, 79, 47, 223, 107, 80, 58, 8, 85, 130, 22, 195, 155, 117,
+			63, 233, 108, 210, 58, 84, 92, 162, 147, 111, 79, 98, 117, 32,
+			146, 8, 28, 164, 117, 118, 133, 138, 56, 205, 163, 52, 203, 232,
+			110, 158, 203, 244, 211, 4, 20, 80, 214, 242, 58, 33, 238, 141,
+			170, 108, 52, 89, 122, 86, 181, 64, 228, 136, 199, 41, 130, 123,
+			67, 167, 201, 193, 106, 65, 118, 139, 128, 94, 7, 17, 11, 202,
+			40, 148, 84, 132, 251, 34, 161, 2, 249, 73, 30, 15, 238, 177,
+			32, 10, 154, 226, 27, 84, 79, 85, 146, 152, 41, 122, 43, 24,
+			44, 166, 72, 136, 186, 68, 68, 213, 5, 253, 22, 196, 130, 178,
+			113, 27, 94, 146, 209, 8, 123, 79, 236, 183, 91, 219, 130, 246,
+			74, 38, 188, 45, 111, 91, 201, 171, 140, 207, 76, 66, 26, 255,
+			118, 128, 116, 230, 171, 248, 40, 69, 174, 131, 117, 49, 115, 165,
+			62, 63, 51, 247, 228, 202, 252, 119, 44, 44, 45, 47, 41, 190,
+			197, 125, 218, 189, 198, 168, 239, 226, 96, 172, 217, 80, 241, 72,
+			204, 68, 219, 143, 55, 240, 176, 82, 176, 131, 126, 171, 81, 19,
+			175, 124, 228, 223, 153, 86, 212, 92, 107, 109, 79, 98, 244, 234,
+			61, 40, 144, 128, 222, 149, 217, 131, 155, 49, 225, 232, 90, 156,
+			50, 149, 126, 87, 79, 59, 132, 161, 122, 78, 85, 137, 132, 29,
+			12, 145, 151, 95, 52, 243, 13, 175, 133, 107, 193, 169, 10, 104,
+			215, 233, 181, 165, 153, 137, 251, 114, 225, 250, 30, 51, 8, 90,
+			204, 132, 98, 135, 178, 163, 146, 115, 107, 89, 147, 101, 206, 81,
+			113, 104, 137, 14, 169, 166, 25, 89, 143, 189, 48, 101, 74, 162,
+			233, 254, 41, 175, 117, 26, 225, 30, 4, 93, 200, 196, 13, 73,
+			156, 19, 19, 51, 109, 226, 240, 158, 136, 164, 23, 80, 46, 248,
+			26, 57, 39, 18, 31, 30, 9, 153, 214, 112, 103, 239, 176, 146,
+			233, 158, 20, 238, 59, 27, 97, 99, 65, 132, 20, 135, 53, 100,
+			115, 231, 2, 63, 161, 33, 135, 59, 23, 206, 60, 202, 62, 92,
+			32, 97, 99, 115, 231, 229, 246, 197, 202, 251, 10, 106, 33, 233,
+			253, 79, 5, 248, 236, 156, 166, 41, 69, 15, 218, 210, 210, 132,
+			94, 37, 148, 12, 5, 242, 233, 156, 44, 216, 38, 252, 219, 109,
+			29, 200, 173, 214, 241, 157, 87, 191, 94, 246, 50, 92, 217, 68,
+			133, 235, 76, 234, 249, 37, 94, 19, 151, 188, 160, 213, 193, 101,
+			165, 72, 52, 35, 144, 78, 205, 185, 126, 215, 67, 118, 153, 229,
+			88, 139, 94, 195, 105, 138, 14, 61, 124, 70, 10, 62, 56, 45,
+			245, 205, 25, 2, 26, 130, 140, 204, 165, 27, 2, 59, 202, 247,
+			13, 178, 129, 96, 134, 245, 6, 149, 11, 115, 143, 168, 151, 12,
+			83, 40, 151, 198, 182, 185, 15, 177, 229, 5, 105, 166, 3, 73,
+			217, 146, 70, 200, 111, 20, 172, 109, 239, 146, 36, 178, 231, 136,
+			69, 127, 174, 125, 71, 45, 63, 35, 142, 119, 78, 145, 89, 194,
+			36, 10, 128, 99, 30, 134, 24, 118, 0, 172, 9, 115, 197, 64,
+			183, 38, 213, 97, 90, 196, 152, 55, 156, 115, 119, 224, 241, 161,
+			170, 42, 217, 214, 56, 232, 213, 73, 198, 85, 148, 124, 119, 135,
+			179, 166, 167, 112, 83, 64, 238, 72, 219, 230, 205, 79, 179, 189,
+			119, 181, 15, 121, 1, 205, 2, 164, 151, 193, 157, 177, 122, 123,
+			40, 218, 19, 181, 186, 19, 109, 88, 28, 94, 250, 151, 155, 253,
+			148, 216, 216, 236, 167, 182, 195, 157, 151, 159, 123, 25, 251, 178,
+			67, 44, 238, 112, 231, 73, 251, 98, 229, 79, 28, 145, 183, 113,
+			144, 251, 39, 78, 159, 155, 219, 65, 188, 133, 84, 32, 42, 153,
+			82, 28, 165, 145, 208, 38, 161, 228, 32, 108, 252, 36, 248, 177,
+			239, 10, 99, 104, 170, 31, 193, 67, 13, 120, 165, 19, 209, 105,
+			67, 22, 109, 231, 54, 214, 117, 47, 94, 165, 60, 68, 122, 134,
+			83, 117, 35, 88, 196, 126, 3, 146, 88, 226, 136, 214, 238, 192,
+			212, 19, 38, 195, 72, 144, 170, 203, 192, 136, 110, 19, 115, 234,
+			34, 234, 6, 86, 142, 22, 213, 230, 171, 32, 49, 59, 69, 131,
+			136, 1, 85, 46, 22, 107, 94, 208, 242, 155, 57, 126, 163, 235,
+			93, 223, 60, 207, 9, 33, 46, 205, 44, 92, 153, 159, 91, 185,
+			94, 159, 55, 118, 132, 70, 179, 235, 107, 44, 189, 80, 24, 75,
+			28, 61, 50, 86, 186, 178, 171, 36, 53, 228, 141, 120, 41, 78,
+			100, 207, 87, 125, 140, 83, 109, 12, 126, 83, 132, 209, 214, 164,
+			97, 18, 199, 226, 206, 147, 134, 73, 28, 155, 59, 79, 26, 38,
+			193, 17, 204, 147, 231, 94, 198, 190, 207, 102, 118, 161, 135, 187,
+			205, 158, 13, 171, 242, 126, 75, 44, 153, 80, 53, 44, 77, 32,
+			135, 183, 41, 14, 210, 141, 77, 165, 61, 43, 165, 243, 57, 204,
+			9, 16, 242, 74, 144, 250, 120, 174, 9, 62, 50, 117, 237, 2,
+			58, 85, 234, 133, 233, 174, 101, 162, 245, 78, 188, 115, 64, 90,
+			255, 14, 81, 137, 156, 254, 233, 70, 150, 214, 142, 34, 230, 22,
+			207, 124, 75, 255, 35, 221, 185, 0, 221, 185, 89, 216, 199, 250,
+			153, 91, 32, 213, 217, 183, 239, 6, 193, 0, 88, 220, 241, 237,
+			3, 26, 178, 185, 227, 87, 14, 169, 138, 22, 119, 214, 76, 69,
+			236, 62, 107, 118, 81, 67, 54, 119, 214, 76, 69, 155, 59, 235,
+			166, 34, 214, 240, 186, 60, 78, 4, 132, 223, 42, 135, 216, 227,
+			242, 208, 184, 213, 19, 89, 149, 71, 197, 76, 22, 232, 173, 130,
+			173, 181, 190, 21, 190, 128, 89, 208, 167, 160, 173, 210, 48, 123,
+			169, 62, 5, 221, 180, 15, 86, 207, 67, 55, 53, 19, 171, 30,
+			236, 49, 162, 145, 132, 33, 61, 177, 153, 154, 151, 231, 181, 107,
+			184, 167, 200, 157, 77, 115, 136, 4, 228, 155, 253, 163, 26, 114,
+			184, 179, 121, 96, 76, 29, 184, 90, 220, 9, 237, 131, 234, 192,
+			53, 84, 49, 211, 173, 104, 203, 143, 73, 193, 219, 240, 111, 171,
+			91, 46, 234, 8, 118, 220, 91, 109, 52, 253, 181, 90, 173, 54,
+			174, 209, 227, 116, 52, 52, 30, 111, 16, 54, 52, 167, 2, 56,
+			29, 13, 15, 140, 81, 76, 155, 197, 221, 184, 231, 105, 25, 211,
+			134, 90, 113, 233, 16, 155, 101, 46, 189, 209, 228, 164, 246, 129,
+			202, 3, 59, 9, 153, 233, 61, 121, 237, 207, 104, 176, 100, 1,
+			169, 0, 54, 11, 17, 234, 78, 106, 247, 107, 200, 226, 78, 58,
+			192, 53, 228, 112, 39, 189, 107, 63, 251, 69, 36, 75, 178, 48,
+			165, 219, 246, 209, 202, 79, 89, 208, 157, 213, 109, 186, 41, 165,
+			123, 41, 69, 10, 182, 128, 190, 242, 65, 250, 182, 210, 233, 103,
+			213, 84, 206, 5, 9, 89, 194, 216, 186, 213, 65, 146, 50, 31,
+			229, 189, 37, 245, 200, 87, 112, 139, 52, 66, 233, 133, 174, 145,
+			156, 246, 110, 226, 152, 62, 142, 182, 18, 220, 200, 74, 116, 106,
+			3, 218, 224, 140, 35, 129, 169, 79, 233, 51, 61, 66, 16, 122,
+			91, 17, 218, 178, 65, 194, 237, 190, 138, 134, 28, 238, 108, 31,
+			62, 194, 250, 153, 237, 218, 188, 240, 186, 158, 55, 90, 146, 210,
+			24, 236, 235, 74, 195, 236, 23, 145, 118, 204, 182, 123, 184, 251,
+			6, 203, 30, 171, 252, 36, 50, 194, 41, 11, 19, 105, 17, 151,
+			164, 157, 25, 237, 214, 154, 33, 39, 46, 129, 210, 234, 182, 142,
+			150, 162, 202, 33, 68, 199, 139, 136, 148, 82, 113, 245, 240, 100,
+			4, 240, 7, 200, 123, 133, 116, 63, 161, 19, 42, 215, 10, 212,
+			134, 100, 35, 138, 83, 225, 109, 70, 157, 80, 250, 1, 2, 69,
+			153, 221, 106, 12, 157, 251, 41, 53, 6, 154, 19, 148, 151, 205,
+			77, 191, 9, 115, 19, 226, 133, 110, 148, 82, 199, 3, 18, 236,
+			235, 29, 15, 186, 173, 242, 68, 153, 103, 203, 187, 210, 145, 72,
+			225, 148, 57, 46, 244, 99, 8, 76, 120, 41, 54, 62, 82, 137,
+			144, 227, 10, 205, 169, 219, 16, 54, 165, 186, 124, 131, 101, 151,
+			52, 136, 188, 241, 86, 223, 136, 6, 29, 128, 251, 15, 176, 87,
+			49, 187, 96, 241, 226, 63, 180, 122, 222, 110, 89, 223, 122, 225,
+			215, 207, 156, 2, 194, 249, 255, 161, 85, 128, 222, 236, 22, 176,
+			116, 220, 183, 88, 54, 221, 195, 0, 132, 251, 5, 150, 125, 72,
+			131, 54, 192, 35, 71, 113, 132, 95, 0, 219, 187, 207, 88, 118,
+			185, 42, 140, 201, 2, 213, 81, 110, 78, 70, 209, 10, 163, 45,
+			166, 62, 71, 83, 207, 88, 118, 191, 6, 109, 128, 131, 67, 56,
+			125, 43, 208, 3, 102, 255, 8, 216, 38, 187, 89, 6, 27, 82,
+			134, 86, 191, 108, 146, 71, 139, 126, 252, 163, 12, 173, 68, 52,
+			56, 132, 8, 137, 130, 101, 59, 220, 125, 43, 208, 158, 202, 161,
+			133, 33, 164, 222, 161, 233, 172, 182, 96, 0, 52, 181, 104, 157,
+			157, 89, 210, 120, 17, 9, 255, 214, 12, 175, 131, 107, 13, 214,
+			224, 16, 100, 106, 193, 194, 85, 131, 183, 1, 239, 57, 149, 154,
+			132, 50, 164, 174, 121, 169, 215, 18, 116, 6, 96, 242, 155, 1,
+			171, 218, 170, 137, 163, 53, 122, 92, 84, 122, 155, 101, 247, 105,
+			16, 15, 175, 2, 253, 195, 132, 190, 192, 221, 31, 4, 250, 233,
+			29, 186, 204, 150, 151, 100, 74, 12, 236, 233, 188, 122, 167, 81,
+			23, 44, 250, 218, 128, 54, 192, 65, 4, 173, 224, 54, 84, 241,
+			89, 171, 231, 103, 44, 139, 242, 87, 97, 140, 207, 90, 165, 10,
+			123, 189, 203, 92, 215, 193, 244, 255, 152, 101, 31, 168, 252, 133,
+			243, 13, 202, 206, 118, 199, 236, 78, 106, 39, 154, 18, 58, 248,
+			65, 25, 220, 119, 182, 220, 167, 40, 125, 42, 45, 75, 101, 53,
+			145, 108, 188, 131, 13, 79, 46, 1, 242, 8, 24, 75, 62, 11,
+			77, 206, 158, 139, 154, 64, 84, 187, 151, 248, 249, 238, 222, 209,
+			208, 71, 170, 198, 92, 189, 171, 55, 150, 150, 41, 126, 191, 181,
+			45, 204, 225, 155, 108, 98, 10, 196, 104, 249, 105, 151, 5, 78,
+			248, 160, 155, 178, 221, 10, 84, 206, 202, 213, 177, 212, 218, 86,
+			71, 55, 187, 24, 93, 139, 32, 99, 204, 55, 169, 56, 119, 89,
+			80, 219, 229, 94, 227, 166, 158, 21, 213, 103, 47, 209, 249, 55,
+			216, 46, 139, 121, 98, 92, 53, 35, 199, 48, 9, 187, 62, 111,
+			96, 146, 104, 84, 194, 201, 193, 206, 231, 254, 152, 98, 124, 240,
+			132, 5, 166, 24, 224, 26, 116, 0, 222, 181, 159, 125, 29, 155,
+			159, 131, 213, 247, 207, 44, 251, 96, 229, 115, 150, 152, 201, 171,
+			23, 42, 118, 28, 247, 212, 252, 24, 151, 179, 205, 72, 177, 9,
+			233, 213, 33, 229, 132, 74, 51, 100, 150, 10, 219, 195, 85, 161,
+			47, 143, 194, 45, 99, 216, 202, 164, 103, 11, 214, 50, 207, 18,
+			93, 89, 166, 77, 88, 49, 8, 110, 129, 102, 63, 195, 66, 25,
+			55, 179, 58, 78, 202, 168, 217, 180, 85, 173, 90, 238, 119, 98,
+			134, 77, 47, 133, 95, 45, 247, 157, 33, 23, 178, 206, 254, 51,
+			181, 218, 0, 18, 65, 250, 71, 53, 232, 0, 60, 128, 152, 12,
+			36, 246, 43, 254, 156, 213, 243, 255, 87, 139, 15, 18, 224, 231,
+			172, 210, 33, 246, 237, 204, 117, 93, 172, 189, 159, 183, 236, 99,
+			149, 89, 208, 177, 59, 72, 99, 47, 223, 81, 198, 11, 219, 34,
+			119, 148, 134, 165, 164, 186, 230, 210, 54, 243, 243, 122, 155, 113,
+			105, 38, 127, 222, 234, 187, 91, 131, 14, 90, 60, 42, 216, 95,
+			98, 47, 119, 49, 147, 191, 106, 217, 135, 42, 255, 221, 134, 30,
+			147, 248, 233, 84, 238, 29, 103, 237, 192, 72, 35, 145, 220, 12,
+			218, 217, 234, 233, 114, 51, 130, 182, 228, 184, 151, 73, 28, 80,
+			75, 187, 178, 205, 133, 45, 124, 150, 211, 102, 50, 190, 207, 165,
+			241, 218, 237, 172, 215, 46, 125, 233, 97, 69, 120, 253, 166, 18,
+			168, 106, 73, 193, 179, 4, 46, 34, 159, 68, 26, 119, 18, 101,
+			231, 154, 214, 225, 83, 67, 58, 179, 92, 90, 23, 253, 162, 163,
+			34, 46, 244, 1, 178, 184, 97, 77, 137, 249, 219, 106, 111, 215,
+			122, 3, 24, 165, 233, 135, 184, 189, 213, 73, 64, 11, 157, 94,
+			87, 185, 50, 103, 178, 13, 95, 105, 23, 210, 245, 134, 71, 75,
+			66, 113, 125, 190, 126, 117, 97, 105, 105, 97, 241, 218, 202, 220,
+			252, 181, 133, 249, 57, 51, 75, 96, 160, 95, 213, 235, 205, 37,
+			6, 250, 85, 107, 96, 191, 6, 29, 252, 122, 16, 138, 153, 237,
+			22, 120, 241, 215, 173, 158, 223, 84, 12, 4, 57, 255, 235, 154,
+			129, 10, 96, 160, 143, 124, 75, 25, 168, 64, 12, 244, 17, 205,
+			64, 5, 98, 160, 143, 104, 6, 42, 144, 158, 242, 17, 235, 168,
+			160, 174, 21, 121, 241, 95, 89, 116, 226, 137, 141, 165, 104, 113,
+			247, 95, 89, 165, 3, 236, 189, 144, 18, 69, 244, 237, 183, 44,
+			187, 82, 249, 65, 146, 18, 81, 219, 123, 45, 30, 162, 76, 213,
+			14, 233, 165, 89, 127, 85, 174, 238, 59, 184, 37, 60, 240, 34,
+			120, 32, 231, 85, 208, 177, 63, 218, 241, 117, 19, 58, 114, 20,
+			119, 75, 56, 44, 11, 177, 12, 31, 35, 121, 166, 189, 36, 115,
+			50, 202, 121, 40, 210, 96, 127, 75, 15, 182, 72, 131, 253, 45,
+			171, 239, 46, 13, 58, 24, 194, 216, 65, 220, 20, 196, 136, 44,
+			238, 126, 18, 154, 239, 199, 45, 117, 164, 98, 36, 113, 78, 218,
+			127, 3, 238, 127, 186, 224, 173, 70, 189, 167, 210, 124, 45, 218,
+			161, 27, 139, 32, 119, 140, 154, 121, 224, 137, 207, 149, 191, 9,
+			93, 211, 174, 86, 175, 149, 68, 106, 39, 98, 187, 179, 93, 116,
+			147, 2, 183, 62, 63, 153, 145, 2, 44, 249, 73, 173, 159, 22,
+			73, 166, 125, 210, 218, 127, 128, 157, 161, 185, 69, 174, 108, 204,
+			237, 49, 33, 195, 19, 186, 253, 55, 93, 83, 40, 191, 167, 228,
+			215, 150, 61, 168, 208, 65, 240, 124, 202, 26, 210, 132, 134, 202,
+			246, 41, 107, 236, 32, 251, 117, 73, 104, 135, 187, 127, 0, 157,
+			228, 159, 91, 148, 72, 44, 243, 152, 192, 61, 162, 51, 253, 236,
+			210, 85, 50, 205, 46, 243, 43, 106, 17, 19, 132, 249, 148, 130,
+			42, 45, 102, 118, 62, 148, 219, 141, 238, 184, 13, 49, 25, 70,
+			42, 58, 97, 26, 180, 242, 163, 37, 85, 4, 59, 43, 185, 150,
+			228, 136, 156, 34, 13, 161, 95, 13, 16, 74, 215, 31, 232, 13,
+			181, 72, 151, 222, 255, 192, 186, 107, 63, 25, 175, 69, 40, 150,
+			255, 25, 82, 248, 126, 26, 45, 121, 110, 115, 99, 245, 196, 70,
+			103, 147, 30, 42, 247, 154, 116, 136, 212, 21, 59, 102, 90, 196,
+			181, 248, 255, 156, 205, 31, 118, 154, 255, 108, 245, 237, 215, 160,
+			3, 240, 96, 197, 132, 115, 125, 125, 152, 29, 218, 121, 63, 19,
+			162, 108, 91, 133, 110, 13, 237, 184, 101, 89, 237, 101, 133, 121,
+			252, 126, 241, 117, 108, 164, 17, 109, 238, 188, 133, 121, 145, 209,
+			175, 215, 81, 253, 186, 245, 74, 253, 243, 122, 132, 155, 111, 20,
+			59, 161, 107, 158, 65, 20, 119, 114, 134, 136, 41, 155, 108, 175,
+			126, 217, 178, 222, 107, 59, 151, 175, 95, 252, 128, 125, 68, 250,
+			132, 106, 215, 85, 245, 218, 19, 126, 171, 245, 114, 84, 94, 198,
+			119, 143, 255, 90, 25, 55, 61, 142, 244, 156, 47, 179, 143, 15,
+			80, 156, 197, 145, 30, 126, 254, 55, 6, 204, 179, 3, 250, 205,
+			1, 49, 173, 22, 226, 120, 162, 44, 21, 236, 48, 141, 13, 47,
+			92, 39, 157, 108, 19, 186, 91, 62, 56, 227, 236, 75, 244, 202,
+			93, 8, 27, 120, 212, 179, 213, 146, 135, 81, 137, 121, 126, 161,
+			150, 69, 174, 55, 253, 91, 126, 43, 106, 251, 113, 82, 91, 255,
+			127, 216, 123, 23, 240, 200, 174, 234, 76, 84, 117, 206, 169, 35,
+			105, 171, 31, 234, 211, 234, 150, 186, 212, 143, 77, 249, 209, 82,
+			187, 84, 234, 135, 109, 112, 183, 109, 172, 150, 212, 221, 178, 229,
+			86, 167, 36, 217, 193, 142, 233, 62, 170, 58, 37, 213, 237, 82,
+			29, 165, 78, 169, 219, 178, 227, 123, 19, 79, 18, 8, 220, 144,
+			152, 144, 92, 184, 216, 33, 151, 124, 60, 195, 231, 9, 137, 3,
+			201, 13, 31, 97, 8, 132, 153, 225, 18, 190, 185, 153, 132, 129,
+			60, 8, 121, 145, 9, 33, 48, 36, 36, 31, 9, 249, 102, 190,
+			127, 237, 181, 247, 57, 245, 80, 63, 12, 38, 100, 166, 249, 204,
+			215, 218, 167, 206, 217, 123, 237, 181, 215, 94, 107, 237, 181, 215,
+			35, 118, 95, 215, 91, 123, 140, 29, 240, 199, 233, 78, 213, 184,
+			38, 104, 129, 201, 140, 130, 67, 178, 241, 100, 169, 82, 243, 17,
+			137, 23, 214, 87, 245, 53, 115, 88, 215, 137, 29, 133, 92, 13,
+			75, 70, 228, 230, 148, 254, 98, 74, 168, 27, 115, 165, 41, 238,
+			27, 39, 48, 133, 67, 122, 197, 20, 34, 166, 26, 4, 28, 29,
+			39, 15, 181, 0, 22, 181, 102, 168, 93, 133, 84, 175, 7, 13,
+			93, 54, 87, 165, 214, 42, 106, 140, 9, 156, 121, 169, 34, 13,
+			113, 239, 106, 69, 93, 202, 39, 71, 172, 149, 90, 192, 41, 85,
+			162, 98, 213, 175, 172, 34, 146, 96, 19, 32, 42, 181, 36, 46,
+			52, 16, 156, 81, 61, 134, 67, 196, 128, 124, 75, 112, 232, 36,
+			235, 45, 185, 112, 253, 90, 105, 28, 217, 223, 113, 210, 147, 171,
+			62, 18, 121, 248, 213, 56, 47, 142, 241, 160, 73, 248, 165, 232,
+			36, 174, 72, 245, 113, 150, 157, 71, 140, 73, 44, 44, 55, 209,
+			86, 141, 239, 74, 240, 91, 196, 233, 20, 48, 35, 196, 105, 44,
+			173, 55, 112, 141, 193, 137, 47, 181, 205, 52, 168, 149, 66, 88,
+			20, 224, 232, 95, 15, 87, 145, 208, 74, 225, 164, 17, 177, 182,
+			93, 226, 44, 40, 180, 26, 218, 111, 198, 164, 6, 53, 158, 41,
+			107, 117, 84, 123, 135, 131, 77, 35, 168, 37, 156, 82, 136, 91,
+			158, 153, 153, 151, 243, 115, 167, 22, 30, 158, 40, 76, 203, 153,
+			121, 121, 174, 48, 247, 208, 204, 212, 244, 148, 60, 249, 42, 185,
+			112, 102, 90, 78, 206, 157, 123, 85, 97, 230, 244, 153, 5, 121,
+			102, 110, 118, 106, 186, 48, 47, 39, 206, 78, 33, 98, 118, 161,
+			48, 115, 114, 113, 97, 174, 48, 47, 140, 215, 13, 126, 153, 56,
+			251, 42, 57, 253, 189, 231, 10, 211, 243, 20, 90, 59, 243, 224,
+			185, 217, 153, 233, 169, 132, 3, 78, 78, 206, 156, 157, 156, 93,
+			156, 154, 57, 123, 58, 39, 79, 46, 46, 192, 241, 64, 200, 217,
+			153, 7, 103, 22, 166, 167, 228, 194, 92, 142, 134, 109, 255, 14,
+			174, 58, 15, 78, 23, 38, 207, 76, 156, 93, 152, 56, 57, 51,
+			59, 179, 240, 42, 2, 229, 212, 204, 194, 89, 12, 118, 106, 174,
+			128, 116, 82, 231, 38, 10, 11, 51, 147, 139, 179, 19, 5, 121,
+			110, 177, 112, 110, 110, 126, 90, 98, 102, 83, 51, 243, 147, 179,
+			19, 51, 15, 78, 79, 229, 229, 204, 89, 121, 118, 78, 78, 63,
+			52, 125, 118, 65, 206, 159, 153, 152, 157, 109, 158, 168, 144, 115,
+			15, 159, 157, 46, 176, 163, 144, 153, 166, 60, 57, 45, 103, 103,
+			38, 78, 206, 78, 203, 83, 115, 5, 138, 18, 158, 154, 41, 76,
+			79, 46, 96, 66, 241, 95, 147, 51, 83, 211, 103, 23, 38, 102,
+			115, 66, 146, 251, 223, 196, 108, 78, 78, 127, 239, 244, 131, 231,
+			102, 39, 10, 175, 202, 113, 167, 243, 211, 223, 179, 56, 125, 118,
+			97, 102, 98, 86, 78, 77, 60, 56, 113, 122, 122, 94, 142, 92,
+			13, 43, 231, 10, 115, 147, 139, 133, 233, 7, 1, 245, 220, 41,
+			57, 191, 120, 114, 126, 97, 102, 97, 113, 97, 90, 158, 158, 155,
+			155, 34, 100, 207, 79, 23, 30, 154, 153, 156, 158, 63, 33, 103,
+			231, 128, 254, 83, 114, 113, 126, 58, 39, 228, 212, 196, 194, 4,
+			13, 125, 174, 48, 119, 106, 102, 97, 254, 4, 254, 62, 185, 56,
+			63, 67, 136, 155, 57, 187, 48, 93, 40, 44, 158, 67, 0, 244,
+			168, 60, 51, 247, 240, 244, 67, 211, 5, 57, 57, 177, 56, 63,
+			141, 229, 156, 146, 115, 103, 49, 91, 208, 202, 244, 92, 225, 85,
+			232, 22, 120, 160, 21, 200, 201, 135, 207, 76, 47, 156, 153, 46,
+			0, 169, 132, 173, 9, 160, 97, 126, 161, 48, 51, 185, 144, 124,
+			109, 174, 32, 23, 230, 10, 11, 34, 49, 79, 121, 118, 250, 244,
+			236, 204, 233, 233, 179, 147, 211, 128, 103, 14, 221, 60, 60, 51,
+			63, 61, 42, 39, 10, 51, 243, 120, 97, 134, 6, 150, 15, 79,
+			188, 74, 194, 137, 107, 238, 20, 64, 192, 156, 4, 128, 104, 34,
+			221, 28, 173, 167, 156, 57, 37, 39, 166, 30, 154, 1, 228, 252,
+			246, 185, 185, 249, 249, 25, 38, 23, 66, 219, 228, 25, 198, 185,
+			241, 183, 146, 93, 67, 236, 111, 149, 237, 58, 65, 254, 86, 183,
+			168, 63, 213, 195, 155, 186, 166, 181, 19, 22, 254, 84, 15, 111,
+			238, 202, 209, 67, 4, 143, 116, 229, 248, 225, 45, 93, 183, 209,
+			67, 254, 83, 61, 188, 181, 43, 75, 15, 133, 250, 83, 61, 60,
+			216, 245, 50, 122, 120, 179, 250, 83, 61, 28, 233, 58, 64, 15,
+			15, 168, 63, 127, 93, 5, 153, 217, 71, 187, 250, 51, 255, 22,
+			150, 87, 242, 66, 168, 20, 37, 73, 80, 227, 73, 78, 34, 0,
+			222, 128, 69, 210, 24, 198, 248, 60, 238, 95, 10, 43, 58, 219,
+			52, 216, 223, 58, 69, 219, 83, 246, 153, 166, 239, 73, 81, 218,
+			8, 215, 235, 114, 226, 220, 76, 132, 236, 54, 156, 5, 79, 31,
+			160, 160, 11, 197, 135, 29, 63, 74, 122, 153, 11, 25, 214, 155,
+			189, 220, 33, 233, 249, 44, 2, 191, 44, 229, 82, 144, 39, 45,
+			71, 251, 149, 105, 105, 164, 15, 123, 137, 140, 225, 82, 214, 215,
+			138, 242, 164, 95, 31, 105, 209, 53, 242, 164, 106, 140, 242, 113,
+			38, 146, 155, 252, 174, 34, 174, 17, 114, 109, 46, 72, 142, 246,
+			108, 141, 53, 160, 172, 216, 219, 170, 1, 41, 155, 238, 102, 42,
+			208, 155, 83, 194, 157, 167, 55, 188, 19, 194, 165, 35, 96, 52,
+			148, 146, 246, 72, 223, 209, 155, 90, 245, 161, 188, 122, 81, 37,
+			159, 136, 40, 90, 186, 192, 159, 100, 190, 71, 244, 37, 30, 123,
+			253, 194, 190, 24, 108, 176, 155, 51, 254, 244, 114, 34, 77, 1,
+			39, 236, 135, 189, 187, 173, 243, 135, 240, 107, 65, 189, 116, 220,
+			122, 69, 42, 251, 75, 150, 72, 211, 67, 239, 132, 16, 181, 245,
+			106, 245, 60, 253, 198, 30, 246, 153, 182, 14, 206, 174, 87, 171,
+			244, 254, 153, 174, 66, 111, 77, 55, 188, 155, 196, 22, 149, 16,
+			145, 63, 199, 248, 169, 51, 93, 133, 62, 245, 212, 188, 4, 145,
+			87, 91, 230, 151, 224, 99, 221, 139, 151, 212, 83, 245, 210, 1,
+			33, 16, 171, 196, 175, 192, 181, 186, 7, 67, 225, 153, 122, 225,
+			110, 177, 69, 97, 155, 95, 129, 55, 117, 223, 209, 193, 54, 72,
+			21, 30, 185, 251, 245, 98, 195, 204, 18, 130, 158, 191, 117, 233,
+			219, 246, 89, 34, 182, 207, 204, 178, 170, 27, 39, 93, 225, 92,
+			172, 212, 74, 217, 19, 162, 215, 188, 225, 229, 133, 75, 157, 233,
+			21, 221, 12, 233, 252, 214, 161, 97, 209, 107, 144, 232, 109, 19,
+			226, 236, 226, 236, 236, 249, 135, 38, 102, 23, 167, 251, 187, 78,
+			254, 31, 157, 117, 228, 62, 53, 25, 173, 36, 143, 95, 163, 146,
+			172, 38, 126, 93, 90, 242, 255, 189, 79, 105, 201, 175, 78, 221,
+			208, 146, 111, 104, 201, 55, 180, 228, 27, 90, 242, 13, 45, 249,
+			134, 150, 252, 29, 212, 146, 141, 242, 122, 83, 215, 1, 126, 120,
+			115, 215, 41, 173, 58, 227, 79, 173, 37, 27, 213, 249, 22, 163,
+			58, 223, 202, 15, 249, 79, 173, 37, 27, 213, 249, 160, 81, 157,
+			71, 98, 213, 25, 127, 254, 50, 138, 214, 117, 121, 206, 209, 174,
+			59, 82, 153, 119, 218, 242, 130, 18, 184, 23, 154, 83, 30, 155,
+			155, 123, 182, 156, 114, 89, 103, 184, 37, 85, 162, 6, 71, 90,
+			43, 93, 77, 176, 171, 245, 170, 191, 134, 83, 120, 105, 163, 230,
+			175, 114, 86, 104, 40, 183, 37, 93, 168, 89, 206, 64, 78, 173,
+			6, 38, 240, 35, 202, 153, 193, 133, 92, 37, 6, 140, 138, 86,
+			198, 235, 106, 105, 67, 250, 178, 230, 83, 14, 99, 3, 28, 251,
+			63, 52, 229, 161, 71, 69, 7, 149, 54, 13, 128, 153, 238, 169,
+			214, 142, 188, 127, 222, 76, 7, 154, 185, 233, 72, 251, 165, 27,
+			23, 75, 114, 115, 40, 65, 74, 85, 217, 88, 235, 39, 170, 181,
+			49, 55, 167, 203, 14, 140, 181, 4, 49, 25, 46, 7, 224, 206,
+			194, 112, 113, 240, 117, 220, 5, 169, 57, 24, 127, 79, 13, 146,
+			190, 201, 188, 127, 126, 238, 108, 107, 215, 120, 215, 172, 69, 37,
+			82, 239, 24, 43, 109, 172, 157, 111, 227, 224, 223, 46, 207, 190,
+			221, 146, 153, 156, 92, 172, 161, 238, 65, 61, 40, 161, 92, 62,
+			0, 223, 116, 5, 140, 171, 146, 139, 111, 141, 171, 82, 202, 179,
+			111, 223, 53, 172, 91, 182, 103, 223, 190, 255, 128, 248, 24, 14,
+			83, 41, 207, 185, 167, 235, 193, 84, 230, 131, 150, 188, 64, 90,
+			92, 11, 149, 108, 50, 18, 19, 4, 135, 82, 40, 9, 38, 36,
+			84, 232, 28, 86, 148, 244, 100, 252, 165, 125, 158, 124, 29, 179,
+			143, 63, 235, 1, 74, 13, 96, 205, 121, 209, 226, 138, 226, 190,
+			48, 66, 89, 211, 212, 4, 11, 47, 117, 35, 72, 79, 155, 61,
+			117, 67, 85, 241, 38, 81, 61, 77, 200, 75, 126, 189, 2, 247,
+			166, 188, 156, 88, 138, 232, 42, 159, 14, 96, 27, 250, 7, 84,
+			136, 169, 20, 117, 180, 5, 217, 146, 175, 186, 114, 140, 30, 189,
+			112, 4, 9, 175, 27, 124, 136, 238, 233, 217, 42, 178, 112, 139,
+			234, 233, 242, 156, 87, 90, 179, 118, 102, 128, 232, 13, 74, 182,
+			129, 92, 123, 89, 245, 96, 165, 95, 217, 179, 133, 130, 246, 225,
+			125, 98, 79, 56, 251, 50, 25, 89, 72, 226, 30, 232, 108, 254,
+			12, 87, 212, 246, 132, 179, 85, 183, 82, 158, 61, 177, 109, 72,
+			183, 108, 207, 158, 24, 222, 43, 70, 181, 111, 214, 164, 179, 55,
+			179, 183, 185, 75, 78, 96, 220, 220, 41, 252, 161, 38, 29, 211,
+			74, 121, 246, 100, 223, 160, 110, 217, 158, 61, 153, 25, 230, 78,
+			45, 207, 158, 110, 239, 148, 175, 179, 154, 59, 181, 210, 120, 215,
+			180, 82, 158, 61, 109, 58, 133, 207, 238, 116, 102, 152, 242, 108,
+			192, 83, 197, 62, 237, 12, 101, 246, 53, 119, 202, 244, 210, 50,
+			127, 59, 237, 217, 167, 29, 237, 186, 5, 151, 207, 211, 236, 35,
+			151, 194, 125, 130, 125, 122, 247, 160, 24, 163, 94, 29, 207, 158,
+			113, 246, 102, 164, 44, 108, 194, 244, 154, 59, 118, 92, 188, 111,
+			90, 41, 207, 158, 49, 224, 58, 182, 103, 207, 100, 134, 185, 227,
+			180, 103, 63, 224, 236, 107, 237, 216, 148, 125, 224, 93, 100, 58,
+			78, 187, 120, 95, 175, 24, 50, 107, 60, 96, 86, 44, 109, 123,
+			246, 3, 195, 123, 197, 123, 82, 202, 45, 117, 190, 235, 161, 84,
+			230, 217, 148, 188, 96, 206, 84, 23, 184, 48, 13, 229, 100, 111,
+			132, 168, 195, 180, 190, 202, 215, 74, 160, 124, 67, 167, 196, 132,
+			98, 130, 209, 124, 73, 24, 178, 197, 230, 69, 5, 67, 165, 189,
+			93, 153, 210, 155, 135, 167, 13, 113, 1, 125, 95, 200, 199, 142,
+			163, 243, 233, 109, 34, 163, 29, 71, 23, 173, 29, 153, 173, 242,
+			108, 11, 189, 178, 31, 233, 162, 181, 69, 183, 44, 207, 94, 220,
+			222, 47, 158, 70, 70, 98, 203, 115, 30, 237, 122, 117, 42, 115,
+			73, 94, 48, 231, 79, 158, 238, 229, 58, 82, 198, 215, 165, 95,
+			15, 215, 107, 165, 36, 118, 141, 71, 186, 225, 119, 87, 158, 73,
+			115, 207, 52, 19, 191, 94, 247, 55, 120, 223, 98, 151, 60, 218,
+			179, 67, 28, 103, 159, 62, 251, 49, 107, 111, 102, 76, 22, 218,
+			134, 187, 10, 195, 181, 40, 237, 223, 99, 236, 41, 106, 209, 54,
+			125, 172, 175, 95, 183, 82, 158, 253, 216, 142, 65, 221, 178, 61,
+			251, 177, 204, 176, 177, 192, 124, 226, 136, 56, 208, 106, 129, 49,
+			73, 225, 54, 51, 194, 156, 16, 189, 11, 250, 29, 164, 128, 227,
+			148, 6, 100, 233, 176, 11, 186, 137, 148, 110, 53, 191, 22, 70,
+			100, 66, 73, 23, 84, 227, 228, 143, 164, 58, 31, 204, 183, 153,
+			46, 245, 217, 252, 232, 53, 158, 205, 13, 188, 215, 117, 60, 255,
+			248, 56, 162, 133, 247, 119, 189, 49, 117, 227, 124, 126, 227, 124,
+			126, 227, 124, 126, 227, 124, 126, 227, 124, 126, 227, 124, 254, 47,
+			126, 62, 159, 137, 207, 231, 51, 252, 112, 147, 243, 249, 120, 124,
+			62, 31, 191, 142, 243, 249, 31, 237, 163, 243, 185, 251, 154, 20,
+			68, 95, 230, 211, 251, 228, 132, 52, 162, 55, 86, 99, 160, 248,
+			173, 133, 21, 78, 226, 143, 220, 70, 168, 0, 183, 134, 170, 138,
+			202, 227, 159, 98, 204, 240, 252, 9, 228, 76, 15, 235, 178, 26,
+			22, 253, 170, 128, 83, 43, 74, 16, 214, 81, 53, 10, 220, 159,
+			93, 157, 138, 58, 82, 128, 181, 3, 48, 70, 89, 174, 251, 197,
+			88, 98, 232, 31, 96, 176, 133, 170, 64, 109, 88, 180, 195, 170,
+			98, 138, 164, 109, 169, 142, 42, 28, 100, 88, 185, 100, 42, 35,
+			32, 215, 31, 10, 215, 46, 46, 76, 202, 213, 74, 169, 6, 209,
+			34, 195, 154, 144, 247, 251, 181, 117, 152, 105, 143, 228, 228, 145,
+			187, 94, 126, 56, 167, 25, 245, 90, 61, 172, 6, 107, 141, 74,
+			81, 158, 174, 7, 112, 160, 245, 107, 6, 122, 62, 98, 82, 110,
+			113, 84, 1, 94, 9, 68, 167, 183, 16, 12, 124, 217, 175, 227,
+			141, 80, 110, 4, 126, 157, 179, 49, 147, 200, 95, 173, 212, 214,
+			27, 28, 145, 117, 231, 97, 51, 191, 106, 136, 186, 219, 179, 129,
+			191, 22, 79, 185, 30, 200, 108, 180, 26, 160, 170, 100, 22, 142,
+			99, 36, 128, 107, 112, 121, 246, 215, 4, 191, 198, 233, 41, 225,
+			144, 27, 4, 192, 43, 180, 108, 83, 27, 145, 5, 186, 74, 66,
+			233, 203, 71, 143, 222, 62, 182, 130, 75, 68, 148, 106, 65, 249,
+			35, 234, 253, 177, 145, 43, 43, 29, 208, 164, 198, 233, 205, 81,
+			173, 220, 214, 73, 203, 129, 127, 85, 61, 92, 149, 135, 15, 31,
+			62, 50, 70, 255, 45, 28, 62, 124, 156, 254, 123, 4, 83, 191,
+			235, 174, 187, 238, 26, 59, 114, 116, 236, 216, 145, 133, 163, 199,
+			142, 223, 113, 215, 241, 59, 238, 202, 223, 165, 255, 247, 72, 94,
+			158, 220, 64, 177, 62, 168, 8, 197, 134, 41, 17, 9, 243, 7,
+			122, 207, 201, 203, 200, 143, 25, 81, 168, 45, 38, 126, 25, 190,
+			216, 200, 150, 130, 44, 147, 92, 146, 150, 235, 17, 61, 90, 56,
+			53, 41, 228, 177, 99, 199, 238, 138, 231, 130, 12, 40, 149, 160,
+			81, 38, 13, 177, 94, 46, 142, 215, 203, 69, 188, 145, 111, 60,
+			222, 24, 133, 121, 73, 251, 60, 70, 152, 212, 77, 166, 244, 80,
+			92, 133, 72, 30, 57, 46, 39, 195, 213, 181, 245, 70, 144, 216,
+			11, 52, 224, 185, 185, 249, 153, 239, 149, 23, 128, 153, 145, 81,
+			28, 65, 32, 151, 19, 47, 25, 229, 147, 175, 58, 77, 59, 31,
+			5, 141, 243, 188, 192, 35, 120, 58, 130, 235, 169, 209, 209, 142,
+			239, 145, 106, 60, 114, 120, 244, 68, 2, 166, 163, 87, 131, 105,
+			57, 104, 160, 151, 176, 92, 242, 55, 18, 176, 177, 89, 3, 63,
+			93, 242, 171, 178, 113, 137, 71, 108, 122, 253, 214, 198, 165, 156,
+			36, 128, 78, 188, 216, 41, 93, 202, 55, 46, 97, 130, 87, 154,
+			145, 122, 9, 57, 134, 229, 33, 121, 228, 240, 225, 230, 25, 30,
+			219, 116, 134, 15, 87, 106, 199, 142, 202, 11, 167, 131, 198, 60,
+			149, 211, 192, 207, 19, 17, 18, 129, 45, 52, 47, 196, 169, 153,
+			217, 233, 133, 153, 7, 167, 101, 185, 193, 96, 108, 246, 205, 173,
+			229, 134, 134, 116, 113, 230, 236, 2, 165, 17, 47, 94, 140, 228,
+			61, 114, 100, 100, 68, 61, 25, 45, 55, 242, 165, 203, 103, 42,
+			203, 43, 83, 126, 131, 190, 26, 149, 119, 223, 45, 143, 29, 29,
+			149, 63, 32, 233, 183, 217, 240, 178, 254, 73, 227, 13, 37, 156,
+			228, 195, 149, 90, 137, 50, 54, 84, 138, 23, 113, 214, 59, 114,
+			248, 112, 130, 135, 69, 121, 243, 130, 226, 82, 71, 238, 108, 223,
+			70, 166, 55, 124, 126, 228, 206, 219, 111, 191, 253, 229, 199, 238,
+			60, 28, 179, 13, 142, 84, 95, 172, 85, 30, 103, 94, 7, 102,
+			214, 218, 75, 254, 197, 45, 230, 136, 154, 191, 28, 25, 81, 72,
+			25, 167, 197, 194, 127, 163, 114, 44, 9, 206, 85, 40, 24, 253,
+			28, 59, 26, 247, 115, 75, 162, 31, 34, 128, 209, 38, 2, 184,
+			125, 83, 2, 184, 223, 191, 228, 203, 11, 106, 241, 243, 92, 152,
+			13, 175, 60, 88, 169, 86, 43, 81, 130, 0, 192, 77, 229, 42,
+			61, 149, 247, 200, 205, 63, 184, 2, 153, 203, 123, 226, 167, 249,
+			90, 112, 249, 228, 122, 165, 90, 10, 234, 35, 163, 152, 216, 60,
+			99, 136, 135, 80, 136, 25, 213, 222, 17, 82, 226, 157, 179, 68,
+			235, 35, 149, 90, 3, 51, 231, 55, 213, 212, 121, 218, 64, 193,
+			232, 104, 126, 9, 61, 19, 44, 49, 14, 238, 184, 10, 14, 102,
+			200, 75, 163, 145, 175, 133, 151, 19, 211, 230, 167, 18, 33, 48,
+			247, 200, 166, 119, 174, 56, 211, 24, 240, 171, 79, 185, 22, 94,
+			206, 47, 7, 141, 105, 16, 155, 122, 54, 50, 154, 152, 121, 243,
+			236, 249, 101, 52, 70, 54, 153, 233, 157, 155, 206, 148, 215, 75,
+			235, 25, 242, 220, 70, 99, 133, 77, 69, 82, 118, 94, 168, 145,
+			209, 150, 31, 243, 167, 131, 198, 100, 188, 238, 35, 163, 196, 235,
+			201, 232, 242, 160, 191, 182, 134, 188, 9, 2, 87, 4, 244, 4,
+			55, 182, 126, 3, 7, 189, 36, 44, 176, 173, 96, 247, 54, 41,
+			46, 108, 95, 84, 58, 131, 32, 1, 116, 93, 242, 71, 13, 133,
+			146, 51, 112, 130, 87, 142, 211, 130, 205, 6, 24, 44, 251, 36,
+			244, 134, 167, 198, 158, 92, 13, 107, 141, 149, 167, 198, 158, 44,
+			249, 27, 79, 45, 60, 9, 225, 253, 212, 241, 39, 87, 43, 181,
+			167, 142, 63, 25, 5, 197, 167, 30, 205, 63, 9, 117, 9, 91,
+			246, 169, 199, 30, 201, 10, 46, 164, 171, 190, 70, 71, 190, 170,
+			18, 202, 201, 178, 76, 66, 234, 50, 180, 128, 82, 101, 185, 210,
+			128, 135, 53, 226, 83, 121, 164, 156, 164, 161, 114, 66, 170, 193,
+			114, 146, 70, 83, 233, 162, 104, 200, 184, 34, 231, 154, 95, 42,
+			113, 62, 133, 203, 161, 238, 45, 240, 139, 43, 152, 87, 96, 244,
+			56, 232, 127, 204, 82, 116, 130, 156, 162, 95, 147, 203, 240, 146,
+			135, 24, 191, 75, 127, 170, 162, 235, 213, 195, 35, 157, 181, 61,
+			4, 135, 97, 124, 93, 106, 77, 141, 148, 125, 36, 43, 163, 245,
+			114, 185, 242, 120, 194, 136, 142, 85, 4, 233, 144, 38, 58, 146,
+			93, 92, 152, 204, 142, 158, 104, 122, 42, 146, 94, 251, 108, 213,
+			111, 132, 199, 148, 233, 46, 162, 51, 121, 229, 9, 100, 227, 33,
+			71, 125, 141, 74, 24, 87, 22, 23, 38, 229, 136, 31, 153, 209,
+			16, 184, 33, 100, 246, 145, 236, 40, 22, 0, 137, 118, 42, 181,
+			134, 142, 56, 108, 33, 37, 32, 210, 111, 26, 138, 66, 82, 205,
+			48, 84, 142, 19, 238, 228, 208, 112, 138, 72, 104, 165, 130, 166,
+			48, 38, 190, 229, 10, 139, 60, 135, 168, 13, 14, 168, 189, 170,
+			130, 17, 169, 107, 77, 119, 86, 89, 100, 186, 27, 59, 124, 100,
+			236, 200, 29, 11, 135, 143, 28, 63, 118, 248, 248, 145, 59, 242,
+			135, 143, 60, 146, 101, 234, 142, 36, 181, 141, 120, 81, 69, 143,
+			232, 77, 26, 63, 172, 197, 122, 243, 29, 57, 137, 222, 242, 188,
+			129, 252, 75, 254, 60, 221, 134, 229, 160, 237, 54, 169, 106, 190,
+			132, 120, 212, 78, 251, 58, 28, 130, 137, 93, 233, 166, 100, 235,
+			0, 187, 42, 249, 245, 146, 144, 143, 54, 194, 153, 249, 185, 121,
+			218, 100, 35, 163, 29, 20, 212, 252, 106, 248, 68, 165, 90, 245,
+			73, 187, 11, 106, 99, 139, 243, 42, 47, 249, 195, 193, 210, 120,
+			12, 202, 120, 65, 135, 34, 140, 159, 174, 134, 75, 126, 245, 188,
+			74, 153, 25, 141, 3, 160, 241, 196, 32, 163, 194, 56, 193, 205,
+			104, 78, 131, 123, 33, 13, 146, 188, 0, 141, 17, 228, 148, 215,
+			127, 92, 208, 19, 226, 27, 39, 158, 45, 92, 247, 58, 77, 81,
+			200, 71, 47, 68, 141, 122, 153, 62, 77, 204, 40, 44, 70, 249,
+			53, 197, 217, 48, 151, 163, 227, 213, 202, 18, 66, 105, 198, 241,
+			98, 126, 165, 177, 90, 189, 137, 254, 210, 223, 142, 146, 113, 72,
+			24, 66, 214, 131, 192, 2, 35, 15, 222, 242, 170, 177, 91, 86,
+			199, 110, 41, 45, 220, 114, 230, 248, 45, 15, 30, 191, 101, 62,
+			127, 75, 249, 145, 131, 121, 57, 91, 185, 24, 92, 174, 68, 116,
+			109, 73, 107, 21, 175, 210, 122, 20, 168, 222, 238, 15, 75, 62,
+			241, 189, 131, 145, 124, 244, 194, 204, 252, 156, 86, 106, 78, 209,
+			8, 52, 113, 86, 180, 30, 27, 17, 201, 76, 131, 255, 91, 88,
+			82, 43, 129, 63, 198, 0, 37, 234, 156, 208, 130, 232, 167, 52,
+			157, 113, 5, 235, 120, 123, 223, 52, 79, 61, 192, 45, 71, 167,
+			110, 57, 58, 37, 228, 40, 24, 129, 201, 123, 168, 107, 192, 33,
+			102, 115, 141, 14, 61, 97, 89, 167, 124, 34, 18, 210, 219, 140,
+			203, 128, 25, 252, 147, 97, 29, 129, 80, 136, 34, 122, 77, 170,
+			103, 135, 120, 46, 197, 151, 153, 206, 235, 83, 214, 64, 230, 13,
+			169, 228, 197, 137, 166, 253, 176, 76, 36, 15, 184, 57, 95, 70,
+			172, 101, 137, 206, 106, 150, 124, 144, 211, 238, 95, 233, 88, 36,
+			58, 157, 139, 30, 81, 69, 81, 163, 202, 37, 29, 56, 66, 217,
+			238, 1, 95, 183, 110, 166, 60, 231, 245, 169, 158, 237, 186, 105,
+			163, 233, 237, 20, 127, 158, 226, 108, 15, 206, 51, 41, 203, 203,
+			252, 94, 74, 158, 13, 107, 99, 181, 96, 89, 157, 131, 53, 23,
+			142, 116, 90, 111, 204, 14, 39, 226, 142, 252, 53, 47, 207, 242,
+			135, 230, 128, 169, 110, 21, 136, 234, 18, 157, 145, 225, 84, 165,
+			213, 162, 96, 252, 90, 114, 76, 234, 154, 175, 35, 184, 116, 156,
+			58, 160, 151, 195, 58, 14, 198, 218, 122, 208, 138, 48, 62, 52,
+			230, 248, 255, 162, 3, 82, 16, 13, 245, 76, 140, 20, 68, 67,
+			61, 147, 234, 217, 170, 155, 54, 154, 253, 59, 204, 77, 198, 103,
+			115, 98, 79, 235, 77, 134, 95, 219, 52, 150, 230, 78, 97, 79,
+			212, 54, 188, 61, 162, 7, 172, 58, 145, 67, 184, 27, 109, 100,
+			176, 29, 72, 122, 128, 110, 97, 79, 207, 147, 151, 58, 223, 94,
+			244, 76, 212, 54, 244, 189, 69, 238, 26, 239, 45, 252, 218, 198,
+			218, 210, 181, 94, 87, 124, 237, 144, 186, 174, 120, 238, 198, 117,
+			197, 141, 235, 138, 27, 215, 21, 55, 174, 43, 110, 92, 87, 220,
+			184, 174, 248, 142, 94, 87, 76, 234, 155, 137, 155, 186, 38, 175,
+			28, 116, 51, 170, 111, 38, 240, 167, 190, 174, 184, 122, 208, 205,
+			223, 247, 211, 205, 68, 250, 7, 186, 158, 75, 165, 50, 127, 217,
+			47, 47, 76, 212, 54, 46, 196, 233, 47, 125, 248, 199, 45, 85,
+			26, 208, 153, 227, 67, 91, 34, 150, 91, 93, 149, 155, 64, 28,
+			159, 108, 67, 196, 177, 124, 17, 103, 224, 213, 254, 117, 124, 110,
+			228, 248, 24, 206, 118, 160, 251, 228, 62, 192, 28, 180, 68, 150,
+			172, 174, 107, 102, 104, 106, 141, 66, 151, 65, 106, 229, 241, 245,
+			26, 254, 145, 19, 181, 13, 214, 136, 52, 143, 5, 71, 23, 50,
+			44, 203, 245, 70, 165, 138, 186, 43, 229, 245, 154, 86, 213, 234,
+			40, 148, 82, 225, 132, 26, 113, 94, 83, 157, 7, 153, 65, 67,
+			159, 80, 76, 18, 165, 243, 97, 180, 62, 135, 241, 112, 84, 228,
+			161, 125, 13, 56, 70, 158, 188, 237, 54, 109, 66, 65, 72, 143,
+			42, 170, 159, 207, 231, 217, 126, 135, 46, 253, 218, 6, 183, 160,
+			42, 161, 183, 83, 245, 112, 117, 164, 28, 134, 218, 202, 151, 207,
+			51, 115, 175, 148, 229, 8, 94, 90, 164, 145, 22, 194, 145, 91,
+			241, 214, 104, 28, 41, 100, 222, 124, 42, 1, 227, 209, 171, 192,
+			136, 3, 202, 53, 0, 41, 239, 1, 86, 243, 24, 249, 138, 192,
+			85, 162, 145, 83, 97, 152, 47, 86, 253, 40, 74, 194, 166, 186,
+			5, 248, 10, 81, 137, 183, 78, 180, 3, 125, 236, 42, 64, 55,
+			155, 167, 84, 223, 167, 194, 112, 36, 159, 207, 179, 85, 202, 128,
+			60, 50, 218, 140, 94, 130, 190, 29, 120, 252, 60, 163, 96, 159,
+			154, 158, 159, 44, 204, 156, 91, 152, 43, 140, 30, 215, 224, 199,
+			120, 79, 124, 175, 122, 136, 161, 190, 253, 42, 80, 159, 14, 25,
+			96, 130, 248, 248, 61, 242, 214, 181, 165, 252, 169, 48, 124, 50,
+			159, 207, 63, 197, 191, 248, 181, 141, 28, 50, 41, 201, 227, 132,
+			173, 181, 165, 252, 217, 224, 114, 114, 204, 74, 153, 126, 126, 217,
+			61, 178, 86, 169, 198, 248, 141, 39, 35, 159, 18, 173, 79, 90,
+			134, 123, 170, 185, 43, 53, 82, 126, 177, 182, 234, 215, 163, 21,
+			191, 186, 16, 210, 120, 39, 174, 62, 142, 242, 152, 4, 86, 204,
+			110, 49, 122, 202, 210, 134, 92, 107, 221, 183, 42, 159, 207, 6,
+			98, 240, 168, 92, 63, 29, 135, 15, 210, 174, 90, 38, 29, 197,
+			95, 171, 68, 228, 124, 83, 94, 175, 86, 243, 244, 3, 252, 42,
+			14, 234, 32, 59, 60, 33, 62, 162, 21, 43, 69, 76, 218, 160,
+			16, 169, 84, 229, 58, 59, 44, 58, 217, 144, 223, 191, 238, 87,
+			145, 96, 131, 107, 187, 162, 63, 78, 170, 102, 10, 76, 31, 28,
+			63, 104, 180, 49, 61, 68, 78, 38, 82, 166, 200, 108, 57, 12,
+			243, 75, 126, 157, 128, 123, 124, 124, 35, 255, 68, 86, 101, 39,
+			218, 48, 133, 224, 4, 233, 84, 50, 139, 223, 112, 12, 38, 211,
+			214, 21, 28, 212, 112, 68, 172, 49, 135, 37, 102, 5, 141, 75,
+			77, 179, 30, 44, 175, 87, 113, 103, 216, 254, 9, 126, 46, 5,
+			49, 159, 204, 201, 96, 117, 41, 32, 219, 32, 83, 26, 235, 240,
+			240, 123, 78, 240, 53, 229, 202, 118, 225, 62, 76, 239, 130, 182,
+			12, 106, 190, 158, 156, 119, 94, 147, 179, 214, 214, 117, 206, 122,
+			62, 107, 172, 213, 195, 114, 165, 26, 240, 166, 213, 212, 125, 46,
+			168, 71, 97, 45, 38, 19, 182, 217, 82, 142, 223, 243, 132, 151,
+			123, 228, 145, 19, 45, 191, 86, 253, 248, 199, 163, 252, 227, 83,
+			60, 170, 233, 41, 75, 32, 103, 143, 203, 108, 39, 58, 105, 6,
+			42, 175, 192, 200, 230, 204, 199, 4, 192, 89, 127, 53, 200, 30,
+			151, 119, 43, 160, 238, 141, 127, 173, 250, 109, 63, 198, 80, 112,
+			174, 236, 86, 244, 26, 27, 245, 229, 160, 90, 29, 163, 4, 14,
+			180, 225, 145, 221, 199, 151, 197, 245, 168, 129, 139, 3, 44, 125,
+			203, 234, 229, 58, 250, 155, 235, 20, 87, 102, 24, 172, 25, 242,
+			104, 233, 138, 252, 23, 136, 54, 244, 146, 173, 132, 85, 92, 117,
+			175, 4, 201, 145, 64, 186, 122, 169, 117, 30, 16, 94, 105, 78,
+			226, 107, 22, 85, 37, 2, 210, 83, 121, 52, 70, 31, 109, 212,
+			252, 212, 186, 242, 45, 125, 236, 209, 199, 70, 143, 191, 216, 149,
+			104, 238, 42, 177, 24, 52, 19, 172, 228, 145, 252, 209, 35, 71,
+			163, 108, 123, 104, 234, 15, 244, 244, 137, 207, 8, 109, 48, 122,
+			75, 202, 218, 157, 249, 247, 112, 254, 89, 44, 204, 142, 195, 48,
+			77, 71, 68, 34, 39, 194, 229, 122, 173, 242, 253, 235, 65, 117,
+			163, 57, 139, 14, 47, 81, 155, 74, 33, 54, 211, 83, 96, 216,
+			134, 163, 21, 145, 135, 58, 247, 241, 214, 128, 237, 166, 26, 144,
+			97, 22, 38, 188, 236, 120, 86, 22, 87, 124, 24, 102, 130, 58,
+			190, 98, 254, 17, 5, 203, 171, 236, 148, 129, 225, 23, 11, 179,
+			7, 145, 149, 172, 177, 18, 31, 34, 105, 23, 139, 142, 108, 73,
+			31, 211, 12, 228, 202, 202, 44, 228, 5, 116, 177, 41, 98, 47,
+			140, 42, 8, 232, 115, 99, 207, 38, 90, 72, 84, 242, 82, 138,
+			207, 8, 146, 142, 230, 224, 204, 64, 196, 149, 205, 103, 117, 90,
+			49, 36, 44, 95, 107, 4, 165, 81, 182, 45, 175, 97, 114, 234,
+			76, 27, 248, 171, 176, 192, 175, 83, 160, 199, 90, 61, 64, 237,
+			20, 220, 94, 84, 106, 76, 99, 124, 90, 70, 66, 109, 192, 205,
+			121, 209, 145, 233, 86, 176, 127, 62, 39, 82, 102, 163, 131, 201,
+			225, 250, 56, 249, 161, 64, 159, 144, 103, 194, 203, 72, 108, 172,
+			184, 237, 98, 97, 54, 98, 66, 103, 30, 142, 176, 143, 149, 96,
+			53, 144, 23, 96, 253, 184, 144, 83, 255, 70, 23, 114, 80, 219,
+			80, 126, 129, 126, 141, 13, 172, 250, 174, 162, 186, 65, 81, 1,
+			235, 107, 200, 152, 68, 204, 89, 103, 228, 6, 221, 172, 34, 181,
+			189, 102, 120, 228, 57, 162, 119, 68, 201, 84, 223, 166, 116, 75,
+			202, 156, 17, 97, 43, 28, 146, 51, 229, 120, 68, 89, 137, 69,
+			157, 1, 10, 15, 85, 2, 48, 152, 107, 14, 33, 227, 19, 114,
+			130, 202, 211, 211, 11, 186, 230, 0, 100, 23, 209, 132, 146, 27,
+			126, 251, 22, 92, 216, 88, 11, 30, 123, 244, 49, 236, 13, 14,
+			118, 104, 50, 75, 224, 206, 76, 57, 14, 146, 85, 34, 142, 94,
+			144, 135, 228, 4, 50, 219, 22, 185, 108, 6, 44, 53, 137, 2,
+			28, 69, 191, 8, 105, 23, 134, 23, 215, 215, 56, 53, 126, 36,
+			151, 252, 200, 36, 240, 199, 128, 148, 53, 41, 172, 203, 21, 206,
+			166, 186, 154, 88, 246, 18, 156, 110, 67, 233, 107, 96, 76, 64,
+			189, 95, 219, 192, 183, 170, 107, 34, 201, 58, 93, 148, 231, 244,
+			155, 186, 46, 170, 82, 188, 107, 65, 0, 14, 70, 37, 65, 214,
+			180, 117, 11, 29, 132, 53, 236, 173, 26, 194, 122, 64, 95, 32,
+			168, 188, 28, 89, 140, 76, 117, 204, 164, 4, 167, 119, 86, 253,
+			26, 37, 76, 150, 114, 169, 30, 248, 23, 65, 217, 220, 67, 126,
+			148, 18, 71, 53, 130, 227, 108, 156, 102, 109, 223, 39, 16, 152,
+			244, 249, 206, 179, 186, 33, 253, 75, 126, 165, 74, 102, 110, 166,
+			210, 176, 92, 174, 20, 43, 184, 76, 211, 235, 2, 103, 167, 192,
+			143, 2, 117, 57, 87, 49, 105, 249, 200, 169, 19, 180, 27, 147,
+			211, 18, 146, 138, 213, 42, 124, 242, 17, 178, 3, 183, 196, 102,
+			155, 39, 210, 141, 244, 29, 211, 138, 95, 107, 37, 114, 57, 194,
+			198, 26, 149, 158, 64, 81, 222, 168, 9, 164, 226, 218, 27, 24,
+			132, 170, 240, 7, 177, 17, 201, 216, 92, 162, 96, 213, 71, 82,
+			217, 8, 26, 9, 27, 179, 211, 196, 89, 123, 116, 51, 133, 102,
+			239, 14, 221, 180, 209, 28, 216, 37, 30, 214, 150, 238, 103, 97,
+			233, 158, 49, 214, 99, 31, 39, 171, 74, 233, 74, 199, 63, 230,
+			101, 100, 45, 211, 160, 240, 226, 53, 217, 150, 159, 109, 182, 45,
+			63, 219, 108, 91, 126, 54, 105, 91, 126, 115, 191, 24, 100, 219,
+			114, 125, 173, 56, 206, 101, 8, 104, 96, 79, 240, 22, 170, 175,
+			21, 51, 155, 27, 160, 179, 101, 228, 45, 192, 103, 168, 140, 142,
+			235, 101, 50, 55, 167, 11, 244, 55, 156, 232, 153, 7, 112, 37,
+			53, 221, 244, 242, 162, 155, 99, 198, 134, 108, 74, 115, 48, 144,
+			111, 221, 179, 19, 181, 141, 130, 126, 233, 164, 47, 182, 37, 236,
+			211, 245, 181, 226, 201, 62, 53, 174, 182, 78, 191, 188, 221, 58,
+			189, 28, 212, 168, 51, 102, 246, 32, 147, 196, 60, 79, 168, 127,
+			148, 107, 125, 225, 220, 228, 253, 79, 111, 67, 96, 251, 182, 174,
+			124, 74, 124, 40, 46, 179, 245, 254, 150, 66, 223, 135, 181, 225,
+			111, 118, 118, 242, 95, 99, 125, 173, 27, 229, 181, 190, 115, 229,
+			181, 118, 234, 90, 222, 94, 215, 77, 108, 27, 26, 136, 221, 99,
+			7, 140, 123, 236, 174, 174, 179, 218, 8, 133, 63, 213, 195, 221,
+			177, 105, 105, 183, 49, 45, 13, 178, 101, 138, 255, 84, 15, 135,
+			186, 14, 106, 115, 21, 254, 84, 15, 247, 196, 70, 40, 252, 249,
+			97, 14, 95, 205, 118, 229, 83, 153, 247, 219, 16, 40, 136, 34,
+			197, 14, 184, 64, 60, 36, 81, 76, 188, 26, 46, 147, 150, 195,
+			217, 224, 194, 82, 80, 229, 180, 138, 176, 13, 85, 148, 191, 102,
+			57, 172, 99, 105, 161, 241, 65, 73, 91, 171, 135, 203, 117, 127,
+			117, 21, 12, 58, 168, 93, 170, 212, 195, 26, 24, 103, 148, 227,
+			156, 227, 120, 94, 152, 158, 95, 160, 236, 47, 192, 34, 50, 67,
+			82, 131, 211, 107, 51, 223, 93, 218, 144, 143, 162, 78, 106, 124,
+			191, 188, 92, 105, 172, 172, 47, 129, 191, 143, 47, 215, 215, 138,
+			163, 121, 57, 237, 23, 87, 98, 224, 153, 163, 152, 115, 23, 212,
+			193, 122, 16, 200, 181, 74, 128, 82, 85, 97, 153, 110, 139, 142,
+			43, 137, 78, 55, 33, 57, 254, 155, 191, 84, 194, 71, 61, 98,
+			118, 3, 73, 242, 42, 78, 117, 83, 166, 80, 195, 117, 20, 177,
+			128, 240, 95, 66, 176, 0, 137, 191, 36, 122, 208, 195, 10, 210,
+			0, 135, 242, 114, 88, 191, 168, 182, 105, 69, 103, 49, 22, 242,
+			81, 36, 169, 153, 10, 144, 211, 92, 158, 94, 175, 148, 130, 120,
+			126, 109, 117, 202, 137, 79, 149, 232, 221, 113, 26, 35, 26, 229,
+			200, 42, 40, 243, 217, 158, 109, 162, 196, 186, 188, 125, 179, 181,
+			35, 243, 48, 173, 101, 162, 150, 150, 118, 81, 137, 181, 87, 232,
+			51, 181, 245, 85, 86, 125, 194, 178, 209, 143, 234, 107, 197, 252,
+			100, 88, 10, 30, 107, 123, 16, 7, 189, 162, 136, 181, 41, 228,
+			10, 8, 110, 238, 217, 162, 91, 40, 98, 189, 189, 95, 124, 73,
+			95, 224, 218, 163, 214, 174, 204, 31, 166, 228, 132, 52, 215, 105,
+			99, 101, 191, 136, 197, 111, 65, 121, 43, 136, 149, 154, 156, 174,
+			45, 35, 125, 118, 94, 78, 64, 247, 89, 143, 54, 249, 54, 241,
+			17, 185, 102, 147, 176, 4, 254, 113, 22, 136, 17, 158, 152, 144,
+			162, 148, 60, 175, 237, 99, 87, 248, 73, 103, 101, 214, 110, 223,
+			212, 55, 231, 140, 85, 249, 56, 13, 94, 82, 77, 197, 163, 83,
+			84, 60, 90, 87, 122, 70, 97, 129, 209, 157, 3, 98, 67, 151,
+			85, 31, 179, 110, 203, 84, 229, 132, 185, 204, 226, 169, 176, 110,
+			95, 244, 235, 117, 53, 70, 51, 17, 74, 172, 44, 42, 19, 209,
+			25, 56, 92, 93, 13, 107, 164, 127, 163, 52, 156, 70, 6, 182,
+			46, 185, 9, 208, 94, 226, 99, 129, 1, 210, 114, 48, 182, 105,
+			185, 158, 61, 214, 183, 95, 183, 80, 121, 249, 192, 173, 92, 121,
+			25, 1, 163, 99, 163, 135, 140, 106, 240, 147, 95, 178, 196, 225,
+			107, 43, 202, 139, 178, 123, 124, 29, 77, 85, 121, 51, 87, 202,
+			254, 152, 185, 98, 98, 164, 204, 213, 130, 246, 50, 155, 233, 43,
+			153, 235, 173, 32, 156, 61, 40, 182, 170, 226, 252, 92, 171, 211,
+			219, 45, 92, 85, 224, 158, 111, 204, 185, 149, 125, 206, 18, 219,
+			212, 155, 15, 114, 61, 246, 205, 94, 245, 164, 232, 43, 87, 106,
+			203, 65, 157, 220, 167, 88, 231, 73, 62, 242, 78, 136, 190, 245,
+			53, 184, 137, 156, 135, 251, 0, 21, 145, 237, 148, 94, 200, 120,
+			92, 21, 132, 122, 29, 15, 188, 3, 230, 99, 236, 14, 74, 125,
+			212, 171, 95, 64, 241, 112, 47, 39, 28, 191, 88, 141, 134, 210,
+			164, 82, 113, 105, 212, 102, 216, 243, 19, 147, 179, 5, 122, 43,
+			51, 45, 236, 137, 201, 89, 111, 191, 112, 16, 49, 192, 9, 157,
+			132, 250, 168, 16, 86, 131, 2, 61, 247, 246, 11, 129, 233, 20,
+			43, 107, 126, 21, 65, 143, 246, 72, 111, 33, 241, 36, 123, 94,
+			12, 206, 212, 86, 130, 122, 165, 17, 148, 90, 240, 52, 37, 118,
+			174, 5, 245, 243, 10, 59, 231, 117, 57, 123, 78, 131, 52, 208,
+			9, 188, 194, 142, 181, 160, 222, 252, 40, 251, 154, 148, 216, 5,
+			120, 162, 153, 154, 94, 49, 149, 5, 204, 187, 87, 164, 1, 162,
+			78, 172, 52, 18, 195, 222, 246, 46, 61, 53, 15, 213, 103, 153,
+			188, 216, 146, 124, 124, 53, 84, 100, 215, 196, 14, 4, 191, 94,
+			19, 221, 120, 123, 69, 175, 9, 202, 39, 82, 232, 41, 196, 15,
+			188, 91, 196, 54, 114, 152, 41, 5, 231, 87, 42, 165, 82, 80,
+			35, 90, 232, 41, 108, 229, 167, 103, 232, 97, 118, 86, 120, 201,
+			17, 121, 222, 25, 209, 195, 6, 68, 53, 245, 222, 130, 105, 211,
+			111, 4, 64, 160, 23, 203, 180, 179, 135, 196, 54, 216, 210, 253,
+			229, 64, 3, 63, 36, 186, 249, 75, 134, 94, 55, 179, 31, 76,
+			137, 158, 25, 78, 165, 182, 249, 107, 222, 109, 162, 71, 39, 92,
+			27, 178, 58, 151, 57, 54, 47, 120, 55, 137, 173, 245, 96, 185,
+			18, 53, 130, 122, 80, 58, 191, 180, 65, 115, 238, 45, 108, 137,
+			31, 158, 220, 240, 94, 217, 244, 82, 35, 26, 114, 174, 186, 73,
+			18, 29, 44, 68, 217, 247, 165, 196, 80, 129, 31, 232, 25, 24,
+			212, 29, 54, 5, 156, 83, 84, 192, 153, 55, 137, 122, 95, 25,
+			215, 148, 188, 40, 240, 123, 222, 161, 182, 25, 114, 201, 108, 211,
+			119, 60, 193, 163, 162, 215, 20, 169, 230, 205, 221, 185, 4, 112,
+			161, 71, 87, 172, 206, 86, 197, 0, 150, 88, 247, 22, 93, 117,
+			105, 188, 97, 209, 187, 230, 47, 7, 231, 163, 202, 19, 193, 208,
+			0, 157, 183, 122, 240, 96, 190, 242, 68, 128, 2, 214, 248, 251,
+			60, 165, 174, 30, 218, 69, 95, 210, 235, 11, 120, 144, 93, 21,
+			187, 90, 70, 99, 196, 228, 68, 175, 158, 134, 222, 79, 173, 243,
+			140, 95, 240, 110, 21, 219, 107, 193, 227, 141, 243, 137, 161, 6,
+			104, 168, 173, 120, 124, 206, 12, 247, 227, 41, 177, 123, 62, 240,
+			235, 197, 149, 235, 152, 223, 62, 225, 52, 252, 101, 69, 190, 125,
+			71, 123, 21, 20, 11, 254, 114, 129, 30, 127, 75, 211, 15, 197,
+			96, 27, 56, 47, 41, 2, 62, 156, 18, 54, 170, 211, 123, 194,
+			129, 137, 133, 167, 74, 127, 39, 49, 96, 53, 99, 32, 185, 171,
+			236, 171, 237, 170, 3, 162, 79, 133, 63, 171, 61, 197, 98, 65,
+			63, 58, 185, 225, 157, 72, 188, 208, 128, 116, 184, 170, 208, 209,
+			175, 47, 68, 217, 251, 68, 255, 84, 80, 13, 26, 1, 182, 50,
+			47, 221, 117, 77, 38, 123, 155, 216, 14, 146, 43, 4, 229, 171,
+			175, 125, 246, 136, 232, 143, 95, 230, 149, 217, 39, 156, 122, 80,
+			214, 139, 194, 244, 0, 112, 232, 113, 246, 245, 41, 97, 47, 248,
+			203, 29, 178, 29, 54, 249, 186, 245, 178, 175, 27, 196, 168, 223,
+			104, 224, 144, 158, 224, 65, 66, 63, 82, 248, 50, 47, 92, 19,
+			255, 49, 31, 47, 68, 217, 13, 177, 99, 130, 90, 11, 254, 242,
+			53, 208, 250, 117, 241, 79, 189, 49, 236, 142, 27, 3, 67, 79,
+			5, 255, 50, 67, 255, 97, 74, 244, 235, 109, 162, 5, 247, 213,
+			22, 68, 59, 31, 162, 44, 63, 87, 33, 59, 15, 125, 150, 87,
+			164, 143, 159, 193, 118, 219, 170, 89, 57, 237, 154, 85, 203, 170,
+			166, 175, 182, 170, 238, 117, 173, 234, 27, 83, 98, 151, 90, 86,
+			61, 187, 111, 51, 126, 143, 138, 30, 163, 31, 217, 156, 38, 178,
+			137, 249, 152, 113, 205, 123, 4, 212, 84, 240, 93, 6, 212, 251,
+			82, 98, 39, 118, 240, 75, 4, 146, 39, 156, 139, 193, 134, 218,
+			2, 189, 5, 250, 251, 91, 18, 8, 117, 49, 208, 12, 45, 243,
+			156, 228, 212, 83, 215, 54, 245, 107, 150, 9, 15, 136, 93, 133,
+			32, 10, 171, 151, 2, 182, 13, 94, 29, 71, 67, 162, 155, 13,
+			246, 154, 187, 114, 51, 251, 106, 177, 235, 116, 96, 228, 249, 98,
+			97, 246, 219, 139, 240, 236, 155, 44, 209, 123, 174, 30, 162, 2,
+			111, 88, 247, 182, 9, 171, 82, 226, 254, 172, 74, 201, 187, 77,
+			164, 161, 38, 169, 126, 182, 29, 221, 165, 117, 122, 126, 159, 14,
+			216, 65, 65, 189, 3, 190, 170, 203, 25, 156, 111, 68, 67, 246,
+			213, 119, 160, 126, 125, 33, 242, 198, 133, 171, 110, 88, 134, 156,
+			43, 230, 115, 45, 240, 107, 224, 53, 116, 158, 38, 121, 215, 91,
+			80, 141, 236, 41, 145, 38, 152, 188, 93, 98, 199, 252, 194, 196,
+			194, 244, 249, 197, 179, 228, 203, 119, 106, 102, 122, 170, 191, 203,
+			235, 19, 221, 231, 166, 207, 34, 226, 190, 63, 229, 109, 21, 189,
+			243, 139, 147, 147, 211, 211, 83, 211, 83, 253, 150, 39, 132, 171,
+			138, 114, 246, 219, 217, 223, 79, 137, 193, 41, 118, 185, 210, 200,
+			255, 246, 98, 30, 218, 178, 118, 234, 58, 15, 65, 199, 39, 132,
+			45, 250, 33, 100, 100, 211, 75, 196, 160, 157, 230, 151, 32, 10,
+			188, 113, 177, 211, 188, 180, 166, 215, 82, 41, 2, 61, 5, 79,
+			255, 100, 86, 45, 202, 254, 74, 74, 12, 181, 207, 142, 183, 70,
+			82, 33, 78, 93, 69, 33, 214, 162, 219, 234, 40, 186, 175, 34,
+			85, 188, 113, 33, 18, 224, 58, 210, 142, 17, 102, 128, 45, 36,
+			94, 193, 102, 208, 96, 79, 146, 189, 230, 219, 188, 25, 126, 193,
+			18, 187, 91, 7, 120, 17, 88, 201, 11, 161, 204, 73, 88, 215,
+			205, 70, 237, 45, 242, 0, 101, 239, 118, 177, 149, 223, 87, 183,
+			127, 157, 116, 66, 236, 252, 45, 234, 173, 147, 244, 18, 244, 28,
+			254, 138, 14, 10, 32, 12, 187, 192, 3, 211, 81, 225, 128, 232,
+			171, 6, 203, 126, 113, 227, 124, 180, 226, 31, 225, 125, 34, 212,
+			163, 249, 21, 255, 136, 119, 143, 240, 184, 135, 122, 80, 62, 239,
+			87, 43, 126, 20, 68, 67, 174, 180, 59, 193, 219, 111, 224, 157,
+			80, 47, 102, 107, 98, 191, 70, 214, 201, 48, 108, 224, 124, 181,
+			118, 114, 189, 86, 170, 6, 87, 59, 59, 103, 68, 143, 206, 54,
+			167, 15, 177, 186, 157, 100, 133, 118, 51, 43, 252, 176, 37, 14,
+			108, 58, 32, 47, 211, 140, 72, 195, 169, 69, 43, 147, 199, 212,
+			44, 174, 242, 85, 222, 60, 63, 85, 169, 6, 5, 213, 67, 230,
+			211, 41, 177, 181, 233, 135, 43, 80, 217, 33, 115, 236, 84, 171,
+			237, 229, 219, 172, 144, 230, 192, 121, 93, 202, 255, 77, 194, 1,
+			52, 67, 78, 231, 23, 233, 71, 115, 248, 72, 39, 14, 31, 158,
+			112, 136, 44, 92, 34, 11, 250, 251, 208, 43, 133, 3, 107, 135,
+			55, 32, 250, 11, 115, 179, 173, 172, 81, 8, 23, 53, 244, 166,
+			11, 253, 41, 176, 194, 135, 11, 51, 11, 211, 133, 126, 203, 235,
+			21, 105, 202, 1, 211, 111, 31, 138, 132, 215, 126, 146, 246, 110,
+			18, 7, 10, 211, 167, 103, 230, 23, 10, 19, 184, 174, 57, 15,
+			190, 187, 56, 223, 210, 251, 54, 33, 212, 75, 211, 133, 233, 169,
+			254, 148, 183, 91, 120, 186, 102, 95, 226, 185, 229, 245, 139, 45,
+			40, 171, 172, 74, 69, 131, 21, 31, 253, 225, 173, 66, 20, 2,
+			170, 120, 26, 214, 55, 188, 123, 197, 142, 211, 1, 91, 76, 180,
+			144, 246, 118, 106, 174, 145, 176, 220, 100, 58, 90, 160, 188, 115,
+			34, 67, 2, 181, 179, 73, 171, 99, 71, 251, 244, 78, 239, 252,
+			205, 73, 49, 176, 72, 86, 188, 150, 231, 29, 199, 223, 4, 170,
+			41, 209, 127, 58, 104, 52, 153, 182, 58, 195, 50, 124, 5, 35,
+			152, 247, 74, 33, 98, 115, 146, 55, 168, 94, 77, 26, 152, 84,
+			31, 67, 237, 63, 112, 7, 39, 68, 223, 153, 74, 41, 96, 43,
+			146, 167, 97, 109, 50, 42, 101, 218, 19, 156, 83, 90, 125, 239,
+			30, 177, 117, 177, 182, 242, 173, 124, 174, 78, 162, 47, 238, 243,
+			147, 162, 191, 213, 42, 228, 181, 176, 232, 204, 126, 45, 160, 54,
+			177, 30, 157, 17, 91, 155, 172, 39, 94, 38, 198, 148, 121, 216,
+			178, 18, 45, 191, 113, 79, 103, 197, 246, 22, 67, 132, 183, 87,
+			245, 213, 242, 184, 133, 198, 218, 126, 229, 254, 242, 162, 119, 146,
+			106, 112, 65, 94, 196, 114, 118, 83, 108, 156, 16, 189, 230, 88,
+			239, 177, 106, 219, 122, 206, 223, 244, 227, 187, 68, 143, 62, 164,
+			123, 172, 242, 197, 135, 118, 253, 105, 203, 99, 134, 243, 30, 33,
+			226, 227, 177, 166, 192, 182, 3, 243, 166, 35, 223, 35, 196, 84,
+			208, 250, 249, 84, 112, 173, 159, 79, 139, 109, 205, 167, 56, 143,
+			23, 169, 227, 217, 238, 74, 221, 76, 5, 157, 186, 153, 10, 174,
+			175, 155, 45, 201, 179, 135, 183, 39, 198, 89, 107, 23, 153, 78,
+			63, 25, 148, 110, 107, 62, 78, 104, 104, 58, 30, 50, 50, 45,
+			52, 239, 221, 43, 182, 53, 31, 32, 244, 231, 29, 143, 21, 153,
+			38, 17, 179, 88, 152, 245, 190, 71, 244, 183, 170, 138, 222, 190,
+			102, 177, 170, 159, 235, 62, 246, 111, 246, 51, 207, 232, 1, 177,
+			173, 89, 203, 242, 134, 155, 191, 104, 82, 238, 50, 123, 59, 255,
+			200, 157, 149, 99, 69, 221, 72, 107, 165, 133, 120, 55, 55, 127,
+			216, 242, 179, 238, 254, 150, 171, 188, 165, 198, 57, 121, 219, 35,
+			163, 215, 116, 7, 117, 194, 95, 171, 220, 255, 238, 23, 44, 4,
+			62, 110, 235, 250, 187, 238, 148, 248, 88, 236, 110, 242, 107, 205,
+			238, 38, 71, 94, 142, 59, 64, 57, 187, 56, 57, 35, 39, 168,
+			50, 33, 124, 142, 254, 245, 121, 157, 220, 112, 59, 249, 14, 186,
+			157, 108, 101, 23, 16, 175, 235, 62, 237, 86, 194, 127, 194, 23,
+			101, 160, 235, 22, 60, 69, 154, 223, 93, 93, 183, 210, 159, 22,
+			92, 76, 40, 186, 9, 233, 126, 7, 149, 231, 136, 237, 192, 157,
+			228, 46, 241, 95, 119, 10, 203, 237, 242, 210, 103, 186, 126, 221,
+			78, 101, 126, 127, 167, 124, 144, 28, 4, 35, 57, 57, 115, 110,
+			74, 178, 126, 107, 130, 50, 43, 117, 233, 71, 81, 88, 172, 232,
+			104, 31, 98, 85, 160, 217, 9, 253, 46, 112, 239, 147, 91, 127,
+			73, 22, 195, 106, 53, 40, 106, 55, 124, 206, 184, 70, 142, 22,
+			172, 211, 115, 208, 247, 114, 61, 92, 95, 195, 159, 80, 103, 163,
+			124, 178, 55, 118, 7, 224, 106, 66, 213, 56, 202, 18, 56, 214,
+			89, 126, 13, 93, 16, 85, 135, 53, 184, 10, 144, 3, 139, 223,
+			240, 169, 54, 45, 54, 2, 46, 217, 107, 203, 213, 80, 231, 2,
+			206, 39, 97, 102, 22, 165, 47, 227, 107, 168, 112, 137, 199, 69,
+			191, 142, 92, 235, 72, 200, 70, 144, 82, 97, 106, 6, 254, 56,
+			187, 52, 34, 147, 49, 188, 134, 43, 213, 38, 79, 71, 227, 95,
+			77, 20, 142, 252, 33, 243, 103, 38, 142, 222, 113, 39, 60, 20,
+			5, 114, 45, 30, 161, 57, 132, 85, 236, 113, 6, 36, 26, 69,
+			174, 145, 32, 106, 228, 98, 7, 104, 229, 163, 96, 116, 126, 78,
+			166, 174, 61, 33, 180, 31, 161, 94, 3, 83, 170, 82, 251, 26,
+			154, 40, 136, 246, 30, 224, 142, 170, 61, 184, 41, 211, 72, 57,
+			168, 99, 67, 34, 7, 128, 31, 201, 172, 62, 133, 200, 153, 169,
+			108, 98, 98, 58, 200, 149, 39, 184, 209, 134, 197, 72, 142, 172,
+			134, 81, 3, 62, 230, 53, 248, 235, 0, 145, 141, 160, 94, 246,
+			139, 96, 64, 107, 33, 123, 2, 225, 7, 162, 178, 98, 184, 186,
+			138, 238, 145, 245, 141, 11, 163, 230, 228, 210, 58, 151, 33, 133,
+			87, 119, 179, 168, 131, 115, 145, 10, 51, 58, 68, 37, 161, 212,
+			145, 22, 171, 16, 97, 55, 17, 106, 21, 26, 149, 75, 175, 206,
+			140, 67, 110, 59, 240, 238, 173, 83, 177, 239, 149, 224, 113, 149,
+			86, 4, 172, 5, 189, 73, 118, 173, 225, 68, 42, 218, 227, 183,
+			82, 147, 183, 31, 38, 31, 118, 57, 49, 63, 57, 51, 195, 40,
+			131, 223, 14, 133, 15, 207, 17, 99, 232, 52, 160, 202, 29, 2,
+			143, 225, 59, 111, 31, 81, 47, 200, 219, 228, 163, 143, 45, 109,
+			52, 130, 39, 235, 65, 57, 127, 198, 143, 86, 38, 170, 203, 225,
+			83, 163, 6, 0, 164, 199, 57, 168, 62, 161, 242, 212, 139, 133,
+			217, 177, 200, 47, 7, 38, 66, 74, 69, 60, 160, 48, 245, 109,
+			244, 2, 168, 213, 111, 4, 53, 174, 155, 202, 65, 105, 117, 77,
+			109, 26, 46, 146, 1, 201, 41, 221, 222, 97, 74, 202, 99, 37,
+			168, 49, 174, 38, 65, 85, 90, 124, 19, 129, 163, 159, 96, 53,
+			10, 170, 151, 56, 69, 224, 19, 149, 53, 9, 149, 181, 130, 7,
+			244, 17, 85, 51, 72, 68, 209, 196, 92, 66, 46, 172, 4, 27,
+			92, 67, 27, 193, 208, 60, 15, 148, 79, 88, 95, 85, 52, 1,
+			33, 42, 39, 103, 103, 12, 25, 232, 160, 37, 93, 62, 93, 17,
+			150, 48, 229, 217, 139, 126, 194, 109, 43, 168, 112, 110, 65, 68,
+			37, 154, 188, 229, 152, 4, 31, 34, 216, 15, 26, 126, 215, 170,
+			14, 66, 61, 12, 27, 36, 141, 48, 57, 25, 81, 34, 46, 138,
+			66, 136, 148, 255, 191, 204, 250, 227, 75, 227, 197, 236, 40, 57,
+			105, 11, 77, 243, 186, 84, 114, 76, 250, 38, 147, 145, 142, 86,
+			160, 94, 216, 181, 27, 133, 204, 67, 248, 79, 171, 173, 40, 100,
+			214, 164, 129, 196, 91, 81, 150, 124, 146, 178, 249, 188, 250, 3,
+			113, 120, 202, 195, 57, 170, 172, 86, 80, 119, 22, 40, 162, 233,
+			68, 137, 248, 5, 37, 141, 133, 10, 167, 64, 63, 9, 238, 135,
+			200, 154, 26, 237, 241, 122, 165, 22, 193, 169, 185, 178, 92, 131,
+			40, 162, 149, 84, 178, 12, 193, 53, 225, 250, 242, 10, 111, 235,
+			176, 220, 64, 69, 108, 189, 187, 75, 65, 13, 97, 235, 190, 92,
+			171, 250, 13, 68, 68, 96, 176, 228, 124, 129, 232, 26, 103, 116,
+			204, 39, 211, 248, 8, 112, 142, 114, 221, 31, 111, 132, 97, 53,
+			82, 10, 82, 181, 82, 91, 127, 124, 204, 95, 45, 221, 121, 123,
+			118, 179, 78, 228, 44, 94, 146, 245, 245, 26, 249, 101, 66, 104,
+			227, 125, 36, 84, 42, 174, 84, 26, 129, 90, 76, 83, 153, 87,
+			213, 14, 151, 197, 176, 118, 9, 124, 40, 172, 37, 153, 47, 24,
+			126, 80, 43, 135, 245, 98, 80, 66, 252, 190, 80, 34, 53, 106,
+			132, 161, 225, 61, 76, 82, 32, 15, 242, 57, 12, 170, 65, 50,
+			34, 69, 67, 9, 92, 71, 107, 224, 93, 35, 28, 185, 20, 201,
+			172, 254, 145, 208, 206, 158, 68, 88, 58, 0, 37, 100, 150, 159,
+			140, 34, 168, 68, 69, 6, 208, 174, 208, 91, 65, 234, 171, 39,
+			32, 186, 210, 200, 75, 78, 1, 6, 142, 217, 96, 151, 74, 56,
+			55, 214, 64, 233, 8, 32, 46, 194, 166, 139, 169, 54, 234, 97,
+			85, 249, 153, 141, 76, 76, 206, 142, 170, 253, 202, 106, 6, 54,
+			32, 154, 240, 101, 81, 155, 115, 185, 238, 147, 252, 104, 132, 244,
+			11, 45, 110, 61, 50, 234, 137, 42, 170, 174, 96, 37, 220, 33,
+			42, 133, 103, 198, 58, 72, 45, 192, 4, 43, 141, 182, 76, 77,
+			212, 53, 150, 9, 54, 156, 233, 2, 13, 202, 254, 105, 245, 251,
+			248, 45, 120, 117, 66, 125, 155, 152, 156, 77, 170, 13, 224, 18,
+			16, 92, 52, 44, 239, 47, 42, 240, 30, 181, 127, 29, 107, 73,
+			232, 188, 30, 248, 77, 64, 138, 132, 200, 9, 203, 122, 175, 170,
+			169, 212, 140, 92, 66, 116, 65, 53, 188, 12, 212, 38, 247, 244,
+			120, 9, 187, 26, 170, 27, 140, 41, 66, 249, 80, 2, 53, 218,
+			141, 15, 83, 210, 233, 242, 245, 30, 46, 87, 106, 65, 61, 9,
+			21, 240, 0, 121, 75, 49, 18, 181, 168, 82, 10, 154, 247, 9,
+			213, 136, 207, 73, 26, 151, 72, 147, 242, 134, 203, 181, 48, 138,
+			42, 58, 137, 22, 34, 78, 204, 244, 136, 80, 18, 114, 220, 244,
+			196, 51, 205, 197, 105, 70, 124, 157, 32, 35, 137, 154, 38, 140,
+			36, 64, 81, 78, 166, 46, 92, 60, 207, 244, 120, 226, 87, 108,
+			225, 184, 72, 49, 100, 23, 172, 251, 50, 239, 178, 101, 129, 107,
+			34, 198, 52, 218, 188, 98, 109, 20, 3, 204, 224, 12, 85, 169,
+			7, 28, 93, 71, 181, 239, 49, 17, 162, 120, 50, 26, 42, 186,
+			208, 4, 199, 245, 30, 41, 105, 59, 173, 190, 102, 118, 101, 129,
+			42, 219, 216, 124, 218, 153, 137, 24, 161, 14, 205, 34, 2, 247,
+			171, 114, 153, 146, 100, 201, 44, 88, 202, 152, 126, 115, 236, 82,
+			37, 184, 28, 212, 163, 172, 82, 35, 115, 66, 69, 135, 32, 155,
+			148, 1, 75, 150, 17, 102, 64, 152, 107, 47, 174, 175, 163, 129,
+			76, 248, 34, 79, 4, 60, 84, 77, 34, 177, 222, 56, 12, 17,
+			196, 237, 0, 67, 99, 33, 239, 212, 182, 105, 50, 55, 222, 20,
+			181, 149, 70, 174, 19, 168, 2, 153, 36, 206, 159, 154, 91, 60,
+			27, 195, 184, 69, 164, 177, 108, 41, 207, 46, 184, 131, 186, 101,
+			121, 118, 97, 232, 22, 221, 178, 61, 187, 112, 248, 94, 241, 1,
+			135, 86, 56, 229, 217, 175, 182, 10, 153, 119, 59, 215, 191, 194,
+			9, 158, 208, 52, 213, 255, 9, 87, 254, 44, 100, 29, 49, 211,
+			74, 27, 13, 36, 86, 31, 85, 140, 248, 172, 103, 176, 200, 83,
+			108, 95, 118, 90, 83, 14, 224, 38, 77, 54, 10, 90, 62, 235,
+			128, 219, 156, 224, 100, 94, 44, 199, 84, 210, 100, 112, 137, 40,
+			10, 86, 137, 99, 64, 193, 168, 36, 53, 43, 131, 101, 198, 237,
+			72, 165, 193, 169, 169, 144, 239, 161, 162, 205, 214, 208, 186, 214,
+			130, 122, 3, 17, 159, 148, 0, 172, 195, 240, 163, 134, 196, 224,
+			243, 252, 106, 87, 234, 150, 229, 217, 175, 126, 89, 94, 183, 108,
+			207, 126, 245, 93, 231, 196, 135, 186, 137, 196, 44, 207, 110, 88,
+			83, 153, 231, 187, 165, 178, 131, 83, 80, 70, 145, 204, 149, 223,
+			77, 252, 228, 197, 210, 6, 41, 28, 42, 88, 94, 22, 87, 2,
+			100, 136, 61, 152, 112, 77, 57, 24, 151, 60, 1, 76, 205, 134,
+			125, 206, 193, 151, 103, 214, 34, 218, 215, 172, 121, 195, 85, 33,
+			3, 54, 100, 240, 120, 37, 106, 68, 177, 246, 155, 24, 206, 248,
+			192, 243, 228, 141, 38, 188, 234, 55, 138, 43, 28, 230, 76, 218,
+			68, 242, 171, 92, 18, 95, 137, 121, 11, 169, 174, 188, 207, 159,
+			43, 76, 27, 203, 71, 27, 67, 52, 64, 235, 177, 8, 62, 185,
+			129, 163, 53, 14, 182, 77, 224, 153, 120, 78, 14, 88, 164, 64,
+			60, 193, 39, 140, 188, 156, 71, 218, 145, 40, 226, 242, 195, 201,
+			47, 41, 51, 37, 145, 13, 244, 10, 10, 41, 77, 18, 185, 194,
+			100, 212, 206, 167, 209, 93, 208, 161, 67, 195, 145, 227, 62, 90,
+			240, 43, 54, 193, 138, 212, 87, 83, 211, 223, 59, 51, 191, 48,
+			223, 137, 77, 52, 98, 157, 224, 178, 79, 145, 157, 37, 50, 175,
+			39, 224, 229, 93, 124, 217, 143, 212, 172, 130, 82, 94, 158, 243,
+			35, 156, 9, 117, 245, 228, 142, 196, 130, 125, 15, 184, 150, 0,
+			20, 135, 16, 80, 6, 199, 18, 167, 105, 159, 171, 201, 104, 93,
+			169, 132, 186, 146, 113, 231, 142, 204, 62, 91, 167, 125, 89, 74,
+			98, 199, 108, 116, 20, 244, 105, 184, 25, 221, 194, 102, 30, 30,
+			213, 45, 219, 179, 27, 183, 159, 20, 111, 80, 178, 196, 246, 156,
+			167, 83, 214, 169, 204, 127, 143, 213, 5, 159, 99, 22, 88, 235,
+			76, 44, 11, 132, 94, 165, 214, 70, 227, 64, 227, 98, 141, 14,
+			95, 109, 247, 120, 106, 87, 114, 200, 63, 115, 2, 45, 1, 98,
+			46, 28, 201, 17, 205, 34, 133, 65, 128, 47, 171, 33, 169, 238,
+			165, 160, 225, 115, 232, 173, 138, 253, 165, 172, 172, 178, 245, 118,
+			205, 100, 197, 132, 232, 194, 145, 0, 138, 246, 6, 35, 120, 166,
+			161, 143, 11, 190, 78, 250, 31, 60, 190, 70, 127, 171, 105, 50,
+			79, 133, 158, 165, 117, 187, 38, 146, 132, 85, 168, 198, 201, 168,
+			244, 214, 195, 201, 59, 33, 12, 116, 180, 188, 186, 103, 165, 157,
+			206, 170, 244, 136, 74, 220, 161, 78, 202, 108, 100, 20, 56, 180,
+			44, 5, 216, 24, 126, 77, 245, 11, 86, 109, 150, 129, 163, 93,
+			147, 75, 209, 46, 200, 106, 97, 19, 22, 33, 84, 20, 21, 177,
+			37, 137, 240, 226, 195, 236, 82, 5, 39, 37, 91, 23, 63, 109,
+			218, 248, 42, 32, 213, 237, 178, 236, 20, 8, 194, 221, 173, 155,
+			22, 154, 131, 55, 235, 38, 145, 203, 248, 148, 248, 121, 37, 38,
+			28, 207, 249, 153, 148, 53, 145, 121, 83, 183, 196, 29, 72, 164,
+			53, 83, 224, 170, 36, 163, 245, 37, 205, 171, 101, 236, 239, 157,
+			176, 132, 198, 228, 163, 190, 102, 131, 44, 101, 47, 41, 85, 234,
+			160, 247, 82, 16, 21, 81, 226, 0, 105, 50, 195, 122, 92, 52,
+			15, 225, 202, 213, 106, 242, 103, 62, 206, 243, 170, 80, 10, 54,
+			170, 174, 88, 13, 30, 175, 20, 17, 235, 182, 182, 130, 32, 185,
+			234, 70, 219, 193, 7, 178, 199, 0, 254, 104, 214, 207, 230, 232,
+			220, 161, 254, 41, 142, 151, 244, 31, 193, 120, 57, 251, 88, 78,
+			86, 227, 194, 144, 89, 31, 249, 143, 65, 0, 75, 1, 91, 175,
+			158, 212, 61, 29, 151, 143, 82, 47, 143, 229, 120, 29, 244, 163,
+			98, 246, 177, 167, 64, 92, 186, 31, 228, 177, 76, 204, 43, 191,
+			73, 63, 155, 64, 211, 218, 183, 254, 85, 13, 194, 185, 201, 121,
+			164, 166, 81, 98, 74, 35, 124, 235, 225, 104, 229, 116, 167, 242,
+			82, 197, 156, 105, 98, 202, 227, 3, 59, 5, 150, 130, 75, 95,
+			130, 145, 129, 88, 189, 158, 146, 175, 73, 175, 157, 92, 121, 93,
+			193, 180, 13, 173, 134, 77, 198, 134, 156, 104, 90, 102, 221, 39,
+			186, 130, 109, 71, 119, 77, 251, 174, 78, 155, 133, 213, 30, 158,
+			64, 243, 152, 162, 101, 40, 57, 2, 181, 93, 101, 117, 37, 152,
+			153, 161, 173, 84, 130, 58, 172, 18, 27, 241, 70, 69, 180, 26,
+			114, 66, 228, 104, 138, 132, 205, 246, 249, 240, 14, 143, 119, 161,
+			230, 144, 128, 149, 66, 37, 17, 95, 8, 9, 218, 188, 125, 81,
+			160, 164, 38, 203, 245, 0, 115, 92, 170, 135, 151, 89, 139, 169,
+			27, 191, 8, 22, 20, 28, 37, 73, 166, 131, 228, 65, 148, 57,
+			74, 17, 25, 110, 160, 134, 98, 221, 106, 97, 99, 5, 184, 10,
+			170, 17, 231, 47, 117, 187, 44, 39, 133, 93, 234, 246, 235, 166,
+			133, 230, 142, 172, 110, 218, 104, 142, 189, 82, 60, 109, 209, 150,
+			78, 123, 206, 219, 82, 214, 201, 204, 223, 166, 100, 194, 111, 64,
+			174, 250, 152, 68, 98, 120, 76, 72, 197, 193, 24, 133, 170, 30,
+			172, 134, 176, 25, 86, 26, 148, 9, 145, 47, 59, 180, 71, 130,
+			78, 183, 160, 100, 184, 230, 62, 177, 10, 231, 151, 203, 65, 17,
+			90, 110, 3, 70, 3, 140, 48, 18, 31, 177, 57, 239, 107, 57,
+			104, 20, 87, 16, 205, 58, 218, 164, 90, 118, 208, 35, 53, 148,
+			204, 98, 12, 173, 183, 41, 133, 137, 19, 134, 193, 88, 58, 5,
+			36, 184, 59, 116, 211, 66, 211, 147, 186, 105, 163, 121, 219, 125,
+			226, 87, 82, 132, 49, 215, 115, 222, 149, 178, 166, 50, 239, 76,
+			201, 38, 103, 137, 206, 56, 211, 59, 202, 95, 246, 43, 53, 204,
+			98, 166, 113, 16, 66, 31, 55, 16, 148, 0, 46, 129, 245, 151,
+			116, 146, 110, 10, 112, 187, 59, 117, 211, 66, 115, 64, 147, 133,
+			107, 163, 57, 118, 82, 188, 45, 77, 147, 236, 246, 156, 95, 195,
+			36, 127, 50, 45, 155, 92, 58, 204, 162, 39, 225, 25, 73, 102,
+			188, 171, 86, 105, 73, 141, 17, 35, 39, 225, 64, 136, 219, 144,
+			114, 36, 100, 208, 40, 142, 198, 212, 18, 19, 127, 139, 145, 102,
+			189, 86, 10, 243, 114, 174, 86, 12, 154, 6, 66, 44, 58, 65,
+			83, 202, 49, 157, 44, 227, 74, 170, 28, 18, 62, 13, 97, 162,
+			168, 29, 217, 210, 105, 211, 162, 158, 80, 3, 246, 69, 20, 37,
+			9, 85, 210, 12, 170, 136, 26, 174, 71, 114, 173, 82, 171, 5,
+			37, 185, 82, 137, 26, 97, 29, 242, 130, 110, 205, 132, 46, 11,
+			3, 155, 178, 190, 58, 91, 143, 244, 85, 22, 204, 63, 250, 18,
+			171, 232, 171, 84, 49, 72, 198, 2, 227, 95, 77, 6, 143, 55,
+			234, 200, 221, 130, 27, 10, 220, 132, 77, 162, 182, 48, 110, 136,
+			96, 89, 148, 43, 149, 146, 230, 110, 102, 86, 181, 168, 1, 246,
+			24, 150, 213, 236, 240, 123, 108, 175, 195, 252, 245, 189, 8, 229,
+			150, 80, 218, 72, 60, 71, 163, 103, 249, 165, 213, 138, 182, 52,
+			30, 87, 224, 132, 151, 107, 200, 204, 27, 150, 69, 11, 190, 137,
+			93, 17, 75, 98, 165, 151, 129, 137, 18, 130, 130, 36, 92, 27,
+			109, 181, 104, 37, 108, 169, 245, 85, 135, 52, 30, 73, 180, 153,
+			179, 15, 77, 204, 206, 76, 157, 159, 40, 156, 94, 164, 172, 153,
+			149, 114, 147, 170, 142, 3, 153, 95, 133, 126, 71, 97, 255, 50,
+			97, 40, 169, 148, 155, 208, 211, 89, 113, 233, 78, 129, 66, 13,
+			57, 119, 91, 104, 26, 114, 238, 182, 209, 28, 59, 41, 222, 235,
+			18, 57, 247, 120, 206, 255, 7, 114, 126, 171, 43, 181, 235, 80,
+			148, 180, 253, 51, 177, 26, 94, 30, 227, 105, 164, 82, 214, 71,
+			0, 21, 16, 198, 229, 8, 160, 111, 129, 102, 176, 128, 250, 76,
+			2, 58, 185, 236, 211, 81, 46, 161, 255, 108, 4, 141, 81, 40,
+			99, 234, 56, 68, 133, 153, 100, 45, 184, 204, 221, 137, 120, 37,
+			129, 250, 73, 210, 245, 248, 14, 138, 146, 9, 113, 58, 218, 199,
+			131, 226, 122, 35, 104, 73, 171, 171, 175, 14, 105, 165, 142, 228,
+			229, 68, 163, 1, 53, 18, 31, 104, 0, 58, 205, 114, 105, 131,
+			116, 74, 208, 89, 171, 35, 21, 45, 197, 81, 108, 61, 153, 116,
+			226, 147, 202, 243, 49, 199, 64, 55, 175, 15, 212, 126, 76, 157,
+			76, 26, 149, 39, 56, 131, 17, 20, 17, 253, 122, 76, 172, 234,
+			62, 109, 158, 17, 135, 20, 3, 200, 80, 84, 225, 164, 204, 38,
+			52, 207, 176, 8, 238, 167, 206, 254, 93, 4, 222, 177, 4, 103,
+			104, 27, 128, 220, 36, 106, 58, 75, 28, 166, 217, 54, 71, 197,
+			139, 249, 186, 14, 139, 203, 219, 154, 78, 100, 218, 182, 193, 241,
+			250, 177, 103, 35, 74, 158, 240, 201, 149, 212, 234, 152, 102, 34,
+			67, 0, 137, 85, 95, 218, 160, 162, 224, 96, 79, 144, 206, 57,
+			125, 204, 17, 178, 221, 105, 146, 7, 139, 47, 70, 192, 96, 226,
+			28, 71, 49, 85, 172, 18, 7, 129, 129, 14, 194, 47, 210, 39,
+			143, 176, 206, 210, 226, 42, 130, 66, 116, 176, 145, 180, 11, 138,
+			158, 20, 54, 139, 57, 18, 244, 88, 104, 14, 30, 208, 77, 27,
+			205, 67, 39, 197, 31, 41, 105, 216, 235, 57, 255, 57, 101, 221,
+			159, 249, 116, 138, 149, 122, 141, 23, 118, 12, 96, 80, 114, 114,
+			53, 164, 236, 214, 69, 101, 214, 168, 71, 141, 43, 77, 76, 159,
+			163, 96, 97, 3, 207, 27, 253, 86, 167, 166, 13, 14, 29, 153,
+			138, 89, 156, 152, 9, 25, 108, 244, 166, 48, 65, 195, 103, 122,
+			45, 52, 7, 70, 116, 211, 70, 243, 216, 25, 241, 81, 165, 77,
+			9, 207, 249, 124, 202, 58, 151, 249, 101, 75, 3, 102, 70, 140,
+			241, 66, 122, 30, 77, 22, 212, 169, 172, 71, 144, 145, 230, 78,
+			9, 152, 249, 158, 245, 160, 94, 225, 27, 36, 179, 115, 9, 167,
+			155, 93, 47, 168, 218, 38, 141, 36, 9, 9, 109, 117, 253, 254,
+			245, 160, 190, 49, 250, 175, 3, 227, 34, 5, 36, 186, 187, 116,
+			211, 66, 115, 119, 78, 55, 109, 52, 95, 126, 86, 252, 166, 58,
+			146, 246, 121, 206, 215, 83, 214, 29, 153, 23, 186, 229, 36, 219,
+			43, 21, 123, 173, 7, 101, 112, 93, 165, 173, 96, 51, 61, 206,
+			7, 11, 182, 16, 76, 64, 35, 193, 102, 243, 229, 234, 186, 74,
+			153, 130, 123, 194, 146, 170, 62, 136, 19, 107, 72, 59, 56, 254,
+			176, 117, 37, 73, 97, 23, 218, 12, 65, 183, 174, 164, 110, 176,
+			115, 73, 226, 166, 185, 249, 188, 131, 12, 140, 141, 32, 106, 100,
+			1, 95, 54, 162, 177, 179, 88, 27, 184, 103, 18, 231, 55, 23,
+			150, 37, 3, 69, 251, 130, 231, 229, 116, 126, 57, 31, 119, 70,
+			211, 169, 9, 253, 115, 36, 179, 19, 202, 231, 35, 123, 50, 43,
+			181, 103, 13, 100, 125, 117, 35, 145, 23, 6, 138, 17, 217, 147,
+			193, 115, 232, 78, 155, 5, 142, 143, 26, 20, 180, 160, 0, 237,
+			92, 200, 229, 102, 224, 42, 83, 230, 210, 135, 6, 17, 42, 125,
+			48, 18, 230, 173, 250, 23, 89, 57, 52, 63, 102, 81, 171, 22,
+			185, 122, 120, 158, 172, 213, 144, 133, 8, 106, 13, 75, 220, 178,
+			70, 36, 89, 163, 160, 188, 52, 66, 211, 9, 111, 26, 182, 153,
+			225, 114, 126, 3, 19, 141, 8, 231, 56, 12, 134, 81, 99, 172,
+			158, 112, 108, 151, 28, 115, 2, 131, 229, 139, 213, 104, 152, 187,
+			150, 195, 102, 235, 197, 183, 85, 185, 9, 235, 205, 200, 210, 184,
+			32, 34, 164, 243, 107, 211, 190, 161, 209, 59, 217, 123, 43, 229,
+			230, 126, 204, 17, 74, 89, 156, 24, 27, 12, 205, 196, 201, 185,
+			194, 194, 244, 84, 219, 71, 208, 150, 137, 228, 97, 71, 85, 183,
+			13, 28, 183, 211, 102, 250, 175, 52, 180, 252, 108, 151, 134, 1,
+			157, 236, 212, 105, 126, 169, 142, 128, 58, 34, 195, 118, 229, 217,
+			108, 249, 190, 20, 118, 177, 187, 93, 55, 45, 52, 251, 53, 207,
+			237, 179, 209, 220, 127, 76, 252, 55, 37, 114, 182, 120, 206, 127,
+			199, 145, 245, 79, 80, 84, 133, 247, 55, 8, 136, 205, 187, 210,
+			39, 235, 56, 170, 58, 86, 202, 73, 43, 47, 1, 202, 239, 125,
+			215, 18, 68, 203, 114, 51, 10, 182, 164, 48, 103, 131, 160, 45,
+			22, 154, 253, 250, 132, 186, 197, 70, 243, 182, 251, 196, 55, 20,
+			130, 182, 122, 206, 107, 44, 235, 68, 230, 175, 180, 76, 198, 217,
+			139, 243, 71, 33, 161, 225, 38, 98, 25, 219, 151, 195, 202, 99,
+			9, 253, 162, 16, 197, 194, 228, 95, 0, 81, 91, 83, 152, 187,
+			187, 77, 55, 45, 52, 183, 239, 215, 77, 27, 205, 209, 187, 196,
+			151, 250, 8, 81, 219, 60, 231, 221, 150, 53, 149, 249, 131, 62,
+			168, 206, 144, 189, 81, 147, 143, 35, 73, 229, 102, 94, 7, 156,
+			204, 87, 170, 10, 93, 209, 197, 202, 90, 172, 253, 105, 241, 77,
+			7, 94, 188, 7, 135, 119, 226, 188, 217, 139, 193, 198, 113, 138,
+			171, 134, 235, 76, 165, 195, 126, 74, 184, 44, 234, 9, 198, 35,
+			194, 210, 185, 209, 36, 103, 128, 89, 146, 233, 216, 220, 9, 173,
+			83, 127, 3, 137, 167, 222, 98, 97, 36, 18, 21, 120, 142, 131,
+			225, 249, 128, 82, 86, 98, 165, 3, 188, 6, 115, 199, 135, 164,
+			116, 132, 181, 24, 134, 102, 93, 78, 29, 38, 241, 125, 231, 84,
+			180, 149, 88, 11, 100, 195, 48, 174, 181, 252, 229, 166, 41, 64,
+			84, 34, 89, 51, 66, 209, 244, 205, 155, 249, 106, 102, 202, 224,
+			47, 150, 45, 204, 64, 150, 2, 115, 61, 165, 180, 155, 234, 70,
+			236, 225, 83, 138, 173, 72, 74, 20, 69, 240, 189, 165, 122, 89,
+			132, 47, 115, 105, 219, 17, 105, 188, 20, 70, 37, 203, 73, 191,
+			88, 15, 35, 44, 113, 53, 22, 172, 163, 230, 86, 111, 67, 150,
+			66, 108, 86, 210, 163, 212, 93, 153, 194, 200, 113, 25, 33, 63,
+			45, 80, 196, 117, 69, 18, 104, 22, 114, 117, 189, 218, 168, 172,
+			85, 227, 9, 71, 114, 132, 81, 64, 223, 133, 245, 100, 230, 54,
+			61, 44, 80, 50, 97, 188, 242, 170, 27, 202, 178, 34, 71, 214,
+			213, 93, 12, 54, 249, 40, 145, 155, 113, 201, 82, 32, 177, 39,
+			39, 14, 25, 149, 98, 78, 93, 78, 211, 18, 34, 103, 56, 25,
+			47, 88, 240, 199, 202, 70, 124, 205, 28, 150, 53, 0, 49, 176,
+			58, 59, 24, 185, 113, 171, 110, 141, 30, 17, 31, 98, 98, 141,
+			133, 60, 107, 86, 215, 194, 58, 10, 74, 210, 10, 16, 224, 134,
+			146, 59, 208, 40, 205, 149, 80, 6, 10, 87, 196, 250, 237, 85,
+			58, 0, 1, 211, 163, 89, 155, 255, 101, 213, 142, 255, 21, 148,
+			140, 109, 41, 112, 123, 99, 23, 223, 102, 161, 105, 236, 226, 219,
+			108, 52, 199, 78, 138, 167, 213, 69, 233, 118, 207, 249, 160, 101,
+			77, 101, 254, 214, 150, 83, 193, 102, 162, 65, 33, 100, 67, 94,
+			14, 234, 49, 21, 25, 51, 36, 244, 201, 14, 0, 201, 122, 184,
+			222, 168, 212, 2, 108, 96, 218, 139, 124, 248, 171, 199, 124, 141,
+			246, 173, 217, 90, 35, 198, 254, 136, 94, 112, 59, 154, 48, 138,
+			83, 110, 70, 25, 22, 139, 126, 164, 83, 64, 175, 71, 65, 121,
+			189, 74, 59, 171, 92, 121, 28, 251, 39, 42, 214, 201, 224, 20,
+			177, 191, 104, 190, 117, 59, 160, 76, 141, 137, 209, 66, 135, 58,
+			135, 50, 237, 136, 165, 13, 101, 95, 152, 103, 49, 192, 62, 42,
+			128, 70, 233, 200, 243, 184, 37, 187, 24, 232, 45, 11, 190, 61,
+			250, 98, 233, 159, 239, 70, 255, 69, 233, 159, 105, 98, 123, 10,
+			68, 96, 40, 102, 187, 133, 166, 161, 152, 237, 54, 154, 99, 39,
+			197, 71, 122, 136, 98, 250, 61, 231, 183, 45, 107, 54, 243, 254,
+			158, 206, 202, 132, 113, 43, 8, 106, 13, 58, 218, 183, 43, 22,
+			19, 9, 246, 214, 244, 250, 6, 208, 227, 203, 139, 193, 198, 24,
+			169, 17, 164, 69, 228, 216, 215, 28, 19, 187, 24, 168, 87, 68,
+			194, 59, 158, 227, 2, 180, 7, 138, 250, 176, 210, 82, 207, 102,
+			169, 26, 46, 113, 149, 212, 59, 142, 28, 149, 15, 44, 65, 63,
+			140, 42, 79, 240, 25, 153, 163, 51, 46, 178, 254, 65, 130, 206,
+			72, 175, 88, 70, 113, 217, 153, 246, 13, 76, 246, 111, 115, 236,
+			84, 39, 226, 162, 95, 109, 153, 137, 162, 254, 82, 80, 90, 167,
+			220, 181, 141, 160, 116, 156, 183, 19, 168, 151, 245, 43, 97, 118,
+			88, 140, 74, 204, 70, 214, 194, 112, 13, 98, 103, 157, 200, 54,
+			254, 49, 44, 39, 241, 203, 232, 33, 224, 87, 253, 53, 141, 60,
+			66, 42, 167, 70, 15, 99, 95, 8, 37, 198, 121, 94, 10, 21,
+			237, 11, 210, 30, 31, 146, 240, 206, 56, 46, 125, 240, 186, 21,
+			236, 153, 216, 193, 31, 181, 59, 49, 160, 175, 203, 30, 202, 17,
+			92, 230, 105, 211, 164, 113, 163, 208, 233, 35, 203, 113, 186, 201,
+			81, 186, 93, 76, 168, 80, 57, 145, 44, 214, 145, 131, 224, 162,
+			99, 35, 11, 74, 144, 25, 187, 181, 96, 83, 98, 93, 155, 163,
+			31, 111, 72, 168, 239, 50, 9, 213, 159, 2, 11, 113, 7, 116,
+			211, 66, 115, 215, 33, 221, 180, 209, 188, 227, 126, 241, 27, 234,
+			138, 110, 135, 231, 124, 30, 252, 230, 23, 211, 157, 37, 84, 19,
+			189, 86, 130, 43, 72, 171, 105, 126, 1, 155, 176, 153, 166, 85,
+			64, 70, 130, 170, 85, 244, 130, 98, 6, 236, 190, 177, 68, 44,
+			28, 182, 72, 162, 110, 178, 220, 183, 178, 42, 237, 176, 175, 98,
+			66, 174, 78, 243, 194, 16, 125, 78, 127, 202, 149, 12, 112, 31,
+			179, 217, 151, 17, 251, 111, 107, 97, 166, 127, 231, 56, 81, 229,
+			218, 122, 240, 98, 176, 113, 144, 116, 133, 131, 4, 223, 65, 185,
+			22, 174, 41, 224, 213, 85, 117, 45, 108, 136, 102, 247, 64, 186,
+			190, 105, 248, 88, 65, 154, 93, 179, 89, 112, 211, 121, 208, 73,
+			132, 17, 162, 0, 147, 190, 236, 20, 32, 76, 178, 82, 129, 140,
+			14, 205, 194, 145, 128, 110, 34, 252, 4, 195, 251, 215, 47, 94,
+			119, 192, 208, 27, 147, 251, 14, 24, 122, 99, 114, 223, 1, 67,
+			175, 117, 199, 253, 226, 205, 202, 207, 221, 243, 156, 47, 89, 214,
+			233, 204, 143, 217, 108, 212, 104, 163, 111, 35, 30, 218, 5, 235,
+			180, 34, 85, 109, 116, 110, 22, 19, 236, 129, 164, 187, 161, 141,
+			205, 115, 14, 74, 32, 100, 14, 217, 129, 103, 138, 72, 120, 26,
+			49, 97, 54, 233, 255, 72, 26, 11, 142, 223, 6, 28, 133, 248,
+			38, 140, 45, 40, 81, 83, 143, 26, 47, 90, 77, 210, 6, 251,
+			239, 130, 117, 244, 82, 88, 26, 215, 211, 77, 11, 205, 157, 183,
+			234, 166, 141, 230, 145, 105, 241, 95, 213, 58, 238, 244, 156, 111,
+			88, 214, 43, 51, 159, 179, 229, 2, 157, 219, 98, 131, 121, 66,
+			97, 33, 107, 5, 59, 151, 112, 152, 227, 21, 12, 36, 16, 207,
+			45, 157, 232, 163, 29, 121, 168, 179, 175, 214, 4, 255, 56, 166,
+			163, 251, 204, 196, 102, 166, 216, 9, 48, 235, 47, 21, 75, 65,
+			57, 159, 207, 231, 179, 236, 153, 165, 46, 10, 96, 97, 208, 239,
+			176, 197, 221, 252, 78, 150, 2, 245, 19, 67, 113, 252, 72, 254,
+			200, 225, 252, 177, 236, 119, 227, 234, 54, 153, 67, 19, 43, 205,
+			160, 95, 77, 182, 226, 36, 110, 86, 167, 17, 118, 176, 101, 24,
+			194, 216, 153, 194, 90, 155, 13, 190, 211, 66, 211, 108, 240, 157,
+			54, 154, 119, 220, 35, 126, 88, 221, 157, 13, 120, 206, 15, 217,
+			214, 125, 153, 175, 167, 80, 90, 184, 180, 142, 227, 55, 194, 131,
+			151, 225, 160, 97, 170, 40, 38, 244, 31, 232, 54, 228, 33, 212,
+			68, 179, 102, 77, 225, 187, 241, 93, 143, 127, 13, 173, 193, 217,
+			64, 10, 104, 48, 56, 27, 176, 208, 52, 56, 27, 176, 209, 188,
+			227, 94, 241, 25, 117, 74, 221, 229, 57, 207, 216, 214, 124, 230,
+			223, 199, 177, 33, 9, 167, 89, 142, 170, 52, 134, 59, 179, 68,
+			64, 203, 84, 176, 22, 212, 74, 28, 166, 71, 254, 239, 48, 150,
+			233, 4, 237, 122, 58, 241, 69, 29, 11, 100, 117, 18, 247, 17,
+			168, 22, 176, 27, 187, 238, 149, 156, 36, 18, 118, 53, 200, 216,
+			165, 13, 121, 121, 37, 92, 69, 55, 229, 72, 93, 173, 113, 184,
+			19, 69, 204, 36, 111, 61, 201, 50, 134, 167, 56, 246, 146, 107,
+			20, 216, 116, 66, 249, 226, 123, 83, 36, 134, 40, 193, 192, 7,
+			85, 192, 175, 169, 107, 205, 7, 253, 13, 21, 107, 172, 105, 3,
+			230, 69, 25, 85, 86, 147, 148, 137, 109, 20, 161, 86, 191, 10,
+			2, 200, 129, 164, 181, 87, 41, 18, 71, 156, 63, 196, 133, 195,
+			162, 22, 44, 208, 241, 164, 236, 195, 203, 78, 95, 107, 234, 95,
+			216, 105, 32, 34, 175, 156, 86, 132, 144, 188, 139, 190, 27, 137,
+			240, 106, 60, 125, 23, 170, 158, 219, 198, 9, 96, 151, 133, 230,
+			96, 94, 55, 109, 52, 239, 250, 30, 241, 67, 138, 167, 239, 246,
+			156, 103, 109, 235, 193, 204, 215, 172, 43, 146, 33, 92, 171, 180,
+			10, 200, 36, 9, 220, 44, 70, 65, 169, 69, 65, 68, 200, 221,
+			152, 114, 121, 87, 6, 162, 18, 71, 1, 39, 241, 216, 54, 39,
+			77, 230, 97, 253, 138, 83, 252, 159, 242, 236, 176, 59, 133, 53,
+			48, 124, 99, 183, 133, 166, 225, 27, 187, 109, 52, 239, 120, 64,
+			188, 73, 208, 130, 13, 122, 206, 255, 107, 91, 43, 153, 31, 17,
+			87, 88, 48, 74, 101, 7, 141, 8, 30, 109, 40, 254, 86, 82,
+			186, 236, 146, 206, 175, 210, 18, 105, 42, 180, 241, 88, 123, 121,
+			107, 75, 176, 47, 107, 149, 226, 74, 208, 82, 143, 74, 151, 19,
+			161, 143, 40, 129, 138, 10, 222, 142, 18, 241, 5, 116, 255, 140,
+			100, 1, 166, 212, 37, 248, 39, 57, 255, 193, 66, 13, 57, 172,
+			239, 79, 150, 214, 107, 197, 149, 132, 185, 26, 140, 69, 200, 16,
+			220, 78, 78, 215, 235, 134, 129, 196, 166, 110, 191, 195, 165, 122,
+			188, 10, 35, 9, 183, 188, 166, 104, 29, 226, 44, 151, 87, 194,
+			106, 160, 172, 237, 117, 34, 73, 240, 187, 72, 182, 229, 140, 75,
+			68, 148, 10, 89, 12, 235, 202, 145, 137, 216, 174, 73, 83, 131,
+			148, 117, 218, 168, 80, 147, 87, 201, 98, 147, 199, 93, 60, 71,
+			158, 130, 81, 82, 245, 76, 164, 95, 88, 175, 41, 203, 137, 178,
+			114, 95, 246, 55, 58, 239, 17, 158, 69, 80, 50, 10, 19, 95,
+			60, 104, 4, 124, 123, 55, 11, 84, 72, 19, 207, 173, 201, 221,
+			111, 39, 33, 148, 28, 244, 171, 213, 171, 238, 173, 239, 220, 182,
+			2, 234, 166, 143, 147, 226, 159, 76, 164, 162, 207, 161, 166, 244,
+			24, 168, 129, 10, 231, 4, 181, 18, 201, 55, 190, 28, 73, 40,
+			42, 116, 253, 84, 15, 112, 188, 184, 196, 177, 107, 42, 115, 50,
+			93, 175, 180, 111, 39, 20, 222, 147, 84, 63, 111, 220, 252, 56,
+			126, 55, 255, 122, 239, 248, 109, 227, 119, 243, 218, 221, 43, 4,
+			143, 70, 222, 241, 4, 154, 244, 229, 177, 195, 71, 101, 61, 96,
+			207, 251, 70, 24, 107, 81, 235, 245, 170, 145, 38, 23, 160, 31,
+			93, 48, 144, 180, 50, 215, 185, 7, 90, 40, 134, 164, 187, 174,
+			155, 72, 104, 27, 89, 11, 234, 43, 48, 156, 209, 54, 130, 233,
+			121, 52, 94, 248, 118, 169, 83, 35, 227, 68, 43, 21, 234, 229,
+			137, 18, 4, 247, 29, 23, 144, 204, 37, 7, 83, 96, 139, 238,
+			62, 221, 180, 208, 220, 127, 183, 110, 218, 104, 158, 46, 163, 154,
+			142, 149, 238, 242, 220, 143, 219, 93, 255, 217, 78, 101, 94, 159,
+			162, 240, 115, 190, 207, 170, 212, 12, 165, 112, 224, 194, 196, 228,
+			44, 177, 180, 9, 188, 80, 143, 205, 168, 73, 91, 13, 9, 0,
+			229, 211, 135, 201, 196, 26, 27, 79, 9, 100, 184, 33, 151, 131,
+			134, 208, 129, 75, 156, 117, 169, 90, 77, 134, 221, 228, 197, 209,
+			185, 241, 111, 235, 255, 132, 232, 19, 118, 186, 43, 229, 57, 31,
+			183, 211, 189, 98, 139, 112, 210, 8, 57, 119, 126, 203, 182, 6,
+			129, 23, 180, 82, 212, 244, 116, 211, 66, 115, 215, 110, 241, 92,
+			138, 94, 78, 121, 206, 127, 180, 173, 173, 153, 55, 192, 91, 195,
+			47, 193, 141, 17, 40, 80, 138, 187, 158, 102, 76, 5, 32, 44,
+			253, 212, 28, 162, 201, 146, 162, 163, 250, 99, 253, 10, 225, 149,
+			168, 194, 233, 47, 243, 95, 80, 50, 71, 141, 21, 135, 145, 215,
+			218, 13, 214, 3, 58, 163, 2, 23, 37, 248, 254, 163, 109, 245,
+			232, 166, 133, 102, 223, 22, 241, 90, 5, 61, 76, 112, 128, 254,
+			9, 249, 48, 144, 206, 192, 151, 66, 132, 136, 212, 55, 84, 244,
+			133, 190, 71, 209, 115, 203, 201, 181, 234, 186, 142, 24, 36, 95,
+			52, 158, 80, 148, 19, 218, 35, 182, 109, 226, 57, 214, 131, 121,
+			54, 112, 107, 33, 157, 217, 0, 106, 193, 56, 24, 3, 170, 32,
+			235, 219, 34, 94, 80, 128, 218, 158, 243, 59, 182, 181, 37, 243,
+			142, 148, 156, 187, 92, 187, 2, 160, 151, 227, 121, 48, 160, 176,
+			174, 183, 225, 138, 140, 112, 29, 194, 139, 77, 68, 121, 130, 236,
+			212, 125, 56, 149, 36, 216, 216, 188, 163, 38, 58, 229, 89, 32,
+			252, 236, 119, 108, 171, 91, 55, 45, 52, 69, 159, 216, 66, 197,
+			208, 220, 207, 216, 93, 159, 179, 83, 32, 65, 7, 36, 248, 25,
+			187, 103, 151, 184, 135, 106, 54, 117, 121, 206, 103, 109, 107, 32,
+			51, 46, 39, 226, 0, 33, 204, 178, 197, 127, 91, 159, 203, 85,
+			94, 137, 124, 178, 26, 228, 103, 237, 166, 106, 144, 159, 181, 123,
+			183, 39, 170, 65, 126, 214, 246, 118, 138, 55, 166, 132, 229, 164,
+			60, 247, 143, 237, 174, 31, 114, 82, 153, 127, 147, 106, 141, 210,
+			172, 36, 204, 80, 218, 191, 6, 23, 198, 225, 106, 208, 110, 175,
+			224, 189, 172, 46, 237, 116, 52, 21, 69, 90, 85, 34, 61, 137,
+			142, 216, 109, 142, 227, 2, 54, 64, 181, 127, 108, 247, 236, 22,
+			219, 132, 227, 164, 236, 46, 207, 253, 130, 109, 253, 165, 109, 211,
+			4, 82, 54, 230, 243, 5, 52, 198, 133, 139, 159, 177, 99, 255,
+			212, 118, 188, 204, 1, 98, 85, 58, 254, 181, 18, 81, 98, 15,
+			125, 252, 193, 178, 108, 23, 221, 234, 3, 151, 190, 232, 137, 31,
+			164, 240, 160, 119, 107, 252, 192, 198, 131, 254, 29, 226, 117, 41,
+			30, 36, 229, 57, 95, 180, 157, 155, 50, 79, 72, 212, 58, 82,
+			91, 153, 194, 250, 205, 209, 237, 82, 144, 76, 170, 22, 148, 200,
+			247, 52, 206, 222, 66, 42, 16, 52, 104, 162, 154, 85, 153, 189,
+			251, 98, 165, 86, 186, 247, 248, 221, 100, 168, 189, 55, 171, 23,
+			147, 58, 61, 14, 19, 13, 212, 139, 44, 216, 42, 181, 18, 240,
+			167, 28, 2, 102, 75, 252, 32, 141, 7, 91, 119, 198, 15, 8,
+			220, 129, 253, 241, 3, 27, 15, 94, 150, 21, 63, 138, 106, 105,
+			41, 224, 236, 203, 32, 177, 199, 121, 209, 213, 58, 153, 229, 174,
+			196, 30, 85, 126, 163, 141, 204, 76, 25, 86, 115, 220, 55, 31,
+			170, 98, 193, 20, 47, 129, 42, 140, 122, 229, 201, 33, 183, 6,
+			182, 2, 221, 62, 171, 105, 53, 69, 180, 250, 101, 77, 171, 41,
+			90, 136, 47, 107, 90, 77, 209, 50, 124, 25, 180, 250, 78, 5,
+			117, 202, 115, 190, 102, 91, 123, 50, 255, 87, 10, 133, 120, 195,
+			53, 255, 251, 215, 205, 77, 32, 173, 130, 49, 240, 71, 205, 10,
+			112, 147, 58, 152, 152, 104, 242, 132, 214, 41, 73, 46, 116, 155,
+			53, 196, 223, 212, 26, 156, 232, 134, 70, 240, 171, 18, 62, 159,
+			196, 109, 160, 229, 232, 18, 181, 122, 86, 169, 52, 1, 170, 103,
+			5, 130, 254, 154, 221, 59, 160, 155, 54, 154, 131, 67, 226, 223,
+			168, 89, 89, 158, 243, 15, 182, 149, 203, 172, 203, 135, 249, 148,
+			29, 47, 4, 244, 18, 227, 183, 102, 138, 219, 195, 16, 11, 208,
+			85, 50, 60, 115, 190, 36, 21, 169, 158, 147, 149, 229, 90, 88,
+			135, 202, 136, 238, 216, 145, 180, 17, 118, 156, 160, 1, 217, 114,
+			9, 138, 97, 221, 76, 161, 185, 247, 160, 110, 218, 104, 30, 186,
+			77, 252, 140, 2, 217, 246, 156, 111, 98, 33, 94, 147, 146, 51,
+			64, 8, 156, 96, 120, 29, 194, 50, 140, 34, 180, 212, 77, 128,
+			155, 41, 189, 148, 179, 176, 211, 4, 152, 70, 60, 56, 240, 55,
+			99, 196, 219, 4, 246, 224, 144, 248, 49, 53, 11, 199, 115, 126,
+			208, 177, 134, 50, 79, 130, 83, 240, 62, 6, 235, 218, 184, 38,
+			198, 69, 91, 121, 213, 95, 91, 131, 244, 193, 217, 81, 72, 223,
+			228, 25, 242, 77, 154, 158, 245, 205, 217, 69, 133, 77, 18, 78,
+			202, 114, 20, 44, 166, 233, 162, 217, 183, 77, 55, 83, 104, 110,
+			223, 169, 155, 54, 154, 187, 145, 95, 5, 17, 144, 238, 15, 59,
+			93, 175, 119, 148, 36, 129, 32, 253, 97, 167, 231, 128, 120, 175,
+			37, 28, 199, 194, 62, 127, 157, 99, 29, 205, 188, 213, 146, 231,
+			130, 58, 167, 38, 137, 201, 203, 76, 153, 185, 181, 246, 127, 64,
+			146, 147, 146, 14, 245, 224, 143, 170, 65, 109, 185, 177, 210, 22,
+			112, 76, 203, 195, 154, 103, 211, 5, 15, 116, 61, 254, 212, 100,
+			52, 210, 2, 140, 156, 169, 55, 112, 104, 164, 128, 80, 115, 145,
+			128, 111, 90, 98, 150, 249, 195, 17, 146, 126, 126, 67, 1, 166,
+			178, 12, 105, 150, 36, 200, 87, 113, 77, 41, 168, 196, 200, 24,
+			58, 156, 90, 161, 43, 173, 248, 151, 0, 26, 114, 111, 24, 240,
+			180, 101, 142, 181, 37, 224, 202, 33, 100, 153, 166, 139, 102, 223,
+			30, 221, 76, 161, 153, 25, 211, 77, 27, 205, 195, 71, 104, 13,
+			108, 207, 125, 131, 211, 245, 211, 188, 6, 160, 186, 55, 56, 61,
+			251, 72, 126, 217, 144, 95, 63, 225, 88, 207, 56, 74, 126, 217,
+			36, 191, 126, 194, 17, 16, 111, 174, 99, 67, 216, 120, 206, 79,
+			58, 142, 71, 188, 218, 102, 241, 244, 147, 142, 211, 19, 63, 72,
+			225, 1, 139, 39, 155, 197, 211, 79, 58, 253, 59, 196, 89, 140,
+			128, 14, 222, 228, 88, 217, 204, 125, 114, 177, 166, 215, 13, 214,
+			206, 176, 126, 197, 180, 7, 173, 233, 73, 24, 17, 54, 33, 226,
+			77, 26, 17, 54, 137, 203, 55, 57, 125, 131, 186, 153, 194, 112,
+			67, 251, 116, 211, 70, 83, 190, 76, 60, 38, 64, 197, 238, 91,
+			157, 174, 159, 119, 82, 47, 137, 170, 238, 56, 41, 207, 121, 171,
+			211, 179, 135, 20, 88, 199, 193, 196, 127, 214, 177, 6, 50, 79,
+			92, 69, 81, 194, 142, 196, 126, 108, 145, 100, 146, 18, 33, 235,
+			47, 113, 251, 88, 141, 66, 97, 206, 129, 148, 64, 115, 53, 240,
+			107, 145, 204, 66, 160, 197, 231, 59, 221, 175, 150, 99, 14, 201,
+			177, 159, 117, 88, 142, 57, 68, 44, 63, 235, 176, 28, 115, 136,
+			88, 126, 214, 241, 118, 138, 5, 225, 56, 180, 71, 127, 206, 177,
+			118, 101, 78, 193, 222, 74, 150, 215, 28, 179, 139, 205, 146, 5,
+			176, 21, 67, 239, 77, 29, 137, 2, 166, 100, 64, 128, 208, 249,
+			57, 199, 114, 117, 147, 70, 233, 238, 215, 77, 27, 205, 157, 3,
+			226, 149, 4, 130, 229, 57, 111, 119, 172, 76, 230, 8, 64, 104,
+			212, 215, 3, 93, 42, 55, 224, 64, 104, 173, 216, 37, 168, 5,
+			185, 8, 204, 104, 86, 154, 122, 208, 163, 97, 78, 111, 119, 186,
+			119, 233, 166, 141, 230, 208, 30, 218, 29, 105, 207, 125, 167, 211,
+			245, 11, 188, 59, 16, 143, 252, 78, 167, 39, 35, 166, 133, 227,
+			164, 177, 132, 239, 118, 172, 3, 153, 151, 203, 217, 214, 220, 6,
+			250, 42, 82, 115, 82, 78, 91, 200, 96, 225, 206, 74, 75, 220,
+			52, 145, 236, 187, 53, 201, 166, 105, 57, 222, 237, 244, 237, 208,
+			77, 120, 199, 57, 94, 70, 55, 225, 14, 231, 236, 219, 79, 5,
+			209, 211, 0, 253, 189, 0, 97, 230, 234, 32, 20, 87, 42, 85,
+			189, 93, 112, 239, 201, 185, 206, 100, 163, 238, 87, 170, 96, 49,
+			7, 199, 15, 106, 134, 146, 38, 61, 237, 189, 49, 80, 88, 160,
+			247, 198, 64, 65, 43, 120, 111, 12, 20, 234, 163, 191, 23, 64,
+			61, 3, 189, 220, 245, 220, 95, 114, 186, 94, 112, 82, 153, 163,
+			146, 163, 147, 245, 69, 63, 77, 60, 113, 138, 110, 143, 149, 127,
+			105, 54, 31, 98, 172, 127, 201, 233, 217, 45, 182, 8, 199, 113,
+			177, 112, 191, 236, 88, 106, 193, 93, 66, 248, 47, 107, 250, 119,
+			9, 225, 191, 236, 244, 246, 235, 166, 141, 151, 119, 14, 224, 216,
+			108, 165, 83, 158, 251, 235, 78, 215, 191, 115, 82, 153, 123, 101,
+			123, 189, 6, 237, 93, 11, 116, 183, 5, 55, 194, 88, 26, 135,
+			75, 190, 36, 243, 76, 99, 93, 126, 221, 73, 67, 186, 58, 105,
+			210, 148, 63, 228, 88, 116, 225, 139, 86, 202, 115, 62, 228, 88,
+			47, 211, 77, 11, 205, 155, 111, 17, 19, 244, 110, 202, 115, 62,
+			236, 88, 123, 178, 199, 154, 109, 123, 80, 226, 146, 110, 182, 73,
+			167, 118, 138, 155, 174, 133, 151, 5, 247, 136, 209, 63, 236, 88,
+			91, 116, 211, 66, 115, 112, 72, 188, 156, 6, 176, 60, 231, 55,
+			48, 192, 104, 243, 0, 45, 121, 131, 216, 75, 61, 146, 115, 15,
+			232, 110, 65, 228, 191, 225, 88, 3, 186, 73, 29, 13, 14, 137,
+			251, 168, 91, 219, 115, 62, 130, 110, 143, 54, 119, 203, 166, 195,
+			37, 29, 137, 10, 77, 46, 52, 241, 173, 116, 197, 175, 251, 183,
+			83, 212, 197, 54, 221, 180, 208, 28, 28, 18, 211, 194, 114, 186,
+			61, 247, 227, 78, 215, 111, 59, 169, 204, 203, 141, 63, 9, 86,
+			217, 79, 70, 163, 37, 124, 26, 18, 113, 128, 250, 26, 5, 244,
+			135, 160, 232, 143, 59, 61, 253, 226, 78, 225, 56, 221, 88, 151,
+			79, 128, 137, 142, 200, 137, 100, 190, 81, 253, 81, 19, 171, 31,
+			47, 105, 78, 221, 77, 148, 250, 9, 77, 169, 221, 180, 160, 159,
+			208, 148, 218, 77, 172, 225, 19, 206, 206, 1, 32, 198, 233, 6,
+			214, 254, 131, 99, 237, 201, 28, 85, 23, 229, 240, 7, 44, 154,
+			4, 34, 6, 94, 24, 27, 53, 155, 228, 176, 105, 51, 92, 202,
+			165, 46, 250, 116, 147, 122, 220, 50, 160, 155, 54, 154, 131, 67,
+			196, 149, 187, 97, 246, 248, 164, 99, 13, 103, 142, 208, 33, 19,
+			122, 116, 146, 84, 154, 198, 28, 9, 215, 27, 107, 235, 42, 152,
+			76, 115, 156, 110, 226, 202, 159, 140, 39, 7, 248, 63, 233, 244,
+			238, 214, 77, 27, 205, 61, 25, 30, 205, 246, 156, 79, 57, 86,
+			62, 115, 36, 62, 119, 108, 118, 219, 217, 121, 52, 219, 165, 30,
+			134, 117, 51, 133, 230, 222, 81, 221, 164, 254, 115, 99, 36, 3,
+			122, 60, 247, 63, 57, 93, 159, 103, 25, 128, 40, 220, 255, 228,
+			244, 72, 81, 23, 142, 211, 131, 165, 252, 93, 199, 146, 153, 146,
+			156, 91, 111, 20, 195, 120, 45, 77, 228, 115, 206, 228, 181, 51,
+			105, 48, 85, 138, 202, 74, 85, 57, 230, 211, 157, 47, 76, 20,
+			156, 100, 84, 221, 173, 240, 125, 39, 46, 50, 56, 91, 53, 195,
+			222, 67, 74, 205, 239, 242, 134, 0, 8, 41, 192, 176, 107, 88,
+			55, 109, 52, 247, 31, 16, 63, 2, 69, 163, 7, 120, 252, 44,
+			78, 10, 151, 201, 183, 216, 92, 77, 36, 162, 156, 193, 133, 59,
+			4, 63, 179, 130, 27, 223, 48, 155, 92, 192, 177, 165, 47, 143,
+			243, 52, 93, 79, 67, 253, 237, 16, 153, 110, 128, 6, 49, 125,
+			86, 11, 148, 30, 34, 166, 207, 58, 125, 59, 117, 211, 198, 175,
+			187, 7, 197, 47, 41, 160, 45, 207, 249, 35, 160, 245, 231, 83,
+			9, 168, 155, 251, 55, 224, 37, 163, 246, 19, 1, 231, 173, 94,
+			9, 48, 246, 47, 7, 132, 91, 136, 62, 195, 11, 116, 156, 123,
+			35, 212, 125, 52, 81, 19, 54, 72, 211, 44, 57, 248, 146, 97,
+			50, 114, 188, 135, 142, 161, 127, 228, 88, 59, 116, 51, 133, 166,
+			167, 151, 197, 178, 209, 220, 127, 128, 116, 205, 94, 207, 253, 115,
+			167, 235, 171, 47, 149, 174, 137, 216, 232, 63, 119, 122, 246, 130,
+			243, 58, 189, 160, 209, 47, 58, 214, 174, 204, 168, 60, 203, 204,
+			38, 150, 192, 172, 93, 154, 249, 226, 230, 131, 103, 212, 75, 252,
+			230, 139, 122, 75, 246, 18, 161, 125, 81, 243, 155, 94, 226, 55,
+			95, 4, 191, 185, 159, 70, 73, 121, 206, 95, 57, 214, 96, 230,
+			110, 121, 118, 125, 117, 9, 233, 163, 202, 137, 94, 213, 69, 11,
+			114, 4, 225, 102, 171, 22, 72, 212, 29, 204, 193, 82, 227, 115,
+			154, 168, 35, 135, 15, 155, 129, 161, 110, 252, 149, 163, 204, 143,
+			104, 82, 223, 61, 59, 116, 211, 70, 115, 96, 183, 88, 161, 129,
+			45, 207, 249, 10, 24, 221, 35, 242, 33, 93, 166, 255, 96, 75,
+			53, 193, 131, 113, 106, 19, 152, 68, 40, 195, 72, 44, 139, 17,
+			199, 111, 200, 4, 28, 178, 30, 32, 203, 158, 50, 133, 171, 64,
+			85, 53, 48, 88, 212, 87, 98, 124, 96, 107, 125, 197, 233, 221,
+			169, 155, 54, 154, 187, 135, 136, 105, 8, 207, 253, 91, 167, 235,
+			31, 153, 105, 32, 116, 250, 111, 113, 172, 42, 8, 199, 17, 88,
+			144, 175, 59, 86, 54, 51, 165, 245, 163, 24, 81, 185, 132, 19,
+			91, 155, 3, 91, 231, 96, 125, 140, 46, 72, 139, 252, 186, 222,
+			95, 130, 120, 196, 215, 245, 254, 18, 180, 116, 95, 119, 6, 246,
+			233, 38, 34, 55, 29, 249, 50, 197, 35, 4, 38, 242, 13, 199,
+			218, 159, 185, 204, 40, 108, 112, 38, 65, 63, 146, 7, 147, 88,
+			172, 212, 100, 167, 130, 61, 29, 112, 38, 97, 166, 230, 212, 105,
+			156, 6, 191, 201, 201, 134, 98, 53, 56, 19, 145, 153, 3, 150,
+			253, 27, 26, 191, 130, 150, 253, 27, 78, 239, 144, 110, 194, 47,
+			201, 25, 222, 71, 59, 168, 207, 115, 127, 48, 221, 245, 83, 233,
+			151, 104, 7, 33, 240, 245, 7, 211, 61, 251, 197, 164, 112, 156,
+			62, 44, 216, 211, 105, 107, 87, 230, 142, 206, 59, 72, 69, 176,
+			197, 196, 30, 150, 229, 8, 103, 127, 49, 103, 244, 62, 218, 77,
+			79, 167, 121, 118, 125, 180, 36, 79, 167, 121, 55, 245, 209, 110,
+			122, 58, 189, 115, 64, 252, 45, 172, 31, 125, 88, 146, 215, 165,
+			173, 161, 204, 159, 89, 114, 129, 163, 29, 171, 97, 120, 145, 228,
+			7, 249, 44, 150, 195, 245, 90, 242, 154, 142, 204, 50, 19, 179,
+			112, 199, 10, 162, 160, 217, 133, 7, 64, 200, 41, 181, 176, 38,
+			139, 123, 162, 42, 6, 57, 201, 232, 105, 192, 106, 23, 53, 224,
+			234, 80, 41, 146, 87, 48, 36, 39, 231, 232, 106, 136, 68, 74,
+			108, 92, 148, 113, 252, 87, 185, 82, 133, 50, 180, 180, 145, 151,
+			19, 213, 106, 50, 71, 189, 137, 161, 33, 215, 30, 101, 231, 240,
+			145, 41, 107, 205, 175, 227, 26, 38, 106, 4, 107, 210, 47, 227,
+			107, 192, 160, 115, 83, 18, 44, 109, 225, 48, 10, 138, 136, 42,
+			57, 129, 166, 136, 29, 27, 71, 55, 26, 74, 93, 106, 47, 5,
+			13, 86, 206, 20, 184, 202, 60, 71, 243, 162, 105, 227, 99, 122,
+			61, 185, 137, 250, 232, 212, 243, 186, 52, 111, 162, 62, 146, 89,
+			175, 75, 179, 41, 171, 143, 232, 241, 117, 105, 54, 101, 245, 17,
+			61, 190, 46, 189, 123, 144, 248, 95, 31, 20, 160, 31, 79, 127,
+			91, 248, 95, 31, 233, 66, 63, 158, 102, 254, 215, 71, 22, 205,
+			31, 79, 51, 255, 235, 35, 81, 242, 227, 105, 230, 127, 125, 104,
+			189, 49, 253, 29, 224, 127, 125, 100, 162, 124, 99, 76, 193, 80,
+			156, 223, 152, 102, 254, 215, 71, 74, 211, 27, 211, 204, 255, 182,
+			120, 238, 207, 164, 187, 158, 75, 43, 254, 135, 48, 233, 159, 73,
+			247, 28, 32, 254, 183, 5, 219, 233, 205, 233, 111, 43, 255, 219,
+			66, 252, 239, 205, 122, 233, 182, 16, 255, 123, 115, 154, 249, 223,
+			22, 218, 108, 111, 78, 51, 255, 219, 66, 155, 237, 205, 105, 249,
+			50, 241, 26, 240, 191, 45, 192, 239, 179, 105, 107, 127, 102, 227,
+			234, 252, 175, 115, 145, 177, 14, 88, 19, 184, 3, 185, 62, 14,
+			184, 133, 56, 224, 179, 26, 195, 91, 136, 226, 158, 77, 51, 7,
+			220, 66, 20, 247, 108, 122, 120, 159, 248, 77, 156, 179, 183, 122,
+			238, 59, 210, 93, 239, 79, 167, 50, 37, 89, 184, 182, 148, 25,
+			107, 45, 232, 110, 115, 221, 20, 155, 102, 202, 120, 137, 78, 230,
+			136, 11, 127, 71, 186, 167, 79, 140, 11, 199, 217, 10, 202, 120,
+			87, 218, 242, 50, 47, 51, 140, 150, 19, 47, 180, 185, 248, 18,
+			74, 182, 18, 83, 125, 151, 70, 216, 86, 90, 231, 119, 165, 123,
+			183, 234, 38, 178, 167, 165, 251, 119, 208, 229, 228, 86, 44, 243,
+			123, 192, 198, 199, 155, 122, 215, 72, 137, 131, 182, 56, 1, 9,
+			95, 35, 153, 177, 176, 56, 239, 137, 199, 194, 226, 188, 71, 51,
+			240, 173, 180, 56, 239, 1, 3, 63, 78, 99, 89, 158, 243, 11,
+			216, 149, 185, 78, 181, 112, 18, 131, 36, 92, 47, 205, 64, 208,
+			36, 127, 33, 109, 245, 233, 102, 10, 77, 62, 120, 109, 165, 237,
+			255, 11, 233, 193, 33, 113, 154, 6, 178, 61, 231, 121, 12, 116,
+			87, 124, 240, 74, 92, 94, 80, 58, 192, 114, 243, 197, 69, 199,
+			35, 209, 86, 218, 221, 207, 199, 211, 195, 238, 126, 62, 205, 23,
+			16, 91, 105, 119, 63, 143, 81, 79, 209, 168, 142, 231, 252, 98,
+			218, 202, 101, 94, 17, 31, 192, 48, 161, 205, 239, 124, 54, 25,
+			212, 113, 169, 163, 97, 221, 76, 161, 201, 119, 55, 91, 233, 182,
+			224, 23, 211, 135, 110, 35, 150, 178, 205, 115, 95, 72, 119, 253,
+			26, 179, 20, 68, 141, 190, 144, 238, 25, 34, 194, 217, 6, 194,
+			249, 192, 117, 16, 206, 54, 34, 156, 15, 232, 217, 110, 35, 194,
+			249, 128, 38, 156, 109, 68, 56, 31, 208, 132, 179, 13, 132, 243,
+			171, 47, 154, 112, 182, 209, 174, 254, 213, 120, 44, 16, 206, 175,
+			106, 194, 217, 70, 132, 243, 171, 32, 28, 76, 114, 187, 231, 126,
+			40, 221, 245, 27, 60, 73, 4, 58, 126, 40, 221, 51, 40, 142,
+			10, 199, 217, 142, 73, 126, 24, 96, 220, 220, 89, 13, 33, 75,
+			31, 124, 19, 98, 29, 126, 59, 205, 243, 195, 122, 236, 237, 52,
+			207, 15, 235, 177, 183, 211, 60, 63, 172, 199, 238, 247, 220, 143,
+			166, 187, 62, 206, 99, 35, 232, 233, 163, 64, 48, 44, 141, 253,
+			24, 251, 99, 208, 71, 102, 12, 207, 198, 72, 73, 118, 173, 214,
+			189, 184, 9, 187, 166, 0, 238, 117, 40, 35, 77, 140, 187, 159,
+			24, 247, 199, 52, 227, 238, 39, 198, 253, 49, 45, 115, 251, 9,
+			222, 143, 105, 153, 219, 79, 240, 126, 12, 50, 247, 71, 108, 97,
+			57, 59, 60, 247, 119, 210, 93, 127, 152, 78, 101, 222, 65, 90,
+			146, 9, 198, 60, 30, 7, 99, 26, 29, 200, 48, 65, 189, 11,
+			33, 248, 30, 8, 54, 162, 205, 114, 5, 112, 230, 192, 68, 213,
+			153, 74, 157, 237, 74, 173, 121, 82, 149, 210, 1, 110, 97, 114,
+			170, 199, 17, 7, 156, 236, 71, 183, 143, 102, 155, 117, 51, 109,
+			146, 137, 252, 213, 132, 139, 50, 231, 93, 148, 141, 176, 1, 85,
+			141, 238, 175, 52, 221, 65, 231, 26, 65, 21, 41, 51, 211, 44,
+			1, 55, 154, 176, 122, 81, 229, 29, 226, 238, 183, 31, 62, 140,
+			20, 199, 141, 151, 204, 11, 201, 65, 188, 208, 239, 128, 135, 195,
+			240, 180, 3, 148, 242, 187, 105, 107, 71, 230, 168, 124, 128, 131,
+			95, 219, 99, 95, 41, 163, 58, 254, 120, 212, 31, 123, 226, 240,
+			216, 93, 231, 191, 111, 236, 177, 219, 152, 36, 118, 16, 205, 254,
+			174, 166, 217, 29, 68, 3, 191, 155, 238, 221, 162, 155, 48, 112,
+			164, 183, 247, 139, 57, 26, 14, 254, 39, 105, 107, 103, 102, 130,
+			101, 55, 209, 64, 219, 48, 19, 99, 143, 168, 145, 110, 30, 25,
+			61, 116, 91, 238, 251, 198, 242, 227, 199, 79, 220, 125, 207, 189,
+			247, 125, 223, 247, 157, 127, 242, 169, 255, 93, 38, 70, 199, 110,
+			253, 76, 60, 58, 118, 235, 103, 210, 189, 219, 116, 211, 70, 115,
+			135, 71, 108, 126, 7, 180, 190, 207, 129, 251, 230, 20, 247, 37,
+			140, 199, 43, 11, 91, 38, 173, 86, 7, 222, 183, 131, 180, 188,
+			207, 197, 3, 129, 203, 124, 78, 51, 220, 29, 196, 230, 63, 7,
+			134, 251, 10, 26, 200, 246, 156, 63, 0, 195, 61, 20, 51, 92,
+			244, 124, 217, 79, 80, 82, 39, 22, 187, 195, 178, 93, 250, 116,
+			88, 55, 83, 104, 50, 139, 221, 65, 124, 253, 15, 52, 139, 245,
+			60, 247, 143, 211, 93, 95, 100, 14, 128, 248, 161, 63, 78, 247,
+			236, 33, 150, 239, 97, 93, 255, 4, 220, 231, 21, 114, 33, 193,
+			252, 104, 198, 43, 97, 181, 212, 146, 232, 225, 178, 14, 101, 212,
+			201, 90, 24, 30, 143, 86, 247, 79, 244, 180, 61, 90, 221, 63,
+			209, 28, 201, 163, 29, 254, 39, 224, 72, 224, 235, 30, 112, 242,
+			103, 192, 239, 203, 228, 194, 181, 245, 14, 157, 253, 207, 180, 236,
+			244, 72, 131, 250, 51, 45, 59, 61, 226, 181, 127, 6, 164, 158,
+			164, 222, 45, 207, 249, 11, 48, 181, 219, 229, 92, 194, 233, 143,
+			187, 212, 35, 140, 208, 189, 164, 241, 191, 85, 57, 139, 53, 122,
+			61, 203, 114, 168, 19, 211, 116, 209, 100, 254, 229, 145, 234, 254,
+			23, 154, 127, 121, 180, 168, 127, 145, 230, 235, 239, 157, 158, 251,
+			87, 233, 174, 255, 198, 216, 70, 80, 206, 95, 1, 219, 103, 132,
+			227, 236, 4, 182, 255, 26, 216, 62, 126, 173, 216, 230, 104, 98,
+			2, 30, 218, 62, 3, 184, 147, 240, 253, 215, 26, 223, 59, 9,
+			223, 127, 173, 241, 189, 147, 240, 253, 215, 192, 247, 17, 26, 54,
+			229, 57, 127, 3, 124, 223, 36, 23, 174, 181, 127, 96, 252, 111,
+			52, 198, 119, 18, 198, 255, 70, 99, 124, 39, 97, 252, 111, 52,
+			198, 119, 98, 191, 124, 117, 115, 140, 151, 130, 171, 99, 124, 39,
+			97, 252, 171, 26, 227, 59, 201, 238, 246, 85, 141, 241, 157, 132,
+			241, 175, 106, 140, 239, 36, 140, 127, 21, 24, 135, 213, 96, 192,
+			115, 255, 33, 221, 245, 127, 186, 47, 145, 213, 0, 49, 66, 255,
+			0, 145, 137, 13, 51, 128, 37, 252, 6, 24, 225, 43, 174, 147,
+			17, 62, 121, 36, 119, 251, 225, 195, 79, 241, 124, 7, 104, 1,
+			191, 161, 23, 112, 128, 22, 240, 27, 154, 29, 14, 208, 2, 126,
+			3, 236, 112, 138, 6, 77, 121, 206, 63, 65, 17, 186, 83, 62,
+			212, 57, 175, 192, 70, 35, 80, 201, 5, 162, 85, 174, 2, 178,
+			226, 215, 56, 199, 128, 25, 18, 60, 240, 159, 244, 1, 116, 128,
+			214, 244, 159, 210, 61, 91, 117, 211, 198, 175, 253, 59, 196, 60,
+			13, 105, 121, 206, 63, 167, 173, 76, 102, 90, 206, 173, 241, 169,
+			255, 193, 153, 7, 167, 181, 11, 179, 108, 108, 172, 25, 157, 201,
+			248, 48, 144, 220, 66, 74, 246, 202, 170, 95, 175, 84, 55, 200,
+			176, 177, 56, 99, 32, 0, 115, 252, 231, 120, 210, 88, 213, 127,
+			78, 247, 238, 210, 77, 27, 205, 161, 61, 226, 23, 97, 45, 25,
+			192, 34, 255, 168, 107, 237, 201, 252, 63, 150, 60, 21, 7, 8,
+			183, 101, 63, 50, 163, 195, 105, 99, 35, 78, 211, 1, 206, 69,
+			90, 137, 60, 114, 39, 97, 136, 108, 56, 170, 204, 223, 72, 246,
+			238, 139, 193, 70, 236, 236, 54, 138, 83, 96, 188, 142, 43, 193,
+			227, 194, 212, 58, 228, 92, 143, 74, 29, 56, 118, 180, 249, 53,
+			42, 10, 136, 112, 141, 186, 74, 25, 116, 78, 135, 55, 55, 123,
+			15, 161, 123, 120, 26, 234, 243, 121, 148, 151, 51, 236, 78, 132,
+			130, 95, 157, 202, 45, 163, 48, 19, 99, 190, 202, 241, 27, 109,
+			73, 5, 248, 85, 131, 95, 104, 251, 63, 234, 26, 252, 66, 219,
+			255, 81, 151, 133, 207, 0, 73, 133, 31, 117, 249, 114, 103, 0,
+			27, 238, 199, 128, 222, 35, 155, 74, 57, 131, 217, 78, 50, 104,
+			192, 114, 210, 212, 131, 30, 13, 206, 16, 63, 22, 143, 6, 53,
+			255, 199, 48, 218, 61, 52, 90, 218, 115, 94, 239, 90, 185, 204,
+			120, 44, 234, 76, 247, 87, 149, 119, 3, 86, 218, 165, 239, 135,
+			117, 51, 133, 38, 203, 187, 1, 202, 26, 254, 122, 151, 229, 221,
+			46, 207, 253, 9, 183, 235, 103, 92, 197, 129, 17, 91, 245, 19,
+			110, 207, 62, 50, 232, 236, 194, 246, 125, 198, 181, 118, 101, 238,
+			190, 78, 121, 103, 96, 53, 82, 105, 23, 109, 225, 103, 244, 252,
+			119, 209, 22, 126, 198, 101, 30, 188, 139, 182, 240, 51, 238, 206,
+			1, 113, 140, 6, 78, 121, 206, 79, 1, 219, 183, 200, 133, 107,
+			31, 1, 92, 248, 167, 92, 230, 194, 187, 104, 199, 254, 148, 203,
+			92, 120, 23, 113, 225, 159, 2, 134, 113, 35, 176, 11, 92, 248,
+			167, 93, 107, 52, 51, 218, 196, 133, 219, 98, 163, 141, 12, 52,
+			163, 128, 18, 126, 218, 101, 214, 187, 139, 88, 239, 79, 187, 125,
+			195, 186, 153, 194, 175, 123, 111, 214, 191, 218, 104, 30, 28, 33,
+			84, 239, 246, 220, 183, 184, 93, 63, 199, 168, 70, 84, 212, 91,
+			128, 234, 89, 225, 56, 187, 129, 234, 183, 2, 213, 247, 94, 167,
+			176, 51, 16, 39, 4, 210, 110, 66, 246, 91, 53, 178, 119, 19,
+			178, 223, 170, 145, 189, 155, 144, 253, 86, 32, 251, 14, 26, 58,
+			229, 57, 207, 1, 217, 7, 229, 194, 245, 140, 1, 116, 63, 167,
+			209, 189, 155, 208, 253, 156, 70, 247, 110, 66, 247, 115, 64, 247,
+			57, 26, 195, 242, 156, 183, 1, 221, 39, 77, 70, 7, 35, 235,
+			56, 31, 120, 115, 213, 163, 176, 174, 178, 33, 220, 166, 51, 33,
+			248, 117, 19, 254, 162, 6, 192, 58, 188, 77, 175, 195, 110, 90,
+			135, 183, 233, 117, 216, 77, 34, 240, 109, 122, 29, 118, 19, 179,
+			124, 155, 94, 135, 65, 207, 253, 121, 183, 235, 223, 242, 58, 12,
+			166, 60, 231, 231, 221, 158, 97, 82, 58, 6, 177, 14, 239, 112,
+			175, 71, 233, 160, 35, 167, 193, 144, 57, 118, 14, 210, 26, 188,
+			67, 175, 193, 32, 173, 193, 59, 244, 26, 12, 210, 26, 188, 195,
+			101, 165, 99, 16, 224, 190, 203, 109, 87, 58, 26, 225, 230, 253,
+			3, 255, 239, 210, 248, 31, 36, 252, 191, 75, 227, 127, 144, 240,
+			255, 46, 224, 31, 78, 75, 131, 32, 247, 247, 184, 214, 112, 230,
+			148, 212, 220, 144, 178, 7, 64, 227, 32, 107, 48, 77, 203, 212,
+			183, 8, 99, 195, 157, 134, 32, 153, 151, 192, 128, 128, 53, 120,
+			143, 94, 131, 65, 210, 230, 223, 227, 178, 139, 204, 32, 173, 193,
+			123, 92, 111, 183, 110, 194, 58, 228, 238, 201, 136, 25, 130, 200,
+			246, 156, 247, 185, 214, 96, 230, 68, 194, 88, 204, 182, 192, 206,
+			166, 226, 88, 8, 64, 78, 170, 148, 215, 170, 103, 48, 242, 247,
+			185, 44, 170, 7, 169, 112, 204, 251, 92, 182, 21, 15, 18, 35,
+			127, 159, 59, 176, 155, 84, 146, 65, 128, 252, 60, 48, 253, 138,
+			23, 109, 43, 86, 131, 130, 159, 63, 31, 47, 47, 248, 249, 243,
+			46, 91, 130, 7, 201, 108, 243, 188, 203, 150, 224, 33, 207, 253,
+			37, 183, 235, 87, 153, 224, 134, 224, 137, 227, 246, 236, 21, 139,
+			194, 113, 134, 64, 112, 47, 96, 103, 156, 150, 83, 156, 23, 46,
+			153, 69, 40, 225, 200, 121, 80, 51, 253, 243, 141, 232, 96, 91,
+			210, 134, 56, 103, 3, 224, 25, 34, 155, 194, 11, 122, 105, 134,
+			200, 166, 240, 130, 222, 30, 67, 68, 140, 47, 232, 237, 49, 68,
+			196, 248, 2, 182, 7, 25, 131, 135, 176, 112, 31, 116, 175, 205,
+			24, 220, 156, 52, 228, 138, 119, 97, 126, 237, 58, 141, 193, 67,
+			100, 54, 250, 160, 70, 242, 16, 209, 248, 7, 93, 54, 6, 15,
+			17, 141, 127, 208, 229, 235, 176, 61, 158, 251, 97, 183, 235, 55,
+			95, 42, 197, 118, 15, 172, 70, 96, 215, 80, 248, 246, 96, 213,
+			62, 2, 54, 49, 221, 206, 38, 204, 173, 124, 43, 167, 0, 11,
+			83, 233, 9, 48, 123, 54, 134, 196, 59, 122, 15, 113, 140, 143,
+			232, 217, 238, 161, 69, 250, 136, 230, 24, 123, 104, 145, 62, 2,
+			142, 1, 183, 209, 61, 88, 163, 143, 2, 130, 251, 8, 2, 221,
+			27, 163, 86, 173, 64, 88, 189, 20, 40, 223, 139, 150, 18, 215,
+			137, 212, 77, 102, 112, 160, 250, 163, 241, 224, 64, 245, 71, 227,
+			193, 129, 234, 143, 98, 112, 156, 33, 50, 158, 251, 31, 220, 174,
+			223, 126, 169, 80, 157, 129, 151, 141, 86, 66, 50, 64, 245, 39,
+			175, 75, 9, 185, 140, 84, 142, 141, 16, 49, 105, 148, 156, 193,
+			96, 56, 67, 24, 254, 164, 158, 100, 134, 48, 252, 73, 61, 201,
+			12, 97, 248, 147, 90, 9, 201, 0, 195, 159, 234, 172, 132, 92,
+			97, 4, 112, 229, 79, 105, 174, 156, 33, 138, 253, 148, 230, 202,
+			25, 162, 216, 79, 129, 43, 255, 59, 92, 95, 12, 123, 238, 239,
+			185, 93, 127, 233, 166, 50, 75, 200, 43, 129, 232, 196, 176, 30,
+			199, 188, 72, 63, 206, 55, 64, 102, 187, 43, 229, 116, 164, 91,
+			68, 129, 18, 92, 136, 79, 65, 116, 107, 173, 57, 211, 204, 75,
+			179, 86, 195, 41, 207, 249, 61, 240, 90, 120, 75, 15, 83, 96,
+			148, 107, 253, 129, 171, 188, 165, 135, 85, 104, 148, 219, 189, 133,
+			188, 165, 135, 29, 242, 150, 254, 47, 174, 147, 33, 95, 232, 97,
+			14, 111, 250, 47, 174, 179, 43, 126, 96, 225, 193, 208, 30, 243,
+			5, 188, 100, 146, 95, 0, 163, 159, 117, 157, 190, 248, 129, 133,
+			7, 137, 47, 96, 144, 74, 126, 129, 133, 252, 156, 235, 108, 141,
+			31, 208, 27, 137, 47, 108, 207, 249, 253, 228, 23, 144, 32, 191,
+			239, 58, 34, 126, 96, 225, 193, 208, 30, 186, 189, 27, 198, 44,
+			62, 239, 90, 253, 153, 41, 57, 163, 175, 146, 227, 123, 34, 125,
+			206, 227, 181, 9, 235, 218, 254, 142, 194, 178, 231, 85, 142, 129,
+			243, 20, 192, 190, 113, 252, 210, 145, 172, 218, 127, 195, 68, 154,
+			159, 215, 164, 57, 76, 164, 249, 121, 183, 183, 79, 55, 145, 114,
+			200, 221, 182, 157, 108, 110, 195, 152, 209, 23, 92, 203, 203, 228,
+			104, 243, 27, 42, 225, 168, 7, 51, 110, 210, 237, 138, 41, 116,
+			152, 174, 116, 191, 160, 165, 229, 48, 81, 232, 23, 92, 62, 216,
+			14, 19, 133, 126, 193, 237, 223, 65, 39, 128, 97, 232, 13, 127,
+			138, 131, 200, 221, 241, 65, 196, 244, 47, 203, 149, 90, 37, 130,
+			93, 89, 87, 213, 30, 105, 242, 147, 36, 41, 109, 68, 210, 48,
+			169, 104, 127, 170, 79, 37, 195, 164, 30, 252, 169, 62, 149, 12,
+			147, 138, 246, 167, 56, 149, 220, 75, 3, 219, 158, 243, 231, 174,
+			117, 115, 230, 48, 217, 153, 231, 23, 39, 39, 167, 167, 181, 79,
+			85, 144, 99, 65, 209, 130, 234, 248, 162, 118, 152, 76, 126, 127,
+			238, 90, 67, 186, 9, 127, 32, 119, 207, 1, 221, 164, 238, 179,
+			55, 137, 219, 105, 48, 68, 100, 185, 214, 206, 204, 173, 52, 152,
+			10, 42, 215, 35, 161, 127, 42, 209, 168, 147, 103, 153, 33, 160,
+			1, 124, 49, 94, 49, 104, 0, 95, 116, 217, 74, 58, 76, 26,
+			192, 23, 221, 29, 30, 105, 0, 123, 61, 247, 75, 110, 215, 63,
+			176, 6, 176, 23, 89, 137, 220, 158, 3, 164, 27, 239, 5, 53,
+			125, 25, 12, 238, 228, 139, 97, 112, 234, 183, 114, 8, 227, 1,
+			67, 182, 151, 104, 233, 203, 26, 178, 189, 68, 75, 95, 214, 108,
+			110, 47, 177, 185, 47, 131, 205, 221, 69, 195, 195, 133, 7, 108,
+			238, 54, 185, 112, 189, 227, 128, 148, 190, 162, 153, 221, 94, 34,
+			165, 175, 104, 102, 183, 151, 72, 233, 43, 96, 118, 19, 52, 142,
+			229, 57, 95, 195, 56, 199, 64, 74, 184, 5, 230, 251, 34, 165,
+			87, 105, 195, 189, 246, 99, 207, 226, 110, 37, 171, 114, 158, 152,
+			241, 160, 96, 126, 205, 101, 39, 249, 189, 68, 65, 95, 115, 187,
+			245, 120, 160, 160, 175, 197, 227, 217, 158, 243, 119, 215, 49, 30,
+			12, 112, 173, 227, 65, 177, 252, 187, 120, 60, 176, 133, 191, 139,
+			199, 131, 98, 249, 119, 46, 223, 66, 238, 133, 98, 249, 247, 174,
+			117, 32, 115, 215, 181, 141, 103, 182, 81, 219, 168, 160, 171, 191,
+			143, 71, 5, 93, 253, 189, 219, 157, 209, 77, 27, 205, 125, 251,
+			137, 174, 246, 121, 238, 63, 186, 93, 63, 209, 173, 232, 106, 95,
+			202, 115, 254, 209, 237, 145, 180, 133, 246, 129, 174, 190, 233, 90,
+			67, 153, 195, 45, 11, 171, 107, 97, 177, 233, 128, 221, 44, 203,
+			149, 106, 149, 12, 44, 12, 199, 62, 210, 25, 191, 169, 85, 200,
+			125, 68, 69, 223, 116, 217, 159, 96, 31, 81, 209, 55, 221, 221,
+			131, 226, 45, 208, 25, 247, 97, 45, 158, 238, 182, 134, 50, 175,
+			67, 48, 117, 75, 66, 157, 36, 1, 95, 251, 125, 25, 231, 33,
+			35, 67, 145, 242, 255, 228, 146, 35, 250, 102, 83, 167, 206, 139,
+			140, 204, 60, 15, 170, 145, 247, 220, 67, 65, 17, 102, 34, 41,
+			135, 96, 51, 77, 23, 77, 182, 150, 238, 35, 170, 125, 186, 155,
+			173, 165, 251, 136, 106, 159, 238, 222, 61, 72, 53, 213, 156, 125,
+			224, 128, 175, 197, 188, 222, 153, 226, 164, 233, 45, 151, 86, 157,
+			230, 165, 175, 38, 144, 78, 147, 230, 96, 210, 114, 215, 132, 249,
+			189, 178, 26, 68, 13, 127, 117, 77, 142, 116, 84, 227, 175, 125,
+			206, 160, 220, 182, 57, 131, 28, 95, 27, 207, 25, 188, 247, 181,
+			241, 156, 177, 90, 175, 141, 231, 140, 157, 243, 90, 204, 249, 231,
+			212, 156, 109, 207, 121, 67, 183, 117, 115, 230, 153, 148, 156, 143,
+			101, 75, 130, 245, 155, 56, 56, 149, 241, 40, 137, 7, 147, 251,
+			168, 5, 33, 149, 186, 144, 51, 83, 215, 53, 177, 196, 112, 173,
+			211, 179, 29, 2, 209, 52, 93, 52, 251, 6, 116, 19, 49, 92,
+			221, 187, 14, 232, 38, 205, 39, 123, 19, 157, 19, 246, 123, 238,
+			79, 119, 119, 61, 215, 253, 18, 41, 175, 251, 97, 0, 234, 238,
+			217, 39, 214, 132, 227, 236, 199, 30, 124, 75, 183, 181, 43, 179,
+			20, 103, 235, 104, 206, 50, 212, 202, 103, 137, 151, 147, 155, 113,
+			75, 172, 158, 60, 88, 169, 149, 235, 254, 120, 35, 12, 171, 209,
+			56, 116, 137, 241, 106, 165, 182, 254, 248, 152, 191, 90, 186, 243,
+			246, 131, 140, 153, 253, 196, 251, 223, 210, 205, 188, 127, 63, 237,
+			218, 183, 116, 51, 239, 223, 79, 187, 246, 45, 221, 59, 7, 232,
+			46, 98, 63, 200, 224, 217, 110, 107, 79, 230, 118, 185, 16, 103,
+			56, 186, 170, 8, 208, 224, 1, 187, 251, 73, 159, 120, 182, 155,
+			133, 192, 126, 218, 78, 207, 118, 179, 16, 216, 79, 219, 233, 217,
+			238, 65, 117, 16, 62, 224, 185, 63, 215, 221, 245, 9, 102, 87,
+			7, 16, 227, 212, 221, 179, 159, 216, 213, 1, 160, 234, 237, 221,
+			47, 154, 93, 29, 32, 118, 245, 118, 77, 18, 7, 104, 226, 111,
+			239, 102, 118, 117, 128, 38, 254, 118, 144, 248, 187, 108, 26, 13,
+			199, 245, 110, 107, 56, 243, 102, 84, 27, 110, 9, 13, 136, 147,
+			10, 49, 70, 148, 182, 214, 30, 36, 64, 88, 163, 76, 186, 126,
+			117, 57, 148, 56, 204, 74, 191, 122, 217, 223, 64, 10, 143, 6,
+			37, 230, 198, 7, 244, 35, 140, 87, 202, 121, 79, 249, 88, 235,
+			82, 123, 106, 8, 147, 194, 223, 228, 134, 155, 164, 231, 166, 176,
+			159, 170, 184, 134, 165, 40, 87, 16, 207, 90, 75, 12, 169, 107,
+			70, 35, 231, 63, 84, 46, 25, 173, 175, 33, 165, 61, 182, 162,
+			72, 218, 213, 97, 58, 214, 213, 154, 27, 161, 121, 205, 215, 206,
+			130, 232, 114, 148, 211, 168, 242, 184, 107, 149, 226, 69, 83, 48,
+			26, 105, 23, 150, 233, 174, 126, 195, 124, 76, 110, 197, 172, 213,
+			214, 131, 242, 121, 170, 154, 16, 68, 108, 53, 66, 229, 180, 8,
+			197, 148, 161, 159, 32, 103, 113, 165, 100, 10, 202, 45, 172, 172,
+			243, 165, 4, 137, 32, 237, 118, 89, 221, 144, 167, 94, 165, 111,
+			65, 14, 16, 121, 61, 175, 201, 235, 0, 145, 215, 243, 221, 91,
+			118, 235, 38, 236, 44, 221, 123, 50, 164, 152, 31, 0, 179, 126,
+			127, 183, 181, 63, 51, 37, 231, 227, 228, 124, 173, 146, 232, 154,
+			151, 87, 13, 1, 214, 249, 254, 24, 0, 236, 153, 247, 119, 111,
+			217, 163, 155, 54, 154, 123, 247, 209, 153, 241, 0, 24, 233, 11,
+			32, 226, 91, 228, 124, 229, 9, 195, 55, 219, 198, 97, 103, 4,
+			238, 3, 106, 198, 11, 221, 172, 145, 31, 32, 251, 213, 11, 221,
+			61, 154, 114, 161, 102, 188, 0, 202, 253, 255, 83, 68, 185, 142,
+			231, 124, 8, 123, 246, 55, 83, 114, 254, 204, 196, 17, 89, 170,
+			44, 131, 115, 118, 28, 10, 62, 18, 43, 193, 227, 108, 22, 24,
+			53, 1, 243, 58, 119, 122, 88, 213, 72, 224, 244, 38, 204, 154,
+			137, 146, 177, 98, 75, 126, 241, 226, 101, 191, 94, 162, 10, 77,
+			126, 67, 133, 108, 108, 8, 118, 70, 197, 20, 228, 217, 224, 114,
+			80, 55, 189, 80, 174, 128, 75, 65, 29, 14, 167, 176, 220, 133,
+			229, 78, 196, 193, 9, 172, 12, 6, 160, 242, 124, 72, 51, 173,
+			3, 164, 242, 124, 168, 155, 47, 71, 14, 144, 42, 253, 33, 240,
+			144, 47, 225, 170, 235, 0, 180, 192, 223, 234, 182, 114, 153, 63,
+			176, 228, 164, 54, 182, 96, 23, 6, 81, 103, 44, 196, 57, 128,
+			185, 200, 31, 124, 132, 65, 201, 145, 166, 226, 160, 100, 228, 50,
+			111, 21, 78, 133, 0, 39, 158, 100, 143, 134, 107, 39, 138, 46,
+			106, 70, 80, 228, 250, 101, 156, 229, 74, 49, 78, 70, 69, 59,
+			80, 0, 248, 170, 155, 44, 46, 20, 174, 7, 81, 219, 170, 33,
+			171, 1, 220, 202, 88, 155, 140, 228, 193, 24, 201, 7, 81, 228,
+			52, 168, 34, 1, 97, 73, 81, 8, 13, 53, 162, 239, 89, 133,
+			172, 6, 203, 126, 113, 227, 124, 180, 226, 31, 81, 187, 93, 133,
+			101, 99, 3, 174, 215, 154, 61, 183, 14, 88, 105, 135, 176, 109,
+			154, 46, 154, 44, 105, 15, 32, 28, 208, 249, 173, 238, 93, 7,
+			117, 211, 198, 175, 135, 110, 35, 73, 43, 61, 247, 83, 221, 93,
+			95, 124, 169, 36, 173, 132, 105, 165, 187, 231, 86, 241, 189, 194,
+			113, 36, 196, 199, 167, 187, 173, 129, 204, 253, 77, 167, 40, 142,
+			14, 110, 55, 204, 153, 236, 85, 250, 213, 136, 243, 131, 105, 237,
+			67, 99, 64, 146, 68, 253, 180, 38, 78, 73, 130, 229, 211, 221,
+			28, 29, 44, 73, 176, 124, 186, 219, 219, 41, 62, 14, 193, 34,
+			193, 30, 126, 191, 219, 58, 144, 249, 128, 141, 224, 92, 170, 215,
+			146, 75, 120, 140, 7, 143, 251, 69, 212, 231, 161, 56, 39, 51,
+			52, 149, 209, 244, 87, 43, 170, 254, 219, 180, 250, 51, 78, 197,
+			163, 130, 70, 185, 186, 183, 100, 3, 15, 220, 159, 238, 86, 243,
+			187, 119, 252, 110, 84, 141, 245, 107, 141, 123, 179, 121, 33, 228,
+			73, 63, 10, 74, 255, 131, 189, 171, 249, 109, 35, 201, 238, 236,
+			42, 82, 77, 22, 37, 138, 46, 81, 18, 69, 73, 86, 177, 181,
+			178, 62, 44, 145, 182, 236, 201, 250, 99, 60, 54, 37, 209, 50,
+			99, 89, 118, 40, 122, 63, 18, 4, 116, 147, 108, 81, 61, 38,
+			217, 12, 155, 26, 143, 246, 152, 227, 34, 151, 28, 247, 127, 200,
+			231, 6, 8, 176, 64, 128, 28, 18, 32, 155, 143, 127, 32, 152,
+			67, 128, 28, 230, 144, 157, 221, 217, 100, 114, 203, 37, 120, 213,
+			245, 138, 77, 127, 204, 120, 129, 61, 206, 173, 95, 245, 171, 247,
+			186, 171, 171, 171, 126, 239, 213, 171, 87, 194, 11, 157, 44, 23,
+			146, 4, 47, 217, 244, 122, 144, 21, 3, 78, 106, 223, 22, 47,
+			84, 93, 255, 133, 62, 116, 229, 149, 10, 162, 130, 115, 235, 165,
+			247, 0, 183, 20, 65, 18, 187, 142, 61, 132, 71, 240, 85, 210,
+			162, 63, 176, 66, 56, 4, 54, 255, 119, 237, 166, 38, 10, 133,
+			194, 31, 110, 170, 35, 247, 228, 130, 66, 96, 80, 75, 32, 4,
+			99, 139, 3, 231, 146, 194, 123, 97, 179, 143, 31, 160, 29, 236,
+			166, 238, 168, 31, 97, 236, 40, 121, 157, 98, 83, 165, 189, 132,
+			169, 23, 206, 167, 243, 70, 181, 117, 37, 85, 7, 15, 203, 151,
+			24, 154, 201, 60, 136, 42, 71, 159, 206, 6, 166, 63, 58, 216,
+			12, 159, 97, 183, 23, 210, 59, 250, 153, 169, 150, 54, 132, 156,
+			133, 62, 51, 213, 238, 95, 33, 65, 206, 103, 230, 242, 101, 246,
+			127, 48, 64, 9, 152, 134, 62, 7, 212, 247, 5, 25, 235, 140,
+			232, 164, 149, 75, 95, 193, 235, 190, 135, 123, 86, 148, 66, 25,
+			30, 194, 141, 163, 3, 39, 94, 4, 175, 247, 66, 92, 13, 125,
+			75, 213, 232, 91, 118, 167, 179, 53, 170, 211, 177, 229, 72, 227,
+			250, 232, 127, 30, 107, 147, 119, 53, 9, 52, 177, 156, 50, 194,
+			33, 212, 239, 18, 230, 14, 190, 46, 155, 32, 211, 75, 171, 95,
+			151, 80, 80, 165, 117, 131, 142, 33, 67, 9, 223, 76, 94, 232,
+			67, 194, 177, 161, 55, 246, 152, 193, 167, 130, 233, 225, 243, 209,
+			239, 10, 63, 228, 231, 8, 128, 133, 116, 51, 125, 110, 170, 112,
+			207, 60, 159, 248, 47, 51, 242, 191, 10, 143, 230, 33, 252, 200,
+			140, 175, 203, 204, 15, 121, 200, 252, 240, 11, 147, 252, 143, 25,
+			248, 50, 243, 50, 243, 195, 47, 76, 54, 207, 110, 179, 137, 104,
+			62, 200, 252, 240, 133, 25, 157, 87, 219, 121, 85, 171, 200, 107,
+			192, 112, 114, 19, 230, 8, 106, 0, 76, 10, 252, 137, 178, 42,
+			4, 36, 153, 202, 193, 152, 87, 73, 34, 190, 48, 147, 124, 84,
+			64, 161, 96, 118, 142, 221, 81, 218, 192, 135, 99, 70, 243, 214,
+			150, 68, 120, 79, 31, 203, 223, 27, 252, 82, 190, 58, 34, 73,
+			89, 164, 174, 62, 215, 115, 36, 29, 48, 212, 47, 77, 229, 101,
+			205, 171, 12, 67, 191, 52, 231, 150, 70, 5, 224, 19, 50, 87,
+			4, 43, 42, 117, 132, 71, 127, 101, 70, 23, 173, 21, 213, 63,
+			100, 7, 125, 51, 57, 216, 72, 7, 192, 164, 95, 153, 202, 203,
+			42, 11, 32, 10, 202, 76, 205, 141, 10, 32, 14, 10, 176, 26,
+			234, 0, 255, 16, 52, 224, 202, 107, 176, 44, 188, 35, 119, 191,
+			116, 50, 210, 1, 78, 189, 47, 195, 58, 0, 42, 125, 105, 166,
+			70, 205, 6, 96, 233, 75, 115, 118, 142, 93, 83, 58, 32, 80,
+			202, 140, 102, 44, 33, 117, 132, 183, 26, 143, 235, 27, 41, 1,
+			44, 242, 235, 240, 183, 1, 52, 242, 107, 51, 57, 61, 42, 128,
+			128, 42, 24, 242, 87, 149, 146, 24, 143, 254, 183, 25, 157, 177,
+			102, 96, 234, 149, 71, 148, 104, 144, 55, 18, 19, 11, 184, 18,
+			163, 2, 3, 10, 88, 106, 84, 64, 161, 64, 186, 12, 163, 209,
+			60, 244, 175, 175, 76, 178, 26, 116, 63, 185, 160, 247, 21, 14,
+			72, 121, 105, 237, 124, 101, 38, 179, 72, 194, 246, 58, 115, 225,
+			50, 146, 20, 200, 188, 213, 152, 144, 231, 48, 223, 96, 127, 187,
+			194, 118, 219, 94, 161, 121, 54, 240, 186, 238, 121, 183, 224, 13,
+			218, 197, 206, 121, 211, 13, 12, 73, 187, 175, 46, 62, 185, 94,
+			148, 233, 146, 252, 130, 172, 199, 163, 80, 154, 187, 246, 126, 53,
+			33, 179, 70, 80, 47, 183, 18, 252, 176, 69, 73, 53, 206, 79,
+			139, 218, 219, 17, 48, 88, 255, 64, 89, 172, 12, 154, 248, 42,
+			139, 190, 116, 123, 173, 172, 33, 140, 141, 212, 238, 116, 1, 164,
+			21, 228, 173, 199, 110, 175, 85, 149, 55, 121, 154, 209, 87, 103,
+			94, 150, 8, 99, 35, 81, 133, 75, 94, 96, 81, 48, 166, 178,
+			84, 24, 27, 201, 221, 92, 65, 141, 16, 168, 176, 80, 67, 133,
+			85, 201, 199, 179, 204, 84, 125, 55, 27, 149, 82, 144, 228, 57,
+			22, 199, 222, 156, 141, 201, 91, 154, 6, 189, 3, 231, 52, 59,
+			33, 139, 233, 192, 57, 133, 146, 161, 221, 206, 154, 65, 201, 208,
+			110, 243, 187, 108, 178, 61, 176, 123, 67, 167, 85, 135, 252, 65,
+			217, 184, 160, 27, 201, 221, 108, 240, 34, 175, 101, 58, 42, 237,
+			31, 85, 147, 138, 27, 210, 157, 65, 229, 129, 243, 137, 247, 18,
+			43, 39, 190, 169, 178, 226, 150, 149, 103, 217, 68, 183, 85, 127,
+			233, 92, 100, 153, 124, 156, 88, 183, 245, 216, 185, 224, 11, 44,
+			222, 109, 213, 101, 220, 68, 54, 41, 111, 152, 221, 150, 92, 236,
+			230, 87, 216, 116, 183, 85, 87, 81, 105, 117, 136, 74, 203, 78,
+			74, 142, 169, 110, 11, 160, 180, 211, 27, 214, 46, 250, 14, 95,
+			99, 169, 110, 171, 30, 138, 200, 200, 78, 33, 91, 40, 184, 108,
+			235, 75, 194, 18, 250, 83, 241, 28, 155, 43, 127, 175, 124, 92,
+			171, 63, 174, 28, 31, 212, 159, 31, 159, 60, 43, 239, 87, 30,
+			86, 202, 7, 233, 8, 159, 99, 252, 89, 181, 252, 176, 242, 131,
+			122, 105, 255, 168, 190, 255, 168, 116, 124, 88, 62, 72, 183, 120,
+			134, 77, 63, 43, 237, 63, 46, 29, 150, 235, 251, 213, 114, 169,
+			86, 62, 72, 255, 220, 8, 151, 30, 148, 143, 202, 80, 250, 207,
+			6, 159, 97, 41, 44, 125, 84, 57, 56, 40, 31, 167, 255, 197,
+			224, 179, 44, 141, 133, 207, 143, 85, 241, 191, 202, 226, 202, 241,
+			73, 173, 116, 188, 63, 18, 252, 103, 100, 172, 24, 37, 255, 249,
+			120, 113, 181, 252, 176, 126, 82, 174, 165, 255, 130, 240, 121, 198,
+			199, 138, 159, 31, 195, 141, 191, 36, 60, 199, 102, 245, 141, 90,
+			233, 176, 94, 170, 213, 74, 251, 143, 202, 7, 233, 191, 122, 243,
+			222, 65, 89, 221, 251, 107, 194, 87, 88, 78, 223, 123, 82, 174,
+			149, 14, 74, 181, 210, 168, 242, 79, 223, 193, 160, 37, 252, 13,
+			217, 187, 250, 251, 155, 239, 245, 51, 222, 181, 251, 238, 239, 254,
+			108, 158, 77, 240, 104, 42, 242, 212, 96, 255, 24, 101, 198, 36,
+			167, 169, 8, 223, 253, 89, 84, 236, 123, 253, 139, 129, 219, 62,
+			27, 138, 221, 107, 215, 131, 96, 102, 153, 229, 184, 116, 62, 60,
+			243, 6, 210, 170, 59, 114, 155, 112, 60, 97, 107, 132, 188, 68,
+			169, 15, 142, 79, 188, 179, 45, 16, 174, 236, 22, 174, 5, 225,
+			8, 150, 186, 101, 109, 222, 101, 226, 194, 59, 135, 44, 81, 114,
+			182, 58, 247, 29, 52, 244, 33, 159, 240, 167, 144, 169, 21, 70,
+			72, 48, 42, 59, 238, 200, 205, 51, 28, 137, 47, 48, 241, 67,
+			37, 193, 107, 0, 108, 151, 231, 80, 246, 47, 112, 244, 86, 108,
+			144, 73, 89, 229, 212, 61, 27, 14, 251, 119, 138, 197, 87, 175,
+			94, 21, 108, 249, 164, 193, 32, 23, 240, 249, 197, 163, 202, 126,
+			249, 248, 164, 188, 179, 91, 184, 6, 86, 112, 79, 110, 15, 192,
+			45, 170, 96, 11, 67, 238, 48, 183, 41, 183, 142, 117, 236, 87,
+			16, 1, 99, 183, 7, 78, 224, 41, 113, 123, 2, 210, 197, 185,
+			189, 54, 120, 53, 79, 135, 175, 228, 249, 130, 45, 23, 236, 234,
+			198, 249, 112, 172, 153, 240, 201, 92, 127, 140, 33, 88, 143, 181,
+			74, 39, 162, 114, 98, 137, 189, 210, 73, 229, 100, 155, 137, 239,
+			87, 106, 143, 158, 62, 175, 137, 239, 151, 170, 213, 210, 113, 173,
+			82, 62, 17, 79, 171, 66, 39, 51, 62, 17, 79, 31, 138, 210,
+			241, 15, 5, 252, 82, 219, 120, 172, 141, 243, 41, 228, 67, 240,
+			101, 2, 99, 104, 64, 136, 138, 18, 39, 142, 51, 166, 30, 51,
+			207, 42, 211, 163, 41, 58, 118, 175, 125, 14, 115, 119, 27, 178,
+			223, 245, 192, 12, 232, 59, 131, 174, 235, 3, 12, 148, 230, 63,
+			11, 111, 136, 125, 243, 141, 10, 140, 197, 153, 65, 56, 77, 71,
+			166, 224, 42, 206, 41, 143, 60, 96, 9, 70, 226, 73, 125, 73,
+			35, 156, 102, 34, 119, 160, 148, 26, 156, 206, 70, 54, 25, 99,
+			36, 22, 225, 209, 108, 100, 199, 96, 44, 72, 217, 74, 179, 177,
+			20, 75, 170, 140, 173, 116, 129, 44, 179, 73, 76, 216, 74, 23,
+			72, 22, 41, 194, 233, 194, 226, 18, 187, 173, 178, 181, 210, 37,
+			178, 152, 219, 198, 156, 135, 193, 68, 117, 71, 161, 31, 189, 183,
+			69, 154, 22, 58, 37, 214, 36, 102, 82, 165, 75, 36, 163, 196,
+			194, 43, 44, 205, 231, 32, 73, 8, 36, 47, 229, 116, 69, 174,
+			18, 225, 166, 158, 55, 229, 142, 182, 24, 109, 132, 50, 108, 107,
+			233, 240, 100, 43, 228, 18, 82, 132, 211, 149, 217, 172, 122, 59,
+			202, 169, 32, 11, 234, 237, 160, 65, 132, 102, 164, 132, 83, 161,
+			25, 163, 156, 230, 53, 99, 212, 0, 42, 141, 20, 225, 52, 175,
+			25, 99, 156, 90, 154, 49, 102, 0, 197, 145, 34, 156, 90, 179,
+			89, 136, 100, 139, 69, 200, 4, 167, 107, 100, 53, 119, 119, 148,
+			181, 229, 237, 111, 166, 211, 44, 226, 75, 226, 236, 167, 95, 112,
+			194, 0, 81, 168, 101, 130, 112, 186, 182, 98, 169, 199, 49, 57,
+			189, 66, 86, 213, 45, 211, 0, 10, 25, 77, 194, 233, 21, 205,
+			24, 231, 116, 93, 51, 198, 13, 160, 144, 49, 78, 56, 93, 215,
+			140, 9, 78, 55, 52, 99, 194, 0, 10, 191, 92, 130, 112, 186,
+			161, 25, 25, 167, 155, 154, 145, 25, 64, 205, 35, 69, 56, 221,
+			212, 140, 73, 78, 183, 52, 99, 210, 0, 10, 25, 147, 132, 211,
+			45, 205, 56, 201, 233, 85, 205, 56, 105, 0, 181, 132, 20, 225,
+			244, 170, 102, 156, 226, 116, 91, 51, 78, 25, 64, 33, 227, 20,
+			225, 116, 123, 197, 98, 63, 33, 50, 81, 108, 244, 187, 145, 167,
+			70, 238, 79, 136, 144, 243, 36, 12, 122, 54, 158, 124, 239, 15,
+			7, 231, 205, 225, 249, 192, 105, 5, 95, 70, 116, 188, 182, 10,
+			200, 150, 118, 187, 52, 145, 26, 222, 240, 76, 236, 185, 109, 56,
+			116, 255, 66, 108, 192, 95, 109, 183, 206, 188, 166, 220, 170, 238,
+			58, 254, 38, 252, 184, 192, 120, 96, 15, 109, 240, 76, 59, 1,
+			147, 127, 230, 189, 146, 91, 112, 97, 232, 114, 26, 226, 121, 69,
+			133, 33, 192, 77, 75, 45, 54, 169, 53, 79, 199, 134, 167, 144,
+			102, 250, 72, 10, 158, 154, 58, 114, 155, 248, 206, 192, 181, 59,
+			238, 143, 156, 150, 122, 25, 27, 210, 20, 169, 76, 189, 163, 209,
+			89, 254, 133, 50, 23, 242, 80, 121, 118, 131, 204, 209, 110, 175,
+			229, 192, 145, 143, 5, 6, 163, 0, 132, 134, 208, 239, 198, 167,
+			160, 65, 33, 236, 131, 211, 91, 100, 22, 154, 16, 136, 9, 160,
+			146, 72, 25, 156, 222, 154, 76, 35, 69, 57, 189, 53, 147, 145,
+			105, 132, 34, 240, 231, 221, 38, 151, 44, 104, 4, 21, 129, 161,
+			195, 203, 48, 234, 2, 50, 56, 222, 249, 208, 233, 218, 110, 231,
+			35, 139, 41, 41, 70, 12, 42, 198, 145, 2, 49, 137, 73, 164,
+			40, 167, 183, 167, 211, 236, 79, 193, 221, 42, 199, 135, 123, 100,
+			13, 18, 236, 86, 29, 187, 35, 0, 200, 74, 71, 153, 118, 163,
+			194, 187, 5, 167, 20, 118, 221, 78, 199, 245, 157, 166, 7, 137,
+			162, 7, 78, 211, 149, 35, 107, 65, 28, 219, 61, 47, 40, 150,
+			167, 9, 50, 97, 55, 112, 159, 121, 224, 120, 147, 159, 223, 23,
+			78, 215, 29, 170, 5, 51, 56, 93, 70, 30, 111, 56, 28, 216,
+			61, 223, 110, 42, 159, 121, 240, 132, 240, 107, 223, 35, 139, 72,
+			25, 156, 222, 91, 18, 72, 81, 78, 239, 173, 126, 71, 38, 9,
+			2, 11, 139, 222, 39, 179, 214, 110, 104, 8, 235, 169, 83, 124,
+			71, 135, 101, 194, 11, 40, 112, 182, 165, 30, 101, 19, 85, 209,
+			24, 167, 247, 117, 67, 81, 131, 211, 251, 9, 252, 20, 20, 164,
+			207, 100, 216, 182, 84, 21, 229, 244, 1, 153, 179, 86, 194, 81,
+			71, 162, 114, 32, 63, 189, 198, 52, 91, 40, 55, 26, 3, 118,
+			148, 11, 227, 221, 131, 196, 37, 164, 40, 167, 15, 50, 179, 74,
+			110, 140, 211, 18, 185, 4, 114, 245, 97, 71, 227, 50, 1, 176,
+			105, 185, 49, 201, 142, 114, 97, 120, 44, 233, 15, 27, 163, 156,
+			150, 166, 211, 50, 37, 140, 28, 30, 247, 200, 37, 107, 75, 157,
+			224, 11, 25, 42, 215, 95, 222, 249, 100, 29, 132, 119, 55, 199,
+			85, 0, 142, 211, 42, 38, 98, 156, 238, 233, 71, 135, 177, 113,
+			79, 171, 152, 160, 156, 238, 77, 167, 229, 102, 213, 8, 49, 57,
+			125, 72, 110, 238, 174, 65, 186, 91, 153, 205, 216, 61, 61, 21,
+			161, 214, 14, 65, 97, 253, 109, 205, 40, 84, 210, 212, 4, 167,
+			15, 147, 22, 82, 6, 167, 15, 87, 139, 72, 81, 78, 31, 238,
+			222, 80, 63, 80, 156, 211, 67, 114, 83, 221, 138, 71, 129, 66,
+			33, 241, 9, 78, 15, 181, 16, 24, 122, 15, 181, 144, 56, 229,
+			244, 112, 247, 6, 59, 146, 66, 18, 156, 62, 38, 179, 86, 26,
+			118, 209, 43, 171, 3, 194, 132, 217, 238, 142, 120, 8, 75, 52,
+			170, 219, 143, 181, 141, 134, 169, 216, 119, 244, 171, 36, 98, 32,
+			14, 27, 10, 70, 242, 199, 137, 105, 164, 40, 167, 143, 121, 70,
+			70, 25, 69, 8, 227, 244, 136, 204, 91, 247, 132, 253, 230, 238,
+			24, 189, 69, 68, 255, 108, 206, 167, 176, 240, 228, 180, 198, 118,
+			215, 248, 168, 149, 197, 64, 24, 106, 133, 105, 225, 72, 247, 44,
+			70, 57, 61, 202, 204, 177, 43, 82, 107, 146, 211, 39, 228, 178,
+			181, 16, 214, 26, 22, 137, 18, 147, 49, 96, 68, 137, 48, 127,
+			60, 73, 100, 145, 162, 156, 62, 89, 92, 102, 107, 82, 226, 36,
+			167, 199, 100, 217, 202, 134, 37, 134, 140, 40, 20, 56, 25, 3,
+			62, 20, 8, 243, 204, 113, 98, 30, 41, 202, 233, 113, 110, 73,
+			27, 238, 63, 78, 177, 155, 239, 103, 126, 99, 179, 140, 153, 238,
+			223, 104, 136, 255, 187, 193, 146, 101, 85, 179, 102, 183, 121, 138,
+			17, 55, 48, 198, 19, 85, 226, 182, 198, 172, 99, 242, 154, 117,
+			28, 178, 169, 233, 184, 77, 157, 102, 20, 204, 210, 192, 210, 134,
+			75, 158, 97, 49, 249, 69, 149, 137, 29, 16, 124, 133, 37, 117,
+			84, 115, 227, 66, 217, 217, 12, 139, 246, 46, 248, 221, 16, 195,
+			208, 207, 154, 223, 104, 237, 235, 202, 53, 255, 55, 51, 150, 126,
+			146, 12, 140, 165, 213, 175, 53, 150, 110, 127, 107, 44, 125, 107,
+			44, 253, 150, 141, 165, 77, 246, 247, 16, 13, 28, 225, 209, 197,
+			200, 170, 145, 251, 169, 33, 66, 63, 164, 202, 164, 0, 102, 135,
+			223, 60, 115, 186, 182, 28, 2, 215, 241, 103, 175, 3, 132, 171,
+			127, 248, 177, 215, 112, 91, 31, 173, 143, 128, 226, 208, 110, 116,
+			96, 187, 59, 110, 239, 27, 13, 163, 141, 11, 81, 254, 193, 179,
+			167, 213, 90, 189, 86, 58, 60, 169, 215, 158, 214, 247, 126, 15,
+			14, 25, 238, 59, 3, 241, 177, 215, 144, 112, 50, 148, 14, 69,
+			173, 219, 15, 32, 97, 24, 83, 208, 211, 238, 217, 157, 11, 223,
+			245, 1, 237, 129, 254, 16, 162, 91, 140, 207, 176, 2, 34, 186,
+			37, 146, 182, 242, 98, 163, 255, 178, 13, 249, 105, 33, 129, 175,
+			219, 218, 132, 167, 145, 201, 4, 84, 128, 20, 14, 138, 145, 24,
+			84, 192, 65, 17, 208, 225, 82, 66, 67, 64, 202, 233, 82, 106,
+			90, 73, 54, 56, 93, 38, 115, 86, 254, 53, 164, 129, 128, 175,
+			212, 122, 214, 124, 4, 57, 247, 81, 50, 128, 189, 101, 45, 25,
+			108, 193, 101, 61, 35, 24, 148, 211, 229, 204, 44, 187, 138, 88,
+			239, 50, 153, 181, 46, 191, 6, 151, 80, 178, 202, 87, 173, 170,
+			146, 24, 112, 163, 88, 128, 162, 151, 53, 52, 2, 224, 117, 121,
+			38, 163, 166, 5, 42, 237, 67, 43, 11, 115, 169, 128, 239, 166,
+			147, 217, 203, 48, 49, 124, 78, 192, 90, 43, 90, 32, 96, 173,
+			21, 13, 44, 0, 107, 173, 76, 167, 217, 58, 98, 45, 65, 102,
+			172, 156, 58, 167, 249, 221, 34, 1, 102, 9, 45, 18, 96, 150,
+			72, 164, 144, 2, 219, 244, 18, 87, 175, 30, 3, 139, 115, 193,
+			186, 44, 211, 86, 218, 45, 121, 4, 229, 112, 251, 29, 240, 25,
+			80, 86, 94, 139, 5, 148, 149, 79, 100, 66, 40, 43, 63, 159,
+			101, 2, 81, 150, 69, 182, 173, 25, 76, 158, 37, 87, 69, 164,
+			120, 148, 53, 33, 89, 16, 204, 2, 156, 178, 150, 214, 145, 162,
+			156, 90, 91, 87, 245, 100, 248, 199, 57, 246, 225, 251, 77, 134,
+			114, 217, 93, 69, 22, 214, 193, 174, 10, 79, 138, 197, 183, 203,
+			240, 186, 93, 175, 87, 108, 252, 81, 177, 223, 40, 6, 155, 73,
+			149, 27, 220, 250, 121, 148, 101, 190, 23, 146, 120, 228, 181, 97,
+			27, 219, 5, 207, 179, 73, 157, 110, 177, 174, 230, 77, 90, 77,
+			234, 178, 74, 11, 88, 130, 140, 94, 224, 32, 110, 92, 40, 31,
+			118, 82, 151, 237, 93, 112, 193, 38, 135, 78, 183, 95, 111, 251,
+			245, 190, 61, 60, 147, 62, 237, 68, 149, 65, 217, 161, 255, 204,
+			30, 158, 241, 107, 44, 19, 4, 0, 57, 173, 58, 118, 121, 208,
+			23, 76, 176, 28, 239, 161, 165, 95, 105, 65, 141, 160, 13, 94,
+			171, 17, 76, 191, 28, 239, 133, 106, 236, 176, 132, 127, 222, 8,
+			76, 16, 57, 19, 211, 189, 233, 255, 252, 187, 239, 36, 89, 162,
+			86, 121, 82, 62, 169, 149, 158, 60, 171, 142, 56, 248, 38, 51,
+			253, 161, 13, 99, 80, 214, 124, 59, 51, 222, 231, 87, 89, 28,
+			67, 197, 179, 241, 183, 243, 106, 6, 190, 206, 166, 85, 62, 202,
+			186, 90, 85, 204, 38, 228, 51, 167, 84, 177, 154, 73, 249, 50,
+			99, 42, 64, 16, 90, 34, 240, 128, 39, 84, 73, 165, 5, 94,
+			112, 88, 223, 145, 47, 173, 188, 224, 146, 174, 180, 248, 34, 75,
+			192, 146, 79, 221, 119, 127, 20, 248, 191, 105, 53, 14, 5, 16,
+			178, 195, 119, 24, 31, 235, 60, 126, 223, 113, 90, 210, 253, 77,
+			171, 151, 194, 119, 78, 224, 6, 172, 43, 120, 65, 218, 211, 108,
+			42, 208, 162, 72, 64, 60, 50, 110, 60, 59, 45, 203, 3, 226,
+			55, 195, 36, 63, 206, 4, 152, 228, 131, 175, 195, 36, 187, 187,
+			223, 98, 146, 111, 49, 201, 111, 25, 147, 220, 102, 95, 208, 0,
+			147, 136, 200, 7, 70, 238, 63, 168, 120, 219, 248, 247, 118, 112,
+			242, 34, 252, 151, 188, 120, 13, 147, 40, 72, 50, 112, 154, 222,
+			160, 245, 122, 98, 93, 25, 168, 20, 174, 13, 136, 196, 7, 240,
+			16, 246, 83, 14, 61, 209, 244, 58, 29, 167, 57, 100, 99, 71,
+			67, 130, 110, 88, 4, 240, 239, 20, 139, 205, 65, 227, 188, 93,
+			104, 122, 221, 226, 245, 221, 223, 185, 126, 251, 214, 173, 241, 67,
+			31, 27, 16, 94, 224, 156, 158, 66, 144, 100, 199, 107, 135, 224,
+			81, 16, 32, 230, 246, 118, 186, 78, 23, 206, 30, 104, 156, 159,
+			158, 58, 3, 191, 32, 78, 188, 174, 163, 99, 23, 186, 246, 5,
+			3, 132, 212, 26, 120, 253, 62, 76, 149, 167, 0, 25, 130, 177,
+			71, 52, 7, 65, 44, 90, 195, 57, 5, 71, 26, 196, 62, 118,
+			206, 125, 12, 6, 13, 4, 194, 227, 72, 147, 90, 90, 154, 42,
+			108, 174, 225, 232, 48, 187, 142, 250, 161, 116, 227, 97, 174, 94,
+			121, 214, 151, 40, 61, 171, 200, 200, 17, 54, 122, 225, 142, 119,
+			222, 66, 187, 8, 222, 187, 225, 182, 193, 69, 120, 81, 108, 121,
+			77, 191, 8, 157, 223, 217, 177, 251, 238, 42, 44, 37, 202, 149,
+			63, 88, 6, 84, 35, 172, 63, 2, 112, 34, 190, 196, 54, 17,
+			192, 1, 34, 88, 210, 161, 172, 207, 101, 188, 219, 83, 156, 219,
+			10, 149, 3, 156, 195, 1, 187, 229, 137, 137, 20, 224, 129, 56,
+			226, 129, 136, 194, 3, 26, 187, 89, 36, 103, 229, 21, 162, 146,
+			232, 2, 28, 134, 94, 207, 121, 160, 2, 157, 225, 171, 105, 164,
+			1, 216, 205, 210, 72, 3, 176, 155, 149, 64, 55, 33, 96, 55,
+			43, 187, 160, 92, 93, 132, 211, 85, 146, 83, 73, 208, 15, 79,
+			132, 215, 248, 24, 2, 102, 117, 80, 167, 221, 134, 15, 107, 15,
+			28, 91, 5, 122, 225, 20, 136, 170, 0, 207, 173, 106, 85, 128,
+			231, 86, 181, 42, 192, 115, 171, 217, 5, 182, 129, 120, 110, 141,
+			88, 214, 162, 92, 157, 106, 56, 106, 87, 171, 60, 71, 112, 40,
+			94, 246, 188, 87, 61, 148, 9, 144, 110, 77, 203, 4, 72, 183,
+			150, 88, 14, 65, 186, 53, 145, 103, 91, 8, 233, 174, 16, 203,
+			90, 198, 248, 225, 81, 143, 244, 122, 152, 126, 30, 165, 2, 170,
+			187, 162, 165, 2, 170, 187, 162, 165, 70, 41, 167, 87, 68, 94,
+			1, 197, 24, 167, 27, 164, 100, 229, 68, 215, 109, 14, 180, 15,
+			210, 119, 229, 106, 64, 223, 107, 158, 161, 72, 64, 116, 27, 250,
+			11, 2, 162, 219, 136, 35, 70, 6, 68, 183, 145, 193, 166, 136,
+			197, 57, 221, 152, 123, 192, 210, 44, 1, 156, 241, 127, 251, 39,
+			120, 128, 141, 249, 251, 74, 229, 4, 248, 225, 239, 191, 135, 74,
+			240, 163, 109, 106, 149, 0, 252, 54, 227, 211, 33, 224, 183, 201,
+			103, 144, 138, 115, 186, 153, 249, 72, 169, 156, 80, 42, 55, 103,
+			239, 41, 149, 38, 120, 244, 31, 188, 135, 74, 51, 6, 156, 168,
+			18, 86, 43, 182, 226, 8, 217, 77, 202, 233, 214, 12, 246, 90,
+			51, 206, 233, 214, 236, 125, 165, 210, 84, 42, 183, 230, 62, 98,
+			31, 72, 149, 113, 112, 249, 47, 91, 27, 226, 176, 84, 22, 10,
+			152, 96, 20, 148, 234, 219, 55, 175, 239, 222, 216, 9, 206, 108,
+			215, 221, 57, 30, 131, 122, 248, 229, 192, 21, 183, 173, 93, 73,
+			224, 138, 219, 14, 86, 187, 192, 65, 198, 233, 14, 89, 176, 182,
+			223, 178, 177, 80, 159, 222, 134, 1, 116, 106, 224, 65, 37, 137,
+			24, 212, 69, 37, 224, 119, 219, 73, 96, 83, 38, 40, 167, 59,
+			115, 89, 229, 91, 101, 156, 22, 8, 196, 223, 236, 195, 232, 33,
+			106, 128, 143, 192, 103, 235, 157, 134, 163, 194, 80, 46, 120, 214,
+			10, 90, 46, 120, 214, 10, 99, 158, 181, 66, 102, 78, 125, 144,
+			36, 167, 215, 200, 188, 149, 83, 185, 219, 0, 91, 189, 30, 21,
+			163, 220, 103, 49, 224, 196, 15, 2, 174, 181, 107, 186, 219, 37,
+			41, 167, 215, 50, 115, 236, 22, 186, 214, 174, 19, 97, 93, 21,
+			26, 185, 137, 162, 104, 157, 99, 130, 121, 20, 12, 27, 96, 69,
+			208, 3, 80, 7, 120, 219, 174, 107, 29, 224, 109, 187, 30, 207,
+			33, 69, 57, 189, 190, 188, 162, 254, 235, 41, 78, 111, 144, 57,
+			107, 17, 70, 86, 209, 180, 253, 66, 48, 226, 169, 136, 51, 8,
+			131, 68, 153, 83, 49, 96, 197, 166, 128, 5, 160, 27, 218, 246,
+			155, 162, 156, 222, 152, 153, 101, 223, 145, 50, 83, 156, 222, 36,
+			25, 107, 126, 124, 15, 97, 112, 190, 119, 239, 2, 229, 165, 98,
+			192, 134, 242, 82, 6, 167, 55, 181, 157, 150, 162, 156, 222, 188,
+			52, 211, 152, 232, 15, 188, 161, 119, 227, 255, 7, 0, 237, 183,
+			118, 76, 213, 41, 2, 0},
 	)
 }
 
@@ -53,6 +53,9 @@ func main() {
 			}),
 		))
 
+		// Downloads of chunked CAS objects. Authorized via tokens in URLs.
+		svc.InternalCAS.InstallHandlers(srv.Routes, router.NewMiddlewareChain())
+
 		// UI pages. When running locally, serve static files ourself as well.
 		ui.InstallHandlers(srv, svc, "templates")
 		if !srv.Options.Prod {
@@ -36,9 +36,11 @@ import (
 	"go.chromium.org/luci/server"
 	"go.chromium.org/luci/server/auth"
 	"go.chromium.org/luci/server/bqlog"
+	"go.chromium.org/luci/server/router"
 	"go.chromium.org/luci/server/tq"
 
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/cas/chunks"
 	"go.chromium.org/luci/cipd/appengine/impl/cas/tasks"
 	"go.chromium.org/luci/cipd/appengine/impl/cas/upload"
 	"go.chromium.org/luci/cipd/appengine/impl/gs"
@@ -63,6 +65,12 @@ type StorageServer interface {
 	// Returns grpc errors. In particular NotFound is returned if there's no such
 	// object in the storage.
 	GetReader(ctx context.Context, ref *api.ObjectRef) (gs.Reader, error)
+
+	// InstallHandlers installs non-pRPC HTTP handlers into the router.
+	//
+	// They serve downloads of chunked objects. Access to them is controlled
+	// through tokens embedded into URLs returned by GetObjectURL.
+	InstallHandlers(r *router.Router, base router.MiddlewareChain)
 }
 
 // Internal returns non-ACLed implementation of StorageService.
@@ -82,6 +90,19 @@ func Internal(d *tq.Dispatcher, b *bqlog.Bundler, s *settings.Settings, opts *se
 		getSignedURL:   getSignedURL,
 		submitLog:      func(ctx context.Context, entry *api.VerificationLogEntry) { b.Log(ctx, entry) },
 	}
+	if s.ChunksGSPath != "" {
+		impl.getChunks = func(ctx context.Context) chunks.Store {
+			tr, err := auth.GetRPCTransport(ctx, auth.NoAuth)
+			if err != nil {
+				panic(err) // NoAuth transport never fails
+			}
+			return &chunks.GSStore{
+				GS:     gs.Get(ctx),
+				Client: &http.Client{Transport: tr},
+				Root:   s.ChunksGSPath,
+			}
+		}
+	}
 	impl.registerTasks()
 	b.RegisterSink(bqlog.Sink{
 		Prototype: &api.VerificationLogEntry{},
@@ -107,6 +128,10 @@ type storageImpl struct {
 	getGS        func(ctx context.Context) gs.GoogleStorage
 	getSignedURL func(ctx context.Context, gsPath, filename string, signer signerFactory, gs gs.GoogleStorage) (string, uint64, error)
 	submitLog    func(ctx context.Context, entry *api.VerificationLogEntry)
+
+	// Storage for chunked objects or nil if objects are stored as is.
+	getChunks func(ctx context.Context) chunks.Store
+	chunking  chunks.Params // if zero, chunks.DefaultParams is used
 }
 
 // registerTasks adds tasks to the tq Dispatcher.
@@ -140,6 +165,13 @@ func (s *storageImpl) GetReader(ctx context.Context, ref *api.ObjectRef) (r gs.R
 		return nil, errors.Annotate(err, "bad ref").Err()
 	}
 
+	switch m, err := s.getManifest(ctx, ref); {
+	case err != nil:
+		return nil, err
+	case m != nil:
+		return chunks.NewReader(ctx, s.chunkStore(ctx), m), nil
+	}
+
 	r, err = s.getGS(ctx).Reader(ctx, s.settings.ObjectPath(ref), 0)
 	if err != nil {
 		ann := errors.Annotate(err, "can't read the object")
@@ -166,6 +198,19 @@ func (s *storageImpl) GetObjectURL(ctx context.Context, r *api.GetObjectURLReque
 		return nil, status.Errorf(codes.InvalidArgument, "bad 'download_filename' field, contains one of %q", "\"\r\n")
 	}
 
+	// Chunked objects are reassembled by the backend itself.
+	switch m, err := s.getManifest(ctx, r.Object); {
+	case err != nil:
+		return nil, err
+	case m != nil:
+		url, err := s.chunkedURL(ctx, r.Object, r.DownloadFilename)
+		if err != nil {
+			return nil, err
+		}
+		monitoring.FileSize(ctx, uint64(m.Size))
+		return &api.ObjectURL{SignedUrl: url}, nil
+	}
+
 	url, size, err := s.getSignedURL(ctx, s.settings.ObjectPath(r.Object), r.DownloadFilename, defaultSigner, s.getGS(ctx))
 	if err != nil {
 		return nil, errors.Annotate(err, "failed to get signed URL").Err()
@@ -205,7 +250,7 @@ func (s *storageImpl) BeginUpload(ctx context.Context, r *api.BeginUploadRequest
 	// client is still uploading, nothing catastrophic happens, just some time
 	// gets wasted.
 	if r.Object != nil {
-		switch yes, err := gs.Exists(ctx, s.settings.ObjectPath(r.Object)); {
+		switch yes, err := s.objectExists(ctx, gs, r.Object); {
 		case err != nil:
 			return nil, errors.Annotate(err, "failed to check the object's presence").
 				Tag(grpcutil.InternalTag).Err()
@@ -388,7 +433,12 @@ func (s *storageImpl) finishAndForcedHash(ctx context.Context, op *upload.Operat
 	// Try to move the object into the final location. This may fail
 	// transiently, in which case we ask the client to retry, or fatally, in
 	// which case we close the upload operation with an error.
-	pubErr := gs.Publish(ctx, s.settings.ObjectPath(hash), op.TempGSPath, -1)
+	var pubErr error
+	if store := s.chunkStore(ctx); store != nil {
+		pubErr = s.publishChunked(ctx, store, gs, hash, op.TempGSPath, -1)
+	} else {
+		pubErr = gs.Publish(ctx, s.settings.ObjectPath(hash), op.TempGSPath, -1)
+	}
 	if transient.Tag.In(pubErr) {
 		return nil, errors.Annotate(pubErr, "failed to publish the object").
 			Tag(grpcutil.InternalTag).Err()
@@ -440,10 +490,10 @@ func (s *storageImpl) verifyUploadTask(ctx context.Context, task *tasks.VerifyUp
 	// Otherwise we still need to verify the temp file, and then move it into
 	// the final location.
 	if op.HexDigest != "" {
-		exists, err := gs.Exists(ctx, s.settings.ObjectPath(&api.ObjectRef{
+		exists, err := s.objectExists(ctx, gs, &api.ObjectRef{
 			HashAlgo:  op.HashAlgo,
 			HexDigest: op.HexDigest,
-		}))
+		})
 		switch {
 		case err != nil:
 			return errors.Annotate(err, "failed to check the presence of the destination file").
@@ -560,10 +610,22 @@ func (s *storageImpl) verifyUploadTask(ctx context.Context, task *tasks.VerifyUp
 	}
 	logEntry.FileSize = fileSize
 
-	// Feed the file to the hasher.
-	_, err = io.CopyBuffer(hash, io.NewSectionReader(r, 0, fileSize), make([]byte, bufSize))
-	if err != nil {
-		return errors.Annotate(err, "failed to read Google Storage file").Err()
+	// Feed the file to the hasher. If storing objects as chunks, split it into
+	// chunks at the same time. Chunks are content-addressed, so it is fine to
+	// store them before the verification is done. The manifest that makes them
+	// an object is stored only if the hash matches.
+	var manifest *chunks.Manifest
+	store := s.chunkStore(ctx)
+	if store != nil {
+		manifest, err = s.writeChunks(ctx, store, io.TeeReader(io.NewSectionReader(r, 0, fileSize), hash))
+		if err != nil {
+			return errors.Annotate(err, "failed to store chunks").Err()
+		}
+	} else {
+		_, err = io.CopyBuffer(hash, io.NewSectionReader(r, 0, fileSize), make([]byte, bufSize))
+		if err != nil {
+			return errors.Annotate(err, "failed to read Google Storage file").Err()
+		}
 	}
 	verifiedHexDigest = hex.EncodeToString(hash.Sum(nil))
 
@@ -578,16 +640,24 @@ func (s *storageImpl) verifyUploadTask(ctx context.Context, task *tasks.VerifyUp
 		return errors.Reason("expected %s to be %s, got %s", op.HashAlgo, op.HexDigest, verifiedHexDigest).Err()
 	}
 
+	verifiedRef := &api.ObjectRef{
+		HashAlgo:  op.HashAlgo,
+		HexDigest: verifiedHexDigest,
+	}
+	if manifest != nil {
+		if err := store.PutManifest(ctx, verifiedRef, manifest); err != nil {
+			return errors.Annotate(err, "failed to store the manifest").Err()
+		}
+		return nil
+	}
+
 	// The verification was successful, move the temp file (at the generation we
 	// have just verified) to the final location. If the file was modified after
 	// we have verified it (has different generation number), Publish fails:
 	// clients must not modify uploads after calling FinishUpload, this is
 	// sneaky behavior. Regardless of the outcome of this operation, the upload
 	// operation is closed in the defer above.
-	err = gs.Publish(ctx, s.settings.ObjectPath(&api.ObjectRef{
-		HashAlgo:  op.HashAlgo,
-		HexDigest: verifiedHexDigest,
-	}), op.TempGSPath, r.Generation())
+	err = gs.Publish(ctx, s.settings.ObjectPath(verifiedRef), op.TempGSPath, r.Generation())
 	if err != nil {
 		return errors.Annotate(err, "failed to publish the verified file").Err()
 	}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"sync"
 	"testing"
@@ -32,17 +33,20 @@ import (
 	"go.chromium.org/luci/gae/impl/memory"
 	"go.chromium.org/luci/gae/service/datastore"
 	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/server/router"
 	"go.chromium.org/luci/server/secrets"
 	"go.chromium.org/luci/server/secrets/testsecrets"
 	"go.chromium.org/luci/server/tq"
 	"go.chromium.org/luci/server/tq/tqtesting"
 
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/cas/chunks"
 	"go.chromium.org/luci/cipd/appengine/impl/cas/tasks"
 	"go.chromium.org/luci/cipd/appengine/impl/cas/upload"
 	"go.chromium.org/luci/cipd/appengine/impl/gs"
 	"go.chromium.org/luci/cipd/appengine/impl/settings"
 	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+	"go.chromium.org/luci/cipd/common"
 
 	. "github.com/smartystreets/goconvey/convey"
 	. "go.chromium.org/luci/common/testing/assertions"
@@ -792,6 +796,50 @@ func TestFinishUpload(t *testing.T) {
 			})
 		})
 
+		Convey("Without force hash, known expected BLAKE3 hash", func() {
+			op, err := impl.BeginUpload(ctx, &api.BeginUploadRequest{
+				Object: &api.ObjectRef{
+					HashAlgo:  api.HashAlgo_BLAKE3,
+					HexDigest: "86f2d80abe9c3f7b4a1a57a8d1130fa8dc08c81604833ce1212dc039b010d9e4",
+				},
+			})
+			So(err, ShouldBeNil)
+
+			gsMock.files["/bucket/tmp_path/1454472306_1"] = "12345"
+
+			op, err = impl.FinishUpload(ctx, &api.FinishUploadRequest{
+				UploadOperationId: op.OperationId,
+			})
+			So(err, ShouldBeNil)
+			So(op.Status, ShouldEqual, api.UploadStatus_VERIFYING)
+
+			t := tq.Tasks()
+			So(t, ShouldHaveLength, 1)
+
+			Convey("Successful verification", func() {
+				So(impl.verifyUploadTask(ctx, t[0].Payload.(*tasks.VerifyUpload)), ShouldBeNil)
+
+				So(gsMock.publisCalls, ShouldResemble, []publishCall{
+					{
+						dst:    "/bucket/store/BLAKE3/86f2d80abe9c3f7b4a1a57a8d1130fa8dc08c81604833ce1212dc039b010d9e4",
+						src:    "/bucket/tmp_path/1454472306_1",
+						srcGen: 42,
+					},
+				})
+
+				So(verificationLogs.last().ExpectedInstanceId, ShouldEqual, "hvLYCr6cP3tKGleo0RMPqNwIyBYEgzzhIS3AObAQ2eQD")
+				So(verificationLogs.last().VerifiedInstanceId, ShouldEqual, "hvLYCr6cP3tKGleo0RMPqNwIyBYEgzzhIS3AObAQ2eQD")
+			})
+
+			Convey("Failed verification", func() {
+				gsMock.files["/bucket/tmp_path/1454472306_1"] = "123456"
+
+				err := impl.verifyUploadTask(ctx, t[0].Payload.(*tasks.VerifyUpload))
+				So(err, ShouldErrLike, "expected BLAKE3 to be")
+				So(gsMock.publisCalls, ShouldHaveLength, 0)
+			})
+		})
+
 		Convey("Bad force_hash field", func() {
 			_, err := impl.FinishUpload(ctx, &api.FinishUploadRequest{
 				ForceHash: &api.ObjectRef{
@@ -812,6 +860,146 @@ func TestFinishUpload(t *testing.T) {
 	})
 }
 
+func TestChunkedStorage(t *testing.T) {
+	t.Parallel()
+
+	Convey("With mocks", t, func() {
+		ctx, gsMock, tq, _, impl := storageMocks()
+
+		store := &chunks.LocalStore{Root: t.TempDir()}
+		impl.settings.ServiceURL = "https://cipd.example.com"
+		impl.getChunks = func(context.Context) chunks.Store { return store }
+		impl.chunking = chunks.Params{MinSize: 1, AvgSize: 2, MaxSize: 4}
+
+		ref := &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_SHA256,
+			HexDigest: "5994471abb01112afcc18159f6cc74b4f511b99806da59b3caf5a9c173cacfc5",
+		}
+
+		doUpload := func(forceHash *api.ObjectRef) {
+			op, err := impl.BeginUpload(ctx, &api.BeginUploadRequest{
+				HashAlgo: api.HashAlgo_SHA256,
+			})
+			So(err, ShouldBeNil)
+
+			// Pretend we've uploaded 5 bytes.
+			gsMock.files["/bucket/tmp_path/1454472306_1"] = "12345"
+
+			op, err = impl.FinishUpload(ctx, &api.FinishUploadRequest{
+				UploadOperationId: op.OperationId,
+				ForceHash:         forceHash,
+			})
+			So(err, ShouldBeNil)
+			if forceHash == nil {
+				t := tq.Tasks()
+				So(t, ShouldHaveLength, 1)
+				So(impl.verifyUploadTask(ctx, t[0].Payload.(*tasks.VerifyUpload)), ShouldBeNil)
+				op, err = impl.FinishUpload(ctx, &api.FinishUploadRequest{
+					UploadOperationId: op.OperationId,
+				})
+				So(err, ShouldBeNil)
+			}
+			So(op.Status, ShouldEqual, api.UploadStatus_PUBLISHED)
+			So(op.Object, ShouldResembleProto, ref)
+
+			// Stored as chunks, the temp file is deleted.
+			So(gsMock.publisCalls, ShouldHaveLength, 0)
+			So(gsMock.deleteCalls, ShouldResemble, []string{"/bucket/tmp_path/1454472306_1"})
+			m, err := store.GetManifest(ctx, ref)
+			So(err, ShouldBeNil)
+			So(m.Size, ShouldEqual, 5)
+			So(len(m.Chunks), ShouldBeGreaterThan, 1)
+		}
+
+		Convey("Verified upload", func() {
+			doUpload(nil)
+		})
+
+		Convey("Forced hash upload", func() {
+			doUpload(ref)
+		})
+
+		Convey("Failed verification", func() {
+			op, err := impl.BeginUpload(ctx, &api.BeginUploadRequest{
+				Object: &api.ObjectRef{
+					HashAlgo:  api.HashAlgo_SHA256,
+					HexDigest: strings.Repeat("a", 64),
+				},
+			})
+			So(err, ShouldBeNil)
+			gsMock.files["/bucket/tmp_path/1454472306_1"] = "12345"
+			_, err = impl.FinishUpload(ctx, &api.FinishUploadRequest{
+				UploadOperationId: op.OperationId,
+			})
+			So(err, ShouldBeNil)
+			err = impl.verifyUploadTask(ctx, tq.Tasks()[0].Payload.(*tasks.VerifyUpload))
+			So(err, ShouldErrLike, "expected SHA256 to be")
+
+			// No manifests.
+			_, err = store.GetManifest(ctx, &api.ObjectRef{
+				HashAlgo:  api.HashAlgo_SHA256,
+				HexDigest: strings.Repeat("a", 64),
+			})
+			So(err, ShouldEqual, chunks.ErrNotFound)
+		})
+
+		Convey("Reading", func() {
+			doUpload(nil)
+
+			Convey("Already exists", func() {
+				_, err := impl.BeginUpload(ctx, &api.BeginUploadRequest{Object: ref})
+				So(status.Code(err), ShouldEqual, codes.AlreadyExists)
+			})
+
+			Convey("GetReader", func() {
+				r, err := impl.GetReader(ctx, ref)
+				So(err, ShouldBeNil)
+				body, err := io.ReadAll(io.NewSectionReader(r, 0, r.Size()))
+				So(err, ShouldBeNil)
+				So(string(body), ShouldEqual, "12345")
+			})
+
+			Convey("GetObjectURL and download", func() {
+				resp, err := impl.GetObjectURL(ctx, &api.GetObjectURLRequest{
+					Object:           ref,
+					DownloadFilename: "file.name",
+				})
+				So(err, ShouldBeNil)
+				So(resp.SignedUrl, ShouldStartWith, "https://cipd.example.com/_cas/chunked/WZRHGrsBESr8wYFZ9sx0tPURuZgG2lmzyvWpwXPKz8UC?token=")
+
+				r := router.New()
+				impl.InstallHandlers(r, router.NewMiddlewareChain())
+				fetch := func(url string, hdr http.Header) *httptest.ResponseRecorder {
+					req := httptest.NewRequest("GET", url, nil).WithContext(ctx)
+					for k, v := range hdr {
+						req.Header[k] = v
+					}
+					rec := httptest.NewRecorder()
+					r.ServeHTTP(rec, req)
+					return rec
+				}
+
+				rec := fetch(resp.SignedUrl, nil)
+				So(rec.Code, ShouldEqual, http.StatusOK)
+				So(rec.Body.String(), ShouldEqual, "12345")
+				So(rec.Header().Get("Content-Disposition"), ShouldEqual, `attachment; filename="file.name"`)
+
+				rec = fetch(resp.SignedUrl, http.Header{"Range": {"bytes=1-3"}})
+				So(rec.Code, ShouldEqual, http.StatusPartialContent)
+				So(rec.Body.String(), ShouldEqual, "234")
+
+				// The token is bound to the object.
+				rec = fetch(strings.Replace(resp.SignedUrl, "WZRHGrsBESr8wYFZ9sx0tPURuZgG2lmzyvWpwXPKz8UC",
+					common.ObjectRefToInstanceID(&api.ObjectRef{
+						HashAlgo:  api.HashAlgo_SHA256,
+						HexDigest: strings.Repeat("a", 64),
+					}), 1), nil)
+				So(rec.Code, ShouldEqual, http.StatusForbidden)
+			})
+		})
+	})
+}
+
 func TestCancelUpload(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,193 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cas
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/server/router"
+	"go.chromium.org/luci/server/tokens"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/cas/chunks"
+	"go.chromium.org/luci/cipd/appengine/impl/gs"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// chunkedDownloadPath is a path of the HTTP endpoint that serves chunked
+// objects.
+const chunkedDownloadPath = "/_cas/chunked/"
+
+// downloadToken describes how to generate HMAC-protected tokens used in
+// download URLs of chunked objects.
+//
+// They play the same role as signatures in Google Storage signed URLs.
+var downloadToken = tokens.TokenKind{
+	Algo:       tokens.TokenAlgoHmacSHA256,
+	Expiration: maxSignedURLExpiration,
+	SecretKey:  "cipd_chunked_download_key",
+	Version:    1,
+}
+
+// chunkStore returns the storage for chunked objects or nil if the chunking is
+// disabled.
+func (s *storageImpl) chunkStore(ctx context.Context) chunks.Store {
+	if s.getChunks == nil {
+		return nil
+	}
+	return s.getChunks(ctx)
+}
+
+// chunkParams returns parameters of the content-defined chunking.
+func (s *storageImpl) chunkParams() chunks.Params {
+	if s.chunking.AvgSize == 0 {
+		return chunks.DefaultParams
+	}
+	return s.chunking
+}
+
+// getManifest returns a manifest of a chunked object.
+//
+// Returns nil if the chunking is disabled or the object is not chunked. Returns
+// grpc-tagged errors.
+func (s *storageImpl) getManifest(ctx context.Context, ref *api.ObjectRef) (*chunks.Manifest, error) {
+	store := s.chunkStore(ctx)
+	if store == nil {
+		return nil, nil
+	}
+	switch m, err := store.GetManifest(ctx, ref); {
+	case err == chunks.ErrNotFound:
+		return nil, nil
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to fetch the object manifest").Tag(grpcutil.InternalTag).Err()
+	default:
+		return m, nil
+	}
+}
+
+// objectExists returns true if the object is in the storage, either as
+// a chunked object or as a regular Google Storage file.
+func (s *storageImpl) objectExists(ctx context.Context, gs gs.GoogleStorage, ref *api.ObjectRef) (bool, error) {
+	switch m, err := s.getManifest(ctx, ref); {
+	case err != nil:
+		return false, err
+	case m != nil:
+		return true, nil
+	}
+	return gs.Exists(ctx, s.settings.ObjectPath(ref))
+}
+
+// chunkedURL returns a URL to download a chunked object from.
+func (s *storageImpl) chunkedURL(ctx context.Context, ref *api.ObjectRef, filename string) (string, error) {
+	iid := common.ObjectRefToInstanceID(ref)
+	tok, err := downloadToken.Generate(ctx, []byte(iid), map[string]string{"fn": filename}, 0)
+	if err != nil {
+		return "", errors.Annotate(err, "failed to generate download token").Tag(grpcutil.InternalTag).Err()
+	}
+	return fmt.Sprintf("%s%s%s?%s",
+		strings.TrimSuffix(s.settings.ServiceURL, "/"), chunkedDownloadPath, iid,
+		url.Values{"token": {tok}}.Encode()), nil
+}
+
+// writeChunks splits the object into chunks and stores new ones.
+//
+// The manifest is not stored. Returns errors as is.
+func (s *storageImpl) writeChunks(ctx context.Context, store chunks.Store, r io.Reader) (*chunks.Manifest, error) {
+	params := s.chunkParams()
+	m, stats, err := chunks.Write(ctx, store, bufio.NewReaderSize(r, params.MaxSize), params)
+	if err != nil {
+		return nil, err
+	}
+	logging.Infof(ctx, "Stored %d new chunks out of %d (%d bytes out of %d)",
+		stats.NewChunks, stats.Chunks, stats.StoredBytes, m.Size)
+	return m, nil
+}
+
+// publishChunked stores a temp file as a chunked object.
+//
+// Returns errors as is (in particular preserving transient tags).
+func (s *storageImpl) publishChunked(ctx context.Context, store chunks.Store, gs gs.GoogleStorage, ref *api.ObjectRef, tempPath string, gen int64) error {
+	r, err := gs.Reader(ctx, tempPath, gen)
+	if err != nil {
+		return errors.Annotate(err, "failed to start reading Google Storage file").Err()
+	}
+	m, err := s.writeChunks(ctx, store, io.NewSectionReader(r, 0, r.Size()))
+	if err != nil {
+		return errors.Annotate(err, "failed to store chunks").Err()
+	}
+	if err := store.PutManifest(ctx, ref, m); err != nil {
+		return errors.Annotate(err, "failed to store the manifest").Err()
+	}
+	return nil
+}
+
+// InstallHandlers is part of StorageServer interface.
+func (s *storageImpl) InstallHandlers(r *router.Router, base router.MiddlewareChain) {
+	r.GET(chunkedDownloadPath+":iid", base, func(ctx *router.Context) {
+		err := status.Convert(grpcutil.GRPCifyAndLogErr(ctx.Context, s.handleChunkedDownload(ctx)))
+		if err.Code() != codes.OK {
+			http.Error(ctx.Writer, err.Message(), grpcutil.CodeStatus(err.Code()))
+		}
+	})
+}
+
+// handleChunkedDownload reassembles a chunked object and streams it.
+//
+// GET /_cas/chunked/<instance id>?token=...
+//
+// Supports Range requests.
+func (s *storageImpl) handleChunkedDownload(ctx *router.Context) error {
+	iid := ctx.Params.ByName("iid")
+	if err := common.ValidateInstanceID(iid, common.KnownHash); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	body, err := downloadToken.Validate(ctx.Context, ctx.Request.FormValue("token"), []byte(iid))
+	if err != nil {
+		return errors.Reason("bad download token").
+			InternalReason("%s", err).
+			Tag(grpcutil.PermissionDeniedTag).Err()
+	}
+
+	ref := common.InstanceIDToObjectRef(iid)
+	m, err := s.getManifest(ctx.Context, ref)
+	switch {
+	case err != nil:
+		return err
+	case m == nil:
+		return status.Errorf(codes.NotFound, "no such object")
+	}
+
+	hdr := ctx.Writer.Header()
+	hdr.Set("Content-Type", "application/octet-stream")
+	if fn := body["fn"]; fn != "" {
+		hdr.Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, fn))
+	}
+	r := chunks.NewReader(ctx.Context, s.chunkStore(ctx.Context), m)
+	http.ServeContent(ctx.Writer, ctx.Request, "", time.Time{}, io.NewSectionReader(r, 0, r.Size()))
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chunks implements content-defined chunking of CAS objects.
+//
+// Objects are split into variable-sized chunks at positions determined by
+// their content (using a rolling "gear" hash), so that inserting or removing
+// bytes in the middle of an object changes only the chunks around the edit.
+// Each chunk is stored once under its SHA256 digest. An object is represented
+// by a manifest: an ordered list of its chunks.
+//
+// Successive instances of the same package usually have most of their files
+// unchanged and thus share most of their chunks.
+package chunks
+
+import (
+	"fmt"
+	"io"
+	"math/bits"
+)
+
+// Params define the chunking parameters.
+//
+// Changing them for an existing storage is fine, but new objects will share
+// fewer chunks with old ones.
+type Params struct {
+	MinSize int // chunks are never smaller than this, except the last one
+	AvgSize int // desired average chunk size, must be a power of two
+	MaxSize int // chunks are never larger than this
+}
+
+// DefaultParams are used by default.
+var DefaultParams = Params{
+	MinSize: 256 * 1024,
+	AvgSize: 1024 * 1024,
+	MaxSize: 4 * 1024 * 1024,
+}
+
+// Validate returns an error if the parameters are invalid.
+func (p Params) Validate() error {
+	switch {
+	case p.MinSize <= 0:
+		return fmt.Errorf("min size should be positive, got %d", p.MinSize)
+	case p.AvgSize&(p.AvgSize-1) != 0:
+		return fmt.Errorf("average size should be a power of two, got %d", p.AvgSize)
+	case p.MinSize > p.AvgSize || p.AvgSize > p.MaxSize:
+		return fmt.Errorf("should have min <= avg <= max, got %d, %d, %d", p.MinSize, p.AvgSize, p.MaxSize)
+	}
+	return nil
+}
+
+// gear is a table of random 64-bit values used by the rolling hash.
+//
+// It is derived from a fixed seed, since chunk boundaries must be stable.
+var gear [256]uint64
+
+func init() {
+	// splitmix64.
+	x := uint64(0x2545f4914f6cdd1d)
+	for i := range gear {
+		x += 0x9e3779b97f4a7c15
+		z := x
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		gear[i] = z ^ (z >> 31)
+	}
+}
+
+// Split reads all of `r` and calls `cb` for each content-defined chunk.
+//
+// The slice passed to `cb` is valid only until `cb` returns. Returns the first
+// error from `r` (other than io.EOF) or from `cb`.
+func Split(r io.Reader, p Params, cb func(chunk []byte) error) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	// Check the top log2(AvgSize) bits of the hash. Top bits of the gear hash
+	// depend on the last 64 bytes of the input.
+	shift := 64 - bits.TrailingZeros(uint(p.AvgSize))
+	mask := uint64(p.AvgSize-1) << shift
+
+	buf := make([]byte, p.MaxSize)
+	filled := 0
+	eof := false
+	for {
+		// Fill the buffer as much as possible.
+		for !eof && filled < len(buf) {
+			n, err := r.Read(buf[filled:])
+			filled += n
+			switch {
+			case err == io.EOF:
+				eof = true
+			case err != nil:
+				return err
+			}
+		}
+		if filled == 0 {
+			return nil
+		}
+
+		// Find the cut point.
+		cut := filled
+		if filled > p.MinSize {
+			var h uint64
+			for i := p.MinSize; i < filled; i++ {
+				h = (h << 1) + gear[buf[i]]
+				if h&mask == 0 {
+					cut = i + 1
+					break
+				}
+			}
+		}
+
+		if err := cb(buf[:cut]); err != nil {
+			return err
+		}
+		filled = copy(buf, buf[cut:filled])
+	}
+}
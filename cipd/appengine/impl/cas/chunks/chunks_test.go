@@ -0,0 +1,173 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chunks
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+var testParams = Params{
+	MinSize: 1024,
+	AvgSize: 4096,
+	MaxSize: 16384,
+}
+
+func randomBytes(seed int64, n int) []byte {
+	buf := make([]byte, n)
+	rand.New(rand.NewSource(seed)).Read(buf)
+	return buf
+}
+
+func split(data []byte) [][]byte {
+	var out [][]byte
+	err := Split(bytes.NewReader(data), testParams, func(chunk []byte) error {
+		out = append(out, append([]byte(nil), chunk...))
+		return nil
+	})
+	So(err, ShouldBeNil)
+	return out
+}
+
+func TestSplit(t *testing.T) {
+	t.Parallel()
+
+	Convey("Empty", t, func() {
+		So(split(nil), ShouldHaveLength, 0)
+	})
+
+	Convey("Small", t, func() {
+		So(split([]byte("hello")), ShouldResemble, [][]byte{[]byte("hello")})
+	})
+
+	Convey("Respects limits and reassembles", t, func() {
+		data := randomBytes(1, 1024*1024)
+		chunks := split(data)
+		So(len(chunks), ShouldBeGreaterThan, 1)
+		for i, c := range chunks {
+			So(len(c), ShouldBeLessThanOrEqualTo, testParams.MaxSize)
+			if i != len(chunks)-1 {
+				So(len(c), ShouldBeGreaterThanOrEqualTo, testParams.MinSize)
+			}
+		}
+		So(bytes.Join(chunks, nil), ShouldResemble, data)
+	})
+
+	Convey("Insertions change only nearby chunks", t, func() {
+		data := randomBytes(2, 512*1024)
+		edited := append(append(append([]byte(nil), data[:200000]...), []byte("inserted")...), data[200000:]...)
+
+		before := map[string]bool{}
+		for _, c := range split(data) {
+			before[string(c)] = true
+		}
+		after := split(edited)
+		changed := 0
+		for _, c := range after {
+			if !before[string(c)] {
+				changed++
+			}
+		}
+		So(changed, ShouldBeLessThanOrEqualTo, 2)
+	})
+
+	Convey("Bad params", t, func() {
+		err := Split(bytes.NewReader(nil), Params{MinSize: 1, AvgSize: 3, MaxSize: 4}, nil)
+		So(err, ShouldErrLike, "power of two")
+	})
+}
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	Convey("With local store", t, func() {
+		ctx := context.Background()
+		st := &LocalStore{Root: t.TempDir()}
+
+		ref := &api.ObjectRef{HashAlgo: api.HashAlgo_SHA256, HexDigest: "abcdef"}
+
+		Convey("Write and read", func() {
+			data := randomBytes(3, 100000)
+
+			m, stats, err := Write(ctx, st, bytes.NewReader(data), testParams)
+			So(err, ShouldBeNil)
+			So(m.Size, ShouldEqual, len(data))
+			So(stats.NewChunks, ShouldEqual, stats.Chunks)
+			So(stats.StoredBytes, ShouldEqual, len(data))
+
+			So(st.PutManifest(ctx, ref, m), ShouldBeNil)
+			m, err = st.GetManifest(ctx, ref)
+			So(err, ShouldBeNil)
+
+			r := NewReader(ctx, st, m)
+			So(r.Size(), ShouldEqual, len(data))
+			got, err := io.ReadAll(io.NewSectionReader(r, 0, r.Size()))
+			So(err, ShouldBeNil)
+			So(got, ShouldResemble, data)
+
+			// Random access across chunk boundaries.
+			buf := make([]byte, 30000)
+			n, err := r.ReadAt(buf, 50000)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, len(buf))
+			So(buf, ShouldResemble, data[50000:80000])
+
+			// Reading past the end.
+			n, err = r.ReadAt(buf, 90000)
+			So(err, ShouldEqual, io.EOF)
+			So(n, ShouldEqual, 10000)
+
+			// Writing a similar object stores only a few new chunks.
+			edited := append(append([]byte("prefix"), data[:60000]...), []byte("middle")...)
+			edited = append(edited, data[60000:]...)
+			_, stats, err = Write(ctx, st, bytes.NewReader(edited), testParams)
+			So(err, ShouldBeNil)
+			So(stats.NewChunks, ShouldBeLessThan, stats.Chunks)
+		})
+
+		Convey("Missing manifest", func() {
+			_, err := st.GetManifest(ctx, ref)
+			So(err, ShouldEqual, ErrNotFound)
+		})
+
+		Convey("Corrupted chunk", func() {
+			m, _, err := Write(ctx, st, bytes.NewReader([]byte("hello")), testParams)
+			So(err, ShouldBeNil)
+			So(os.WriteFile(st.chunkPath(m.Chunks[0].Digest), []byte("world"), 0666), ShouldBeNil)
+
+			_, err = NewReader(ctx, st, m).ReadAt(make([]byte, 5), 0)
+			So(err, ShouldErrLike, "is corrupted")
+		})
+
+		Convey("Missing chunk", func() {
+			m, _, err := Write(ctx, st, bytes.NewReader([]byte("hello")), testParams)
+			So(err, ShouldBeNil)
+			So(os.RemoveAll(filepath.Join(st.Root, "chunks")), ShouldBeNil)
+
+			_, err = NewReader(ctx, st, m).ReadAt(make([]byte, 5), 0)
+			So(err, ShouldErrLike, "not found")
+		})
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chunks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.chromium.org/luci/common/errors"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/gs"
+)
+
+// GSStore is a Store that keeps everything in Google Storage.
+//
+// Chunks are stored as <Root>/chunks/<digest>, manifests as
+// <Root>/manifests/<hash algo>/<hex digest>.
+type GSStore struct {
+	GS     gs.GoogleStorage // Google Storage client
+	Client *http.Client     // anonymous client used to upload files
+	Root   string           // GS path in a form of /bucket/path
+}
+
+var _ Store = (*GSStore)(nil)
+
+func (s *GSStore) chunkPath(digest string) string {
+	return s.Root + "/chunks/" + digest
+}
+
+func (s *GSStore) manifestPath(ref *api.ObjectRef) string {
+	return s.Root + "/manifests/" + ref.HashAlgo.String() + "/" + ref.HexDigest
+}
+
+// HasChunk is part of Store interface.
+func (s *GSStore) HasChunk(ctx context.Context, digest string) (bool, error) {
+	return s.GS.Exists(ctx, s.chunkPath(digest))
+}
+
+// PutChunk is part of Store interface.
+func (s *GSStore) PutChunk(ctx context.Context, digest string, data []byte) error {
+	return s.write(ctx, s.chunkPath(digest), data)
+}
+
+// GetChunk is part of Store interface.
+func (s *GSStore) GetChunk(ctx context.Context, digest string) ([]byte, error) {
+	return s.read(ctx, s.chunkPath(digest))
+}
+
+// PutManifest is part of Store interface.
+func (s *GSStore) PutManifest(ctx context.Context, ref *api.ObjectRef, m *Manifest) error {
+	blob, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return s.write(ctx, s.manifestPath(ref), blob)
+}
+
+// GetManifest is part of Store interface.
+func (s *GSStore) GetManifest(ctx context.Context, ref *api.ObjectRef) (*Manifest, error) {
+	blob, err := s.read(ctx, s.manifestPath(ref))
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	if err := json.Unmarshal(blob, m); err != nil {
+		return nil, errors.Annotate(err, "bad manifest").Err()
+	}
+	return m, nil
+}
+
+// write uploads a file using a resumable upload session.
+func (s *GSStore) write(ctx context.Context, path string, data []byte) error {
+	uploadURL, err := s.GS.StartUpload(ctx, path)
+	if err != nil {
+		return errors.Annotate(err, "failed to start resumable upload").Err()
+	}
+	u := &gs.Uploader{
+		Context:   ctx,
+		Client:    s.Client,
+		UploadURL: uploadURL,
+		FileSize:  int64(len(data)),
+	}
+	if _, err := u.Write(data); err != nil {
+		if cancelErr := s.GS.CancelUpload(ctx, uploadURL); cancelErr != nil {
+			return errors.Annotate(err, "failed to upload %s (and to cancel the upload: %s)", path, cancelErr).Err()
+		}
+		return errors.Annotate(err, "failed to upload %s", path).Err()
+	}
+	return nil
+}
+
+// read reads the whole file, returning ErrNotFound if it is missing.
+func (s *GSStore) read(ctx context.Context, path string) ([]byte, error) {
+	r, err := s.GS.Reader(ctx, path, 0)
+	if err != nil {
+		if gs.StatusCode(err) == http.StatusNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	data := make([]byte, r.Size())
+	if _, err := r.ReadAt(data, 0); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return data, nil
+}
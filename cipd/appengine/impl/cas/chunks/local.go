@@ -0,0 +1,119 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chunks
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"go.chromium.org/luci/common/errors"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+)
+
+// LocalStore is a Store that keeps everything in a local directory.
+//
+// Useful in tests and when running the server locally without access to
+// Google Storage.
+type LocalStore struct {
+	Root string // the root directory, will be created if necessary
+}
+
+var _ Store = (*LocalStore)(nil)
+
+func (s *LocalStore) chunkPath(digest string) string {
+	return filepath.Join(s.Root, "chunks", digest[:2], digest)
+}
+
+func (s *LocalStore) manifestPath(ref *api.ObjectRef) string {
+	return filepath.Join(s.Root, "manifests", ref.HashAlgo.String(), ref.HexDigest+".json")
+}
+
+// HasChunk is part of Store interface.
+func (s *LocalStore) HasChunk(ctx context.Context, digest string) (bool, error) {
+	switch _, err := os.Stat(s.chunkPath(digest)); {
+	case err == nil:
+		return true, nil
+	case os.IsNotExist(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// PutChunk is part of Store interface.
+func (s *LocalStore) PutChunk(ctx context.Context, digest string, data []byte) error {
+	return s.write(s.chunkPath(digest), data)
+}
+
+// GetChunk is part of Store interface.
+func (s *LocalStore) GetChunk(ctx context.Context, digest string) ([]byte, error) {
+	return s.read(s.chunkPath(digest))
+}
+
+// PutManifest is part of Store interface.
+func (s *LocalStore) PutManifest(ctx context.Context, ref *api.ObjectRef, m *Manifest) error {
+	blob, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return s.write(s.manifestPath(ref), blob)
+}
+
+// GetManifest is part of Store interface.
+func (s *LocalStore) GetManifest(ctx context.Context, ref *api.ObjectRef) (*Manifest, error) {
+	blob, err := s.read(s.manifestPath(ref))
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	if err := json.Unmarshal(blob, m); err != nil {
+		return nil, errors.Annotate(err, "bad manifest").Err()
+	}
+	return m, nil
+}
+
+// write atomically writes a file.
+func (s *LocalStore) write(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// read reads a file, returning ErrNotFound if it is missing.
+func (s *LocalStore) read(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
@@ -0,0 +1,189 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chunks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"sort"
+	"sync"
+
+	"go.chromium.org/luci/common/errors"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+)
+
+// ErrNotFound is returned by Store if the requested chunk or manifest is
+// missing.
+var ErrNotFound = errors.New("not found")
+
+// Store stores chunks and manifests.
+//
+// Chunks are keyed by hex-encoded SHA256 digests of their content. Manifests
+// are keyed by references to objects they describe. Both are immutable once
+// stored, so storing an existing key again is a noop.
+type Store interface {
+	// HasChunk returns true if the chunk is in the store.
+	HasChunk(ctx context.Context, digest string) (bool, error)
+	// PutChunk stores the chunk.
+	PutChunk(ctx context.Context, digest string, data []byte) error
+	// GetChunk returns the chunk content or ErrNotFound.
+	GetChunk(ctx context.Context, digest string) ([]byte, error)
+
+	// PutManifest stores the manifest of an object.
+	PutManifest(ctx context.Context, ref *api.ObjectRef, m *Manifest) error
+	// GetManifest returns the manifest of an object or ErrNotFound.
+	GetManifest(ctx context.Context, ref *api.ObjectRef) (*Manifest, error)
+}
+
+// Manifest describes how to reassemble an object from chunks.
+type Manifest struct {
+	Size   int64   `json:"size"`   // total object size
+	Chunks []Chunk `json:"chunks"` // chunks in order they appear in the object
+}
+
+// Chunk is a reference to a chunk in a manifest.
+type Chunk struct {
+	Digest string `json:"digest"` // hex-encoded SHA256 of the chunk
+	Size   int64  `json:"size"`   // length of the chunk
+}
+
+// WriteStats are returned by Write.
+type WriteStats struct {
+	Chunks      int   // total number of chunks in the object
+	NewChunks   int   // number of chunks that were not in the store before
+	StoredBytes int64 // total size of new chunks
+}
+
+// Write splits data from `r` into chunks and stores new ones in the store.
+//
+// Returns the manifest of the object. It is not stored: the caller should call
+// PutManifest after verifying the object hash.
+func Write(ctx context.Context, st Store, r io.Reader, p Params) (*Manifest, WriteStats, error) {
+	m := &Manifest{Chunks: []Chunk{}}
+	var stats WriteStats
+	err := Split(r, p, func(chunk []byte) error {
+		sum := sha256.Sum256(chunk)
+		digest := hex.EncodeToString(sum[:])
+		m.Chunks = append(m.Chunks, Chunk{Digest: digest, Size: int64(len(chunk))})
+		m.Size += int64(len(chunk))
+		stats.Chunks++
+
+		switch has, err := st.HasChunk(ctx, digest); {
+		case err != nil:
+			return errors.Annotate(err, "checking presence of chunk %s", digest).Err()
+		case has:
+			return nil
+		}
+		if err := st.PutChunk(ctx, digest, chunk); err != nil {
+			return errors.Annotate(err, "storing chunk %s", digest).Err()
+		}
+		stats.NewChunks++
+		stats.StoredBytes += int64(len(chunk))
+		return nil
+	})
+	if err != nil {
+		return nil, stats, err
+	}
+	return m, stats, nil
+}
+
+// Reader reads an object reassembling it from chunks.
+//
+// It implements gs.Reader interface. Fetched chunks are verified against
+// their digests.
+type Reader struct {
+	ctx     context.Context
+	st      Store
+	m       *Manifest
+	offsets []int64 // offsets[i] is where m.Chunks[i] starts
+
+	lock      sync.Mutex
+	lastIdx   int // index of the last fetched chunk or -1
+	lastChunk []byte
+}
+
+// NewReader returns a reader of an object described by the manifest.
+func NewReader(ctx context.Context, st Store, m *Manifest) *Reader {
+	offsets := make([]int64, len(m.Chunks))
+	var off int64
+	for i, c := range m.Chunks {
+		offsets[i] = off
+		off += c.Size
+	}
+	return &Reader{
+		ctx:     ctx,
+		st:      st,
+		m:       m,
+		offsets: offsets,
+		lastIdx: -1,
+	}
+}
+
+// Size is the total object size.
+func (r *Reader) Size() int64 { return r.m.Size }
+
+// Generation is always 1, the objects are immutable.
+func (r *Reader) Generation() int64 { return 1 }
+
+// ReadAt implements io.ReaderAt.
+func (r *Reader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errors.Reason("negative offset").Err()
+	}
+	for n < len(p) {
+		if off >= r.m.Size {
+			return n, io.EOF
+		}
+		// Find the chunk that contains `off`.
+		idx := sort.Search(len(r.offsets), func(i int) bool { return r.offsets[i] > off }) - 1
+		chunk, err := r.chunk(idx)
+		if err != nil {
+			return n, err
+		}
+		copied := copy(p[n:], chunk[off-r.offsets[idx]:])
+		n += copied
+		off += int64(copied)
+	}
+	if off == r.m.Size {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// chunk fetches and verifies a chunk, caching the last one.
+func (r *Reader) chunk(idx int) ([]byte, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if idx == r.lastIdx {
+		return r.lastChunk, nil
+	}
+
+	ref := r.m.Chunks[idx]
+	data, err := r.st.GetChunk(r.ctx, ref.Digest)
+	if err != nil {
+		return nil, errors.Annotate(err, "fetching chunk %s", ref.Digest).Err()
+	}
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != ref.Digest || int64(len(data)) != ref.Size {
+		return nil, errors.Reason("chunk %s is corrupted", ref.Digest).Err()
+	}
+
+	r.lastIdx = idx
+	r.lastChunk = data
+	return data, nil
+}
@@ -19,4 +19,10 @@
 //     internally by the CIPD backend. Get it with Internal().
 //   - The publicly exposed one. It wraps the internal one, adding ACLs. Get it
 //     with Public().
+//
+// Objects are normally stored as whole files in Google Storage. If
+// -cipd-chunks-gs-path is set, new objects are instead split into
+// content-defined chunks (see the chunks package) that are stored only once
+// across all objects. Such objects are reassembled on the fly by GetReader
+// and by the download endpoint that GetObjectURL URLs point to.
 package cas
@@ -17,6 +17,7 @@ package settings
 
 import (
 	"flag"
+	"net/url"
 
 	"go.chromium.org/luci/common/errors"
 
@@ -38,6 +39,20 @@ type Settings struct {
 	// It contains unverified files uploaded by clients before they pass the
 	// hash verification check and copied to the CAS storage area.
 	TempGSPath string
+
+	// ChunksGSPath is GS path in a form of /bucket/path to the root of the
+	// storage area for content-defined chunks of objects.
+	//
+	// If set, new objects are split into chunks which are stored only once, and
+	// objects are reassembled from them on the fly when downloaded. Objects
+	// uploaded before are still read from StorageGSPath.
+	ChunksGSPath string
+
+	// ServiceURL is the root URL of the service as seen by clients.
+	//
+	// It is used to construct download URLs of chunked objects. Required if
+	// ChunksGSPath is set.
+	ServiceURL string
 }
 
 // Register registers settings as CLI flags.
@@ -54,6 +69,18 @@ func (s *Settings) Register(f *flag.FlagSet) {
 		s.TempGSPath,
 		"The root of the pending uploads storage area in Google Storage as a '/bucket/path' string.",
 	)
+	f.StringVar(
+		&s.ChunksGSPath,
+		"cipd-chunks-gs-path",
+		s.ChunksGSPath,
+		"If set, store new objects as deduplicated content-defined chunks under this '/bucket/path'.",
+	)
+	f.StringVar(
+		&s.ServiceURL,
+		"cipd-service-url",
+		s.ServiceURL,
+		"The root URL of the service, e.g. 'https://cipd.example.com'. Required if -cipd-chunks-gs-path is set.",
+	)
 }
 
 // Validate validates settings format.
@@ -70,6 +97,17 @@ func (s *Settings) Validate() error {
 	if err := gs.ValidatePath(s.TempGSPath); err != nil {
 		return errors.Annotate(err, "bad -cipd-temp-gs-path").Err()
 	}
+	if s.ChunksGSPath != "" {
+		if err := gs.ValidatePath(s.ChunksGSPath); err != nil {
+			return errors.Annotate(err, "bad -cipd-chunks-gs-path").Err()
+		}
+		if s.ServiceURL == "" {
+			return errors.Reason("-cipd-service-url is required when using -cipd-chunks-gs-path").Err()
+		}
+		if _, err := url.Parse(s.ServiceURL); err != nil {
+			return errors.Annotate(err, "bad -cipd-service-url").Err()
+		}
+	}
 	return nil
 }
 
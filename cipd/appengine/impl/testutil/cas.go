@@ -17,6 +17,8 @@ package testutil
 import (
 	"context"
 
+	"go.chromium.org/luci/server/router"
+
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
 	"go.chromium.org/luci/cipd/appengine/impl/gs"
 )
@@ -45,6 +47,10 @@ func (m *MockCAS) GetReader(ctx context.Context, ref *api.ObjectRef) (gs.Reader,
 	return m.GetReaderImpl(ctx, ref)
 }
 
+// InstallHandlers implements the corresponding method of cas.StorageServer
+// interface.
+func (m *MockCAS) InstallHandlers(r *router.Router, base router.MiddlewareChain) {}
+
 // GetObjectURL implements the corresponding RPC method, see the proto doc.
 func (m *MockCAS) GetObjectURL(ctx context.Context, r *api.GetObjectURLRequest) (*api.ObjectURL, error) {
 	if m.Err != nil {
@@ -25,6 +25,7 @@ import (
 
 	"github.com/klauspost/compress/flate"
 	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
 
 	"go.chromium.org/luci/common/data/stringset"
 	"go.chromium.org/luci/common/errors"
@@ -54,14 +55,31 @@ type Options struct {
 	// InstallMode defines how to install the package: "copy" or "symlink".
 	InstallMode pkg.InstallMode
 
-	// CompressionLevel defines deflate compression level in range [0-9].
+	// CompressionLevel defines compression level in range [0-9].
+	//
+	// 0 disables compression. For zstd it is mapped to the closest zstd encoder
+	// level.
 	CompressionLevel int
 
+	// Compression defines what compression method to use for files.
+	//
+	// By default it is pkg.CompressionDeflate. Packages that use zstd can be
+	// installed only by clients that support it. Older clients report them as
+	// corrupted, see pkg.CompressionZstd.
+	Compression pkg.Compression
+
 	// HashAlgo specifies what hashing algorithm to use for computing instance ID.
 	//
 	// By default it is common.DefaultHashAlgo.
 	HashAlgo api.HashAlgo
 
+	// FileHashes, if true, makes the builder record names, sizes and hashes of
+	// all files in the package manifest.
+	//
+	// Clients need them to upgrade the package by fetching only changed files
+	// (see cipd.EnsureOptions.DeltaUpgrades).
+	FileHashes bool
+
 	// OverrideFormatVersion, if set, will override the default format version put
 	// into the manifest file.
 	//
@@ -84,6 +102,14 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 		return common.Pin{}, err
 	}
 
+	// Make sure compression method is supported.
+	if err := pkg.ValidateCompression(opts.Compression); err != nil {
+		return common.Pin{}, err
+	}
+	if opts.Compression == "" {
+		opts.Compression = pkg.CompressionDeflate
+	}
+
 	// Make sure hash algo is supported.
 	if opts.HashAlgo == 0 {
 		opts.HashAlgo = common.DefaultHashAlgo
@@ -123,7 +149,7 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 	}
 
 	// Write the final zip file, calculate its hash to use for instance ID.
-	if err := zipInputFiles(ctx, files, io.MultiWriter(opts.Output, hash), opts.CompressionLevel); err != nil {
+	if err := zipInputFiles(ctx, files, io.MultiWriter(opts.Output, hash), opts.Compression, opts.CompressionLevel); err != nil {
 		return common.Pin{}, err
 	}
 	return common.Pin{
@@ -137,8 +163,8 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 
 // zipInputFiles deterministically builds a zip archive out of input files and
 // writes it to the writer. Files are written in the order given.
-func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int) error {
-	logging.Infof(ctx, "About to zip %d files with compression level %d", len(files), level)
+func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, compression pkg.Compression, level int) error {
+	logging.Infof(ctx, "About to zip %d files with %s compression level %d", len(files), compression, level)
 
 	writer := zip.NewWriter(w)
 	defer writer.Close()
@@ -147,6 +173,16 @@ func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int)
 		return flate.NewWriter(out, level)
 	})
 
+	method := zip.Deflate
+	if compression == pkg.CompressionZstd {
+		method = zstd.ZipMethodWinZip
+		// Use a single goroutine to make sure the output is deterministic.
+		writer.RegisterCompressor(method, zstd.ZipCompressor(
+			zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+			zstd.WithEncoderConcurrency(1),
+		))
+	}
+
 	// Reports zipping progress to the log each second.
 	lastReport := time.Time{}
 	progress := func(count int) {
@@ -169,7 +205,13 @@ func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int)
 		// are zero valued. See also zip.FileInfoHeader() implementation.
 		fh := zip.FileHeader{
 			Name:   in.Name(),
-			Method: zip.Deflate,
+			Method: method,
+		}
+		// The manifest is always deflated, so that clients that don't support
+		// zstd but check the format version can read it and fail with a clear
+		// error.
+		if in.Name() == pkg.ManifestName {
+			fh.Method = zip.Deflate
 		}
 		if level == 0 || in.Symlink() || isLikelyAlreadyCompressed(in) {
 			fh.Method = zip.Store
@@ -351,15 +393,26 @@ func makeManifestFile(opts Options) (fs.File, error) {
 		return nil, err
 	}
 	formatVer := pkg.ManifestFormatVersion
+	if opts.Compression == pkg.CompressionZstd {
+		formatVer = pkg.ManifestFormatVersionZstd
+	}
 	if opts.OverrideFormatVersion != "" {
 		formatVer = opts.OverrideFormatVersion
 	}
+	var files []pkg.FileInfo
+	if opts.FileHashes {
+		var err error
+		if files, err = hashInputFiles(opts.Input); err != nil {
+			return nil, err
+		}
+	}
 	buf := &bytes.Buffer{}
 	err := pkg.WriteManifest(&pkg.Manifest{
 		FormatVersion: formatVer,
 		PackageName:   opts.PackageName,
 		VersionFile:   opts.VersionFile,
 		InstallMode:   opts.InstallMode,
+		Files:         files,
 	}, buf)
 	if err != nil {
 		return nil, err
@@ -367,3 +420,36 @@ func makeManifestFile(opts Options) (fs.File, error) {
 	out := manifestFile(buf.Bytes())
 	return &out, nil
 }
+
+// hashInputFiles returns names, sizes and hashes of bodies of the given files.
+//
+// Symlinks are recorded with their targets instead.
+func hashInputFiles(files []fs.File) ([]pkg.FileInfo, error) {
+	out := make([]pkg.FileInfo, 0, len(files))
+	for _, f := range files {
+		if f.Symlink() {
+			target, err := f.SymlinkTarget()
+			if err != nil {
+				return nil, errors.Annotate(err, "resolving symlink %q for hashing", f.Name()).Tag(cipderr.IO).Err()
+			}
+			out = append(out, pkg.FileInfo{Name: f.Name(), Symlink: target})
+			continue
+		}
+		h := common.MustNewHash(common.DefaultHashAlgo)
+		r, err := f.Open()
+		if err != nil {
+			return nil, errors.Annotate(err, "opening %q for hashing", f.Name()).Tag(cipderr.IO).Err()
+		}
+		_, err = io.Copy(h, r)
+		r.Close()
+		if err != nil {
+			return nil, errors.Annotate(err, "hashing %q", f.Name()).Tag(cipderr.IO).Err()
+		}
+		out = append(out, pkg.FileInfo{
+			Name: f.Name(),
+			Size: f.Size(),
+			Hash: common.ObjectRefToInstanceID(common.ObjectRefFromHash(h)),
+		})
+	}
+	return out, nil
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
 
 	"go.chromium.org/luci/cipd/client/cipd/fs"
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
@@ -265,6 +266,116 @@ func TestBuildInstance(t *testing.T) {
 		}
 	})
 
+	Convey("Building package with zstd compression", t, func() {
+		build := func() []byte {
+			out := bytes.Buffer{}
+			_, err := BuildInstance(ctx, Options{
+				Input: []fs.File{
+					fs.NewTestFile("testing/qwerty", "12345", fs.TestFileOpts{}),
+					fs.NewTestFile("abc", "duh", fs.TestFileOpts{Executable: true}),
+					fs.NewTestSymlink("rel_symlink", "abc"),
+				},
+				Output:           &out,
+				PackageName:      "testing",
+				CompressionLevel: 5,
+				Compression:      pkg.CompressionZstd,
+			})
+			So(err, ShouldBeNil)
+			return out.Bytes()
+		}
+
+		data := build()
+
+		// Zstd output is deterministic too.
+		So(build(), ShouldResemble, data)
+
+		goodManifest := `{
+  "format_version": "1.2",
+  "package_name": "testing"
+}`
+		So(readZip(data), ShouldResemble, []zippedFile{
+			{
+				name: "testing/qwerty",
+				size: 5,
+				mode: 0400,
+				body: []byte("12345"),
+			},
+			{
+				name: "abc",
+				size: 3,
+				mode: 0500,
+				body: []byte("duh"),
+			},
+			{
+				name: "rel_symlink",
+				size: 3,
+				mode: 0400 | os.ModeSymlink,
+				body: []byte("abc"),
+			},
+			{
+				name: pkg.ManifestName,
+				size: uint64(len(goodManifest)),
+				mode: 0400,
+				body: []byte(goodManifest),
+			},
+		})
+
+		// Regular files use zstd, the manifest and symlinks do not.
+		z, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		So(err, ShouldBeNil)
+		methods := map[string]uint16{}
+		for _, zf := range z.File {
+			methods[zf.Name] = zf.Method
+		}
+		So(methods, ShouldResemble, map[string]uint16{
+			"testing/qwerty": zstd.ZipMethodWinZip,
+			"abc":            zstd.ZipMethodWinZip,
+			"rel_symlink":    zip.Store,
+			pkg.ManifestName: zip.Deflate,
+		})
+	})
+
+	Convey("Building package with file hashes", t, func() {
+		out := bytes.Buffer{}
+		_, err := BuildInstance(ctx, Options{
+			Input: []fs.File{
+				fs.NewTestFile("testing/qwerty", "12345", fs.TestFileOpts{}),
+				fs.NewTestFile("abc", "duh", fs.TestFileOpts{Executable: true}),
+				fs.NewTestSymlink("rel_symlink", "abc"),
+			},
+			Output:           &out,
+			PackageName:      "testing",
+			CompressionLevel: 5,
+			FileHashes:       true,
+		})
+		So(err, ShouldBeNil)
+
+		hash := func(body string) string {
+			h := common.MustNewHash(common.DefaultHashAlgo)
+			h.Write([]byte(body))
+			return common.ObjectRefToInstanceID(common.ObjectRefFromHash(h))
+		}
+
+		files := readZip(out.Bytes())
+		So(files[len(files)-1].name, ShouldEqual, pkg.ManifestName)
+		manifest, err := pkg.ReadManifest(bytes.NewReader(files[len(files)-1].body))
+		So(err, ShouldBeNil)
+		So(manifest.Files, ShouldResemble, []pkg.FileInfo{
+			{Name: "testing/qwerty", Size: 5, Hash: hash("12345")},
+			{Name: "abc", Size: 3, Hash: hash("duh")},
+			{Name: "rel_symlink", Symlink: "abc"},
+		})
+	})
+
+	Convey("Unknown compression fails", t, func() {
+		_, err := BuildInstance(ctx, Options{
+			Output:      &bytes.Buffer{},
+			PackageName: "testing",
+			Compression: "huh",
+		})
+		So(err, ShouldNotBeNil)
+	})
+
 	Convey("Duplicate files fail", t, func() {
 		_, err := BuildInstance(ctx, Options{
 			Input: []fs.File{
@@ -332,6 +443,7 @@ func readZip(data []byte) []zippedFile {
 	if err != nil {
 		panic("Failed to open zip file")
 	}
+	z.RegisterDecompressor(zstd.ZipMethodWinZip, zstd.ZipDecompressor())
 	files := make([]zippedFile, len(z.File))
 	for i, zf := range z.File {
 		reader, err := zf.Open()
@@ -0,0 +1,358 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle implements reading and writing of offline bundles.
+//
+// A bundle is a single zip archive with a set of package instances and
+// the resolved versions file that maps versions used in some ensure file to
+// instances in the bundle. It can be used to install packages on machines that
+// have no access to the CIPD backend (see 'cipd bundle-create' and
+// 'cipd ensure -from-bundle').
+//
+// The archive layout:
+//
+//	bundle.json               - the bundle manifest, see Manifest.
+//	resolved_versions.txt     - serialized ensure.VersionsFile.
+//	instances/<instance ID>   - instance files, stored uncompressed.
+package bundle
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/cipd/client/cipd/ensure"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/cipd/common/cipderr"
+)
+
+const (
+	// FormatVersion is the version of the bundle format produced by Writer.
+	FormatVersion = "1"
+
+	// ManifestName is a name of the manifest file inside the bundle.
+	ManifestName = "bundle.json"
+
+	// VersionsName is a name of the resolved versions file inside the bundle.
+	VersionsName = "resolved_versions.txt"
+
+	// instancesDir is a directory inside the bundle with instance files.
+	instancesDir = "instances/"
+)
+
+// Manifest is stored as bundle.json inside the bundle.
+type Manifest struct {
+	FormatVersion string     `json:"format_version"`
+	ServiceURL    string     `json:"service_url,omitempty"`
+	Instances     []Instance `json:"instances"`
+}
+
+// Instance describes an instance stored in the bundle.
+type Instance struct {
+	Package    string `json:"package"`
+	InstanceID string `json:"instance_id"`
+	Path       string `json:"path"`
+}
+
+// Pin returns the pin of the instance.
+func (i *Instance) Pin() common.Pin {
+	return common.Pin{PackageName: i.Package, InstanceID: i.InstanceID}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Writer.
+
+// Writer writes a bundle file.
+//
+// Not safe for concurrent use.
+type Writer struct {
+	// ServiceURL is the backend the instances were fetched from.
+	//
+	// Informational only. Stored in the manifest.
+	ServiceURL string
+
+	out      io.Writer
+	zip      *zip.Writer
+	manifest Manifest
+	versions ensure.VersionsFile
+	seen     map[string]bool // instance IDs already in the bundle
+}
+
+// NewWriter returns a writer that writes the bundle into the given output.
+//
+// Must be finalized with Close.
+func NewWriter(out io.Writer) *Writer {
+	return &Writer{
+		out:  out,
+		zip:  zip.NewWriter(out),
+		seen: map[string]bool{},
+	}
+}
+
+// AddInstance copies the instance file into the bundle.
+//
+// Instances already in the bundle are skipped. Doesn't verify the hash of
+// the instance file, it is expected to be verified already (e.g. by
+// the fetcher). It will be verified again when installing from the bundle.
+func (w *Writer) AddInstance(ctx context.Context, pin common.Pin, src pkg.Source) error {
+	if err := common.ValidatePin(pin, common.KnownHash); err != nil {
+		return err
+	}
+	if w.seen[pin.InstanceID] {
+		return nil
+	}
+
+	path := instancesDir + pin.InstanceID
+	dst, err := w.zip.CreateHeader(&zip.FileHeader{
+		Name:   path,
+		Method: zip.Store, // instances are already compressed
+	})
+	if err != nil {
+		return errors.Annotate(err, "adding %s to the bundle", pin).Tag(cipderr.IO).Err()
+	}
+	if _, err := io.Copy(dst, io.NewSectionReader(src, 0, src.Size())); err != nil {
+		return errors.Annotate(err, "copying %s into the bundle", pin).Tag(cipderr.IO).Err()
+	}
+
+	w.seen[pin.InstanceID] = true
+	w.manifest.Instances = append(w.manifest.Instances, Instance{
+		Package:    pin.PackageName,
+		InstanceID: pin.InstanceID,
+		Path:       path,
+	})
+	return nil
+}
+
+// SetVersions sets the resolved versions file to store in the bundle.
+//
+// It is used by 'cipd ensure -from-bundle' to resolve versions in the ensure
+// file without calling the backend.
+func (w *Writer) SetVersions(v ensure.VersionsFile) {
+	w.versions = v
+}
+
+// Close writes the manifest and the versions file and finalizes the archive.
+//
+// Doesn't close the underlying output.
+func (w *Writer) Close() error {
+	sort.Slice(w.manifest.Instances, func(i, j int) bool {
+		l, r := w.manifest.Instances[i], w.manifest.Instances[j]
+		if l.Package != r.Package {
+			return l.Package < r.Package
+		}
+		return l.InstanceID < r.InstanceID
+	})
+	w.manifest.FormatVersion = FormatVersion
+	w.manifest.ServiceURL = w.ServiceURL
+
+	blob, err := json.MarshalIndent(&w.manifest, "", "  ")
+	if err != nil {
+		return errors.Annotate(err, "serializing the bundle manifest").Tag(cipderr.IO).Err()
+	}
+	if err := w.writeFile(ManifestName, blob); err != nil {
+		return err
+	}
+
+	versions := w.versions
+	if versions == nil {
+		versions = ensure.VersionsFile{}
+	}
+	buf := strings.Builder{}
+	if err := versions.Serialize(&buf); err != nil {
+		return err
+	}
+	if err := w.writeFile(VersionsName, []byte(buf.String())); err != nil {
+		return err
+	}
+
+	if err := w.zip.Close(); err != nil {
+		return errors.Annotate(err, "finalizing the bundle").Tag(cipderr.IO).Err()
+	}
+	return nil
+}
+
+func (w *Writer) writeFile(name string, body []byte) error {
+	f, err := w.zip.CreateHeader(&zip.FileHeader{
+		Name:   name,
+		Method: zip.Deflate,
+	})
+	if err == nil {
+		_, err = f.Write(body)
+	}
+	if err != nil {
+		return errors.Annotate(err, "writing %s", name).Tag(cipderr.IO).Err()
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Reader.
+
+// Reader reads a bundle file.
+//
+// Safe for concurrent use.
+type Reader struct {
+	file     *os.File
+	manifest Manifest
+	versions ensure.VersionsFile
+	entries  map[string]*zip.File // instance ID => zip entry with it
+}
+
+// Open opens a bundle file and reads its manifest.
+//
+// The returned reader must be closed with Close when no longer needed.
+func Open(path string) (r *Reader, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "opening the bundle").Tag(cipderr.IO).Err()
+	}
+	defer func() {
+		if err != nil {
+			file.Close()
+		}
+	}()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, errors.Annotate(err, "checking the bundle size").Tag(cipderr.IO).Err()
+	}
+	z, err := zip.NewReader(file, stat.Size())
+	if err != nil {
+		return nil, errors.Annotate(err, "not a valid bundle file").Tag(cipderr.BadArgument).Err()
+	}
+
+	r = &Reader{
+		file:    file,
+		entries: make(map[string]*zip.File, len(z.File)),
+	}
+
+	files := make(map[string]*zip.File, len(z.File))
+	for _, f := range z.File {
+		files[f.Name] = f
+	}
+
+	if err := readJSON(files[ManifestName], &r.manifest); err != nil {
+		return nil, err
+	}
+	if r.manifest.FormatVersion != FormatVersion {
+		return nil, errors.Reason("unsupported bundle format version %q, expecting %q",
+			r.manifest.FormatVersion, FormatVersion).Tag(cipderr.BadArgument).Err()
+	}
+
+	if err := readFile(files[VersionsName], VersionsName, func(rd io.Reader) (err error) {
+		r.versions, err = ensure.ParseVersionsFile(rd)
+		return
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, inst := range r.manifest.Instances {
+		if err := common.ValidatePin(inst.Pin(), common.KnownHash); err != nil {
+			return nil, errors.Annotate(err, "bad bundle manifest").Err()
+		}
+		f := files[inst.Path]
+		if f == nil {
+			return nil, errors.Reason("bad bundle: %s is missing", inst.Path).Tag(cipderr.BadArgument).Err()
+		}
+		r.entries[inst.InstanceID] = f
+	}
+
+	return r, nil
+}
+
+// Close closes the underlying file.
+func (r *Reader) Close() error {
+	return r.file.Close()
+}
+
+// Manifest returns the bundle manifest.
+//
+// It must not be modified.
+func (r *Reader) Manifest() *Manifest {
+	return &r.manifest
+}
+
+// Versions returns the resolved versions file stored in the bundle.
+func (r *Reader) Versions() ensure.VersionsFile {
+	return r.versions
+}
+
+// Fetch copies the instance file from the bundle into the output, verifying
+// its hash along the way.
+//
+// Has a signature compatible with the instance cache fetcher.
+func (r *Reader) Fetch(ctx context.Context, pin common.Pin, output io.WriteSeeker) error {
+	if err := common.ValidatePin(pin, common.KnownHash); err != nil {
+		return err
+	}
+
+	f := r.entries[pin.InstanceID]
+	if f == nil {
+		return errors.Reason("instance %s is not in the bundle", pin).Tag(cipderr.InvalidVersion).Err()
+	}
+	src, err := f.Open()
+	if err != nil {
+		return errors.Annotate(err, "opening %s in the bundle", pin).Tag(cipderr.IO).Err()
+	}
+	defer src.Close()
+
+	if _, err := output.Seek(0, io.SeekStart); err != nil {
+		return errors.Annotate(err, "seeking the output").Tag(cipderr.IO).Err()
+	}
+
+	objRef := common.InstanceIDToObjectRef(pin.InstanceID)
+	hash := common.MustNewHash(objRef.HashAlgo)
+	if _, err := io.Copy(io.MultiWriter(output, hash), src); err != nil {
+		return errors.Annotate(err, "reading %s from the bundle", pin).Tag(cipderr.IO).Err()
+	}
+
+	if digest := common.HexDigest(hash); objRef.HexDigest != digest {
+		return errors.Reason("package hash mismatch: expecting %q, got %q", objRef.HexDigest, digest).Tag(cipderr.HashMismatch).Err()
+	}
+	return nil
+}
+
+func readJSON(f *zip.File, out any) error {
+	return readFile(f, ManifestName, func(r io.Reader) error {
+		if err := json.NewDecoder(r).Decode(out); err != nil {
+			return errors.Annotate(err, "bad bundle manifest").Tag(cipderr.BadArgument).Err()
+		}
+		return nil
+	})
+}
+
+func readFile(f *zip.File, name string, cb func(r io.Reader) error) error {
+	if f == nil {
+		return errors.Reason("bad bundle: %s is missing", name).Tag(cipderr.BadArgument).Err()
+	}
+	r, err := f.Open()
+	if err != nil {
+		return errors.Annotate(err, "opening %s", name).Tag(cipderr.IO).Err()
+	}
+	defer r.Close()
+	return cb(r)
+}
+
+// String is used in log messages.
+func (r *Reader) String() string {
+	return fmt.Sprintf("bundle %s", r.file.Name())
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.chromium.org/luci/cipd/client/cipd/ensure"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/cipd/common/cipderr"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestBundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	instance := func(body string) (common.Pin, pkg.Source) {
+		digest := sha256.Sum256([]byte(body))
+		iid := common.ObjectRefToInstanceID(&api.ObjectRef{
+			HashAlgo:  api.HashAlgo_SHA256,
+			HexDigest: hex.EncodeToString(digest[:]),
+		})
+		return common.Pin{PackageName: "pkg/" + body, InstanceID: iid}, pkg.NewBytesSource([]byte(body))
+	}
+
+	Convey("With temp dir", t, func() {
+		tmp := t.TempDir()
+		path := filepath.Join(tmp, "bundle.zip")
+
+		write := func(cb func(w *Writer)) {
+			out, err := os.Create(path)
+			So(err, ShouldBeNil)
+			defer out.Close()
+			w := NewWriter(out)
+			cb(w)
+			So(w.Close(), ShouldBeNil)
+		}
+
+		fetch := func(r *Reader, pin common.Pin) (string, error) {
+			out, err := os.Create(filepath.Join(tmp, "fetched"))
+			So(err, ShouldBeNil)
+			defer out.Close()
+			if err := r.Fetch(ctx, pin, out); err != nil {
+				return "", err
+			}
+			blob, err := os.ReadFile(out.Name())
+			So(err, ShouldBeNil)
+			return string(blob), nil
+		}
+
+		pin1, src1 := instance("a")
+		pin2, src2 := instance("b")
+
+		Convey("Round trip", func() {
+			versions := ensure.VersionsFile{}
+			So(versions.AddVersion(pin1.PackageName, "latest", pin1.InstanceID), ShouldBeNil)
+			So(versions.AddVersion(pin2.PackageName, "latest", pin2.InstanceID), ShouldBeNil)
+
+			write(func(w *Writer) {
+				w.ServiceURL = "https://example.com"
+				So(w.AddInstance(ctx, pin2, src2), ShouldBeNil)
+				So(w.AddInstance(ctx, pin1, src1), ShouldBeNil)
+				So(w.AddInstance(ctx, pin1, src1), ShouldBeNil) // dup is skipped
+				w.SetVersions(versions)
+			})
+
+			r, err := Open(path)
+			So(err, ShouldBeNil)
+			defer r.Close()
+
+			So(r.Manifest().ServiceURL, ShouldEqual, "https://example.com")
+			So(r.Manifest().Instances, ShouldHaveLength, 2)
+			So(r.Manifest().Instances[0].Pin(), ShouldResemble, pin1)
+			So(r.Manifest().Instances[1].Pin(), ShouldResemble, pin2)
+			So(r.Versions().Equal(versions), ShouldBeTrue)
+
+			body, err := fetch(r, pin1)
+			So(err, ShouldBeNil)
+			So(body, ShouldEqual, "a")
+
+			body, err = fetch(r, pin2)
+			So(err, ShouldBeNil)
+			So(body, ShouldEqual, "b")
+		})
+
+		Convey("Missing instance", func() {
+			write(func(w *Writer) {
+				So(w.AddInstance(ctx, pin1, src1), ShouldBeNil)
+			})
+
+			r, err := Open(path)
+			So(err, ShouldBeNil)
+			defer r.Close()
+
+			_, err = fetch(r, pin2)
+			So(err, ShouldErrLike, "is not in the bundle")
+			So(cipderr.ToCode(err), ShouldEqual, cipderr.InvalidVersion)
+		})
+
+		Convey("Hash mismatch", func() {
+			write(func(w *Writer) {
+				So(w.AddInstance(ctx, pin1, src2), ShouldBeNil)
+			})
+
+			r, err := Open(path)
+			So(err, ShouldBeNil)
+			defer r.Close()
+
+			_, err = fetch(r, pin1)
+			So(err, ShouldErrLike, "package hash mismatch")
+			So(cipderr.ToCode(err), ShouldEqual, cipderr.HashMismatch)
+		})
+
+		Convey("Not a bundle", func() {
+			So(os.WriteFile(path, []byte(strings.Repeat("z", 100)), 0666), ShouldBeNil)
+			_, err := Open(path)
+			So(err, ShouldErrLike, "not a valid bundle file")
+		})
+	})
+}
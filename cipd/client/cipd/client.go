@@ -44,6 +44,7 @@ package cipd
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -73,6 +74,7 @@ import (
 	"go.chromium.org/luci/hardcoded/chromeinfra"
 
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/client/cipd/bundle"
 	"go.chromium.org/luci/cipd/client/cipd/configpb"
 	"go.chromium.org/luci/cipd/client/cipd/deployer"
 	"go.chromium.org/luci/cipd/client/cipd/digests"
@@ -83,6 +85,7 @@ import (
 	"go.chromium.org/luci/cipd/client/cipd/platform"
 	"go.chromium.org/luci/cipd/client/cipd/plugin"
 	"go.chromium.org/luci/cipd/client/cipd/reader"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/client/cipd/ui"
 	"go.chromium.org/luci/cipd/common"
@@ -122,6 +125,7 @@ const (
 	EnvParallelDownloads   = "CIPD_PARALLEL_DOWNLOADS"
 	EnvAdmissionPlugin     = "CIPD_ADMISSION_PLUGIN"
 	EnvCIPDServiceURL      = "CIPD_SERVICE_URL"
+	EnvHashAlgo            = "CIPD_HASH_ALGO"
 )
 
 var (
@@ -151,6 +155,8 @@ type UploadSession struct {
 type DescribeInstanceOpts struct {
 	DescribeRefs bool // if true, will fetch all refs pointing to the instance
 	DescribeTags bool // if true, will fetch all tags attached to the instance
+
+	DescribeMetadata bool // if true, will fetch all metadata attached to the instance
 }
 
 // Client provides high-level CIPD client interface. Thread safe.
@@ -280,6 +286,13 @@ type Client interface {
 	// paranoid checks that all installed packages are free from corruption.
 	FindDeployed(ctx context.Context) (common.PinSliceBySubdir, error)
 
+	// CollectGarbage prunes unused entries from the instance cache, the tag cache
+	// and orphaned directories in the site root guts.
+	//
+	// Instances deployed to the site root (if any) are never pruned. Returns
+	// a report describing everything found and what was pruned.
+	CollectGarbage(ctx context.Context, opts *GCOptions) (*GCReport, error)
+
 	// EnsurePackages installs, removes and updates packages in the site root.
 	//
 	// Given a description of what packages (and versions) should be installed it
@@ -320,6 +333,29 @@ type EnsureOptions struct {
 	// Paranoia needs to be at least CheckDeployed to change the install
 	// mode of an already-deployed package.
 	OverrideInstallMode pkg.InstallMode
+
+	// DeltaUpgrades, if true, makes EnsurePackages upgrade already installed
+	// packages by fetching only files that changed since the installed instance
+	// (using ranged reads from the storage), instead of the whole instance file.
+	//
+	// Falls back to fetching the whole instance file if the storage doesn't
+	// support ranged reads or the delta upgrade fails for some other reason.
+	//
+	// Only instances built with file hashes in their manifest (see
+	// builder.Options.FileHashes) and registered with the hash of the manifest
+	// in their metadata (see deployer.ManifestHashMetadataKey) are upgraded this
+	// way, since the hash of the instance file can't be verified when it is not
+	// fetched in full. Instead the manifest is verified against the hash from
+	// the metadata, and each installed file against the hash from the manifest.
+	DeltaUpgrades bool
+
+	// RequireSignedBy, if not empty, is a list of public keys (as accepted by
+	// signing.ParsePublicKey) trusted to sign package instances.
+	//
+	// EnsurePackages will refuse to install instances that don't have a valid
+	// signature by at least one of these keys. Can't be used with bundles (see
+	// ClientOptions.Bundle), since they don't have signatures.
+	RequireSignedBy []string
 }
 
 // ClientOptions is passed to NewClient and NewClientFromEnv.
@@ -355,6 +391,15 @@ type ClientOptions struct {
 	// This is primarily used to implement $ResolvedVersions ensure file feature.
 	Versions ensure.VersionsFile
 
+	// Bundle is an optional offline bundle to fetch instances from.
+	//
+	// If set, instances are read from the bundle (with their hashes verified)
+	// instead of being fetched from the backend. Instances missing from
+	// the bundle result in an error.
+	//
+	// This is primarily used to implement 'cipd ensure -from-bundle'.
+	Bundle *bundle.Reader
+
 	// AnonymousClient is http.Client that doesn't attach authentication headers.
 	//
 	// Will be used when talking to the Google Storage. We use signed URLs that do
@@ -887,7 +932,7 @@ func (c *clientImpl) instanceCache(ctx context.Context) (*internal.InstanceCache
 	cache := &internal.InstanceCache{
 		FS:                fs.NewFileSystem(cacheDir, ""),
 		Tmp:               tmp,
-		Fetcher:           c.remoteFetchInstance,
+		Fetcher:           c.fetcher(),
 		ParallelDownloads: parallelDownloads,
 	}
 	cache.Launch(ctx) // start background download goroutines
@@ -1428,8 +1473,25 @@ func (c *clientImpl) DescribeInstance(ctx context.Context, pin common.Pin, opts
 	if err != nil {
 		return nil, c.rpcErr(err, nil)
 	}
+	desc = apiDescToInfo(resp)
+
+	if opts.DescribeMetadata {
+		md, err := c.repo.ListMetadata(ctx, &api.ListMetadataRequest{
+			Package:  pin.PackageName,
+			Instance: common.InstanceIDToObjectRef(pin.InstanceID),
+		}, expectedCodes)
+		if err != nil {
+			return nil, c.rpcErr(err, nil)
+		}
+		if len(md.Metadata) != 0 {
+			desc.Metadata = make([]MetadataInfo, len(md.Metadata))
+			for i, m := range md.Metadata {
+				desc.Metadata[i] = apiMetadataToInfo(m)
+			}
+		}
+	}
 
-	return apiDescToInfo(resp), nil
+	return desc, nil
 }
 
 func (c *clientImpl) DescribeClient(ctx context.Context, pin common.Pin) (desc *ClientDescription, err error) {
@@ -1793,7 +1855,7 @@ func (c *clientImpl) FetchInstanceTo(ctx context.Context, pin common.Pin, output
 	// Deal with no-cache situation first, it is simple - just fetch the instance
 	// into the 'output'.
 	if c.CacheDir == "" {
-		return c.remoteFetchInstance(ctx, pin, output)
+		return c.fetcher()(ctx, pin, output)
 	}
 
 	// If using the cache, always fetch into the cache first, and then copy data
@@ -1817,6 +1879,15 @@ func (c *clientImpl) FetchInstanceTo(ctx context.Context, pin common.Pin, output
 	return nil
 }
 
+// fetcher returns a callback that fetches instance files, either from
+// the offline bundle (if configured) or from the backend.
+func (c *clientImpl) fetcher() internal.Fetcher {
+	if c.Bundle != nil {
+		return c.Bundle.Fetch
+	}
+	return c.remoteFetchInstance
+}
+
 // remoteFetchInstance fetches the package file into 'output' and verifies its
 // hash along the way. Assumes 'pin' is already validated.
 func (c *clientImpl) remoteFetchInstance(ctx context.Context, pin common.Pin, output io.WriteSeeker) (err error) {
@@ -1856,6 +1927,173 @@ func (c *clientImpl) remoteFetchInstance(ctx context.Context, pin common.Pin, ou
 	return
 }
 
+// openRemoteInstance opens the instance for reading directly from the storage,
+// fetching only parts of the instance file that are actually read.
+//
+// Doesn't verify the instance hash, since it requires reading the whole file.
+// Files read from the instance must be verified using hashes from its manifest
+// instead, after checking the manifest against its hash from the instance
+// metadata (see deployer.DeltaInstance and manifestHash). Returns
+// errNoRangedReads if the storage doesn't support ranged reads.
+func (c *clientImpl) openRemoteInstance(ctx context.Context, pin common.Pin) (pkg.Instance, error) {
+	objRef := common.InstanceIDToObjectRef(pin.InstanceID)
+	resp, err := c.repo.GetInstanceURL(ctx, &api.GetInstanceURLRequest{
+		Package:  pin.PackageName,
+		Instance: objRef,
+	}, expectedCodes)
+	if err != nil {
+		return nil, c.rpcErr(err, nil)
+	}
+	src, err := c.storage.openRanged(ctx, resp.SignedUrl)
+	if err != nil {
+		return nil, err
+	}
+	inst, err := reader.OpenInstance(ctx, src, reader.OpenInstanceOpts{
+		VerificationMode: reader.SkipHashVerification,
+		InstanceID:       pin.InstanceID,
+	})
+	if err != nil {
+		src.Close(ctx, false)
+		return nil, err
+	}
+	return inst, nil
+}
+
+// deltaUpgrades installs packages that are already installed (at some other
+// version) by fetching only files that changed.
+//
+// Returns actions that still need to be performed using full instance files:
+// repairs, new installations and upgrades that failed.
+func (c *clientImpl) deltaUpgrades(ctx context.Context, updates []updateActions, overrideInstallMode pkg.InstallMode) []updateActions {
+	var remaining []updateActions
+	for _, ua := range updates {
+		todo := c.deltaUpgrade(ctx, ua, overrideInstallMode)
+		if len(todo) != 0 {
+			remaining = append(remaining, updateActions{pin: ua.pin, updates: todo})
+		}
+	}
+	return remaining
+}
+
+// deltaUpgrade attempts to install a single pin using delta upgrades.
+//
+// Returns actions that still need to be performed.
+func (c *clientImpl) deltaUpgrade(ctx context.Context, ua updateActions, overrideInstallMode pkg.InstallMode) (todo []pinAction) {
+	for _, a := range ua.updates {
+		if a.action != ActionInstall {
+			return ua.updates // repairs need the full instance file
+		}
+	}
+
+	if c.pluginAdmission != nil {
+		if err := c.pluginAdmission.CheckAdmission(ua.pin).Wait(ctx); err != nil {
+			return ua.updates // the error will be reported by the regular code path
+		}
+	}
+
+	manifestHash, err := c.manifestHash(ctx, ua.pin)
+	if err != nil {
+		logging.Infof(ctx, "Delta upgrade of %s is not possible, fetching the full instance: %s", ua.pin, err)
+		return ua.updates
+	}
+
+	inst, err := c.openRemoteInstance(ctx, ua.pin)
+	if err != nil {
+		if err != errNoRangedReads {
+			logging.Warningf(ctx, "Delta upgrade of %s is not possible, fetching the full instance: %s", ua.pin, err)
+		}
+		return ua.updates
+	}
+	defer inst.Close(ctx, false)
+
+	for _, a := range ua.updates {
+		delta, err := c.deployer.DeltaInstance(ctx, a.subdir, inst, manifestHash)
+		if err == nil {
+			_, err = c.deployer.DeployInstance(ctx, a.subdir, delta, overrideInstallMode, c.MaxThreads)
+			delta.Close(ctx, false)
+		}
+		if err != nil {
+			switch err {
+			case deployer.ErrNoDeltaBase:
+			case deployer.ErrNoFileHashes:
+				logging.Infof(ctx, "Delta upgrade of %s is not possible, fetching the full instance: %s", ua.pin, err)
+			default:
+				logging.Warningf(ctx, "Delta upgrade of %s failed, fetching the full instance: %s", ua.pin, err)
+			}
+			todo = append(todo, a)
+		}
+	}
+	return todo
+}
+
+// manifestHash returns the hash of the package manifest attached to the
+// instance when it was registered.
+//
+// The instance file isn't verified during delta upgrades, so this hash is what
+// ties hashes of files in the manifest to the pin.
+func (c *clientImpl) manifestHash(ctx context.Context, pin common.Pin) (string, error) {
+	resp, err := c.repo.ListMetadata(ctx, &api.ListMetadataRequest{
+		Package:  pin.PackageName,
+		Instance: common.InstanceIDToObjectRef(pin.InstanceID),
+		Keys:     []string{deployer.ManifestHashMetadataKey},
+	}, expectedCodes)
+	if err != nil {
+		return "", c.rpcErr(err, nil)
+	}
+	hash := ""
+	for _, md := range resp.Metadata {
+		if md.Key != deployer.ManifestHashMetadataKey {
+			continue
+		}
+		if hash != "" && hash != string(md.Value) {
+			return "", errors.Reason("the instance has conflicting %q metadata", deployer.ManifestHashMetadataKey).Err()
+		}
+		hash = string(md.Value)
+	}
+	if hash == "" {
+		return "", errors.Reason("the instance has no %q metadata", deployer.ManifestHashMetadataKey).Err()
+	}
+	return hash, nil
+}
+
+// checkSignatures verifies all pins are signed by at least one of the trusted
+// keys.
+//
+// Reports pins without a valid signature through `report` and returns the rest.
+func (c *clientImpl) checkSignatures(ctx context.Context, updates []updateActions, trusted []ed25519.PublicKey, report func(context.Context, pinAction, error)) []updateActions {
+	var verified []updateActions
+	for _, ua := range updates {
+		err := c.checkSignature(ctx, ua.pin, trusted)
+		if err != nil {
+			for _, a := range ua.updates {
+				report(ctx, a, err)
+			}
+			continue
+		}
+		verified = append(verified, ua)
+	}
+	return verified
+}
+
+// checkSignature fetches signatures of the pin and verifies them.
+func (c *clientImpl) checkSignature(ctx context.Context, pin common.Pin, trusted []ed25519.PublicKey) error {
+	resp, err := c.repo.ListMetadata(ctx, &api.ListMetadataRequest{
+		Package:  pin.PackageName,
+		Instance: common.InstanceIDToObjectRef(pin.InstanceID),
+		Keys:     []string{signing.MetadataKey},
+	}, expectedCodes)
+	if err != nil {
+		return c.rpcErr(err, nil)
+	}
+	sigs := make([][]byte, 0, len(resp.Metadata))
+	for _, md := range resp.Metadata {
+		if md.Key == signing.MetadataKey {
+			sigs = append(sigs, md.Value)
+		}
+	}
+	return signing.Verify(pin, sigs, trusted)
+}
+
 func (c *clientImpl) FindDeployed(ctx context.Context) (common.PinSliceBySubdir, error) {
 	return c.deployer.FindDeployed(ctx)
 }
@@ -1874,6 +2112,17 @@ func (c *clientImpl) EnsurePackages(ctx context.Context, allPins common.PinSlice
 	} else if err = realOpts.Paranoia.Validate(); err != nil {
 		return
 	}
+	trustedKeys := make([]ed25519.PublicKey, len(realOpts.RequireSignedBy))
+	for i, key := range realOpts.RequireSignedBy {
+		if trustedKeys[i], err = signing.ParsePublicKey(key); err != nil {
+			return
+		}
+	}
+	if len(trustedKeys) != 0 && c.Bundle != nil {
+		// Signatures are stored in the backend, bundles don't have them.
+		err = errors.Reason("signatures can't be verified when installing from a bundle, remove $RequireSignedBy or install from the backend").Tag(cipderr.BadArgument).Err()
+		return
+	}
 
 	c.BeginBatch(ctx)
 	defer c.EndBatch(ctx)
@@ -1940,6 +2189,18 @@ func (c *clientImpl) EnsurePackages(ctx context.Context, allPins common.PinSlice
 	// they are fetched. Collect a list of packages to delete and "relink".
 	perPinActions := aMap.perPinActions()
 
+	// Skip instances that don't have a valid signature, if signatures are
+	// required.
+	if len(trustedKeys) != 0 {
+		perPinActions.updates = c.checkSignatures(ctx, perPinActions.updates, trustedKeys, reportActionErr)
+	}
+
+	// Try to upgrade packages by fetching only changed files. Whatever fails
+	// will be installed by fetching full instance files below.
+	if realOpts.DeltaUpgrades && c.Bundle == nil {
+		perPinActions.updates = c.deltaUpgrades(ctx, perPinActions.updates, realOpts.OverrideInstallMode)
+	}
+
 	// Enqueue deployment admission checks if have the plugin enabled. They will
 	// be consulted later before unzipping fetched instances. This is just an
 	// optimization to do checks in parallel with fetching and installing.
@@ -17,6 +17,7 @@ package cipd
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -39,14 +40,18 @@ import (
 
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
 	"go.chromium.org/luci/cipd/client/cipd/builder"
+	"go.chromium.org/luci/cipd/client/cipd/bundle"
+	"go.chromium.org/luci/cipd/client/cipd/deployer"
 	"go.chromium.org/luci/cipd/client/cipd/digests"
 	"go.chromium.org/luci/cipd/client/cipd/fs"
 	"go.chromium.org/luci/cipd/client/cipd/internal"
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
 	"go.chromium.org/luci/cipd/client/cipd/platform"
 	"go.chromium.org/luci/cipd/client/cipd/reader"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/cipd/common/cipderr"
 
 	. "github.com/smartystreets/goconvey/convey"
 
@@ -845,6 +850,54 @@ func TestDescribeInstance(t *testing.T) {
 			})
 		})
 
+		Convey("With metadata", func() {
+			repo.expect(rpcCall{
+				method: "DescribeInstance",
+				in: &api.DescribeInstanceRequest{
+					Package:  "a/b",
+					Instance: fakeObjectRef("0"),
+				},
+				out: &api.DescribeInstanceResponse{
+					Instance: &api.Instance{
+						Package:  "a/b",
+						Instance: fakeObjectRef("0"),
+					},
+				},
+			})
+			repo.expect(rpcCall{
+				method: "ListMetadata",
+				in: &api.ListMetadataRequest{
+					Package:  "a/b",
+					Instance: fakeObjectRef("0"),
+				},
+				out: &api.ListMetadataResponse{
+					Metadata: []*api.InstanceMetadata{
+						{
+							Key:         "k",
+							Value:       []byte("v"),
+							ContentType: "text/plain",
+							Fingerprint: "fp",
+							AttachedBy:  "user:a@example.com",
+						},
+					},
+				},
+			})
+
+			desc, err := client.DescribeInstance(ctx, pin, &DescribeInstanceOpts{
+				DescribeMetadata: true,
+			})
+			So(err, ShouldBeNil)
+			So(desc.Metadata, ShouldResemble, []MetadataInfo{
+				{
+					Fingerprint: "fp",
+					Key:         "k",
+					Value:       []byte("v"),
+					ContentType: "text/plain",
+					AttachedBy:  "user:a@example.com",
+				},
+			})
+		})
+
 		Convey("Bad pin", func() {
 			_, err := client.DescribeInstance(ctx, common.Pin{
 				PackageName: "a/b////",
@@ -1083,6 +1136,184 @@ func TestEnsurePackage(t *testing.T) {
 			So(string(body), ShouldEqual, testFileBody)
 		})
 
+		Convey("EnsurePackages with RequireSignedBy", func() {
+			pub, priv, _ := ed25519.GenerateKey(nil)
+			eo := &EnsureOptions{
+				RequireSignedBy: []string{signing.FormatPublicKey(pub)},
+			}
+
+			// Signatures are checked before the instance is fetched.
+			repo.expected = nil
+			expectSignatures := func(sigs ...[]byte) {
+				out := &api.ListMetadataResponse{}
+				for _, sig := range sigs {
+					out.Metadata = append(out.Metadata, &api.InstanceMetadata{
+						Key:   signing.MetadataKey,
+						Value: sig,
+					})
+				}
+				repo.expect(rpcCall{
+					method: "ListMetadata",
+					in: &api.ListMetadataRequest{
+						Package:  pin.PackageName,
+						Instance: common.InstanceIDToObjectRef(pin.InstanceID),
+						Keys:     []string{signing.MetadataKey},
+					},
+					out: out,
+				})
+			}
+
+			Convey("Signed", func() {
+				expectSignatures(signing.Sign(pin, priv))
+				setupRemoteInstance(body, pin, repo, storage)
+
+				_, err := client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {pin}}, eo)
+				So(err, ShouldBeNil)
+
+				body, err = os.ReadFile(filepath.Join(client.Root, "test_name"))
+				So(err, ShouldBeNil)
+				So(string(body), ShouldEqual, testFileBody)
+			})
+
+			Convey("Unsigned", func() {
+				expectSignatures()
+
+				aMap, err := client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {pin}}, eo)
+				So(err, ShouldErrLike, "is not signed")
+				So(aMap[""].Errors, ShouldHaveLength, 1)
+				So(aMap[""].Errors[0].ErrorCode, ShouldEqual, cipderr.BadSignature)
+				So(storage.downloads(), ShouldEqual, 0)
+
+				_, err = os.Stat(filepath.Join(client.Root, "test_name"))
+				So(os.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("Signed by an untrusted key", func() {
+				_, another, _ := ed25519.GenerateKey(nil)
+				expectSignatures(signing.Sign(pin, another))
+
+				_, err := client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {pin}}, eo)
+				So(err, ShouldErrLike, "is not signed by any of trusted keys")
+				So(storage.downloads(), ShouldEqual, 0)
+			})
+
+			Convey("Bad key", func() {
+				eo.RequireSignedBy = []string{"zzz"}
+				_, err := client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {pin}}, eo)
+				So(err, ShouldErrLike, "bad public key")
+			})
+
+			Convey("From a bundle", func() {
+				client.Bundle = &bundle.Reader{}
+				_, err := client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {pin}}, eo)
+				So(err, ShouldErrLike, "signatures can't be verified when installing from a bundle")
+				So(cipderr.ToCode(err), ShouldEqual, cipderr.BadArgument)
+			})
+		})
+
+		Convey("EnsurePackages with DeltaUpgrades", func() {
+			eo := &EnsureOptions{DeltaUpgrades: true}
+
+			build := func(blobs map[string]string) ([]byte, common.Pin) {
+				var files []fs.File
+				for _, k := range []string{"changed", "same"} {
+					files = append(files, fs.NewTestFile(k, blobs[k], fs.TestFileOpts{}))
+				}
+				out := bytes.Buffer{}
+				pin, err := builder.BuildInstance(ctx, builder.Options{
+					Input:            files,
+					Output:           &out,
+					PackageName:      "delta",
+					CompressionLevel: 5,
+					FileHashes:       true,
+				})
+				So(err, ShouldBeNil)
+				return out.Bytes(), pin
+			}
+			oldBody, oldPin := build(map[string]string{"changed": "old", "same": "same"})
+			newBody, newPin := build(map[string]string{"changed": "new", "same": "same"})
+
+			repo.expected = nil
+			setupRemoteInstance(oldBody, oldPin, repo, storage)
+			_, err := client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {oldPin}}, nil)
+			So(err, ShouldBeNil)
+			So(storage.downloads(), ShouldEqual, 1)
+
+			expectManifestHash := func(hashes ...string) {
+				out := &api.ListMetadataResponse{}
+				for _, h := range hashes {
+					out.Metadata = append(out.Metadata, &api.InstanceMetadata{
+						Key:   deployer.ManifestHashMetadataKey,
+						Value: []byte(h),
+					})
+				}
+				repo.expect(rpcCall{
+					method: "ListMetadata",
+					in: &api.ListMetadataRequest{
+						Package:  newPin.PackageName,
+						Instance: common.InstanceIDToObjectRef(newPin.InstanceID),
+						Keys:     []string{deployer.ManifestHashMetadataKey},
+					},
+					out: out,
+				})
+			}
+
+			readChanged := func() string {
+				body, err := os.ReadFile(filepath.Join(client.Root, "changed"))
+				So(err, ShouldBeNil)
+				return string(body)
+			}
+
+			Convey("With the manifest hash", func() {
+				inst, err := reader.OpenInstance(ctx, pkg.NewBytesSource(newBody), reader.OpenInstanceOpts{
+					VerificationMode: reader.VerifyHash,
+					InstanceID:       newPin.InstanceID,
+				})
+				So(err, ShouldBeNil)
+				hash, err := deployer.ManifestHash(inst)
+				So(err, ShouldBeNil)
+
+				expectManifestHash(hash)
+				setupRemoteInstance(newBody, newPin, repo, storage)
+
+				_, err = client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {newPin}}, eo)
+				So(err, ShouldBeNil)
+				So(readChanged(), ShouldEqual, "new")
+				So(storage.downloads(), ShouldEqual, 1) // the instance wasn't downloaded
+			})
+
+			Convey("Without the manifest hash", func() {
+				expectManifestHash()
+				setupRemoteInstance(newBody, newPin, repo, storage)
+
+				_, err = client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {newPin}}, eo)
+				So(err, ShouldBeNil)
+				So(readChanged(), ShouldEqual, "new")
+				So(storage.downloads(), ShouldEqual, 2)
+			})
+
+			Convey("With conflicting manifest hashes", func() {
+				expectManifestHash(oldPin.InstanceID, newPin.InstanceID)
+				setupRemoteInstance(newBody, newPin, repo, storage)
+
+				_, err = client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {newPin}}, eo)
+				So(err, ShouldBeNil)
+				So(readChanged(), ShouldEqual, "new")
+				So(storage.downloads(), ShouldEqual, 2)
+			})
+
+			Convey("With a wrong manifest hash", func() {
+				expectManifestHash(oldPin.InstanceID)
+				setupRemoteInstance(newBody, newPin, repo, storage)
+				setupRemoteInstance(newBody, newPin, repo, storage)
+
+				_, err = client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {newPin}}, eo)
+				So(err, ShouldBeNil)
+				So(readChanged(), ShouldEqual, "new")
+				So(storage.downloads(), ShouldEqual, 2)
+			})
+		})
+
 		// on windows the ONLY install mode is copy, so this is pointless.
 		if platform.CurrentOS() != "windows" {
 			Convey("EnsurePackages works with OverrideInstallMode", func() {
@@ -0,0 +1,317 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployer
+
+import (
+	"context"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/cipd/common/cipderr"
+)
+
+// ErrNoDeltaBase is returned by DeltaInstance if there's no installed instance
+// of the package to use as a base for the delta.
+var ErrNoDeltaBase = errors.New("no installed instance to use as a delta base", cipderr.BadArgument)
+
+// ErrNoFileHashes is returned by DeltaInstance if the manifest of the instance
+// doesn't have hashes of all its files, i.e. the instance was built without
+// them.
+var ErrNoFileHashes = errors.New("the package manifest doesn't have file hashes", cipderr.BadArgument)
+
+// deltaInstance wraps a pkg.Instance, replacing bodies of files that didn't
+// change since the installed instance with their local copies.
+type deltaInstance struct {
+	pkg.Instance
+
+	files []fs.File
+
+	reused int64 // number of files read from the disk, updated atomically
+}
+
+func (d *deltaInstance) Files() []fs.File { return d.files }
+
+// Close logs stats, but doesn't close the wrapped instance.
+func (d *deltaInstance) Close(ctx context.Context, corrupt bool) error {
+	logging.Infof(ctx, "Reused %d file(s) from the installed instance", atomic.LoadInt64(&d.reused))
+	return nil
+}
+
+// deltaFile is fs.File that reads its body from a local file if it has the
+// hash from the package manifest, falling back to reading it from the instance.
+//
+// Either way the body is verified against the hash as it is read.
+type deltaFile struct {
+	fs.File
+
+	inst  *deltaInstance
+	ref   *api.ObjectRef // the expected hash of the body
+	local string         // native path to the installed copy or "" if none
+}
+
+func (f *deltaFile) Open() (io.ReadCloser, error) {
+	if f.local != "" {
+		if r := f.openLocal(); r != nil {
+			atomic.AddInt64(&f.inst.reused, 1)
+			return r, nil
+		}
+	}
+	r, err := f.File.Open()
+	if err != nil {
+		return nil, err
+	}
+	return f.verifying(r), nil
+}
+
+// openLocal opens the local file if it has the expected body.
+//
+// Returns nil if the local file is missing or different.
+func (f *deltaFile) openLocal() io.ReadCloser {
+	file, err := os.Open(f.local)
+	if err != nil {
+		return nil
+	}
+	h := common.MustNewHash(f.ref.HashAlgo)
+	switch n, err := io.Copy(h, file); {
+	case err != nil || uint64(n) != f.Size() || common.HexDigest(h) != f.ref.HexDigest:
+		file.Close()
+		return nil
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		file.Close()
+		return nil
+	}
+	// The file may change after it was checked, so verify it again.
+	return f.verifying(file)
+}
+
+// verifying wraps the body reader into a reader that checks the size and the
+// hash of the body when reaching EOF.
+func (f *deltaFile) verifying(r io.ReadCloser) io.ReadCloser {
+	return &verifyingReader{
+		ReadCloser: r,
+		name:       f.Name(),
+		size:       f.Size(),
+		ref:        f.ref,
+		h:          common.MustNewHash(f.ref.HashAlgo),
+	}
+}
+
+// deltaSymlink is a symlink fs.File which checks its target against the
+// package manifest when it is read.
+type deltaSymlink struct {
+	fs.File
+
+	target string // the expected target
+}
+
+func (s *deltaSymlink) SymlinkTarget() (string, error) {
+	target, err := s.File.SymlinkTarget()
+	if err == nil && target != s.target {
+		err = errors.Reason("symlink %s: target mismatch", s.Name()).Tag(cipderr.HashMismatch).Err()
+	}
+	return target, err
+}
+
+// verifyingReader returns an error instead of io.EOF if the body it read
+// doesn't match the expected size and hash.
+type verifyingReader struct {
+	io.ReadCloser
+
+	name string
+	size uint64
+	ref  *api.ObjectRef
+	h    hash.Hash
+	read uint64
+}
+
+func (r *verifyingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.h.Write(p[:n])
+	r.read += uint64(n)
+	switch {
+	case err != io.EOF:
+	case r.read != r.size:
+		err = errors.Reason("file %s: expected size %d, got %d", r.name, r.size, r.read).Tag(cipderr.HashMismatch).Err()
+	case common.HexDigest(r.h) != r.ref.HexDigest:
+		err = errors.Reason("file %s: hash mismatch", r.name).Tag(cipderr.HashMismatch).Err()
+	}
+	return n, err
+}
+
+// ManifestHashMetadataKey is an instance metadata key with the hash of the
+// package manifest, in the same format as used for instance IDs.
+//
+// Delta upgrades read the manifest without verifying the instance hash, so
+// they trust hashes of files in the manifest only if the manifest matches this
+// hash. It is attached when registering instances built with file hashes.
+const ManifestHashMetadataKey = "cipd_manifest_hash"
+
+// ManifestHash returns the hash of the manifest of the instance to attach
+// as ManifestHashMetadataKey metadata.
+//
+// Returns ErrNoFileHashes if the manifest doesn't have hashes of all files.
+func ManifestHash(inst pkg.Instance) (string, error) {
+	manifest, ref, err := readManifest(inst, common.DefaultHashAlgo)
+	if err != nil {
+		return "", err
+	}
+	if _, err := manifestFiles(inst, manifest); err != nil {
+		return "", err
+	}
+	return common.ObjectRefToInstanceID(ref), nil
+}
+
+// readManifest reads the manifest of the instance and hashes it using the given
+// algorithm.
+func readManifest(inst pkg.Instance, algo api.HashAlgo) (pkg.Manifest, *api.ObjectRef, error) {
+	for _, f := range inst.Files() {
+		if f.Name() != pkg.ManifestName {
+			continue
+		}
+		r, err := f.Open()
+		if err != nil {
+			return pkg.Manifest{}, nil, err
+		}
+		defer r.Close()
+		h := common.MustNewHash(algo)
+		manifest, err := pkg.ReadManifest(io.TeeReader(r, h))
+		if err != nil {
+			return pkg.Manifest{}, nil, err
+		}
+		// Hash the rest of the file too, if the JSON decoder didn't read it.
+		if _, err := io.Copy(h, r); err != nil {
+			return pkg.Manifest{}, nil, errors.Annotate(err, "reading the package manifest").Tag(cipderr.IO).Err()
+		}
+		return manifest, common.ObjectRefFromHash(h), nil
+	}
+	return pkg.Manifest{}, nil, errors.Reason("bad CIPD package: no %s file", pkg.ManifestName).Tag(cipderr.BadArgument).Err()
+}
+
+// manifestFiles returns entries of the manifest for all files of the instance,
+// except the manifest itself.
+//
+// Returns ErrNoFileHashes if some regular file doesn't have a hash of the
+// expected size or some symlink doesn't have a target.
+func manifestFiles(inst pkg.Instance, manifest pkg.Manifest) (map[string]pkg.FileInfo, error) {
+	infos := make(map[string]pkg.FileInfo, len(manifest.Files))
+	for _, fi := range manifest.Files {
+		if fi.Hash != "" {
+			if err := common.ValidateInstanceID(fi.Hash, common.KnownHash); err != nil {
+				return nil, errors.Annotate(err, "bad hash of %s in the package manifest", fi.Name).Err()
+			}
+		}
+		infos[fi.Name] = fi
+	}
+
+	for _, f := range inst.Files() {
+		if f.Name() == pkg.ManifestName {
+			continue
+		}
+		fi, ok := infos[f.Name()]
+		switch {
+		case !ok:
+			return nil, ErrNoFileHashes
+		case f.Symlink() && fi.Symlink == "":
+			return nil, ErrNoFileHashes
+		case !f.Symlink() && (fi.Hash == "" || fi.Size != f.Size()):
+			return nil, ErrNoFileHashes
+		}
+	}
+	return infos, nil
+}
+
+func (d *deployerImpl) DeltaInstance(ctx context.Context, subdir string, inst pkg.Instance, manifestHash string) (pkg.Instance, error) {
+	if err := common.ValidateInstanceID(manifestHash, common.KnownHash); err != nil {
+		return nil, errors.Annotate(err, "bad manifest hash").Err()
+	}
+	manifestRef := common.InstanceIDToObjectRef(manifestHash)
+
+	pin := inst.Pin()
+	deployed, err := d.CheckDeployed(ctx, subdir, pin.PackageName, NotParanoid, pkg.WithManifest)
+	switch {
+	case err != nil:
+		return nil, err
+	case !deployed.Deployed || deployed.Manifest == nil:
+		return nil, ErrNoDeltaBase
+	}
+
+	// The manifest is read from the unverified instance. Check it before trusting
+	// hashes of files in it.
+	manifest, ref, err := readManifest(inst, manifestRef.HashAlgo)
+	if err != nil {
+		return nil, err
+	}
+	if ref.HexDigest != manifestRef.HexDigest {
+		return nil, errors.Reason("the package manifest doesn't match its hash %s", manifestHash).Tag(cipderr.HashMismatch).Err()
+	}
+	infos, err := manifestFiles(inst, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find where files of the installed instance are.
+	baseDir := deployed.instancePath
+	if deployed.ActualInstallMode == pkg.InstallModeCopy {
+		baseDir = filepath.Join(d.fs.Root(), filepath.FromSlash(subdir))
+	}
+	installed := make(map[string]pkg.FileInfo, len(deployed.Manifest.Files))
+	for _, f := range deployed.Manifest.Files {
+		installed[f.Name] = f
+	}
+
+	delta := &deltaInstance{Instance: inst}
+	candidates := 0
+	for _, f := range inst.Files() {
+		switch {
+		case f.Name() == pkg.ManifestName:
+			// The manifest was read already, but verify it again when it is deployed.
+			delta.files = append(delta.files, &deltaFile{File: f, inst: delta, ref: manifestRef})
+			continue
+		case f.Symlink():
+			delta.files = append(delta.files, &deltaSymlink{File: f, target: infos[f.Name()].Symlink})
+			continue
+		}
+		df := &deltaFile{
+			File: f,
+			inst: delta,
+			ref:  common.InstanceIDToObjectRef(infos[f.Name()].Hash),
+		}
+		if strings.HasPrefix(f.Name(), pkg.ServiceDir+"/") {
+			delta.files = append(delta.files, df)
+			continue
+		}
+		if prev, found := installed[f.Name()]; found && prev.Symlink == "" && prev.Size == f.Size() {
+			df.local = filepath.Join(baseDir, filepath.FromSlash(f.Name()))
+			candidates++
+		}
+		delta.files = append(delta.files, df)
+	}
+
+	logging.Infof(ctx, "%d of %d file(s) of %s may be reused from the installed instance %s",
+		candidates, len(delta.files), pin, deployed.Pin.InstanceID)
+	return delta, nil
+}
@@ -0,0 +1,326 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployer
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/cipd/common/cipderr"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// remoteTestFile is a test file that records when its body is opened.
+type remoteTestFile struct {
+	fs.File
+
+	m      *sync.Mutex
+	opened *[]string
+}
+
+func (f *remoteTestFile) Open() (io.ReadCloser, error) {
+	f.m.Lock()
+	*f.opened = append(*f.opened, f.Name())
+	f.m.Unlock()
+	return f.File.Open()
+}
+
+// makeHashedTestInstance is like makeTestInstance, but records hashes of the
+// given bodies in the manifest.
+//
+// 'hashed' maps file names to bodies to take hashes of (or to targets of
+// symlinks). They may differ from the actual bodies to simulate corrupted files.
+func makeHashedTestInstance(name string, files []fs.File, hashed map[string]string) *testPackageInstance {
+	var infos []pkg.FileInfo
+	for _, f := range files {
+		if f.Symlink() {
+			infos = append(infos, pkg.FileInfo{Name: f.Name(), Symlink: hashed[f.Name()]})
+			continue
+		}
+		body := hashed[f.Name()]
+		h := common.MustNewHash(common.DefaultHashAlgo)
+		h.Write([]byte(body))
+		infos = append(infos, pkg.FileInfo{
+			Name: f.Name(),
+			Size: uint64(len(body)),
+			Hash: common.ObjectRefToInstanceID(common.ObjectRefFromHash(h)),
+		})
+	}
+	out := bytes.Buffer{}
+	err := pkg.WriteManifest(&pkg.Manifest{
+		FormatVersion: pkg.ManifestFormatVersion,
+		PackageName:   name,
+		InstallMode:   pkg.InstallModeCopy,
+		Files:         infos,
+	}, &out)
+	if err != nil {
+		panic("Failed to write a manifest")
+	}
+	files = append(files, fs.NewTestFile(pkg.ManifestName, out.String(), fs.TestFileOpts{}))
+	return &testPackageInstance{
+		packageName: name,
+		instanceID:  "111111111_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+		files:       files,
+	}
+}
+
+// rawManifestHash returns the hash of the manifest file of the instance.
+func rawManifestHash(inst pkg.Instance) string {
+	for _, f := range inst.Files() {
+		if f.Name() == pkg.ManifestName {
+			r, err := f.Open()
+			if err != nil {
+				panic(err)
+			}
+			defer r.Close()
+			h := common.MustNewHash(common.DefaultHashAlgo)
+			if _, err := io.Copy(h, r); err != nil {
+				panic(err)
+			}
+			return common.ObjectRefToInstanceID(common.ObjectRefFromHash(h))
+		}
+	}
+	panic("no manifest")
+}
+
+func TestDeltaInstance(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	Convey("Given a temp directory", t, func() {
+		tempDir := mkTempDir()
+
+		m := sync.Mutex{}
+		var opened []string
+
+		remoteFile := func(name, body string) fs.File {
+			return &remoteTestFile{
+				File:   fs.NewTestFile(name, body, fs.TestFileOpts{}),
+				m:      &m,
+				opened: &opened,
+			}
+		}
+
+		openedFiles := func() []string {
+			m.Lock()
+			defer m.Unlock()
+			out := append([]string(nil), opened...)
+			sort.Strings(out)
+			return out
+		}
+
+		oldPkg := makeTestInstance("test/package", []fs.File{
+			fs.NewTestFile("same", "same data", fs.TestFileOpts{}),
+			fs.NewTestFile("changed", "old data", fs.TestFileOpts{}),
+			fs.NewTestFile("removed", "removed data", fs.TestFileOpts{}),
+		}, pkg.InstallModeCopy)
+		oldPkg.instanceID = "000000000_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC"
+
+		hashed := map[string]string{
+			"same":    "same data",
+			"changed": "new data",
+			"added":   "added data",
+		}
+		newPkg := func() *testPackageInstance {
+			return makeHashedTestInstance("test/package", []fs.File{
+				remoteFile("same", "same data"),
+				remoteFile("changed", "new data"),
+				remoteFile("added", "added data"),
+			}, hashed)
+		}
+
+		Convey("Not installed", func() {
+			inst := newPkg()
+			_, err := New(tempDir).DeltaInstance(ctx, "", inst, rawManifestHash(inst))
+			So(err, ShouldEqual, ErrNoDeltaBase)
+		})
+
+		Convey("No file hashes", func() {
+			d := New(tempDir)
+			_, err := d.DeployInstance(ctx, "", oldPkg, "", 0)
+			So(err, ShouldBeNil)
+
+			noHashes := makeTestInstance("test/package", []fs.File{
+				remoteFile("same", "same data"),
+			}, pkg.InstallModeCopy)
+			_, err = d.DeltaInstance(ctx, "", noHashes, rawManifestHash(noHashes))
+			So(err, ShouldEqual, ErrNoFileHashes)
+
+			_, err = ManifestHash(noHashes)
+			So(err, ShouldEqual, ErrNoFileHashes)
+		})
+
+		Convey("Reuses unchanged files", func() {
+			d := New(tempDir)
+			_, err := d.DeployInstance(ctx, "", oldPkg, "", 0)
+			So(err, ShouldBeNil)
+
+			inst := newPkg()
+			delta, err := d.DeltaInstance(ctx, "", inst, rawManifestHash(inst))
+			So(err, ShouldBeNil)
+			_, err = d.DeployInstance(ctx, "", delta, "", 0)
+			So(err, ShouldBeNil)
+			So(delta.Close(ctx, false), ShouldBeNil)
+
+			So(openedFiles(), ShouldResemble, []string{"added", "changed"})
+			So(scanDirAndSkipGuts(tempDir), ShouldResemble, []string{
+				"added",
+				"changed",
+				"same",
+			})
+			So(readFile(tempDir, "same"), ShouldEqual, "same data")
+			So(readFile(tempDir, "changed"), ShouldEqual, "new data")
+			So(readFile(tempDir, "added"), ShouldEqual, "added data")
+		})
+
+		Convey("Refetches locally modified files", func() {
+			d := New(tempDir)
+			_, err := d.DeployInstance(ctx, "", oldPkg, "", 0)
+			So(err, ShouldBeNil)
+
+			path := filepath.Join(tempDir, "same")
+			So(os.Chmod(path, 0666), ShouldBeNil)
+			So(os.WriteFile(path, []byte("SAME DATA"), 0666), ShouldBeNil)
+
+			inst := newPkg()
+			delta, err := d.DeltaInstance(ctx, "", inst, rawManifestHash(inst))
+			So(err, ShouldBeNil)
+			_, err = d.DeployInstance(ctx, "", delta, "", 0)
+			So(err, ShouldBeNil)
+
+			So(openedFiles(), ShouldResemble, []string{"added", "changed", "same"})
+			So(readFile(tempDir, "same"), ShouldEqual, "same data")
+		})
+
+		Convey("Fails on corrupted fetched files", func() {
+			d := New(tempDir)
+			_, err := d.DeployInstance(ctx, "", oldPkg, "", 0)
+			So(err, ShouldBeNil)
+
+			hashed["added"] = "ADDED DATA"
+			inst := newPkg()
+			delta, err := d.DeltaInstance(ctx, "", inst, rawManifestHash(inst))
+			So(err, ShouldBeNil)
+			_, err = d.DeployInstance(ctx, "", delta, "", 0)
+			So(err, ShouldErrLike, "file added: hash mismatch")
+			So(cipderr.ToCode(err), ShouldEqual, cipderr.HashMismatch)
+
+			// The installed instance is intact.
+			So(readFile(tempDir, "changed"), ShouldEqual, "old data")
+		})
+
+		Convey("Doesn't reuse files that don't match the hash", func() {
+			d := New(tempDir)
+			_, err := d.DeployInstance(ctx, "", oldPkg, "", 0)
+			So(err, ShouldBeNil)
+
+			hashed["same"] = "SAME DATA"
+			inst := newPkg()
+			delta, err := d.DeltaInstance(ctx, "", inst, rawManifestHash(inst))
+			So(err, ShouldBeNil)
+			_, err = d.DeployInstance(ctx, "", delta, "", 0)
+			So(err, ShouldErrLike, "file same: hash mismatch")
+			So(openedFiles(), ShouldContain, "same")
+		})
+
+		Convey("Computes the manifest hash", func() {
+			inst := newPkg()
+			hash, err := ManifestHash(inst)
+			So(err, ShouldBeNil)
+			So(hash, ShouldEqual, rawManifestHash(inst))
+		})
+
+		Convey("Fails if the manifest doesn't match its hash", func() {
+			d := New(tempDir)
+			_, err := d.DeployInstance(ctx, "", oldPkg, "", 0)
+			So(err, ShouldBeNil)
+
+			// The manifest is tampered with along with the files.
+			hashed["added"] = "ADDED DATA"
+			tampered := newPkg()
+			hashed["added"] = "added data"
+			_, err = d.DeltaInstance(ctx, "", tampered, rawManifestHash(newPkg()))
+			So(err, ShouldErrLike, "the package manifest doesn't match its hash")
+			So(cipderr.ToCode(err), ShouldEqual, cipderr.HashMismatch)
+		})
+
+		Convey("Requires all files in the manifest", func() {
+			d := New(tempDir)
+			_, err := d.DeployInstance(ctx, "", oldPkg, "", 0)
+			So(err, ShouldBeNil)
+
+			inst := newPkg()
+			inst.files = append(inst.files, fs.NewTestFile(pkg.ServiceDir+"/extra", "extra", fs.TestFileOpts{}))
+			_, err = d.DeltaInstance(ctx, "", inst, rawManifestHash(inst))
+			So(err, ShouldEqual, ErrNoFileHashes)
+		})
+
+		Convey("Verifies symlink targets", func() {
+			d := New(tempDir)
+			_, err := d.DeployInstance(ctx, "", oldPkg, "", 0)
+			So(err, ShouldBeNil)
+
+			withLink := func() *testPackageInstance {
+				return makeHashedTestInstance("test/package", []fs.File{
+					remoteFile("same", "same data"),
+					fs.NewTestSymlink("link", "same"),
+				}, hashed)
+			}
+
+			Convey("Matching", func() {
+				hashed["link"] = "same"
+				inst := withLink()
+				delta, err := d.DeltaInstance(ctx, "", inst, rawManifestHash(inst))
+				So(err, ShouldBeNil)
+				_, err = d.DeployInstance(ctx, "", delta, "", 0)
+				So(err, ShouldBeNil)
+				So(scanDirAndSkipGuts(tempDir), ShouldResemble, []string{
+					"link:same",
+					"same",
+				})
+			})
+
+			Convey("Mismatching", func() {
+				hashed["link"] = "changed"
+				inst := withLink()
+				delta, err := d.DeltaInstance(ctx, "", inst, rawManifestHash(inst))
+				So(err, ShouldBeNil)
+				_, err = d.DeployInstance(ctx, "", delta, "", 0)
+				So(err, ShouldErrLike, "symlink link: target mismatch")
+				So(cipderr.ToCode(err), ShouldEqual, cipderr.HashMismatch)
+			})
+
+			Convey("Missing", func() {
+				delete(hashed, "link")
+				inst := withLink()
+				_, err := d.DeltaInstance(ctx, "", inst, rawManifestHash(inst))
+				So(err, ShouldEqual, ErrNoFileHashes)
+			})
+		})
+	})
+}
@@ -109,6 +109,15 @@ type DeployedPackage struct {
 	instancePath string
 }
 
+// Orphan is a directory in the site root guts not used by any deployed
+// package, as returned by FindOrphans.
+type Orphan struct {
+	Path    string    // native absolute path to the directory
+	Reason  string    // human readable reason why it is considered an orphan
+	Size    int64     // total size of all files in the directory
+	ModTime time.Time // the most recent modification time of anything inside
+}
+
 // RepairParams is passed to RepairDeployed.
 type RepairParams struct {
 	// Instance holds the original package data.
@@ -139,6 +148,23 @@ type Deployer interface {
 	// warnings logged.
 	DeployInstance(ctx context.Context, subdir string, inst pkg.Instance, overrideInstallMode pkg.InstallMode, maxThreads int) (common.Pin, error)
 
+	// DeltaInstance returns an instance that reads files unchanged since the
+	// currently installed instance of the same package (in the given subdir)
+	// from the disk instead of from the given instance.
+	//
+	// Unchanged files are detected by comparing the installed files with sizes
+	// and hashes of files from the manifest of 'inst'. The manifest itself must
+	// match 'manifestHash', which should come from a trusted source, such as
+	// the instance metadata (see ManifestHashMetadataKey). All files, reused or
+	// not, and symlink targets are verified against the manifest when they are
+	// read. Useful when 'inst' reads its data from a remote storage on demand
+	// without verifying the instance hash: only changed files are fetched.
+	//
+	// The returned instance can be passed to DeployInstance. Closing it doesn't
+	// close 'inst'. Returns ErrNoDeltaBase if the package is not installed in
+	// the subdir and ErrNoFileHashes if 'inst' was built without file hashes.
+	DeltaInstance(ctx context.Context, subdir string, inst pkg.Instance, manifestHash string) (pkg.Instance, error)
+
 	// CheckDeployed checks whether a given package is deployed at the given
 	// subdir.
 	//
@@ -175,6 +201,15 @@ type Deployer interface {
 	// the one specified in the pin, returns an error.
 	RepairDeployed(ctx context.Context, subdir string, pin common.Pin, overrideInstallMode pkg.InstallMode, maxThreads int, params RepairParams) error
 
+	// FindOrphans returns directories in the site root guts that don't belong
+	// to any deployed package instance.
+	//
+	// These are leftovers of interrupted or crashed deployments: package
+	// directories without a description or a deployed instance, duplicate
+	// package directories, instance directories that are not current and
+	// temporary directories. It doesn't remove anything.
+	FindOrphans(ctx context.Context) ([]Orphan, error)
+
 	// FS returns an fs.FileSystem rooted at the deployer root dir.
 	FS() fs.FileSystem
 }
@@ -206,6 +241,10 @@ func (d errDeployer) DeployInstance(context.Context, string, pkg.Instance, pkg.I
 	return common.Pin{}, d.err
 }
 
+func (d errDeployer) DeltaInstance(context.Context, string, pkg.Instance, string) (pkg.Instance, error) {
+	return nil, d.err
+}
+
 func (d errDeployer) CheckDeployed(context.Context, string, string, ParanoidMode, pkg.ManifestMode) (*DeployedPackage, error) {
 	return nil, d.err
 }
@@ -220,6 +259,8 @@ func (d errDeployer) RepairDeployed(context.Context, string, common.Pin, pkg.Ins
 	return d.err
 }
 
+func (d errDeployer) FindOrphans(context.Context) ([]Orphan, error) { return nil, d.err }
+
 func (d errDeployer) FS() fs.FileSystem { return nil }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -735,6 +776,90 @@ func (d *deployerImpl) RepairDeployed(ctx context.Context, subdir string, pin co
 	return nil
 }
 
+func (d *deployerImpl) FindOrphans(ctx context.Context) ([]Orphan, error) {
+	var out []Orphan
+	addOrphan := func(path, reason string) error {
+		size, modTime, err := scanDirStats(path)
+		if err != nil {
+			return errors.Annotate(err, "scanning %q", path).Tag(cipderr.IO).Err()
+		}
+		out = append(out, Orphan{
+			Path:    path,
+			Reason:  reason,
+			Size:    size,
+			ModTime: modTime,
+		})
+		return nil
+	}
+
+	// Visit all package directories, remembering valid ones to find duplicates.
+	pkgs := filepath.Join(d.fs.Root(), filepath.FromSlash(packagesDir))
+	entries, err := os.ReadDir(pkgs)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Annotate(err, "scanning packages directory").Tag(cipderr.IO).Err()
+	}
+	valid := map[description][]string{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		pkgPath := filepath.Join(pkgs, entry.Name())
+		desc, err := d.readDescription(ctx, pkgPath)
+		if err != nil || desc == nil {
+			if err := addOrphan(pkgPath, "no package description"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		currentID, err := d.getCurrentInstanceID(pkgPath)
+		if err != nil || currentID == "" {
+			if err := addOrphan(pkgPath, "no deployed instance"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		valid[*desc] = append(valid[*desc], pkgPath)
+
+		// Find instance directories left from previous deployments.
+		children, err := os.ReadDir(pkgPath)
+		if err != nil {
+			return nil, errors.Annotate(err, "scanning package directory").Tag(cipderr.IO).Err()
+		}
+		for _, child := range children {
+			name := child.Name()
+			if child.IsDir() && name != currentID && common.ValidateInstanceID(name, common.AnyHash) == nil {
+				if err := addOrphan(filepath.Join(pkgPath, name), "not the current instance "+currentID); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	// Duplicates are resolved the same way as in packagePath(...).
+	for _, paths := range valid {
+		sort.Sort(byLenThenAlpha(paths))
+		for _, dup := range paths[1:] {
+			if err := addOrphan(dup, "duplicate of "+paths[0]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Temp directories are normally deleted when the deployment finishes.
+	tmp := filepath.Join(d.fs.Root(), fs.SiteServiceDir, "tmp")
+	if entries, err = os.ReadDir(tmp); err != nil && !os.IsNotExist(err) {
+		return nil, errors.Annotate(err, "scanning temp directory").Tag(cipderr.IO).Err()
+	}
+	for _, entry := range entries {
+		if err := addOrphan(filepath.Join(tmp, entry.Name()), "temporary directory"); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Path < out[j].Path })
+	return out, nil
+}
+
 func (d *deployerImpl) TempDir(ctx context.Context, prefix string, mode os.FileMode) (string, error) {
 	dir, err := d.fs.EnsureDirectory(ctx, filepath.Join(d.fs.Root(), fs.SiteServiceDir, "tmp"))
 	if err != nil {
@@ -1554,6 +1679,30 @@ func scanPackageDir(ctx context.Context, dir string) ([]pkg.FileInfo, error) {
 	return out, err
 }
 
+// scanDirStats returns the total size of all files in the directory and the
+// most recent modification time of the directory or anything inside it.
+//
+// Doesn't follow symlinks.
+func scanDirStats(root string) (size int64, modTime time.Time, err error) {
+	err = filepath.WalkDir(root, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+		return nil
+	})
+	return
+}
+
 // removeEmptyTrees recursively removes empty directory subtrees after some
 // files have been removed.
 //
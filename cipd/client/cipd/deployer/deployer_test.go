@@ -1501,6 +1501,75 @@ func TestFindDeployed(t *testing.T) {
 	})
 }
 
+func TestFindOrphans(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	Convey("Given a temp directory", t, func() {
+		tempDir := mkTempDir()
+		pkgs := filepath.Join(tempDir, fs.SiteServiceDir, "pkgs")
+
+		Convey("FindOrphans works with empty dir", func() {
+			out, err := New(tempDir).FindOrphans(ctx)
+			So(err, ShouldBeNil)
+			So(out, ShouldBeNil)
+		})
+
+		Convey("FindOrphans works", func() {
+			d := New(tempDir)
+
+			_, err := d.DeployInstance(ctx, "", makeTestInstance("test/pkg", nil, pkg.InstallModeCopy), "", 0)
+			So(err, ShouldBeNil)
+			_, err = d.DeployInstance(ctx, "", makeTestInstance("broken", nil, pkg.InstallModeCopy), "", 0)
+			So(err, ShouldBeNil)
+			if runtime.GOOS == "windows" {
+				err = os.Remove(filepath.Join(pkgs, "1", "_current.txt"))
+			} else {
+				err = os.Remove(filepath.Join(pkgs, "1", "_current"))
+			}
+			So(err, ShouldBeNil)
+
+			stale := filepath.Join(pkgs, "0", "B7r75joOfFfFcq7fHCKAIrU34oeFAT174Bf8eHMajMUC")
+			So(os.MkdirAll(stale, 0777), ShouldBeNil)
+			So(os.WriteFile(filepath.Join(stale, "file"), []byte("12345"), 0666), ShouldBeNil)
+
+			So(os.MkdirAll(filepath.Join(pkgs, "junk"), 0777), ShouldBeNil)
+			So(os.WriteFile(filepath.Join(pkgs, "junk", "file"), []byte("123"), 0666), ShouldBeNil)
+
+			So(os.MkdirAll(filepath.Join(tempDir, fs.SiteServiceDir, "tmp", "dl_123"), 0777), ShouldBeNil)
+
+			out, err := d.FindOrphans(ctx)
+			So(err, ShouldBeNil)
+
+			type orphan struct {
+				path   string
+				reason string
+				size   int64
+			}
+			var got []orphan
+			for _, o := range out {
+				rel, _ := filepath.Rel(tempDir, o.Path)
+				got = append(got, orphan{filepath.ToSlash(rel), o.Reason, o.Size})
+				So(o.ModTime.IsZero(), ShouldBeFalse)
+			}
+			So(got, ShouldResemble, []orphan{
+				{".cipd/pkgs/0/B7r75joOfFfFcq7fHCKAIrU34oeFAT174Bf8eHMajMUC", "not the current instance -wEu41lw0_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC", 5},
+				{".cipd/pkgs/1", "no deployed instance", got[1].size},
+				{".cipd/pkgs/junk", "no package description", 3},
+				{".cipd/tmp/dl_123", "temporary directory", 0},
+			})
+
+			// Doesn't affect deployed packages.
+			pins, err := d.FindDeployed(ctx)
+			So(err, ShouldBeNil)
+			So(pins, ShouldResemble, PinSliceBySubdir{
+				"": PinSlice{{"test/pkg", "-wEu41lw0_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC"}},
+			})
+		})
+	})
+}
+
 func TestRemoveDeployedCommon(t *testing.T) {
 	ctx := context.Background()
 
@@ -42,6 +42,13 @@ func TestClientDigestsFile(t *testing.T) {
 		}
 	}
 
+	blake3 := func(char string) *api.ObjectRef {
+		return &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_BLAKE3,
+			HexDigest: strings.Repeat(char, 64),
+		}
+	}
+
 	Convey("Works", t, func() {
 		df := ClientDigestsFile{}
 
@@ -59,6 +66,17 @@ func TestClientDigestsFile(t *testing.T) {
 		So(df.Contains("unknown", sha1("a")), ShouldBeFalse)
 	})
 
+	Convey("Prefers BLAKE3", t, func() {
+		df := ClientDigestsFile{}
+
+		So(df.AddClientRef("linux-amd64", sha256("a")), ShouldBeNil)
+		So(df.AddClientRef("linux-amd64", blake3("b")), ShouldBeNil)
+		So(df.AddClientRef("linux-amd64", sha1("c")), ShouldBeNil)
+
+		So(df.ClientRef("linux-amd64"), ShouldResemble, blake3("b"))
+		So(df.Contains("linux-amd64", sha256("a")), ShouldBeTrue)
+	})
+
 	Convey("Errors in AddClientRef", t, func() {
 		df := ClientDigestsFile{}
 
@@ -151,6 +169,7 @@ func TestClientDigestsFile(t *testing.T) {
 			" # Another comment",
 			"linux-amd64  unknown  bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 			"linux-amd64	sha1  bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+			"linux-amd64	blake3  dddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddd",
 			"windows-amd64	sha1	aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 		}, "\n")))
 		So(err, ShouldBeNil)
@@ -158,6 +177,7 @@ func TestClientDigestsFile(t *testing.T) {
 			entries: []clientDigestEntry{
 				{"linux-amd64", sha256("c")},
 				{"linux-amd64", sha1("b")},
+				{"linux-amd64", blake3("d")},
 				{"windows-amd64", sha1("a")},
 			},
 		})
@@ -95,6 +95,56 @@ var badEnsureFiles = []struct {
 		`bad subdir "$${os=linux}"`,
 	},
 
+	{
+		"bad @If condition",
+		"@If linux",
+		"bad @If: condition must be",
+	},
+
+	{
+		"unknown @If variable",
+		"@If blah=linux",
+		`bad @If: unknown variable "blah"`,
+	},
+
+	{
+		"nested @If",
+		f(
+			"@If os=linux",
+			"@If arch=amd64",
+			"@End",
+			"@End",
+		),
+		"(line 2): nested @If blocks are not supported (the outer one is at line 1)",
+	},
+
+	{
+		"@End without @If",
+		f(
+			"some/package latest",
+			"@End",
+		),
+		"(line 2): @End without matching @If",
+	},
+
+	{
+		"@End with arguments",
+		f(
+			"@If os=linux",
+			"@End os=linux",
+		),
+		"@End takes no arguments",
+	},
+
+	{
+		"unclosed @If",
+		f(
+			"@If os=linux",
+			"some/package latest",
+		),
+		"(line 1): @If without matching @End",
+	},
+
 	{
 		"empty setting",
 		"$ something",
@@ -125,6 +175,12 @@ var badEnsureFiles = []struct {
 		"only copy mode is allowed",
 	},
 
+	{
+		"bad signing key",
+		"$RequireSignedBy rsa:AAAA",
+		"bad $RequireSignedBy",
+	},
+
 	{
 		"too many urls",
 		f(
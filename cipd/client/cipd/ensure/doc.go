@@ -67,6 +67,13 @@
 //     solves. We recommend that all ensure files have this setting, and in the
 //     future this will become automatically set. See crbug.com/1329641 for
 //     additional discussion.
+//   - `$RequireSignedBy ed25519:<base64 public key>` makes CIPD refuse to
+//     install package instances that don't have a valid signature by this key
+//     (see `-sign-key` flag of `cipd create`, `cipd pkg-register` and
+//     `cipd attach`). Multiple $RequireSignedBy settings can be specified, and
+//     will accumulate. In that case a valid signature by any of the listed
+//     keys is sufficient. Signatures are fetched from the backend, so this
+//     can't be used with `cipd ensure -from-bundle`.
 //
 // # Package Definitions
 //
@@ -119,6 +126,14 @@
 //     and `${platform}`. Using a subdir expansion like `${param=val}` will
 //     cause that subdirectory, and any packages in it, to only exist if the
 //     param matches one of the values.
+//   - `@If <var>=<pattern>[,<pattern>]*` and `@End` enclose a block of package
+//     lines that are used only if the value of `var` (`os`, `arch` or
+//     `platform`) matches one of the glob patterns (e.g. `linux-*`). Packages
+//     in a block that doesn't match are skipped, exactly like packages with
+//     a non-matching `${var=value}` expansion. This is honored by
+//     `ensure-file-resolve` and `$VerifiedPlatform` checks, which evaluate
+//     the condition for each verified platform. @If blocks can't be nested
+//     and they don't affect @Subdir, which stays sticky across them.
 //
 // # Example
 //
@@ -148,4 +163,9 @@
 //	# Always exists, but the directory changes based on the os
 //	@Subdir platform/${os}
 //	a/platform/package latest
+//
+//	# Only installed on Linux and Mac
+//	@If platform=linux-*,mac-*
+//	posix/only/package/${platform}  latest
+//	@End
 package ensure
@@ -41,6 +41,7 @@ type File struct {
 	ParanoidMode        deployer.ParanoidMode
 	ResolvedVersions    string
 	OverrideInstallMode pkg.InstallMode
+	RequireSignedBy     []string
 
 	PackagesBySubdir map[string]PackageSlice
 	VerifyPlatforms  []template.Platform
@@ -70,6 +71,7 @@ func ParseFile(r io.Reader) (*File, error) {
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
 		lineNo++
+		state.lineNo = lineNo
 
 		// Remove all space
 		line := strings.TrimSpace(scanner.Text())
@@ -108,13 +110,22 @@ func ParseFile(r io.Reader) (*File, error) {
 
 		default:
 			settingsAllowed = false
-			pkg := PackageDef{tok1, tok2, lineNo}
+			pkg := PackageDef{
+				PackageTemplate:   tok1,
+				UnresolvedVersion: tok2,
+				LineNo:            lineNo,
+				Condition:         state.curCondition,
+			}
 			ret.PackagesBySubdir[state.curSubdir] = append(ret.PackagesBySubdir[state.curSubdir], pkg)
 		}
 	}
 	if err := scanner.Err(); err != nil {
 		return nil, errors.Annotate(err, "failed to read the ensure file").Tag(cipderr.IO).Err()
 	}
+	if state.curCondition != "" {
+		lineNo = state.conditionLineNo
+		return nil, makeError("@If without matching @End")
+	}
 
 	return ret, nil
 }
@@ -135,6 +146,7 @@ type ResolvedFile struct {
 	ServiceURL          string
 	ParanoidMode        deployer.ParanoidMode
 	OverrideInstallMode pkg.InstallMode
+	RequireSignedBy     []string
 
 	PackagesBySubdir common.PinSliceBySubdir
 }
@@ -157,6 +169,7 @@ func (f *ResolvedFile) Serialize(w io.Writer) error {
 		ServiceURL:          f.ServiceURL,
 		ParanoidMode:        f.ParanoidMode,
 		OverrideInstallMode: f.OverrideInstallMode,
+		RequireSignedBy:     f.RequireSignedBy,
 		PackagesBySubdir:    packagesBySubdir,
 	}).Serialize(w)
 }
@@ -170,7 +183,10 @@ func (f *ResolvedFile) Serialize(w io.Writer) error {
 // or a multi-error with all resolution errors, sorted by definition line
 // numbers.
 func (f *File) Resolve(rslv VersionResolver, expander template.Expander) (*ResolvedFile, error) {
-	ret := &ResolvedFile{OverrideInstallMode: f.OverrideInstallMode}
+	ret := &ResolvedFile{
+		OverrideInstallMode: f.OverrideInstallMode,
+		RequireSignedBy:     f.RequireSignedBy,
+	}
 
 	if f.ServiceURL != "" {
 		// double check the url
@@ -332,6 +348,11 @@ func (f *File) Serialize(w io.Writer) error {
 			fmt.Fprintf(w, "$OverrideInstallMode %s", f.OverrideInstallMode)
 			needsNLs = 1
 		}
+		for _, key := range f.RequireSignedBy {
+			maybeAddNL()
+			fmt.Fprintf(w, "$RequireSignedBy %s", key)
+			needsNLs = 1
+		}
 
 		if needsNLs != 0 {
 			needsNLs++ // new line separator if any of $Directives were used
@@ -370,13 +391,36 @@ func (f *File) Serialize(w io.Writer) error {
 					maxLength = l
 				}
 			}
-			sort.Sort(pkgsSort)
+			// Unconditional packages go first, followed by @If blocks.
+			sort.SliceStable(pkgsSort, func(i, j int) bool {
+				if pkgsSort[i].Condition != pkgsSort[j].Condition {
+					return pkgsSort[i].Condition < pkgsSort[j].Condition
+				}
+				return pkgsSort[i].PackageTemplate < pkgsSort[j].PackageTemplate
+			})
 
+			cond := ""
 			for _, p := range pkgsSort {
+				if p.Condition != cond {
+					if cond != "" {
+						maybeAddNL()
+						fmt.Fprintf(w, "@End")
+						needsNLs = 1
+					}
+					maybeAddNL()
+					fmt.Fprintf(w, "@If %s", p.Condition)
+					needsNLs = 1
+					cond = p.Condition
+				}
 				maybeAddNL()
 				fmt.Fprintf(w, "%-*s %s", maxLength+1, p.PackageTemplate, p.UnresolvedVersion)
 				needsNLs = 1
 			}
+			if cond != "" {
+				maybeAddNL()
+				fmt.Fprintf(w, "@End")
+				needsNLs = 1
+			}
 			needsNLs++
 		}
 
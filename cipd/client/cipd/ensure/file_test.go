@@ -46,7 +46,7 @@ var fileSerializationTests = []struct {
 
 	{
 		"ServiceURL",
-		&File{"https://something.example.com", "", "", "", nil, nil},
+		&File{"https://something.example.com", "", "", "", nil, nil, nil},
 		f(
 			"$ServiceURL https://something.example.com",
 		),
@@ -54,18 +54,30 @@ var fileSerializationTests = []struct {
 
 	{
 		"OverrideInstallMode",
-		&File{"", "", "", pkg.InstallModeCopy, nil, nil},
+		&File{"", "", "", pkg.InstallModeCopy, nil, nil, nil},
 		f(
 			"$OverrideInstallMode copy",
 		),
 	},
 
+	{
+		"RequireSignedBy",
+		&File{RequireSignedBy: []string{
+			"ed25519:8t8t5Dx6QpR3CVffR9Kfnx6F8J0WEEaaNXhdaVYEoUA=",
+			"ed25519:sQ4GBGc25BpEvqV1w+I3BEUQ3aIb5C3Fl9d1zJ1c1s0=",
+		}},
+		f(
+			"$RequireSignedBy ed25519:8t8t5Dx6QpR3CVffR9Kfnx6F8J0WEEaaNXhdaVYEoUA=",
+			"$RequireSignedBy ed25519:sQ4GBGc25BpEvqV1w+I3BEUQ3aIb5C3Fl9d1zJ1c1s0=",
+		),
+	},
+
 	{
 		"simple packages",
-		&File{"", "", "", "", map[string]PackageSlice{
+		&File{"", "", "", "", nil, map[string]PackageSlice{
 			"": {
-				PackageDef{"some/thing", "version", 0},
-				PackageDef{"some/other_thing", "latest", 0},
+				PackageDef{PackageTemplate: "some/thing", UnresolvedVersion: "version"},
+				PackageDef{PackageTemplate: "some/other_thing", UnresolvedVersion: "latest"},
 			},
 		}, nil},
 		f(
@@ -74,6 +86,28 @@ var fileSerializationTests = []struct {
 		),
 	},
 
+	{
+		"conditional packages",
+		&File{PackagesBySubdir: map[string]PackageSlice{
+			"": {
+				PackageDef{PackageTemplate: "some/thing", UnresolvedVersion: "version", Condition: "os=linux"},
+				PackageDef{PackageTemplate: "some/other_thing", UnresolvedVersion: "latest"},
+				PackageDef{PackageTemplate: "mac/thing", UnresolvedVersion: "latest", Condition: "os=mac"},
+				PackageDef{PackageTemplate: "linux/thing", UnresolvedVersion: "latest", Condition: "os=linux"},
+			},
+		}},
+		f(
+			"some/other_thing  latest",
+			"@If os=linux",
+			"linux/thing       latest",
+			"some/thing        version",
+			"@End",
+			"@If os=mac",
+			"mac/thing         latest",
+			"@End",
+		),
+	},
+
 	{
 		"full file",
 		&File{
@@ -82,11 +116,11 @@ var fileSerializationTests = []struct {
 			ResolvedVersions: "resolved.versions",
 			PackagesBySubdir: map[string]PackageSlice{
 				"": {
-					PackageDef{"some/thing", "version", 0},
-					PackageDef{"some/other_thing", "latest", 0},
+					PackageDef{PackageTemplate: "some/thing", UnresolvedVersion: "version"},
+					PackageDef{PackageTemplate: "some/other_thing", UnresolvedVersion: "latest"},
 				},
 				"path/to dir/with/spaces": {
-					PackageDef{"different/package", "some_tag:thingy", 0},
+					PackageDef{PackageTemplate: "different/package", UnresolvedVersion: "some_tag:thingy"},
 				},
 			},
 			VerifyPlatforms: []template.Platform{
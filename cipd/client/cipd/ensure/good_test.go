@@ -58,7 +58,7 @@ var goodEnsureFiles = []struct {
 			"path/to/other_package some_tag:version",
 			"path/to/yet_another a_ref",
 		),
-		&ResolvedFile{"", deployer.NotParanoid, "", common.PinSliceBySubdir{
+		&ResolvedFile{"", deployer.NotParanoid, "", nil, common.PinSliceBySubdir{
 			"": {
 				p("path/to/package", "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef"),
 				p("path/to/other_package", "some_tag:version"),
@@ -73,7 +73,7 @@ var goodEnsureFiles = []struct {
 			"path/to/package/${os}-${arch} latest",
 			"path/to/other/${platform} latest",
 		),
-		&ResolvedFile{"", deployer.NotParanoid, "", common.PinSliceBySubdir{
+		&ResolvedFile{"", deployer.NotParanoid, "", nil, common.PinSliceBySubdir{
 			"": {
 				p("path/to/package/test_os-test_arch", "latest"),
 				p("path/to/other/test_os-test_arch", "latest"),
@@ -87,7 +87,7 @@ var goodEnsureFiles = []struct {
 			"path/to/package/${os}-${arch=neep,test_arch} latest",
 			"path/to/other/${platform=test_os-test_arch} latest",
 		),
-		&ResolvedFile{"", deployer.NotParanoid, "", common.PinSliceBySubdir{
+		&ResolvedFile{"", deployer.NotParanoid, "", nil, common.PinSliceBySubdir{
 			"": {
 				p("path/to/package/test_os-test_arch", "latest"),
 				p("path/to/other/test_os-test_arch", "latest"),
@@ -101,7 +101,7 @@ var goodEnsureFiles = []struct {
 			"path/to/package/${os=spaz}-${arch=neep,test_arch} latest",
 			"path/to/package/${platform=neep-foo} latest",
 		),
-		&ResolvedFile{"", deployer.NotParanoid, "", common.PinSliceBySubdir{}},
+		&ResolvedFile{"", deployer.NotParanoid, "", nil, common.PinSliceBySubdir{}},
 	},
 
 	{
@@ -126,7 +126,7 @@ var goodEnsureFiles = []struct {
 			"@Subdir something/${os=test_os,other}",
 			"some/os_specific/package canary",
 		),
-		&ResolvedFile{"", deployer.NotParanoid, "", common.PinSliceBySubdir{
+		&ResolvedFile{"", deployer.NotParanoid, "", nil, common.PinSliceBySubdir{
 			"": {
 				p("some/package", "latest"),
 				p("cool/package", "beef"),
@@ -154,7 +154,7 @@ var goodEnsureFiles = []struct {
 			"",
 			"some/package version",
 		),
-		&ResolvedFile{"https://cipd.example.com/path/to/thing", deployer.NotParanoid, "", common.PinSliceBySubdir{
+		&ResolvedFile{"https://cipd.example.com/path/to/thing", deployer.NotParanoid, "", nil, common.PinSliceBySubdir{
 			"": {
 				p("some/package", "version"),
 			},
@@ -170,7 +170,7 @@ var goodEnsureFiles = []struct {
 			"",
 			"some/package version",
 		),
-		&ResolvedFile{"", deployer.NotParanoid, "", common.PinSliceBySubdir{
+		&ResolvedFile{"", deployer.NotParanoid, "", nil, common.PinSliceBySubdir{
 			"": {
 				p("some/package", "version"),
 			},
@@ -184,7 +184,7 @@ var goodEnsureFiles = []struct {
 			"",
 			"some/package version",
 		),
-		&ResolvedFile{"", deployer.CheckPresence, "", common.PinSliceBySubdir{
+		&ResolvedFile{"", deployer.CheckPresence, "", nil, common.PinSliceBySubdir{
 			"": {
 				p("some/package", "version"),
 			},
@@ -209,17 +209,80 @@ var goodEnsureFiles = []struct {
 			"",
 			"some/package version",
 		),
-		&ResolvedFile{"", deployer.NotParanoid, pkg.InstallModeCopy, common.PinSliceBySubdir{
+		&ResolvedFile{"", deployer.NotParanoid, pkg.InstallModeCopy, nil, common.PinSliceBySubdir{
 			"": {
 				p("some/package", "version"),
 			},
 		}},
 	},
 
+	{
+		"RequireSignedBy setting",
+		f(
+			"$RequireSignedBy ed25519:8t8t5Dx6QpR3CVffR9Kfnx6F8J0WEEaaNXhdaVYEoUA=",
+			"$RequireSignedBy ed25519:sQ4GBGc25BpEvqV1w+I3BEUQ3aIb5C3Fl9d1zJ1c1s0=",
+			"",
+			"some/package version",
+		),
+		&ResolvedFile{"", deployer.NotParanoid, "", []string{
+			"ed25519:8t8t5Dx6QpR3CVffR9Kfnx6F8J0WEEaaNXhdaVYEoUA=",
+			"ed25519:sQ4GBGc25BpEvqV1w+I3BEUQ3aIb5C3Fl9d1zJ1c1s0=",
+		}, common.PinSliceBySubdir{
+			"": {
+				p("some/package", "version"),
+			},
+		}},
+	},
+
+	{
+		"conditional blocks",
+		f(
+			"path/to/package latest",
+			"",
+			"@If platform=linux-*,mac-*",
+			"path/to/posix/${platform} latest",
+			"@End",
+			"",
+			"@Subdir sub",
+			"@If os=test_*",
+			"path/to/test/${platform} latest",
+			"@End",
+			"path/to/unconditional latest",
+		),
+		&ResolvedFile{"", deployer.NotParanoid, "", nil, common.PinSliceBySubdir{
+			"": {
+				p("path/to/package", "latest"),
+			},
+			"sub": {
+				p("path/to/test/test_os-test_arch", "latest"),
+				p("path/to/unconditional", "latest"),
+			},
+		}},
+	},
+
+	{
+		"conditional blocks (parsed)",
+		f(
+			"@if arch=test_arch",
+			"path/to/package latest",
+			"@end",
+		),
+		&File{PackagesBySubdir: map[string]PackageSlice{
+			"": {
+				PackageDef{
+					PackageTemplate:   "path/to/package",
+					UnresolvedVersion: "latest",
+					LineNo:            2,
+					Condition:         "arch=test_arch",
+				},
+			},
+		}},
+	},
+
 	{
 		"empty",
 		"",
-		&ResolvedFile{"", deployer.NotParanoid, "", nil},
+		&ResolvedFile{"", deployer.NotParanoid, "", nil, nil},
 	},
 
 	{
@@ -229,7 +292,7 @@ var goodEnsureFiles = []struct {
 			"tabs/to/package\t\t\t\tlatest",
 			"\ttabs/and/spaces  \t  \t  \tlatest   \t",
 		),
-		&ResolvedFile{"", deployer.NotParanoid, "", common.PinSliceBySubdir{
+		&ResolvedFile{"", deployer.NotParanoid, "", nil, common.PinSliceBySubdir{
 			"": {
 				p("path/to/package", "latest"),
 				p("tabs/to/package", "latest"),
@@ -22,6 +22,7 @@ import (
 
 	"go.chromium.org/luci/cipd/client/cipd/deployer"
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/common"
 	"go.chromium.org/luci/cipd/common/cipderr"
@@ -34,7 +35,13 @@ type itemParser func(s *itemParserState, f *File, val string) error
 // itemParserState is the state object shared between the item parsers and the
 // main ParseFile implementation.
 type itemParserState struct {
+	lineNo    int
 	curSubdir string
+
+	// curCondition is a condition of the currently open @If block or "".
+	curCondition string
+	// conditionLineNo is a line number of the currently open @If directive.
+	conditionLineNo int
 }
 
 func subdirParser(s *itemParserState, _ *File, val string) (err error) {
@@ -52,6 +59,33 @@ func subdirParser(s *itemParserState, _ *File, val string) (err error) {
 	return
 }
 
+func ifParser(s *itemParserState, _ *File, val string) error {
+	if s.curCondition != "" {
+		return errors.Reason("nested @If blocks are not supported (the outer one is at line %d)", s.conditionLineNo).Tag(cipderr.BadArgument).Err()
+	}
+	// Like in subdirParser, evaluate with the default expander just to check
+	// the condition is well-formed. The actual evaluation happens in
+	// PackageDef.Expand.
+	if _, err := template.DefaultExpander().Match(val); err != nil {
+		return errors.Annotate(err, "bad @If").Err()
+	}
+	s.curCondition = val
+	s.conditionLineNo = s.lineNo
+	return nil
+}
+
+func endParser(s *itemParserState, _ *File, val string) error {
+	if s.curCondition == "" {
+		return errors.Reason("@End without matching @If").Tag(cipderr.BadArgument).Err()
+	}
+	if val != "" {
+		return errors.Reason("@End takes no arguments").Tag(cipderr.BadArgument).Err()
+	}
+	s.curCondition = ""
+	s.conditionLineNo = 0
+	return nil
+}
+
 func serviceURLParser(_ *itemParserState, f *File, val string) error {
 	if f.ServiceURL != "" {
 		return errors.Reason("$ServiceURL may only be set once per file").Tag(cipderr.BadArgument).Err()
@@ -102,14 +136,25 @@ func overrideInstallModeParser(_ *itemParserState, f *File, val string) error {
 	return nil
 }
 
+func requireSignedByParser(_ *itemParserState, f *File, val string) error {
+	if _, err := signing.ParsePublicKey(val); err != nil {
+		return errors.Annotate(err, "bad $RequireSignedBy").Err()
+	}
+	f.RequireSignedBy = append(f.RequireSignedBy, val)
+	return nil
+}
+
 // itemParsers is the main way that the ensure file format is extended. If you
 // need to add a new setting or directive, please add an appropriate function
 // above and then add it to this map.
 var itemParsers = map[string]itemParser{
 	"@subdir":              subdirParser,
+	"@if":                  ifParser,
+	"@end":                 endParser,
 	"$serviceurl":          serviceURLParser,
 	"$verifiedplatform":    verifyParser,
 	"$paranoidmode":        paranoidModeParser,
 	"$resolvedversions":    resolvedVersionsParser,
 	"$overrideinstallmode": overrideInstallModeParser,
+	"$requiresignedby":     requireSignedByParser,
 }
@@ -31,6 +31,10 @@ type PackageDef struct {
 	// LineNo is set while parsing an ensure file by the ParseFile method. It is
 	// used by File.Resolve to give additional context if an error occurs.
 	LineNo int
+
+	// Condition is a condition of the enclosing @If block (e.g.
+	// "platform=linux-*") or "" if the package is not inside such block.
+	Condition string
 }
 
 func (p PackageDef) String() string {
@@ -48,8 +52,17 @@ func (ps PackageSlice) Swap(i, j int)      { ps[i], ps[j] = ps[j], ps[i] }
 // name and version are syntactically correct.
 //
 // May return template.ErrSkipTemplate is this package definition should be
-// skipped given the current expansion variables values.
+// skipped given the current expansion variables values (either because of
+// the package template itself or because of the enclosing @If condition).
 func (p *PackageDef) Expand(expander template.Expander) (pkg string, err error) {
+	if p.Condition != "" {
+		switch yes, err := expander.Match(p.Condition); {
+		case err != nil:
+			return "", errors.Annotate(err, "failed to evaluate @If condition (line %d)", p.LineNo).Err()
+		case !yes:
+			return "", template.ErrSkipTemplate
+		}
+	}
 	switch pkg, err = expander.Expand(p.PackageTemplate); {
 	case err == template.ErrSkipTemplate:
 		return "", err
@@ -0,0 +1,224 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cipd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/client/cipd/internal"
+	"go.chromium.org/luci/cipd/common/cipderr"
+)
+
+// minOrphanAge is how long an orphaned directory in the site root guts is kept
+// regardless of GCOptions.MaxAge.
+//
+// Such directories may belong to a deployment still running in another
+// process.
+const minOrphanAge = time.Hour
+
+// GCOptions is passed to CollectGarbage.
+type GCOptions struct {
+	// MaxAge is how long an unused cached instance, a tag cache entry or an
+	// orphaned directory in the site root guts is allowed to stay around.
+	//
+	// Zero means no age limit, i.e. prune all unused entries. Orphaned
+	// directories are always kept for at least an hour though, since they may
+	// still be in use by a concurrent deployment.
+	MaxAge time.Duration
+
+	// MaxSize is a budget for the total size of the instance cache in bytes.
+	//
+	// If the cache is larger, least recently used instances not deployed to the
+	// site root are pruned until it fits. Zero means no limit.
+	MaxSize int64
+
+	// DryRun, if true, instructs CollectGarbage to just report what it would do.
+	DryRun bool
+}
+
+// GCReport is returned by CollectGarbage.
+type GCReport struct {
+	Instances []GCInstance `json:"instances,omitempty"` // all instances in the instance cache
+	Orphans   []GCOrphan   `json:"orphans,omitempty"`   // orphaned directories in the site root
+
+	TagCacheKept   int `json:"tag_cache_kept"`   // number of kept tag cache entries
+	TagCachePruned int `json:"tag_cache_pruned"` // number of pruned tag cache entries
+
+	CacheSize  int64 `json:"cache_size"`  // size of the instance cache after the GC
+	FreedBytes int64 `json:"freed_bytes"` // total size of pruned instances and orphans
+	DryRun     bool  `json:"dry_run,omitempty"`
+}
+
+// GCInstance describes an instance in the instance cache.
+type GCInstance struct {
+	InstanceID string    `json:"instance_id"`
+	Size       int64     `json:"size"`
+	LastAccess time.Time `json:"last_access"`
+	Deployed   bool      `json:"deployed,omitempty"` // deployed to the site root
+	Pruned     bool      `json:"pruned,omitempty"`   // deleted (or would be deleted if DryRun)
+	Reason     string    `json:"reason,omitempty"`   // why it was pruned
+}
+
+// GCOrphan describes an orphaned directory in the site root guts.
+type GCOrphan struct {
+	Path    string    `json:"path"` // relative to the site root, slash-separated
+	Reason  string    `json:"reason"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+	Pruned  bool      `json:"pruned,omitempty"` // deleted (or would be deleted if DryRun)
+}
+
+func (c *clientImpl) CollectGarbage(ctx context.Context, opts *GCOptions) (*GCReport, error) {
+	if c.Root == "" && c.CacheDir == "" {
+		return nil, errors.Reason("neither a site root nor a cache directory is given").Tag(cipderr.BadArgument).Err()
+	}
+
+	realOpts := GCOptions{}
+	if opts != nil {
+		realOpts = *opts
+	}
+	now := clock.Now(ctx)
+	tooOld := func(t time.Time) bool {
+		return realOpts.MaxAge == 0 || now.Sub(t) > realOpts.MaxAge
+	}
+	orphanMaxAge := realOpts.MaxAge
+	if orphanMaxAge < minOrphanAge {
+		orphanMaxAge = minOrphanAge
+	}
+
+	report := &GCReport{DryRun: realOpts.DryRun}
+
+	// Instances deployed to the site root are never pruned.
+	deployed := stringset.New(0)
+	if c.Root != "" {
+		pins, err := c.deployer.FindDeployed(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, pins := range pins {
+			for _, pin := range pins {
+				deployed.Add(pin.InstanceID)
+			}
+		}
+
+		orphans, err := c.deployer.FindOrphans(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range orphans {
+			rel, err := filepath.Rel(c.deployer.FS().Root(), o.Path)
+			if err != nil {
+				rel = o.Path
+			}
+			entry := GCOrphan{
+				Path:    filepath.ToSlash(rel),
+				Reason:  o.Reason,
+				Size:    o.Size,
+				ModTime: o.ModTime,
+				Pruned:  now.Sub(o.ModTime) > orphanMaxAge,
+			}
+			if entry.Pruned && !realOpts.DryRun {
+				if err := c.deployer.FS().EnsureDirectoryGone(ctx, o.Path); err != nil {
+					logging.Warningf(ctx, "Failed to remove %s: %s", o.Path, err)
+					entry.Pruned = false
+				}
+			}
+			if entry.Pruned {
+				report.FreedBytes += entry.Size
+			}
+			report.Orphans = append(report.Orphans, entry)
+		}
+	}
+
+	// The instance cache exists only if using a cache directory.
+	if c.CacheDir != "" {
+		cache := &internal.InstanceCache{
+			FS: fs.NewFileSystem(filepath.Join(c.CacheDir, "instances"), ""),
+		}
+
+		// Instances are sorted by last access time, oldest first.
+		instances := cache.List(ctx)
+		var size int64
+		for _, inst := range instances {
+			size += inst.Size
+		}
+
+		var prune []string
+		for _, inst := range instances {
+			entry := GCInstance{
+				InstanceID: inst.InstanceID,
+				Size:       inst.Size,
+				LastAccess: inst.LastAccess,
+				Deployed:   deployed.Has(inst.InstanceID),
+			}
+			switch {
+			case entry.Deployed:
+			case tooOld(inst.LastAccess):
+				entry.Pruned = true
+				entry.Reason = fmt.Sprintf("not used since %s", inst.LastAccess.UTC().Format(time.RFC3339))
+			case realOpts.MaxSize > 0 && size > realOpts.MaxSize:
+				entry.Pruned = true
+				entry.Reason = "the cache is over the size budget"
+			}
+			if entry.Pruned {
+				size -= entry.Size
+				prune = append(prune, entry.InstanceID)
+			}
+			report.Instances = append(report.Instances, entry)
+		}
+
+		if len(prune) != 0 && !realOpts.DryRun {
+			pruned := stringset.NewFromSlice(cache.Prune(ctx, prune)...)
+			size = 0
+			for i := range report.Instances {
+				entry := &report.Instances[i]
+				if entry.Pruned && !pruned.Has(entry.InstanceID) {
+					entry.Pruned = false
+					entry.Reason = ""
+				}
+				if !entry.Pruned {
+					size += entry.Size
+				}
+			}
+		}
+
+		for _, entry := range report.Instances {
+			if entry.Pruned {
+				report.FreedBytes += entry.Size
+			}
+		}
+		report.CacheSize = size
+	}
+
+	// Forget old resolved tags. They are resolved again when needed.
+	if tagCache := c.getTagCache(); tagCache != nil {
+		var err error
+		report.TagCacheKept, report.TagCachePruned, err = tagCache.Prune(ctx, realOpts.MaxAge, realOpts.DryRun)
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
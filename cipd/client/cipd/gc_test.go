@@ -0,0 +1,184 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cipd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCollectGarbage(t *testing.T) {
+	t.Parallel()
+
+	Convey("With client", t, func(c C) {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeLocal)
+
+		client, _, _, _ := mockedCipdClient(c)
+		cacheDir := setupInstanceCache(client, c)
+
+		// An instance deployed to the site root.
+		inst := fakeInstance("pkg/deployed")
+		deployed, err := client.deployer.DeployInstance(ctx, "", inst, "", 0)
+		So(err, ShouldBeNil)
+
+		// Orphaned temp directories, modified at the given time.
+		putOrphan := func(name string, mtime time.Time) {
+			path := filepath.Join(client.Root, fs.SiteServiceDir, "tmp", name)
+			So(os.MkdirAll(path, 0777), ShouldBeNil)
+			So(os.Chtimes(path, mtime, mtime), ShouldBeNil)
+		}
+		isOrphan := func(name string) bool {
+			_, err := os.Stat(filepath.Join(client.Root, fs.SiteServiceDir, "tmp", name))
+			return err == nil
+		}
+		orphans := func(r *GCReport) map[string]GCOrphan {
+			out := map[string]GCOrphan{}
+			for _, o := range r.Orphans {
+				out[o.Path] = o
+			}
+			return out
+		}
+		putOrphan("dl_1", testclock.TestRecentTimeLocal)
+
+		putCached := func(iid, body string) {
+			So(os.MkdirAll(filepath.Join(cacheDir, "instances"), 0777), ShouldBeNil)
+			So(os.WriteFile(filepath.Join(cacheDir, "instances", iid), []byte(body), 0666), ShouldBeNil)
+		}
+		isCached := func(iid string) bool {
+			_, err := os.Stat(filepath.Join(cacheDir, "instances", iid))
+			return err == nil
+		}
+
+		// The instance cache discovers new files when it syncs its state, which
+		// happens every 8h.
+		putCached(deployed.InstanceID, "deployed")
+		putCached(fakeIID("a"), "old")
+		So(client.getTagCache().AddTag(ctx, common.Pin{PackageName: "pkg/a", InstanceID: fakeIID("a")}, "k:a"), ShouldBeNil)
+		So(client.getTagCache().Save(ctx), ShouldBeNil)
+		report, err := client.CollectGarbage(ctx, &GCOptions{DryRun: true})
+		So(err, ShouldBeNil)
+		So(report.Instances, ShouldHaveLength, 2)
+
+		tc.Add(9 * time.Hour)
+		putCached(fakeIID("b"), "new instance")
+		So(client.getTagCache().AddTag(ctx, common.Pin{PackageName: "pkg/b", InstanceID: fakeIID("b")}, "k:b"), ShouldBeNil)
+		So(client.getTagCache().Save(ctx), ShouldBeNil)
+
+		tc.Add(time.Hour)
+		putOrphan("dl_2", tc.Now().Add(-30*time.Minute))
+
+		Convey("Dry run", func() {
+			report, err := client.CollectGarbage(ctx, &GCOptions{
+				MaxAge: 5 * time.Hour,
+				DryRun: true,
+			})
+			So(err, ShouldBeNil)
+			So(report.DryRun, ShouldBeTrue)
+
+			So(report.Orphans, ShouldHaveLength, 2)
+			So(orphans(report)[".cipd/tmp/dl_1"].Pruned, ShouldBeTrue)
+			So(orphans(report)[".cipd/tmp/dl_2"].Pruned, ShouldBeFalse)
+
+			So(report.Instances, ShouldHaveLength, 3)
+			byID := map[string]GCInstance{}
+			for _, inst := range report.Instances {
+				byID[inst.InstanceID] = inst
+			}
+			So(byID[deployed.InstanceID].Deployed, ShouldBeTrue)
+			So(byID[deployed.InstanceID].Pruned, ShouldBeFalse)
+			So(byID[fakeIID("a")].Pruned, ShouldBeTrue)
+			So(byID[fakeIID("b")].Pruned, ShouldBeFalse)
+			So(report.FreedBytes, ShouldEqual, int64(len("old")))
+			So(report.TagCachePruned, ShouldEqual, 1)
+
+			// Nothing is actually deleted.
+			So(isCached(fakeIID("a")), ShouldBeTrue)
+			So(isCached(fakeIID("b")), ShouldBeTrue)
+			So(isOrphan("dl_1"), ShouldBeTrue)
+		})
+
+		Convey("By age", func() {
+			report, err := client.CollectGarbage(ctx, &GCOptions{MaxAge: 5 * time.Hour})
+			So(err, ShouldBeNil)
+			So(report.CacheSize, ShouldEqual, int64(len("deployed")+len("new instance")))
+			So(report.TagCacheKept, ShouldEqual, 1)
+			So(report.TagCachePruned, ShouldEqual, 1)
+
+			So(isCached(deployed.InstanceID), ShouldBeTrue)
+			So(isCached(fakeIID("a")), ShouldBeFalse)
+			So(isCached(fakeIID("b")), ShouldBeTrue)
+
+			pin, err := client.getTagCache().ResolveTag(ctx, "pkg/a", "k:a")
+			So(err, ShouldBeNil)
+			So(pin, ShouldResemble, common.Pin{})
+		})
+
+		Convey("Tag cache by age only", func() {
+			// The instance is neither cached nor deployed, but the tag is recent.
+			So(client.getTagCache().AddTag(ctx, common.Pin{PackageName: "pkg/c", InstanceID: fakeIID("c")}, "k:c"), ShouldBeNil)
+			So(client.getTagCache().Save(ctx), ShouldBeNil)
+
+			report, err := client.CollectGarbage(ctx, &GCOptions{MaxAge: 5 * time.Hour})
+			So(err, ShouldBeNil)
+			So(report.TagCacheKept, ShouldEqual, 2)
+			So(report.TagCachePruned, ShouldEqual, 1)
+
+			pin, err := client.getTagCache().ResolveTag(ctx, "pkg/c", "k:c")
+			So(err, ShouldBeNil)
+			So(pin, ShouldResemble, common.Pin{PackageName: "pkg/c", InstanceID: fakeIID("c")})
+		})
+
+		Convey("By size", func() {
+			report, err := client.CollectGarbage(ctx, &GCOptions{
+				MaxAge:  100 * time.Hour,
+				MaxSize: int64(len("deployed") + len("new instance")),
+			})
+			So(err, ShouldBeNil)
+			So(report.CacheSize, ShouldEqual, int64(len("deployed")+len("new instance")))
+
+			So(isCached(deployed.InstanceID), ShouldBeTrue)
+			So(isCached(fakeIID("a")), ShouldBeFalse)
+			So(isCached(fakeIID("b")), ShouldBeTrue)
+		})
+
+		Convey("Everything unused", func() {
+			report, err := client.CollectGarbage(ctx, nil)
+			So(err, ShouldBeNil)
+			So(report.CacheSize, ShouldEqual, int64(len("deployed")))
+			So(report.TagCacheKept, ShouldEqual, 0)
+			So(report.TagCachePruned, ShouldEqual, 2)
+
+			So(isCached(deployed.InstanceID), ShouldBeTrue)
+			So(isCached(fakeIID("a")), ShouldBeFalse)
+			So(isCached(fakeIID("b")), ShouldBeFalse)
+
+			// Recently modified orphans may still be in use, they are kept.
+			So(orphans(report)[".cipd/tmp/dl_1"].Pruned, ShouldBeTrue)
+			So(orphans(report)[".cipd/tmp/dl_2"].Pruned, ShouldBeFalse)
+			So(isOrphan("dl_1"), ShouldBeFalse)
+			So(isOrphan("dl_2"), ShouldBeTrue)
+		})
+	})
+}
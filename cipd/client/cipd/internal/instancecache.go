@@ -22,6 +22,7 @@ import (
 	"io"
 	"math/rand"
 	"os"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -501,6 +502,67 @@ func (c *InstanceCache) GC(ctx context.Context) {
 	})
 }
 
+// CachedInstance describes an instance file in the cache, see List.
+type CachedInstance struct {
+	InstanceID string    // the instance ID of the cached instance
+	Size       int64     // size of the instance file
+	LastAccess time.Time // last time the instance was fetched from the cache
+}
+
+// List returns all instances in the cache, least recently accessed first.
+//
+// Entries for instance files that are gone are silently skipped.
+func (c *InstanceCache) List(ctx context.Context) []CachedInstance {
+	var out []CachedInstance
+	c.withState(ctx, clock.Now(ctx), func(s *messages.InstanceCache) (save bool) {
+		for instanceID, e := range s.Entries {
+			path, err := c.FS.RootRelToAbs(instanceID)
+			if err != nil {
+				continue
+			}
+			fi, err := os.Stat(path)
+			if err != nil {
+				continue
+			}
+			out = append(out, CachedInstance{
+				InstanceID: instanceID,
+				Size:       fi.Size(),
+				LastAccess: e.LastAccess.AsTime(),
+			})
+		}
+		return true // the state may have been synchronized, persist it
+	})
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].LastAccess.Equal(out[j].LastAccess) {
+			return out[i].LastAccess.Before(out[j].LastAccess)
+		}
+		return out[i].InstanceID < out[j].InstanceID
+	})
+	return out
+}
+
+// Prune deletes given instances from the cache.
+//
+// Returns IDs of instances that were actually deleted.
+func (c *InstanceCache) Prune(ctx context.Context, instanceIDs []string) (deleted []string) {
+	c.withState(ctx, clock.Now(ctx), func(s *messages.InstanceCache) (save bool) {
+		for _, instanceID := range instanceIDs {
+			path, err := c.FS.RootRelToAbs(instanceID)
+			if err != nil {
+				continue
+			}
+			// EnsureFileGone logs errors already.
+			if c.FS.EnsureFileGone(ctx, path) == nil {
+				delete(s.Entries, instanceID)
+				deleted = append(deleted, instanceID)
+			}
+		}
+		c.FS.CleanupTrash(ctx)
+		return true
+	})
+	return
+}
+
 type garbageCandidate struct {
 	instanceID     string
 	lastAccessTime time.Time
@@ -341,6 +341,33 @@ func TestInstanceCache(t *testing.T) {
 			}
 		})
 
+		Convey("List and Prune", func() {
+			for i := 0; i < 3; i++ {
+				putNew(cache, pin(i))
+				tc.Add(time.Second)
+			}
+			testHas(cache, pin(0)) // moves it to the end of the LRU list
+
+			listed := cache.List(ctx)
+			So(listed, ShouldHaveLength, 3)
+			So(listed[0].InstanceID, ShouldEqual, pin(1).InstanceID)
+			So(listed[1].InstanceID, ShouldEqual, pin(2).InstanceID)
+			So(listed[2].InstanceID, ShouldEqual, pin(0).InstanceID)
+			So(listed[2].Size, ShouldEqual, int64(len(fakeData(pin(0)))))
+			So(listed[2].LastAccess.Equal(clock.Now(ctx)), ShouldBeTrue)
+
+			deleted := cache.Prune(ctx, []string{pin(1).InstanceID, pin(2).InstanceID})
+			So(deleted, ShouldResemble, []string{pin(1).InstanceID, pin(2).InstanceID})
+
+			listed = cache.List(ctx)
+			So(listed, ShouldHaveLength, 1)
+			So(listed[0].InstanceID, ShouldEqual, pin(0).InstanceID)
+			So(countTempFiles(), ShouldEqual, 2) // +1 for state.db
+
+			// Can be recreated.
+			putNew(cache, pin(1))
+		})
+
 		Convey("Sync", func() {
 			stateDbPath := filepath.Join(tempDir, instanceCacheStateFilename)
 			const count = 10
@@ -20,7 +20,11 @@ import (
 	"os"
 	"sort"
 	"sync"
+	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
 
@@ -209,6 +213,7 @@ func (c *TagCache) AddTag(ctx context.Context, pin common.Pin, tag string) error
 		Package:    pin.PackageName,
 		Tag:        tag,
 		InstanceId: pin.InstanceID,
+		AddedTs:    timestamppb.New(clock.Now(ctx)),
 	}
 
 	return nil
@@ -241,6 +246,7 @@ func (c *TagCache) AddExtractedObjectRef(ctx context.Context, pin common.Pin, fi
 		InstanceId: pin.InstanceID,
 		FileName:   fileName,
 		ObjectRef:  common.ObjectRefToInstanceID(ref),
+		AddedTs:    timestamppb.New(clock.Now(ctx)),
 	}
 	return nil
 }
@@ -330,6 +336,54 @@ func (c *TagCache) Save(ctx context.Context) error {
 	return nil
 }
 
+// Prune removes cache entries added more than maxAge ago.
+//
+// Zero maxAge means all entries are removed. Entries without the time they
+// were added (written by older clients) are considered too old.
+//
+// Applies to entries of all services. If dryRun is true, just counts entries
+// without modifying the cache file. Returns the number of kept and pruned
+// entries.
+func (c *TagCache) Prune(ctx context.Context, maxAge time.Duration, dryRun bool) (kept, pruned int, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	recent, err := c.loadFromDisk(ctx, true)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	now := clock.Now(ctx)
+	keep := func(added *timestamppb.Timestamp) bool {
+		return maxAge != 0 && added != nil && now.Sub(added.AsTime()) <= maxAge
+	}
+
+	updated := &messages.TagCache{}
+	for _, e := range recent.Entries {
+		if keep(e.AddedTs) {
+			updated.Entries = append(updated.Entries, e)
+		}
+	}
+	for _, e := range recent.FileEntries {
+		if keep(e.AddedTs) {
+			updated.FileEntries = append(updated.FileEntries, e)
+		}
+	}
+
+	total := len(recent.Entries) + len(recent.FileEntries)
+	kept = len(updated.Entries) + len(updated.FileEntries)
+	if dryRun || kept == total {
+		return kept, total - kept, nil
+	}
+	if err := c.dumpToDisk(ctx, updated); err != nil {
+		return 0, 0, err
+	}
+
+	// Force reload from disk next time it is used.
+	c.cache = nil
+	return kept, total - kept, nil
+}
+
 // loadFromDisk loads and parses the tag cache file.
 //
 // If 'allService' is true, returns all cache entries (regardless of what
@@ -21,12 +21,16 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
 
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
 	"go.chromium.org/luci/cipd/client/cipd/fs"
 	"go.chromium.org/luci/cipd/common"
 
 	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
 func TestTagCacheWorks(t *testing.T) {
@@ -118,6 +122,54 @@ func TestTagCacheWorks(t *testing.T) {
 			So(file, ShouldResemble, numberedObjRef(2))
 		})
 
+		Convey("pruning", func() {
+			ctx, tc := testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+
+			// Entries 0 and 1 are old, entries 2 and 3 are recent.
+			cache := NewTagCache(fs, "service.example.com")
+			for i := 0; i < 4; i++ {
+				if i == 2 {
+					So(cache.Save(ctx), ShouldBeNil)
+					tc.Add(2 * time.Hour)
+				}
+				So(cache.AddTag(ctx, numberedPin(i), fmt.Sprintf("tag:%d", i)), ShouldBeNil)
+			}
+			So(cache.AddExtractedObjectRef(ctx, numberedPin(3), "cipd", numberedObjRef(13)), ShouldBeNil)
+			So(cache.Save(ctx), ShouldBeNil)
+			tc.Add(time.Hour)
+
+			// Dry run doesn't touch anything.
+			kept, pruned, err := cache.Prune(ctx, 90*time.Minute, true)
+			So(err, ShouldBeNil)
+			So(kept, ShouldEqual, 3)
+			So(pruned, ShouldEqual, 2)
+			pin, err := cache.ResolveTag(ctx, "pkg", "tag:1")
+			So(err, ShouldBeNil)
+			So(pin, ShouldResemble, numberedPin(1))
+
+			// The real run.
+			kept, pruned, err = cache.Prune(ctx, 90*time.Minute, false)
+			So(err, ShouldBeNil)
+			So(kept, ShouldEqual, 3)
+			So(pruned, ShouldEqual, 2)
+
+			another := NewTagCache(fs, "service.example.com")
+			for i, expected := range []common.Pin{{}, {}, numberedPin(2), numberedPin(3)} {
+				pin, err := another.ResolveTag(ctx, "pkg", fmt.Sprintf("tag:%d", i))
+				So(err, ShouldBeNil)
+				So(pin, ShouldResemble, expected)
+			}
+			ref, err := another.ResolveExtractedObjectRef(ctx, numberedPin(3), "cipd")
+			So(err, ShouldBeNil)
+			So(ref, ShouldResembleProto, numberedObjRef(13))
+
+			// Zero max age prunes everything.
+			kept, pruned, err = cache.Prune(ctx, 0, false)
+			So(err, ShouldBeNil)
+			So(kept, ShouldEqual, 0)
+			So(pruned, ShouldEqual, 3)
+		})
+
 		Convey("many tags", func() {
 			tc := NewTagCache(fs, "service.example.com")
 
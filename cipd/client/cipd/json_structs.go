@@ -149,6 +149,12 @@ type InstanceDescription struct {
 	//
 	// Present only if DescribeTags in DescribeInstanceOpts is true.
 	Tags []TagInfo `json:"tags,omitempty"`
+
+	// Metadata is a list of metadata entries attached to the instance, sorted by
+	// attachment timestamp (newest first).
+	//
+	// Present only if DescribeMetadata in DescribeInstanceOpts is true.
+	Metadata []MetadataInfo `json:"metadata,omitempty"`
 }
 
 // ClientDescription contains extended information about a CIPD client binary
@@ -222,6 +228,21 @@ func apiTagToInfo(t *api.Tag) TagInfo {
 	}
 }
 
+func apiMetadataToInfo(m *api.InstanceMetadata) MetadataInfo {
+	var t time.Time
+	if m.AttachedTs.IsValid() {
+		t = m.AttachedTs.AsTime()
+	}
+	return MetadataInfo{
+		Fingerprint: m.Fingerprint,
+		Key:         m.Key,
+		Value:       m.Value,
+		ContentType: m.ContentType,
+		AttachedBy:  m.AttachedBy,
+		AttachedTs:  UnixTime(t),
+	}
+}
+
 func apiDescToInfo(d *api.DescribeInstanceResponse) *InstanceDescription {
 	desc := &InstanceDescription{
 		InstanceInfo: apiInstanceToInfo(d.Instance),
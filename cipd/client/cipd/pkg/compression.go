@@ -0,0 +1,69 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"go.chromium.org/luci/cipd/common/cipderr"
+	"go.chromium.org/luci/common/errors"
+)
+
+// Compression defines how files are compressed inside a package.
+type Compression string
+
+const (
+	// CompressionDeflate is default (for backward compatibility).
+	//
+	// Files are compressed using the standard zip deflate method, understood by
+	// all CIPD clients and zip tools.
+	CompressionDeflate Compression = "deflate"
+
+	// CompressionZstd compresses files using zstd.
+	//
+	// It is much faster to decompress, but such packages can be installed only
+	// by CIPD clients that understand the zstd zip method. They also have
+	// a newer manifest format version (ManifestFormatVersionZstd).
+	//
+	// Clients released before zstd support don't check the format version and
+	// don't fail with a clear error: they see the unknown zip method as
+	// a corruption, fetch the package again and then report it as corrupted.
+	// Use zstd only for packages installed by up-to-date clients.
+	CompressionZstd Compression = "zstd"
+)
+
+// Set is called by 'flag' package when parsing command line options.
+func (c *Compression) Set(value string) error {
+	val := Compression(value)
+	if err := ValidateCompression(val); err != nil {
+		return err
+	}
+	*c = val
+	return nil
+}
+
+// String is needed to conform to flag.Value interface.
+func (c Compression) String() string {
+	return string(c)
+}
+
+// ValidateCompression returns non nil if the compression is invalid.
+//
+// Valid values are: "" (same as "deflate"), "deflate" (aka CompressionDeflate),
+// "zstd" (aka CompressionZstd).
+func ValidateCompression(c Compression) error {
+	if c == "" || c == CompressionDeflate || c == CompressionZstd {
+		return nil
+	}
+	return errors.Reason("invalid compression %q", c).Tag(cipderr.BadArgument).Err()
+}
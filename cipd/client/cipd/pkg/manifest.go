@@ -32,8 +32,23 @@ const (
 
 	// ManifestFormatVersion is a version to write to the manifest file.
 	ManifestFormatVersion = "1.1"
+
+	// ManifestFormatVersionZstd is a version to write to the manifest file of
+	// packages that use zstd compression (see CompressionZstd).
+	ManifestFormatVersionZstd = "1.2"
 )
 
+// knownFormatVersions is a set of manifest format versions this client can
+// install.
+//
+// An empty format version is allowed for compatibility with ancient packages.
+var knownFormatVersions = map[string]bool{
+	"":                        true,
+	"1":                       true,
+	ManifestFormatVersion:     true,
+	ManifestFormatVersionZstd: true,
+}
+
 // Manifest defines structure of manifest.json file.
 type Manifest struct {
 	FormatVersion string      `json:"format_version"`
@@ -50,10 +65,12 @@ type Manifest struct {
 // FileInfo describes a file that was extracted from a CIPD package.
 //
 // It is derived (based on zip info headers and actual contents of the files) by
-// ExtractFiles when it unpacks the CIPD package. FileInfo structs are *not*
-// stored in an explicit form in manifest.json inside the package. They are
+// ExtractFiles when it unpacks the CIPD package. FileInfo structs are usually
+// *not* stored in an explicit form in manifest.json inside the package. They are
 // present only in manifest.json files on disk, representing already unpacked
-// packages.
+// packages. The exception are packages built with file hashes (see
+// builder.Options.FileHashes): their manifest.json lists names, sizes and hashes
+// of all files.
 type FileInfo struct {
 	// Name is slash separated file path relative to a package root.
 	Name string `json:"name"`
@@ -101,6 +118,20 @@ type VersionFile struct {
 	InstanceID  string `json:"instance_id"`
 }
 
+// ValidateFormatVersion returns an error if the package with the given
+// manifest format version can't be installed by this client.
+//
+// It protects only against format versions newer than the ones known to this
+// client. Clients that predate this check don't reject packages with unknown
+// format versions, see CompressionZstd.
+func ValidateFormatVersion(v string) error {
+	if knownFormatVersions[v] {
+		return nil
+	}
+	return errors.Reason("unsupported package format version %q, "+
+		"the package was likely built by a newer CIPD client, update the client", v).Tag(cipderr.BadArgument).Err()
+}
+
 // ReadManifest reads and decodes manifest JSON from io.Reader.
 func ReadManifest(r io.Reader) (Manifest, error) {
 	blob, err := io.ReadAll(r)
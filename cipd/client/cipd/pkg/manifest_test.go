@@ -54,4 +54,12 @@ func TestReadManifest(t *testing.T) {
 		So(WriteManifest(&m, buf), ShouldBeNil)
 		So(string(buf.Bytes()), ShouldEqual, goodManifest)
 	})
+
+	Convey("ValidateFormatVersion works", t, func() {
+		So(ValidateFormatVersion(""), ShouldBeNil)
+		So(ValidateFormatVersion("1"), ShouldBeNil)
+		So(ValidateFormatVersion(ManifestFormatVersion), ShouldBeNil)
+		So(ValidateFormatVersion(ManifestFormatVersionZstd), ShouldBeNil)
+		So(ValidateFormatVersion("999"), ShouldNotBeNil)
+	})
 }
@@ -0,0 +1,312 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proxyserver implements a caching CIPD proxy.
+//
+// The proxy implements a subset of cipd.v1.Repository pRPC service used by the
+// CIPD client when installing packages. Most RPCs are forwarded to the upstream
+// backend as is. GetInstanceURL returns URLs that point back to the proxy.
+// Instances fetched through such URLs are stored in a local LRU cache, so that
+// multiple clients fetching the same instance hit the upstream storage only
+// once.
+//
+// All upstream RPCs are done using the credentials of the proxy itself. Any
+// client that can reach the proxy gets the same access as the proxy. In
+// particular, instances are always fetched by their package name, so that
+// package ACLs apply even to cached instances.
+package proxyserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/data/caching/cache"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/server/router"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// fetchPath is a path of the proxy HTTP endpoint that serves objects.
+const fetchPath = "/_cipd_proxy/fetch/"
+
+// Server is a caching proxy for the CIPD backend.
+type Server struct {
+	// Repository is a client for the upstream Repository service.
+	Repository api.RepositoryClient
+
+	// HTTPClient is used to fetch objects from upstream signed URLs.
+	//
+	// Default is http.DefaultClient.
+	HTTPClient *http.Client
+
+	// Cache is where fetched objects are stored.
+	//
+	// Must use crypto.SHA256 hash. Objects that use SHA256 hash algo are stored
+	// under their digest. Objects that use other hash algos are verified when
+	// fetched and stored under the SHA256 digest of their instance ID. Objects
+	// with hash algos unknown to the proxy are streamed from the upstream on
+	// every fetch. If nil, nothing is cached.
+	Cache *cache.Cache
+
+	// TempDir is where objects that don't use SHA256 hash algo are stored while
+	// they are fetched and verified, before being hardlinked into Cache. It
+	// should be on the same filesystem as Cache.
+	//
+	// Default is os.TempDir().
+	TempDir string
+
+	// URL is a root URL of the proxy as seen by its clients.
+	//
+	// It is used to construct URLs returned by GetInstanceURL, e.g.
+	// "http://cipd-proxy.example.com:8080".
+	URL string
+}
+
+// InstallHandlers installs the pRPC service and the fetch endpoint into the
+// router.
+func (s *Server) InstallHandlers(r *router.Router, base router.MiddlewareChain) {
+	srv := &prpc.Server{}
+	srv.InstallHandlers(r, base)
+	api.RegisterRepositoryServer(srv, &repoServer{s: s})
+	r.GET(fetchPath+":iid", base, func(ctx *router.Context) {
+		err := status.Convert(grpcutil.GRPCifyAndLogErr(ctx.Context, s.handleFetch(ctx)))
+		if err.Code() != codes.OK {
+			http.Error(ctx.Writer, err.Message(), grpcutil.CodeStatus(err.Code()))
+		}
+	})
+}
+
+// fetchURL returns an URL of the fetch endpoint for the given instance.
+func (s *Server) fetchURL(ref *api.ObjectRef, pkg string) string {
+	return s.URL + fetchPath + common.ObjectRefToInstanceID(ref) + "?" + url.Values{"package": {pkg}}.Encode()
+}
+
+// handleFetch serves an instance, fetching it from the upstream if necessary.
+//
+// The package name is required: the upstream checks the package ACLs when the
+// proxy asks it for the instance URL. This happens even if the instance is
+// already cached.
+//
+// GET /_cipd_proxy/fetch/<instance id>?package=<name>
+func (s *Server) handleFetch(ctx *router.Context) error {
+	iid := ctx.Params.ByName("iid")
+	if err := common.ValidateInstanceID(iid, common.AnyHash); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	ref := common.InstanceIDToObjectRef(iid)
+
+	pkg := ctx.Request.URL.Query().Get("package")
+	if pkg == "" {
+		return status.Errorf(codes.InvalidArgument, "the package name is required")
+	}
+	if err := common.ValidatePackageName(pkg); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	// Ask the upstream for the instance URL even if the instance is cached, to
+	// make sure the package is still visible and the instance belongs to it.
+	objURL, err := s.Repository.GetInstanceURL(ctx.Context, &api.GetInstanceURLRequest{
+		Package:  pkg,
+		Instance: ref,
+	})
+	if err != nil {
+		return err
+	}
+
+	key := cacheKey(ref)
+	if s.Cache == nil || key == "" {
+		src, err := s.openUpstream(ctx.Context, objURL)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		ctx.Writer.Header().Set("Content-Type", "application/octet-stream")
+		if _, err := io.Copy(ctx.Writer, src); err != nil {
+			logging.Warningf(ctx.Context, "Failed to stream %s: %s", iid, err)
+		}
+		return nil
+	}
+
+	f, err := s.Cache.Read(key)
+	switch {
+	case os.IsNotExist(err):
+		logging.Infof(ctx.Context, "Cache miss for %s", iid)
+		if err := s.fetchToCache(ctx.Context, ref, key, objURL); err != nil {
+			return err
+		}
+		if f, err = s.Cache.Read(key); err != nil {
+			return errors.Annotate(err, "reading %s from the cache", iid).Tag(grpcutil.InternalTag).Err()
+		}
+	case err != nil:
+		return errors.Annotate(err, "reading %s from the cache", iid).Tag(grpcutil.InternalTag).Err()
+	}
+	defer f.Close()
+
+	ctx.Writer.Header().Set("Content-Type", "application/octet-stream")
+	if rs, ok := f.(io.ReadSeeker); ok {
+		http.ServeContent(ctx.Writer, ctx.Request, "", time.Time{}, rs)
+	} else if _, err := io.Copy(ctx.Writer, f); err != nil {
+		logging.Warningf(ctx.Context, "Failed to send %s: %s", iid, err)
+	}
+	return nil
+}
+
+// cacheKey returns the key of the object in the cache, or "" if the object
+// can't be cached because its hash algo is unknown.
+func cacheKey(ref *api.ObjectRef) cache.HexDigest {
+	switch {
+	case ref.HashAlgo == api.HashAlgo_SHA256:
+		return cache.HexDigest(ref.HexDigest)
+	case common.ValidateHashAlgo(ref.HashAlgo) != nil:
+		return ""
+	}
+	sum := sha256.Sum256([]byte(common.ObjectRefToInstanceID(ref)))
+	return cache.HexDigest(hex.EncodeToString(sum[:]))
+}
+
+// fetchToCache fetches the instance from the upstream and puts it into the
+// cache under the given key.
+//
+// The hash of the fetched data is verified.
+func (s *Server) fetchToCache(ctx context.Context, ref *api.ObjectRef, key cache.HexDigest, objURL *api.ObjectURL) error {
+	src, err := s.openUpstream(ctx, objURL)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	if ref.HashAlgo == api.HashAlgo_SHA256 {
+		// The cache verifies the hash itself.
+		err = s.Cache.Add(ctx, key, src)
+	} else {
+		err = s.addVerified(ctx, ref, key, src)
+	}
+	if err != nil {
+		if errors.Contains(err, cache.ErrInvalidHash) {
+			return errors.Annotate(err, "fetched corrupted data").Tag(grpcutil.InternalTag).Err()
+		}
+		return errors.Annotate(err, "storing the object in the cache").Tag(grpcutil.InternalTag).Err()
+	}
+	return nil
+}
+
+// addVerified puts an object that doesn't use SHA256 hash algo into the cache,
+// verifying its hash first.
+func (s *Server) addVerified(ctx context.Context, ref *api.ObjectRef, key cache.HexDigest, src io.Reader) error {
+	tmp, err := os.CreateTemp(s.TempDir, "cipd_proxy_*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	h := common.MustNewHash(ref.HashAlgo)
+	_, err = io.Copy(tmp, io.TeeReader(src, h))
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if got := common.HexDigest(h); got != ref.HexDigest {
+		return errors.Annotate(cache.ErrInvalidHash, "invalid hash, got=%s, want=%s", got, ref.HexDigest).Err()
+	}
+	return s.Cache.AddFileWithoutValidation(ctx, key, tmp.Name())
+}
+
+// openUpstream opens a reader that fetches the object from the upstream signed
+// URL.
+func (s *Server) openUpstream(ctx context.Context, objURL *api.ObjectURL) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", objURL.SignedUrl, nil)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad upstream URL").Tag(grpcutil.InternalTag).Err()
+	}
+	client := s.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Annotate(err, "fetching from the upstream").Tag(grpcutil.UnavailableTag).Err()
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, status.Errorf(codes.Unavailable, "upstream storage replied with HTTP %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Repository service.
+
+// repoServer implements read-only subset of the Repository service.
+type repoServer struct {
+	api.UnimplementedRepositoryServer
+
+	s *Server
+}
+
+// GetInstanceURL returns an URL that points to the proxy fetch endpoint.
+func (r *repoServer) GetInstanceURL(ctx context.Context, req *api.GetInstanceURLRequest) (*api.ObjectURL, error) {
+	// Ask the upstream first to get the correct errors if the instance is
+	// missing or not visible. The signed URL itself is not used.
+	if _, err := r.s.Repository.GetInstanceURL(ctx, req); err != nil {
+		return nil, err
+	}
+	return &api.ObjectURL{SignedUrl: r.s.fetchURL(req.Instance, req.Package)}, nil
+}
+
+func (r *repoServer) ResolveVersion(ctx context.Context, req *api.ResolveVersionRequest) (*api.Instance, error) {
+	return r.s.Repository.ResolveVersion(ctx, req)
+}
+
+func (r *repoServer) DescribeInstance(ctx context.Context, req *api.DescribeInstanceRequest) (*api.DescribeInstanceResponse, error) {
+	return r.s.Repository.DescribeInstance(ctx, req)
+}
+
+func (r *repoServer) DescribeClient(ctx context.Context, req *api.DescribeClientRequest) (*api.DescribeClientResponse, error) {
+	return r.s.Repository.DescribeClient(ctx, req)
+}
+
+func (r *repoServer) ListMetadata(ctx context.Context, req *api.ListMetadataRequest) (*api.ListMetadataResponse, error) {
+	return r.s.Repository.ListMetadata(ctx, req)
+}
+
+func (r *repoServer) ListInstances(ctx context.Context, req *api.ListInstancesRequest) (*api.ListInstancesResponse, error) {
+	return r.s.Repository.ListInstances(ctx, req)
+}
+
+func (r *repoServer) SearchInstances(ctx context.Context, req *api.SearchInstancesRequest) (*api.SearchInstancesResponse, error) {
+	return r.s.Repository.SearchInstances(ctx, req)
+}
+
+func (r *repoServer) ListRefs(ctx context.Context, req *api.ListRefsRequest) (*api.ListRefsResponse, error) {
+	return r.s.Repository.ListRefs(ctx, req)
+}
+
+func (r *repoServer) ListPrefix(ctx context.Context, req *api.ListPrefixRequest) (*api.ListPrefixResponse, error) {
+	return r.s.Repository.ListPrefix(ctx, req)
+}
@@ -0,0 +1,238 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyserver
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/data/caching/cache"
+	"go.chromium.org/luci/server/router"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// upstream is a fake CIPD backend with a fake storage.
+type upstream struct {
+	api.RepositoryClient
+
+	storage *httptest.Server
+
+	m       sync.Mutex
+	objects map[string]string // instance ID => body
+	fetches map[string]int    // instance ID => number of fetches
+}
+
+func newUpstream() *upstream {
+	u := &upstream{
+		objects: map[string]string{},
+		fetches: map[string]int{},
+	}
+	u.storage = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		iid := r.URL.Path[1:]
+		u.m.Lock()
+		body, ok := u.objects[iid]
+		u.fetches[iid]++
+		u.m.Unlock()
+		if !ok {
+			http.Error(rw, "gone", http.StatusNotFound)
+			return
+		}
+		io.WriteString(rw, body)
+	}))
+	return u
+}
+
+func (u *upstream) put(algo api.HashAlgo, body string) *api.ObjectRef {
+	h := common.MustNewHash(algo)
+	io.WriteString(h, body)
+	ref := common.ObjectRefFromHash(h)
+	u.putRef(ref, body)
+	return ref
+}
+
+func (u *upstream) putRef(ref *api.ObjectRef, body string) {
+	u.m.Lock()
+	u.objects[common.ObjectRefToInstanceID(ref)] = body
+	u.m.Unlock()
+}
+
+func (u *upstream) fetchCount(ref *api.ObjectRef) int {
+	u.m.Lock()
+	defer u.m.Unlock()
+	return u.fetches[common.ObjectRefToInstanceID(ref)]
+}
+
+func (u *upstream) signedURL(ref *api.ObjectRef) (*api.ObjectURL, error) {
+	iid := common.ObjectRefToInstanceID(ref)
+	u.m.Lock()
+	_, ok := u.objects[iid]
+	u.m.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no such instance")
+	}
+	return &api.ObjectURL{SignedUrl: u.storage.URL + "/" + iid}, nil
+}
+
+func (u *upstream) GetInstanceURL(ctx context.Context, req *api.GetInstanceURLRequest, opts ...grpc.CallOption) (*api.ObjectURL, error) {
+	if req.Package != "a/pkg" {
+		return nil, status.Errorf(codes.NotFound, "no such package")
+	}
+	return u.signedURL(req.Instance)
+}
+
+func TestProxyServer(t *testing.T) {
+	t.Parallel()
+
+	Convey("With proxy", t, func() {
+		ctx := context.Background()
+
+		up := newUpstream()
+		defer up.storage.Close()
+
+		c, err := cache.New(cache.Policies{MaxSize: 1 << 30}, t.TempDir(), crypto.SHA256)
+		So(err, ShouldBeNil)
+		defer c.Close()
+
+		proxy := &Server{
+			Repository: up,
+			Cache:      c,
+			TempDir:    t.TempDir(),
+		}
+		r := router.New()
+		proxy.InstallHandlers(r, router.NewMiddlewareChain())
+		ts := httptest.NewServer(r)
+		defer ts.Close()
+		proxy.URL = ts.URL
+
+		repo := &repoServer{s: proxy}
+
+		fetch := func(url string) (int, string) {
+			resp, err := http.Get(url)
+			So(err, ShouldBeNil)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			So(err, ShouldBeNil)
+			return resp.StatusCode, string(body)
+		}
+
+		for _, algo := range []api.HashAlgo{api.HashAlgo_SHA256, api.HashAlgo_SHA1, api.HashAlgo_BLAKE3} {
+			algo := algo
+			Convey(fmt.Sprintf("GetInstanceURL: cached %s", algo), func() {
+				ref := up.put(algo, "instance body")
+
+				objURL, err := repo.GetInstanceURL(ctx, &api.GetInstanceURLRequest{
+					Package:  "a/pkg",
+					Instance: ref,
+				})
+				So(err, ShouldBeNil)
+				So(objURL.SignedUrl, ShouldEqual, ts.URL+"/_cipd_proxy/fetch/"+common.ObjectRefToInstanceID(ref)+"?package=a%2Fpkg")
+
+				for i := 0; i < 3; i++ {
+					code, body := fetch(objURL.SignedUrl)
+					So(code, ShouldEqual, http.StatusOK)
+					So(body, ShouldEqual, "instance body")
+				}
+				So(up.fetchCount(ref), ShouldEqual, 1)
+				So(c.Keys(), ShouldHaveLength, 1)
+			})
+		}
+
+		Convey("GetInstanceURL: unknown algo", func() {
+			ref := &api.ObjectRef{HashAlgo: 100, HexDigest: strings.Repeat("a", 64)}
+			up.putRef(ref, "instance body")
+
+			objURL, err := repo.GetInstanceURL(ctx, &api.GetInstanceURLRequest{
+				Package:  "a/pkg",
+				Instance: ref,
+			})
+			So(err, ShouldBeNil)
+
+			for i := 0; i < 2; i++ {
+				code, body := fetch(objURL.SignedUrl)
+				So(code, ShouldEqual, http.StatusOK)
+				So(body, ShouldEqual, "instance body")
+			}
+			So(up.fetchCount(ref), ShouldEqual, 2)
+			So(c.Keys(), ShouldHaveLength, 0)
+		})
+
+		Convey("GetInstanceURL: missing", func() {
+			ref := up.put(api.HashAlgo_SHA256, "instance body")
+			_, err := repo.GetInstanceURL(ctx, &api.GetInstanceURLRequest{
+				Package:  "another/pkg",
+				Instance: ref,
+			})
+			So(status.Code(err), ShouldEqual, codes.NotFound)
+		})
+
+		Convey("Fetch without a package", func() {
+			ref := up.put(api.HashAlgo_SHA256, "instance body")
+			code, body := fetch(ts.URL + "/_cipd_proxy/fetch/" + common.ObjectRefToInstanceID(ref))
+			So(code, ShouldEqual, http.StatusBadRequest)
+			So(body, ShouldContainSubstring, "the package name is required")
+			So(up.fetchCount(ref), ShouldEqual, 0)
+		})
+
+		Convey("Fetch of a cached instance checks the package", func() {
+			ref := up.put(api.HashAlgo_SHA256, "instance body")
+
+			code, _ := fetch(proxy.fetchURL(ref, "a/pkg"))
+			So(code, ShouldEqual, http.StatusOK)
+			So(c.Keys(), ShouldHaveLength, 1)
+
+			code, _ = fetch(proxy.fetchURL(ref, "another/pkg"))
+			So(code, ShouldEqual, http.StatusNotFound)
+		})
+
+		for _, algo := range []api.HashAlgo{api.HashAlgo_SHA256, api.HashAlgo_BLAKE3} {
+			algo := algo
+			Convey(fmt.Sprintf("Corrupted upstream data %s", algo), func() {
+				ref := up.put(algo, "instance body")
+				up.objects[common.ObjectRefToInstanceID(ref)] = "something else"
+
+				code, body := fetch(proxy.fetchURL(ref, "a/pkg"))
+				So(code, ShouldEqual, http.StatusInternalServerError)
+				So(body, ShouldContainSubstring, "fetched corrupted data")
+				So(c.Keys(), ShouldHaveLength, 0)
+			})
+		}
+
+		Convey("Bad instance ID", func() {
+			code, _ := fetch(ts.URL + "/_cipd_proxy/fetch/zzz")
+			So(code, ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey("Upstream errors", func() {
+			ref := up.put(api.HashAlgo_SHA256, "instance body")
+			code, _ := fetch(proxy.fetchURL(ref, "another/pkg"))
+			So(code, ShouldEqual, http.StatusNotFound)
+		})
+	})
+}
@@ -31,6 +31,7 @@ import (
 
 	"github.com/klauspost/compress/flate"
 	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
@@ -506,6 +507,7 @@ func (inst *packageInstance) open(opts OpenInstanceOpts) error {
 	if inst.zip, err = zip.NewReader(inst.data, inst.data.Size()); err != nil {
 		return errors.Annotate(err, "reading instance file zip header").Tag(cipderr.IO).Err()
 	}
+	inst.zip.RegisterDecompressor(zstd.ZipMethodWinZip, zstd.ZipDecompressor())
 	inst.files = make([]fs.File, len(inst.zip.File))
 	for i, zf := range inst.zip.File {
 		fiz := &fileInZip{z: zf}
@@ -521,6 +523,12 @@ func (inst *packageInstance) open(opts OpenInstanceOpts) error {
 		inst.files[i] = fiz
 	}
 
+	// Refuse to install packages built in a format we don't understand (e.g.
+	// using a compression method we don't know about).
+	if err := pkg.ValidateFormatVersion(inst.manifest.FormatVersion); err != nil {
+		return err
+	}
+
 	// Version "1" (legacy format) used to set the writable mode bit (0200) in
 	// zipped files, and then ignored it when unpacking. Newer versions respect
 	// the writable mode bit. Strip it off for the version "1", to preserve
@@ -576,7 +584,8 @@ func (inst *packageInstance) Close(ctx context.Context, corrupt bool) error {
 func IsCorruptionError(err error) bool {
 	return errors.Any(err, func(err error) bool {
 		switch err {
-		case io.ErrUnexpectedEOF, zip.ErrFormat, zip.ErrChecksum, zip.ErrAlgorithm, ErrHashMismatch:
+		case io.ErrUnexpectedEOF, zip.ErrFormat, zip.ErrChecksum, zip.ErrAlgorithm, ErrHashMismatch,
+			zstd.ErrMagicMismatch, zstd.ErrCRCMismatch:
 			return true
 		default:
 			_, flateCorrupt := err.(flate.CorruptInputError)
@@ -34,6 +34,7 @@ import (
 
 	. "github.com/smartystreets/goconvey/convey"
 	. "go.chromium.org/luci/cipd/common"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
 func stringCounts(values []string) map[string]int {
@@ -383,6 +384,53 @@ func TestPackageReading(t *testing.T) {
 			shouldBeSameJSONDict, goodManifest)
 	})
 
+	Convey("ExtractFiles handles zstd packages", t, func() {
+		out := bytes.Buffer{}
+		_, err := builder.BuildInstance(ctx, builder.Options{
+			Input: []fs.File{
+				fs.NewTestFile("testing/qwerty", "12345", fs.TestFileOpts{}),
+				fs.NewTestFile("abc", "duh", fs.TestFileOpts{Executable: true}),
+				fs.NewTestSymlink("rel_symlink", "abc"),
+			},
+			Output:           &out,
+			PackageName:      "testing",
+			CompressionLevel: 5,
+			Compression:      pkg.CompressionZstd,
+		})
+		So(err, ShouldBeNil)
+
+		inst, err := OpenInstance(ctx, bytesFile(&out), OpenInstanceOpts{
+			VerificationMode: CalculateHash,
+			HashAlgo:         api.HashAlgo_SHA256,
+		})
+		So(err, ShouldBeNil)
+		defer inst.Close(ctx, false)
+
+		dest := &testDestination{}
+		_, err = ExtractFilesTxn(ctx, inst.Files(), dest, 16, pkg.WithManifest, "")
+		So(err, ShouldBeNil)
+
+		So(string(dest.fileByName("testing/qwerty").Bytes()), ShouldEqual, "12345")
+		So(string(dest.fileByName("abc").Bytes()), ShouldEqual, "duh")
+		So(dest.fileByName("rel_symlink").symlinkTarget, ShouldEqual, "abc")
+	})
+
+	Convey("OpenInstance rejects unknown format versions", t, func() {
+		out := bytes.Buffer{}
+		_, err := builder.BuildInstance(ctx, builder.Options{
+			Output:                &out,
+			PackageName:           "testing",
+			OverrideFormatVersion: "999",
+		})
+		So(err, ShouldBeNil)
+
+		_, err = OpenInstance(ctx, bytesFile(&out), OpenInstanceOpts{
+			VerificationMode: CalculateHash,
+			HashAlgo:         api.HashAlgo_SHA256,
+		})
+		So(err, ShouldErrLike, "unsupported package format version")
+	})
+
 	Convey("ExtractFiles handles v1 packages correctly", t, func() {
 		// ZipInfos in packages with format_version "1" always have the writable bit
 		// set, and always have 0 timestamp. During the extraction of such package,
@@ -203,6 +203,38 @@ func TestResolveAllPlatforms(t *testing.T) {
 		})
 	})
 
+	Convey("Conditional blocks", t, func() {
+		file, err := ensure.ParseFile(strings.NewReader(`
+			$VerifiedPlatform windows-amd64 linux-amd64
+			pkg1/${platform} latest
+			@If platform=linux-*
+			pkg2/${platform} latest
+			@End
+		`))
+		So(err, ShouldBeNil)
+
+		mc := newMockedClient()
+		mc.mockResolve("pkg1/windows-amd64", "latest", iid1)
+		mc.mockResolve("pkg1/linux-amd64", "latest", iid2)
+		mc.mockResolve("pkg2/linux-amd64", "latest", iid4)
+
+		r := Resolver{Client: mc}
+
+		res, err := r.ResolveAllPlatforms(ctx, file)
+		So(err, ShouldBeNil)
+		So(res[template.Platform{"linux", "amd64"}].PackagesBySubdir, ShouldResemble, common.PinSliceBySubdir{
+			"": common.PinSlice{
+				{"pkg1/linux-amd64", iid2},
+				{"pkg2/linux-amd64", iid4},
+			},
+		})
+		So(res[template.Platform{"windows", "amd64"}].PackagesBySubdir, ShouldResemble, common.PinSliceBySubdir{
+			"": common.PinSlice{
+				{"pkg1/windows-amd64", iid1},
+			},
+		})
+	})
+
 	Convey("Unhappy path", t, func() {
 		mc := newMockedClient()
 		r := Resolver{Client: mc}
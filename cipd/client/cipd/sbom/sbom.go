@@ -0,0 +1,268 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbom generates a software bill of materials for a site root.
+//
+// The output is a CycloneDX 1.5 JSON document. Each deployed package becomes
+// a top-level component with CIPD-specific details (instance ID, tags, refs,
+// metadata) stored as properties. Files of the package are nested components
+// with their hashes taken from the package manifest.
+//
+// See https://cyclonedx.org/docs/1.5/json/.
+package sbom
+
+import (
+	"context"
+	"encoding/base64"
+	"path"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"go.chromium.org/luci/common/clock"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/client/cipd"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// Property names used in the generated document.
+const (
+	PropServiceURL  = "cipd:service_url"
+	PropSiteRoot    = "cipd:site_root"
+	PropSubdir      = "cipd:subdir"
+	PropInstanceID  = "cipd:instance_id"
+	PropTag         = "cipd:tag"
+	PropRef         = "cipd:ref"
+	PropMetadata    = "cipd:metadata:"        // followed by the metadata key
+	PropMetadataB64 = "cipd:metadata_base64:" // followed by the metadata key
+	PropExecutable  = "cipd:executable"
+	PropSymlink     = "cipd:symlink"
+)
+
+// Document is a CycloneDX BOM document.
+type Document struct {
+	BOMFormat    string      `json:"bomFormat"`
+	SpecVersion  string      `json:"specVersion"`
+	SerialNumber string      `json:"serialNumber,omitempty"`
+	Version      int         `json:"version"`
+	Metadata     Metadata    `json:"metadata"`
+	Components   []Component `json:"components"`
+}
+
+// Metadata describes the BOM itself.
+type Metadata struct {
+	Timestamp  string     `json:"timestamp"`
+	Tools      []Tool     `json:"tools,omitempty"`
+	Properties []Property `json:"properties,omitempty"`
+}
+
+// Tool is a tool that produced the BOM.
+type Tool struct {
+	Vendor  string `json:"vendor,omitempty"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// Component is a CycloneDX component: a package or a file.
+type Component struct {
+	Type       string      `json:"type"`
+	BOMRef     string      `json:"bom-ref,omitempty"`
+	Name       string      `json:"name"`
+	Version    string      `json:"version,omitempty"`
+	Hashes     []Hash      `json:"hashes,omitempty"`
+	Properties []Property  `json:"properties,omitempty"`
+	Components []Component `json:"components,omitempty"`
+}
+
+// Hash is a hash of a component.
+type Hash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+// Property is a name-value pair with some extra information.
+type Property struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Package is a deployed package to put into the BOM.
+type Package struct {
+	// Subdir is a subdirectory of the site root the package is installed to.
+	Subdir string
+	// Pin is the deployed pin.
+	Pin common.Pin
+	// Description is the instance description from the backend, if available.
+	Description *cipd.InstanceDescription
+	// Manifest is the manifest of the deployed instance, if available.
+	Manifest *pkg.Manifest
+}
+
+// Options are passed to Generate.
+type Options struct {
+	// SiteRoot is an absolute path to the site root being described.
+	SiteRoot string
+	// ServiceURL is the URL of the CIPD backend the packages came from.
+	ServiceURL string
+	// Packages is a list of deployed packages.
+	Packages []Package
+}
+
+// Generate produces a BOM document describing the packages.
+//
+// Packages are sorted by subdir and package name, files by their names.
+func Generate(ctx context.Context, opts Options) *Document {
+	doc := &Document{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + uuid.New().String(),
+		Version:      1,
+		Metadata: Metadata{
+			Timestamp: clock.Now(ctx).UTC().Format(time.RFC3339),
+			Tools: []Tool{{
+				Vendor:  "LUCI",
+				Name:    "cipd",
+				Version: strings.TrimPrefix(cipd.UserAgent, "cipd "),
+			}},
+		},
+		Components: make([]Component, 0, len(opts.Packages)),
+	}
+	if opts.ServiceURL != "" {
+		doc.Metadata.Properties = append(doc.Metadata.Properties, Property{PropServiceURL, opts.ServiceURL})
+	}
+	if opts.SiteRoot != "" {
+		doc.Metadata.Properties = append(doc.Metadata.Properties, Property{PropSiteRoot, opts.SiteRoot})
+	}
+
+	pkgs := append([]Package(nil), opts.Packages...)
+	sort.Slice(pkgs, func(i, j int) bool {
+		if pkgs[i].Subdir != pkgs[j].Subdir {
+			return pkgs[i].Subdir < pkgs[j].Subdir
+		}
+		return pkgs[i].Pin.PackageName < pkgs[j].Pin.PackageName
+	})
+	for _, p := range pkgs {
+		doc.Components = append(doc.Components, packageComponent(p))
+	}
+	return doc
+}
+
+// packageComponent converts a deployed package into a BOM component.
+func packageComponent(p Package) Component {
+	bomRef := p.Pin.String()
+	if p.Subdir != "" {
+		bomRef = p.Subdir + "/" + bomRef
+	}
+
+	c := Component{
+		Type:    "application",
+		BOMRef:  bomRef,
+		Name:    p.Pin.PackageName,
+		Version: p.Pin.InstanceID,
+	}
+	if h, ok := instanceIDHash(p.Pin.InstanceID); ok {
+		c.Hashes = []Hash{h}
+	}
+
+	c.Properties = append(c.Properties, Property{PropInstanceID, p.Pin.InstanceID})
+	if p.Subdir != "" {
+		c.Properties = append(c.Properties, Property{PropSubdir, p.Subdir})
+	}
+
+	if d := p.Description; d != nil {
+		for _, t := range d.Tags {
+			c.Properties = append(c.Properties, Property{PropTag, t.Tag})
+		}
+		for _, r := range d.Refs {
+			c.Properties = append(c.Properties, Property{PropRef, r.Ref})
+		}
+		for _, md := range d.Metadata {
+			c.Properties = append(c.Properties, metadataProperty(md))
+		}
+	}
+
+	if p.Manifest != nil {
+		files := append([]pkg.FileInfo(nil), p.Manifest.Files...)
+		sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+		for _, f := range files {
+			c.Components = append(c.Components, fileComponent(bomRef, p.Subdir, f))
+		}
+	}
+
+	return c
+}
+
+// fileComponent converts a manifest entry into a BOM component.
+func fileComponent(pkgRef, subdir string, f pkg.FileInfo) Component {
+	name := f.Name
+	if subdir != "" {
+		name = path.Join(subdir, f.Name)
+	}
+	c := Component{
+		Type:   "file",
+		BOMRef: pkgRef + "#" + f.Name,
+		Name:   name,
+	}
+	if h, ok := instanceIDHash(f.Hash); ok {
+		c.Hashes = []Hash{h}
+	}
+	if f.Executable {
+		c.Properties = append(c.Properties, Property{PropExecutable, "true"})
+	}
+	if f.Symlink != "" {
+		c.Properties = append(c.Properties, Property{PropSymlink, f.Symlink})
+	}
+	return c
+}
+
+// metadataProperty converts a metadata entry into a BOM property.
+//
+// Text values are stored as is, everything else is base64-encoded.
+func metadataProperty(md cipd.MetadataInfo) Property {
+	ct := md.ContentType
+	isText := strings.HasPrefix(ct, "text/") || ct == "application/json"
+	if (ct == "" || isText) && utf8.Valid(md.Value) {
+		return Property{PropMetadata + md.Key, string(md.Value)}
+	}
+	return Property{PropMetadataB64 + md.Key, base64.StdEncoding.EncodeToString(md.Value)}
+}
+
+// instanceIDHash converts an instance ID (or a file hash in the same format)
+// into a CycloneDX hash.
+//
+// Returns false if the hash is empty, malformed or uses an algorithm CycloneDX
+// doesn't know about.
+func instanceIDHash(iid string) (Hash, bool) {
+	if iid == "" || common.ValidateInstanceID(iid, common.KnownHash) != nil {
+		return Hash{}, false
+	}
+	ref := common.InstanceIDToObjectRef(iid)
+	var alg string
+	switch ref.HashAlgo {
+	case api.HashAlgo_SHA1:
+		alg = "SHA-1"
+	case api.HashAlgo_SHA256:
+		alg = "SHA-256"
+	case api.HashAlgo_BLAKE3:
+		alg = "BLAKE3"
+	default:
+		return Hash{}, false
+	}
+	return Hash{Alg: alg, Content: ref.HexDigest}, true
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/client/cipd"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	iid := func(algo api.HashAlgo, letter string) string {
+		return common.ObjectRefToInstanceID(&api.ObjectRef{
+			HashAlgo:  algo,
+			HexDigest: strings.Repeat(letter, common.MustNewHash(algo).Size()*2),
+		})
+	}
+
+	Convey("Works", t, func() {
+		ctx, _ := testclock.UseTime(context.Background(), time.Date(2023, time.January, 2, 3, 4, 5, 0, time.UTC))
+
+		doc := Generate(ctx, Options{
+			SiteRoot:   "/site/root",
+			ServiceURL: "https://cipd.example.com",
+			Packages: []Package{
+				{
+					Subdir: "sub",
+					Pin:    common.Pin{PackageName: "z/pkg", InstanceID: iid(api.HashAlgo_SHA256, "a")},
+				},
+				{
+					Pin: common.Pin{PackageName: "a/pkg", InstanceID: iid(api.HashAlgo_SHA1, "b")},
+					Description: &cipd.InstanceDescription{
+						Tags: []cipd.TagInfo{{Tag: "version:1"}},
+						Refs: []cipd.RefInfo{{Ref: "latest"}},
+						Metadata: []cipd.MetadataInfo{
+							{Key: "text", Value: []byte("hello"), ContentType: "text/plain"},
+							{Key: "bin", Value: []byte{0, 1, 2}, ContentType: "application/octet-stream"},
+						},
+					},
+					Manifest: &pkg.Manifest{
+						Files: []pkg.FileInfo{
+							{Name: "z", Hash: iid(api.HashAlgo_BLAKE3, "c"), Executable: true},
+							{Name: "a", Symlink: "z"},
+						},
+					},
+				},
+			},
+		})
+
+		So(doc.BOMFormat, ShouldEqual, "CycloneDX")
+		So(doc.SerialNumber, ShouldStartWith, "urn:uuid:")
+		So(doc.Metadata.Timestamp, ShouldEqual, "2023-01-02T03:04:05Z")
+		So(doc.Metadata.Properties, ShouldResemble, []Property{
+			{PropServiceURL, "https://cipd.example.com"},
+			{PropSiteRoot, "/site/root"},
+		})
+
+		So(doc.Components, ShouldResemble, []Component{
+			{
+				Type:    "application",
+				BOMRef:  "a/pkg:" + iid(api.HashAlgo_SHA1, "b"),
+				Name:    "a/pkg",
+				Version: iid(api.HashAlgo_SHA1, "b"),
+				Hashes:  []Hash{{"SHA-1", strings.Repeat("b", 40)}},
+				Properties: []Property{
+					{PropInstanceID, iid(api.HashAlgo_SHA1, "b")},
+					{PropTag, "version:1"},
+					{PropRef, "latest"},
+					{PropMetadata + "text", "hello"},
+					{PropMetadataB64 + "bin", "AAEC"},
+				},
+				Components: []Component{
+					{
+						Type:       "file",
+						BOMRef:     "a/pkg:" + iid(api.HashAlgo_SHA1, "b") + "#a",
+						Name:       "a",
+						Properties: []Property{{PropSymlink, "z"}},
+					},
+					{
+						Type:       "file",
+						BOMRef:     "a/pkg:" + iid(api.HashAlgo_SHA1, "b") + "#z",
+						Name:       "z",
+						Hashes:     []Hash{{"BLAKE3", strings.Repeat("c", 64)}},
+						Properties: []Property{{PropExecutable, "true"}},
+					},
+				},
+			},
+			{
+				Type:    "application",
+				BOMRef:  "sub/z/pkg:" + iid(api.HashAlgo_SHA256, "a"),
+				Name:    "z/pkg",
+				Version: iid(api.HashAlgo_SHA256, "a"),
+				Hashes:  []Hash{{"SHA-256", strings.Repeat("a", 64)}},
+				Properties: []Property{
+					{PropInstanceID, iid(api.HashAlgo_SHA256, "a")},
+					{PropSubdir, "sub"},
+				},
+			},
+		})
+
+		// Serializes to JSON fine.
+		_, err := json.Marshal(doc)
+		So(err, ShouldBeNil)
+	})
+}
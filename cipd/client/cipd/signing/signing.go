@@ -0,0 +1,173 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signing implements signing of package instances and verification of
+// such signatures.
+//
+// A signature is an ed25519 signature of a statement that identifies a package
+// instance (its package name and instance ID). It is stored as an instance
+// metadata entry with MetadataKey key, JSON-serialized as Signature struct.
+// An instance can have multiple signatures (e.g. by different keys).
+//
+// Public keys are represented by strings "ed25519:<base64 std encoding>". This
+// is the format used by $RequireSignedBy ensure file directive.
+//
+// Private keys are stored in PEM-encoded PKCS #8 files, e.g. as generated by
+// `openssl genpkey -algorithm ed25519`.
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/cipd/common/cipderr"
+)
+
+const (
+	// MetadataKey is an instance metadata key used to store signatures.
+	MetadataKey = "cipd_signature"
+
+	// ContentType is a content type of signature metadata entries.
+	ContentType = "application/vnd.cipd.signature+json"
+
+	// keyPrefix is a prefix of the string representation of public keys.
+	keyPrefix = "ed25519:"
+
+	// payloadVersion is mixed into signed payloads to allow changing them.
+	payloadVersion = "cipd-instance-signature/v1"
+)
+
+// Signature is a body of a signature metadata entry.
+type Signature struct {
+	// Key is a public key the signature can be verified with.
+	//
+	// Formatted by FormatPublicKey.
+	Key string `json:"key"`
+
+	// Sig is an ed25519 signature of Payload(pin).
+	Sig []byte `json:"sig"`
+}
+
+// Payload returns a statement about the pin that is signed.
+func Payload(pin common.Pin) []byte {
+	return []byte(fmt.Sprintf("%s\n%s\n%s\n", payloadVersion, pin.PackageName, pin.InstanceID))
+}
+
+// ParsePublicKey parses a public key given as "ed25519:<base64>" string.
+func ParsePublicKey(key string) (ed25519.PublicKey, error) {
+	if !strings.HasPrefix(key, keyPrefix) {
+		return nil, errors.Reason("bad public key %q: should start with %q", key, keyPrefix).Tag(cipderr.BadArgument).Err()
+	}
+	blob, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(key, keyPrefix))
+	if err != nil {
+		return nil, errors.Annotate(err, "bad public key %q", key).Tag(cipderr.BadArgument).Err()
+	}
+	if len(blob) != ed25519.PublicKeySize {
+		return nil, errors.Reason("bad public key %q: expecting %d bytes, got %d", key, ed25519.PublicKeySize, len(blob)).Tag(cipderr.BadArgument).Err()
+	}
+	return ed25519.PublicKey(blob), nil
+}
+
+// FormatPublicKey is a reverse of ParsePublicKey.
+func FormatPublicKey(key ed25519.PublicKey) string {
+	return keyPrefix + base64.StdEncoding.EncodeToString(key)
+}
+
+// LoadPrivateKey loads an ed25519 private key from a PEM-encoded PKCS #8 file.
+func LoadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Annotate(err, "missing signing key file").Tag(cipderr.BadArgument).Err()
+		}
+		return nil, errors.Annotate(err, "reading signing key file").Tag(cipderr.IO).Err()
+	}
+	block, _ := pem.Decode(blob)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, errors.Reason("signing key file %q should contain a PEM-encoded PRIVATE KEY", path).Tag(cipderr.BadArgument).Err()
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad signing key in %q", path).Tag(cipderr.BadArgument).Err()
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.Reason("signing key in %q is %T, not an ed25519 key", path, key).Tag(cipderr.BadArgument).Err()
+	}
+	return edKey, nil
+}
+
+// Sign signs the pin and returns a serialized Signature to store in
+// the instance metadata.
+func Sign(pin common.Pin, key ed25519.PrivateKey) []byte {
+	blob, err := json.Marshal(&Signature{
+		Key: FormatPublicKey(key.Public().(ed25519.PublicKey)),
+		Sig: ed25519.Sign(key, Payload(pin)),
+	})
+	if err != nil {
+		panic(err) // impossible
+	}
+	return blob
+}
+
+// Verify checks at least one of the given serialized signatures is a valid
+// signature of the pin by one of the trusted keys.
+//
+// Signatures by unknown keys and bad signatures are skipped, so a bad
+// signature doesn't cancel out a good one. Returns an error tagged with
+// cipderr.BadSignature if there's no valid signature.
+func Verify(pin common.Pin, sigs [][]byte, trusted []ed25519.PublicKey) error {
+	if len(sigs) == 0 {
+		return errors.Reason("%s is not signed", pin).Tag(cipderr.BadSignature).Err()
+	}
+
+	trustedKeys := make(map[string]ed25519.PublicKey, len(trusted))
+	for _, key := range trusted {
+		trustedKeys[FormatPublicKey(key)] = key
+	}
+
+	payload := Payload(pin)
+	var badSig error
+	for _, blob := range sigs {
+		var sig Signature
+		if err := json.Unmarshal(blob, &sig); err != nil {
+			continue // not a signature we understand, skip
+		}
+		key := trustedKeys[sig.Key]
+		if key == nil {
+			continue
+		}
+		if !ed25519.Verify(key, payload, sig.Sig) {
+			if badSig == nil {
+				badSig = errors.Reason("%s has a bad signature by %s", pin, sig.Key).Tag(cipderr.BadSignature).Err()
+			}
+			continue
+		}
+		return nil
+	}
+
+	if badSig != nil {
+		return badSig
+	}
+	return errors.Reason("%s is not signed by any of trusted keys", pin).Tag(cipderr.BadSignature).Err()
+}
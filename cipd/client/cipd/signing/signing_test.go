@@ -0,0 +1,132 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/cipd/common/cipderr"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestSigning(t *testing.T) {
+	t.Parallel()
+
+	pin := common.Pin{
+		PackageName: "a/b/c",
+		InstanceID:  "qUiQTy8PR5uPgZdpSzAYSw0u0cHNKh7A-4XSmaGSpEcC",
+	}
+
+	Convey("Public keys", t, func() {
+		pub, _, _ := ed25519.GenerateKey(rand.Reader)
+		str := FormatPublicKey(pub)
+		So(str, ShouldStartWith, "ed25519:")
+
+		parsed, err := ParsePublicKey(str)
+		So(err, ShouldBeNil)
+		So(parsed, ShouldResemble, pub)
+
+		_, err = ParsePublicKey("rsa:AAAA")
+		So(err, ShouldErrLike, "should start with")
+		_, err = ParsePublicKey("ed25519:???")
+		So(err, ShouldErrLike, "bad public key")
+		_, err = ParsePublicKey("ed25519:AAAA")
+		So(err, ShouldErrLike, "expecting 32 bytes, got 3")
+	})
+
+	Convey("Loading private keys", t, func() {
+		tmp := t.TempDir()
+
+		_, priv, _ := ed25519.GenerateKey(rand.Reader)
+		der, err := x509.MarshalPKCS8PrivateKey(priv)
+		So(err, ShouldBeNil)
+
+		path := filepath.Join(tmp, "key.pem")
+		So(os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600), ShouldBeNil)
+
+		loaded, err := LoadPrivateKey(path)
+		So(err, ShouldBeNil)
+		So(loaded, ShouldResemble, priv)
+
+		So(os.WriteFile(path, []byte("garbage"), 0600), ShouldBeNil)
+		_, err = LoadPrivateKey(path)
+		So(err, ShouldErrLike, "should contain a PEM-encoded PRIVATE KEY")
+
+		_, err = LoadPrivateKey(filepath.Join(tmp, "missing.pem"))
+		So(err, ShouldErrLike, "missing signing key file")
+	})
+
+	Convey("Sign and verify", t, func() {
+		pub1, priv1, _ := ed25519.GenerateKey(rand.Reader)
+		pub2, priv2, _ := ed25519.GenerateKey(rand.Reader)
+
+		sig1 := Sign(pin, priv1)
+		sig2 := Sign(pin, priv2)
+
+		Convey("OK", func() {
+			So(Verify(pin, [][]byte{sig1}, []ed25519.PublicKey{pub1}), ShouldBeNil)
+			So(Verify(pin, [][]byte{sig1, sig2}, []ed25519.PublicKey{pub2}), ShouldBeNil)
+			So(Verify(pin, [][]byte{[]byte("garbage"), sig2}, []ed25519.PublicKey{pub1, pub2}), ShouldBeNil)
+		})
+
+		Convey("Unsigned", func() {
+			err := Verify(pin, nil, []ed25519.PublicKey{pub1})
+			So(err, ShouldErrLike, "is not signed")
+			So(cipderr.ToCode(err), ShouldEqual, cipderr.BadSignature)
+		})
+
+		Convey("Signed by an untrusted key", func() {
+			err := Verify(pin, [][]byte{sig2}, []ed25519.PublicKey{pub1})
+			So(err, ShouldErrLike, "is not signed by any of trusted keys")
+			So(cipderr.ToCode(err), ShouldEqual, cipderr.BadSignature)
+		})
+
+		Convey("Signature of another instance", func() {
+			another := pin
+			another.InstanceID = "B7r75joOfFfFcq7fHCKAIrU34oeFAT174Bf8eHMajMUC"
+			err := Verify(another, [][]byte{sig1}, []ed25519.PublicKey{pub1})
+			So(err, ShouldErrLike, "has a bad signature by "+FormatPublicKey(pub1))
+			So(cipderr.ToCode(err), ShouldEqual, cipderr.BadSignature)
+		})
+
+		Convey("Forged signature", func() {
+			var sig Signature
+			So(json.Unmarshal(sig2, &sig), ShouldBeNil)
+			sig.Key = FormatPublicKey(pub1) // pretend it was signed by the trusted key
+			forged, _ := json.Marshal(&sig)
+			err := Verify(pin, [][]byte{forged}, []ed25519.PublicKey{pub1})
+			So(err, ShouldErrLike, "has a bad signature")
+		})
+
+		Convey("Bad and good signatures", func() {
+			var sig Signature
+			So(json.Unmarshal(sig2, &sig), ShouldBeNil)
+			sig.Key = FormatPublicKey(pub1)
+			forged, _ := json.Marshal(&sig)
+			So(Verify(pin, [][]byte{forged, sig1}, []ed25519.PublicKey{pub1}), ShouldBeNil)
+			So(Verify(pin, [][]byte{forged, sig2}, []ed25519.PublicKey{pub1, pub2}), ShouldBeNil)
+		})
+	})
+}
@@ -21,6 +21,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/net/context/ctxhttp"
@@ -30,6 +33,7 @@ import (
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/retry/transient"
 
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
 	"go.chromium.org/luci/cipd/client/cipd/ui"
 	"go.chromium.org/luci/cipd/common/cipderr"
 )
@@ -41,8 +45,16 @@ const (
 	uploadMaxErrors = 20
 	// downloadMaxAttempts is how many times to retry a download on errors.
 	downloadMaxAttempts = 10
+	// rangedBlockSize is the size of a single ranged read request.
+	rangedBlockSize int64 = 1024 * 1024
+	// rangedCachedBlocks is how many blocks fetched via ranged reads to keep.
+	rangedCachedBlocks = 32
 )
 
+// errNoRangedReads is returned by openRanged if the storage doesn't support
+// ranged reads.
+var errNoRangedReads = errors.New("the storage doesn't support ranged reads", cipderr.CAS)
+
 func isTemporaryNetError(err error) bool {
 	// net/http.Client seems to be wrapping errors into *url.Error. Unwrap if so.
 	if uerr, ok := err.(*url.Error); ok {
@@ -70,6 +82,7 @@ func isTemporaryHTTPError(statusCode int) bool {
 type storage interface {
 	upload(ctx context.Context, url string, data io.ReadSeeker) error
 	download(ctx context.Context, url string, output io.WriteSeeker, h hash.Hash) error
+	openRanged(ctx context.Context, url string) (pkg.Source, error)
 }
 
 // storageImpl implements 'storage' via Google Storage signed URLs.
@@ -324,6 +337,164 @@ func (s *storageImpl) download(ctx context.Context, url string, output io.WriteS
 	return errors.Reason("failed to download after multiple attempts").Tag(cipderr.CAS).Err()
 }
 
+// openRanged returns a pkg.Source that fetches parts of the file on demand
+// using HTTP range requests.
+//
+// Returns errNoRangedReads if the storage doesn't support range requests.
+// The context is used for all future reads from the returned source.
+func (s *storageImpl) openRanged(ctx context.Context, url string) (pkg.Source, error) {
+	// Fetch the first byte to discover the total size and to check the storage
+	// understands range requests at all.
+	_, total, err := s.fetchRange(ctx, url, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	return &rangedSource{
+		ctx:     ctx,
+		storage: s,
+		url:     url,
+		size:    total,
+		blocks:  make(map[int64][]byte, rangedCachedBlocks),
+	}, nil
+}
+
+// fetchRange fetches 'length' bytes at the given offset.
+//
+// Returns the fetched data and the total size of the file.
+func (s *storageImpl) fetchRange(ctx context.Context, url string, offset, length int64) (data []byte, total int64, err error) {
+	for attempt := 0; attempt < downloadMaxAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, 0, errors.Annotate(err, "initializing GET request").Tag(cipderr.CAS).Err()
+		}
+		req.Header.Set("User-Agent", s.userAgent)
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+		resp, err := ctxhttp.Do(ctx, s.client, req)
+		if err != nil {
+			if isTemporaryNetError(err) {
+				logging.Warningf(ctx, "Failed to connect: %s", err)
+				clock.Sleep(ctx, 2*time.Second)
+				continue
+			}
+			return nil, 0, errors.Annotate(err, "ranged read failed").Tag(cipderr.CAS).Err()
+		}
+
+		if isTemporaryHTTPError(resp.StatusCode) {
+			resp.Body.Close()
+			logging.Warningf(ctx, "Transient HTTP error %d", resp.StatusCode)
+			clock.Sleep(ctx, 2*time.Second)
+			continue
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusOK:
+			// The storage ignored the Range header.
+			resp.Body.Close()
+			return nil, 0, errNoRangedReads
+		case resp.StatusCode != http.StatusPartialContent:
+			resp.Body.Close()
+			return nil, 0, errors.Reason("storage server replied with HTTP code %d", resp.StatusCode).Tag(cipderr.CAS).Err()
+		}
+
+		// Content-Range is "bytes <first>-<last>/<total>".
+		contentRange := resp.Header.Get("Content-Range")
+		if idx := strings.LastIndexByte(contentRange, '/'); idx != -1 {
+			total, err = strconv.ParseInt(contentRange[idx+1:], 10, 64)
+		}
+		if total <= 0 || err != nil {
+			resp.Body.Close()
+			return nil, 0, errNoRangedReads
+		}
+
+		data, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			logging.Warningf(ctx, "Transient error: %s", err)
+			continue
+		}
+		if int64(len(data)) != length {
+			return nil, 0, errors.Reason("ranged read returned %d bytes, expecting %d", len(data), length).Tag(cipderr.CAS).Err()
+		}
+		return data, total, nil
+	}
+
+	return nil, 0, errors.Reason("failed to fetch the range after multiple attempts").Tag(cipderr.CAS).Err()
+}
+
+// rangedSource implements pkg.Source by fetching aligned blocks of the file
+// via ranged reads, caching most recently fetched ones.
+type rangedSource struct {
+	ctx     context.Context
+	storage *storageImpl
+	url     string
+	size    int64
+
+	m      sync.Mutex
+	blocks map[int64][]byte // block index => its data
+	order  []int64          // indexes of cached blocks, oldest first
+}
+
+func (r *rangedSource) Size() int64 { return r.size }
+
+func (r *rangedSource) Close(ctx context.Context, corrupt bool) error { return nil }
+
+func (r *rangedSource) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errors.Reason("negative offset").Err()
+	}
+	for n < len(p) {
+		if off >= r.size {
+			return n, io.EOF
+		}
+		idx := off / rangedBlockSize
+		block, err := r.block(idx)
+		if err != nil {
+			return n, err
+		}
+		copied := copy(p[n:], block[off-idx*rangedBlockSize:])
+		n += copied
+		off += int64(copied)
+	}
+	return n, nil
+}
+
+// block returns the data of the block with the given index.
+func (r *rangedSource) block(idx int64) ([]byte, error) {
+	r.m.Lock()
+	block, ok := r.blocks[idx]
+	r.m.Unlock()
+	if ok {
+		return block, nil
+	}
+
+	// Note: concurrent readers may end up fetching the same block, this is fine.
+	offset := idx * rangedBlockSize
+	length := rangedBlockSize
+	if offset+length > r.size {
+		length = r.size - offset
+	}
+	block, _, err := r.storage.fetchRange(r.ctx, r.url, offset, length)
+	if err != nil {
+		return nil, err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	if _, ok := r.blocks[idx]; !ok {
+		r.blocks[idx] = block
+		r.order = append(r.order, idx)
+		if len(r.order) > rangedCachedBlocks {
+			delete(r.blocks, r.order[0])
+			r.order = r.order[1:]
+		}
+	}
+	return block, nil
+}
+
 // readerWithProgress is io.Reader that calls callback whenever something is
 // read from it.
 type readerWithProgress struct {
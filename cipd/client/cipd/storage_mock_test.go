@@ -22,6 +22,8 @@ import (
 	"sync/atomic"
 
 	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
 )
 
 // This file has no tests, but contains definition of a mock for 'storage'
@@ -96,3 +98,14 @@ func (s *mockedStorage) download(ctx context.Context, url string, output io.Writ
 	_, err := io.MultiWriter(output, h).Write([]byte(body))
 	return err
 }
+
+func (s *mockedStorage) openRanged(ctx context.Context, url string) (pkg.Source, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	body := s.getStored(url)
+	if body == "" {
+		return nil, errNoRangedReads
+	}
+	return pkg.NewBytesSource([]byte(body)), nil
+}
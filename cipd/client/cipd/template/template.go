@@ -20,6 +20,7 @@ package template
 
 import (
 	"fmt"
+	"path"
 	"regexp"
 	"strings"
 
@@ -122,6 +123,41 @@ func (t Expander) expandImpl(template string, alwaysFill bool) (pkg string, err
 	return
 }
 
+// Match evaluates a condition like "platform=linux-*,mac-*".
+//
+// The condition is a variable name and a comma-separated list of glob patterns
+// (see path.Match). It matches if the value of the variable matches any of the
+// patterns.
+//
+// Returns an error if the condition is malformed or refers to an unknown
+// variable.
+func (t Expander) Match(cond string) (bool, error) {
+	varName, patterns, ok := strings.Cut(cond, "=")
+	varName = strings.TrimSpace(varName)
+	if !ok || varName == "" {
+		return false, errors.Reason("condition must be <var>=<pattern>[,<pattern>...], got %q", cond).Tag(cipderr.BadArgument).Err()
+	}
+	ourValue, ok := t[varName]
+	if !ok {
+		return false, errors.Reason("unknown variable %q in condition %q", varName, cond).Tag(cipderr.BadArgument).Err()
+	}
+	matched := false
+	for _, pat := range strings.Split(patterns, ",") {
+		pat = strings.TrimSpace(pat)
+		if pat == "" {
+			return false, errors.Reason("empty pattern in condition %q", cond).Tag(cipderr.BadArgument).Err()
+		}
+		// Check all patterns to catch malformed ones even if some match.
+		switch yes, err := path.Match(pat, ourValue); {
+		case err != nil:
+			return false, errors.Reason("bad pattern %q in condition %q", pat, cond).Tag(cipderr.BadArgument).Err()
+		case yes:
+			matched = true
+		}
+	}
+	return matched, nil
+}
+
 // Platform contains the parameters for a "${platform}" template.
 //
 // The string value can be obtained by calling String().
@@ -75,3 +75,37 @@ func TestTemplateExpander(t *testing.T) {
 		}
 	})
 }
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	expander := Expander{
+		"os":       "linux",
+		"arch":     "amd64",
+		"platform": "linux-amd64",
+	}
+
+	Convey(`Match`, t, func() {
+		match := func(cond string) bool {
+			yes, err := expander.Match(cond)
+			So(err, ShouldBeNil)
+			return yes
+		}
+
+		So(match("os=linux"), ShouldBeTrue)
+		So(match("os=mac,linux"), ShouldBeTrue)
+		So(match("platform=linux-*"), ShouldBeTrue)
+		So(match("platform = mac-*, linux-*"), ShouldBeTrue)
+		So(match("platform=mac-*"), ShouldBeFalse)
+		So(match("arch=arm*"), ShouldBeFalse)
+
+		_, err := expander.Match("linux")
+		So(err, ShouldErrLike, "condition must be")
+		_, err = expander.Match("blah=linux")
+		So(err, ShouldErrLike, "unknown variable")
+		_, err = expander.Match("os=linux,")
+		So(err, ShouldErrLike, "empty pattern")
+		_, err = expander.Match("os=linux,[")
+		So(err, ShouldErrLike, "bad pattern")
+	})
+}
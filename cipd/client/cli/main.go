@@ -17,12 +17,16 @@ package cli
 import (
 	"bytes"
 	"context"
+	"crypto"
+	"crypto/ed25519"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"sort"
@@ -35,7 +39,9 @@ import (
 	"go.chromium.org/luci/auth"
 	"go.chromium.org/luci/client/versioncli"
 	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/data/caching/cache"
 	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/data/text/units"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/flag/fixflagpos"
 	"go.chromium.org/luci/common/logging"
@@ -44,18 +50,24 @@ import (
 	"go.chromium.org/luci/common/system/environ"
 	"go.chromium.org/luci/common/system/signals"
 	"go.chromium.org/luci/common/system/terminal"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/server/router"
 
 	"go.chromium.org/luci/auth/client/authcli"
 
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
 	"go.chromium.org/luci/cipd/client/cipd"
 	"go.chromium.org/luci/cipd/client/cipd/builder"
+	"go.chromium.org/luci/cipd/client/cipd/bundle"
 	"go.chromium.org/luci/cipd/client/cipd/deployer"
 	"go.chromium.org/luci/cipd/client/cipd/digests"
 	"go.chromium.org/luci/cipd/client/cipd/ensure"
 	"go.chromium.org/luci/cipd/client/cipd/fs"
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/client/cipd/proxyserver"
 	"go.chromium.org/luci/cipd/client/cipd/reader"
+	"go.chromium.org/luci/cipd/client/cipd/sbom"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/client/cipd/ui"
 	"go.chromium.org/luci/cipd/common"
@@ -396,6 +408,7 @@ type clientOptions struct {
 	maxThreads maxThreadsOption
 	rootDir    string              // used only if registerFlags got withRootDir arg
 	versions   ensure.VersionsFile // mutated by loadEnsureFile
+	bundle     *bundle.Reader      // set by 'ensure -from-bundle'
 
 	authFlags authcli.Flags
 }
@@ -443,6 +456,7 @@ func (opts *clientOptions) toCIPDClientOpts(ctx context.Context) (cipd.ClientOpt
 		Root:                opts.rootDir,
 		CacheDir:            opts.cacheDir,
 		Versions:            opts.versions,
+		Bundle:              opts.bundle,
 		AuthenticatedClient: client,
 		MaxThreads:          opts.maxThreads.maxThreads,
 		AnonymousClient:     http.DefaultClient,
@@ -501,10 +515,16 @@ type inputOptions struct {
 	preserveModTime  bool
 	preserveWritable bool
 
-	// Deflate compression level (if [1-9]) or 0 to disable compression.
+	// Compression level (if [1-9]) or 0 to disable compression.
 	//
 	// Default is 5.
 	compressionLevel int
+
+	// Compression method: "deflate" (default) or "zstd".
+	compression pkg.Compression
+
+	// If true, record hashes of all files in the package manifest.
+	fileHashes bool
 }
 
 func (opts *inputOptions) registerFlags(f *flag.FlagSet) {
@@ -531,7 +551,14 @@ func (opts *inputOptions) registerFlags(f *flag.FlagSet) {
 
 	// Options for the builder.
 	f.IntVar(&opts.compressionLevel, "compression-level", 5,
-		"Deflate compression level [0-9]: 0 - disable, 1 - best speed, 9 - best compression.")
+		"Compression level [0-9]: 0 - disable, 1 - best speed, 9 - best compression.")
+	f.Var(&opts.compression, "compression",
+		"Compression method: \"deflate\" (default) or \"zstd\". "+
+			"Packages compressed with zstd are faster to install, but require a recent CIPD client. "+
+			"Older clients don't recognize them and report them as corrupted.")
+	f.BoolVar(&opts.fileHashes, "file-hashes", false,
+		"Record hashes of all files in the package manifest, and the hash of the manifest in the instance metadata "+
+			"when registering the package. Required to upgrade the package with 'cipd ensure -delta-upgrades'.")
 }
 
 // prepareInput processes inputOptions by collecting all files to be added to
@@ -573,6 +600,8 @@ func (opts *inputOptions) prepareInput() (builder.Options, error) {
 			PackageName:      packageName,
 			InstallMode:      opts.installMode,
 			CompressionLevel: opts.compressionLevel,
+			Compression:      opts.compression,
+			FileHashes:       opts.fileHashes,
 		}, nil
 	}
 
@@ -618,6 +647,8 @@ func (opts *inputOptions) prepareInput() (builder.Options, error) {
 			VersionFile:      pkgDef.VersionFile(),
 			InstallMode:      pkgDef.InstallMode,
 			CompressionLevel: opts.compressionLevel,
+			Compression:      opts.compression,
+			FileHashes:       opts.fileHashes,
 		}, nil
 	}
 
@@ -870,6 +901,49 @@ func (opts *uploadOptions) registerFlags(f *flag.FlagSet) {
 		cipd.CASFinalizationTimeout, "Maximum time to wait for backend-side package hash verification.")
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// signingOptions mixin.
+
+// signingOptions defines -sign-key flag that specifies a private key to sign
+// package instances with.
+type signingOptions struct {
+	signKey string
+}
+
+func (opts *signingOptions) registerFlags(f *flag.FlagSet) {
+	f.StringVar(&opts.signKey, "sign-key", "",
+		"A path to a PEM-encoded PKCS #8 ed25519 private key to sign the package instance with. "+
+			"The signature is attached as an instance metadata and can be verified by $RequireSignedBy in ensure files.")
+}
+
+// loadKey loads the signing key if -sign-key was given or returns nil.
+func (opts *signingOptions) loadKey(ctx context.Context) (ed25519.PrivateKey, error) {
+	if opts.signKey == "" {
+		return nil, nil
+	}
+	key, err := signing.LoadPrivateKey(opts.signKey)
+	if err != nil {
+		return nil, err
+	}
+	logging.Infof(ctx, "Signing with %s", signing.FormatPublicKey(key.Public().(ed25519.PublicKey)))
+	return key, nil
+}
+
+// withSignature returns a copy of `md` with the pin signature appended to it if
+// `key` is not nil.
+func withSignature(md []cipd.Metadata, pin common.Pin, key ed25519.PrivateKey) []cipd.Metadata {
+	if key == nil {
+		return md
+	}
+	out := make([]cipd.Metadata, 0, len(md)+1)
+	out = append(out, md...)
+	return append(out, cipd.Metadata{
+		Key:         signing.MetadataKey,
+		Value:       signing.Sign(pin, key),
+		ContentType: signing.ContentType,
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // hashOptions mixin.
 
@@ -905,7 +979,8 @@ func (ha *hashAlgoFlag) Set(value string) error {
 // hashOptions defines -hash-algo flag that specifies hash algo to use for
 // constructing instance IDs.
 //
-// Default value is given by common.DefaultHashAlgo.
+// Default value is taken from CIPD_HASH_ALGO env var, falling back to
+// common.DefaultHashAlgo if it is not set.
 //
 // Not all algos may be accepted by the server.
 type hashOptions struct {
@@ -913,12 +988,23 @@ type hashOptions struct {
 }
 
 func (opts *hashOptions) registerFlags(f *flag.FlagSet) {
-	opts.algo = hashAlgoFlag(common.DefaultHashAlgo)
-	f.Var(&opts.algo, "hash-algo", fmt.Sprintf("Algorithm to use for deriving package instance ID, one of: %s", allAlgos))
+	f.Var(&opts.algo, "hash-algo", fmt.Sprintf(
+		"Algorithm to use for deriving package instance ID, one of: %s. Default is $%s or %s.",
+		allAlgos, cipd.EnvHashAlgo, strings.ToLower(common.DefaultHashAlgo.String())))
 }
 
-func (opts *hashOptions) hashAlgo() api.HashAlgo {
-	return api.HashAlgo(opts.algo)
+func (opts *hashOptions) hashAlgo(ctx context.Context) (api.HashAlgo, error) {
+	if opts.algo != 0 {
+		return api.HashAlgo(opts.algo), nil
+	}
+	if v := environ.FromCtx(ctx).Get(cipd.EnvHashAlgo); v != "" {
+		var algo hashAlgoFlag
+		if err := algo.Set(v); err != nil {
+			return 0, errors.Annotate(err, "bad %s", cipd.EnvHashAlgo).Err()
+		}
+		return api.HashAlgo(algo), nil
+	}
+	return common.DefaultHashAlgo, nil
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -1258,6 +1344,7 @@ func cmdCreate(params Parameters) *subcommands.Command {
 			c.Opts.refsOptions.registerFlags(&c.Flags)
 			c.Opts.tagsOptions.registerFlags(&c.Flags)
 			c.Opts.metadataOptions.registerFlags(&c.Flags)
+			c.Opts.signingOptions.registerFlags(&c.Flags)
 			c.Opts.clientOptions.registerFlags(&c.Flags, params, withoutRootDir, withoutMaxThreads)
 			c.Opts.uploadOptions.registerFlags(&c.Flags)
 			c.Opts.hashOptions.registerFlags(&c.Flags)
@@ -1271,6 +1358,7 @@ type createOpts struct {
 	refsOptions
 	tagsOptions
 	metadataOptions
+	signingOptions
 	clientOptions
 	uploadOptions
 	hashOptions
@@ -1303,7 +1391,11 @@ func buildAndUploadInstance(ctx context.Context, opts *createOpts) (common.Pin,
 		_ = f.Close()
 		_ = os.Remove(f.Name())
 	}()
-	pin, err := buildInstanceFile(ctx, f.Name(), opts.inputOptions, opts.hashAlgo())
+	hashAlgo, err := opts.hashAlgo(ctx)
+	if err != nil {
+		return common.Pin{}, err
+	}
+	pin, err := buildInstanceFile(ctx, f.Name(), opts.inputOptions, hashAlgo)
 	if err != nil {
 		return common.Pin{}, err
 	}
@@ -1311,6 +1403,7 @@ func buildAndUploadInstance(ctx context.Context, opts *createOpts) (common.Pin,
 		refsOptions:     opts.refsOptions,
 		tagsOptions:     opts.tagsOptions,
 		metadataOptions: opts.metadataOptions,
+		signingOptions:  opts.signingOptions,
 		clientOptions:   opts.clientOptions,
 		uploadOptions:   opts.uploadOptions,
 		hashOptions:     opts.hashOptions,
@@ -1322,10 +1415,13 @@ func buildAndUploadInstance(ctx context.Context, opts *createOpts) (common.Pin,
 
 func cmdAttach(params Parameters) *subcommands.Command {
 	return &subcommands.Command{
-		UsageLine: "attach <package or package prefix> -metadata key:value -metadata-from-file key:path -tag key:value -ref name [options]",
+		UsageLine: "attach <package or package prefix> -metadata key:value -metadata-from-file key:path -tag key:value -ref name -sign-key path [options]",
 		ShortDesc: "attaches tags, metadata and points refs to an instance",
 		LongDesc: `Attaches tags, metadata and points refs to an instance.
 
+If -sign-key is given, also signs the instance and attaches the signature as
+a metadata entry.
+
 Note that this operation is not atomic. It attaches metadata first, then tags,
 then moves refs one by one. Reattaching already attached data is not an error
 though, so a failed operation can be safely retried.
@@ -1336,6 +1432,7 @@ though, so a failed operation can be safely retried.
 			c.refsOptions.registerFlags(&c.Flags)
 			c.tagsOptions.registerFlags(&c.Flags)
 			c.metadataOptions.registerFlags(&c.Flags)
+			c.signingOptions.registerFlags(&c.Flags)
 			c.clientOptions.registerFlags(&c.Flags, params, withoutRootDir, withoutMaxThreads)
 			c.Flags.StringVar(&c.version, "version", "<version>",
 				"Package version to resolve. Could also be a tag or a ref.")
@@ -1349,6 +1446,7 @@ type attachRun struct {
 	refsOptions
 	tagsOptions
 	metadataOptions
+	signingOptions
 	clientOptions
 
 	version string
@@ -1365,8 +1463,12 @@ func (c *attachRun) Run(a subcommands.Application, args []string, env subcommand
 	if err != nil {
 		return c.done(nil, err)
 	}
-	if len(c.refs) == 0 && len(c.tags) == 0 && len(md) == 0 {
-		return c.done(nil, makeCLIError("no -tags, -refs or -metadata is provided"))
+	signKey, err := c.signingOptions.loadKey(ctx)
+	if err != nil {
+		return c.done(nil, err)
+	}
+	if len(c.refs) == 0 && len(c.tags) == 0 && len(md) == 0 && signKey == nil {
+		return c.done(nil, makeCLIError("no -tags, -refs, -metadata or -sign-key is provided"))
 	}
 
 	pkgPrefix, err := expandTemplate(args[0])
@@ -1379,7 +1481,7 @@ func (c *attachRun) Run(a subcommands.Application, args []string, env subcommand
 		packagePrefix: pkgPrefix,
 		version:       c.version,
 		updatePin: func(client cipd.Client, pin common.Pin) error {
-			return attachAndMove(ctx, client, pin, md, c.tags, c.refs)
+			return attachAndMove(ctx, client, pin, withSignature(md, pin, signKey), c.tags, c.refs)
 		},
 	}))
 }
@@ -1404,6 +1506,11 @@ folder has the packages at the versions specified:
 
     cipd ensure -root a/directory -ensure-file ensure_file
 
+To install packages on a machine without access to the backend, prepare
+a bundle with 'cipd bundle-create' elsewhere and pass it via -from-bundle:
+
+    cipd ensure -root a/directory -ensure-file ensure_file -from-bundle b.zip
+
 For the full syntax of the ensure file, see:
 
    https://go.chromium.org/luci/cipd/client/cipd/ensure
@@ -1413,6 +1520,12 @@ For the full syntax of the ensure file, see:
 			c.registerBaseFlags()
 			c.clientOptions.registerFlags(&c.Flags, params, withRootDir, withMaxThreads)
 			c.ensureFileOptions.registerFlags(&c.Flags, withEnsureOutFlag, withLegacyListFlag)
+			c.Flags.StringVar(&c.fromBundle, "from-bundle", "",
+				"A bundle produced by 'cipd bundle-create' to install packages from instead of the backend.")
+			c.Flags.BoolVar(&c.deltaUpgrades, "delta-upgrades", false,
+				"When upgrading already installed packages, fetch only files that changed. "+
+					"Falls back to fetching whole packages if the storage doesn't support it "+
+					"or they were built without -file-hashes.")
 			return c
 		},
 	}
@@ -1422,6 +1535,9 @@ type ensureRun struct {
 	cipdSubcommand
 	clientOptions
 	ensureFileOptions
+
+	fromBundle    string
+	deltaUpgrades bool
 }
 
 func (c *ensureRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
@@ -1430,16 +1546,34 @@ func (c *ensureRun) Run(a subcommands.Application, args []string, env subcommand
 	}
 	ctx := cli.GetContext(a, c, env)
 
-	ef, err := c.loadEnsureFile(ctx, &c.clientOptions, ignoreVerifyPlatforms, parseVersionsFile)
+	// When installing from a bundle, the versions are resolved using the bundle
+	// itself, not the $ResolvedVersions file.
+	parseVers := parseVersionsFile
+	if c.fromBundle != "" {
+		parseVers = ignoreVersionsFile
+	}
+
+	ef, err := c.loadEnsureFile(ctx, &c.clientOptions, ignoreVerifyPlatforms, parseVers)
 	if err != nil {
 		return c.done(nil, err)
 	}
 
-	pins, _, err := ensurePackages(ctx, ef, c.ensureFileOut, false, c.clientOptions)
+	if c.fromBundle != "" {
+		b, err := bundle.Open(c.fromBundle)
+		if err != nil {
+			return c.done(nil, err)
+		}
+		defer b.Close()
+		logging.Infof(ctx, "Installing packages from %s", b)
+		c.clientOptions.versions = b.Versions()
+		c.clientOptions.bundle = b
+	}
+
+	pins, _, err := ensurePackages(ctx, ef, c.ensureFileOut, false, c.deltaUpgrades, c.clientOptions)
 	return c.done(pins, err)
 }
 
-func ensurePackages(ctx context.Context, ef *ensure.File, ensureFileOut string, dryRun bool, clientOpts clientOptions) (common.PinSliceBySubdir, cipd.ActionMap, error) {
+func ensurePackages(ctx context.Context, ef *ensure.File, ensureFileOut string, dryRun, deltaUpgrades bool, clientOpts clientOptions) (common.PinSliceBySubdir, cipd.ActionMap, error) {
 	client, err := clientOpts.makeCIPDClient(ctx)
 	if err != nil {
 		return nil, nil, err
@@ -1459,6 +1593,8 @@ func ensurePackages(ctx context.Context, ef *ensure.File, ensureFileOut string,
 		Paranoia:            resolved.ParanoidMode,
 		DryRun:              dryRun,
 		OverrideInstallMode: resolved.OverrideInstallMode,
+		DeltaUpgrades:       deltaUpgrades,
+		RequireSignedBy:     resolved.RequireSignedBy,
 	})
 	if err != nil {
 		return nil, actions, err
@@ -1480,6 +1616,126 @@ func ensurePackages(ctx context.Context, ef *ensure.File, ensureFileOut string,
 	return resolved.PackagesBySubdir, actions, err
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'bundle-create' subcommand.
+
+func cmdBundleCreate(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "bundle-create [options]",
+		ShortDesc: "packs all packages in an ensure file into an offline bundle",
+		LongDesc: `Packs all packages in an ensure file into an offline bundle.
+
+Resolves versions of all packages for all verified platforms in the "ensure"
+file, fetches all resolved instances and writes them, along with the resolved
+versions, into a single bundle file:
+
+    cipd bundle-create -ensure-file ensure_file -out bundle.zip
+
+The bundle can then be copied to a machine without access to the backend and
+installed there with:
+
+    cipd ensure -root a/directory -ensure-file ensure_file -from-bundle bundle.zip
+
+Hashes of all instances are verified again when installing from the bundle.
+`,
+		Advanced: true,
+		CommandRun: func() subcommands.CommandRun {
+			c := &bundleCreateRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params, withoutRootDir, withoutMaxThreads)
+			c.ensureFileOptions.registerFlags(&c.Flags, withoutEnsureOutFlag, withoutLegacyListFlag)
+			c.Flags.StringVar(&c.out, "out", "<path>", "A path to write the bundle to.")
+			return c
+		},
+	}
+}
+
+type bundleCreateRun struct {
+	cipdSubcommand
+	clientOptions
+	ensureFileOptions
+
+	out string
+}
+
+func (c *bundleCreateRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+
+	ef, err := c.loadEnsureFile(ctx, &c.clientOptions, requireVerifyPlatforms, ignoreVersionsFile)
+	if err != nil {
+		return c.done(nil, err)
+	}
+
+	pinMap, versions, err := resolveEnsureFile(ctx, ef, c.clientOptions)
+	if err != nil {
+		return c.doneWithPinMap(pinMap, err)
+	}
+
+	return c.doneWithPinMap(pinMap, createBundle(ctx, c.out, pinMap, versions, c.clientOptions))
+}
+
+func createBundle(ctx context.Context, path string, pinMap map[string][]pinInfo, versions ensure.VersionsFile, clientOpts clientOptions) (err error) {
+	client, err := clientOpts.makeCIPDClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close(ctx)
+
+	// Same instance may be used by multiple platforms or subdirs.
+	seen := stringset.New(0)
+	var pins []common.Pin
+	for _, infos := range pinMap {
+		for _, info := range infos {
+			if info.Pin != nil && seen.Add(info.Pin.InstanceID) {
+				pins = append(pins, *info.Pin)
+			}
+		}
+	}
+	sort.Slice(pins, func(i, j int) bool { return pins[i].String() < pins[j].String() })
+
+	out, err := os.Create(path)
+	if err != nil {
+		return errors.Annotate(err, "opening the bundle file for writing").Tag(cipderr.IO).Err()
+	}
+	ok := false
+	defer func() {
+		if !ok {
+			out.Close()
+			os.Remove(path)
+		}
+	}()
+
+	w := bundle.NewWriter(out)
+	w.ServiceURL = clientOpts.resolvedServiceURL(ctx)
+	w.SetVersions(versions)
+
+	for _, pin := range pins {
+		src, err := client.FetchInstance(ctx, pin)
+		if err != nil {
+			return err
+		}
+		err = w.AddInstance(ctx, pin, src)
+		src.Close(ctx, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return errors.Annotate(err, "flushing the bundle file").Tag(cipderr.IO).Err()
+	}
+	ok = true
+
+	logging.Infof(ctx, "Wrote %d instance(s) into %s", len(pins), path)
+	return nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'ensure-file-verify' subcommand.
 
@@ -1638,7 +1894,7 @@ func (c *checkUpdatesRun) Run(a subcommands.Application, args []string, env subc
 		return 0 // on fatal errors ask puppet to run 'ensure' for real
 	}
 
-	_, actions, err := ensurePackages(ctx, ef, "", true, c.clientOptions)
+	_, actions, err := ensurePackages(ctx, ef, "", true, false, c.clientOptions)
 	if err != nil {
 		ret := c.done(actions, err)
 		if transient.Tag.In(err) {
@@ -1653,6 +1909,124 @@ func (c *checkUpdatesRun) Run(a subcommands.Application, args []string, env subc
 	return 0
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'proxy-serve' subcommand.
+
+func cmdProxyServe(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		Advanced:  true,
+		UsageLine: "proxy-serve [options]",
+		ShortDesc: "runs a local caching proxy for the CIPD backend",
+		LongDesc: `Runs a local caching proxy for the CIPD backend.
+
+The proxy forwards RPCs used by "ensure" and similar read-only commands to the
+backend (using the credentials of the proxy) and caches fetched instances in
+a subdirectory of -cache-dir. Clients can use it by setting their service URL
+(via -service-url flag, $ServiceURL directive or $CIPD_SERVICE_URL env var)
+to the proxy URL:
+
+    cipd proxy-serve -listen 0.0.0.0:8080 -advertise-url http://proxy.lab:8080 \
+        -cache-dir /var/cache/cipd -cache-max-size 50G
+
+Instances that use hash algorithms unknown to the proxy are not cached.
+`,
+		CommandRun: func() subcommands.CommandRun {
+			c := &proxyServeRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params, withoutRootDir, withoutMaxThreads)
+			c.policies.AddFlags(&c.Flags)
+			c.Flags.StringVar(&c.listen, "listen", "localhost:8080", "An address to listen on.")
+			c.Flags.StringVar(&c.advertiseURL, "advertise-url", "",
+				"A root URL of the proxy as seen by clients. Default is http://<-listen value>.")
+			return c
+		},
+	}
+}
+
+type proxyServeRun struct {
+	cipdSubcommand
+	clientOptions
+
+	policies     cache.Policies
+	listen       string
+	advertiseURL string
+}
+
+func (c *proxyServeRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.done(nil, proxyServe(ctx, c.listen, c.advertiseURL, c.policies, c.clientOptions))
+}
+
+func proxyServe(ctx context.Context, listen, advertiseURL string, policies cache.Policies, clientOpts clientOptions) error {
+	opts, err := clientOpts.toCIPDClientOpts(ctx)
+	if err != nil {
+		return err
+	}
+	if opts.CacheDir == "" {
+		return makeCLIError("-cache-dir or %s env var is required", cipd.EnvCacheDir)
+	}
+	upstream, err := url.Parse(opts.ServiceURL)
+	if err != nil {
+		return errors.Annotate(err, "not a valid URL %q", opts.ServiceURL).Tag(cipderr.BadArgument).Err()
+	}
+	if advertiseURL == "" {
+		advertiseURL = "http://" + listen
+	}
+
+	objCache, err := cache.New(policies, filepath.Join(opts.CacheDir, "proxy"), crypto.SHA256)
+	if objCache == nil {
+		return errors.Annotate(err, "initializing the cache").Tag(cipderr.IO).Err()
+	}
+	if err != nil {
+		logging.Warningf(ctx, "Failed to load the cache state, starting from scratch: %s", err)
+	}
+	defer objCache.Close()
+
+	// Objects not using SHA256 are verified in a temp file before being
+	// hardlinked into the cache, so keep it on the same filesystem.
+	tmpDir := filepath.Join(opts.CacheDir, "proxy_tmp")
+	if err := os.MkdirAll(tmpDir, 0700); err != nil {
+		return errors.Annotate(err, "creating the temp directory").Tag(cipderr.IO).Err()
+	}
+
+	prpcC := &prpc.Client{
+		C:    opts.AuthenticatedClient,
+		Host: upstream.Host,
+		Options: &prpc.Options{
+			UserAgent: cipd.UserAgent,
+			Insecure:  upstream.Scheme == "http",
+		},
+	}
+	proxy := &proxyserver.Server{
+		Repository: api.NewRepositoryClient(prpcC),
+		HTTPClient: opts.AnonymousClient,
+		Cache:      objCache,
+		TempDir:    tmpDir,
+		URL:        strings.TrimSuffix(advertiseURL, "/"),
+	}
+
+	r := router.New()
+	proxy.InstallHandlers(r, router.NewMiddlewareChain())
+	srv := &http.Server{
+		Addr:        listen,
+		Handler:     r,
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+	go func() {
+		<-ctx.Done()
+		srv.Shutdown(context.Background())
+	}()
+
+	logging.Infof(ctx, "Proxying %s at %s", opts.ServiceURL, proxy.URL)
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return errors.Annotate(err, "serving").Tag(cipderr.IO).Err()
+	}
+	return nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'resolve' subcommand.
 
@@ -1766,7 +2140,7 @@ func (c *exportRun) Run(a subcommands.Application, args []string, env subcommand
 	}
 	ef.OverrideInstallMode = pkg.InstallModeCopy
 
-	pins, _, err := ensurePackages(ctx, ef, c.ensureFileOut, false, c.clientOptions)
+	pins, _, err := ensurePackages(ctx, ef, c.ensureFileOut, false, false, c.clientOptions)
 	if err != nil {
 		return c.done(pins, err)
 	}
@@ -2708,11 +3082,15 @@ func (c *buildRun) Run(a subcommands.Application, args []string, env subcommands
 		return 1
 	}
 	ctx := cli.GetContext(a, c, env)
-	_, err := buildInstanceFile(ctx, c.outputFile, c.inputOptions, c.hashAlgo())
+	hashAlgo, err := c.hashAlgo(ctx)
+	if err != nil {
+		return c.done(nil, err)
+	}
+	_, err = buildInstanceFile(ctx, c.outputFile, c.inputOptions, hashAlgo)
 	if err != nil {
 		return c.done(nil, err)
 	}
-	return c.done(inspectInstanceFile(ctx, c.outputFile, c.hashAlgo(), false))
+	return c.done(inspectInstanceFile(ctx, c.outputFile, hashAlgo, false))
 }
 
 func buildInstanceFile(ctx context.Context, instanceFile string, inputOpts inputOptions, algo api.HashAlgo) (common.Pin, error) {
@@ -2786,7 +3164,11 @@ func (c *deployRun) Run(a subcommands.Application, args []string, env subcommand
 	if err != nil {
 		return c.done(nil, err)
 	}
-	return c.done(deployInstanceFile(ctx, c.rootDir, args[0], c.hashAlgo(), maxThreads, c.overrideInstallMode))
+	hashAlgo, err := c.hashAlgo(ctx)
+	if err != nil {
+		return c.done(nil, err)
+	}
+	return c.done(deployInstanceFile(ctx, c.rootDir, args[0], hashAlgo, maxThreads, c.overrideInstallMode))
 }
 
 func deployInstanceFile(ctx context.Context, root, instanceFile string, hashAlgo api.HashAlgo, maxThreads int, overrideInstallMode pkg.InstallMode) (common.Pin, error) {
@@ -2934,7 +3316,11 @@ func (c *inspectRun) Run(a subcommands.Application, args []string, env subcomman
 		return 1
 	}
 	ctx := cli.GetContext(a, c, env)
-	return c.done(inspectInstanceFile(ctx, args[0], c.hashAlgo(), true))
+	hashAlgo, err := c.hashAlgo(ctx)
+	if err != nil {
+		return c.done(nil, err)
+	}
+	return c.done(inspectInstanceFile(ctx, args[0], hashAlgo, true))
 }
 
 func inspectInstanceFile(ctx context.Context, instanceFile string, hashAlgo api.HashAlgo, listFiles bool) (common.Pin, error) {
@@ -3002,6 +3388,7 @@ func cmdRegister(params Parameters) *subcommands.Command {
 			c.Opts.refsOptions.registerFlags(&c.Flags)
 			c.Opts.tagsOptions.registerFlags(&c.Flags)
 			c.Opts.metadataOptions.registerFlags(&c.Flags)
+			c.Opts.signingOptions.registerFlags(&c.Flags)
 			c.Opts.clientOptions.registerFlags(&c.Flags, params, withoutRootDir, withoutMaxThreads)
 			c.Opts.uploadOptions.registerFlags(&c.Flags)
 			c.Opts.hashOptions.registerFlags(&c.Flags)
@@ -3014,6 +3401,7 @@ type registerOpts struct {
 	refsOptions
 	tagsOptions
 	metadataOptions
+	signingOptions
 	clientOptions
 	uploadOptions
 	hashOptions
@@ -3039,6 +3427,10 @@ func registerInstanceFile(ctx context.Context, instanceFile string, knownPin *co
 	if err != nil {
 		return common.Pin{}, err
 	}
+	signKey, err := opts.signingOptions.loadKey(ctx)
+	if err != nil {
+		return common.Pin{}, err
+	}
 
 	src, err := pkg.NewFileSource(instanceFile)
 	if err != nil {
@@ -3054,13 +3446,22 @@ func registerInstanceFile(ctx context.Context, instanceFile string, knownPin *co
 	if knownPin != nil {
 		pin = *knownPin
 	} else {
-		pin, err = reader.CalculatePin(ctx, src, opts.hashAlgo())
+		hashAlgo, err := opts.hashAlgo(ctx)
+		if err != nil {
+			return common.Pin{}, err
+		}
+		pin, err = reader.CalculatePin(ctx, src, hashAlgo)
 		if err != nil {
 			return common.Pin{}, err
 		}
 	}
 	inspectPin(ctx, pin)
 
+	metadata, err = withManifestHash(ctx, metadata, src, pin)
+	if err != nil {
+		return common.Pin{}, err
+	}
+
 	client, err := opts.clientOptions.makeCIPDClient(ctx)
 	if err != nil {
 		return common.Pin{}, err
@@ -3071,13 +3472,43 @@ func registerInstanceFile(ctx context.Context, instanceFile string, knownPin *co
 	if err != nil {
 		return common.Pin{}, err
 	}
-	err = attachAndMove(ctx, client, pin, metadata, opts.tags, opts.refs)
+	err = attachAndMove(ctx, client, pin, withSignature(metadata, pin, signKey), opts.tags, opts.refs)
 	if err != nil {
 		return common.Pin{}, err
 	}
 	return pin, nil
 }
 
+// withManifestHash returns a copy of `md` with the hash of the package manifest
+// appended to it if the instance was built with file hashes.
+//
+// Clients need it to upgrade the package by fetching only changed files, see
+// deployer.ManifestHashMetadataKey.
+func withManifestHash(ctx context.Context, md []cipd.Metadata, src pkg.Source, pin common.Pin) ([]cipd.Metadata, error) {
+	// Note: the instance is not closed, since it would close `src`.
+	inst, err := reader.OpenInstance(ctx, src, reader.OpenInstanceOpts{
+		VerificationMode: reader.SkipHashVerification,
+		InstanceID:       pin.InstanceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	hash, err := deployer.ManifestHash(inst)
+	switch {
+	case err == deployer.ErrNoFileHashes:
+		return md, nil
+	case err != nil:
+		return nil, err
+	}
+	out := make([]cipd.Metadata, 0, len(md)+1)
+	out = append(out, md...)
+	return append(out, cipd.Metadata{
+		Key:         deployer.ManifestHashMetadataKey,
+		Value:       []byte(hash),
+		ContentType: "text/plain",
+	}), nil
+}
+
 func attachAndMove(ctx context.Context, client cipd.Client, pin common.Pin, md []cipd.Metadata, tags tagList, refs refList) error {
 	if err := client.AttachMetadataWhenReady(ctx, pin, md); err != nil {
 		return err
@@ -3503,6 +3934,220 @@ func repairDeployment(ctx context.Context, clientOpts clientOptions) (cipd.Actio
 	})
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'cache-gc' subcommand.
+
+func cmdCacheGC(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		Advanced:  true,
+		UsageLine: "cache-gc [options]",
+		ShortDesc: "prunes unused cached instances and orphaned site root directories",
+		LongDesc: `Prunes unused cached instances and orphaned site root directories.
+
+Visits the instance cache (if -cache-dir or $CIPD_CACHE_DIR is set) and
+the site root guts (if -root is set) and prints a report with what was found.
+
+Cached instances not used for longer than -max-age are deleted. If the instance
+cache is still larger than -max-size, least recently used instances are deleted
+until it fits. Instances deployed to the site root are never deleted.
+
+Directories in the site root guts that don't belong to any deployed package
+(left by interrupted deployments) are deleted if they were not modified for
+longer than -max-age, but no sooner than an hour after their last modification,
+since they may be used by a concurrent deployment. Tag cache entries older than
+-max-age are forgotten.
+
+Use -dry-run to just print the report.
+`,
+		CommandRun: func() subcommands.CommandRun {
+			c := &cacheGCRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params, withoutRootDir, withoutMaxThreads)
+			c.Flags.StringVar(&c.rootDir, "root", "", "Path to an installation site root directory to check.")
+			c.Flags.DurationVar(&c.maxAge, "max-age", 48*time.Hour, "Prune entries not used for longer than this. 0 to prune everything unused.")
+			c.Flags.Var(&c.maxSize, "max-size", "A size budget for the instance cache, e.g. 10G. 0 for no limit.")
+			c.Flags.BoolVar(&c.dryRun, "dry-run", false, "Don't delete anything, just print the report.")
+			return c
+		},
+	}
+}
+
+type cacheGCRun struct {
+	cipdSubcommand
+	clientOptions
+
+	maxAge  time.Duration
+	maxSize units.Size
+	dryRun  bool
+}
+
+func (c *cacheGCRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.done(cacheGC(ctx, &cipd.GCOptions{
+		MaxAge:  c.maxAge,
+		MaxSize: int64(c.maxSize),
+		DryRun:  c.dryRun,
+	}, c.clientOptions))
+}
+
+func cacheGC(ctx context.Context, opts *cipd.GCOptions, clientOpts clientOptions) (*cipd.GCReport, error) {
+	client, err := clientOpts.makeCIPDClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close(ctx)
+
+	report, err := client.CollectGarbage(ctx, opts)
+	if report == nil {
+		return nil, err
+	}
+
+	verb := "Pruned"
+	if report.DryRun {
+		verb = "Would prune"
+	}
+
+	if len(report.Instances) != 0 {
+		fmt.Printf("Instance cache:\n")
+		for _, inst := range report.Instances {
+			status := "kept"
+			switch {
+			case inst.Pruned:
+				status = strings.ToLower(verb) + ": " + inst.Reason
+			case inst.Deployed:
+				status = "kept: deployed"
+			}
+			fmt.Printf("  %s  %9s  %s  %s\n",
+				inst.InstanceID, units.SizeToString(inst.Size), inst.LastAccess.Local().Format(time.RFC3339), status)
+		}
+		fmt.Printf("\n")
+	}
+
+	if len(report.Orphans) != 0 {
+		fmt.Printf("Orphaned site root directories:\n")
+		for _, o := range report.Orphans {
+			status := "kept: recently modified"
+			if o.Pruned {
+				status = strings.ToLower(verb)
+			}
+			fmt.Printf("  %s  %9s  %s (%s)\n", o.Path, units.SizeToString(o.Size), o.Reason, status)
+		}
+		fmt.Printf("\n")
+	}
+
+	fmt.Printf("%s %s, the instance cache size is %s now.\n",
+		verb, units.SizeToString(report.FreedBytes), units.SizeToString(report.CacheSize))
+	if report.TagCachePruned != 0 {
+		fmt.Printf("%s %d tag cache entries, %d left.\n", verb, report.TagCachePruned, report.TagCacheKept)
+	}
+	return report, err
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 'sbom' subcommand.
+
+func cmdSBOM(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		Advanced:  true,
+		UsageLine: "sbom [options]",
+		ShortDesc: "generates a software bill of materials for a site root",
+		LongDesc: `Generates a software bill of materials for a site root.
+
+Visits all packages deployed to the site root, fetches their tags, refs and
+metadata from the backend and writes a CycloneDX 1.5 JSON document with all
+packages and hashes of their files (as recorded in the package manifests).
+
+The document is written to -out or to stdout if -out is not set.
+`,
+		CommandRun: func() subcommands.CommandRun {
+			c := &sbomRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params, withRootDir, withoutMaxThreads)
+			c.Flags.StringVar(&c.outputPath, "out", "", "Path to a file to write the SBOM to or \"-\" for stdout.")
+			return c
+		},
+	}
+}
+
+type sbomRun struct {
+	cipdSubcommand
+	clientOptions
+
+	outputPath string
+}
+
+func (c *sbomRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.done(generateSBOM(ctx, c.outputPath, c.clientOptions))
+}
+
+func generateSBOM(ctx context.Context, outputPath string, clientOpts clientOptions) (*sbom.Document, error) {
+	client, err := clientOpts.makeCIPDClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close(ctx)
+
+	d := deployer.New(client.Options().Root)
+	deployed, err := d.FindDeployed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pkgs []sbom.Package
+	for subdir, pins := range deployed {
+		for _, pin := range pins {
+			state, err := d.CheckDeployed(ctx, subdir, pin.PackageName, deployer.NotParanoid, pkg.WithManifest)
+			if err != nil {
+				return nil, err
+			}
+			desc, err := client.DescribeInstance(ctx, pin, &cipd.DescribeInstanceOpts{
+				DescribeRefs:     true,
+				DescribeTags:     true,
+				DescribeMetadata: true,
+			})
+			if err != nil {
+				return nil, err
+			}
+			pkgs = append(pkgs, sbom.Package{
+				Subdir:      subdir,
+				Pin:         pin,
+				Description: desc,
+				Manifest:    state.Manifest,
+			})
+		}
+	}
+
+	doc := sbom.Generate(ctx, sbom.Options{
+		SiteRoot:   d.FS().Root(),
+		ServiceURL: client.Options().ServiceURL,
+		Packages:   pkgs,
+	})
+
+	blob, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, errors.Annotate(err, "serializing the SBOM").Tag(cipderr.IO).Err()
+	}
+	blob = append(blob, '\n')
+
+	if outputPath == "" || outputPath == "-" {
+		_, err = os.Stdout.Write(blob)
+	} else {
+		err = os.WriteFile(outputPath, blob, 0666)
+	}
+	if err != nil {
+		return nil, errors.Annotate(err, "writing the SBOM").Tag(cipderr.IO).Err()
+	}
+	logging.Infof(ctx, "Described %d package(s).", len(pkgs))
+	return doc, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Main.
 
@@ -3559,6 +4204,11 @@ func GetApplication(params Parameters) *cli.Application {
 				Advanced:  true,
 				ShortDesc: "Override CIPD service URL.",
 			},
+			cipd.EnvHashAlgo: {
+				Advanced: true,
+				ShortDesc: fmt.Sprintf("Hash algorithm to use for deriving instance IDs of new packages, one of: %s "+
+					"(-hash-algo, if given, takes precedence).", allAlgos),
+			},
 			envSimpleTerminalUI: {
 				Advanced:  true,
 				ShortDesc: "If set disables the fancy terminal UI with progress bars in favor of a simpler one that just logs to stderr.",
@@ -3602,6 +4252,7 @@ func GetApplication(params Parameters) *cli.Application {
 			{Advanced: true},
 			cmdEnsureFileVerify(params),
 			cmdEnsureFileResolve(params),
+			cmdBundleCreate(params),
 
 			// User friendly subcommands that operates within a site root. Implemented
 			// in friendly.go. These are advanced because they're half-baked.
@@ -3628,11 +4279,14 @@ func GetApplication(params Parameters) *cli.Application {
 			{Advanced: true},
 			cmdCheckDeployment(params),
 			cmdRepairDeployment(params),
+			cmdCacheGC(params),
+			cmdSBOM(params),
 
 			// Low level misc commands.
 			{Advanced: true},
 			cmdExpandPackageName(params),
 			cmdPuppetCheckUpdates(params),
+			cmdProxyServe(params),
 		},
 	}
 }
@@ -47,6 +47,8 @@ const (
 	HashMismatch Code = "hash_mismatch_error"
 	// The admission plugin forbid installation of a package.
 	NotAdmitted Code = "not_admitted_error"
+	// An instance doesn't have a valid signature by a trusted key.
+	BadSignature Code = "bad_signature_error"
 	// A timeout of some sort.
 	Timeout Code = "timeout_error"
 	// Unrecognized (possibly transient) error.
@@ -55,4 +55,14 @@ func TestNewHash(t *testing.T) {
 			HexDigest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
 		})
 	})
+
+	Convey("BLAKE3", t, func() {
+		algo, err := NewHash(api.HashAlgo_BLAKE3)
+		So(err, ShouldBeNil)
+		So(algo, ShouldNotBeNil)
+		So(ObjectRefFromHash(algo), ShouldResembleProto, &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_BLAKE3,
+			HexDigest: "af1349b9f5f9a1a6a0404dea36dcc9499bcb25c9adc112b7cc9a93cae41f3262",
+		})
+	})
 }
@@ -34,6 +34,7 @@ func TestValidateInstanceID(t *testing.T) {
 		"B7r75joOfFfFcq7fHCKAIrU34oeFAT174Bf8eHMajMUC",
 		"-dXsPJ3XDdzO3GLNqekTAmNfIXtE697vame6_4_HNUkC",
 		"ytsp2xXp26LpDqWLjKOUmpGorZXaEJGryJO1-Nkp5t0C",
+		"B7r75joOfFfFcq7fHCKAIrU34oeFAT174Bf8eHMajMUD",
 	}
 	for _, iid := range good {
 		Convey(fmt.Sprintf("Works with %q", iid), t, func() {
@@ -61,7 +62,7 @@ func TestValidateInstanceID(t *testing.T) {
 	}
 
 	unknown := []string{
-		"B7r75joOfFfFcq7fHCKAIrU34oeFAT174Bf8eHMajMUD", // unrecognized hash algo
+		"B7r75joOfFfFcq7fHCKAIrU34oeFAT174Bf8eHMajMUE", // unrecognized hash algo
 		"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",      // happens to be looking like unknown hash algo
 	}
 	for _, iid := range unknown {
@@ -109,6 +110,18 @@ func TestValidateObjectRef(t *testing.T) {
 		}, KnownHash), ShouldErrLike, "wrong char")
 	})
 
+	Convey("BLAKE3", t, func() {
+		So(ValidateObjectRef(&api.ObjectRef{
+			HashAlgo:  api.HashAlgo_BLAKE3,
+			HexDigest: "af1349b9f5f9a1a6a0404dea36dcc9499bcb25c9adc112b7cc9a93cae41f3262",
+		}, KnownHash), ShouldBeNil)
+
+		So(ValidateObjectRef(&api.ObjectRef{
+			HashAlgo:  api.HashAlgo_BLAKE3,
+			HexDigest: "abcd",
+		}, KnownHash), ShouldErrLike, "expecting 64 chars, got 4")
+	})
+
 	Convey("Some future hash in KnownHash mode", t, func() {
 		So(ValidateObjectRef(&api.ObjectRef{
 			HashAlgo:  33,
@@ -173,6 +186,21 @@ func TestRefIIDConversion(t *testing.T) {
 		})
 	})
 
+	Convey("BLAKE3 works", t, func() {
+		blake3hex := "a948904f2f0f479b8f8197694b30184b0d2ed1c1cd2a1ec0fb85d299a192a447"
+		blake3iid := "qUiQTy8PR5uPgZdpSzAYSw0u0cHNKh7A-4XSmaGSpEcD"
+
+		So(ObjectRefToInstanceID(&api.ObjectRef{
+			HashAlgo:  api.HashAlgo_BLAKE3,
+			HexDigest: blake3hex,
+		}), ShouldEqual, blake3iid)
+
+		So(InstanceIDToObjectRef(blake3iid), ShouldResembleProto, &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_BLAKE3,
+			HexDigest: blake3hex,
+		})
+	})
+
 	Convey("Some future unknown hash", t, func() {
 		hex := strings.Repeat("a", 60)
 		iid := "qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqIQ"
@@ -21,8 +21,16 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
 )
 
+// ErrExists is returned by Insert if a key already exists.
+var ErrExists = errors.New("key already exists")
+
+// Stop can be returned by the callback of ForEachWithPrefix to stop the
+// iteration without an error.
+var Stop = errors.New("stop iteration")
+
 type KVS struct {
 	db *badger.DB
 }
@@ -131,3 +139,81 @@ func (k *KVS) ForEach(fn func(key string, value []byte) error) error {
 	}
 	return nil
 }
+
+// Insert receives callback that takes function which is used to insert a
+// key/value pair.
+//
+// All pairs are inserted in a single transaction. If any of the keys already
+// exists, none are inserted and ErrExists is returned. Conflicts with
+// concurrent transactions are tagged as transient.
+func (k *KVS) Insert(fn func(insert func(key string, value []byte) error) error) error {
+	err := k.db.Update(func(txn *badger.Txn) error {
+		return fn(func(key string, value []byte) error {
+			switch _, err := txn.Get([]byte(key)); {
+			case err == nil:
+				return ErrExists
+			case err != badger.ErrKeyNotFound:
+				return errors.Annotate(err, "failed to get %s", key).Err()
+			}
+			if err := txn.Set([]byte(key), value); err != nil {
+				return errors.Annotate(err, "failed to set key: %s", key).Err()
+			}
+			return nil
+		})
+	})
+	switch {
+	case err == nil || err == ErrExists:
+		return err
+	case err == badger.ErrConflict:
+		return errors.Annotate(err, "failed to insert").Tag(transient.Tag).Err()
+	default:
+		return errors.Annotate(err, "failed to insert").Err()
+	}
+}
+
+// ForEachWithPrefix executes a function for each key/value pair in KVS whose
+// key has the prefix, in key order, starting at the first key >= start.
+//
+// If keysOnly is true, values are not read and the function receives nil
+// values. If the function returns Stop, the iteration stops without an error.
+func (k *KVS) ForEachWithPrefix(prefix, start string, keysOnly bool, fn func(key string, value []byte) error) error {
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = []byte(prefix)
+	opts.PrefetchValues = !keysOnly
+
+	err := k.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Seek([]byte(start)); it.ValidForPrefix(opts.Prefix); it.Next() {
+			item := it.Item()
+
+			var err error
+			if keysOnly {
+				err = fn(string(item.Key()), nil)
+			} else {
+				err = item.Value(func(val []byte) error {
+					return fn(string(item.Key()), val)
+				})
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	switch {
+	case err == nil || err == Stop:
+		return nil
+	default:
+		return errors.Annotate(err, "failed to iterate").Err()
+	}
+}
+
+// DeletePrefix deletes all key/value pairs in KVS whose key has the prefix.
+func (k *KVS) DeletePrefix(prefix string) error {
+	if err := k.db.DropPrefix([]byte(prefix)); err != nil {
+		return errors.Annotate(err, "failed to delete prefix %q", prefix).Err()
+	}
+	return nil
+}
@@ -71,4 +71,60 @@ func TestCache(t *testing.T) {
 
 		So(k.Close(), ShouldBeNil)
 	})
+
+	Convey("prefix", t, func() {
+		k, err := New(context.Background(), filepath.Join(t.TempDir(), "db"))
+		So(err, ShouldBeNil)
+		defer k.Close()
+
+		So(k.Insert(func(insert func(key string, value []byte) error) error {
+			for _, key := range []string{"a/1", "a/2", "a/3", "b/1"} {
+				So(insert(key, []byte("v"+key)), ShouldBeNil)
+			}
+			return nil
+		}), ShouldBeNil)
+
+		forEach := func(prefix, start string, keysOnly bool, limit int) (keys, values []string) {
+			So(k.ForEachWithPrefix(prefix, start, keysOnly, func(key string, value []byte) error {
+				keys = append(keys, key)
+				values = append(values, string(value))
+				if len(keys) == limit {
+					return Stop
+				}
+				return nil
+			}), ShouldBeNil)
+			return
+		}
+
+		Convey("ForEachWithPrefix", func() {
+			keys, values := forEach("a/", "", false, 0)
+			So(keys, ShouldResemble, []string{"a/1", "a/2", "a/3"})
+			So(values, ShouldResemble, []string{"va/1", "va/2", "va/3"})
+
+			keys, values = forEach("a/", "a/2", true, 0)
+			So(keys, ShouldResemble, []string{"a/2", "a/3"})
+			So(values, ShouldResemble, []string{"", ""})
+
+			keys, _ = forEach("a/", "", false, 2)
+			So(keys, ShouldResemble, []string{"a/1", "a/2"})
+		})
+
+		Convey("Insert existing", func() {
+			So(k.Insert(func(insert func(key string, value []byte) error) error {
+				if err := insert("a/4", nil); err != nil {
+					return err
+				}
+				return insert("a/1", nil)
+			}), ShouldEqual, ErrExists)
+
+			keys, _ := forEach("a/", "", true, 0)
+			So(keys, ShouldResemble, []string{"a/1", "a/2", "a/3"})
+		})
+
+		Convey("DeletePrefix", func() {
+			So(k.DeletePrefix("a/"), ShouldBeNil)
+			keys, _ := forEach("", "", true, 0)
+			So(keys, ShouldResemble, []string{"b/1"})
+		})
+	})
 }
@@ -56,6 +56,7 @@ require (
 	github.com/vmihailenco/msgpack/v5 v5.3.5
 	github.com/yosuke-furukawa/json5 v0.1.1
 	github.com/yuin/gopher-lua v0.0.0-20220504180219-658193537a64
+	github.com/zeebo/blake3 v0.2.3
 	go.opencensus.io v0.24.0
 	go.starlark.net v0.0.0-20230122040757-066229b0515d
 	golang.org/x/crypto v0.5.0
@@ -101,6 +102,7 @@ require (
 	github.com/iancoleman/strcase v0.2.0 // indirect
 	github.com/jmespath/go-jmespath v0.4.0 // indirect
 	github.com/jtolds/gls v4.20.0+incompatible // indirect
+	github.com/klauspost/cpuid/v2 v2.0.12 // indirect
 	github.com/kr/pretty v0.3.0 // indirect
 	github.com/lyft/protoc-gen-star v0.6.1 // indirect
 	github.com/mattn/go-colorable v0.1.12 // indirect
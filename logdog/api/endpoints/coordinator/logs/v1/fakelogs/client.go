@@ -105,6 +105,13 @@ func (c *Client) Query(_ context.Context, in *logs_api.QueryRequest, _ ...grpc.C
 	return c.logsServ.Query(c.ctx, realIn)
 }
 
+// Search implements logs.Search.
+func (c *Client) Search(_ context.Context, in *logs_api.SearchRequest, _ ...grpc.CallOption) (*logs_api.SearchResponse, error) {
+	realIn := proto.Clone(in).(*logs_api.SearchRequest)
+	realIn.Project = Project
+	return c.logsServ.Search(c.ctx, realIn)
+}
+
 // OpenTextStream returns a stream for text (line delimited) data.
 //
 //   - Lines are always delimited with "\n".
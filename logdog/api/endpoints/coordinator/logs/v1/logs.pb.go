@@ -636,9 +636,202 @@ func (x *QueryResponse) GetNext() string {
 	return ""
 }
 
+// SearchRequest is the request structure for the user Search endpoint.
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// (required) The project to search in.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// (required) The stream query path, as in QueryRequest.
+	//
+	// Only text log streams matching it are searched.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// (required) The RE2 regular expression to match log lines against.
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// MaxMatches is the maximum number of matches to return.
+	//
+	// If MaxMatches is zero or exceeds the internal limit, the internal limit is
+	// used.
+	MaxMatches int32 `protobuf:"varint,4,opt,name=max_matches,json=maxMatches,proto3" json:"max_matches,omitempty"`
+	// Next, if not empty, indicates that this search should continue at the
+	// point where the previous search left off.
+	Next string `protobuf:"bytes,5,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetMaxMatches() int32 {
+	if x != nil {
+		return x.MaxMatches
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+// SearchResponse is the response structure for the user Search endpoint.
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Project is the project name that all matches belong to.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// The matching lines, ordered by the log stream path (in the query order)
+	// and then by their position in the stream.
+	Matches []*SearchResponse_Match `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Searched is the number of archived log streams that were scanned to the
+	// end.
+	Searched int32 `protobuf:"varint,3,opt,name=searched,proto3" json:"searched,omitempty"`
+	// Skipped are the paths of matching log streams that were not scanned since
+	// they are not archived yet.
+	Skipped []string `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	// Truncated is true if the search stopped after finding MaxMatches matches.
+	//
+	// In this case, Next continues the search right after the last match.
+	Truncated bool `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// If not empty, indicates that there are more log streams to search. They
+	// can be searched by repeating the Search request with the same Path and
+	// Pattern fields and supplying this value in the Next field.
+	Next string `protobuf:"bytes,6,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchResponse) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *SearchResponse) GetMatches() []*SearchResponse_Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetSearched() int32 {
+	if x != nil {
+		return x.Searched
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *SearchResponse) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
 // If supplied, the response will contain a SignedUrls message with the
 // requested signed URLs. If signed URLs are not supported by the log's
-// current storage system, the response message will be empty.
+// current storage system, or the log is archived in compressed form, the
+// response message will be empty.
 type GetRequest_SignURLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -655,7 +848,7 @@ type GetRequest_SignURLRequest struct {
 func (x *GetRequest_SignURLRequest) Reset() {
 	*x = GetRequest_SignURLRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[5]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -668,7 +861,7 @@ func (x *GetRequest_SignURLRequest) String() string {
 func (*GetRequest_SignURLRequest) ProtoMessage() {}
 
 func (x *GetRequest_SignURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[5]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,7 +915,7 @@ type GetResponse_SignedUrls struct {
 func (x *GetResponse_SignedUrls) Reset() {
 	*x = GetResponse_SignedUrls{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[6]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -735,7 +928,7 @@ func (x *GetResponse_SignedUrls) String() string {
 func (*GetResponse_SignedUrls) ProtoMessage() {}
 
 func (x *GetResponse_SignedUrls) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[6]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,7 +978,7 @@ type QueryRequest_StreamTypeFilter struct {
 func (x *QueryRequest_StreamTypeFilter) Reset() {
 	*x = QueryRequest_StreamTypeFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[7]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -798,7 +991,7 @@ func (x *QueryRequest_StreamTypeFilter) String() string {
 func (*QueryRequest_StreamTypeFilter) ProtoMessage() {}
 
 func (x *QueryRequest_StreamTypeFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[7]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,7 +1043,7 @@ type QueryResponse_Stream struct {
 func (x *QueryResponse_Stream) Reset() {
 	*x = QueryResponse_Stream{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[9]
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -863,7 +1056,7 @@ func (x *QueryResponse_Stream) String() string {
 func (*QueryResponse_Stream) ProtoMessage() {}
 
 func (x *QueryResponse_Stream) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[9]
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,6 +1100,82 @@ func (x *QueryResponse_Stream) GetDescProto() []byte {
 	return nil
 }
 
+// Match is a single log line matching the pattern.
+type SearchResponse_Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path is the log stream path.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// StreamIndex is the index of the log entry in the stream.
+	StreamIndex uint64 `protobuf:"varint,2,opt,name=stream_index,json=streamIndex,proto3" json:"stream_index,omitempty"`
+	// Line is the index of the line within the log entry.
+	Line int32 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	// Text is the line text, without the delimiter.
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *SearchResponse_Match) Reset() {
+	*x = SearchResponse_Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse_Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse_Match) ProtoMessage() {}
+
+func (x *SearchResponse_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse_Match.ProtoReflect.Descriptor instead.
+func (*SearchResponse_Match) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *SearchResponse_Match) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SearchResponse_Match) GetStreamIndex() uint64 {
+	if x != nil {
+		return x.StreamIndex
+	}
+	return 0
+}
+
+func (x *SearchResponse_Match) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *SearchResponse_Match) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
 var File_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_rawDesc = []byte{
@@ -1035,22 +1304,54 @@ var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_prot
 	0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
 	0x72, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x5f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x73,
-	0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x9e, 0x01, 0x0a, 0x04, 0x4c, 0x6f, 0x67, 0x73, 0x12,
-	0x2e, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x67,
-	0x64, 0x6f, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x30, 0x0a, 0x04, 0x54, 0x61, 0x69, 0x6c, 0x12, 0x13, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67,
-	0x2e, 0x54, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c,
-	0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x34, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x2e, 0x6c, 0x6f, 0x67,
-	0x64, 0x6f, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f,
-	0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
-	0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0xb2, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65,
+	0x78, 0x74, 0x1a, 0x66, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x32, 0xd7, 0x01, 0x0a, 0x04, 0x4c,
+	0x6f, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x6c, 0x6f, 0x67,
+	0x64, 0x6f, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x54, 0x61, 0x69, 0x6c, 0x12, 0x13, 0x2e, 0x6c, 0x6f,
+	0x67, 0x64, 0x6f, 0x67, 0x2e, 0x54, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14,
+	0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c,
+	0x6f, 0x67, 0x64, 0x6f, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x6c, 0x6f, 0x67,
+	0x64, 0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x6c, 0x6f,
+	0x67, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x67, 0x64, 0x6f, 0x67, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1066,7 +1367,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 }
 
 var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_goTypes = []interface{}{
 	(QueryRequest_Trinary)(0),             // 0: logdog.QueryRequest.Trinary
 	(*GetRequest)(nil),                    // 1: logdog.GetRequest
@@ -1074,44 +1375,50 @@ var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_prot
 	(*GetResponse)(nil),                   // 3: logdog.GetResponse
 	(*QueryRequest)(nil),                  // 4: logdog.QueryRequest
 	(*QueryResponse)(nil),                 // 5: logdog.QueryResponse
-	(*GetRequest_SignURLRequest)(nil),     // 6: logdog.GetRequest.SignURLRequest
-	(*GetResponse_SignedUrls)(nil),        // 7: logdog.GetResponse.SignedUrls
-	(*QueryRequest_StreamTypeFilter)(nil), // 8: logdog.QueryRequest.StreamTypeFilter
-	nil,                                   // 9: logdog.QueryRequest.TagsEntry
-	(*QueryResponse_Stream)(nil),          // 10: logdog.QueryResponse.Stream
-	(*LogStreamState)(nil),                // 11: logdog.LogStreamState
-	(*logpb.LogStreamDescriptor)(nil),     // 12: logpb.LogStreamDescriptor
-	(*logpb.LogEntry)(nil),                // 13: logpb.LogEntry
-	(*durationpb.Duration)(nil),           // 14: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),         // 15: google.protobuf.Timestamp
-	(logpb.StreamType)(0),                 // 16: logpb.StreamType
+	(*SearchRequest)(nil),                 // 6: logdog.SearchRequest
+	(*SearchResponse)(nil),                // 7: logdog.SearchResponse
+	(*GetRequest_SignURLRequest)(nil),     // 8: logdog.GetRequest.SignURLRequest
+	(*GetResponse_SignedUrls)(nil),        // 9: logdog.GetResponse.SignedUrls
+	(*QueryRequest_StreamTypeFilter)(nil), // 10: logdog.QueryRequest.StreamTypeFilter
+	nil,                                   // 11: logdog.QueryRequest.TagsEntry
+	(*QueryResponse_Stream)(nil),          // 12: logdog.QueryResponse.Stream
+	(*SearchResponse_Match)(nil),          // 13: logdog.SearchResponse.Match
+	(*LogStreamState)(nil),                // 14: logdog.LogStreamState
+	(*logpb.LogStreamDescriptor)(nil),     // 15: logpb.LogStreamDescriptor
+	(*logpb.LogEntry)(nil),                // 16: logpb.LogEntry
+	(*durationpb.Duration)(nil),           // 17: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),         // 18: google.protobuf.Timestamp
+	(logpb.StreamType)(0),                 // 19: logpb.StreamType
 }
 var file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_depIdxs = []int32{
-	6,  // 0: logdog.GetRequest.get_signed_urls:type_name -> logdog.GetRequest.SignURLRequest
-	11, // 1: logdog.GetResponse.state:type_name -> logdog.LogStreamState
-	12, // 2: logdog.GetResponse.desc:type_name -> logpb.LogStreamDescriptor
-	13, // 3: logdog.GetResponse.logs:type_name -> logpb.LogEntry
-	7,  // 4: logdog.GetResponse.signed_urls:type_name -> logdog.GetResponse.SignedUrls
-	8,  // 5: logdog.QueryRequest.stream_type:type_name -> logdog.QueryRequest.StreamTypeFilter
-	9,  // 6: logdog.QueryRequest.tags:type_name -> logdog.QueryRequest.TagsEntry
+	8,  // 0: logdog.GetRequest.get_signed_urls:type_name -> logdog.GetRequest.SignURLRequest
+	14, // 1: logdog.GetResponse.state:type_name -> logdog.LogStreamState
+	15, // 2: logdog.GetResponse.desc:type_name -> logpb.LogStreamDescriptor
+	16, // 3: logdog.GetResponse.logs:type_name -> logpb.LogEntry
+	9,  // 4: logdog.GetResponse.signed_urls:type_name -> logdog.GetResponse.SignedUrls
+	10, // 5: logdog.QueryRequest.stream_type:type_name -> logdog.QueryRequest.StreamTypeFilter
+	11, // 6: logdog.QueryRequest.tags:type_name -> logdog.QueryRequest.TagsEntry
 	0,  // 7: logdog.QueryRequest.purged:type_name -> logdog.QueryRequest.Trinary
-	10, // 8: logdog.QueryResponse.streams:type_name -> logdog.QueryResponse.Stream
-	14, // 9: logdog.GetRequest.SignURLRequest.lifetime:type_name -> google.protobuf.Duration
-	15, // 10: logdog.GetResponse.SignedUrls.expiration:type_name -> google.protobuf.Timestamp
-	16, // 11: logdog.QueryRequest.StreamTypeFilter.value:type_name -> logpb.StreamType
-	11, // 12: logdog.QueryResponse.Stream.state:type_name -> logdog.LogStreamState
-	12, // 13: logdog.QueryResponse.Stream.desc:type_name -> logpb.LogStreamDescriptor
-	1,  // 14: logdog.Logs.Get:input_type -> logdog.GetRequest
-	2,  // 15: logdog.Logs.Tail:input_type -> logdog.TailRequest
-	4,  // 16: logdog.Logs.Query:input_type -> logdog.QueryRequest
-	3,  // 17: logdog.Logs.Get:output_type -> logdog.GetResponse
-	3,  // 18: logdog.Logs.Tail:output_type -> logdog.GetResponse
-	5,  // 19: logdog.Logs.Query:output_type -> logdog.QueryResponse
-	17, // [17:20] is the sub-list for method output_type
-	14, // [14:17] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	12, // 8: logdog.QueryResponse.streams:type_name -> logdog.QueryResponse.Stream
+	13, // 9: logdog.SearchResponse.matches:type_name -> logdog.SearchResponse.Match
+	17, // 10: logdog.GetRequest.SignURLRequest.lifetime:type_name -> google.protobuf.Duration
+	18, // 11: logdog.GetResponse.SignedUrls.expiration:type_name -> google.protobuf.Timestamp
+	19, // 12: logdog.QueryRequest.StreamTypeFilter.value:type_name -> logpb.StreamType
+	14, // 13: logdog.QueryResponse.Stream.state:type_name -> logdog.LogStreamState
+	15, // 14: logdog.QueryResponse.Stream.desc:type_name -> logpb.LogStreamDescriptor
+	1,  // 15: logdog.Logs.Get:input_type -> logdog.GetRequest
+	2,  // 16: logdog.Logs.Tail:input_type -> logdog.TailRequest
+	4,  // 17: logdog.Logs.Query:input_type -> logdog.QueryRequest
+	6,  // 18: logdog.Logs.Search:input_type -> logdog.SearchRequest
+	3,  // 19: logdog.Logs.Get:output_type -> logdog.GetResponse
+	3,  // 20: logdog.Logs.Tail:output_type -> logdog.GetResponse
+	5,  // 21: logdog.Logs.Query:output_type -> logdog.QueryResponse
+	7,  // 22: logdog.Logs.Search:output_type -> logdog.SearchResponse
+	19, // [19:23] is the sub-list for method output_type
+	15, // [15:19] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_init() }
@@ -1182,7 +1489,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRequest_SignURLRequest); i {
+			switch v := v.(*SearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1194,7 +1501,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetResponse_SignedUrls); i {
+			switch v := v.(*SearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1206,7 +1513,19 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRequest_StreamTypeFilter); i {
+			switch v := v.(*GetRequest_SignURLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResponse_SignedUrls); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1218,6 +1537,18 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 			}
 		}
 		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequest_StreamTypeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryResponse_Stream); i {
 			case 0:
 				return &v.state
@@ -1229,6 +1560,18 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 				return nil
 			}
 		}
+		file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1236,7 +1579,7 @@ func file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_pro
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_logdog_api_endpoints_coordinator_logs_v1_logs_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1269,6 +1612,9 @@ type LogsClient interface {
 	Tail(ctx context.Context, in *TailRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	// Query returns log stream paths that match the requested query.
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// Search returns lines of archived text log streams that match a regular
+	// expression.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 }
 type logsPRPCClient struct {
 	client *prpc.Client
@@ -1305,6 +1651,15 @@ func (c *logsPRPCClient) Query(ctx context.Context, in *QueryRequest, opts ...gr
 	return out, nil
 }
 
+func (c *logsPRPCClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.client.Call(ctx, "logdog.Logs", "Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type logsClient struct {
 	cc grpc.ClientConnInterface
 }
@@ -1340,6 +1695,15 @@ func (c *logsClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *logsClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/logdog.Logs/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LogsServer is the server API for Logs service.
 type LogsServer interface {
 	// Get returns state and log data for a single log stream.
@@ -1348,6 +1712,9 @@ type LogsServer interface {
 	Tail(context.Context, *TailRequest) (*GetResponse, error)
 	// Query returns log stream paths that match the requested query.
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// Search returns lines of archived text log streams that match a regular
+	// expression.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 }
 
 // UnimplementedLogsServer can be embedded to have forward compatible implementations.
@@ -1363,6 +1730,9 @@ func (*UnimplementedLogsServer) Tail(context.Context, *TailRequest) (*GetRespons
 func (*UnimplementedLogsServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (*UnimplementedLogsServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
 
 func RegisterLogsServer(s prpc.Registrar, srv LogsServer) {
 	s.RegisterService(&_Logs_serviceDesc, srv)
@@ -1422,6 +1792,24 @@ func _Logs_Query_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Logs_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogsServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logdog.Logs/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogsServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Logs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "logdog.Logs",
 	HandlerType: (*LogsServer)(nil),
@@ -1438,6 +1826,10 @@ var _Logs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _Logs_Query_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _Logs_Search_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1/logs.proto",
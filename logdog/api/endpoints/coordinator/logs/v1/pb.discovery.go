@@ -12,993 +12,1021 @@ func init() {
 			"logdog.Logs",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 125, 112, 28, 71,
-			118, 24, 190, 243, 177, 139, 69, 3, 36, 128, 1, 72, 65, 195,
-			175, 230, 74, 20, 1, 114, 177, 248, 160, 72, 73, 160, 116, 62,
-			16, 88, 146, 171, 3, 1, 104, 177, 144, 142, 82, 169, 200, 217,
-			221, 222, 197, 136, 179, 51, 171, 153, 89, 128, 144, 78, 191, 179,
-			207, 246, 79, 103, 95, 157, 127, 191, 251, 112, 165, 206, 57, 251,
-			143, 187, 74, 114, 165, 179, 19, 87, 108, 87, 165, 226, 186, 43,
-			219, 151, 171, 196, 142, 191, 147, 178, 47, 78, 124, 174, 56, 103,
-			95, 226, 186, 248, 15, 151, 227, 36, 85, 46, 39, 245, 94, 119,
-			207, 199, 2, 164, 72, 159, 156, 148, 93, 98, 73, 228, 190, 158,
-			158, 238, 247, 94, 119, 191, 126, 253, 62, 122, 200, 191, 156, 39,
-			167, 218, 158, 215, 118, 216, 108, 215, 247, 66, 175, 222, 107, 205,
-			134, 118, 135, 5, 161, 213, 233, 150, 176, 200, 24, 225, 21, 74,
-			178, 66, 225, 50, 25, 172, 201, 58, 198, 36, 25, 8, 88, 195,
-			115, 155, 193, 164, 66, 149, 41, 173, 42, 65, 99, 130, 100, 93,
-			203, 245, 130, 73, 149, 42, 83, 217, 42, 7, 174, 252, 160, 66,
-			198, 27, 94, 167, 212, 215, 232, 149, 195, 81, 147, 27, 80, 180,
-			161, 188, 188, 32, 170, 180, 61, 199, 114, 219, 37, 207, 111, 39,
-			112, 220, 235, 178, 96, 246, 142, 235, 237, 186, 49, 190, 221, 250,
-			255, 80, 148, 47, 168, 218, 181, 141, 43, 239, 168, 39, 175, 241,
-			183, 55, 196, 43, 165, 151, 152, 227, 124, 8, 94, 168, 193, 187,
-			207, 127, 125, 150, 12, 24, 217, 147, 153, 79, 43, 10, 249, 213,
-			97, 162, 12, 27, 218, 201, 140, 177, 240, 139, 195, 20, 223, 104,
-			120, 14, 189, 210, 107, 181, 152, 31, 208, 25, 202, 219, 58, 27,
-			208, 166, 21, 90, 212, 118, 67, 230, 55, 182, 45, 183, 205, 104,
-			203, 243, 59, 86, 72, 232, 178, 215, 221, 243, 237, 246, 118, 72,
-			23, 230, 230, 158, 22, 47, 208, 138, 219, 40, 81, 186, 228, 56,
-			20, 159, 5, 212, 103, 1, 243, 119, 88, 179, 68, 232, 118, 24,
-			118, 131, 197, 217, 217, 38, 219, 97, 142, 215, 101, 126, 32, 121,
-			210, 240, 58, 156, 210, 134, 231, 204, 212, 57, 18, 179, 132, 208,
-			42, 107, 218, 65, 232, 219, 245, 94, 104, 123, 46, 181, 220, 38,
-			237, 5, 140, 218, 46, 13, 188, 158, 223, 96, 88, 82, 183, 93,
-			203, 223, 67, 188, 130, 34, 221, 181, 195, 109, 234, 249, 248, 175,
-			215, 11, 9, 237, 120, 77, 187, 101, 55, 44, 104, 161, 72, 45,
-			159, 209, 46, 243, 59, 118, 24, 178, 38, 237, 250, 222, 142, 221,
-			100, 77, 26, 110, 91, 33, 13, 183, 129, 58, 199, 241, 118, 109,
-			183, 77, 97, 72, 109, 120, 41, 128, 151, 8, 237, 176, 112, 145,
-			16, 10, 127, 206, 245, 33, 22, 80, 175, 37, 49, 106, 120, 77,
-			70, 59, 189, 32, 164, 62, 11, 45, 219, 197, 86, 173, 186, 183,
-			3, 143, 4, 199, 8, 117, 189, 208, 110, 176, 34, 13, 183, 237,
-			128, 58, 118, 16, 66, 11, 201, 30, 221, 102, 31, 58, 77, 59,
-			104, 56, 150, 221, 97, 126, 233, 94, 72, 216, 110, 146, 23, 18,
-			137, 174, 239, 53, 123, 13, 22, 227, 65, 98, 68, 190, 43, 60,
-			8, 21, 212, 53, 189, 70, 175, 195, 220, 208, 146, 131, 52, 235,
-			249, 212, 11, 183, 153, 79, 59, 86, 200, 124, 219, 114, 130, 152,
-			213, 56, 64, 225, 54, 35, 52, 137, 125, 68, 212, 26, 179, 241,
-			77, 104, 216, 181, 58, 12, 16, 74, 206, 45, 215, 139, 159, 33,
-			223, 237, 48, 0, 138, 92, 222, 148, 231, 7, 180, 99, 237, 209,
-			58, 131, 153, 210, 164, 161, 71, 153, 219, 244, 252, 128, 193, 164,
-			232, 250, 94, 199, 11, 25, 229, 60, 9, 3, 218, 100, 190, 189,
-			195, 154, 180, 229, 123, 29, 194, 185, 16, 120, 173, 112, 23, 166,
-			137, 152, 65, 52, 232, 178, 6, 204, 32, 218, 245, 109, 152, 88,
-			62, 204, 29, 151, 207, 162, 32, 64, 220, 9, 173, 93, 175, 108,
-			210, 205, 245, 171, 181, 151, 150, 170, 101, 90, 217, 164, 27, 213,
-			245, 23, 43, 43, 229, 21, 122, 229, 38, 173, 93, 47, 211, 229,
-			245, 141, 155, 213, 202, 181, 235, 53, 122, 125, 125, 117, 165, 92,
-			221, 164, 75, 107, 43, 116, 121, 125, 173, 86, 173, 92, 217, 170,
-			173, 87, 55, 9, 45, 44, 109, 210, 202, 102, 1, 159, 44, 173,
-			221, 164, 229, 15, 111, 84, 203, 155, 155, 116, 189, 74, 43, 55,
-			54, 86, 43, 229, 21, 250, 210, 82, 181, 186, 180, 86, 171, 148,
-			55, 139, 180, 178, 182, 188, 186, 181, 82, 89, 187, 86, 164, 87,
-			182, 106, 116, 109, 189, 70, 232, 106, 229, 70, 165, 86, 94, 161,
-			181, 245, 34, 118, 187, 255, 61, 186, 126, 149, 222, 40, 87, 151,
-			175, 47, 173, 213, 150, 174, 84, 86, 43, 181, 155, 216, 225, 213,
-			74, 109, 13, 58, 187, 186, 94, 37, 116, 137, 110, 44, 85, 107,
-			149, 229, 173, 213, 165, 42, 221, 216, 170, 110, 172, 111, 150, 41,
-			80, 182, 82, 217, 92, 94, 93, 170, 220, 40, 175, 148, 104, 101,
-			141, 174, 173, 211, 242, 139, 229, 181, 26, 221, 188, 190, 180, 186,
-			154, 38, 148, 208, 245, 151, 214, 202, 85, 192, 62, 73, 38, 189,
-			82, 166, 171, 149, 165, 43, 171, 101, 232, 10, 233, 92, 169, 84,
-			203, 203, 53, 32, 40, 254, 181, 92, 89, 41, 175, 213, 150, 86,
-			139, 132, 110, 110, 148, 151, 43, 75, 171, 69, 90, 254, 112, 249,
-			198, 198, 234, 82, 245, 102, 81, 52, 186, 89, 126, 97, 171, 188,
-			86, 171, 44, 173, 210, 149, 165, 27, 75, 215, 202, 155, 116, 234,
-			221, 184, 178, 81, 93, 95, 222, 170, 150, 111, 0, 214, 235, 87,
-			233, 230, 214, 149, 205, 90, 165, 182, 85, 43, 211, 107, 235, 235,
-			43, 200, 236, 205, 114, 245, 197, 202, 114, 121, 243, 50, 93, 93,
-			223, 68, 134, 109, 109, 150, 139, 132, 174, 44, 213, 150, 176, 235,
-			141, 234, 250, 213, 74, 109, 243, 50, 252, 190, 178, 181, 89, 65,
-			198, 85, 214, 106, 229, 106, 117, 107, 163, 86, 89, 95, 155, 166,
-			215, 215, 95, 42, 191, 88, 174, 210, 229, 165, 173, 205, 242, 10,
-			114, 120, 125, 13, 168, 133, 185, 82, 94, 175, 222, 132, 102, 129,
-			15, 56, 2, 69, 250, 210, 245, 114, 237, 122, 185, 10, 76, 69,
-			110, 45, 1, 27, 54, 107, 213, 202, 114, 45, 89, 109, 189, 74,
-			107, 235, 213, 26, 73, 208, 73, 215, 202, 215, 86, 43, 215, 202,
-			107, 203, 101, 120, 188, 14, 205, 188, 84, 217, 44, 79, 211, 165,
-			106, 101, 19, 42, 84, 176, 99, 250, 210, 210, 77, 186, 190, 133,
-			84, 195, 64, 109, 109, 150, 9, 255, 157, 152, 186, 69, 28, 79,
-			90, 185, 74, 151, 86, 94, 172, 0, 230, 162, 246, 198, 250, 230,
-			102, 69, 76, 23, 100, 219, 242, 117, 193, 243, 18, 33, 121, 162,
-			168, 134, 70, 51, 147, 240, 43, 111, 104, 133, 204, 101, 50, 72,
-			212, 252, 25, 254, 147, 23, 62, 150, 57, 133, 133, 167, 248, 79,
-			94, 248, 120, 166, 130, 133, 67, 252, 39, 47, 60, 147, 41, 98,
-			161, 194, 127, 242, 194, 39, 50, 179, 88, 40, 126, 242, 194, 179,
-			153, 2, 22, 18, 254, 147, 23, 78, 101, 78, 99, 225, 227, 252,
-			231, 55, 79, 16, 85, 207, 24, 185, 183, 21, 216, 250, 204, 223,
-			56, 65, 151, 104, 180, 245, 162, 128, 100, 1, 115, 195, 128, 90,
-			180, 235, 217, 110, 136, 98, 205, 238, 192, 54, 211, 100, 93, 230,
-			54, 153, 139, 98, 209, 114, 247, 120, 249, 27, 158, 139, 210, 196,
-			241, 26, 150, 67, 104, 195, 114, 152, 219, 180, 252, 34, 101, 46,
-			72, 255, 38, 181, 160, 173, 134, 215, 227, 239, 9, 237, 0, 101,
-			105, 203, 183, 26, 241, 142, 33, 31, 192, 134, 0, 170, 2, 194,
-			176, 99, 122, 14, 23, 138, 180, 182, 205, 68, 67, 54, 108, 165,
-			142, 21, 218, 59, 12, 132, 154, 229, 82, 214, 245, 26, 219, 212,
-			10, 233, 86, 109, 153, 118, 236, 166, 139, 18, 221, 115, 9, 125,
-			222, 114, 123, 176, 13, 204, 23, 233, 252, 51, 79, 205, 21, 165,
-			160, 238, 250, 158, 195, 186, 161, 221, 160, 215, 124, 214, 246, 124,
-			219, 114, 35, 236, 233, 238, 182, 221, 216, 166, 236, 110, 200, 0,
-			39, 20, 208, 7, 212, 170, 91, 141, 59, 187, 150, 15, 53, 60,
-			186, 199, 44, 159, 122, 46, 3, 1, 8, 91, 126, 199, 118, 123,
-			33, 195, 253, 146, 94, 154, 139, 232, 115, 60, 183, 93, 162, 171,
-			204, 234, 198, 36, 251, 140, 22, 130, 14, 179, 124, 214, 44, 208,
-			192, 227, 27, 176, 235, 81, 135, 89, 93, 34, 170, 209, 208, 170,
-			59, 12, 40, 119, 25, 3, 190, 182, 60, 159, 171, 34, 93, 216,
-			91, 249, 134, 222, 11, 96, 87, 178, 232, 43, 11, 79, 206, 108,
-			123, 61, 159, 58, 182, 203, 44, 159, 80, 108, 253, 213, 169, 251,
-			43, 29, 48, 158, 179, 88, 115, 26, 165, 248, 54, 163, 62, 106,
-			57, 118, 128, 123, 2, 157, 155, 155, 155, 159, 193, 255, 106, 115,
-			115, 139, 248, 223, 203, 64, 250, 51, 207, 60, 243, 204, 204, 252,
-			194, 204, 133, 249, 218, 194, 133, 197, 139, 207, 44, 94, 124, 166,
-			244, 140, 252, 243, 114, 137, 94, 217, 35, 48, 144, 161, 111, 55,
-			66, 64, 48, 20, 36, 98, 235, 69, 186, 203, 40, 115, 131, 158,
-			207, 120, 233, 46, 163, 13, 224, 178, 231, 238, 48, 63, 228, 227,
-			203, 55, 37, 250, 74, 245, 234, 50, 161, 23, 46, 92, 120, 38,
-			166, 101, 119, 119, 183, 100, 179, 176, 133, 26, 162, 223, 106, 192,
-			255, 80, 163, 20, 222, 13, 167, 65, 99, 99, 20, 122, 118, 219,
-			1, 16, 245, 24, 45, 223, 181, 58, 93, 135, 5, 132, 200, 159,
-			116, 126, 145, 46, 123, 157, 110, 47, 100, 137, 181, 128, 29, 110,
-			172, 111, 86, 62, 76, 111, 3, 103, 166, 166, 111, 151, 132, 202,
-			19, 87, 138, 148, 207, 203, 252, 73, 172, 60, 7, 44, 188, 37,
-			6, 120, 10, 95, 95, 219, 90, 93, 157, 158, 62, 176, 30, 206,
-			247, 169, 185, 233, 203, 9, 156, 22, 222, 13, 167, 54, 11, 161,
-			21, 175, 213, 180, 246, 18, 184, 5, 161, 223, 107, 132, 216, 193,
-			142, 229, 208, 112, 71, 244, 152, 170, 254, 68, 184, 83, 164, 136,
-			208, 229, 191, 46, 73, 59, 165, 112, 7, 160, 251, 81, 196, 43,
-			245, 2, 214, 160, 231, 232, 252, 220, 92, 154, 194, 11, 247, 164,
-			240, 37, 219, 189, 176, 64, 111, 95, 99, 225, 230, 94, 16, 178,
-			14, 60, 94, 10, 174, 218, 14, 171, 165, 7, 226, 106, 101, 181,
-			92, 171, 220, 40, 211, 86, 40, 208, 184, 215, 59, 79, 180, 66,
-			137, 233, 86, 101, 173, 118, 233, 73, 26, 218, 141, 59, 1, 125,
-			142, 78, 77, 77, 241, 146, 233, 86, 88, 106, 238, 94, 183, 219,
-			219, 43, 86, 136, 111, 77, 211, 103, 159, 165, 23, 22, 166, 233,
-			71, 40, 62, 91, 245, 118, 229, 35, 201, 183, 217, 89, 186, 4,
-			248, 54, 189, 221, 0, 155, 132, 197, 50, 63, 55, 151, 144, 97,
-			65, 41, 170, 192, 165, 212, 252, 165, 253, 203, 40, 106, 13, 94,
-			159, 191, 244, 228, 147, 79, 62, 117, 225, 210, 92, 44, 54, 234,
-			172, 229, 249, 140, 110, 185, 246, 93, 217, 202, 51, 79, 205, 245,
-			183, 82, 250, 235, 13, 230, 20, 167, 159, 78, 77, 113, 166, 204,
-			226, 96, 193, 159, 105, 58, 147, 68, 231, 93, 102, 48, 180, 3,
-			236, 146, 237, 156, 73, 180, 131, 19, 96, 58, 53, 1, 158, 188,
-			231, 4, 120, 222, 218, 177, 232, 109, 62, 144, 165, 70, 207, 247,
-			153, 27, 66, 149, 27, 182, 227, 216, 65, 98, 2, 128, 52, 165,
-			29, 44, 165, 207, 209, 123, 191, 112, 159, 105, 78, 159, 139, 75,
-			75, 46, 219, 189, 210, 179, 157, 38, 243, 167, 166, 129, 176, 77,
-			193, 33, 209, 5, 103, 204, 52, 111, 11, 254, 64, 157, 53, 78,
-			187, 237, 134, 64, 185, 168, 201, 73, 23, 100, 35, 7, 166, 75,
-			117, 104, 25, 113, 137, 121, 112, 241, 93, 120, 80, 113, 131, 208,
-			114, 195, 146, 235, 237, 38, 200, 22, 165, 212, 245, 118, 233, 115,
-			52, 85, 231, 190, 148, 198, 136, 191, 59, 201, 174, 183, 91, 106,
-			179, 176, 12, 147, 141, 151, 77, 77, 39, 40, 79, 83, 47, 42,
-			3, 48, 117, 15, 74, 47, 221, 147, 82, 49, 94, 82, 207, 160,
-			27, 123, 225, 54, 63, 72, 164, 38, 90, 114, 160, 166, 166, 251,
-			103, 225, 53, 22, 46, 199, 227, 62, 53, 141, 178, 254, 249, 205,
-			245, 53, 122, 195, 234, 118, 109, 183, 77, 8, 173, 184, 188, 132,
-			159, 218, 139, 168, 6, 36, 248, 180, 215, 197, 173, 46, 165, 184,
-			240, 173, 67, 232, 12, 4, 55, 160, 135, 218, 127, 120, 87, 160,
-			187, 88, 160, 182, 20, 121, 51, 188, 20, 58, 43, 188, 9, 122,
-			195, 91, 51, 111, 118, 60, 55, 220, 126, 107, 230, 205, 166, 181,
-			247, 86, 237, 77, 216, 188, 223, 90, 124, 179, 99, 187, 111, 45,
-			190, 25, 176, 198, 91, 175, 148, 222, 4, 117, 9, 150, 236, 91,
-			175, 190, 92, 32, 116, 119, 155, 249, 140, 242, 183, 161, 33, 203,
-			217, 181, 246, 2, 202, 238, 130, 6, 7, 135, 61, 174, 11, 180,
-			64, 11, 104, 218, 109, 59, 12, 64, 169, 113, 24, 21, 61, 21,
-			41, 118, 85, 36, 148, 119, 86, 164, 216, 91, 17, 55, 91, 236,
-			18, 245, 146, 55, 152, 239, 205, 116, 173, 102, 147, 31, 31, 195,
-			93, 79, 182, 198, 172, 198, 54, 215, 201, 164, 30, 7, 250, 159,
-			16, 41, 69, 161, 65, 193, 70, 222, 246, 104, 175, 139, 106, 130,
-			124, 117, 202, 46, 177, 146, 40, 156, 63, 88, 219, 155, 46, 18,
-			236, 223, 235, 242, 150, 121, 79, 133, 151, 11, 52, 232, 181, 90,
-			246, 93, 208, 71, 237, 134, 5, 10, 22, 140, 34, 204, 3, 212,
-			68, 167, 10, 91, 181, 229, 194, 244, 229, 84, 41, 225, 10, 227,
-			235, 61, 219, 103, 205, 18, 93, 162, 104, 94, 185, 192, 39, 67,
-			128, 103, 114, 251, 13, 230, 211, 96, 219, 235, 57, 77, 201, 202,
-			94, 192, 80, 155, 156, 178, 130, 168, 183, 38, 173, 239, 17, 64,
-			99, 26, 6, 192, 133, 83, 176, 203, 85, 154, 253, 83, 9, 24,
-			105, 165, 186, 234, 90, 126, 16, 119, 83, 103, 132, 162, 78, 7,
-			26, 78, 163, 193, 186, 33, 173, 123, 225, 54, 246, 9, 239, 114,
-			163, 129, 164, 33, 216, 135, 7, 168, 189, 94, 171, 21, 176, 16,
-			213, 181, 171, 158, 79, 25, 95, 107, 69, 90, 88, 152, 155, 127,
-			10, 118, 135, 249, 139, 181, 185, 249, 197, 11, 115, 139, 243, 23,
-			75, 115, 243, 47, 23, 196, 236, 14, 40, 194, 209, 246, 210, 181,
-			130, 144, 80, 172, 137, 253, 123, 110, 172, 55, 95, 44, 82, 104,
-			173, 36, 22, 144, 181, 99, 109, 54, 124, 187, 27, 22, 65, 219,
-			77, 169, 106, 22, 133, 237, 145, 122, 245, 215, 88, 35, 228, 90,
-			30, 168, 142, 124, 178, 243, 249, 136, 211, 31, 196, 85, 211, 242,
-			155, 132, 190, 18, 122, 149, 205, 245, 77, 92, 100, 83, 211, 7,
-			40, 168, 165, 142, 247, 134, 237, 56, 22, 174, 46, 230, 206, 108,
-			109, 206, 54, 189, 70, 48, 251, 18, 171, 207, 198, 168, 204, 86,
-			89, 139, 249, 204, 109, 176, 217, 107, 142, 87, 183, 156, 91, 235,
-			136, 67, 48, 11, 8, 205, 38, 58, 153, 70, 219, 213, 182, 215,
-			44, 1, 49, 92, 210, 20, 113, 157, 115, 148, 232, 109, 208, 24,
-			129, 233, 37, 249, 227, 182, 36, 8, 72, 173, 51, 73, 45, 107,
-			146, 3, 73, 36, 244, 149, 219, 65, 232, 183, 240, 213, 4, 69,
-			94, 35, 40, 117, 185, 100, 3, 90, 22, 102, 29, 187, 238, 91,
-			254, 30, 170, 221, 165, 237, 176, 227, 60, 134, 191, 228, 187, 211,
-			104, 115, 33, 209, 68, 150, 157, 4, 93, 214, 160, 103, 207, 220,
-			156, 57, 211, 153, 57, 211, 172, 157, 185, 190, 120, 230, 198, 226,
-			153, 205, 210, 153, 214, 203, 103, 75, 116, 213, 190, 195, 118, 237,
-			128, 225, 49, 7, 24, 20, 143, 82, 47, 96, 188, 181, 231, 189,
-			166, 133, 147, 245, 108, 64, 95, 185, 93, 217, 92, 151, 74, 205,
-			85, 46, 172, 154, 2, 156, 154, 190, 253, 234, 20, 183, 84, 10,
-			57, 247, 154, 215, 228, 35, 1, 63, 102, 240, 188, 96, 117, 109,
-			28, 16, 89, 202, 79, 17, 28, 215, 217, 253, 109, 35, 157, 178,
-			131, 51, 11, 43, 103, 22, 86, 8, 157, 6, 70, 122, 117, 180,
-			16, 90, 130, 206, 144, 249, 180, 97, 117, 113, 129, 120, 45, 218,
-			102, 46, 243, 45, 190, 212, 228, 50, 11, 184, 88, 142, 248, 95,
-			34, 132, 144, 33, 162, 233, 25, 197, 208, 223, 86, 242, 99, 228,
-			199, 21, 162, 235, 25, 53, 99, 232, 159, 80, 212, 9, 243, 71,
-			20, 90, 141, 79, 184, 114, 238, 123, 45, 156, 242, 200, 227, 192,
-			118, 27, 73, 45, 139, 28, 172, 102, 209, 27, 189, 32, 132, 185,
-			112, 191, 99, 17, 57, 232, 92, 244, 50, 181, 221, 134, 211, 11,
-			236, 29, 56, 40, 30, 34, 89, 64, 47, 139, 248, 13, 72, 80,
-			1, 48, 63, 34, 65, 13, 64, 99, 156, 252, 17, 39, 70, 49,
-			244, 79, 41, 170, 97, 254, 142, 66, 215, 60, 119, 198, 101, 109,
-			126, 14, 78, 157, 166, 45, 121, 106, 132, 131, 228, 193, 167, 233,
-			53, 241, 98, 116, 192, 220, 177, 156, 30, 11, 184, 73, 50, 110,
-			12, 13, 167, 65, 104, 59, 14, 221, 182, 118, 24, 117, 147, 125,
-			98, 211, 226, 69, 194, 79, 111, 252, 128, 222, 242, 124, 56, 24,
-			75, 235, 65, 63, 195, 196, 161, 177, 40, 254, 39, 7, 48, 69,
-			201, 34, 157, 146, 41, 10, 146, 157, 63, 36, 65, 13, 192, 209,
-			177, 122, 142, 203, 87, 242, 233, 9, 82, 105, 123, 165, 198, 182,
-			239, 117, 236, 94, 7, 39, 169, 211, 107, 216, 179, 142, 215, 110,
-			122, 109, 152, 166, 179, 204, 109, 162, 77, 35, 152, 109, 120, 158,
-			223, 180, 93, 43, 244, 124, 168, 16, 204, 238, 204, 207, 6, 161,
-			21, 10, 135, 132, 145, 227, 111, 153, 239, 230, 28, 41, 124, 70,
-			35, 135, 87, 189, 246, 102, 232, 51, 171, 179, 9, 45, 24, 143,
-			145, 67, 248, 236, 214, 14, 243, 3, 219, 115, 209, 47, 50, 88,
-			29, 198, 194, 23, 121, 153, 241, 36, 25, 104, 248, 12, 4, 57,
-			186, 71, 134, 22, 204, 126, 143, 72, 41, 218, 77, 170, 178, 170,
-			113, 134, 28, 14, 153, 223, 177, 93, 203, 185, 101, 187, 77, 118,
-			119, 82, 67, 159, 203, 33, 89, 90, 129, 66, 227, 89, 50, 96,
-			249, 141, 109, 123, 135, 77, 234, 216, 120, 161, 196, 233, 41, 165,
-			81, 45, 45, 241, 90, 21, 183, 229, 85, 229, 43, 198, 81, 146,
-			235, 246, 252, 54, 107, 78, 102, 169, 50, 149, 175, 10, 200, 252,
-			146, 66, 134, 18, 47, 24, 199, 200, 32, 226, 112, 171, 231, 59,
-			130, 198, 60, 22, 108, 249, 142, 113, 130, 144, 0, 59, 194, 167,
-			42, 62, 29, 228, 37, 240, 248, 81, 146, 111, 90, 161, 133, 15,
-			53, 124, 56, 0, 48, 60, 50, 73, 190, 225, 193, 38, 22, 114,
-			236, 243, 213, 8, 54, 158, 32, 35, 142, 215, 190, 197, 220, 208,
-			223, 187, 133, 19, 13, 113, 212, 170, 135, 28, 175, 93, 134, 210,
-			101, 40, 188, 114, 245, 229, 149, 239, 106, 46, 92, 230, 21, 159,
-			255, 185, 17, 146, 51, 116, 61, 179, 160, 144, 159, 83, 208, 71,
-			164, 103, 140, 133, 119, 148, 148, 187, 103, 254, 18, 170, 38, 171,
-			91, 203, 21, 186, 212, 11, 183, 61, 63, 40, 221, 195, 231, 179,
-			21, 160, 44, 19, 150, 245, 216, 67, 98, 7, 180, 237, 237, 48,
-			223, 5, 181, 205, 109, 10, 131, 255, 82, 215, 106, 64, 195, 118,
-			131, 185, 32, 208, 197, 220, 161, 11, 165, 57, 185, 214, 248, 134,
-			212, 242, 122, 110, 83, 154, 181, 86, 43, 203, 229, 181, 205, 50,
-			109, 217, 14, 139, 140, 145, 185, 204, 136, 48, 7, 230, 51, 175,
-			72, 19, 163, 248, 169, 101, 12, 141, 100, 166, 201, 79, 40, 104,
-			25, 212, 71, 50, 11, 138, 249, 41, 133, 166, 231, 10, 170, 152,
-			180, 110, 55, 109, 159, 9, 101, 143, 226, 138, 225, 171, 159, 251,
-			23, 108, 151, 110, 117, 65, 184, 243, 23, 105, 195, 114, 28, 180,
-			186, 236, 111, 139, 117, 234, 172, 217, 228, 38, 45, 151, 150, 229,
-			104, 160, 150, 198, 130, 112, 214, 103, 65, 215, 115, 3, 38, 236,
-			25, 1, 202, 118, 148, 236, 218, 72, 254, 40, 89, 17, 114, 93,
-			27, 203, 159, 54, 159, 226, 62, 59, 201, 31, 59, 144, 246, 61,
-			92, 72, 84, 172, 67, 52, 152, 113, 214, 35, 42, 37, 66, 134,
-			165, 248, 213, 198, 242, 135, 37, 164, 24, 218, 216, 200, 113, 9,
-			105, 134, 54, 118, 138, 146, 13, 33, 122, 181, 137, 124, 201, 92,
-			198, 1, 199, 253, 2, 149, 64, 232, 205, 241, 218, 162, 93, 186,
-			107, 1, 25, 109, 59, 8, 153, 159, 112, 241, 208, 229, 120, 162,
-			69, 125, 43, 57, 104, 242, 180, 132, 160, 131, 194, 180, 132, 52,
-			67, 155, 40, 206, 144, 29, 236, 91, 53, 180, 201, 252, 105, 211,
-			198, 190, 69, 79, 184, 220, 248, 140, 74, 98, 112, 54, 160, 82,
-			32, 208, 14, 11, 2, 171, 205, 74, 180, 194, 107, 241, 209, 178,
-			3, 58, 51, 95, 36, 209, 123, 200, 20, 16, 241, 188, 1, 219,
-			109, 71, 24, 170, 89, 232, 248, 144, 132, 20, 67, 155, 60, 44,
-			185, 163, 106, 134, 54, 121, 138, 146, 235, 128, 161, 150, 49, 244,
-			99, 234, 57, 205, 92, 164, 9, 49, 129, 206, 40, 203, 70, 191,
-			33, 22, 210, 38, 11, 45, 219, 9, 196, 112, 36, 241, 150, 125,
-			106, 48, 6, 199, 200, 17, 242, 2, 201, 1, 4, 227, 124, 66,
-			127, 212, 188, 130, 180, 11, 255, 215, 102, 232, 249, 86, 155, 209,
-			173, 234, 170, 56, 11, 237, 99, 2, 103, 143, 29, 117, 221, 44,
-			17, 114, 152, 12, 240, 38, 179, 208, 102, 2, 86, 12, 237, 196,
-			208, 68, 12, 107, 134, 118, 226, 145, 73, 242, 138, 64, 65, 49,
-			180, 83, 186, 105, 174, 62, 36, 10, 190, 181, 43, 7, 139, 251,
-			142, 15, 68, 70, 201, 66, 235, 9, 24, 122, 27, 58, 18, 195,
-			154, 161, 157, 154, 124, 148, 188, 44, 144, 81, 13, 237, 180, 62,
-			105, 126, 232, 33, 145, 177, 130, 128, 117, 234, 14, 107, 222, 15,
-			23, 24, 239, 211, 9, 92, 128, 242, 211, 67, 227, 49, 172, 25,
-			218, 233, 163, 143, 144, 223, 87, 4, 50, 154, 161, 61, 161, 31,
-			53, 127, 77, 193, 41, 230, 247, 88, 145, 90, 142, 131, 61, 131,
-			160, 182, 89, 64, 235, 44, 220, 101, 204, 165, 115, 220, 133, 154,
-			218, 194, 232, 46, 224, 26, 33, 66, 43, 45, 66, 91, 150, 19,
-			72, 63, 108, 242, 156, 39, 220, 210, 125, 107, 205, 245, 64, 235,
-			224, 91, 132, 179, 71, 29, 207, 106, 162, 44, 2, 13, 12, 13,
-			228, 29, 214, 180, 185, 25, 152, 179, 40, 90, 180, 188, 87, 203,
-			225, 213, 224, 7, 187, 219, 197, 51, 98, 76, 191, 150, 5, 250,
-			242, 49, 172, 24, 218, 19, 131, 99, 49, 12, 244, 79, 28, 33,
-			5, 193, 14, 221, 208, 166, 245, 147, 230, 56, 142, 141, 219, 235,
-			212, 153, 15, 43, 212, 241, 218, 113, 155, 122, 22, 42, 13, 198,
-			176, 98, 104, 211, 228, 209, 24, 214, 12, 109, 250, 248, 9, 98,
-			225, 202, 215, 12, 109, 70, 53, 205, 26, 240, 23, 181, 47, 219,
-			41, 246, 243, 33, 49, 150, 69, 225, 167, 6, 181, 216, 102, 78,
-			179, 127, 5, 194, 41, 93, 172, 193, 104, 145, 107, 57, 232, 67,
-			46, 114, 32, 113, 230, 240, 17, 9, 65, 255, 147, 143, 146, 143,
-			34, 50, 186, 161, 205, 231, 39, 77, 159, 86, 82, 231, 111, 174,
-			35, 136, 29, 1, 245, 79, 199, 107, 195, 73, 27, 112, 196, 129,
-			219, 182, 96, 30, 192, 145, 153, 87, 181, 3, 234, 185, 206, 30,
-			161, 86, 227, 142, 235, 237, 58, 172, 217, 230, 38, 6, 171, 217,
-			177, 93, 59, 8, 125, 174, 98, 54, 28, 27, 180, 245, 8, 85,
-			224, 221, 124, 126, 88, 66, 138, 161, 205, 31, 26, 151, 144, 102,
-			104, 243, 71, 31, 137, 148, 194, 111, 156, 33, 39, 251, 53, 184,
-			102, 207, 71, 103, 201, 189, 162, 91, 22, 73, 126, 69, 84, 121,
-			232, 224, 150, 239, 191, 71, 112, 203, 33, 217, 162, 140, 109, 153,
-			127, 192, 216, 22, 137, 236, 67, 133, 182, 252, 222, 99, 160, 181,
-			156, 204, 4, 239, 71, 182, 188, 31, 217, 242, 126, 100, 203, 251,
-			145, 45, 239, 71, 182, 188, 31, 217, 242, 127, 61, 178, 229, 122,
-			28, 217, 114, 253, 254, 145, 45, 165, 56, 178, 165, 244, 16, 145,
-			45, 63, 49, 202, 207, 175, 173, 76, 160, 152, 159, 26, 165, 75,
-			84, 238, 186, 233, 176, 150, 192, 110, 187, 160, 37, 181, 236, 187,
-			172, 57, 227, 48, 183, 29, 110, 211, 160, 139, 198, 116, 126, 168,
-			139, 170, 179, 38, 121, 248, 240, 149, 132, 189, 141, 164, 12, 110,
-			21, 116, 248, 28, 16, 71, 19, 197, 147, 64, 171, 13, 207, 109,
-			176, 110, 8, 98, 250, 14, 163, 133, 166, 181, 87, 32, 32, 211,
-			10, 232, 182, 41, 200, 102, 48, 252, 133, 11, 190, 216, 237, 128,
-			130, 90, 236, 112, 77, 187, 37, 76, 226, 82, 253, 38, 232, 189,
-			137, 107, 11, 123, 31, 30, 236, 35, 86, 1, 10, 118, 100, 89,
-			224, 126, 31, 207, 167, 65, 175, 30, 2, 185, 192, 17, 52, 225,
-			89, 9, 15, 34, 173, 202, 40, 17, 171, 219, 245, 189, 187, 54,
-			108, 7, 206, 30, 61, 63, 51, 63, 87, 156, 155, 155, 195, 208,
-			152, 7, 138, 190, 136, 208, 192, 62, 82, 232, 226, 190, 215, 13,
-			88, 175, 233, 225, 230, 187, 63, 22, 35, 8, 45, 63, 164, 207,
-			209, 82, 169, 116, 185, 255, 25, 115, 155, 169, 39, 81, 71, 82,
-			195, 146, 79, 249, 227, 72, 73, 148, 195, 250, 28, 180, 16, 65,
-			51, 188, 47, 9, 95, 238, 123, 137, 91, 69, 249, 43, 252, 183,
-			124, 1, 33, 217, 137, 221, 162, 83, 251, 58, 122, 150, 206, 209,
-			39, 158, 232, 111, 235, 3, 116, 110, 154, 190, 41, 157, 175, 251,
-			94, 58, 255, 28, 157, 191, 188, 239, 169, 232, 250, 185, 200, 9,
-			63, 55, 39, 42, 189, 69, 153, 3, 250, 213, 65, 8, 124, 224,
-			64, 4, 158, 189, 63, 2, 51, 247, 65, 224, 252, 65, 8, 60,
-			80, 160, 75, 12, 158, 143, 7, 236, 225, 103, 193, 61, 199, 250,
-			222, 115, 132, 63, 74, 14, 249, 115, 233, 33, 167, 231, 247, 49,
-			225, 114, 252, 146, 156, 0, 137, 65, 79, 190, 176, 111, 22, 196,
-			239, 164, 249, 156, 154, 115, 73, 22, 199, 47, 28, 200, 221, 120,
-			120, 227, 138, 31, 72, 86, 188, 71, 31, 231, 15, 238, 227, 192,
-			41, 116, 112, 32, 79, 122, 1, 71, 174, 52, 248, 171, 201, 28,
-			60, 98, 244, 187, 247, 129, 233, 251, 43, 78, 53, 173, 189, 224,
-			185, 11, 69, 25, 70, 247, 220, 188, 12, 187, 72, 140, 162, 236,
-			109, 170, 239, 81, 233, 170, 239, 97, 0, 16, 111, 42, 108, 62,
-			112, 52, 64, 132, 255, 253, 130, 1, 124, 75, 168, 174, 22, 200,
-			75, 34, 220, 131, 197, 132, 217, 69, 84, 196, 224, 65, 161, 61,
-			11, 183, 117, 33, 40, 208, 41, 97, 211, 128, 42, 130, 245, 211,
-			32, 120, 209, 77, 221, 245, 89, 3, 227, 251, 234, 123, 92, 1,
-			142, 76, 8, 177, 107, 29, 84, 235, 68, 124, 81, 194, 233, 111,
-			5, 36, 225, 142, 167, 81, 0, 82, 202, 57, 124, 33, 218, 173,
-			176, 165, 84, 172, 82, 210, 57, 45, 105, 183, 83, 140, 2, 86,
-			20, 46, 4, 133, 162, 136, 39, 184, 144, 218, 20, 83, 238, 124,
-			222, 22, 129, 157, 36, 70, 241, 160, 214, 74, 114, 118, 205, 67,
-			187, 208, 78, 95, 171, 132, 118, 236, 134, 159, 110, 247, 65, 155,
-			157, 15, 10, 104, 61, 150, 246, 227, 86, 126, 148, 252, 7, 233,
-			24, 212, 94, 83, 39, 204, 95, 87, 232, 38, 106, 5, 73, 159,
-			32, 14, 90, 66, 45, 232, 243, 97, 205, 92, 152, 191, 88, 188,
-			248, 212, 37, 216, 224, 224, 127, 116, 6, 159, 239, 43, 76, 248,
-			181, 232, 154, 23, 178, 69, 104, 53, 96, 180, 238, 245, 100, 224,
-			103, 131, 175, 28, 126, 54, 89, 36, 34, 84, 116, 182, 99, 187,
-			244, 28, 0, 29, 219, 157, 221, 246, 233, 57, 186, 240, 36, 221,
-			246, 103, 155, 214, 30, 61, 71, 47, 92, 186, 88, 90, 184, 72,
-			97, 141, 204, 98, 220, 169, 8, 51, 226, 59, 109, 210, 176, 253,
-			154, 58, 144, 48, 108, 191, 150, 31, 73, 24, 182, 95, 51, 198,
-			201, 247, 107, 210, 178, 237, 171, 134, 249, 223, 84, 201, 136, 135,
-			243, 38, 38, 249, 69, 98, 134, 201, 213, 20, 80, 135, 5, 1,
-			95, 48, 158, 203, 162, 214, 252, 148, 174, 197, 103, 163, 69, 231,
-			8, 189, 45, 198, 225, 182, 48, 97, 137, 80, 10, 47, 176, 209,
-			38, 228, 249, 52, 114, 65, 222, 70, 132, 68, 69, 62, 209, 165,
-			24, 8, 16, 149, 68, 135, 158, 79, 59, 158, 207, 138, 212, 66,
-			67, 218, 27, 204, 247, 132, 65, 92, 26, 163, 83, 173, 241, 163,
-			53, 172, 4, 73, 158, 133, 46, 226, 182, 11, 211, 11, 171, 167,
-			241, 236, 159, 34, 41, 23, 39, 76, 143, 68, 65, 202, 229, 57,
-			44, 93, 158, 154, 31, 141, 151, 2, 99, 18, 25, 222, 21, 205,
-			208, 252, 132, 191, 243, 159, 86, 201, 236, 187, 249, 184, 28, 175,
-			221, 173, 195, 223, 194, 214, 149, 197, 130, 119, 117, 106, 154, 239,
-			98, 51, 43, 252, 87, 149, 140, 71, 30, 157, 21, 22, 96, 8,
-			134, 231, 163, 231, 208, 103, 45, 251, 174, 112, 7, 10, 200, 48,
-			136, 14, 199, 120, 225, 6, 196, 223, 198, 2, 25, 18, 14, 66,
-			144, 180, 232, 4, 60, 188, 48, 86, 66, 12, 75, 188, 229, 218,
-			94, 151, 85, 133, 27, 17, 126, 27, 167, 201, 112, 195, 115, 67,
-			230, 134, 252, 37, 29, 219, 27, 18, 101, 88, 229, 105, 50, 24,
-			81, 131, 190, 193, 251, 123, 86, 227, 202, 198, 211, 68, 15, 173,
-			118, 48, 153, 163, 218, 212, 208, 194, 227, 2, 147, 3, 200, 44,
-			213, 172, 118, 128, 206, 198, 42, 190, 97, 60, 65, 70, 184, 61,
-			230, 86, 203, 118, 216, 45, 118, 55, 156, 28, 64, 204, 14, 241,
-			226, 171, 182, 195, 202, 119, 67, 243, 41, 50, 24, 189, 106, 140,
-			18, 237, 14, 219, 19, 140, 130, 159, 198, 4, 201, 226, 92, 20,
-			108, 226, 192, 162, 250, 180, 82, 120, 141, 232, 53, 118, 55, 52,
-			158, 32, 89, 199, 118, 89, 48, 169, 32, 142, 163, 2, 71, 120,
-			86, 90, 181, 93, 86, 229, 143, 205, 69, 162, 3, 24, 183, 8,
-			189, 12, 139, 22, 141, 227, 100, 176, 201, 28, 187, 99, 135, 204,
-			151, 158, 217, 168, 160, 80, 32, 185, 43, 136, 53, 140, 90, 211,
-			10, 45, 172, 50, 92, 197, 223, 207, 235, 121, 101, 84, 45, 252,
-			152, 66, 242, 43, 86, 104, 181, 125, 171, 19, 85, 83, 226, 106,
-			198, 60, 25, 232, 90, 126, 104, 91, 142, 240, 110, 63, 34, 80,
-			149, 111, 149, 54, 248, 227, 170, 172, 103, 94, 35, 3, 162, 12,
-			208, 230, 206, 109, 104, 242, 80, 149, 3, 208, 79, 96, 191, 193,
-			185, 163, 87, 241, 55, 148, 57, 86, 16, 226, 236, 201, 87, 241,
-			119, 225, 31, 171, 36, 191, 42, 188, 193, 198, 34, 25, 130, 17,
-			190, 197, 195, 164, 176, 193, 161, 133, 71, 247, 77, 8, 41, 167,
-			170, 4, 106, 175, 99, 101, 152, 109, 124, 254, 10, 87, 59, 239,
-			120, 136, 151, 113, 71, 251, 105, 50, 44, 38, 113, 236, 141, 215,
-			171, 98, 98, 243, 42, 38, 201, 7, 236, 245, 30, 156, 195, 112,
-			190, 234, 213, 8, 54, 78, 19, 61, 132, 217, 66, 16, 173, 161,
-			196, 112, 94, 207, 84, 241, 145, 113, 150, 228, 248, 36, 154, 28,
-			194, 74, 135, 68, 37, 62, 70, 215, 51, 85, 241, 216, 152, 225,
-			30, 117, 96, 238, 228, 48, 86, 29, 233, 227, 249, 245, 76, 53,
-			170, 114, 101, 144, 12, 136, 101, 83, 248, 178, 134, 12, 227, 232,
-			150, 136, 222, 100, 65, 67, 112, 202, 188, 247, 42, 168, 98, 61,
-			99, 150, 12, 8, 39, 207, 164, 138, 147, 242, 72, 252, 10, 182,
-			88, 226, 43, 69, 214, 50, 206, 145, 49, 24, 166, 91, 41, 214,
-			114, 190, 141, 192, 131, 141, 4, 123, 101, 221, 20, 143, 245, 184,
-			238, 102, 130, 207, 247, 8, 13, 208, 251, 66, 3, 204, 175, 40,
-			36, 203, 231, 198, 81, 146, 75, 76, 11, 189, 42, 160, 212, 136,
-			169, 251, 70, 108, 248, 0, 196, 239, 59, 39, 244, 253, 115, 162,
-			111, 86, 102, 31, 98, 86, 158, 155, 35, 36, 150, 142, 70, 158,
-			232, 181, 242, 135, 107, 163, 25, 131, 144, 220, 149, 202, 218, 82,
-			245, 230, 168, 98, 12, 147, 252, 202, 82, 109, 233, 90, 117, 233,
-			198, 168, 122, 229, 236, 203, 103, 30, 104, 163, 120, 254, 119, 203,
-			100, 192, 200, 234, 153, 47, 171, 247, 13, 119, 184, 248, 183, 33,
-			220, 225, 112, 20, 238, 240, 108, 28, 238, 240, 108, 50, 220, 1,
-			126, 42, 134, 54, 148, 153, 34, 148, 168, 217, 140, 161, 31, 206,
-			24, 138, 57, 33, 124, 86, 194, 175, 6, 251, 76, 137, 130, 234,
-			152, 5, 253, 233, 112, 118, 132, 12, 17, 61, 139, 154, 227, 136,
-			58, 4, 155, 115, 150, 171, 86, 35, 106, 78, 66, 170, 161, 141,
-			12, 18, 81, 81, 49, 180, 81, 238, 89, 203, 242, 61, 125, 84,
-			205, 75, 72, 53, 180, 209, 161, 97, 81, 81, 53, 180, 49, 117,
-			68, 60, 130, 215, 198, 84, 34, 33, 120, 118, 232, 48, 121, 157,
-			91, 184, 38, 51, 107, 138, 201, 206, 97, 88, 69, 228, 93, 142,
-			86, 38, 186, 114, 74, 180, 134, 206, 83, 174, 166, 180, 122, 14,
-			156, 5, 208, 224, 100, 187, 92, 67, 198, 67, 206, 182, 21, 18,
-			241, 106, 157, 5, 220, 107, 215, 198, 99, 137, 116, 200, 75, 165,
-			121, 50, 127, 140, 252, 231, 72, 105, 62, 173, 78, 152, 191, 167,
-			144, 68, 44, 194, 89, 60, 185, 192, 9, 103, 74, 6, 218, 78,
-			139, 200, 143, 128, 122, 190, 221, 182, 93, 126, 224, 225, 150, 29,
-			169, 76, 93, 233, 133, 14, 243, 169, 141, 17, 236, 13, 70, 119,
-			49, 8, 97, 27, 52, 67, 139, 114, 89, 128, 249, 84, 160, 166,
-			217, 77, 217, 69, 20, 196, 96, 81, 190, 24, 214, 160, 45, 73,
-			7, 204, 141, 197, 216, 13, 117, 191, 201, 223, 240, 58, 29, 207,
-			229, 46, 183, 199, 226, 166, 146, 10, 244, 105, 62, 92, 66, 129,
-			62, 61, 152, 84, 160, 79, 27, 227, 228, 207, 101, 84, 158, 118,
-			94, 53, 204, 63, 22, 76, 73, 249, 221, 209, 191, 145, 102, 139,
-			28, 29, 233, 188, 232, 185, 246, 235, 61, 230, 236, 81, 187, 201,
-			220, 208, 110, 237, 241, 177, 136, 220, 220, 118, 184, 45, 207, 145,
-			13, 175, 203, 34, 135, 72, 119, 31, 139, 176, 179, 255, 83, 12,
-			2, 141, 245, 124, 196, 32, 152, 221, 231, 7, 147, 26, 235, 249,
-			209, 49, 242, 180, 12, 95, 41, 169, 39, 204, 243, 251, 185, 35,
-			246, 33, 126, 226, 78, 112, 137, 202, 32, 147, 28, 188, 58, 156,
-			8, 64, 41, 29, 154, 76, 4, 160, 148, 142, 29, 39, 63, 174,
-			74, 79, 249, 211, 170, 105, 254, 127, 106, 223, 204, 188, 87, 23,
-			114, 32, 132, 158, 79, 45, 151, 94, 175, 213, 54, 232, 50, 175,
-			63, 3, 50, 150, 243, 178, 68, 175, 176, 182, 237, 138, 19, 243,
-			141, 202, 141, 50, 197, 168, 2, 108, 242, 50, 237, 88, 123, 34,
-			134, 177, 9, 19, 183, 177, 109, 249, 176, 220, 68, 176, 20, 156,
-			254, 184, 213, 182, 99, 193, 243, 29, 203, 118, 100, 224, 247, 170,
-			215, 94, 241, 218, 210, 203, 141, 161, 9, 132, 190, 222, 99, 254,
-			94, 188, 12, 105, 135, 133, 22, 95, 213, 149, 144, 47, 17, 203,
-			9, 60, 196, 184, 219, 117, 108, 225, 55, 23, 254, 127, 233, 28,
-			116, 188, 54, 225, 178, 32, 242, 242, 103, 129, 63, 249, 132, 151,
-			255, 233, 193, 164, 151, 255, 233, 201, 71, 201, 207, 43, 210, 205,
-			255, 65, 245, 156, 249, 83, 7, 77, 231, 186, 21, 176, 68, 86,
-			198, 1, 252, 116, 61, 25, 23, 192, 173, 113, 104, 85, 223, 23,
-			166, 196, 183, 13, 161, 171, 217, 44, 50, 99, 64, 53, 219, 39,
-			137, 46, 172, 32, 117, 252, 231, 251, 102, 32, 69, 137, 12, 116,
-			136, 67, 4, 114, 128, 253, 177, 68, 136, 192, 7, 143, 159, 73,
-			132, 8, 124, 112, 106, 154, 124, 134, 211, 153, 53, 180, 107, 234,
-			41, 243, 7, 129, 78, 11, 227, 160, 44, 151, 90, 126, 221, 14,
-			125, 224, 225, 29, 182, 55, 203, 215, 82, 104, 181, 169, 21, 4,
-			94, 195, 182, 194, 216, 187, 105, 7, 233, 240, 165, 254, 225, 132,
-			29, 11, 7, 19, 15, 152, 113, 85, 206, 196, 38, 5, 25, 108,
-			181, 133, 37, 62, 194, 63, 155, 3, 172, 228, 200, 100, 21, 67,
-			187, 118, 212, 148, 144, 102, 104, 215, 78, 156, 36, 159, 227, 248,
-			231, 12, 237, 134, 122, 194, 252, 132, 66, 104, 165, 5, 34, 190,
-			40, 237, 79, 92, 108, 56, 14, 204, 146, 215, 60, 219, 197, 73,
-			210, 102, 104, 185, 106, 246, 208, 60, 21, 133, 191, 132, 30, 245,
-			25, 15, 50, 229, 46, 91, 33, 176, 101, 60, 24, 158, 191, 251,
-			230, 174, 21, 210, 103, 185, 244, 249, 192, 236, 249, 217, 103, 65,
-			236, 124, 160, 4, 39, 17, 73, 69, 46, 11, 184, 201, 217, 150,
-			83, 12, 237, 198, 160, 92, 183, 57, 205, 208, 110, 28, 59, 78,
-			10, 4, 134, 71, 127, 33, 179, 173, 152, 71, 41, 104, 190, 178,
-			71, 177, 100, 249, 222, 171, 131, 100, 121, 33, 63, 76, 126, 11,
-			214, 185, 162, 101, 12, 253, 21, 181, 165, 153, 191, 164, 18, 92,
-			171, 118, 187, 231, 245, 2, 10, 26, 51, 102, 188, 70, 14, 109,
-			219, 167, 209, 209, 38, 144, 57, 173, 150, 239, 91, 123, 24, 147,
-			131, 85, 185, 207, 59, 232, 243, 127, 119, 49, 2, 29, 196, 36,
-			165, 51, 116, 142, 122, 62, 157, 151, 81, 70, 176, 117, 242, 119,
-			101, 129, 72, 0, 1, 165, 84, 78, 232, 189, 179, 1, 39, 136,
-			39, 187, 240, 88, 126, 248, 99, 197, 40, 209, 122, 15, 125, 243,
-			32, 203, 153, 211, 138, 247, 106, 104, 125, 154, 119, 109, 185, 123,
-			9, 27, 160, 140, 115, 18, 253, 199, 109, 199, 141, 22, 49, 51,
-			7, 154, 164, 222, 174, 155, 108, 74, 80, 33, 142, 92, 188, 9,
-			62, 90, 10, 134, 220, 189, 66, 14, 147, 171, 36, 7, 16, 108,
-			242, 175, 234, 19, 230, 83, 124, 249, 219, 46, 59, 43, 248, 43,
-			6, 166, 200, 241, 70, 145, 135, 9, 82, 232, 101, 23, 40, 148,
-			40, 134, 50, 41, 34, 206, 238, 85, 17, 234, 164, 136, 56, 187,
-			87, 201, 72, 12, 107, 134, 246, 170, 49, 78, 126, 70, 17, 29,
-			43, 134, 198, 244, 71, 205, 47, 74, 201, 195, 187, 142, 89, 198,
-			77, 171, 152, 140, 34, 84, 75, 190, 104, 89, 167, 27, 238, 137,
-			167, 81, 160, 131, 139, 155, 32, 160, 108, 187, 61, 22, 41, 141,
-			46, 78, 52, 84, 234, 129, 19, 4, 107, 22, 163, 40, 8, 209,
-			167, 212, 250, 37, 251, 155, 30, 227, 193, 102, 86, 115, 7, 148,
-			21, 17, 36, 166, 136, 0, 62, 166, 39, 96, 160, 66, 68, 19,
-			42, 34, 128, 143, 61, 50, 9, 154, 158, 174, 0, 111, 219, 42,
-			95, 208, 138, 154, 209, 1, 34, 18, 202, 25, 90, 123, 104, 68,
-			66, 138, 161, 181, 71, 143, 72, 72, 51, 180, 246, 228, 163, 228,
-			113, 162, 234, 170, 161, 223, 201, 188, 174, 152, 147, 148, 159, 4,
-			15, 94, 54, 192, 204, 59, 249, 195, 228, 26, 252, 30, 52, 180,
-			142, 122, 200, 188, 76, 175, 122, 126, 135, 249, 14, 55, 47, 203,
-			217, 90, 218, 148, 212, 114, 121, 183, 107, 5, 180, 217, 235, 58,
-			34, 219, 104, 155, 249, 64, 47, 224, 175, 14, 102, 12, 173, 51,
-			196, 55, 100, 117, 16, 112, 76, 64, 42, 135, 166, 160, 34, 16,
-			218, 85, 199, 204, 99, 56, 146, 245, 36, 162, 34, 226, 72, 108,
-			242, 42, 78, 147, 174, 48, 125, 169, 72, 119, 87, 196, 120, 169,
-			72, 119, 119, 100, 148, 20, 9, 72, 239, 108, 152, 249, 97, 69,
-			49, 79, 81, 121, 174, 237, 35, 61, 161, 178, 235, 176, 197, 133,
-			249, 81, 82, 32, 186, 174, 1, 54, 59, 234, 152, 121, 132, 239,
-			81, 242, 40, 156, 196, 67, 67, 60, 118, 4, 30, 26, 226, 177,
-			35, 240, 208, 16, 143, 157, 145, 81, 242, 125, 32, 122, 53, 45,
-			99, 100, 63, 162, 126, 92, 209, 76, 63, 53, 21, 113, 130, 68,
-			107, 76, 246, 82, 76, 6, 228, 161, 88, 150, 218, 130, 207, 68,
-			88, 230, 30, 119, 1, 240, 16, 186, 254, 32, 89, 148, 10, 178,
-			49, 33, 98, 53, 92, 180, 31, 33, 99, 164, 78, 114, 0, 169,
-			25, 67, 255, 62, 69, 63, 98, 86, 249, 218, 193, 3, 104, 145,
-			239, 193, 176, 70, 81, 78, 188, 193, 124, 175, 24, 29, 203, 34,
-			46, 180, 124, 171, 221, 97, 226, 190, 7, 209, 33, 73, 246, 56,
-			66, 6, 120, 31, 89, 236, 36, 81, 160, 64, 193, 208, 104, 92,
-			160, 65, 193, 248, 4, 153, 21, 104, 41, 134, 254, 3, 138, 62,
-			97, 158, 226, 90, 153, 253, 70, 164, 12, 164, 200, 162, 81, 11,
-			74, 22, 223, 136, 251, 80, 176, 137, 161, 145, 184, 64, 131, 2,
-			99, 156, 108, 137, 62, 84, 67, 127, 91, 209, 13, 179, 28, 71,
-			161, 134, 137, 19, 16, 74, 230, 254, 65, 145, 180, 194, 89, 212,
-			74, 242, 55, 198, 68, 205, 98, 187, 249, 184, 0, 83, 139, 6,
-			15, 197, 5, 26, 20, 140, 142, 145, 97, 156, 100, 138, 161, 255,
-			144, 162, 30, 197, 52, 21, 77, 85, 114, 8, 14, 74, 16, 159,
-			146, 49, 9, 106, 0, 78, 28, 33, 95, 85, 136, 170, 235, 70,
-			238, 211, 74, 230, 235, 138, 98, 254, 19, 229, 28, 161, 75, 46,
-			134, 190, 238, 216, 205, 158, 21, 71, 211, 238, 69, 58, 86, 20,
-			213, 201, 157, 75, 93, 230, 139, 3, 94, 232, 91, 110, 128, 33,
-			75, 176, 77, 195, 139, 82, 117, 140, 21, 97, 156, 135, 1, 73,
-			56, 82, 162, 155, 36, 98, 41, 137, 149, 239, 134, 7, 198, 151,
-			167, 22, 27, 79, 186, 130, 141, 252, 211, 74, 126, 148, 124, 25,
-			214, 135, 14, 147, 241, 115, 138, 122, 222, 252, 124, 234, 156, 40,
-			117, 65, 208, 224, 226, 136, 124, 41, 182, 36, 113, 226, 57, 72,
-			246, 40, 243, 244, 64, 53, 180, 16, 41, 137, 5, 174, 197, 4,
-			158, 179, 35, 148, 152, 88, 127, 140, 35, 255, 101, 140, 215, 114,
-			212, 33, 12, 133, 14, 114, 23, 176, 53, 37, 168, 0, 120, 236,
-			9, 9, 106, 0, 78, 159, 35, 223, 228, 164, 41, 134, 254, 5,
-			69, 53, 205, 223, 16, 164, 137, 32, 123, 17, 112, 158, 56, 172,
-			109, 28, 116, 36, 150, 103, 191, 232, 144, 198, 15, 127, 81, 50,
-			161, 20, 201, 212, 2, 149, 151, 15, 48, 108, 84, 62, 147, 103,
-			120, 97, 76, 35, 120, 82, 150, 202, 71, 196, 24, 113, 108, 22,
-			71, 14, 121, 194, 108, 178, 192, 110, 187, 32, 91, 122, 174, 213,
-			169, 11, 149, 9, 15, 9, 158, 223, 100, 98, 83, 229, 244, 194,
-			250, 251, 130, 162, 230, 37, 136, 244, 14, 30, 145, 160, 6, 224,
-			228, 163, 228, 223, 113, 110, 168, 134, 254, 14, 112, 227, 87, 238,
-			199, 13, 208, 15, 68, 86, 200, 1, 220, 232, 103, 133, 160, 28,
-			22, 165, 140, 33, 79, 145, 10, 167, 89, 193, 91, 216, 180, 121,
-			195, 132, 194, 209, 244, 129, 233, 142, 200, 78, 157, 174, 165, 38,
-			207, 73, 133, 229, 255, 78, 204, 8, 24, 248, 119, 98, 70, 192,
-			210, 127, 7, 24, 241, 107, 42, 50, 66, 51, 244, 159, 83, 212,
-			163, 230, 87, 229, 249, 179, 79, 129, 144, 66, 207, 246, 131, 72,
-			143, 226, 171, 58, 74, 100, 140, 182, 99, 224, 12, 187, 27, 46,
-			166, 236, 56, 92, 161, 73, 38, 125, 240, 182, 196, 94, 210, 196,
-			10, 37, 186, 42, 170, 217, 13, 140, 189, 111, 219, 174, 208, 60,
-			67, 148, 254, 37, 34, 148, 134, 116, 227, 245, 189, 48, 90, 152,
-			169, 214, 241, 129, 224, 79, 212, 19, 63, 88, 70, 155, 112, 186,
-			169, 20, 138, 177, 84, 173, 69, 77, 70, 18, 120, 219, 18, 105,
-			26, 28, 67, 142, 158, 96, 175, 150, 69, 126, 74, 230, 107, 10,
-			128, 131, 99, 18, 68, 110, 79, 28, 33, 33, 240, 62, 159, 49,
-			114, 63, 175, 168, 255, 66, 209, 204, 38, 17, 23, 5, 113, 254,
-			10, 44, 162, 60, 24, 142, 4, 108, 195, 33, 79, 201, 167, 93,
-			175, 219, 115, 34, 45, 7, 141, 1, 132, 118, 172, 176, 177, 45,
-			133, 206, 217, 128, 222, 78, 120, 171, 110, 75, 20, 243, 32, 39,
-			126, 94, 201, 143, 144, 89, 156, 0, 186, 161, 127, 69, 209, 199,
-			205, 211, 92, 243, 231, 211, 114, 17, 199, 35, 16, 73, 0, 168,
-			68, 151, 168, 32, 66, 207, 225, 27, 146, 68, 16, 161, 95, 129,
-			205, 69, 128, 26, 128, 163, 6, 41, 98, 235, 89, 67, 255, 5,
-			69, 127, 196, 60, 153, 214, 249, 22, 121, 236, 118, 192, 112, 255,
-			142, 154, 206, 230, 176, 186, 100, 102, 86, 1, 112, 72, 114, 47,
-			171, 1, 56, 113, 148, 156, 199, 166, 115, 134, 254, 53, 69, 63,
-			102, 158, 232, 215, 170, 22, 163, 130, 32, 106, 57, 199, 107, 15,
-			75, 80, 1, 240, 144, 92, 20, 57, 13, 192, 73, 147, 252, 39,
-			149, 168, 122, 214, 200, 253, 166, 146, 249, 178, 170, 152, 255, 86,
-			229, 6, 203, 74, 148, 148, 227, 202, 4, 29, 151, 95, 225, 100,
-			133, 51, 62, 11, 66, 33, 229, 49, 85, 67, 30, 217, 18, 166,
-			48, 88, 239, 152, 178, 132, 239, 90, 62, 147, 169, 193, 113, 200,
-			4, 79, 63, 178, 97, 158, 165, 15, 186, 104, 39, 115, 99, 75,
-			73, 95, 2, 5, 94, 5, 192, 71, 170, 17, 159, 42, 35, 113,
-			220, 242, 173, 14, 11, 74, 177, 106, 5, 179, 164, 43, 172, 166,
-			103, 81, 166, 216, 13, 190, 87, 71, 161, 74, 32, 246, 120, 15,
-			69, 97, 171, 19, 199, 12, 187, 195, 112, 53, 135, 30, 55, 228,
-			97, 227, 176, 171, 241, 161, 149, 27, 96, 50, 79, 37, 141, 112,
-			221, 241, 234, 98, 231, 133, 177, 253, 77, 216, 121, 127, 3, 4,
-			114, 22, 118, 222, 223, 85, 212, 83, 230, 47, 8, 129, 124, 128,
-			251, 38, 222, 18, 19, 77, 246, 11, 102, 185, 144, 97, 48, 34,
-			123, 142, 216, 100, 14, 106, 83, 222, 92, 101, 9, 251, 7, 109,
-			88, 46, 161, 119, 92, 111, 119, 159, 21, 26, 122, 149, 86, 45,
-			24, 182, 166, 183, 235, 58, 158, 21, 29, 37, 177, 99, 177, 204,
-			178, 184, 59, 255, 174, 162, 30, 145, 160, 2, 224, 81, 83, 130,
-			26, 128, 39, 78, 146, 127, 128, 228, 107, 25, 35, 247, 7, 138,
-			250, 113, 85, 51, 63, 171, 16, 202, 199, 142, 15, 175, 237, 182,
-			29, 73, 84, 66, 155, 98, 113, 166, 24, 156, 175, 61, 180, 204,
-			180, 82, 243, 65, 236, 66, 226, 108, 221, 240, 124, 158, 159, 216,
-			20, 183, 89, 89, 36, 113, 156, 164, 129, 107, 117, 131, 109, 15,
-			9, 221, 111, 245, 18, 68, 129, 242, 174, 255, 129, 66, 70, 224,
-			60, 145, 3, 24, 198, 237, 91, 138, 126, 212, 124, 157, 35, 149,
-			20, 200, 98, 34, 48, 145, 208, 144, 24, 52, 209, 65, 149, 53,
-			60, 191, 89, 89, 23, 251, 137, 56, 55, 144, 120, 246, 238, 195,
-			25, 71, 95, 110, 54, 160, 205, 102, 133, 118, 255, 45, 169, 121,
-			103, 133, 118, 255, 45, 144, 27, 81, 129, 6, 5, 160, 180, 170,
-			2, 109, 197, 208, 255, 84, 209, 39, 205, 159, 122, 232, 109, 239,
-			61, 219, 229, 248, 238, 129, 91, 221, 223, 158, 93, 78, 114, 20,
-			180, 173, 63, 77, 242, 92, 65, 142, 14, 141, 199, 5, 26, 20,
-			28, 125, 132, 252, 164, 156, 42, 170, 161, 255, 133, 162, 31, 55,
-			255, 126, 194, 64, 203, 241, 17, 1, 192, 24, 186, 6, 227, 30,
-			123, 119, 238, 227, 129, 168, 239, 69, 38, 75, 16, 72, 177, 15,
-			34, 82, 152, 163, 121, 36, 148, 37, 75, 172, 100, 18, 89, 52,
-			165, 130, 150, 240, 219, 72, 252, 97, 235, 250, 139, 36, 133, 48,
-			103, 254, 66, 25, 122, 36, 46, 208, 160, 192, 60, 70, 62, 37,
-			41, 212, 12, 253, 175, 128, 194, 239, 21, 20, 38, 207, 13, 242,
-			244, 26, 157, 138, 222, 107, 218, 80, 45, 142, 214, 171, 68, 18,
-			20, 146, 191, 74, 146, 1, 42, 201, 95, 37, 201, 208, 16, 107,
-			243, 24, 249, 83, 73, 134, 110, 232, 111, 171, 250, 140, 249, 251,
-			15, 66, 6, 94, 218, 145, 176, 116, 7, 73, 98, 82, 39, 161,
-			216, 221, 119, 54, 72, 29, 130, 132, 106, 147, 32, 148, 223, 184,
-			34, 105, 141, 15, 69, 137, 222, 83, 58, 243, 189, 248, 69, 14,
-			96, 88, 157, 95, 2, 153, 224, 17, 104, 52, 111, 171, 250, 241,
-			184, 0, 14, 204, 234, 137, 169, 184, 0, 14, 204, 234, 249, 34,
-			249, 125, 21, 119, 43, 197, 208, 63, 171, 170, 39, 204, 95, 87,
-			9, 93, 74, 136, 92, 43, 104, 48, 20, 86, 51, 168, 168, 179,
-			166, 16, 229, 66, 147, 11, 226, 208, 49, 244, 148, 8, 153, 203,
-			95, 117, 201, 65, 123, 38, 112, 243, 37, 169, 235, 195, 105, 144,
-			143, 65, 186, 89, 110, 64, 47, 240, 33, 42, 20, 105, 33, 233,
-			240, 47, 20, 9, 45, 36, 221, 251, 34, 90, 177, 144, 240, 231,
-			139, 49, 8, 34, 235, 123, 68, 136, 220, 109, 90, 62, 151, 144,
-			123, 251, 123, 151, 22, 164, 38, 107, 217, 46, 107, 94, 166, 54,
-			31, 152, 174, 28, 248, 72, 183, 33, 180, 235, 123, 13, 116, 151,
-			120, 180, 177, 237, 121, 1, 163, 86, 220, 116, 180, 119, 42, 58,
-			242, 55, 2, 115, 0, 14, 141, 74, 16, 185, 63, 54, 41, 65,
-			13, 192, 99, 199, 201, 87, 185, 38, 161, 26, 250, 231, 85, 245,
-			20, 183, 72, 212, 34, 59, 10, 63, 121, 73, 237, 109, 255, 6,
-			23, 205, 217, 248, 246, 166, 74, 75, 88, 158, 66, 84, 178, 65,
-			60, 241, 4, 48, 215, 75, 185, 164, 173, 186, 215, 11, 35, 147,
-			13, 73, 245, 85, 164, 124, 87, 195, 124, 97, 126, 53, 85, 116,
-			60, 140, 46, 245, 20, 162, 135, 211, 3, 130, 231, 243, 170, 56,
-			66, 100, 81, 236, 124, 94, 29, 148, 138, 3, 200, 152, 207, 171,
-			39, 78, 74, 106, 53, 67, 255, 226, 126, 106, 83, 185, 246, 127,
-			195, 212, 38, 251, 122, 0, 106, 211, 26, 5, 202, 167, 47, 198,
-			212, 130, 116, 250, 98, 76, 173, 134, 228, 157, 56, 73, 126, 137,
-			83, 171, 27, 250, 59, 176, 238, 126, 70, 82, 155, 74, 86, 190,
-			103, 87, 239, 9, 181, 188, 43, 210, 215, 215, 195, 83, 172, 103,
-			145, 8, 73, 49, 200, 154, 119, 212, 65, 57, 155, 65, 210, 188,
-			163, 30, 59, 30, 5, 77, 126, 161, 77, 174, 127, 119, 151, 196,
-			192, 191, 125, 119, 196, 188, 119, 215, 206, 152, 15, 27, 209, 249,
-			221, 135, 114, 126, 66, 35, 228, 26, 11, 171, 252, 214, 13, 99,
-			146, 12, 116, 125, 239, 53, 214, 8, 69, 100, 162, 4, 13, 131,
-			232, 93, 43, 220, 150, 49, 156, 240, 219, 152, 32, 89, 196, 94,
-			196, 223, 113, 32, 14, 223, 211, 49, 101, 90, 132, 239, 157, 32,
-			4, 52, 172, 68, 104, 86, 182, 58, 8, 37, 24, 150, 101, 28,
-			35, 131, 142, 215, 22, 79, 115, 248, 52, 239, 120, 109, 254, 240,
-			12, 57, 236, 122, 238, 173, 248, 76, 134, 241, 149, 249, 234, 33,
-			215, 115, 99, 247, 159, 81, 33, 35, 109, 22, 222, 226, 249, 93,
-			183, 122, 190, 19, 76, 230, 49, 180, 234, 180, 188, 254, 38, 166,
-			180, 180, 105, 183, 221, 173, 234, 170, 0, 171, 135, 218, 44, 228,
-			145, 207, 91, 190, 19, 152, 61, 114, 56, 93, 193, 184, 72, 242,
-			142, 221, 194, 36, 133, 119, 15, 35, 140, 170, 26, 71, 73, 142,
-			79, 89, 100, 92, 190, 42, 160, 152, 73, 130, 117, 124, 51, 121,
-			129, 12, 213, 44, 219, 121, 15, 71, 163, 240, 63, 85, 50, 132,
-			100, 243, 235, 84, 238, 211, 230, 4, 201, 250, 204, 114, 58, 200,
-			254, 193, 42, 7, 140, 162, 108, 149, 7, 114, 30, 61, 248, 38,
-			33, 57, 246, 50, 124, 80, 123, 192, 240, 193, 199, 136, 14, 235,
-			96, 82, 199, 216, 193, 145, 184, 190, 136, 175, 133, 135, 198, 247,
-			144, 161, 228, 152, 242, 112, 185, 147, 169, 49, 229, 196, 149, 226,
-			17, 172, 146, 32, 30, 205, 29, 66, 226, 39, 198, 34, 33, 120,
-			203, 3, 14, 85, 20, 232, 120, 239, 24, 225, 68, 237, 190, 225,
-			28, 60, 120, 56, 7, 229, 112, 126, 69, 39, 195, 47, 244, 152,
-			191, 247, 30, 47, 47, 68, 82, 92, 142, 196, 1, 12, 177, 102,
-			119, 249, 194, 26, 172, 226, 111, 227, 20, 25, 234, 88, 119, 111,
-			249, 44, 232, 57, 97, 32, 86, 21, 233, 88, 119, 171, 188, 100,
-			95, 60, 53, 217, 31, 79, 125, 53, 29, 166, 205, 131, 80, 207,
-			72, 222, 39, 137, 75, 4, 109, 95, 181, 157, 144, 249, 169, 208,
-			237, 5, 17, 93, 61, 130, 3, 125, 242, 192, 6, 250, 227, 170,
-			159, 140, 46, 162, 26, 197, 232, 240, 227, 7, 191, 229, 227, 41,
-			47, 186, 166, 234, 50, 25, 237, 199, 196, 56, 155, 12, 132, 62,
-			48, 204, 156, 63, 255, 235, 135, 104, 63, 78, 6, 4, 34, 70,
-			158, 232, 87, 214, 107, 215, 71, 51, 198, 0, 209, 110, 150, 55,
-			71, 21, 35, 71, 212, 181, 245, 81, 245, 121, 61, 63, 60, 122,
-			232, 121, 61, 127, 104, 244, 240, 243, 122, 254, 240, 232, 72, 53,
-			235, 178, 93, 230, 87, 179, 158, 211, 100, 126, 53, 125, 113, 88,
-			225, 31, 169, 228, 144, 160, 245, 193, 87, 175, 158, 92, 189, 151,
-			200, 128, 56, 158, 137, 240, 220, 126, 30, 202, 133, 131, 149, 170,
-			178, 114, 52, 157, 180, 120, 58, 153, 159, 83, 72, 142, 215, 139,
-			102, 171, 146, 152, 173, 127, 179, 130, 226, 4, 33, 240, 239, 173,
-			120, 234, 15, 87, 7, 161, 4, 239, 180, 88, 248, 9, 133, 232,
-			171, 32, 43, 74, 68, 187, 198, 66, 195, 216, 47, 241, 205, 241,
-			3, 36, 134, 49, 71, 116, 144, 184, 70, 244, 48, 33, 127, 15,
-			126, 227, 73, 146, 69, 222, 25, 19, 7, 77, 71, 243, 200, 129,
-			12, 126, 207, 110, 36, 251, 198, 22, 143, 209, 253, 236, 253, 99,
-			116, 255, 78, 92, 73, 182, 25, 199, 232, 62, 131, 63, 85, 67,
-			27, 22, 145, 187, 154, 161, 29, 202, 76, 145, 175, 171, 60, 34,
-			246, 104, 102, 83, 49, 255, 153, 74, 227, 225, 150, 70, 26, 113,
-			159, 152, 184, 70, 172, 231, 199, 169, 62, 189, 128, 249, 240, 2,
-			149, 140, 143, 98, 74, 162, 183, 210, 150, 54, 118, 215, 14, 194,
-			160, 72, 45, 17, 105, 153, 232, 140, 135, 173, 246, 26, 13, 198,
-			48, 233, 187, 109, 249, 77, 76, 121, 242, 90, 116, 119, 155, 69,
-			55, 93, 164, 219, 229, 151, 235, 239, 90, 65, 28, 86, 5, 56,
-			172, 121, 33, 75, 153, 113, 56, 122, 120, 223, 133, 207, 194, 158,
-			239, 210, 22, 72, 13, 104, 67, 36, 85, 197, 237, 54, 185, 35,
-			145, 43, 224, 68, 54, 108, 59, 118, 184, 71, 229, 7, 3, 92,
-			203, 161, 13, 207, 13, 66, 223, 178, 221, 212, 213, 106, 71, 243,
-			6, 41, 201, 32, 223, 73, 245, 136, 121, 154, 127, 5, 64, 144,
-			41, 132, 13, 247, 180, 114, 241, 155, 12, 149, 157, 76, 133, 202,
-			78, 14, 142, 38, 66, 101, 39, 199, 39, 200, 207, 170, 50, 84,
-			246, 180, 106, 152, 255, 80, 197, 182, 65, 120, 236, 183, 146, 66,
-			23, 109, 22, 59, 132, 97, 90, 137, 67, 5, 156, 168, 101, 156,
-			157, 168, 204, 219, 224, 44, 222, 188, 190, 180, 112, 241, 18, 221,
-			182, 130, 109, 110, 196, 229, 85, 73, 178, 46, 52, 187, 233, 117,
-			24, 237, 133, 192, 25, 56, 95, 0, 115, 91, 182, 219, 164, 93,
-			43, 224, 31, 81, 240, 113, 10, 91, 220, 108, 45, 250, 195, 142,
-			66, 140, 188, 108, 224, 17, 38, 240, 58, 140, 72, 166, 219, 97,
-			64, 249, 125, 2, 104, 5, 220, 67, 172, 189, 46, 242, 11, 154,
-			149, 109, 2, 154, 136, 159, 237, 6, 33, 179, 154, 60, 240, 195,
-			103, 112, 210, 217, 97, 124, 88, 249, 109, 185, 118, 152, 74, 14,
-			59, 157, 10, 181, 61, 157, 10, 181, 61, 61, 58, 70, 42, 50,
-			212, 246, 113, 117, 204, 124, 54, 142, 132, 16, 131, 117, 224, 21,
-			90, 103, 3, 17, 114, 130, 151, 10, 192, 236, 98, 205, 212, 229,
-			111, 143, 243, 192, 118, 17, 123, 251, 248, 192, 112, 34, 246, 246,
-			241, 145, 81, 17, 223, 171, 25, 218, 89, 213, 16, 241, 189, 182,
-			107, 243, 8, 179, 132, 127, 133, 27, 39, 189, 136, 204, 84, 84,
-			234, 217, 40, 235, 13, 214, 251, 217, 40, 235, 13, 214, 249, 217,
-			209, 49, 242, 199, 170, 140, 74, 157, 85, 31, 49, 191, 193, 103,
-			78, 199, 186, 107, 119, 122, 157, 196, 185, 21, 206, 19, 129, 232,
-			164, 231, 187, 37, 121, 99, 22, 63, 157, 114, 83, 138, 140, 152,
-			133, 85, 71, 18, 203, 0, 94, 195, 24, 179, 190, 172, 91, 185,
-			200, 66, 145, 174, 24, 113, 72, 68, 42, 184, 142, 92, 149, 65,
-			34, 58, 15, 142, 137, 116, 41, 8, 122, 29, 24, 70, 108, 3,
-			99, 218, 229, 58, 71, 108, 64, 106, 16, 185, 164, 173, 144, 58,
-			204, 10, 66, 204, 87, 4, 198, 77, 177, 29, 230, 82, 187, 5,
-			53, 119, 108, 207, 137, 238, 218, 194, 0, 154, 24, 241, 105, 158,
-			29, 25, 16, 218, 177, 220, 61, 106, 53, 249, 165, 62, 156, 253,
-			1, 208, 41, 238, 115, 144, 23, 221, 176, 187, 32, 166, 184, 47,
-			157, 199, 94, 136, 150, 82, 119, 108, 205, 70, 67, 162, 43, 134,
-			54, 155, 55, 18, 1, 180, 179, 71, 142, 146, 79, 171, 50, 128,
-			246, 146, 122, 212, 252, 129, 123, 13, 9, 202, 57, 244, 77, 4,
-			105, 177, 17, 197, 76, 69, 190, 127, 62, 74, 174, 39, 110, 219,
-			72, 12, 77, 100, 73, 227, 99, 87, 74, 191, 139, 105, 211, 113,
-			130, 105, 212, 76, 210, 138, 33, 91, 136, 198, 47, 22, 43, 241,
-			21, 66, 132, 182, 88, 216, 216, 78, 78, 219, 216, 206, 26, 68,
-			252, 195, 74, 40, 34, 220, 189, 36, 125, 113, 252, 46, 50, 101,
-			32, 17, 191, 123, 41, 63, 150, 136, 223, 189, 52, 113, 132, 124,
-			175, 46, 227, 119, 87, 84, 211, 252, 51, 45, 117, 121, 158, 12,
-			71, 229, 27, 132, 148, 190, 209, 188, 198, 57, 157, 240, 80, 198,
-			253, 211, 37, 154, 42, 231, 47, 78, 53, 89, 203, 234, 57, 225,
-			180, 136, 60, 11, 209, 93, 42, 239, 232, 13, 18, 41, 25, 61,
-			206, 96, 66, 185, 151, 22, 38, 86, 16, 122, 93, 152, 133, 137,
-			251, 154, 24, 198, 96, 39, 60, 167, 204, 197, 33, 67, 35, 109,
-			100, 194, 177, 113, 129, 97, 32, 146, 155, 22, 3, 37, 186, 68,
-			215, 146, 71, 247, 8, 83, 196, 207, 103, 29, 111, 71, 92, 95,
-			136, 81, 184, 36, 190, 170, 106, 223, 69, 231, 129, 181, 215, 191,
-			117, 192, 196, 129, 157, 154, 122, 173, 69, 66, 95, 185, 80, 164,
-			79, 22, 233, 165, 34, 125, 234, 213, 123, 49, 8, 3, 227, 56,
-			201, 23, 36, 14, 192, 232, 69, 254, 246, 171, 69, 228, 66, 23,
-			232, 168, 179, 134, 133, 23, 105, 95, 196, 120, 126, 78, 29, 16,
-			180, 111, 76, 82, 20, 225, 176, 37, 81, 73, 133, 73, 175, 68,
-			34, 54, 167, 24, 218, 202, 192, 145, 68, 152, 244, 202, 228, 163,
-			228, 27, 138, 188, 96, 179, 162, 190, 160, 153, 191, 140, 87, 45,
-			202, 193, 42, 10, 205, 66, 220, 151, 138, 29, 138, 224, 48, 106,
-			209, 248, 224, 27, 197, 229, 196, 55, 115, 197, 106, 3, 63, 45,
-			211, 173, 234, 106, 128, 171, 43, 1, 163, 224, 114, 189, 16, 59,
-			244, 252, 132, 159, 29, 221, 222, 36, 250, 124, 131, 188, 94, 49,
-			192, 175, 112, 244, 161, 21, 119, 206, 23, 34, 134, 235, 166, 46,
-			252, 172, 144, 73, 114, 61, 190, 240, 243, 67, 250, 57, 243, 25,
-			17, 2, 204, 141, 41, 241, 238, 21, 99, 23, 181, 87, 231, 59,
-			171, 87, 194, 173, 55, 113, 175, 103, 14, 154, 58, 158, 186, 231,
-			243, 67, 39, 206, 164, 238, 249, 252, 208, 212, 52, 121, 62, 190,
-			231, 115, 77, 159, 48, 47, 211, 170, 16, 203, 201, 206, 18, 87,
-			150, 158, 13, 98, 151, 107, 116, 211, 172, 200, 182, 72, 94, 235,
-			185, 150, 184, 58, 18, 54, 237, 181, 193, 145, 212, 181, 158, 107,
-			198, 56, 41, 199, 215, 122, 110, 232, 227, 230, 165, 7, 232, 59,
-			10, 164, 136, 12, 22, 233, 27, 60, 55, 18, 221, 2, 77, 27,
-			131, 135, 83, 55, 120, 110, 140, 25, 24, 204, 155, 81, 7, 12,
-			173, 170, 202, 236, 136, 129, 28, 64, 82, 111, 27, 80, 12, 173,
-			58, 38, 243, 112, 6, 52, 67, 171, 62, 246, 56, 249, 49, 133,
-			7, 237, 191, 148, 105, 41, 230, 39, 21, 154, 56, 49, 61, 160,
-			210, 13, 111, 196, 90, 55, 173, 132, 114, 3, 37, 113, 172, 38,
-			119, 104, 82, 139, 182, 109, 216, 6, 147, 1, 32, 124, 14, 36,
-			115, 74, 226, 93, 69, 38, 11, 188, 148, 31, 71, 69, 22, 131,
-			173, 111, 62, 184, 34, 171, 160, 34, 123, 83, 232, 89, 60, 2,
-			251, 166, 80, 100, 121, 4, 246, 77, 169, 200, 42, 192, 215, 250,
-			251, 138, 236, 195, 41, 178, 10, 174, 138, 122, 196, 96, 24, 172,
-			186, 80, 100, 21, 92, 17, 117, 161, 200, 42, 176, 30, 216, 123,
-			162, 200, 42, 184, 38, 152, 144, 178, 10, 174, 8, 38, 20, 89,
-			5, 215, 3, 27, 25, 37, 235, 24, 87, 159, 181, 51, 63, 162,
-			40, 230, 21, 154, 56, 244, 199, 243, 58, 117, 41, 245, 187, 157,
-			38, 101, 8, 190, 157, 135, 53, 46, 2, 226, 239, 168, 71, 204,
-			167, 233, 134, 152, 128, 241, 93, 213, 8, 98, 242, 158, 164, 45,
-			16, 28, 172, 51, 252, 202, 81, 232, 9, 106, 120, 180, 252, 29,
-			193, 66, 30, 45, 127, 71, 204, 81, 30, 45, 127, 103, 124, 130,
-			108, 17, 30, 176, 168, 185, 234, 184, 121, 157, 86, 153, 229, 116,
-			98, 58, 0, 152, 74, 196, 61, 10, 4, 166, 147, 217, 67, 118,
-			208, 159, 241, 20, 33, 0, 99, 232, 70, 8, 192, 24, 186, 131,
-			135, 37, 164, 25, 154, 59, 102, 240, 77, 11, 83, 87, 67, 245,
-			152, 249, 43, 74, 127, 32, 87, 34, 99, 149, 235, 25, 66, 39,
-			233, 187, 163, 27, 196, 131, 80, 211, 226, 237, 170, 190, 71, 3,
-			22, 70, 121, 55, 226, 193, 217, 128, 242, 251, 197, 35, 39, 56,
-			204, 26, 121, 245, 53, 225, 247, 159, 138, 135, 192, 9, 17, 37,
-			206, 248, 91, 103, 3, 154, 140, 143, 138, 35, 245, 97, 239, 171,
-			199, 65, 33, 17, 19, 64, 121, 11, 85, 201, 119, 96, 117, 56,
-			118, 84, 66, 154, 161, 133, 143, 154, 228, 191, 115, 38, 104, 134,
-			246, 17, 245, 140, 249, 95, 56, 19, 216, 221, 174, 229, 54, 89,
-			243, 192, 32, 170, 72, 160, 71, 247, 197, 133, 204, 149, 223, 251,
-			123, 126, 115, 125, 141, 31, 90, 122, 157, 174, 212, 135, 132, 205,
-			34, 54, 71, 96, 12, 112, 138, 212, 228, 133, 202, 114, 199, 140,
-			194, 30, 47, 19, 126, 139, 39, 255, 108, 8, 78, 0, 249, 73,
-			156, 102, 188, 183, 201, 215, 228, 189, 152, 194, 31, 29, 99, 142,
-			93, 146, 84, 42, 158, 138, 23, 11, 127, 68, 164, 226, 169, 120,
-			184, 251, 200, 113, 153, 243, 1, 135, 187, 143, 60, 246, 56, 249,
-			30, 100, 145, 110, 104, 31, 85, 31, 51, 23, 48, 169, 57, 113,
-			97, 31, 199, 7, 29, 245, 82, 176, 52, 15, 208, 186, 85, 85,
-			199, 22, 34, 40, 103, 104, 31, 29, 122, 84, 66, 138, 161, 125,
-			212, 60, 41, 33, 205, 208, 62, 122, 186, 64, 86, 161, 99, 45,
-			99, 228, 62, 166, 168, 63, 172, 104, 230, 179, 244, 186, 231, 224,
-			141, 77, 7, 122, 104, 83, 146, 134, 111, 204, 60, 248, 100, 171,
-			186, 42, 60, 146, 42, 70, 117, 125, 76, 33, 19, 228, 89, 146,
-			3, 80, 205, 96, 42, 195, 140, 89, 220, 119, 105, 188, 29, 236,
-			83, 100, 248, 245, 211, 34, 156, 66, 229, 234, 11, 188, 126, 34,
-			46, 192, 76, 136, 147, 83, 113, 1, 102, 66, 156, 47, 146, 69,
-			209, 33, 38, 40, 232, 71, 205, 115, 34, 219, 2, 123, 72, 172,
-			187, 173, 234, 106, 17, 20, 250, 104, 53, 37, 186, 83, 120, 186,
-			67, 162, 0, 91, 19, 225, 95, 170, 8, 69, 122, 91, 153, 56,
-			66, 158, 17, 221, 169, 134, 254, 67, 138, 126, 196, 156, 238, 239,
-			142, 159, 248, 239, 215, 155, 154, 197, 119, 19, 5, 152, 31, 33,
-			82, 73, 84, 17, 22, 244, 67, 202, 248, 4, 233, 18, 17, 141,
-			253, 73, 69, 61, 97, 214, 233, 146, 27, 249, 159, 147, 125, 242,
-			241, 216, 175, 152, 237, 195, 130, 238, 216, 22, 161, 5, 120, 85,
-			220, 25, 209, 243, 157, 91, 82, 211, 44, 200, 225, 196, 40, 218,
-			79, 42, 234, 176, 4, 21, 0, 15, 77, 74, 80, 3, 240, 216,
-			113, 82, 197, 212, 164, 220, 167, 148, 204, 31, 40, 138, 185, 66,
-			147, 38, 225, 7, 84, 138, 240, 149, 228, 238, 49, 196, 19, 152,
-			244, 79, 41, 249, 9, 114, 145, 232, 186, 166, 103, 140, 220, 103,
-			20, 245, 199, 20, 205, 60, 67, 133, 187, 33, 125, 183, 101, 40,
-			10, 121, 206, 50, 39, 130, 127, 14, 231, 51, 202, 0, 79, 238,
-			211, 248, 247, 112, 126, 84, 209, 15, 153, 151, 232, 21, 47, 220,
-			142, 239, 85, 2, 57, 28, 93, 172, 36, 252, 70, 251, 143, 206,
-			34, 45, 71, 124, 169, 230, 71, 163, 236, 24, 29, 175, 62, 208,
-			127, 84, 25, 26, 198, 217, 161, 241, 143, 213, 252, 61, 69, 31,
-			54, 167, 233, 186, 235, 236, 197, 61, 61, 64, 227, 10, 127, 119,
-			32, 46, 80, 161, 128, 12, 69, 141, 171, 134, 254, 57, 69, 31,
-			146, 141, 63, 12, 230, 42, 38, 120, 232, 185, 184, 0, 27, 27,
-			36, 156, 211, 192, 161, 31, 87, 212, 35, 230, 217, 68, 100, 25,
-			215, 242, 98, 205, 81, 36, 246, 250, 158, 140, 71, 192, 244, 49,
-			120, 47, 47, 65, 5, 192, 193, 81, 9, 106, 0, 142, 79, 144,
-			63, 87, 101, 174, 208, 151, 20, 213, 48, 255, 72, 237, 239, 69,
-			44, 85, 222, 67, 215, 242, 173, 14, 11, 153, 15, 194, 126, 195,
-			10, 183, 249, 145, 76, 70, 47, 122, 126, 135, 22, 64, 183, 155,
-			5, 165, 110, 150, 71, 192, 204, 158, 159, 229, 109, 204, 130, 74,
-			81, 72, 220, 77, 23, 5, 48, 241, 39, 20, 142, 116, 32, 232,
-			196, 119, 188, 240, 216, 216, 118, 188, 250, 76, 16, 238, 57, 140,
-			22, 206, 21, 120, 132, 211, 185, 115, 5, 234, 117, 153, 111, 133,
-			30, 79, 165, 77, 228, 188, 189, 214, 11, 194, 253, 56, 20, 112,
-			51, 73, 198, 109, 96, 151, 66, 175, 232, 117, 120, 52, 91, 29,
-			48, 130, 198, 167, 58, 204, 114, 81, 97, 141, 190, 67, 17, 96,
-			52, 223, 122, 199, 142, 183, 122, 84, 98, 81, 123, 149, 145, 65,
-			60, 251, 211, 247, 61, 159, 78, 185, 30, 12, 122, 179, 129, 102,
-			141, 212, 29, 224, 211, 209, 24, 129, 140, 251, 82, 60, 70, 10,
-			142, 130, 136, 185, 231, 57, 89, 95, 82, 70, 199, 72, 19, 135,
-			72, 53, 244, 159, 84, 212, 49, 243, 197, 164, 14, 202, 141, 125,
-			241, 105, 148, 227, 122, 86, 4, 56, 247, 235, 160, 145, 114, 236,
-			181, 144, 81, 4, 213, 235, 13, 174, 189, 243, 78, 65, 176, 253,
-			164, 162, 230, 36, 168, 0, 56, 48, 44, 65, 13, 192, 145, 81,
-			242, 113, 76, 60, 4, 240, 167, 1, 167, 55, 98, 156, 208, 38,
-			17, 244, 185, 24, 56, 38, 92, 201, 143, 144, 177, 130, 131, 118,
-			119, 34, 239, 127, 143, 48, 109, 178, 68, 53, 254, 157, 60, 41,
-			183, 130, 8, 111, 45, 139, 168, 72, 188, 65, 90, 253, 116, 140,
-			183, 134, 136, 142, 140, 146, 255, 7, 209, 214, 13, 253, 103, 97,
-			182, 119, 233, 26, 187, 27, 162, 56, 6, 205, 10, 13, 1, 197,
-			253, 159, 121, 176, 3, 49, 253, 69, 142, 154, 76, 226, 149, 231,
-			63, 20, 147, 36, 113, 231, 98, 215, 103, 59, 182, 215, 147, 175,
-			57, 172, 5, 74, 67, 43, 66, 86, 207, 34, 2, 114, 220, 225,
-			8, 251, 179, 241, 184, 235, 26, 128, 163, 99, 60, 169, 73, 131,
-			17, 249, 231, 138, 58, 9, 122, 235, 141, 200, 99, 46, 69, 249,
-			126, 51, 39, 239, 83, 10, 158, 216, 2, 205, 215, 73, 186, 133,
-			200, 64, 217, 235, 118, 225, 24, 136, 71, 41, 41, 171, 162, 15,
-			252, 149, 232, 117, 111, 151, 237, 48, 191, 40, 109, 53, 98, 4,
-			121, 39, 241, 135, 176, 249, 135, 91, 234, 140, 6, 189, 232, 187,
-			123, 247, 112, 243, 112, 82, 179, 156, 182, 1, 9, 42, 0, 230,
-			199, 37, 168, 1, 120, 244, 17, 178, 141, 124, 200, 25, 250, 87,
-			21, 245, 152, 249, 178, 76, 153, 171, 237, 117, 89, 255, 224, 201,
-			111, 59, 7, 73, 14, 200, 184, 224, 232, 251, 55, 177, 117, 177,
-			47, 123, 144, 119, 156, 203, 98, 87, 114, 124, 114, 10, 128, 34,
-			215, 74, 195, 180, 146, 175, 42, 147, 38, 102, 218, 104, 160, 180,
-			253, 34, 79, 247, 57, 149, 74, 46, 220, 235, 226, 140, 111, 161,
-			223, 159, 162, 126, 124, 72, 166, 202, 234, 191, 168, 16, 147, 60,
-			25, 231, 202, 126, 77, 209, 79, 153, 143, 227, 251, 113, 40, 128,
-			176, 71, 247, 53, 18, 229, 182, 242, 220, 151, 137, 84, 246, 235,
-			215, 148, 35, 102, 42, 251, 245, 107, 202, 137, 147, 34, 31, 116,
-			192, 208, 191, 174, 168, 143, 11, 42, 6, 114, 8, 26, 18, 84,
-			0, 28, 63, 41, 65, 13, 192, 211, 143, 145, 58, 108, 253, 196,
-			208, 255, 149, 162, 142, 155, 91, 244, 149, 149, 242, 70, 181, 188,
-			188, 84, 43, 175, 188, 74, 111, 99, 196, 192, 237, 251, 243, 92,
-			124, 48, 140, 90, 173, 80, 216, 108, 69, 236, 46, 143, 105, 231,
-			206, 23, 93, 215, 72, 6, 58, 25, 50, 184, 178, 49, 104, 232,
-			191, 172, 168, 135, 249, 163, 193, 12, 64, 67, 98, 125, 12, 2,
-			157, 73, 80, 21, 96, 67, 160, 250, 171, 128, 234, 139, 125, 168,
-			98, 84, 195, 3, 162, 42, 62, 112, 124, 31, 92, 21, 232, 37,
-			129, 235, 175, 197, 184, 42, 0, 69, 200, 41, 125, 160, 42, 192,
-			255, 87, 17, 200, 254, 22, 204, 232, 189, 52, 178, 149, 212, 140,
-			142, 214, 77, 116, 240, 151, 120, 135, 219, 94, 0, 42, 60, 252,
-			29, 139, 79, 249, 141, 41, 113, 241, 43, 102, 143, 177, 32, 53,
-			237, 101, 157, 136, 30, 21, 16, 25, 50, 35, 122, 126, 59, 166,
-			71, 5, 40, 34, 0, 246, 131, 36, 40, 159, 254, 71, 174, 70,
-			228, 13, 253, 223, 43, 42, 53, 127, 91, 165, 53, 171, 29, 9,
-			41, 25, 97, 14, 69, 161, 23, 83, 132, 15, 185, 188, 18, 103,
-			122, 186, 132, 151, 159, 112, 229, 185, 99, 237, 197, 6, 170, 69,
-			66, 103, 232, 82, 226, 26, 20, 124, 15, 195, 212, 229, 247, 93,
-			3, 150, 226, 16, 191, 210, 84, 116, 197, 79, 234, 142, 215, 198,
-			4, 13, 206, 179, 208, 106, 203, 163, 180, 144, 245, 113, 235, 93,
-			203, 246, 75, 81, 151, 226, 62, 80, 55, 242, 33, 76, 185, 182,
-			51, 205, 215, 231, 187, 160, 0, 221, 37, 68, 159, 196, 66, 126,
-			137, 102, 71, 26, 89, 172, 54, 116, 84, 236, 243, 216, 195, 254,
-			156, 210, 160, 213, 124, 14, 121, 44, 133, 81, 94, 1, 80, 36,
-			32, 105, 106, 94, 3, 240, 196, 41, 242, 18, 142, 199, 160, 161,
-			127, 83, 81, 143, 154, 21, 186, 193, 63, 117, 19, 207, 255, 152,
-			245, 137, 21, 16, 35, 229, 249, 248, 175, 123, 54, 76, 126, 42,
-			39, 194, 98, 48, 135, 45, 203, 108, 114, 152, 245, 223, 84, 136,
-			212, 46, 7, 53, 0, 199, 143, 144, 26, 79, 38, 255, 67, 37,
-			243, 113, 85, 49, 175, 210, 84, 36, 202, 131, 154, 178, 14, 60,
-			142, 192, 158, 249, 135, 74, 254, 8, 218, 231, 116, 158, 175, 164,
-			30, 49, 47, 191, 187, 57, 203, 66, 47, 8, 239, 50, 109, 209,
-			18, 25, 214, 152, 119, 20, 229, 122, 242, 172, 35, 161, 56, 235,
-			34, 231, 104, 124, 130, 124, 88, 166, 95, 127, 27, 196, 205, 243,
-			15, 108, 213, 74, 168, 148, 220, 15, 187, 207, 174, 21, 39, 62,
-			127, 59, 157, 248, 252, 109, 101, 240, 112, 34, 241, 249, 219, 202,
-			152, 65, 150, 1, 13, 216, 131, 254, 68, 81, 255, 151, 162, 153,
-			23, 100, 106, 115, 223, 135, 6, 48, 221, 44, 82, 13, 34, 126,
-			39, 50, 140, 113, 95, 250, 19, 24, 196, 18, 201, 1, 8, 76,
-			253, 142, 162, 79, 152, 39, 81, 69, 140, 114, 162, 210, 54, 98,
-			177, 35, 233, 34, 99, 235, 59, 242, 16, 173, 139, 29, 233, 59,
-			242, 174, 4, 93, 236, 72, 223, 81, 140, 113, 242, 45, 69, 244,
-			161, 24, 250, 159, 41, 250, 9, 243, 223, 40, 52, 250, 32, 95,
-			248, 119, 198, 58, 39, 233, 86, 114, 72, 166, 17, 23, 32, 221,
-			227, 147, 113, 129, 6, 5, 199, 142, 147, 111, 171, 130, 51, 170,
-			161, 255, 165, 162, 159, 53, 127, 135, 219, 244, 65, 241, 157, 233,
-			90, 141, 59, 105, 19, 74, 243, 0, 115, 29, 138, 209, 4, 138,
-			172, 47, 235, 46, 144, 22, 51, 28, 214, 88, 41, 194, 19, 145,
-			200, 118, 148, 166, 60, 57, 101, 14, 226, 27, 207, 91, 41, 246,
-			101, 1, 73, 253, 145, 196, 102, 189, 232, 147, 127, 7, 219, 2,
-			87, 246, 189, 123, 47, 139, 96, 159, 137, 111, 127, 245, 4, 53,
-			241, 153, 33, 198, 141, 36, 153, 36, 89, 15, 138, 229, 95, 74,
-			195, 150, 46, 108, 63, 127, 169, 156, 44, 196, 5, 26, 20, 156,
-			121, 130, 124, 80, 140, 13, 207, 8, 155, 52, 231, 68, 150, 97,
-			212, 85, 98, 100, 14, 50, 164, 198, 157, 138, 4, 174, 193, 184,
-			0, 19, 184, 200, 68, 92, 128, 157, 28, 125, 132, 212, 228, 213,
-			6, 31, 83, 213, 147, 230, 85, 225, 136, 228, 31, 78, 74, 9,
-			110, 252, 124, 157, 200, 146, 18, 113, 37, 241, 102, 36, 15, 233,
-			56, 160, 241, 173, 2, 58, 54, 27, 129, 57, 0, 163, 212, 108,
-			96, 196, 199, 84, 227, 209, 196, 37, 3, 31, 83, 143, 159, 32,
-			191, 167, 200, 75, 6, 222, 86, 85, 195, 252, 215, 74, 159, 202,
-			178, 255, 67, 121, 34, 143, 162, 3, 122, 85, 250, 128, 146, 136,
-			55, 3, 202, 130, 120, 243, 60, 104, 49, 251, 172, 203, 172, 104,
-			57, 191, 144, 156, 156, 9, 103, 50, 191, 148, 1, 38, 119, 124,
-			103, 55, 234, 62, 123, 81, 168, 137, 8, 245, 224, 194, 25, 78,
-			129, 145, 181, 56, 206, 248, 127, 91, 77, 101, 252, 191, 173, 70,
-			233, 240, 26, 146, 62, 58, 70, 214, 136, 154, 203, 24, 185, 79,
-			168, 153, 207, 170, 138, 249, 65, 126, 199, 164, 16, 97, 176, 121,
-			205, 180, 172, 134, 8, 187, 193, 15, 171, 7, 252, 62, 176, 215,
-			83, 91, 26, 76, 160, 29, 187, 33, 110, 47, 201, 225, 71, 151,
-			213, 252, 48, 185, 70, 244, 28, 74, 225, 79, 170, 106, 209, 124,
-			6, 125, 58, 242, 200, 207, 165, 160, 204, 64, 199, 35, 51, 136,
-			196, 72, 212, 247, 103, 249, 230, 184, 52, 254, 164, 154, 27, 148,
-			160, 10, 32, 153, 144, 160, 6, 224, 169, 115, 160, 57, 228, 80,
-			46, 255, 255, 170, 90, 50, 43, 220, 69, 26, 91, 26, 210, 78,
-			209, 62, 81, 125, 95, 135, 40, 239, 71, 193, 150, 115, 17, 168,
-			2, 56, 116, 84, 130, 26, 128, 167, 139, 100, 13, 177, 80, 13,
-			253, 51, 170, 186, 96, 126, 48, 26, 106, 142, 70, 223, 30, 36,
-			230, 89, 124, 81, 66, 60, 103, 18, 211, 61, 199, 87, 246, 103,
-			212, 220, 144, 4, 177, 253, 225, 73, 9, 106, 0, 62, 54, 39,
-			147, 116, 254, 119, 0, 0, 0, 255, 255, 45, 84, 14, 172, 148,
-			146, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 125, 112, 28, 201,
+			117, 24, 190, 243, 177, 139, 69, 131, 36, 128, 1, 200, 3, 135,
+			95, 205, 189, 227, 17, 32, 23, 139, 15, 30, 121, 119, 224, 157,
+			44, 16, 88, 146, 123, 2, 1, 220, 98, 113, 212, 221, 213, 21,
+			57, 187, 211, 187, 152, 227, 236, 204, 106, 102, 22, 32, 116, 186,
+			159, 108, 89, 254, 157, 100, 69, 142, 245, 225, 114, 201, 145, 156,
+			42, 169, 146, 168, 100, 59, 81, 217, 114, 85, 202, 46, 185, 108,
+			39, 170, 196, 142, 191, 147, 178, 20, 39, 182, 43, 142, 108, 39,
+			46, 69, 127, 168, 28, 39, 169, 74, 57, 169, 247, 186, 123, 102,
+			118, 1, 126, 73, 23, 167, 156, 186, 59, 222, 113, 95, 79, 79,
+			247, 123, 175, 95, 191, 126, 253, 222, 235, 30, 242, 47, 230, 200,
+			169, 150, 239, 183, 92, 54, 211, 9, 252, 200, 175, 119, 155, 51,
+			145, 211, 102, 97, 100, 181, 59, 37, 44, 50, 134, 121, 133, 146,
+			172, 80, 184, 76, 6, 107, 178, 142, 49, 65, 6, 66, 214, 240,
+			61, 59, 156, 80, 168, 50, 169, 85, 37, 104, 140, 147, 172, 103,
+			121, 126, 56, 161, 82, 101, 50, 91, 229, 192, 149, 31, 82, 200,
+			88, 195, 111, 151, 250, 26, 189, 114, 40, 110, 114, 29, 138, 214,
+			149, 87, 230, 69, 149, 150, 239, 90, 94, 171, 228, 7, 173, 20,
+			142, 187, 29, 22, 206, 220, 241, 252, 29, 47, 193, 183, 83, 255,
+			239, 138, 242, 121, 85, 187, 182, 126, 229, 75, 234, 201, 107, 252,
+			237, 117, 241, 74, 233, 38, 115, 221, 247, 192, 11, 53, 120, 247,
+			133, 175, 205, 144, 1, 35, 123, 50, 243, 73, 69, 33, 191, 113,
+			128, 40, 7, 12, 237, 100, 198, 152, 255, 149, 3, 20, 223, 104,
+			248, 46, 189, 210, 109, 54, 89, 16, 210, 105, 202, 219, 58, 27,
+			82, 219, 138, 44, 234, 120, 17, 11, 26, 91, 150, 215, 98, 180,
+			233, 7, 109, 43, 34, 116, 201, 239, 236, 6, 78, 107, 43, 162,
+			243, 179, 179, 207, 136, 23, 104, 197, 107, 148, 40, 93, 116, 93,
+			138, 207, 66, 26, 176, 144, 5, 219, 204, 46, 17, 186, 21, 69,
+			157, 112, 97, 102, 198, 102, 219, 204, 245, 59, 44, 8, 37, 79,
+			26, 126, 155, 83, 218, 240, 221, 233, 58, 71, 98, 134, 16, 90,
+			101, 182, 19, 70, 129, 83, 239, 70, 142, 239, 81, 203, 179, 105,
+			55, 100, 212, 241, 104, 232, 119, 131, 6, 195, 146, 186, 227, 89,
+			193, 46, 226, 21, 22, 233, 142, 19, 109, 81, 63, 192, 191, 253,
+			110, 68, 104, 219, 183, 157, 166, 211, 176, 160, 133, 34, 181, 2,
+			70, 59, 44, 104, 59, 81, 196, 108, 218, 9, 252, 109, 199, 102,
+			54, 141, 182, 172, 136, 70, 91, 64, 157, 235, 250, 59, 142, 215,
+			162, 48, 194, 14, 188, 20, 194, 75, 132, 182, 89, 180, 64, 8,
+			133, 127, 206, 245, 33, 22, 82, 191, 41, 49, 106, 248, 54, 163,
+			237, 110, 24, 209, 128, 69, 150, 227, 97, 171, 86, 221, 223, 134,
+			71, 130, 99, 132, 122, 126, 228, 52, 88, 145, 70, 91, 78, 72,
+			93, 39, 140, 160, 133, 116, 143, 158, 221, 135, 142, 237, 132, 13,
+			215, 114, 218, 44, 40, 221, 11, 9, 199, 75, 243, 66, 34, 209,
+			9, 124, 187, 219, 96, 9, 30, 36, 65, 228, 123, 194, 131, 80,
+			65, 157, 237, 55, 186, 109, 230, 69, 150, 28, 164, 25, 63, 160,
+			126, 180, 197, 2, 218, 182, 34, 22, 56, 150, 27, 38, 172, 134,
+			129, 129, 54, 9, 77, 99, 31, 19, 181, 202, 28, 124, 19, 26,
+			246, 172, 54, 3, 132, 210, 178, 229, 249, 201, 51, 228, 187, 19,
+			133, 64, 145, 199, 155, 242, 131, 144, 182, 173, 93, 90, 103, 32,
+			41, 54, 141, 124, 202, 60, 219, 15, 66, 6, 66, 209, 9, 252,
+			182, 31, 49, 64, 198, 238, 54, 162, 144, 218, 44, 112, 182, 153,
+			77, 155, 129, 223, 38, 156, 11, 161, 223, 140, 118, 64, 76, 132,
+			4, 209, 176, 195, 26, 32, 65, 180, 19, 56, 32, 88, 1, 200,
+			142, 199, 165, 40, 12, 17, 119, 66, 107, 215, 43, 27, 116, 99,
+			237, 106, 237, 230, 98, 181, 76, 43, 27, 116, 189, 186, 246, 82,
+			101, 185, 188, 76, 175, 188, 76, 107, 215, 203, 116, 105, 109, 253,
+			229, 106, 229, 218, 245, 26, 189, 190, 182, 178, 92, 174, 110, 208,
+			197, 213, 101, 186, 180, 182, 90, 171, 86, 174, 108, 214, 214, 170,
+			27, 132, 22, 22, 55, 104, 101, 163, 128, 79, 22, 87, 95, 166,
+			229, 247, 174, 87, 203, 27, 27, 116, 173, 74, 43, 55, 214, 87,
+			42, 229, 101, 122, 115, 177, 90, 93, 92, 173, 85, 202, 27, 69,
+			90, 89, 93, 90, 217, 92, 174, 172, 94, 43, 210, 43, 155, 53,
+			186, 186, 86, 35, 116, 165, 114, 163, 82, 43, 47, 211, 218, 90,
+			17, 187, 221, 251, 30, 93, 187, 74, 111, 148, 171, 75, 215, 23,
+			87, 107, 139, 87, 42, 43, 149, 218, 203, 216, 225, 213, 74, 109,
+			21, 58, 187, 186, 86, 37, 116, 145, 174, 47, 86, 107, 149, 165,
+			205, 149, 197, 42, 93, 223, 172, 174, 175, 109, 148, 41, 80, 182,
+			92, 217, 88, 90, 89, 172, 220, 40, 47, 151, 104, 101, 149, 174,
+			174, 209, 242, 75, 229, 213, 26, 221, 184, 190, 184, 178, 210, 75,
+			40, 161, 107, 55, 87, 203, 85, 192, 62, 77, 38, 189, 82, 166,
+			43, 149, 197, 43, 43, 101, 122, 117, 173, 138, 116, 46, 87, 170,
+			229, 165, 26, 16, 148, 252, 90, 170, 44, 151, 87, 107, 139, 43,
+			69, 66, 55, 214, 203, 75, 149, 197, 149, 34, 45, 191, 183, 124,
+			99, 125, 101, 177, 250, 114, 81, 52, 186, 81, 126, 113, 179, 188,
+			90, 171, 44, 174, 208, 229, 197, 27, 139, 215, 202, 27, 116, 242,
+			65, 92, 89, 175, 174, 45, 109, 86, 203, 55, 0, 235, 181, 171,
+			116, 99, 243, 202, 70, 173, 82, 219, 172, 149, 233, 181, 181, 181,
+			101, 100, 246, 70, 185, 250, 82, 101, 169, 188, 113, 153, 174, 172,
+			1, 251, 175, 210, 205, 141, 114, 145, 208, 229, 197, 218, 34, 118,
+			189, 94, 93, 187, 90, 169, 109, 92, 134, 223, 87, 54, 55, 42,
+			200, 184, 202, 106, 173, 92, 173, 110, 174, 215, 42, 107, 171, 83,
+			244, 250, 218, 205, 242, 75, 229, 42, 93, 90, 220, 220, 40, 47,
+			35, 135, 215, 86, 129, 90, 144, 149, 242, 90, 245, 101, 104, 22,
+			248, 128, 35, 80, 164, 55, 175, 151, 107, 215, 203, 85, 96, 42,
+			114, 107, 17, 216, 176, 81, 171, 86, 150, 106, 233, 106, 107, 85,
+			90, 91, 171, 214, 72, 138, 78, 186, 90, 190, 182, 82, 185, 86,
+			94, 93, 42, 3, 62, 107, 208, 204, 205, 202, 70, 121, 138, 46,
+			86, 43, 27, 80, 161, 130, 29, 211, 155, 139, 47, 211, 181, 77,
+			164, 26, 6, 106, 115, 163, 76, 248, 239, 148, 232, 22, 113, 60,
+			105, 229, 42, 93, 92, 126, 169, 2, 152, 139, 218, 235, 107, 27,
+			27, 21, 33, 46, 200, 182, 165, 235, 130, 231, 37, 66, 242, 68,
+			81, 13, 141, 102, 38, 224, 87, 222, 208, 10, 153, 203, 100, 144,
+			168, 249, 51, 252, 39, 47, 124, 60, 115, 10, 11, 79, 241, 159,
+			188, 240, 137, 76, 5, 11, 135, 248, 79, 94, 120, 38, 83, 196,
+			66, 133, 255, 228, 133, 79, 102, 102, 176, 80, 252, 228, 133, 103,
+			51, 5, 44, 36, 252, 39, 47, 156, 204, 156, 198, 194, 39, 248,
+			207, 63, 58, 65, 84, 61, 99, 228, 222, 82, 96, 233, 51, 127,
+			251, 4, 93, 164, 241, 210, 139, 10, 146, 133, 204, 139, 66, 106,
+			209, 142, 239, 120, 17, 170, 53, 167, 13, 203, 140, 205, 58, 204,
+			179, 153, 135, 234, 217, 242, 118, 41, 172, 188, 244, 253, 190, 135,
+			218, 196, 245, 27, 150, 75, 104, 195, 114, 153, 103, 91, 65, 145,
+			50, 15, 180, 191, 77, 45, 104, 171, 225, 119, 249, 123, 194, 58,
+			64, 93, 218, 12, 172, 70, 178, 98, 200, 7, 17, 161, 104, 42,
+			32, 12, 43, 166, 239, 114, 165, 72, 107, 91, 76, 52, 228, 192,
+			82, 234, 90, 145, 179, 205, 64, 169, 89, 30, 101, 29, 191, 177,
+			69, 173, 136, 110, 214, 150, 104, 219, 177, 61, 212, 232, 190, 71,
+			232, 11, 150, 215, 133, 37, 113, 174, 72, 231, 158, 125, 122, 182,
+			40, 21, 117, 39, 240, 93, 214, 137, 156, 6, 189, 22, 176, 150,
+			31, 56, 150, 23, 99, 79, 119, 182, 156, 198, 22, 101, 119, 35,
+			6, 200, 162, 130, 222, 167, 86, 221, 106, 220, 217, 177, 2, 168,
+			225, 211, 93, 102, 5, 212, 247, 88, 137, 16, 92, 242, 219, 142,
+			215, 141, 24, 174, 151, 244, 210, 108, 76, 159, 235, 123, 173, 18,
+			93, 97, 86, 39, 33, 57, 96, 180, 16, 182, 153, 21, 48, 187,
+			64, 67, 159, 47, 192, 158, 79, 93, 102, 117, 136, 168, 70, 35,
+			171, 238, 50, 234, 132, 212, 99, 12, 248, 218, 244, 3, 110, 138,
+			116, 96, 109, 5, 14, 21, 105, 55, 132, 213, 209, 162, 175, 206,
+			63, 53, 189, 229, 119, 3, 234, 58, 30, 179, 2, 66, 177, 245,
+			215, 38, 239, 111, 116, 192, 120, 206, 96, 205, 41, 32, 2, 216,
+			29, 160, 149, 227, 132, 184, 38, 208, 217, 217, 217, 185, 105, 252,
+			83, 155, 157, 93, 192, 63, 175, 0, 233, 207, 62, 251, 236, 179,
+			211, 115, 243, 211, 23, 230, 106, 243, 23, 22, 46, 62, 187, 112,
+			241, 217, 210, 179, 242, 159, 87, 74, 244, 202, 46, 129, 129, 140,
+			2, 167, 17, 1, 130, 145, 32, 17, 91, 47, 210, 29, 70, 153,
+			23, 118, 3, 88, 150, 173, 8, 192, 6, 140, 133, 239, 109, 179,
+			32, 130, 246, 185, 176, 248, 109, 250, 106, 245, 234, 18, 161, 23,
+			46, 92, 120, 54, 161, 101, 103, 103, 167, 228, 176, 168, 137, 22,
+			98, 208, 108, 204, 4, 205, 6, 212, 40, 69, 119, 163, 41, 176,
+			216, 24, 5, 187, 192, 107, 133, 64, 212, 227, 180, 124, 215, 106,
+			119, 92, 22, 18, 34, 127, 210, 185, 5, 186, 228, 183, 59, 221,
+			136, 165, 230, 2, 118, 184, 190, 182, 81, 121, 47, 189, 13, 156,
+			153, 156, 186, 93, 18, 38, 79, 82, 41, 54, 62, 47, 243, 39,
+			49, 92, 10, 89, 116, 75, 12, 240, 36, 148, 78, 174, 110, 174,
+			172, 76, 77, 237, 91, 15, 229, 125, 114, 118, 234, 114, 10, 167,
+			249, 7, 225, 212, 98, 17, 180, 235, 55, 109, 107, 55, 133, 91,
+			24, 5, 221, 70, 132, 29, 108, 91, 46, 141, 182, 69, 143, 61,
+			213, 159, 140, 182, 139, 20, 17, 186, 252, 221, 146, 180, 93, 138,
+			182, 129, 192, 251, 81, 196, 43, 117, 67, 214, 160, 231, 232, 220,
+			236, 108, 47, 133, 23, 238, 73, 225, 77, 199, 187, 48, 79, 111,
+			95, 99, 209, 198, 110, 24, 177, 54, 60, 94, 12, 175, 58, 46,
+			171, 245, 14, 196, 213, 202, 74, 185, 86, 185, 81, 166, 205, 72,
+			160, 113, 175, 119, 158, 108, 70, 18, 211, 205, 202, 106, 237, 210,
+			83, 52, 114, 26, 119, 66, 250, 60, 157, 156, 156, 228, 37, 83,
+			205, 168, 100, 239, 92, 119, 90, 91, 203, 86, 132, 111, 77, 209,
+			231, 158, 163, 23, 230, 167, 232, 7, 40, 62, 91, 241, 119, 228,
+			35, 201, 183, 153, 25, 186, 72, 111, 58, 158, 237, 239, 132, 216,
+			36, 204, 208, 185, 217, 217, 148, 14, 11, 75, 113, 5, 174, 165,
+			230, 46, 237, 157, 70, 113, 107, 240, 250, 220, 165, 167, 158, 122,
+			234, 233, 11, 151, 102, 19, 181, 81, 103, 77, 63, 96, 116, 211,
+			115, 238, 10, 93, 7, 202, 172, 191, 149, 210, 119, 55, 152, 147,
+			156, 126, 58, 57, 9, 20, 132, 116, 6, 7, 11, 254, 76, 209,
+			233, 52, 58, 15, 144, 96, 104, 231, 194, 124, 210, 206, 153, 84,
+			59, 40, 0, 83, 61, 2, 240, 212, 61, 5, 224, 5, 107, 219,
+			162, 183, 249, 224, 151, 26, 221, 32, 96, 94, 4, 85, 110, 56,
+			174, 235, 132, 41, 1, 0, 109, 74, 219, 88, 74, 159, 167, 247,
+			126, 225, 62, 98, 78, 159, 79, 74, 75, 30, 219, 185, 210, 117,
+			92, 155, 5, 147, 83, 64, 216, 134, 224, 144, 232, 130, 51, 102,
+			138, 183, 5, 255, 66, 157, 85, 148, 245, 73, 199, 139, 128, 114,
+			81, 147, 147, 46, 200, 6, 22, 76, 77, 149, 234, 208, 50, 226,
+			146, 240, 224, 226, 3, 120, 80, 241, 194, 200, 242, 162, 146, 231,
+			239, 164, 200, 22, 165, 212, 243, 119, 232, 243, 180, 167, 206, 125,
+			41, 77, 16, 127, 48, 201, 158, 191, 83, 106, 177, 168, 12, 194,
+			198, 203, 38, 167, 82, 148, 247, 82, 47, 42, 3, 48, 121, 15,
+			74, 47, 221, 147, 82, 49, 94, 210, 206, 160, 235, 187, 209, 22,
+			223, 72, 244, 8, 90, 122, 160, 38, 167, 250, 30, 150, 174, 177,
+			104, 41, 25, 247, 201, 41, 212, 245, 47, 108, 172, 173, 210, 27,
+			86, 167, 227, 120, 45, 66, 104, 197, 227, 37, 176, 59, 182, 34,
+			216, 112, 166, 113, 137, 118, 59, 184, 190, 246, 24, 46, 124, 233,
+			16, 54, 3, 193, 5, 232, 145, 214, 31, 222, 85, 137, 214, 96,
+			73, 119, 66, 236, 147, 8, 183, 1, 116, 86, 120, 3, 236, 134,
+			55, 167, 223, 104, 251, 94, 180, 245, 230, 244, 27, 182, 181, 251,
+			102, 237, 13, 88, 188, 223, 92, 120, 163, 237, 120, 111, 46, 188,
+			17, 178, 198, 155, 175, 150, 222, 0, 115, 9, 166, 236, 155, 175,
+			189, 82, 32, 116, 103, 139, 5, 140, 242, 183, 161, 33, 203, 221,
+			177, 118, 67, 202, 238, 130, 5, 7, 155, 61, 110, 11, 52, 193,
+			10, 176, 157, 150, 19, 133, 96, 212, 184, 140, 138, 158, 138, 20,
+			187, 42, 18, 202, 59, 43, 82, 236, 173, 136, 150, 25, 118, 137,
+			118, 201, 251, 89, 224, 79, 119, 44, 27, 24, 2, 203, 246, 142,
+			47, 91, 99, 86, 99, 11, 232, 98, 177, 29, 7, 246, 159, 80,
+			41, 69, 97, 65, 53, 44, 143, 182, 124, 218, 237, 192, 50, 254,
+			172, 124, 117, 210, 41, 177, 146, 40, 156, 219, 223, 218, 155, 42,
+			18, 236, 223, 239, 0, 100, 185, 188, 167, 194, 43, 5, 26, 118,
+			155, 77, 231, 46, 216, 163, 78, 195, 2, 3, 11, 70, 17, 132,
+			4, 45, 209, 201, 194, 102, 109, 169, 48, 117, 185, 167, 148, 0,
+			131, 2, 246, 190, 174, 19, 48, 187, 68, 23, 97, 203, 27, 249,
+			23, 184, 48, 132, 184, 39, 119, 222, 207, 2, 26, 110, 249, 93,
+			215, 150, 172, 4, 231, 202, 102, 109, 137, 78, 90, 97, 220, 155,
+			77, 235, 187, 132, 22, 94, 41, 76, 193, 0, 120, 176, 11, 246,
+			184, 73, 179, 87, 148, 128, 145, 86, 79, 87, 29, 43, 8, 147,
+			110, 234, 140, 80, 180, 233, 192, 194, 105, 52, 88, 39, 162, 117,
+			63, 218, 66, 11, 22, 222, 229, 78, 3, 73, 67, 184, 7, 15,
+			106, 121, 212, 111, 54, 67, 22, 161, 185, 118, 213, 15, 40, 227,
+			115, 173, 72, 11, 243, 179, 115, 79, 79, 207, 206, 77, 207, 93,
+			172, 205, 206, 45, 92, 152, 93, 152, 187, 88, 154, 157, 123, 165,
+			32, 204, 242, 144, 34, 28, 47, 47, 29, 43, 140, 8, 197, 154,
+			216, 191, 239, 37, 118, 243, 197, 34, 133, 214, 74, 98, 2, 89,
+			219, 214, 70, 35, 112, 58, 81, 17, 172, 221, 30, 83, 205, 162,
+			176, 60, 82, 191, 254, 58, 3, 19, 4, 172, 60, 48, 29, 185,
+			176, 115, 121, 68, 241, 7, 117, 101, 91, 129, 77, 232, 171, 145,
+			95, 217, 88, 219, 192, 73, 54, 57, 181, 143, 129, 90, 106, 251,
+			239, 119, 92, 215, 66, 235, 142, 121, 211, 155, 27, 51, 182, 223,
+			8, 103, 110, 178, 250, 76, 130, 202, 76, 149, 53, 89, 192, 188,
+			6, 155, 185, 230, 250, 117, 203, 189, 181, 134, 56, 132, 51, 128,
+			208, 76, 170, 147, 41, 244, 93, 109, 249, 118, 9, 180, 1, 215,
+			52, 69, 106, 197, 40, 209, 219, 96, 49, 2, 211, 75, 242, 199,
+			109, 73, 16, 144, 90, 103, 146, 90, 102, 147, 125, 73, 36, 244,
+			213, 219, 97, 20, 52, 241, 213, 20, 69, 126, 35, 44, 117, 176,
+			63, 164, 101, 126, 198, 117, 234, 129, 21, 236, 162, 3, 179, 180,
+			21, 181, 221, 199, 241, 151, 124, 119, 10, 189, 118, 36, 22, 100,
+			217, 9, 120, 96, 232, 217, 51, 47, 79, 159, 105, 79, 159, 177,
+			107, 103, 174, 47, 156, 185, 177, 112, 102, 163, 116, 166, 249, 202,
+			217, 18, 93, 113, 238, 176, 29, 39, 100, 184, 205, 1, 6, 37,
+			163, 212, 13, 25, 111, 237, 5, 223, 182, 80, 88, 207, 134, 244,
+			213, 219, 149, 141, 53, 105, 212, 92, 197, 30, 144, 112, 97, 104,
+			189, 54, 201, 61, 149, 66, 207, 189, 238, 219, 124, 36, 224, 199,
+			52, 96, 57, 99, 117, 28, 28, 16, 89, 138, 228, 204, 112, 92,
+			103, 246, 182, 141, 116, 202, 14, 206, 204, 47, 159, 153, 95, 38,
+			116, 10, 24, 233, 215, 209, 67, 104, 9, 58, 35, 22, 208, 134,
+			213, 193, 9, 226, 55, 105, 139, 121, 44, 176, 248, 84, 147, 211,
+			12, 166, 101, 154, 255, 37, 66, 8, 25, 34, 154, 158, 81, 12,
+			253, 45, 37, 63, 74, 62, 167, 16, 93, 207, 168, 25, 67, 255,
+			152, 162, 142, 155, 63, 162, 208, 106, 178, 195, 149, 178, 239, 55,
+			81, 228, 1, 111, 26, 58, 94, 35, 109, 101, 145, 253, 205, 44,
+			122, 3, 220, 137, 117, 118, 223, 109, 17, 217, 111, 95, 244, 10,
+			117, 188, 134, 219, 13, 157, 109, 216, 40, 30, 36, 89, 64, 47,
+			139, 248, 13, 72, 80, 1, 48, 63, 44, 65, 13, 64, 99, 140,
+			252, 41, 39, 70, 49, 244, 79, 40, 170, 97, 254, 190, 66, 87,
+			125, 111, 218, 99, 45, 190, 15, 150, 90, 24, 9, 178, 4, 117,
+			176, 35, 222, 87, 191, 150, 232, 170, 120, 49, 222, 96, 110, 91,
+			110, 151, 133, 40, 117, 169, 198, 208, 113, 26, 70, 142, 235, 210,
+			45, 107, 155, 81, 47, 221, 39, 54, 45, 94, 4, 217, 178, 34,
+			177, 65, 111, 250, 1, 108, 140, 165, 247, 160, 159, 97, 98, 211,
+			88, 20, 255, 145, 125, 152, 162, 100, 145, 78, 201, 20, 5, 201,
+			206, 31, 148, 160, 6, 224, 200, 104, 61, 199, 245, 43, 249, 228,
+			56, 169, 180, 252, 82, 99, 43, 240, 219, 78, 183, 141, 66, 234,
+			118, 27, 206, 140, 235, 183, 108, 191, 5, 98, 58, 195, 60, 27,
+			125, 26, 225, 76, 195, 247, 3, 219, 241, 172, 200, 15, 160, 66,
+			56, 179, 61, 55, 19, 70, 86, 36, 2, 18, 70, 142, 191, 101,
+			62, 40, 56, 82, 248, 148, 70, 14, 173, 248, 173, 141, 40, 96,
+			86, 123, 3, 90, 48, 30, 39, 7, 177, 250, 173, 109, 22, 128,
+			67, 20, 227, 34, 131, 213, 3, 88, 248, 18, 47, 51, 158, 34,
+			3, 141, 128, 129, 34, 199, 240, 200, 208, 188, 41, 55, 226, 178,
+			171, 82, 188, 154, 84, 101, 85, 227, 12, 57, 20, 129, 163, 213,
+			179, 220, 91, 224, 143, 185, 59, 161, 97, 204, 229, 160, 44, 173,
+			64, 161, 241, 28, 25, 176, 130, 198, 150, 179, 205, 38, 116, 108,
+			188, 80, 226, 244, 148, 122, 81, 45, 45, 242, 90, 21, 175, 233,
+			87, 229, 43, 198, 17, 146, 235, 116, 131, 22, 179, 39, 178, 84,
+			153, 204, 87, 5, 100, 126, 81, 33, 67, 169, 23, 140, 99, 100,
+			16, 113, 184, 213, 13, 92, 65, 99, 30, 11, 54, 3, 215, 56,
+			65, 72, 136, 29, 225, 83, 21, 159, 14, 242, 18, 120, 124, 148,
+			228, 33, 148, 130, 15, 53, 124, 56, 0, 48, 60, 50, 73, 190,
+			225, 195, 34, 22, 113, 236, 243, 213, 24, 54, 158, 36, 195, 174,
+			223, 186, 197, 188, 40, 216, 189, 133, 130, 134, 56, 106, 213, 131,
+			174, 223, 42, 67, 233, 18, 20, 94, 185, 250, 202, 242, 247, 36,
+			11, 151, 57, 187, 94, 248, 249, 97, 146, 51, 116, 61, 51, 175,
+			144, 159, 87, 48, 70, 164, 103, 140, 249, 47, 41, 61, 225, 158,
+			185, 75, 104, 154, 172, 108, 46, 85, 232, 98, 55, 218, 242, 131,
+			176, 116, 143, 152, 207, 38, 56, 222, 155, 210, 179, 158, 68, 72,
+			156, 144, 182, 252, 109, 22, 120, 96, 182, 121, 182, 112, 248, 47,
+			118, 172, 6, 52, 236, 52, 152, 7, 10, 93, 200, 14, 157, 47,
+			205, 202, 185, 198, 23, 164, 166, 223, 245, 108, 233, 214, 90, 169,
+			44, 149, 87, 55, 202, 180, 233, 184, 44, 118, 70, 230, 50, 195,
+			194, 29, 152, 207, 188, 42, 93, 140, 226, 167, 150, 49, 52, 146,
+			153, 34, 63, 169, 160, 103, 80, 31, 206, 204, 43, 230, 39, 20,
+			218, 43, 43, 96, 65, 89, 180, 238, 216, 78, 192, 132, 177, 7,
+			107, 102, 196, 248, 236, 231, 241, 5, 199, 163, 155, 29, 80, 238,
+			124, 62, 128, 59, 205, 13, 193, 110, 216, 219, 22, 107, 215, 153,
+			109, 163, 118, 119, 60, 90, 150, 163, 129, 86, 26, 11, 163, 153,
+			128, 133, 29, 223, 11, 209, 119, 3, 193, 9, 212, 237, 168, 217,
+			181, 225, 252, 17, 178, 44, 244, 186, 54, 154, 63, 109, 62, 205,
+			99, 118, 146, 63, 78, 40, 253, 123, 24, 44, 164, 98, 30, 194,
+			186, 34, 88, 143, 168, 148, 8, 57, 32, 213, 175, 54, 154, 63,
+			36, 33, 197, 208, 70, 135, 143, 75, 72, 51, 180, 209, 83, 148,
+			172, 11, 213, 171, 141, 231, 75, 230, 18, 14, 56, 232, 53, 110,
+			4, 66, 111, 174, 223, 18, 237, 210, 29, 11, 6, 189, 229, 132,
+			17, 11, 82, 33, 30, 186, 148, 8, 90, 220, 183, 146, 51, 180,
+			241, 252, 105, 9, 41, 134, 54, 94, 152, 146, 144, 102, 104, 227,
+			197, 105, 178, 141, 125, 171, 134, 54, 145, 63, 109, 58, 216, 183,
+			232, 9, 167, 27, 151, 168, 52, 6, 103, 67, 42, 21, 2, 109,
+			179, 48, 180, 90, 172, 68, 43, 188, 22, 31, 45, 39, 164, 211,
+			115, 69, 18, 191, 135, 76, 1, 21, 207, 27, 112, 188, 86, 140,
+			161, 154, 133, 142, 15, 74, 72, 49, 180, 137, 67, 146, 59, 170,
+			102, 104, 19, 167, 40, 185, 14, 24, 106, 25, 67, 63, 166, 158,
+			211, 204, 5, 154, 82, 19, 96, 45, 193, 186, 30, 82, 161, 95,
+			168, 13, 145, 64, 55, 20, 195, 145, 198, 91, 246, 169, 193, 40,
+			31, 35, 135, 201, 139, 36, 7, 16, 140, 243, 9, 253, 168, 121,
+			5, 105, 23, 241, 175, 141, 200, 15, 172, 22, 163, 155, 213, 21,
+			177, 23, 234, 109, 236, 44, 88, 7, 192, 30, 39, 238, 218, 46,
+			17, 114, 136, 12, 240, 38, 179, 208, 102, 10, 86, 12, 237, 196,
+			208, 120, 2, 107, 134, 118, 226, 177, 9, 242, 170, 64, 65, 49,
+			180, 83, 186, 105, 174, 60, 34, 10, 129, 181, 35, 0, 17, 59,
+			222, 23, 25, 37, 11, 173, 167, 96, 232, 109, 232, 112, 2, 107,
+			134, 118, 106, 226, 40, 121, 69, 32, 163, 26, 218, 105, 125, 194,
+			124, 207, 35, 34, 99, 133, 33, 107, 215, 93, 102, 223, 15, 23,
+			24, 239, 211, 41, 92, 64, 232, 79, 15, 141, 37, 176, 102, 104,
+			167, 143, 60, 70, 254, 80, 17, 200, 104, 134, 246, 164, 126, 196,
+			252, 77, 5, 69, 44, 232, 178, 34, 181, 92, 23, 135, 21, 20,
+			181, 195, 192, 121, 21, 237, 48, 230, 209, 89, 220, 92, 74, 217,
+			228, 75, 24, 221, 1, 92, 99, 68, 104, 165, 73, 104, 211, 114,
+			67, 25, 135, 149, 59, 158, 48, 9, 75, 39, 68, 225, 92, 243,
+			124, 176, 58, 248, 146, 225, 238, 82, 215, 183, 96, 179, 234, 120,
+			96, 129, 161, 131, 188, 205, 108, 7, 246, 37, 161, 96, 81, 60,
+			105, 121, 175, 150, 203, 253, 232, 224, 46, 101, 119, 59, 78, 208,
+			195, 15, 45, 11, 244, 229, 19, 88, 49, 180, 39, 7, 71, 19,
+			24, 232, 31, 63, 76, 10, 130, 29, 186, 161, 77, 233, 39, 205,
+			49, 28, 27, 175, 219, 174, 179, 0, 102, 168, 235, 183, 146, 54,
+			245, 44, 84, 26, 76, 96, 197, 208, 166, 200, 209, 4, 214, 12,
+			109, 234, 248, 9, 98, 193, 188, 130, 73, 54, 173, 154, 102, 13,
+			248, 139, 214, 151, 227, 22, 251, 249, 144, 26, 203, 162, 136, 83,
+			195, 182, 196, 97, 174, 221, 63, 3, 97, 151, 46, 230, 96, 60,
+			201, 181, 28, 244, 33, 39, 185, 166, 24, 218, 244, 161, 195, 18,
+			130, 254, 39, 142, 146, 15, 34, 50, 186, 161, 205, 229, 39, 204,
+			128, 86, 82, 227, 194, 40, 183, 17, 196, 138, 128, 246, 167, 235,
+			183, 96, 167, 13, 56, 226, 192, 109, 89, 32, 7, 204, 147, 85,
+			157, 144, 250, 158, 187, 75, 168, 213, 128, 60, 14, 151, 217, 80,
+			26, 249, 212, 178, 219, 142, 7, 193, 112, 110, 98, 54, 92, 7,
+			226, 81, 49, 170, 192, 187, 185, 252, 1, 9, 41, 134, 54, 119,
+			112, 76, 66, 154, 161, 205, 29, 121, 44, 54, 10, 191, 113, 134,
+			156, 236, 183, 224, 236, 46, 108, 35, 124, 239, 94, 217, 45, 11,
+			36, 191, 44, 170, 60, 114, 114, 203, 15, 222, 35, 185, 229, 160,
+			108, 81, 230, 182, 204, 61, 100, 110, 139, 68, 246, 145, 82, 91,
+			254, 224, 113, 176, 90, 78, 102, 194, 119, 50, 91, 222, 201, 108,
+			121, 39, 179, 229, 157, 204, 150, 119, 50, 91, 222, 201, 108, 249,
+			191, 158, 217, 114, 93, 110, 59, 159, 200, 92, 191, 127, 102, 75,
+			41, 201, 108, 41, 61, 66, 102, 203, 79, 142, 240, 253, 107, 51,
+			19, 42, 230, 39, 70, 232, 34, 149, 171, 110, 111, 90, 75, 232,
+			180, 60, 102, 23, 105, 211, 185, 203, 236, 105, 151, 121, 173, 104,
+			139, 134, 29, 116, 166, 163, 111, 49, 169, 14, 46, 222, 71, 78,
+			95, 73, 249, 219, 72, 143, 195, 173, 2, 1, 160, 253, 242, 104,
+			226, 124, 18, 104, 181, 225, 123, 16, 10, 128, 4, 196, 59, 140,
+			22, 108, 107, 183, 64, 96, 161, 43, 96, 216, 166, 32, 155, 193,
+			244, 23, 174, 248, 146, 176, 131, 227, 37, 43, 156, 237, 52, 133,
+			75, 92, 154, 223, 4, 163, 55, 73, 109, 225, 239, 3, 211, 49,
+			97, 21, 160, 224, 196, 158, 5, 30, 247, 241, 3, 26, 118, 235,
+			17, 184, 4, 129, 35, 160, 68, 169, 149, 116, 91, 162, 85, 153,
+			37, 98, 117, 58, 129, 127, 215, 129, 229, 192, 221, 165, 231, 167,
+			231, 102, 139, 179, 179, 179, 152, 26, 243, 80, 217, 23, 49, 26,
+			216, 71, 15, 186, 176, 157, 163, 157, 144, 117, 109, 31, 157, 38,
+			50, 60, 24, 87, 0, 227, 51, 136, 232, 243, 180, 84, 42, 93,
+			238, 127, 198, 60, 187, 231, 73, 220, 145, 180, 176, 228, 83, 254,
+			162, 44, 45, 201, 97, 125, 30, 18, 39, 99, 104, 154, 247, 37,
+			225, 203, 125, 47, 161, 0, 136, 87, 248, 111, 249, 2, 66, 178,
+			19, 167, 73, 39, 247, 116, 244, 28, 157, 165, 79, 62, 217, 223,
+			214, 187, 232, 236, 20, 125, 67, 6, 95, 247, 188, 116, 254, 121,
+			58, 119, 121, 207, 83, 209, 245, 243, 113, 16, 126, 118, 86, 84,
+			122, 147, 50, 55, 100, 251, 35, 240, 174, 125, 17, 120, 238, 254,
+			8, 76, 223, 7, 129, 243, 251, 33, 240, 80, 137, 46, 9, 120,
+			62, 17, 208, 71, 151, 130, 123, 142, 245, 189, 101, 132, 191, 152,
+			30, 242, 231, 123, 135, 156, 158, 79, 200, 20, 69, 162, 189, 100,
+			208, 229, 43, 130, 13, 201, 11, 123, 164, 32, 121, 167, 151, 207,
+			61, 50, 151, 102, 113, 242, 194, 249, 251, 15, 111, 82, 241, 93,
+			233, 138, 247, 232, 227, 252, 254, 125, 76, 63, 96, 4, 83, 137,
+			60, 49, 175, 113, 0, 227, 80, 26, 252, 207, 102, 110, 100, 237,
+			19, 222, 135, 137, 185, 183, 226, 164, 109, 237, 134, 207, 95, 40,
+			202, 52, 186, 231, 231, 100, 218, 133, 100, 35, 125, 62, 30, 217,
+			201, 190, 71, 165, 171, 129, 143, 9, 64, 216, 231, 100, 100, 63,
+			116, 54, 64, 140, 255, 253, 146, 1, 2, 75, 152, 174, 22, 232,
+			75, 34, 194, 131, 197, 148, 219, 69, 84, 196, 228, 65, 97, 61,
+			139, 176, 117, 33, 44, 208, 73, 225, 211, 128, 182, 4, 235, 167,
+			192, 158, 198, 48, 117, 39, 96, 13, 204, 239, 171, 239, 210, 168,
+			199, 133, 32, 170, 138, 189, 79, 178, 202, 164, 131, 254, 86, 72,
+			226, 117, 201, 114, 101, 235, 165, 222, 224, 240, 5, 89, 206, 91,
+			234, 201, 85, 74, 7, 167, 37, 237, 78, 15, 163, 32, 161, 179,
+			112, 33, 44, 20, 69, 62, 65, 210, 26, 172, 29, 61, 225, 124,
+			222, 22, 161, 117, 150, 66, 113, 191, 214, 74, 82, 186, 230, 160,
+			93, 104, 167, 175, 85, 66, 219, 78, 35, 232, 109, 247, 97, 155,
+			157, 11, 11, 232, 61, 150, 254, 227, 102, 126, 132, 252, 123, 25,
+			24, 212, 94, 87, 199, 205, 223, 82, 232, 6, 90, 5, 113, 167,
+			194, 167, 154, 54, 11, 250, 98, 88, 211, 23, 230, 46, 22, 47,
+			62, 125, 9, 22, 56, 248, 15, 131, 193, 231, 251, 10, 83, 113,
+			45, 186, 234, 71, 108, 1, 90, 13, 25, 173, 131, 199, 30, 55,
+			156, 232, 185, 234, 70, 98, 111, 178, 64, 68, 170, 232, 76, 219,
+			241, 232, 57, 0, 218, 142, 55, 179, 21, 208, 115, 116, 254, 41,
+			186, 21, 204, 216, 214, 46, 61, 71, 47, 92, 186, 88, 154, 191,
+			72, 97, 142, 204, 192, 226, 42, 211, 140, 248, 74, 43, 93, 37,
+			153, 172, 161, 189, 174, 14, 72, 72, 49, 180, 215, 243, 195, 18,
+			210, 12, 237, 117, 99, 140, 252, 160, 38, 61, 219, 129, 106, 152,
+			255, 85, 149, 140, 120, 180, 104, 162, 244, 65, 3, 191, 72, 194,
+			48, 57, 155, 66, 234, 178, 16, 29, 120, 30, 70, 163, 101, 107,
+			65, 143, 173, 197, 165, 209, 162, 179, 132, 222, 22, 227, 112, 91,
+			184, 176, 68, 42, 133, 31, 58, 232, 19, 242, 3, 26, 135, 32,
+			111, 163, 188, 137, 138, 92, 208, 165, 26, 8, 17, 149, 84, 135,
+			126, 64, 219, 126, 0, 14, 74, 12, 99, 66, 110, 139, 112, 136,
+			75, 103, 116, 79, 107, 124, 127, 95, 103, 36, 38, 15, 142, 96,
+			128, 253, 8, 226, 133, 213, 123, 241, 236, 23, 145, 158, 16, 39,
+			136, 71, 170, 160, 39, 228, 41, 28, 254, 89, 24, 5, 57, 94,
+			138, 98, 104, 65, 236, 120, 87, 52, 67, 11, 82, 241, 206, 159,
+			173, 146, 153, 7, 197, 184, 92, 191, 213, 169, 67, 129, 240, 117,
+			101, 177, 224, 129, 65, 77, 243, 1, 62, 179, 194, 127, 81, 201,
+			88, 28, 209, 89, 102, 33, 102, 131, 248, 1, 70, 14, 3, 214,
+			116, 238, 138, 112, 160, 128, 12, 131, 232, 176, 141, 199, 72, 231,
+			96, 21, 127, 27, 243, 100, 72, 4, 8, 65, 211, 98, 28, 243,
+			208, 252, 40, 196, 41, 59, 245, 210, 6, 62, 169, 237, 118, 88,
+			85, 132, 17, 225, 183, 113, 154, 28, 0, 7, 0, 243, 34, 254,
+			18, 132, 7, 7, 171, 67, 162, 12, 171, 60, 67, 6, 99, 106,
+			38, 178, 15, 140, 172, 38, 149, 141, 103, 136, 30, 89, 173, 112,
+			34, 71, 181, 201, 161, 249, 39, 4, 38, 251, 144, 89, 170, 89,
+			173, 16, 131, 141, 85, 124, 3, 162, 146, 220, 31, 115, 11, 98,
+			110, 183, 216, 221, 104, 98, 0, 49, 59, 200, 139, 33, 111, 181,
+			124, 55, 50, 159, 38, 131, 241, 171, 198, 8, 209, 238, 176, 93,
+			193, 40, 248, 9, 46, 69, 148, 69, 193, 38, 14, 44, 168, 207,
+			40, 133, 215, 137, 94, 99, 119, 35, 227, 73, 146, 133, 124, 110,
+			112, 70, 2, 142, 35, 2, 71, 120, 86, 90, 113, 60, 86, 229,
+			143, 205, 5, 162, 3, 152, 180, 8, 189, 28, 16, 45, 26, 199,
+			201, 160, 205, 92, 167, 237, 68, 44, 16, 125, 37, 5, 133, 2,
+			201, 93, 65, 172, 97, 212, 192, 41, 136, 85, 14, 84, 241, 247,
+			11, 122, 94, 25, 81, 11, 63, 161, 144, 252, 178, 21, 89, 173,
+			192, 106, 199, 213, 148, 164, 154, 49, 71, 6, 58, 86, 16, 57,
+			150, 43, 162, 219, 143, 9, 84, 229, 91, 165, 117, 254, 184, 42,
+			235, 153, 215, 200, 128, 40, 3, 180, 97, 147, 196, 165, 232, 96,
+			149, 3, 208, 79, 232, 188, 159, 97, 131, 122, 21, 127, 67, 153,
+			107, 133, 17, 74, 79, 190, 138, 191, 11, 255, 88, 37, 249, 21,
+			17, 13, 54, 22, 200, 16, 140, 240, 45, 158, 38, 133, 13, 14,
+			205, 31, 221, 35, 16, 82, 79, 85, 9, 212, 94, 195, 202, 32,
+			109, 92, 126, 69, 168, 157, 119, 60, 196, 203, 120, 160, 253, 52,
+			57, 32, 132, 56, 137, 198, 235, 85, 33, 216, 188, 138, 73, 242,
+			33, 132, 52, 189, 6, 15, 103, 235, 213, 24, 54, 78, 19, 61,
+			2, 105, 33, 136, 214, 80, 106, 56, 175, 103, 170, 248, 200, 56,
+			75, 114, 92, 136, 38, 134, 176, 210, 65, 81, 137, 143, 209, 245,
+			76, 85, 60, 54, 166, 121, 68, 29, 152, 59, 113, 0, 171, 14,
+			247, 241, 252, 122, 166, 26, 87, 185, 50, 72, 6, 196, 180, 41,
+			252, 148, 134, 12, 227, 232, 150, 136, 110, 179, 176, 33, 56, 101,
+			222, 123, 22, 84, 177, 158, 49, 67, 6, 68, 144, 103, 66, 197,
+			137, 115, 56, 121, 5, 91, 44, 225, 64, 84, 101, 45, 227, 28,
+			25, 133, 97, 186, 213, 195, 90, 206, 183, 97, 120, 176, 158, 98,
+			175, 172, 219, 195, 99, 61, 169, 187, 145, 226, 243, 61, 82, 3,
+			244, 190, 212, 0, 243, 171, 10, 201, 34, 74, 160, 173, 82, 98,
+			161, 87, 5, 212, 51, 98, 234, 158, 17, 235, 149, 9, 237, 193,
+			50, 161, 239, 149, 137, 62, 169, 204, 62, 130, 84, 158, 155, 37,
+			36, 209, 142, 70, 158, 232, 181, 242, 123, 107, 35, 25, 131, 144,
+			220, 149, 202, 234, 98, 245, 229, 17, 197, 56, 64, 242, 224, 194,
+			186, 86, 93, 188, 49, 162, 94, 57, 251, 202, 153, 135, 90, 40,
+			94, 248, 122, 25, 142, 196, 234, 153, 159, 82, 239, 155, 238, 112,
+			241, 111, 67, 186, 195, 33, 225, 35, 202, 103, 158, 147, 126, 39,
+			241, 83, 166, 59, 192, 79, 197, 208, 134, 50, 147, 132, 18, 53,
+			155, 49, 244, 67, 25, 67, 49, 199, 233, 98, 58, 174, 6, 235,
+			76, 137, 66, 234, 65, 22, 76, 199, 67, 217, 97, 50, 68, 244,
+			44, 90, 142, 195, 234, 16, 44, 206, 0, 40, 134, 54, 172, 230,
+			36, 164, 26, 218, 240, 32, 17, 21, 21, 67, 27, 81, 15, 138,
+			138, 176, 166, 143, 168, 121, 9, 169, 134, 54, 50, 116, 64, 84,
+			84, 13, 109, 84, 29, 22, 21, 193, 32, 27, 85, 137, 132, 224,
+			217, 193, 67, 228, 125, 220, 195, 53, 145, 89, 85, 76, 118, 14,
+			211, 42, 36, 162, 118, 60, 51, 49, 184, 11, 233, 178, 224, 108,
+			226, 102, 74, 179, 11, 161, 125, 134, 94, 127, 199, 227, 22, 50,
+			236, 131, 129, 171, 68, 188, 90, 135, 163, 74, 64, 122, 203, 241,
+			82, 1, 121, 105, 52, 79, 228, 143, 145, 255, 20, 27, 205, 167,
+			213, 113, 243, 15, 20, 146, 202, 69, 56, 11, 174, 127, 152, 186,
+			116, 82, 38, 218, 78, 137, 204, 143, 144, 250, 129, 211, 114, 60,
+			158, 189, 135, 150, 81, 108, 76, 93, 233, 70, 46, 131, 115, 76,
+			144, 127, 217, 0, 103, 60, 96, 186, 5, 134, 177, 69, 185, 46,
+			128, 86, 22, 193, 76, 115, 108, 217, 69, 156, 196, 96, 81, 62,
+			25, 86, 193, 48, 147, 116, 128, 108, 44, 36, 97, 168, 251, 9,
+			127, 195, 111, 183, 125, 143, 135, 220, 30, 79, 154, 74, 27, 208,
+			167, 213, 124, 202, 128, 62, 61, 152, 54, 160, 79, 27, 99, 228,
+			47, 101, 86, 158, 118, 94, 53, 204, 63, 19, 76, 73, 68, 232,
+			108, 72, 193, 224, 233, 99, 139, 28, 29, 25, 188, 232, 122, 206,
+			251, 186, 204, 221, 165, 14, 56, 6, 157, 230, 46, 181, 82, 109,
+			160, 101, 44, 247, 145, 13, 191, 195, 226, 128, 72, 103, 15, 139,
+			176, 179, 191, 41, 6, 41, 89, 67, 59, 31, 51, 8, 164, 251,
+			252, 96, 218, 98, 61, 63, 50, 74, 158, 145, 233, 43, 37, 245,
+			132, 121, 126, 47, 119, 196, 58, 196, 115, 166, 83, 92, 162, 162,
+			29, 53, 7, 175, 202, 128, 47, 76, 140, 210, 193, 9, 9, 105,
+			134, 86, 58, 118, 156, 124, 78, 149, 145, 242, 103, 84, 211, 252,
+			187, 106, 159, 100, 222, 171, 11, 57, 16, 194, 206, 135, 132, 234,
+			235, 181, 218, 58, 93, 226, 245, 167, 107, 128, 18, 242, 178, 68,
+			175, 176, 22, 196, 208, 97, 36, 232, 13, 56, 114, 132, 89, 5,
+			136, 245, 101, 8, 69, 137, 28, 70, 27, 4, 183, 177, 5, 137,
+			222, 17, 21, 201, 82, 219, 76, 122, 109, 219, 22, 60, 223, 182,
+			28, 87, 38, 126, 175, 248, 173, 101, 191, 37, 163, 220, 224, 66,
+			240, 8, 125, 95, 151, 5, 187, 201, 52, 132, 152, 164, 197, 103,
+			117, 37, 226, 83, 196, 114, 67, 31, 49, 238, 116, 92, 71, 196,
+			205, 69, 252, 95, 6, 7, 33, 227, 128, 235, 2, 57, 90, 90,
+			22, 248, 35, 71, 11, 162, 252, 207, 12, 166, 163, 252, 207, 76,
+			28, 37, 191, 168, 200, 48, 255, 187, 213, 115, 230, 207, 236, 39,
+			206, 117, 43, 100, 73, 18, 238, 126, 252, 244, 124, 153, 23, 128,
+			30, 48, 172, 44, 247, 80, 73, 83, 60, 75, 78, 216, 106, 14,
+			139, 221, 24, 32, 230, 78, 64, 82, 93, 88, 97, 207, 246, 159,
+			175, 155, 226, 76, 98, 146, 232, 16, 211, 169, 231, 12, 237, 221,
+			234, 49, 9, 41, 134, 246, 238, 227, 103, 36, 164, 25, 218, 187,
+			39, 167, 200, 167, 56, 157, 89, 67, 187, 166, 158, 50, 127, 8,
+			232, 180, 48, 15, 10, 124, 58, 65, 221, 137, 32, 53, 155, 222,
+			97, 187, 51, 56, 254, 52, 178, 90, 212, 10, 67, 191, 225, 88,
+			241, 86, 21, 187, 78, 209, 67, 72, 255, 112, 130, 27, 29, 7,
+			19, 66, 181, 41, 210, 57, 19, 109, 10, 58, 216, 106, 9, 79,
+			124, 140, 127, 54, 7, 88, 201, 145, 201, 42, 134, 118, 237, 136,
+			41, 33, 205, 208, 174, 157, 56, 73, 62, 195, 241, 207, 25, 218,
+			13, 245, 132, 249, 49, 133, 208, 10, 68, 39, 132, 27, 43, 86,
+			27, 174, 11, 82, 242, 186, 239, 192, 158, 62, 242, 91, 12, 61,
+			87, 118, 23, 221, 83, 113, 250, 75, 228, 211, 128, 241, 36, 83,
+			120, 157, 72, 133, 45, 243, 193, 192, 57, 213, 47, 187, 86, 68,
+			159, 227, 218, 231, 93, 51, 231, 103, 158, 3, 181, 243, 174, 18,
+			236, 68, 36, 21, 185, 44, 224, 38, 165, 45, 167, 24, 218, 141,
+			65, 57, 111, 115, 154, 161, 221, 56, 118, 156, 20, 8, 12, 143,
+			254, 98, 102, 75, 49, 143, 208, 26, 187, 27, 201, 30, 197, 148,
+			229, 107, 175, 14, 154, 229, 197, 252, 1, 242, 187, 48, 207, 21,
+			200, 52, 123, 85, 109, 106, 230, 175, 170, 4, 231, 170, 211, 234,
+			250, 93, 72, 122, 187, 27, 225, 137, 215, 56, 160, 237, 4, 52,
+			222, 218, 132, 242, 76, 171, 21, 4, 214, 46, 136, 35, 175, 202,
+			99, 222, 97, 95, 252, 187, 99, 69, 17, 11, 188, 5, 112, 44,
+			78, 211, 89, 136, 192, 204, 201, 44, 35, 88, 58, 249, 187, 178,
+			64, 28, 0, 113, 173, 48, 146, 2, 189, 123, 54, 228, 4, 241,
+			195, 46, 32, 48, 208, 22, 165, 86, 130, 18, 173, 119, 49, 54,
+			15, 186, 156, 185, 205, 100, 173, 134, 214, 209, 167, 57, 141, 1,
+			162, 196, 7, 40, 58, 100, 162, 255, 164, 237, 164, 209, 34, 158,
+			204, 129, 38, 169, 191, 227, 165, 155, 18, 84, 136, 45, 23, 62,
+			17, 190, 34, 5, 83, 238, 94, 37, 135, 200, 85, 146, 211, 21,
+			158, 114, 247, 154, 62, 110, 62, 205, 167, 191, 227, 65, 142, 8,
+			242, 87, 12, 76, 145, 227, 13, 62, 12, 27, 248, 5, 221, 197,
+			40, 148, 40, 166, 50, 97, 59, 89, 104, 104, 48, 129, 21, 67,
+			123, 141, 12, 39, 176, 102, 104, 175, 25, 99, 228, 231, 20, 209,
+			177, 98, 104, 76, 63, 106, 126, 65, 106, 30, 222, 117, 220, 180,
+			112, 173, 194, 88, 86, 132, 105, 201, 39, 45, 107, 119, 162, 93,
+			241, 52, 78, 116, 240, 112, 17, 4, 148, 29, 175, 203, 98, 163,
+			209, 3, 66, 184, 145, 15, 155, 79, 130, 53, 101, 222, 84, 220,
+			167, 180, 250, 37, 251, 109, 159, 161, 106, 163, 150, 189, 13, 198,
+			138, 72, 18, 83, 68, 2, 31, 19, 73, 115, 138, 72, 224, 99,
+			34, 155, 80, 17, 9, 124, 236, 177, 9, 176, 244, 116, 5, 120,
+			219, 82, 249, 132, 86, 212, 140, 14, 144, 24, 6, 53, 147, 51,
+			180, 214, 208, 176, 132, 20, 67, 107, 141, 28, 150, 144, 102, 104,
+			173, 137, 163, 228, 9, 162, 234, 170, 161, 223, 201, 188, 79, 49,
+			39, 40, 223, 9, 238, 63, 109, 96, 177, 188, 147, 63, 68, 174,
+			17, 77, 87, 7, 13, 173, 173, 30, 52, 47, 131, 139, 172, 205,
+			2, 151, 187, 151, 165, 180, 150, 54, 36, 181, 168, 140, 48, 177,
+			206, 238, 118, 92, 113, 218, 8, 18, 44, 75, 104, 210, 234, 234,
+			96, 198, 208, 218, 67, 124, 65, 86, 7, 51, 74, 15, 164, 114,
+			104, 146, 232, 186, 10, 132, 118, 212, 81, 243, 24, 142, 164, 88,
+			150, 226, 101, 4, 151, 38, 190, 200, 171, 152, 142, 217, 17, 174,
+			47, 21, 45, 173, 142, 200, 241, 82, 81, 68, 58, 195, 35, 164,
+			72, 64, 123, 103, 163, 204, 15, 43, 138, 121, 138, 202, 125, 109,
+			31, 233, 41, 147, 93, 135, 37, 46, 202, 143, 144, 2, 209, 117,
+			13, 176, 217, 86, 71, 205, 195, 124, 141, 146, 91, 97, 97, 46,
+			99, 95, 26, 226, 177, 45, 240, 208, 16, 143, 109, 129, 135, 134,
+			120, 108, 15, 143, 144, 31, 0, 213, 171, 105, 25, 35, 251, 1,
+			245, 35, 138, 102, 6, 61, 162, 136, 2, 18, 207, 49, 217, 139,
+			144, 72, 238, 86, 196, 21, 92, 90, 11, 1, 19, 105, 153, 187,
+			32, 127, 68, 164, 208, 245, 39, 201, 162, 86, 144, 141, 9, 21,
+			171, 225, 164, 253, 0, 25, 37, 117, 146, 211, 53, 152, 76, 134,
+			254, 3, 138, 126, 216, 172, 242, 185, 131, 123, 212, 34, 180, 24,
+			160, 138, 66, 61, 1, 78, 207, 98, 188, 45, 147, 77, 66, 208,
+			160, 5, 215, 214, 200, 41, 2, 29, 146, 24, 253, 18, 33, 195,
+			100, 128, 247, 145, 197, 78, 82, 5, 10, 20, 12, 141, 36, 5,
+			26, 20, 140, 141, 147, 25, 129, 150, 98, 232, 31, 86, 244, 113,
+			243, 20, 98, 5, 158, 27, 105, 12, 244, 144, 69, 227, 22, 224,
+			236, 199, 135, 211, 125, 192, 233, 143, 15, 43, 67, 195, 73, 129,
+			6, 5, 198, 24, 217, 20, 125, 168, 112, 226, 71, 55, 204, 114,
+			146, 133, 42, 71, 36, 214, 204, 253, 131, 34, 105, 133, 212, 123,
+			43, 205, 223, 4, 19, 53, 139, 237, 230, 147, 2, 5, 10, 6,
+			15, 38, 5, 26, 20, 140, 140, 146, 3, 32, 21, 170, 98, 232,
+			31, 85, 212, 35, 120, 76, 69, 83, 149, 28, 130, 131, 18, 196,
+			167, 100, 84, 130, 26, 128, 227, 135, 201, 47, 65, 234, 189, 110,
+			228, 62, 169, 100, 190, 166, 40, 230, 63, 81, 206, 17, 186, 232,
+			65, 170, 128, 179, 237, 216, 93, 43, 201, 166, 221, 141, 109, 172,
+			56, 171, 19, 40, 8, 187, 112, 151, 3, 223, 224, 69, 129, 229,
+			133, 152, 178, 4, 38, 38, 188, 40, 77, 199, 196, 16, 70, 57,
+			12, 73, 42, 144, 18, 223, 36, 145, 104, 73, 172, 124, 55, 218,
+			107, 184, 245, 89, 212, 168, 23, 52, 29, 22, 242, 79, 42, 249,
+			17, 242, 83, 48, 63, 116, 16, 198, 207, 40, 234, 121, 243, 179,
+			66, 147, 139, 105, 42, 108, 65, 176, 224, 132, 116, 11, 98, 64,
+			109, 73, 226, 196, 115, 208, 236, 241, 201, 211, 126, 20, 192, 130,
+			162, 133, 216, 72, 44, 64, 37, 140, 72, 108, 11, 35, 38, 126,
+			148, 244, 19, 231, 120, 9, 11, 95, 156, 54, 210, 65, 239, 234,
+			159, 81, 84, 83, 130, 10, 32, 127, 236, 73, 9, 106, 0, 78,
+			157, 35, 127, 196, 73, 83, 12, 253, 243, 138, 106, 154, 191, 45,
+			72, 19, 73, 246, 34, 225, 60, 181, 89, 91, 223, 111, 75, 44,
+			247, 126, 241, 38, 141, 111, 254, 226, 195, 132, 82, 37, 83, 11,
+			34, 94, 92, 126, 97, 161, 10, 152, 220, 195, 11, 103, 26, 140,
+			159, 21, 72, 227, 35, 102, 140, 216, 54, 139, 45, 135, 220, 97,
+			218, 12, 2, 23, 144, 6, 221, 245, 172, 118, 93, 152, 76, 184,
+			73, 240, 3, 200, 143, 195, 156, 127, 78, 47, 204, 191, 207, 43,
+			106, 94, 144, 15, 179, 239, 243, 202, 224, 97, 9, 106, 0, 78,
+			28, 37, 255, 150, 115, 67, 53, 244, 47, 1, 55, 126, 253, 126,
+			220, 0, 251, 64, 156, 10, 217, 135, 27, 253, 172, 16, 148, 195,
+			164, 20, 180, 246, 146, 106, 181, 99, 222, 194, 162, 205, 27, 38,
+			20, 182, 166, 15, 77, 119, 76, 118, 207, 238, 90, 90, 242, 156,
+			84, 152, 254, 95, 74, 24, 1, 179, 251, 75, 9, 35, 84, 13,
+			192, 137, 163, 228, 55, 193, 44, 213, 1, 252, 121, 69, 61, 98,
+			254, 146, 220, 127, 246, 25, 16, 82, 233, 57, 65, 24, 219, 81,
+			72, 223, 110, 124, 144, 49, 94, 142, 65, 78, 216, 221, 104, 161,
+			199, 143, 3, 134, 137, 96, 107, 79, 91, 98, 45, 177, 209, 114,
+			129, 51, 160, 188, 154, 211, 192, 220, 251, 150, 227, 9, 203, 51,
+			66, 237, 95, 34, 194, 104, 232, 109, 188, 190, 27, 197, 19, 179,
+			167, 117, 124, 32, 248, 19, 247, 132, 58, 133, 196, 139, 112, 111,
+			83, 61, 40, 38, 90, 181, 22, 55, 41, 203, 48, 43, 28, 107,
+			115, 12, 57, 122, 130, 189, 90, 22, 249, 41, 153, 175, 41, 0,
+			14, 142, 74, 16, 185, 61, 126, 152, 68, 192, 251, 124, 198, 200,
+			253, 162, 162, 254, 115, 69, 51, 109, 34, 46, 10, 226, 252, 21,
+			88, 8, 161, 148, 72, 192, 50, 12, 222, 47, 192, 184, 227, 119,
+			186, 110, 108, 229, 96, 208, 143, 192, 93, 109, 141, 45, 169, 116,
+			206, 134, 244, 182, 112, 234, 130, 117, 113, 91, 162, 152, 135, 131,
+			166, 191, 168, 228, 135, 201, 12, 32, 161, 234, 134, 254, 85, 69,
+			31, 51, 79, 227, 216, 9, 177, 92, 192, 241, 8, 197, 33, 0,
+			220, 164, 148, 168, 32, 66, 207, 225, 27, 146, 68, 80, 161, 95,
+			85, 6, 15, 74, 80, 3, 112, 196, 32, 69, 108, 61, 107, 232,
+			191, 172, 232, 143, 153, 39, 123, 109, 190, 5, 228, 38, 13, 25,
+			174, 223, 113, 211, 217, 28, 86, 151, 204, 204, 42, 0, 14, 73,
+			238, 101, 53, 0, 199, 143, 144, 243, 216, 116, 206, 208, 255, 153,
+			162, 31, 51, 79, 244, 91, 85, 11, 113, 65, 24, 183, 156, 227,
+			181, 15, 72, 80, 1, 240, 160, 156, 20, 57, 13, 192, 9, 147,
+			252, 71, 149, 168, 122, 214, 200, 253, 142, 2, 30, 101, 243, 223,
+			168, 220, 97, 89, 137, 15, 229, 120, 66, 78, 224, 164, 6, 64,
+			86, 52, 13, 151, 4, 9, 45, 143, 71, 53, 228, 150, 45, 81,
+			252, 96, 36, 97, 13, 254, 46, 56, 10, 197, 209, 224, 36, 101,
+			130, 31, 63, 130, 44, 231, 190, 141, 46, 52, 183, 232, 9, 144,
+			217, 233, 102, 1, 33, 188, 10, 128, 133, 34, 55, 58, 18, 170,
+			34, 86, 199, 205, 192, 106, 179, 176, 148, 152, 86, 32, 37, 29,
+			225, 53, 61, 139, 58, 197, 105, 240, 181, 58, 78, 85, 2, 181,
+			199, 17, 47, 10, 95, 157, 216, 102, 56, 109, 6, 147, 21, 52,
+			148, 19, 241, 164, 141, 54, 108, 116, 132, 149, 44, 23, 192, 244,
+			57, 149, 94, 132, 235, 174, 95, 23, 43, 47, 140, 237, 239, 192,
+			202, 251, 219, 160, 144, 179, 176, 242, 126, 93, 81, 79, 153, 191,
+			44, 20, 242, 62, 225, 155, 100, 73, 76, 53, 217, 175, 152, 229,
+			68, 134, 115, 51, 44, 236, 93, 100, 246, 107, 83, 222, 92, 101,
+			9, 255, 7, 56, 236, 9, 133, 211, 29, 137, 177, 39, 180, 11,
+			244, 42, 189, 90, 48, 108, 182, 191, 227, 193, 193, 29, 185, 149,
+			196, 142, 197, 52, 203, 226, 234, 252, 117, 69, 61, 44, 65, 5,
+			8, 60, 98, 74, 80, 3, 240, 196, 73, 242, 15, 144, 124, 45,
+			99, 228, 254, 88, 81, 63, 162, 106, 230, 167, 21, 66, 113, 47,
+			46, 134, 215, 241, 224, 164, 20, 182, 157, 182, 166, 100, 17, 26,
+			34, 237, 142, 15, 43, 166, 223, 236, 145, 7, 177, 10, 137, 189,
+			117, 195, 15, 248, 249, 68, 91, 220, 102, 101, 145, 212, 118, 146,
+			134, 158, 213, 9, 183, 124, 36, 84, 168, 159, 132, 203, 146, 40,
+			48, 222, 245, 63, 86, 200, 48, 236, 39, 114, 0, 195, 184, 125,
+			83, 209, 143, 152, 239, 227, 72, 165, 21, 178, 16, 4, 38, 14,
+			52, 164, 6, 77, 116, 80, 101, 13, 63, 176, 43, 107, 98, 61,
+			17, 251, 6, 18, 239, 239, 246, 226, 140, 235, 141, 92, 108, 192,
+			154, 205, 10, 235, 254, 155, 210, 242, 198, 2, 5, 10, 134, 70,
+			147, 2, 13, 10, 192, 104, 85, 5, 218, 138, 161, 127, 91, 209,
+			39, 204, 159, 121, 228, 101, 239, 109, 91, 229, 248, 234, 81, 7,
+			23, 237, 223, 158, 85, 78, 114, 20, 172, 173, 111, 167, 121, 14,
+			246, 214, 183, 149, 161, 177, 164, 64, 131, 130, 35, 143, 145, 159,
+			150, 162, 162, 26, 250, 95, 41, 250, 113, 243, 239, 137, 41, 158,
+			104, 68, 145, 0, 140, 247, 216, 128, 228, 75, 167, 127, 120, 15,
+			43, 20, 237, 164, 250, 110, 236, 178, 4, 133, 148, 196, 32, 98,
+			131, 57, 150, 35, 97, 44, 89, 98, 38, 19, 33, 135, 137, 129,
+			150, 138, 219, 72, 252, 193, 140, 250, 171, 52, 133, 96, 72, 253,
+			149, 50, 244, 88, 82, 160, 65, 129, 121, 140, 124, 66, 82, 168,
+			25, 250, 95, 3, 133, 223, 47, 40, 76, 239, 27, 228, 238, 53,
+			222, 21, 189, 221, 180, 161, 89, 28, 207, 87, 137, 36, 24, 36,
+			127, 157, 38, 3, 76, 146, 191, 78, 147, 161, 33, 214, 230, 49,
+			242, 109, 73, 134, 110, 232, 111, 169, 250, 180, 249, 135, 15, 67,
+			6, 94, 218, 145, 242, 116, 11, 151, 165, 19, 238, 217, 9, 37,
+			225, 190, 179, 97, 207, 38, 72, 152, 54, 41, 66, 81, 13, 196,
+			180, 198, 85, 211, 189, 247, 216, 204, 247, 226, 23, 217, 135, 97,
+			144, 163, 8, 119, 150, 36, 44, 1, 139, 230, 45, 85, 63, 158,
+			20, 40, 80, 112, 98, 50, 41, 128, 13, 179, 122, 190, 72, 254,
+			16, 172, 230, 44, 136, 194, 167, 85, 245, 132, 249, 91, 42, 4,
+			177, 18, 149, 107, 133, 13, 134, 202, 106, 26, 13, 117, 102, 11,
+			85, 46, 44, 185, 48, 73, 29, 3, 133, 38, 117, 46, 106, 107,
+			88, 118, 246, 89, 51, 129, 155, 55, 165, 173, 15, 49, 105, 62,
+			6, 189, 205, 130, 223, 128, 209, 2, 31, 162, 66, 145, 22, 210,
+			1, 255, 66, 145, 208, 66, 58, 188, 47, 178, 21, 11, 169, 120,
+			190, 24, 131, 48, 246, 190, 199, 132, 200, 213, 166, 9, 194, 202,
+			188, 198, 238, 222, 222, 165, 7, 201, 102, 77, 112, 217, 95, 166,
+			14, 223, 196, 117, 228, 192, 199, 182, 13, 196, 254, 252, 6, 134,
+			75, 124, 218, 216, 242, 253, 16, 34, 79, 113, 211, 241, 218, 169,
+			232, 200, 223, 24, 204, 1, 56, 52, 34, 65, 228, 254, 232, 132,
+			4, 53, 0, 143, 29, 7, 143, 4, 140, 141, 106, 232, 159, 85,
+			213, 83, 220, 35, 81, 139, 253, 40, 200, 17, 161, 111, 132, 202,
+			236, 229, 178, 148, 217, 228, 246, 166, 74, 19, 213, 30, 114, 55,
+			192, 173, 158, 56, 0, 230, 249, 61, 33, 105, 171, 14, 103, 177,
+			164, 203, 70, 6, 56, 165, 43, 139, 175, 106, 1, 139, 175, 166,
+			138, 183, 135, 2, 141, 56, 30, 202, 233, 1, 197, 243, 89, 85,
+			236, 223, 178, 232, 188, 249, 172, 58, 40, 13, 7, 216, 176, 125,
+			86, 61, 113, 82, 82, 171, 25, 250, 23, 246, 82, 43, 214, 217,
+			191, 17, 106, 211, 125, 61, 4, 181, 49, 10, 156, 30, 208, 79,
+			95, 72, 168, 5, 237, 244, 133, 132, 90, 208, 77, 95, 0, 106,
+			127, 149, 83, 171, 27, 250, 151, 96, 222, 253, 156, 164, 54, 89,
+			174, 165, 66, 218, 175, 171, 183, 133, 90, 222, 21, 233, 235, 235,
+			209, 41, 214, 97, 127, 158, 80, 12, 251, 167, 47, 169, 131, 82,
+			154, 117, 216, 159, 171, 199, 142, 199, 73, 147, 127, 255, 14, 185,
+			254, 61, 93, 12, 2, 21, 195, 190, 59, 98, 222, 190, 107, 103,
+			204, 153, 135, 74, 212, 73, 50, 58, 191, 247, 84, 206, 143, 105,
+			132, 92, 99, 81, 21, 180, 70, 24, 193, 129, 231, 78, 224, 195,
+			205, 89, 34, 51, 81, 130, 144, 106, 215, 177, 162, 45, 145, 48,
+			136, 191, 33, 81, 15, 189, 213, 34, 255, 142, 3, 73, 250, 30,
+			100, 62, 105, 50, 125, 239, 4, 33, 96, 97, 165, 82, 179, 178,
+			213, 65, 40, 193, 180, 44, 184, 76, 6, 110, 118, 225, 79, 115,
+			248, 52, 239, 250, 45, 254, 240, 12, 57, 228, 249, 222, 173, 100,
+			79, 134, 249, 149, 249, 234, 65, 207, 247, 146, 240, 159, 81, 33,
+			195, 45, 184, 212, 18, 19, 152, 111, 117, 3, 55, 156, 200, 99,
+			106, 213, 105, 121, 253, 77, 66, 105, 9, 210, 156, 55, 171, 43,
+			2, 172, 30, 108, 177, 8, 138, 152, 189, 25, 184, 161, 217, 37,
+			135, 122, 43, 24, 23, 73, 222, 117, 154, 12, 248, 251, 224, 52,
+			194, 184, 42, 36, 153, 241, 73, 138, 140, 203, 87, 5, 148, 48,
+			73, 176, 14, 129, 194, 139, 100, 168, 102, 57, 238, 219, 56, 26,
+			133, 255, 161, 146, 33, 36, 27, 182, 43, 33, 187, 79, 155, 227,
+			36, 27, 48, 203, 109, 35, 251, 7, 171, 28, 48, 138, 178, 85,
+			232, 106, 104, 254, 136, 100, 101, 188, 255, 195, 75, 143, 68, 111,
+			113, 250, 160, 246, 144, 233, 131, 143, 19, 29, 102, 212, 132, 78,
+			181, 84, 198, 162, 52, 47, 170, 248, 208, 248, 62, 50, 148, 30,
+			83, 158, 46, 119, 178, 103, 76, 57, 113, 165, 100, 4, 171, 36,
+			76, 70, 115, 155, 144, 228, 137, 177, 64, 8, 222, 242, 128, 67,
+			21, 39, 58, 222, 59, 71, 56, 85, 187, 111, 56, 7, 247, 31,
+			206, 65, 57, 156, 95, 213, 201, 129, 23, 187, 44, 216, 125, 27,
+			7, 20, 186, 66, 129, 19, 151, 35, 113, 0, 166, 39, 4, 53,
+			113, 98, 13, 86, 241, 183, 113, 138, 12, 181, 173, 187, 183, 2,
+			22, 118, 221, 40, 20, 179, 138, 180, 173, 187, 85, 94, 178, 39,
+			159, 154, 236, 205, 167, 190, 218, 155, 166, 205, 147, 80, 207, 72,
+			222, 167, 137, 75, 37, 109, 95, 117, 220, 136, 5, 61, 169, 219,
+			243, 34, 187, 122, 152, 106, 233, 193, 235, 105, 160, 63, 175, 250,
+			169, 248, 34, 170, 17, 204, 14, 63, 190, 255, 91, 1, 238, 242,
+			226, 107, 170, 46, 147, 145, 126, 76, 140, 179, 233, 68, 232, 125,
+			211, 204, 249, 243, 239, 62, 69, 251, 9, 50, 32, 16, 129, 156,
+			204, 43, 107, 181, 235, 35, 25, 99, 128, 104, 47, 151, 55, 70,
+			20, 35, 71, 212, 213, 181, 17, 245, 5, 61, 127, 96, 228, 224,
+			11, 122, 254, 224, 200, 161, 23, 244, 252, 161, 145, 225, 106, 214,
+			99, 59, 44, 168, 102, 125, 215, 102, 65, 181, 247, 226, 176, 194,
+			63, 82, 201, 65, 65, 235, 195, 207, 94, 61, 61, 123, 47, 145,
+			1, 177, 61, 19, 233, 185, 253, 60, 148, 19, 7, 43, 85, 101,
+			229, 88, 156, 180, 68, 156, 204, 207, 40, 36, 199, 57, 22, 75,
+			171, 146, 146, 214, 255, 179, 138, 226, 4, 33, 160, 88, 110, 37,
+			162, 127, 160, 58, 8, 37, 120, 167, 69, 225, 239, 40, 228, 224,
+			6, 3, 147, 255, 187, 155, 106, 80, 155, 39, 124, 136, 25, 44,
+			65, 57, 137, 208, 29, 204, 66, 156, 116, 124, 18, 221, 224, 37,
+			251, 205, 188, 194, 207, 170, 228, 144, 68, 231, 129, 35, 119, 137,
+			12, 200, 214, 251, 198, 168, 183, 137, 18, 246, 88, 149, 149, 121,
+			158, 50, 84, 96, 54, 34, 157, 173, 198, 48, 244, 22, 222, 113,
+			58, 29, 102, 163, 106, 29, 172, 74, 208, 56, 78, 6, 163, 160,
+			235, 97, 84, 95, 92, 240, 150, 20, 196, 196, 228, 18, 98, 204,
+			38, 201, 98, 207, 251, 142, 122, 127, 182, 179, 186, 55, 219, 25,
+			146, 244, 29, 143, 9, 28, 241, 55, 52, 5, 126, 112, 33, 172,
+			152, 234, 62, 255, 117, 133, 232, 43, 160, 239, 75, 68, 187, 198,
+			34, 195, 216, 187, 106, 155, 99, 61, 101, 130, 181, 179, 68, 135,
+			85, 211, 136, 31, 166, 214, 208, 253, 223, 120, 138, 100, 113, 94,
+			25, 227, 125, 211, 129, 191, 115, 184, 175, 84, 188, 245, 52, 201,
+			241, 17, 49, 14, 247, 143, 16, 127, 239, 72, 127, 49, 127, 241,
+			109, 187, 142, 238, 27, 155, 60, 65, 251, 211, 234, 255, 251, 247,
+			209, 109, 36, 9, 218, 207, 226, 79, 213, 208, 14, 136, 180, 109,
+			205, 208, 14, 102, 38, 201, 215, 32, 192, 144, 49, 244, 35, 153,
+			13, 197, 252, 167, 42, 77, 228, 68, 122, 232, 196, 101, 114, 226,
+			14, 57, 248, 192, 128, 240, 139, 130, 103, 40, 128, 23, 168, 100,
+			124, 156, 80, 20, 191, 213, 235, 102, 101, 119, 157, 48, 10, 225,
+			228, 24, 79, 179, 77, 117, 134, 251, 252, 176, 219, 104, 48, 134,
+			39, 254, 91, 86, 96, 227, 121, 55, 191, 73, 119, 182, 88, 124,
+			205, 73, 111, 187, 248, 237, 3, 76, 179, 137, 115, 234, 0, 7,
+			56, 37, 216, 227, 195, 227, 114, 1, 25, 166, 52, 96, 81, 55,
+			240, 104, 19, 150, 12, 192, 77, 156, 168, 75, 218, 181, 121, 20,
+			153, 239, 190, 136, 108, 216, 113, 157, 104, 23, 182, 86, 24, 227,
+			247, 44, 23, 162, 27, 112, 191, 18, 236, 73, 82, 41, 222, 71,
+			242, 6, 41, 201, 12, 239, 9, 245, 48, 132, 177, 82, 76, 20,
+			250, 10, 58, 16, 69, 113, 170, 31, 100, 205, 76, 196, 169, 126,
+			144, 149, 50, 49, 56, 34, 33, 184, 35, 110, 108, 156, 124, 69,
+			149, 121, 210, 167, 85, 195, 252, 135, 42, 182, 13, 58, 68, 122,
+			80, 83, 204, 142, 124, 218, 98, 73, 54, 0, 136, 149, 216, 81,
+			66, 202, 171, 76, 178, 20, 149, 121, 27, 156, 197, 27, 215, 23,
+			231, 47, 94, 130, 168, 34, 54, 43, 171, 198, 27, 107, 168, 11,
+			205, 110, 248, 109, 70, 187, 17, 112, 198, 97, 33, 50, 183, 233,
+			120, 54, 237, 88, 33, 222, 82, 108, 5, 40, 194, 22, 143, 89,
+			136, 254, 224, 101, 160, 190, 206, 104, 3, 247, 175, 161, 223, 134,
+			235, 109, 186, 50, 138, 67, 249, 101, 18, 24, 33, 130, 91, 29,
+			60, 216, 35, 195, 51, 104, 86, 182, 9, 104, 34, 126, 144, 243,
+			206, 44, 27, 252, 71, 32, 53, 176, 205, 221, 70, 46, 132, 226,
+			170, 100, 39, 201, 165, 84, 122, 18, 209, 21, 76, 68, 79, 231,
+			89, 159, 30, 25, 37, 21, 153, 103, 253, 132, 58, 106, 62, 151,
+			164, 193, 136, 193, 218, 247, 254, 52, 200, 114, 147, 55, 50, 114,
+			233, 98, 73, 26, 45, 220, 4, 247, 132, 154, 75, 37, 94, 63,
+			49, 16, 167, 97, 107, 134, 246, 196, 240, 136, 72, 238, 214, 12,
+			237, 172, 106, 136, 228, 110, 199, 115, 32, 169, 46, 61, 158, 194,
+			51, 237, 199, 100, 198, 125, 64, 74, 242, 89, 145, 111, 133, 254,
+			84, 237, 108, 124, 228, 17, 82, 146, 207, 142, 140, 146, 63, 83,
+			101, 74, 242, 140, 250, 152, 249, 13, 46, 57, 109, 235, 174, 211,
+			238, 182, 83, 78, 11, 216, 76, 134, 162, 147, 110, 224, 149, 228,
+			117, 105, 220, 53, 193, 253, 104, 50, 93, 26, 102, 29, 73, 77,
+			3, 120, 13, 19, 12, 105, 212, 239, 8, 17, 124, 131, 64, 99,
+			138, 67, 34, 77, 197, 115, 229, 172, 140, 115, 65, 249, 16, 150,
+			232, 98, 24, 118, 219, 48, 140, 0, 162, 63, 67, 76, 71, 184,
+			158, 219, 225, 105, 220, 68, 188, 12, 49, 11, 151, 129, 179, 9,
+			14, 171, 2, 227, 38, 217, 54, 243, 224, 4, 191, 19, 209, 109,
+			199, 119, 227, 139, 214, 48, 123, 42, 65, 28, 207, 141, 227, 169,
+			239, 54, 36, 138, 90, 182, 237, 136, 163, 223, 188, 219, 80, 94,
+			230, 33, 111, 57, 98, 119, 65, 77, 1, 94, 50, 241, 70, 180,
+			20, 15, 9, 92, 176, 54, 19, 15, 137, 174, 24, 218, 76, 222,
+			144, 144, 102, 104, 51, 135, 143, 144, 79, 170, 50, 123, 250, 146,
+			122, 196, 252, 240, 189, 134, 4, 40, 9, 88, 195, 23, 95, 200,
+			73, 212, 70, 156, 48, 23, 39, 126, 240, 81, 242, 124, 113, 213,
+			74, 130, 87, 226, 70, 229, 99, 87, 234, 125, 23, 207, 204, 39,
+			167, 139, 227, 102, 210, 46, 44, 217, 66, 60, 126, 137, 90, 73,
+			238, 143, 34, 180, 201, 162, 198, 22, 117, 247, 59, 83, 19, 198,
+			252, 195, 74, 192, 62, 96, 119, 138, 190, 152, 125, 89, 100, 138,
+			100, 31, 36, 111, 95, 202, 143, 166, 146, 183, 47, 141, 31, 38,
+			223, 175, 203, 228, 237, 101, 213, 52, 191, 163, 37, 147, 213, 74,
+			114, 145, 249, 2, 33, 120, 150, 200, 53, 202, 116, 42, 60, 157,
+			244, 79, 23, 211, 97, 107, 249, 226, 164, 205, 154, 86, 215, 141,
+			166, 68, 218, 97, 132, 177, 114, 136, 255, 194, 5, 205, 113, 18,
+			61, 230, 144, 33, 131, 225, 98, 4, 152, 174, 32, 88, 97, 228,
+			119, 64, 10, 133, 246, 5, 180, 224, 38, 21, 191, 25, 207, 108,
+			8, 51, 225, 144, 225, 93, 167, 177, 255, 14, 226, 173, 112, 102,
+			159, 171, 83, 89, 25, 212, 0, 92, 10, 184, 154, 246, 219, 196,
+			152, 34, 126, 1, 107, 251, 219, 226, 238, 74, 52, 193, 73, 114,
+			79, 217, 158, 91, 238, 67, 107, 183, 127, 233, 0, 193, 113, 66,
+			200, 125, 110, 46, 16, 250, 234, 133, 34, 125, 170, 72, 47, 21,
+			233, 211, 175, 221, 139, 65, 48, 178, 130, 228, 11, 18, 7, 96,
+			244, 2, 127, 251, 53, 72, 160, 244, 241, 206, 8, 90, 103, 13,
+			11, 111, 81, 191, 8, 82, 39, 168, 3, 130, 246, 140, 73, 15,
+			69, 208, 90, 15, 42, 177, 176, 228, 178, 32, 2, 82, 197, 66,
+			142, 252, 242, 128, 204, 251, 135, 28, 249, 229, 137, 163, 228, 27,
+			138, 188, 93, 181, 162, 190, 168, 153, 191, 134, 247, 108, 202, 193,
+			42, 10, 203, 66, 92, 150, 139, 29, 138, 204, 64, 56, 251, 19,
+			123, 61, 100, 128, 33, 117, 45, 155, 64, 18, 110, 109, 196, 106,
+			112, 147, 106, 136, 179, 43, 5, 163, 226, 130, 128, 4, 116, 232,
+			7, 226, 195, 3, 130, 231, 103, 67, 18, 127, 187, 67, 222, 173,
+			25, 226, 39, 88, 250, 208, 74, 58, 231, 19, 17, 115, 181, 37,
+			19, 32, 16, 174, 85, 200, 4, 185, 46, 110, 208, 204, 24, 218,
+			123, 244, 115, 230, 179, 34, 255, 155, 59, 221, 146, 213, 43, 193,
+			46, 110, 15, 175, 83, 160, 145, 95, 194, 165, 55, 185, 103, 19,
+			178, 169, 223, 163, 31, 79, 96, 197, 208, 222, 115, 226, 76, 2,
+			107, 134, 246, 158, 201, 41, 242, 130, 232, 89, 49, 180, 85, 125,
+			220, 188, 76, 171, 66, 45, 167, 59, 147, 166, 35, 18, 158, 196,
+			219, 165, 199, 72, 30, 181, 145, 109, 195, 146, 189, 154, 186, 55,
+			20, 22, 237, 213, 193, 225, 4, 214, 12, 109, 213, 24, 35, 101,
+			209, 183, 106, 104, 235, 250, 152, 121, 233, 33, 250, 142, 179, 104,
+			98, 111, 85, 210, 45, 44, 218, 235, 169, 110, 33, 5, 124, 125,
+			240, 80, 2, 107, 134, 182, 62, 106, 96, 38, 119, 70, 29, 48,
+			180, 170, 42, 143, 198, 12, 228, 0, 146, 118, 219, 128, 98, 104,
+			213, 81, 121, 8, 107, 64, 51, 180, 234, 227, 79, 144, 159, 128,
+			76, 85, 197, 208, 111, 102, 154, 138, 249, 113, 133, 166, 182, 90,
+			15, 105, 116, 195, 27, 137, 213, 13, 129, 71, 177, 128, 146, 56,
+			14, 34, 242, 123, 168, 69, 91, 14, 44, 131, 169, 233, 45, 100,
+			64, 68, 81, 211, 253, 9, 67, 22, 216, 124, 51, 63, 134, 134,
+			44, 102, 218, 191, 252, 240, 134, 172, 2, 201, 205, 218, 203, 194,
+			206, 226, 233, 247, 47, 11, 67, 150, 167, 223, 191, 44, 13, 89,
+			5, 248, 90, 127, 199, 144, 125, 52, 67, 86, 193, 89, 81, 143,
+			25, 12, 131, 85, 23, 134, 172, 130, 134, 108, 93, 24, 178, 10,
+			24, 178, 236, 109, 49, 100, 21, 156, 19, 76, 104, 89, 5, 13,
+			89, 38, 12, 89, 5, 231, 3, 27, 30, 33, 107, 120, 168, 34,
+			235, 100, 126, 68, 81, 204, 43, 52, 229, 45, 72, 228, 90, 192,
+			15, 183, 155, 148, 231, 47, 156, 60, 204, 113, 113, 26, 226, 142,
+			122, 216, 124, 6, 110, 43, 71, 1, 20, 13, 75, 121, 244, 172,
+			148, 154, 11, 5, 7, 235, 12, 63, 113, 21, 249, 130, 26, 126,
+			84, 226, 142, 96, 161, 138, 50, 122, 71, 200, 40, 63, 42, 113,
+			103, 108, 156, 108, 226, 241, 11, 197, 208, 60, 117, 204, 188, 78,
+			171, 224, 12, 76, 232, 0, 96, 50, 149, 244, 42, 16, 152, 74,
+			69, 216, 160, 114, 223, 113, 183, 24, 1, 24, 67, 47, 70, 0,
+			198, 208, 27, 60, 36, 33, 205, 208, 188, 81, 131, 47, 90, 42,
+			12, 98, 164, 30, 51, 127, 93, 233, 207, 226, 75, 76, 43, 97,
+			103, 8, 155, 68, 248, 34, 226, 96, 99, 37, 118, 42, 136, 209,
+			231, 11, 80, 8, 31, 179, 19, 201, 192, 226, 193, 89, 200, 47,
+			198, 86, 100, 6, 4, 72, 141, 188, 247, 156, 0, 211, 35, 95,
+			60, 116, 194, 248, 136, 0, 92, 156, 109, 69, 144, 121, 151, 248,
+			28, 69, 45, 92, 93, 96, 237, 171, 39, 25, 65, 49, 19, 192,
+			120, 139, 132, 178, 84, 81, 166, 162, 209, 35, 18, 210, 12, 45,
+			58, 106, 146, 255, 198, 153, 160, 25, 218, 7, 212, 51, 230, 127,
+			230, 76, 96, 119, 59, 150, 103, 51, 123, 223, 12, 186, 88, 161,
+			139, 132, 12, 216, 177, 123, 242, 99, 143, 240, 9, 33, 180, 134,
+			194, 110, 187, 35, 237, 33, 225, 179, 72, 220, 17, 103, 195, 126,
+			82, 211, 183, 105, 203, 21, 51, 206, 121, 189, 76, 248, 199, 137,
+			249, 55, 99, 80, 0, 228, 247, 144, 236, 228, 10, 125, 249, 154,
+			188, 20, 85, 36, 35, 36, 152, 35, 119, 73, 207, 57, 76, 21,
+			111, 149, 254, 128, 56, 135, 169, 226, 230, 238, 3, 199, 229, 129,
+			31, 216, 220, 125, 224, 241, 39, 200, 247, 33, 139, 116, 67, 251,
+			160, 250, 184, 57, 15, 76, 73, 242, 58, 196, 142, 135, 103, 105,
+			72, 197, 98, 239, 99, 117, 171, 112, 122, 66, 251, 160, 56, 69,
+			165, 226, 1, 208, 15, 14, 29, 149, 144, 98, 104, 31, 52, 79,
+			74, 72, 51, 180, 15, 158, 46, 144, 21, 232, 24, 114, 5, 63,
+			164, 168, 63, 172, 104, 230, 115, 244, 186, 239, 218, 225, 61, 2,
+			214, 189, 154, 134, 27, 5, 176, 189, 216, 133, 229, 89, 132, 163,
+			85, 176, 100, 244, 15, 41, 100, 156, 60, 71, 114, 208, 56, 100,
+			244, 125, 88, 209, 167, 205, 98, 146, 251, 35, 46, 31, 119, 194,
+			61, 134, 12, 198, 153, 100, 46, 13, 190, 157, 195, 215, 79, 36,
+			5, 120, 12, 230, 228, 100, 82, 128, 199, 96, 206, 23, 201, 130,
+			232, 80, 129, 195, 40, 250, 17, 243, 156, 56, 106, 131, 136, 166,
+			230, 221, 102, 117, 165, 8, 6, 125, 60, 155, 82, 221, 65, 30,
+			218, 91, 50, 189, 73, 21, 121, 104, 111, 201, 220, 63, 85, 228,
+			161, 189, 165, 140, 31, 38, 207, 138, 238, 84, 56, 192, 162, 31,
+			54, 167, 250, 187, 227, 59, 254, 251, 245, 6, 169, 25, 31, 77,
+			247, 6, 201, 25, 31, 149, 231, 136, 84, 145, 19, 246, 81, 101,
+			108, 156, 116, 136, 72, 197, 255, 184, 162, 158, 48, 235, 112, 52,
+			70, 38, 31, 164, 251, 228, 227, 177, 215, 48, 219, 131, 5, 221,
+			118, 44, 200, 227, 113, 90, 158, 184, 48, 164, 27, 184, 183, 164,
+			165, 89, 144, 195, 137, 41, 212, 31, 87, 212, 3, 18, 84, 0,
+			60, 56, 33, 65, 13, 192, 99, 199, 73, 21, 207, 165, 229, 62,
+			161, 100, 254, 88, 81, 204, 101, 154, 246, 37, 63, 164, 81, 132,
+			175, 164, 87, 15, 248, 138, 17, 228, 111, 124, 66, 201, 143, 147,
+			139, 112, 178, 8, 62, 218, 251, 41, 69, 253, 9, 69, 51, 207,
+			80, 17, 107, 74, 79, 21, 139, 70, 162, 16, 247, 197, 130, 8,
+			188, 188, 65, 255, 148, 50, 192, 79, 118, 106, 224, 144, 51, 244,
+			31, 83, 244, 131, 230, 37, 122, 197, 143, 182, 146, 75, 181, 64,
+			15, 199, 183, 106, 137, 160, 97, 172, 47, 82, 107, 42, 12, 143,
+			38, 62, 83, 244, 99, 241, 209, 40, 40, 80, 161, 96, 232, 0,
+			74, 7, 212, 80, 12, 253, 199, 21, 253, 128, 57, 69, 215, 192,
+			81, 18, 247, 244, 16, 141, 131, 232, 253, 184, 162, 15, 36, 5,
+			42, 20, 144, 161, 184, 113, 21, 142, 228, 232, 67, 178, 241, 71,
+			193, 28, 36, 237, 51, 138, 158, 75, 10, 176, 177, 65, 194, 57,
+			13, 28, 250, 156, 162, 30, 54, 207, 166, 210, 10, 105, 45, 89,
+			40, 97, 113, 17, 167, 186, 3, 95, 38, 163, 224, 217, 65, 253,
+			115, 242, 188, 2, 30, 30, 212, 63, 167, 12, 142, 72, 80, 131,
+			86, 199, 198, 201, 95, 170, 242, 160, 216, 23, 225, 51, 78, 127,
+			170, 246, 247, 34, 166, 42, 239, 161, 99, 5, 86, 155, 193, 153,
+			90, 66, 232, 186, 21, 109, 129, 253, 21, 219, 190, 144, 55, 69,
+			11, 224, 164, 156, 1, 139, 112, 134, 167, 63, 205, 156, 159, 225,
+			109, 204, 128, 73, 81, 72, 93, 76, 24, 103, 175, 241, 39, 180,
+			227, 7, 48, 143, 228, 71, 220, 112, 219, 216, 114, 253, 250, 116,
+			24, 237, 186, 140, 22, 206, 21, 112, 121, 46, 156, 59, 87, 160,
+			112, 147, 59, 100, 174, 132, 105, 255, 141, 19, 210, 215, 225, 242,
+			132, 61, 56, 20, 112, 49, 73, 39, 237, 96, 151, 194, 174, 232,
+			182, 249, 225, 28, 184, 91, 13, 27, 159, 108, 51, 203, 67, 131,
+			213, 149, 135, 206, 67, 76, 229, 92, 107, 59, 201, 82, 15, 157,
+			112, 235, 85, 166, 133, 241, 163, 191, 65, 224, 7, 116, 210, 243,
+			97, 208, 237, 6, 186, 53, 122, 46, 128, 159, 138, 199, 8, 116,
+			220, 23, 147, 49, 2, 49, 251, 162, 60, 112, 161, 129, 5, 170,
+			127, 81, 25, 25, 37, 54, 14, 145, 106, 232, 63, 173, 168, 163,
+			230, 75, 105, 27, 20, 68, 53, 101, 130, 10, 92, 207, 138, 236,
+			246, 126, 27, 52, 54, 142, 253, 38, 50, 138, 160, 121, 13, 227,
+			24, 163, 4, 138, 237, 167, 21, 53, 39, 65, 5, 192, 129, 3,
+			18, 212, 0, 28, 30, 33, 31, 1, 99, 66, 3, 157, 248, 101,
+			192, 233, 253, 9, 78, 232, 147, 232, 81, 51, 241, 151, 24, 34,
+			63, 61, 5, 192, 29, 184, 207, 234, 78, 228, 229, 255, 49, 166,
+			54, 75, 85, 3, 179, 35, 209, 91, 97, 140, 55, 100, 155, 125,
+			57, 193, 27, 180, 213, 151, 19, 188, 33, 219, 236, 203, 202, 240,
+			8, 249, 255, 16, 109, 221, 208, 191, 2, 210, 222, 161, 171, 236,
+			110, 132, 75, 16, 88, 86, 232, 8, 40, 198, 95, 53, 140, 249,
+			234, 132, 98, 130, 137, 3, 138, 242, 4, 55, 21, 92, 71, 53,
+			41, 191, 129, 41, 242, 254, 182, 29, 240, 234, 240, 215, 92, 214,
+			4, 163, 161, 25, 35, 11, 137, 98, 95, 73, 198, 29, 18, 197,
+			190, 146, 140, 59, 36, 138, 125, 5, 198, 29, 79, 180, 105, 48,
+			34, 191, 160, 168, 19, 96, 183, 222, 136, 211, 37, 164, 42, 223,
+			235, 230, 228, 125, 74, 197, 147, 120, 160, 249, 60, 233, 109, 33,
+			118, 80, 118, 59, 29, 216, 6, 226, 86, 74, 234, 42, 201, 7,
+			187, 68, 175, 251, 59, 108, 27, 14, 243, 11, 95, 141, 24, 65,
+			222, 137, 112, 146, 198, 95, 237, 169, 131, 247, 46, 254, 232, 226,
+			61, 194, 60, 156, 212, 44, 167, 109, 64, 80, 14, 71, 71, 126,
+			65, 201, 143, 73, 80, 3, 240, 200, 99, 100, 11, 249, 144, 51,
+			244, 95, 82, 212, 99, 230, 43, 242, 70, 148, 218, 110, 135, 245,
+			15, 158, 252, 176, 119, 152, 230, 128, 152, 22, 177, 187, 41, 229,
+			93, 236, 59, 58, 202, 59, 206, 101, 177, 43, 57, 62, 57, 5,
+			64, 113, 208, 78, 83, 115, 26, 128, 19, 38, 30, 179, 130, 147,
+			215, 185, 95, 225, 103, 189, 196, 137, 98, 177, 217, 222, 237, 224,
+			6, 182, 137, 233, 39, 20, 175, 152, 61, 40, 207, 73, 235, 191,
+			162, 16, 147, 60, 37, 78, 11, 103, 240, 4, 211, 41, 243, 9,
+			124, 63, 201, 3, 17, 103, 50, 251, 26, 145, 71, 125, 51, 252,
+			224, 211, 120, 82, 128, 71, 159, 14, 155, 73, 1, 30, 126, 58,
+			113, 82, 28, 6, 30, 48, 244, 175, 41, 234, 19, 130, 138, 129,
+			28, 130, 134, 4, 21, 0, 199, 78, 74, 80, 3, 240, 244, 227,
+			164, 14, 75, 63, 49, 244, 127, 169, 168, 99, 230, 38, 125, 117,
+			185, 188, 94, 45, 47, 45, 214, 202, 203, 175, 209, 219, 152, 46,
+			114, 251, 254, 60, 23, 95, 139, 163, 86, 51, 18, 62, 91, 145,
+			184, 205, 15, 52, 48, 180, 207, 117, 93, 35, 25, 232, 100, 8,
+			124, 65, 154, 174, 13, 26, 250, 175, 41, 42, 236, 227, 116, 93,
+			27, 204, 0, 52, 36, 230, 7, 156, 241, 239, 1, 85, 1, 54,
+			4, 170, 191, 1, 168, 190, 212, 135, 170, 239, 218, 15, 141, 170,
+			248, 186, 245, 125, 112, 85, 12, 253, 55, 210, 184, 254, 102, 130,
+			171, 2, 80, 140, 156, 210, 7, 170, 2, 252, 255, 21, 129, 236,
+			239, 130, 68, 239, 246, 34, 91, 233, 145, 232, 120, 222, 72, 165,
+			26, 227, 29, 109, 65, 154, 245, 14, 254, 63, 81, 159, 242, 3,
+			99, 226, 214, 95, 145, 160, 209, 35, 246, 178, 78, 76, 143, 106,
+			232, 191, 171, 12, 153, 49, 61, 191, 151, 208, 163, 26, 250, 239,
+			37, 4, 168, 74, 47, 40, 159, 254, 7, 110, 70, 228, 13, 253,
+			223, 41, 42, 53, 127, 79, 165, 144, 185, 36, 149, 148, 72, 109,
+			135, 156, 43, 16, 141, 152, 34, 68, 138, 235, 43, 177, 167, 167,
+			139, 240, 162, 48, 158, 33, 16, 26, 59, 168, 22, 8, 157, 166,
+			139, 169, 59, 112, 240, 61, 208, 214, 241, 199, 125, 67, 214, 195,
+			33, 88, 116, 227, 174, 96, 88, 49, 68, 130, 167, 115, 56, 207,
+			34, 184, 99, 135, 111, 165, 133, 174, 79, 90, 239, 88, 78, 80,
+			138, 187, 228, 154, 195, 242, 226, 24, 194, 164, 231, 184, 83, 124,
+			126, 62, 0, 5, 232, 46, 198, 34, 10, 37, 22, 242, 51, 68,
+			219, 210, 201, 98, 181, 128, 182, 98, 95, 196, 30, 214, 231, 30,
+			11, 90, 205, 231, 144, 199, 82, 25, 229, 21, 0, 197, 233, 51,
+			77, 205, 107, 0, 158, 56, 69, 110, 226, 120, 12, 26, 250, 31,
+			193, 25, 224, 10, 93, 231, 223, 57, 74, 228, 63, 97, 125, 106,
+			6, 36, 72, 249, 1, 254, 237, 157, 141, 210, 223, 73, 138, 177,
+			24, 204, 97, 203, 242, 42, 129, 65, 5, 64, 34, 173, 203, 65,
+			13, 192, 177, 195, 164, 198, 111, 18, 248, 19, 37, 243, 17, 85,
+			49, 175, 202, 205, 200, 163, 185, 178, 246, 221, 142, 192, 154, 249,
+			39, 74, 254, 48, 250, 231, 240, 120, 255, 55, 193, 72, 190, 252,
+			96, 119, 22, 28, 227, 150, 93, 246, 122, 180, 196, 241, 250, 44,
+			28, 41, 19, 139, 179, 46, 142, 156, 9, 195, 89, 23, 7, 206,
+			198, 198, 201, 123, 229, 217, 251, 63, 7, 117, 243, 194, 67, 123,
+			181, 82, 38, 37, 218, 206, 123, 253, 90, 226, 92, 123, 22, 155,
+			150, 104, 128, 34, 249, 115, 101, 240, 144, 4, 53, 0, 71, 13,
+			178, 4, 104, 192, 26, 244, 23, 138, 250, 191, 20, 205, 188, 32,
+			206, 249, 246, 110, 198, 64, 102, 93, 57, 222, 105, 126, 39, 233,
+			235, 58, 250, 11, 254, 66, 33, 35, 164, 68, 114, 208, 38, 48,
+			245, 91, 112, 83, 198, 73, 52, 245, 37, 109, 169, 253, 187, 240,
+			251, 194, 122, 163, 139, 227, 122, 223, 146, 155, 104, 93, 172, 72,
+			223, 146, 23, 101, 232, 98, 69, 250, 150, 98, 140, 145, 111, 42,
+			162, 15, 197, 208, 191, 163, 232, 39, 204, 127, 173, 8, 135, 217,
+			222, 94, 254, 22, 123, 231, 36, 221, 112, 243, 198, 119, 20, 221,
+			136, 25, 1, 195, 249, 29, 101, 108, 34, 41, 208, 160, 224, 216,
+			113, 242, 231, 170, 224, 140, 106, 232, 255, 83, 209, 207, 154, 191,
+			207, 125, 250, 96, 248, 78, 119, 172, 198, 29, 102, 223, 131, 57,
+			114, 9, 0, 94, 44, 166, 81, 100, 125, 71, 46, 197, 226, 193,
+			248, 176, 38, 70, 17, 238, 136, 196, 81, 87, 193, 139, 88, 100,
+			246, 227, 155, 19, 38, 119, 124, 165, 240, 16, 246, 35, 73, 220,
+			122, 146, 179, 247, 240, 5, 46, 239, 121, 247, 94, 30, 193, 164,
+			38, 111, 105, 79, 245, 20, 53, 201, 158, 33, 193, 141, 196, 219,
+			140, 212, 224, 168, 57, 228, 244, 137, 164, 64, 129, 130, 147, 133,
+			164, 64, 131, 130, 51, 79, 146, 119, 139, 177, 193, 131, 117, 250,
+			132, 57, 75, 107, 189, 93, 165, 70, 102, 121, 223, 145, 145, 77,
+			138, 211, 123, 131, 73, 1, 158, 222, 35, 227, 73, 1, 118, 114,
+			228, 49, 82, 147, 247, 90, 124, 72, 85, 79, 154, 87, 177, 75,
+			249, 213, 172, 30, 197, 141, 223, 46, 20, 71, 228, 68, 94, 73,
+			178, 24, 201, 77, 58, 14, 104, 172, 101, 224, 146, 128, 15, 201,
+			243, 88, 58, 178, 226, 67, 106, 124, 46, 31, 212, 219, 135, 84,
+			227, 168, 4, 53, 0, 143, 159, 32, 127, 160, 200, 27, 38, 222,
+			82, 85, 195, 252, 87, 10, 173, 220, 127, 7, 37, 15, 209, 192,
+			125, 231, 137, 22, 66, 59, 38, 206, 67, 41, 225, 185, 159, 48,
+			89, 60, 247, 155, 204, 1, 235, 48, 241, 221, 237, 45, 70, 95,
+			76, 11, 103, 60, 244, 68, 220, 200, 1, 194, 157, 92, 216, 142,
+			38, 255, 110, 156, 106, 34, 82, 61, 184, 114, 134, 93, 96, 236,
+			45, 22, 23, 58, 128, 251, 81, 158, 229, 225, 215, 61, 188, 165,
+			138, 45, 26, 191, 238, 225, 45, 117, 100, 148, 172, 18, 53, 151,
+			49, 114, 31, 83, 51, 159, 86, 21, 243, 221, 224, 49, 142, 173,
+			30, 240, 165, 77, 55, 173, 134, 72, 187, 193, 175, 234, 67, 230,
+			149, 103, 211, 247, 245, 44, 105, 32, 64, 219, 14, 94, 225, 53,
+			68, 180, 28, 104, 205, 143, 169, 249, 3, 228, 26, 209, 115, 232,
+			33, 251, 184, 170, 22, 205, 103, 49, 166, 35, 183, 252, 152, 184,
+			28, 95, 63, 128, 91, 102, 240, 225, 197, 170, 62, 17, 68, 78,
+			18, 52, 4, 30, 67, 53, 55, 40, 65, 21, 64, 50, 46, 65,
+			112, 32, 170, 167, 206, 129, 229, 144, 67, 111, 217, 143, 170, 106,
+			201, 172, 96, 80, 85, 108, 249, 194, 61, 65, 209, 62, 85, 125,
+			223, 128, 40, 239, 7, 52, 223, 143, 170, 185, 24, 84, 1, 28,
+			58, 34, 65, 13, 192, 211, 69, 178, 138, 88, 168, 134, 254, 41,
+			85, 157, 55, 223, 29, 15, 53, 167, 62, 213, 37, 172, 65, 66,
+			206, 146, 91, 50, 68, 167, 76, 112, 58, 238, 28, 200, 250, 148,
+			154, 27, 146, 32, 182, 127, 96, 66, 130, 26, 128, 143, 207, 214,
+			115, 157, 192, 143, 252, 11, 255, 123, 0, 66, 179, 246, 213, 145,
+			148, 0, 0},
 	)
 }
 
@@ -13,558 +13,569 @@ func init() {
 		},
 		[]byte{31, 139,
 			8, 0, 0, 0, 0, 0, 0, 255, 236, 124, 109, 108, 28, 201,
-			149, 216, 84, 119, 207, 112, 88, 164, 68, 178, 73, 81, 84, 83,
-			43, 149, 168, 93, 137, 90, 13, 135, 31, 178, 188, 187, 218, 149,
-			188, 67, 114, 36, 206, 46, 197, 161, 103, 134, 187, 150, 141, 141,
+			149, 216, 84, 119, 207, 112, 88, 164, 68, 178, 73, 73, 84, 83,
+			43, 61, 81, 187, 18, 181, 34, 135, 31, 178, 188, 187, 218, 149,
+			236, 33, 57, 18, 103, 151, 226, 208, 51, 195, 93, 175, 141, 141,
 			220, 156, 46, 206, 244, 170, 167, 123, 220, 221, 35, 137, 155, 91,
-			24, 23, 3, 135, 187, 124, 57, 70, 14, 249, 17, 248, 46, 246,
-			249, 18, 95, 236, 205, 57, 14, 46, 155, 31, 134, 131, 75, 114,
-			249, 97, 32, 184, 4, 57, 224, 46, 8, 130, 252, 200, 207, 228,
-			71, 126, 5, 8, 144, 31, 9, 222, 171, 170, 158, 30, 114, 168,
-			213, 250, 54, 247, 227, 176, 4, 180, 219, 175, 186, 234, 189, 87,
-			239, 189, 122, 245, 234, 245, 171, 161, 255, 102, 140, 222, 111, 5,
-			197, 102, 59, 12, 58, 110, 175, 83, 12, 194, 214, 178, 215, 107,
-			186, 203, 94, 208, 114, 130, 214, 178, 221, 117, 151, 185, 239, 116,
-			3, 215, 143, 163, 229, 102, 16, 132, 142, 235, 219, 113, 16, 46,
-			71, 60, 124, 236, 54, 121, 180, 252, 120, 117, 57, 138, 237, 152,
-			23, 187, 97, 16, 7, 102, 78, 140, 92, 248, 167, 132, 206, 86,
-			252, 152, 135, 190, 237, 109, 7, 173, 122, 28, 114, 187, 83, 135,
-			142, 230, 101, 122, 10, 251, 62, 124, 204, 195, 200, 13, 252, 57,
-			194, 200, 226, 104, 109, 28, 27, 223, 17, 109, 230, 44, 205, 69,
-			188, 25, 242, 120, 78, 99, 100, 113, 188, 38, 33, 243, 37, 122,
-			58, 230, 97, 199, 245, 109, 239, 161, 235, 59, 252, 233, 156, 206,
-			200, 162, 94, 59, 165, 90, 43, 208, 104, 90, 52, 111, 135, 205,
-			182, 251, 152, 59, 115, 6, 35, 139, 249, 90, 2, 3, 234, 110,
-			47, 108, 113, 103, 46, 139, 111, 36, 180, 254, 214, 87, 183, 254,
-			220, 178, 120, 93, 116, 126, 235, 191, 229, 105, 206, 52, 140, 12,
-			35, 244, 159, 19, 74, 198, 77, 221, 200, 152, 107, 63, 34, 108,
-			35, 232, 30, 134, 110, 171, 29, 179, 181, 149, 213, 47, 178, 70,
-			155, 179, 237, 189, 141, 10, 43, 245, 226, 118, 16, 70, 69, 86,
-			242, 60, 134, 29, 34, 22, 114, 192, 204, 157, 34, 101, 123, 17,
-			103, 193, 1, 139, 219, 110, 196, 162, 160, 23, 54, 57, 107, 6,
-			14, 103, 110, 196, 90, 193, 99, 30, 250, 220, 97, 61, 223, 225,
-			33, 139, 219, 156, 149, 186, 118, 19, 16, 187, 77, 238, 71, 188,
-			192, 164, 80, 217, 90, 113, 133, 178, 184, 109, 199, 172, 105, 251,
-			108, 159, 179, 131, 160, 231, 59, 204, 245, 113, 212, 118, 101, 163,
-			188, 83, 47, 179, 3, 215, 227, 69, 74, 243, 148, 104, 166, 158,
-			203, 76, 192, 83, 222, 212, 243, 153, 135, 116, 148, 106, 249, 49,
-			241, 248, 30, 213, 140, 140, 105, 140, 103, 24, 177, 190, 204, 134,
-			171, 26, 216, 3, 204, 94, 208, 98, 17, 54, 51, 180, 21, 246,
-			216, 246, 122, 48, 131, 78, 167, 231, 187, 77, 59, 230, 14, 139,
-			3, 202, 148, 32, 139, 148, 82, 170, 27, 25, 98, 234, 227, 249,
-			11, 116, 147, 26, 70, 70, 203, 152, 250, 105, 109, 222, 122, 133,
-			237, 166, 236, 68, 81, 64, 219, 217, 239, 29, 48, 105, 83, 236,
-			32, 8, 165, 180, 144, 110, 145, 210, 113, 154, 5, 44, 89, 64,
-			147, 87, 16, 49, 245, 211, 163, 179, 10, 210, 77, 253, 244, 57,
-			139, 254, 150, 134, 4, 137, 169, 207, 106, 211, 214, 183, 53, 212,
-			82, 127, 14, 87, 35, 38, 140, 177, 72, 41, 219, 9, 98, 46,
-			68, 10, 108, 136, 118, 96, 42, 228, 113, 15, 180, 114, 137, 53,
-			128, 11, 55, 98, 193, 35, 251, 176, 192, 34, 215, 111, 114, 193,
-			153, 50, 38, 124, 233, 123, 135, 148, 217, 205, 38, 143, 34, 119,
-			223, 227, 44, 14, 88, 28, 246, 34, 144, 76, 34, 21, 228, 67,
-			146, 144, 10, 108, 130, 162, 29, 182, 127, 152, 244, 66, 65, 62,
-			182, 61, 215, 1, 65, 75, 169, 187, 254, 65, 16, 118, 236, 24,
-			36, 243, 196, 141, 219, 65, 47, 102, 62, 231, 142, 235, 183, 128,
-			82, 23, 255, 223, 230, 108, 163, 111, 213, 204, 245, 41, 219, 231,
-			241, 19, 206, 125, 198, 237, 102, 155, 245, 186, 128, 50, 145, 36,
-			201, 130, 124, 70, 20, 4, 210, 202, 159, 86, 144, 110, 234, 179,
-			83, 38, 125, 140, 130, 212, 76, 221, 210, 230, 45, 87, 240, 175,
-			56, 114, 248, 83, 97, 210, 71, 100, 171, 22, 50, 235, 240, 40,
-			178, 91, 188, 200, 42, 162, 151, 48, 26, 55, 98, 75, 171, 5,
-			154, 140, 67, 21, 187, 158, 39, 17, 184, 126, 43, 225, 80, 203,
-			2, 97, 197, 33, 232, 211, 202, 43, 93, 107, 186, 169, 91, 231,
-			44, 122, 3, 57, 212, 77, 253, 5, 109, 198, 186, 162, 72, 165,
-			12, 182, 109, 71, 108, 31, 100, 160, 252, 71, 130, 94, 207, 194,
-			168, 156, 130, 136, 169, 191, 48, 50, 161, 32, 192, 104, 78, 211,
-			85, 68, 111, 152, 250, 69, 205, 180, 94, 124, 22, 122, 225, 132,
-			18, 228, 70, 22, 198, 40, 228, 6, 49, 245, 139, 35, 167, 20,
-			164, 155, 250, 197, 201, 169, 253, 28, 90, 253, 13, 250, 127, 232,
-			103, 225, 197, 99, 59, 122, 20, 29, 241, 226, 191, 66, 199, 74,
-			98, 218, 13, 59, 122, 100, 206, 209, 145, 110, 24, 188, 207, 155,
-			177, 244, 217, 10, 52, 79, 83, 205, 117, 208, 85, 143, 214, 52,
-			215, 49, 231, 233, 40, 224, 123, 232, 219, 29, 62, 55, 130, 205,
-			121, 104, 216, 177, 59, 220, 156, 161, 217, 144, 219, 94, 103, 46,
-			143, 47, 4, 96, 78, 82, 253, 17, 63, 68, 119, 62, 94, 131,
-			199, 207, 212, 33, 255, 123, 233, 144, 207, 255, 165, 117, 200, 142,
-			114, 200, 231, 137, 245, 21, 150, 82, 27, 240, 100, 51, 144, 62,
-			251, 70, 143, 247, 184, 120, 116, 120, 212, 12, 221, 110, 220, 119,
-			150, 92, 154, 184, 237, 193, 204, 108, 112, 85, 45, 143, 211, 148,
-			181, 14, 248, 229, 233, 180, 95, 62, 99, 189, 130, 162, 3, 125,
-			171, 85, 45, 109, 67, 121, 199, 196, 23, 3, 63, 251, 56, 207,
-			56, 120, 166, 95, 158, 76, 251, 229, 233, 25, 250, 170, 114, 203,
-			147, 218, 164, 117, 29, 233, 181, 237, 168, 205, 42, 155, 199, 29,
-			9, 184, 53, 185, 98, 7, 60, 214, 100, 66, 3, 60, 214, 228,
-			232, 88, 202, 99, 77, 158, 158, 160, 29, 229, 177, 166, 181, 179,
-			214, 215, 145, 6, 200, 240, 203, 40, 184, 157, 100, 118, 176, 237,
-			216, 209, 163, 2, 235, 69, 60, 209, 23, 180, 8, 9, 119, 221,
-			46, 247, 92, 159, 23, 105, 226, 254, 67, 254, 141, 158, 27, 114,
-			7, 133, 93, 218, 120, 251, 168, 163, 154, 78, 24, 131, 25, 78,
-			143, 154, 41, 71, 53, 125, 102, 150, 114, 229, 168, 96, 79, 250,
-			10, 50, 134, 235, 70, 136, 124, 17, 188, 186, 100, 67, 202, 253,
-			90, 127, 91, 234, 134, 252, 192, 77, 188, 109, 162, 5, 202, 236,
-			40, 10, 154, 46, 238, 191, 128, 96, 192, 181, 205, 38, 12, 129,
-			107, 155, 29, 61, 157, 114, 109, 224, 219, 255, 132, 40, 223, 54,
-			175, 77, 90, 255, 150, 176, 70, 117, 179, 186, 216, 118, 253, 224,
-			145, 125, 237, 22, 171, 241, 78, 240, 88, 238, 116, 246, 65, 204,
-			67, 214, 12, 247, 123, 173, 98, 51, 232, 44, 191, 182, 118, 227,
-			230, 205, 87, 40, 78, 34, 177, 185, 71, 252, 240, 184, 26, 165,
-			243, 119, 35, 124, 237, 4, 60, 242, 175, 198, 172, 99, 199, 205,
-			54, 246, 132, 86, 216, 168, 134, 69, 25, 5, 108, 5, 185, 243,
-			8, 55, 217, 168, 215, 229, 225, 129, 215, 11, 122, 17, 179, 125,
-			135, 69, 237, 160, 231, 57, 176, 236, 28, 238, 241, 248, 136, 247,
-			157, 79, 118, 14, 240, 190, 243, 249, 177, 148, 247, 157, 63, 61,
-			145, 120, 223, 255, 244, 18, 189, 208, 10, 130, 150, 199, 151, 85,
-			20, 178, 236, 244, 66, 220, 108, 165, 59, 157, 16, 239, 139, 234,
-			253, 194, 45, 154, 223, 148, 93, 192, 169, 70, 188, 25, 248, 78,
-			132, 78, 85, 175, 41, 16, 252, 164, 111, 251, 65, 132, 126, 53,
-			91, 19, 192, 250, 183, 8, 157, 110, 6, 157, 226, 17, 156, 235,
-			167, 20, 70, 140, 143, 118, 201, 87, 87, 101, 143, 86, 224, 217,
-			126, 11, 221, 103, 194, 96, 124, 216, 229, 209, 242, 35, 63, 120,
-			226, 39, 204, 118, 247, 255, 55, 33, 191, 163, 233, 247, 118, 215,
-			127, 164, 93, 184, 39, 6, 239, 202, 17, 197, 119, 185, 231, 189,
-			13, 253, 27, 48, 244, 173, 255, 124, 25, 252, 233, 133, 76, 68,
-			232, 191, 27, 71, 127, 122, 33, 99, 174, 253, 171, 113, 17, 157,
-			53, 3, 143, 173, 247, 14, 14, 120, 24, 177, 37, 38, 80, 93,
-			141, 152, 99, 199, 54, 115, 33, 84, 108, 182, 109, 191, 5, 238,
-			14, 162, 18, 58, 224, 132, 87, 94, 149, 3, 88, 197, 111, 22,
-			217, 9, 254, 183, 29, 199, 221, 232, 214, 242, 178, 195, 31, 115,
-			47, 232, 242, 48, 82, 2, 1, 3, 235, 74, 38, 150, 246, 5,
-			19, 203, 148, 178, 26, 119, 220, 40, 14, 221, 253, 30, 250, 60,
-			48, 128, 94, 196, 97, 237, 74, 255, 13, 45, 251, 174, 111, 135,
-			135, 200, 87, 84, 192, 53, 193, 130, 80, 133, 76, 148, 117, 2,
-			199, 61, 128, 128, 213, 13, 252, 2, 179, 67, 206, 186, 16, 173,
-			196, 176, 128, 186, 97, 240, 216, 117, 32, 146, 85, 203, 238, 32,
-			240, 188, 224, 9, 196, 86, 160, 79, 23, 6, 69, 48, 136, 178,
-			14, 143, 111, 81, 202, 224, 239, 229, 35, 140, 69, 176, 8, 210,
-			59, 74, 167, 23, 197, 16, 78, 218, 114, 121, 219, 251, 176, 174,
-			154, 74, 98, 148, 249, 65, 236, 54, 209, 218, 221, 136, 121, 110,
-			20, 3, 134, 52, 69, 240, 180, 3, 236, 56, 110, 212, 244, 108,
-			183, 195, 195, 226, 73, 76, 184, 126, 90, 22, 138, 137, 110, 24,
-			56, 61, 140, 95, 37, 31, 180, 207, 200, 159, 139, 15, 170, 124,
-			168, 19, 52, 123, 29, 238, 199, 182, 82, 210, 114, 16, 178, 32,
-			110, 243, 16, 86, 61, 15, 93, 219, 139, 250, 162, 70, 5, 197,
-			109, 78, 89, 154, 251, 100, 82, 59, 220, 197, 145, 113, 106, 103,
-			74, 219, 150, 31, 244, 223, 161, 220, 221, 56, 130, 25, 249, 2,
-			85, 16, 70, 172, 99, 31, 130, 131, 64, 55, 31, 7, 16, 179,
-			7, 33, 108, 253, 33, 48, 209, 129, 224, 95, 200, 36, 142, 152,
-			195, 67, 136, 15, 217, 65, 24, 116, 168, 138, 12, 14, 226, 39,
-			96, 38, 42, 232, 142, 186, 188, 9, 22, 196, 186, 161, 11, 134,
-			21, 130, 237, 248, 194, 138, 162, 8, 121, 167, 172, 177, 85, 169,
-			179, 122, 245, 110, 227, 221, 82, 173, 204, 42, 117, 182, 91, 171,
-			190, 83, 217, 44, 111, 178, 245, 7, 172, 177, 85, 102, 27, 213,
-			221, 7, 181, 202, 189, 173, 6, 219, 170, 110, 111, 150, 107, 117,
-			86, 218, 217, 100, 27, 213, 157, 70, 173, 178, 190, 215, 168, 214,
-			234, 148, 45, 148, 234, 172, 82, 95, 192, 55, 165, 157, 7, 172,
-			252, 149, 221, 90, 185, 94, 103, 213, 26, 171, 220, 223, 221, 174,
-			148, 55, 217, 187, 165, 90, 173, 180, 211, 168, 148, 235, 5, 86,
-			217, 217, 216, 222, 219, 172, 236, 220, 43, 176, 245, 189, 6, 219,
-			169, 54, 40, 219, 174, 220, 175, 52, 202, 155, 172, 81, 45, 32,
-			217, 227, 227, 88, 245, 46, 187, 95, 174, 109, 108, 149, 118, 26,
-			165, 245, 202, 118, 165, 241, 0, 9, 222, 173, 52, 118, 128, 216,
-			221, 106, 141, 178, 18, 219, 45, 213, 26, 149, 141, 189, 237, 82,
-			141, 237, 238, 213, 118, 171, 245, 50, 131, 153, 109, 86, 234, 27,
-			219, 165, 202, 253, 242, 102, 145, 85, 118, 216, 78, 149, 149, 223,
-			41, 239, 52, 88, 125, 171, 180, 189, 61, 56, 81, 202, 170, 239,
-			238, 148, 107, 192, 125, 122, 154, 108, 189, 204, 182, 43, 165, 245,
-			237, 50, 144, 194, 121, 110, 86, 106, 229, 141, 6, 76, 168, 255,
-			180, 81, 217, 44, 239, 52, 74, 219, 5, 202, 234, 187, 229, 141,
-			74, 105, 187, 192, 202, 95, 41, 223, 223, 221, 46, 213, 30, 20,
-			36, 210, 122, 249, 203, 123, 229, 157, 70, 165, 180, 205, 54, 75,
-			247, 75, 247, 202, 117, 182, 248, 73, 82, 217, 173, 85, 55, 246,
-			106, 229, 251, 192, 117, 245, 46, 171, 239, 173, 215, 27, 149, 198,
-			94, 163, 204, 238, 85, 171, 155, 40, 236, 122, 185, 246, 78, 101,
-			163, 92, 127, 157, 109, 87, 235, 40, 176, 189, 122, 185, 64, 217,
-			102, 169, 81, 66, 210, 187, 181, 234, 221, 74, 163, 254, 58, 60,
-			175, 239, 213, 43, 40, 184, 202, 78, 163, 92, 171, 237, 237, 54,
-			42, 213, 157, 107, 108, 171, 250, 110, 249, 157, 114, 141, 109, 148,
-			246, 234, 229, 77, 148, 112, 117, 7, 102, 11, 182, 82, 174, 214,
-			30, 0, 90, 144, 3, 106, 160, 192, 222, 221, 42, 55, 182, 202,
-			53, 16, 42, 74, 171, 4, 98, 168, 55, 106, 149, 141, 70, 186,
-			91, 181, 198, 26, 213, 90, 131, 166, 230, 201, 118, 202, 247, 182,
-			43, 247, 202, 59, 27, 101, 120, 93, 5, 52, 239, 86, 234, 229,
-			107, 172, 84, 171, 212, 161, 67, 5, 9, 179, 119, 75, 15, 88,
-			117, 15, 103, 13, 138, 218, 171, 151, 169, 120, 78, 153, 110, 1,
-			245, 201, 42, 119, 89, 105, 243, 157, 10, 112, 46, 123, 239, 86,
-			235, 245, 138, 52, 23, 20, 219, 198, 150, 148, 121, 18, 230, 178,
-			204, 156, 12, 115, 23, 50, 175, 99, 152, 251, 146, 120, 20, 141,
-			151, 51, 23, 177, 241, 162, 120, 20, 141, 47, 102, 182, 84, 64,
-			12, 143, 162, 241, 165, 76, 1, 27, 137, 120, 20, 141, 87, 50,
-			69, 108, 148, 143, 162, 241, 106, 102, 1, 27, 169, 120, 20, 141,
-			139, 153, 75, 216, 248, 162, 120, 252, 237, 73, 17, 101, 31, 100,
-			34, 98, 125, 103, 146, 149, 152, 218, 117, 209, 59, 242, 136, 251,
-			113, 132, 177, 115, 203, 231, 78, 129, 29, 184, 79, 185, 179, 228,
-			113, 191, 21, 183, 89, 212, 181, 125, 12, 113, 220, 14, 239, 119,
-			231, 14, 196, 97, 204, 102, 205, 160, 231, 163, 207, 148, 251, 63,
-			58, 204, 131, 208, 110, 246, 183, 5, 245, 34, 102, 24, 11, 32,
-			72, 97, 91, 12, 60, 225, 249, 88, 5, 35, 29, 56, 93, 119,
-			185, 239, 112, 129, 208, 246, 15, 89, 211, 246, 184, 239, 216, 33,
-			98, 109, 6, 126, 147, 119, 99, 112, 211, 143, 56, 91, 112, 236,
-			195, 5, 10, 62, 109, 161, 19, 248, 113, 123, 65, 161, 9, 185,
-			39, 115, 51, 172, 225, 118, 120, 20, 219, 157, 174, 112, 212, 114,
-			135, 115, 92, 216, 94, 185, 223, 228, 42, 85, 64, 89, 252, 36,
-			221, 27, 15, 237, 145, 56, 130, 36, 162, 2, 22, 220, 228, 204,
-			99, 59, 224, 202, 131, 144, 69, 189, 253, 24, 166, 11, 18, 1,
-			39, 202, 236, 62, 162, 34, 171, 97, 196, 0, 136, 186, 221, 48,
-			120, 234, 194, 118, 224, 29, 178, 235, 75, 171, 43, 133, 149, 149,
-			21, 118, 200, 237, 48, 2, 247, 121, 153, 149, 159, 218, 157, 174,
-			199, 35, 74, 213, 35, 91, 189, 197, 54, 130, 78, 183, 23, 243,
-			62, 27, 72, 99, 128, 93, 220, 247, 186, 17, 239, 57, 1, 110,
-			190, 69, 185, 73, 247, 231, 19, 197, 118, 24, 179, 219, 172, 88,
-			44, 190, 126, 244, 29, 247, 157, 129, 55, 9, 33, 21, 97, 169,
-			183, 226, 117, 18, 36, 42, 181, 222, 6, 12, 9, 180, 36, 104,
-			41, 248, 245, 35, 131, 208, 0, 228, 16, 241, 172, 6, 32, 164,
-			136, 184, 7, 108, 241, 24, 161, 55, 216, 10, 187, 114, 229, 40,
-			174, 59, 108, 229, 26, 251, 171, 98, 216, 16, 238, 174, 223, 102,
-			171, 175, 31, 123, 43, 73, 223, 102, 171, 43, 234, 79, 118, 250,
-			144, 113, 15, 226, 171, 97, 12, 220, 25, 202, 192, 27, 207, 102,
-			96, 233, 25, 12, 92, 31, 198, 64, 74, 253, 107, 125, 245, 247,
-			245, 133, 250, 239, 131, 215, 251, 10, 251, 244, 86, 112, 162, 174,
-			79, 182, 17, 241, 42, 173, 242, 219, 131, 42, 103, 215, 143, 9,
-			225, 245, 254, 32, 101, 0, 41, 165, 167, 7, 28, 179, 130, 254,
-			152, 65, 57, 15, 216, 92, 90, 196, 253, 1, 67, 165, 219, 87,
-			111, 191, 227, 157, 116, 199, 19, 104, 92, 31, 78, 99, 168, 9,
-			165, 52, 120, 227, 164, 5, 236, 216, 49, 7, 143, 90, 132, 255,
-			56, 220, 195, 35, 6, 219, 61, 140, 219, 34, 154, 130, 191, 24,
-			132, 126, 188, 227, 162, 99, 31, 70, 183, 111, 20, 88, 199, 245,
-			123, 49, 143, 110, 175, 174, 92, 27, 92, 102, 236, 118, 66, 109,
-			241, 200, 171, 226, 221, 48, 232, 52, 18, 84, 177, 115, 13, 125,
-			207, 91, 245, 234, 14, 187, 111, 119, 187, 174, 223, 162, 148, 85,
-			124, 209, 34, 78, 58, 226, 72, 154, 240, 15, 167, 48, 134, 153,
-			96, 48, 51, 71, 108, 3, 16, 199, 250, 45, 22, 218, 50, 116,
-			181, 193, 95, 82, 22, 236, 195, 161, 190, 192, 158, 180, 121, 200,
-			213, 57, 30, 58, 114, 16, 170, 140, 158, 163, 222, 1, 28, 244,
-			23, 162, 5, 182, 232, 250, 14, 158, 84, 252, 150, 218, 55, 174,
-			129, 227, 165, 64, 176, 27, 242, 38, 119, 68, 226, 24, 3, 224,
-			94, 103, 159, 135, 169, 45, 70, 158, 125, 250, 187, 76, 196, 248,
-			83, 216, 176, 34, 100, 147, 38, 251, 146, 237, 169, 33, 69, 118,
-			55, 8, 25, 23, 234, 42, 176, 27, 201, 110, 133, 152, 86, 6,
-			112, 37, 39, 111, 154, 204, 221, 29, 16, 20, 136, 98, 225, 70,
-			180, 0, 243, 117, 65, 251, 3, 155, 226, 106, 10, 153, 196, 69,
-			97, 39, 233, 179, 56, 12, 91, 81, 89, 215, 42, 224, 5, 60,
-			71, 176, 82, 214, 113, 155, 225, 32, 222, 231, 69, 187, 26, 45,
-			96, 118, 76, 229, 199, 14, 242, 147, 244, 191, 16, 149, 32, 123,
-			95, 155, 177, 254, 152, 176, 58, 70, 5, 9, 81, 149, 143, 73,
-			133, 5, 69, 118, 31, 78, 90, 251, 92, 216, 246, 210, 141, 213,
-			155, 133, 155, 175, 124, 17, 54, 56, 248, 71, 97, 43, 190, 126,
-			164, 145, 185, 126, 211, 235, 69, 238, 99, 94, 196, 207, 17, 183,
-			0, 107, 196, 69, 142, 13, 15, 156, 172, 41, 86, 142, 56, 155,
-			220, 162, 236, 139, 43, 192, 196, 114, 199, 245, 217, 203, 0, 116,
-			92, 127, 185, 29, 178, 151, 217, 218, 23, 88, 59, 92, 118, 236,
-			67, 246, 50, 187, 241, 197, 155, 197, 181, 155, 12, 214, 200, 50,
-			108, 174, 236, 101, 177, 66, 197, 78, 155, 78, 220, 189, 159, 164,
-			74, 96, 234, 239, 231, 39, 82, 137, 187, 247, 205, 105, 250, 45,
-			93, 101, 238, 66, 205, 180, 254, 151, 166, 4, 49, 16, 220, 216,
-			82, 46, 131, 209, 77, 42, 184, 73, 203, 139, 246, 5, 166, 86,
-			83, 196, 60, 30, 69, 98, 193, 4, 62, 79, 176, 133, 3, 177,
-			150, 176, 70, 155, 173, 80, 246, 117, 169, 135, 175, 179, 3, 151,
-			123, 14, 218, 128, 205, 186, 65, 228, 198, 238, 99, 60, 226, 249,
-			188, 101, 227, 243, 215, 145, 33, 217, 81, 24, 186, 114, 3, 17,
-			178, 146, 34, 24, 132, 172, 19, 132, 188, 192, 108, 230, 7, 254,
-			210, 7, 60, 12, 228, 167, 11, 149, 102, 29, 192, 38, 142, 214,
-			176, 18, 212, 244, 224, 160, 10, 241, 35, 152, 23, 118, 31, 228,
-			243, 168, 137, 188, 246, 218, 107, 5, 249, 79, 152, 71, 170, 33,
-			101, 26, 233, 36, 104, 56, 240, 217, 38, 204, 159, 74, 37, 65,
-			195, 212, 135, 133, 255, 59, 69, 231, 143, 166, 182, 120, 167, 27,
-			31, 158, 148, 215, 26, 161, 217, 50, 188, 95, 255, 112, 120, 142,
-			138, 226, 91, 149, 160, 42, 62, 103, 130, 10, 73, 126, 170, 236,
-			212, 191, 156, 20, 217, 169, 181, 201, 207, 179, 83, 159, 103, 167,
-			62, 207, 78, 125, 158, 157, 250, 60, 59, 245, 121, 118, 234, 47,
-			48, 59, 85, 86, 137, 40, 120, 84, 217, 169, 36, 17, 245, 98,
-			146, 136, 122, 41, 115, 93, 37, 162, 224, 81, 101, 167, 146, 68,
-			212, 149, 36, 17, 117, 181, 159, 136, 130, 71, 149, 157, 74, 210,
-			96, 240, 248, 51, 13, 179, 83, 250, 90, 102, 210, 250, 137, 198,
-			74, 172, 197, 125, 30, 186, 77, 134, 59, 168, 42, 168, 16, 91,
-			192, 97, 208, 195, 4, 76, 200, 151, 96, 163, 137, 3, 102, 63,
-			14, 92, 135, 57, 252, 192, 245, 209, 253, 245, 186, 158, 168, 205,
-			161, 131, 227, 209, 253, 30, 6, 189, 144, 149, 118, 43, 81, 145,
-			149, 224, 28, 227, 54, 109, 79, 5, 255, 88, 148, 19, 136, 253,
-			43, 86, 81, 140, 252, 14, 135, 105, 38, 1, 71, 221, 192, 7,
-			202, 112, 8, 194, 36, 21, 224, 131, 205, 167, 29, 200, 24, 203,
-			245, 163, 216, 246, 155, 92, 237, 70, 242, 187, 62, 187, 27, 4,
-			253, 179, 101, 216, 109, 178, 117, 59, 92, 60, 18, 107, 20, 49,
-			212, 184, 38, 11, 113, 34, 118, 194, 251, 254, 73, 51, 9, 221,
-			215, 242, 167, 146, 8, 232, 119, 246, 104, 245, 51, 40, 144, 19,
-			207, 131, 197, 21, 214, 103, 91, 121, 247, 89, 160, 75, 149, 128,
-			88, 159, 240, 77, 211, 122, 86, 96, 184, 208, 160, 217, 114, 24,
-			6, 161, 57, 79, 71, 91, 97, 183, 249, 16, 98, 5, 252, 188,
-			153, 173, 229, 161, 97, 35, 112, 184, 121, 158, 142, 198, 161, 237,
-			71, 46, 247, 69, 153, 95, 190, 214, 111, 48, 39, 169, 222, 137,
-			90, 88, 15, 50, 90, 131, 199, 133, 127, 76, 232, 153, 26, 111,
-			185, 81, 204, 67, 81, 101, 86, 19, 70, 245, 140, 194, 148, 147,
-			234, 8, 143, 21, 33, 234, 67, 138, 16, 77, 106, 56, 60, 106,
-			98, 5, 225, 120, 13, 159, 135, 20, 32, 102, 135, 20, 32, 46,
-			124, 139, 208, 217, 163, 188, 10, 131, 151, 181, 50, 36, 169, 149,
-			249, 2, 205, 162, 30, 145, 195, 177, 181, 11, 69, 161, 179, 226,
-			240, 154, 186, 154, 232, 108, 94, 166, 89, 14, 34, 70, 198, 199,
-			214, 78, 169, 81, 40, 247, 154, 120, 183, 240, 101, 58, 181, 29,
-			216, 206, 243, 10, 235, 104, 21, 143, 154, 191, 142, 186, 193, 231,
-			133, 223, 39, 212, 76, 227, 148, 147, 74, 38, 65, 62, 205, 36,
-			20, 1, 45, 37, 224, 235, 84, 183, 91, 92, 78, 235, 220, 209,
-			35, 68, 81, 29, 247, 106, 208, 203, 188, 68, 199, 85, 1, 193,
-			195, 71, 252, 80, 106, 106, 76, 181, 189, 205, 15, 23, 126, 149,
-			208, 217, 134, 208, 77, 204, 127, 89, 73, 244, 205, 72, 255, 132,
-			114, 84, 99, 152, 53, 252, 107, 141, 206, 200, 138, 156, 95, 150,
-			131, 43, 116, 194, 11, 90, 15, 185, 31, 135, 135, 15, 241, 83,
-			129, 170, 124, 245, 130, 86, 25, 90, 55, 160, 241, 57, 57, 50,
-			103, 148, 249, 100, 69, 13, 22, 2, 230, 11, 148, 138, 10, 138,
-			135, 189, 208, 155, 163, 248, 106, 84, 180, 236, 133, 158, 121, 145,
-			142, 201, 215, 145, 251, 1, 159, 27, 67, 196, 114, 68, 221, 253,
-			128, 195, 114, 71, 154, 56, 124, 70, 148, 125, 97, 3, 140, 126,
-			129, 82, 241, 18, 7, 159, 193, 193, 162, 59, 140, 125, 203, 200,
-			95, 152, 188, 248, 150, 145, 191, 56, 201, 106, 121, 56, 139, 1,
-			146, 218, 40, 62, 193, 136, 133, 255, 161, 209, 241, 117, 59, 110,
-			182, 149, 240, 10, 84, 15, 249, 55, 230, 8, 211, 23, 199, 214,
-			44, 101, 113, 233, 46, 69, 20, 76, 13, 186, 89, 31, 105, 52,
-			139, 160, 185, 69, 39, 66, 185, 52, 31, 10, 230, 165, 213, 190,
-			160, 112, 12, 245, 50, 91, 153, 218, 233, 112, 224, 133, 249, 6,
-			29, 243, 2, 219, 81, 88, 52, 105, 179, 18, 203, 177, 165, 183,
-			149, 169, 81, 47, 105, 52, 223, 166, 147, 177, 50, 76, 133, 66,
-			31, 92, 62, 195, 13, 119, 43, 83, 155, 136, 7, 223, 152, 101,
-			122, 90, 214, 59, 41, 84, 6, 162, 58, 175, 80, 13, 179, 191,
-			173, 76, 237, 148, 157, 110, 95, 31, 161, 89, 76, 86, 44, 252,
-			61, 141, 158, 146, 162, 148, 75, 124, 153, 26, 176, 105, 75, 121,
-			207, 31, 145, 183, 232, 36, 5, 142, 29, 173, 63, 33, 74, 226,
-			51, 52, 43, 108, 81, 108, 1, 2, 48, 47, 81, 157, 135, 161,
-			148, 218, 160, 3, 219, 202, 212, 224, 157, 89, 57, 174, 170, 35,
-			18, 26, 238, 100, 135, 232, 234, 246, 160, 174, 132, 116, 172, 97,
-			186, 74, 80, 164, 148, 213, 23, 12, 167, 227, 219, 220, 142, 184,
-			178, 194, 121, 58, 218, 177, 159, 62, 196, 189, 83, 86, 240, 228,
-			59, 246, 211, 6, 192, 230, 171, 148, 122, 208, 249, 97, 236, 118,
-			120, 98, 31, 39, 250, 180, 81, 236, 220, 112, 59, 124, 225, 22,
-			61, 37, 201, 72, 241, 95, 163, 89, 69, 3, 228, 63, 125, 68,
-			175, 64, 175, 38, 122, 192, 216, 77, 172, 104, 82, 60, 62, 255,
-			216, 181, 255, 169, 211, 124, 93, 198, 4, 102, 149, 158, 30, 148,
-			175, 249, 236, 37, 98, 125, 130, 90, 204, 13, 74, 251, 146, 54,
-			79, 94, 41, 214, 51, 20, 3, 70, 113, 100, 93, 152, 159, 176,
-			96, 172, 217, 99, 50, 199, 240, 207, 44, 211, 83, 3, 235, 194,
-			124, 230, 114, 57, 17, 205, 23, 104, 22, 151, 129, 57, 51, 204,
-			11, 89, 103, 134, 174, 21, 243, 77, 58, 133, 42, 78, 105, 33,
-			234, 99, 72, 27, 89, 31, 195, 160, 77, 108, 80, 83, 40, 122,
-			0, 69, 210, 121, 192, 8, 78, 98, 254, 51, 45, 179, 253, 239,
-			119, 232, 136, 153, 53, 50, 127, 70, 254, 210, 214, 217, 142, 82,
-			77, 207, 152, 58, 205, 188, 139, 143, 196, 212, 199, 228, 163, 102,
-			234, 227, 153, 69, 124, 212, 77, 253, 84, 230, 37, 250, 167, 68,
-			148, 11, 204, 100, 230, 137, 245, 11, 194, 208, 183, 137, 143, 225,
-			114, 159, 151, 121, 109, 155, 181, 106, 187, 27, 12, 119, 97, 188,
-			86, 80, 109, 148, 111, 137, 218, 81, 241, 177, 60, 98, 109, 238,
-			117, 121, 200, 14, 122, 190, 204, 153, 247, 147, 63, 7, 61, 15,
-			115, 206, 226, 188, 103, 123, 222, 97, 145, 221, 183, 15, 247, 57,
-			101, 24, 79, 119, 61, 219, 151, 37, 184, 112, 206, 27, 232, 203,
-			186, 158, 221, 228, 44, 234, 53, 219, 248, 61, 99, 121, 25, 226,
-			243, 5, 230, 30, 192, 225, 13, 63, 46, 245, 186, 108, 159, 187,
-			126, 139, 74, 90, 169, 50, 224, 153, 252, 41, 90, 80, 95, 57,
-			102, 181, 89, 235, 34, 106, 22, 39, 131, 122, 74, 238, 97, 168,
-			169, 245, 191, 26, 204, 14, 124, 53, 152, 205, 79, 165, 190, 26,
-			204, 206, 156, 161, 119, 212, 71, 131, 57, 237, 140, 181, 202, 26,
-			234, 100, 128, 199, 203, 176, 135, 223, 30, 99, 89, 93, 107, 179,
-			228, 224, 112, 132, 18, 201, 2, 130, 92, 42, 223, 61, 55, 50,
-			153, 202, 119, 207, 77, 207, 208, 98, 255, 154, 194, 148, 117, 137,
-			149, 124, 22, 116, 229, 199, 173, 84, 133, 172, 186, 143, 48, 120,
-			189, 32, 93, 181, 107, 141, 142, 167, 175, 23, 76, 76, 210, 95,
-			161, 154, 65, 76, 227, 98, 166, 72, 172, 46, 27, 234, 51, 213,
-			21, 150, 136, 139, 148, 164, 237, 121, 60, 92, 138, 122, 221, 174,
-			231, 114, 71, 100, 165, 229, 151, 3, 122, 4, 195, 192, 125, 13,
-			117, 56, 86, 107, 85, 106, 9, 102, 124, 49, 255, 2, 93, 164,
-			134, 65, 64, 75, 151, 180, 51, 214, 252, 241, 43, 45, 210, 30,
-			229, 236, 8, 106, 232, 146, 156, 29, 65, 13, 93, 146, 5, 217,
-			4, 53, 116, 105, 122, 134, 94, 69, 156, 196, 212, 47, 107, 211,
-			150, 245, 140, 107, 50, 98, 24, 168, 226, 178, 84, 58, 65, 85,
-			92, 150, 55, 70, 8, 170, 226, 242, 148, 73, 95, 71, 148, 154,
-			169, 95, 209, 230, 45, 113, 227, 229, 216, 221, 30, 249, 225, 115,
-			248, 21, 31, 130, 122, 185, 146, 112, 14, 236, 93, 145, 87, 124,
-			8, 234, 229, 202, 57, 139, 190, 141, 100, 116, 83, 95, 212, 166,
-			172, 59, 242, 98, 77, 232, 218, 158, 251, 1, 119, 88, 114, 88,
-			217, 148, 69, 242, 34, 161, 42, 184, 56, 137, 172, 158, 5, 108,
-			106, 118, 224, 23, 22, 243, 227, 10, 2, 74, 19, 147, 244, 247,
-			8, 210, 53, 76, 125, 73, 155, 183, 254, 62, 73, 221, 136, 73,
-			223, 124, 193, 240, 8, 75, 159, 239, 220, 102, 43, 226, 163, 241,
-			224, 75, 246, 196, 245, 60, 88, 253, 17, 143, 147, 236, 180, 8,
-			120, 146, 114, 36, 180, 174, 130, 72, 235, 168, 11, 62, 62, 151,
-			21, 232, 182, 207, 108, 71, 228, 190, 109, 143, 42, 236, 56, 178,
-			182, 187, 145, 76, 202, 200, 2, 171, 106, 82, 6, 49, 245, 165,
-			188, 146, 165, 161, 155, 250, 210, 57, 139, 222, 161, 154, 161, 153,
-			198, 106, 230, 53, 98, 173, 201, 194, 116, 153, 219, 81, 25, 39,
-			245, 229, 235, 136, 249, 10, 74, 96, 165, 160, 165, 213, 252, 5,
-			250, 5, 106, 24, 26, 88, 233, 13, 109, 210, 186, 138, 200, 210,
-			54, 62, 172, 210, 95, 242, 170, 161, 197, 222, 144, 122, 215, 208,
-			98, 111, 200, 242, 126, 13, 45, 246, 198, 233, 9, 122, 19, 241,
-			19, 83, 191, 169, 93, 182, 22, 165, 248, 237, 56, 185, 179, 32,
-			165, 198, 157, 97, 4, 72, 14, 198, 205, 41, 8, 176, 156, 187,
-			160, 32, 221, 212, 111, 94, 90, 160, 215, 145, 128, 102, 234, 175,
-			106, 166, 117, 161, 239, 240, 1, 57, 58, 166, 68, 56, 9, 90,
-			45, 7, 189, 71, 20, 68, 76, 253, 85, 249, 69, 78, 67, 123,
-			125, 117, 114, 138, 110, 81, 16, 183, 241, 70, 102, 147, 88, 111,
-			176, 99, 225, 18, 131, 64, 85, 16, 105, 246, 194, 16, 188, 96,
-			50, 45, 251, 248, 5, 14, 48, 206, 55, 242, 231, 208, 39, 232,
-			32, 237, 59, 207, 227, 19, 116, 148, 240, 29, 41, 97, 29, 37,
-			124, 71, 250, 4, 29, 37, 124, 103, 122, 6, 53, 168, 195, 36,
-			222, 76, 52, 56, 136, 211, 142, 219, 71, 116, 154, 224, 7, 7,
-			241, 102, 130, 31, 4, 252, 166, 212, 160, 142, 2, 126, 243, 244,
-			4, 189, 133, 248, 53, 83, 223, 208, 38, 173, 37, 188, 30, 16,
-			246, 120, 65, 124, 233, 116, 248, 209, 155, 0, 78, 178, 130, 19,
-			42, 224, 31, 54, 228, 142, 160, 163, 188, 55, 70, 20, 21, 144,
-			247, 6, 82, 209, 12, 195, 52, 238, 101, 246, 136, 244, 66, 39,
-			218, 116, 95, 25, 41, 123, 134, 149, 114, 47, 111, 161, 189, 25,
-			32, 225, 202, 167, 182, 55, 67, 203, 228, 96, 220, 156, 130, 136,
-			169, 87, 164, 189, 25, 40, 238, 202, 165, 5, 250, 91, 4, 41,
-			16, 83, 191, 175, 77, 89, 223, 38, 32, 144, 4, 105, 65, 110,
-			44, 137, 99, 27, 46, 152, 161, 94, 150, 38, 97, 87, 191, 167,
-			240, 58, 253, 219, 23, 11, 3, 41, 180, 5, 249, 65, 219, 245,
-			217, 2, 78, 114, 33, 153, 9, 40, 246, 190, 52, 113, 3, 21,
-			123, 95, 250, 70, 3, 21, 123, 127, 98, 146, 94, 195, 137, 104,
-			166, 190, 171, 93, 182, 206, 139, 187, 33, 45, 126, 226, 122, 55,
-			112, 221, 236, 106, 150, 130, 136, 169, 239, 206, 43, 241, 128, 30,
-			119, 47, 45, 208, 239, 11, 241, 232, 166, 222, 208, 206, 89, 127,
-			151, 124, 198, 87, 78, 18, 76, 137, 183, 253, 37, 175, 156, 24,
-			184, 125, 52, 18, 17, 193, 10, 109, 228, 103, 20, 4, 252, 159,
-			157, 163, 33, 5, 135, 108, 60, 200, 52, 137, 117, 192, 134, 31,
-			117, 62, 85, 56, 113, 4, 197, 73, 49, 68, 150, 152, 250, 131,
-			252, 5, 244, 23, 89, 176, 230, 175, 61, 143, 191, 200, 162, 191,
-			248, 154, 92, 207, 89, 52, 224, 175, 73, 127, 145, 69, 3, 254,
-			154, 244, 23, 89, 80, 222, 123, 159, 214, 95, 100, 209, 172, 222,
-			75, 240, 131, 89, 189, 39, 253, 69, 22, 205, 234, 189, 211, 19,
-			24, 163, 100, 193, 172, 30, 62, 71, 140, 146, 69, 231, 240, 80,
-			170, 33, 139, 70, 245, 80, 198, 40, 89, 52, 170, 135, 83, 38,
-			93, 65, 148, 186, 169, 239, 107, 243, 214, 101, 68, 121, 100, 119,
-			30, 184, 105, 149, 224, 6, 21, 239, 39, 184, 65, 197, 251, 114,
-			51, 205, 162, 138, 247, 207, 89, 52, 160, 154, 145, 51, 179, 173,
-			204, 183, 8, 177, 246, 217, 176, 83, 232, 167, 208, 240, 224, 120,
-			122, 146, 126, 115, 196, 212, 91, 249, 243, 168, 223, 28, 232, 215,
-			125, 30, 253, 230, 80, 191, 174, 148, 127, 14, 245, 235, 74, 253,
-			230, 80, 191, 238, 244, 12, 189, 139, 56, 137, 169, 63, 210, 38,
-			173, 215, 158, 107, 71, 23, 39, 181, 39, 118, 116, 244, 206, 109,
-			14, 53, 254, 40, 161, 8, 26, 127, 36, 53, 158, 67, 141, 63,
-			58, 61, 65, 223, 64, 138, 154, 169, 119, 180, 243, 214, 178, 184,
-			150, 152, 212, 183, 1, 21, 238, 199, 161, 203, 35, 73, 134, 135,
-			252, 56, 29, 48, 131, 142, 84, 85, 14, 205, 160, 147, 63, 171,
-			32, 221, 212, 59, 214, 60, 158, 79, 114, 0, 4, 218, 60, 156,
-			79, 218, 156, 181, 221, 86, 91, 108, 199, 173, 193, 139, 206, 39,
-			207, 8, 140, 34, 72, 40, 129, 81, 4, 210, 40, 114, 104, 20,
-			193, 57, 139, 254, 87, 130, 164, 12, 83, 239, 105, 211, 214, 127,
-			64, 39, 239, 7, 177, 248, 44, 135, 62, 39, 228, 2, 187, 223,
-			119, 73, 201, 89, 18, 79, 145, 194, 53, 227, 177, 26, 15, 129,
-			178, 58, 80, 126, 12, 180, 125, 25, 156, 4, 77, 140, 31, 28,
-			182, 136, 39, 62, 172, 96, 226, 226, 67, 26, 214, 14, 198, 1,
-			67, 67, 59, 132, 33, 162, 170, 73, 76, 13, 111, 96, 183, 131,
-			39, 112, 88, 148, 225, 104, 90, 205, 71, 236, 48, 185, 250, 238,
-			5, 173, 40, 17, 5, 4, 155, 189, 68, 185, 176, 133, 246, 228,
-			173, 195, 28, 6, 155, 189, 41, 147, 126, 9, 37, 145, 53, 245,
-			167, 218, 57, 25, 109, 74, 145, 178, 189, 218, 246, 208, 11, 229,
-			106, 195, 179, 99, 59, 33, 149, 69, 12, 138, 20, 248, 183, 167,
-			163, 211, 10, 210, 77, 253, 233, 236, 28, 94, 55, 205, 193, 38,
-			243, 129, 118, 78, 94, 55, 141, 220, 15, 134, 236, 72, 39, 208,
-			200, 101, 97, 168, 210, 44, 172, 177, 15, 242, 138, 70, 78, 55,
-			245, 15, 102, 231, 164, 13, 141, 152, 250, 135, 218, 156, 180, 161,
-			79, 154, 142, 48, 168, 1, 74, 35, 89, 64, 160, 102, 51, 66,
-			76, 253, 67, 121, 127, 52, 167, 141, 232, 166, 254, 225, 153, 179,
-			244, 21, 164, 148, 55, 245, 111, 106, 115, 214, 203, 207, 158, 205,
-			16, 18, 249, 44, 140, 84, 147, 201, 19, 83, 255, 102, 94, 145,
-			200, 235, 166, 254, 205, 51, 103, 233, 24, 184, 18, 106, 26, 191,
-			74, 180, 203, 116, 28, 232, 209, 12, 64, 99, 103, 36, 68, 0,
-			58, 187, 32, 58, 142, 154, 198, 95, 35, 154, 41, 94, 141, 102,
-			0, 26, 59, 69, 79, 1, 198, 209, 12, 25, 4, 53, 9, 138,
-			190, 248, 114, 98, 74, 190, 36, 71, 64, 77, 130, 95, 165, 154,
-			49, 98, 230, 126, 141, 100, 190, 67, 136, 181, 205, 210, 25, 57,
-			145, 50, 216, 199, 61, 61, 56, 192, 197, 240, 216, 117, 122, 253,
-			141, 28, 215, 72, 199, 126, 36, 126, 136, 97, 208, 152, 139, 20,
-			39, 48, 66, 76, 227, 215, 72, 126, 6, 99, 188, 17, 61, 99,
-			230, 126, 157, 104, 223, 38, 186, 245, 18, 10, 183, 25, 120, 94,
-			63, 191, 131, 164, 184, 147, 160, 47, 82, 100, 119, 68, 135, 153,
-			254, 58, 161, 19, 116, 146, 230, 0, 204, 103, 204, 220, 111, 16,
-			227, 111, 147, 44, 157, 160, 35, 162, 133, 152, 198, 111, 64, 151,
-			211, 162, 139, 150, 49, 141, 191, 78, 114, 43, 170, 3, 196, 137,
-			208, 48, 223, 111, 32, 208, 112, 254, 122, 191, 65, 135, 134, 226,
-			114, 130, 130, 152, 198, 223, 32, 185, 197, 164, 3, 201, 97, 195,
-			217, 126, 3, 246, 152, 187, 220, 111, 208, 161, 225, 202, 213, 4,
-			133, 102, 26, 127, 147, 228, 214, 146, 14, 90, 14, 27, 206, 247,
-			27, 8, 52, 188, 176, 212, 111, 208, 161, 97, 101, 53, 65, 161,
-			155, 198, 223, 34, 185, 98, 210, 65, 207, 97, 131, 213, 111, 32,
-			208, 48, 127, 173, 223, 128, 67, 10, 75, 104, 9, 35, 32, 137,
-			191, 67, 180, 115, 66, 150, 90, 198, 64, 144, 42, 48, 7, 224,
-			216, 164, 2, 9, 128, 83, 51, 10, 212, 1, 60, 59, 135, 199,
-			215, 188, 153, 251, 77, 146, 249, 61, 66, 172, 21, 54, 144, 163,
-			21, 134, 210, 175, 85, 8, 152, 61, 96, 72, 210, 22, 242, 196,
-			52, 126, 147, 228, 207, 208, 159, 107, 212, 48, 242, 96, 12, 223,
-			37, 218, 63, 34, 186, 245, 145, 246, 124, 214, 192, 202, 118, 179,
-			141, 59, 211, 33, 107, 6, 161, 160, 232, 68, 202, 252, 6, 140,
-			87, 244, 82, 213, 101, 170, 106, 11, 203, 147, 29, 254, 20, 145,
-			97, 15, 81, 50, 46, 18, 133, 118, 183, 203, 237, 144, 5, 61,
-			140, 25, 130, 208, 225, 97, 130, 193, 13, 251, 33, 43, 96, 224,
-			81, 145, 213, 131, 14, 167, 201, 196, 37, 14, 47, 10, 32, 116,
-			197, 98, 48, 191, 85, 16, 9, 56, 136, 119, 177, 212, 140, 227,
-			197, 34, 8, 111, 97, 111, 146, 85, 112, 208, 161, 67, 153, 211,
-			67, 225, 53, 3, 63, 138, 67, 219, 245, 227, 136, 45, 242, 98,
-			171, 88, 64, 39, 84, 192, 82, 222, 107, 114, 81, 228, 113, 81,
-			124, 23, 44, 254, 75, 52, 7, 32, 232, 249, 183, 137, 49, 35,
-			119, 115, 225, 158, 228, 46, 51, 32, 153, 126, 182, 17, 4, 80,
-			164, 104, 55, 136, 32, 139, 24, 70, 251, 13, 4, 26, 232, 68,
-			191, 65, 135, 6, 115, 26, 23, 98, 94, 44, 196, 239, 17, 227,
-			31, 202, 133, 152, 151, 11, 241, 123, 106, 33, 230, 197, 42, 250,
-			62, 201, 205, 36, 56, 96, 21, 125, 159, 228, 198, 250, 13, 216,
-			99, 188, 79, 5, 86, 209, 247, 129, 138, 66, 161, 153, 198, 15,
-			72, 110, 53, 233, 0, 171, 232, 7, 106, 21, 229, 229, 42, 250,
-			1, 121, 161, 208, 111, 208, 161, 97, 121, 37, 65, 161, 155, 198,
-			239, 146, 220, 181, 164, 3, 172, 162, 223, 37, 185, 185, 126, 3,
-			129, 134, 115, 47, 246, 27, 112, 200, 213, 69, 92, 69, 121, 144,
-			238, 15, 137, 102, 9, 225, 227, 42, 250, 161, 90, 69, 121, 92,
-			69, 63, 84, 171, 40, 143, 114, 251, 33, 153, 58, 163, 64, 29,
-			192, 185, 115, 116, 156, 106, 198, 168, 153, 251, 17, 201, 252, 19,
-			66, 112, 77, 128, 155, 254, 17, 193, 51, 140, 97, 140, 2, 145,
-			31, 19, 109, 22, 199, 141, 162, 62, 126, 76, 180, 17, 5, 18,
-			0, 243, 83, 10, 212, 1, 156, 57, 35, 135, 18, 211, 248, 136,
-			104, 47, 203, 151, 32, 228, 143, 20, 187, 163, 40, 226, 143, 200,
-			252, 75, 10, 212, 1, 92, 188, 134, 12, 81, 51, 247, 19, 146,
-			249, 103, 146, 33, 216, 126, 126, 2, 139, 244, 29, 106, 24, 20,
-			24, 250, 41, 209, 46, 89, 91, 248, 3, 15, 162, 78, 30, 63,
-			129, 49, 252, 8, 231, 168, 165, 39, 226, 235, 34, 99, 85, 223,
-			59, 100, 187, 242, 71, 45, 224, 48, 87, 17, 213, 229, 7, 174,
-			231, 97, 64, 7, 28, 80, 148, 223, 79, 149, 252, 40, 202, 239,
-			167, 100, 108, 86, 129, 4, 192, 179, 231, 21, 168, 3, 120, 145,
-			33, 187, 99, 102, 238, 15, 72, 230, 95, 72, 118, 199, 136, 105,
-			252, 1, 176, 251, 87, 168, 97, 140, 1, 187, 31, 3, 187, 187,
-			199, 216, 237, 51, 201, 158, 216, 190, 216, 186, 196, 233, 242, 25,
-			76, 171, 159, 163, 144, 108, 143, 33, 219, 31, 43, 182, 199, 144,
-			237, 143, 21, 219, 99, 200, 246, 199, 138, 237, 49, 100, 251, 99,
-			96, 59, 162, 90, 46, 99, 230, 126, 70, 50, 127, 70, 136, 197,
-			153, 250, 114, 152, 156, 52, 208, 127, 110, 7, 173, 205, 160, 53,
-			16, 16, 38, 17, 32, 134, 143, 88, 117, 125, 96, 195, 64, 112,
-			74, 84, 141, 232, 134, 1, 254, 34, 18, 222, 97, 73, 126, 27,
-			106, 140, 234, 57, 96, 232, 103, 36, 63, 73, 119, 169, 145, 67,
-			7, 241, 115, 162, 221, 183, 214, 143, 166, 24, 241, 3, 10, 115,
-			29, 222, 233, 6, 177, 200, 139, 165, 126, 135, 74, 125, 34, 102,
-			65, 151, 203, 234, 38, 20, 64, 78, 56, 136, 159, 195, 226, 150,
-			160, 6, 224, 153, 151, 21, 168, 3, 120, 243, 109, 186, 129, 228,
-			137, 105, 252, 33, 209, 74, 214, 205, 116, 54, 232, 249, 115, 114,
-			2, 39, 65, 44, 185, 73, 5, 106, 0, 78, 45, 40, 80, 7,
-			112, 233, 75, 116, 31, 41, 106, 166, 241, 71, 68, 219, 177, 26,
-			199, 206, 240, 199, 102, 156, 204, 13, 139, 242, 240, 71, 156, 82,
-			167, 211, 84, 222, 153, 38, 27, 136, 32, 9, 211, 250, 35, 146,
-			59, 163, 64, 164, 57, 91, 80, 160, 14, 224, 43, 219, 244, 247,
-			9, 114, 164, 155, 198, 47, 136, 246, 150, 245, 15, 200, 96, 180,
-			255, 9, 12, 133, 188, 25, 132, 206, 128, 68, 174, 70, 201, 41,
-			134, 178, 174, 29, 218, 29, 30, 243, 48, 82, 183, 82, 177, 218,
-			89, 94, 92, 18, 148, 220, 40, 78, 12, 174, 215, 13, 124, 22,
-			245, 240, 151, 180, 14, 122, 234, 103, 161, 104, 130, 49, 153, 29,
-			120, 197, 95, 144, 220, 180, 2, 53, 0, 103, 22, 21, 136, 211,
-			185, 177, 69, 255, 80, 204, 206, 48, 141, 63, 38, 218, 154, 245,
-			83, 34, 118, 28, 97, 216, 17, 199, 131, 100, 112, 208, 143, 27,
-			163, 222, 190, 44, 185, 119, 125, 177, 209, 35, 223, 170, 12, 209,
-			166, 71, 2, 140, 102, 224, 199, 182, 168, 184, 76, 110, 127, 244,
-			143, 171, 242, 168, 42, 139, 253, 121, 63, 70, 64, 77, 209, 84,
-			168, 32, 127, 202, 203, 141, 163, 193, 205, 60, 153, 175, 65, 96,
-			10, 185, 49, 5, 106, 0, 142, 207, 41, 80, 7, 240, 242, 10,
-			253, 158, 152, 111, 214, 52, 254, 35, 209, 238, 88, 223, 33, 253,
-			223, 191, 193, 111, 207, 242, 235, 165, 29, 69, 113, 59, 12, 122,
-			173, 54, 6, 69, 221, 46, 247, 91, 174, 207, 175, 70, 253, 95,
-			193, 17, 223, 170, 139, 244, 25, 63, 12, 243, 164, 205, 253, 228,
-			178, 68, 211, 11, 122, 78, 250, 158, 4, 186, 183, 101, 252, 121,
-			52, 17, 182, 172, 115, 60, 145, 8, 142, 179, 4, 88, 204, 157,
-			85, 160, 6, 224, 220, 139, 10, 212, 1, 92, 126, 131, 254, 88,
-			204, 39, 103, 26, 127, 74, 180, 45, 235, 187, 169, 249, 136, 207,
-			227, 247, 123, 94, 236, 126, 138, 89, 165, 70, 253, 255, 154, 90,
-			142, 0, 183, 57, 165, 155, 156, 6, 224, 185, 43, 10, 212, 1,
-			92, 189, 171, 138, 84, 255, 95, 0, 0, 0, 255, 255, 58, 126,
-			207, 187, 198, 81, 0, 0},
+			24, 137, 129, 195, 93, 190, 124, 70, 14, 1, 18, 248, 46, 118,
+			124, 137, 47, 246, 230, 28, 7, 151, 205, 15, 195, 129, 147, 92,
+			126, 28, 16, 56, 65, 14, 184, 4, 65, 144, 31, 249, 153, 252,
+			200, 175, 0, 7, 228, 71, 130, 247, 186, 170, 231, 131, 212, 199,
+			218, 155, 251, 113, 88, 1, 220, 237, 87, 31, 175, 94, 189, 247,
+			234, 213, 171, 87, 175, 134, 255, 155, 49, 126, 175, 25, 20, 26,
+			173, 48, 104, 187, 221, 118, 33, 8, 155, 203, 94, 183, 225, 46,
+			123, 65, 211, 9, 154, 203, 118, 199, 93, 22, 190, 211, 9, 92,
+			63, 142, 150, 27, 65, 16, 58, 174, 111, 199, 65, 184, 28, 137,
+			240, 161, 219, 16, 209, 242, 195, 213, 229, 40, 182, 99, 81, 232,
+			132, 65, 28, 152, 185, 164, 231, 252, 63, 101, 252, 116, 217, 143,
+			69, 232, 219, 222, 118, 208, 172, 197, 161, 176, 219, 53, 108, 104,
+			94, 226, 39, 168, 237, 253, 135, 34, 140, 220, 192, 159, 101, 192,
+			22, 70, 171, 227, 84, 248, 118, 82, 102, 158, 230, 185, 72, 52,
+			66, 17, 207, 106, 192, 22, 198, 171, 18, 50, 95, 226, 39, 99,
+			17, 182, 93, 223, 246, 238, 187, 190, 35, 30, 207, 234, 192, 22,
+			244, 234, 9, 85, 90, 198, 66, 211, 226, 121, 59, 108, 180, 220,
+			135, 194, 153, 53, 128, 45, 228, 171, 41, 140, 168, 59, 221, 176,
+			41, 156, 217, 44, 213, 72, 104, 253, 205, 175, 108, 253, 202, 188,
+			120, 61, 153, 254, 155, 255, 61, 207, 115, 166, 97, 100, 128, 241,
+			127, 206, 56, 27, 55, 117, 35, 99, 174, 253, 144, 193, 70, 208,
+			57, 12, 221, 102, 43, 134, 181, 149, 213, 207, 67, 189, 37, 96,
+			123, 111, 163, 12, 197, 110, 220, 10, 194, 168, 0, 69, 207, 3,
+			106, 16, 65, 40, 16, 179, 112, 10, 28, 246, 34, 1, 193, 1,
+			196, 45, 55, 130, 40, 232, 134, 13, 1, 141, 192, 17, 224, 70,
+			208, 12, 30, 138, 208, 23, 14, 116, 125, 71, 132, 16, 183, 4,
+			20, 59, 118, 3, 17, 187, 13, 225, 71, 98, 17, 36, 83, 97,
+			173, 176, 194, 33, 110, 217, 49, 52, 108, 31, 246, 5, 28, 4,
+			93, 223, 1, 215, 167, 94, 219, 229, 141, 210, 78, 173, 4, 7,
+			174, 39, 10, 156, 231, 57, 211, 76, 61, 151, 153, 192, 175, 188,
+			169, 231, 51, 247, 249, 40, 215, 242, 99, 201, 231, 123, 92, 51,
+			50, 166, 49, 158, 1, 102, 125, 9, 142, 23, 53, 146, 135, 152,
+			189, 160, 9, 17, 21, 3, 233, 10, 60, 180, 189, 46, 206, 160,
+			221, 238, 250, 110, 195, 142, 133, 3, 113, 192, 65, 49, 178, 192,
+			57, 231, 186, 145, 97, 166, 62, 158, 63, 207, 55, 185, 97, 100,
+			180, 140, 169, 159, 212, 230, 172, 87, 96, 183, 79, 79, 212, 8,
+			164, 59, 251, 221, 3, 144, 58, 5, 7, 65, 40, 185, 69, 227,
+			22, 56, 31, 231, 89, 196, 146, 69, 52, 121, 5, 49, 83, 63,
+			57, 122, 90, 65, 186, 169, 159, 60, 107, 241, 223, 209, 104, 64,
+			102, 234, 167, 181, 105, 235, 91, 26, 212, 7, 230, 112, 37, 130,
+			68, 25, 11, 156, 195, 78, 16, 139, 132, 165, 72, 70, 82, 142,
+			68, 133, 34, 238, 162, 84, 46, 66, 29, 101, 230, 70, 16, 60,
+			176, 15, 23, 33, 114, 253, 6, 118, 112, 35, 80, 202, 132, 205,
+			3, 223, 59, 228, 96, 55, 26, 34, 138, 220, 125, 79, 64, 28,
+			64, 28, 118, 35, 228, 76, 202, 21, 168, 247, 134, 144, 2, 108,
+			160, 160, 29, 216, 63, 76, 91, 17, 35, 31, 218, 158, 235, 32,
+			163, 37, 215, 93, 255, 32, 8, 219, 118, 140, 156, 121, 228, 198,
+			173, 160, 27, 131, 47, 132, 227, 250, 77, 28, 169, 67, 255, 111,
+			9, 216, 232, 105, 53, 184, 62, 135, 125, 17, 63, 18, 194, 7,
+			97, 55, 90, 208, 237, 32, 202, 148, 147, 44, 139, 252, 25, 81,
+			16, 114, 43, 127, 82, 65, 186, 169, 159, 158, 50, 249, 67, 98,
+			164, 102, 234, 150, 54, 103, 185, 9, 253, 138, 34, 71, 60, 78,
+			84, 122, 136, 183, 106, 33, 67, 91, 68, 145, 221, 20, 5, 40,
+			39, 173, 18, 165, 113, 35, 88, 90, 93, 228, 105, 63, 18, 177,
+			235, 121, 18, 129, 235, 55, 83, 10, 181, 44, 14, 172, 40, 212,
+			152, 169, 91, 121, 37, 107, 77, 55, 117, 235, 172, 197, 175, 19,
+			133, 186, 169, 191, 160, 205, 88, 151, 161, 60, 76, 16, 180, 236,
+			8, 246, 145, 7, 202, 126, 164, 232, 245, 44, 246, 202, 41, 136,
+			153, 250, 11, 35, 19, 10, 66, 140, 230, 52, 95, 37, 244, 134,
+			169, 95, 208, 76, 235, 197, 167, 161, 79, 140, 80, 138, 220, 200,
+			98, 31, 133, 220, 96, 166, 126, 97, 228, 132, 130, 116, 83, 191,
+			48, 57, 181, 159, 35, 173, 191, 206, 255, 15, 255, 52, 172, 120,
+			108, 71, 15, 162, 33, 43, 254, 107, 124, 172, 152, 76, 187, 110,
+			71, 15, 204, 89, 62, 210, 9, 131, 247, 69, 35, 150, 54, 91,
+			129, 230, 73, 174, 185, 14, 153, 234, 209, 170, 230, 58, 230, 28,
+			31, 69, 124, 247, 125, 187, 45, 102, 71, 168, 56, 143, 5, 59,
+			118, 91, 152, 51, 60, 27, 10, 219, 107, 207, 230, 169, 34, 1,
+			204, 73, 174, 63, 16, 135, 100, 206, 199, 171, 248, 249, 169, 26,
+			228, 127, 47, 13, 242, 185, 191, 176, 6, 217, 81, 6, 249, 28,
+			179, 190, 12, 125, 98, 67, 154, 108, 64, 238, 195, 215, 187, 162,
+			43, 146, 79, 71, 68, 141, 208, 237, 196, 61, 99, 41, 164, 138,
+			219, 30, 206, 204, 70, 83, 213, 244, 4, 239, 211, 214, 1, 187,
+			60, 221, 111, 151, 79, 89, 175, 16, 235, 80, 222, 106, 85, 75,
+			221, 80, 214, 49, 181, 197, 72, 207, 62, 109, 60, 113, 240, 84,
+			187, 60, 169, 32, 180, 203, 211, 51, 252, 85, 101, 150, 39, 181,
+			73, 235, 26, 141, 215, 178, 163, 22, 148, 55, 143, 26, 18, 52,
+			107, 114, 197, 166, 99, 160, 197, 154, 76, 199, 96, 204, 212, 39,
+			71, 199, 20, 164, 155, 250, 228, 201, 9, 222, 86, 22, 107, 90,
+			59, 99, 125, 141, 198, 64, 213, 255, 18, 49, 110, 39, 157, 29,
+			110, 59, 118, 244, 96, 17, 186, 145, 72, 229, 133, 124, 77, 56,
+			220, 113, 59, 194, 115, 125, 81, 224, 169, 249, 15, 197, 215, 187,
+			110, 40, 28, 98, 118, 113, 227, 173, 97, 67, 53, 157, 18, 134,
+			51, 156, 30, 53, 21, 164, 155, 250, 244, 169, 211, 92, 40, 67,
+			133, 123, 210, 151, 137, 48, 90, 55, 9, 203, 23, 208, 170, 187,
+			126, 63, 223, 175, 246, 182, 165, 78, 40, 14, 220, 212, 218, 166,
+			82, 224, 96, 71, 81, 208, 112, 105, 255, 69, 4, 41, 65, 104,
+			218, 78, 167, 4, 233, 104, 219, 71, 149, 109, 215, 165, 109, 255,
+			19, 166, 108, 219, 156, 54, 105, 253, 91, 6, 245, 202, 102, 101,
+			161, 229, 250, 193, 3, 251, 234, 77, 168, 138, 118, 240, 80, 238,
+			116, 246, 65, 44, 66, 104, 132, 251, 221, 102, 161, 17, 180, 151,
+			95, 91, 187, 126, 227, 198, 43, 200, 155, 62, 157, 123, 32, 14,
+			143, 138, 81, 26, 127, 55, 162, 106, 39, 16, 145, 127, 37, 134,
+			182, 29, 55, 90, 52, 23, 44, 197, 141, 106, 72, 248, 228, 101,
+			44, 82, 11, 228, 187, 136, 98, 84, 186, 168, 219, 17, 225, 129,
+			215, 13, 186, 17, 216, 190, 3, 81, 43, 232, 122, 14, 46, 59,
+			71, 120, 34, 30, 178, 190, 115, 233, 206, 129, 214, 119, 46, 175,
+			52, 197, 208, 77, 125, 238, 228, 68, 106, 125, 255, 243, 75, 252,
+			124, 51, 8, 154, 158, 88, 86, 94, 200, 178, 211, 13, 105, 179,
+			149, 230, 116, 34, 169, 47, 168, 250, 249, 155, 60, 191, 41, 155,
+			160, 81, 141, 68, 35, 240, 157, 136, 140, 170, 94, 85, 32, 218,
+			73, 223, 246, 131, 136, 236, 106, 182, 154, 0, 235, 223, 100, 124,
+			186, 17, 180, 11, 67, 56, 215, 79, 40, 140, 228, 31, 237, 178,
+			175, 172, 202, 22, 205, 192, 179, 253, 38, 249, 246, 170, 241, 114,
+			124, 216, 17, 209, 242, 3, 63, 120, 228, 167, 196, 118, 246, 255,
+			140, 177, 127, 160, 233, 119, 119, 215, 127, 168, 157, 191, 155, 116,
+			222, 149, 61, 10, 239, 8, 207, 123, 11, 219, 215, 177, 235, 155,
+			255, 229, 18, 218, 211, 243, 153, 136, 241, 127, 55, 78, 246, 244,
+			124, 198, 92, 251, 87, 227, 137, 119, 214, 8, 60, 88, 239, 30,
+			28, 136, 48, 130, 37, 72, 80, 93, 137, 192, 177, 99, 27, 92,
+			116, 21, 27, 45, 219, 111, 162, 185, 67, 175, 132, 15, 24, 225,
+			149, 87, 101, 7, 40, 251, 141, 2, 60, 193, 254, 182, 226, 184,
+			19, 221, 92, 94, 118, 196, 67, 225, 5, 29, 17, 70, 138, 33,
+			168, 96, 29, 73, 196, 210, 126, 66, 196, 50, 231, 80, 21, 142,
+			27, 197, 161, 187, 223, 69, 38, 145, 2, 116, 35, 129, 107, 87,
+			218, 111, 44, 217, 119, 125, 59, 60, 36, 186, 162, 69, 90, 19,
+			16, 132, 202, 101, 226, 208, 14, 28, 247, 192, 109, 16, 187, 22,
+			193, 14, 5, 116, 208, 91, 137, 113, 1, 117, 194, 224, 161, 235,
+			8, 167, 183, 236, 14, 2, 207, 11, 30, 161, 111, 133, 226, 117,
+			177, 83, 132, 157, 56, 180, 69, 124, 147, 115, 192, 127, 47, 15,
+			17, 22, 225, 34, 232, 223, 81, 218, 221, 40, 134, 80, 196, 182,
+			92, 222, 246, 62, 174, 171, 134, 226, 24, 7, 63, 136, 221, 6,
+			105, 187, 27, 129, 231, 70, 49, 98, 232, 31, 209, 119, 134, 200,
+			113, 220, 168, 225, 217, 110, 91, 132, 133, 39, 17, 225, 250, 253,
+			188, 80, 68, 116, 194, 192, 233, 54, 68, 143, 14, 222, 35, 228,
+			87, 162, 131, 43, 27, 234, 4, 141, 110, 91, 248, 177, 173, 132,
+			180, 28, 132, 16, 196, 45, 17, 226, 170, 23, 161, 107, 123, 81,
+			143, 213, 40, 24, 196, 201, 161, 159, 250, 116, 82, 59, 194, 165,
+			158, 113, 223, 206, 212, 175, 91, 190, 220, 247, 176, 142, 248, 238,
+			198, 17, 206, 200, 79, 80, 5, 97, 4, 109, 251, 16, 13, 4,
+			153, 249, 56, 64, 159, 61, 8, 113, 235, 15, 145, 136, 54, 58,
+			255, 9, 79, 226, 8, 28, 17, 162, 127, 8, 7, 97, 208, 230,
+			202, 51, 56, 136, 31, 161, 154, 40, 167, 59, 234, 136, 6, 106,
+			16, 116, 66, 23, 21, 43, 116, 227, 88, 248, 137, 22, 69, 232,
+			18, 224, 153, 162, 190, 85, 174, 65, 173, 114, 167, 254, 78, 177,
+			90, 130, 114, 13, 118, 171, 149, 183, 203, 155, 165, 77, 88, 127,
+			23, 234, 91, 37, 216, 168, 236, 190, 91, 45, 223, 221, 170, 195,
+			86, 101, 123, 179, 84, 173, 65, 113, 103, 19, 54, 42, 59, 245,
+			106, 121, 125, 175, 94, 169, 214, 56, 204, 23, 107, 80, 174, 205,
+			83, 77, 113, 231, 93, 40, 125, 121, 183, 90, 170, 213, 160, 82,
+			133, 242, 189, 221, 237, 114, 105, 19, 222, 41, 86, 171, 197, 157,
+			122, 185, 84, 91, 132, 242, 206, 198, 246, 222, 102, 121, 231, 238,
+			34, 172, 239, 213, 97, 167, 82, 231, 176, 93, 190, 87, 174, 151,
+			54, 161, 94, 89, 164, 97, 143, 246, 131, 202, 29, 184, 87, 170,
+			110, 108, 21, 119, 234, 197, 245, 242, 118, 185, 254, 46, 13, 120,
+			167, 92, 223, 193, 193, 238, 84, 170, 28, 138, 176, 91, 172, 214,
+			203, 27, 123, 219, 197, 42, 236, 238, 85, 119, 43, 181, 18, 224,
+			204, 54, 203, 181, 141, 237, 98, 249, 94, 105, 179, 0, 229, 29,
+			216, 169, 64, 233, 237, 210, 78, 29, 106, 91, 197, 237, 237, 193,
+			137, 114, 168, 188, 179, 83, 170, 34, 245, 253, 211, 132, 245, 18,
+			108, 151, 139, 235, 219, 37, 184, 83, 169, 210, 60, 55, 203, 213,
+			210, 70, 29, 39, 212, 251, 218, 40, 111, 150, 118, 234, 197, 237,
+			69, 14, 181, 221, 210, 70, 185, 184, 189, 8, 165, 47, 151, 238,
+			237, 110, 23, 171, 239, 46, 74, 164, 181, 210, 151, 246, 74, 59,
+			245, 114, 113, 27, 54, 139, 247, 138, 119, 75, 53, 88, 120, 22,
+			87, 118, 171, 149, 141, 189, 106, 233, 30, 82, 93, 185, 3, 181,
+			189, 245, 90, 189, 92, 223, 171, 151, 224, 110, 165, 178, 73, 204,
+			174, 149, 170, 111, 151, 55, 74, 181, 215, 97, 187, 130, 236, 191,
+			3, 123, 181, 210, 34, 135, 205, 98, 189, 72, 67, 239, 86, 43,
+			119, 202, 245, 218, 235, 248, 189, 190, 87, 43, 19, 227, 202, 59,
+			245, 82, 181, 186, 183, 91, 47, 87, 118, 174, 194, 86, 229, 157,
+			210, 219, 165, 42, 108, 20, 247, 106, 165, 77, 226, 112, 101, 7,
+			103, 139, 186, 82, 170, 84, 223, 69, 180, 200, 7, 146, 192, 34,
+			188, 179, 85, 170, 111, 149, 170, 200, 84, 226, 86, 17, 217, 80,
+			171, 87, 203, 27, 245, 254, 102, 149, 42, 212, 43, 213, 58, 239,
+			155, 39, 236, 148, 238, 110, 151, 239, 150, 118, 54, 74, 72, 79,
+			5, 209, 188, 83, 174, 149, 174, 66, 177, 90, 174, 97, 131, 50,
+			13, 12, 239, 20, 223, 133, 202, 30, 205, 26, 5, 181, 87, 43,
+			241, 228, 187, 79, 117, 23, 73, 158, 80, 190, 3, 197, 205, 183,
+			203, 72, 185, 108, 189, 91, 169, 213, 202, 82, 93, 136, 109, 27,
+			91, 146, 231, 169, 155, 11, 153, 89, 233, 230, 206, 103, 94, 167,
+			184, 195, 75, 201, 103, 82, 120, 41, 115, 129, 10, 47, 36, 159,
+			73, 225, 139, 153, 45, 229, 16, 227, 103, 82, 248, 82, 102, 145,
+			10, 89, 242, 153, 20, 94, 206, 20, 168, 80, 126, 38, 133, 87,
+			50, 243, 84, 200, 147, 207, 164, 112, 33, 115, 145, 10, 95, 76,
+			62, 127, 119, 50, 241, 178, 15, 50, 17, 179, 190, 61, 9, 69,
+			80, 187, 46, 89, 71, 17, 9, 63, 70, 111, 59, 114, 155, 190,
+			112, 22, 225, 192, 125, 44, 156, 37, 79, 248, 205, 184, 5, 81,
+			199, 246, 209, 202, 196, 110, 91, 244, 154, 11, 7, 253, 48, 176,
+			161, 17, 116, 125, 178, 221, 114, 255, 39, 131, 121, 16, 218, 141,
+			222, 182, 160, 42, 98, 32, 95, 128, 64, 142, 113, 162, 192, 75,
+			44, 31, 148, 201, 211, 193, 211, 117, 71, 248, 142, 72, 16, 218,
+			254, 33, 52, 108, 79, 248, 142, 29, 18, 214, 70, 224, 55, 68,
+			39, 70, 51, 253, 64, 192, 188, 99, 31, 206, 115, 180, 105, 243,
+			237, 192, 143, 91, 243, 10, 77, 40, 60, 25, 155, 129, 186, 219,
+			22, 81, 108, 183, 59, 137, 161, 150, 59, 156, 227, 226, 246, 42,
+			48, 164, 33, 67, 5, 28, 226, 71, 253, 173, 233, 208, 78, 14,
+			176, 221, 99, 21, 146, 224, 166, 103, 30, 219, 65, 83, 30, 132,
+			16, 117, 247, 99, 156, 46, 114, 4, 141, 40, 216, 61, 68, 5,
+			168, 146, 199, 128, 136, 58, 157, 48, 120, 236, 226, 118, 224, 29,
+			194, 181, 165, 213, 149, 197, 149, 149, 21, 56, 20, 118, 24, 161,
+			249, 188, 4, 165, 199, 118, 187, 227, 137, 136, 115, 245, 9, 171,
+			55, 97, 35, 104, 119, 186, 177, 232, 145, 65, 99, 12, 144, 75,
+			251, 94, 39, 18, 93, 39, 160, 205, 183, 32, 55, 233, 180, 1,
+			198, 175, 194, 24, 110, 65, 161, 80, 120, 125, 184, 78, 248, 206,
+			64, 77, 58, 144, 242, 176, 84, 109, 210, 81, 149, 22, 148, 88,
+			111, 225, 246, 146, 66, 75, 201, 88, 10, 126, 125, 168, 19, 41,
+			128, 236, 146, 124, 171, 14, 4, 169, 65, 220, 3, 88, 56, 50,
+			208, 27, 176, 2, 151, 47, 15, 227, 186, 13, 43, 87, 225, 47,
+			39, 221, 142, 161, 238, 218, 45, 88, 125, 253, 72, 173, 28, 250,
+			22, 172, 174, 168, 127, 178, 209, 135, 32, 188, 72, 28, 79, 192,
+			237, 99, 9, 120, 227, 233, 4, 44, 61, 133, 128, 107, 199, 17,
+			208, 39, 254, 181, 158, 248, 123, 242, 34, 249, 247, 192, 107, 61,
+			205, 248, 228, 90, 240, 68, 89, 63, 89, 71, 146, 142, 253, 34,
+			191, 53, 40, 114, 184, 214, 155, 166, 44, 146, 248, 122, 66, 87,
+			93, 36, 27, 122, 29, 142, 104, 65, 175, 207, 32, 159, 7, 116,
+			174, 159, 197, 189, 14, 215, 158, 46, 222, 94, 195, 219, 253, 13,
+			159, 48, 198, 181, 227, 199, 88, 122, 134, 4, 175, 63, 105, 1,
+			59, 118, 44, 208, 162, 22, 240, 63, 142, 240, 232, 136, 1, 187,
+			135, 113, 43, 241, 166, 16, 81, 140, 11, 243, 104, 195, 5, 199,
+			62, 140, 110, 93, 95, 132, 182, 235, 119, 99, 17, 221, 90, 93,
+			185, 58, 184, 204, 224, 86, 58, 218, 194, 80, 85, 225, 78, 24,
+			180, 235, 41, 170, 216, 185, 74, 182, 231, 205, 90, 101, 7, 238,
+			217, 157, 142, 235, 55, 57, 135, 178, 159, 148, 160, 23, 109, 199,
+			232, 164, 247, 209, 143, 167, 48, 52, 141, 194, 71, 53, 115, 146,
+			109, 0, 189, 112, 191, 9, 161, 45, 93, 87, 27, 237, 37, 135,
+			96, 31, 15, 245, 139, 240, 168, 37, 66, 161, 206, 241, 120, 182,
+			16, 40, 56, 233, 61, 71, 221, 3, 60, 232, 207, 71, 243, 176,
+			224, 250, 14, 157, 84, 252, 166, 218, 55, 174, 162, 237, 231, 56,
+			96, 39, 20, 13, 129, 35, 238, 31, 82, 63, 191, 219, 222, 23,
+			97, 223, 22, 35, 207, 62, 189, 93, 38, 2, 241, 24, 247, 55,
+			244, 131, 237, 8, 237, 115, 178, 47, 217, 158, 234, 82, 128, 59,
+			65, 8, 34, 89, 112, 139, 112, 93, 149, 39, 152, 86, 250, 118,
+			172, 168, 119, 242, 230, 233, 220, 221, 1, 70, 33, 43, 230, 175,
+			71, 243, 56, 95, 215, 19, 125, 216, 112, 239, 88, 237, 67, 38,
+			113, 97, 172, 186, 143, 196, 227, 176, 21, 148, 118, 173, 34, 94,
+			196, 51, 132, 149, 67, 219, 109, 132, 131, 120, 159, 23, 237, 106,
+			52, 79, 209, 49, 21, 31, 59, 200, 79, 242, 255, 202, 84, 128,
+			236, 125, 109, 198, 250, 5, 131, 26, 121, 5, 233, 160, 42, 30,
+			211, 231, 22, 20, 224, 30, 158, 180, 240, 106, 6, 117, 123, 233,
+			250, 234, 141, 197, 27, 175, 124, 30, 55, 56, 252, 227, 184, 21,
+			95, 27, 42, 4, 215, 111, 120, 221, 8, 35, 93, 116, 29, 113,
+			19, 177, 70, 34, 137, 177, 209, 129, 19, 111, 90, 112, 235, 115,
+			8, 235, 77, 14, 159, 95, 65, 34, 150, 219, 174, 15, 47, 35,
+			208, 118, 253, 229, 86, 8, 47, 195, 218, 231, 160, 21, 46, 59,
+			246, 33, 188, 12, 215, 63, 127, 163, 176, 118, 3, 112, 141, 44,
+			227, 230, 10, 47, 39, 43, 52, 217, 105, 85, 168, 36, 147, 53,
+			245, 247, 211, 80, 9, 78, 253, 253, 188, 138, 130, 103, 116, 83,
+			127, 223, 156, 230, 223, 212, 85, 228, 46, 212, 76, 235, 127, 107,
+			138, 17, 3, 206, 141, 45, 249, 50, 232, 221, 244, 57, 55, 105,
+			252, 170, 67, 235, 65, 49, 76, 173, 166, 8, 60, 17, 225, 109,
+			16, 114, 211, 23, 41, 182, 112, 192, 215, 74, 180, 209, 134, 21,
+			14, 95, 147, 114, 248, 26, 28, 184, 194, 115, 112, 113, 128, 13,
+			157, 32, 114, 99, 247, 33, 29, 241, 124, 209, 180, 233, 251, 107,
+			68, 144, 108, 152, 40, 186, 50, 3, 17, 145, 210, 55, 96, 16,
+			66, 59, 8, 197, 34, 216, 224, 7, 254, 210, 7, 34, 12, 228,
+			213, 133, 10, 179, 14, 96, 75, 142, 214, 251, 130, 167, 211, 195,
+			120, 30, 250, 143, 168, 94, 212, 124, 144, 206, 97, 21, 121, 237,
+			181, 215, 22, 229, 95, 162, 30, 125, 5, 125, 170, 161, 228, 133,
+			65, 208, 48, 149, 23, 6, 65, 195, 188, 186, 88, 96, 186, 169,
+			135, 125, 23, 11, 255, 119, 138, 207, 13, 135, 182, 68, 187, 19,
+			31, 62, 41, 174, 53, 194, 179, 37, 172, 95, 255, 240, 248, 24,
+			21, 167, 90, 21, 160, 82, 213, 207, 10, 80, 209, 144, 159, 40,
+			58, 245, 47, 39, 147, 232, 212, 218, 228, 103, 209, 169, 207, 162,
+			83, 159, 69, 167, 62, 139, 78, 125, 22, 157, 250, 44, 58, 245,
+			231, 24, 157, 42, 169, 64, 212, 165, 76, 73, 22, 190, 216, 11,
+			68, 189, 152, 6, 162, 94, 202, 92, 83, 129, 40, 252, 84, 209,
+			169, 52, 16, 117, 57, 13, 68, 93, 233, 5, 162, 240, 83, 69,
+			167, 210, 48, 24, 126, 254, 84, 163, 232, 148, 190, 150, 153, 180,
+			126, 172, 65, 17, 154, 194, 23, 161, 219, 0, 218, 65, 85, 66,
+			69, 178, 5, 28, 6, 93, 10, 192, 132, 98, 9, 175, 65, 240,
+			186, 244, 97, 224, 58, 224, 136, 3, 215, 199, 93, 193, 233, 118,
+			60, 60, 64, 96, 52, 102, 160, 63, 153, 223, 195, 160, 27, 66,
+			113, 183, 140, 23, 228, 16, 31, 118, 220, 134, 237, 41, 231, 31,
+			79, 24, 113, 128, 86, 9, 220, 88, 121, 49, 242, 30, 142, 194,
+			76, 9, 28, 117, 2, 31, 71, 198, 67, 16, 250, 127, 62, 226,
+			195, 171, 145, 86, 32, 125, 44, 215, 143, 98, 219, 111, 8, 181,
+			27, 201, 123, 125, 184, 19, 4, 189, 179, 101, 216, 105, 192, 186,
+			29, 46, 12, 249, 26, 5, 114, 53, 174, 202, 68, 156, 8, 158,
+			80, 223, 59, 105, 166, 87, 219, 107, 249, 19, 169, 7, 244, 119,
+			223, 230, 149, 95, 61, 181, 66, 126, 15, 38, 87, 88, 159, 110,
+			230, 157, 245, 233, 166, 128, 88, 207, 184, 211, 180, 158, 230, 24,
+			206, 215, 121, 182, 20, 134, 65, 136, 121, 32, 205, 176, 211, 184,
+			143, 231, 91, 186, 222, 204, 86, 243, 88, 176, 17, 56, 194, 60,
+			199, 71, 227, 208, 246, 35, 87, 248, 73, 154, 95, 190, 218, 43,
+			192, 124, 144, 118, 212, 164, 124, 144, 209, 170, 222, 142, 154, 243,
+			255, 152, 241, 83, 85, 209, 116, 163, 88, 132, 73, 66, 97, 53,
+			81, 170, 167, 36, 166, 60, 41, 143, 240, 72, 18, 162, 126, 76,
+			18, 162, 201, 13, 76, 147, 160, 12, 194, 241, 42, 125, 31, 147,
+			128, 152, 61, 38, 1, 113, 254, 155, 140, 159, 30, 166, 53, 81,
+			120, 153, 43, 195, 210, 92, 153, 207, 241, 44, 221, 87, 19, 11,
+			198, 214, 206, 23, 18, 21, 40, 28, 159, 83, 87, 77, 26, 155,
+			151, 120, 86, 32, 139, 137, 65, 99, 107, 39, 84, 47, 226, 123,
+			53, 169, 155, 255, 18, 159, 218, 14, 108, 231, 121, 153, 53, 156,
+			197, 163, 230, 143, 67, 228, 147, 249, 207, 255, 1, 227, 102, 63,
+			78, 57, 169, 116, 18, 236, 147, 76, 66, 13, 160, 245, 49, 248,
+			26, 215, 237, 166, 144, 211, 58, 171, 252, 102, 165, 102, 5, 117,
+			220, 171, 98, 43, 243, 34, 31, 87, 9, 4, 247, 49, 123, 40,
+			145, 212, 152, 42, 123, 75, 28, 206, 255, 25, 227, 167, 235, 137,
+			108, 98, 241, 203, 114, 162, 167, 70, 250, 51, 210, 81, 141, 227,
+			210, 81, 223, 224, 70, 108, 55, 163, 217, 44, 232, 11, 99, 107,
+			11, 138, 57, 199, 147, 85, 168, 219, 205, 168, 228, 199, 225, 97,
+			149, 122, 89, 175, 240, 209, 180, 72, 165, 73, 33, 155, 71, 41,
+			77, 10, 211, 4, 232, 124, 41, 201, 77, 128, 155, 218, 171, 108,
+			254, 95, 107, 124, 70, 38, 2, 253, 178, 19, 191, 204, 39, 188,
+			160, 121, 95, 32, 57, 247, 233, 134, 66, 37, 220, 122, 65, 147,
+			40, 218, 192, 194, 231, 101, 196, 140, 210, 90, 92, 52, 163, 82,
+			77, 205, 23, 56, 79, 18, 55, 238, 119, 67, 111, 150, 83, 213,
+			104, 82, 178, 23, 122, 230, 5, 62, 38, 171, 35, 247, 3, 49,
+			59, 70, 136, 101, 143, 154, 251, 129, 64, 43, 67, 204, 167, 238,
+			51, 212, 61, 79, 5, 216, 251, 5, 206, 233, 59, 233, 124, 138,
+			58, 143, 82, 9, 246, 125, 211, 200, 159, 159, 188, 240, 166, 145,
+			191, 48, 9, 213, 60, 38, 40, 32, 146, 234, 40, 125, 97, 143,
+			249, 255, 169, 241, 241, 117, 76, 56, 81, 204, 91, 228, 122, 40,
+			190, 62, 203, 72, 150, 150, 146, 101, 127, 147, 2, 49, 166, 138,
+			205, 172, 143, 52, 158, 37, 208, 220, 226, 19, 161, 180, 8, 247,
+			19, 226, 137, 249, 99, 107, 47, 40, 28, 195, 6, 131, 198, 219,
+			202, 84, 79, 170, 126, 73, 133, 249, 6, 31, 243, 2, 219, 81,
+			88, 52, 194, 114, 86, 97, 57, 178, 226, 183, 50, 85, 238, 165,
+			133, 230, 91, 124, 82, 10, 37, 22, 10, 133, 62, 184, 106, 143,
+			87, 204, 173, 76, 117, 34, 237, 41, 145, 149, 248, 73, 153, 102,
+			165, 80, 25, 132, 234, 156, 66, 117, 156, 254, 109, 101, 170, 39,
+			100, 175, 4, 205, 250, 136, 212, 225, 249, 191, 163, 241, 19, 146,
+			149, 210, 178, 44, 115, 3, 125, 5, 201, 239, 185, 33, 126, 39,
+			141, 36, 195, 169, 161, 245, 39, 76, 113, 124, 134, 103, 73, 208,
+			114, 231, 73, 0, 243, 34, 215, 69, 24, 206, 106, 199, 216, 205,
+			173, 76, 21, 235, 204, 242, 81, 81, 13, 113, 104, 88, 84, 9,
+			29, 199, 200, 234, 214, 160, 172, 18, 238, 88, 199, 201, 42, 69,
+			209, 39, 172, 30, 99, 4, 31, 223, 22, 118, 36, 36, 11, 81,
+			231, 219, 246, 227, 251, 180, 101, 203, 196, 161, 124, 219, 126, 140,
+			201, 106, 145, 249, 42, 231, 30, 54, 190, 143, 33, 178, 89, 237,
+			89, 166, 116, 148, 26, 99, 44, 123, 254, 38, 63, 33, 135, 145,
+			236, 191, 202, 179, 106, 12, 212, 247, 233, 33, 185, 226, 120, 213,
+			164, 5, 246, 221, 164, 68, 42, 69, 227, 243, 247, 93, 251, 95,
+			58, 207, 215, 164, 43, 98, 86, 248, 201, 65, 254, 154, 79, 95,
+			34, 214, 51, 196, 98, 110, 112, 222, 227, 180, 249, 228, 149, 98,
+			61, 69, 48, 168, 20, 67, 235, 194, 124, 198, 130, 177, 78, 31,
+			225, 57, 121, 165, 102, 137, 159, 144, 60, 144, 136, 158, 186, 92,
+			158, 136, 230, 115, 60, 75, 203, 192, 156, 25, 90, 21, 73, 183,
+			83, 67, 165, 114, 30, 95, 228, 83, 36, 98, 57, 20, 74, 48,
+			234, 97, 232, 87, 50, 235, 212, 80, 169, 196, 176, 193, 205, 68,
+			208, 3, 40, 210, 198, 3, 74, 240, 36, 226, 63, 213, 236, 222,
+			255, 113, 155, 143, 152, 89, 35, 243, 159, 216, 95, 216, 244, 222,
+			81, 174, 233, 25, 83, 231, 153, 119, 240, 196, 167, 51, 83, 31,
+			147, 159, 154, 169, 143, 103, 22, 232, 83, 55, 245, 19, 153, 151,
+			248, 159, 50, 58, 7, 26, 51, 153, 57, 102, 253, 49, 3, 178,
+			109, 72, 187, 173, 146, 67, 101, 56, 221, 134, 102, 117, 119, 3,
+			104, 23, 198, 216, 206, 78, 165, 94, 186, 153, 164, 172, 98, 52,
+			220, 141, 35, 104, 9, 175, 35, 66, 56, 232, 250, 50, 84, 223,
+			139, 57, 29, 116, 61, 10, 117, 39, 199, 76, 219, 243, 14, 11,
+			112, 207, 62, 196, 88, 54, 185, 241, 29, 207, 246, 101, 230, 47,
+			30, 47, 7, 218, 66, 199, 179, 27, 2, 162, 110, 163, 133, 39,
+			196, 249, 229, 101, 60, 22, 204, 227, 125, 162, 27, 99, 32, 43,
+			130, 110, 7, 246, 5, 222, 162, 201, 177, 250, 178, 143, 103, 242,
+			39, 248, 162, 186, 92, 57, 173, 157, 182, 46, 144, 100, 105, 50,
+			36, 167, 244, 249, 135, 154, 154, 188, 144, 24, 120, 179, 144, 161,
+			55, 11, 83, 10, 194, 188, 214, 153, 83, 252, 182, 186, 171, 152,
+			213, 78, 89, 171, 80, 87, 7, 18, 228, 95, 28, 118, 233, 70,
+			59, 150, 73, 189, 54, 164, 231, 149, 148, 137, 50, 148, 158, 69,
+			4, 42, 127, 31, 195, 236, 179, 35, 42, 159, 25, 195, 236, 179,
+			211, 51, 188, 208, 123, 29, 49, 101, 93, 132, 162, 15, 65, 71,
+			222, 169, 245, 37, 230, 202, 83, 119, 138, 57, 121, 213, 208, 159,
+			44, 108, 141, 142, 43, 8, 95, 53, 76, 76, 242, 95, 227, 154,
+			193, 76, 227, 66, 166, 192, 172, 14, 28, 107, 51, 213, 203, 153,
+			72, 80, 138, 72, 195, 246, 60, 17, 46, 69, 221, 78, 199, 115,
+			133, 3, 232, 255, 168, 188, 112, 62, 132, 97, 224, 153, 136, 92,
+			141, 160, 214, 170, 148, 18, 206, 248, 66, 254, 5, 190, 192, 13,
+			131, 161, 148, 46, 106, 167, 172, 185, 163, 47, 105, 164, 62, 202,
+			217, 49, 202, 3, 191, 40, 51, 143, 25, 229, 129, 95, 28, 157,
+			84, 144, 110, 234, 23, 167, 103, 248, 21, 194, 201, 76, 253, 146,
+			54, 109, 89, 79, 121, 157, 147, 116, 195, 27, 143, 75, 82, 232,
+			140, 30, 170, 92, 146, 15, 85, 24, 165, 125, 95, 154, 50, 249,
+			235, 132, 82, 51, 245, 203, 218, 156, 85, 32, 148, 71, 158, 20,
+			201, 251, 214, 227, 95, 22, 49, 146, 203, 229, 148, 114, 148, 203,
+			101, 249, 178, 136, 145, 92, 46, 159, 181, 248, 91, 52, 140, 110,
+			234, 11, 218, 148, 117, 91, 190, 231, 193, 156, 70, 247, 3, 225,
+			64, 122, 70, 218, 148, 185, 249, 65, 216, 123, 216, 244, 164, 97,
+			245, 44, 98, 83, 179, 67, 187, 176, 144, 31, 87, 16, 142, 52,
+			49, 201, 127, 31, 47, 34, 25, 166, 106, 47, 105, 115, 214, 223,
+			99, 125, 15, 113, 250, 31, 220, 144, 123, 68, 25, 215, 183, 111,
+			193, 74, 114, 87, 61, 88, 9, 143, 240, 165, 205, 62, 105, 77,
+			26, 20, 79, 28, 30, 121, 165, 45, 195, 60, 139, 73, 52, 73,
+			189, 43, 194, 183, 70, 164, 77, 120, 153, 237, 36, 33, 119, 219,
+			227, 10, 59, 130, 80, 221, 221, 72, 39, 133, 175, 95, 150, 210,
+			73, 97, 254, 245, 146, 124, 185, 195, 232, 245, 203, 210, 89, 139,
+			223, 230, 154, 161, 153, 198, 106, 230, 53, 102, 173, 65, 189, 63,
+			164, 36, 151, 76, 250, 174, 97, 72, 125, 147, 145, 48, 220, 131,
+			82, 90, 205, 159, 231, 159, 227, 134, 161, 161, 150, 94, 215, 38,
+			173, 43, 80, 31, 122, 10, 117, 220, 3, 3, 73, 171, 70, 26,
+			123, 93, 202, 93, 35, 141, 189, 46, 95, 21, 104, 100, 83, 174,
+			159, 156, 224, 55, 8, 63, 51, 245, 27, 218, 37, 107, 65, 178,
+			31, 223, 103, 5, 7, 253, 193, 49, 225, 28, 55, 0, 203, 97,
+			191, 89, 5, 33, 150, 179, 231, 21, 164, 155, 250, 141, 139, 243,
+			252, 26, 13, 160, 153, 250, 171, 154, 105, 157, 239, 25, 124, 164,
+			153, 12, 83, 202, 156, 20, 173, 150, 195, 214, 35, 10, 98, 166,
+			254, 170, 188, 8, 212, 72, 95, 95, 157, 156, 226, 91, 28, 217,
+			109, 188, 145, 217, 100, 214, 27, 112, 196, 93, 2, 116, 84, 147,
+			65, 26, 221, 48, 68, 43, 152, 78, 203, 30, 156, 10, 114, 27,
+			149, 243, 141, 252, 89, 178, 9, 58, 114, 251, 246, 243, 216, 4,
+			157, 56, 124, 91, 114, 88, 39, 14, 223, 150, 54, 65, 39, 14,
+			223, 158, 158, 33, 9, 234, 40, 206, 47, 166, 18, 28, 196, 105,
+			199, 173, 33, 153, 166, 248, 209, 64, 124, 49, 197, 143, 6, 226,
+			139, 82, 130, 58, 49, 248, 139, 39, 39, 248, 77, 194, 175, 153,
+			250, 134, 54, 105, 45, 225, 26, 193, 189, 96, 49, 185, 96, 117,
+			196, 240, 3, 4, 245, 186, 38, 221, 17, 116, 178, 15, 27, 114,
+			71, 208, 53, 36, 117, 99, 68, 141, 130, 252, 222, 160, 81, 52,
+			195, 48, 141, 187, 153, 61, 102, 21, 158, 174, 211, 61, 97, 200,
+			149, 131, 28, 198, 149, 114, 55, 111, 145, 190, 25, 200, 225, 242,
+			39, 214, 55, 67, 203, 228, 176, 223, 172, 130, 152, 169, 151, 165,
+			190, 25, 196, 238, 242, 197, 121, 254, 59, 104, 81, 12, 156, 196,
+			61, 109, 202, 250, 22, 67, 134, 164, 72, 23, 33, 30, 52, 108,
+			199, 51, 230, 88, 43, 43, 175, 193, 221, 168, 175, 101, 98, 117,
+			122, 143, 62, 230, 7, 34, 119, 243, 242, 30, 221, 245, 97, 158,
+			180, 111, 62, 157, 9, 10, 246, 158, 84, 113, 131, 86, 206, 61,
+			105, 27, 13, 18, 236, 189, 137, 73, 126, 149, 38, 162, 153, 250,
+			174, 118, 201, 58, 71, 52, 217, 205, 148, 81, 199, 176, 7, 215,
+			205, 174, 102, 41, 136, 153, 250, 238, 156, 98, 15, 202, 113, 247,
+			226, 60, 255, 94, 194, 30, 221, 212, 235, 218, 89, 235, 111, 179,
+			79, 249, 165, 75, 138, 73, 178, 252, 151, 126, 233, 98, 208, 246,
+			81, 79, 89, 132, 43, 180, 158, 159, 81, 16, 210, 127, 102, 150,
+			135, 28, 31, 196, 24, 239, 102, 26, 204, 58, 128, 227, 143, 58,
+			159, 200, 157, 24, 66, 241, 36, 31, 34, 203, 76, 253, 221, 252,
+			121, 178, 23, 89, 212, 230, 175, 62, 143, 189, 200, 146, 189, 248,
+			170, 92, 207, 89, 178, 23, 95, 149, 246, 34, 75, 10, 252, 85,
+			105, 47, 178, 168, 191, 239, 125, 82, 123, 145, 37, 181, 122, 47,
+			197, 143, 106, 245, 158, 180, 23, 89, 82, 171, 247, 78, 78, 144,
+			143, 146, 69, 223, 238, 254, 115, 248, 40, 89, 50, 14, 247, 165,
+			24, 178, 164, 84, 247, 165, 143, 146, 37, 99, 124, 127, 202, 228,
+			43, 132, 82, 55, 245, 125, 109, 206, 186, 4, 245, 163, 187, 179,
+			84, 170, 1, 133, 205, 146, 136, 247, 83, 220, 40, 226, 125, 185,
+			153, 102, 73, 196, 251, 103, 45, 30, 112, 205, 200, 153, 217, 102,
+			230, 155, 140, 89, 251, 234, 245, 224, 47, 43, 225, 193, 254, 252,
+			73, 242, 205, 49, 83, 111, 230, 207, 145, 124, 115, 40, 95, 247,
+			121, 228, 155, 35, 249, 186, 146, 255, 57, 146, 175, 43, 229, 155,
+			35, 249, 186, 211, 51, 252, 14, 225, 100, 166, 254, 64, 155, 180,
+			94, 123, 174, 29, 61, 185, 23, 123, 100, 71, 195, 79, 125, 115,
+			36, 241, 7, 233, 136, 40, 241, 7, 82, 226, 57, 146, 248, 131,
+			147, 19, 252, 13, 26, 81, 51, 245, 182, 118, 206, 90, 134, 250,
+			64, 90, 29, 174, 115, 140, 161, 186, 34, 146, 195, 96, 22, 223,
+			145, 113, 80, 13, 218, 82, 84, 57, 82, 131, 118, 254, 140, 130,
+			116, 83, 111, 91, 115, 116, 62, 201, 161, 78, 4, 218, 28, 158,
+			79, 90, 2, 90, 110, 179, 133, 18, 234, 155, 75, 162, 16, 79,
+			158, 17, 42, 69, 144, 142, 132, 74, 17, 72, 165, 200, 145, 82,
+			4, 103, 45, 254, 223, 208, 138, 229, 208, 109, 236, 106, 211, 214,
+			127, 32, 35, 239, 7, 113, 114, 155, 72, 54, 7, 115, 7, 16,
+			187, 122, 29, 109, 123, 233, 49, 40, 57, 69, 38, 166, 25, 229,
+			143, 19, 15, 64, 38, 37, 202, 59, 72, 219, 151, 206, 73, 208,
+			32, 255, 193, 129, 5, 58, 241, 81, 226, 20, 141, 34, 83, 22,
+			227, 0, 77, 90, 199, 59, 196, 161, 40, 30, 38, 167, 134, 27,
+			67, 212, 10, 30, 225, 97, 81, 186, 163, 253, 98, 30, 210, 67,
+			165, 127, 40, 244, 40, 101, 5, 58, 155, 221, 84, 184, 184, 133,
+			118, 229, 99, 199, 28, 122, 63, 122, 119, 202, 228, 95, 32, 78,
+			100, 77, 253, 177, 118, 86, 122, 155, 146, 165, 176, 87, 221, 62,
+			170, 75, 87, 210, 199, 174, 184, 46, 210, 161, 178, 132, 65, 13,
+			133, 246, 237, 241, 232, 180, 130, 116, 83, 127, 124, 122, 150, 94,
+			185, 230, 112, 147, 249, 64, 59, 43, 95, 185, 98, 28, 250, 185,
+			199, 200, 101, 177, 171, 146, 44, 174, 177, 15, 242, 106, 140, 156,
+			110, 234, 31, 156, 158, 149, 58, 52, 98, 234, 31, 106, 179, 214,
+			234, 115, 77, 39, 81, 168, 129, 145, 70, 178, 136, 64, 205, 102,
+			132, 153, 250, 135, 242, 217, 106, 78, 27, 209, 77, 253, 195, 83,
+			103, 248, 43, 52, 82, 222, 212, 191, 161, 205, 90, 47, 63, 125,
+			54, 199, 12, 145, 207, 98, 79, 53, 25, 188, 59, 255, 70, 94,
+			13, 145, 215, 77, 253, 27, 167, 206, 240, 49, 52, 37, 220, 52,
+			254, 10, 211, 46, 241, 113, 28, 143, 103, 16, 26, 59, 37, 33,
+			134, 208, 153, 249, 164, 225, 168, 105, 252, 85, 166, 153, 73, 195,
+			209, 12, 66, 99, 39, 248, 9, 28, 109, 52, 195, 6, 65, 77,
+			130, 73, 91, 170, 156, 152, 146, 149, 108, 8, 212, 36, 248, 21,
+			174, 25, 35, 102, 238, 215, 89, 230, 219, 140, 89, 219, 208, 31,
+			145, 195, 77, 210, 134, 125, 44, 65, 30, 227, 98, 120, 232, 58,
+			221, 222, 70, 78, 107, 164, 109, 63, 160, 251, 248, 33, 101, 46,
+			112, 154, 192, 8, 51, 141, 95, 103, 249, 25, 242, 241, 70, 244,
+			140, 153, 251, 13, 166, 125, 139, 233, 214, 75, 196, 220, 70, 224,
+			121, 189, 248, 14, 13, 37, 156, 20, 125, 129, 19, 245, 35, 58,
+			206, 244, 55, 24, 159, 224, 147, 60, 103, 140, 232, 153, 124, 198,
+			204, 253, 38, 51, 254, 22, 203, 242, 9, 62, 146, 148, 48, 211,
+			248, 77, 108, 114, 50, 105, 162, 101, 76, 227, 175, 177, 220, 138,
+			106, 128, 126, 34, 22, 204, 245, 10, 24, 182, 56, 119, 173, 87,
+			160, 99, 65, 97, 57, 69, 193, 76, 227, 175, 179, 220, 66, 218,
+			128, 229, 168, 224, 76, 175, 128, 90, 204, 94, 234, 21, 232, 88,
+			112, 249, 74, 138, 66, 51, 141, 191, 193, 114, 107, 105, 3, 45,
+			71, 5, 231, 122, 5, 12, 11, 94, 88, 234, 21, 232, 88, 176,
+			178, 154, 162, 208, 77, 227, 111, 178, 92, 33, 109, 160, 231, 168,
+			192, 234, 21, 48, 44, 152, 187, 218, 43, 160, 46, 139, 75, 164,
+			53, 35, 200, 137, 223, 98, 218, 217, 132, 151, 90, 198, 32, 80,
+			178, 150, 216, 242, 91, 108, 108, 82, 129, 12, 107, 167, 102, 20,
+			168, 35, 120, 6, 151, 160, 102, 228, 205, 220, 111, 179, 204, 239,
+			51, 102, 173, 40, 61, 145, 222, 62, 41, 74, 234, 251, 83, 244,
+			172, 95, 145, 164, 46, 228, 153, 105, 252, 54, 203, 159, 226, 63,
+			195, 95, 44, 201, 163, 50, 124, 135, 105, 255, 136, 233, 214, 71,
+			218, 243, 105, 3, 148, 240, 151, 61, 112, 103, 58, 132, 70, 16,
+			38, 35, 58, 145, 82, 191, 254, 49, 101, 43, 149, 212, 166, 146,
+			197, 40, 217, 26, 227, 6, 248, 218, 130, 90, 36, 153, 234, 73,
+			114, 154, 221, 233, 96, 230, 48, 62, 125, 196, 44, 217, 16, 127,
+			161, 70, 97, 112, 67, 69, 73, 130, 1, 127, 225, 164, 22, 180,
+			5, 79, 15, 61, 18, 135, 23, 5, 24, 60, 165, 23, 146, 126,
+			115, 49, 9, 192, 161, 191, 75, 239, 47, 241, 213, 77, 131, 82,
+			181, 113, 111, 146, 201, 119, 136, 191, 205, 193, 193, 95, 59, 192,
+			71, 28, 62, 6, 40, 48, 149, 2, 22, 68, 161, 89, 192, 223,
+			97, 249, 0, 147, 1, 221, 182, 184, 42, 23, 69, 158, 22, 197,
+			119, 80, 227, 191, 192, 115, 70, 62, 209, 248, 223, 101, 198, 140,
+			220, 205, 137, 72, 181, 203, 12, 112, 38, 141, 205, 16, 139, 10,
+			156, 244, 134, 16, 100, 9, 195, 104, 175, 128, 97, 1, 159, 232,
+			21, 232, 88, 96, 78, 211, 66, 204, 39, 11, 241, 187, 204, 248,
+			135, 114, 33, 230, 229, 66, 252, 174, 90, 136, 136, 149, 153, 198,
+			247, 88, 110, 38, 197, 129, 171, 232, 123, 44, 55, 214, 43, 160,
+			22, 227, 189, 81, 152, 142, 5, 230, 116, 138, 66, 51, 141, 239,
+			179, 220, 106, 218, 0, 87, 209, 247, 213, 42, 202, 203, 85, 244,
+			125, 246, 194, 98, 175, 64, 199, 130, 229, 149, 20, 133, 110, 26,
+			191, 199, 114, 87, 83, 20, 184, 138, 126, 143, 229, 102, 123, 5,
+			12, 11, 206, 190, 216, 43, 160, 46, 87, 22, 104, 21, 229, 145,
+			187, 63, 96, 154, 149, 48, 159, 86, 209, 15, 212, 42, 202, 211,
+			42, 250, 129, 90, 69, 121, 226, 219, 15, 216, 212, 41, 5, 234,
+			88, 59, 123, 150, 143, 115, 205, 24, 53, 115, 63, 100, 153, 127,
+			194, 24, 173, 137, 81, 102, 26, 63, 68, 251, 136, 131, 140, 226,
+			32, 63, 98, 218, 105, 234, 55, 138, 158, 163, 241, 35, 166, 141,
+			40, 144, 97, 109, 126, 74, 213, 234, 8, 206, 156, 146, 93, 153,
+			105, 124, 196, 180, 151, 101, 37, 50, 249, 35, 69, 238, 40, 177,
+			248, 35, 54, 247, 146, 2, 117, 172, 93, 184, 74, 4, 113, 51,
+			247, 99, 150, 249, 103, 146, 32, 206, 76, 227, 199, 184, 72, 223,
+			230, 134, 193, 145, 160, 159, 48, 237, 162, 181, 5, 116, 241, 130,
+			25, 183, 244, 83, 18, 17, 208, 37, 156, 163, 150, 94, 226, 95,
+			23, 0, 42, 190, 119, 136, 121, 203, 184, 168, 208, 27, 130, 178,
+			67, 157, 14, 92, 207, 35, 215, 17, 41, 224, 196, 191, 159, 40,
+			254, 113, 226, 223, 79, 216, 216, 105, 5, 50, 28, 246, 204, 57,
+			5, 234, 8, 94, 0, 34, 119, 204, 204, 253, 33, 203, 252, 11,
+			73, 238, 24, 51, 141, 63, 68, 114, 255, 18, 55, 140, 49, 36,
+			247, 99, 36, 119, 247, 8, 185, 61, 34, 225, 145, 141, 43, 43,
+			14, 228, 233, 242, 41, 68, 171, 95, 193, 144, 100, 143, 17, 217,
+			31, 43, 178, 199, 136, 236, 143, 21, 217, 99, 36, 160, 143, 21,
+			217, 99, 68, 246, 199, 236, 2, 240, 136, 107, 185, 140, 153, 251,
+			41, 195, 27, 31, 75, 128, 186, 57, 76, 79, 26, 100, 63, 183,
+			131, 230, 102, 208, 28, 240, 251, 83, 15, 144, 220, 71, 74, 246,
+			62, 176, 241, 87, 147, 208, 40, 113, 213, 163, 19, 6, 244, 67,
+			76, 126, 83, 33, 68, 111, 113, 140, 235, 57, 36, 232, 167, 44,
+			63, 201, 119, 185, 145, 35, 3, 241, 51, 166, 221, 179, 214, 135,
+			35, 228, 56, 190, 15, 174, 35, 218, 157, 32, 78, 226, 98, 125,
+			63, 127, 165, 174, 136, 1, 127, 184, 128, 226, 166, 9, 63, 16,
+			35, 51, 141, 159, 225, 226, 150, 160, 134, 224, 169, 151, 21, 168,
+			35, 120, 227, 45, 190, 65, 195, 51, 211, 248, 57, 211, 138, 214,
+			141, 254, 104, 208, 243, 199, 228, 18, 156, 168, 201, 63, 103, 185,
+			73, 5, 106, 8, 78, 205, 43, 80, 71, 112, 233, 11, 124, 159,
+			70, 212, 76, 227, 143, 152, 182, 99, 213, 135, 195, 0, 71, 103,
+			156, 206, 13, 53, 58, 249, 237, 40, 136, 143, 139, 59, 243, 116,
+			3, 73, 134, 68, 211, 243, 71, 44, 119, 74, 129, 52, 230, 233,
+			69, 5, 234, 8, 190, 178, 205, 255, 128, 17, 69, 186, 105, 252,
+			49, 211, 222, 180, 254, 62, 27, 244, 246, 159, 65, 80, 40, 26,
+			65, 232, 12, 112, 228, 138, 58, 38, 33, 73, 29, 59, 180, 219,
+			34, 198, 223, 149, 144, 143, 97, 187, 81, 239, 189, 84, 50, 18,
+			254, 212, 130, 82, 184, 110, 7, 111, 8, 186, 244, 3, 94, 120,
+			47, 22, 201, 35, 135, 194, 152, 206, 78, 103, 72, 112, 110, 90,
+			129, 26, 130, 51, 11, 10, 164, 233, 92, 223, 226, 63, 79, 102,
+			103, 152, 198, 47, 152, 182, 102, 253, 132, 37, 14, 2, 205, 10,
+			149, 18, 15, 146, 65, 26, 115, 195, 223, 54, 217, 151, 153, 254,
+			152, 27, 143, 77, 137, 110, 149, 253, 104, 243, 33, 7, 3, 127,
+			228, 192, 78, 18, 61, 211, 71, 39, 189, 227, 170, 60, 170, 202,
+			55, 6, 162, 231, 35, 144, 164, 120, 159, 171, 32, 127, 65, 12,
+			111, 10, 7, 54, 243, 116, 190, 120, 15, 245, 11, 220, 156, 36,
+			168, 33, 56, 62, 171, 64, 29, 193, 75, 43, 252, 187, 201, 124,
+			179, 166, 241, 31, 153, 118, 219, 250, 54, 235, 253, 236, 14, 221,
+			61, 203, 219, 75, 59, 138, 226, 86, 24, 116, 155, 45, 212, 42,
+			244, 46, 252, 166, 235, 139, 43, 81, 239, 199, 119, 10, 212, 190,
+			192, 159, 242, 123, 52, 143, 90, 194, 79, 223, 104, 52, 188, 160,
+			235, 168, 59, 106, 124, 158, 65, 214, 120, 25, 7, 148, 110, 203,
+			186, 160, 19, 73, 66, 113, 150, 33, 137, 185, 51, 114, 2, 89,
+			13, 193, 217, 23, 21, 168, 35, 184, 252, 6, 255, 81, 50, 159,
+			156, 105, 252, 41, 211, 182, 172, 239, 244, 205, 39, 185, 30, 191,
+			215, 245, 98, 247, 19, 204, 170, 175, 215, 255, 175, 169, 229, 24,
+			82, 155, 83, 178, 201, 105, 8, 158, 189, 172, 64, 29, 193, 213,
+			59, 251, 185, 78, 24, 196, 193, 245, 255, 55, 0, 14, 91, 202,
+			213, 61, 82, 0, 0},
 	)
 }
 
@@ -58,6 +58,9 @@ func (d *LogStreamDescriptor) Validate(prefix bool) error {
 	if d.ContentType == "" {
 		return errors.New("missing content type")
 	}
+	if d.ContentType == types.ContentTypeJSONLines && d.StreamType != StreamType_TEXT {
+		return fmt.Errorf("content type %q requires a TEXT stream", d.ContentType)
+	}
 
 	if d.Timestamp == nil {
 		return errors.New("missing timestamp")
@@ -78,6 +78,12 @@ func (st *ArchivalStorage) GetSignedURLs(c context.Context, req *coordinator.URL
 	if req.Lifetime < 0 {
 		return nil, errors.New("invalid lifetime")
 	}
+	switch compressed, err := archive.IsCompressed(c, st.Storage); {
+	case err != nil:
+		return nil, err
+	case compressed:
+		return nil, nil
+	}
 
 	resp := coordinator.URLSigningResponse{
 		Expiration: clock.Now(c).Add(req.Lifetime),
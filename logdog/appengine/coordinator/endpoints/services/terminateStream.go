@@ -52,6 +52,11 @@ func (s *server) TerminateStream(c context.Context, req *logdog.TerminateStreamR
 	if req.TerminalIndex < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "Negative terminal index.")
 	}
+	for k, v := range req.Tags {
+		if err := types.ValidateTag(k, v); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid tag %q: %s", k, err)
+		}
+	}
 
 	id := coordinator.HashID(req.Id)
 	if err := id.Normalize(); err != nil {
@@ -117,7 +122,16 @@ func (s *server) TerminateStream(c context.Context, req *logdog.TerminateStreamR
 			lst.TerminalIndex = req.TerminalIndex
 			lst.TerminatedTime = now
 
-			if err := ds.Put(c, lst); err != nil {
+			toPut := []any{lst}
+			switch added, err := addTags(ls, req.Tags); {
+			case err != nil:
+				log.WithError(err).Errorf(c, "Failed to add tags to the descriptor.")
+				return status.Error(codes.Internal, "internal server error")
+			case added:
+				toPut = append(toPut, ls)
+			}
+
+			if err := ds.Put(c, toPut...); err != nil {
 				log.Fields{
 					log.ErrorKey: err,
 				}.Errorf(c, "Failed to Put() LogStream.")
@@ -137,3 +151,31 @@ func (s *server) TerminateStream(c context.Context, req *logdog.TerminateStreamR
 	terminateStreamMetric.Add(c, 1, req.Project)
 	return &emptypb.Empty{}, nil
 }
+
+// addTags adds tags to the descriptor of the log stream.
+//
+// Returns false if the descriptor already has all of them.
+func addTags(ls *coordinator.LogStream, tags map[string]string) (bool, error) {
+	if len(tags) == 0 {
+		return false, nil
+	}
+	desc, err := ls.DescriptorProto()
+	if err != nil {
+		return false, err
+	}
+	added := false
+	for k, v := range tags {
+		if cur, ok := desc.Tags[k]; ok && cur == v {
+			continue
+		}
+		if desc.Tags == nil {
+			desc.Tags = make(map[string]string, len(tags))
+		}
+		desc.Tags[k] = v
+		added = true
+	}
+	if !added {
+		return false, nil
+	}
+	return true, ls.LoadDescriptor(desc)
+}
@@ -119,6 +119,25 @@ func TestTerminateStream(t *testing.T) {
 					})
 				})
 
+				Convey(`Records the tags of the terminal entry in the descriptor.`, func() {
+					req.Tags = map[string]string{"logdog.truncated": "size limit"}
+					_, err := svr.TerminateStream(c, &req)
+					So(err, ShouldBeRPCOK)
+
+					So(tls.Get(c), ShouldBeNil)
+					So(tls.State.Terminated(), ShouldBeTrue)
+					desc, err := tls.Stream.DescriptorProto()
+					So(err, ShouldBeNil)
+					So(desc.Tags, ShouldContainKey, "logdog.truncated")
+					So(desc.Tags["logdog.truncated"], ShouldEqual, "size limit")
+				})
+
+				Convey(`Will reject invalid tags.`, func() {
+					req.Tags = map[string]string{"!!!invalid key!!!": "x"}
+					_, err := svr.TerminateStream(c, &req)
+					So(err, ShouldBeRPCInvalidArgument, "Invalid tag")
+				})
+
 				Convey(`Will return an internal server error if Put() fails.`, func() {
 					c, fb := featureBreaker.FilterRDS(c, nil)
 					fb.BreakFeatures(errors.New("test error"), "PutMulti")
@@ -275,6 +275,9 @@ func testGetImpl(t *testing.T, archived bool) {
 		})
 
 		Convey(`When testing log data is added`, func() {
+			// compressionBlockSize, if >0, is the compression block size of the
+			// archived log stream.
+			compressionBlockSize := 0
 			putLogData := func() {
 				if !archived {
 					// Add the logs to the in-memory temporary storage.
@@ -300,6 +303,8 @@ func testGetImpl(t *testing.T, archived bool) {
 						LogWriter:        &lbuf,
 						IndexWriter:      &ibuf,
 						StreamIndexRange: 2,
+
+						CompressionBlockSize: compressionBlockSize,
 					}
 					if err := archive.Archive(m); err != nil {
 						panic(err)
@@ -481,6 +486,16 @@ func testGetImpl(t *testing.T, archived bool) {
 							So(resp.SignedUrls.Index, ShouldEndWith, "&signed=true")
 							So(resp.SignedUrls.Expiration.AsTime(), ShouldResemble, clock.Now(c).Add(duration))
 						})
+
+						Convey(`Will succeed, but return no URL if the archive is compressed.`, func() {
+							compressionBlockSize = 64
+							putLogData()
+
+							resp, err := svr.Get(c, &req)
+							So(err, ShouldBeNil)
+							So(resp.Logs, ShouldHaveLength, 0)
+							So(resp.SignedUrls, ShouldBeNil)
+						})
 					} else {
 						Convey(`Will succeed, but return no URL.`, func() {
 							resp, err := svr.Get(c, &req)
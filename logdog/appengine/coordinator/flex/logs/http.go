@@ -39,6 +39,7 @@ import (
 	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/appengine/coordinator"
 	"go.chromium.org/luci/logdog/appengine/coordinator/flex"
+	"go.chromium.org/luci/logdog/common/renderer"
 	"go.chromium.org/luci/logdog/common/storage"
 	"go.chromium.org/luci/logdog/common/types"
 	"go.chromium.org/luci/logdog/common/viewer"
@@ -65,6 +66,7 @@ type header struct {
 	UserEmail   string
 	Path        string
 	IsFull      bool // Full or Lite HTML mode.
+	ANSIStyle   template.CSS
 }
 
 // The stylesheet is inlined because static_dir isn't supported in flex.
@@ -79,6 +81,7 @@ var headerTemplate = template.Must(template.New("header").Parse(`
 <meta name="google" value="notranslate">
 <link rel="stylesheet" href="/static/css/viewer.css" type="text/css">
 <link rel="stylesheet" href="/static/third_party/css/jquery-ui.min.css" type="text/css">
+<style>{{ .ANSIStyle }}</style>
 <script src="/static/third_party/js/moment-with-locales.min.js"></script>
 <script src="/static/third_party/js/moment-timezone-with-data-2012-2022.min.js"></script>
 <script src="/static/js/time.js"></script>
@@ -467,6 +470,7 @@ func writeHTMLHeader(ctx *router.Context, data logData) {
 		UserPicture: user.Picture,
 		UserEmail:   user.Email,
 		IsFull:      data.options.format == formatHTMLFull,
+		ANSIStyle:   template.CSS(renderer.HTMLStyle),
 	}); err != nil {
 		fmt.Fprintf(ctx.Writer, "Failed to render page: %s", err)
 	}
@@ -645,7 +649,7 @@ func durationInfo(previous, current time.Duration) (di durationInfoStruct) {
 
 // logLineStruct is used by lineTemplate for rendering lines and line timestamps.
 type logLineStruct struct {
-	Text          string
+	Text          template.HTML // Escaped and linkified, see newHTMLConverter.
 	ID            string
 	DataTimestamp int64 // Milliseconds since epoch.
 	DurationInfo  durationInfoStruct
@@ -655,16 +659,14 @@ type logLineStruct struct {
 var errorTemplate = template.Must(template.New("error").Parse(`
 <div class="error line">LOGDOG ERROR: {{.}}</div>`))
 
-var lineTemplate = template.Must(template.New("line").Funcs(template.FuncMap{
-	"linkify": linkify,
-}).Parse(`
+var lineTemplate = template.Must(template.New("line").Parse(`
 <div class="line" id="{{.ID}}">
 	<div class="timestamp" onclick="window.location.hash='{{.ID}}'"
 			 data-timestamp="{{.DataTimestamp}}" data-delta="{{.DurationInfo.Delta}}"
 			 onmouseover="utils.maybeFormatTime(this)" style="{{.DurationInfo.Style}}">
 		{{.DurationInfo.Text}}
 	</div>
-	<span class="text">{{.Text | linkify}}</span>
+	<span class="text">{{.Text}}</span>
 </div>`))
 
 // serve reads log entries from data.ch and writes into w.
@@ -691,6 +693,7 @@ func serve(c context.Context, data logData, w http.ResponseWriter) (err error) {
 		flusher = &nopFlusher{}
 	}
 	prevDuration := time.Duration(0)
+	conv := newHTMLConverter()
 	// Serve the logs.
 	for logResp := range data.ch {
 		log, ierr := logResp.log, logResp.err
@@ -703,9 +706,10 @@ func serve(c context.Context, data logData, w http.ResponseWriter) (err error) {
 		}
 
 		for i, line := range log.GetText().GetLines() {
-			// For HTML full mode, we escape, linkify URLs in text,
-			// and wrap each line with a div with timing information.
-			// For HTML lite mode, just escape and linkify URLs.
+			// For HTML full mode, we escape, convert ANSI colors, linkify URLs in
+			// text, and wrap each line with a div with timing information.
+			// For HTML lite mode, just escape, convert ANSI colors and linkify
+			// URLs.
 			// For raw mode, we just regurgitate the line.
 			ierr = nil
 			switch data.options.format {
@@ -713,9 +717,8 @@ func serve(c context.Context, data logData, w http.ResponseWriter) (err error) {
 				lt := logLineStruct{
 					// Note: We want to use PrefixIndex because we might be
 					// viewing more than 1 stream.
-					ID: fmt.Sprintf("L%d_%d", log.PrefixIndex, i),
-					// The templating engine below escapes the lines for us.
-					Text: string(line.GetValue()),
+					ID:   fmt.Sprintf("L%d_%d", log.PrefixIndex, i),
+					Text: template.HTML(conv.Convert(line.GetValue())),
 				}
 				// Add in timestamp information, if available.
 				var duration time.Duration
@@ -731,8 +734,7 @@ func serve(c context.Context, data logData, w http.ResponseWriter) (err error) {
 				prevDuration = duration
 				ierr = lineTemplate.Execute(w, lt)
 			case formatHTMLLite:
-				text := linkify(string(line.GetValue()))
-				_, ierr = fmt.Fprintf(w, "%s\n", text)
+				_, ierr = fmt.Fprintf(w, "%s\n", conv.Convert(line.GetValue()))
 			case formatRAW:
 				_, ierr = fmt.Fprintf(w, "%s%s", line.GetValue(), line.GetDelimiter())
 			default:
@@ -774,6 +776,14 @@ func GetHandler(ctx *router.Context) {
 	writeFooter(ctx, start, err, data.options.isHTML())
 }
 
+// newHTMLConverter returns a converter of log lines with ANSI escape sequences
+// into linkified HTML.
+func newHTMLConverter() *renderer.HTMLConverter {
+	return &renderer.HTMLConverter{
+		Escape: func(s string) string { return string(linkify(s)) },
+	}
+}
+
 var linkTemplate = template.Must(template.New("link").Parse(`<a href="{{.}}">{{.}}</a>`))
 
 var urlPattern = regexp.MustCompile(
@@ -178,13 +178,18 @@ func TestHelperFunctions(t *testing.T) {
 		})
 
 		Convey(`lineTemplate uses linkify`, func() {
-			lt := logLineStruct{Text: "See https://crbug.com/1167332."}
+			lt := logLineStruct{Text: template.HTML(newHTMLConverter().Convert([]byte("See https://crbug.com/1167332.")))}
 			w := bytes.NewBuffer([]byte{})
 			So(lineTemplate.Execute(w, lt), ShouldBeNil)
 			So(w.String(), ShouldContainSubstring,
 				`<span class="text">See <a href="https://crbug.com/1167332">https://crbug.com/1167332</a>.</span>`)
 		})
 
+		Convey(`ANSI colors are converted around links`, func() {
+			So(newHTMLConverter().Convert([]byte("\x1b[31mSee https://x.com <b>\x1b[0m")), ShouldEqual,
+				`<span class="ansi-fg-1">See <a href="https://x.com">https://x.com</a> &lt;b&gt;</span>`)
+		})
+
 	})
 
 	Convey(`contentTypeHeader adjusts based on format and data content type`, t, func() {
@@ -0,0 +1,394 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/appengine/coordinator"
+	"go.chromium.org/luci/logdog/appengine/coordinator/flex"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+
+	"go.chromium.org/luci/common/clock"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/sync/parallel"
+	ds "go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/auth/realms"
+)
+
+const (
+	// searchMatchLimit is the maximum number of matches that will be returned
+	// in a single search.
+	searchMatchLimit = 1000
+
+	// searchStreamLimit is the maximum number of log streams that will be
+	// scanned in a single search. The rest of them can be scanned by passing
+	// SearchResponse.Next as SearchRequest.Next.
+	searchStreamLimit = 100
+
+	// searchParallelism is the maximum number of log streams scanned
+	// concurrently.
+	searchParallelism = 8
+
+	// searchGetBatchSize is the number of log entries fetched from the storage
+	// at once.
+	searchGetBatchSize = 256
+)
+
+// Search scans archived text log streams matching the query path, looking for
+// lines matching the pattern.
+//
+// Streams are scanned concurrently, but the matches are returned in the query
+// order. If the search stops after finding the maximum number of matches, Next
+// points right after the last returned match.
+func (s *server) Search(c context.Context, req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+	re, err := regexp.Compile(req.Pattern)
+	switch {
+	case req.Pattern == "":
+		return nil, status.Error(codes.InvalidArgument, "pattern is required")
+	case err != nil:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pattern: %s", err)
+	}
+	maxMatches := searchMatchLimit
+	if req.MaxMatches > 0 && int(req.MaxMatches) < maxMatches {
+		maxMatches = int(req.MaxMatches)
+	}
+	cursor, err := parseSearchCursor(req.Next)
+	if err != nil {
+		log.WithError(err).Errorf(c, "Invalid search cursor.")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid `next` value")
+	}
+	c = log.SetFields(c, log.Fields{"path": req.Path, "pattern": req.Pattern})
+
+	q, err := coordinator.NewLogStreamQuery(req.Path)
+	if err != nil {
+		log.WithError(err).Errorf(c, "Invalid query path.")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query `path`")
+	}
+
+	pfx := &coordinator.LogPrefix{ID: coordinator.LogPrefixID(q.Prefix)}
+	if err := ds.Get(c, pfx); err != nil {
+		if err == ds.ErrNoSuchEntity {
+			return nil, coordinator.PermissionDeniedErr(c)
+		}
+		log.WithError(err).Errorf(c, "Failed to fetch LogPrefix")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	// Old prefixes have no realm set. Fallback to "@legacy".
+	realm := pfx.Realm
+	if realm == "" {
+		realm = realms.Join(req.Project, realms.LegacyRealm)
+	}
+
+	// Searching both enumerates streams and reads their content.
+	for _, perm := range []realms.Permission{coordinator.PermLogsList, coordinator.PermLogsGet} {
+		if err := coordinator.CheckPermission(c, perm, q.Prefix, realm); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := q.SetCursor(c, cursor.Query); err != nil {
+		log.WithError(err).Errorf(c, "Failed to SetCursor.")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid `next` value")
+	}
+	if err := q.OnlyStreamType(logpb.StreamType_TEXT); err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	// cursors[i] is the query cursor right before streams[i]; the last one is
+	// right after the last stream.
+	var streams []*coordinator.LogStream
+	cursors := []string{cursor.Query}
+	err = q.Run(c, func(ls *coordinator.LogStream, cb ds.CursorCB) error {
+		cur, err := cb()
+		if err != nil {
+			return err
+		}
+		streams = append(streams, ls)
+		cursors = append(cursors, cur.String())
+		if len(streams) == searchStreamLimit {
+			return ds.Stop
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to execute query.")
+		return nil, status.Errorf(codes.Internal, "failed to execute query: %s", err)
+	}
+
+	states := make([]*coordinator.LogStreamState, len(streams))
+	for i, ls := range streams {
+		states[i] = ls.State(c)
+	}
+	if err := ds.Get(c, states); err != nil {
+		log.WithError(err).Errorf(c, "Failed to load log stream states.")
+		return nil, status.Errorf(codes.Internal, "failed to load log stream states: %s", err)
+	}
+
+	sc := newSearchCollector(len(streams), maxMatches)
+	cc, cancel := context.WithCancel(c)
+	defer cancel()
+	sc.cancel = cancel
+
+	startTime := clock.Now(c)
+	err = parallel.WorkPool(searchParallelism, func(workC chan<- func() error) {
+		for i, ls := range streams {
+			i, ls, state := i, ls, states[i]
+			if !state.ArchivalState().Archived() {
+				sc.finish(i)
+				continue
+			}
+
+			from := searchPosition{}
+			if i == 0 && cursor.Path == string(ls.Path()) {
+				from = cursor.searchPosition
+			}
+			workC <- func() error {
+				return scanStream(cc, req.Project, i, ls, state, from, re, sc)
+			}
+		}
+	})
+	if err != nil && !sc.satisfied() {
+		log.WithError(err).Errorf(c, "Failed to scan log streams.")
+		return nil, status.Errorf(codes.Internal, "failed to scan log streams: %s", err)
+	}
+
+	resp := &logdog.SearchResponse{Project: req.Project}
+	for i, ls := range streams {
+		matches := sc.streams[i].matches
+		if room := maxMatches - len(resp.Matches); len(matches) >= room {
+			// Stop at this stream, continuing right after the last match next time.
+			resp.Matches = append(resp.Matches, matches[:room]...)
+			last := resp.Matches[len(resp.Matches)-1]
+			resp.Truncated = true
+			resp.Next = searchCursor{
+				Query: cursors[i],
+				Path:  string(ls.Path()),
+				searchPosition: searchPosition{
+					Index: last.StreamIndex,
+					Line:  int(last.Line) + 1,
+				},
+			}.String()
+			break
+		}
+
+		resp.Matches = append(resp.Matches, matches...)
+		if states[i].ArchivalState().Archived() {
+			resp.Searched++
+		} else {
+			resp.Skipped = append(resp.Skipped, string(ls.Path()))
+		}
+	}
+	if !resp.Truncated && len(streams) == searchStreamLimit {
+		resp.Next = searchCursor{Query: cursors[len(streams)]}.String()
+	}
+	log.Infof(c, "Scanned %d streams in %s", resp.Searched, clock.Now(c).Sub(startTime))
+	return resp, nil
+}
+
+// scanStream scans the log entries of an archived stream, starting at from.
+func scanStream(c context.Context, project string, ordinal int, ls *coordinator.LogStream, state *coordinator.LogStreamState, from searchPosition, re *regexp.Regexp, sc *searchCollector) error {
+	path := ls.Path()
+
+	st, err := flex.GetServices(c).StorageForStream(c, state, project)
+	if err != nil {
+		return err
+	}
+	defer st.Close()
+
+	next := types.MessageIndex(from.Index)
+	for c.Err() == nil {
+		if state.TerminalIndex >= 0 && next > types.MessageIndex(state.TerminalIndex) {
+			break
+		}
+
+		var cbErr error
+		fetched := 0
+		stopped := false
+		err := st.Get(c, storage.GetRequest{
+			Project: project,
+			Path:    path,
+			Index:   next,
+			Limit:   searchGetBatchSize,
+		}, func(e *storage.Entry) bool {
+			le, err := e.GetLogEntry()
+			if err != nil {
+				cbErr = err
+				return false
+			}
+			fetched++
+			next = types.MessageIndex(le.StreamIndex) + 1
+			for i, line := range le.GetText().GetLines() {
+				if le.StreamIndex == from.Index && i < from.Line {
+					continue
+				}
+				if re.Match(line.Value) {
+					m := &logdog.SearchResponse_Match{
+						Path:        string(path),
+						StreamIndex: le.StreamIndex,
+						Line:        int32(i),
+						Text:        string(line.Value),
+					}
+					if !sc.add(ordinal, m) {
+						stopped = true
+						return false
+					}
+				}
+			}
+			return true
+		})
+		switch {
+		case err == storage.ErrDoesNotExist:
+			sc.finish(ordinal)
+			return nil
+		case err != nil:
+			return err
+		case cbErr != nil:
+			return cbErr
+		case stopped:
+			return nil
+		case fetched == 0:
+			sc.finish(ordinal)
+			return nil
+		}
+	}
+	if err := c.Err(); err != nil {
+		return err
+	}
+	sc.finish(ordinal)
+	return nil
+}
+
+// searchCollector accumulates matches found by concurrent stream scans.
+//
+// It cancels the scans once the streams scanned to the end, followed by at
+// most one partially scanned stream, have enough matches.
+type searchCollector struct {
+	max    int
+	cancel context.CancelFunc
+
+	m       sync.Mutex
+	streams []streamMatches
+}
+
+type streamMatches struct {
+	matches []*logdog.SearchResponse_Match
+	done    bool // true if the stream was scanned to the end
+}
+
+func newSearchCollector(streams, max int) *searchCollector {
+	return &searchCollector{
+		max:     max,
+		streams: make([]streamMatches, streams),
+	}
+}
+
+// add adds a match of the stream at the ordinal.
+//
+// Returns false if the stream has enough matches and need not be scanned
+// further.
+func (sc *searchCollector) add(ordinal int, m *logdog.SearchResponse_Match) bool {
+	sc.m.Lock()
+	defer sc.m.Unlock()
+	s := &sc.streams[ordinal]
+	s.matches = append(s.matches, m)
+	sc.maybeCancelLocked()
+	return len(s.matches) < sc.max
+}
+
+// finish marks the stream at the ordinal as scanned to the end.
+func (sc *searchCollector) finish(ordinal int) {
+	sc.m.Lock()
+	defer sc.m.Unlock()
+	sc.streams[ordinal].done = true
+	sc.maybeCancelLocked()
+}
+
+func (sc *searchCollector) satisfied() bool {
+	sc.m.Lock()
+	defer sc.m.Unlock()
+	return sc.satisfiedLocked()
+}
+
+func (sc *searchCollector) satisfiedLocked() bool {
+	count := 0
+	for _, s := range sc.streams {
+		count += len(s.matches)
+		if count >= sc.max {
+			return true
+		}
+		if !s.done {
+			return false
+		}
+	}
+	return false
+}
+
+func (sc *searchCollector) maybeCancelLocked() {
+	if sc.cancel != nil && sc.satisfiedLocked() {
+		sc.cancel()
+	}
+}
+
+// searchPosition is a position within a log stream.
+type searchPosition struct {
+	// Index is the index of the log entry.
+	Index uint64 `json:"i,omitempty"`
+	// Line is the index of the line within the log entry.
+	Line int `json:"l,omitempty"`
+}
+
+// searchCursor is the position after which a search continues.
+type searchCursor struct {
+	// Query is the query cursor, positioned right before the log stream to
+	// continue with.
+	Query string `json:"q,omitempty"`
+	// Path, if not empty, is the path of the log stream to continue with, which
+	// is scanned from searchPosition.
+	Path string `json:"p,omitempty"`
+
+	searchPosition
+}
+
+func (sc searchCursor) String() string {
+	b, err := json.Marshal(sc)
+	if err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func parseSearchCursor(s string) (sc searchCursor, err error) {
+	if s == "" {
+		return
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(b, &sc)
+	return
+}
@@ -0,0 +1,197 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.chromium.org/luci/common/gcloud/gs"
+	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/api/logpb"
+	ct "go.chromium.org/luci/logdog/appengine/coordinator/coordinatorTest"
+	"go.chromium.org/luci/logdog/common/archive"
+	"go.chromium.org/luci/logdog/common/renderer"
+	"go.chromium.org/luci/logdog/common/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration, a Search request`, t, func() {
+		c, env := ct.Install()
+
+		svr := New()
+
+		const project = "some-project"
+		const realm = "some-realm"
+
+		env.AddProject(c, project)
+		env.ActAsReader(project, realm)
+
+		// makeStream creates a stream with one log entry per element of entries,
+		// archiving it if necessary. Lines of an entry are separated by "\n".
+		makeStream := func(path types.StreamPath, archived bool, entries ...string) {
+			tls := ct.MakeStream(c, project, realm, path)
+
+			var les []*logpb.LogEntry
+			for i, e := range entries {
+				le := tls.LogEntry(c, i)
+				lines := le.GetText().Lines[:0]
+				for _, line := range strings.Split(e, "\n") {
+					lines = append(lines, &logpb.Text_Line{Value: []byte(line), Delimiter: "\n"})
+				}
+				le.GetText().Lines = lines
+				les = append(les, le)
+			}
+
+			if archived {
+				src := renderer.StaticSource(les)
+				var lbuf, ibuf bytes.Buffer
+				err := archive.Archive(archive.Manifest{
+					Desc:        tls.Desc,
+					Source:      &src,
+					LogWriter:   &lbuf,
+					IndexWriter: &ibuf,
+				})
+				So(err, ShouldBeNil)
+
+				streamURL := gs.Path(fmt.Sprintf("gs://testbucket/%s/stream", path))
+				indexURL := gs.Path(fmt.Sprintf("gs://testbucket/%s/index", path))
+				env.GSClient.Put(streamURL, lbuf.Bytes())
+				env.GSClient.Put(indexURL, ibuf.Bytes())
+
+				now := env.Clock.Now().UTC()
+				tls.State.TerminalIndex = int64(len(entries) - 1)
+				tls.State.TerminatedTime = now
+				tls.State.ArchivedTime = now
+				tls.State.ArchiveStreamURL = string(streamURL)
+				tls.State.ArchiveIndexURL = string(indexURL)
+			}
+
+			So(tls.Put(c), ShouldBeNil)
+		}
+
+		makeStream("testing/+/a", true, "hello world", "nothing here", "goodbye world\nsecond world")
+		makeStream("testing/+/b", true, "another world", "no match")
+		makeStream("testing/+/c", false, "not archived world")
+
+		req := &logdog.SearchRequest{
+			Project: project,
+			Path:    "testing/+/**",
+			Pattern: `w.rld$`,
+		}
+
+		match := func(path string, index uint64, line int32, text string) *logdog.SearchResponse_Match {
+			return &logdog.SearchResponse_Match{Path: path, StreamIndex: index, Line: line, Text: text}
+		}
+		allMatches := []*logdog.SearchResponse_Match{
+			match("testing/+/a", 0, 0, "hello world"),
+			match("testing/+/a", 2, 0, "goodbye world"),
+			match("testing/+/a", 2, 1, "second world"),
+			match("testing/+/b", 0, 0, "another world"),
+		}
+
+		Convey(`Finds matching lines`, func() {
+			resp, err := svr.Search(c, req)
+			So(err, ShouldBeRPCOK)
+			So(resp.Matches, ShouldResembleProto, allMatches)
+			So(resp.Searched, ShouldEqual, 2)
+			So(resp.Skipped, ShouldResemble, []string{"testing/+/c"})
+			So(resp.Truncated, ShouldBeFalse)
+			So(resp.Next, ShouldEqual, "")
+		})
+
+		Convey(`Respects the match limit`, func() {
+			req.MaxMatches = 2
+			resp, err := svr.Search(c, req)
+			So(err, ShouldBeRPCOK)
+			So(resp.Matches, ShouldResembleProto, allMatches[:2])
+			So(resp.Searched, ShouldEqual, 0)
+			So(resp.Skipped, ShouldBeEmpty)
+			So(resp.Truncated, ShouldBeTrue)
+			So(resp.Next, ShouldNotEqual, "")
+		})
+
+		Convey(`Continues after the last match when truncated`, func() {
+			for _, max := range []int32{1, 2, 3} {
+				req.MaxMatches = max
+				req.Next = ""
+
+				var matches []*logdog.SearchResponse_Match
+				var skipped []string
+				searched := 0
+				for {
+					resp, err := svr.Search(c, req)
+					So(err, ShouldBeRPCOK)
+					So(len(resp.Matches), ShouldBeLessThanOrEqualTo, max)
+					matches = append(matches, resp.Matches...)
+					skipped = append(skipped, resp.Skipped...)
+					searched += int(resp.Searched)
+					if resp.Next == "" {
+						break
+					}
+					req.Next = resp.Next
+				}
+				So(matches, ShouldResembleProto, allMatches)
+				So(skipped, ShouldResemble, []string{"testing/+/c"})
+				So(searched, ShouldEqual, 2)
+			}
+		})
+
+		Convey(`No matches`, func() {
+			req.Pattern = `zzz`
+			resp, err := svr.Search(c, req)
+			So(err, ShouldBeRPCOK)
+			So(resp.Matches, ShouldHaveLength, 0)
+		})
+
+		Convey(`Bad pattern`, func() {
+			req.Pattern = `(`
+			_, err := svr.Search(c, req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid pattern")
+		})
+
+		Convey(`Missing pattern`, func() {
+			req.Pattern = ``
+			_, err := svr.Search(c, req)
+			So(err, ShouldBeRPCInvalidArgument, "pattern is required")
+		})
+
+		Convey(`Bad cursor`, func() {
+			req.Next = `!!!`
+			_, err := svr.Search(c, req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid `next` value")
+		})
+
+		Convey(`Checks permissions`, func() {
+			env.ActAsNobody()
+			_, err := svr.Search(c, req)
+			So(err, ShouldBeRPCPermissionDenied)
+		})
+
+		Convey(`Unknown prefix`, func() {
+			req.Path = "unknown/+/**"
+			_, err := svr.Search(c, req)
+			So(err, ShouldBeRPCPermissionDenied)
+		})
+	})
+}
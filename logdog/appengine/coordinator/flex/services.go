@@ -57,7 +57,7 @@ type Services interface {
 //
 // It lives in the root context.
 type GlobalServices struct {
-	btStorage       *bigtable.Storage
+	intermediate    storage.Storage
 	gsClientFactory func(ctx context.Context, project string) (gs.Client, error)
 	storageCache    *StorageCache
 }
@@ -79,8 +79,20 @@ func NewGlobalServices(c context.Context, bt *bigtable.Flags) (*GlobalServices,
 	}
 	storage.Cache = storageCache
 
+	return newGlobalServices(storage, storageCache), nil
+}
+
+// NewGlobalServicesWithStorage instantiates a new GlobalServices instance
+// reading intermediate logs from the given storage, e.g. a local.Storage.
+//
+// The storage is not closed by GlobalServices.
+func NewGlobalServicesWithStorage(st storage.Storage) *GlobalServices {
+	return newGlobalServices(st, &StorageCache{})
+}
+
+func newGlobalServices(st storage.Storage, storageCache *StorageCache) *GlobalServices {
 	return &GlobalServices{
-		btStorage:    storage,
+		intermediate: st,
 		storageCache: storageCache,
 		gsClientFactory: func(c context.Context, project string) (client gs.Client, e error) {
 			// TODO(vadimsh): Switch to AsProject + WithProject(project) once
@@ -95,7 +107,7 @@ func NewGlobalServices(c context.Context, bt *bigtable.Flags) (*GlobalServices,
 			}
 			return prodClient, nil
 		},
-	}, nil
+	}
 }
 
 // Storage returns a Storage instance for the supplied log stream.
@@ -106,7 +118,7 @@ func (gsvc *GlobalServices) StorageForStream(c context.Context, lst *coordinator
 
 	if !lst.ArchivalState().Archived() {
 		logging.Debugf(c, "Log is not archived. Fetching from intermediate storage.")
-		return noSignedURLStorage{gsvc.btStorage}, nil
+		return noSignedURLStorage{gsvc.intermediate}, nil
 	}
 
 	// Some very old logs have malformed data where they claim to be archived but
@@ -159,6 +171,11 @@ type noSignedURLStorage struct {
 	storage.Storage
 }
 
+// Close implements storage.Storage.
+//
+// It does nothing, since the intermediate storage is shared by all requests.
+func (noSignedURLStorage) Close() {}
+
 func (noSignedURLStorage) GetSignedURLs(context.Context, *coordinator.URLSigningRequest) (
 	*coordinator.URLSigningResponse, error) {
 
@@ -186,6 +203,16 @@ func (si *googleStorage) Close() {
 }
 
 func (si *googleStorage) GetSignedURLs(c context.Context, req *coordinator.URLSigningRequest) (*coordinator.URLSigningResponse, error) {
+	// Consumers of signed URLs read the archive files directly, which they
+	// can't do for compressed archives.
+	switch compressed, err := archive.IsCompressed(c, si.Storage); {
+	case err != nil:
+		return nil, errors.Annotate(err, "").InternalReason("failed to load index").Err()
+	case compressed:
+		logging.Debugf(c, "Log stream archive is compressed, not signing URLs.")
+		return nil, nil
+	}
+
 	signer := auth.GetSigner(c)
 	info, err := signer.ServiceInfo(c)
 	if err != nil {
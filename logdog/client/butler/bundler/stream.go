@@ -20,6 +20,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"go.chromium.org/luci/logdog/api/logpb"
 )
 
@@ -50,6 +52,14 @@ type Stream interface {
 
 	// Close closes the Stream, flushing any remaining data.
 	Close()
+
+	// SetTag sets a tag on the stream's descriptor. It applies to bundle entries
+	// generated after the call.
+	//
+	// Note that a LogDog Coordinator registers the stream's descriptor when it
+	// receives the first bundle entry. Tags set later are recorded when it
+	// receives the terminal bundle entry.
+	SetTag(key, value string)
 }
 
 // streamConfig is the set of static configuration parameters for the stream.
@@ -184,6 +194,20 @@ func (s *streamImpl) Close() {
 	s.closeLocked()
 }
 
+func (s *streamImpl) SetTag(key, value string) {
+	s.stateLock.Lock()
+	defer s.stateLock.Unlock()
+
+	// The current descriptor may be referenced by bundles in flight, so replace
+	// it with a modified copy.
+	desc := proto.Clone(s.c.template.Desc).(*logpb.LogStreamDescriptor)
+	if desc.Tags == nil {
+		desc.Tags = make(map[string]string, 1)
+	}
+	desc.Tags[key] = value
+	s.c.template.Desc = desc
+}
+
 func (s *streamImpl) closeLocked() {
 	s.closed = true
 	if s.c.onAppend != nil {
@@ -383,5 +407,8 @@ func (s *streamImpl) setAppendErrorLocked(err error) {
 }
 
 func (s *streamImpl) streamDesc() *logpb.LogStreamDescriptor {
+	s.stateLock.Lock()
+	defer s.stateLock.Unlock()
+
 	return s.c.template.Desc
 }
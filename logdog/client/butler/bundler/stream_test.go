@@ -329,6 +329,22 @@ func TestStream(t *testing.T) {
 				So(bb.bundle(), shouldHaveBundleEntries, "test:a:b")
 			})
 
+			Convey(`SetTag applies to subsequent bundle entries only.`, func() {
+				tp.tags(tc.Now(), "a")
+				So(s.nextBundleEntry(bb, false), ShouldBeTrue)
+				first := bb.bundle()
+
+				s.SetTag("truncated", "true")
+				So(s.streamDesc().Tags, ShouldResemble, map[string]string{"truncated": "true"})
+				So(c.template.Desc.Tags, ShouldBeNil)
+
+				bb = &builder{size: 1024}
+				tp.tags(tc.Now(), "b")
+				So(s.nextBundleEntry(bb, false), ShouldBeTrue)
+				So(first.Entries[0].Desc.Tags, ShouldBeNil)
+				So(bb.bundle().Entries[0].Desc.Tags, ShouldResemble, map[string]string{"truncated": "true"})
+			})
+
 			Convey(`When split is allowed, returns nil.`, func() {
 				tp.tags(tc.Now(), "a", "b")
 				tp.setAllowSplit(true)
@@ -20,9 +20,11 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"golang.org/x/time/rate"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.chromium.org/luci/common/clock"
@@ -64,6 +66,33 @@ type Config struct {
 	// be buffered before being marked for dispatch. If this is zero,
 	// DefaultMaxBufferAge will be used.
 	MaxBufferAge time.Duration
+
+	// StreamByteRate, if >0, is the maximum number of bytes per second read from
+	// each stream. Reading from a stream exceeding it is throttled, blocking its
+	// writer.
+	StreamByteRate int
+	// GlobalByteRate, if >0, is the maximum number of bytes per second read from
+	// all streams combined.
+	GlobalByteRate int
+
+	// MaxStreamBytes, if >0, is the maximum number of bytes sent for each
+	// stream. The rest of the stream's data is read and discarded, the stream is
+	// tagged with TruncatedTag and, if it is a text stream, a truncation marker
+	// line is appended to it.
+	//
+	// Datagram streams are exempt from size limits, since truncating them would
+	// corrupt their framing.
+	MaxStreamBytes int64
+	// MaxTotalBytes, if >0, is the maximum number of bytes sent for all streams
+	// combined. Streams are truncated like with MaxStreamBytes once it's
+	// reached.
+	MaxTotalBytes int64
+
+	// Redactor, if not nil, replaces secrets in the data of text streams before
+	// it is bundled. Data which may be the beginning of a secret is held back
+	// for up to a second waiting for the rest of it. Binary and datagram streams
+	// are not redacted.
+	Redactor Redactor
 }
 
 // Validate validates that the configuration is sufficient to instantiate a
@@ -72,6 +101,12 @@ func (c *Config) Validate() error {
 	if c.Output == nil {
 		return errors.New("butler: an Output must be supplied")
 	}
+	if c.StreamByteRate < 0 || c.GlobalByteRate < 0 {
+		return errors.New("butler: byte rates must be >= 0")
+	}
+	if c.MaxStreamBytes < 0 || c.MaxTotalBytes < 0 {
+		return errors.New("butler: size limits must be >= 0")
+	}
 	return nil
 }
 
@@ -127,6 +162,16 @@ type Butler struct {
 	// streamStopC is a stop signal channel for stream. This will cause streams
 	// to prematurely terminate (before EOF) on shutdown.
 	streamStopC chan struct{}
+
+	// globalRate, if not nil, limits the byte rate of all streams combined.
+	globalRate *rate.Limiter
+	// totalBytes, if not nil, is the remaining size budget of all streams
+	// combined.
+	totalBytes *byteBudget
+
+	// redactions is the number of secrets redacted from all streams. It must
+	// be accessed atomically.
+	redactions int64
 }
 
 // New instantiates a new Butler instance and starts its processing.
@@ -151,6 +196,11 @@ func New(ctx context.Context, config Config) (*Butler, error) {
 		streamC:           make(chan *stream),
 		streamServerStopC: make(chan struct{}),
 		streamStopC:       make(chan struct{}),
+
+		globalRate: newByteRateLimiter(config.GlobalByteRate),
+	}
+	if config.MaxTotalBytes > 0 {
+		b.totalBytes = &byteBudget{remaining: config.MaxTotalBytes}
 	}
 
 	bc := bundler.Config{
@@ -394,11 +444,19 @@ func (b *Butler) AddStream(rc io.ReadCloser, d *logpb.LogStreamDescriptor) error
 	streamCtx := log.SetField(b.ctx, "stream", d.Name)
 	logging.Infof(streamCtx, "adding stream")
 	s := stream{
-		log:  logging.Get(streamCtx),
-		now:  clock.Get(streamCtx).Now,
-		r:    rc,
-		c:    rc,
-		name: types.StreamName(d.Name),
+		log:        logging.Get(streamCtx),
+		now:        clock.Get(streamCtx).Now,
+		r:          rc,
+		c:          rc,
+		name:       types.StreamName(d.Name),
+		streamType: d.StreamType,
+		limits:     b.newStreamLimits(d.StreamType),
+		lastByte:   -1,
+	}
+	s.ctx, s.cancel = context.WithCancel(streamCtx)
+	if b.c.Redactor != nil && d.StreamType == logpb.StreamType_TEXT {
+		s.redactor = newRedactingReader(s.ctx, rc, b.c.Redactor, &b.redactions)
+		s.r = s.redactor
 	}
 
 	// Register this stream with our Bundler. It will take ownership of "d", so
@@ -413,6 +471,28 @@ func (b *Butler) AddStream(rc io.ReadCloser, d *logpb.LogStreamDescriptor) error
 	return nil
 }
 
+// Redactions returns the number of secrets redacted from all streams so far.
+func (b *Butler) Redactions() int64 {
+	return atomic.LoadInt64(&b.redactions)
+}
+
+// newStreamLimits returns the limits of a new stream of type t, or nil if
+// there are none.
+func (b *Butler) newStreamLimits(t logpb.StreamType) *streamLimits {
+	l := streamLimits{
+		rate:       newByteRateLimiter(b.c.StreamByteRate),
+		globalRate: b.globalRate,
+	}
+	if t != logpb.StreamType_DATAGRAM {
+		l.maxBytes = b.c.MaxStreamBytes
+		l.total = b.totalBytes
+	}
+	if l == (streamLimits{}) {
+		return nil
+	}
+	return &l
+}
+
 func (b *Butler) runStreams(activateC chan struct{}) {
 	streamFinishedC := make(chan *stream)
 	streamC := b.streamC
@@ -448,6 +528,7 @@ func (b *Butler) runStreams(activateC chan struct{}) {
 					for s.readChunk() {
 						didSomething = true
 					}
+					s.logRedactions()
 					if !didSomething {
 						b.finalCallback(string(s.name))
 					}
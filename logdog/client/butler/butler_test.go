@@ -343,6 +343,15 @@ func TestButler(t *testing.T) {
 				So(b.AddStream(s, s.desc), ShouldNotBeNil)
 			})
 
+			Convey(`Will not add a binary stream with the JSON lines content type.`, func() {
+				s := newTestStream(func(d *logpb.LogStreamDescriptor) {
+					d.StreamType = logpb.StreamType_BINARY
+					d.ContentType = types.ContentTypeJSONLines
+				})
+				b := mkb(c, conf)
+				So(b.AddStream(s, s.desc), ShouldErrLike, "requires a TEXT stream")
+			})
+
 			Convey(`Will not add a stream with a duplicate stream name.`, func() {
 				b := mkb(c, conf)
 
@@ -0,0 +1,149 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package butler
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"golang.org/x/time/rate"
+
+	"go.chromium.org/luci/logdog/api/logpb"
+)
+
+// TruncatedTag is the tag set on streams whose data was truncated because
+// they exceeded a size limit.
+//
+// Its value is the reason for the truncation. Since it's set after the stream
+// was registered, the Coordinator records it when the stream is terminated.
+const TruncatedTag = "logdog.truncated"
+
+// minRateBurst is the minimum burst of a byte rate limiter. It must be at
+// least the size of a single stream read.
+const minRateBurst = 64 * 1024
+
+// newByteRateLimiter returns a limiter allowing bytesPerSec bytes per second,
+// or nil if bytesPerSec is <= 0.
+func newByteRateLimiter(bytesPerSec int) *rate.Limiter {
+	if bytesPerSec <= 0 {
+		return nil
+	}
+	burst := bytesPerSec
+	if burst < minRateBurst {
+		burst = minRateBurst
+	}
+	return rate.NewLimiter(rate.Limit(bytesPerSec), burst)
+}
+
+// byteBudget is a goroutine-safe number of bytes shared by several streams.
+type byteBudget struct {
+	remaining int64
+}
+
+// take consumes up to n bytes from the budget, returning the number consumed.
+func (b *byteBudget) take(n int64) int64 {
+	for {
+		rem := atomic.LoadInt64(&b.remaining)
+		if rem <= 0 {
+			return 0
+		}
+		took := n
+		if took > rem {
+			took = rem
+		}
+		if atomic.CompareAndSwapInt64(&b.remaining, rem, rem-took) {
+			return took
+		}
+	}
+}
+
+// streamLimits enforces the byte rate limits and size caps of a single
+// stream.
+//
+// A nil *streamLimits has no limits.
+type streamLimits struct {
+	// rate and globalRate, if not nil, limit the stream's byte rate.
+	rate       *rate.Limiter
+	globalRate *rate.Limiter
+
+	// maxBytes, if >0, is the maximum number of bytes of the stream.
+	maxBytes int64
+	// total, if not nil, is the budget shared with all other streams.
+	total *byteBudget
+
+	// admitted is the number of bytes admitted so far.
+	admitted int64
+	// truncated is the reason the stream was truncated, or "" if it wasn't.
+	truncated string
+}
+
+// wait blocks until n bytes can be read without exceeding the rate limits.
+func (l *streamLimits) wait(ctx context.Context, n int) error {
+	if l == nil {
+		return nil
+	}
+	for _, r := range []*rate.Limiter{l.rate, l.globalRate} {
+		if r != nil {
+			if err := r.WaitN(ctx, n); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// admit returns how many of n read bytes may be sent.
+//
+// Once this is less than n, the stream is truncated and admit always returns
+// 0.
+func (l *streamLimits) admit(n int) int {
+	if l == nil {
+		return n
+	}
+	if l.truncated != "" {
+		return 0
+	}
+
+	allowed := int64(n)
+	if l.maxBytes > 0 && l.admitted+allowed > l.maxBytes {
+		allowed = l.maxBytes - l.admitted
+		l.truncated = fmt.Sprintf("stream exceeded %d bytes", l.maxBytes)
+	}
+	if l.total != nil {
+		if took := l.total.take(allowed); took < allowed {
+			allowed = took
+			l.truncated = "butler exceeded its total size limit"
+		}
+	}
+	l.admitted += allowed
+	return int(allowed)
+}
+
+// truncationMarker returns the data appended to a truncated stream, or nil if
+// none should be.
+//
+// Only text streams get a marker, since it would corrupt binary and datagram
+// streams. lastByte is the last byte sent, or -1 if there was none.
+func truncationMarker(t logpb.StreamType, reason string, lastByte int) []byte {
+	if t != logpb.StreamType_TEXT {
+		return nil
+	}
+	marker := fmt.Sprintf("[logdog butler: stream truncated: %s]\n", reason)
+	if lastByte >= 0 && lastByte != '\n' {
+		marker = "\n" + marker
+	}
+	return []byte(marker)
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package butler
+
+import (
+	"context"
+	"testing"
+
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/common/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStreamLimits(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Stream limits`, t, func() {
+		Convey(`A nil streamLimits has no limits.`, func() {
+			var l *streamLimits
+			So(l.admit(100), ShouldEqual, 100)
+			So(l.wait(context.Background(), 100), ShouldBeNil)
+		})
+
+		Convey(`Share the total budget between streams.`, func() {
+			total := &byteBudget{remaining: 10}
+			a := &streamLimits{total: total}
+			b := &streamLimits{total: total, maxBytes: 100}
+
+			So(a.admit(4), ShouldEqual, 4)
+			So(b.admit(4), ShouldEqual, 4)
+			So(a.admit(4), ShouldEqual, 2)
+			So(a.truncated, ShouldEqual, "butler exceeded its total size limit")
+			So(b.admit(4), ShouldEqual, 0)
+			So(b.truncated, ShouldEqual, "butler exceeded its total size limit")
+			So(a.admit(4), ShouldEqual, 0)
+		})
+
+		Convey(`Stop admitting data once truncated.`, func() {
+			l := &streamLimits{maxBytes: 5}
+			So(l.admit(3), ShouldEqual, 3)
+			So(l.admit(3), ShouldEqual, 2)
+			So(l.truncated, ShouldEqual, "stream exceeded 5 bytes")
+			So(l.admit(3), ShouldEqual, 0)
+		})
+
+		Convey(`Rate limiters allow at least a single read.`, func() {
+			So(newByteRateLimiter(0), ShouldBeNil)
+			So(newByteRateLimiter(10).Burst(), ShouldEqual, minRateBurst)
+			So(newByteRateLimiter(10*minRateBurst).Burst(), ShouldEqual, 10*minRateBurst)
+		})
+
+		Convey(`Waiting for the rate stops when the context is canceled.`, func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			l := &streamLimits{rate: newByteRateLimiter(1)}
+			So(l.wait(ctx, minRateBurst), ShouldNotBeNil)
+		})
+
+		Convey(`Truncation markers`, func() {
+			So(string(truncationMarker(logpb.StreamType_TEXT, "x", 'a')), ShouldEqual,
+				"\n[logdog butler: stream truncated: x]\n")
+			So(string(truncationMarker(logpb.StreamType_TEXT, "x", '\n')), ShouldEqual,
+				"[logdog butler: stream truncated: x]\n")
+			So(truncationMarker(logpb.StreamType_BINARY, "x", 'a'), ShouldBeNil)
+		})
+
+		Convey(`The truncation tag is valid.`, func() {
+			So(types.ValidateTag(TruncatedTag, "stream exceeded 5 bytes"), ShouldBeNil)
+		})
+	})
+}
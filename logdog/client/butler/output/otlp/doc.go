@@ -0,0 +1,23 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otlp implements the "otlp" Output.
+//
+// It forwards Butler log streams to an OpenTelemetry collector using the
+// OTLP/HTTP logs protocol with the JSON encoding.
+//
+// Each stream becomes a separate resource whose attributes describe the stream
+// (see the Attr* constants). Each line of a text stream, each chunk of a binary
+// stream and each datagram becomes a separate log record.
+package otlp
@@ -0,0 +1,348 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/client/butler/bootstrap"
+	"go.chromium.org/luci/logdog/client/butler/output"
+)
+
+// Resource attributes describing a stream.
+const (
+	AttrServiceName  = "service.name"
+	AttrStreamPrefix = "logdog.stream.prefix"
+	AttrStreamName   = "logdog.stream.name"
+	AttrStreamType   = "logdog.stream.type"
+	AttrContentType  = "logdog.stream.content_type"
+	// AttrTagPrefix is prepended to the stream tag keys.
+	AttrTagPrefix = "logdog.tag."
+)
+
+// Log record attributes.
+const (
+	AttrStreamIndex = "logdog.stream_index"
+	AttrLineIndex   = "logdog.line_index"
+)
+
+// scopeName is the name of the instrumentation scope of all log records.
+const scopeName = "go.chromium.org/luci/logdog/client/butler"
+
+// DefaultServiceName is the default value of the "service.name" attribute.
+const DefaultServiceName = "logdog_butler"
+
+// Options should be used to configure and make an OTLP Output (using the
+// .New() method).
+type Options struct {
+	// Endpoint is the base URL of the OTLP/HTTP receiver, e.g.
+	// "http://localhost:4318". Logs are sent to "<Endpoint>/v1/logs".
+	Endpoint string
+
+	// Headers are additional HTTP headers to send with each request, e.g. for
+	// authentication.
+	Headers map[string]string
+
+	// ServiceName is the value of the "service.name" resource attribute.
+	//
+	// If empty, DefaultServiceName will be used.
+	ServiceName string
+
+	// Client is the HTTP client to use. If nil, http.DefaultClient will be used.
+	Client *http.Client
+
+	// BundleSize is the maximum size of the Butler bundle to use. If <= 0,
+	// a default of 1MB will be used.
+	BundleSize int
+
+	// RPCTimeout is the timeout to apply to an individual request. If zero, no
+	// timeout will be applied.
+	RPCTimeout time.Duration
+
+	// Retry is the retry strategy for transient errors (network errors, HTTP
+	// 429 and 5xx). If nil, a limited exponential backoff will be used.
+	Retry retry.Factory
+}
+
+// New creates a new OTLP Output from the specified Options.
+func (opt Options) New(c context.Context) output.Output {
+	if opt.ServiceName == "" {
+		opt.ServiceName = DefaultServiceName
+	}
+	if opt.Client == nil {
+		opt.Client = http.DefaultClient
+	}
+	if opt.BundleSize <= 0 {
+		opt.BundleSize = 1024 * 1024
+	}
+	if opt.Retry == nil {
+		opt.Retry = defaultRetry
+	}
+	o := &otlpOutput{
+		Options: &opt,
+		url:     strings.TrimRight(opt.Endpoint, "/") + "/v1/logs",
+	}
+	o.Context = log.SetField(c, "output", o)
+	return o
+}
+
+// otlpOutput is an output.Output implementation that sends log entries to an
+// OTLP/HTTP receiver.
+type otlpOutput struct {
+	context.Context
+	*Options
+
+	url string
+
+	statsMu sync.Mutex
+	stats   output.StatsBase
+}
+
+var _ output.Output = (*otlpOutput)(nil)
+
+func (o *otlpOutput) String() string { return fmt.Sprintf("otlp(%s)", o.Endpoint) }
+
+func (o *otlpOutput) SendBundle(bundle *logpb.ButlerLogBundle) error {
+	st := output.StatsBase{}
+	defer o.mergeStats(&st)
+
+	req, records := o.buildRequest(bundle)
+	if records == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		st.F.DiscardedMessages++
+		st.F.Errors++
+		return errors.Annotate(err, "failed to encode the OTLP request").Err()
+	}
+
+	if err := o.export(body); err != nil {
+		log.WithError(err).Errorf(o, "Failed to export logs.")
+		st.F.DiscardedMessages++
+		st.F.Errors++
+		return err
+	}
+
+	st.F.SentBytes += int64(len(body))
+	st.F.SentMessages++
+	return nil
+}
+
+func (o *otlpOutput) MaxSendBundles() int {
+	return 4
+}
+
+func (o *otlpOutput) MaxSize() int {
+	return o.BundleSize
+}
+
+func (o *otlpOutput) Stats() output.Stats {
+	o.statsMu.Lock()
+	defer o.statsMu.Unlock()
+
+	st := o.stats
+	return &st
+}
+
+func (o *otlpOutput) URLConstructionEnv() bootstrap.Environment {
+	return bootstrap.Environment{}
+}
+
+func (o *otlpOutput) Close() {}
+
+func (o *otlpOutput) mergeStats(s output.Stats) {
+	o.statsMu.Lock()
+	defer o.statsMu.Unlock()
+
+	o.stats.Merge(s)
+}
+
+// buildRequest converts a bundle into an OTLP request, one resource per
+// stream. Returns the request and the total number of log records in it.
+func (o *otlpOutput) buildRequest(bundle *logpb.ButlerLogBundle) (*ExportLogsServiceRequest, int) {
+	observed := strconv.FormatInt(clock.Now(o).UnixNano(), 10)
+
+	req := &ExportLogsServiceRequest{}
+	total := 0
+	for _, be := range bundle.GetEntries() {
+		desc := be.GetDesc()
+		if desc == nil || len(be.GetLogs()) == 0 {
+			continue
+		}
+
+		var base time.Time
+		if desc.Timestamp != nil {
+			base = desc.Timestamp.AsTime()
+		}
+
+		var records []*LogRecord
+		for _, le := range be.Logs {
+			ts := ""
+			if !base.IsZero() {
+				ts = strconv.FormatInt(base.Add(le.TimeOffset.AsDuration()).UnixNano(), 10)
+			}
+			add := func(body *AnyValue, attrs ...*KeyValue) {
+				records = append(records, &LogRecord{
+					TimeUnixNano:         ts,
+					ObservedTimeUnixNano: observed,
+					Body:                 body,
+					Attributes: append([]*KeyValue{
+						intAttr(AttrStreamIndex, int64(le.StreamIndex)),
+					}, attrs...),
+				})
+			}
+
+			switch {
+			case le.GetText() != nil:
+				for i, l := range le.GetText().Lines {
+					add(stringValue(string(l.Value)), intAttr(AttrLineIndex, int64(i)))
+				}
+			case le.GetBinary() != nil:
+				add(&AnyValue{BytesValue: le.GetBinary().Data})
+			case le.GetDatagram() != nil:
+				add(&AnyValue{BytesValue: le.GetDatagram().Data})
+			}
+		}
+
+		total += len(records)
+		req.ResourceLogs = append(req.ResourceLogs, &ResourceLogs{
+			Resource: &Resource{Attributes: o.resourceAttrs(bundle, desc)},
+			ScopeLogs: []*ScopeLogs{{
+				Scope:      &InstrumentationScope{Name: scopeName},
+				LogRecords: records,
+			}},
+		})
+	}
+	return req, total
+}
+
+// resourceAttrs returns the resource attributes describing a stream.
+func (o *otlpOutput) resourceAttrs(bundle *logpb.ButlerLogBundle, desc *logpb.LogStreamDescriptor) []*KeyValue {
+	prefix := desc.Prefix
+	if prefix == "" {
+		prefix = bundle.Prefix
+	}
+	attrs := []*KeyValue{
+		{Key: AttrServiceName, Value: stringValue(o.ServiceName)},
+		{Key: AttrStreamPrefix, Value: stringValue(prefix)},
+		{Key: AttrStreamName, Value: stringValue(desc.Name)},
+		{Key: AttrStreamType, Value: stringValue(desc.StreamType.String())},
+	}
+	if desc.ContentType != "" {
+		attrs = append(attrs, &KeyValue{Key: AttrContentType, Value: stringValue(desc.ContentType)})
+	}
+
+	tags := make([]string, 0, len(desc.Tags))
+	for k := range desc.Tags {
+		tags = append(tags, k)
+	}
+	sort.Strings(tags)
+	for _, k := range tags {
+		attrs = append(attrs, &KeyValue{Key: AttrTagPrefix + k, Value: stringValue(desc.Tags[k])})
+	}
+	return attrs
+}
+
+func intAttr(key string, v int64) *KeyValue {
+	s := strconv.FormatInt(v, 10)
+	return &KeyValue{Key: key, Value: &AnyValue{IntValue: &s}}
+}
+
+// export sends an encoded request, retrying transient errors.
+func (o *otlpOutput) export(body []byte) error {
+	return retry.Retry(o, transient.Only(o.Retry), func() error {
+		return o.exportOnce(body)
+	}, func(err error, d time.Duration) {
+		log.Fields{
+			log.ErrorKey: err,
+			"delay":      d,
+		}.Warningf(o, "TRANSIENT error exporting logs; retrying...")
+	})
+}
+
+func (o *otlpOutput) exportOnce(body []byte) error {
+	ctx := o.Context
+	if o.RPCTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = clock.WithTimeout(ctx, o.RPCTimeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", o.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Annotate(err, "failed to create the request").Err()
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range o.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := o.Client.Do(req)
+	if err != nil {
+		return errors.Annotate(err, "failed to send the request").Tag(transient.Tag).Err()
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		err := errors.Reason("OTLP receiver responded with HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(msg))).Err()
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			err = transient.Tag.Apply(err)
+		}
+		return err
+	}
+
+	out := &ExportLogsServiceResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+		return errors.Annotate(err, "failed to decode the OTLP response").Err()
+	}
+	if ps := out.PartialSuccess; ps != nil && ps.RejectedLogRecords != "" && ps.RejectedLogRecords != "0" {
+		log.Fields{
+			"rejected": ps.RejectedLogRecords,
+			"message":  ps.ErrorMessage,
+		}.Warningf(o, "OTLP receiver rejected some log records.")
+	}
+	return nil
+}
+
+// defaultRetry retries transient errors a few times with an exponential
+// backoff.
+func defaultRetry() retry.Iterator {
+	return &retry.ExponentialBackoff{
+		Limited: retry.Limited{
+			Retries: 5,
+			Delay:   500 * time.Millisecond,
+		},
+		MaxDelay: 10 * time.Second,
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/logdog/client/butler"
+	"go.chromium.org/luci/logdog/client/butlerlib/streamclient"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// receiver is an in-process OTLP/HTTP logs receiver.
+type receiver struct {
+	*httptest.Server
+
+	m        sync.Mutex
+	requests []*ExportLogsServiceRequest
+	headers  []http.Header
+	fail     int // the number of requests to fail with HTTP 503
+}
+
+func newReceiver() *receiver {
+	r := &receiver{}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.m.Lock()
+		defer r.m.Unlock()
+
+		if req.Method != "POST" || req.URL.Path != "/v1/logs" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		if r.fail > 0 {
+			r.fail--
+			http.Error(w, "try later", http.StatusServiceUnavailable)
+			return
+		}
+		body := &ExportLogsServiceRequest{}
+		if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.requests = append(r.requests, body)
+		r.headers = append(r.headers, req.Header)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	}))
+	return r
+}
+
+// streams returns the received records of each stream, keyed by
+// "<prefix>/+/<name>", and their resource attributes.
+func (r *receiver) streams() (records map[string][]string, attrs map[string]map[string]string) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	records = map[string][]string{}
+	attrs = map[string]map[string]string{}
+	for _, req := range r.requests {
+		for _, rl := range req.ResourceLogs {
+			ra := map[string]string{}
+			for _, kv := range rl.Resource.Attributes {
+				ra[kv.Key] = *kv.Value.StringValue
+			}
+			path := ra[AttrStreamPrefix] + "/+/" + ra[AttrStreamName]
+			attrs[path] = ra
+			for _, sl := range rl.ScopeLogs {
+				for _, lr := range sl.LogRecords {
+					switch b := lr.Body; {
+					case b.StringValue != nil:
+						records[path] = append(records[path], *b.StringValue)
+					default:
+						records[path] = append(records[path], string(b.BytesValue))
+					}
+				}
+			}
+		}
+	}
+	return
+}
+
+func TestOutput(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With an OTLP receiver`, t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		r := newReceiver()
+		defer r.Close()
+
+		o := Options{
+			Endpoint: r.URL + "/",
+			Headers:  map[string]string{"X-Api-Key": "secret"},
+			Retry:    func() retry.Iterator { return &retry.Limited{Retries: 3} },
+		}.New(ctx)
+		defer o.Close()
+
+		b, err := butler.New(ctx, butler.Config{Output: o, GlobalTags: map[string]string{"global": "tag"}})
+		So(err, ShouldBeNil)
+
+		didStop := false
+		stop := func() {
+			if !didStop {
+				didStop = true
+				b.Activate()
+				So(b.Wait(), ShouldBeNil)
+			}
+		}
+		defer stop()
+
+		sc := streamclient.NewLoopback(b, "ns")
+
+		Convey(`Forwards text streams`, func() {
+			stream, err := sc.NewStream(ctx, "stdout", streamclient.WithTags("neat", "thingy"))
+			So(err, ShouldBeNil)
+			_, err = fmt.Fprint(stream, "hello world!\nthis is pretty cool.\n")
+			So(err, ShouldBeNil)
+			So(stream.Close(), ShouldBeNil)
+			stop()
+
+			records, attrs := r.streams()
+			So(records, ShouldResemble, map[string][]string{
+				"/+/ns/stdout": {"hello world!", "this is pretty cool."},
+			})
+			So(attrs["/+/ns/stdout"], ShouldResemble, map[string]string{
+				AttrServiceName:          DefaultServiceName,
+				AttrStreamPrefix:         "",
+				AttrStreamName:           "ns/stdout",
+				AttrStreamType:           "TEXT",
+				AttrContentType:          "text/plain; charset=utf-8",
+				AttrTagPrefix + "neat":   "thingy",
+				AttrTagPrefix + "global": "tag",
+			})
+			So(r.headers[0].Get("X-Api-Key"), ShouldEqual, "secret")
+			So(r.headers[0].Get("Content-Type"), ShouldEqual, "application/json")
+
+			st := o.Stats()
+			So(st.SentMessages(), ShouldBeGreaterThan, 0)
+			So(st.Errors(), ShouldEqual, 0)
+		})
+
+		Convey(`Forwards datagram streams`, func() {
+			stream, err := sc.NewDatagramStream(ctx, "dg")
+			So(err, ShouldBeNil)
+			So(stream.WriteDatagram([]byte("one")), ShouldBeNil)
+			So(stream.WriteDatagram([]byte("two")), ShouldBeNil)
+			So(stream.Close(), ShouldBeNil)
+			stop()
+
+			records, attrs := r.streams()
+			So(records["/+/ns/dg"], ShouldResemble, []string{"one", "two"})
+			So(attrs["/+/ns/dg"][AttrStreamType], ShouldEqual, "DATAGRAM")
+		})
+
+		Convey(`Retries transient errors`, func() {
+			r.fail = 2
+
+			stream, err := sc.NewStream(ctx, "stdout")
+			So(err, ShouldBeNil)
+			_, err = fmt.Fprintln(stream, "eventually")
+			So(err, ShouldBeNil)
+			So(stream.Close(), ShouldBeNil)
+			stop()
+
+			records, _ := r.streams()
+			So(records["/+/ns/stdout"], ShouldResemble, []string{"eventually"})
+			So(o.Stats().Errors(), ShouldEqual, 0)
+		})
+	})
+
+	Convey(`Fails on fatal errors`, t, func() {
+		ctx := context.Background()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			http.Error(w, "bad request", http.StatusBadRequest)
+		}))
+		defer srv.Close()
+
+		o := Options{Endpoint: srv.URL}.New(ctx).(*otlpOutput)
+		req := &ExportLogsServiceRequest{}
+		body, err := json.Marshal(req)
+		So(err, ShouldBeNil)
+		err = o.export(body)
+		So(err, ShouldErrLike, "HTTP 400: bad request")
+	})
+}
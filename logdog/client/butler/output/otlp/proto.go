@@ -0,0 +1,85 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+// This file contains the subset of the OTLP logs data model used by the
+// output, in its canonical JSON encoding. See
+// https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/logs/v1/logs.proto
+//
+// Note that, per the OTLP/JSON spec, 64-bit integers are encoded as decimal
+// strings and bytes are encoded as base64.
+
+// ExportLogsServiceRequest is the body of a POST request to "/v1/logs".
+type ExportLogsServiceRequest struct {
+	ResourceLogs []*ResourceLogs `json:"resourceLogs"`
+}
+
+// ExportLogsServiceResponse is the body of a successful "/v1/logs" response.
+type ExportLogsServiceResponse struct {
+	PartialSuccess *ExportLogsPartialSuccess `json:"partialSuccess,omitempty"`
+}
+
+// ExportLogsPartialSuccess is set if the collector rejected some records.
+type ExportLogsPartialSuccess struct {
+	RejectedLogRecords string `json:"rejectedLogRecords,omitempty"`
+	ErrorMessage       string `json:"errorMessage,omitempty"`
+}
+
+// ResourceLogs is a collection of log records produced by a resource.
+type ResourceLogs struct {
+	Resource  *Resource    `json:"resource"`
+	ScopeLogs []*ScopeLogs `json:"scopeLogs"`
+}
+
+// Resource describes the entity that produced the logs.
+type Resource struct {
+	Attributes []*KeyValue `json:"attributes"`
+}
+
+// ScopeLogs is a collection of log records produced by an instrumentation
+// scope.
+type ScopeLogs struct {
+	Scope      *InstrumentationScope `json:"scope"`
+	LogRecords []*LogRecord          `json:"logRecords"`
+}
+
+// InstrumentationScope identifies the library that produced the logs.
+type InstrumentationScope struct {
+	Name string `json:"name"`
+}
+
+// LogRecord is a single log record.
+type LogRecord struct {
+	TimeUnixNano         string      `json:"timeUnixNano,omitempty"`
+	ObservedTimeUnixNano string      `json:"observedTimeUnixNano,omitempty"`
+	Body                 *AnyValue   `json:"body"`
+	Attributes           []*KeyValue `json:"attributes,omitempty"`
+}
+
+// KeyValue is an attribute.
+type KeyValue struct {
+	Key   string    `json:"key"`
+	Value *AnyValue `json:"value"`
+}
+
+// AnyValue is a value of an attribute or a log record body. Exactly one field
+// is set.
+type AnyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+	BytesValue  []byte  `json:"bytesValue,omitempty"`
+}
+
+func stringValue(v string) *AnyValue { return &AnyValue{StringValue: &v} }
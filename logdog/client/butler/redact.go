@@ -0,0 +1,199 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package butler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/tsmon/metric"
+)
+
+// maxRedactDelay is the maximum time the data of a text stream is held back
+// waiting for the rest of a possible secret before being redacted. Secrets
+// written in pieces further apart are not redacted.
+const maxRedactDelay = time.Second
+
+var tsRedactions = metric.NewCounter("logdog/butler/redactions",
+	"The number of secrets redacted from log streams.",
+	nil)
+
+// Redactor replaces secrets in the data of text streams.
+//
+// Its methods must be goroutine-safe.
+type Redactor interface {
+	// Redact returns data with the secrets replaced, and the number of
+	// replacements made. It may modify data in place.
+	Redact(data []byte) ([]byte, int)
+
+	// Complete returns the length of the longest prefix of data which can be
+	// redacted without waiting for more data, i.e. which doesn't end in the
+	// middle of a secret.
+	Complete(data []byte) int
+}
+
+// redactingReader is an io.Reader redacting the data read from a text stream.
+//
+// The data which may contain the beginning of a secret is held back until the
+// rest of it is read or for maxRedactDelay, so that secrets written in several
+// pieces are still redacted.
+type redactingReader struct {
+	ctx      context.Context
+	redactor Redactor
+	// total, if not nil, is incremented by the number of redactions.
+	total *int64
+	// count is the number of redactions made in this stream.
+	count int
+	// delay is the maximum time the data is held back.
+	delay time.Duration
+
+	// chunks receives the data read from the underlying reader, up to and
+	// including the first error.
+	chunks chan redactChunk
+	// done is closed by stop to make the reading goroutine exit.
+	done chan struct{}
+	// pending is the data read which hasn't been redacted yet.
+	pending []byte
+	// arrivals are the ends of the chunks in pending and when they were read.
+	arrivals []redactArrival
+	// deadline fires when the oldest data in pending must be redacted.
+	deadline <-chan clock.TimerResult
+	// out is the redacted data not read yet.
+	out []byte
+	err error
+}
+
+// errRedactingReaderStopped is returned by a redactingReader after it is
+// stopped.
+var errRedactingReaderStopped = errors.New("butler: the redacting reader was stopped")
+
+// redactChunk is the result of a read from the underlying reader.
+type redactChunk struct {
+	data []byte
+	err  error
+}
+
+// redactArrival is the end of a chunk of the pending data and when it was read.
+type redactArrival struct {
+	end int
+	at  time.Time
+}
+
+// newRedactingReader returns a redactingReader reading from r until an error
+// or until it is stopped.
+func newRedactingReader(ctx context.Context, r io.Reader, redactor Redactor, total *int64) *redactingReader {
+	rr := &redactingReader{
+		ctx:      ctx,
+		redactor: redactor,
+		total:    total,
+		delay:    maxRedactDelay,
+		chunks:   make(chan redactChunk),
+		done:     make(chan struct{}),
+	}
+	// Read in the background, so that the held back data can be returned when
+	// the underlying reader blocks.
+	go func() {
+		for {
+			buf := make([]byte, 4096)
+			n, err := r.Read(buf)
+			select {
+			case rr.chunks <- redactChunk{buf[:n], err}:
+			case <-rr.done:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return rr
+}
+
+func (rr *redactingReader) Read(p []byte) (int, error) {
+	for len(rr.out) == 0 {
+		if rr.err != nil {
+			return 0, rr.err
+		}
+
+		select {
+		case c := <-rr.chunks:
+			if len(c.data) > 0 {
+				rr.pending = append(rr.pending, c.data...)
+				rr.arrivals = append(rr.arrivals, redactArrival{len(rr.pending), clock.Now(rr.ctx)})
+			}
+			if c.err != nil {
+				rr.err = c.err
+				rr.redact(len(rr.pending))
+			} else {
+				rr.redact(rr.redactor.Complete(rr.pending))
+			}
+		case <-rr.deadline:
+			rr.redact(len(rr.pending))
+		case <-rr.done:
+			rr.err = errRedactingReaderStopped
+			rr.redact(len(rr.pending))
+		}
+	}
+
+	n := copy(p, rr.out)
+	rr.out = rr.out[n:]
+	return n, nil
+}
+
+// stop makes Read return the data read so far and then
+// errRedactingReaderStopped. The reading goroutine exits once the pending read
+// from the underlying reader returns.
+func (rr *redactingReader) stop() {
+	close(rr.done)
+}
+
+// redact moves the first n bytes of pending to out, redacting them.
+func (rr *redactingReader) redact(n int) {
+	if n > 0 {
+		// Copy the data, since the redactor may modify it in place.
+		data, count := rr.redactor.Redact(append([]byte(nil), rr.pending[:n]...))
+		if count > 0 {
+			rr.count += count
+			tsRedactions.Add(rr.ctx, int64(count))
+			if rr.total != nil {
+				atomic.AddInt64(rr.total, int64(count))
+			}
+		}
+		rr.out = data
+		rr.pending = append(rr.pending[:0], rr.pending[n:]...)
+	}
+
+	// Wait for the rest of the pending data for at most rr.delay since its
+	// oldest part was read.
+	i := 0
+	for i < len(rr.arrivals) && rr.arrivals[i].end <= n {
+		i++
+	}
+	rr.arrivals = append(rr.arrivals[:0], rr.arrivals[i:]...)
+	for j := range rr.arrivals {
+		rr.arrivals[j].end -= n
+	}
+	switch {
+	case len(rr.pending) == 0:
+		rr.deadline = nil
+	case rr.deadline == nil || i > 0:
+		wait := rr.delay - clock.Now(rr.ctx).Sub(rr.arrivals[0].at)
+		rr.deadline = clock.After(rr.ctx, wait)
+	}
+}
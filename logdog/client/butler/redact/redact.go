@@ -0,0 +1,223 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redact implements a butler.Redactor replacing literal secrets and
+// regular expression matches.
+package redact
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/lucictx"
+)
+
+// DefaultReplacement is the default text replacing redacted secrets.
+const DefaultReplacement = "[REDACTED]"
+
+// minSecretLength is the minimum length of a literal secret. Shorter ones
+// would redact too much unrelated text.
+const minSecretLength = 4
+
+// Redactor replaces secrets in log data.
+//
+// It is goroutine-safe.
+type Redactor struct {
+	// Replacement is the text replacing redacted secrets.
+	Replacement []byte
+
+	literals [][]byte
+	patterns []*regexp.Regexp
+}
+
+// New returns a Redactor replacing the given literal secrets and the matches
+// of the given regular expressions (in RE2 syntax) with DefaultReplacement.
+//
+// Empty and very short secrets are ignored. Regular expressions which can
+// match the empty string are rejected.
+func New(secrets, patterns []string) (*Redactor, error) {
+	r := &Redactor{Replacement: []byte(DefaultReplacement)}
+	r.AddSecrets(secrets...)
+	for _, p := range patterns {
+		parsed, err := syntax.Parse(p, syntax.Perl)
+		if err != nil {
+			return nil, errors.Annotate(err, "invalid redaction pattern %q", p).Err()
+		}
+		if matchesEmpty(parsed.Simplify()) {
+			return nil, errors.Reason("invalid redaction pattern %q: it matches the empty string", p).Err()
+		}
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, errors.Annotate(err, "invalid redaction pattern %q", p).Err()
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	return r, nil
+}
+
+// matchesEmpty returns true if the regular expression can match the empty
+// string somewhere in the text.
+func matchesEmpty(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpNoMatch, syntax.OpCharClass, syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		return false
+	case syntax.OpLiteral:
+		return len(re.Rune) == 0
+	case syntax.OpCapture, syntax.OpPlus:
+		return matchesEmpty(re.Sub[0])
+	case syntax.OpRepeat:
+		return re.Min == 0 || matchesEmpty(re.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if !matchesEmpty(sub) {
+				return false
+			}
+		}
+		return true
+	case syntax.OpAlternate:
+		for _, sub := range re.Sub {
+			if matchesEmpty(sub) {
+				return true
+			}
+		}
+		return false
+	default:
+		// OpEmptyMatch, OpStar, OpQuest and the zero-width assertions.
+		return true
+	}
+}
+
+// AddSecrets adds literal secrets to redact.
+//
+// It must not be called concurrently with Redact.
+func (r *Redactor) AddSecrets(secrets ...string) {
+	for _, s := range secrets {
+		if len(s) >= minSecretLength {
+			r.literals = append(r.literals, []byte(s))
+		}
+	}
+
+	// Replace longer secrets first, in case one contains another.
+	sort.SliceStable(r.literals, func(i, j int) bool {
+		return len(r.literals[i]) > len(r.literals[j])
+	})
+}
+
+// Empty returns true if the Redactor has nothing to redact.
+func (r *Redactor) Empty() bool {
+	return len(r.literals) == 0 && len(r.patterns) == 0
+}
+
+// Complete implements butler.Redactor.
+//
+// It holds back the end of the data which may be the beginning of a literal
+// secret. If there are regular expressions, it also holds back the last line
+// if it's incomplete, since they may match anything up to the end of a line.
+func (r *Redactor) Complete(data []byte) int {
+	n := len(data)
+	if len(r.patterns) > 0 {
+		n = bytes.LastIndexByte(data, '\n') + 1
+	}
+	if len(r.literals) == 0 {
+		return n
+	}
+
+	// A secret starting in the last len(longest)-1 bytes may be incomplete.
+	if cut := len(data) - len(r.literals[0]) + 1; cut < n {
+		n = cut
+	}
+	if n < 0 {
+		n = 0
+	}
+
+	// Don't split complete secrets crossing the end of the prefix. Without
+	// patterns the prefix can grow to include them, otherwise it must stay at the
+	// end of a line and they are held back entirely.
+	for moved := true; moved; {
+		moved = false
+		for _, s := range r.literals {
+			lo := n - len(s) + 1
+			if lo < 0 {
+				lo = 0
+			}
+			hi := n + len(s) - 1
+			if hi > len(data) {
+				hi = len(data)
+			}
+			if lo >= hi {
+				continue
+			}
+			if i := bytes.Index(data[lo:hi], s); i >= 0 {
+				if n = lo + i; len(r.patterns) == 0 {
+					n += len(s)
+				}
+				moved = true
+			}
+		}
+	}
+	return n
+}
+
+// Redact implements butler.Redactor.
+func (r *Redactor) Redact(data []byte) ([]byte, int) {
+	count := 0
+	for _, s := range r.literals {
+		if n := bytes.Count(data, s); n > 0 {
+			data = bytes.ReplaceAll(data, s, r.Replacement)
+			count += n
+		}
+	}
+	for _, re := range r.patterns {
+		data = re.ReplaceAllFunc(data, func([]byte) []byte {
+			count++
+			return r.Replacement
+		})
+	}
+	return data, count
+}
+
+// LUCIContextSecrets returns the secrets found in the LUCI_CONTEXT, in their
+// raw and base64 encodings.
+func LUCIContextSecrets(ctx context.Context) []string {
+	var raw [][]byte
+	if la := lucictx.GetLocalAuth(ctx); la != nil {
+		raw = append(raw, la.Secret)
+	}
+	if sw := lucictx.GetSwarming(ctx); sw != nil {
+		raw = append(raw, sw.SecretBytes)
+	}
+	if rdb := lucictx.GetResultDB(ctx); rdb != nil {
+		raw = append(raw, []byte(rdb.CurrentInvocation.GetUpdateToken()))
+	}
+	if rs := lucictx.GetResultSink(ctx); rs != nil {
+		raw = append(raw, []byte(rs.AuthToken))
+	}
+	if bb := lucictx.GetBuildbucket(ctx); bb != nil {
+		raw = append(raw, []byte(bb.ScheduleBuildToken))
+	}
+
+	var secrets []string
+	for _, s := range raw {
+		if len(s) == 0 {
+			continue
+		}
+		secrets = append(secrets, string(s), base64.StdEncoding.EncodeToString(s))
+	}
+	return secrets
+}
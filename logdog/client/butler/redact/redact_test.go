@@ -0,0 +1,139 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redact
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"go.chromium.org/luci/lucictx"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRedactor(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A Redactor`, t, func() {
+		Convey(`Replaces literal secrets.`, func() {
+			r, err := New([]string{"hunter2", "hunter2-extended", "", "ab"}, nil)
+			So(err, ShouldBeNil)
+
+			data, n := r.Redact([]byte("pw=hunter2 long=hunter2-extended ab\n"))
+			So(string(data), ShouldEqual, "pw=[REDACTED] long=[REDACTED] ab\n")
+			So(n, ShouldEqual, 2)
+		})
+
+		Convey(`Replaces regular expression matches.`, func() {
+			r, err := New(nil, []string{`ya29\.[A-Za-z0-9_-]+`})
+			So(err, ShouldBeNil)
+
+			data, n := r.Redact([]byte("token ya29.abc-DEF and ya29.xyz\n"))
+			So(string(data), ShouldEqual, "token [REDACTED] and [REDACTED]\n")
+			So(n, ShouldEqual, 2)
+		})
+
+		Convey(`Leaves other data alone.`, func() {
+			r, err := New([]string{"hunter2"}, []string{`secret\d+`})
+			So(err, ShouldBeNil)
+
+			data, n := r.Redact([]byte("nothing to see here\n"))
+			So(string(data), ShouldEqual, "nothing to see here\n")
+			So(n, ShouldEqual, 0)
+		})
+
+		Convey(`Rejects invalid patterns.`, func() {
+			_, err := New(nil, []string{"("})
+			So(err, ShouldErrLike, "invalid redaction pattern")
+		})
+
+		Convey(`Rejects patterns matching the empty string.`, func() {
+			for _, p := range []string{`a*`, `x?`, `^`, `\b`, `(a|)`, `a{0,3}`} {
+				_, err := New(nil, []string{p})
+				So(err, ShouldErrLike, "matches the empty string")
+			}
+			for _, p := range []string{`a+`, `x?y`, `^a`, `(a|b)`, `a{1,3}`} {
+				_, err := New(nil, []string{p})
+				So(err, ShouldBeNil)
+			}
+		})
+
+		Convey(`Completes data.`, func() {
+			Convey(`Holds back possible beginnings of literal secrets.`, func() {
+				r, err := New([]string{"hunter2"}, nil)
+				So(err, ShouldBeNil)
+
+				So(r.Complete([]byte("pw=")), ShouldEqual, 0)
+				So(r.Complete([]byte("a prompt> ")), ShouldEqual, 4)
+				So(r.Complete([]byte("pw=hunter2")), ShouldEqual, 10)
+				So(r.Complete([]byte("pw=hunter2 and")), ShouldEqual, 10)
+			})
+
+			Convey(`Holds back incomplete lines with patterns.`, func() {
+				r, err := New(nil, []string{`secret\d+`})
+				So(err, ShouldBeNil)
+
+				So(r.Complete([]byte("secret1\nsecret2")), ShouldEqual, 8)
+				So(r.Complete([]byte("secret1\n")), ShouldEqual, 8)
+			})
+
+			Convey(`Doesn't split literal secrets at the end of a line.`, func() {
+				r, err := New([]string{"hunter2"}, []string{`secret\d+`})
+				So(err, ShouldBeNil)
+
+				So(r.Complete([]byte("pw=hunter2\nxxxxxx")), ShouldEqual, 11)
+				So(r.Complete([]byte("x\nhunter2")), ShouldEqual, 2)
+			})
+		})
+
+		Convey(`Is empty without secrets.`, func() {
+			r, err := New([]string{"ab"}, nil)
+			So(err, ShouldBeNil)
+			So(r.Empty(), ShouldBeTrue)
+		})
+	})
+}
+
+func TestLUCIContextSecrets(t *testing.T) {
+	t.Parallel()
+
+	Convey(`LUCIContextSecrets`, t, func() {
+		ctx := context.Background()
+
+		Convey(`Returns nothing without a LUCI_CONTEXT.`, func() {
+			So(LUCIContextSecrets(ctx), ShouldBeEmpty)
+		})
+
+		Convey(`Returns the secrets in the LUCI_CONTEXT.`, func() {
+			ctx = lucictx.SetLocalAuth(ctx, &lucictx.LocalAuth{
+				RpcPort: 1234,
+				Secret:  []byte("local-auth-secret"),
+			})
+			ctx = lucictx.SetResultSink(ctx, &lucictx.ResultSink{
+				Address:   "localhost:1",
+				AuthToken: "sink-token",
+			})
+
+			So(LUCIContextSecrets(ctx), ShouldResemble, []string{
+				"local-auth-secret",
+				base64.StdEncoding.EncodeToString([]byte("local-auth-secret")),
+				"sink-token",
+				base64.StdEncoding.EncodeToString([]byte("sink-token")),
+			})
+		})
+	})
+}
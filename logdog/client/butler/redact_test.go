@@ -0,0 +1,140 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package butler
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/logdog/client/butler/redact"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// chunkReader returns its data in reads of at most size bytes.
+type chunkReader struct {
+	data []byte
+	size int
+}
+
+func (cr *chunkReader) Read(p []byte) (int, error) {
+	if len(cr.data) == 0 {
+		return 0, io.EOF
+	}
+	n := cr.size
+	if n > len(p) {
+		n = len(p)
+	}
+	if n > len(cr.data) {
+		n = len(cr.data)
+	}
+	copy(p, cr.data[:n])
+	cr.data = cr.data[n:]
+	return n, nil
+}
+
+func TestRedactingReader(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A redacting reader`, t, func() {
+		ctx := context.Background()
+		var total int64
+
+		redactor, err := redact.New([]string{"secret"}, nil)
+		So(err, ShouldBeNil)
+		redactor.Replacement = []byte("***")
+
+		read := func(data string, chunk int) string {
+			rr := newRedactingReader(ctx, &chunkReader{data: []byte(data), size: chunk}, redactor, &total)
+			out, err := io.ReadAll(rr)
+			So(err, ShouldBeNil)
+			return string(out)
+		}
+
+		// readN reads exactly n bytes from rr.
+		readN := func(rr io.Reader, n int) string {
+			buf := make([]byte, n)
+			_, err := io.ReadFull(rr, buf)
+			So(err, ShouldBeNil)
+			return string(buf)
+		}
+
+		Convey(`Redacts secrets.`, func() {
+			So(read("a secret\nanother secret\n", 4096), ShouldEqual, "a ***\nanother ***\n")
+			So(total, ShouldEqual, 2)
+		})
+
+		Convey(`Redacts secrets split between reads.`, func() {
+			So(read("a secret\nno newline secret", 3), ShouldEqual, "a ***\nno newline ***")
+			So(total, ShouldEqual, 2)
+		})
+
+		Convey(`Doesn't wait for the end of a line.`, func() {
+			pr, pw := io.Pipe()
+			defer pw.Close()
+			rr := newRedactingReader(ctx, pr, redactor, &total)
+			rr.delay = time.Hour
+			defer rr.stop()
+
+			go pw.Write([]byte("a secret and a prompt> "))
+			// Only the end which may be the beginning of a secret is held back.
+			So(readN(rr, 15), ShouldEqual, "a *** and a pro")
+
+			go func() {
+				pw.Write([]byte("sec"))
+				pw.Write([]byte("ret\n"))
+			}()
+			So(readN(rr, 8), ShouldEqual, "mpt> ***")
+			So(total, ShouldEqual, 2)
+		})
+
+		Convey(`Returns held back data after the delay.`, func() {
+			pr, pw := io.Pipe()
+			defer pw.Close()
+			rr := newRedactingReader(ctx, pr, redactor, &total)
+			rr.delay = 10 * time.Millisecond
+			defer rr.stop()
+
+			go pw.Write([]byte("> "))
+			So(readN(rr, 2), ShouldEqual, "> ")
+
+			// Pieces of a secret written further apart than the delay aren't
+			// redacted.
+			go pw.Write([]byte("sec"))
+			So(readN(rr, 3), ShouldEqual, "sec")
+			So(total, ShouldEqual, 0)
+		})
+
+		Convey(`Returns an error when stopped.`, func() {
+			pr, pw := io.Pipe()
+			defer pw.Close()
+			rr := newRedactingReader(ctx, pr, redactor, &total)
+			rr.stop()
+
+			_, err := rr.Read(make([]byte, 10))
+			So(err, ShouldEqual, errRedactingReaderStopped)
+		})
+
+		Convey(`Counts redactions per stream.`, func() {
+			rr := newRedactingReader(ctx, &chunkReader{data: []byte("secret secret\n"), size: 5}, redactor, &total)
+			_, err := io.ReadAll(rr)
+			So(err, ShouldBeNil)
+			So(rr.count, ShouldEqual, 2)
+			So(total, ShouldEqual, 2)
+		})
+	})
+}
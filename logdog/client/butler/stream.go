@@ -15,10 +15,12 @@
 package butler
 
 import (
+	"context"
 	"io"
 	"time"
 
 	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/client/butler/bundler"
 	"go.chromium.org/luci/logdog/common/types"
 )
@@ -27,11 +29,23 @@ type stream struct {
 	log logging.Logger
 	now func() time.Time
 
-	name types.StreamName
+	name       types.StreamName
+	streamType logpb.StreamType
 
 	r  io.Reader
 	c  io.Closer
 	bs bundler.Stream
+
+	// ctx is used to wait for rate limits. It is canceled when the stream is
+	// closed.
+	ctx    context.Context
+	cancel context.CancelFunc
+	// limits, if not nil, are the stream's rate limits and size caps.
+	limits *streamLimits
+	// lastByte is the last byte sent to the bundler, or -1 if none was.
+	lastByte int
+	// redactor, if not nil, is the reader redacting secrets from r.
+	redactor *redactingReader
 }
 
 func (s *stream) readChunk() bool {
@@ -39,18 +53,34 @@ func (s *stream) readChunk() bool {
 
 	amount, err := s.r.Read(d.Bytes())
 	if amount > 0 {
-		d.Bind(amount, s.now())
-
 		s.log.Debugf("Read %d bytes", amount)
 
-		// Add the data to our bundler endpoint. This may block waiting for the
-		// bundler to consume data.
-
-		err := s.bs.Append(d)
-		d = nil // Append takes ownership of "d" regardless of error.
-		if err != nil {
-			s.log.Errorf("Failed to Append to the stream: %s", err)
-			return false
+		// Data beyond the stream's size limits is discarded.
+		wasTruncated := s.limits != nil && s.limits.truncated != ""
+		if admitted := s.limits.admit(amount); admitted > 0 {
+			if err := s.limits.wait(s.ctx, admitted); err != nil {
+				s.log.Warningf("Stopped waiting for the rate limit: %s", err)
+				d.Release()
+				return false
+			}
+
+			s.lastByte = int(d.Bytes()[admitted-1])
+			d.Bind(admitted, s.now())
+
+			// Add the data to our bundler endpoint. This may block waiting for the
+			// bundler to consume data.
+			err := s.bs.Append(d)
+			d = nil // Append takes ownership of "d" regardless of error.
+			if err != nil {
+				s.log.Errorf("Failed to Append to the stream: %s", err)
+				return false
+			}
+		}
+		if !wasTruncated && s.limits != nil && s.limits.truncated != "" {
+			if err := s.truncate(s.limits.truncated); err != nil {
+				s.log.Errorf("Failed to Append the truncation marker to the stream: %s", err)
+				return false
+			}
 		}
 	}
 
@@ -76,9 +106,38 @@ func (s *stream) readChunk() bool {
 	return true
 }
 
+// truncate marks the stream as truncated, appending a truncation marker to it
+// if it's a text stream.
+func (s *stream) truncate(reason string) error {
+	s.log.Warningf("Truncating the stream: %s", reason)
+	s.bs.SetTag(TruncatedTag, reason)
+
+	marker := truncationMarker(s.streamType, reason, s.lastByte)
+	if marker == nil {
+		return nil
+	}
+	d := s.bs.LeaseData()
+	d.Bind(copy(d.Bytes(), marker), s.now())
+	return s.bs.Append(d)
+}
+
+// logRedactions logs the number of secrets redacted from the stream. It must be
+// called after the stream is done reading.
+func (s *stream) logRedactions() {
+	if s.redactor != nil && s.redactor.count > 0 {
+		s.log.Infof("Redacted %d secret(s) from the stream.", s.redactor.count)
+	}
+}
+
 func (s *stream) closeStream() {
+	if s.cancel != nil {
+		s.cancel()
+	}
 	if err := s.c.Close(); err != nil {
 		s.log.Warningf("Error closing stream: ", err)
 	}
+	if s.redactor != nil {
+		s.redactor.stop()
+	}
 	s.bs.Close()
 }
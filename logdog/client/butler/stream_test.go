@@ -25,6 +25,7 @@ import (
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/clock/testclock"
 	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/client/butler/bundler"
 )
 
@@ -33,6 +34,7 @@ type testBundlerStream struct {
 	appended []byte
 	ts       []time.Time
 	err      error
+	tags     map[string]string
 
 	data []*testBundlerData
 }
@@ -64,6 +66,13 @@ func (bs *testBundlerStream) Close() {
 	bs.closed = true
 }
 
+func (bs *testBundlerStream) SetTag(key, value string) {
+	if bs.tags == nil {
+		bs.tags = map[string]string{}
+	}
+	bs.tags[key] = value
+}
+
 func (bs *testBundlerStream) allReleased() bool {
 	for _, d := range bs.data {
 		if !d.released {
@@ -185,6 +194,46 @@ func TestStream(t *testing.T) {
 			So(bs.closedAndReleased(), ShouldBeTrue)
 		})
 
+		Convey(`With a size limit`, func() {
+			s.limits = &streamLimits{maxBytes: 5}
+			s.lastByte = -1
+
+			Convey(`Truncates a text stream, appending a marker.`, func() {
+				rc.data = []byte("foo")
+				So(s.readChunk(), ShouldBeTrue)
+				rc.data = []byte("barbaz")
+				So(s.readChunk(), ShouldBeTrue)
+				rc.data = []byte("qux")
+				So(s.readChunk(), ShouldBeTrue)
+
+				s.closeStream()
+				So(string(bs.appended), ShouldEqual,
+					"fooba\n[logdog butler: stream truncated: stream exceeded 5 bytes]\n")
+				So(bs.tags, ShouldResemble, map[string]string{TruncatedTag: "stream exceeded 5 bytes"})
+				So(bs.closedAndReleased(), ShouldBeTrue)
+			})
+
+			Convey(`Truncates a binary stream without a marker.`, func() {
+				s.streamType = logpb.StreamType_BINARY
+				rc.data = []byte("foobarbaz")
+				So(s.readChunk(), ShouldBeTrue)
+
+				s.closeStream()
+				So(string(bs.appended), ShouldEqual, "fooba")
+				So(bs.tags, ShouldResemble, map[string]string{TruncatedTag: "stream exceeded 5 bytes"})
+				So(bs.closedAndReleased(), ShouldBeTrue)
+			})
+
+			Convey(`Does not truncate a stream within the limit.`, func() {
+				rc.data = []byte("fooba")
+				So(s.readChunk(), ShouldBeTrue)
+
+				s.closeStream()
+				So(string(bs.appended), ShouldEqual, "fooba")
+				So(bs.tags, ShouldBeNil)
+			})
+		})
+
 		Convey(`Will close Bundler Stream even if Closer returns an error.`, func() {
 			rc.err = errors.New("test error")
 			s.closeStream()
@@ -163,6 +163,31 @@ func (c *Client) NewDatagramStream(ctx context.Context, name types.StreamName, o
 	return ret, errors.Annotate(err, "attempting to connect datagram stream %q", name).Err()
 }
 
+// NewJSONLinesStream returns a new structured log stream to the butler.
+//
+// This is a text stream with the content type types.ContentTypeJSONLines,
+// where each record is written as a line holding a JSON object.
+//
+// NOTE: It is an error to pass ForProcess or Binary as an Option.
+func (c *Client) NewJSONLinesStream(ctx context.Context, name types.StreamName, opts ...Option) (JSONLinesStream, error) {
+	newOpts := make([]Option, 0, len(opts)+1)
+	newOpts = append(newOpts, opts...)
+	newOpts = append(newOpts, WithContentType(types.ContentTypeJSONLines))
+
+	fullOpts, err := c.mkOptions(ctx, name, newOpts)
+	if err != nil {
+		return nil, err
+	}
+	if fullOpts.forProcess {
+		return nil, errors.Reason("cannot specify ForProcess on a JSON lines stream").Err()
+	}
+	ret, err := c.dial.DialStream(false, fullOpts.desc)
+	if err != nil {
+		return nil, errors.Annotate(err, "attempting to connect JSON lines stream %q", name).Err()
+	}
+	return &jsonLinesStreamWriter{Raw: ret}, nil
+}
+
 // GetNamespace returns the LOGDOG_NAMESPACE value associated with this Client.
 //
 // Safe to call on a nil client; will return an empty StreamName.
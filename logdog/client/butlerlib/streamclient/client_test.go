@@ -46,6 +46,17 @@ func TestClientGeneral(t *testing.T) {
 			So(err, ShouldErrLike, "cannot specify ForProcess on a datagram stream")
 		})
 
+		Convey(`bad options for a JSON lines stream`, func() {
+			client, err := New(scFake.StreamServerPath(), "")
+			So(err, ShouldBeNil)
+
+			_, err = client.NewJSONLinesStream(ctx, "test", ForProcess())
+			So(err, ShouldErrLike, "cannot specify ForProcess on a JSON lines stream")
+
+			_, err = client.NewJSONLinesStream(ctx, "test", Binary())
+			So(err, ShouldErrLike, "requires a TEXT stream")
+		})
+
 		Convey(`bad options`, func() {
 			client, err := New(scFake.StreamServerPath(), "")
 			So(err, ShouldBeNil)
@@ -102,6 +102,30 @@ func TestFakeProtocol(t *testing.T) {
 					Tags:        nil,
 				})
 			})
+
+			Convey(`can use a JSON lines stream`, func() {
+				stream, err := client.NewJSONLinesStream(ctx, "test")
+				So(err, ShouldBeNil)
+
+				So(stream.WriteRecord(map[string]any{"level": "INFO", "msg": "a\nb"}), ShouldBeNil)
+				So(stream.WriteRecord(struct {
+					Level string `json:"level"`
+				}{"ERROR"}), ShouldBeNil)
+				So(stream.WriteRecord("not an object"), ShouldErrLike, "must encode to a JSON object")
+				So(stream.Close(), ShouldBeNil)
+
+				streamData := scFake.Data()["namespace/test"]
+				So(streamData, ShouldNotBeNil)
+				So(streamData.GetStreamData(), ShouldEqual,
+					`{"level":"INFO","msg":"a\nb"}`+"\n"+`{"level":"ERROR"}`+"\n")
+				So(streamData.GetFlags(), ShouldResemble, streamproto.Flags{
+					Name:        "namespace/test",
+					ContentType: "application/jsonl",
+					Type:        streamproto.StreamType(logpb.StreamType_TEXT),
+					Timestamp:   clockflag.Time(testclock.TestTimeUTC),
+					Tags:        nil,
+				})
+			})
 		})
 
 		Convey(`bad`, func() {
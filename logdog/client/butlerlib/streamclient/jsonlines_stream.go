@@ -0,0 +1,71 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// JSONLinesStream is the interface for structured log streams.
+//
+// Each record is written as a single line holding a JSON object, so its
+// fields can be used to filter the stream (e.g. `logdog cat -where`).
+type JSONLinesStream interface {
+	io.Closer
+
+	// WriteRecord writes `record` as a single line.
+	//
+	// `record` must encode to a JSON object, e.g. a map or a struct.
+	WriteRecord(record any) error
+}
+
+// jsonLinesStreamWriter implements a JSONLinesStream on top of a raw text
+// stream writer.
+type jsonLinesStreamWriter struct {
+	Raw io.WriteCloser
+
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+// Close implements io.Closer.
+func (w *jsonLinesStreamWriter) Close() error {
+	return w.Raw.Close()
+}
+
+// WriteRecord implements JSONLinesStream.
+func (w *jsonLinesStreamWriter) WriteRecord(record any) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf.Reset()
+	enc := json.NewEncoder(&w.buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(record); err != nil {
+		return errors.Annotate(err, "failed to encode record").Err()
+	}
+	if w.buf.Bytes()[0] != '{' {
+		return errors.Reason("record must encode to a JSON object, got %q", bytes.TrimSpace(w.buf.Bytes())).Err()
+	}
+
+	// Encode terminates the line with "\n", and escapes any newlines within it.
+	_, err := w.Raw.Write(w.buf.Bytes())
+	return err
+}
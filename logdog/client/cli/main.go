@@ -171,6 +171,8 @@ func Main(ctx context.Context, params Parameters) int {
 				newCatCommand(),
 				newQueryCommand(),
 				newLatestCommand(),
+				newGrepCommand(),
+				newDownloadCommand(),
 				authcli.SubcommandLogin(authOptions, "auth-login", false),
 				authcli.SubcommandLogout(authOptions, "auth-logout", false),
 				authcli.SubcommandInfo(authOptions, "auth-info", false),
@@ -16,18 +16,22 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/flag/flagenum"
 	log "go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/client/coordinator"
 	"go.chromium.org/luci/logdog/common/fetcher"
+	"go.chromium.org/luci/logdog/common/jsonlines"
 	"go.chromium.org/luci/logdog/common/renderer"
 	"go.chromium.org/luci/logdog/common/types"
 	annopb "go.chromium.org/luci/luciexe/legacy/annotee/proto"
@@ -53,6 +57,43 @@ var timestampFlagEnum = flagenum.Enum{
 	"utc":   timestampsUTC,
 }
 
+type catFormatFlag string
+
+const (
+	catFormatText catFormatFlag = "text"
+	catFormatRaw  catFormatFlag = "raw"
+	catFormatHTML catFormatFlag = "html"
+)
+
+func (f *catFormatFlag) Set(v string) error { return catFormatFlagEnum.FlagSet(f, v) }
+func (f *catFormatFlag) String() string     { return catFormatFlagEnum.FlagString(f) }
+
+var catFormatFlagEnum = flagenum.Enum{
+	"text": catFormatText,
+	"raw":  catFormatRaw,
+	"html": catFormatHTML,
+}
+
+// whereFlag is a repeatable flag of jsonlines conditions.
+type whereFlag jsonlines.Filter
+
+func (f *whereFlag) Set(v string) error {
+	c, err := jsonlines.ParseCondition(v)
+	if err != nil {
+		return err
+	}
+	*f = append(*f, c)
+	return nil
+}
+
+func (f *whereFlag) String() string {
+	parts := make([]string, len(*f))
+	for i, c := range *f {
+		parts[i] = c.String()
+	}
+	return strings.Join(parts, ",")
+}
+
 type catCommandRun struct {
 	subcommands.CommandRunBase
 
@@ -62,17 +103,31 @@ type catCommandRun struct {
 	fetchSize  int
 	fetchBytes int
 	raw        bool
+	format     catFormatFlag
 
 	timestamps      timestampsFlag
 	showStreamIndex bool
+
+	follow bool
+	where  whereFlag
 }
 
+const (
+	// followMinDelay is the initial delay between polls in -follow mode.
+	followMinDelay = time.Second
+	// followMaxDelay is the maximum delay between polls in -follow mode.
+	followMaxDelay = 30 * time.Second
+	// followFlushInterval is how long -follow mode waits for entries from idle
+	// streams before emitting already fetched entries of the other streams.
+	followFlushInterval = time.Second
+)
+
 func newCatCommand() *subcommands.Command {
 	return &subcommands.Command{
 		UsageLine: "cat",
 		ShortDesc: "Write log stream to STDOUT.",
 		CommandRun: func() subcommands.CommandRun {
-			cmd := &catCommandRun{}
+			cmd := &catCommandRun{format: catFormatText}
 
 			cmd.Flags.Int64Var(&cmd.index, "index", 0, "Starting index.")
 			cmd.Flags.Int64Var(&cmd.count, "count", 0, "The number of log entries to fetch.")
@@ -86,7 +141,19 @@ func newCatCommand() *subcommands.Command {
 			cmd.Flags.IntVar(&cmd.fetchSize, "fetch-size", 0, "Constrains the number of log entries to fetch per request.")
 			cmd.Flags.IntVar(&cmd.fetchBytes, "fetch-bytes", 0, "Constrains the number of bytes to fetch per request.")
 			cmd.Flags.BoolVar(&cmd.raw, "raw", false,
-				"Reproduce original log stream, instead of attempting to render for humans.")
+				"Reproduce original log stream, instead of attempting to render for humans. "+
+					"Same as -format raw.")
+			cmd.Flags.Var(&cmd.format, "format",
+				"The output format. Options are: "+catFormatFlagEnum.Choices()+". The 'html' format renders "+
+					"a standalone page with ANSI colors converted to styles and an anchor for each line.")
+			cmd.Flags.BoolVar(&cmd.follow, "follow", false,
+				"Keep polling the streams (with backoff) until they are terminated, like 'tail -f'. "+
+					"Multiple streams are fetched concurrently and interleaved by timestamp, with "+
+					"each text line prefixed by its stream path.")
+			cmd.Flags.Var(&cmd.where, "where",
+				"Only show records of structured (JSON lines) streams whose field matches, e.g. 'level=ERROR' "+
+					"or 'http.status!=200'. Nested fields are separated by dots. Can be repeated; all "+
+					"conditions must match. Lines which are not JSON objects are dropped.")
 			return cmd
 		},
 	}
@@ -156,7 +223,28 @@ func (cmd *catCommandRun) Run(scApp subcommands.Application, args []string, _ su
 		}
 	}
 
+	if cmd.raw {
+		cmd.format = catFormatRaw
+	}
+	if cmd.format == catFormatHTML {
+		if err := renderer.WriteHTMLHeader(os.Stdout, strings.Join(args, " ")); err != nil {
+			log.WithError(err).Errorf(a, "Failed to write the HTML header.")
+			return 1
+		}
+		defer renderer.WriteHTMLFooter(os.Stdout)
+	}
+
 	tctx, _ := a.timeoutCtx(a)
+	if cmd.follow {
+		if err := cmd.followPaths(tctx, coords, addrs); err != nil {
+			log.WithError(err).Errorf(a, "Failed to follow log streams.")
+			if err == context.DeadlineExceeded {
+				return 2
+			}
+			return 1
+		}
+		return 0
+	}
 	for i, addr := range addrs {
 		if err := cmd.catPath(tctx, coords[addr.Host], addr); err != nil {
 			log.Fields{
@@ -186,15 +274,16 @@ func (cmd *catCommandRun) catPath(c context.Context, coord *coordinator.Client,
 	})
 
 	rend := renderer.Renderer{
-		Source: f,
-		Raw:    cmd.raw,
+		Source: cmd.filtered(f),
+		Raw:    cmd.format == catFormatRaw,
+		HTML:   cmd.format == catFormatHTML,
 		TextPrefix: func(le *logpb.LogEntry, line *logpb.Text_Line) string {
 			desc := f.Descriptor()
 			if desc == nil {
 				log.Errorf(c, "Failed to get text prefix descriptor.")
 				return ""
 			}
-			return cmd.getTextPrefix(desc, le)
+			return cmd.getTextPrefix("", desc, le)
 		},
 		DatagramWriter: func(w io.Writer, dg []byte) bool {
 			desc := f.Descriptor()
@@ -211,8 +300,190 @@ func (cmd *catCommandRun) catPath(c context.Context, coord *coordinator.Client,
 	return nil
 }
 
-func (cmd *catCommandRun) getTextPrefix(desc *logpb.LogStreamDescriptor, le *logpb.LogEntry) string {
+// filtered applies the -where filter, if any, to a stream's entries.
+func (cmd *catCommandRun) filtered(f *fetcher.Fetcher) renderer.Source {
+	if len(cmd.where) == 0 {
+		return f
+	}
+	return &jsonlines.FilteredSource{Source: f, Filter: jsonlines.Filter(cmd.where)}
+}
+
+// followPaths fetches all streams concurrently until they are all terminated,
+// writing their interleaved entries to STDOUT.
+func (cmd *catCommandRun) followPaths(c context.Context, coords map[string]*coordinator.Client, addrs []*types.StreamAddr) error {
+	c, cancel := context.WithCancel(c)
+	defer cancel()
+
+	src := newFollowSource(c)
+	for _, addr := range addrs {
+		name := ""
+		if len(addrs) > 1 {
+			name = fmt.Sprintf("%s/%s", addr.Project, addr.Path)
+		}
+		f := coords[addr.Host].Stream(addr.Project, addr.Path).Fetcher(c, &fetcher.Options{
+			Index:       types.MessageIndex(cmd.index),
+			Count:       cmd.count,
+			BufferCount: cmd.fetchSize,
+			BufferBytes: int64(cmd.fetchBytes),
+			Delay:       followMinDelay,
+			MaxDelay:    followMaxDelay,
+		})
+		src.add(name, f.Descriptor, cmd.filtered(f))
+	}
+
+	rend := renderer.Renderer{
+		Source: src,
+		Raw:    cmd.format == catFormatRaw,
+		HTML:   cmd.format == catFormatHTML,
+		Stream: func(*logpb.LogEntry) int { return src.cur.stream },
+		TextPrefix: func(le *logpb.LogEntry, line *logpb.Text_Line) string {
+			return cmd.getTextPrefix(src.cur.name, src.cur.desc, le)
+		},
+		DatagramWriter: func(w io.Writer, dg []byte) bool {
+			return getDatagramWriter(c, src.cur.desc)(w, dg)
+		},
+	}
+	_, err := io.CopyBuffer(os.Stdout, &rend, make([]byte, cmd.buffer))
+	return err
+}
+
+// followEntry is a log entry fetched in -follow mode.
+type followEntry struct {
+	stream int // index of the stream in followSource.finished
+	name   string
+	desc   *logpb.LogStreamDescriptor
+	le     *logpb.LogEntry
+	ts     time.Time
+	err    error
+}
+
+// followSource is a renderer.Source that merges entries of multiple streams
+// fetched concurrently, ordering them by timestamp.
+//
+// Since streams are live, it is impossible to know whether an idle stream will
+// produce an earlier entry later. An entry is emitted when all unfinished
+// streams have a fetched entry (so the earliest of them is known), or when
+// followFlushInterval passes without that happening.
+type followSource struct {
+	c        context.Context
+	entryC   chan *followEntry
+	finished []bool // per stream, true if it was fully fetched
+
+	pending []*followEntry // fetched, but not yet emitted, sorted by ts
+	ready   int            // number of entries in pending ready to be emitted
+	cur     *followEntry   // the last emitted entry
+}
+
+func newFollowSource(c context.Context) *followSource {
+	return &followSource{
+		c:      c,
+		entryC: make(chan *followEntry),
+	}
+}
+
+// add starts reading entries of a stream in a background goroutine.
+//
+// Entries are read from src, desc returns the stream's descriptor once it's
+// known (see fetcher.Fetcher.Descriptor).
+func (s *followSource) add(name string, desc func() *logpb.LogStreamDescriptor, src renderer.Source) {
+	idx := len(s.finished)
+	s.finished = append(s.finished, false)
+	go func() {
+		for {
+			le, err := src.NextLogEntry()
+			e := &followEntry{stream: idx, name: name, le: le, err: err}
+			if le != nil {
+				e.desc = desc()
+				if e.desc != nil {
+					e.ts = e.desc.Timestamp.AsTime().Add(le.TimeOffset.AsDuration())
+				}
+			}
+			select {
+			case s.entryC <- e:
+			case <-s.c.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+}
+
+// NextLogEntry implements renderer.Source.
+func (s *followSource) NextLogEntry() (*logpb.LogEntry, error) {
+	var flushC <-chan clock.TimerResult
+	for s.ready == 0 {
+		switch unfinished := s.unfinishedWithoutPending(); {
+		case unfinished < 0 && len(s.pending) == 0:
+			return nil, io.EOF
+		case unfinished < 0:
+			s.ready = len(s.pending)
+			continue
+		case unfinished == 0:
+			// The earliest pending entry precedes whatever unfinished streams
+			// produce next.
+			s.ready = 1
+			continue
+		}
+		if len(s.pending) > 0 && flushC == nil {
+			flushC = clock.After(s.c, followFlushInterval)
+		}
+
+		select {
+		case e := <-s.entryC:
+			switch {
+			case e.err == io.EOF:
+				s.finished[e.stream] = true
+			case e.err != nil:
+				return nil, e.err
+			}
+			if e.le != nil {
+				s.push(e)
+			}
+		case <-flushC:
+			s.ready = len(s.pending)
+		case <-s.c.Done():
+			return nil, s.c.Err()
+		}
+	}
+
+	s.cur, s.pending = s.pending[0], s.pending[1:]
+	s.ready--
+	return s.cur.le, nil
+}
+
+// push adds an entry to the pending list, keeping it sorted by timestamp.
+func (s *followSource) push(e *followEntry) {
+	idx := sort.Search(len(s.pending), func(i int) bool { return s.pending[i].ts.After(e.ts) })
+	s.pending = append(s.pending, nil)
+	copy(s.pending[idx+1:], s.pending[idx:])
+	s.pending[idx] = e
+}
+
+// unfinishedWithoutPending returns the number of unfinished streams that have
+// no pending entries or -1 if all streams are finished.
+func (s *followSource) unfinishedWithoutPending() int {
+	waiting := make(map[int]struct{}, len(s.finished))
+	for i, done := range s.finished {
+		if !done {
+			waiting[i] = struct{}{}
+		}
+	}
+	if len(waiting) == 0 {
+		return -1
+	}
+	for _, e := range s.pending {
+		delete(waiting, e.stream)
+	}
+	return len(waiting)
+}
+
+func (cmd *catCommandRun) getTextPrefix(stream string, desc *logpb.LogStreamDescriptor, le *logpb.LogEntry) string {
 	var parts []string
+	if stream != "" {
+		parts = append(parts, stream)
+	}
 	if cmd.timestamps != timestampsOff {
 		ts := desc.Timestamp.AsTime()
 		ts = ts.Add(le.TimeOffset.AsDuration())
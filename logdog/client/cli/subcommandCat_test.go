@@ -0,0 +1,187 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/logdog/api/logpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// chanLogEntrySource returns the entries sent to it, then io.EOF once it's
+// closed.
+type chanLogEntrySource chan *logpb.LogEntry
+
+func (s chanLogEntrySource) NextLogEntry() (*logpb.LogEntry, error) {
+	if le, ok := <-s; ok {
+		return le, nil
+	}
+	return nil, io.EOF
+}
+
+// offsetEntry returns a log entry the given number of seconds after the start
+// of its stream.
+func offsetEntry(sec int) *logpb.LogEntry {
+	return &logpb.LogEntry{TimeOffset: durationpb.New(time.Duration(sec) * time.Second)}
+}
+
+func TestFollowSource(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A followSource`, t, func() {
+		c, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		c, cancel := context.WithCancel(c)
+		defer cancel()
+
+		desc := &logpb.LogStreamDescriptor{Timestamp: timestamppb.New(testclock.TestRecentTimeUTC)}
+		descFn := func() *logpb.LogStreamDescriptor { return desc }
+		src := newFollowSource(c)
+
+		// next returns the next entry and the name of its stream.
+		next := func() (*logpb.LogEntry, string) {
+			le, err := src.NextLogEntry()
+			So(err, ShouldBeNil)
+			return le, src.cur.name
+		}
+
+		// nextAsync calls NextLogEntry in a goroutine.
+		type result struct {
+			le  *logpb.LogEntry
+			err error
+		}
+		nextAsync := func() <-chan result {
+			resC := make(chan result, 1)
+			go func() {
+				le, err := src.NextLogEntry()
+				resC <- result{le, err}
+			}()
+			return resC
+		}
+
+		Convey(`Orders the entries of finished streams by timestamp.`, func() {
+			a := []*logpb.LogEntry{offsetEntry(1), offsetEntry(3), offsetEntry(4)}
+			b := []*logpb.LogEntry{offsetEntry(2), offsetEntry(5)}
+			src.add("a", descFn, &testLogEntrySource{entries: a, err: io.EOF})
+			src.add("b", descFn, &testLogEntrySource{entries: b, err: io.EOF})
+
+			for _, exp := range []struct {
+				le     *logpb.LogEntry
+				stream string
+			}{
+				{a[0], "a"}, {b[0], "b"}, {a[1], "a"}, {a[2], "a"}, {b[1], "b"},
+			} {
+				le, stream := next()
+				So(le, ShouldEqual, exp.le)
+				So(stream, ShouldEqual, exp.stream)
+			}
+
+			// Stays terminated.
+			for i := 0; i < 2; i++ {
+				_, err := src.NextLogEntry()
+				So(err, ShouldEqual, io.EOF)
+			}
+		})
+
+		Convey(`Waits for an entry of each unfinished stream.`, func() {
+			a, b := make(chanLogEntrySource), make(chanLogEntrySource)
+			defer close(a)
+			defer close(b)
+			src.add("a", descFn, a)
+			src.add("b", descFn, b)
+
+			a2, b1, b3 := offsetEntry(2), offsetEntry(1), offsetEntry(3)
+			a <- a2
+			resC := nextAsync()
+			// b may still produce an earlier entry, a2 isn't emitted yet.
+			b <- b1
+			res := <-resC
+			So(res.err, ShouldBeNil)
+			So(res.le, ShouldEqual, b1)
+
+			resC = nextAsync()
+			b <- b3
+			res = <-resC
+			So(res.err, ShouldBeNil)
+			So(res.le, ShouldEqual, a2)
+		})
+
+		Convey(`Doesn't wait for finished streams.`, func() {
+			a := make(chanLogEntrySource)
+			defer close(a)
+			src.add("a", descFn, a)
+			src.add("b", descFn, &testLogEntrySource{err: io.EOF})
+
+			a1 := offsetEntry(1)
+			a <- a1
+			le, _ := next()
+			So(le, ShouldEqual, a1)
+		})
+
+		Convey(`Flushes entries when a stream stalls.`, func() {
+			var timers []time.Duration
+			tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+				timers = append(timers, d)
+				tc.Add(d)
+			})
+
+			a, stalled := make(chanLogEntrySource), make(chanLogEntrySource)
+			defer close(a)
+			defer close(stalled)
+			src.add("a", descFn, a)
+			src.add("stalled", descFn, stalled)
+
+			a1 := offsetEntry(1)
+			a <- a1
+			start := clock.Now(c)
+			le, _ := next()
+			So(le, ShouldEqual, a1)
+			So(clock.Now(c).Sub(start), ShouldEqual, followFlushInterval)
+			So(timers, ShouldResemble, []time.Duration{followFlushInterval})
+		})
+
+		Convey(`Returns stream errors.`, func() {
+			a := make(chanLogEntrySource)
+			defer close(a)
+			src.add("a", descFn, a)
+			src.add("b", descFn, &testLogEntrySource{err: errors.New("boom")})
+
+			_, err := src.NextLogEntry()
+			So(err, ShouldErrLike, "boom")
+		})
+
+		Convey(`Stops when the context is cancelled.`, func() {
+			a := make(chanLogEntrySource)
+			defer close(a)
+			src.add("a", descFn, a)
+
+			resC := nextAsync()
+			cancel()
+			res := <-resC
+			So(res.err, ShouldEqual, context.Canceled)
+		})
+	})
+}
@@ -0,0 +1,285 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"go.chromium.org/luci/common/data/recordio"
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/client/coordinator"
+	"go.chromium.org/luci/logdog/common/fetcher"
+	"go.chromium.org/luci/logdog/common/types"
+
+	"github.com/maruel/subcommands"
+)
+
+// downloadIndexName is the name of the index file written to the output
+// directory.
+const downloadIndexName = "index.json"
+
+type downloadCommandRun struct {
+	subcommands.CommandRunBase
+
+	out        string
+	wait       bool
+	fetchBytes int
+}
+
+func newDownloadCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "download -out DIR PREFIX",
+		ShortDesc: "Download all log streams under a prefix to a directory.",
+		LongDesc: "" +
+			"Mirrors every log stream under a prefix ('project/prefix' or a unified path) to a\n" +
+			"directory tree, following the stream names:\n" +
+			"  - text streams are written as 'name.txt',\n" +
+			"  - binary streams are written as 'name.bin',\n" +
+			"  - datagram streams are written as 'name.recordio', one length-prefixed (uvarint)\n" +
+			"    record per datagram.\n" +
+			"\n" +
+			"The descriptors of the streams are written to '" + downloadIndexName + "'.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &downloadCommandRun{}
+
+			cmd.Flags.StringVar(&cmd.out, "out", "", "The directory to write the streams to (required).")
+			cmd.Flags.BoolVar(&cmd.wait, "wait", false,
+				"Wait for streams which are not terminated yet. By default they are skipped.")
+			cmd.Flags.IntVar(&cmd.fetchBytes, "fetch-bytes", 0, "Constrains the number of bytes to fetch per request.")
+			return cmd
+		},
+	}
+}
+
+// downloadedStream is an entry of the download index.
+type downloadedStream struct {
+	Path string `json:"path"`
+	File string `json:"file,omitempty"`
+	// Descriptor is the logpb.LogStreamDescriptor of the stream in protojson
+	// format.
+	Descriptor json.RawMessage `json:"descriptor,omitempty"`
+
+	TerminalIndex int64 `json:"terminalIndex"`
+	Skipped       bool  `json:"skipped,omitempty"`
+}
+
+func (cmd *downloadCommandRun) Run(scApp subcommands.Application, args []string, _ subcommands.Env) int {
+	a := scApp.(*application)
+
+	if len(args) != 1 {
+		log.Errorf(a, "Exactly one log prefix must be supplied.")
+		return 1
+	}
+	if cmd.out == "" {
+		log.Errorf(a, "An output directory must be supplied with -out.")
+		return 1
+	}
+
+	project, path, _, err := a.splitPath(args[0])
+	if err != nil {
+		log.WithError(err).Errorf(a, "Invalid path specifier.")
+		return 1
+	}
+	prefix, _, name := types.StreamPath(path).SplitParts()
+	if name != "" && name != "**" {
+		log.Errorf(a, "The path must be a log prefix, not a stream path.")
+		return 1
+	}
+	if err := prefix.Validate(); err != nil {
+		log.WithError(err).Errorf(a, "Invalid log prefix.")
+		return 1
+	}
+
+	coord, err := a.coordinatorClient("")
+	if err != nil {
+		errors.Log(a, errors.Annotate(err, "could not create Coordinator client").Err())
+		return 1
+	}
+
+	tctx, _ := a.timeoutCtx(a)
+	if err := cmd.download(tctx, coord, project, prefix); err != nil {
+		errors.Log(a, errors.Annotate(err, "failed to download log streams").Err())
+		if err == context.DeadlineExceeded {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+func (cmd *downloadCommandRun) download(c context.Context, coord *coordinator.Client, project string, prefix types.StreamName) error {
+	var streams []*coordinator.LogStream
+	qo := coordinator.QueryOptions{State: true}
+	err := coord.Query(c, project, string(prefix.AsPathPrefix("**")), qo, func(s *coordinator.LogStream) bool {
+		streams = append(streams, s)
+		return true
+	})
+	if err != nil {
+		return errors.Annotate(err, "failed to query log streams").Err()
+	}
+	log.Infof(c, "Downloading %d log stream(s).", len(streams))
+
+	if err := os.MkdirAll(cmd.out, 0755); err != nil {
+		return err
+	}
+
+	index := make([]*downloadedStream, len(streams))
+	for i, s := range streams {
+		desc, err := protojson.Marshal(&s.Desc)
+		if err != nil {
+			return errors.Annotate(err, "failed to marshal the descriptor of %q", s.Path).Err()
+		}
+		ds := &downloadedStream{
+			Path:          string(s.Path),
+			Descriptor:    desc,
+			TerminalIndex: int64(s.State.TerminalIndex),
+		}
+		index[i] = ds
+
+		if s.State.TerminalIndex < 0 && !cmd.wait {
+			log.Warningf(c, "Skipping log stream %q, which is not terminated yet.", s.Path)
+			ds.Skipped = true
+			continue
+		}
+
+		if ds.File, err = downloadFileName(&s.Desc); err != nil {
+			return err
+		}
+		log.Debugf(c, "Downloading log stream %q to %q.", s.Path, ds.File)
+		if err := cmd.downloadStream(c, coord, s, filepath.Join(cmd.out, ds.File)); err != nil {
+			return errors.Annotate(err, "failed to download log stream %q", s.Path).Err()
+		}
+	}
+
+	return writeDownloadIndex(filepath.Join(cmd.out, downloadIndexName), index)
+}
+
+func (cmd *downloadCommandRun) downloadStream(c context.Context, coord *coordinator.Client, s *coordinator.LogStream, path string) (err error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	src := coord.Stream(s.Project, s.Path).Fetcher(c, &fetcher.Options{
+		BufferBytes: int64(cmd.fetchBytes),
+	})
+	bw := bufio.NewWriter(f)
+	if err := writeStreamEntries(bw, s.Desc.StreamType, src); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// downloadFileName returns the path of a stream's file, relative to the output
+// directory.
+func downloadFileName(desc *logpb.LogStreamDescriptor) (string, error) {
+	name := types.StreamName(desc.Name)
+	if err := name.Validate(); err != nil {
+		return "", errors.Annotate(err, "invalid stream name %q", desc.Name).Err()
+	}
+
+	ext := ""
+	switch desc.StreamType {
+	case logpb.StreamType_TEXT:
+		ext = ".txt"
+	case logpb.StreamType_BINARY:
+		ext = ".bin"
+	case logpb.StreamType_DATAGRAM:
+		ext = ".recordio"
+	default:
+		return "", errors.Reason("unknown stream type %s", desc.StreamType).Err()
+	}
+	return filepath.FromSlash(string(name)) + ext, nil
+}
+
+// logEntrySource is a source of log entries, returning io.EOF at the end of
+// the stream.
+type logEntrySource interface {
+	NextLogEntry() (*logpb.LogEntry, error)
+}
+
+// writeStreamEntries writes the content of the log entries of a stream of
+// type t to w.
+func writeStreamEntries(w io.Writer, t logpb.StreamType, src logEntrySource) error {
+	var datagram []byte
+	for {
+		le, err := src.NextLogEntry()
+		switch {
+		case err == io.EOF:
+			if len(datagram) > 0 {
+				return errors.New("the last datagram is incomplete")
+			}
+			return nil
+		case err != nil:
+			return err
+		}
+
+		switch t {
+		case logpb.StreamType_TEXT:
+			for _, line := range le.GetText().GetLines() {
+				if _, err := w.Write(line.Value); err != nil {
+					return err
+				}
+				if _, err := io.WriteString(w, line.Delimiter); err != nil {
+					return err
+				}
+			}
+
+		case logpb.StreamType_BINARY:
+			if _, err := w.Write(le.GetBinary().GetData()); err != nil {
+				return err
+			}
+
+		case logpb.StreamType_DATAGRAM:
+			dg := le.GetDatagram()
+			datagram = append(datagram, dg.GetData()...)
+			if p := dg.GetPartial(); p != nil && !p.Last {
+				continue
+			}
+			if _, err := recordio.WriteFrame(w, datagram); err != nil {
+				return err
+			}
+			datagram = datagram[:0]
+
+		default:
+			return errors.Reason("unknown stream type %s", t).Err()
+		}
+	}
+}
+
+func writeDownloadIndex(path string, index []*downloadedStream) error {
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
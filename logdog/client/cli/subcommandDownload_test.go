@@ -0,0 +1,168 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"go.chromium.org/luci/common/data/recordio"
+	"go.chromium.org/luci/logdog/api/logpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// testLogEntrySource returns its entries, then err.
+type testLogEntrySource struct {
+	entries []*logpb.LogEntry
+	err     error
+}
+
+func (s *testLogEntrySource) NextLogEntry() (*logpb.LogEntry, error) {
+	if len(s.entries) == 0 {
+		return nil, s.err
+	}
+	le := s.entries[0]
+	s.entries = s.entries[1:]
+	return le, nil
+}
+
+func datagramEntry(data string, partial *logpb.Datagram_Partial) *logpb.LogEntry {
+	return &logpb.LogEntry{
+		Content: &logpb.LogEntry_Datagram{Datagram: &logpb.Datagram{
+			Data:    []byte(data),
+			Partial: partial,
+		}},
+	}
+}
+
+func readFrames(data []byte) []string {
+	records, err := recordio.Split(data)
+	So(err, ShouldBeNil)
+	frames := make([]string, len(records))
+	for i, r := range records {
+		frames[i] = string(r)
+	}
+	return frames
+}
+
+func TestWriteStreamEntries(t *testing.T) {
+	t.Parallel()
+
+	Convey(`writeStreamEntries`, t, func() {
+		var buf bytes.Buffer
+		src := &testLogEntrySource{err: io.EOF}
+
+		Convey(`Writes text lines with their delimiters`, func() {
+			src.entries = []*logpb.LogEntry{
+				{Content: &logpb.LogEntry_Text{Text: &logpb.Text{Lines: []*logpb.Text_Line{
+					{Value: []byte("hello"), Delimiter: "\n"},
+					{Value: []byte("world"), Delimiter: "\r\n"},
+				}}}},
+				{Content: &logpb.LogEntry_Text{Text: &logpb.Text{Lines: []*logpb.Text_Line{
+					{Value: []byte("partial")},
+				}}}},
+			}
+			So(writeStreamEntries(&buf, logpb.StreamType_TEXT, src), ShouldBeNil)
+			So(buf.String(), ShouldEqual, "hello\nworld\r\npartial")
+		})
+
+		Convey(`Writes binary data`, func() {
+			src.entries = []*logpb.LogEntry{
+				{Content: &logpb.LogEntry_Binary{Binary: &logpb.Binary{Data: []byte{0, 1}}}},
+				{Content: &logpb.LogEntry_Binary{Binary: &logpb.Binary{Data: []byte{2}}}},
+			}
+			So(writeStreamEntries(&buf, logpb.StreamType_BINARY, src), ShouldBeNil)
+			So(buf.Bytes(), ShouldResemble, []byte{0, 1, 2})
+		})
+
+		Convey(`Writes a record per datagram`, func() {
+			src.entries = []*logpb.LogEntry{
+				datagramEntry("first", nil),
+				datagramEntry("", nil),
+				datagramEntry("third", nil),
+			}
+			So(writeStreamEntries(&buf, logpb.StreamType_DATAGRAM, src), ShouldBeNil)
+			So(readFrames(buf.Bytes()), ShouldResemble, []string{"first", "", "third"})
+		})
+
+		Convey(`Joins partial datagrams`, func() {
+			src.entries = []*logpb.LogEntry{
+				datagramEntry("whole", nil),
+				datagramEntry("sp", &logpb.Datagram_Partial{Index: 0, Size: 6}),
+				datagramEntry("li", &logpb.Datagram_Partial{Index: 1, Size: 6}),
+				datagramEntry("t", &logpb.Datagram_Partial{Index: 2, Size: 6, Last: true}),
+				datagramEntry("again", &logpb.Datagram_Partial{Index: 0, Size: 5, Last: true}),
+			}
+			So(writeStreamEntries(&buf, logpb.StreamType_DATAGRAM, src), ShouldBeNil)
+			So(readFrames(buf.Bytes()), ShouldResemble, []string{"whole", "split", "again"})
+		})
+
+		Convey(`Fails on an incomplete last datagram`, func() {
+			src.entries = []*logpb.LogEntry{
+				datagramEntry("whole", nil),
+				datagramEntry("sp", &logpb.Datagram_Partial{Index: 0, Size: 6}),
+			}
+			So(writeStreamEntries(&buf, logpb.StreamType_DATAGRAM, src), ShouldErrLike, "the last datagram is incomplete")
+		})
+
+		Convey(`Forwards source errors`, func() {
+			src.err = io.ErrUnexpectedEOF
+			So(writeStreamEntries(&buf, logpb.StreamType_TEXT, src), ShouldEqual, io.ErrUnexpectedEOF)
+		})
+	})
+}
+
+func TestWriteDownloadIndex(t *testing.T) {
+	t.Parallel()
+
+	Convey(`writeDownloadIndex writes descriptors in protojson format`, t, func() {
+		desc := &logpb.LogStreamDescriptor{
+			Prefix:      "prefix",
+			Name:        "foo/bar",
+			StreamType:  logpb.StreamType_DATAGRAM,
+			ContentType: "application/octet-stream",
+			Tags:        map[string]string{"k": "v"},
+		}
+		raw, err := protojson.Marshal(desc)
+		So(err, ShouldBeNil)
+
+		path := filepath.Join(t.TempDir(), downloadIndexName)
+		So(writeDownloadIndex(path, []*downloadedStream{{
+			Path:          "prefix/+/foo/bar",
+			File:          "foo/bar.recordio",
+			Descriptor:    raw,
+			TerminalIndex: 3,
+		}}), ShouldBeNil)
+
+		data, err := os.ReadFile(path)
+		So(err, ShouldBeNil)
+		var index []map[string]json.RawMessage
+		So(json.Unmarshal(data, &index), ShouldBeNil)
+		So(index, ShouldHaveLength, 1)
+		So(string(index[0]["descriptor"]), ShouldContainSubstring, `"streamType": "DATAGRAM"`)
+
+		var got logpb.LogStreamDescriptor
+		So(protojson.Unmarshal(index[0]["descriptor"], &got), ShouldBeNil)
+		So(&got, ShouldResembleProto, desc)
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/logdog/client/coordinator"
+	"go.chromium.org/luci/logdog/common/types"
+
+	"github.com/maruel/subcommands"
+)
+
+type grepCommandRun struct {
+	subcommands.CommandRunBase
+
+	maxMatches int
+}
+
+func newGrepCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "grep [OPTIONS] <prefix> <regexp>",
+		ShortDesc: "Search archived log streams for lines matching a regexp.",
+		LongDesc: "" +
+			"Searches the content of archived text log streams under the given\n" +
+			"path for lines matching the RE2 regular expression and prints them as\n" +
+			"'<stream path>:<stream index>: <line>'.\n" +
+			"\n" +
+			"The path is either a prefix (e.g. 'project/prefix') meaning all streams\n" +
+			"under it, or a full query path, as in the 'query' subcommand.\n" +
+			"\n" +
+			"Streams are scanned by the Coordinator. Streams that are not archived yet\n" +
+			"are not scanned and are reported as warnings.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &grepCommandRun{}
+
+			cmd.Flags.IntVar(&cmd.maxMatches, "max-matches", 0,
+				"The maximum number of matches to print. If 0, no limit will be applied.")
+			return cmd
+		},
+	}
+}
+
+func (cmd *grepCommandRun) Run(scApp subcommands.Application, args []string, _ subcommands.Env) int {
+	a := scApp.(*application)
+
+	if len(args) != 2 {
+		log.Errorf(a, "Exactly two arguments, the path prefix and the regexp, must be supplied.")
+		return 1
+	}
+	pattern := args[1]
+	if _, err := regexp.Compile(pattern); err != nil {
+		log.WithError(err).Errorf(a, "Invalid regexp.")
+		return 1
+	}
+
+	project, path, unified, err := a.splitPath(args[0])
+	if err != nil {
+		log.WithError(err).Errorf(a, "Invalid path specifier.")
+		return 1
+	}
+	// A plain prefix means all streams under it.
+	path = strings.Trim(path, types.StreamNameSepStr)
+	if !strings.ContainsRune(path, types.StreamPathSep) {
+		path += "/+/**"
+	}
+
+	coord, err := a.coordinatorClient("")
+	if err != nil {
+		errors.Log(a, errors.Annotate(err, "could not create Coordinator client").Err())
+		return 1
+	}
+
+	bw := bufio.NewWriter(os.Stdout)
+	defer bw.Flush()
+
+	count := 0
+	tctx, _ := a.timeoutCtx(a)
+	stats, err := coord.Search(tctx, project, path, pattern, coordinator.SearchOptions{MaxMatches: cmd.maxMatches},
+		func(m *coordinator.SearchMatch) bool {
+			p := string(m.Path)
+			if unified {
+				p = makeUnifiedPath(m.Project, m.Path)
+			}
+			fmt.Fprintf(bw, "%s:%d: %s\n", p, m.StreamIndex, m.Text)
+			count++
+			return !(cmd.maxMatches > 0 && count >= cmd.maxMatches)
+		})
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"count":      count,
+		}.Errorf(a, "Search failed.")
+
+		if err == context.DeadlineExceeded {
+			return 2
+		}
+		return 1
+	}
+
+	for _, p := range stats.Skipped {
+		log.Fields{
+			"path": p,
+		}.Warningf(a, "Stream is not archived yet, skipped it.")
+	}
+	log.Fields{
+		"searched": stats.Searched,
+		"count":    count,
+	}.Infof(a, "Search completed.")
+	return 0
+}
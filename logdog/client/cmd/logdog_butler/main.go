@@ -31,6 +31,7 @@ import (
 	"go.chromium.org/luci/common/clock/clockflag"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/flag/multiflag"
+	"go.chromium.org/luci/common/flag/stringlistflag"
 	log "go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/logging/gologger"
 	"go.chromium.org/luci/common/runtime/paniccatcher"
@@ -40,6 +41,7 @@ import (
 	grpcLogging "go.chromium.org/luci/grpc/logging"
 	"go.chromium.org/luci/logdog/client/butler"
 	"go.chromium.org/luci/logdog/client/butler/output"
+	"go.chromium.org/luci/logdog/client/butler/redact"
 	"go.chromium.org/luci/logdog/client/butlerlib/streamproto"
 	"go.chromium.org/luci/logdog/common/types"
 
@@ -78,6 +80,15 @@ type application struct {
 	maxBufferAge clockflag.Duration
 	noBufferLogs bool
 
+	streamByteRate int
+	globalByteRate int
+	maxStreamBytes int64
+	maxTotalBytes  int64
+
+	redactPatterns    stringlistflag.Flag
+	redactSecretsFile string
+	redactLUCIContext bool
+
 	prof profiling.Profiler
 
 	// ncCtx is a context that will not be cancelled when cancelFunc is called.
@@ -115,6 +126,52 @@ func (a *application) addFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&a.noBufferLogs, "output-no-buffer", false,
 		"If true, dispatch logs immediately. Setting this flag simplifies output at the expense "+
 			"of wire-format efficiency.")
+	fs.IntVar(&a.streamByteRate, "stream-byte-rate", 0,
+		"If >0, the maximum number of bytes per second read from each stream. Faster writers are throttled.")
+	fs.IntVar(&a.globalByteRate, "global-byte-rate", 0,
+		"If >0, the maximum number of bytes per second read from all streams combined.")
+	fs.Int64Var(&a.maxStreamBytes, "max-stream-bytes", 0,
+		"If >0, the maximum number of bytes sent for each stream. Longer streams are truncated "+
+			"and tagged with '"+butler.TruncatedTag+"'. Datagram streams are never truncated.")
+	fs.Int64Var(&a.maxTotalBytes, "max-total-bytes", 0,
+		"If >0, the maximum number of bytes sent for all streams combined. Once reached, streams "+
+			"are truncated like with -max-stream-bytes.")
+	fs.Var(&a.redactPatterns, "redact-regex",
+		"A regular expression (RE2 syntax) whose matches are redacted from text streams. It must not "+
+			"match the empty string. Can be specified multiple times.")
+	fs.StringVar(&a.redactSecretsFile, "redact-secrets-file", "",
+		"The path to a file with one literal secret per line to redact from text streams.")
+	fs.BoolVar(&a.redactLUCIContext, "redact-luci-context", false,
+		"If true, redact the secrets found in the LUCI_CONTEXT from text streams.")
+}
+
+// redactor returns the configured Redactor, or nil if nothing is to be
+// redacted.
+func (a *application) redactor() (butler.Redactor, error) {
+	var secrets []string
+	if a.redactSecretsFile != "" {
+		data, err := os.ReadFile(a.redactSecretsFile)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to read the secrets file").Err()
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if line = strings.TrimRight(line, "\r"); line != "" {
+				secrets = append(secrets, line)
+			}
+		}
+	}
+	if a.redactLUCIContext {
+		secrets = append(secrets, redact.LUCIContextSecrets(a)...)
+	}
+
+	r, err := redact.New(secrets, a.redactPatterns)
+	if err != nil {
+		return nil, err
+	}
+	if r.Empty() {
+		return nil, nil
+	}
+	return r, nil
 }
 
 func (a *application) authenticator(ctx context.Context) (*auth.Authenticator, error) {
@@ -145,12 +202,24 @@ func (a *application) runWithButler(out output.Output, runFunc func(*butler.Butl
 	}
 	defer a.prof.Stop()
 
+	redactor, err := a.redactor()
+	if err != nil {
+		return err
+	}
+
 	// Instantiate our Butler.
 	butlerOpts := butler.Config{
 		GlobalTags:   a.globalTags,
 		MaxBufferAge: time.Duration(a.maxBufferAge),
 		BufferLogs:   !a.noBufferLogs,
 		Output:       out,
+
+		StreamByteRate: a.streamByteRate,
+		GlobalByteRate: a.globalByteRate,
+		MaxStreamBytes: a.maxStreamBytes,
+		MaxTotalBytes:  a.maxTotalBytes,
+
+		Redactor: redactor,
 	}
 	b, err := butler.New(a, butlerOpts)
 	if err != nil {
@@ -168,6 +237,9 @@ func (a *application) runWithButler(out output.Output, runFunc func(*butler.Butl
 			"count":   len(paths),
 			"streams": paths,
 		}.Infof(a, "Butler emitted %d stream(s).", len(paths))
+		if n := b.Redactions(); n > 0 {
+			log.Infof(a, "Butler redacted %d secret(s).", n)
+		}
 	}()
 
 	// Execute our Butler run function with the instantiated Butler.
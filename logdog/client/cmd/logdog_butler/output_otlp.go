@@ -0,0 +1,70 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"time"
+
+	"go.chromium.org/luci/common/clock/clockflag"
+	"go.chromium.org/luci/common/flag/multiflag"
+	"go.chromium.org/luci/common/flag/stringmapflag"
+	"go.chromium.org/luci/logdog/client/butler/output"
+	otlpOutput "go.chromium.org/luci/logdog/client/butler/output/otlp"
+)
+
+func init() {
+	registerOutputFactory(&otlpOutputFactory{})
+}
+
+// otlpOutputFactory for forwarding logs to an OpenTelemetry collector.
+type otlpOutputFactory struct {
+	otlpOutput.Options
+
+	headers    stringmapflag.Value
+	rpcTimeout clockflag.Duration
+}
+
+var _ outputFactory = (*otlpOutputFactory)(nil)
+
+func (f *otlpOutputFactory) option() multiflag.Option {
+	opt := newOutputOption("otlp", "Output to an OpenTelemetry collector via OTLP/HTTP.", f)
+
+	flags := opt.Flags()
+	flags.StringVar(&f.Endpoint, "endpoint", "",
+		"Base URL of the OTLP/HTTP receiver, e.g. http://localhost:4318.")
+	flags.Var(&f.headers, "header",
+		"Additional HTTP header to send, as Key=Value. Can be specified multiple times.")
+	flags.StringVar(&f.ServiceName, "service-name", otlpOutput.DefaultServiceName,
+		"Value of the service.name resource attribute.")
+	flags.IntVar(&f.BundleSize, "bundle-size", 1024*1024,
+		"Maximum bundle size.")
+	f.rpcTimeout = clockflag.Duration(30 * time.Second)
+	flags.Var(&f.rpcTimeout, "rpc-timeout",
+		"Timeout of an individual export request.")
+
+	return opt
+}
+
+func (f *otlpOutputFactory) configOutput(a *application) (output.Output, error) {
+	if f.Endpoint == "" {
+		return nil, errors.New("missing required OTLP endpoint")
+	}
+	f.Headers = f.headers
+	f.RPCTimeout = time.Duration(f.rpcTimeout)
+	return f.New(a), nil
+}
+
+func (f *otlpOutputFactory) scopes() []string { return nil }
@@ -0,0 +1,101 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+
+	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+// SearchMatch is a log line that matches a Search pattern.
+type SearchMatch struct {
+	// Project is the project of the stream.
+	Project string
+	// Path is the path of the stream.
+	Path types.StreamPath
+	// StreamIndex is the index of the log entry in the stream.
+	StreamIndex uint64
+	// Line is the index of the line within the log entry.
+	Line int
+	// Text is the line text.
+	Text string
+}
+
+// SearchOptions is the set of options that can accompany a search.
+type SearchOptions struct {
+	// MaxMatches, if >0, is the maximum number of matches to fetch per request.
+	// The server may apply a lower limit.
+	MaxMatches int
+}
+
+// SearchStats describes a finished search.
+type SearchStats struct {
+	// Searched is the number of scanned archived streams.
+	Searched int
+	// Skipped is a list of streams that matched the query path, but were not
+	// scanned since they are not archived yet.
+	Skipped []types.StreamPath
+}
+
+// SearchCallback is a callback method type that is used in search requests.
+//
+// If it returns false, the search will be aborted.
+type SearchCallback func(m *SearchMatch) bool
+
+// Search scans archived text log streams matching the query path (see Query
+// for its format) for lines matching the RE2 regular expression pattern,
+// invoking the supplied callback once for each matching line.
+//
+// Streams are scanned by the Coordinator in batches. Search keeps requesting
+// batches until all streams are scanned or the callback returns false.
+func (c *Client) Search(ctx context.Context, project, path, pattern string, o SearchOptions, cb SearchCallback) (*SearchStats, error) {
+	req := logdog.SearchRequest{
+		Project:    project,
+		Path:       path,
+		Pattern:    pattern,
+		MaxMatches: int32(o.MaxMatches),
+	}
+
+	stats := &SearchStats{}
+	for {
+		resp, err := c.C.Search(ctx, &req)
+		if err != nil {
+			return stats, normalizeError(err)
+		}
+
+		stats.Searched += int(resp.Searched)
+		for _, s := range resp.Skipped {
+			stats.Skipped = append(stats.Skipped, types.StreamPath(s))
+		}
+		for _, m := range resp.Matches {
+			match := &SearchMatch{
+				Project:     resp.Project,
+				Path:        types.StreamPath(m.Path),
+				StreamIndex: m.StreamIndex,
+				Line:        int(m.Line),
+				Text:        m.Text,
+			}
+			if !cb(match) {
+				return stats, nil
+			}
+		}
+
+		if req.Next = resp.Next; req.Next == "" {
+			return stats, nil
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/testing/prpctest"
+	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/common/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+type testSearchLogsService struct {
+	testLogsServiceBase
+
+	LR []*logdog.SearchRequest
+	H  func(*logdog.SearchRequest) (*logdog.SearchResponse, error)
+}
+
+func (s *testSearchLogsService) Search(c context.Context, req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+	s.LR = append(s.LR, proto.Clone(req).(*logdog.SearchRequest))
+	if h := s.H; h != nil {
+		return s.H(req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestClientSearch(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A testing Client`, t, func() {
+		c := context.Background()
+
+		ts := prpctest.Server{}
+		svc := testSearchLogsService{}
+		logdog.RegisterLogsServer(&ts, &svc)
+
+		// Create a testing server and client.
+		ts.Start(c)
+		defer ts.Close()
+
+		prpcClient, err := ts.NewClient()
+		if err != nil {
+			panic(err)
+		}
+		client := Client{
+			C: logdog.NewLogsPRPCClient(prpcClient),
+		}
+
+		// This handler returns two pages, progressing "" => "page2" => "".
+		svc.H = func(req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+			switch req.Next {
+			case "":
+				return &logdog.SearchResponse{
+					Project: "proj",
+					Matches: []*logdog.SearchResponse_Match{
+						{Path: "a/+/s1", StreamIndex: 1, Line: 0, Text: "hello"},
+						{Path: "a/+/s2", StreamIndex: 5, Line: 2, Text: "hello again"},
+					},
+					Searched:  1,
+					Skipped:   []string{"a/+/live"},
+					Truncated: true,
+					Next:      "page2",
+				}, nil
+			case "page2":
+				return &logdog.SearchResponse{
+					Project: "proj",
+					Matches: []*logdog.SearchResponse_Match{
+						{Path: "a/+/s3", StreamIndex: 0, Line: 0, Text: "bye, hello"},
+					},
+					Searched: 2,
+				}, nil
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "invalid `next` value")
+			}
+		}
+
+		search := func(limit int) ([]string, *SearchStats, error) {
+			var out []string
+			stats, err := client.Search(c, "proj", "a/+/**", "hello", SearchOptions{MaxMatches: 10}, func(m *SearchMatch) bool {
+				out = append(out, fmt.Sprintf("%s/%s:%d:%d:%s", m.Project, m.Path, m.StreamIndex, m.Line, m.Text))
+				return limit == 0 || len(out) < limit
+			})
+			return out, stats, err
+		}
+
+		Convey(`Fetches all pages`, func() {
+			out, stats, err := search(0)
+			So(err, ShouldBeNil)
+			So(out, ShouldResemble, []string{
+				"proj/a/+/s1:1:0:hello",
+				"proj/a/+/s2:5:2:hello again",
+				"proj/a/+/s3:0:0:bye, hello",
+			})
+			So(stats, ShouldResemble, &SearchStats{
+				Searched: 3,
+				Skipped:  []types.StreamPath{"a/+/live"},
+			})
+			So(svc.LR, ShouldResembleProto, []*logdog.SearchRequest{
+				{Project: "proj", Path: "a/+/**", Pattern: "hello", MaxMatches: 10},
+				{Project: "proj", Path: "a/+/**", Pattern: "hello", MaxMatches: 10, Next: "page2"},
+			})
+		})
+
+		Convey(`Stops when asked`, func() {
+			out, _, err := search(1)
+			So(err, ShouldBeNil)
+			So(out, ShouldHaveLength, 1)
+			So(svc.LR, ShouldHaveLength, 1)
+		})
+
+		Convey(`Will return ErrNoAccess if unauthenticated.`, func() {
+			svc.H = func(*logdog.SearchRequest) (*logdog.SearchResponse, error) {
+				return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+			}
+			_, _, err := search(0)
+			So(err, ShouldEqual, ErrNoAccess)
+		})
+
+		Convey(`Will return ErrNoAccess if permission denied.`, func() {
+			svc.H = func(*logdog.SearchRequest) (*logdog.SearchResponse, error) {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+			}
+			_, _, err := search(0)
+			So(err, ShouldEqual, ErrNoAccess)
+		})
+
+		Convey(`Will forward other errors.`, func() {
+			svc.H = func(*logdog.SearchRequest) (*logdog.SearchResponse, error) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid pattern")
+			}
+			_, _, err := search(0)
+			So(err, ShouldErrLike, "invalid pattern")
+		})
+	})
+}
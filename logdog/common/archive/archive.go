@@ -19,6 +19,7 @@ package archive
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"io"
 	"reflect"
 
@@ -88,6 +89,15 @@ type Manifest struct {
 	// successive index entries.
 	ByteRange int
 
+	// CompressionBlockSize, if >0, enables compression of the log stream.
+	//
+	// The log stream is written in the zstd seekable format: independently
+	// decompressible zstd frames of CompressionBlockSize uncompressed bytes,
+	// followed by a seek table. The frames are also listed in the index, which
+	// is required to read the stream with random access, so IndexWriter must be
+	// set too.
+	CompressionBlockSize int
+
 	// Logger, if not nil, will be used to log status during archival.
 	Logger logging.Logger
 
@@ -110,6 +120,11 @@ func (m *Manifest) logger() logging.Logger {
 
 // Archive performs the log archival described in the supplied Manifest.
 func Archive(m Manifest) error {
+	// A compressed stream can only be read back through its index.
+	if m.CompressionBlockSize > 0 && m.IndexWriter == nil {
+		return errors.New("compression requires an index")
+	}
+
 	// Wrap our log source in a safeLogEntrySource to protect our index order.
 	m.Source = &safeLogEntrySource{
 		Manifest: &m,
@@ -144,14 +159,30 @@ func Archive(m Manifest) error {
 	sha.Write([]byte(m.Desc.Name))
 	streamIDHash := sha.Sum(nil)
 
+	logWriter := m.LogWriter
+	var bw *blockWriter
+	if m.CompressionBlockSize > 0 {
+		bw = newBlockWriter(logWriter, m.CompressionBlockSize)
+		logWriter = bw
+	}
+
 	return parallel.FanOutIn(func(taskC chan<- func() error) {
 		logC := make(chan *logpb.LogEntry)
 
 		taskC <- func() error {
-			if err := archiveLogs(m.LogWriter, m.Desc, logC, idx, m.CloudLogger, streamIDHash, m.logger()); err != nil {
+			if err := archiveLogs(logWriter, m.Desc, logC, idx, m.CloudLogger, streamIDHash, m.logger()); err != nil {
 				return err
 			}
 
+			if bw != nil {
+				if err := bw.Close(); err != nil {
+					return err
+				}
+				if idx != nil {
+					idx.index.Blocks = bw.blocks
+				}
+			}
+
 			// If we're building an index, emit it now that the log stream has
 			// finished.
 			if idx != nil {
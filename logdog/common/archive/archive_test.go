@@ -17,6 +17,7 @@ package archive
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"strconv"
@@ -25,6 +26,7 @@ import (
 
 	cl "cloud.google.com/go/logging"
 	"github.com/golang/protobuf/proto"
+	"github.com/klauspost/compress/zstd"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -434,6 +436,60 @@ func TestArchive(t *testing.T) {
 			})
 		})
 
+		Convey(`When compressing`, func() {
+			ts.add(0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+			m.CompressionBlockSize = 16
+			So(Archive(m), ShouldBeNil)
+
+			compressed := logB.Bytes()
+			dec, err := zstd.NewReader(nil)
+			So(err, ShouldBeNil)
+			defer dec.Close()
+
+			// The whole file is a valid zstd stream.
+			plain, err := dec.DecodeAll(compressed, nil)
+			So(err, ShouldBeNil)
+
+			// Index entries refer to the uncompressed stream.
+			So(&indexB, ic.shouldContainIndexFor, desc, bytes.NewBuffer(plain))
+			var index logpb.LogIndex
+			So(proto.Unmarshal(indexB.Bytes(), &index), ShouldBeNil)
+			So(len(index.Blocks), ShouldBeGreaterThan, 1)
+
+			// Each block can be decompressed independently.
+			var offset uint64
+			for _, b := range index.Blocks {
+				So(b.Offset, ShouldEqual, offset)
+				data, err := dec.DecodeAll(compressed[b.CompressedOffset:b.CompressedOffset+b.CompressedSize], nil)
+				So(err, ShouldBeNil)
+				So(data, ShouldResemble, plain[b.Offset:b.Offset+b.Size])
+				offset += b.Size
+			}
+			So(offset, ShouldEqual, len(plain))
+
+			// The seek table follows the blocks.
+			last := index.Blocks[len(index.Blocks)-1]
+			table := compressed[last.CompressedOffset+last.CompressedSize:]
+			So(binary.LittleEndian.Uint32(table), ShouldEqual, skippableFrameMagic)
+			So(binary.LittleEndian.Uint32(table[len(table)-9:]), ShouldEqual, len(index.Blocks))
+			So(binary.LittleEndian.Uint32(table[len(table)-4:]), ShouldEqual, seekableMagic)
+		})
+
+		Convey(`When compressing no data, writes nothing`, func() {
+			var out bytes.Buffer
+			bw := newBlockWriter(&out, 16)
+			So(bw.Close(), ShouldBeNil)
+			So(bw.blocks, ShouldHaveLength, 0)
+			So(out.Len(), ShouldEqual, 0)
+		})
+
+		Convey(`Will refuse to compress without an index`, func() {
+			ts.add(0, 1, 2)
+			m.CompressionBlockSize = 16
+			m.IndexWriter = nil
+			So(Archive(m), ShouldErrLike, "compression requires an index")
+		})
+
 		Convey(`Exports entries to Cloud Logs`, func() {
 			clogger := m.CloudLogger.(*testCLLogger)
 			line := func(msg, del string) *logpb.Text_Line {
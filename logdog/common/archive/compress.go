@@ -0,0 +1,150 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/klauspost/compress/zstd"
+
+	"go.chromium.org/luci/logdog/api/logpb"
+)
+
+// Constants of the zstd seekable format, see
+// https://github.com/facebook/zstd/blob/dev/contrib/seekable_format/zstd_seekable_compression_format.md
+const (
+	// skippableFrameMagic is the magic number of the skippable frame holding
+	// the seek table.
+	skippableFrameMagic = 0x184D2A5E
+	// seekableMagic is the magic number at the end of the seek table.
+	seekableMagic = 0x8F92EAB1
+)
+
+// zstdEncoder is a globally shared zstd encoder. We use only its EncodeAll
+// method, which is allowed to be used concurrently.
+var zstdEncoder *zstd.Encoder
+
+func init() {
+	var err error
+	if zstdEncoder, err = zstd.NewWriter(nil); err != nil {
+		panic(err) // this is impossible
+	}
+}
+
+// blockWriter is an io.Writer that compresses written data in independently
+// decompressible zstd frames of roughly blockSize uncompressed bytes.
+//
+// Close writes the seek table, making the output a zstd seekable format file.
+type blockWriter struct {
+	w         io.Writer
+	blockSize int
+
+	buf    []byte
+	blocks []*logpb.LogIndex_Block
+
+	// offset is the uncompressed offset of the data in buf.
+	offset uint64
+	// compressedOffset is the number of bytes written to w.
+	compressedOffset uint64
+}
+
+func newBlockWriter(w io.Writer, blockSize int) *blockWriter {
+	// The seek table stores 32-bit sizes.
+	if blockSize > math.MaxUint32/2 {
+		blockSize = math.MaxUint32 / 2
+	}
+	return &blockWriter{
+		w:         w,
+		blockSize: blockSize,
+		buf:       make([]byte, 0, blockSize),
+	}
+}
+
+func (bw *blockWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := bw.blockSize - len(bw.buf)
+		if n > len(p) {
+			n = len(p)
+		}
+		bw.buf = append(bw.buf, p[:n]...)
+		p = p[n:]
+		written += n
+
+		if len(bw.buf) >= bw.blockSize {
+			if err := bw.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// flush compresses the buffered data into a new block.
+func (bw *blockWriter) flush() error {
+	if len(bw.buf) == 0 {
+		return nil
+	}
+
+	frame := zstdEncoder.EncodeAll(bw.buf, nil)
+	if _, err := bw.w.Write(frame); err != nil {
+		return err
+	}
+
+	bw.blocks = append(bw.blocks, &logpb.LogIndex_Block{
+		Offset:           bw.offset,
+		Size:             uint64(len(bw.buf)),
+		CompressedOffset: bw.compressedOffset,
+		CompressedSize:   uint64(len(frame)),
+	})
+	bw.offset += uint64(len(bw.buf))
+	bw.compressedOffset += uint64(len(frame))
+	bw.buf = bw.buf[:0]
+	return nil
+}
+
+// Close flushes the buffered data and writes the seek table.
+//
+// Without blocks, nothing is written: readers tell compressed streams apart by
+// their blocks in the index, so an empty compressed stream must look like an
+// empty uncompressed one.
+//
+// It does not close the underlying Writer.
+func (bw *blockWriter) Close() error {
+	if err := bw.flush(); err != nil {
+		return err
+	}
+	if len(bw.blocks) == 0 {
+		return nil
+	}
+
+	const entrySize = 8
+	const footerSize = 9
+	table := make([]byte, 8, 8+len(bw.blocks)*entrySize+footerSize)
+	binary.LittleEndian.PutUint32(table[0:], skippableFrameMagic)
+	binary.LittleEndian.PutUint32(table[4:], uint32(len(bw.blocks)*entrySize+footerSize))
+	for _, b := range bw.blocks {
+		table = binary.LittleEndian.AppendUint32(table, uint32(b.CompressedSize))
+		table = binary.LittleEndian.AppendUint32(table, uint32(b.Size))
+	}
+	table = binary.LittleEndian.AppendUint32(table, uint32(len(bw.blocks)))
+	table = append(table, 0) // Seek_Table_Descriptor: no checksums.
+	table = binary.LittleEndian.AppendUint32(table, seekableMagic)
+
+	_, err := bw.w.Write(table)
+	return err
+}
@@ -102,6 +102,11 @@ type Options struct {
 	// Delay is the amount of time to wait in between unsuccessful log requests.
 	Delay time.Duration
 
+	// MaxDelay, if greater than Delay, enables exponential backoff: the delay
+	// doubles after each unsuccessful log request up to MaxDelay, and resets
+	// back to Delay once logs are returned.
+	MaxDelay time.Duration
+
 	// Set this to immediately bail out with ErrIncompleteStream if the stream
 	// isn't complete yet. This can be useful when you believe the stream to
 	// already be terminal, but haven't done an RPC with LogDog yet to actually
@@ -363,6 +368,7 @@ func (f *Fetcher) fetchLogs(c context.Context, req *fetchRequest) {
 		lreq.Bytes = 0
 	}
 
+	delay := f.o.Delay
 	for {
 		log.Fields{
 			"index": req.index,
@@ -395,13 +401,18 @@ func (f *Fetcher) fetchLogs(c context.Context, req *fetchRequest) {
 			// No logs this round. Sleep for more.
 			log.Fields{
 				"index": req.index,
-				"delay": f.o.Delay,
+				"delay": delay,
 			}.Infof(c, "No logs returned. Sleeping...")
-			if tr := clock.Sleep(c, f.o.Delay); tr.Incomplete() {
+			if tr := clock.Sleep(c, delay); tr.Incomplete() {
 				log.WithError(tr.Err).Warningf(c, "Context was canceled.")
 				resp.err = tr.Err
 				return
 			}
+			if f.o.MaxDelay > f.o.Delay {
+				if delay *= 2; delay > f.o.MaxDelay {
+					delay = f.o.MaxDelay
+				}
+			}
 		}
 	}
 }
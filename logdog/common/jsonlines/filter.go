@@ -0,0 +1,152 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsonlines implements field access and filtering for structured
+// (JSON lines) log streams.
+//
+// A structured log stream is a TEXT stream with the content type
+// types.ContentTypeJSONLines, where each line is a JSON object (a record).
+package jsonlines
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// Record is a decoded structured log record.
+type Record map[string]any
+
+// ParseRecord decodes a single line of a structured log stream.
+//
+// Returns an error if the line is not a JSON object.
+func ParseRecord(line []byte) (Record, error) {
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.UseNumber()
+
+	var r Record
+	if err := dec.Decode(&r); err != nil {
+		return nil, errors.Annotate(err, "not a JSON object").Err()
+	}
+	if r == nil || dec.More() {
+		return nil, errors.New("not a JSON object")
+	}
+	return r, nil
+}
+
+// Field returns the value of a field as a string.
+//
+// Nested fields are addressed with dots, e.g. "http.status". Strings are
+// returned as is, other values in their JSON encoding.
+//
+// Returns false if the field doesn't exist.
+func (r Record) Field(path string) (string, bool) {
+	var v any = map[string]any(r)
+	for _, p := range strings.Split(path, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		if v, ok = m[p]; !ok {
+			return "", false
+		}
+	}
+
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case nil:
+		return "null", true
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(b), true
+	}
+}
+
+// Condition is a single predicate on a record field.
+type Condition struct {
+	// Field is the dot-separated path of the field.
+	Field string
+	// Value is the value to compare the field with.
+	Value string
+	// Negate, if true, makes the condition match records whose field doesn't
+	// equal Value (including records without the field).
+	Negate bool
+}
+
+// ParseCondition parses a condition in the "field=value" or "field!=value"
+// form.
+func ParseCondition(s string) (Condition, error) {
+	idx := strings.IndexRune(s, '=')
+	if idx <= 0 {
+		return Condition{}, errors.Reason("condition %q must be in the field=value or field!=value form", s).Err()
+	}
+
+	c := Condition{Field: s[:idx], Value: s[idx+1:]}
+	if strings.HasSuffix(c.Field, "!") {
+		c.Field = strings.TrimSuffix(c.Field, "!")
+		c.Negate = true
+	}
+	if c.Field == "" {
+		return Condition{}, errors.Reason("condition %q has an empty field", s).Err()
+	}
+	return c, nil
+}
+
+// Match returns true if the record satisfies the condition.
+func (c Condition) Match(r Record) bool {
+	v, ok := r.Field(c.Field)
+	return (ok && v == c.Value) != c.Negate
+}
+
+// String returns the condition in the form accepted by ParseCondition.
+func (c Condition) String() string {
+	if c.Negate {
+		return c.Field + "!=" + c.Value
+	}
+	return c.Field + "=" + c.Value
+}
+
+// Filter is a conjunction of conditions.
+type Filter []Condition
+
+// Match returns true if the line is a record satisfying all of the filter's
+// conditions.
+//
+// Lines which are not JSON objects never match a non-empty Filter.
+func (f Filter) Match(line []byte) bool {
+	if len(f) == 0 {
+		return true
+	}
+	r, err := ParseRecord(line)
+	if err != nil {
+		return false
+	}
+	for _, c := range f {
+		if !c.Match(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonlines
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	Convey(`ParseRecord`, t, func() {
+		r, err := ParseRecord([]byte(`{"level": "ERROR", "code": 12, "ok": false, "http": {"status": 500}}`))
+		So(err, ShouldBeNil)
+
+		field := func(path string) string {
+			v, ok := r.Field(path)
+			if !ok {
+				return "<missing>"
+			}
+			return v
+		}
+		So(field("level"), ShouldEqual, "ERROR")
+		So(field("code"), ShouldEqual, "12")
+		So(field("ok"), ShouldEqual, "false")
+		So(field("http.status"), ShouldEqual, "500")
+		So(field("http"), ShouldEqual, `{"status":500}`)
+		So(field("level.x"), ShouldEqual, "<missing>")
+		So(field("nope"), ShouldEqual, "<missing>")
+
+		for _, line := range []string{``, `hello`, `[1, 2]`, `"str"`, `null`, `{} {}`} {
+			_, err := ParseRecord([]byte(line))
+			So(err, ShouldErrLike, "not a JSON object")
+		}
+	})
+
+	Convey(`ParseCondition`, t, func() {
+		c, err := ParseCondition("level=ERROR")
+		So(err, ShouldBeNil)
+		So(c, ShouldResemble, Condition{Field: "level", Value: "ERROR"})
+
+		c, err = ParseCondition("http.status!=200")
+		So(err, ShouldBeNil)
+		So(c, ShouldResemble, Condition{Field: "http.status", Value: "200", Negate: true})
+		So(c.String(), ShouldEqual, "http.status!=200")
+
+		c, err = ParseCondition("msg=a=b")
+		So(err, ShouldBeNil)
+		So(c, ShouldResemble, Condition{Field: "msg", Value: "a=b"})
+
+		_, err = ParseCondition("level")
+		So(err, ShouldErrLike, "must be in the field=value")
+		_, err = ParseCondition("=x")
+		So(err, ShouldErrLike, "must be in the field=value")
+		_, err = ParseCondition("!=x")
+		So(err, ShouldErrLike, "empty field")
+	})
+
+	Convey(`Filter`, t, func() {
+		f := Filter{
+			{Field: "level", Value: "ERROR"},
+			{Field: "component", Value: "db", Negate: true},
+		}
+
+		So(f.Match([]byte(`{"level": "ERROR", "component": "web"}`)), ShouldBeTrue)
+		So(f.Match([]byte(`{"level": "ERROR"}`)), ShouldBeTrue)
+		So(f.Match([]byte(`{"level": "ERROR", "component": "db"}`)), ShouldBeFalse)
+		So(f.Match([]byte(`{"level": "INFO"}`)), ShouldBeFalse)
+		So(f.Match([]byte(`level=ERROR`)), ShouldBeFalse)
+
+		So(Filter(nil).Match([]byte(`not json`)), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonlines
+
+import (
+	"io"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/logdog/api/logpb"
+)
+
+// Source is a source of log entries, e.g. a fetcher.Fetcher.
+type Source interface {
+	// NextLogEntry returns the next log entry, or io.EOF at the end of the
+	// stream. It may return an entry and an error at the same time.
+	NextLogEntry() (*logpb.LogEntry, error)
+}
+
+// FilteredSource is a Source returning only the records of a single structured
+// log stream that match a Filter.
+//
+// Records split across several lines (with an empty delimiter, e.g. because
+// they exceeded the butler's bundle size) are joined before they are matched.
+// Entries without text content are returned as is.
+type FilteredSource struct {
+	// Source is the underlying source of log entries.
+	Source Source
+	// Filter is the filter to apply to records.
+	Filter Filter
+
+	// partial is the beginning of a record split across entries, and
+	// partialEntry is the last entry holding part of it.
+	partial      []byte
+	partialEntry *logpb.LogEntry
+}
+
+// NextLogEntry implements Source.
+func (s *FilteredSource) NextLogEntry() (*logpb.LogEntry, error) {
+	for {
+		le, err := s.Source.NextLogEntry()
+		if le != nil {
+			le = s.filter(le, err == io.EOF)
+		} else if err == io.EOF {
+			le = s.flush()
+		}
+		if le != nil || err != nil {
+			return le, err
+		}
+	}
+}
+
+// filter removes non-matching records from le, returning nil if none are
+// left.
+//
+// If last is true, a trailing partial record is matched as is.
+func (s *FilteredSource) filter(le *logpb.LogEntry, last bool) *logpb.LogEntry {
+	txt := le.GetText()
+	if txt == nil {
+		return le
+	}
+
+	var lines []*logpb.Text_Line
+	for i, l := range txt.Lines {
+		if l.Delimiter == "" && !(last && i == len(txt.Lines)-1) {
+			s.partialEntry = le
+			s.partial = append(s.partial, l.Value...)
+			continue
+		}
+
+		value := l.Value
+		if s.partial != nil {
+			value = append(s.partial, value...)
+			s.partial, s.partialEntry = nil, nil
+		}
+		if s.Filter.Match(value) {
+			lines = append(lines, &logpb.Text_Line{Value: value, Delimiter: l.Delimiter})
+		}
+	}
+	return withLines(le, lines)
+}
+
+// flush matches the pending partial record at the end of the stream.
+func (s *FilteredSource) flush() *logpb.LogEntry {
+	if s.partial == nil {
+		return nil
+	}
+	le, value := s.partialEntry, s.partial
+	s.partial, s.partialEntry = nil, nil
+	if !s.Filter.Match(value) {
+		return nil
+	}
+	return withLines(le, []*logpb.Text_Line{{Value: value}})
+}
+
+// withLines returns a copy of le with the given text lines, or nil if there
+// are none.
+func withLines(le *logpb.LogEntry, lines []*logpb.Text_Line) *logpb.LogEntry {
+	if len(lines) == 0 {
+		return nil
+	}
+	le = proto.Clone(le).(*logpb.LogEntry)
+	le.GetText().Lines = lines
+	return le
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonlines
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"go.chromium.org/luci/logdog/api/logpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testSource struct {
+	logs []*logpb.LogEntry
+}
+
+func (ts *testSource) NextLogEntry() (*logpb.LogEntry, error) {
+	if len(ts.logs) == 0 {
+		return nil, io.EOF
+	}
+	le := ts.logs[0]
+	ts.logs = ts.logs[1:]
+	return le, nil
+}
+
+func textEntry(index uint64, lines ...string) *logpb.LogEntry {
+	txt := &logpb.Text{}
+	for _, l := range lines {
+		line := &logpb.Text_Line{Value: []byte(strings.TrimSuffix(l, "\n"))}
+		if strings.HasSuffix(l, "\n") {
+			line.Delimiter = "\n"
+		}
+		txt.Lines = append(txt.Lines, line)
+	}
+	return &logpb.LogEntry{
+		StreamIndex: index,
+		Content:     &logpb.LogEntry_Text{Text: txt},
+	}
+}
+
+func TestFilteredSource(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A FilteredSource`, t, func() {
+		src := &testSource{}
+		fs := &FilteredSource{
+			Source: src,
+			Filter: Filter{{Field: "level", Value: "ERROR"}},
+		}
+
+		read := func() (records []string, indexes []uint64) {
+			for {
+				le, err := fs.NextLogEntry()
+				if le != nil {
+					indexes = append(indexes, le.StreamIndex)
+					for _, l := range le.GetText().GetLines() {
+						records = append(records, string(l.Value)+l.Delimiter)
+					}
+				}
+				if err == io.EOF {
+					return
+				}
+				So(err, ShouldBeNil)
+			}
+		}
+
+		Convey(`Drops non-matching records and empty entries.`, func() {
+			src.logs = []*logpb.LogEntry{
+				textEntry(0, `{"level": "INFO", "n": 0}`+"\n", `{"level": "ERROR", "n": 1}`+"\n"),
+				textEntry(1, `{"level": "INFO", "n": 2}`+"\n"),
+				textEntry(2, `garbage`+"\n", `{"level": "ERROR", "n": 3}`+"\n"),
+			}
+			records, indexes := read()
+			So(records, ShouldResemble, []string{
+				`{"level": "ERROR", "n": 1}` + "\n",
+				`{"level": "ERROR", "n": 3}` + "\n",
+			})
+			So(indexes, ShouldResemble, []uint64{0, 2})
+		})
+
+		Convey(`Joins records split across entries.`, func() {
+			src.logs = []*logpb.LogEntry{
+				textEntry(0, `{"level": "INFO"}`+"\n", `{"level": `),
+				textEntry(1, `"ERROR", `),
+				textEntry(2, `"n": 1}`+"\n", `{"level": "ERROR"`),
+				textEntry(3, `, "n": 2}`),
+			}
+			records, indexes := read()
+			So(records, ShouldResemble, []string{
+				`{"level": "ERROR", "n": 1}` + "\n",
+				`{"level": "ERROR", "n": 2}`,
+			})
+			So(indexes, ShouldResemble, []uint64{2, 3})
+		})
+
+		Convey(`Flushes a trailing partial record.`, func() {
+			src.logs = []*logpb.LogEntry{
+				textEntry(0, `{"level": `),
+				textEntry(1, `"ERROR"}`),
+			}
+			// The source doesn't know that the last entry is the last one.
+			records, indexes := read()
+			So(records, ShouldResemble, []string{`{"level": "ERROR"}`})
+			So(indexes, ShouldResemble, []uint64{1})
+		})
+
+		Convey(`Passes non-text entries through.`, func() {
+			bin := &logpb.LogEntry{
+				StreamIndex: 0,
+				Content:     &logpb.LogEntry_Binary{Binary: &logpb.Binary{Data: []byte("x")}},
+			}
+			src.logs = []*logpb.LogEntry{bin}
+			le, err := fs.NextLogEntry()
+			So(err, ShouldBeNil)
+			So(le, ShouldEqual, bin)
+		})
+	})
+}
@@ -0,0 +1,380 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package renderer
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// HTMLStyle is a CSS stylesheet with the classes used by HTMLConverter.
+//
+// Colors 0-15 are rendered as "ansi-fg-N" and "ansi-bg-N" classes, other
+// colors are rendered as inline styles.
+const HTMLStyle = `
+.ansi-bold { font-weight: bold; }
+.ansi-faint { opacity: 0.7; }
+.ansi-italic { font-style: italic; }
+.ansi-underline { text-decoration: underline; }
+.ansi-fg-0 { color: #000000; } .ansi-bg-0 { background-color: #000000; }
+.ansi-fg-1 { color: #cd3131; } .ansi-bg-1 { background-color: #cd3131; }
+.ansi-fg-2 { color: #0dbc79; } .ansi-bg-2 { background-color: #0dbc79; }
+.ansi-fg-3 { color: #949800; } .ansi-bg-3 { background-color: #949800; }
+.ansi-fg-4 { color: #0451a5; } .ansi-bg-4 { background-color: #0451a5; }
+.ansi-fg-5 { color: #bc05bc; } .ansi-bg-5 { background-color: #bc05bc; }
+.ansi-fg-6 { color: #0598bc; } .ansi-bg-6 { background-color: #0598bc; }
+.ansi-fg-7 { color: #555555; } .ansi-bg-7 { background-color: #e5e5e5; }
+.ansi-fg-8 { color: #666666; } .ansi-bg-8 { background-color: #666666; }
+.ansi-fg-9 { color: #cd3131; } .ansi-bg-9 { background-color: #f14c4c; }
+.ansi-fg-10 { color: #14ce14; } .ansi-bg-10 { background-color: #23d18b; }
+.ansi-fg-11 { color: #b5ba00; } .ansi-bg-11 { background-color: #f5f543; }
+.ansi-fg-12 { color: #0451a5; } .ansi-bg-12 { background-color: #3b8eea; }
+.ansi-fg-13 { color: #bc05bc; } .ansi-bg-13 { background-color: #d670d6; }
+.ansi-fg-14 { color: #0598bc; } .ansi-bg-14 { background-color: #29b8db; }
+.ansi-fg-15 { color: #a5a5a5; } .ansi-bg-15 { background-color: #ffffff; }
+`
+
+// htmlPageStyle is the stylesheet of the standalone page written by
+// WriteHTMLHeader, in addition to HTMLStyle.
+const htmlPageStyle = `
+body { margin: 0; font-family: monospace; }
+.lines { white-space: pre-wrap; word-break: break-all; }
+.line { display: flex; }
+.line:target { background-color: #fff9c4; }
+.line > .anchor { flex: none; width: 6em; padding-right: 1em; text-align: right; color: #999999; text-decoration: none; user-select: none; }
+.line > .text { flex: auto; }
+`
+
+// WriteHTMLHeader writes the beginning of a standalone HTML page that the
+// output of a Renderer in HTML mode can be written into.
+//
+// It must be followed by WriteHTMLFooter.
+func WriteHTMLHeader(w io.Writer, title string) error {
+	_, err := fmt.Fprintf(w, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n"+
+		"<title>%s</title>\n<style>%s%s</style>\n</head>\n<body>\n<div class=\"lines\">\n",
+		html.EscapeString(title), htmlPageStyle, HTMLStyle)
+	return err
+}
+
+// WriteHTMLFooter writes the end of the page started by WriteHTMLHeader.
+func WriteHTMLFooter(w io.Writer) error {
+	_, err := io.WriteString(w, "</div>\n</body>\n</html>\n")
+	return err
+}
+
+// HTMLConverter converts lines of text containing ANSI escape sequences into
+// HTML.
+//
+// SGR ("Select Graphic Rendition") sequences are converted into styled spans
+// (see HTMLStyle), all other escape sequences are dropped. Carriage returns
+// rewrite the line, as they would in a terminal, so only the text after the
+// last one is kept. This collapses progress bars into their final state.
+//
+// HTMLConverter is stateful: the style set by a line carries over to the
+// following lines, until it is reset.
+type HTMLConverter struct {
+	// Escape, if not nil, is used to convert plain text runs to HTML instead of
+	// html.EscapeString. It must return safe HTML.
+	Escape func(string) string
+
+	style sgrStyle
+}
+
+// Convert converts a single line, without its delimiter, into HTML.
+func (c *HTMLConverter) Convert(line []byte) string {
+	segs := bytes.Split(line, []byte{'\r'})
+	last := len(segs) - 1
+	for last > 0 && len(segs[last]) == 0 {
+		last--
+	}
+
+	// Rewritten segments still affect the style.
+	for _, seg := range segs[:last] {
+		c.scan(seg, nil)
+	}
+	var b strings.Builder
+	c.scan(segs[last], &b)
+	return b.String()
+}
+
+// Reset resets the style to the default.
+func (c *HTMLConverter) Reset() {
+	c.style = sgrStyle{}
+}
+
+// scan processes the escape sequences in seg, writing its text into out, if
+// not nil.
+func (c *HTMLConverter) scan(seg []byte, out *strings.Builder) {
+	start := 0
+	flush := func(end int) {
+		if out != nil && end > start {
+			c.writeText(out, string(seg[start:end]))
+		}
+	}
+
+	for i := 0; i < len(seg); {
+		if seg[i] != 0x1b {
+			i++
+			continue
+		}
+		flush(i)
+		i = c.escape(seg, i)
+		start = i
+	}
+	flush(len(seg))
+}
+
+// escape processes the escape sequence starting at seg[i] and returns the
+// index right after it.
+func (c *HTMLConverter) escape(seg []byte, i int) int {
+	i++ // ESC
+	if i == len(seg) {
+		return i
+	}
+
+	switch seg[i] {
+	case '[':
+		// CSI: parameter bytes, intermediate bytes and the final byte.
+		i++
+		paramStart := i
+		for i < len(seg) && seg[i] >= 0x30 && seg[i] <= 0x3f {
+			i++
+		}
+		params := seg[paramStart:i]
+		for i < len(seg) && seg[i] >= 0x20 && seg[i] <= 0x2f {
+			i++
+		}
+		if i == len(seg) {
+			return i
+		}
+		if final := seg[i]; final == 'm' {
+			c.style.apply(string(params))
+		}
+		return i + 1
+
+	case ']':
+		// OSC: terminated by BEL or ST (ESC \).
+		for i++; i < len(seg); i++ {
+			switch {
+			case seg[i] == 0x07:
+				return i + 1
+			case seg[i] == 0x1b && i+1 < len(seg) && seg[i+1] == '\\':
+				return i + 2
+			}
+		}
+		return i
+
+	default:
+		// Intermediate bytes (e.g. charset designation "ESC ( B") and the final
+		// byte.
+		for i < len(seg) && seg[i] >= 0x20 && seg[i] <= 0x2f {
+			i++
+		}
+		if i == len(seg) {
+			return i
+		}
+		return i + 1
+	}
+}
+
+func (c *HTMLConverter) writeText(out *strings.Builder, text string) {
+	escape := html.EscapeString
+	if c.Escape != nil {
+		escape = c.Escape
+	}
+	escaped := escape(text)
+
+	open := c.style.span()
+	if open == "" {
+		out.WriteString(escaped)
+		return
+	}
+	out.WriteString(open)
+	out.WriteString(escaped)
+	out.WriteString("</span>")
+}
+
+// sgrColor is a color set by an SGR sequence.
+type sgrColor struct {
+	kind    uint8 // one of colorDefault, colorIndexed, colorRGB
+	index   uint8
+	r, g, b uint8
+}
+
+const (
+	colorDefault = iota
+	colorIndexed
+	colorRGB
+)
+
+// css returns a class name for the color, or an inline CSS color.
+func (c sgrColor) css() (class, color string) {
+	switch {
+	case c.kind == colorIndexed && c.index < 16:
+		return strconv.Itoa(int(c.index)), ""
+	case c.kind == colorIndexed && c.index < 232:
+		// 6x6x6 color cube.
+		levels := [6]uint8{0, 95, 135, 175, 215, 255}
+		n := c.index - 16
+		return "", fmt.Sprintf("#%02x%02x%02x", levels[n/36], levels[n/6%6], levels[n%6])
+	case c.kind == colorIndexed:
+		// Grayscale ramp.
+		v := 8 + 10*(c.index-232)
+		return "", fmt.Sprintf("#%02x%02x%02x", v, v, v)
+	case c.kind == colorRGB:
+		return "", fmt.Sprintf("#%02x%02x%02x", c.r, c.g, c.b)
+	}
+	return "", ""
+}
+
+// sgrStyle is the current graphic rendition.
+type sgrStyle struct {
+	bold, faint, italic, underline bool
+	fg, bg                         sgrColor
+}
+
+// apply applies SGR parameters, e.g. "1;31", to the style.
+//
+// Unsupported parameters are ignored.
+func (s *sgrStyle) apply(params string) {
+	if params != "" && strings.ContainsAny(params[:1], "<=>?") {
+		// Private sequence.
+		return
+	}
+	if strings.Contains(params, ":") {
+		// ITU T.416 sub-parameters are not supported.
+		return
+	}
+
+	var codes []int
+	for _, p := range strings.Split(params, ";") {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			n = 0 // empty parameters mean 0
+		}
+		codes = append(codes, n)
+	}
+
+	for i := 0; i < len(codes); i++ {
+		switch n := codes[i]; {
+		case n == 0:
+			*s = sgrStyle{}
+		case n == 1:
+			s.bold = true
+		case n == 2:
+			s.faint = true
+		case n == 3:
+			s.italic = true
+		case n == 4:
+			s.underline = true
+		case n == 22:
+			s.bold, s.faint = false, false
+		case n == 23:
+			s.italic = false
+		case n == 24:
+			s.underline = false
+		case n >= 30 && n <= 37:
+			s.fg = sgrColor{kind: colorIndexed, index: uint8(n - 30)}
+		case n == 38:
+			s.fg, i = extendedColor(codes, i)
+		case n == 39:
+			s.fg = sgrColor{}
+		case n >= 40 && n <= 47:
+			s.bg = sgrColor{kind: colorIndexed, index: uint8(n - 40)}
+		case n == 48:
+			s.bg, i = extendedColor(codes, i)
+		case n == 49:
+			s.bg = sgrColor{}
+		case n >= 90 && n <= 97:
+			s.fg = sgrColor{kind: colorIndexed, index: uint8(n - 90 + 8)}
+		case n >= 100 && n <= 107:
+			s.bg = sgrColor{kind: colorIndexed, index: uint8(n - 100 + 8)}
+		}
+	}
+}
+
+// extendedColor parses "38;5;N" or "38;2;R;G;B" starting at codes[i] and
+// returns the color and the index of its last code.
+func extendedColor(codes []int, i int) (sgrColor, int) {
+	clamp := func(v int) uint8 {
+		switch {
+		case v < 0:
+			return 0
+		case v > 255:
+			return 255
+		}
+		return uint8(v)
+	}
+
+	switch {
+	case i+2 < len(codes) && codes[i+1] == 5:
+		return sgrColor{kind: colorIndexed, index: clamp(codes[i+2])}, i + 2
+	case i+4 < len(codes) && codes[i+1] == 2:
+		return sgrColor{
+			kind: colorRGB,
+			r:    clamp(codes[i+2]),
+			g:    clamp(codes[i+3]),
+			b:    clamp(codes[i+4]),
+		}, i + 4
+	}
+	// Malformed, skip the rest.
+	return sgrColor{}, len(codes)
+}
+
+// span returns the opening span tag for the style, or "" for the default
+// style.
+func (s sgrStyle) span() string {
+	var classes, styles []string
+	if s.bold {
+		classes = append(classes, "ansi-bold")
+	}
+	if s.faint {
+		classes = append(classes, "ansi-faint")
+	}
+	if s.italic {
+		classes = append(classes, "ansi-italic")
+	}
+	if s.underline {
+		classes = append(classes, "ansi-underline")
+	}
+	if class, color := s.fg.css(); class != "" {
+		classes = append(classes, "ansi-fg-"+class)
+	} else if color != "" {
+		styles = append(styles, "color:"+color)
+	}
+	if class, color := s.bg.css(); class != "" {
+		classes = append(classes, "ansi-bg-"+class)
+	} else if color != "" {
+		styles = append(styles, "background-color:"+color)
+	}
+
+	if len(classes) == 0 && len(styles) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("<span")
+	if len(classes) > 0 {
+		fmt.Fprintf(&b, ` class="%s"`, strings.Join(classes, " "))
+	}
+	if len(styles) > 0 {
+		fmt.Fprintf(&b, ` style="%s"`, strings.Join(styles, ";"))
+	}
+	b.WriteString(">")
+	return b.String()
+}
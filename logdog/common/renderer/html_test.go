@@ -0,0 +1,89 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package renderer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHTMLConverter(t *testing.T) {
+	t.Parallel()
+
+	Convey(`An HTMLConverter`, t, func() {
+		c := &HTMLConverter{}
+		conv := func(line string) string { return c.Convert([]byte(line)) }
+
+		Convey(`Escapes plain text`, func() {
+			So(conv(`a < b && "c"`), ShouldEqual, `a &lt; b &amp;&amp; &#34;c&#34;`)
+		})
+
+		Convey(`Converts basic colors and attributes`, func() {
+			So(conv("\x1b[1;31mError:\x1b[0m \x1b[4mfile.cc\x1b[24m:10"), ShouldEqual,
+				`<span class="ansi-bold ansi-fg-1">Error:</span> <span class="ansi-underline">file.cc</span>:10`)
+			So(conv("\x1b[92;44mbright\x1b[39;49m"), ShouldEqual,
+				`<span class="ansi-fg-10 ansi-bg-4">bright</span>`)
+			So(conv("\x1b[mplain"), ShouldEqual, `plain`)
+		})
+
+		Convey(`Converts extended colors`, func() {
+			So(conv("\x1b[38;5;196mred\x1b[0m"), ShouldEqual, `<span style="color:#ff0000">red</span>`)
+			So(conv("\x1b[38;5;9mbright\x1b[0m"), ShouldEqual, `<span class="ansi-fg-9">bright</span>`)
+			So(conv("\x1b[48;5;232mgray\x1b[0m"), ShouldEqual, `<span style="background-color:#080808">gray</span>`)
+			So(conv("\x1b[38;2;1;2;300mrgb\x1b[0m"), ShouldEqual, `<span style="color:#0102ff">rgb</span>`)
+			So(conv("\x1b[38;5mbroken"), ShouldEqual, `broken`)
+		})
+
+		Convey(`Carries the style across lines`, func() {
+			So(conv("\x1b[33mwarning"), ShouldEqual, `<span class="ansi-fg-3">warning</span>`)
+			So(conv("continued\x1b[0m done"), ShouldEqual, `<span class="ansi-fg-3">continued</span> done`)
+			So(conv("next"), ShouldEqual, `next`)
+
+			conv("\x1b[33m")
+			c.Reset()
+			So(conv("reset"), ShouldEqual, `reset`)
+		})
+
+		Convey(`Drops other escape sequences`, func() {
+			So(conv("\x1b[2Kerased\x1b[1A\x1b[?25l"), ShouldEqual, `erased`)
+			So(conv("\x1b]0;title\x07text\x1b]8;;http://x\x1b\\link"), ShouldEqual, `textlink`)
+			So(conv("a\x1b(Bb\x1b"), ShouldEqual, `ab`)
+			So(conv("trailing\x1b[31"), ShouldEqual, `trailing`)
+		})
+
+		Convey(`Collapses carriage return rewrites`, func() {
+			So(conv("[  0%]\r[ 50%]\r[100%] done"), ShouldEqual, `[100%] done`)
+			So(conv("progress\r"), ShouldEqual, `progress`)
+			So(conv("\x1b[32m10%\r\x1b[1m100%"), ShouldEqual, `<span class="ansi-bold ansi-fg-2">100%</span>`)
+		})
+
+		Convey(`Uses a custom escaper`, func() {
+			c.Escape = strings.ToUpper
+			So(conv("\x1b[31mred\x1b[0m"), ShouldEqual, `<span class="ansi-fg-1">RED</span>`)
+		})
+	})
+
+	Convey(`HTML page`, t, func() {
+		var b bytes.Buffer
+		So(WriteHTMLHeader(&b, "a <title>"), ShouldBeNil)
+		So(WriteHTMLFooter(&b), ShouldBeNil)
+		So(b.String(), ShouldContainSubstring, "<title>a &lt;title&gt;</title>")
+		So(b.String(), ShouldContainSubstring, ".ansi-fg-15")
+		So(b.String(), ShouldEndWith, "</html>\n")
+	})
+}
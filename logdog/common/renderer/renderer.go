@@ -19,12 +19,16 @@
 //     order.
 //   - Binary streams are rendered by emitting the sequential binary data
 //     verbatim.
+//
+// In HTML mode, text lines are rendered as anchored HTML elements, converting
+// ANSI escape sequences into styled spans (see HTMLConverter).
 package renderer
 
 import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"html"
 	"io"
 
 	"go.chromium.org/luci/logdog/api/logpb"
@@ -52,6 +56,24 @@ type Renderer struct {
 	//   DatagramWriters and hex translation and dumps data directly to output.
 	Raw bool
 
+	// HTML, if true, renders the stream as a sequence of HTML elements suitable
+	// for embedding into a page started by WriteHTMLHeader. Takes precedence
+	// over Raw.
+	//
+	// Each text line is rendered as a <div class="line"> with an
+	// "L<stream index>_<line index>" id and a link to it, with ANSI escape
+	// sequences converted by an HTMLConverter. Other content is rendered as
+	// escaped <pre> blocks.
+	HTML bool
+
+	// Stream, if not nil, is called in HTML mode to identify the stream a log
+	// entry belongs to when Source merges several streams.
+	//
+	// Each stream then gets its own HTMLConverter, so ANSI styles don't leak
+	// between streams, and the ids of its lines are prefixed with
+	// "S<stream>_".
+	Stream func(le *logpb.LogEntry) int
+
 	// TextPrefix, if not nil, is called prior to rendering a text line. The
 	// resulting string is prepended to that text line on render.
 	TextPrefix func(le *logpb.LogEntry, line *logpb.Text_Line) string
@@ -71,6 +93,9 @@ type Renderer struct {
 
 	// dgBuf is a buffer used for partial datagrams.
 	dgBuf bytes.Buffer
+
+	// html converts text lines in HTML mode, per stream.
+	html map[int]*HTMLConverter
 }
 
 var _ io.Reader = (*Renderer)(nil)
@@ -100,6 +125,11 @@ func (r *Renderer) bufferNext() error {
 	le, err := r.Source.NextLogEntry()
 	if le != nil {
 		switch {
+		case le.GetText() != nil && r.HTML:
+			for i, line := range le.GetText().Lines {
+				r.writeHTMLLine(le, i, line)
+			}
+
 		case le.GetText() != nil:
 			for _, line := range le.GetText().Lines {
 				if r.TextPrefix != nil {
@@ -116,7 +146,9 @@ func (r *Renderer) bufferNext() error {
 
 		case le.GetBinary() != nil:
 			data := le.GetBinary().Data
-			if !r.Raw {
+			if r.HTML {
+				fmt.Fprintf(&r.buf, "<pre>%s</pre>\n", hex.EncodeToString(data))
+			} else if !r.Raw {
 				r.buf.WriteString(hex.EncodeToString(data))
 			} else {
 				r.buf.Write(data)
@@ -124,7 +156,7 @@ func (r *Renderer) bufferNext() error {
 
 		case le.GetDatagram() != nil:
 			dg := le.GetDatagram()
-			if r.Raw {
+			if r.Raw && !r.HTML {
 				r.buf.Write(dg.Data)
 				break
 			}
@@ -141,14 +173,21 @@ func (r *Renderer) bufferNext() error {
 					bytesStr = "byte"
 				}
 
-				fmt.Fprintf(&r.buf, "Datagram #%d (%d %s)\n", le.Sequence, r.dgBuf.Len(), bytesStr)
-				if f := r.DatagramWriter; f == nil || !f(&r.buf, r.dgBuf.Bytes()) {
+				var dgOut bytes.Buffer
+				fmt.Fprintf(&dgOut, "Datagram #%d (%d %s)\n", le.Sequence, r.dgBuf.Len(), bytesStr)
+				if f := r.DatagramWriter; f == nil || !f(&dgOut, r.dgBuf.Bytes()) {
 					// Writer failed, or no writer configured. Use a hex dump.
-					if err := dumpHex(&r.buf, r.dgBuf.Bytes()); err != nil {
+					if err := dumpHex(&dgOut, r.dgBuf.Bytes()); err != nil {
 						return err
 					}
 				}
-				r.buf.WriteRune('\n')
+				dgOut.WriteRune('\n')
+
+				if r.HTML {
+					fmt.Fprintf(&r.buf, "<pre>%s</pre>\n", html.EscapeString(dgOut.String()))
+				} else {
+					r.buf.Write(dgOut.Bytes())
+				}
 
 				r.dgBuf.Reset()
 			}
@@ -158,6 +197,32 @@ func (r *Renderer) bufferNext() error {
 	return err
 }
 
+// writeHTMLLine renders a text line in HTML mode.
+func (r *Renderer) writeHTMLLine(le *logpb.LogEntry, i int, line *logpb.Text_Line) {
+	id := fmt.Sprintf("L%d_%d", le.StreamIndex, i)
+	stream := 0
+	if r.Stream != nil {
+		stream = r.Stream(le)
+		id = fmt.Sprintf("S%d_%s", stream, id)
+	}
+	conv := r.html[stream]
+	if conv == nil {
+		if r.html == nil {
+			r.html = make(map[int]*HTMLConverter)
+		}
+		conv = &HTMLConverter{}
+		r.html[stream] = conv
+	}
+
+	fmt.Fprintf(&r.buf, `<div class="line" id="%s"><a class="anchor" href="#%s">%d</a><span class="text">`,
+		id, id, le.StreamIndex)
+	if r.TextPrefix != nil {
+		r.buf.WriteString(html.EscapeString(r.TextPrefix(le, line)))
+	}
+	r.buf.WriteString(conv.Convert(line.Value))
+	r.buf.WriteString("</span></div>\n")
+}
+
 func dumpHex(w io.Writer, data []byte) (err error) {
 	// Hex dump.
 	d := hex.Dumper(w)
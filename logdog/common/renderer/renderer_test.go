@@ -121,6 +121,47 @@ func TestRenderer(t *testing.T) {
 			})
 		})
 
+		Convey(`With colorized TEXT log entries, renders HTML.`, func() {
+			ts.loadText("\x1b[31merror\x1b[0m: <bad>", "\n")
+			ts.loadText("10%\r50%\r100%", "\n")
+			ts.logs[1].StreamIndex = 1
+			r.HTML = true
+			r.TextPrefix = func(*logpb.LogEntry, *logpb.Text_Line) string { return "a&b: " }
+
+			_, err := b.ReadFrom(r)
+			So(err, ShouldBeNil)
+			So(b.String(), ShouldEqual, ""+
+				`<div class="line" id="L0_0"><a class="anchor" href="#L0_0">0</a><span class="text">a&amp;b: `+
+				`<span class="ansi-fg-1">error</span>: &lt;bad&gt;</span></div>`+"\n"+
+				`<div class="line" id="L1_0"><a class="anchor" href="#L1_0">1</a><span class="text">a&amp;b: `+
+				`100%</span></div>`+"\n")
+		})
+
+		Convey(`With TEXT log entries of several streams, renders HTML per stream.`, func() {
+			ts.loadText("\x1b[31mred", "\n")
+			ts.loadText("plain", "\n")
+			ts.loadText("still red", "\n")
+			ts.logs[2].StreamIndex = 1
+			stream1 := ts.logs[1]
+			r.HTML = true
+			r.Stream = func(le *logpb.LogEntry) int {
+				if le == stream1 {
+					return 1
+				}
+				return 0
+			}
+
+			_, err := b.ReadFrom(r)
+			So(err, ShouldBeNil)
+			So(b.String(), ShouldEqual, ""+
+				`<div class="line" id="S0_L0_0"><a class="anchor" href="#S0_L0_0">0</a><span class="text">`+
+				`<span class="ansi-fg-1">red</span></span></div>`+"\n"+
+				`<div class="line" id="S1_L0_0"><a class="anchor" href="#S1_L0_0">0</a><span class="text">`+
+				`plain</span></div>`+"\n"+
+				`<div class="line" id="S0_L1_0"><a class="anchor" href="#S0_L1_0">1</a><span class="text">`+
+				`<span class="ansi-fg-1">still red</span></span></div>`+"\n")
+		})
+
 		Convey(`With BINARY log entries {{0x00}, {0x01, 0x02}, {}, {0x03}}`, func() {
 			ts.loadBinary([]byte{0x00})
 			ts.loadBinary([]byte{0x01, 0x02})
@@ -200,6 +241,18 @@ func TestRenderer(t *testing.T) {
 					So(bytes, ShouldResemble, []byte{0x00, 0x01, 0x02, 0x03})
 				})
 
+				Convey(`Escapes the writer output in HTML mode.`, func() {
+					r.HTML = true
+					r.DatagramWriter = func(w io.Writer, dg []byte) bool {
+						w.Write([]byte("<rendered>"))
+						return true
+					}
+
+					_, err := b.ReadFrom(r)
+					So(err, ShouldBeNil)
+					So(b.String(), ShouldEqual, "<pre>Datagram #0 (4 bytes)\n&lt;rendered&gt;\n</pre>\n")
+				})
+
 				Convey(`Renders a full hex dump when the writer returns false.`, func() {
 					r.DatagramWriter = func(w io.Writer, dg []byte) bool { return false }
 
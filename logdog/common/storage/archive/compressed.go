@@ -0,0 +1,102 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"io"
+	"sort"
+
+	"github.com/klauspost/compress/zstd"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/gcloud/gs"
+	"go.chromium.org/luci/logdog/api/logpb"
+)
+
+// newStreamReader returns a reader of the uncompressed log stream data
+// starting at offset. If length is >= 0, at most length bytes will be read.
+//
+// If the index lists compressed blocks, only the blocks covering the requested
+// range are fetched and decompressed.
+func newStreamReader(client gs.Client, path gs.Path, idx *logpb.LogIndex, offset uint64, length int64) (io.ReadCloser, error) {
+	blocks := idx.GetBlocks()
+	if len(blocks) == 0 {
+		return client.NewReader(path, int64(offset), length)
+	}
+
+	// The first block is the last one starting at or before offset.
+	first := sort.Search(len(blocks), func(i int) bool { return blocks[i].Offset > offset }) - 1
+	if first < 0 {
+		first = 0
+	}
+	if b := blocks[first]; offset >= b.Offset+b.Size {
+		// Past the end of the stream.
+		return io.NopCloser(eofReader{}), nil
+	}
+
+	// The last block is the last one starting before the end of the range.
+	last := len(blocks) - 1
+	if length >= 0 {
+		end := offset + uint64(length)
+		last = sort.Search(len(blocks), func(i int) bool { return blocks[i].Offset >= end }) - 1
+		if last < first {
+			last = first
+		}
+	}
+
+	start := blocks[first].CompressedOffset
+	end := blocks[last].CompressedOffset + blocks[last].CompressedSize
+	r, err := client.NewReader(path, int64(start), int64(end-start))
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		r.Close()
+		return nil, errors.Annotate(err, "failed to create zstd decoder").Err()
+	}
+	cr := &compressedReader{Reader: dec, dec: dec, r: r}
+
+	// Skip to the requested offset within the first block.
+	if skip := int64(offset - blocks[first].Offset); skip > 0 {
+		if _, err := io.CopyN(io.Discard, dec, skip); err != nil {
+			cr.Close()
+			return nil, errors.Annotate(err, "failed to skip to offset %d", offset).Err()
+		}
+	}
+	if length >= 0 {
+		cr.Reader = io.LimitReader(dec, length)
+	}
+	return cr, nil
+}
+
+// compressedReader reads decompressed data, closing both the decoder and the
+// underlying reader when closed.
+type compressedReader struct {
+	io.Reader
+
+	dec *zstd.Decoder
+	r   io.ReadCloser
+}
+
+func (cr *compressedReader) Close() error {
+	cr.dec.Close()
+	return cr.r.Close()
+}
+
+type eofReader struct{}
+
+func (eofReader) Read([]byte) (int, error) { return 0, io.EOF }
@@ -20,6 +20,10 @@
 //   - It is read-only. Mutation methods will return storage.ErrReadOnly.
 //   - Storage methods ignore the supplied Path argument, instead opting for
 //     the archive configured in its Options.
+//
+// Compressed archives (see archive.Manifest's CompressionBlockSize) are
+// supported: offsets in the index refer to the uncompressed stream, and only
+// the compressed blocks covering the requested range are fetched.
 package archive
 
 import (
@@ -115,7 +119,7 @@ func (s *storageImpl) Get(c context.Context, req storage.GetRequest, cb storage.
 		return nil
 	}
 
-	switch err := s.getLogEntriesIter(c, st, cb); errors.Unwrap(err) {
+	switch err := s.getLogEntriesIter(c, idx, st, cb); errors.Unwrap(err) {
 	case nil, io.EOF:
 		// We hit the end of our log stream.
 		return nil
@@ -129,7 +133,7 @@ func (s *storageImpl) Get(c context.Context, req storage.GetRequest, cb storage.
 }
 
 // getLogEntriesImpl retrieves log entries from archive until complete.
-func (s *storageImpl) getLogEntriesIter(c context.Context, st *getStrategy, cb storage.GetCallback) error {
+func (s *storageImpl) getLogEntriesIter(c context.Context, idx *logpb.LogIndex, st *getStrategy, cb storage.GetCallback) error {
 	// Get our maximum byte limit. If we are externally constrained via MaxBytes,
 	// apply that limit too.
 	// Get an archive reader.
@@ -143,7 +147,7 @@ func (s *storageImpl) getLogEntriesIter(c context.Context, st *getStrategy, cb s
 		"length": length,
 		"path":   s.Stream,
 	}.Debugf(c, "Creating stream reader for range.")
-	storageReader, err := s.Client.NewReader(s.Stream, int64(offset), length)
+	storageReader, err := newStreamReader(s.Client, s.Stream, idx, offset, length)
 	if err != nil {
 		log.WithError(err).Errorf(c, "Failed to create stream Reader.")
 		return errors.Annotate(err, "failed to create stream Reader").Err()
@@ -287,6 +291,24 @@ func (s *storageImpl) Tail(c context.Context, project string, path types.StreamP
 	}
 }
 
+// IsCompressed returns true if st is an archive Storage instance whose log
+// stream is compressed.
+//
+// A compressed log stream is not a plain RecordIO stream, and the offsets in
+// its index refer to the uncompressed data, so neither can be handed to
+// consumers that read the archive files directly.
+func IsCompressed(c context.Context, st storage.Storage) (bool, error) {
+	s, ok := st.(*storageImpl)
+	if !ok {
+		return false, nil
+	}
+	idx, err := s.getIndex(c)
+	if err != nil {
+		return false, err
+	}
+	return len(idx.GetBlocks()) > 0, nil
+}
+
 // getIndex returns the cached log stream index, fetching it if necessary.
 func (s *storageImpl) getIndex(c context.Context) (*logpb.LogIndex, error) {
 	idx := s.index.Load()
@@ -45,6 +45,9 @@ const (
 type logStreamGenerator struct {
 	lines []string
 
+	// blockSize, if >0, enables compression.
+	blockSize int
+
 	indexBuf  bytes.Buffer
 	streamBuf bytes.Buffer
 }
@@ -87,9 +90,10 @@ func (g *logStreamGenerator) generate(lines ...string) {
 			Prefix: "prefix",
 			Name:   "name",
 		},
-		Source:      &src,
-		LogWriter:   &g.streamBuf,
-		IndexWriter: &g.indexBuf,
+		Source:               &src,
+		LogWriter:            &g.streamBuf,
+		IndexWriter:          &g.indexBuf,
+		CompressionBlockSize: g.blockSize,
 	})
 	if err != nil {
 		panic(err)
@@ -250,6 +254,13 @@ func testArchiveStorage(t *testing.T, limit int64) {
 		Convey(`Given a stream with 5 log entries`, func() {
 			gen.generate("foo", "bar", "baz", "qux", "quux")
 
+			Convey(`Is not reported as compressed`, func() {
+				client.load(&gen)
+				compressed, err := IsCompressed(c, st)
+				So(err, ShouldBeNil)
+				So(compressed, ShouldBeFalse)
+			})
+
 			// Basic test cases.
 			for _, tc := range []struct {
 				title string
@@ -320,6 +331,89 @@ func testArchiveStorage(t *testing.T, limit int64) {
 			}
 		})
 
+		Convey(`Given a compressed stream with 5 log entries`, func() {
+			gen.blockSize = 8
+			gen.generate("foo", "bar", "baz", "qux", "quux")
+
+			for _, tc := range []struct {
+				title string
+				mod   func()
+			}{
+				{`Complete index`, func() {}},
+				{`Complete index without hints`, func() { gen.pruneIndexHints() }},
+				{`Sparse index with a start and terminal entry`, func() { gen.sparseIndex(0, 2, 4) }},
+				{`Sparse index missing a terminal entry`, func() { gen.sparseIndex(1, 3) }},
+			} {
+				Convey(fmt.Sprintf(`Test Case: %q`, tc.title), func() {
+					tc.mod()
+					client.load(&gen)
+
+					var entries []string
+					collect := func(e *storage.Entry) bool {
+						entries = append(entries, gen.lineFromEntry(e))
+						return true
+					}
+
+					Convey(`Can Get [0..]`, func() {
+						So(st.Get(c, storage.GetRequest{}, collect), ShouldBeNil)
+						So(entries, ShouldResemble, gen.lines)
+					})
+
+					Convey(`Can Get [2..3].`, func() {
+						So(st.Get(c, storage.GetRequest{Index: 2, Limit: 2}, collect), ShouldBeNil)
+						So(entries, ShouldResemble, gen.lines[2:4])
+					})
+
+					Convey(`Can Get [5..].`, func() {
+						So(st.Get(c, storage.GetRequest{Index: 5}, collect), ShouldBeNil)
+						So(entries, ShouldHaveLength, 0)
+					})
+
+					Convey(`Can tail.`, func() {
+						e, err := st.Tail(c, "", "")
+						So(err, ShouldBeNil)
+						So(gen.lineFromEntry(e), ShouldEqual, gen.lines[len(gen.lines)-1])
+					})
+				})
+			}
+
+			Convey(`Is reported as compressed`, func() {
+				client.load(&gen)
+				compressed, err := IsCompressed(c, st)
+				So(err, ShouldBeNil)
+				So(compressed, ShouldBeTrue)
+			})
+
+			Convey(`Fetches only the needed blocks`, func() {
+				client.load(&gen)
+				var index logpb.LogIndex
+				So(proto.Unmarshal(client.index, &index), ShouldBeNil)
+				So(len(index.Blocks), ShouldBeGreaterThan, 2)
+
+				// Corrupt the first block; reading the last entry must not touch it.
+				b := index.Blocks[0]
+				for i := b.CompressedOffset; i < b.CompressedOffset+b.CompressedSize; i++ {
+					client.stream[i] = 0
+				}
+				var entries []string
+				So(st.Get(c, storage.GetRequest{Index: 4}, func(e *storage.Entry) bool {
+					entries = append(entries, gen.lineFromEntry(e))
+					return true
+				}), ShouldBeNil)
+				So(entries, ShouldResemble, []string{"quux"})
+			})
+		})
+
+		Convey(`Given a compressed stream with no log entries`, func() {
+			gen.blockSize = 8
+			gen.generate()
+			client.load(&gen)
+
+			So(st.Get(c, storage.GetRequest{}, func(e *storage.Entry) bool {
+				panic("unexpected entry")
+			}), ShouldBeNil)
+		})
+
 		// Individual error test cases.
 		for _, tc := range []struct {
 			title string
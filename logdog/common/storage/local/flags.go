@@ -0,0 +1,47 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"flag"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// Flags contains the local storage config.
+type Flags struct {
+	// Dir is the directory with the database.
+	Dir string
+}
+
+// Register registers flags in the flag set.
+func (f *Flags) Register(fs *flag.FlagSet) {
+	fs.StringVar(&f.Dir, "local-storage-dir", f.Dir,
+		"Directory with the local intermediate storage database. It is created if necessary.")
+}
+
+// Validate returns an error if some parsed flags have invalid values.
+func (f *Flags) Validate() error {
+	if f.Dir == "" {
+		return errors.New("-local-storage-dir is required")
+	}
+	return nil
+}
+
+// StorageFromFlags opens the *local.Storage given parsed flags.
+func StorageFromFlags(ctx context.Context, f *Flags) (*Storage, error) {
+	return Open(ctx, f.Dir)
+}
@@ -0,0 +1,211 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package local implements a durable storage.Storage backed by an embedded
+// key-value store on the local disk.
+//
+// It is intended for small, self-hosted deployments and integration tests that
+// don't want to depend on a BigTable instance or emulator. Only one process
+// can open the database at a time, so all LogDog services using this Storage
+// must share the same process (see logdog/server/cmd/logdog_devserver).
+package local
+
+import (
+	"context"
+	"encoding/binary"
+
+	"go.chromium.org/luci/common/data/embeddedkvs"
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+// Storage is a storage.Storage implementation that stores log entries in a
+// local embeddedkvs database.
+//
+// Each log entry is stored under its own key, composed of the stream's project,
+// path, and the entry's big-endian index, so entries of a stream are iterated
+// in index order.
+type Storage struct {
+	kvs *embeddedkvs.KVS
+}
+
+var _ storage.Storage = (*Storage)(nil)
+
+// Open opens (creating, if necessary) the Storage database in the directory at
+// path.
+func Open(ctx context.Context, path string) (*Storage, error) {
+	kvs, err := embeddedkvs.New(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{kvs: kvs}, nil
+}
+
+// Close implements storage.Storage.
+func (s *Storage) Close() {
+	if err := s.kvs.Close(); err != nil {
+		log.WithError(err).Errorf(context.Background(), "Failed to close database.")
+	}
+}
+
+// Put implements storage.Storage.
+//
+// All of the request's values are written in a single transaction. If any of
+// them already exists, none are written and storage.ErrExists is returned.
+func (s *Storage) Put(c context.Context, r storage.PutRequest) error {
+	prefix := streamPrefix(r.Project, r.Path)
+	err := s.kvs.Insert(func(insert func(key string, value []byte) error) error {
+		for i, v := range r.Values {
+			if err := insert(entryKey(prefix, r.Index+types.MessageIndex(i)), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == embeddedkvs.ErrExists {
+		return storage.ErrExists
+	}
+	return errors.Annotate(err, "failed to put log entries").Err()
+}
+
+// Expunge implements storage.Storage.
+func (s *Storage) Expunge(c context.Context, r storage.ExpungeRequest) error {
+	err := s.kvs.DeletePrefix(streamPrefix(r.Project, r.Path))
+	return errors.Annotate(err, "failed to expunge log stream").Err()
+}
+
+// Get implements storage.Storage.
+func (s *Storage) Get(c context.Context, r storage.GetRequest, cb storage.GetCallback) error {
+	prefix := streamPrefix(r.Project, r.Path)
+	if r.Index < 0 {
+		r.Index = 0
+	}
+
+	found := false
+	count := 0
+	err := s.kvs.ForEachWithPrefix(prefix, entryKey(prefix, r.Index), r.KeysOnly, func(key string, value []byte) error {
+		found = true
+		index, err := entryIndex(prefix, key)
+		if err != nil {
+			return err
+		}
+
+		var data []byte
+		if !r.KeysOnly {
+			// The value is valid only during the callback.
+			data = append([]byte(nil), value...)
+		}
+		if !cb(storage.MakeEntry(data, index)) {
+			return embeddedkvs.Stop
+		}
+
+		count++
+		if r.Limit > 0 && count >= r.Limit {
+			return embeddedkvs.Stop
+		}
+		return nil
+	})
+	if err == nil && !found {
+		// Nothing at or after r.Index; check whether the stream has any entries
+		// at all.
+		err = s.kvs.ForEachWithPrefix(prefix, prefix, true, func(string, []byte) error {
+			found = true
+			return embeddedkvs.Stop
+		})
+	}
+
+	switch {
+	case errors.Is(err, storage.ErrBadData):
+		return storage.ErrBadData
+	case err != nil:
+		return errors.Annotate(err, "failed to get log entries").Err()
+	case !found:
+		return storage.ErrDoesNotExist
+	default:
+		return nil
+	}
+}
+
+// Tail implements storage.Storage.
+//
+// Like the BigTable Storage, it returns the last entry of the contiguous
+// sequence of entries starting at index 0.
+func (s *Storage) Tail(c context.Context, project string, path types.StreamPath) (*storage.Entry, error) {
+	prefix := streamPrefix(project, path)
+
+	// Iterate through all log keys in the stream. Record the latest contiguous
+	// one.
+	var (
+		latest    string
+		nextIndex types.MessageIndex
+	)
+	err := s.kvs.ForEachWithPrefix(prefix, prefix, true, func(key string, _ []byte) error {
+		index, err := entryIndex(prefix, key)
+		if err != nil {
+			return err
+		}
+		if index != nextIndex {
+			return embeddedkvs.Stop
+		}
+		latest = key
+		nextIndex++
+		return nil
+	})
+	switch {
+	case errors.Is(err, storage.ErrBadData):
+		return nil, storage.ErrBadData
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to get tail log entry").Err()
+	case nextIndex == 0:
+		return nil, storage.ErrDoesNotExist
+	}
+
+	var e *storage.Entry
+	err = s.kvs.GetMulti(c, []string{latest}, func(_ string, value []byte) error {
+		e = storage.MakeEntry(append([]byte(nil), value...), nextIndex-1)
+		return nil
+	})
+	switch {
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to get tail log entry").Err()
+	case e == nil:
+		// The stream was expunged concurrently.
+		return nil, storage.ErrDoesNotExist
+	default:
+		return e, nil
+	}
+}
+
+// streamPrefix returns the key prefix shared by all entries of a stream.
+//
+// Neither project names nor stream paths may contain NUL bytes, so they are
+// used as separators.
+func streamPrefix(project string, path types.StreamPath) string {
+	return project + "\x00" + string(path) + "\x00"
+}
+
+// entryKey returns the key of the entry at index in the stream with prefix.
+func entryKey(prefix string, index types.MessageIndex) string {
+	return string(binary.BigEndian.AppendUint64([]byte(prefix), uint64(index)))
+}
+
+// entryIndex decodes the index from an entry key.
+func entryIndex(prefix, key string) (types.MessageIndex, error) {
+	if len(key) != len(prefix)+8 {
+		return 0, storage.ErrBadData
+	}
+	return types.MessageIndex(binary.BigEndian.Uint64([]byte(key[len(prefix):]))), nil
+}
@@ -0,0 +1,233 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func mustGetIndex(e *storage.Entry) types.MessageIndex {
+	idx, err := e.GetStreamIndex()
+	if err != nil {
+		panic(err)
+	}
+	return idx
+}
+
+func TestStorage(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A local storage instance`, t, func() {
+		c := context.Background()
+
+		s, err := Open(c, t.TempDir())
+		So(err, ShouldBeNil)
+		defer s.Close()
+
+		project := "test-project"
+		get := func(path string, index int, limit int, keysOnly bool) ([]string, error) {
+			req := storage.GetRequest{
+				Project:  project,
+				Path:     types.StreamPath(path),
+				Index:    types.MessageIndex(index),
+				Limit:    limit,
+				KeysOnly: keysOnly,
+			}
+			var got []string
+			err := s.Get(c, req, func(e *storage.Entry) bool {
+				if keysOnly {
+					got = append(got, strconv.Itoa(int(mustGetIndex(e))))
+				} else {
+					got = append(got, string(e.D))
+				}
+				return true
+			})
+			return got, err
+		}
+
+		put := func(path string, index int, d ...string) error {
+			data := make([][]byte, len(d))
+			for i, v := range d {
+				data[i] = []byte(v)
+			}
+
+			return s.Put(c, storage.PutRequest{
+				Project: project,
+				Path:    types.StreamPath(path),
+				Index:   types.MessageIndex(index),
+				Values:  data,
+			})
+		}
+
+		tail := func(path string) (string, error) {
+			e, err := s.Tail(c, project, types.StreamPath(path))
+			if err != nil {
+				return "", err
+			}
+			return string(e.D) + "@" + strconv.Itoa(int(mustGetIndex(e))), nil
+		}
+
+		Convey(`With data: A{0, 1, 2, 3, 4}, B{10, 12, 13}, C{0, 1, 2, 4}`, func() {
+			So(put("A", 0, "0", "1", "2"), ShouldBeNil)
+			So(put("A", 3, "3", "4"), ShouldBeNil)
+			So(put("B", 10, "10"), ShouldBeNil)
+			So(put("B", 12, "12", "13"), ShouldBeNil)
+			So(put("C", 0, "0", "1", "2"), ShouldBeNil)
+			So(put("C", 4, "4"), ShouldBeNil)
+
+			Convey(`Testing "Put"...`, func() {
+				Convey(`Refuses to overwrite existing entries.`, func() {
+					So(put("A", 4, "4", "5"), ShouldEqual, storage.ErrExists)
+
+					Convey(`Without writing any of them.`, func() {
+						got, err := get("A", 5, 0, false)
+						So(err, ShouldBeNil)
+						So(got, ShouldBeEmpty)
+					})
+				})
+
+				Convey(`Keeps streams with a common path prefix apart.`, func() {
+					So(put("A/B", 0, "AB0"), ShouldBeNil)
+
+					got, err := get("A", 0, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"0", "1", "2", "3", "4"})
+				})
+			})
+
+			Convey(`Testing "Get"...`, func() {
+				Convey(`Can fetch the full stream, "A".`, func() {
+					got, err := get("A", 0, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"0", "1", "2", "3", "4"})
+				})
+
+				Convey(`Will fetch A{1, 2} with index=1 and limit=2.`, func() {
+					got, err := get("A", 1, 2, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"1", "2"})
+				})
+
+				Convey(`Will fetch B{12, 13} when index=11.`, func() {
+					got, err := get("B", 11, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"12", "13"})
+				})
+
+				Convey(`Will fetch {} for A when index=5.`, func() {
+					got, err := get("A", 5, 0, false)
+					So(err, ShouldBeNil)
+					So(got, ShouldBeEmpty)
+				})
+
+				Convey(`Will fetch B{10, 12, 13} keys only.`, func() {
+					got, err := get("B", 0, 0, true)
+					So(err, ShouldBeNil)
+					So(got, ShouldResemble, []string{"10", "12", "13"})
+				})
+
+				Convey(`Will stop when the callback returns false.`, func() {
+					var got []string
+					So(s.Get(c, storage.GetRequest{Project: project, Path: "A"}, func(e *storage.Entry) bool {
+						got = append(got, string(e.D))
+						return len(got) < 2
+					}), ShouldBeNil)
+					So(got, ShouldResemble, []string{"0", "1"})
+				})
+
+				Convey(`Will return ErrDoesNotExist for INVALID.`, func() {
+					_, err := get("INVALID", 0, 0, false)
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+
+			Convey(`Testing "Tail"...`, func() {
+				Convey(`A tail request for "A" returns A{4}.`, func() {
+					got, err := tail("A")
+					So(err, ShouldBeNil)
+					So(got, ShouldEqual, "4@4")
+				})
+
+				Convey(`A tail request for "B" returns nothing (no contiguous logs).`, func() {
+					_, err := tail("B")
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+
+				Convey(`A tail request for "C" returns 2.`, func() {
+					got, err := tail("C")
+					So(err, ShouldBeNil)
+					So(got, ShouldEqual, "2@2")
+
+					Convey(`After "3" is added, returns 4.`, func() {
+						So(put("C", 3, "3"), ShouldBeNil)
+
+						got, err := tail("C")
+						So(err, ShouldBeNil)
+						So(got, ShouldEqual, "4@4")
+					})
+				})
+
+				Convey(`A tail request for "INVALID" errors NOT FOUND.`, func() {
+					_, err := tail("INVALID")
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+
+			Convey(`Testing "Expunge"...`, func() {
+				So(s.Expunge(c, storage.ExpungeRequest{Project: project, Path: "A"}), ShouldBeNil)
+
+				_, err := get("A", 0, 0, false)
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+
+				got, err := get("C", 0, 0, false)
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"0", "1", "2", "4"})
+			})
+		})
+	})
+}
+
+func TestStorageDurability(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Entries survive reopening the database`, t, func() {
+		c := context.Background()
+		dir := t.TempDir()
+
+		s, err := Open(c, dir)
+		So(err, ShouldBeNil)
+		So(s.Put(c, storage.PutRequest{
+			Project: "proj",
+			Path:    "a/+/b",
+			Values:  [][]byte{[]byte("0"), []byte("1")},
+		}), ShouldBeNil)
+		s.Close()
+
+		s, err = Open(c, dir)
+		So(err, ShouldBeNil)
+		defer s.Close()
+
+		e, err := s.Tail(c, "proj", "a/+/b")
+		So(err, ShouldBeNil)
+		So(string(e.D), ShouldEqual, "1")
+	})
+}
@@ -25,6 +25,9 @@ const (
 	ContentTypeText ContentType = "text/plain; charset=utf-8"
 	// ContentTypeBinary is a stream content type for binary streams.
 	ContentTypeBinary = "application/octet-stream"
+	// ContentTypeJSONLines is a stream content type for text streams of
+	// structured logs, where each line is a JSON object.
+	ContentTypeJSONLines = "application/jsonl"
 
 	// ContentTypeLogdogDatagram is a content type for size-prefixed datagram
 	// frame stream.
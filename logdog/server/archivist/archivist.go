@@ -141,6 +141,11 @@ type Settings struct {
 	// entries. See archive.Manifest for more information.
 	IndexByteRange int
 
+	// CompressionBlockSize, if >0, enables compression of archived log streams
+	// in independently-seekable blocks of this many bytes. See archive.Manifest
+	// for more information.
+	CompressionBlockSize int
+
 	// CloudLoggingProjectID is the ID of the Google Cloud Platform project to export
 	// logs to.
 	//
@@ -660,6 +665,8 @@ func (sa *stagedArchival) stage() (err error) {
 		PrefixIndexRange: sa.IndexPrefixRange,
 		ByteRange:        sa.IndexByteRange,
 
+		CompressionBlockSize: sa.CompressionBlockSize,
+
 		Logger: logging.Get(sa.ctx),
 	}
 
@@ -82,6 +82,12 @@ type CommandLineFlags struct {
 	// Default is 0.
 	ArchiveIndexByteRange int
 
+	// ArchiveCompressionBlockSize, if not zero, enables compression of archived
+	// log streams in independently-seekable blocks of this many bytes.
+	//
+	// Default is 0.
+	ArchiveCompressionBlockSize int
+
 	// CloudLoggingExportBufferLimit, is the maximum number of megabytes that
 	// the CloudLogger will keep in memory per concurrent-task before flushing
 	// them out.
@@ -117,6 +123,8 @@ func (f *CommandLineFlags) Register(fs *flag.FlagSet) {
 		"The maximum number of prefix indexes between index entries.")
 	fs.IntVar(&f.ArchiveIndexByteRange, "archive-index-byte-range", f.ArchiveIndexByteRange,
 		"The maximum number of log data bytes between index entries.")
+	fs.IntVar(&f.ArchiveCompressionBlockSize, "archive-compression-block-size", f.ArchiveCompressionBlockSize,
+		"If not zero, compress archived log streams in seekable blocks of this many bytes.")
 	fs.IntVar(&f.CloudLoggingExportBufferLimit, "cloud-logging-export-buffer-limit", f.CloudLoggingExportBufferLimit,
 		"Maximum number of bytes that the Cloud Logger will keep in memory before flushing out.")
 }
@@ -129,5 +137,8 @@ func (f *CommandLineFlags) Validate() error {
 	if f.CloudLoggingExportBufferLimit == 0 {
 		return errors.New("-cloud-logging-export-buffer-limit must be > 0")
 	}
+	if f.ArchiveCompressionBlockSize < 0 {
+		return errors.New("-archive-compression-block-size must be >= 0")
+	}
 	return nil
 }
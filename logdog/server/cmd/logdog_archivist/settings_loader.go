@@ -75,6 +75,8 @@ func GetSettingsLoader(serviceID string, flags *CommandLineFlags) archivist.Sett
 			IndexPrefixRange: indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.PrefixRange }),
 			IndexByteRange:   indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.ByteRange }),
 
+			CompressionBlockSize: flags.ArchiveCompressionBlockSize,
+
 			CloudLoggingProjectID:   pcfg.CloudLoggingConfig.GetDestination(),
 			CloudLoggingBufferLimit: flags.CloudLoggingExportBufferLimit,
 		}, nil
@@ -0,0 +1,81 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Binary logdog_devserver runs the LogDog services that use the intermediate
+// storage in a single process, keeping the storage in a local database.
+//
+// It collects logs from a Pub/Sub subscription, like the Collector, and serves
+// them via the Logs pRPC service, like the Coordinator. Streams are not
+// archived, they stay in the local database.
+package main
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server"
+
+	logspb "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/appengine/coordinator/flex"
+	"go.chromium.org/luci/logdog/appengine/coordinator/flex/logs"
+	"go.chromium.org/luci/logdog/server/collector"
+	"go.chromium.org/luci/logdog/server/collector/coordinator"
+	"go.chromium.org/luci/logdog/server/service"
+)
+
+func main() {
+	var pubSubProject, pubSubSubscription string
+	flag.StringVar(&pubSubProject, "pubsub-project", "",
+		"Cloud Project that hosts the PubSub subscription.")
+	flag.StringVar(&pubSubSubscription, "pubsub-subscription", "",
+		"PubSub subscription within the project.")
+
+	cfg := service.MainCfg{LocalStorage: true}
+	service.Main(cfg, func(srv *server.Server, impl *service.Implementations) error {
+		if pubSubProject == "" {
+			return errors.New("-pubsub-project is required")
+		}
+		if pubSubSubscription == "" {
+			return errors.New("-pubsub-subscription is required")
+		}
+
+		// Collect the logs into the local storage.
+		coll := &collector.Collector{
+			Coordinator: coordinator.NewCache(coordinator.NewCoordinator(impl.Coordinator), 0, 0),
+			Storage:     impl.Storage,
+		}
+		srv.RegisterCleanup(func(context.Context) { coll.Close() })
+
+		psClient, err := collector.PubSubClient(srv.Context, pubSubProject)
+		if err != nil {
+			return err
+		}
+		psSub := psClient.Subscription(pubSubSubscription)
+		psSub.ReceiveSettings = pubsub.ReceiveSettings{
+			MaxExtension: 24 * time.Hour,
+		}
+		srv.RunInBackground("collector", func(ctx context.Context) {
+			collector.RunSubscription(ctx, coll, psSub)
+		})
+
+		// Serve the logs from the local storage.
+		srv.Context = flex.WithServices(srv.Context, flex.NewGlobalServicesWithStorage(impl.Storage))
+		logspb.RegisterLogsServer(srv, logs.New())
+		return nil
+	})
+}
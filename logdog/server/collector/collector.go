@@ -441,6 +441,7 @@ func (c *Collector) processLogStream(ctx context.Context, h *bundleEntryHandler)
 					ID:            state.ID,
 					Secret:        state.Secret,
 					TerminalIndex: types.MessageIndex(h.be.TerminalIndex),
+					Tags:          h.be.Desc.Tags,
 				}
 
 				log.Fields{
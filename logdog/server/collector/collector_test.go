@@ -108,6 +108,24 @@ func testCollectorImpl(t *testing.T, caching bool) {
 			So(terminateCalled, ShouldBeTrue)
 		})
 
+		Convey(`Will send the tags of the terminal entry when terminating a stream.`, func() {
+			var tags map[string]string
+			tcc.terminateCallback = func(tr cc.TerminateRequest) error {
+				tags = tr.Tags
+				return nil
+			}
+
+			// Register independently from terminate so we don't bundle RPC.
+			bb.addStreamEntries("foo/+/bar", -1, 0, 1)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+
+			be := bb.genBundleEntry("foo/+/bar", 2, 2)
+			be.Desc.Tags = map[string]string{"logdog.truncated": "true"}
+			bb.addBundleEntry(be)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+			So(tags, ShouldResemble, map[string]string{"logdog.truncated": "true"})
+		})
+
 		Convey(`Will return a transient error if a transient error happened while terminating.`, func() {
 			tcc.terminateCallback = func(cc.TerminateRequest) error { return errors.New("test error", transient.Tag) }
 
@@ -79,6 +79,11 @@ type TerminateRequest struct {
 	TerminalIndex types.MessageIndex
 	// Secret is the log stream's prefix secret.
 	Secret types.PrefixSecret
+	// Tags are the tags of the stream's descriptor in the terminal bundle entry.
+	//
+	// The Coordinator adds them to the registered descriptor, since they may
+	// have been set after the stream was registered.
+	Tags map[string]string
 }
 
 type coordinatorImpl struct {
@@ -148,6 +153,7 @@ func (c *coordinatorImpl) TerminateStream(ctx context.Context, r *TerminateReque
 		Id:            r.ID,
 		Secret:        []byte(r.Secret),
 		TerminalIndex: int64(r.TerminalIndex),
+		Tags:          r.Tags,
 	}
 
 	if _, err := c.c.TerminateStream(ctx, &req); err != nil {
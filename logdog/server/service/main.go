@@ -25,6 +25,7 @@ import (
 	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/services/v1"
 	"go.chromium.org/luci/logdog/common/storage"
 	"go.chromium.org/luci/logdog/common/storage/bigtable"
+	"go.chromium.org/luci/logdog/common/storage/local"
 	"go.chromium.org/luci/logdog/server/config"
 )
 
@@ -43,6 +44,13 @@ type MainCfg struct {
 	//
 	// If empty, the default application profile will be used.
 	BigTableAppProfile string
+
+	// LocalStorage, if true, makes the service keep the intermediate storage in
+	// a local database (see -local-storage-dir) instead of BigTable.
+	//
+	// Only one process can open the database, so this is meant for processes
+	// running all LogDog services that use the intermediate storage.
+	LocalStorage bool
 }
 
 // Main initializes and runs a logdog microservice process.
@@ -57,13 +65,22 @@ func Main(cfg MainCfg, init func(srv *server.Server, impl *Implementations) erro
 	storageFlags := bigtable.Flags{
 		AppProfile: cfg.BigTableAppProfile,
 	}
-	storageFlags.Register(flag.CommandLine)
+	localFlags := local.Flags{}
+	if cfg.LocalStorage {
+		localFlags.Register(flag.CommandLine)
+	} else {
+		storageFlags.Register(flag.CommandLine)
+	}
 
 	server.Main(nil, modules, func(srv *server.Server) error {
 		if err := coordFlags.validate(); err != nil {
 			return err
 		}
-		if err := storageFlags.Validate(); err != nil {
+		if cfg.LocalStorage {
+			if err := localFlags.Validate(); err != nil {
+				return err
+			}
+		} else if err := storageFlags.Validate(); err != nil {
 			return err
 		}
 
@@ -71,7 +88,13 @@ func Main(cfg MainCfg, init func(srv *server.Server, impl *Implementations) erro
 		srv.Context = config.WithStore(srv.Context, &config.Store{})
 
 		// Initialize our Storage.
-		st, err := bigtable.StorageFromFlags(srv.Context, &storageFlags)
+		var st storage.Storage
+		var err error
+		if cfg.LocalStorage {
+			st, err = local.StorageFromFlags(srv.Context, &localFlags)
+		} else {
+			st, err = bigtable.StorageFromFlags(srv.Context, &storageFlags)
+		}
 		if err != nil {
 			return err
 		}
@@ -49,7 +49,10 @@ func application(p Params) *cli.Application {
 		Commands: []*subcommands.Command{
 			cmdRPC(p),
 			cmdQuery(p),
+			cmdDiff(p),
+			cmdHistory(p),
 			cmdStream(p),
+			cmdFlush(p),
 
 			{}, // a separator
 			authcli.SubcommandLogin(p.Auth, "auth-login", false),
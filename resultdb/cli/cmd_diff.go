@@ -0,0 +1,452 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/maruel/subcommands"
+	"github.com/pmezard/go-difflib/difflib"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"go.chromium.org/luci/auth"
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/data/text"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// maxDiffedArtifactSize is the maximum size of the artifacts diffed by
+// rdb diff -artifacts.
+const maxDiffedArtifactSize = 1024 * 1024
+
+func cmdDiff(p Params) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: `diff [flags] INVOCATION_ID_A INVOCATION_ID_B`,
+		ShortDesc: "compare the test variants of two invocations",
+		LongDesc: text.Doc(`
+			Compare the test variants of two invocations, e.g. the invocations of a
+			build with and without a CL.
+
+			Prints the test variants which are newly failing, newly passing or newly
+			flaky in INVOCATION_ID_B compared to INVOCATION_ID_A, and the test
+			variants which were added or removed. A test variant is failing if all
+			its results are unexpected, and passing if all its results are expected
+			or it is exonerated.
+		`),
+		CommandRun: func() subcommands.CommandRun {
+			r := &diffRun{}
+			r.RegisterGlobalFlags(p)
+			r.RegisterJSONFlag(text.Doc(`
+				Print the changed test variants in JSON format separated by newline.
+				One test variant takes exactly one line. Object properties are
+				change, testId, variantHash, variant, before, after and artifactDiffs.
+			`))
+			r.Flags.BoolVar(&r.artifacts, "artifacts", false, text.Doc(`
+				Also print the test variants failing in both invocations, with the
+				diffs of the text artifacts with the same ID of their first
+				unexpected results.
+			`))
+			return r
+		},
+	}
+}
+
+type diffRun struct {
+	baseCommandRun
+
+	artifacts bool
+	invIDs    [2]string
+}
+
+// testVariantChange is a change of a test variant between two invocations.
+type testVariantChange string
+
+const (
+	changeNewlyFailing testVariantChange = "NEWLY_FAILING"
+	changeNewlyPassing testVariantChange = "NEWLY_PASSING"
+	changeNewlyFlaky   testVariantChange = "NEWLY_FLAKY"
+	changeAdded        testVariantChange = "ADDED"
+	changeRemoved      testVariantChange = "REMOVED"
+	// The test variants failing in both invocations are only reported with
+	// -artifacts, to print their artifact diffs.
+	changeStillFailing testVariantChange = "STILL_FAILING"
+)
+
+// testVariantChanges is the order of the changes in the output.
+var testVariantChanges = []testVariantChange{changeNewlyFailing, changeNewlyFlaky, changeNewlyPassing, changeAdded, changeRemoved, changeStillFailing}
+
+// testVariantDiff is a test variant which changed between two invocations.
+type testVariantDiff struct {
+	Change      testVariantChange `json:"change"`
+	TestID      string            `json:"testId"`
+	VariantHash string            `json:"variantHash"`
+	Variant     map[string]string `json:"variant,omitempty"`
+	Before      string            `json:"before,omitempty"`
+	After       string            `json:"after,omitempty"`
+	// ArtifactDiffs are the unified diffs of the text artifacts, by artifact ID.
+	ArtifactDiffs map[string]string `json:"artifactDiffs,omitempty"`
+
+	// a and b are the test variant in the first and second invocations.
+	a, b *pb.TestVariant
+}
+
+func (r *diffRun) parseArgs(args []string) error {
+	if len(args) != 2 {
+		return errors.Reason("expected 2 invocation ids, got %d", len(args)).Err()
+	}
+	for i, id := range args {
+		if err := pbutil.ValidateInvocationID(id); err != nil {
+			return errors.Annotate(err, "invocation id %q", id).Err()
+		}
+		r.invIDs[i] = id
+	}
+	return nil
+}
+
+func (r *diffRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, r, env)
+
+	if err := r.parseArgs(args); err != nil {
+		return r.done(err)
+	}
+
+	if err := r.initClients(ctx, auth.SilentLogin); err != nil {
+		return r.done(err)
+	}
+
+	return r.done(r.diffAndPrint(ctx))
+}
+
+// diffAndPrint compares the invocations and prints the changed test variants.
+func (r *diffRun) diffAndPrint(ctx context.Context) error {
+	var tvs [2]map[string]*pb.TestVariant
+	eg, ectx := errgroup.WithContext(ctx)
+	for i, id := range r.invIDs {
+		i, id := i, id
+		eg.Go(func() (err error) {
+			tvs[i], err = r.fetchTestVariants(ectx, id)
+			return errors.Annotate(err, "invocation %q", id).Err()
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	diffs := diffTestVariants(tvs[0], tvs[1], r.artifacts)
+	if r.artifacts {
+		if err := r.diffArtifacts(ctx, diffs); err != nil {
+			return err
+		}
+	}
+
+	if r.json {
+		enc := json.NewEncoder(os.Stdout)
+		for _, d := range diffs {
+			if err := enc.Encode(d); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return printDiffTable(os.Stdout, diffs)
+}
+
+// fetchTestVariants fetches the test variants of an invocation, keyed by test
+// ID and variant hash.
+func (r *diffRun) fetchTestVariants(ctx context.Context, invID string) (map[string]*pb.TestVariant, error) {
+	req := &pb.QueryTestVariantsRequest{
+		Invocations: []string{pbutil.InvocationName(invID)},
+		PageSize:    10000,
+		ReadMask: &fieldmaskpb.FieldMask{Paths: []string{
+			"variant",
+			"results.*.result.name",
+			"results.*.result.expected",
+		}},
+	}
+	ret := map[string]*pb.TestVariant{}
+	for {
+		res, err := r.resultdb.QueryTestVariants(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, tv := range res.TestVariants {
+			ret[testVariantKey(tv)] = tv
+		}
+		if res.NextPageToken == "" {
+			return ret, nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
+
+func testVariantKey(tv *pb.TestVariant) string {
+	return tv.TestId + "\n" + tv.VariantHash
+}
+
+// testVariantOutcome simplifies a test variant status to "failing", "flaky"
+// or "passing".
+func testVariantOutcome(s pb.TestVariantStatus) string {
+	switch s {
+	case pb.TestVariantStatus_UNEXPECTED, pb.TestVariantStatus_UNEXPECTEDLY_SKIPPED:
+		return "failing"
+	case pb.TestVariantStatus_FLAKY:
+		return "flaky"
+	default:
+		return "passing"
+	}
+}
+
+// diffTestVariants returns the test variants which changed from a to b,
+// ordered by change, test ID and variant hash. If stillFailing is true, the
+// test variants failing in both a and b are returned too.
+func diffTestVariants(a, b map[string]*pb.TestVariant, stillFailing bool) []*testVariantDiff {
+	var ret []*testVariantDiff
+	add := func(change testVariantChange, tvA, tvB *pb.TestVariant) {
+		d := &testVariantDiff{Change: change, a: tvA, b: tvB}
+		tv := tvB
+		if tv == nil {
+			tv = tvA
+		}
+		d.TestID = tv.TestId
+		d.VariantHash = tv.VariantHash
+		d.Variant = tv.Variant.GetDef()
+		if tvA != nil {
+			d.Before = tvA.Status.String()
+		}
+		if tvB != nil {
+			d.After = tvB.Status.String()
+		}
+		ret = append(ret, d)
+	}
+
+	for key, tvB := range b {
+		tvA, ok := a[key]
+		if !ok {
+			add(changeAdded, nil, tvB)
+			continue
+		}
+		before, after := testVariantOutcome(tvA.Status), testVariantOutcome(tvB.Status)
+		switch {
+		case before == "failing" && after == "failing":
+			if stillFailing {
+				add(changeStillFailing, tvA, tvB)
+			}
+		case before == after:
+		case after == "failing":
+			add(changeNewlyFailing, tvA, tvB)
+		case after == "flaky":
+			add(changeNewlyFlaky, tvA, tvB)
+		default:
+			add(changeNewlyPassing, tvA, tvB)
+		}
+	}
+	for key, tvA := range a {
+		if _, ok := b[key]; !ok {
+			add(changeRemoved, tvA, nil)
+		}
+	}
+
+	order := make(map[testVariantChange]int, len(testVariantChanges))
+	for i, c := range testVariantChanges {
+		order[c] = i
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		switch {
+		case ret[i].Change != ret[j].Change:
+			return order[ret[i].Change] < order[ret[j].Change]
+		case ret[i].TestID != ret[j].TestID:
+			return ret[i].TestID < ret[j].TestID
+		default:
+			return ret[i].VariantHash < ret[j].VariantHash
+		}
+	})
+	return ret
+}
+
+// diffArtifacts sets the artifact diffs of the test variants failing in both
+// invocations.
+func (r *diffRun) diffArtifacts(ctx context.Context, diffs []*testVariantDiff) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(8)
+	for _, d := range diffs {
+		d := d
+		if d.Change != changeStillFailing {
+			continue
+		}
+		eg.Go(func() error {
+			return errors.Annotate(r.diffTestVariantArtifacts(ctx, d), "test %q", d.TestID).Err()
+		})
+	}
+	return eg.Wait()
+}
+
+// diffTestVariantArtifacts diffs the text artifacts of the first unexpected
+// results of a test variant.
+func (r *diffRun) diffTestVariantArtifacts(ctx context.Context, d *testVariantDiff) error {
+	var arts [2]map[string]*pb.Artifact
+	for i, tv := range []*pb.TestVariant{d.a, d.b} {
+		result := firstUnexpectedResult(tv)
+		if result == "" {
+			return nil
+		}
+		var err error
+		if arts[i], err = r.listTextArtifacts(ctx, result); err != nil {
+			return err
+		}
+	}
+
+	for id, artA := range arts[0] {
+		artB, ok := arts[1][id]
+		if !ok {
+			continue
+		}
+		before, err := r.fetchArtifact(ctx, artA)
+		if err != nil {
+			return err
+		}
+		after, err := r.fetchArtifact(ctx, artB)
+		if err != nil {
+			return err
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(before),
+			B:        difflib.SplitLines(after),
+			FromFile: r.invIDs[0],
+			ToFile:   r.invIDs[1],
+			Context:  3,
+			Eol:      "\n",
+		})
+		if err != nil {
+			return err
+		}
+		if diff != "" {
+			if d.ArtifactDiffs == nil {
+				d.ArtifactDiffs = map[string]string{}
+			}
+			d.ArtifactDiffs[id] = diff
+		}
+	}
+	return nil
+}
+
+// firstUnexpectedResult returns the name of the first unexpected result of a
+// test variant, or "" if none.
+func firstUnexpectedResult(tv *pb.TestVariant) string {
+	for _, r := range tv.Results {
+		if !r.GetResult().GetExpected() {
+			return r.GetResult().GetName()
+		}
+	}
+	return ""
+}
+
+// listTextArtifacts returns the text artifacts of a test result, which are
+// small enough to be diffed, keyed by artifact ID.
+func (r *diffRun) listTextArtifacts(ctx context.Context, result string) (map[string]*pb.Artifact, error) {
+	req := &pb.ListArtifactsRequest{Parent: result, PageSize: 1000}
+	ret := map[string]*pb.Artifact{}
+	for {
+		res, err := r.resultdb.ListArtifacts(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range res.Artifacts {
+			if strings.HasPrefix(a.ContentType, "text/") && a.SizeBytes <= maxDiffedArtifactSize {
+				ret[a.ArtifactId] = a
+			}
+		}
+		if res.NextPageToken == "" {
+			return ret, nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
+
+// fetchArtifact fetches the contents of an artifact.
+func (r *diffRun) fetchArtifact(ctx context.Context, a *pb.Artifact) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.FetchUrl, nil)
+	if err != nil {
+		return "", err
+	}
+	res, err := r.http.Do(req)
+	if err != nil {
+		return "", errors.Annotate(err, "fetching %q", a.Name).Err()
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", errors.Reason("fetching %q: HTTP %d", a.Name, res.StatusCode).Err()
+	}
+	contents, err := io.ReadAll(io.LimitReader(res.Body, maxDiffedArtifactSize))
+	if err != nil {
+		return "", errors.Annotate(err, "fetching %q", a.Name).Err()
+	}
+	return string(contents), nil
+}
+
+// printDiffTable prints the changed test variants as a table, followed by the
+// artifact diffs.
+func printDiffTable(w io.Writer, diffs []*testVariantDiff) error {
+	if len(diffs) == 0 {
+		_, err := fmt.Fprintln(w, "no changes")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHANGE\tTEST ID\tVARIANT\tBEFORE\tAFTER")
+	for _, d := range diffs {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", d.Change, d.TestID, variantString(d.Variant), orDash(d.Before), orDash(d.After))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, d := range diffs {
+		ids := make([]string, 0, len(d.ArtifactDiffs))
+		for id := range d.ArtifactDiffs {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			if _, err := fmt.Fprintf(w, "\n%s %s, artifact %q:\n%s", d.TestID, variantString(d.Variant), id, d.ArtifactDiffs[id]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// variantString formats a variant definition as "{k1:v1, k2:v2}".
+func variantString(def map[string]string) string {
+	return "{" + strings.Join(pbutil.VariantToStrings(&pb.Variant{Def: def}), ", ") + "}"
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
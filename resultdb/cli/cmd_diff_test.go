@@ -0,0 +1,174 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"testing"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTestVariantOutcome(t *testing.T) {
+	t.Parallel()
+
+	Convey(`testVariantOutcome`, t, func() {
+		cases := []struct {
+			status pb.TestVariantStatus
+			want   string
+		}{
+			{pb.TestVariantStatus_TEST_VARIANT_STATUS_UNSPECIFIED, "passing"},
+			{pb.TestVariantStatus_UNEXPECTED, "failing"},
+			{pb.TestVariantStatus_UNEXPECTEDLY_SKIPPED, "failing"},
+			{pb.TestVariantStatus_FLAKY, "flaky"},
+			{pb.TestVariantStatus_EXONERATED, "passing"},
+			{pb.TestVariantStatus_EXPECTED, "passing"},
+		}
+		for _, c := range cases {
+			So(testVariantOutcome(c.status), ShouldEqual, c.want)
+		}
+	})
+}
+
+func TestDiffTestVariants(t *testing.T) {
+	t.Parallel()
+
+	Convey(`diffTestVariants`, t, func() {
+		const (
+			unexpected = pb.TestVariantStatus_UNEXPECTED
+			skipped    = pb.TestVariantStatus_UNEXPECTEDLY_SKIPPED
+			flaky      = pb.TestVariantStatus_FLAKY
+			exonerated = pb.TestVariantStatus_EXONERATED
+			expected   = pb.TestVariantStatus_EXPECTED
+		)
+
+		v := pbutil.Variant("k", "v")
+		tv := func(testID string, status pb.TestVariantStatus) *pb.TestVariant {
+			return &pb.TestVariant{
+				TestId:      testID,
+				Variant:     v,
+				VariantHash: pbutil.VariantHash(v),
+				Status:      status,
+			}
+		}
+		tvs := func(tvs ...*pb.TestVariant) map[string]*pb.TestVariant {
+			m := make(map[string]*pb.TestVariant, len(tvs))
+			for _, tv := range tvs {
+				m[testVariantKey(tv)] = tv
+			}
+			return m
+		}
+
+		Convey(`Classifies a single test variant`, func() {
+			// A zero status means that the test variant is missing.
+			cases := []struct {
+				before, after pb.TestVariantStatus
+				stillFailing  bool
+				want          testVariantChange
+			}{
+				{0, expected, false, changeAdded},
+				{0, unexpected, false, changeAdded},
+				{expected, 0, false, changeRemoved},
+				{unexpected, 0, false, changeRemoved},
+
+				{expected, unexpected, false, changeNewlyFailing},
+				{exonerated, skipped, false, changeNewlyFailing},
+				{flaky, unexpected, false, changeNewlyFailing},
+				{expected, flaky, false, changeNewlyFlaky},
+				{unexpected, flaky, false, changeNewlyFlaky},
+				{unexpected, expected, false, changeNewlyPassing},
+				{skipped, exonerated, false, changeNewlyPassing},
+				{flaky, expected, false, changeNewlyPassing},
+
+				{expected, expected, false, ""},
+				{expected, exonerated, false, ""},
+				{flaky, flaky, false, ""},
+				{unexpected, unexpected, false, ""},
+				{unexpected, skipped, false, ""},
+				{unexpected, unexpected, true, changeStillFailing},
+				{skipped, unexpected, true, changeStillFailing},
+				{expected, expected, true, ""},
+			}
+			for _, c := range cases {
+				a, b := tvs(), tvs()
+				if c.before != 0 {
+					a = tvs(tv("t", c.before))
+				}
+				if c.after != 0 {
+					b = tvs(tv("t", c.after))
+				}
+
+				diffs := diffTestVariants(a, b, c.stillFailing)
+				if c.want == "" {
+					So(diffs, ShouldBeEmpty)
+					continue
+				}
+				So(diffs, ShouldHaveLength, 1)
+				d := diffs[0]
+				So(d.Change, ShouldEqual, c.want)
+				So(d.TestID, ShouldEqual, "t")
+				So(d.VariantHash, ShouldEqual, pbutil.VariantHash(v))
+				So(d.Variant, ShouldResemble, v.Def)
+
+				wantBefore, wantAfter := "", ""
+				if c.before != 0 {
+					wantBefore = c.before.String()
+				}
+				if c.after != 0 {
+					wantAfter = c.after.String()
+				}
+				So(d.Before, ShouldEqual, wantBefore)
+				So(d.After, ShouldEqual, wantAfter)
+			}
+		})
+
+		Convey(`Orders by change, then by test ID`, func() {
+			a := tvs(
+				tv("removed", expected),
+				tv("b-failing", expected),
+				tv("a-failing", flaky),
+				tv("flaky", expected),
+				tv("passing", unexpected),
+				tv("still-failing", unexpected),
+				tv("unchanged", expected),
+			)
+			b := tvs(
+				tv("added", unexpected),
+				tv("b-failing", unexpected),
+				tv("a-failing", skipped),
+				tv("flaky", flaky),
+				tv("passing", expected),
+				tv("still-failing", unexpected),
+				tv("unchanged", expected),
+			)
+
+			var got []string
+			for _, d := range diffTestVariants(a, b, true) {
+				got = append(got, string(d.Change)+" "+d.TestID)
+			}
+			So(got, ShouldResemble, []string{
+				"NEWLY_FAILING a-failing",
+				"NEWLY_FAILING b-failing",
+				"NEWLY_FLAKY flaky",
+				"NEWLY_PASSING passing",
+				"ADDED added",
+				"REMOVED removed",
+				"STILL_FAILING still-failing",
+			})
+		})
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/auth"
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/data/text"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/resultdb/sink"
+)
+
+func cmdFlush(p Params) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: `flush -spool-dir DIR`,
+		ShortDesc: "Upload the results spooled by rdb stream",
+		LongDesc: text.Doc(`
+			Upload the results which "rdb stream -spool-dir" couldn't upload to
+			ResultDB and persisted in the spool directory.
+
+			The uploaded results are removed from the spool directory. The results
+			which fail to upload with a transient error are kept, and the command
+			fails. Results are deduplicated by ResultDB, so flushing is safe to
+			retry.
+		`),
+		CommandRun: func() subcommands.CommandRun {
+			r := &flushRun{}
+			r.RegisterGlobalFlags(p)
+			r.Flags.StringVar(&r.spoolDir, "spool-dir", "", text.Doc(`
+				The spool directory given to "rdb stream". Required.
+			`))
+			return r
+		},
+	}
+}
+
+type flushRun struct {
+	baseCommandRun
+	spoolDir string
+}
+
+func (r *flushRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, r, env)
+
+	if len(args) != 0 {
+		return r.done(errors.Reason("unexpected positional arguments").Err())
+	}
+	if r.spoolDir == "" {
+		return r.done(errors.Reason("-spool-dir is required").Err())
+	}
+
+	if err := r.initClients(ctx, auth.SilentLogin); err != nil {
+		return r.done(err)
+	}
+
+	return r.done(r.flush(ctx))
+}
+
+func (r *flushRun) flush(ctx context.Context) error {
+	sent, kept, err := sink.ReplaySpool(ctx, r.recorder, r.spoolDir)
+	fmt.Printf("uploaded %d spooled request(s), %d remaining\n", sent, kept)
+	switch {
+	case err != nil:
+		return err
+	case kept > 0:
+		return errors.Reason("%d spooled request(s) failed to upload; retry later", kept).Err()
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/auth"
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/data/text"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/auth/realms"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+func cmdHistory(p Params) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: `history [flags] TEST_ID`,
+		ShortDesc: "print the history of a test",
+		LongDesc: text.Doc(`
+			Print the history of a test in a realm, i.e. its results in the
+			invocations of the realm, most recent first, grouped by variant.
+
+			The history is limited by the number of results, not invocations:
+			an invocation may have several results of the test, e.g. retries.
+
+			Example:
+			  rdb history -realm chromium:ci -max-results 20 ninja://chrome/test:browser_tests/FooTest.Bar
+		`),
+		CommandRun: func() subcommands.CommandRun {
+			r := &historyRun{}
+			r.RegisterGlobalFlags(p)
+			r.RegisterJSONFlag(text.Doc(`
+				Print the history in JSON format separated by newline.
+				One variant takes exactly one line, a
+				luci.resultdb.v1.TestVariantHistory message.
+			`))
+			r.Flags.StringVar(&r.realm, "realm", "", text.Doc(`
+				Required. The realm of the invocations, e.g. "chromium:ci".
+			`))
+			r.Flags.StringVar(&r.variantHash, "variant-hash", "", text.Doc(`
+				Print only the history of the variant with this hash.
+			`))
+			r.Flags.IntVar(&r.limit, "max-results", 100, text.Doc(`
+				Print up to this many most recent results, across all variants.
+				If 0, then unlimited.
+			`))
+			return r
+		},
+	}
+}
+
+type historyRun struct {
+	baseCommandRun
+
+	realm       string
+	variantHash string
+	limit       int
+	testID      string
+}
+
+func (r *historyRun) parseArgs(args []string) error {
+	if len(args) != 1 {
+		return errors.Reason("expected 1 test id, got %d", len(args)).Err()
+	}
+	r.testID = args[0]
+	if err := pbutil.ValidateTestID(r.testID); err != nil {
+		return errors.Annotate(err, "test id %q", r.testID).Err()
+	}
+
+	if r.realm == "" {
+		return errors.Reason("-realm is required").Err()
+	}
+	if err := realms.ValidateRealmName(r.realm, realms.GlobalScope); err != nil {
+		return errors.Annotate(err, "-realm").Err()
+	}
+
+	if r.variantHash != "" {
+		if err := pbutil.ValidateVariantHash(r.variantHash); err != nil {
+			return errors.Annotate(err, "-variant-hash").Err()
+		}
+	}
+
+	if r.limit < 0 {
+		return errors.Reason("-max-results must be non-negative").Err()
+	}
+	return nil
+}
+
+func (r *historyRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, r, env)
+
+	if err := r.parseArgs(args); err != nil {
+		return r.done(err)
+	}
+
+	if err := r.initClients(ctx, auth.SilentLogin); err != nil {
+		return r.done(err)
+	}
+
+	variants, err := r.fetchHistory(ctx)
+	if err != nil {
+		return r.done(err)
+	}
+	if r.json {
+		for _, v := range variants {
+			fmt.Printf("%s\n", msgToJSON(v))
+		}
+		return 0
+	}
+	return r.done(printHistory(os.Stdout, variants))
+}
+
+// fetchHistory fetches up to r.limit results of the test and returns the
+// history of its variants, ordered by variant hash.
+func (r *historyRun) fetchHistory(ctx context.Context) ([]*pb.TestVariantHistory, error) {
+	req := &pb.QueryTestHistoryRequest{
+		TestId:      r.testID,
+		Realm:       r.realm,
+		VariantHash: r.variantHash,
+	}
+	byHash := map[string]*pb.TestVariantHistory{}
+	count := 0
+	for {
+		if r.limit > 0 {
+			req.PageSize = int32(r.limit - count)
+		}
+		res, err := r.resultdb.QueryTestHistory(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		// A variant may appear in several pages. Its results in a page are
+		// older than its results in the previous pages.
+		for _, v := range res.Variants {
+			if h, ok := byHash[v.VariantHash]; ok {
+				h.Results = append(h.Results, v.Results...)
+			} else {
+				byHash[v.VariantHash] = v
+			}
+			count += len(v.Results)
+		}
+
+		if res.NextPageToken == "" || (r.limit > 0 && count >= r.limit) {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+
+	ret := make([]*pb.TestVariantHistory, 0, len(byHash))
+	for _, v := range byHash {
+		ret = append(ret, v)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].VariantHash < ret[j].VariantHash })
+	return ret, nil
+}
+
+// printHistory prints the results of each variant, most recent first.
+func printHistory(w io.Writer, variants []*pb.TestVariantHistory) error {
+	tw := tabwriter.NewWriter(w, 0, 2, 2, ' ', 0)
+	for _, v := range variants {
+		unexpected := 0
+		for _, tr := range v.Results {
+			if !tr.Expected {
+				unexpected++
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\n", v.VariantHash, strings.Join(pbutil.VariantToStrings(v.Variant), ", "))
+		fmt.Fprintf(tw, "  %d results, %d unexpected\n", len(v.Results), unexpected)
+		for _, tr := range v.Results {
+			invID, _, _, err := pbutil.ParseTestResultName(tr.Name)
+			if err != nil {
+				return err
+			}
+			expectancy := "expected"
+			if !tr.Expected {
+				expectancy = "unexpected"
+			}
+			var start, duration string
+			if tr.StartTime != nil {
+				start = tr.StartTime.AsTime().UTC().Format(time.RFC3339)
+			}
+			if tr.Duration != nil {
+				duration = tr.Duration.AsDuration().String()
+			}
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\n", start, tr.Status, expectancy, duration, invID)
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
@@ -94,6 +94,17 @@ func cmdQuery(p Params) *subcommands.Command {
 				of a test.
 			`))
 
+			r.Flags.StringVar(&r.format, "format", "", text.Doc(`
+				Output format, one of "text", "json", "junit" and "html".
+				Defaults to "text", or "json" if -json is set.
+				"junit" prints a JUnit XML report with a testsuite per invocation
+				containing test results. The variant is in the testcase properties,
+				along with the fetch URLs of the artifacts, which expire.
+				"html" prints a self-contained HTML report.
+				The "junit" and "html" reports are printed after all the results are
+				fetched.
+			`))
+
 			r.Flags.StringVar(&r.trFields, "tr-fields", "", text.Doc(`
 				Test result fields to include in the response. Fields should be passed
 				as a comma separated string to match the JSON encoding schema of FieldMask.
@@ -113,6 +124,7 @@ type queryRun struct {
 	testID     string
 	merge      bool
 	trFields   string
+	format     string
 	invIDs     []string
 
 	// TODO(crbug.com/1021849): add flag -artifact-dir
@@ -138,6 +150,21 @@ func (r *queryRun) parseArgs(args []string) error {
 		return errors.Reason("-n must be non-negative").Err()
 	}
 
+	switch {
+	case r.format == "":
+		r.format = "text"
+		if r.json {
+			r.format = "json"
+		}
+	case r.json && r.format != "json":
+		return errors.Reason("-json cannot be used with -format %q", r.format).Err()
+	}
+	switch r.format {
+	case "text", "json", "junit", "html":
+	default:
+		return errors.Reason("invalid -format %q", r.format).Err()
+	}
+
 	// TODO(crbug.com/1021849): improve validation.
 	return nil
 }
@@ -231,8 +258,23 @@ func (r *queryRun) queryAndPrint(ctx context.Context, invIDs []string) error {
 		errC <- err
 	}()
 
-	r.printProto(resultC, r.json)
-	return <-errC
+	switch r.format {
+	case "junit", "html":
+		report := newQueryReport(r.merge)
+		for res := range resultC {
+			report.add(res)
+		}
+		if err := <-errC; err != nil {
+			return err
+		}
+		if r.format == "junit" {
+			return report.writeJUnit(os.Stdout)
+		}
+		return report.writeHTML(os.Stdout)
+	default:
+		r.printProto(resultC, r.format == "json")
+		return <-errC
+	}
 }
 
 // fetchInvocation fetches an invocation.
@@ -272,6 +314,18 @@ func (r *queryRun) fetchItems(ctx context.Context, invIDs []string, trMask *fiel
 		PageSize: int32(r.limit),
 	}
 
+	reqs := []proto.Message{trReq, teReq}
+	if r.format == "junit" || r.format == "html" {
+		// Fetch the artifacts of the test results to link them in the report.
+		reqs = append(reqs, &pb.QueryArtifactsRequest{
+			Invocations: invNames,
+			Predicate: &pb.ArtifactPredicate{
+				FollowEdges:         &pb.ArtifactPredicate_EdgeTypeSet{TestResults: true},
+				TestResultPredicate: trReq.Predicate,
+			},
+		})
+	}
+
 	// Query for results.
 	msgC := make(chan proto.Message)
 	errC := make(chan error, 1)
@@ -279,19 +333,22 @@ func (r *queryRun) fetchItems(ctx context.Context, invIDs []string, trMask *fiel
 	defer cancelQuery()
 	go func() {
 		defer close(msgC)
-		errC <- pbutil.Query(queryCtx, msgC, r.resultdb, trReq, teReq)
+		errC <- pbutil.Query(queryCtx, msgC, r.resultdb, reqs...)
 	}()
 
 	// Send findings to the destination channel.
 	count := 0
 	reachedLimit := false
 	for m := range msgC {
-		if r.limit > 0 && count > r.limit {
-			reachedLimit = true
-			cancelQuery()
-			break
+		if _, ok := m.(*pb.Artifact); !ok {
+			// Artifacts do not count as results.
+			if r.limit > 0 && count > r.limit {
+				reachedLimit = true
+				cancelQuery()
+				break
+			}
+			count++
 		}
-		count++
 
 		item := resultItemTemplate
 		item.result = m
@@ -173,6 +173,29 @@ func cmdStream(p Params) *subcommands.Command {
 				object.
 				Cannot be used in combination with -sources or -inherit-sources.
 			`))
+			r.Flags.StringVar(&r.adapter, "adapter", "", text.Doc(`
+				The format of the -result-file written by the test command, one of
+				`+strings.Join(sink.AdapterNames(), ", ")+`.
+				"junit" is a JUnit XML report, "gtest" a GoogleTest JSON report
+				(--gtest_output=json) and "gotest" the output of "go test -json".
+				The test results in the file are reported after the test command
+				exits, with the per-test logs attached as artifacts.
+			`))
+			r.Flags.StringVar(&r.resultFile, "result-file", "", text.Doc(`
+				Path to the file with the test results written by the test command.
+				Required if -adapter is set.
+			`))
+			r.Flags.StringVar(&r.spoolDir, "spool-dir", "", text.Doc(`
+				Directory to persist the results which couldn't be uploaded to
+				ResultDB in, e.g. because it's unreachable. They are uploaded by
+				the next "rdb stream" with the same -spool-dir, or by "rdb flush".
+				Cannot be used with -new, since the new invocation is finalized when
+				the test command exits.
+			`))
+			r.Flags.DurationVar(&r.spoolAfter, "spool-after", sink.DefaultSpoolAfter, text.Doc(`
+				How long to keep trying to upload the pending results after the test
+				command exits, before persisting them in -spool-dir.
+			`))
 			return r
 		},
 	}
@@ -265,6 +288,10 @@ type streamRun struct {
 	inheritSources          bool
 	sourcesFile             string
 	sources                 sources
+	adapter                 string
+	resultFile              string
+	spoolDir                string
+	spoolAfter              time.Duration
 	// TODO(ddoman): add flags
 	// - invocation-tag
 	// - log-file
@@ -300,6 +327,20 @@ func (r *streamRun) validate(ctx context.Context, args []string) (err error) {
 	if sourceSpecs > 1 {
 		return errors.Reason("cannot specify more than one of -inherit-sources, -sources and -sources-file at the same time").Err()
 	}
+	if r.adapter != "" {
+		if _, ok := sink.Adapters[r.adapter]; !ok {
+			return errors.Reason("invalid -adapter %q; must be one of %s", r.adapter, strings.Join(sink.AdapterNames(), ", ")).Err()
+		}
+		if r.resultFile == "" {
+			return errors.Reason("-result-file is required with -adapter").Err()
+		}
+	} else if r.resultFile != "" {
+		return errors.Reason("-adapter is required with -result-file").Err()
+	}
+	if r.spoolDir != "" && r.isNew {
+		// The spooled results couldn't be uploaded to the finalized invocation.
+		return errors.Reason("cannot specify both -new and -spool-dir at the same time").Err()
+	}
 	return nil
 }
 
@@ -453,6 +494,9 @@ func (r *streamRun) runTestCmd(ctx context.Context, args []string) error {
 		TestLocationBase:        r.testTestLocationBase,
 		TestIDPrefix:            r.testIDPrefix,
 		ExonerateUnexpectedPass: r.exonerateUnexpectedPass,
+
+		SpoolDir:   r.spoolDir,
+		SpoolAfter: r.spoolAfter,
 	}
 	return sink.Run(ctx, cfg, func(ctx context.Context, cfg sink.ServerConfig) error {
 		exported, err := lucictx.Export(ctx)
@@ -473,7 +517,19 @@ func (r *streamRun) runTestCmd(ctx context.Context, args []string) error {
 		if err != nil {
 			return errors.Annotate(err, "cmd.start").Err()
 		}
-		return cmd.Wait()
+		err = cmd.Wait()
+
+		// Report the test results written by the test command, even if it failed.
+		if r.adapter != "" {
+			logging.Infof(ctx, "rdb-stream: reporting the test results in %q", r.resultFile)
+			if rerr := sink.ReportResultFile(ctx, r.adapter, r.resultFile); rerr != nil {
+				logging.Errorf(ctx, "rdb-stream: failed to report the test results: %s", rerr)
+				if err == nil {
+					err = rerr
+				}
+			}
+		}
+		return err
 	})
 }
 
@@ -0,0 +1,360 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// queryReport is a report of the results of rdb query, grouping the test
+// results by the invocation queried and the invocation containing them.
+type queryReport struct {
+	// merge is true if the results of the invocations are merged, see
+	// rdb query -merge.
+	merge bool
+	roots map[string]*reportRoot
+	// artifacts are the artifacts of the test results, by test result name.
+	artifacts map[string][]*pb.Artifact
+}
+
+// reportRoot is an invocation given to rdb query, or all of them with -merge.
+type reportRoot struct {
+	// ID is the invocation ID, or "" with -merge.
+	ID         string
+	Invocation *pb.Invocation
+	Suites     []*reportSuite
+
+	suites map[string]*reportSuite
+	// exonerated is the set of exonerated test variants, keyed by test ID and
+	// variant hash.
+	exonerated map[string]bool
+}
+
+// reportSuite is an invocation containing test results, included in a root
+// invocation directly or indirectly.
+type reportSuite struct {
+	InvocationID string
+	Results      []*reportResult
+}
+
+// reportResult is a test result along with its artifacts.
+type reportResult struct {
+	*pb.TestResult
+	Exonerated bool
+	Artifacts  []*pb.Artifact
+}
+
+func newQueryReport(merge bool) *queryReport {
+	return &queryReport{
+		merge:     merge,
+		roots:     map[string]*reportRoot{},
+		artifacts: map[string][]*pb.Artifact{},
+	}
+}
+
+func (q *queryReport) root(id string) *reportRoot {
+	r, ok := q.roots[id]
+	if !ok {
+		r = &reportRoot{
+			ID:         id,
+			suites:     map[string]*reportSuite{},
+			exonerated: map[string]bool{},
+		}
+		q.roots[id] = r
+	}
+	return r
+}
+
+// add adds a result of rdb query to the report.
+func (q *queryReport) add(item resultItem) {
+	if _, ok := item.result.(*pb.Invocation); ok && q.merge {
+		// The merged results are reported under a single root.
+		return
+	}
+	root := q.root(item.invocationID)
+	switch m := item.result.(type) {
+	case *pb.Invocation:
+		root.Invocation = m
+
+	case *pb.TestResult:
+		invID, _, _, err := pbutil.ParseTestResultName(m.Name)
+		if err != nil {
+			panic(err) // the names returned by ResultDB are valid
+		}
+		s, ok := root.suites[invID]
+		if !ok {
+			s = &reportSuite{InvocationID: invID}
+			root.suites[invID] = s
+		}
+		s.Results = append(s.Results, &reportResult{TestResult: m})
+
+	case *pb.TestExoneration:
+		root.exonerated[m.TestId+"\n"+m.VariantHash] = true
+
+	case *pb.Artifact:
+		if i := strings.LastIndex(m.Name, "/artifacts/"); i >= 0 {
+			q.artifacts[m.Name[:i]] = append(q.artifacts[m.Name[:i]], m)
+		}
+
+	default:
+		panic(fmt.Sprintf("unexpected result type %T", item.result))
+	}
+}
+
+// sortedRoots finalizes the report and returns the root invocations, with
+// suites and results in a stable order.
+func (q *queryReport) sortedRoots() []*reportRoot {
+	roots := make([]*reportRoot, 0, len(q.roots))
+	for _, r := range q.roots {
+		r.Suites = r.Suites[:0]
+		for _, s := range r.suites {
+			for _, res := range s.Results {
+				res.Exonerated = r.exonerated[res.TestId+"\n"+res.VariantHash]
+				res.Artifacts = q.artifacts[res.Name]
+				sort.Slice(res.Artifacts, func(i, j int) bool {
+					return res.Artifacts[i].ArtifactId < res.Artifacts[j].ArtifactId
+				})
+			}
+			sort.Slice(s.Results, func(i, j int) bool {
+				a, b := s.Results[i], s.Results[j]
+				if a.TestId != b.TestId {
+					return a.TestId < b.TestId
+				}
+				if a.VariantHash != b.VariantHash {
+					return a.VariantHash < b.VariantHash
+				}
+				return a.ResultId < b.ResultId
+			})
+			r.Suites = append(r.Suites, s)
+		}
+		sort.Slice(r.Suites, func(i, j int) bool {
+			return r.Suites[i].InvocationID < r.Suites[j].InvocationID
+		})
+		roots = append(roots, r)
+	}
+	sort.Slice(roots, func(i, j int) bool { return roots[i].ID < roots[j].ID })
+	return roots
+}
+
+// outcome returns how a test result is reported: "pass", "fail", "error" or
+// "skip".
+func (r *reportResult) outcome() string {
+	switch {
+	case r.Status == pb.TestStatus_SKIP:
+		return "skip"
+	case r.Expected || r.Exonerated:
+		return "pass"
+	case r.Status == pb.TestStatus_CRASH || r.Status == pb.TestStatus_ABORT:
+		return "error"
+	default:
+		return "fail"
+	}
+}
+
+// message returns the failure message of a test result.
+func (r *reportResult) message() string {
+	if msg := r.FailureReason.GetPrimaryErrorMessage(); msg != "" {
+		return msg
+	}
+	if r.Expected {
+		return ""
+	}
+	return "unexpected " + r.Status.String()
+}
+
+// JUnit XML elements, as understood by the common JUnit consumers.
+type (
+	junitTestSuites struct {
+		XMLName xml.Name          `xml:"testsuites"`
+		Suites  []*junitTestSuite `xml:"testsuite"`
+	}
+
+	junitTestSuite struct {
+		Name       string           `xml:"name,attr"`
+		Tests      int              `xml:"tests,attr"`
+		Failures   int              `xml:"failures,attr"`
+		Errors     int              `xml:"errors,attr"`
+		Skipped    int              `xml:"skipped,attr"`
+		Time       float64          `xml:"time,attr"`
+		Properties []*junitProperty `xml:"properties>property,omitempty"`
+		TestCases  []*junitTestCase `xml:"testcase"`
+	}
+
+	junitTestCase struct {
+		Name       string           `xml:"name,attr"`
+		ClassName  string           `xml:"classname,attr"`
+		Time       float64          `xml:"time,attr"`
+		Properties []*junitProperty `xml:"properties>property,omitempty"`
+		Failure    *junitMessage    `xml:"failure,omitempty"`
+		Error      *junitMessage    `xml:"error,omitempty"`
+		Skipped    *junitMessage    `xml:"skipped,omitempty"`
+	}
+
+	junitProperty struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:"value,attr"`
+	}
+
+	junitMessage struct {
+		Message string `xml:"message,attr,omitempty"`
+		Type    string `xml:"type,attr,omitempty"`
+	}
+)
+
+// writeJUnit writes the report in the JUnit XML format.
+//
+// Each invocation containing test results is a testsuite. The variant, the
+// exoneration and the artifact fetch URLs of a test result are properties of
+// its testcase.
+func (q *queryReport) writeJUnit(w io.Writer) error {
+	out := &junitTestSuites{}
+	for _, root := range q.sortedRoots() {
+		for _, s := range root.Suites {
+			suite := &junitTestSuite{
+				Name: s.InvocationID,
+				Properties: []*junitProperty{
+					{Name: "resultdb.invocation", Value: pbutil.InvocationName(s.InvocationID)},
+				},
+			}
+			if root.ID != "" && root.ID != s.InvocationID {
+				suite.Properties = append(suite.Properties, &junitProperty{
+					Name:  "resultdb.included_by",
+					Value: pbutil.InvocationName(root.ID),
+				})
+			}
+			for _, res := range s.Results {
+				suite.TestCases = append(suite.TestCases, res.junitTestCase(s.InvocationID))
+				suite.Tests++
+				suite.Time += res.Duration.AsDuration().Seconds()
+				switch res.outcome() {
+				case "fail":
+					suite.Failures++
+				case "error":
+					suite.Errors++
+				case "skip":
+					suite.Skipped++
+				}
+			}
+			out.Suites = append(out.Suites, suite)
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func (r *reportResult) junitTestCase(invID string) *junitTestCase {
+	tc := &junitTestCase{
+		Name:      r.TestId,
+		ClassName: invID,
+		Time:      r.Duration.AsDuration().Seconds(),
+	}
+	for _, kv := range pbutil.VariantToStringPairs(r.Variant) {
+		tc.Properties = append(tc.Properties, &junitProperty{Name: "variant." + kv.Key, Value: kv.Value})
+	}
+	tc.Properties = append(tc.Properties, &junitProperty{Name: "resultdb.status", Value: r.Status.String()})
+	if r.Exonerated {
+		tc.Properties = append(tc.Properties, &junitProperty{Name: "resultdb.exonerated", Value: "true"})
+	}
+	for _, a := range r.Artifacts {
+		tc.Properties = append(tc.Properties, &junitProperty{Name: "artifact." + a.ArtifactId, Value: a.FetchUrl})
+	}
+
+	msg := &junitMessage{Message: r.message(), Type: r.Status.String()}
+	switch r.outcome() {
+	case "fail":
+		tc.Failure = msg
+	case "error":
+		tc.Error = msg
+	case "skip":
+		tc.Skipped = msg
+	}
+	return tc
+}
+
+// reportHTML is the template of the HTML report. It's self-contained, i.e.
+// it has no external resources, except for the artifact links.
+var reportHTML = template.Must(template.New("report").Funcs(template.FuncMap{
+	"outcome":  (*reportResult).outcome,
+	"message":  (*reportResult).message,
+	"variant":  func(v *pb.Variant) string { return strings.Join(pbutil.VariantToStrings(v), ", ") },
+	"invName":  pbutil.InvocationName,
+	"duration": func(r *reportResult) string { return r.Duration.AsDuration().String() },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>ResultDB report</title>
+<style>
+body { font-family: sans-serif; font-size: 14px; margin: 16px; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 16px; }
+th, td { border: 1px solid #ddd; padding: 4px 8px; text-align: left; vertical-align: top; }
+th { background: #f5f5f5; }
+.pass { color: #0b8043; }
+.fail, .error { color: #d23f31; font-weight: bold; }
+.skip { color: #757575; }
+pre { white-space: pre-wrap; margin: 0; }
+</style>
+</head>
+<body>
+<h1>ResultDB report</h1>
+{{range .}}
+<h2>{{if .ID}}{{invName .ID}}{{else}}Merged invocations{{end}}</h2>
+{{with .Invocation}}<p>Realm: {{.Realm}}, state: {{.State}}</p>{{end}}
+{{range .Suites}}
+<h3>{{invName .InvocationID}}</h3>
+<table>
+<tr><th>Outcome</th><th>Test ID</th><th>Variant</th><th>Status</th><th>Duration</th><th>Details</th></tr>
+{{range .Results}}{{$outcome := outcome .}}
+<tr>
+<td class="{{$outcome}}">{{$outcome}}{{if .Exonerated}} (exonerated){{end}}</td>
+<td>{{.TestId}}</td>
+<td>{{variant .Variant}}</td>
+<td>{{.Status}}{{if not .Expected}} (unexpected){{end}}</td>
+<td>{{duration .}}</td>
+<td>
+{{with message .}}<pre>{{.}}</pre>{{end}}
+{{with .SummaryHtml}}<details><summary>Summary</summary><pre>{{.}}</pre></details>{{end}}
+{{range .Artifacts}}<a href="{{.FetchUrl}}">{{.ArtifactId}}</a> {{end}}
+</td>
+</tr>
+{{end}}
+</table>
+{{end}}
+{{end}}
+</body>
+</html>
+`))
+
+// writeHTML writes the report as a self-contained HTML page.
+func (q *queryReport) writeHTML(w io.Writer) error {
+	return reportHTML.Execute(w, q.sortedRoots())
+}
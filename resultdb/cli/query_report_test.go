@@ -0,0 +1,107 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// testQueryReport returns a report of an invocation "root" including an
+// invocation "child", with test IDs, messages and summaries to be escaped.
+func testQueryReport() *queryReport {
+	q := newQueryReport(false)
+
+	result := func(invID, testID string, status pb.TestStatus, expected bool) *pb.TestResult {
+		v := pbutil.Variant("builder", `linux "rel" & <debug>`)
+		return &pb.TestResult{
+			Name:        pbutil.TestResultName(invID, testID, "r1"),
+			TestId:      testID,
+			ResultId:    "r1",
+			Variant:     v,
+			VariantHash: pbutil.VariantHash(v),
+			Expected:    expected,
+			Status:      status,
+			Duration:    durationpb.New(1500 * time.Millisecond),
+		}
+	}
+
+	failed := result("child", `suite/Test<"quoted" & 'single'>`, pb.TestStatus_FAIL, false)
+	failed.SummaryHtml = `<p>Failed: <script>alert("x")</script></p>`
+	failed.FailureReason = &pb.FailureReason{PrimaryErrorMessage: "expected 1 < 2 && \"ok\"\n\tat line 3"}
+	crashed := result("child", "suite/Crash", pb.TestStatus_CRASH, false)
+	exonerated := result("child", "suite/Exonerated", pb.TestStatus_FAIL, false)
+	skipped := result("root", "suite/Skipped", pb.TestStatus_SKIP, true)
+	passed := result("root", "suite/Passed", pb.TestStatus_PASS, true)
+
+	for _, m := range []resultItem{
+		{"root", &pb.Invocation{Name: pbutil.InvocationName("root"), Realm: "project:realm", State: pb.Invocation_FINALIZED}},
+		{"root", passed},
+		{"root", skipped},
+		{"root", failed},
+		{"root", crashed},
+		{"root", exonerated},
+		{"root", &pb.TestExoneration{TestId: exonerated.TestId, VariantHash: exonerated.VariantHash}},
+		{"root", &pb.Artifact{
+			Name:       failed.Name + "/artifacts/stdout",
+			ArtifactId: "stdout",
+			FetchUrl:   "https://results.example.com/stdout?token=a&b=<c>",
+		}},
+		{"root", &pb.Artifact{
+			Name:       failed.Name + "/artifacts/screenshot.png",
+			ArtifactId: "screenshot.png",
+			FetchUrl:   `javascript:alert("x")`,
+		}},
+	} {
+		q.add(m)
+	}
+	return q
+}
+
+func TestQueryReport(t *testing.T) {
+	t.Parallel()
+
+	Convey(`queryReport`, t, func() {
+		q := testQueryReport()
+		var buf bytes.Buffer
+
+		Convey(`writeJUnit`, func() {
+			So(q.writeJUnit(&buf), ShouldBeNil)
+			want, err := os.ReadFile("testdata/query_report_junit.golden")
+			So(err, ShouldBeNil)
+			So(buf.String(), ShouldEqual, string(want))
+		})
+
+		Convey(`writeHTML`, func() {
+			So(q.writeHTML(&buf), ShouldBeNil)
+			want, err := os.ReadFile("testdata/query_report_html.golden")
+			So(err, ShouldBeNil)
+			So(buf.String(), ShouldEqual, string(want))
+
+			// The summary is untrusted, so it's shown as text, not rendered.
+			So(buf.String(), ShouldNotContainSubstring, "<script>")
+			So(buf.String(), ShouldNotContainSubstring, "javascript:")
+		})
+	})
+}
@@ -97,11 +97,9 @@ func (s *recorderServer) BatchCreateTestResults(ctx context.Context, in *pb.Batc
 	ret := &pb.BatchCreateTestResultsResponse{
 		TestResults: make([]*pb.TestResult, len(in.Requests)),
 	}
-	ms := make([]*spanner.Mutation, len(in.Requests))
 	var commonPrefix string
 	varUnion := stringset.New(0)
 	for i, r := range in.Requests {
-		ret.TestResults[i], ms[i] = insertTestResult(ctx, invID, in.RequestId, r.TestResult)
 		if i == 0 {
 			commonPrefix = r.TestResult.TestId
 		} else {
@@ -112,7 +110,6 @@ func (s *recorderServer) BatchCreateTestResults(ctx context.Context, in *pb.Batc
 
 	var realm string
 	err := mutateInvocation(ctx, invID, func(ctx context.Context) error {
-		span.BufferWrite(ctx, ms...)
 		eg, ctx := errgroup.WithContext(ctx)
 		eg.Go(func() (err error) {
 			var invCommonTestIdPrefix spanner.NullString
@@ -125,6 +122,14 @@ func (s *recorderServer) BatchCreateTestResults(ctx context.Context, in *pb.Batc
 				return
 			}
 
+			// The test results are inserted with the realm of the invocation,
+			// so that they can be queried by realm, see QueryTestHistory.
+			ms := make([]*spanner.Mutation, len(in.Requests))
+			for i, r := range in.Requests {
+				ret.TestResults[i], ms[i] = insertTestResult(ctx, invID, realm, in.RequestId, r.TestResult)
+			}
+			span.BufferWrite(ctx, ms...)
+
 			newPrefix := commonPrefix
 			if !invCommonTestIdPrefix.IsNull() {
 				newPrefix = longestCommonPrefix(invCommonTestIdPrefix.String(), commonPrefix)
@@ -154,7 +159,7 @@ func (s *recorderServer) BatchCreateTestResults(ctx context.Context, in *pb.Batc
 	return ret, nil
 }
 
-func insertTestResult(ctx context.Context, invID invocations.ID, requestID string, body *pb.TestResult) (*pb.TestResult, *spanner.Mutation) {
+func insertTestResult(ctx context.Context, invID invocations.ID, realm, requestID string, body *pb.TestResult) (*pb.TestResult, *spanner.Mutation) {
 	// create a copy of the input message with the OUTPUT_ONLY field(s) to be used in
 	// the response
 	ret := proto.Clone(body).(*pb.TestResult)
@@ -181,6 +186,7 @@ func insertTestResult(ctx context.Context, invID invocations.ID, requestID strin
 		"StartTime":       ret.StartTime,
 		"RunDurationUsec": runDuration,
 		"Tags":            ret.Tags,
+		"Realm":           realm,
 	}
 	if ret.TestMetadata != nil {
 		row["TestMetadata"] = spanutil.Compressed(pbutil.MustMarshal(ret.TestMetadata))
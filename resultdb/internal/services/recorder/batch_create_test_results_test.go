@@ -168,6 +168,13 @@ func TestBatchCreateTestResults(t *testing.T) {
 				So(err, ShouldBeNil)
 				So(row, ShouldResembleProto, expected)
 
+				// the realm of the invocation is copied to the row.
+				var realm string
+				testutil.MustReadRow(ctx, "TestResults", invocations.ID("u-build-1").Key(expected.TestId, expected.ResultId), map[string]any{
+					"Realm": &realm,
+				})
+				So(realm, ShouldEqual, insert.TestRealm)
+
 				var invCommonTestIDPrefix string
 				var invVars []string
 				err = invocations.ReadColumns(
@@ -0,0 +1,109 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resultdb
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/grpc/appstatus"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/realms"
+	"go.chromium.org/luci/server/span"
+
+	"go.chromium.org/luci/resultdb/internal/pagination"
+	"go.chromium.org/luci/resultdb/internal/testresults"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	"go.chromium.org/luci/resultdb/rdbperms"
+)
+
+func validateQueryTestHistoryRequest(req *pb.QueryTestHistoryRequest) error {
+	if err := pbutil.ValidateTestID(req.GetTestId()); err != nil {
+		return errors.Annotate(err, "test_id").Err()
+	}
+	if req.GetVariantHash() != "" {
+		if err := pbutil.ValidateVariantHash(req.VariantHash); err != nil {
+			return errors.Annotate(err, "variant_hash").Err()
+		}
+	}
+	if err := pagination.ValidatePageSize(req.GetPageSize()); err != nil {
+		return errors.Annotate(err, "page_size").Err()
+	}
+	return nil
+}
+
+// QueryTestHistory implements pb.ResultDBServer.
+// It returns the results of a test in the invocations of a realm, most recent
+// first, grouped by variant.
+func (s *resultDBServer) QueryTestHistory(ctx context.Context, in *pb.QueryTestHistoryRequest) (*pb.QueryTestHistoryResponse, error) {
+	// Validate realm before using it to check permission.
+	if err := realms.ValidateRealmName(in.GetRealm(), realms.GlobalScope); err != nil {
+		return nil, appstatus.BadRequest(errors.Annotate(err, "realm").Err())
+	}
+	switch allowed, err := auth.HasPermission(ctx, rdbperms.PermListTestResults, in.Realm, nil); {
+	case err != nil:
+		return nil, err
+	case !allowed:
+		return nil, appstatus.Errorf(codes.PermissionDenied, "caller does not have permission %s in realm %q", rdbperms.PermListTestResults, in.Realm)
+	}
+	if err := validateQueryTestHistoryRequest(in); err != nil {
+		return nil, appstatus.BadRequest(err)
+	}
+
+	ctx, cancel := span.ReadOnlyTransaction(ctx)
+	defer cancel()
+	q := testresults.HistoryQuery{
+		TestID:      in.TestId,
+		Realm:       in.Realm,
+		VariantHash: in.VariantHash,
+		PageSize:    pagination.AdjustPageSize(in.PageSize),
+		PageToken:   in.PageToken,
+	}
+	trs, nextPageToken, err := q.Fetch(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "fetch").Err()
+	}
+
+	// Group the results by variant, keeping them most recent first.
+	byHash := map[string]*pb.TestVariantHistory{}
+	var variants []*pb.TestVariantHistory
+	for _, tr := range trs {
+		h, ok := byHash[tr.VariantHash]
+		if !ok {
+			h = &pb.TestVariantHistory{
+				VariantHash: tr.VariantHash,
+				Variant:     tr.Variant,
+			}
+			byHash[tr.VariantHash] = h
+			variants = append(variants, h)
+		}
+		tr.TestId = ""
+		tr.Variant = nil
+		tr.VariantHash = ""
+		h.Results = append(h.Results, tr)
+	}
+	sort.Slice(variants, func(i, j int) bool {
+		return variants[i].VariantHash < variants[j].VariantHash
+	})
+
+	return &pb.QueryTestHistoryResponse{
+		Variants:      variants,
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resultdb
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/codes"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+
+	"go.chromium.org/luci/resultdb/internal/testutil"
+	"go.chromium.org/luci/resultdb/internal/testutil/insert"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	"go.chromium.org/luci/resultdb/rdbperms"
+)
+
+func TestQueryTestHistory(t *testing.T) {
+	Convey(`QueryTestHistory`, t, func() {
+		ctx := auth.WithState(testutil.SpannerTestContext(t), &authtest.FakeState{
+			Identity: "user:someone@example.com",
+			IdentityPermissions: []authtest.RealmPermission{
+				{Realm: insert.TestRealm, Permission: rdbperms.PermListTestResults},
+			},
+		})
+
+		v1 := pbutil.Variant("k", "1")
+		v2 := pbutil.Variant("k", "2")
+		testutil.MustApply(ctx,
+			insert.Invocation("inv1", pb.Invocation_FINALIZED, nil),
+			insert.Invocation("inv2", pb.Invocation_FINALIZED, nil),
+			insert.Invocation("inv3", pb.Invocation_ACTIVE, nil),
+		)
+		// Insert the results in separate transactions, so that they have
+		// different commit timestamps.
+		testutil.MustApply(ctx, insert.TestResults("inv1", "T", v1, pb.TestStatus_PASS)...)
+		testutil.MustApply(ctx, insert.TestResults("inv2", "T", v2, pb.TestStatus_FAIL)...)
+		testutil.MustApply(ctx, insert.TestResults("inv3", "T", v1, pb.TestStatus_FAIL)...)
+
+		result := func(invID string, status pb.TestStatus) *pb.TestResult {
+			tr := insert.MakeTestResults(invID, "T", nil, status)[0]
+			return &pb.TestResult{
+				Name:     tr.Name,
+				ResultId: tr.ResultId,
+				Expected: tr.Expected,
+				Status:   tr.Status,
+				Duration: tr.Duration,
+			}
+		}
+
+		srv := newTestResultDBService()
+		req := &pb.QueryTestHistoryRequest{
+			TestId: "T",
+			Realm:  insert.TestRealm,
+		}
+
+		Convey(`Permission denied`, func() {
+			req.Realm = "secretproject:secretrealm"
+			_, err := srv.QueryTestHistory(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.PermissionDenied)
+		})
+
+		Convey(`Invalid realm`, func() {
+			req.Realm = "testrealm"
+			_, err := srv.QueryTestHistory(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument)
+			So(err, ShouldErrLike, "realm")
+		})
+
+		Convey(`Invalid variant hash`, func() {
+			req.VariantHash = "x"
+			_, err := srv.QueryTestHistory(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument)
+			So(err, ShouldErrLike, "variant_hash")
+		})
+
+		Convey(`Invalid page size`, func() {
+			req.PageSize = -1
+			_, err := srv.QueryTestHistory(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument)
+			So(err, ShouldErrLike, "page_size")
+		})
+
+		Convey(`Valid`, func() {
+			h1 := &pb.TestVariantHistory{
+				VariantHash: pbutil.VariantHash(v1),
+				Variant:     v1,
+				Results: []*pb.TestResult{
+					result("inv3", pb.TestStatus_FAIL),
+					result("inv1", pb.TestStatus_PASS),
+				},
+			}
+			h2 := &pb.TestVariantHistory{
+				VariantHash: pbutil.VariantHash(v2),
+				Variant:     v2,
+				Results: []*pb.TestResult{
+					result("inv2", pb.TestStatus_FAIL),
+				},
+			}
+			expected := []*pb.TestVariantHistory{h1, h2}
+			if h2.VariantHash < h1.VariantHash {
+				expected = []*pb.TestVariantHistory{h2, h1}
+			}
+
+			Convey(`All variants`, func() {
+				res, err := srv.QueryTestHistory(ctx, req)
+				So(err, ShouldBeNil)
+				So(res.NextPageToken, ShouldEqual, "")
+				So(res.Variants, ShouldResembleProto, expected)
+			})
+
+			Convey(`One variant`, func() {
+				req.VariantHash = h1.VariantHash
+				res, err := srv.QueryTestHistory(ctx, req)
+				So(err, ShouldBeNil)
+				So(res.Variants, ShouldResembleProto, []*pb.TestVariantHistory{h1})
+			})
+
+			Convey(`Paging`, func() {
+				req.PageSize = 1
+				res, err := srv.QueryTestHistory(ctx, req)
+				So(err, ShouldBeNil)
+				So(res.NextPageToken, ShouldNotEqual, "")
+				So(res.Variants, ShouldResembleProto, []*pb.TestVariantHistory{{
+					VariantHash: h1.VariantHash,
+					Variant:     v1,
+					Results:     h1.Results[:1],
+				}})
+			})
+		})
+	})
+}
@@ -204,6 +204,11 @@ CREATE TABLE TestResults (
   -- domain-specific properties of the test result.
   -- See spanutil.Compressed type for details of compression.
   Properties BYTES(MAX),
+
+  -- Realm of the parent invocation, copied from Invocations.Realm.
+  -- Used to query the history of a test in a realm.
+  -- NULL for the test results created before the column was added.
+  Realm STRING(64),
 ) PRIMARY KEY (InvocationId, TestId, ResultId),
   INTERLEAVE IN PARENT Invocations ON DELETE CASCADE;
 
@@ -257,6 +262,13 @@ CREATE NULL_FILTERED INDEX UnexpectedTestResults
   ON TestResults (InvocationId, TestId, IsUnexpected) STORING (VariantHash, Variant),
   INTERLEAVE IN Invocations;
 
+-- Test results by test id and realm, most recent first.
+-- Used to query the history of a test in a realm, see QueryTestHistory RPC.
+-- The test results without a realm are not indexed.
+CREATE NULL_FILTERED INDEX TestResultsByTestIdAndRealm
+  ON TestResults (TestId, Realm, CommitTimestamp DESC)
+  STORING (VariantHash, Variant, IsUnexpected, Status, StartTime, RunDurationUsec);
+
 
 -- Stores test exonerations, see TestExoneration in test_result.proto
 CREATE TABLE TestExonerations (
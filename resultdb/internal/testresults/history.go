@@ -0,0 +1,160 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testresults
+
+import (
+	"context"
+	"text/template"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/resultdb/internal/invocations"
+	"go.chromium.org/luci/resultdb/internal/pagination"
+	"go.chromium.org/luci/resultdb/internal/spanutil"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+// HistoryQuery specifies the results of a test in the invocations of a realm,
+// i.e. the history of the test in the realm.
+//
+// It uses the TestResultsByTestIdAndRealm index, so the test results created
+// before TestResults.Realm was populated are not part of the history.
+type HistoryQuery struct {
+	TestID string
+	Realm  string
+	// VariantHash, if not empty, restricts the history to a single variant.
+	VariantHash string
+
+	PageSize  int // must be positive
+	PageToken string
+}
+
+// Fetch returns a page of the test results of the history, most recent first.
+//
+// Only the name, test_id, result_id, variant, variant_hash, expected, status,
+// start_time and duration fields of the returned test results are set.
+func (q *HistoryQuery) Fetch(ctx context.Context) (trs []*pb.TestResult, nextPageToken string, err error) {
+	if q.PageSize <= 0 {
+		panic("PageSize <= 0")
+	}
+
+	st, err := spanutil.GenerateStatement(historyQueryTmpl, map[string]any{
+		"filterVariantHash": q.VariantHash != "",
+		"pagination":        q.PageToken != "",
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	params := map[string]any{
+		"testID":      q.TestID,
+		"realm":       q.Realm,
+		"variantHash": q.VariantHash,
+		"limit":       q.PageSize,
+	}
+	if q.PageToken != "" {
+		afterCommitTime, afterInvID, afterResultID, err := parseHistoryPageToken(q.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		params["afterCommitTimestamp"] = afterCommitTime
+		params["afterInvocationId"] = afterInvID
+		params["afterResultId"] = afterResultID
+	}
+	st.Params = spanutil.ToSpannerMap(params)
+
+	var b spanutil.Buffer
+	var lastCommitTime time.Time
+	err = spanutil.Query(ctx, st, func(row *spanner.Row) error {
+		var invID invocations.ID
+		var maybeUnexpected spanner.NullBool
+		var micros spanner.NullInt64
+		tr := &pb.TestResult{TestId: q.TestID}
+		err := b.FromSpanner(row,
+			&invID,
+			&tr.ResultId,
+			&tr.Variant,
+			&tr.VariantHash,
+			&maybeUnexpected,
+			&tr.Status,
+			&tr.StartTime,
+			&micros,
+			&lastCommitTime,
+		)
+		if err != nil {
+			return err
+		}
+		tr.Name = pbutil.TestResultName(string(invID), tr.TestId, tr.ResultId)
+		PopulateExpectedField(tr, maybeUnexpected)
+		PopulateDurationField(tr, micros)
+		trs = append(trs, tr)
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	// If we got pageSize results, then we haven't exhausted the collection and
+	// need to return the next page token.
+	if len(trs) == q.PageSize {
+		invID, _, resultID := MustParseName(trs[len(trs)-1].Name)
+		nextPageToken = pagination.Token(lastCommitTime.Format(time.RFC3339Nano), string(invID), resultID)
+	}
+	return trs, nextPageToken, nil
+}
+
+func parseHistoryPageToken(pageToken string) (afterCommitTime time.Time, afterInvID invocations.ID, afterResultID string, err error) {
+	parts, err := pagination.ParseToken(pageToken)
+	if err != nil {
+		return
+	}
+	if len(parts) != 3 {
+		err = pagination.InvalidToken(errors.Reason("expected 3 components, got %q", parts).Err())
+		return
+	}
+	if afterCommitTime, err = time.Parse(time.RFC3339Nano, parts[0]); err != nil {
+		err = pagination.InvalidToken(err)
+		return
+	}
+	return afterCommitTime, invocations.ID(parts[1]), parts[2], nil
+}
+
+var historyQueryTmpl = template.Must(template.New("").Parse(`
+	SELECT
+		InvocationId,
+		ResultId,
+		Variant,
+		VariantHash,
+		IsUnexpected,
+		Status,
+		StartTime,
+		RunDurationUsec,
+		CommitTimestamp
+	FROM TestResults@{FORCE_INDEX=TestResultsByTestIdAndRealm, spanner_emulator.disable_query_null_filtered_index_check=true}
+	WHERE TestId = @testID AND Realm = @realm
+		{{if .filterVariantHash}}
+			AND VariantHash = @variantHash
+		{{end}}
+		{{if .pagination}}
+			AND (CommitTimestamp < @afterCommitTimestamp
+				OR (CommitTimestamp = @afterCommitTimestamp AND InvocationId > @afterInvocationId)
+				OR (CommitTimestamp = @afterCommitTimestamp AND InvocationId = @afterInvocationId AND ResultId > @afterResultId))
+		{{end}}
+	ORDER BY CommitTimestamp DESC, InvocationId, ResultId
+	LIMIT @limit
+`))
@@ -0,0 +1,110 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testresults
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/codes"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+	"go.chromium.org/luci/resultdb/internal/testutil"
+	"go.chromium.org/luci/resultdb/internal/testutil/insert"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	"go.chromium.org/luci/server/span"
+)
+
+func TestHistoryQuery(t *testing.T) {
+	Convey(`HistoryQuery`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+
+		v1 := pbutil.Variant("k", "1")
+		v2 := pbutil.Variant("k", "2")
+		testutil.MustApply(ctx,
+			insert.Invocation("inv1", pb.Invocation_ACTIVE, nil),
+			insert.Invocation("inv2", pb.Invocation_ACTIVE, nil),
+		)
+
+		// Insert the results in separate transactions, so that they have
+		// different commit timestamps.
+		trs1 := insert.MakeTestResults("inv1", "T", v1, pb.TestStatus_PASS, pb.TestStatus_FAIL)
+		trs2 := insert.MakeTestResults("inv2", "T", v2, pb.TestStatus_PASS)
+		testutil.MustApply(ctx, insert.TestResultMessages(trs1)...)
+		testutil.MustApply(ctx, insert.TestResultMessages(trs2)...)
+		testutil.MustApply(ctx, insert.TestResults("inv2", "Other", v1, pb.TestStatus_FAIL)...)
+
+		// Most recent first.
+		expected := append(append([]*pb.TestResult{}, trs2...), trs1...)
+		for _, tr := range expected {
+			tr.SummaryHtml = ""
+		}
+
+		q := &HistoryQuery{
+			TestID:   "T",
+			Realm:    insert.TestRealm,
+			PageSize: 100,
+		}
+		fetch := func(q *HistoryQuery) (trs []*pb.TestResult, token string, err error) {
+			ctx, cancel := span.ReadOnlyTransaction(ctx)
+			defer cancel()
+			return q.Fetch(ctx)
+		}
+		mustFetch := func(q *HistoryQuery) (trs []*pb.TestResult, token string) {
+			trs, token, err := fetch(q)
+			So(err, ShouldBeNil)
+			return trs, token
+		}
+
+		Convey(`Works`, func() {
+			trs, token := mustFetch(q)
+			So(token, ShouldEqual, "")
+			So(trs, ShouldResembleProto, expected)
+		})
+
+		Convey(`Variant hash`, func() {
+			q.VariantHash = pbutil.VariantHash(v1)
+			trs, _ := mustFetch(q)
+			So(trs, ShouldResembleProto, expected[1:])
+		})
+
+		Convey(`Other realm`, func() {
+			q.Realm = "otherproject:otherrealm"
+			trs, _ := mustFetch(q)
+			So(trs, ShouldBeEmpty)
+		})
+
+		Convey(`Paging`, func() {
+			q.PageSize = 1
+			var all []*pb.TestResult
+			for {
+				trs, token := mustFetch(q)
+				all = append(all, trs...)
+				if token == "" {
+					break
+				}
+				q.PageToken = token
+			}
+			So(all, ShouldResembleProto, expected)
+		})
+
+		Convey(`Bad page token`, func() {
+			q.PageToken = "CgVoZWxsbw=="
+			_, _, err := fetch(q)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument)
+		})
+	})
+}
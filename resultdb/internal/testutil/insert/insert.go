@@ -105,6 +105,7 @@ func TestResultMessages(trs []*pb.TestResult) []*spanner.Mutation {
 			"Status":          tr.Status,
 			"RunDurationUsec": 1e6*i + 234567,
 			"SummaryHtml":     spanutil.Compressed("SummaryHtml"),
+			"Realm":           TestRealm,
 		}
 		if !trs[i].Expected {
 			mutMap["IsUnexpected"] = true
@@ -19,6 +19,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"regexp"
 	"sort"
 
 	"go.chromium.org/luci/common/errors"
@@ -37,6 +38,14 @@ func ValidateVariant(vr *pb.Variant) error {
 	return nil
 }
 
+var variantHashRe = regexp.MustCompile(`^[0-9a-f]{16}$`)
+
+// ValidateVariantHash returns an error if hash is not a valid variant hash,
+// see VariantHash.
+func ValidateVariantHash(hash string) error {
+	return validateWithRe(variantHashRe, hash)
+}
+
 // Variant creates a pb.Variant from a list of strings alternating
 // key/value. Does not validate pairs.
 // See also VariantFromStrings.
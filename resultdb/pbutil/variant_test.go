@@ -37,6 +37,23 @@ func TestValidateVariant(t *testing.T) {
 	})
 }
 
+func TestValidateVariantHash(t *testing.T) {
+	t.Parallel()
+	Convey(`TestValidateVariantHash`, t, func() {
+		Convey(`valid`, func() {
+			So(ValidateVariantHash(VariantHash(Variant("k", "v"))), ShouldBeNil)
+		})
+
+		Convey(`empty`, func() {
+			So(ValidateVariantHash(""), ShouldErrLike, `unspecified`)
+		})
+
+		Convey(`invalid`, func() {
+			So(ValidateVariantHash("ABCDEF0123456789"), ShouldErrLike, `does not match`)
+		})
+	})
+}
+
 func TestVariantUtils(t *testing.T) {
 	t.Parallel()
 
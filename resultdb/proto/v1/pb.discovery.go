@@ -12,4148 +12,4291 @@ func init() {
 			"luci.resultdb.v1.Recorder", "luci.resultdb.v1.ResultDB",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 228, 253, 125, 116, 100, 87,
-			117, 32, 138, 235, 126, 85, 149, 142, 190, 74, 71, 178, 173, 174,
-			110, 187, 79, 87, 119, 91, 31, 93, 170, 238, 150, 191, 112, 55,
-			198, 163, 150, 202, 237, 50, 106, 73, 41, 73, 152, 38, 11, 203,
-			87, 85, 71, 210, 117, 87, 221, 91, 220, 123, 171, 101, 121, 200,
-			36, 3, 3, 51, 192, 226, 199, 152, 216, 152, 31, 33, 241, 132,
-			64, 2, 100, 189, 144, 9, 12, 47, 76, 178, 146, 73, 178, 102,
-			241, 50, 129, 100, 72, 66, 94, 66, 230, 173, 121, 139, 48, 243,
-			8, 249, 226, 99, 8, 36, 132, 225, 173, 189, 207, 199, 189, 85,
-			82, 219, 205, 135, 51, 127, 60, 47, 175, 213, 218, 167, 206, 217,
-			247, 156, 125, 246, 217, 103, 239, 125, 246, 217, 135, 252, 254, 91,
-			44, 194, 118, 130, 96, 167, 201, 207, 182, 195, 32, 14, 182, 58,
-			219, 103, 27, 60, 170, 135, 94, 59, 14, 194, 50, 150, 209, 17,
-			81, 163, 172, 106, 20, 175, 144, 209, 135, 188, 38, 95, 212, 21,
-			215, 120, 76, 95, 70, 236, 109, 175, 201, 39, 12, 102, 77, 13,
-			204, 157, 42, 247, 52, 42, 119, 183, 88, 133, 226, 26, 182, 40,
-			126, 193, 38, 99, 135, 252, 74, 41, 177, 125, 183, 5, 24, 141,
-			169, 254, 26, 254, 77, 39, 72, 182, 237, 214, 175, 185, 59, 124,
-			194, 196, 98, 5, 210, 59, 8, 105, 240, 54, 247, 27, 220, 175,
-			239, 79, 88, 204, 154, 234, 175, 165, 74, 232, 25, 50, 218, 238,
-			108, 53, 189, 250, 102, 170, 26, 97, 214, 148, 83, 203, 139, 31,
-			22, 147, 202, 147, 100, 100, 143, 187, 215, 210, 85, 7, 176, 234,
-			48, 20, 167, 42, 46, 144, 193, 22, 143, 34, 119, 135, 111, 198,
-			251, 109, 62, 97, 227, 232, 217, 129, 209, 247, 142, 124, 64, 182,
-			90, 223, 111, 115, 58, 79, 250, 185, 223, 105, 9, 12, 206, 13,
-			232, 87, 241, 59, 173, 94, 44, 57, 104, 38, 81, 100, 35, 30,
-			94, 247, 234, 124, 34, 131, 8, 38, 15, 32, 88, 19, 191, 247,
-			226, 80, 237, 232, 2, 233, 231, 79, 198, 220, 143, 188, 192, 159,
-			200, 34, 146, 211, 135, 204, 34, 111, 54, 122, 81, 36, 237, 232,
-			189, 36, 27, 180, 99, 47, 240, 163, 137, 28, 51, 166, 6, 230,
-			142, 29, 202, 8, 43, 162, 78, 77, 85, 166, 85, 146, 143, 130,
-			78, 88, 231, 155, 245, 160, 193, 55, 61, 127, 59, 152, 232, 71,
-			4, 199, 15, 14, 4, 43, 46, 4, 13, 94, 245, 183, 131, 218,
-			112, 212, 5, 211, 91, 73, 38, 218, 247, 99, 247, 201, 137, 65,
-			228, 16, 9, 21, 63, 145, 33, 35, 55, 195, 98, 23, 137, 179,
-			13, 163, 156, 48, 191, 27, 26, 136, 54, 221, 68, 204, 124, 143,
-			68, 156, 39, 3, 62, 143, 98, 222, 16, 28, 97, 221, 36, 79,
-			17, 209, 232, 32, 75, 217, 223, 19, 75, 189, 154, 140, 232, 46,
-			109, 134, 174, 191, 163, 120, 243, 236, 139, 245, 164, 92, 81, 237,
-			106, 208, 172, 54, 204, 187, 96, 186, 72, 72, 224, 243, 96, 123,
-			179, 193, 235, 205, 137, 220, 13, 168, 180, 2, 85, 14, 80, 41,
-			16, 165, 245, 38, 189, 63, 97, 181, 236, 13, 56, 229, 138, 88,
-			100, 7, 184, 109, 131, 12, 135, 28, 248, 158, 55, 228, 200, 250,
-			177, 19, 229, 23, 29, 89, 77, 54, 19, 3, 27, 10, 211, 32,
-			61, 73, 116, 193, 38, 178, 21, 65, 41, 52, 168, 10, 151, 221,
-			22, 47, 60, 69, 134, 187, 201, 67, 199, 137, 19, 197, 110, 24,
-			35, 23, 58, 53, 1, 208, 60, 177, 184, 223, 64, 41, 231, 212,
-			224, 79, 250, 79, 146, 1, 91, 56, 224, 59, 15, 206, 104, 23,
-			230, 222, 113, 23, 238, 35, 67, 93, 3, 184, 217, 79, 23, 95,
-			79, 110, 57, 20, 53, 125, 53, 25, 239, 248, 158, 31, 243, 176,
-			29, 114, 224, 88, 241, 169, 137, 255, 39, 123, 3, 158, 219, 72,
-			215, 22, 88, 106, 99, 157, 131, 133, 51, 253, 185, 47, 102, 243,
-			63, 246, 99, 63, 246, 99, 102, 241, 55, 50, 100, 252, 176, 53,
-			115, 232, 242, 189, 149, 100, 252, 78, 107, 139, 135, 72, 36, 167,
-			38, 33, 58, 79, 156, 166, 187, 197, 155, 19, 54, 51, 166, 134,
-			231, 206, 220, 212, 170, 44, 47, 65, 147, 154, 104, 73, 95, 65,
-			108, 41, 162, 1, 195, 204, 205, 97, 128, 181, 84, 195, 118, 244,
-			40, 233, 135, 127, 5, 111, 100, 176, 207, 57, 40, 0, 190, 160,
-			5, 146, 195, 101, 210, 224, 106, 107, 211, 48, 48, 86, 131, 111,
-			187, 157, 102, 188, 121, 221, 109, 118, 56, 50, 124, 127, 109, 80,
-			22, 190, 10, 202, 232, 113, 50, 32, 86, 149, 231, 55, 248, 147,
-			40, 61, 157, 154, 88, 104, 85, 40, 129, 207, 63, 17, 5, 190,
-			98, 77, 252, 4, 20, 224, 231, 239, 235, 21, 220, 183, 31, 62,
-			188, 3, 107, 105, 146, 140, 96, 141, 187, 228, 212, 187, 205, 137,
-			81, 102, 76, 229, 106, 195, 162, 120, 69, 150, 22, 63, 110, 18,
-			27, 5, 203, 8, 25, 88, 191, 186, 90, 217, 92, 92, 217, 184,
-			180, 84, 201, 27, 116, 152, 16, 44, 120, 104, 105, 101, 126, 61,
-			111, 106, 184, 186, 188, 126, 239, 221, 121, 75, 55, 216, 16, 5,
-			118, 186, 194, 93, 115, 121, 135, 230, 201, 160, 64, 80, 125, 117,
-			101, 241, 222, 187, 243, 153, 238, 146, 187, 230, 242, 89, 58, 68,
-			250, 177, 228, 210, 202, 202, 82, 62, 167, 113, 174, 173, 215, 170,
-			203, 151, 243, 253, 26, 231, 229, 218, 202, 198, 106, 158, 104, 12,
-			87, 42, 107, 107, 243, 151, 43, 249, 1, 93, 227, 210, 213, 245,
-			202, 90, 126, 176, 171, 91, 119, 205, 229, 135, 244, 39, 42, 203,
-			27, 87, 242, 195, 116, 148, 12, 137, 79, 168, 78, 140, 244, 20,
-			221, 123, 119, 62, 159, 116, 68, 96, 25, 237, 42, 184, 247, 238,
-			60, 45, 46, 16, 7, 217, 144, 82, 50, 188, 52, 127, 169, 178,
-			180, 185, 178, 186, 94, 93, 89, 158, 95, 202, 27, 73, 89, 173,
-			242, 67, 27, 213, 90, 101, 49, 111, 166, 203, 86, 43, 243, 235,
-			149, 197, 188, 85, 172, 147, 241, 195, 4, 234, 161, 75, 40, 197,
-			11, 230, 13, 120, 1, 113, 245, 242, 66, 241, 207, 76, 50, 118,
-			200, 166, 114, 232, 71, 30, 36, 142, 224, 101, 177, 205, 78, 31,
-			186, 59, 33, 103, 31, 216, 106, 177, 93, 90, 213, 176, 110, 160,
-			106, 0, 138, 3, 12, 251, 218, 3, 194, 95, 236, 143, 247, 222,
-			204, 254, 136, 101, 223, 221, 38, 224, 28, 178, 9, 92, 36, 163,
-			7, 16, 221, 180, 48, 126, 163, 65, 38, 110, 68, 156, 23, 17,
-			137, 102, 151, 72, 188, 216, 75, 193, 19, 55, 158, 132, 3, 115,
-			253, 115, 6, 185, 245, 112, 149, 242, 208, 62, 188, 130, 100, 90,
-			60, 222, 13, 148, 90, 117, 112, 239, 186, 130, 63, 247, 78, 182,
-			108, 149, 222, 237, 173, 27, 233, 133, 162, 55, 7, 122, 250, 22,
-			147, 220, 114, 40, 242, 67, 59, 122, 59, 33, 158, 223, 238, 196,
-			66, 117, 18, 146, 184, 31, 75, 80, 120, 129, 148, 237, 196, 250,
-			119, 11, 127, 39, 162, 8, 43, 188, 44, 233, 168, 141, 29, 189,
-			227, 6, 35, 61, 192, 152, 231, 72, 190, 222, 244, 184, 31, 111,
-			70, 113, 200, 221, 150, 231, 239, 224, 86, 147, 187, 224, 108, 187,
-			205, 136, 215, 70, 196, 207, 107, 234, 87, 104, 129, 12, 20, 166,
-			90, 100, 186, 90, 136, 159, 117, 139, 226, 211, 253, 100, 32, 165,
-			128, 211, 19, 100, 240, 9, 247, 186, 187, 169, 140, 42, 65, 137,
-			1, 40, 91, 149, 134, 213, 57, 50, 142, 85, 130, 78, 204, 195,
-			205, 122, 211, 141, 34, 36, 90, 14, 171, 82, 248, 109, 5, 126,
-			90, 80, 191, 208, 123, 200, 24, 182, 104, 117, 154, 177, 215, 110,
-			242, 77, 48, 243, 34, 220, 114, 116, 207, 70, 161, 198, 21, 89,
-			1, 122, 20, 209, 69, 114, 59, 54, 219, 225, 62, 15, 221, 152,
-			111, 242, 215, 117, 220, 102, 180, 233, 250, 141, 205, 93, 55, 218,
-			157, 24, 7, 4, 151, 204, 9, 163, 118, 4, 42, 94, 150, 245,
-			42, 88, 109, 222, 111, 60, 236, 70, 187, 244, 2, 185, 21, 177,
-			68, 113, 232, 249, 59, 155, 245, 93, 94, 191, 182, 217, 137, 183,
-			95, 54, 113, 52, 253, 125, 236, 225, 26, 214, 89, 128, 42, 27,
-			241, 246, 203, 232, 26, 25, 132, 201, 104, 121, 79, 241, 205, 237,
-			32, 196, 61, 116, 248, 16, 209, 148, 162, 96, 121, 69, 54, 184,
-			18, 52, 248, 5, 103, 109, 181, 82, 89, 172, 13, 40, 44, 15,
-			5, 33, 48, 212, 78, 160, 9, 60, 32, 24, 106, 39, 80, 228,
-			189, 135, 140, 213, 235, 98, 204, 94, 125, 83, 26, 99, 209, 68,
-			190, 139, 88, 245, 250, 101, 81, 65, 242, 120, 68, 239, 39, 183,
-			36, 196, 74, 55, 28, 61, 48, 202, 222, 166, 247, 144, 177, 246,
-			254, 193, 134, 180, 235, 139, 237, 253, 222, 102, 247, 145, 241, 246,
-			110, 251, 96, 187, 153, 116, 59, 218, 222, 109, 247, 54, 60, 141,
-			150, 121, 200, 235, 110, 204, 27, 19, 183, 165, 171, 167, 126, 160,
-			101, 146, 175, 215, 55, 185, 239, 110, 53, 249, 166, 27, 114, 223,
-			141, 38, 142, 99, 101, 59, 14, 59, 188, 54, 92, 175, 87, 240,
-			199, 121, 252, 141, 206, 144, 209, 96, 235, 137, 186, 224, 200, 205,
-			118, 200, 183, 189, 39, 39, 78, 33, 121, 71, 224, 7, 228, 199,
-			85, 44, 166, 211, 36, 95, 143, 118, 221, 176, 141, 34, 57, 106,
-			187, 117, 62, 113, 90, 84, 21, 229, 203, 170, 24, 86, 68, 180,
-			231, 109, 199, 10, 227, 164, 88, 17, 88, 38, 177, 77, 145, 60,
-			80, 162, 235, 195, 83, 88, 109, 184, 189, 219, 78, 127, 247, 36,
-			25, 130, 154, 201, 71, 167, 133, 226, 214, 222, 77, 125, 241, 110,
-			114, 43, 84, 106, 241, 216, 109, 184, 177, 155, 170, 93, 194, 218,
-			64, 246, 43, 242, 199, 174, 126, 134, 157, 173, 125, 205, 88, 179,
-			162, 159, 80, 166, 88, 235, 37, 83, 206, 139, 23, 200, 96, 154,
-			239, 105, 63, 17, 156, 159, 55, 64, 9, 90, 88, 89, 4, 245,
-			229, 53, 149, 188, 9, 106, 212, 82, 117, 189, 178, 89, 219, 88,
-			94, 175, 94, 169, 228, 173, 148, 98, 255, 136, 157, 187, 51, 63,
-			9, 90, 195, 112, 183, 165, 70, 95, 78, 110, 83, 110, 149, 136,
-			199, 155, 123, 94, 136, 11, 178, 229, 138, 205, 81, 243, 207, 184,
-			172, 181, 198, 227, 71, 189, 16, 150, 91, 203, 141, 233, 18, 57,
-			238, 7, 155, 81, 236, 250, 13, 55, 108, 108, 38, 14, 173, 77,
-			183, 94, 231, 81, 20, 136, 141, 80, 99, 57, 230, 7, 107, 178,
-			114, 178, 67, 204, 203, 170, 61, 236, 107, 221, 136, 125, 143, 146,
-			254, 150, 219, 222, 228, 126, 28, 238, 163, 126, 158, 171, 229, 90,
-			110, 187, 2, 240, 63, 138, 153, 244, 136, 157, 179, 243, 206, 35,
-			118, 206, 201, 103, 30, 177, 115, 153, 124, 246, 17, 59, 151, 203,
-			247, 63, 98, 231, 250, 243, 164, 248, 78, 155, 12, 166, 53, 120,
-			48, 136, 234, 184, 135, 25, 40, 229, 78, 190, 160, 190, 95, 94,
-			128, 205, 237, 66, 70, 168, 203, 53, 209, 18, 20, 11, 96, 63,
-			46, 212, 147, 92, 77, 66, 244, 50, 201, 60, 17, 33, 238, 12,
-			226, 62, 204, 27, 152, 194, 253, 200, 26, 34, 239, 127, 100, 109,
-			115, 121, 165, 118, 101, 126, 169, 38, 155, 211, 35, 196, 110, 186,
-			79, 237, 119, 111, 131, 88, 68, 203, 100, 164, 227, 95, 231, 161,
-			183, 237, 241, 198, 38, 214, 26, 73, 215, 26, 78, 126, 93, 130,
-			250, 55, 57, 141, 71, 136, 189, 199, 221, 107, 221, 155, 21, 22,
-			189, 132, 203, 233, 44, 113, 144, 190, 148, 16, 73, 225, 124, 31,
-			205, 17, 123, 97, 165, 6, 75, 42, 79, 6, 69, 233, 230, 106,
-			181, 178, 80, 201, 155, 197, 123, 72, 70, 16, 13, 150, 155, 38,
-			91, 190, 79, 130, 18, 135, 161, 126, 221, 184, 114, 169, 82, 203,
-			155, 7, 152, 165, 24, 145, 193, 180, 38, 255, 143, 99, 206, 255,
-			7, 131, 12, 164, 52, 115, 80, 169, 220, 102, 51, 216, 219, 116,
-			155, 158, 27, 73, 86, 34, 88, 52, 15, 37, 55, 59, 117, 255,
-			72, 139, 204, 201, 103, 138, 63, 101, 144, 124, 175, 106, 220, 211,
-			77, 227, 127, 101, 55, 139, 239, 53, 200, 112, 183, 62, 220, 211,
-			189, 19, 255, 75, 187, 247, 127, 155, 100, 168, 75, 11, 190, 217,
-			222, 189, 142, 140, 122, 13, 222, 106, 7, 49, 247, 235, 251, 155,
-			77, 126, 157, 55, 39, 138, 40, 100, 14, 186, 37, 187, 190, 80,
-			174, 38, 237, 150, 160, 217, 133, 177, 234, 98, 229, 202, 234, 202,
-			122, 101, 121, 225, 234, 230, 198, 242, 43, 151, 87, 30, 93, 174,
-			229, 189, 158, 106, 47, 225, 178, 95, 37, 249, 222, 78, 209, 219,
-			200, 97, 221, 202, 247, 209, 49, 50, 178, 188, 178, 185, 86, 93,
-			172, 108, 86, 30, 122, 168, 178, 176, 190, 38, 60, 39, 186, 246,
-			122, 215, 2, 47, 190, 199, 34, 99, 135, 244, 132, 206, 75, 155,
-			71, 152, 97, 179, 55, 211, 251, 50, 104, 29, 171, 110, 24, 75,
-			19, 105, 154, 0, 149, 252, 24, 132, 107, 40, 61, 82, 194, 16,
-			26, 73, 202, 133, 83, 170, 68, 104, 59, 136, 188, 216, 187, 206,
-			55, 61, 95, 185, 175, 192, 48, 178, 107, 121, 245, 75, 213, 143,
-			117, 109, 159, 239, 184, 61, 181, 65, 248, 91, 181, 188, 250, 69,
-			215, 62, 65, 6, 27, 65, 7, 180, 69, 81, 15, 246, 26, 163,
-			54, 32, 202, 116, 21, 105, 7, 36, 126, 179, 193, 218, 128, 40,
-			19, 85, 38, 201, 136, 187, 179, 19, 2, 114, 133, 72, 88, 54,
-			195, 186, 24, 43, 22, 30, 33, 57, 69, 7, 216, 236, 129, 18,
-			155, 109, 97, 174, 155, 83, 253, 181, 156, 175, 126, 60, 65, 6,
-			189, 104, 51, 57, 6, 48, 153, 57, 149, 171, 13, 120, 145, 118,
-			161, 22, 127, 206, 36, 195, 221, 199, 24, 116, 145, 228, 154, 65,
-			221, 69, 214, 18, 103, 104, 83, 47, 114, 242, 81, 94, 146, 245,
-			107, 186, 101, 225, 119, 13, 146, 83, 197, 244, 86, 98, 183, 221,
-			120, 23, 209, 57, 151, 204, 188, 81, 67, 24, 202, 163, 182, 235,
-			35, 11, 200, 114, 128, 97, 94, 155, 220, 109, 160, 217, 20, 180,
-			90, 220, 143, 35, 53, 175, 178, 124, 65, 22, 211, 51, 100, 52,
-			14, 93, 175, 217, 85, 215, 198, 186, 121, 245, 131, 174, 124, 129,
-			28, 81, 120, 27, 60, 118, 235, 187, 188, 145, 52, 202, 160, 123,
-			228, 54, 89, 97, 81, 254, 174, 218, 22, 63, 99, 144, 81, 101,
-			232, 53, 52, 177, 174, 16, 226, 250, 126, 16, 167, 201, 117, 144,
-			149, 15, 180, 43, 207, 235, 70, 181, 20, 130, 66, 139, 144, 228,
-			151, 27, 146, 237, 56, 25, 144, 103, 84, 120, 208, 41, 92, 3,
-			68, 20, 129, 69, 72, 199, 137, 179, 197, 119, 60, 95, 122, 158,
-			5, 160, 28, 56, 182, 118, 224, 92, 250, 103, 100, 172, 30, 180,
-			122, 187, 123, 41, 223, 227, 158, 136, 30, 54, 94, 51, 43, 43,
-			237, 4, 77, 215, 223, 41, 7, 225, 78, 114, 80, 11, 26, 82,
-			148, 58, 174, 109, 111, 125, 211, 48, 222, 103, 90, 151, 87, 47,
-			125, 216, 44, 92, 22, 13, 87, 21, 49, 106, 124, 187, 201, 235,
-			48, 192, 71, 254, 244, 211, 38, 201, 82, 103, 178, 239, 221, 89,
-			131, 124, 120, 132, 24, 131, 212, 154, 236, 163, 115, 191, 49, 200,
-			176, 122, 61, 104, 178, 75, 157, 237, 109, 30, 70, 108, 150, 9,
-			68, 147, 17, 3, 251, 131, 161, 112, 168, 239, 186, 254, 14, 103,
-			66, 35, 39, 108, 33, 104, 239, 135, 222, 206, 110, 204, 230, 206,
-			157, 123, 153, 108, 192, 170, 126, 189, 204, 216, 124, 179, 201, 240,
-			183, 136, 41, 15, 88, 153, 176, 221, 56, 110, 71, 23, 206, 158,
-			109, 128, 204, 11, 218, 60, 140, 20, 53, 234, 65, 75, 140, 176,
-			30, 52, 103, 183, 68, 39, 206, 18, 194, 106, 188, 225, 193, 178,
-			221, 234, 192, 16, 152, 235, 55, 88, 39, 226, 204, 243, 153, 152,
-			0, 44, 217, 242, 124, 55, 220, 199, 126, 69, 37, 182, 231, 197,
-			187, 44, 8, 241, 223, 160, 19, 19, 214, 10, 26, 222, 182, 39,
-			22, 71, 137, 185, 33, 103, 109, 30, 182, 188, 56, 230, 13, 214,
-			14, 131, 235, 94, 131, 55, 88, 188, 235, 198, 44, 222, 133, 209,
-			129, 6, 226, 249, 59, 172, 30, 248, 13, 15, 55, 17, 104, 68,
-			88, 139, 199, 23, 8, 97, 240, 223, 76, 79, 199, 34, 22, 108,
-			171, 30, 213, 131, 6, 103, 173, 78, 20, 179, 144, 199, 174, 231,
-			35, 86, 119, 43, 184, 14, 63, 73, 138, 17, 230, 7, 177, 87,
-			231, 37, 22, 239, 122, 17, 107, 122, 81, 12, 24, 210, 95, 244,
-			27, 61, 221, 105, 120, 81, 189, 233, 122, 45, 30, 150, 111, 212,
-			9, 207, 79, 211, 66, 117, 162, 29, 6, 141, 78, 157, 39, 253,
-			32, 73, 71, 190, 175, 126, 16, 38, 71, 215, 8, 234, 29, 88,
-			183, 174, 154, 164, 179, 65, 200, 130, 120, 151, 135, 172, 229, 198,
-			60, 244, 220, 102, 148, 144, 26, 39, 40, 222, 229, 132, 165, 123,
-			175, 7, 181, 204, 61, 108, 9, 136, 65, 184, 66, 135, 210, 188,
-			229, 7, 201, 111, 72, 119, 47, 142, 96, 68, 190, 64, 21, 132,
-			17, 107, 185, 251, 108, 139, 3, 167, 52, 88, 28, 48, 238, 55,
-			130, 48, 226, 192, 20, 237, 48, 104, 5, 49, 103, 130, 38, 113,
-			196, 26, 60, 244, 174, 243, 6, 219, 14, 131, 22, 17, 84, 136,
-			130, 237, 120, 15, 216, 68, 114, 16, 139, 218, 188, 14, 28, 196,
-			218, 161, 7, 140, 21, 2, 239, 248, 130, 139, 162, 8, 251, 78,
-			216, 250, 195, 213, 53, 182, 182, 242, 208, 250, 163, 243, 181, 10,
-			171, 174, 177, 213, 218, 202, 171, 170, 139, 149, 69, 118, 233, 42,
-			91, 127, 184, 194, 22, 86, 86, 175, 214, 170, 151, 31, 94, 103,
-			15, 175, 44, 45, 86, 106, 107, 108, 126, 121, 145, 45, 172, 44,
-			175, 215, 170, 151, 54, 214, 87, 106, 107, 132, 21, 231, 215, 88,
-			117, 173, 136, 191, 204, 47, 95, 101, 149, 87, 175, 214, 42, 107,
-			107, 108, 165, 198, 170, 87, 86, 151, 170, 149, 69, 246, 232, 124,
-			173, 54, 191, 188, 94, 173, 172, 149, 88, 117, 121, 97, 105, 99,
-			177, 186, 124, 185, 196, 46, 109, 172, 179, 229, 149, 117, 194, 150,
-			170, 87, 170, 235, 149, 69, 182, 190, 82, 194, 207, 30, 108, 199,
-			86, 30, 98, 87, 42, 181, 133, 135, 231, 151, 215, 231, 47, 85,
-			151, 170, 235, 87, 241, 131, 15, 85, 215, 151, 225, 99, 15, 173,
-			212, 8, 155, 103, 171, 243, 181, 245, 234, 194, 198, 210, 124, 141,
-			173, 110, 212, 86, 87, 214, 42, 12, 70, 182, 88, 93, 91, 88,
-			154, 175, 94, 169, 44, 150, 89, 117, 153, 45, 175, 176, 202, 171,
-			42, 203, 235, 108, 237, 225, 249, 165, 165, 238, 129, 18, 182, 242,
-			232, 114, 165, 6, 189, 79, 15, 147, 93, 170, 176, 165, 234, 252,
-			165, 165, 10, 124, 10, 199, 185, 88, 173, 85, 22, 214, 97, 64,
-			201, 95, 11, 213, 197, 202, 242, 250, 252, 82, 137, 176, 181, 213,
-			202, 66, 117, 126, 169, 196, 42, 175, 174, 92, 89, 93, 154, 175,
-			93, 45, 73, 164, 107, 149, 31, 218, 168, 44, 175, 87, 231, 151,
-			216, 226, 252, 149, 249, 203, 149, 53, 54, 245, 98, 84, 89, 173,
-			173, 44, 108, 212, 42, 87, 160, 215, 43, 15, 177, 181, 141, 75,
-			107, 235, 213, 245, 141, 245, 10, 187, 188, 178, 178, 136, 196, 94,
-			171, 212, 94, 85, 93, 168, 172, 93, 100, 75, 43, 107, 72, 176,
-			141, 181, 74, 137, 176, 197, 249, 245, 121, 252, 244, 106, 109, 229,
-			161, 234, 250, 218, 69, 248, 251, 210, 198, 90, 21, 9, 87, 93,
-			94, 175, 212, 106, 27, 120, 74, 51, 205, 30, 94, 121, 180, 242,
-			170, 74, 141, 45, 204, 111, 172, 85, 22, 145, 194, 43, 203, 48,
-			90, 224, 149, 202, 74, 237, 42, 160, 5, 58, 224, 12, 148, 216,
-			163, 15, 87, 214, 31, 174, 212, 128, 168, 72, 173, 121, 32, 3,
-			152, 116, 11, 235, 233, 106, 43, 53, 182, 190, 82, 91, 39, 169,
-			113, 178, 229, 202, 229, 165, 234, 229, 202, 242, 66, 5, 126, 94,
-			1, 52, 143, 86, 215, 42, 211, 108, 190, 86, 93, 131, 10, 85,
-			252, 48, 123, 116, 254, 42, 91, 217, 192, 81, 195, 68, 109, 172,
-			85, 136, 248, 59, 197, 186, 37, 156, 79, 86, 125, 136, 205, 47,
-			190, 170, 10, 61, 151, 181, 87, 87, 214, 214, 170, 146, 93, 144,
-			108, 11, 15, 75, 154, 151, 201, 220, 159, 154, 108, 190, 19, 239,
-			6, 225, 5, 118, 141, 251, 113, 224, 255, 147, 68, 176, 179, 169,
-			87, 98, 17, 123, 149, 27, 54, 220, 105, 194, 216, 37, 23, 86,
-			102, 224, 179, 32, 244, 118, 60, 223, 109, 30, 220, 128, 26, 60,
-			242, 118, 124, 182, 181, 79, 24, 91, 115, 253, 39, 220, 125, 118,
-			121, 151, 183, 220, 61, 55, 46, 177, 71, 248, 246, 54, 91, 228,
-			46, 136, 115, 191, 33, 36, 77, 132, 139, 112, 151, 51, 233, 27,
-			138, 132, 112, 242, 34, 6, 219, 54, 19, 251, 229, 150, 144, 130,
-			13, 190, 237, 249, 82, 192, 109, 7, 29, 191, 1, 117, 197, 142,
-			140, 181, 163, 50, 44, 128, 235, 110, 211, 107, 164, 139, 89, 221,
-			245, 65, 174, 196, 161, 235, 71, 77, 208, 48, 88, 195, 11, 121,
-			61, 110, 238, 131, 152, 113, 217, 33, 225, 76, 68, 75, 17, 215,
-			223, 151, 50, 209, 243, 197, 22, 10, 194, 114, 138, 151, 119, 202,
-			186, 78, 40, 212, 33, 16, 105, 204, 107, 181, 131, 48, 142, 166,
-			203, 132, 228, 136, 97, 82, 107, 186, 111, 2, 254, 202, 81, 235,
-			76, 223, 34, 233, 39, 102, 110, 64, 252, 41, 10, 75, 125, 37,
-			44, 52, 196, 159, 162, 112, 182, 239, 60, 22, 202, 63, 69, 97,
-			185, 239, 62, 44, 60, 45, 254, 20, 133, 103, 251, 78, 96, 225,
-			41, 241, 167, 40, 60, 215, 119, 28, 11, 143, 139, 63, 69, 225,
-			221, 125, 199, 200, 143, 18, 51, 215, 143, 127, 22, 98, 214, 27,
-			58, 38, 54, 158, 45, 206, 148, 63, 188, 1, 251, 17, 136, 81,
-			222, 96, 91, 188, 238, 194, 22, 30, 106, 197, 100, 118, 11, 216,
-			129, 48, 183, 185, 19, 132, 94, 188, 219, 138, 88, 35, 240, 39,
-			99, 182, 23, 132, 215, 88, 163, 3, 74, 59, 219, 10, 130, 56,
-			138, 67, 183, 221, 246, 252, 157, 50, 33, 79, 16, 211, 238, 163,
-			246, 203, 250, 46, 24, 133, 199, 112, 222, 149, 250, 192, 234, 65,
-			171, 237, 53, 121, 136, 211, 37, 142, 104, 14, 204, 205, 26, 143,
-			113, 203, 112, 61, 31, 176, 3, 83, 136, 190, 19, 193, 0, 204,
-			139, 89, 219, 13, 35, 96, 5, 66, 136, 101, 247, 25, 212, 122,
-			89, 238, 8, 25, 32, 182, 221, 103, 246, 81, 235, 126, 115, 138,
-			12, 18, 7, 0, 27, 32, 162, 160, 12, 181, 238, 31, 184, 67,
-			65, 6, 181, 238, 63, 126, 82, 65, 22, 181, 238, 191, 115, 146,
-			156, 37, 166, 109, 80, 251, 129, 190, 215, 24, 133, 147, 108, 81,
-			178, 102, 196, 92, 236, 123, 147, 199, 60, 205, 118, 178, 7, 134,
-			65, 173, 7, 114, 71, 201, 253, 196, 182, 13, 232, 193, 43, 204,
-			163, 197, 146, 96, 76, 216, 11, 75, 44, 228, 77, 52, 149, 128,
-			25, 195, 32, 136, 83, 74, 73, 28, 114, 46, 122, 104, 96, 127,
-			95, 97, 106, 200, 161, 214, 43, 6, 70, 21, 100, 80, 235, 21,
-			244, 86, 5, 89, 212, 122, 197, 145, 2, 153, 193, 79, 26, 212,
-			122, 208, 188, 163, 120, 59, 67, 150, 45, 110, 7, 65, 177, 132,
-			255, 148, 183, 220, 176, 88, 98, 60, 174, 151, 21, 86, 195, 134,
-			202, 26, 114, 168, 245, 160, 254, 6, 12, 228, 65, 122, 68, 65,
-			22, 181, 30, 60, 118, 59, 185, 27, 191, 97, 82, 235, 146, 121,
-			162, 48, 201, 150, 213, 238, 46, 167, 3, 23, 3, 176, 207, 126,
-			178, 168, 245, 215, 76, 27, 154, 105, 200, 161, 214, 37, 253, 53,
-			232, 246, 37, 122, 76, 65, 22, 181, 46, 29, 103, 228, 135, 240,
-			107, 22, 181, 22, 205, 169, 194, 34, 195, 72, 9, 241, 61, 96,
-			5, 17, 24, 152, 124, 84, 246, 65, 42, 59, 58, 12, 80, 232,
-			75, 168, 82, 233, 174, 88, 54, 224, 212, 144, 67, 173, 197, 129,
-			188, 130, 12, 106, 45, 142, 22, 21, 4, 95, 63, 61, 73, 158,
-			194, 174, 216, 212, 186, 108, 222, 89, 104, 245, 118, 101, 143, 187,
-			215, 110, 174, 35, 101, 194, 30, 10, 66, 169, 42, 205, 162, 194,
-			14, 146, 181, 229, 237, 132, 66, 212, 4, 126, 115, 191, 204, 22,
-			3, 208, 249, 64, 55, 210, 125, 182, 241, 227, 26, 114, 168, 117,
-			89, 247, 217, 54, 168, 117, 121, 148, 41, 200, 162, 214, 229, 147,
-			167, 201, 189, 216, 103, 135, 90, 143, 152, 165, 194, 52, 106, 251,
-			113, 208, 158, 69, 191, 76, 151, 116, 77, 203, 96, 253, 61, 199,
-			134, 134, 26, 202, 80, 235, 145, 129, 130, 130, 12, 106, 61, 114,
-			116, 82, 65, 22, 181, 30, 153, 57, 131, 171, 206, 48, 51, 212,
-			122, 165, 57, 43, 127, 202, 216, 0, 41, 36, 25, 248, 77, 174,
-			58, 195, 204, 24, 212, 122, 229, 241, 41, 5, 89, 212, 122, 229,
-			153, 146, 68, 146, 165, 214, 146, 89, 150, 63, 101, 109, 128, 20,
-			146, 108, 134, 90, 75, 3, 39, 20, 100, 80, 107, 169, 56, 173,
-			32, 139, 90, 75, 165, 89, 137, 36, 71, 173, 43, 26, 73, 206,
-			6, 72, 33, 201, 101, 168, 117, 101, 224, 184, 130, 12, 106, 93,
-			97, 10, 73, 206, 162, 214, 21, 141, 164, 159, 90, 43, 230, 73,
-			249, 83, 191, 13, 144, 66, 210, 159, 161, 214, 202, 128, 90, 134,
-			253, 6, 181, 86, 110, 83, 131, 235, 183, 168, 181, 114, 162, 72,
-			254, 135, 129, 88, 8, 181, 54, 204, 179, 133, 255, 110, 176, 117,
-			65, 104, 222, 108, 40, 209, 22, 49, 21, 156, 211, 181, 231, 184,
-			91, 176, 215, 0, 11, 233, 253, 55, 101, 187, 148, 9, 187, 26,
-			116, 80, 135, 142, 220, 109, 222, 220, 103, 33, 111, 129, 245, 130,
-			19, 201, 253, 216, 11, 185, 252, 140, 218, 182, 118, 221, 176, 5,
-			98, 52, 236, 248, 177, 215, 226, 132, 109, 119, 252, 186, 248, 176,
-			23, 239, 43, 86, 78, 182, 137, 136, 205, 206, 98, 81, 186, 87,
-			94, 196, 124, 206, 27, 168, 24, 52, 247, 113, 231, 151, 118, 34,
-			152, 22, 44, 14, 130, 102, 164, 89, 136, 216, 48, 108, 13, 101,
-			168, 181, 49, 160, 36, 10, 49, 168, 181, 81, 152, 81, 144, 69,
-			173, 141, 217, 50, 121, 45, 82, 107, 128, 90, 87, 205, 59, 10,
-			171, 184, 99, 136, 8, 81, 189, 232, 83, 2, 87, 252, 220, 105,
-			203, 101, 135, 254, 33, 180, 2, 89, 17, 171, 205, 21, 81, 241,
-			16, 192, 93, 69, 221, 173, 1, 27, 240, 107, 200, 161, 214, 85,
-			45, 136, 6, 12, 106, 93, 165, 19, 10, 178, 168, 117, 245, 232,
-			237, 100, 146, 152, 182, 73, 237, 215, 246, 253, 136, 81, 56, 218,
-			181, 21, 72, 61, 134, 129, 189, 47, 183, 0, 144, 101, 175, 205,
-			221, 134, 252, 99, 194, 22, 240, 152, 121, 20, 241, 153, 40, 212,
-			31, 147, 95, 54, 81, 168, 63, 38, 191, 108, 162, 80, 127, 76,
-			10, 117, 19, 133, 250, 99, 71, 10, 18, 137, 65, 173, 199, 205,
-			25, 249, 19, 72, 237, 199, 53, 18, 35, 67, 173, 199, 37, 39,
-			155, 40, 181, 31, 103, 167, 21, 100, 81, 235, 241, 169, 105, 137,
-			196, 164, 150, 43, 151, 131, 137, 194, 216, 213, 72, 96, 209, 186,
-			26, 9, 124, 206, 149, 203, 193, 68, 97, 236, 202, 229, 128, 64,
-			221, 60, 35, 127, 2, 49, 90, 215, 72, 172, 12, 181, 234, 82,
-			68, 152, 40, 70, 235, 71, 239, 84, 16, 180, 155, 158, 145, 72,
-			108, 106, 53, 164, 136, 48, 81, 174, 53, 52, 18, 59, 67, 173,
-			134, 20, 17, 38, 202, 181, 134, 20, 17, 38, 202, 181, 198, 153,
-			18, 25, 4, 36, 86, 31, 181, 183, 205, 107, 150, 248, 205, 2,
-			234, 109, 147, 9, 114, 148, 100, 0, 2, 178, 239, 216, 183, 23,
-			7, 193, 26, 109, 118, 34, 15, 133, 255, 48, 201, 138, 31, 109,
-			248, 117, 48, 129, 29, 106, 237, 12, 209, 4, 54, 168, 181, 51,
-			54, 145, 192, 22, 181, 118, 142, 30, 211, 200, 13, 106, 237, 218,
-			71, 139, 131, 172, 242, 228, 65, 228, 48, 61, 187, 41, 228, 176,
-			173, 238, 166, 144, 195, 20, 237, 142, 221, 154, 192, 22, 181, 118,
-			143, 20, 200, 144, 68, 110, 82, 235, 9, 251, 172, 254, 25, 136,
-			245, 68, 10, 29, 76, 213, 19, 67, 197, 4, 54, 168, 245, 196,
-			201, 153, 4, 182, 168, 245, 196, 108, 89, 82, 218, 161, 86, 83,
-			207, 57, 72, 244, 166, 166, 52, 72, 244, 166, 92, 142, 38, 74,
-			244, 102, 65, 205, 57, 72, 244, 166, 158, 243, 12, 181, 124, 243,
-			172, 252, 9, 36, 186, 175, 145, 128, 68, 247, 53, 227, 128, 68,
-			247, 153, 226, 83, 144, 232, 190, 238, 73, 150, 90, 109, 83, 177,
-			3, 72, 244, 182, 70, 2, 18, 189, 173, 123, 2, 18, 189, 93,
-			56, 161, 32, 139, 90, 237, 83, 167, 201, 135, 13, 156, 116, 131,
-			218, 29, 243, 73, 171, 240, 19, 6, 195, 184, 44, 16, 11, 202,
-			117, 197, 98, 119, 135, 137, 232, 169, 168, 204, 106, 135, 148, 162,
-			184, 132, 125, 85, 185, 29, 64, 124, 161, 144, 140, 88, 16, 50,
-			237, 21, 102, 24, 122, 166, 183, 240, 200, 109, 105, 131, 37, 133,
-			88, 86, 106, 185, 251, 232, 40, 98, 193, 117, 30, 54, 221, 182,
-			20, 51, 166, 5, 19, 221, 33, 183, 73, 174, 65, 101, 240, 250,
-			13, 88, 82, 168, 123, 215, 245, 52, 11, 133, 239, 186, 230, 26,
-			161, 242, 93, 215, 44, 41, 148, 190, 235, 154, 37, 81, 237, 219,
-			187, 1, 75, 10, 61, 111, 47, 133, 28, 88, 114, 47, 133, 28,
-			122, 186, 167, 89, 82, 104, 123, 123, 90, 248, 228, 168, 181, 111,
-			150, 228, 124, 192, 54, 186, 175, 103, 14, 182, 209, 253, 129, 9,
-			5, 25, 212, 218, 63, 50, 169, 32, 139, 90, 251, 51, 103, 200,
-			27, 112, 230, 96, 31, 125, 189, 121, 186, 208, 73, 232, 39, 118,
-			39, 116, 16, 149, 216, 222, 174, 87, 223, 61, 100, 126, 212, 244,
-			28, 54, 21, 96, 254, 237, 120, 215, 185, 47, 60, 80, 208, 88,
-			108, 74, 60, 225, 137, 192, 175, 43, 149, 198, 196, 237, 251, 245,
-			186, 243, 253, 14, 181, 94, 175, 197, 47, 108, 223, 175, 167, 138,
-			147, 97, 251, 126, 125, 241, 20, 25, 32, 32, 117, 156, 31, 237,
-			123, 179, 97, 160, 112, 7, 177, 246, 163, 185, 219, 201, 10, 177,
-			109, 203, 236, 163, 246, 63, 55, 204, 11, 133, 121, 97, 219, 128,
-			41, 18, 178, 40, 14, 66, 174, 54, 117, 180, 81, 26, 1, 143,
-			192, 84, 10, 121, 61, 216, 241, 189, 167, 56, 219, 229, 33, 47,
-			179, 53, 206, 181, 98, 58, 68, 28, 64, 104, 35, 70, 13, 102,
-			0, 28, 184, 67, 129, 6, 128, 199, 239, 82, 160, 5, 224, 189,
-			247, 147, 215, 64, 199, 28, 106, 191, 201, 48, 143, 20, 174, 176,
-			5, 140, 68, 139, 208, 178, 66, 53, 143, 179, 122, 39, 138, 131,
-			86, 210, 39, 63, 97, 118, 169, 196, 122, 81, 194, 226, 233, 126,
-			129, 176, 181, 156, 62, 64, 62, 52, 33, 62, 236, 64, 63, 222,
-			100, 12, 141, 42, 208, 4, 240, 150, 9, 114, 23, 1, 105, 158,
-			121, 139, 209, 247, 87, 134, 81, 56, 221, 181, 81, 38, 186, 136,
-			231, 39, 251, 102, 153, 144, 1, 98, 217, 96, 103, 189, 197, 200,
-			29, 35, 195, 196, 182, 109, 187, 143, 102, 222, 106, 152, 31, 50,
-			44, 252, 128, 13, 102, 157, 253, 86, 35, 59, 64, 214, 72, 198,
-			22, 150, 157, 253, 118, 195, 30, 47, 44, 176, 115, 160, 145, 232,
-			217, 6, 3, 150, 135, 97, 16, 70, 101, 194, 86, 194, 6, 152,
-			241, 17, 219, 227, 94, 40, 126, 219, 245, 96, 114, 188, 186, 219,
-			4, 35, 62, 10, 124, 208, 85, 70, 72, 86, 32, 53, 16, 235,
-			72, 82, 96, 66, 1, 29, 35, 195, 242, 179, 6, 181, 255, 127,
-			134, 61, 166, 43, 24, 162, 96, 56, 41, 48, 161, 96, 148, 146,
-			61, 217, 194, 164, 246, 211, 134, 61, 86, 216, 97, 203, 65, 204,
-			94, 227, 237, 188, 198, 221, 97, 220, 7, 13, 174, 81, 102, 108,
-			89, 30, 155, 105, 1, 21, 187, 215, 56, 59, 127, 142, 109, 237,
-			199, 60, 42, 51, 182, 17, 113, 150, 10, 56, 102, 222, 54, 97,
-			234, 172, 45, 173, 240, 52, 189, 107, 188, 185, 159, 26, 12, 244,
-			245, 233, 116, 215, 68, 87, 70, 169, 30, 140, 69, 237, 119, 26,
-			246, 184, 174, 0, 178, 245, 157, 233, 225, 91, 38, 20, 208, 49,
-			61, 24, 155, 218, 207, 254, 192, 6, 115, 215, 220, 205, 15, 6,
-			216, 227, 217, 244, 96, 64, 25, 123, 54, 61, 24, 135, 218, 239,
-			50, 236, 91, 116, 5, 199, 192, 130, 124, 82, 96, 66, 193, 216,
-			184, 110, 145, 161, 246, 115, 233, 22, 25, 3, 11, 146, 22, 25,
-			19, 10, 82, 45, 178, 212, 126, 183, 97, 83, 93, 33, 107, 96,
-			193, 80, 82, 96, 66, 65, 126, 84, 183, 200, 81, 251, 255, 159,
-			38, 113, 206, 192, 130, 132, 196, 57, 19, 10, 232, 24, 249, 188,
-			33, 155, 244, 83, 251, 167, 128, 179, 63, 99, 176, 117, 119, 103,
-			182, 193, 155, 94, 203, 3, 237, 86, 31, 120, 150, 9, 187, 28,
-			6, 157, 54, 170, 156, 192, 222, 201, 9, 60, 170, 187, 32, 63,
-			19, 165, 216, 243, 133, 202, 124, 87, 153, 61, 28, 236, 241, 235,
-			60, 44, 9, 55, 222, 93, 4, 12, 214, 38, 215, 39, 2, 17,
-			139, 118, 131, 78, 179, 193, 162, 216, 107, 54, 65, 136, 186, 91,
-			77, 244, 82, 160, 92, 67, 241, 187, 131, 31, 222, 67, 219, 2,
-			77, 2, 248, 34, 97, 113, 200, 221, 88, 254, 40, 229, 181, 27,
-			177, 142, 127, 205, 15, 246, 124, 89, 146, 154, 206, 126, 3, 7,
-			153, 76, 103, 191, 9, 5, 163, 99, 100, 86, 82, 129, 80, 251,
-			121, 195, 190, 181, 120, 59, 91, 226, 254, 78, 188, 123, 56, 29,
-			116, 123, 98, 96, 253, 100, 238, 136, 9, 5, 99, 183, 144, 147,
-			18, 225, 0, 181, 223, 7, 100, 29, 99, 203, 124, 15, 136, 114,
-			157, 135, 184, 211, 207, 165, 208, 12, 24, 88, 43, 233, 215, 128,
-			9, 5, 163, 137, 0, 24, 164, 246, 207, 164, 153, 102, 208, 192,
-			130, 100, 66, 7, 77, 40, 160, 9, 211, 12, 81, 251, 253, 105,
-			145, 49, 100, 96, 65, 194, 52, 67, 38, 20, 228, 19, 86, 30,
-			166, 246, 7, 12, 251, 54, 93, 97, 216, 192, 130, 209, 164, 192,
-			132, 130, 241, 91, 117, 139, 17, 106, 255, 108, 186, 197, 136, 129,
-			5, 73, 139, 17, 19, 10, 198, 111, 37, 147, 178, 69, 158, 218,
-			63, 103, 216, 183, 20, 111, 131, 53, 25, 117, 45, 101, 225, 184,
-			83, 45, 243, 6, 214, 76, 6, 152, 55, 161, 128, 142, 107, 84,
-			163, 212, 254, 224, 77, 161, 26, 53, 176, 102, 130, 106, 212, 132,
-			2, 164, 21, 8, 125, 131, 102, 126, 222, 48, 127, 81, 11, 125,
-			16, 174, 63, 111, 100, 7, 201, 12, 126, 9, 244, 39, 251, 127,
-			51, 236, 219, 10, 133, 27, 10, 125, 245, 49, 84, 151, 160, 50,
-			77, 10, 76, 40, 184, 69, 17, 13, 20, 38, 251, 23, 18, 162,
-			161, 14, 4, 5, 73, 11, 144, 229, 191, 144, 110, 97, 82, 251,
-			35, 233, 22, 128, 226, 35, 233, 22, 162, 198, 45, 183, 226, 158,
-			105, 67, 127, 127, 201, 48, 143, 138, 225, 224, 206, 254, 75, 106,
-			103, 183, 65, 191, 179, 127, 201, 24, 24, 85, 160, 1, 32, 189,
-			85, 129, 22, 128, 71, 10, 18, 147, 65, 237, 143, 26, 230, 49,
-			249, 163, 97, 35, 168, 48, 25, 14, 128, 3, 121, 5, 98, 229,
-			209, 219, 20, 104, 1, 88, 56, 42, 49, 153, 212, 254, 88, 210,
-			39, 144, 232, 31, 75, 48, 129, 76, 252, 88, 130, 9, 62, 251,
-			49, 99, 84, 245, 9, 54, 140, 143, 65, 159, 222, 107, 32, 42,
-			139, 218, 191, 12, 250, 198, 219, 13, 86, 221, 102, 250, 238, 20,
-			76, 77, 196, 99, 121, 86, 233, 115, 222, 80, 26, 93, 196, 227,
-			50, 131, 186, 91, 1, 30, 45, 122, 242, 220, 82, 181, 36, 40,
-			252, 147, 182, 218, 63, 237, 163, 154, 175, 111, 239, 148, 88, 250,
-			238, 15, 40, 238, 201, 221, 160, 178, 26, 139, 101, 99, 247, 52,
-			152, 1, 112, 96, 68, 129, 6, 128, 249, 113, 5, 226, 88, 110,
-			155, 32, 255, 187, 137, 67, 179, 169, 253, 235, 134, 201, 10, 31,
-			52, 209, 89, 167, 108, 124, 232, 45, 247, 59, 45, 236, 114, 36,
-			123, 233, 69, 93, 103, 160, 240, 55, 250, 2, 112, 164, 234, 23,
-			194, 240, 190, 72, 36, 78, 85, 93, 54, 89, 158, 44, 129, 98,
-			232, 69, 108, 187, 211, 108, 238, 207, 190, 174, 227, 54, 49, 28,
-			178, 204, 216, 74, 188, 203, 195, 61, 47, 226, 37, 182, 112, 230,
-			204, 44, 236, 134, 44, 170, 7, 109, 207, 223, 33, 44, 236, 52,
-			229, 46, 169, 206, 77, 183, 61, 121, 248, 139, 251, 193, 148, 87,
-			230, 101, 182, 237, 133, 145, 240, 29, 137, 107, 167, 162, 199, 74,
-			251, 130, 126, 147, 100, 84, 72, 116, 55, 172, 239, 242, 6, 140,
-			137, 251, 73, 61, 212, 105, 185, 31, 151, 88, 224, 51, 216, 114,
-			2, 44, 12, 131, 32, 38, 76, 135, 66, 79, 107, 170, 219, 130,
-			114, 26, 116, 0, 212, 76, 14, 219, 248, 175, 27, 84, 113, 159,
-			109, 1, 120, 199, 113, 242, 207, 144, 232, 14, 181, 127, 203, 48,
-			143, 23, 218, 72, 243, 68, 61, 125, 97, 58, 179, 45, 238, 249,
-			59, 76, 222, 198, 3, 242, 85, 129, 172, 4, 196, 67, 208, 188,
-			46, 118, 192, 196, 114, 112, 125, 159, 135, 176, 57, 105, 174, 211,
-			125, 119, 108, 236, 128, 6, 177, 63, 186, 239, 160, 80, 252, 150,
-			65, 11, 10, 180, 0, 188, 253, 14, 242, 180, 224, 152, 12, 181,
-			127, 219, 48, 79, 21, 222, 32, 56, 198, 239, 180, 120, 232, 213,
-			21, 163, 104, 71, 95, 151, 55, 47, 230, 79, 138, 131, 127, 30,
-			233, 83, 121, 57, 50, 84, 134, 164, 171, 120, 43, 8, 154, 220,
-			5, 58, 20, 227, 176, 195, 139, 192, 240, 69, 12, 190, 43, 202,
-			26, 34, 98, 170, 247, 59, 242, 254, 161, 248, 12, 252, 130, 54,
-			193, 20, 44, 70, 30, 213, 221, 182, 32, 141, 235, 239, 179, 61,
-			119, 127, 90, 125, 12, 84, 181, 30, 68, 11, 186, 190, 232, 150,
-			136, 27, 193, 154, 236, 21, 15, 176, 243, 115, 47, 67, 30, 146,
-			149, 52, 61, 51, 54, 210, 68, 131, 14, 128, 154, 158, 160, 110,
-			253, 182, 65, 143, 43, 208, 2, 176, 120, 146, 252, 11, 33, 92,
-			178, 212, 254, 180, 97, 158, 40, 92, 135, 85, 132, 34, 1, 12,
-			189, 72, 186, 35, 27, 28, 253, 129, 46, 195, 11, 148, 106, 134,
-			211, 39, 69, 251, 109, 62, 25, 177, 228, 34, 51, 17, 206, 119,
-			150, 118, 191, 122, 194, 127, 7, 186, 170, 160, 187, 27, 139, 22,
-			122, 8, 89, 27, 187, 161, 65, 7, 64, 45, 31, 65, 255, 251,
-			180, 49, 170, 196, 114, 214, 2, 240, 56, 35, 127, 47, 134, 144,
-			163, 246, 31, 192, 16, 254, 194, 96, 143, 172, 173, 44, 167, 56,
-			87, 245, 160, 140, 86, 35, 18, 85, 74, 77, 48, 120, 15, 156,
-			144, 149, 165, 36, 33, 176, 228, 67, 182, 235, 138, 170, 46, 43,
-			234, 27, 163, 69, 105, 217, 193, 50, 77, 240, 151, 228, 152, 240,
-			167, 201, 72, 124, 137, 176, 61, 169, 222, 129, 0, 41, 167, 165,
-			141, 23, 79, 130, 54, 217, 232, 212, 101, 168, 133, 136, 41, 1,
-			84, 147, 145, 232, 255, 214, 62, 144, 249, 58, 15, 99, 148, 70,
-			94, 12, 34, 161, 238, 182, 120, 115, 193, 141, 146, 181, 148, 179,
-			113, 240, 26, 116, 0, 212, 115, 15, 106, 240, 31, 36, 114, 32,
-			103, 1, 120, 7, 147, 91, 84, 63, 181, 63, 107, 152, 167, 228,
-			143, 253, 54, 130, 10, 83, 127, 6, 192, 1, 181, 187, 129, 38,
-			249, 89, 99, 66, 113, 81, 191, 5, 96, 241, 36, 249, 104, 63,
-			162, 34, 212, 254, 75, 195, 60, 93, 120, 127, 63, 146, 48, 236,
-			240, 68, 152, 184, 82, 47, 102, 69, 229, 125, 47, 150, 217, 163,
-			32, 253, 244, 47, 154, 77, 84, 13, 32, 17, 104, 187, 110, 253,
-			90, 196, 196, 186, 173, 115, 6, 234, 104, 216, 104, 242, 72, 158,
-			132, 65, 35, 233, 14, 22, 8, 123, 174, 224, 162, 40, 75, 250,
-			34, 26, 168, 205, 110, 139, 55, 3, 96, 224, 64, 115, 119, 28,
-			16, 22, 121, 59, 40, 49, 2, 22, 52, 27, 170, 123, 117, 105,
-			230, 227, 36, 235, 222, 32, 114, 140, 244, 71, 197, 168, 155, 219,
-			64, 48, 202, 53, 19, 49, 161, 157, 187, 64, 138, 98, 180, 239,
-			199, 187, 60, 246, 234, 69, 241, 123, 73, 134, 20, 29, 232, 159,
-			23, 71, 44, 10, 154, 24, 104, 133, 43, 103, 138, 187, 245, 93,
-			213, 37, 61, 68, 209, 104, 135, 199, 17, 182, 128, 15, 233, 79,
-			136, 47, 76, 151, 217, 154, 42, 145, 157, 138, 24, 127, 210, 139,
-			226, 228, 228, 76, 157, 68, 160, 91, 71, 116, 169, 33, 14, 198,
-			212, 53, 52, 148, 95, 243, 171, 213, 195, 144, 105, 253, 33, 108,
-			240, 16, 44, 135, 237, 24, 100, 127, 179, 201, 138, 33, 119, 155,
-			114, 164, 24, 145, 144, 222, 229, 133, 185, 82, 58, 48, 107, 202,
-			137, 83, 7, 99, 71, 124, 56, 226, 45, 215, 135, 17, 137, 152,
-			187, 18, 76, 20, 204, 129, 31, 248, 179, 33, 111, 115, 180, 201,
-			186, 241, 50, 183, 185, 231, 238, 203, 57, 210, 179, 166, 141, 51,
-			88, 81, 104, 127, 17, 16, 115, 30, 88, 117, 66, 232, 233, 79,
-			53, 120, 236, 122, 77, 224, 180, 189, 93, 174, 67, 176, 80, 50,
-			236, 133, 65, 204, 83, 252, 12, 59, 133, 31, 196, 120, 80, 226,
-			69, 42, 46, 162, 19, 241, 237, 78, 19, 153, 35, 12, 58, 126,
-			99, 54, 14, 61, 60, 175, 79, 157, 175, 139, 3, 22, 36, 75,
-			61, 240, 35, 47, 194, 32, 104, 182, 199, 9, 202, 225, 3, 99,
-			234, 157, 92, 230, 54, 163, 160, 196, 248, 117, 14, 83, 25, 116,
-			118, 118, 165, 182, 3, 115, 23, 242, 215, 117, 188, 144, 131, 221,
-			24, 28, 160, 195, 186, 92, 158, 28, 195, 185, 220, 102, 115, 95,
-			158, 171, 186, 126, 172, 163, 18, 226, 196, 217, 86, 119, 253, 73,
-			88, 147, 188, 217, 100, 222, 182, 246, 51, 121, 233, 179, 153, 32,
-			100, 174, 143, 154, 91, 137, 69, 1, 244, 4, 89, 67, 206, 132,
-			154, 79, 210, 59, 8, 96, 12, 52, 149, 231, 122, 248, 58, 82,
-			92, 8, 98, 88, 172, 145, 166, 187, 83, 74, 119, 111, 159, 185,
-			205, 144, 187, 141, 125, 61, 141, 36, 65, 130, 74, 224, 227, 221,
-			183, 197, 31, 215, 178, 147, 216, 40, 181, 52, 232, 0, 168, 53,
-			87, 48, 117, 255, 210, 200, 43, 137, 71, 44, 0, 139, 167, 72,
-			145, 128, 182, 149, 249, 178, 209, 247, 53, 195, 40, 140, 119, 185,
-			222, 212, 104, 6, 136, 101, 131, 30, 243, 101, 35, 119, 12, 133,
-			173, 3, 54, 202, 87, 148, 61, 224, 160, 141, 242, 21, 245, 105,
-			7, 109, 148, 175, 40, 177, 237, 160, 141, 242, 21, 101, 163, 56,
-			104, 163, 124, 69, 217, 40, 14, 24, 11, 95, 85, 98, 219, 65,
-			27, 229, 171, 9, 38, 35, 3, 160, 20, 219, 14, 218, 40, 95,
-			85, 98, 219, 65, 27, 229, 171, 32, 182, 39, 137, 105, 103, 104,
-			230, 111, 141, 190, 127, 109, 26, 133, 35, 233, 65, 248, 137, 230,
-			45, 71, 2, 26, 196, 223, 26, 57, 97, 217, 100, 96, 36, 223,
-			80, 35, 201, 224, 72, 190, 161, 190, 159, 193, 145, 124, 67, 141,
-			36, 131, 35, 249, 134, 26, 73, 6, 71, 242, 13, 53, 146, 12,
-			140, 228, 239, 12, 179, 44, 127, 132, 145, 252, 93, 130, 9, 70,
-			242, 119, 198, 192, 73, 5, 98, 229, 83, 211, 10, 180, 0, 44,
-			205, 74, 76, 38, 181, 191, 101, 152, 170, 46, 152, 21, 223, 74,
-			48, 129, 206, 248, 45, 99, 64, 117, 2, 62, 251, 45, 227, 182,
-			59, 20, 104, 1, 120, 162, 72, 62, 2, 10, 102, 198, 234, 163,
-			153, 55, 154, 230, 155, 77, 171, 240, 83, 230, 33, 71, 31, 74,
-			225, 20, 94, 180, 212, 33, 133, 116, 171, 29, 118, 240, 193, 253,
-			56, 244, 122, 78, 57, 128, 200, 135, 30, 113, 244, 156, 112, 176,
-			101, 16, 53, 50, 28, 87, 172, 216, 134, 23, 197, 158, 95, 143,
-			133, 230, 240, 130, 185, 89, 196, 39, 221, 24, 183, 83, 104, 173,
-			14, 68, 88, 212, 169, 239, 170, 159, 80, 96, 185, 237, 118, 24,
-			180, 67, 207, 141, 197, 25, 183, 212, 150, 177, 199, 242, 148, 219,
-			243, 227, 187, 230, 8, 107, 4, 45, 215, 243, 229, 50, 202, 88,
-			48, 199, 111, 52, 201, 81, 114, 140, 100, 0, 4, 6, 249, 23,
-			230, 129, 243, 23, 176, 221, 51, 226, 72, 16, 126, 30, 76, 10,
-			28, 40, 24, 162, 73, 129, 1, 5, 99, 19, 73, 129, 5, 5,
-			71, 143, 233, 47, 24, 212, 126, 147, 137, 135, 48, 135, 125, 1,
-			24, 233, 77, 233, 47, 128, 225, 254, 166, 244, 23, 12, 68, 48,
-			118, 107, 82, 96, 65, 193, 145, 130, 56, 253, 202, 0, 79, 188,
-			205, 52, 207, 29, 126, 250, 117, 67, 22, 232, 254, 161, 151, 21,
-			8, 232, 116, 184, 182, 228, 239, 189, 236, 192, 64, 127, 118, 69,
-			116, 202, 13, 89, 131, 164, 120, 67, 48, 48, 24, 224, 111, 51,
-			53, 183, 131, 1, 254, 54, 115, 224, 152, 2, 13, 0, 111, 63,
-			163, 64, 28, 89, 249, 44, 105, 226, 56, 109, 106, 191, 195, 52,
-			79, 21, 30, 75, 62, 151, 244, 240, 134, 39, 70, 33, 23, 26,
-			237, 161, 135, 66, 228, 176, 83, 33, 241, 113, 91, 124, 78, 131,
-			14, 128, 90, 86, 128, 209, 250, 14, 83, 26, 42, 25, 52, 90,
-			223, 97, 22, 79, 146, 243, 196, 180, 179, 52, 243, 227, 102, 223,
-			187, 205, 222, 248, 48, 209, 79, 117, 214, 33, 68, 151, 148, 90,
-			96, 52, 252, 184, 153, 19, 202, 110, 22, 152, 242, 25, 83, 74,
-			173, 44, 50, 225, 51, 170, 39, 89, 100, 193, 103, 84, 79, 178,
-			200, 128, 207, 152, 82, 106, 101, 145, 253, 158, 49, 165, 212, 202,
-			2, 243, 61, 107, 74, 31, 81, 22, 153, 237, 217, 4, 19, 176,
-			218, 179, 166, 180, 92, 178, 200, 104, 207, 154, 210, 71, 148, 69,
-			54, 123, 214, 148, 62, 162, 44, 72, 173, 231, 76, 115, 74, 254,
-			8, 147, 241, 92, 130, 9, 253, 230, 230, 128, 234, 49, 124, 246,
-			57, 243, 216, 73, 5, 90, 0, 222, 57, 73, 78, 17, 211, 206,
-			209, 204, 79, 152, 125, 255, 198, 52, 10, 183, 118, 81, 71, 94,
-			78, 151, 4, 1, 99, 224, 39, 204, 220, 29, 248, 241, 28, 16,
-			228, 189, 138, 32, 57, 36, 200, 123, 213, 199, 115, 72, 144, 247,
-			42, 130, 228, 144, 32, 239, 85, 4, 201, 33, 65, 222, 171, 8,
-			146, 131, 158, 253, 164, 105, 150, 228, 143, 64, 144, 159, 76, 48,
-			129, 24, 255, 73, 115, 128, 41, 16, 43, 159, 152, 84, 160, 5,
-			224, 204, 25, 137, 201, 164, 246, 243, 166, 121, 167, 252, 17, 8,
-			242, 124, 130, 9, 8, 242, 188, 57, 112, 68, 129, 6, 128, 133,
-			19, 10, 180, 0, 60, 117, 154, 156, 35, 166, 221, 79, 51, 63,
-			99, 246, 253, 91, 211, 40, 20, 123, 98, 72, 226, 221, 160, 33,
-			44, 218, 110, 226, 128, 125, 243, 51, 102, 238, 118, 236, 72, 63,
-			16, 231, 253, 138, 56, 253, 72, 156, 247, 171, 142, 244, 35, 113,
-			222, 175, 136, 211, 143, 196, 121, 191, 34, 78, 63, 18, 231, 253,
-			64, 156, 231, 12, 68, 101, 80, 251, 131, 166, 121, 162, 240, 175,
-			12, 86, 245, 49, 246, 210, 111, 168, 48, 76, 244, 171, 224, 42,
-			67, 131, 153, 71, 194, 73, 116, 168, 71, 101, 207, 221, 103, 110,
-			68, 216, 161, 233, 149, 180, 147, 165, 196, 182, 58, 177, 186, 233,
-			176, 13, 58, 107, 112, 48, 118, 70, 116, 20, 38, 234, 131, 201,
-			168, 128, 115, 63, 152, 140, 202, 192, 126, 211, 99, 10, 180, 0,
-			60, 206, 36, 125, 76, 106, 127, 200, 52, 139, 242, 71, 152, 168,
-			15, 37, 152, 76, 7, 64, 141, 9, 40, 240, 33, 147, 222, 174,
-			64, 11, 64, 118, 66, 98, 178, 168, 253, 243, 166, 121, 90, 254,
-			8, 178, 236, 231, 19, 76, 32, 203, 126, 222, 28, 152, 80, 160,
-			1, 224, 17, 166, 64, 108, 123, 242, 20, 169, 32, 38, 155, 218,
-			191, 96, 154, 247, 21, 238, 99, 85, 117, 253, 46, 2, 77, 85,
-			88, 111, 76, 228, 6, 1, 99, 69, 100, 219, 80, 229, 42, 62,
-			90, 125, 211, 22, 120, 52, 232, 0, 40, 181, 194, 126, 20, 82,
-			191, 96, 230, 85, 23, 64, 72, 253, 2, 116, 65, 130, 57, 0,
-			79, 223, 171, 192, 44, 128, 231, 238, 145, 29, 116, 168, 253, 139,
-			135, 117, 80, 100, 39, 57, 216, 65, 89, 222, 219, 65, 199, 70,
-			60, 26, 68, 180, 186, 131, 160, 118, 254, 98, 210, 65, 199, 2,
-			80, 119, 208, 201, 1, 168, 59, 232, 100, 1, 60, 119, 15, 249,
-			200, 48, 49, 109, 66, 51, 127, 104, 246, 253, 158, 101, 204, 45,
-			179, 7, 190, 255, 255, 8, 147, 119, 78, 201, 220, 127, 30, 98,
-			21, 48, 94, 117, 228, 91, 18, 17, 41, 110, 2, 193, 230, 177,
-			235, 94, 215, 54, 85, 84, 100, 110, 44, 238, 192, 165, 87, 6,
-			97, 79, 160, 61, 161, 111, 169, 69, 169, 205, 73, 152, 4, 120,
-			221, 40, 14, 96, 19, 82, 166, 107, 131, 69, 77, 111, 103, 55,
-			110, 238, 179, 134, 183, 189, 205, 67, 238, 199, 176, 79, 129, 41,
-			234, 238, 43, 111, 22, 219, 245, 192, 198, 223, 70, 75, 172, 33,
-			85, 174, 150, 235, 123, 237, 78, 19, 141, 67, 237, 46, 82, 19,
-			2, 218, 153, 138, 0, 0, 68, 135, 71, 0, 184, 209, 129, 8,
-			0, 206, 102, 36, 105, 82, 184, 228, 40, 83, 117, 213, 142, 187,
-			207, 113, 215, 149, 94, 4, 17, 136, 141, 86, 164, 7, 235, 61,
-			10, 122, 172, 52, 84, 18, 48, 52, 34, 241, 112, 42, 101, 163,
-			85, 102, 172, 234, 71, 49, 119, 27, 194, 0, 198, 0, 10, 248,
-			1, 93, 148, 202, 166, 243, 187, 58, 153, 120, 176, 235, 110, 179,
-			201, 27, 236, 176, 139, 192, 229, 180, 195, 15, 229, 15, 206, 167,
-			22, 95, 242, 220, 161, 30, 6, 81, 132, 174, 129, 131, 36, 96,
-			143, 114, 225, 21, 23, 166, 157, 198, 22, 7, 172, 29, 136, 89,
-			16, 222, 185, 20, 141, 246, 208, 139, 206, 217, 86, 199, 107, 54,
-			152, 155, 114, 101, 148, 128, 84, 130, 59, 218, 129, 231, 199, 248,
-			81, 156, 195, 72, 116, 109, 139, 115, 159, 8, 186, 137, 243, 220,
-			40, 192, 58, 41, 236, 32, 140, 145, 236, 48, 213, 250, 222, 170,
-			62, 118, 71, 94, 233, 158, 109, 121, 213, 170, 190, 27, 68, 28,
-			93, 62, 226, 162, 88, 116, 129, 176, 25, 180, 242, 85, 69, 209,
-			51, 244, 16, 170, 80, 26, 180, 23, 116, 220, 6, 76, 113, 147,
-			131, 90, 222, 148, 151, 245, 88, 16, 18, 198, 88, 16, 238, 184,
-			190, 247, 148, 188, 191, 23, 132, 226, 88, 238, 201, 54, 15, 61,
-			60, 98, 110, 170, 111, 148, 144, 144, 50, 248, 71, 118, 249, 158,
-			115, 231, 206, 157, 3, 44, 241, 110, 136, 222, 130, 251, 225, 63,
-			229, 204, 151, 39, 15, 251, 65, 71, 220, 19, 139, 58, 161, 92,
-			8, 80, 212, 208, 161, 210, 98, 26, 152, 156, 89, 68, 141, 189,
-			208, 162, 75, 246, 160, 252, 2, 163, 222, 146, 113, 229, 209, 174,
-			36, 63, 14, 95, 132, 154, 99, 188, 171, 198, 6, 95, 242, 124,
-			21, 222, 29, 163, 53, 18, 123, 160, 153, 242, 217, 150, 235, 201,
-			89, 221, 234, 108, 207, 238, 52, 131, 45, 183, 57, 171, 103, 112,
-			54, 228, 59, 94, 20, 135, 251, 169, 235, 55, 56, 248, 64, 105,
-			168, 169, 80, 52, 29, 208, 182, 230, 181, 218, 205, 125, 117, 65,
-			15, 134, 142, 23, 229, 158, 224, 245, 88, 168, 186, 120, 73, 5,
-			240, 172, 108, 65, 161, 119, 157, 207, 46, 176, 118, 179, 179, 227,
-			249, 211, 56, 148, 174, 38, 123, 124, 43, 242, 98, 206, 166, 188,
-			109, 230, 94, 119, 189, 166, 187, 213, 228, 211, 50, 228, 55, 228,
-			147, 17, 243, 3, 64, 134, 39, 120, 64, 246, 39, 219, 77, 148,
-			71, 193, 30, 146, 29, 214, 154, 143, 191, 72, 202, 183, 202, 108,
-			35, 234, 160, 199, 6, 126, 71, 246, 193, 198, 129, 143, 180, 234,
-			29, 82, 25, 3, 152, 69, 140, 18, 24, 24, 252, 192, 60, 201,
-			75, 162, 128, 40, 240, 83, 52, 193, 110, 137, 233, 221, 218, 103,
-			237, 78, 28, 75, 239, 149, 20, 23, 81, 103, 107, 182, 43, 150,
-			9, 79, 49, 196, 138, 80, 203, 59, 18, 183, 90, 88, 176, 45,
-			216, 14, 175, 71, 70, 146, 105, 221, 86, 187, 201, 97, 113, 176,
-			239, 246, 50, 44, 32, 17, 165, 39, 3, 37, 166, 228, 73, 160,
-			23, 177, 184, 19, 130, 176, 237, 196, 114, 43, 16, 242, 3, 100,
-			2, 76, 135, 210, 253, 52, 35, 70, 60, 102, 157, 182, 228, 12,
-			183, 19, 7, 45, 55, 246, 234, 72, 97, 55, 194, 139, 87, 210,
-			235, 175, 120, 68, 40, 141, 196, 160, 246, 31, 154, 185, 49, 17,
-			123, 78, 64, 107, 252, 156, 105, 158, 44, 252, 55, 131, 173, 241,
-			88, 184, 19, 31, 113, 175, 187, 76, 102, 39, 2, 105, 21, 130,
-			226, 225, 70, 17, 143, 82, 251, 147, 60, 2, 240, 34, 229, 21,
-			148, 29, 35, 172, 221, 116, 235, 184, 7, 94, 218, 87, 231, 76,
-			165, 84, 120, 182, 66, 12, 75, 55, 226, 13, 161, 253, 41, 63,
-			65, 176, 29, 131, 132, 243, 252, 148, 105, 175, 93, 103, 93, 237,
-			181, 159, 205, 15, 194, 22, 14, 27, 79, 81, 5, 91, 108, 185,
-			245, 107, 123, 110, 216, 136, 148, 229, 47, 53, 86, 161, 128, 16,
-			212, 142, 63, 167, 244, 17, 130, 218, 241, 231, 148, 246, 71, 80,
-			59, 254, 156, 73, 239, 80, 160, 5, 224, 137, 34, 249, 138, 137,
-			36, 51, 168, 253, 121, 211, 60, 83, 248, 188, 201, 22, 2, 63,
-			14, 131, 230, 193, 211, 199, 189, 208, 109, 183, 121, 40, 72, 137,
-			196, 75, 147, 78, 94, 127, 45, 247, 196, 171, 187, 177, 172, 138,
-			164, 84, 30, 72, 185, 213, 247, 52, 152, 4, 132, 113, 162, 83,
-			79, 77, 43, 75, 1, 20, 238, 61, 14, 8, 34, 244, 67, 39,
-			183, 58, 82, 187, 132, 216, 247, 245, 182, 217, 221, 147, 234, 54,
-			59, 36, 11, 156, 116, 227, 128, 36, 80, 71, 193, 176, 245, 224,
-			169, 37, 186, 154, 21, 143, 168, 195, 33, 210, 117, 241, 78, 241,
-			173, 60, 115, 246, 252, 200, 107, 200, 189, 86, 236, 26, 138, 100,
-			152, 173, 78, 32, 211, 243, 5, 122, 255, 231, 147, 249, 2, 189,
-			255, 243, 201, 124, 25, 56, 35, 244, 78, 5, 90, 0, 78, 207,
-			144, 159, 181, 112, 190, 76, 106, 127, 201, 52, 47, 22, 158, 179,
-			96, 100, 34, 77, 153, 26, 129, 102, 119, 212, 159, 228, 20, 225,
-			205, 243, 102, 51, 117, 142, 192, 34, 222, 118, 241, 207, 50, 80,
-			70, 220, 48, 19, 242, 0, 116, 196, 132, 196, 82, 178, 148, 164,
-			67, 25, 183, 104, 185, 112, 15, 37, 57, 145, 206, 116, 182, 190,
-			219, 193, 195, 108, 208, 167, 212, 121, 60, 104, 28, 126, 16, 207,
-			28, 78, 54, 69, 47, 164, 148, 56, 112, 199, 152, 216, 195, 82,
-			254, 149, 89, 18, 224, 117, 160, 173, 248, 146, 10, 236, 34, 41,
-			70, 141, 131, 46, 246, 123, 49, 190, 75, 179, 29, 185, 41, 190,
-			147, 119, 138, 196, 188, 129, 53, 244, 165, 100, 146, 193, 246, 248,
-			146, 50, 18, 8, 154, 100, 95, 50, 243, 39, 21, 104, 1, 120,
-			231, 148, 2, 115, 0, 78, 95, 80, 96, 22, 192, 187, 239, 39,
-			211, 200, 1, 22, 181, 255, 202, 52, 43, 133, 163, 66, 225, 147,
-			130, 177, 17, 112, 60, 115, 216, 21, 161, 72, 162, 37, 88, 115,
-			127, 149, 116, 194, 114, 0, 212, 157, 0, 107, 238, 175, 204, 252,
-			172, 2, 17, 241, 185, 57, 5, 230, 0, 188, 107, 17, 131, 130,
-			0, 196, 159, 239, 94, 32, 255, 93, 8, 14, 155, 218, 95, 55,
-			205, 87, 20, 254, 79, 83, 30, 92, 235, 147, 190, 20, 39, 206,
-			189, 48, 43, 106, 61, 159, 160, 58, 180, 39, 188, 223, 79, 214,
-			185, 24, 19, 232, 150, 120, 84, 228, 130, 218, 29, 243, 86, 27,
-			117, 164, 150, 43, 44, 12, 185, 51, 184, 120, 8, 181, 177, 254,
-			208, 236, 203, 8, 158, 213, 179, 136, 191, 174, 131, 39, 132, 104,
-			123, 139, 152, 1, 121, 50, 72, 152, 76, 241, 150, 186, 239, 41,
-			58, 213, 8, 180, 174, 92, 38, 9, 131, 185, 169, 248, 97, 169,
-			202, 9, 230, 114, 235, 208, 205, 40, 249, 120, 247, 183, 35, 117,
-			22, 37, 231, 103, 215, 133, 170, 140, 111, 111, 131, 74, 34, 7,
-			151, 104, 156, 248, 237, 38, 104, 41, 242, 206, 146, 158, 67, 91,
-			16, 90, 131, 14, 128, 122, 14, 193, 28, 254, 186, 153, 87, 210,
-			2, 204, 225, 175, 155, 211, 103, 20, 152, 3, 176, 244, 128, 2,
-			179, 0, 222, 247, 114, 178, 4, 51, 104, 247, 209, 204, 223, 153,
-			230, 255, 52, 173, 194, 203, 153, 206, 81, 162, 5, 159, 60, 67,
-			59, 236, 202, 172, 178, 208, 34, 239, 41, 221, 79, 140, 92, 254,
-			59, 51, 59, 70, 22, 129, 95, 68, 228, 242, 223, 155, 246, 112,
-			241, 110, 141, 60, 185, 77, 138, 205, 1, 161, 52, 165, 74, 32,
-			88, 60, 183, 169, 180, 106, 225, 75, 38, 50, 84, 249, 239, 77,
-			187, 63, 41, 48, 161, 96, 112, 136, 92, 150, 223, 49, 168, 253,
-			15, 166, 77, 11, 25, 113, 225, 179, 120, 22, 163, 111, 147, 60,
-			35, 43, 237, 8, 24, 65, 135, 128, 74, 185, 36, 22, 186, 10,
-			214, 36, 50, 198, 249, 31, 76, 25, 176, 72, 100, 140, 243, 63,
-			152, 249, 81, 253, 41, 147, 218, 223, 54, 237, 91, 138, 247, 165,
-			135, 212, 0, 195, 1, 56, 76, 114, 214, 146, 23, 115, 157, 166,
-			162, 103, 74, 21, 102, 232, 244, 183, 77, 25, 198, 73, 100, 204,
-			242, 183, 205, 177, 113, 116, 202, 16, 144, 22, 223, 49, 205, 139,
-			114, 222, 28, 27, 65, 197, 2, 78, 6, 64, 121, 196, 68, 208,
-			225, 240, 29, 115, 226, 148, 2, 45, 0, 39, 149, 44, 113, 114,
-			0, 106, 89, 226, 100, 1, 188, 251, 126, 242, 121, 177, 138, 51,
-			212, 126, 179, 101, 22, 11, 127, 96, 38, 26, 211, 229, 160, 71,
-			95, 138, 226, 16, 115, 83, 124, 55, 250, 82, 117, 155, 5, 34,
-			167, 73, 233, 0, 82, 185, 121, 166, 83, 93, 116, 231, 244, 64,
-			125, 116, 22, 99, 70, 182, 220, 136, 167, 117, 17, 173, 112, 225,
-			81, 41, 107, 187, 241, 110, 137, 121, 219, 58, 155, 71, 89, 52,
-			77, 197, 122, 164, 91, 69, 177, 27, 11, 38, 56, 168, 43, 72,
-			52, 120, 232, 114, 56, 150, 222, 190, 116, 53, 86, 119, 15, 181,
-			180, 208, 43, 56, 99, 35, 145, 53, 232, 0, 168, 247, 251, 140,
-			1, 160, 244, 243, 17, 12, 15, 122, 179, 117, 252, 4, 249, 67,
-			27, 39, 40, 75, 237, 103, 45, 243, 98, 225, 63, 218, 108, 77,
-			4, 45, 203, 204, 167, 106, 43, 142, 186, 61, 45, 158, 47, 118,
-			241, 166, 235, 239, 116, 220, 29, 254, 32, 99, 69, 153, 9, 181,
-			168, 155, 136, 248, 67, 60, 215, 85, 169, 68, 64, 84, 250, 251,
-			176, 30, 99, 175, 14, 170, 58, 171, 173, 46, 176, 104, 63, 138,
-			209, 103, 177, 142, 7, 189, 97, 250, 75, 120, 243, 153, 19, 134,
-			26, 105, 183, 152, 143, 14, 116, 131, 77, 233, 180, 3, 13, 145,
-			202, 197, 109, 74, 139, 45, 154, 46, 19, 118, 185, 119, 80, 123,
-			92, 186, 79, 208, 40, 186, 230, 249, 232, 53, 86, 234, 135, 252,
-			18, 8, 214, 36, 0, 28, 79, 250, 220, 176, 185, 175, 66, 158,
-			209, 219, 211, 155, 141, 137, 28, 242, 49, 65, 141, 61, 17, 1,
-			32, 66, 40, 82, 161, 230, 158, 207, 182, 221, 235, 1, 134, 78,
-			137, 149, 46, 59, 78, 132, 105, 222, 189, 157, 165, 41, 42, 204,
-			208, 27, 147, 52, 228, 219, 65, 200, 75, 68, 138, 36, 29, 199,
-			22, 48, 140, 122, 43, 51, 182, 130, 151, 103, 27, 92, 26, 238,
-			194, 6, 143, 96, 255, 56, 192, 6, 34, 166, 157, 160, 249, 234,
-			213, 189, 24, 140, 8, 60, 181, 231, 45, 17, 126, 208, 73, 54,
-			149, 172, 141, 108, 165, 65, 7, 64, 189, 169, 100, 13, 0, 181,
-			118, 146, 181, 0, 212, 218, 73, 54, 7, 160, 150, 40, 89, 228,
-			208, 187, 239, 151, 146, 43, 71, 237, 119, 89, 166, 218, 113, 114,
-			54, 130, 234, 59, 57, 7, 64, 253, 157, 156, 1, 96, 254, 180,
-			2, 45, 0, 167, 102, 20, 136, 168, 206, 188, 92, 129, 89, 0,
-			239, 189, 40, 191, 211, 79, 237, 231, 44, 45, 33, 251, 109, 4,
-			213, 119, 250, 29, 0, 245, 119, 250, 13, 0, 245, 120, 250, 45,
-			0, 245, 120, 250, 115, 0, 234, 241, 244, 103, 1, 212, 227, 33,
-			212, 126, 183, 101, 170, 78, 16, 27, 65, 245, 29, 226, 0, 168,
-			191, 3, 230, 232, 187, 173, 188, 146, 196, 196, 2, 112, 114, 90,
-			129, 57, 0, 103, 84, 151, 73, 22, 192, 123, 46, 144, 175, 9,
-			219, 117, 128, 218, 63, 101, 153, 115, 133, 47, 24, 172, 26, 117,
-			37, 10, 81, 140, 248, 32, 97, 226, 201, 31, 96, 193, 64, 200,
-			175, 216, 13, 119, 120, 12, 82, 55, 222, 14, 194, 150, 12, 180,
-			130, 189, 155, 183, 188, 24, 234, 39, 23, 38, 180, 207, 150, 8,
-			101, 255, 58, 15, 247, 81, 95, 76, 43, 176, 232, 208, 242, 98,
-			45, 164, 213, 150, 221, 220, 103, 222, 142, 31, 132, 188, 113, 81,
-			85, 135, 246, 132, 53, 185, 27, 197, 233, 96, 51, 188, 46, 161,
-			118, 114, 252, 146, 26, 130, 80, 195, 154, 41, 211, 117, 192, 198,
-			81, 107, 208, 1, 80, 211, 115, 192, 0, 48, 95, 80, 160, 5,
-			224, 237, 199, 21, 152, 3, 144, 157, 87, 96, 22, 192, 51, 231,
-			200, 143, 34, 57, 7, 169, 253, 62, 203, 188, 175, 240, 58, 38,
-			178, 53, 71, 42, 120, 8, 15, 159, 48, 117, 179, 54, 93, 101,
-			146, 144, 195, 242, 180, 72, 175, 42, 58, 1, 57, 134, 148, 137,
-			220, 42, 59, 7, 244, 37, 64, 182, 112, 230, 140, 30, 218, 160,
-			141, 61, 208, 160, 3, 160, 30, 218, 160, 1, 160, 60, 37, 32,
-			230, 160, 5, 224, 73, 181, 18, 6, 115, 0, 222, 121, 175, 2,
-			179, 0, 158, 191, 135, 188, 83, 176, 202, 16, 181, 127, 206, 50,
-			167, 11, 111, 76, 185, 57, 2, 229, 9, 99, 117, 105, 8, 137,
-			140, 208, 82, 112, 120, 8, 163, 3, 15, 45, 33, 48, 118, 83,
-			77, 200, 97, 3, 234, 217, 227, 203, 66, 98, 137, 216, 38, 37,
-			171, 244, 112, 135, 108, 236, 148, 6, 29, 0, 245, 38, 55, 100,
-			0, 72, 213, 10, 28, 178, 0, 188, 115, 138, 84, 113, 60, 195,
-			212, 254, 176, 101, 78, 21, 46, 50, 157, 87, 26, 201, 121, 160,
-			79, 23, 213, 103, 35, 21, 159, 45, 55, 118, 221, 141, 97, 27,
-			113, 105, 208, 1, 80, 119, 99, 216, 0, 144, 22, 21, 104, 1,
-			120, 122, 82, 132, 54, 19, 115, 132, 218, 191, 104, 137, 208, 230,
-			196, 219, 195, 214, 246, 188, 237, 56, 189, 183, 225, 210, 192, 27,
-			95, 7, 253, 64, 160, 247, 45, 168, 32, 81, 12, 250, 8, 57,
-			168, 68, 192, 250, 147, 229, 73, 161, 235, 119, 252, 6, 15, 163,
-			122, 16, 114, 157, 64, 78, 196, 151, 4, 106, 210, 84, 204, 119,
-			116, 54, 218, 111, 109, 5, 205, 136, 40, 99, 83, 70, 110, 198,
-			137, 105, 17, 137, 201, 21, 234, 79, 73, 132, 100, 97, 23, 181,
-			23, 95, 198, 221, 138, 99, 7, 242, 66, 159, 209, 95, 81, 4,
-			28, 177, 145, 38, 26, 116, 0, 212, 244, 28, 49, 0, 212, 190,
-			165, 17, 11, 192, 19, 39, 49, 204, 157, 152, 121, 106, 127, 12,
-			166, 181, 157, 112, 105, 123, 183, 125, 179, 220, 9, 85, 15, 48,
-			0, 57, 132, 43, 23, 229, 60, 121, 17, 3, 11, 113, 95, 247,
-			61, 111, 99, 7, 52, 232, 0, 168, 251, 158, 55, 0, 212, 188,
-			144, 183, 0, 60, 61, 73, 126, 77, 172, 177, 81, 106, 127, 194,
-			50, 79, 23, 62, 98, 160, 57, 145, 162, 55, 58, 18, 68, 230,
-			65, 157, 246, 13, 89, 54, 216, 62, 188, 211, 186, 139, 36, 233,
-			227, 129, 89, 212, 63, 117, 43, 172, 168, 110, 166, 195, 152, 97,
-			97, 0, 51, 196, 60, 108, 37, 185, 130, 116, 39, 244, 224, 71,
-			109, 28, 128, 6, 29, 0, 245, 224, 71, 13, 0, 169, 18, 165,
-			163, 22, 128, 197, 83, 228, 63, 137, 193, 83, 106, 255, 154, 101,
-			150, 11, 191, 242, 125, 12, 94, 229, 145, 215, 84, 32, 7, 103,
-			234, 69, 169, 144, 120, 220, 210, 132, 32, 154, 18, 55, 65, 8,
-			106, 227, 96, 52, 232, 0, 168, 9, 65, 13, 0, 169, 210, 5,
-			168, 5, 224, 153, 89, 242, 155, 130, 16, 99, 212, 254, 45, 144,
-			8, 31, 125, 49, 66, 168, 249, 10, 182, 89, 216, 217, 218, 255,
-			62, 152, 64, 70, 91, 126, 79, 108, 128, 159, 238, 21, 137, 99,
-			54, 14, 66, 131, 14, 128, 154, 0, 99, 6, 128, 122, 9, 143,
-			89, 0, 158, 56, 73, 94, 143, 227, 31, 167, 246, 255, 97, 153,
-			23, 10, 254, 247, 116, 235, 155, 232, 35, 137, 238, 132, 140, 106,
-			223, 45, 174, 168, 179, 102, 117, 70, 145, 190, 34, 78, 204, 113,
-			27, 63, 175, 193, 12, 128, 3, 170, 171, 227, 6, 128, 242, 138,
-			56, 49, 199, 45, 0, 239, 189, 159, 252, 75, 131, 88, 54, 104,
-			102, 159, 178, 204, 35, 133, 167, 190, 239, 59, 226, 223, 251, 40,
-			80, 131, 116, 250, 160, 39, 242, 66, 57, 193, 11, 229, 159, 178,
-			228, 133, 114, 130, 23, 202, 63, 101, 221, 50, 33, 142, 51, 250,
-			169, 253, 187, 150, 57, 44, 26, 246, 247, 1, 52, 48, 36, 106,
-			246, 67, 195, 52, 104, 74, 112, 144, 152, 246, 0, 205, 124, 198,
-			234, 251, 113, 219, 64, 52, 160, 54, 125, 198, 202, 221, 74, 254,
-			216, 33, 182, 61, 96, 246, 81, 251, 11, 150, 249, 138, 194, 111,
-			59, 32, 136, 209, 16, 72, 29, 102, 37, 113, 246, 231, 149, 7,
-			3, 106, 165, 111, 190, 110, 119, 221, 82, 82, 142, 173, 212, 174,
-			131, 53, 244, 49, 197, 44, 232, 140, 110, 236, 109, 121, 152, 109,
-			71, 187, 183, 122, 176, 19, 137, 190, 204, 240, 120, 76, 94, 201,
-			77, 14, 56, 61, 161, 83, 37, 105, 234, 196, 61, 246, 11, 140,
-			85, 227, 201, 136, 53, 121, 20, 17, 198, 183, 183, 189, 186, 135,
-			247, 182, 118, 65, 163, 227, 123, 60, 100, 219, 220, 141, 59, 33,
-			143, 132, 203, 26, 166, 18, 182, 90, 212, 100, 49, 64, 185, 209,
-			147, 154, 79, 199, 207, 43, 135, 46, 127, 210, 197, 36, 122, 93,
-			199, 199, 76, 87, 127, 40, 8, 216, 63, 21, 233, 65, 229, 186,
-			189, 193, 43, 17, 236, 1, 164, 246, 69, 81, 55, 197, 99, 119,
-			195, 4, 180, 220, 39, 241, 151, 31, 233, 142, 49, 229, 169, 211,
-			126, 208, 217, 197, 97, 57, 144, 65, 117, 79, 132, 102, 95, 76,
-			17, 52, 146, 145, 127, 88, 53, 61, 85, 4, 111, 48, 117, 115,
-			55, 90, 165, 24, 249, 164, 198, 13, 70, 45, 26, 168, 74, 7,
-			190, 40, 114, 170, 161, 46, 161, 34, 26, 182, 100, 252, 105, 36,
-			206, 3, 34, 180, 20, 122, 99, 255, 241, 147, 151, 116, 116, 184,
-			180, 9, 212, 241, 157, 16, 106, 34, 226, 36, 222, 195, 51, 224,
-			56, 244, 234, 58, 167, 45, 206, 62, 247, 183, 131, 176, 46, 237,
-			249, 248, 176, 68, 122, 82, 64, 12, 224, 201, 215, 23, 148, 128,
-			24, 192, 147, 175, 47, 40, 29, 123, 0, 93, 135, 95, 176, 242,
-			147, 10, 180, 0, 156, 57, 163, 192, 28, 128, 210, 55, 58, 96,
-			246, 101, 1, 188, 239, 229, 228, 47, 12, 92, 52, 6, 181, 255,
-			220, 50, 31, 42, 252, 23, 131, 45, 138, 115, 34, 161, 193, 164,
-			60, 15, 210, 11, 164, 30, 248, 96, 197, 70, 234, 32, 161, 200,
-			212, 35, 31, 42, 126, 179, 238, 250, 152, 22, 118, 187, 233, 213,
-			99, 117, 61, 82, 120, 148, 21, 38, 21, 167, 161, 110, 111, 161,
-			20, 114, 49, 217, 20, 107, 129, 194, 169, 147, 167, 73, 69, 72,
-			174, 91, 238, 70, 30, 15, 47, 50, 159, 239, 73, 39, 132, 88,
-			76, 238, 245, 192, 83, 236, 34, 207, 84, 82, 157, 44, 106, 58,
-			26, 54, 142, 86, 131, 14, 128, 154, 142, 6, 210, 34, 127, 86,
-			129, 22, 128, 115, 119, 41, 48, 7, 224, 221, 21, 5, 102, 1,
-			124, 112, 145, 252, 181, 160, 163, 73, 237, 191, 177, 204, 243, 133,
-			255, 154, 152, 181, 138, 189, 95, 50, 203, 54, 181, 134, 190, 75,
-			115, 86, 90, 179, 228, 166, 205, 217, 20, 219, 139, 241, 155, 54,
-			14, 88, 131, 14, 128, 154, 148, 192, 86, 127, 163, 44, 218, 1,
-			60, 247, 249, 27, 235, 246, 59, 20, 152, 3, 240, 248, 57, 5,
-			102, 1, 156, 57, 43, 132, 122, 63, 181, 191, 98, 153, 99, 184,
-			55, 12, 192, 222, 240, 21, 107, 96, 80, 212, 196, 189, 33, 13,
-			154, 18, 20, 117, 241, 199, 97, 217, 133, 126, 163, 7, 52, 37,
-			40, 234, 34, 52, 74, 229, 143, 166, 209, 13, 170, 95, 255, 77,
-			6, 231, 214, 162, 246, 191, 178, 205, 59, 10, 79, 103, 64, 147,
-			209, 55, 116, 212, 252, 138, 149, 223, 125, 154, 159, 210, 15, 221,
-			54, 198, 229, 239, 11, 81, 36, 231, 141, 64, 185, 186, 198, 165,
-			174, 43, 233, 18, 33, 134, 25, 148, 188, 252, 149, 124, 127, 125,
-			191, 205, 75, 12, 211, 226, 195, 159, 175, 128, 242, 77, 177, 162,
-			30, 96, 231, 47, 146, 68, 105, 105, 164, 175, 88, 53, 131, 224,
-			90, 132, 169, 55, 20, 58, 217, 225, 43, 110, 27, 131, 59, 241,
-			177, 28, 37, 225, 211, 82, 94, 61, 172, 211, 45, 215, 147, 26,
-			110, 147, 201, 110, 177, 107, 124, 95, 118, 226, 64, 21, 221, 97,
-			105, 152, 61, 192, 230, 100, 181, 31, 17, 255, 104, 161, 218, 221,
-			161, 158, 209, 17, 86, 237, 201, 106, 129, 193, 116, 187, 65, 16,
-			9, 65, 154, 114, 83, 136, 121, 81, 221, 127, 0, 85, 0, 189,
-			66, 182, 58, 49, 106, 212, 204, 101, 190, 200, 80, 2, 115, 227,
-			117, 45, 67, 237, 199, 141, 3, 182, 11, 26, 3, 252, 118, 141,
-			239, 139, 139, 237, 50, 16, 95, 16, 60, 117, 176, 54, 191, 90,
-			69, 237, 10, 239, 58, 28, 200, 193, 129, 199, 104, 42, 232, 6,
-			115, 171, 186, 17, 97, 222, 118, 114, 23, 83, 172, 192, 195, 239,
-			151, 225, 213, 140, 149, 245, 202, 5, 149, 65, 82, 58, 59, 181,
-			42, 221, 147, 65, 151, 205, 139, 16, 4, 165, 246, 32, 87, 137,
-			140, 123, 68, 217, 198, 226, 154, 170, 68, 32, 101, 168, 10, 14,
-			243, 90, 93, 110, 85, 225, 253, 150, 22, 138, 218, 152, 100, 52,
-			91, 178, 65, 89, 54, 46, 17, 13, 58, 0, 106, 105, 96, 25,
-			0, 230, 143, 40, 16, 215, 211, 177, 219, 201, 41, 185, 222, 223,
-			106, 155, 67, 197, 219, 240, 12, 188, 233, 197, 160, 93, 200, 51,
-			178, 173, 38, 39, 114, 193, 90, 80, 77, 175, 124, 192, 152, 6,
-			77, 9, 30, 151, 24, 223, 6, 24, 41, 98, 244, 93, 63, 216,
-			116, 163, 77, 192, 172, 144, 217, 80, 67, 183, 182, 141, 110, 208,
-			148, 224, 10, 46, 126, 155, 218, 239, 176, 127, 112, 9, 161, 6,
-			196, 229, 132, 132, 86, 118, 6, 192, 1, 37, 27, 241, 114, 130,
-			125, 92, 237, 58, 120, 57, 193, 150, 9, 161, 6, 28, 106, 191,
-			211, 126, 105, 18, 66, 13, 128, 254, 254, 78, 91, 234, 239, 3,
-			168, 191, 191, 211, 150, 250, 251, 0, 234, 239, 239, 180, 111, 153,
-			64, 53, 124, 144, 102, 158, 181, 251, 190, 37, 213, 240, 65, 131,
-			218, 207, 218, 185, 113, 242, 51, 38, 177, 237, 65, 80, 195, 223,
-			99, 155, 229, 194, 51, 38, 82, 12, 159, 174, 74, 152, 85, 157,
-			177, 97, 0, 215, 153, 51, 189, 231, 230, 82, 95, 119, 147, 0,
-			90, 114, 131, 11, 245, 50, 100, 115, 215, 245, 129, 252, 58, 158,
-			104, 15, 152, 185, 204, 180, 45, 163, 142, 41, 136, 38, 204, 22,
-			111, 6, 123, 74, 243, 232, 182, 71, 247, 121, 156, 44, 223, 36,
-			224, 33, 104, 115, 245, 238, 1, 244, 6, 246, 78, 206, 102, 103,
-			89, 20, 132, 225, 126, 137, 237, 241, 201, 102, 147, 161, 132, 15,
-			196, 197, 167, 6, 199, 203, 145, 24, 232, 218, 1, 21, 93, 157,
-			212, 156, 16, 179, 62, 136, 26, 221, 123, 20, 19, 12, 98, 86,
-			176, 247, 216, 242, 54, 199, 32, 106, 116, 239, 177, 101, 158, 142,
-			65, 212, 232, 222, 99, 31, 41, 40, 48, 7, 224, 209, 89, 5,
-			102, 1, 188, 179, 132, 73, 87, 6, 237, 62, 154, 249, 9, 219,
-			124, 159, 45, 146, 174, 12, 226, 121, 245, 79, 216, 89, 88, 27,
-			25, 0, 97, 126, 126, 210, 182, 71, 10, 35, 218, 79, 209, 194,
-			236, 163, 120, 70, 59, 40, 143, 162, 127, 210, 182, 83, 5, 38,
-			20, 12, 13, 99, 136, 196, 160, 56, 138, 126, 222, 150, 7, 200,
-			131, 242, 68, 249, 121, 219, 206, 37, 5, 38, 20, 12, 12, 234,
-			22, 38, 181, 127, 218, 150, 105, 118, 6, 229, 193, 240, 79, 219,
-			242, 96, 120, 80, 30, 12, 255, 180, 61, 54, 78, 62, 39, 184,
-			200, 160, 246, 7, 109, 243, 104, 225, 119, 76, 185, 238, 240, 254,
-			179, 156, 46, 121, 102, 47, 163, 130, 196, 197, 87, 37, 60, 219,
-			161, 215, 194, 103, 110, 148, 62, 136, 1, 166, 40, 74, 152, 43,
-			44, 36, 109, 76, 29, 96, 45, 49, 223, 96, 217, 148, 89, 205,
-			149, 59, 189, 235, 107, 236, 96, 123, 236, 133, 158, 138, 78, 196,
-			76, 132, 42, 227, 73, 18, 89, 196, 5, 3, 149, 210, 87, 218,
-			220, 48, 116, 247, 209, 37, 34, 178, 107, 225, 30, 160, 131, 111,
-			155, 189, 57, 145, 182, 154, 193, 86, 153, 85, 213, 77, 243, 146,
-			16, 207, 234, 200, 11, 36, 115, 44, 242, 149, 227, 101, 114, 60,
-			69, 147, 1, 104, 168, 10, 203, 227, 59, 65, 180, 84, 230, 30,
-			193, 49, 120, 33, 35, 97, 62, 188, 144, 161, 164, 245, 160, 184,
-			144, 97, 231, 21, 243, 225, 133, 12, 96, 190, 247, 56, 56, 49,
-			38, 181, 255, 157, 109, 222, 85, 120, 155, 131, 19, 35, 94, 142,
-			211, 209, 57, 210, 81, 195, 147, 152, 196, 53, 84, 71, 4, 133,
-			180, 59, 75, 198, 138, 7, 50, 11, 130, 188, 205, 158, 222, 149,
-			192, 234, 214, 175, 133, 224, 224, 161, 221, 189, 119, 179, 45, 92,
-			89, 49, 223, 9, 221, 38, 210, 126, 219, 123, 82, 165, 67, 33,
-			108, 202, 243, 227, 123, 239, 46, 177, 142, 252, 55, 146, 255, 98,
-			37, 44, 144, 127, 77, 151, 25, 155, 79, 165, 176, 83, 3, 209,
-			207, 185, 17, 145, 145, 72, 242, 7, 78, 88, 122, 60, 34, 218,
-			70, 217, 60, 72, 245, 136, 53, 3, 145, 49, 0, 244, 102, 15,
-			35, 105, 68, 208, 15, 240, 235, 174, 219, 6, 49, 178, 39, 242,
-			17, 52, 65, 221, 72, 18, 69, 200, 12, 12, 210, 43, 204, 182,
-			155, 129, 80, 187, 69, 64, 121, 242, 217, 50, 97, 107, 40, 208,
-			246, 225, 87, 253, 214, 156, 182, 6, 228, 32, 208, 44, 237, 178,
-			227, 120, 35, 221, 121, 121, 119, 129, 104, 37, 33, 245, 91, 81,
-			132, 158, 22, 85, 206, 27, 12, 78, 224, 187, 238, 117, 47, 8,
-			83, 247, 42, 80, 112, 136, 185, 34, 76, 63, 137, 135, 87, 63,
-			187, 244, 31, 157, 45, 59, 22, 206, 136, 46, 153, 171, 239, 0,
-			7, 114, 178, 211, 167, 230, 34, 160, 78, 132, 213, 186, 13, 244,
-			249, 139, 112, 232, 157, 32, 216, 41, 183, 220, 120, 183, 92, 5,
-			62, 208, 106, 200, 32, 26, 37, 255, 46, 97, 108, 51, 3, 160,
-			116, 1, 14, 162, 204, 249, 119, 54, 157, 80, 160, 5, 224, 209,
-			99, 10, 204, 1, 120, 251, 156, 2, 179, 0, 78, 157, 151, 82,
-			213, 160, 153, 143, 219, 230, 175, 107, 169, 10, 139, 228, 227, 118,
-			118, 8, 147, 102, 13, 138, 84, 86, 191, 108, 219, 180, 112, 155,
-			116, 160, 166, 78, 185, 197, 93, 40, 33, 232, 68, 30, 171, 95,
-			78, 132, 167, 200, 99, 245, 203, 118, 126, 148, 76, 75, 84, 6,
-			181, 127, 5, 80, 29, 65, 84, 7, 120, 46, 74, 33, 51, 68,
-			221, 4, 25, 8, 222, 95, 73, 35, 51, 169, 253, 107, 135, 34,
-			75, 34, 140, 85, 91, 248, 240, 175, 165, 145, 137, 198, 249, 81,
-			242, 71, 72, 4, 32, 216, 159, 217, 230, 153, 194, 39, 135, 85,
-			140, 70, 234, 242, 196, 150, 54, 65, 154, 238, 83, 94, 115, 255,
-			65, 198, 150, 220, 167, 246, 213, 145, 162, 62, 81, 148, 26, 200,
-			44, 144, 69, 165, 106, 21, 215, 2, 90, 220, 245, 101, 238, 139,
-			61, 21, 92, 39, 226, 74, 83, 150, 22, 222, 231, 193, 157, 94,
-			124, 173, 36, 36, 136, 135, 89, 128, 100, 189, 201, 40, 201, 142,
-			131, 66, 81, 94, 39, 149, 253, 219, 234, 196, 74, 17, 22, 138,
-			156, 136, 38, 17, 114, 89, 120, 235, 36, 223, 119, 97, 149, 242,
-			181, 30, 139, 136, 248, 4, 31, 118, 86, 166, 126, 194, 44, 77,
-			194, 35, 162, 220, 111, 50, 70, 26, 134, 239, 226, 221, 31, 24,
-			109, 143, 141, 224, 134, 156, 109, 135, 156, 11, 111, 59, 90, 54,
-			58, 23, 4, 106, 68, 132, 113, 119, 135, 135, 96, 229, 55, 129,
-			170, 234, 106, 78, 119, 166, 18, 125, 13, 71, 235, 123, 234, 206,
-			140, 14, 244, 35, 122, 239, 232, 114, 242, 131, 161, 20, 117, 118,
-			118, 120, 164, 210, 143, 116, 121, 164, 92, 124, 99, 4, 84, 39,
-			143, 139, 164, 61, 46, 218, 82, 128, 167, 171, 63, 93, 217, 106,
-			48, 83, 103, 16, 74, 183, 104, 106, 105, 111, 5, 193, 181, 107,
-			156, 139, 252, 87, 193, 117, 30, 238, 194, 92, 196, 251, 109, 105,
-			61, 203, 124, 228, 93, 209, 108, 222, 1, 1, 162, 66, 65, 153,
-			43, 130, 13, 227, 212, 11, 2, 126, 204, 195, 109, 121, 94, 227,
-			250, 93, 231, 20, 65, 3, 12, 90, 183, 217, 84, 49, 176, 152,
-			138, 5, 29, 169, 44, 228, 45, 55, 117, 23, 178, 204, 216, 67,
-			157, 16, 166, 1, 244, 6, 96, 181, 144, 187, 141, 217, 200, 221,
-			230, 58, 189, 58, 73, 125, 204, 75, 247, 39, 245, 104, 130, 232,
-			240, 69, 140, 187, 137, 85, 68, 158, 250, 24, 96, 67, 97, 12,
-			99, 23, 238, 46, 125, 109, 66, 124, 16, 217, 185, 222, 9, 197,
-			125, 50, 220, 115, 154, 34, 37, 73, 55, 66, 96, 122, 207, 239,
-			112, 34, 238, 157, 96, 78, 14, 198, 85, 246, 96, 201, 150, 101,
-			210, 117, 215, 191, 215, 90, 61, 104, 91, 227, 147, 222, 58, 197,
-			135, 210, 170, 196, 245, 33, 130, 91, 217, 83, 251, 221, 23, 52,
-			68, 84, 188, 23, 149, 112, 72, 192, 22, 213, 168, 42, 214, 173,
-			247, 20, 111, 76, 77, 43, 69, 171, 107, 117, 19, 252, 118, 200,
-			227, 78, 40, 25, 18, 147, 141, 72, 59, 185, 123, 41, 238, 186,
-			17, 195, 55, 160, 112, 9, 116, 245, 44, 229, 182, 247, 57, 12,
-			216, 13, 247, 245, 101, 132, 64, 5, 186, 29, 130, 19, 45, 7,
-			121, 153, 44, 16, 49, 126, 98, 125, 123, 190, 72, 56, 35, 183,
-			41, 124, 226, 11, 179, 83, 1, 97, 74, 32, 231, 185, 171, 53,
-			194, 118, 39, 108, 7, 34, 250, 2, 8, 67, 212, 202, 0, 117,
-			195, 239, 221, 27, 165, 195, 20, 201, 29, 189, 32, 189, 137, 246,
-			97, 235, 236, 45, 177, 124, 79, 192, 139, 211, 20, 87, 71, 9,
-			169, 104, 172, 212, 220, 40, 105, 217, 221, 13, 68, 45, 95, 255,
-			154, 17, 55, 24, 102, 100, 55, 60, 124, 72, 174, 171, 43, 232,
-			106, 156, 193, 128, 229, 25, 242, 66, 213, 186, 101, 147, 146, 103,
-			34, 131, 77, 151, 3, 109, 23, 116, 225, 45, 206, 125, 73, 113,
-			116, 231, 99, 163, 185, 115, 115, 231, 75, 130, 195, 228, 106, 103,
-			245, 32, 12, 121, 61, 246, 1, 45, 126, 60, 82, 220, 36, 226,
-			146, 241, 114, 171, 124, 189, 38, 77, 125, 100, 10, 224, 176, 38,
-			202, 154, 128, 213, 67, 55, 218, 21, 23, 99, 65, 141, 20, 138,
-			25, 152, 168, 226, 253, 161, 52, 54, 47, 130, 157, 202, 79, 109,
-			59, 172, 211, 14, 252, 100, 69, 177, 245, 149, 197, 149, 169, 173,
-			179, 115, 231, 207, 223, 127, 238, 222, 243, 231, 239, 154, 190, 192,
-			100, 64, 142, 120, 206, 72, 219, 23, 56, 22, 157, 63, 85, 232,
-			26, 150, 141, 27, 171, 6, 29, 0, 181, 62, 110, 25, 0, 202,
-			204, 134, 131, 232, 61, 249, 51, 251, 54, 165, 197, 88, 57, 0,
-			143, 204, 40, 48, 11, 224, 169, 105, 242, 17, 3, 183, 108, 155,
-			218, 95, 180, 205, 251, 10, 255, 198, 96, 61, 207, 55, 127, 119,
-			4, 149, 241, 65, 42, 246, 174, 251, 38, 33, 134, 203, 32, 78,
-			212, 164, 197, 103, 234, 250, 117, 137, 118, 24, 236, 122, 91, 210,
-			38, 11, 66, 181, 134, 92, 95, 152, 211, 42, 105, 179, 24, 128,
-			45, 122, 172, 65, 7, 64, 77, 11, 219, 0, 80, 230, 202, 25,
-			68, 239, 200, 23, 237, 226, 41, 5, 230, 0, 148, 151, 142, 7,
-			49, 12, 252, 139, 246, 185, 123, 200, 23, 5, 45, 28, 106, 255,
-			181, 109, 158, 47, 252, 73, 58, 242, 12, 182, 169, 151, 204, 65,
-			175, 142, 70, 162, 239, 205, 61, 47, 211, 58, 222, 84, 180, 89,
-			23, 67, 57, 54, 14, 85, 131, 56, 114, 77, 68, 199, 0, 48,
-			175, 220, 9, 142, 5, 160, 116, 206, 15, 98, 32, 245, 95, 219,
-			210, 57, 63, 136, 129, 212, 127, 109, 207, 156, 37, 15, 34, 13,
-			51, 212, 254, 138, 109, 150, 10, 231, 191, 251, 55, 111, 4, 190,
-			140, 141, 24, 52, 232, 0, 168, 187, 150, 49, 0, 212, 188, 158,
-			177, 0, 188, 237, 136, 2, 115, 0, 22, 206, 40, 48, 11, 224,
-			233, 25, 116, 212, 13, 130, 194, 254, 245, 31, 160, 163, 110, 16,
-			131, 71, 191, 158, 116, 53, 155, 1, 112, 64, 209, 41, 107, 0,
-			40, 29, 117, 131, 24, 60, 250, 117, 229, 168, 27, 116, 168, 253,
-			205, 151, 200, 81, 55, 232, 244, 1, 114, 233, 168, 27, 68, 71,
-			221, 55, 149, 163, 110, 16, 29, 117, 223, 180, 111, 153, 32, 199,
-			160, 31, 253, 212, 254, 123, 219, 28, 42, 142, 200, 183, 100, 26,
-			236, 9, 212, 187, 5, 166, 254, 62, 248, 89, 58, 62, 7, 241,
-			116, 37, 13, 154, 18, 28, 36, 166, 61, 68, 51, 223, 182, 251,
-			222, 232, 8, 151, 223, 144, 65, 237, 111, 219, 185, 113, 164, 252,
-			16, 216, 62, 223, 249, 1, 82, 126, 8, 189, 99, 223, 81, 148,
-			31, 66, 239, 216, 119, 20, 229, 135, 208, 130, 250, 142, 162, 252,
-			16, 122, 199, 190, 163, 40, 63, 228, 80, 251, 13, 206, 75, 67,
-			249, 33, 160, 252, 27, 28, 73, 249, 33, 164, 252, 27, 28, 73,
-			249, 33, 164, 252, 27, 28, 233, 34, 29, 166, 153, 55, 57, 125,
-			239, 150, 244, 26, 54, 168, 253, 38, 39, 55, 70, 30, 39, 182,
-			61, 12, 244, 122, 139, 99, 178, 66, 77, 196, 41, 116, 7, 219,
-			168, 176, 5, 124, 144, 157, 181, 196, 195, 107, 137, 59, 84, 60,
-			126, 129, 143, 116, 197, 201, 77, 30, 162, 242, 139, 98, 95, 134,
-			145, 128, 111, 113, 76, 13, 58, 0, 202, 85, 54, 140, 4, 124,
-			139, 147, 63, 170, 64, 11, 192, 59, 142, 147, 255, 102, 96, 247,
-			12, 106, 191, 195, 49, 207, 23, 254, 40, 145, 147, 50, 107, 208,
-			75, 120, 142, 41, 174, 222, 189, 180, 82, 18, 143, 243, 53, 141,
-			12, 27, 199, 169, 65, 7, 64, 77, 35, 3, 169, 32, 133, 228,
-			48, 122, 193, 222, 225, 72, 33, 57, 140, 135, 193, 239, 112, 164,
-			144, 28, 198, 195, 224, 119, 56, 51, 103, 241, 252, 97, 184, 159,
-			218, 79, 59, 47, 112, 254, 48, 12, 235, 238, 105, 71, 46, 180,
-			97, 92, 119, 105, 208, 148, 224, 10, 206, 134, 73, 237, 103, 156,
-			31, 220, 226, 26, 70, 39, 201, 51, 201, 184, 65, 164, 63, 227,
-			12, 168, 145, 193, 236, 63, 227, 200, 197, 53, 140, 78, 146, 103,
-			28, 185, 184, 134, 29, 106, 63, 247, 18, 45, 174, 97, 88, 92,
-			207, 169, 197, 53, 140, 139, 235, 57, 181, 184, 134, 113, 113, 61,
-			167, 22, 215, 8, 205, 188, 199, 233, 251, 89, 185, 184, 70, 12,
-			106, 191, 199, 201, 77, 144, 111, 0, 251, 142, 192, 234, 122, 30,
-			216, 247, 139, 61, 236, 43, 12, 227, 151, 156, 137, 197, 119, 94,
-			234, 243, 248, 84, 122, 47, 57, 177, 35, 184, 232, 159, 87, 19,
-			59, 130, 139, 254, 121, 197, 208, 35, 184, 232, 159, 87, 12, 61,
-			130, 139, 254, 121, 197, 208, 35, 120, 166, 240, 188, 98, 232, 17,
-			60, 83, 120, 30, 24, 122, 5, 73, 106, 80, 251, 125, 63, 64,
-			30, 28, 193, 181, 247, 190, 164, 171, 70, 6, 192, 1, 213, 25,
-			3, 191, 39, 121, 112, 4, 215, 222, 251, 20, 15, 142, 56, 212,
-			254, 192, 75, 196, 131, 35, 192, 131, 31, 80, 60, 56, 130, 60,
-			248, 1, 197, 131, 35, 200, 131, 31, 80, 60, 152, 167, 153, 15,
-			58, 125, 255, 94, 242, 96, 222, 160, 246, 7, 157, 220, 173, 228,
-			79, 44, 98, 219, 121, 224, 193, 143, 58, 230, 92, 58, 26, 36,
-			185, 81, 252, 18, 50, 160, 252, 200, 75, 205, 125, 234, 46, 78,
-			153, 204, 125, 209, 64, 103, 194, 5, 38, 242, 69, 233, 252, 31,
-			231, 117, 226, 143, 187, 230, 84, 174, 169, 36, 185, 191, 126, 228,
-			92, 171, 142, 181, 213, 5, 194, 24, 219, 14, 221, 22, 223, 11,
-			194, 107, 101, 198, 30, 229, 204, 109, 7, 205, 96, 7, 56, 9,
-			223, 122, 9, 220, 176, 33, 13, 237, 40, 245, 40, 73, 192, 130,
-			78, 24, 241, 230, 117, 30, 201, 83, 126, 198, 246, 184, 184, 90,
-			165, 146, 118, 10, 95, 21, 94, 18, 194, 116, 188, 91, 120, 37,
-			9, 170, 53, 120, 221, 147, 222, 38, 117, 182, 167, 94, 197, 5,
-			68, 242, 97, 92, 201, 186, 121, 92, 101, 31, 85, 172, 155, 199,
-			85, 246, 81, 181, 202, 242, 184, 202, 62, 170, 86, 89, 30, 87,
-			217, 71, 29, 121, 149, 35, 143, 171, 236, 163, 142, 188, 202, 145,
-			199, 85, 246, 81, 231, 204, 57, 92, 101, 121, 88, 101, 31, 255,
-			1, 174, 178, 60, 174, 178, 143, 39, 93, 133, 85, 246, 113, 181,
-			202, 242, 184, 202, 62, 174, 86, 89, 30, 87, 217, 199, 213, 42,
-			203, 59, 212, 254, 196, 75, 180, 202, 242, 176, 202, 62, 161, 86,
-			89, 30, 87, 217, 39, 212, 42, 203, 227, 42, 251, 132, 90, 101,
-			163, 52, 243, 171, 78, 223, 159, 200, 85, 54, 106, 80, 251, 87,
-			157, 220, 45, 228, 143, 96, 149, 141, 194, 42, 251, 36, 172, 178,
-			255, 146, 142, 185, 66, 151, 222, 75, 28, 114, 5, 223, 120, 233,
-			35, 174, 228, 189, 221, 255, 175, 45, 177, 81, 92, 98, 159, 84,
-			124, 59, 138, 75, 236, 147, 106, 137, 141, 226, 18, 251, 164, 90,
-			98, 163, 184, 196, 62, 169, 150, 216, 40, 46, 177, 79, 170, 37,
-			54, 138, 75, 236, 147, 176, 196, 190, 12, 202, 193, 168, 221, 71,
-			51, 159, 114, 204, 255, 236, 88, 221, 129, 122, 210, 15, 220, 224,
-			179, 226, 226, 250, 44, 58, 227, 167, 130, 80, 120, 103, 61, 159,
-			61, 188, 190, 190, 10, 107, 178, 233, 250, 117, 62, 45, 38, 191,
-			193, 91, 237, 32, 230, 62, 76, 107, 16, 50, 95, 184, 208, 30,
-			20, 117, 241, 113, 101, 188, 9, 217, 235, 109, 75, 60, 172, 151,
-			43, 235, 192, 28, 91, 226, 206, 185, 187, 141, 57, 184, 113, 218,
-			69, 72, 239, 234, 70, 234, 247, 228, 115, 218, 225, 171, 78, 49,
-			122, 142, 229, 86, 87, 214, 214, 21, 49, 241, 252, 255, 83, 78,
-			246, 54, 60, 138, 31, 21, 231, 255, 159, 118, 236, 99, 120, 202,
-			51, 42, 143, 251, 63, 237, 200, 87, 80, 70, 229, 113, 255, 167,
-			157, 194, 81, 114, 74, 182, 48, 168, 253, 187, 142, 61, 81, 28,
-			23, 193, 65, 60, 74, 245, 133, 232, 102, 134, 168, 54, 150, 20,
-			152, 80, 112, 235, 109, 228, 62, 137, 199, 164, 246, 239, 57, 246,
-			88, 113, 50, 77, 58, 145, 71, 80, 165, 101, 195, 68, 25, 98,
-			14, 162, 4, 53, 244, 224, 247, 28, 249, 140, 207, 168, 140, 22,
-			248, 61, 7, 95, 139, 2, 65, 96, 208, 204, 103, 28, 243, 247,
-			157, 59, 229, 172, 131, 224, 251, 76, 194, 64, 32, 248, 62, 227,
-			200, 12, 151, 163, 216, 209, 207, 56, 199, 102, 21, 104, 1, 40,
-			243, 65, 140, 162, 106, 255, 251, 78, 230, 180, 2, 179, 0, 142,
-			158, 66, 25, 61, 10, 223, 253, 236, 15, 80, 70, 143, 162, 54,
-			254, 217, 164, 171, 160, 141, 127, 86, 201, 232, 81, 28, 248, 103,
-			149, 140, 30, 69, 109, 252, 179, 74, 70, 143, 58, 212, 254, 227,
-			151, 72, 70, 143, 130, 140, 254, 99, 37, 163, 71, 81, 70, 255,
-			177, 146, 209, 163, 40, 163, 255, 24, 100, 244, 219, 44, 98, 218,
-			148, 102, 62, 239, 244, 253, 173, 99, 20, 254, 193, 100, 243, 218,
-			171, 171, 207, 206, 65, 42, 184, 218, 194, 77, 200, 166, 207, 111,
-			52, 149, 164, 151, 81, 196, 120, 187, 237, 54, 119, 197, 21, 107,
-			53, 10, 153, 85, 77, 228, 247, 86, 55, 179, 117, 84, 218, 133,
-			11, 171, 50, 55, 157, 72, 67, 147, 206, 88, 28, 4, 77, 149,
-			237, 50, 146, 178, 13, 29, 190, 152, 206, 13, 58, 184, 152, 74,
-			77, 143, 247, 5, 163, 114, 215, 205, 229, 158, 46, 120, 126, 87,
-			50, 123, 209, 66, 62, 42, 47, 142, 48, 68, 255, 18, 180, 23,
-			46, 72, 20, 83, 211, 66, 92, 180, 195, 64, 188, 203, 208, 83,
-			109, 33, 104, 239, 175, 7, 83, 211, 211, 242, 236, 18, 51, 131,
-			224, 226, 216, 72, 167, 195, 211, 57, 243, 84, 194, 61, 145, 42,
-			138, 26, 212, 254, 188, 147, 59, 74, 126, 199, 36, 182, 77, 173,
-			62, 154, 249, 162, 99, 254, 133, 99, 21, 254, 189, 136, 164, 73,
-			95, 236, 239, 202, 175, 151, 156, 21, 98, 10, 69, 153, 78, 68,
-			207, 162, 200, 243, 186, 35, 147, 10, 16, 230, 178, 70, 16, 207,
-			170, 20, 59, 13, 21, 207, 237, 69, 155, 73, 30, 17, 249, 44,
-			2, 243, 182, 183, 83, 173, 211, 40, 253, 84, 146, 61, 54, 213,
-			224, 126, 16, 171, 76, 33, 226, 157, 26, 152, 170, 46, 30, 136,
-			218, 188, 30, 245, 70, 61, 78, 151, 9, 171, 148, 119, 202, 165,
-			127, 202, 126, 88, 62, 144, 142, 49, 49, 175, 45, 177, 31, 46,
-			110, 185, 97, 121, 203, 125, 170, 88, 194, 206, 96, 81, 43, 85,
-			133, 253, 72, 170, 71, 68, 60, 172, 62, 37, 219, 76, 151, 161,
-			166, 92, 172, 20, 51, 80, 127, 209, 33, 226, 101, 47, 42, 50,
-			80, 255, 185, 99, 23, 81, 46, 81, 153, 115, 250, 207, 29, 153,
-			17, 154, 202, 156, 211, 127, 238, 12, 141, 37, 5, 6, 20, 140,
-			223, 158, 20, 88, 80, 192, 78, 104, 156, 6, 181, 191, 228, 216,
-			39, 117, 5, 16, 102, 95, 74, 227, 52, 28, 40, 24, 26, 77,
-			10, 176, 9, 189, 35, 41, 176, 160, 224, 68, 17, 215, 50, 133,
-			94, 254, 165, 99, 138, 188, 168, 20, 251, 248, 151, 74, 226, 80,
-			116, 174, 253, 165, 51, 48, 166, 64, 3, 192, 241, 9, 5, 90,
-			0, 30, 61, 38, 158, 136, 162, 208, 185, 47, 59, 230, 100, 225,
-			237, 70, 234, 165, 147, 23, 224, 166, 18, 204, 212, 222, 174, 27,
-			35, 23, 99, 112, 10, 106, 96, 193, 53, 14, 11, 62, 36, 176,
-			21, 136, 20, 165, 152, 40, 210, 141, 228, 209, 77, 114, 114, 83,
-			145, 151, 84, 228, 163, 81, 98, 241, 202, 160, 85, 249, 6, 149,
-			26, 11, 144, 234, 203, 201, 208, 128, 80, 95, 118, 100, 252, 7,
-			69, 50, 125, 217, 145, 55, 33, 41, 18, 233, 203, 206, 233, 59,
-			37, 145, 76, 106, 127, 197, 49, 167, 229, 143, 32, 150, 191, 146,
-			96, 50, 29, 0, 53, 38, 140, 23, 119, 232, 41, 5, 90, 0,
-			78, 78, 73, 76, 22, 181, 191, 234, 200, 108, 203, 20, 15, 119,
-			190, 154, 96, 178, 28, 0, 101, 164, 31, 197, 195, 157, 175, 58,
-			163, 39, 21, 136, 109, 239, 156, 148, 152, 108, 106, 127, 205, 49,
-			213, 143, 182, 0, 21, 38, 219, 1, 80, 247, 201, 54, 0, 148,
-			23, 220, 40, 30, 141, 124, 45, 97, 1, 135, 218, 255, 195, 49,
-			213, 208, 29, 27, 65, 133, 201, 193, 95, 117, 159, 28, 3, 192,
-			81, 197, 47, 142, 5, 160, 204, 163, 75, 97, 131, 250, 186, 35,
-			83, 39, 83, 116, 231, 127, 61, 193, 148, 113, 0, 212, 125, 202,
-			24, 0, 210, 19, 10, 180, 0, 60, 117, 154, 124, 206, 32, 166,
-			61, 70, 51, 223, 118, 250, 254, 103, 198, 40, 60, 193, 42, 126,
-			221, 109, 71, 50, 255, 233, 205, 61, 153, 46, 146, 121, 133, 65,
-			75, 133, 96, 17, 246, 144, 215, 228, 61, 9, 139, 217, 158, 155,
-			202, 231, 82, 38, 115, 143, 255, 32, 51, 206, 118, 63, 224, 142,
-			29, 23, 82, 120, 204, 160, 246, 183, 157, 220, 173, 228, 13, 163,
-			196, 182, 199, 96, 5, 126, 40, 99, 158, 40, 124, 45, 207, 230,
-			217, 82, 160, 206, 198, 146, 236, 188, 46, 107, 123, 92, 132, 43,
-			116, 99, 100, 110, 119, 66, 54, 24, 43, 17, 103, 118, 81, 59,
-			240, 69, 124, 163, 155, 62, 238, 77, 146, 222, 234, 235, 53, 221,
-			175, 189, 139, 60, 143, 242, 181, 142, 228, 249, 144, 56, 96, 213,
-			197, 10, 62, 21, 213, 144, 79, 50, 241, 48, 42, 245, 220, 6,
-			76, 238, 135, 203, 68, 105, 94, 203, 107, 186, 33, 209, 239, 196,
-			203, 183, 190, 48, 225, 98, 137, 69, 238, 62, 24, 0, 226, 106,
-			151, 24, 130, 190, 144, 112, 195, 107, 102, 64, 86, 153, 204, 42,
-			8, 244, 237, 130, 31, 33, 108, 137, 227, 141, 184, 32, 184, 198,
-			220, 88, 164, 232, 77, 66, 129, 147, 113, 35, 246, 23, 66, 245,
-			152, 188, 170, 240, 216, 99, 250, 31, 248, 255, 177, 199, 224, 71,
-			87, 254, 184, 85, 199, 127, 26, 156, 177, 109, 198, 118, 118, 61,
-			2, 230, 146, 78, 54, 171, 147, 248, 176, 166, 156, 79, 113, 107,
-			35, 106, 187, 62, 99, 152, 176, 135, 117, 255, 151, 222, 101, 24,
-			251, 97, 183, 228, 77, 51, 246, 195, 236, 238, 18, 59, 87, 98,
-			115, 37, 118, 142, 189, 22, 235, 129, 96, 221, 219, 13, 154, 7,
-			7, 86, 150, 13, 183, 122, 26, 150, 216, 221, 208, 22, 26, 54,
-			221, 45, 222, 100, 83, 106, 244, 211, 162, 73, 189, 212, 56, 208,
-			228, 30, 213, 68, 188, 50, 39, 200, 36, 235, 243, 210, 246, 129,
-			250, 231, 85, 125, 145, 154, 116, 59, 8, 100, 229, 157, 210, 238,
-			129, 202, 119, 233, 202, 34, 171, 231, 212, 249, 105, 245, 198, 4,
-			144, 105, 150, 205, 107, 178, 201, 176, 15, 157, 67, 92, 71, 242,
-			202, 24, 162, 56, 226, 205, 109, 249, 10, 158, 12, 145, 192, 84,
-			117, 44, 205, 244, 226, 5, 49, 153, 211, 214, 139, 167, 83, 55,
-			203, 212, 25, 181, 76, 177, 134, 183, 26, 130, 109, 21, 164, 27,
-			137, 116, 207, 140, 129, 17, 44, 162, 143, 184, 95, 111, 6, 50,
-			240, 67, 71, 232, 138, 235, 102, 66, 131, 41, 179, 110, 38, 199,
-			48, 198, 216, 11, 147, 164, 168, 24, 192, 91, 191, 198, 166, 218,
-			65, 20, 121, 91, 205, 253, 244, 83, 102, 58, 186, 39, 209, 124,
-			82, 137, 170, 133, 218, 135, 137, 74, 197, 125, 72, 25, 37, 163,
-			201, 181, 183, 11, 166, 35, 242, 23, 82, 77, 31, 4, 21, 19,
-			13, 191, 168, 169, 136, 22, 191, 142, 114, 197, 196, 68, 190, 160,
-			86, 25, 166, 225, 138, 234, 139, 102, 226, 196, 22, 211, 87, 245,
-			224, 91, 138, 160, 34, 140, 53, 82, 113, 172, 130, 58, 41, 250,
-			165, 223, 150, 192, 39, 208, 218, 33, 90, 168, 240, 97, 145, 147,
-			89, 15, 31, 83, 104, 201, 132, 244, 172, 21, 68, 232, 84, 8,
-			182, 174, 123, 65, 39, 82, 196, 85, 143, 4, 138, 177, 53, 138,
-			146, 174, 238, 142, 235, 249, 58, 179, 173, 74, 134, 156, 206, 227,
-			155, 158, 134, 238, 23, 48, 162, 122, 208, 230, 37, 17, 169, 141,
-			113, 41, 58, 83, 240, 33, 163, 238, 102, 213, 201, 72, 44, 111,
-			21, 231, 37, 110, 218, 96, 122, 90, 201, 85, 94, 28, 73, 197,
-			85, 214, 21, 188, 34, 122, 36, 217, 37, 53, 30, 206, 139, 34,
-			66, 173, 139, 23, 18, 2, 42, 27, 72, 196, 227, 1, 150, 45,
-			190, 227, 249, 200, 70, 82, 235, 234, 165, 140, 184, 9, 28, 237,
-			186, 161, 48, 45, 122, 50, 77, 171, 184, 45, 145, 33, 23, 219,
-			224, 32, 31, 17, 225, 69, 34, 76, 202, 61, 108, 196, 233, 97,
-			70, 65, 75, 37, 16, 237, 169, 9, 152, 181, 97, 215, 226, 174,
-			126, 22, 70, 160, 0, 163, 137, 251, 13, 247, 144, 69, 196, 138,
-			248, 220, 112, 81, 90, 176, 40, 36, 241, 93, 81, 87, 72, 40,
-			24, 89, 42, 113, 184, 94, 153, 93, 143, 153, 36, 41, 57, 19,
-			142, 6, 68, 201, 134, 233, 137, 183, 216, 84, 74, 16, 25, 66,
-			14, 72, 101, 46, 59, 47, 68, 139, 50, 240, 147, 248, 81, 253,
-			18, 10, 155, 101, 11, 73, 150, 42, 241, 216, 14, 94, 250, 144,
-			122, 111, 106, 25, 73, 13, 181, 29, 6, 91, 238, 150, 136, 150,
-			105, 240, 200, 219, 241, 209, 15, 134, 169, 166, 209, 77, 200, 98,
-			92, 207, 138, 74, 202, 115, 32, 114, 167, 196, 174, 223, 40, 129,
-			82, 140, 247, 22, 68, 68, 116, 176, 157, 250, 74, 93, 196, 227,
-			48, 241, 22, 74, 61, 8, 27, 169, 60, 157, 120, 245, 68, 42,
-			199, 99, 168, 247, 127, 40, 99, 106, 48, 3, 160, 212, 251, 199,
-			80, 239, 255, 80, 102, 252, 152, 2, 45, 0, 143, 51, 116, 176,
-			140, 129, 21, 249, 225, 140, 249, 237, 140, 8, 127, 30, 67, 67,
-			232, 195, 25, 66, 201, 91, 178, 36, 3, 48, 104, 56, 191, 153,
-			177, 75, 133, 111, 102, 210, 143, 14, 200, 180, 232, 110, 24, 43,
-			118, 189, 145, 142, 166, 238, 124, 203, 231, 135, 136, 30, 35, 102,
-			68, 79, 221, 170, 144, 190, 211, 148, 39, 84, 190, 6, 38, 132,
-			154, 76, 225, 134, 65, 122, 174, 144, 146, 160, 36, 138, 27, 214,
-			97, 16, 196, 135, 246, 64, 101, 217, 1, 137, 36, 227, 153, 226,
-			174, 247, 3, 88, 80, 175, 119, 240, 13, 250, 46, 166, 69, 47,
-			10, 124, 4, 183, 126, 220, 2, 239, 194, 45, 240, 62, 220, 47,
-			73, 34, 161, 47, 8, 65, 220, 228, 101, 117, 135, 30, 102, 115,
-			234, 174, 105, 198, 206, 158, 197, 118, 234, 6, 101, 25, 199, 54,
-			117, 223, 180, 86, 28, 206, 158, 69, 148, 186, 2, 108, 192, 83,
-			211, 41, 205, 226, 236, 89, 118, 62, 9, 123, 84, 171, 248, 144,
-			129, 118, 125, 92, 100, 18, 72, 19, 242, 110, 236, 165, 222, 128,
-			123, 169, 212, 213, 248, 1, 118, 247, 69, 130, 107, 166, 247, 27,
-			2, 229, 1, 228, 115, 221, 200, 15, 123, 94, 132, 169, 107, 159,
-			115, 18, 245, 161, 111, 144, 160, 254, 113, 0, 253, 249, 67, 181,
-			62, 172, 43, 175, 145, 38, 162, 65, 220, 204, 71, 230, 72, 30,
-			33, 213, 27, 108, 144, 60, 58, 39, 157, 26, 213, 109, 80, 98,
-			101, 8, 141, 96, 165, 166, 27, 197, 138, 37, 15, 76, 62, 204,
-			188, 102, 141, 158, 93, 186, 91, 179, 75, 68, 253, 148, 78, 15,
-			169, 69, 60, 81, 139, 70, 40, 117, 74, 106, 225, 13, 27, 117,
-			16, 215, 242, 234, 65, 51, 240, 167, 101, 28, 255, 152, 116, 66,
-			252, 102, 70, 58, 12, 198, 164, 19, 226, 55, 51, 242, 89, 170,
-			49, 233, 132, 248, 205, 204, 216, 109, 73, 129, 5, 5, 133, 163,
-			73, 65, 14, 10, 142, 157, 33, 121, 146, 147, 5, 38, 148, 220,
-			62, 67, 126, 223, 148, 75, 222, 160, 246, 111, 195, 146, 255, 13,
-			83, 221, 57, 221, 197, 55, 40, 132, 185, 30, 239, 134, 156, 139,
-			87, 3, 58, 161, 214, 182, 46, 200, 132, 223, 77, 207, 7, 235,
-			0, 255, 174, 7, 205, 78, 203, 47, 17, 6, 27, 54, 252, 144,
-			168, 175, 165, 84, 196, 174, 27, 69, 157, 22, 111, 136, 205, 217,
-			141, 82, 136, 166, 75, 216, 84, 224, 209, 15, 92, 184, 161, 190,
-			54, 230, 249, 34, 67, 174, 216, 48, 4, 237, 241, 182, 150, 188,
-			8, 86, 223, 47, 179, 84, 76, 52, 224, 20, 252, 39, 80, 234,
-			99, 16, 64, 249, 20, 15, 131, 89, 225, 220, 7, 253, 67, 199,
-			172, 239, 7, 29, 177, 89, 236, 201, 68, 6, 110, 163, 65, 216,
-			121, 188, 126, 6, 226, 75, 158, 134, 52, 188, 168, 221, 116, 247,
-			61, 245, 34, 104, 71, 92, 163, 85, 116, 55, 108, 36, 106, 50,
-			119, 134, 3, 5, 169, 185, 51, 144, 236, 169, 185, 51, 44, 40,
-			72, 205, 157, 145, 131, 130, 212, 220, 25, 48, 119, 191, 13, 115,
-			247, 129, 65, 57, 119, 38, 181, 255, 62, 99, 79, 23, 158, 25,
-			212, 169, 236, 215, 208, 238, 132, 237, 173, 234, 111, 7, 221, 142,
-			63, 157, 208, 54, 197, 177, 42, 241, 181, 139, 233, 189, 246, 193,
-			40, 109, 73, 141, 26, 181, 22, 47, 57, 2, 194, 173, 27, 223,
-			234, 20, 82, 53, 225, 122, 105, 187, 99, 3, 21, 118, 173, 30,
-			100, 81, 12, 159, 222, 224, 9, 97, 243, 152, 67, 87, 110, 132,
-			232, 234, 62, 248, 221, 192, 199, 56, 105, 94, 239, 96, 212, 41,
-			84, 139, 68, 234, 82, 230, 7, 130, 167, 136, 112, 66, 245, 180,
-			18, 155, 177, 174, 47, 142, 246, 98, 233, 111, 198, 135, 85, 181,
-			61, 128, 31, 133, 14, 53, 185, 11, 90, 197, 102, 131, 139, 126,
-			111, 234, 14, 33, 130, 107, 156, 183, 97, 11, 116, 119, 66, 183,
-			189, 139, 221, 214, 21, 144, 221, 68, 7, 136, 34, 214, 212, 86,
-			39, 70, 237, 169, 30, 248, 190, 184, 71, 16, 7, 211, 194, 211,
-			45, 238, 0, 168, 213, 84, 22, 27, 163, 198, 141, 151, 59, 148,
-			63, 118, 107, 95, 100, 95, 234, 29, 76, 144, 80, 44, 217, 82,
-			133, 194, 144, 216, 12, 250, 230, 249, 10, 222, 218, 217, 77, 154,
-			200, 139, 53, 233, 172, 56, 23, 245, 143, 45, 55, 188, 6, 235,
-			68, 248, 193, 207, 158, 157, 22, 198, 85, 132, 239, 145, 114, 180,
-			2, 164, 218, 39, 148, 85, 69, 135, 146, 162, 33, 240, 67, 44,
-			51, 109, 33, 211, 248, 204, 141, 98, 30, 122, 209, 181, 228, 149,
-			99, 141, 238, 160, 196, 68, 195, 14, 243, 177, 2, 99, 4, 201,
-			5, 78, 225, 38, 8, 163, 184, 76, 216, 50, 223, 67, 154, 32,
-			231, 202, 251, 186, 201, 93, 95, 124, 48, 75, 60, 195, 162, 30,
-			141, 232, 218, 88, 48, 55, 140, 246, 38, 224, 222, 187, 32, 135,
-			159, 102, 220, 237, 32, 64, 235, 248, 6, 63, 111, 185, 97, 249,
-			16, 180, 91, 110, 40, 118, 191, 195, 246, 178, 45, 247, 41, 246,
-			0, 187, 235, 226, 11, 162, 125, 74, 125, 117, 222, 151, 250, 57,
-			80, 226, 64, 157, 23, 192, 209, 210, 61, 127, 49, 76, 170, 102,
-			234, 65, 221, 206, 86, 19, 108, 185, 64, 168, 7, 18, 193, 162,
-			92, 24, 154, 79, 196, 219, 71, 225, 78, 234, 117, 88, 96, 120,
-			197, 4, 65, 200, 226, 208, 245, 240, 230, 136, 98, 17, 137, 74,
-			124, 149, 169, 246, 233, 7, 90, 67, 33, 138, 182, 154, 174, 127,
-			77, 48, 189, 90, 13, 242, 166, 172, 208, 3, 17, 13, 216, 21,
-			229, 23, 239, 94, 178, 180, 216, 92, 249, 208, 57, 17, 213, 30,
-			96, 247, 136, 89, 153, 97, 151, 210, 140, 173, 169, 133, 170, 219,
-			140, 72, 205, 143, 195, 102, 75, 114, 172, 138, 189, 35, 89, 69,
-			49, 185, 84, 54, 202, 108, 230, 236, 11, 98, 150, 182, 4, 155,
-			97, 59, 33, 38, 170, 148, 13, 122, 24, 75, 252, 200, 30, 96,
-			247, 234, 89, 145, 81, 10, 172, 209, 51, 252, 40, 181, 29, 153,
-			54, 238, 19, 201, 118, 100, 58, 80, 32, 207, 51, 176, 192, 128,
-			130, 241, 83, 73, 129, 5, 5, 147, 83, 120, 158, 1, 5, 22,
-			181, 191, 149, 177, 103, 116, 5, 203, 198, 130, 4, 167, 229, 64,
-			65, 10, 167, 101, 64, 193, 248, 233, 164, 0, 113, 76, 77, 107,
-			156, 54, 181, 255, 33, 99, 207, 233, 10, 182, 40, 72, 112, 218,
-			14, 20, 164, 112, 218, 6, 20, 140, 207, 38, 5, 22, 20, 156,
-			59, 79, 254, 218, 32, 166, 61, 78, 51, 111, 204, 246, 189, 59,
-			107, 20, 254, 171, 145, 122, 201, 79, 72, 197, 166, 48, 182, 118,
-			189, 54, 219, 226, 241, 30, 231, 126, 207, 125, 50, 97, 117, 199,
-			81, 175, 147, 90, 61, 20, 49, 159, 228, 157, 215, 251, 107, 58,
-			123, 76, 20, 5, 117, 207, 213, 39, 95, 250, 125, 28, 253, 21,
-			146, 246, 122, 39, 71, 230, 234, 157, 7, 212, 48, 49, 27, 63,
-			176, 88, 18, 43, 44, 27, 117, 229, 7, 17, 14, 234, 113, 131,
-			218, 111, 204, 230, 142, 144, 39, 137, 109, 143, 131, 245, 246, 230,
-			172, 121, 186, 240, 4, 155, 247, 217, 188, 14, 122, 81, 91, 80,
-			36, 12, 126, 244, 4, 128, 74, 202, 159, 196, 61, 163, 135, 8,
-			232, 152, 83, 123, 10, 81, 222, 16, 149, 72, 202, 223, 233, 126,
-			176, 4, 45, 201, 113, 84, 86, 223, 156, 53, 53, 152, 1, 112,
-			224, 22, 5, 26, 0, 222, 202, 20, 104, 1, 120, 242, 20, 90,
-			165, 227, 96, 149, 254, 203, 172, 249, 92, 86, 88, 165, 227, 104,
-			149, 254, 203, 44, 25, 39, 239, 48, 72, 6, 96, 24, 215, 91,
-			179, 118, 169, 240, 163, 105, 163, 20, 35, 53, 187, 119, 190, 222,
-			195, 133, 174, 55, 179, 147, 247, 198, 48, 202, 88, 248, 210, 113,
-			91, 86, 174, 34, 183, 87, 123, 42, 43, 31, 127, 89, 120, 145,
-			144, 235, 198, 165, 110, 254, 214, 172, 100, 212, 113, 169, 155, 191,
-			53, 43, 245, 187, 113, 169, 155, 191, 53, 43, 245, 187, 113, 169,
-			155, 191, 53, 43, 245, 187, 113, 169, 155, 191, 53, 43, 245, 187,
-			113, 165, 155, 191, 53, 123, 251, 12, 89, 145, 227, 54, 168, 253,
-			246, 172, 125, 170, 240, 96, 239, 184, 145, 7, 48, 51, 183, 176,
-			77, 164, 138, 117, 248, 248, 83, 253, 6, 189, 244, 237, 233, 126,
-			131, 94, 250, 246, 172, 92, 96, 227, 82, 47, 125, 123, 118, 252,
-			120, 82, 96, 65, 65, 241, 36, 217, 147, 157, 50, 169, 253, 116,
-			214, 190, 189, 176, 211, 219, 41, 212, 228, 197, 238, 189, 29, 113,
-			156, 148, 173, 253, 56, 121, 184, 182, 155, 207, 100, 54, 114, 92,
-			150, 73, 20, 124, 234, 24, 81, 156, 198, 167, 58, 15, 226, 226,
-			233, 116, 231, 65, 138, 61, 157, 38, 58, 16, 236, 233, 172, 124,
-			9, 120, 92, 74, 177, 167, 179, 71, 143, 145, 63, 85, 172, 100,
-			81, 251, 93, 89, 251, 104, 225, 63, 25, 7, 120, 73, 6, 151,
-			221, 76, 231, 229, 205, 217, 23, 232, 60, 98, 17, 9, 122, 184,
-			175, 224, 212, 121, 39, 200, 133, 182, 27, 197, 41, 51, 52, 228,
-			77, 126, 29, 95, 83, 223, 143, 57, 155, 146, 15, 245, 137, 156,
-			18, 202, 132, 196, 53, 251, 0, 162, 156, 21, 250, 210, 116, 138,
-			66, 32, 147, 223, 149, 166, 16, 200, 228, 119, 165, 41, 4, 50,
-			249, 93, 89, 249, 146, 241, 184, 148, 201, 239, 202, 30, 41, 144,
-			247, 221, 65, 142, 139, 228, 243, 103, 221, 182, 119, 22, 23, 202,
-			166, 202, 24, 32, 24, 137, 18, 153, 157, 222, 109, 123, 5, 153,
-			169, 254, 172, 202, 84, 127, 54, 9, 164, 16, 181, 103, 126, 214,
-			32, 67, 104, 249, 95, 146, 88, 232, 29, 164, 240, 80, 181, 178,
-			180, 184, 121, 169, 242, 240, 252, 171, 170, 43, 181, 205, 141, 229,
-			181, 213, 202, 66, 245, 161, 106, 101, 49, 223, 71, 7, 73, 78,
-			61, 187, 158, 55, 0, 170, 85, 126, 104, 163, 90, 171, 44, 230,
-			77, 58, 66, 6, 86, 54, 214, 87, 55, 214, 55, 87, 150, 151,
-			174, 230, 45, 58, 76, 72, 117, 89, 195, 54, 29, 34, 253, 213,
-			43, 87, 54, 214, 231, 47, 45, 85, 242, 14, 165, 100, 120, 99,
-			121, 165, 182, 88, 169, 85, 22, 55, 151, 170, 107, 235, 249, 12,
-			189, 133, 140, 46, 175, 44, 111, 86, 174, 172, 174, 95, 221, 92,
-			172, 60, 52, 191, 177, 180, 158, 207, 94, 120, 156, 12, 119, 15,
-			151, 222, 94, 238, 205, 195, 143, 3, 145, 193, 26, 19, 239, 205,
-			49, 107, 106, 120, 238, 72, 57, 161, 71, 185, 107, 164, 181, 161,
-			237, 52, 120, 169, 77, 134, 235, 65, 43, 85, 253, 18, 237, 170,
-			143, 30, 145, 85, 227, 53, 243, 178, 198, 78, 208, 116, 253, 157,
-			114, 16, 238, 156, 221, 225, 62, 118, 226, 172, 248, 201, 109, 123,
-			17, 78, 80, 42, 166, 241, 98, 234, 239, 247, 153, 246, 229, 249,
-			213, 234, 35, 191, 83, 32, 25, 106, 15, 247, 93, 53, 200, 175,
-			218, 196, 24, 164, 214, 112, 31, 157, 251, 183, 54, 91, 8, 218,
-			251, 161, 183, 179, 27, 179, 185, 115, 231, 95, 38, 227, 11, 217,
-			210, 210, 2, 33, 108, 201, 171, 115, 31, 175, 69, 250, 13, 105,
-			229, 205, 183, 65, 171, 80, 191, 148, 216, 171, 68, 6, 28, 54,
-			87, 62, 199, 166, 208, 113, 45, 127, 42, 78, 95, 36, 104, 61,
-			171, 103, 40, 83, 175, 50, 226, 33, 73, 157, 183, 113, 89, 137,
-			196, 150, 174, 95, 231, 73, 174, 77, 137, 163, 76, 48, 183, 38,
-			62, 13, 189, 133, 91, 34, 216, 172, 109, 117, 71, 91, 85, 99,
-			110, 76, 132, 23, 109, 55, 142, 219, 23, 206, 158, 221, 219, 219,
-			43, 187, 216, 81, 36, 89, 83, 84, 139, 206, 46, 85, 23, 42,
-			203, 107, 149, 217, 185, 242, 57, 66, 216, 134, 143, 151, 88, 245,
-			5, 215, 173, 125, 245, 90, 34, 104, 187, 77, 119, 15, 61, 144,
-			59, 161, 76, 241, 229, 249, 42, 71, 76, 137, 69, 193, 118, 188,
-			135, 70, 80, 195, 3, 149, 113, 171, 19, 119, 81, 73, 117, 76,
-			190, 182, 174, 42, 4, 120, 21, 181, 56, 191, 198, 170, 107, 69,
-			118, 105, 126, 173, 186, 86, 34, 236, 209, 234, 250, 195, 43, 27,
-			235, 236, 209, 249, 90, 109, 126, 121, 189, 90, 89, 99, 43, 53,
-			182, 176, 178, 188, 88, 5, 222, 95, 99, 43, 15, 177, 249, 229,
-			171, 236, 149, 213, 229, 197, 146, 186, 208, 203, 159, 4, 59, 62,
-			194, 128, 69, 140, 222, 107, 164, 50, 189, 170, 207, 235, 248, 113,
-			245, 168, 131, 206, 131, 182, 19, 92, 231, 33, 154, 86, 152, 161,
-			35, 146, 239, 85, 250, 13, 194, 48, 87, 141, 244, 67, 31, 24,
-			81, 153, 144, 28, 49, 76, 106, 229, 251, 198, 72, 63, 49, 173,
-			62, 106, 209, 190, 25, 40, 204, 81, 107, 188, 239, 213, 80, 152,
-			27, 16, 127, 138, 194, 91, 250, 138, 88, 72, 196, 159, 162, 240,
-			214, 190, 187, 176, 80, 254, 41, 10, 111, 235, 155, 196, 66, 67,
-			252, 41, 10, 39, 100, 243, 83, 234, 79, 35, 75, 237, 66, 223,
-			148, 65, 62, 103, 17, 51, 219, 71, 173, 73, 243, 66, 225, 119,
-			45, 54, 47, 29, 241, 41, 111, 159, 30, 183, 202, 101, 33, 243,
-			157, 76, 169, 57, 47, 169, 151, 148, 65, 55, 43, 225, 11, 60,
-			211, 24, 122, 165, 214, 121, 87, 98, 208, 110, 211, 177, 199, 21,
-			201, 126, 120, 42, 181, 250, 187, 229, 199, 52, 123, 128, 41, 209,
-			245, 90, 52, 41, 214, 98, 55, 150, 239, 185, 220, 76, 227, 148,
-			164, 19, 237, 123, 197, 209, 98, 71, 122, 94, 226, 184, 9, 8,
-			197, 114, 120, 17, 172, 137, 184, 60, 28, 233, 186, 215, 226, 81,
-			236, 182, 218, 192, 109, 94, 200, 55, 99, 79, 140, 245, 166, 176,
-			167, 250, 92, 146, 62, 238, 23, 233, 142, 146, 214, 175, 189, 72,
-			8, 33, 86, 182, 207, 164, 86, 33, 123, 82, 252, 109, 195, 68,
-			203, 242, 12, 181, 38, 7, 100, 185, 65, 173, 201, 83, 115, 226,
-			111, 139, 90, 147, 247, 220, 79, 190, 100, 18, 211, 233, 163, 246,
-			185, 190, 171, 70, 225, 255, 50, 241, 134, 190, 223, 240, 234, 152,
-			138, 76, 138, 142, 116, 238, 27, 87, 190, 67, 47, 184, 100, 106,
-			187, 251, 40, 192, 141, 149, 223, 88, 230, 14, 146, 226, 2, 21,
-			244, 215, 117, 120, 20, 139, 123, 192, 2, 135, 27, 41, 150, 194,
-			124, 36, 210, 24, 115, 65, 111, 104, 119, 226, 105, 149, 198, 96,
-			102, 70, 157, 166, 205, 204, 164, 19, 113, 235, 110, 41, 30, 172,
-			7, 77, 38, 223, 172, 148, 103, 230, 23, 193, 232, 21, 177, 148,
-			34, 176, 46, 234, 110, 9, 70, 11, 72, 76, 153, 62, 99, 55,
-			216, 99, 243, 171, 85, 12, 228, 0, 126, 221, 117, 253, 70, 83,
-			171, 141, 42, 19, 33, 6, 162, 175, 235, 75, 85, 51, 51, 45,
-			119, 127, 102, 134, 133, 188, 206, 189, 235, 28, 51, 179, 118, 61,
-			4, 156, 156, 66, 17, 98, 57, 48, 9, 231, 28, 74, 30, 36,
-			182, 3, 218, 185, 53, 103, 158, 40, 204, 177, 133, 192, 191, 14,
-			26, 144, 112, 34, 200, 40, 102, 164, 46, 222, 216, 75, 221, 35,
-			198, 141, 1, 131, 84, 29, 7, 117, 101, 107, 206, 60, 166, 32,
-			147, 90, 115, 199, 25, 249, 105, 3, 177, 27, 212, 186, 207, 28,
-			41, 252, 184, 33, 83, 38, 73, 63, 173, 34, 133, 114, 241, 187,
-			145, 182, 149, 203, 132, 61, 138, 169, 53, 48, 17, 135, 200, 115,
-			113, 24, 121, 221, 144, 179, 196, 67, 29, 171, 196, 2, 91, 90,
-			126, 202, 52, 50, 140, 183, 218, 187, 110, 228, 137, 28, 3, 201,
-			219, 152, 186, 255, 6, 246, 81, 67, 38, 181, 238, 27, 26, 38,
-			255, 65, 244, 223, 164, 214, 203, 205, 145, 194, 47, 129, 61, 122,
-			160, 203, 138, 185, 116, 182, 11, 193, 182, 60, 149, 188, 69, 212,
-			157, 153, 105, 117, 34, 224, 157, 45, 158, 164, 177, 118, 163, 174,
-			51, 57, 201, 157, 37, 225, 243, 222, 118, 189, 38, 62, 199, 27,
-			176, 70, 192, 34, 249, 230, 149, 60, 24, 246, 25, 15, 67, 16,
-			140, 29, 249, 48, 236, 227, 213, 229, 87, 205, 47, 85, 23, 55,
-			231, 107, 151, 55, 174, 84, 150, 215, 31, 159, 214, 195, 131, 41,
-			120, 185, 30, 30, 14, 104, 104, 152, 124, 91, 12, 207, 162, 214,
-			37, 147, 22, 254, 230, 208, 225, 165, 132, 237, 139, 142, 48, 157,
-			159, 27, 151, 90, 212, 14, 96, 19, 47, 201, 68, 63, 245, 102,
-			71, 221, 104, 32, 233, 227, 99, 57, 234, 174, 23, 5, 133, 102,
-			34, 223, 67, 23, 148, 83, 199, 180, 94, 44, 118, 61, 44, 197,
-			245, 40, 222, 242, 75, 104, 226, 138, 36, 158, 17, 102, 198, 74,
-			37, 41, 156, 140, 228, 115, 91, 117, 158, 208, 198, 50, 96, 252,
-			67, 10, 50, 169, 117, 41, 63, 74, 126, 74, 208, 198, 166, 214,
-			101, 115, 180, 240, 175, 15, 165, 13, 202, 135, 239, 145, 52, 74,
-			10, 201, 163, 110, 210, 115, 46, 174, 91, 193, 106, 75, 123, 70,
-			181, 87, 84, 116, 215, 54, 160, 131, 131, 10, 50, 169, 117, 121,
-			36, 79, 126, 70, 116, 222, 161, 214, 146, 153, 47, 60, 123, 120,
-			231, 91, 173, 78, 12, 106, 211, 139, 246, 93, 173, 40, 14, 99,
-			173, 243, 238, 57, 195, 244, 29, 28, 95, 249, 36, 24, 215, 13,
-			86, 107, 226, 23, 145, 249, 158, 132, 184, 108, 8, 231, 32, 158,
-			63, 232, 17, 56, 6, 244, 114, 64, 65, 38, 181, 150, 134, 71,
-			200, 143, 155, 56, 130, 12, 181, 106, 230, 45, 133, 55, 153, 122,
-			4, 82, 184, 79, 41, 15, 249, 116, 42, 111, 171, 207, 58, 62,
-			230, 135, 225, 13, 214, 244, 208, 195, 124, 163, 145, 169, 59, 128,
-			208, 71, 245, 234, 115, 202, 27, 161, 115, 56, 1, 26, 212, 46,
-			92, 127, 159, 185, 225, 150, 23, 135, 110, 184, 207, 68, 26, 154,
-			18, 11, 221, 110, 199, 182, 204, 78, 35, 222, 253, 9, 181, 49,
-			15, 130, 95, 63, 23, 199, 230, 117, 250, 165, 166, 124, 117, 2,
-			190, 50, 25, 201, 214, 168, 58, 135, 105, 234, 69, 114, 158, 36,
-			141, 50, 6, 80, 37, 175, 32, 147, 90, 181, 177, 113, 242, 207,
-			5, 197, 178, 212, 122, 181, 57, 81, 248, 154, 209, 77, 177, 84,
-			142, 13, 17, 20, 31, 201, 87, 44, 197, 201, 132, 146, 244, 50,
-			13, 222, 182, 74, 111, 123, 3, 10, 202, 76, 64, 56, 70, 57,
-			46, 105, 128, 35, 54, 245, 250, 75, 194, 38, 32, 208, 82, 159,
-			19, 21, 18, 7, 171, 136, 210, 23, 166, 54, 34, 77, 231, 10,
-			115, 247, 80, 202, 111, 99, 240, 50, 57, 128, 69, 60, 159, 45,
-			220, 12, 130, 34, 89, 3, 104, 48, 166, 32, 147, 90, 175, 190,
-			245, 182, 173, 140, 200, 223, 72, 62, 121, 94, 27, 201, 218, 238,
-			141, 149, 214, 36, 141, 228, 145, 30, 181, 170, 120, 145, 244, 107,
-			205, 138, 78, 144, 108, 196, 235, 129, 223, 136, 38, 12, 102, 76,
-			89, 53, 5, 210, 113, 226, 248, 174, 31, 68, 19, 38, 51, 166,
-			156, 154, 0, 46, 189, 201, 32, 99, 41, 211, 81, 33, 189, 52,
-			172, 81, 42, 219, 113, 238, 160, 237, 152, 244, 17, 95, 205, 193,
-			167, 233, 147, 254, 182, 183, 190, 105, 24, 239, 51, 173, 203, 171,
-			151, 62, 108, 222, 33, 12, 193, 242, 170, 82, 6, 31, 229, 205,
-			230, 43, 161, 193, 58, 180, 125, 228, 63, 158, 37, 89, 234, 220,
-			209, 247, 78, 195, 32, 159, 26, 68, 131, 242, 142, 62, 58, 247,
-			27, 131, 250, 10, 152, 186, 255, 197, 102, 147, 75, 107, 248, 162,
-			10, 134, 213, 72, 157, 71, 120, 221, 72, 151, 21, 122, 78, 91,
-			161, 85, 191, 94, 102, 248, 4, 0, 254, 22, 233, 171, 112, 101,
-			242, 221, 190, 247, 77, 8, 171, 113, 109, 139, 97, 16, 150, 124,
-			187, 200, 83, 73, 96, 177, 100, 203, 243, 97, 77, 66, 191, 212,
-			209, 34, 190, 251, 138, 65, 118, 132, 181, 130, 134, 78, 130, 83,
-			18, 103, 209, 58, 157, 165, 150, 201, 137, 200, 211, 193, 164, 48,
-			165, 158, 78, 10, 135, 247, 193, 46, 72, 155, 117, 166, 167, 99,
-			81, 111, 180, 48, 238, 76, 33, 215, 79, 255, 138, 48, 135, 186,
-			162, 24, 112, 113, 140, 183, 131, 113, 93, 194, 242, 23, 135, 145,
-			201, 23, 229, 107, 158, 73, 119, 26, 94, 84, 111, 186, 94, 139,
-			139, 227, 170, 195, 58, 225, 249, 105, 90, 168, 78, 200, 219, 45,
-			73, 63, 72, 210, 145, 239, 171, 31, 234, 194, 75, 79, 92, 178,
-			235, 55, 206, 130, 146, 142, 146, 177, 229, 198, 152, 66, 58, 181,
-			253, 41, 143, 65, 202, 16, 87, 1, 181, 48, 168, 101, 105, 45,
-			199, 169, 27, 50, 105, 222, 242, 131, 228, 183, 72, 186, 182, 241,
-			149, 3, 129, 42, 8, 181, 10, 136, 225, 166, 152, 115, 182, 17,
-			132, 17, 151, 55, 125, 90, 65, 204, 229, 141, 159, 56, 234, 122,
-			17, 84, 70, 138, 40, 71, 129, 14, 211, 212, 38, 105, 59, 4,
-			101, 125, 47, 4, 222, 241, 83, 86, 56, 166, 157, 122, 184, 186,
-			198, 214, 86, 30, 90, 127, 116, 190, 86, 97, 213, 53, 182, 90,
-			91, 121, 85, 117, 177, 178, 200, 46, 93, 101, 235, 15, 87, 216,
-			194, 202, 234, 213, 90, 245, 242, 195, 235, 236, 225, 149, 165, 197,
-			74, 109, 141, 205, 47, 47, 178, 133, 149, 229, 245, 90, 245, 210,
-			198, 250, 74, 109, 141, 104, 55, 3, 252, 50, 191, 124, 149, 85,
-			94, 189, 90, 171, 172, 161, 111, 161, 122, 101, 117, 169, 90, 89,
-			76, 121, 28, 74, 172, 186, 252, 255, 178, 247, 239, 193, 113, 100,
-			217, 157, 24, 92, 249, 168, 66, 225, 130, 15, 48, 249, 2, 147,
-			175, 219, 197, 23, 64, 22, 10, 15, 146, 221, 77, 176, 201, 238,
-			2, 8, 18, 213, 3, 2, 236, 2, 208, 143, 225, 112, 128, 68,
-			85, 22, 144, 205, 170, 204, 234, 204, 44, 128, 232, 30, 238, 204,
-			206, 99, 231, 211, 142, 180, 90, 237, 206, 106, 55, 180, 154, 253,
-			118, 244, 178, 21, 122, 120, 181, 19, 150, 44, 201, 178, 35, 188,
-			210, 90, 182, 86, 218, 181, 35, 44, 203, 178, 215, 30, 175, 189,
-			235, 240, 159, 90, 107, 195, 118, 56, 98, 237, 184, 231, 220, 123,
-			243, 102, 161, 64, 178, 91, 214, 40, 28, 65, 70, 147, 93, 55,
-			243, 230, 189, 231, 190, 207, 61, 143, 223, 153, 153, 95, 185, 83,
-			89, 184, 87, 164, 211, 43, 203, 116, 97, 113, 153, 208, 249, 202,
-			253, 202, 242, 236, 29, 186, 188, 88, 132, 106, 119, 127, 71, 23,
-			239, 210, 251, 179, 213, 153, 185, 242, 194, 114, 121, 186, 50, 95,
-			89, 254, 0, 42, 188, 91, 89, 94, 96, 149, 221, 93, 172, 18,
-			90, 166, 15, 202, 213, 229, 202, 204, 202, 124, 185, 74, 31, 172,
-			84, 31, 44, 46, 205, 82, 214, 178, 59, 149, 165, 153, 249, 114,
-			229, 254, 236, 157, 18, 173, 44, 208, 133, 69, 58, 251, 238, 236,
-			194, 50, 93, 154, 43, 207, 207, 167, 27, 74, 232, 226, 123, 11,
-			179, 85, 46, 25, 145, 205, 164, 211, 179, 116, 190, 194, 46, 141,
-			172, 42, 104, 231, 157, 74, 117, 118, 102, 153, 53, 40, 249, 53,
-			83, 185, 51, 187, 176, 92, 158, 47, 18, 10, 242, 197, 242, 124,
-			145, 206, 190, 63, 123, 255, 193, 124, 185, 250, 65, 145, 23, 186,
-			52, 251, 206, 202, 236, 194, 114, 165, 60, 79, 239, 148, 239, 151,
-			239, 205, 46, 209, 225, 231, 245, 202, 131, 234, 226, 204, 74, 117,
-			150, 113, 200, 172, 43, 150, 86, 166, 151, 150, 43, 203, 43, 203,
-			179, 244, 222, 226, 226, 29, 232, 236, 165, 217, 234, 187, 149, 153,
-			217, 165, 155, 116, 126, 113, 9, 58, 108, 101, 105, 182, 72, 232,
-			157, 242, 114, 25, 170, 126, 80, 93, 188, 91, 89, 94, 186, 201,
-			126, 79, 175, 44, 85, 160, 227, 42, 11, 203, 179, 213, 234, 10,
-			72, 63, 71, 232, 220, 226, 123, 179, 239, 206, 86, 233, 76, 121,
-			101, 105, 246, 14, 244, 240, 226, 2, 107, 45, 155, 43, 179, 139,
-			213, 15, 88, 177, 172, 31, 96, 4, 138, 244, 189, 185, 217, 229,
-			185, 217, 42, 235, 84, 232, 173, 50, 235, 134, 165, 229, 106, 101,
-			102, 89, 205, 182, 88, 165, 203, 139, 213, 101, 162, 180, 147, 46,
-			204, 222, 155, 175, 220, 155, 93, 152, 153, 101, 175, 23, 89, 49,
-			239, 85, 150, 102, 71, 104, 185, 90, 89, 98, 25, 42, 80, 49,
-			125, 175, 252, 1, 187, 226, 179, 138, 217, 64, 173, 44, 205, 18,
-			252, 173, 76, 221, 34, 140, 39, 173, 220, 165, 229, 59, 239, 86,
-			24, 229, 60, 247, 131, 197, 165, 165, 10, 159, 46, 208, 109, 51,
-			115, 188, 207, 165, 128, 137, 102, 134, 184, 4, 168, 144, 185, 9,
-			18, 160, 11, 248, 19, 31, 158, 203, 156, 133, 135, 103, 241, 39,
-			62, 60, 159, 169, 8, 249, 19, 251, 137, 15, 47, 100, 138, 66,
-			170, 196, 126, 226, 195, 139, 153, 49, 33, 127, 98, 63, 241, 225,
-			165, 68, 82, 117, 73, 74, 170, 134, 51, 175, 8, 249, 19, 251,
-			249, 223, 159, 38, 186, 153, 177, 114, 223, 212, 216, 209, 103, 255,
-			211, 211, 180, 76, 19, 57, 73, 202, 186, 6, 81, 126, 217, 182,
-			230, 181, 208, 243, 130, 67, 230, 10, 244, 74, 120, 254, 49, 56,
-			64, 161, 193, 109, 19, 208, 34, 93, 191, 238, 132, 69, 5, 81,
-			26, 164, 158, 29, 252, 142, 115, 7, 120, 165, 11, 157, 90, 114,
-			98, 136, 23, 236, 64, 96, 172, 2, 164, 209, 59, 18, 55, 69,
-			196, 109, 135, 130, 64, 142, 209, 196, 104, 9, 92, 191, 215, 14,
-			106, 155, 212, 137, 233, 202, 242, 12, 109, 121, 117, 31, 118, 116,
-			64, 1, 118, 252, 14, 59, 6, 38, 138, 116, 226, 198, 107, 227,
-			69, 177, 81, 183, 195, 160, 233, 182, 99, 175, 70, 239, 133, 238,
-			70, 16, 122, 142, 47, 169, 231, 6, 64, 104, 195, 28, 225, 6,
-			221, 35, 151, 140, 185, 4, 209, 102, 93, 39, 164, 129, 239, 138,
-			168, 63, 45, 207, 239, 196, 220, 156, 227, 213, 113, 217, 190, 102,
-			224, 111, 148, 232, 188, 235, 180, 147, 38, 135, 46, 45, 68, 45,
-			215, 9, 221, 122, 129, 130, 158, 196, 97, 167, 17, 109, 186, 78,
-			155, 240, 108, 20, 248, 94, 196, 143, 116, 69, 208, 39, 105, 225,
-			203, 15, 116, 244, 117, 119, 232, 195, 201, 107, 163, 155, 65, 7,
-			141, 35, 156, 144, 80, 40, 253, 209, 240, 179, 153, 14, 54, 158,
-			99, 144, 115, 68, 132, 104, 10, 129, 203, 241, 120, 56, 202, 241,
-			241, 241, 137, 81, 248, 111, 121, 124, 124, 10, 254, 251, 60, 107,
-			250, 141, 27, 55, 110, 140, 78, 76, 142, 94, 157, 88, 158, 188,
-			58, 117, 253, 198, 212, 245, 27, 165, 27, 226, 207, 231, 75, 116,
-			122, 135, 36, 193, 134, 132, 161, 179, 19, 99, 233, 69, 186, 237,
-			82, 215, 143, 224, 114, 15, 40, 187, 16, 122, 73, 128, 79, 227,
-			248, 114, 171, 154, 135, 213, 187, 51, 132, 94, 189, 122, 245, 70,
-			210, 150, 237, 237, 237, 146, 231, 198, 13, 224, 16, 195, 70, 141,
-			253, 101, 57, 74, 241, 147, 120, 132, 113, 108, 174, 130, 80, 76,
-			207, 73, 41, 104, 34, 16, 165, 19, 83, 116, 38, 104, 181, 59,
-			177, 171, 172, 5, 168, 240, 193, 226, 82, 229, 125, 186, 198, 122,
-			102, 120, 100, 173, 196, 89, 158, 36, 147, 100, 62, 121, 8, 145,
-			132, 121, 142, 220, 120, 149, 15, 240, 48, 124, 190, 176, 50, 63,
-			63, 50, 210, 51, 31, 204, 247, 225, 241, 145, 155, 10, 77, 147,
-			207, 163, 105, 195, 141, 89, 41, 65, 163, 238, 236, 40, 180, 97,
-			36, 3, 168, 96, 203, 105, 210, 120, 139, 215, 152, 202, 126, 49,
-			222, 42, 82, 32, 232, 230, 103, 109, 210, 86, 41, 222, 98, 169,
-			103, 181, 8, 51, 117, 34, 183, 70, 47, 211, 137, 241, 241, 116,
-			11, 175, 238, 217, 194, 247, 60, 255, 234, 36, 93, 187, 231, 198,
-			75, 160, 20, 102, 175, 203, 209, 93, 175, 233, 46, 167, 7, 226,
-			110, 101, 126, 118, 185, 114, 127, 150, 54, 98, 78, 198, 94, 223,
-			92, 108, 196, 130, 210, 149, 202, 194, 242, 171, 215, 104, 236, 213,
-			30, 71, 244, 22, 29, 30, 30, 198, 39, 35, 141, 184, 84, 223,
-			158, 243, 54, 54, 239, 56, 49, 124, 53, 66, 223, 120, 131, 94,
-			157, 28, 161, 95, 162, 240, 110, 62, 216, 22, 175, 68, 191, 141,
-			141, 209, 50, 163, 183, 30, 108, 71, 80, 36, 91, 44, 19, 227,
-			227, 202, 30, 22, 149, 100, 6, 220, 165, 38, 94, 221, 189, 140,
-			100, 105, 236, 243, 137, 87, 175, 93, 187, 246, 218, 213, 87, 199,
-			147, 109, 131, 91, 225, 173, 248, 222, 19, 81, 202, 141, 215, 198,
-			187, 75, 41, 125, 182, 193, 28, 198, 246, 211, 225, 97, 236, 148,
-			49, 24, 44, 246, 103, 132, 142, 170, 228, 60, 103, 6, 179, 114,
-			88, 119, 137, 114, 46, 40, 229, 192, 4, 24, 73, 77, 128, 107,
-			123, 78, 128, 183, 157, 45, 135, 174, 225, 64, 150, 184, 121, 33,
-			203, 114, 223, 107, 54, 189, 72, 153, 0, 128, 232, 209, 130, 167,
-			244, 22, 221, 251, 131, 103, 76, 115, 122, 43, 121, 90, 242, 221,
-			237, 233, 142, 215, 172, 187, 225, 240, 8, 107, 216, 18, 239, 33,
-			94, 5, 118, 204, 72, 18, 85, 136, 229, 89, 192, 182, 123, 126,
-			204, 90, 206, 115, 98, 211, 121, 179, 161, 7, 70, 74, 224, 213,
-			15, 180, 36, 125, 112, 253, 57, 125, 80, 241, 163, 216, 241, 227,
-			146, 31, 108, 43, 205, 230, 79, 33, 106, 250, 45, 154, 202, 243,
-			204, 150, 38, 132, 63, 191, 201, 126, 176, 93, 218, 112, 227, 89,
-			54, 217, 240, 217, 240, 136, 210, 242, 116, 235, 121, 102, 150, 24,
-			222, 163, 165, 175, 238, 217, 82, 97, 63, 202, 249, 12, 250, 96,
-			39, 222, 196, 139, 68, 106, 162, 169, 3, 53, 60, 210, 61, 11,
-			239, 185, 241, 76, 50, 238, 195, 35, 176, 215, 191, 189, 180, 184,
-			64, 239, 35, 88, 33, 33, 180, 226, 227, 19, 188, 181, 163, 184,
-			73, 233, 167, 157, 182, 155, 14, 133, 65, 29, 161, 37, 67, 158,
-			129, 192, 1, 244, 169, 206, 31, 17, 100, 81, 34, 33, 163, 188,
-			23, 227, 19, 122, 17, 45, 124, 194, 248, 134, 167, 163, 159, 180,
-			2, 63, 222, 124, 58, 250, 73, 221, 217, 121, 186, 252, 9, 59,
-			188, 159, 78, 125, 210, 242, 252, 167, 83, 159, 68, 110, 237, 233,
-			195, 210, 39, 140, 93, 98, 75, 246, 233, 163, 207, 23, 8, 119,
-			7, 193, 175, 65, 224, 135, 182, 238, 92, 175, 234, 138, 96, 27,
-			96, 230, 94, 247, 54, 188, 56, 226, 0, 221, 188, 166, 34, 133,
-			170, 138, 132, 98, 101, 69, 10, 181, 161, 16, 22, 170, 76, 236,
-			202, 219, 16, 232, 0, 142, 237, 237, 64, 148, 230, 58, 181, 77,
-			228, 201, 4, 31, 199, 248, 63, 190, 165, 40, 81, 247, 232, 70,
-			64, 59, 109, 96, 19, 196, 167, 232, 53, 137, 15, 39, 122, 115,
-			123, 35, 69, 146, 82, 104, 96, 77, 133, 207, 23, 104, 212, 105,
-			52, 188, 39, 41, 41, 156, 11, 243, 0, 56, 209, 225, 194, 202,
-			242, 76, 97, 228, 102, 234, 105, 74, 241, 85, 162, 101, 30, 190,
-			4, 39, 131, 8, 235, 228, 134, 50, 110, 95, 18, 170, 138, 113,
-			147, 195, 78, 34, 243, 195, 176, 136, 133, 207, 23, 70, 208, 213,
-			176, 29, 122, 190, 4, 217, 238, 154, 74, 232, 25, 166, 86, 37,
-			128, 89, 132, 213, 12, 161, 192, 211, 49, 14, 167, 6, 230, 4,
-			224, 88, 198, 234, 100, 223, 114, 59, 97, 222, 134, 104, 23, 29,
-			224, 132, 9, 118, 56, 35, 187, 28, 156, 11, 147, 227, 19, 175,
-			177, 211, 97, 226, 250, 242, 248, 196, 212, 213, 241, 169, 137, 235,
-			165, 241, 137, 207, 23, 248, 236, 142, 40, 164, 229, 241, 130, 166,
-			59, 144, 19, 234, 15, 252, 132, 111, 190, 94, 164, 172, 180, 18,
-			95, 64, 50, 86, 67, 17, 141, 182, 21, 86, 205, 161, 236, 120,
-			228, 150, 78, 200, 229, 73, 203, 180, 4, 135, 137, 200, 152, 141,
-			132, 62, 140, 131, 202, 210, 226, 18, 44, 178, 225, 145, 30, 12,
-			106, 169, 21, 124, 236, 53, 155, 14, 172, 46, 215, 31, 93, 89,
-			26, 171, 7, 181, 104, 236, 61, 119, 125, 44, 33, 101, 172, 42,
-			76, 13, 199, 238, 53, 131, 117, 167, 185, 186, 136, 216, 39, 99,
-			140, 160, 49, 165, 146, 17, 129, 101, 4, 97, 108, 112, 167, 41,
-			194, 58, 231, 97, 36, 215, 24, 199, 200, 58, 189, 36, 126, 172,
-			137, 6, 241, 248, 62, 50, 42, 10, 233, 217, 68, 66, 31, 174,
-			69, 113, 216, 128, 79, 149, 22, 5, 181, 168, 212, 198, 157, 141,
-			181, 101, 114, 172, 233, 173, 135, 78, 184, 3, 108, 119, 105, 51,
-			110, 53, 207, 193, 47, 241, 237, 8, 200, 92, 136, 156, 200, 162,
-			146, 168, 237, 214, 232, 165, 11, 31, 140, 94, 104, 141, 94, 168,
-			47, 95, 152, 155, 186, 112, 127, 234, 194, 82, 233, 66, 227, 243,
-			151, 74, 116, 222, 123, 236, 110, 123, 145, 11, 215, 28, 214, 65,
-			201, 40, 65, 188, 6, 8, 171, 18, 212, 29, 152, 172, 151, 34,
-			250, 112, 173, 178, 180, 40, 152, 154, 187, 184, 89, 213, 121, 114,
-			120, 100, 237, 209, 48, 81, 141, 82, 62, 12, 234, 56, 18, 236,
-			199, 40, 220, 23, 156, 182, 7, 3, 34, 158, 226, 45, 2, 105,
-			29, 219, 93, 54, 180, 83, 84, 112, 97, 242, 206, 133, 201, 59,
-			132, 142, 0, 146, 151, 176, 144, 17, 150, 140, 33, 173, 57, 109,
-			88, 32, 65, 67, 181, 215, 148, 123, 62, 87, 230, 202, 254, 7,
-			109, 238, 0, 49, 0, 92, 234, 155, 90, 254, 16, 249, 142, 70,
-			76, 196, 150, 250, 150, 166, 31, 177, 255, 154, 70, 171, 201, 13,
-			87, 204, 253, 160, 1, 83, 30, 250, 56, 242, 252, 154, 202, 101,
-			145, 222, 108, 22, 189, 207, 97, 239, 159, 117, 45, 34, 189, 238,
-			69, 159, 71, 5, 86, 228, 73, 176, 37, 52, 182, 252, 150, 166,
-			247, 137, 164, 198, 146, 249, 131, 34, 105, 176, 164, 117, 24, 113,
-			112, 193, 136, 242, 199, 52, 221, 178, 255, 80, 163, 11, 129, 63,
-			234, 187, 27, 120, 15, 78, 221, 166, 29, 113, 107, 100, 23, 201,
-			222, 183, 233, 5, 254, 161, 188, 96, 114, 5, 57, 136, 36, 147,
-			194, 64, 112, 138, 193, 2, 193, 17, 217, 87, 235, 132, 162, 249,
-			135, 220, 0, 18, 47, 232, 141, 32, 100, 23, 99, 33, 61, 232,
-			238, 48, 126, 105, 44, 242, 191, 164, 71, 167, 104, 89, 104, 167,
-			232, 20, 13, 154, 157, 223, 47, 146, 6, 75, 14, 30, 146, 154,
-			140, 127, 125, 130, 92, 223, 8, 74, 181, 205, 48, 104, 121, 157,
-			22, 218, 77, 117, 106, 222, 24, 106, 62, 235, 235, 40, 82, 31,
-			219, 154, 24, 115, 194, 216, 107, 56, 181, 152, 235, 55, 6, 89,
-			182, 146, 200, 86, 218, 154, 176, 159, 103, 55, 104, 63, 79, 103,
-			82, 248, 57, 157, 228, 203, 188, 26, 203, 34, 166, 239, 180, 92,
-			208, 143, 244, 87, 225, 183, 117, 150, 12, 8, 50, 86, 189, 58,
-			168, 72, 250, 171, 68, 60, 170, 212, 173, 147, 164, 191, 225, 198,
-			181, 205, 213, 78, 216, 28, 50, 224, 117, 30, 30, 172, 132, 77,
-			107, 158, 28, 145, 47, 87, 193, 182, 5, 238, 255, 67, 38, 213,
-			134, 7, 38, 237, 93, 198, 126, 242, 96, 170, 90, 162, 140, 89,
-			249, 149, 245, 10, 217, 199, 221, 103, 192, 133, 114, 40, 11, 181,
-			13, 240, 103, 203, 59, 109, 215, 58, 77, 72, 228, 125, 236, 174,
-			130, 9, 233, 80, 14, 20, 61, 253, 236, 201, 52, 123, 96, 157,
-			37, 121, 17, 217, 102, 168, 143, 106, 195, 251, 166, 141, 239, 151,
-			205, 170, 124, 104, 29, 39, 125, 27, 181, 104, 181, 19, 122, 67,
-			121, 40, 61, 183, 81, 139, 86, 66, 111, 122, 226, 243, 99, 47,
-			54, 106, 55, 241, 73, 123, 253, 237, 175, 28, 67, 75, 192, 183,
-			53, 242, 219, 137, 37, 224, 175, 167, 45, 1, 39, 199, 129, 95,
-			152, 95, 153, 169, 208, 50, 68, 137, 129, 219, 255, 255, 231, 12,
-			2, 95, 90, 4, 254, 0, 45, 2, 79, 36, 22, 129, 35, 240,
-			83, 179, 140, 195, 153, 17, 105, 7, 120, 87, 8, 103, 217, 207,
-			223, 212, 65, 144, 106, 158, 201, 188, 173, 217, 191, 172, 67, 212,
-			182, 166, 155, 192, 167, 113, 188, 40, 7, 177, 220, 198, 98, 23,
-			48, 193, 165, 95, 166, 67, 217, 19, 42, 247, 34, 25, 45, 23,
-			161, 200, 184, 254, 140, 221, 70, 176, 119, 28, 186, 236, 70, 113,
-			149, 155, 113, 128, 103, 121, 197, 223, 82, 156, 209, 193, 72, 76,
-			60, 24, 109, 50, 254, 73, 150, 78, 91, 176, 44, 214, 185, 215,
-			8, 103, 229, 165, 229, 151, 39, 50, 248, 65, 12, 161, 90, 72,
-			130, 115, 17, 119, 43, 144, 0, 85, 16, 27, 69, 163, 216, 109,
-			211, 102, 176, 193, 113, 55, 48, 38, 7, 56, 0, 119, 218, 237,
-			32, 140, 33, 66, 9, 220, 29, 192, 191, 237, 73, 76, 189, 250,
-			20, 189, 129, 86, 87, 236, 148, 54, 206, 228, 7, 201, 87, 76,
-			126, 72, 27, 35, 186, 101, 255, 107, 131, 206, 32, 159, 37, 106,
-			148, 32, 44, 176, 216, 148, 30, 67, 150, 2, 112, 91, 238, 130,
-			48, 116, 143, 230, 35, 2, 78, 33, 121, 31, 141, 125, 82, 89,
-			120, 119, 113, 166, 204, 102, 227, 106, 229, 206, 83, 121, 38, 68,
-			99, 159, 148, 171, 203, 149, 187, 229, 153, 101, 246, 188, 80, 18,
-			133, 179, 222, 26, 197, 61, 232, 211, 151, 14, 93, 61, 246, 201,
-			74, 117, 126, 117, 118, 105, 166, 252, 96, 246, 206, 234, 242, 236,
-			18, 212, 192, 119, 186, 104, 236, 147, 234, 236, 210, 202, 252, 242,
-			243, 168, 193, 107, 95, 143, 146, 4, 36, 9, 171, 107, 213, 171,
-			83, 55, 170, 57, 109, 174, 97, 76, 180, 205, 170, 50, 253, 241,
-			198, 152, 239, 198, 99, 157, 176, 57, 118, 238, 129, 19, 111, 206,
-			194, 23, 116, 56, 114, 93, 234, 52, 163, 64, 126, 228, 120, 237,
-			82, 221, 221, 26, 155, 152, 156, 28, 225, 214, 88, 10, 89, 176,
-			37, 112, 165, 39, 219, 115, 32, 142, 169, 98, 108, 74, 11, 81,
-			45, 116, 93, 63, 218, 12, 226, 82, 219, 223, 40, 20, 105, 33,
-			14, 157, 154, 27, 141, 57, 236, 142, 92, 64, 91, 5, 228, 131,
-			140, 17, 61, 47, 82, 154, 101, 140, 244, 239, 23, 41, 195, 50,
-			70, 6, 15, 145, 255, 86, 48, 65, 198, 152, 126, 194, 254, 125,
-			141, 195, 160, 52, 19, 103, 5, 105, 34, 41, 122, 177, 72, 59,
-			190, 247, 81, 199, 21, 56, 60, 241, 238, 133, 86, 34, 244, 126,
-			249, 3, 142, 245, 217, 132, 0, 60, 104, 182, 115, 127, 101, 9,
-			180, 102, 220, 173, 155, 135, 141, 135, 44, 108, 119, 154, 91, 92,
-			153, 191, 35, 15, 131, 117, 167, 246, 152, 127, 93, 34, 180, 234,
-			110, 184, 79, 166, 232, 23, 31, 62, 156, 218, 14, 194, 250, 212,
-			163, 71, 195, 15, 31, 78, 193, 133, 113, 234, 209, 163, 79, 198,
-			139, 147, 215, 175, 61, 77, 94, 142, 188, 121, 94, 244, 131, 150,
-			101, 173, 19, 253, 160, 177, 182, 246, 31, 17, 41, 195, 50, 198,
-			142, 15, 17, 31, 186, 65, 183, 140, 171, 250, 113, 219, 161, 101,
-			202, 49, 60, 162, 205, 32, 140, 71, 155, 160, 199, 93, 169, 206,
-			131, 207, 39, 59, 242, 185, 183, 44, 15, 63, 215, 213, 67, 124,
-			163, 133, 65, 239, 197, 88, 200, 17, 210, 179, 172, 66, 65, 25,
-			27, 133, 171, 253, 150, 72, 25, 150, 113, 245, 232, 49, 50, 15,
-			148, 25, 150, 241, 170, 126, 221, 126, 147, 190, 199, 118, 6, 89,
-			40, 183, 196, 141, 74, 180, 210, 224, 191, 235, 69, 26, 186, 163,
-			210, 132, 138, 173, 241, 178, 164, 139, 151, 109, 228, 88, 113, 39,
-			69, 74, 179, 140, 87, 79, 141, 139, 20, 171, 234, 234, 53, 242,
-			151, 113, 102, 152, 150, 113, 83, 183, 237, 152, 222, 119, 235, 30,
-			199, 104, 217, 221, 220, 121, 182, 105, 57, 8, 94, 194, 119, 228,
-			66, 236, 62, 137, 199, 218, 77, 199, 243, 11, 136, 33, 38, 167,
-			237, 174, 172, 94, 203, 217, 112, 199, 216, 100, 46, 37, 160, 107,
-			146, 90, 51, 203, 104, 16, 189, 100, 106, 150, 113, 179, 255, 168,
-			72, 25, 150, 113, 115, 232, 4, 249, 49, 164, 54, 107, 25, 111,
-			233, 199, 237, 175, 105, 116, 201, 251, 216, 77, 236, 243, 192, 18,
-			77, 221, 7, 61, 159, 174, 84, 192, 248, 17, 64, 139, 165, 199,
-			159, 234, 184, 175, 52, 145, 122, 62, 250, 26, 135, 220, 212, 54,
-			218, 68, 139, 5, 135, 54, 61, 255, 49, 245, 26, 28, 251, 38,
-			14, 2, 140, 250, 41, 169, 207, 2, 77, 125, 34, 165, 89, 198,
-			91, 121, 49, 198, 89, 195, 50, 222, 58, 122, 140, 252, 99, 164,
-			62, 103, 25, 119, 245, 105, 251, 63, 208, 232, 204, 222, 51, 75,
-			184, 210, 38, 214, 218, 220, 208, 207, 121, 236, 250, 140, 69, 153,
-			118, 226, 218, 230, 12, 216, 205, 137, 129, 143, 134, 71, 74, 168,
-			69, 219, 112, 225, 38, 88, 125, 48, 19, 21, 49, 144, 185, 19,
-			209, 121, 47, 138, 149, 172, 69, 90, 71, 75, 220, 118, 208, 6,
-			176, 190, 196, 214, 151, 221, 194, 20, 195, 67, 225, 80, 142, 182,
-			152, 178, 201, 185, 44, 107, 136, 104, 114, 78, 179, 140, 187, 249,
-			65, 145, 50, 44, 227, 238, 97, 177, 252, 114, 121, 203, 184, 123,
-			180, 76, 14, 146, 60, 164, 126, 50, 159, 177, 140, 187, 199, 223,
-			36, 101, 232, 144, 62, 203, 168, 232, 71, 237, 107, 112, 152, 223,
-			155, 89, 162, 43, 213, 74, 119, 151, 96, 223, 95, 138, 148, 32,
-			143, 247, 102, 150, 36, 45, 125, 89, 86, 134, 152, 60, 125, 154,
-			101, 84, 250, 5, 45, 125, 134, 101, 84, 14, 31, 145, 151, 158,
-			223, 127, 157, 92, 125, 193, 75, 79, 45, 104, 181, 2, 255, 185,
-			87, 158, 61, 111, 52, 219, 164, 239, 93, 39, 244, 28, 63, 182,
-			174, 17, 163, 238, 54, 134, 52, 106, 12, 15, 76, 22, 74, 221,
-			101, 149, 120, 190, 210, 29, 183, 1, 145, 243, 171, 44, 187, 253,
-			42, 201, 139, 7, 214, 32, 49, 30, 187, 59, 252, 66, 196, 126,
-			90, 71, 72, 22, 198, 140, 223, 132, 48, 49, 165, 191, 174, 21,
-			174, 17, 130, 146, 155, 7, 142, 23, 190, 232, 151, 133, 31, 214,
-			200, 254, 123, 94, 236, 53, 221, 104, 38, 104, 181, 60, 184, 133,
-			109, 6, 81, 44, 110, 97, 236, 183, 53, 68, 250, 218, 97, 240,
-			161, 91, 139, 249, 215, 34, 201, 234, 9, 221, 6, 191, 120, 177,
-			159, 236, 198, 86, 131, 146, 86, 55, 157, 104, 19, 174, 90, 253,
-			85, 130, 143, 230, 156, 104, 211, 178, 73, 190, 29, 68, 96, 42,
-			4, 87, 40, 163, 42, 211, 133, 54, 217, 119, 207, 13, 67, 47,
-			158, 1, 179, 177, 79, 73, 204, 49, 146, 67, 115, 51, 160, 199,
-			168, 242, 20, 212, 200, 214, 78, 228, 198, 64, 15, 171, 145, 167,
-			11, 77, 114, 0, 27, 254, 128, 211, 240, 103, 238, 0, 181, 125,
-			102, 87, 251, 126, 68, 35, 135, 211, 213, 85, 129, 194, 55, 72,
-			222, 117, 194, 166, 231, 242, 122, 7, 38, 233, 238, 249, 210, 245,
-			161, 252, 194, 122, 157, 228, 192, 31, 17, 137, 123, 145, 111, 121,
-			254, 194, 54, 154, 40, 34, 17, 175, 238, 34, 226, 89, 55, 228,
-			164, 250, 201, 174, 234, 159, 245, 149, 168, 120, 145, 244, 163, 203,
-			109, 213, 109, 88, 175, 147, 190, 13, 156, 131, 188, 222, 83, 187,
-			27, 192, 39, 105, 213, 109, 204, 101, 170, 34, 251, 116, 158, 228,
-			208, 29, 182, 48, 79, 72, 146, 229, 207, 58, 134, 159, 229, 186,
-			253, 63, 130, 161, 228, 129, 204, 207, 106, 207, 186, 111, 79, 220,
-			120, 121, 223, 126, 121, 223, 254, 179, 220, 183, 133, 7, 222, 221,
-			196, 3, 239, 46, 249, 151, 4, 47, 217, 227, 153, 235, 154, 253,
-			71, 132, 150, 233, 99, 119, 103, 20, 109, 157, 91, 78, 155, 131,
-			157, 175, 35, 74, 14, 59, 246, 225, 228, 65, 1, 40, 220, 175,
-			107, 14, 212, 8, 147, 19, 124, 226, 229, 83, 25, 173, 255, 137,
-			91, 131, 174, 245, 124, 5, 162, 96, 219, 217, 225, 81, 75, 197,
-			77, 56, 240, 137, 242, 126, 113, 169, 72, 239, 61, 88, 17, 38,
-			182, 201, 11, 142, 250, 46, 113, 193, 131, 144, 134, 29, 31, 229,
-			247, 77, 103, 131, 93, 12, 202, 146, 78, 5, 200, 173, 230, 180,
-			49, 214, 175, 210, 14, 200, 155, 16, 44, 46, 31, 98, 40, 122,
-			148, 2, 241, 66, 154, 77, 54, 221, 216, 103, 178, 28, 66, 239,
-			117, 188, 186, 139, 240, 35, 24, 233, 126, 215, 183, 232, 125, 8,
-			183, 105, 96, 217, 194, 78, 211, 165, 27, 29, 48, 103, 223, 222,
-			116, 98, 214, 245, 17, 94, 136, 192, 105, 7, 164, 232, 210, 234,
-			53, 13, 6, 91, 22, 120, 68, 104, 141, 238, 214, 21, 7, 20,
-			225, 173, 166, 82, 136, 106, 87, 8, 26, 2, 96, 36, 126, 228,
-			177, 105, 227, 197, 136, 167, 138, 17, 117, 135, 49, 52, 3, 134,
-			217, 110, 52, 157, 199, 94, 115, 103, 4, 46, 18, 200, 202, 122,
-			173, 118, 16, 198, 28, 230, 74, 142, 136, 7, 238, 24, 128, 241,
-			137, 48, 186, 53, 55, 132, 157, 160, 238, 181, 4, 192, 59, 224,
-			2, 137, 72, 176, 219, 238, 58, 202, 70, 128, 233, 151, 211, 99,
-			151, 41, 53, 165, 145, 23, 131, 253, 52, 109, 135, 65, 141, 45,
-			37, 47, 10, 16, 148, 99, 4, 244, 188, 62, 199, 127, 17, 125,
-			230, 176, 46, 228, 82, 114, 49, 220, 28, 63, 179, 155, 24, 7,
-			205, 125, 17, 74, 136, 221, 232, 163, 142, 23, 187, 81, 169, 189,
-			195, 177, 115, 23, 5, 0, 89, 17, 240, 4, 35, 4, 163, 87,
-			214, 0, 66, 135, 178, 171, 130, 35, 125, 167, 192, 110, 15, 213,
-			138, 220, 80, 237, 249, 99, 205, 46, 191, 47, 48, 222, 220, 30,
-			158, 191, 78, 86, 105, 228, 198, 212, 141, 34, 57, 110, 172, 152,
-			56, 145, 84, 136, 121, 177, 233, 49, 110, 120, 7, 10, 75, 41,
-			50, 189, 6, 189, 183, 64, 157, 144, 95, 215, 156, 86, 224, 111,
-			200, 54, 38, 213, 180, 29, 47, 140, 120, 167, 59, 117, 132, 176,
-			97, 101, 249, 238, 54, 255, 158, 83, 24, 41, 200, 6, 50, 88,
-			118, 122, 46, 58, 168, 40, 97, 100, 122, 113, 148, 144, 6, 234,
-			207, 25, 126, 118, 22, 85, 34, 128, 54, 88, 57, 235, 157, 218,
-			99, 55, 158, 194, 13, 143, 29, 134, 248, 160, 72, 97, 84, 11,
-			53, 175, 128, 185, 192, 222, 34, 149, 13, 158, 136, 124, 77, 207,
-			239, 60, 25, 13, 221, 38, 100, 79, 38, 192, 148, 58, 49, 8,
-			63, 174, 162, 169, 177, 177, 90, 148, 62, 213, 69, 98, 44, 10,
-			107, 32, 126, 242, 252, 141, 49, 168, 100, 61, 136, 199, 186, 102,
-			84, 34, 142, 27, 207, 31, 36, 95, 22, 210, 184, 107, 250, 25,
-			59, 132, 157, 83, 5, 156, 20, 225, 220, 197, 14, 245, 57, 119,
-			7, 122, 140, 95, 185, 68, 60, 104, 140, 86, 156, 112, 238, 188,
-			155, 100, 198, 34, 141, 240, 168, 241, 66, 130, 1, 200, 67, 199,
-			67, 148, 31, 46, 240, 201, 49, 10, 142, 42, 194, 160, 107, 199,
-			78, 40, 194, 160, 107, 167, 78, 147, 119, 9, 0, 232, 188, 158,
-			121, 83, 179, 223, 102, 155, 14, 26, 107, 164, 167, 69, 137, 46,
-			203, 139, 59, 92, 165, 225, 76, 115, 54, 54, 240, 28, 118, 93,
-			85, 144, 26, 59, 27, 17, 118, 135, 166, 89, 198, 235, 121, 139,
-			124, 142, 152, 166, 198, 186, 227, 166, 126, 200, 190, 157, 72, 117,
-			156, 209, 143, 31, 177, 127, 198, 71, 111, 172, 62, 186, 60, 60,
-			214, 245, 96, 228, 242, 121, 66, 239, 59, 79, 56, 222, 197, 20,
-			125, 245, 26, 111, 154, 6, 114, 46, 33, 31, 208, 160, 105, 55,
-			251, 247, 137, 148, 97, 25, 55, 15, 14, 146, 179, 80, 173, 102,
-			25, 183, 245, 195, 182, 149, 42, 105, 242, 250, 171, 178, 40, 45,
-			203, 114, 136, 162, 24, 205, 183, 251, 15, 136, 148, 97, 25, 183,
-			15, 89, 228, 175, 235, 68, 55, 117, 203, 188, 151, 249, 162, 102,
-			127, 77, 167, 169, 123, 145, 244, 13, 197, 133, 33, 56, 122, 49,
-			206, 156, 33, 165, 120, 207, 225, 129, 168, 121, 103, 17, 26, 58,
-			62, 98, 30, 71, 113, 81, 184, 56, 65, 9, 65, 184, 115, 73,
-			126, 212, 12, 54, 74, 244, 126, 16, 38, 92, 4, 58, 123, 177,
-			220, 13, 153, 141, 64, 62, 182, 200, 98, 20, 68, 165, 9, 99,
-			172, 238, 24, 103, 108, 199, 66, 23, 192, 234, 214, 61, 238, 44,
-			47, 29, 86, 68, 81, 238, 19, 143, 109, 224, 158, 95, 132, 232,
-			232, 220, 167, 13, 196, 95, 79, 98, 46, 126, 102, 157, 123, 47,
-			127, 148, 124, 145, 152, 166, 206, 6, 248, 109, 221, 178, 223, 129,
-			249, 222, 189, 61, 136, 94, 96, 68, 200, 165, 44, 150, 27, 94,
-			10, 184, 28, 177, 22, 180, 10, 37, 54, 240, 126, 221, 225, 187,
-			6, 27, 12, 29, 198, 252, 109, 62, 80, 58, 140, 249, 219, 92,
-			182, 169, 195, 152, 191, 61, 120, 8, 166, 26, 8, 213, 238, 235,
-			71, 97, 170, 137, 190, 228, 190, 31, 190, 236, 137, 110, 34, 216,
-			50, 47, 148, 122, 84, 203, 230, 199, 125, 89, 45, 155, 31, 247,
-			185, 52, 65, 135, 249, 113, 255, 48, 192, 18, 177, 122, 117, 203,
-			168, 234, 135, 236, 191, 172, 81, 62, 51, 66, 183, 33, 234, 9,
-			221, 70, 52, 182, 233, 58, 245, 104, 172, 5, 242, 49, 37, 194,
-			65, 210, 239, 128, 152, 11, 162, 62, 20, 248, 243, 120, 234, 235,
-			161, 227, 215, 54, 161, 13, 69, 96, 226, 213, 226, 240, 101, 161,
-			87, 151, 233, 89, 70, 145, 160, 157, 245, 75, 149, 47, 19, 29,
-			132, 141, 213, 131, 131, 228, 6, 144, 110, 88, 198, 138, 126, 194,
-			46, 10, 202, 231, 102, 223, 167, 75, 115, 229, 137, 18, 136, 144,
-			154, 193, 182, 27, 2, 35, 216, 163, 18, 35, 203, 190, 21, 149,
-			24, 154, 101, 172, 112, 89, 171, 14, 146, 197, 149, 227, 67, 228,
-			63, 212, 161, 22, 211, 50, 30, 233, 71, 237, 95, 214, 233, 29,
-			132, 130, 102, 76, 102, 16, 59, 77, 238, 171, 200, 17, 20, 1,
-			112, 75, 8, 154, 26, 192, 195, 225, 170, 218, 114, 139, 180, 21,
-			248, 65, 28, 248, 124, 59, 242, 252, 90, 232, 58, 17, 26, 152,
-			197, 238, 134, 27, 162, 153, 83, 232, 34, 232, 25, 68, 67, 216,
-			118, 96, 30, 226, 253, 5, 229, 124, 28, 80, 87, 137, 109, 70,
-			55, 130, 56, 80, 109, 185, 55, 188, 120, 20, 241, 65, 221, 144,
-			80, 20, 62, 208, 118, 179, 179, 225, 249, 37, 100, 30, 168, 48,
-			5, 136, 4, 43, 12, 187, 163, 19, 209, 109, 183, 217, 100, 55,
-			20, 176, 174, 36, 236, 73, 156, 160, 148, 111, 186, 45, 87, 226,
-			215, 39, 81, 255, 155, 59, 24, 90, 156, 183, 121, 119, 71, 155,
-			89, 214, 125, 125, 34, 165, 89, 198, 163, 188, 152, 137, 166, 97,
-			25, 143, 14, 31, 33, 148, 0, 254, 153, 147, 249, 80, 179, 143,
-			0, 60, 25, 146, 205, 133, 26, 124, 225, 178, 65, 114, 242, 71,
-			200, 28, 49, 77, 131, 45, 220, 154, 110, 217, 55, 69, 102, 182,
-			60, 112, 170, 165, 151, 200, 104, 232, 110, 121, 238, 118, 175, 229,
-			10, 84, 24, 176, 68, 107, 124, 42, 24, 176, 68, 107, 124, 137,
-			26, 176, 68, 107, 131, 135, 200, 85, 168, 83, 179, 12, 87, 63,
-			106, 95, 148, 4, 226, 190, 212, 123, 85, 138, 226, 217, 82, 116,
-			101, 241, 108, 41, 186, 124, 41, 26, 176, 20, 221, 195, 71, 200,
-			8, 20, 175, 91, 198, 134, 126, 216, 62, 69, 81, 90, 196, 113,
-			94, 121, 233, 19, 147, 87, 175, 93, 151, 133, 178, 53, 178, 193,
-			123, 213, 128, 53, 178, 145, 63, 32, 82, 134, 101, 108, 28, 178,
-			200, 48, 20, 106, 88, 134, 167, 31, 181, 79, 210, 7, 172, 55,
-			129, 47, 75, 151, 43, 203, 100, 75, 194, 147, 101, 178, 222, 246,
-			242, 130, 80, 182, 36, 188, 195, 71, 200, 53, 2, 224, 118, 173,
-			204, 182, 102, 15, 43, 161, 9, 167, 232, 74, 228, 118, 157, 48,
-			60, 28, 28, 31, 61, 54, 242, 173, 252, 49, 220, 117, 76, 54,
-			124, 109, 221, 98, 187, 206, 114, 202, 179, 78, 224, 28, 160, 86,
-			103, 7, 110, 13, 177, 114, 188, 112, 235, 58, 118, 18, 112, 181,
-			142, 220, 141, 8, 107, 152, 27, 2, 236, 191, 60, 207, 64, 45,
-			149, 14, 109, 162, 64, 166, 122, 66, 213, 96, 194, 44, 104, 243,
-			97, 50, 97, 22, 180, 249, 44, 48, 97, 22, 180, 7, 15, 145,
-			1, 32, 93, 179, 140, 143, 56, 131, 98, 194, 232, 126, 36, 63,
-			99, 163, 251, 17, 31, 93, 19, 70, 247, 163, 195, 71, 248, 103,
-			186, 101, 132, 250, 33, 254, 138, 141, 95, 40, 63, 99, 69, 134,
-			124, 143, 195, 109, 45, 60, 56, 72, 86, 225, 51, 195, 50, 182,
-			244, 163, 118, 85, 132, 175, 232, 110, 32, 63, 170, 106, 162, 207,
-			209, 151, 58, 216, 144, 87, 248, 238, 19, 148, 40, 161, 213, 88,
-			117, 108, 216, 183, 248, 176, 155, 48, 236, 91, 121, 209, 2, 54,
-			236, 91, 135, 143, 144, 139, 132, 173, 227, 236, 199, 153, 175, 104,
-			154, 125, 34, 53, 238, 169, 160, 250, 108, 160, 179, 154, 101, 124,
-			156, 63, 73, 166, 137, 105, 102, 217, 56, 127, 73, 63, 99, 95,
-			199, 104, 32, 193, 54, 220, 106, 145, 86, 217, 4, 16, 145, 128,
-			119, 191, 148, 212, 179, 249, 207, 41, 204, 2, 75, 248, 37, 244,
-			245, 102, 41, 205, 50, 190, 116, 232, 132, 72, 25, 150, 241, 165,
-			83, 167, 201, 50, 212, 166, 89, 198, 151, 245, 83, 246, 61, 90,
-			225, 229, 1, 118, 97, 87, 125, 210, 27, 220, 137, 225, 110, 129,
-			254, 41, 205, 29, 60, 48, 132, 31, 187, 192, 245, 96, 165, 230,
-			88, 177, 162, 126, 54, 198, 95, 62, 116, 92, 164, 12, 203, 248,
-			178, 125, 146, 76, 17, 221, 204, 89, 185, 111, 104, 153, 111, 105,
-			154, 93, 164, 101, 238, 66, 195, 134, 71, 218, 173, 49, 54, 135,
-			27, 43, 51, 166, 212, 103, 123, 41, 71, 64, 204, 105, 150, 249,
-			13, 45, 127, 136, 220, 34, 166, 153, 211, 51, 150, 249, 77, 77,
-			31, 177, 199, 160, 223, 130, 102, 29, 116, 100, 137, 157, 233, 158,
-			61, 182, 159, 100, 217, 231, 57, 248, 254, 164, 72, 130, 93, 220,
-			169, 243, 34, 105, 176, 228, 165, 97, 242, 54, 212, 165, 89, 230,
-			95, 213, 244, 75, 246, 27, 233, 94, 83, 204, 237, 118, 119, 21,
-			163, 40, 221, 85, 88, 180, 150, 131, 194, 68, 197, 26, 148, 125,
-			170, 32, 146, 6, 75, 94, 184, 72, 166, 137, 110, 246, 89, 185,
-			31, 213, 50, 127, 71, 211, 236, 107, 170, 117, 158, 147, 192, 71,
-			242, 184, 10, 194, 165, 216, 143, 195, 160, 73, 81, 62, 202, 123,
-			173, 79, 179, 204, 31, 101, 189, 246, 47, 216, 174, 210, 151, 207,
-			88, 185, 111, 107, 250, 223, 214, 12, 251, 191, 192, 141, 165, 231,
-			199, 20, 251, 29, 209, 132, 5, 143, 231, 69, 194, 122, 33, 129,
-			253, 167, 173, 0, 129, 141, 43, 13, 110, 149, 139, 5, 160, 47,
-			150, 0, 136, 78, 62, 243, 124, 142, 47, 195, 111, 243, 126, 224,
-			143, 138, 242, 55, 188, 184, 72, 163, 206, 250, 22, 202, 61, 139,
-			28, 181, 8, 180, 65, 206, 134, 75, 248, 141, 49, 66, 17, 194,
-			142, 56, 155, 209, 2, 123, 211, 13, 93, 236, 226, 190, 60, 27,
-			204, 111, 107, 249, 3, 100, 140, 53, 153, 205, 148, 31, 215, 204,
-			147, 246, 43, 180, 44, 56, 46, 207, 151, 173, 74, 54, 78, 62,
-			68, 125, 48, 55, 126, 92, 51, 15, 136, 164, 198, 146, 7, 143,
-			137, 164, 193, 146, 39, 108, 242, 6, 209, 205, 188, 149, 251, 187,
-			90, 230, 103, 53, 205, 46, 165, 135, 40, 169, 200, 233, 93, 21,
-			27, 156, 188, 102, 153, 127, 87, 203, 91, 100, 134, 152, 102, 158,
-			17, 250, 29, 77, 183, 248, 86, 240, 105, 89, 107, 32, 47, 15,
-			38, 147, 223, 209, 244, 188, 72, 106, 44, 217, 191, 95, 36, 13,
-			150, 28, 60, 68, 102, 161, 70, 205, 50, 255, 190, 166, 31, 181,
-			95, 67, 27, 27, 220, 2, 5, 143, 246, 44, 2, 196, 1, 142,
-			165, 106, 89, 40, 71, 212, 169, 65, 177, 253, 131, 34, 105, 176,
-			228, 225, 35, 228, 107, 26, 84, 170, 91, 230, 207, 104, 250, 33,
-			187, 243, 23, 194, 78, 115, 162, 244, 44, 80, 33, 72, 102, 61,
-			241, 51, 90, 255, 62, 145, 52, 88, 242, 224, 160, 212, 39, 254,
-			222, 126, 114, 243, 5, 245, 137, 92, 164, 180, 202, 248, 215, 189,
-			245, 138, 133, 25, 178, 255, 46, 230, 172, 66, 70, 107, 146, 28,
-			109, 135, 94, 203, 9, 119, 86, 1, 158, 102, 149, 3, 148, 113,
-			149, 198, 97, 254, 114, 150, 189, 187, 143, 175, 62, 139, 214, 226,
-			255, 28, 64, 35, 193, 11, 207, 52, 18, 156, 120, 169, 180, 120,
-			169, 180, 248, 212, 74, 11, 212, 84, 88, 137, 166, 130, 253, 252,
-			58, 55, 7, 28, 202, 92, 208, 236, 255, 93, 163, 149, 93, 209,
-			2, 183, 55, 165, 213, 31, 91, 60, 8, 241, 210, 21, 6, 143,
-			219, 218, 113, 163, 14, 238, 97, 144, 56, 235, 114, 32, 41, 0,
-			167, 241, 92, 224, 147, 87, 42, 40, 85, 3, 113, 47, 200, 77,
-			20, 203, 185, 90, 179, 19, 197, 236, 254, 199, 195, 225, 9, 65,
-			112, 68, 227, 96, 3, 164, 221, 61, 35, 199, 32, 178, 22, 7,
-			113, 118, 162, 200, 13, 49, 20, 56, 151, 34, 11, 72, 65, 52,
-			146, 137, 157, 218, 99, 138, 246, 92, 138, 93, 223, 80, 254, 40,
-			249, 61, 105, 215, 119, 94, 63, 103, 255, 150, 137, 224, 177, 128,
-			73, 37, 80, 166, 129, 161, 232, 52, 99, 175, 229, 64, 136, 114,
-			128, 243, 170, 39, 34, 217, 56, 128, 90, 121, 79, 161, 223, 12,
-			65, 182, 100, 157, 99, 20, 165, 202, 3, 138, 208, 187, 70, 193,
-			138, 2, 103, 245, 52, 161, 180, 236, 75, 205, 206, 182, 192, 176,
-			141, 55, 101, 227, 112, 71, 118, 124, 58, 11, 43, 22, 116, 43,
-			108, 29, 130, 83, 30, 35, 173, 4, 210, 96, 216, 172, 29, 17,
-			39, 41, 53, 186, 180, 142, 120, 60, 74, 88, 181, 182, 91, 227,
-			176, 32, 98, 28, 138, 24, 162, 194, 107, 181, 220, 186, 135, 93,
-			208, 112, 98, 167, 41, 251, 58, 1, 216, 173, 109, 6, 145, 235,
-			131, 189, 102, 18, 124, 68, 198, 15, 32, 189, 138, 103, 52, 54,
-			84, 180, 35, 47, 66, 80, 34, 30, 191, 68, 92, 178, 134, 85,
-			52, 178, 32, 114, 147, 8, 6, 137, 205, 233, 8, 233, 54, 4,
-			229, 147, 11, 105, 224, 228, 38, 87, 61, 228, 186, 20, 155, 38,
-			209, 177, 66, 64, 236, 126, 212, 113, 32, 120, 76, 16, 210, 168,
-			229, 52, 155, 130, 143, 156, 24, 159, 188, 38, 177, 138, 9, 93,
-			89, 190, 59, 250, 122, 202, 68, 240, 124, 202, 68, 240, 124, 255,
-			25, 69, 42, 124, 254, 149, 130, 60, 205, 254, 239, 2, 57, 213,
-			109, 214, 130, 222, 209, 123, 33, 27, 253, 29, 141, 228, 150, 32,
-			135, 117, 147, 228, 176, 119, 184, 157, 203, 185, 93, 202, 127, 204,
-			136, 56, 185, 17, 26, 186, 240, 79, 236, 119, 200, 128, 242, 184,
-			135, 209, 74, 81, 53, 90, 25, 152, 60, 182, 171, 240, 119, 217,
-			91, 213, 12, 230, 31, 234, 36, 11, 15, 173, 155, 132, 248, 157,
-			102, 115, 21, 11, 96, 133, 30, 232, 97, 154, 176, 208, 105, 54,
-			33, 255, 92, 166, 218, 239, 139, 132, 117, 142, 236, 195, 91, 255,
-			106, 82, 191, 54, 151, 169, 14, 224, 83, 153, 9, 37, 233, 60,
-			19, 24, 16, 176, 76, 248, 20, 51, 157, 37, 100, 61, 8, 4,
-			25, 38, 213, 134, 243, 172, 42, 246, 12, 51, 188, 1, 165, 116,
-			106, 49, 207, 146, 133, 166, 30, 223, 163, 31, 121, 241, 157, 90,
-			44, 91, 217, 244, 34, 241, 109, 110, 15, 3, 140, 121, 47, 138,
-			101, 43, 155, 34, 49, 157, 35, 230, 99, 207, 175, 23, 110, 146,
-			126, 153, 195, 42, 145, 28, 170, 30, 248, 136, 238, 213, 233, 60,
-			215, 229, 147, 164, 95, 118, 162, 117, 128, 144, 133, 149, 249, 249,
-			213, 119, 203, 243, 43, 179, 131, 153, 233, 47, 247, 70, 177, 26,
-			192, 198, 8, 8, 171, 177, 23, 132, 176, 194, 134, 127, 42, 252,
-			170, 159, 56, 205, 24, 156, 51, 153, 47, 190, 132, 175, 122, 9,
-			95, 245, 18, 190, 234, 37, 124, 213, 75, 248, 170, 151, 240, 85,
-			127, 225, 240, 85, 119, 19, 248, 170, 187, 207, 134, 175, 42, 38,
-			240, 85, 197, 79, 1, 95, 245, 61, 3, 175, 89, 147, 153, 235,
-			154, 253, 115, 6, 93, 195, 3, 119, 45, 141, 92, 133, 231, 105,
-			7, 194, 8, 177, 35, 15, 78, 244, 162, 208, 86, 241, 128, 92,
-			200, 171, 17, 46, 118, 105, 57, 32, 77, 173, 239, 248, 78, 139,
-			107, 231, 216, 9, 45, 204, 21, 192, 91, 28, 162, 205, 136, 187,
-			100, 84, 148, 149, 147, 196, 191, 42, 145, 1, 178, 107, 53, 245,
-			209, 77, 86, 18, 199, 195, 193, 166, 45, 92, 124, 66, 49, 210,
-			4, 68, 237, 22, 197, 67, 64, 114, 250, 246, 146, 108, 14, 98,
-			24, 240, 130, 56, 60, 133, 79, 68, 88, 17, 110, 167, 17, 59,
-			94, 147, 27, 225, 59, 113, 87, 189, 112, 94, 114, 139, 57, 216,
-			77, 241, 10, 72, 18, 1, 4, 134, 151, 17, 206, 91, 226, 26,
-			45, 72, 18, 92, 61, 64, 25, 116, 21, 205, 242, 202, 177, 240,
-			34, 204, 35, 35, 158, 136, 91, 225, 100, 254, 0, 104, 108, 185,
-			121, 9, 181, 139, 116, 69, 130, 212, 178, 17, 8, 26, 123, 143,
-			64, 218, 48, 228, 136, 106, 24, 114, 244, 164, 106, 24, 114, 230,
-			44, 249, 109, 29, 45, 67, 110, 101, 238, 107, 246, 175, 233, 116,
-			13, 184, 184, 174, 89, 178, 71, 77, 10, 114, 5, 187, 166, 120,
-			216, 71, 140, 133, 46, 178, 17, 229, 58, 51, 129, 12, 194, 126,
-			49, 158, 215, 117, 124, 180, 104, 168, 117, 194, 136, 141, 57, 31,
-			52, 62, 245, 130, 144, 58, 68, 30, 202, 98, 78, 149, 133, 187,
-			96, 40, 159, 194, 29, 77, 24, 205, 177, 193, 0, 160, 97, 113,
-			139, 138, 92, 34, 76, 111, 216, 231, 235, 128, 222, 44, 224, 216,
-			164, 45, 157, 68, 198, 16, 24, 208, 207, 29, 57, 222, 61, 98,
-			224, 128, 146, 82, 98, 7, 115, 43, 191, 159, 20, 136, 105, 106,
-			249, 140, 101, 190, 169, 207, 27, 246, 17, 40, 143, 49, 217, 146,
-			114, 97, 147, 146, 103, 67, 242, 102, 126, 31, 185, 36, 44, 103,
-			202, 230, 105, 219, 78, 11, 142, 89, 119, 166, 63, 131, 113, 45,
-			155, 251, 21, 171, 152, 242, 129, 33, 197, 42, 166, 124, 242, 20,
-			232, 71, 193, 42, 102, 198, 60, 101, 159, 74, 23, 201, 163, 188,
-			165, 11, 213, 178, 44, 47, 81, 236, 99, 102, 6, 142, 43, 246,
-			49, 51, 246, 73, 94, 168, 110, 25, 179, 187, 11, 229, 22, 69,
-			233, 66, 245, 44, 203, 43, 83, 154, 101, 204, 202, 66, 117, 195,
-			50, 102, 237, 147, 228, 50, 20, 106, 88, 198, 61, 115, 200, 62,
-			221, 37, 53, 199, 249, 210, 85, 170, 145, 101, 153, 133, 41, 143,
-			161, 89, 198, 189, 254, 195, 34, 197, 10, 58, 118, 156, 140, 66,
-			169, 166, 101, 84, 204, 83, 54, 221, 69, 170, 216, 244, 210, 5,
-			155, 57, 150, 95, 166, 52, 203, 168, 72, 114, 77, 195, 50, 42,
-			246, 73, 94, 112, 214, 50, 62, 103, 158, 238, 46, 88, 198, 198,
-			229, 211, 68, 22, 156, 205, 177, 252, 98, 196, 178, 154, 101, 124,
-			78, 142, 88, 214, 176, 140, 207, 157, 60, 69, 126, 94, 195, 88,
-			6, 75, 153, 119, 53, 251, 39, 53, 186, 38, 239, 84, 107, 60,
-			122, 55, 104, 97, 227, 192, 7, 84, 125, 87, 132, 129, 80, 34,
-			125, 34, 139, 40, 39, 140, 216, 151, 136, 156, 182, 224, 227, 213,
-			241, 57, 247, 246, 236, 153, 158, 174, 30, 178, 173, 177, 178, 215,
-			148, 80, 0, 75, 217, 3, 196, 22, 161, 0, 86, 244, 67, 246,
-			126, 186, 208, 53, 95, 57, 202, 255, 138, 68, 27, 207, 232, 150,
-			177, 114, 112, 144, 124, 85, 67, 99, 171, 135, 153, 47, 106, 246,
-			22, 93, 147, 247, 79, 222, 220, 237, 208, 105, 183, 221, 144, 58,
-			97, 208, 225, 26, 108, 222, 187, 40, 162, 73, 118, 135, 231, 173,
-			217, 116, 201, 144, 205, 9, 67, 103, 71, 49, 111, 122, 152, 63,
-			68, 166, 132, 121, 211, 35, 253, 148, 61, 202, 70, 182, 171, 186,
-			231, 108, 184, 186, 158, 1, 195, 23, 153, 202, 89, 198, 163, 129,
-			65, 197, 144, 233, 17, 87, 130, 162, 33, 211, 35, 251, 164, 148,
-			192, 252, 206, 56, 121, 245, 5, 245, 9, 237, 208, 197, 93, 107,
-			79, 23, 165, 207, 226, 233, 84, 248, 23, 58, 57, 156, 8, 177,
-			30, 136, 74, 172, 243, 228, 0, 119, 148, 93, 13, 221, 13, 247,
-			73, 155, 75, 103, 246, 177, 167, 149, 58, 88, 249, 181, 173, 55,
-			72, 31, 223, 84, 185, 160, 102, 111, 111, 39, 89, 116, 85, 124,
-			98, 61, 32, 132, 11, 229, 252, 218, 14, 8, 81, 14, 76, 142,
-			239, 46, 160, 7, 121, 165, 89, 249, 93, 85, 41, 195, 26, 37,
-			150, 251, 4, 68, 154, 171, 238, 147, 128, 7, 240, 66, 217, 75,
-			245, 16, 127, 51, 43, 95, 20, 26, 132, 36, 5, 89, 125, 196,
-			40, 207, 207, 15, 102, 172, 243, 132, 190, 91, 174, 86, 202, 11,
-			203, 75, 171, 239, 85, 150, 231, 86, 87, 22, 102, 223, 127, 48,
-			59, 179, 60, 123, 103, 21, 157, 144, 151, 6, 53, 107, 132, 92,
-			72, 231, 90, 92, 152, 255, 160, 87, 86, 189, 240, 151, 200, 16,
-			107, 132, 168, 217, 11, 252, 31, 104, 71, 23, 126, 68, 35, 131,
-			221, 111, 173, 171, 36, 7, 34, 70, 225, 123, 115, 98, 207, 18,
-			231, 50, 85, 158, 213, 122, 13, 129, 44, 28, 207, 143, 56, 33,
-			207, 252, 76, 102, 158, 30, 32, 253, 114, 6, 23, 190, 97, 144,
-			67, 194, 61, 49, 33, 168, 74, 246, 225, 205, 123, 213, 173, 111,
-			72, 151, 160, 177, 221, 229, 239, 250, 180, 52, 91, 223, 112, 151,
-			119, 218, 238, 146, 27, 87, 7, 176, 16, 246, 40, 178, 62, 32,
-			71, 161, 119, 241, 243, 85, 73, 2, 39, 254, 194, 11, 205, 182,
-			234, 225, 184, 199, 10, 41, 145, 195, 42, 42, 136, 24, 61, 116,
-			38, 58, 164, 128, 131, 240, 33, 44, 18, 75, 65, 52, 17, 217,
-			209, 77, 110, 48, 1, 54, 193, 220, 118, 141, 12, 40, 141, 178,
-			38, 200, 17, 17, 215, 97, 85, 113, 164, 135, 62, 202, 87, 15,
-			139, 119, 137, 161, 110, 100, 189, 66, 246, 41, 77, 199, 225, 202,
-			87, 7, 146, 166, 68, 133, 73, 114, 148, 53, 247, 190, 27, 59,
-			236, 26, 144, 180, 237, 4, 201, 243, 73, 137, 194, 192, 254, 106,
-			31, 78, 199, 232, 179, 232, 26, 255, 235, 139, 232, 33, 245, 141,
-			151, 30, 82, 47, 149, 141, 127, 110, 30, 82, 183, 122, 123, 72,
-			253, 186, 134, 23, 226, 147, 153, 25, 205, 254, 69, 45, 205, 185,
-			53, 58, 62, 160, 56, 169, 216, 33, 163, 183, 129, 255, 44, 17,
-			58, 11, 129, 35, 164, 94, 198, 137, 107, 155, 236, 206, 208, 108,
-			170, 158, 25, 48, 73, 239, 7, 81, 76, 107, 77, 15, 163, 135,
-			131, 70, 74, 132, 147, 7, 255, 14, 126, 95, 17, 107, 17, 176,
-			175, 158, 119, 196, 40, 215, 194, 147, 249, 147, 228, 111, 9, 164,
-			46, 227, 140, 126, 202, 254, 166, 38, 60, 159, 184, 186, 19, 228,
-			159, 0, 207, 192, 149, 108, 94, 29, 41, 150, 6, 177, 161, 187,
-			209, 105, 58, 114, 208, 192, 62, 221, 245, 99, 47, 116, 155, 59,
-			69, 10, 128, 131, 160, 47, 148, 175, 185, 203, 80, 211, 171, 121,
-			113, 115, 135, 51, 102, 92, 164, 249, 69, 16, 150, 158, 79, 169,
-			157, 206, 164, 212, 78, 103, 250, 143, 43, 119, 206, 51, 246, 73,
-			176, 222, 6, 96, 138, 87, 244, 179, 246, 237, 103, 208, 47, 238,
-			105, 145, 19, 123, 81, 99, 71, 182, 32, 97, 129, 36, 16, 68,
-			142, 149, 102, 41, 64, 16, 175, 28, 182, 21, 32, 136, 87, 78,
-			159, 1, 43, 216, 12, 155, 1, 231, 245, 87, 13, 123, 138, 222,
-			245, 154, 177, 27, 70, 169, 49, 164, 235, 78, 132, 75, 35, 153,
-			8, 37, 121, 203, 20, 81, 180, 160, 92, 24, 144, 243, 125, 135,
-			32, 60, 106, 6, 71, 228, 146, 185, 207, 222, 4, 61, 64, 170,
-			80, 78, 63, 55, 252, 98, 75, 107, 161, 178, 112, 111, 74, 26,
-			37, 123, 17, 128, 82, 128, 192, 30, 12, 221, 184, 185, 51, 216,
-			8, 161, 7, 62, 106, 8, 89, 95, 55, 157, 216, 245, 107, 140,
-			121, 61, 64, 250, 176, 98, 141, 213, 220, 151, 164, 117, 203, 184,
-			68, 6, 200, 223, 54, 56, 101, 154, 101, 76, 152, 35, 246, 55,
-			141, 94, 125, 189, 238, 2, 52, 108, 28, 136, 9, 35, 122, 29,
-			4, 35, 155, 14, 58, 218, 5, 33, 109, 5, 108, 139, 233, 248,
-			93, 158, 106, 81, 137, 86, 226, 148, 97, 180, 68, 210, 0, 144,
-			70, 229, 3, 64, 167, 70, 119, 52, 210, 237, 240, 22, 137, 232,
-			100, 137, 86, 66, 14, 51, 236, 92, 96, 73, 206, 13, 205, 186,
-			252, 144, 0, 102, 166, 91, 65, 239, 53, 186, 27, 132, 53, 178,
-			229, 254, 160, 188, 180, 4, 212, 108, 66, 40, 46, 168, 159, 208,
-			135, 119, 203, 149, 249, 34, 197, 127, 89, 150, 71, 194, 79, 170,
-			217, 236, 49, 150, 42, 129, 60, 140, 57, 81, 93, 126, 120, 86,
-			233, 48, 162, 76, 90, 49, 82, 26, 140, 204, 249, 36, 173, 91,
-			198, 196, 165, 97, 57, 165, 116, 203, 184, 110, 150, 236, 77, 186,
-			196, 205, 18, 226, 224, 185, 219, 5, 194, 177, 116, 247, 17, 161,
-			34, 236, 21, 95, 92, 254, 78, 175, 17, 16, 132, 176, 41, 115,
-			221, 28, 73, 210, 140, 146, 226, 40, 249, 93, 77, 160, 169, 220,
-			212, 79, 217, 191, 209, 115, 243, 129, 221, 166, 107, 161, 190, 208,
-			186, 122, 214, 238, 201, 78, 136, 16, 195, 45, 73, 37, 189, 18,
-			59, 94, 132, 34, 34, 10, 246, 8, 188, 100, 163, 13, 168, 29,
-			233, 149, 148, 224, 180, 228, 88, 83, 246, 41, 56, 45, 55, 247,
-			31, 87, 112, 90, 110, 218, 39, 201, 239, 104, 2, 168, 101, 90,
-			63, 99, 255, 154, 6, 182, 2, 97, 199, 45, 50, 238, 33, 118,
-			67, 26, 116, 216, 228, 18, 183, 137, 84, 191, 179, 245, 126, 191,
-			19, 99, 172, 53, 184, 120, 128, 204, 11, 246, 206, 228, 202, 81,
-			42, 207, 207, 131, 71, 79, 131, 54, 156, 102, 228, 22, 5, 184,
-			9, 43, 221, 139, 192, 181, 16, 184, 1, 30, 48, 46, 65, 200,
-			77, 119, 255, 174, 24, 80, 9, 85, 9, 66, 76, 150, 181, 35,
-			167, 32, 196, 76, 247, 157, 80, 16, 98, 166, 79, 157, 38, 31,
-			162, 80, 240, 94, 102, 65, 179, 191, 248, 140, 147, 82, 185, 200,
-			188, 248, 113, 233, 38, 31, 69, 138, 232, 236, 94, 158, 146, 159,
-			208, 132, 36, 236, 109, 253, 148, 253, 195, 114, 122, 41, 159, 252,
-			197, 30, 112, 90, 202, 61, 73, 227, 238, 73, 199, 21, 225, 219,
-			219, 246, 73, 242, 64, 8, 223, 238, 235, 103, 237, 153, 231, 53,
-			226, 69, 79, 57, 13, 78, 185, 251, 252, 148, 67, 25, 221, 125,
-			126, 202, 161, 140, 238, 254, 233, 51, 224, 111, 160, 91, 230, 59,
-			153, 15, 52, 123, 120, 143, 161, 227, 247, 178, 100, 200, 164, 28,
-			228, 157, 252, 16, 217, 71, 76, 83, 207, 103, 44, 179, 170, 191,
-			111, 160, 188, 2, 36, 149, 213, 252, 33, 242, 166, 144, 145, 44,
-			155, 199, 237, 73, 197, 65, 187, 203, 88, 101, 83, 236, 216, 104,
-			175, 238, 62, 113, 106, 113, 83, 241, 241, 202, 177, 18, 6, 20,
-			209, 200, 242, 62, 75, 17, 141, 44, 31, 61, 6, 166, 214, 176,
-			32, 223, 51, 79, 216, 183, 146, 170, 46, 69, 221, 222, 172, 88,
-			185, 48, 195, 66, 195, 28, 129, 119, 138, 222, 187, 220, 141, 43,
-			199, 10, 27, 80, 92, 188, 222, 219, 119, 68, 113, 241, 122, 239,
-			248, 16, 185, 142, 142, 53, 95, 200, 60, 213, 236, 145, 61, 186,
-			79, 92, 59, 187, 250, 143, 45, 165, 47, 228, 79, 128, 28, 201,
-			48, 50, 150, 249, 69, 189, 102, 128, 97, 59, 136, 166, 27, 138,
-			247, 228, 37, 136, 144, 184, 17, 176, 177, 102, 119, 92, 16, 39,
-			9, 57, 146, 97, 176, 14, 249, 34, 57, 74, 30, 144, 28, 75,
-			177, 254, 94, 51, 11, 118, 25, 237, 194, 216, 125, 54, 165, 223,
-			88, 223, 81, 29, 51, 123, 93, 15, 37, 231, 194, 246, 115, 3,
-			163, 214, 27, 107, 102, 62, 73, 107, 150, 177, 214, 127, 58, 73,
-			27, 150, 177, 70, 95, 33, 239, 112, 10, 52, 203, 88, 55, 109,
-			123, 90, 193, 222, 27, 173, 109, 122, 16, 23, 14, 29, 185, 163,
-			77, 175, 45, 177, 150, 18, 114, 96, 245, 40, 123, 126, 66, 130,
-			150, 101, 101, 38, 36, 176, 241, 88, 239, 63, 154, 164, 13, 203,
-			88, 31, 58, 65, 126, 79, 23, 222, 75, 45, 253, 172, 253, 243,
-			186, 136, 6, 10, 14, 216, 16, 123, 4, 58, 132, 49, 29, 112,
-			223, 71, 132, 189, 208, 141, 67, 207, 221, 66, 221, 125, 232, 178,
-			217, 55, 230, 249, 226, 39, 73, 130, 35, 74, 4, 185, 46, 254,
-			1, 220, 137, 3, 81, 14, 183, 240, 223, 27, 56, 175, 136, 160,
-			193, 148, 126, 210, 171, 255, 167, 224, 188, 120, 90, 234, 89, 232,
-			51, 0, 243, 208, 108, 249, 19, 245, 250, 46, 139, 34, 116, 90,
-			70, 224, 43, 242, 182, 227, 46, 11, 29, 82, 34, 5, 75, 188,
-			134, 222, 41, 55, 155, 137, 3, 87, 142, 245, 230, 126, 197, 129,
-			171, 117, 192, 86, 28, 184, 90, 167, 207, 144, 255, 89, 19, 30,
-			92, 145, 62, 110, 255, 33, 156, 122, 142, 50, 249, 145, 111, 140,
-			144, 113, 76, 70, 56, 241, 231, 223, 205, 25, 240, 29, 142, 236,
-			218, 223, 120, 88, 218, 46, 158, 1, 2, 123, 138, 163, 111, 7,
-			111, 189, 123, 245, 63, 59, 22, 3, 202, 37, 123, 172, 246, 86,
-			98, 155, 39, 78, 126, 236, 77, 85, 180, 148, 184, 156, 229, 88,
-			51, 143, 42, 46, 103, 209, 177, 43, 138, 203, 89, 84, 26, 35,
-			191, 160, 9, 159, 179, 39, 250, 43, 246, 119, 52, 90, 246, 21,
-			220, 72, 101, 67, 231, 34, 31, 148, 182, 255, 32, 142, 38, 122,
-			71, 25, 231, 66, 233, 114, 33, 229, 245, 246, 68, 186, 210, 177,
-			193, 124, 210, 127, 74, 241, 122, 123, 114, 150, 146, 159, 210, 132,
-			219, 219, 151, 116, 106, 127, 123, 207, 118, 249, 180, 114, 231, 185,
-			205, 233, 106, 13, 249, 12, 205, 161, 123, 55, 135, 241, 46, 95,
-			146, 205, 97, 27, 238, 151, 250, 79, 42, 14, 119, 95, 58, 115,
-			150, 184, 224, 112, 151, 251, 170, 150, 249, 134, 166, 217, 239, 61,
-			131, 123, 17, 226, 174, 23, 103, 93, 90, 252, 11, 238, 175, 193,
-			120, 164, 175, 106, 249, 211, 224, 175, 97, 234, 25, 203, 252, 186,
-			166, 159, 181, 175, 139, 19, 95, 100, 87, 122, 81, 174, 11, 175,
-			46, 207, 40, 12, 58, 10, 174, 6, 166, 158, 49, 161, 20, 153,
-			204, 178, 228, 192, 33, 145, 212, 88, 210, 178, 69, 210, 96, 201,
-			211, 103, 164, 30, 225, 111, 110, 146, 215, 94, 80, 250, 159, 172,
-			165, 207, 14, 239, 252, 76, 171, 209, 231, 66, 165, 125, 38, 69,
-			133, 253, 25, 245, 36, 133, 255, 50, 71, 72, 114, 46, 89, 182,
-			138, 53, 61, 157, 251, 126, 217, 248, 126, 57, 203, 49, 167, 95,
-			39, 89, 8, 216, 14, 162, 210, 3, 189, 68, 236, 202, 121, 11,
-			1, 222, 171, 248, 129, 53, 67, 6, 48, 174, 45, 68, 80, 127,
-			62, 204, 180, 172, 152, 224, 103, 236, 133, 53, 78, 204, 216, 217,
-			136, 134, 178, 96, 125, 217, 3, 10, 43, 1, 139, 168, 66, 78,
-			235, 14, 217, 223, 240, 124, 136, 205, 128, 21, 239, 101, 6, 154,
-			84, 108, 124, 191, 108, 84, 247, 137, 175, 160, 222, 87, 73, 190,
-			238, 58, 245, 166, 231, 187, 0, 78, 253, 28, 248, 47, 145, 119,
-			79, 153, 116, 30, 4, 198, 61, 101, 210, 159, 35, 131, 235, 222,
-			198, 71, 29, 55, 220, 89, 117, 159, 180, 131, 48, 142, 134, 250,
-			161, 185, 61, 160, 203, 166, 189, 141, 119, 88, 206, 89, 200, 88,
-			61, 40, 190, 196, 116, 100, 21, 8, 239, 189, 250, 234, 250, 206,
-			16, 129, 1, 133, 230, 245, 243, 199, 211, 59, 214, 21, 114, 72,
-			88, 16, 172, 10, 44, 211, 161, 1, 148, 185, 139, 23, 85, 254,
-			220, 58, 66, 178, 161, 235, 52, 91, 67, 251, 16, 41, 15, 18,
-			86, 133, 28, 228, 8, 39, 171, 28, 179, 104, 104, 255, 94, 104,
-			107, 115, 152, 17, 1, 47, 163, 234, 129, 205, 84, 218, 122, 141,
-			144, 118, 24, 180, 221, 48, 246, 220, 104, 232, 192, 51, 237, 125,
-			171, 74, 86, 235, 22, 25, 64, 26, 87, 163, 182, 91, 27, 58,
-			184, 23, 88, 26, 66, 171, 49, 78, 169, 74, 34, 249, 187, 80,
-			33, 89, 152, 174, 214, 81, 114, 104, 105, 185, 188, 60, 187, 186,
-			178, 0, 118, 102, 119, 43, 179, 119, 6, 51, 22, 33, 185, 242,
-			204, 114, 229, 221, 217, 65, 205, 58, 64, 200, 221, 202, 66, 121,
-			190, 242, 249, 202, 194, 189, 65, 221, 218, 79, 250, 121, 122, 246,
-			206, 160, 241, 182, 153, 55, 6, 205, 194, 63, 49, 200, 129, 244,
-			240, 88, 167, 19, 148, 53, 77, 12, 133, 158, 64, 173, 157, 38,
-			125, 108, 75, 140, 92, 14, 194, 198, 95, 243, 103, 214, 9, 146,
-			133, 240, 109, 168, 62, 193, 151, 248, 196, 122, 167, 75, 143, 129,
-			139, 171, 248, 188, 249, 162, 40, 114, 162, 185, 76, 74, 239, 97,
-			189, 71, 14, 196, 238, 147, 120, 85, 178, 17, 124, 225, 148, 94,
-			160, 208, 39, 9, 176, 230, 92, 166, 186, 63, 86, 31, 216, 85,
-			50, 160, 84, 107, 205, 40, 74, 47, 174, 206, 122, 65, 141, 83,
-			242, 157, 93, 37, 251, 83, 181, 90, 229, 221, 165, 158, 123, 1,
-			37, 153, 82, 230, 244, 126, 50, 192, 53, 98, 140, 89, 41, 124,
-			93, 35, 7, 210, 83, 215, 122, 157, 12, 117, 34, 87, 89, 215,
-			171, 114, 23, 231, 74, 167, 99, 157, 200, 77, 214, 118, 18, 240,
-			248, 117, 146, 67, 247, 188, 23, 7, 37, 196, 252, 133, 135, 132,
-			36, 19, 216, 186, 74, 250, 112, 10, 63, 67, 65, 137, 217, 163,
-			170, 200, 105, 13, 145, 62, 207, 223, 116, 67, 94, 123, 190, 42,
-			146, 133, 95, 214, 72, 31, 207, 110, 221, 37, 7, 184, 87, 227,
-			42, 39, 21, 107, 56, 187, 39, 252, 32, 82, 92, 221, 191, 145,
-			130, 204, 124, 139, 12, 160, 157, 56, 59, 202, 163, 33, 29, 118,
-			178, 51, 61, 10, 81, 160, 45, 171, 234, 39, 214, 9, 146, 247,
-			162, 213, 186, 23, 198, 168, 0, 103, 4, 71, 119, 88, 242, 179,
-			40, 218, 190, 254, 62, 42, 218, 126, 70, 127, 169, 104, 123, 169,
-			104, 251, 1, 64, 255, 95, 132, 159, 186, 101, 28, 225, 79, 13,
-			203, 56, 154, 185, 5, 63, 77, 203, 56, 150, 121, 139, 43, 229,
-			134, 18, 165, 28, 251, 249, 13, 116, 6, 204, 210, 204, 191, 163,
-			129, 55, 96, 153, 221, 158, 216, 36, 233, 56, 210, 179, 14, 141,
-			254, 18, 109, 67, 171, 213, 193, 16, 159, 44, 39, 21, 44, 12,
-			235, 137, 74, 156, 50, 92, 84, 196, 246, 224, 170, 208, 2, 135,
-			225, 118, 7, 219, 121, 83, 220, 245, 163, 41, 26, 109, 59, 33,
-			224, 241, 199, 78, 244, 184, 72, 16, 101, 12, 253, 203, 241, 119,
-			145, 206, 188, 67, 157, 56, 118, 91, 237, 184, 68, 32, 62, 91,
-			16, 49, 34, 166, 64, 245, 33, 252, 231, 208, 254, 95, 97, 130,
-			16, 55, 139, 7, 109, 17, 60, 44, 168, 58, 4, 200, 255, 196,
-			117, 69, 193, 71, 243, 22, 153, 100, 191, 251, 45, 227, 21, 125,
-			127, 225, 34, 92, 76, 208, 19, 37, 236, 180, 99, 183, 94, 164,
-			181, 112, 189, 179, 1, 206, 35, 19, 227, 175, 189, 254, 234, 141,
-			87, 225, 54, 98, 154, 153, 254, 140, 101, 188, 50, 192, 101, 217,
-			253, 172, 56, 37, 165, 99, 234, 87, 100, 156, 159, 17, 189, 104,
-			218, 63, 165, 61, 59, 134, 64, 210, 18, 238, 145, 237, 249, 20,
-			143, 168, 59, 211, 165, 123, 110, 92, 81, 48, 174, 170, 15, 102,
-			146, 80, 3, 244, 25, 40, 255, 10, 134, 122, 15, 224, 124, 214,
-			57, 139, 157, 184, 221, 137, 65, 60, 242, 169, 48, 239, 121, 28,
-			160, 188, 101, 142, 88, 69, 131, 99, 79, 103, 16, 123, 250, 178,
-			57, 170, 60, 208, 44, 227, 138, 121, 5, 100, 157, 160, 19, 44,
-			233, 183, 12, 69, 175, 87, 234, 219, 71, 22, 19, 189, 222, 184,
-			105, 219, 111, 9, 128, 55, 7, 188, 74, 193, 194, 13, 189, 45,
-			165, 57, 42, 226, 143, 227, 46, 5, 247, 1, 246, 48, 64, 175,
-			155, 46, 125, 221, 184, 121, 52, 165, 175, 27, 31, 58, 65, 238,
-			36, 234, 186, 171, 230, 65, 238, 246, 159, 244, 35, 120, 185, 115,
-			142, 22, 65, 93, 32, 20, 90, 4, 208, 125, 187, 85, 56, 26,
-			20, 67, 82, 186, 165, 171, 251, 15, 144, 223, 215, 18, 229, 210,
-			235, 230, 97, 251, 183, 180, 238, 122, 188, 72, 137, 252, 11, 112,
-			141, 108, 231, 13, 29, 31, 143, 105, 14, 194, 20, 80, 201, 14,
-			98, 99, 5, 130, 57, 226, 41, 178, 139, 189, 79, 157, 78, 28,
-			180, 156, 152, 107, 100, 162, 128, 109, 133, 141, 216, 13, 97, 109,
-			162, 27, 139, 148, 207, 209, 0, 156, 107, 100, 82, 138, 232, 84,
-			65, 230, 186, 91, 11, 32, 246, 161, 83, 139, 49, 8, 146, 170,
-			178, 122, 221, 60, 144, 82, 89, 189, 126, 200, 34, 91, 188, 185,
-			134, 101, 188, 97, 90, 246, 70, 175, 214, 202, 29, 133, 117, 171,
-			143, 112, 78, 140, 200, 221, 29, 76, 125, 164, 146, 175, 231, 20,
-			245, 10, 241, 10, 93, 108, 131, 124, 195, 220, 159, 164, 117, 203,
-			120, 99, 240, 16, 202, 215, 96, 176, 103, 49, 70, 21, 199, 57,
-			225, 51, 135, 31, 118, 94, 58, 116, 73, 140, 19, 0, 12, 63,
-			189, 22, 63, 90, 185, 238, 53, 114, 65, 83, 150, 48, 237, 124,
-			197, 54, 186, 151, 50, 6, 27, 161, 145, 135, 78, 189, 220, 206,
-			82, 129, 188, 148, 110, 210, 94, 28, 185, 205, 134, 80, 38, 161,
-			79, 25, 76, 229, 4, 85, 83, 130, 108, 57, 62, 13, 146, 101,
-			155, 214, 149, 131, 124, 109, 86, 226, 198, 179, 169, 57, 155, 223,
-			175, 232, 231, 103, 7, 15, 145, 219, 92, 181, 104, 86, 244, 121,
-			211, 30, 199, 120, 8, 241, 158, 75, 160, 212, 123, 155, 208, 115,
-			150, 81, 145, 1, 16, 88, 247, 86, 78, 93, 82, 20, 122, 149,
-			203, 87, 248, 54, 161, 231, 45, 179, 82, 156, 23, 219, 132, 142,
-			219, 196, 219, 114, 155, 208, 113, 155, 248, 156, 121, 133, 44, 10,
-			5, 224, 3, 253, 172, 61, 173, 136, 183, 185, 24, 178, 39, 156,
-			34, 34, 216, 62, 78, 144, 73, 132, 1, 107, 162, 138, 51, 89,
-			137, 106, 232, 134, 7, 3, 71, 21, 197, 220, 131, 99, 182, 162,
-			152, 123, 112, 250, 12, 249, 63, 68, 232, 6, 243, 125, 253, 131,
-			107, 246, 79, 104, 123, 246, 146, 60, 22, 133, 88, 81, 174, 95,
-			220, 231, 119, 47, 222, 93, 30, 208, 145, 27, 23, 57, 155, 17,
-			37, 90, 249, 244, 210, 81, 78, 223, 174, 241, 152, 188, 79, 111,
-			221, 162, 119, 241, 61, 230, 191, 245, 217, 255, 200, 136, 17, 57,
-			203, 120, 95, 14, 175, 169, 89, 198, 251, 167, 70, 68, 202, 176,
-			140, 247, 139, 163, 34, 149, 183, 140, 15, 114, 87, 249, 96, 154,
-			56, 186, 31, 228, 39, 200, 87, 101, 64, 137, 71, 250, 136, 221,
-			81, 130, 81, 110, 247, 234, 75, 182, 14, 214, 129, 169, 170, 185,
-			141, 14, 32, 234, 42, 28, 199, 140, 192, 70, 142, 1, 132, 14,
-			153, 217, 149, 118, 221, 137, 149, 59, 17, 237, 248, 177, 215, 76,
-			245, 21, 210, 152, 205, 49, 34, 68, 107, 178, 154, 101, 60, 58,
-			117, 94, 164, 12, 203, 120, 116, 105, 152, 252, 81, 94, 68, 144,
-			216, 210, 175, 216, 255, 121, 158, 46, 72, 31, 64, 101, 95, 84,
-			54, 75, 113, 118, 7, 190, 91, 162, 139, 91, 110, 216, 197, 7,
-			177, 151, 164, 107, 24, 29, 186, 178, 80, 89, 92, 80, 248, 44,
-			218, 107, 31, 222, 205, 22, 16, 1, 2, 11, 223, 72, 145, 123,
-			175, 189, 187, 152, 248, 124, 176, 249, 145, 182, 214, 112, 232, 116,
-			55, 203, 165, 146, 200, 46, 3, 9, 126, 83, 210, 108, 233, 9,
-			9, 186, 168, 20, 23, 23, 113, 202, 132, 109, 124, 144, 234, 9,
-			177, 191, 34, 115, 71, 168, 235, 215, 156, 118, 212, 105, 58, 50,
-			70, 41, 240, 92, 190, 211, 76, 220, 5, 82, 223, 8, 171, 243,
-			141, 208, 105, 111, 34, 199, 31, 130, 53, 3, 6, 168, 10, 37,
-			108, 182, 19, 211, 86, 16, 161, 111, 10, 232, 250, 132, 146, 76,
-			192, 59, 179, 189, 162, 107, 56, 121, 144, 171, 104, 211, 73, 98,
-			178, 96, 69, 160, 19, 97, 251, 49, 6, 126, 44, 209, 247, 216,
-			186, 196, 4, 108, 195, 168, 82, 218, 116, 185, 210, 145, 208, 208,
-			117, 106, 155, 24, 17, 95, 169, 128, 46, 111, 118, 34, 90, 219,
-			169, 53, 121, 224, 125, 167, 217, 12, 182, 5, 99, 17, 186, 212,
-			15, 130, 54, 107, 35, 8, 61, 20, 89, 6, 13, 221, 184, 19,
-			250, 93, 102, 83, 114, 224, 197, 54, 179, 229, 210, 90, 51, 136,
-			176, 215, 72, 186, 109, 156, 79, 192, 125, 70, 57, 188, 240, 218,
-			81, 117, 107, 224, 232, 84, 194, 64, 39, 138, 212, 176, 42, 34,
-			223, 72, 44, 47, 142, 95, 138, 28, 73, 50, 43, 248, 78, 133,
-			88, 84, 233, 174, 149, 231, 39, 196, 217, 218, 78, 125, 38, 120,
-			43, 206, 252, 0, 140, 227, 58, 24, 179, 12, 111, 121, 14, 73,
-			40, 83, 226, 176, 236, 38, 111, 132, 119, 103, 20, 136, 62, 45,
-			17, 21, 132, 58, 189, 199, 2, 34, 22, 141, 5, 87, 23, 117,
-			157, 105, 116, 57, 192, 99, 183, 55, 59, 132, 26, 45, 73, 151,
-			216, 119, 118, 201, 91, 193, 117, 14, 88, 46, 56, 223, 107, 78,
-			164, 184, 137, 229, 76, 182, 181, 168, 17, 94, 182, 6, 14, 137,
-			148, 102, 25, 91, 214, 69, 145, 50, 44, 99, 107, 228, 50, 137,
-			68, 8, 151, 143, 245, 49, 187, 65, 187, 37, 185, 138, 95, 21,
-			64, 100, 11, 209, 25, 5, 30, 107, 56, 26, 17, 62, 119, 56,
-			119, 184, 26, 36, 181, 37, 113, 52, 15, 44, 144, 194, 141, 9,
-			73, 232, 51, 89, 173, 50, 149, 179, 140, 143, 7, 132, 245, 74,
-			159, 102, 25, 31, 219, 151, 69, 202, 176, 140, 143, 71, 75, 226,
-			200, 204, 91, 230, 87, 53, 253, 158, 253, 191, 106, 40, 240, 144,
-			200, 181, 8, 60, 86, 232, 68, 110, 56, 245, 134, 219, 114, 188,
-			230, 109, 136, 89, 28, 200, 249, 208, 205, 135, 37, 48, 201, 108,
-			223, 130, 210, 34, 55, 220, 242, 106, 46, 227, 24, 33, 170, 39,
-			20, 74, 120, 169, 181, 112, 84, 185, 79, 190, 229, 180, 219, 81,
-			59, 136, 75, 27, 252, 35, 254, 13, 192, 118, 141, 160, 245, 22,
-			91, 20, 2, 162, 198, 97, 99, 28, 146, 20, 157, 31, 6, 155,
-			126, 61, 112, 223, 226, 219, 39, 126, 186, 251, 226, 132, 124, 78,
-			62, 11, 77, 207, 139, 36, 40, 173, 250, 15, 139, 164, 193, 146,
-			199, 134, 68, 18, 250, 233, 196, 93, 50, 8, 199, 102, 30, 142,
-			77, 243, 171, 218, 201, 59, 228, 187, 58, 116, 100, 191, 101, 254,
-			144, 166, 83, 251, 111, 232, 244, 110, 167, 217, 148, 238, 234, 92,
-			211, 138, 120, 0, 48, 192, 50, 194, 95, 215, 72, 167, 59, 243,
-			89, 119, 193, 115, 236, 192, 29, 21, 165, 142, 130, 247, 187, 236,
-			254, 228, 250, 78, 232, 40, 93, 82, 247, 254, 41, 90, 24, 147,
-			200, 222, 163, 112, 44, 148, 68, 183, 179, 139, 51, 156, 15, 99,
-			117, 215, 169, 175, 187, 110, 3, 160, 195, 119, 29, 63, 88, 70,
-			106, 232, 82, 101, 192, 243, 104, 12, 80, 70, 95, 125, 237, 245,
-			27, 227, 5, 217, 227, 253, 89, 232, 35, 209, 227, 253, 26, 75,
-			246, 219, 34, 105, 176, 228, 233, 179, 228, 215, 112, 102, 18, 203,
-			252, 17, 77, 63, 98, 255, 187, 26, 173, 186, 78, 179, 213, 147,
-			213, 226, 56, 205, 176, 69, 242, 78, 147, 136, 230, 123, 129, 192,
-			141, 121, 126, 35, 116, 80, 88, 200, 254, 25, 109, 239, 140, 93,
-			25, 75, 65, 170, 69, 177, 27, 142, 193, 85, 109, 195, 243, 221,
-			49, 167, 19, 111, 174, 242, 105, 201, 197, 138, 160, 251, 136, 86,
-			107, 129, 223, 240, 54, 80, 124, 33, 154, 73, 178, 64, 185, 104,
-			38, 209, 88, 178, 255, 128, 72, 26, 44, 121, 232, 48, 231, 235,
-			7, 44, 243, 175, 107, 250, 69, 123, 92, 193, 194, 44, 209, 119,
-			17, 11, 93, 32, 88, 35, 110, 31, 108, 160, 222, 134, 31, 132,
-			192, 42, 97, 113, 3, 57, 40, 96, 80, 36, 53, 150, 60, 68,
-			69, 210, 96, 201, 115, 23, 200, 79, 98, 159, 238, 179, 204, 31,
-			215, 244, 17, 251, 71, 52, 90, 14, 215, 189, 56, 116, 194, 29,
-			213, 213, 86, 68, 224, 69, 167, 20, 197, 35, 176, 72, 235, 65,
-			203, 241, 252, 81, 5, 255, 64, 104, 95, 72, 239, 75, 25, 156,
-			212, 34, 52, 122, 29, 13, 117, 133, 57, 212, 27, 183, 232, 181,
-			241, 27, 175, 34, 74, 143, 108, 203, 62, 0, 20, 212, 197, 154,
-			219, 7, 128, 130, 39, 206, 137, 36, 0, 10, 94, 28, 38, 255,
-			67, 22, 218, 178, 223, 50, 127, 78, 211, 207, 216, 255, 44, 11,
-			85, 1, 212, 6, 23, 115, 115, 11, 152, 109, 214, 105, 236, 60,
-			70, 113, 230, 238, 53, 38, 34, 139, 9, 33, 143, 231, 215, 221,
-			39, 93, 39, 56, 15, 221, 40, 80, 252, 49, 244, 24, 132, 184,
-			20, 161, 48, 29, 223, 105, 238, 68, 110, 196, 183, 162, 90, 167,
-			217, 14, 1, 234, 156, 61, 246, 34, 58, 198, 35, 7, 180, 3,
-			207, 143, 101, 238, 17, 217, 71, 156, 230, 238, 193, 110, 227, 5,
-			3, 25, 151, 0, 22, 115, 151, 245, 136, 194, 140, 10, 145, 96,
-			189, 199, 102, 82, 68, 73, 230, 40, 24, 183, 62, 91, 140, 128,
-			253, 6, 55, 230, 77, 215, 11, 169, 162, 84, 43, 113, 69, 1,
-			216, 230, 199, 33, 248, 49, 210, 25, 110, 148, 170, 160, 76, 57,
-			33, 187, 14, 32, 212, 6, 240, 57, 140, 232, 78, 187, 25, 56,
-			172, 50, 190, 15, 66, 8, 114, 118, 69, 151, 240, 207, 68, 242,
-			127, 61, 9, 67, 156, 47, 40, 37, 97, 66, 186, 13, 74, 223,
-			117, 195, 186, 87, 227, 113, 55, 156, 40, 10, 106, 158, 19, 43,
-			32, 35, 178, 171, 131, 6, 189, 236, 248, 59, 151, 249, 196, 245,
-			66, 12, 32, 224, 197, 29, 160, 68, 237, 98, 128, 177, 70, 217,
-			85, 232, 162, 8, 72, 18, 93, 219, 121, 38, 209, 69, 210, 19,
-			17, 127, 11, 169, 228, 34, 27, 228, 90, 183, 221, 102, 83, 152,
-			114, 203, 229, 176, 63, 7, 51, 124, 159, 72, 106, 44, 185, 95,
-			172, 142, 253, 6, 75, 158, 60, 77, 190, 4, 70, 169, 185, 95,
-			208, 50, 255, 153, 166, 217, 62, 77, 107, 228, 20, 166, 163, 23,
-			119, 209, 131, 185, 128, 107, 27, 98, 16, 0, 122, 156, 100, 83,
-			80, 66, 213, 80, 110, 174, 220, 222, 67, 211, 44, 243, 23, 180,
-			252, 49, 238, 4, 156, 177, 204, 95, 210, 244, 183, 236, 179, 112,
-			37, 19, 205, 150, 197, 112, 141, 39, 111, 38, 88, 142, 178, 252,
-			121, 145, 212, 88, 146, 163, 98, 130, 237, 168, 249, 75, 218, 225,
-			35, 34, 153, 103, 201, 163, 111, 194, 57, 172, 113, 25, 166, 249,
-			75, 218, 241, 91, 188, 114, 205, 50, 255, 193, 51, 42, 191, 131,
-			250, 84, 89, 185, 150, 133, 252, 162, 114, 13, 62, 151, 149, 107,
-			6, 75, 202, 202, 181, 60, 75, 202, 202, 53, 172, 252, 31, 176,
-			202, 175, 112, 23, 112, 243, 123, 154, 126, 219, 62, 221, 187, 242,
-			101, 198, 231, 201, 170, 245, 44, 228, 22, 85, 51, 202, 191, 39,
-			142, 9, 112, 3, 55, 191, 167, 29, 178, 68, 50, 207, 146, 135,
-			111, 241, 170, 81, 40, 99, 126, 79, 59, 122, 147, 220, 103, 85,
-			27, 25, 43, 247, 171, 154, 254, 27, 154, 97, 223, 162, 234, 181,
-			164, 107, 248, 211, 254, 23, 187, 6, 92, 16, 103, 176, 81, 248,
-			85, 141, 28, 37, 14, 201, 177, 36, 27, 212, 95, 215, 204, 139,
-			246, 59, 128, 207, 157, 24, 163, 197, 1, 253, 8, 153, 88, 181,
-			100, 168, 107, 119, 241, 176, 119, 164, 217, 222, 18, 33, 7, 73,
-			31, 86, 145, 131, 58, 142, 39, 15, 52, 246, 96, 232, 149, 228,
-			129, 193, 30, 156, 191, 64, 222, 129, 54, 107, 86, 238, 183, 52,
-			253, 31, 105, 134, 93, 166, 41, 21, 240, 238, 86, 63, 73, 34,
-			37, 63, 179, 221, 108, 2, 252, 150, 70, 142, 147, 191, 175, 65,
-			195, 97, 54, 255, 39, 154, 121, 222, 254, 81, 109, 175, 150, 39,
-			5, 127, 170, 102, 211, 165, 206, 250, 40, 94, 120, 18, 155, 156,
-			30, 30, 147, 41, 107, 69, 140, 49, 138, 182, 95, 216, 43, 128,
-			123, 192, 72, 60, 154, 60, 208, 216, 131, 99, 103, 147, 7, 6,
-			123, 80, 56, 71, 14, 112, 12, 134, 220, 239, 104, 250, 239, 106,
-			6, 54, 26, 128, 128, 127, 71, 203, 31, 6, 177, 191, 198, 102,
-			222, 63, 214, 204, 87, 248, 204, 51, 114, 144, 60, 40, 146, 26,
-			75, 14, 158, 18, 73, 200, 124, 150, 242, 79, 77, 203, 252, 79,
-			53, 243, 2, 127, 105, 230, 32, 121, 72, 36, 53, 150, 180, 168,
-			72, 26, 44, 121, 238, 60, 249, 30, 122, 188, 231, 254, 64, 203,
-			252, 161, 166, 217, 63, 163, 209, 180, 174, 93, 25, 205, 205, 96,
-			187, 139, 211, 80, 71, 19, 142, 111, 198, 171, 68, 129, 100, 22,
-			61, 56, 168, 113, 86, 242, 59, 53, 27, 53, 118, 204, 6, 91,
-			16, 22, 59, 5, 175, 13, 232, 136, 50, 12, 3, 152, 16, 38,
-			8, 229, 117, 21, 161, 124, 0, 77, 195, 205, 63, 96, 27, 223,
-			7, 220, 254, 219, 252, 103, 154, 126, 222, 254, 28, 93, 114, 57,
-			238, 115, 194, 84, 224, 53, 0, 157, 169, 118, 56, 72, 175, 47,
-			34, 50, 120, 170, 9, 180, 98, 41, 197, 231, 37, 68, 255, 96,
-			101, 231, 68, 82, 99, 201, 190, 179, 34, 105, 176, 100, 225, 28,
-			218, 44, 194, 86, 242, 95, 105, 250, 41, 251, 111, 106, 61, 40,
-			89, 223, 133, 99, 14, 26, 194, 75, 17, 237, 180, 81, 193, 229,
-			34, 9, 65, 184, 155, 177, 19, 30, 123, 40, 96, 87, 131, 55,
-			176, 222, 36, 216, 135, 24, 44, 171, 247, 57, 45, 91, 164, 229,
-			128, 200, 65, 145, 4, 154, 15, 29, 23, 73, 131, 37, 237, 147,
-			228, 39, 52, 176, 60, 207, 253, 177, 150, 249, 255, 233, 154, 253,
-			45, 77, 177, 115, 142, 85, 92, 238, 58, 191, 93, 109, 59, 145,
-			224, 249, 60, 63, 29, 111, 70, 170, 139, 19, 123, 231, 225, 45,
-			207, 73, 177, 6, 176, 32, 71, 210, 90, 11, 204, 197, 249, 159,
-			85, 158, 115, 132, 207, 1, 182, 32, 254, 88, 203, 91, 228, 9,
-			183, 199, 54, 255, 185, 102, 28, 183, 63, 236, 77, 103, 2, 85,
-			159, 166, 181, 68, 232, 172, 128, 58, 243, 80, 143, 157, 174, 78,
-			224, 152, 129, 240, 153, 221, 133, 65, 249, 16, 196, 155, 188, 67,
-			193, 120, 153, 85, 45, 147, 26, 75, 14, 88, 34, 105, 176, 228,
-			209, 99, 228, 199, 246, 115, 251, 101, 243, 155, 186, 113, 196, 254,
-			234, 254, 20, 161, 252, 134, 213, 77, 237, 238, 121, 0, 172, 3,
-			80, 200, 216, 4, 41, 231, 226, 97, 177, 213, 140, 49, 231, 154,
-			4, 127, 196, 182, 190, 115, 176, 151, 214, 156, 200, 77, 49, 225,
-			140, 113, 244, 124, 26, 121, 113, 71, 50, 164, 110, 152, 88, 135,
-			226, 82, 105, 132, 78, 34, 123, 172, 187, 245, 14, 88, 41, 196,
-			236, 34, 130, 193, 214, 4, 197, 16, 131, 141, 145, 0, 50, 13,
-			118, 53, 6, 68, 60, 79, 178, 234, 232, 104, 40, 196, 163, 151,
-			34, 184, 36, 19, 165, 76, 192, 202, 112, 29, 86, 19, 240, 235,
-			243, 110, 76, 203, 2, 233, 85, 105, 36, 70, 46, 73, 201, 89,
-			5, 187, 85, 115, 189, 45, 193, 198, 59, 156, 183, 228, 192, 124,
-			14, 235, 151, 118, 39, 230, 56, 133, 240, 85, 186, 110, 215, 103,
-			135, 5, 155, 29, 172, 230, 233, 61, 107, 222, 165, 151, 231, 156,
-			60, 251, 29, 35, 214, 137, 0, 3, 100, 35, 37, 230, 212, 48,
-			251, 56, 22, 92, 233, 14, 109, 186, 78, 20, 211, 199, 190, 187,
-			173, 174, 137, 17, 20, 102, 199, 161, 183, 177, 225, 134, 41, 30,
-			151, 150, 1, 198, 86, 38, 167, 197, 64, 71, 93, 153, 192, 42,
-			63, 114, 99, 24, 194, 242, 165, 72, 189, 89, 224, 214, 4, 115,
-			9, 111, 87, 120, 19, 146, 33, 169, 57, 25, 4, 134, 82, 169,
-			107, 151, 163, 23, 112, 181, 101, 169, 154, 232, 186, 1, 144, 212,
-			58, 79, 110, 91, 187, 74, 5, 117, 135, 56, 36, 112, 229, 39,
-			175, 165, 152, 214, 65, 199, 130, 70, 39, 236, 182, 109, 160, 211,
-			147, 69, 58, 125, 149, 150, 74, 37, 58, 189, 192, 69, 153, 66,
-			104, 134, 227, 226, 52, 163, 128, 157, 75, 163, 194, 79, 111, 119,
-			43, 216, 26, 151, 45, 64, 203, 125, 152, 37, 237, 32, 138, 188,
-			245, 230, 78, 18, 160, 144, 40, 215, 222, 46, 202, 167, 39, 5,
-			21, 195, 66, 31, 137, 113, 213, 120, 108, 30, 25, 154, 71, 116,
-			177, 192, 135, 116, 248, 124, 25, 77, 214, 78, 178, 0, 219, 157,
-			120, 4, 87, 241, 125, 129, 77, 44, 53, 191, 180, 237, 196, 155,
-			17, 152, 156, 120, 145, 32, 22, 109, 111, 82, 123, 49, 45, 243,
-			142, 81, 118, 6, 126, 72, 114, 185, 124, 74, 240, 137, 82, 254,
-			221, 165, 204, 176, 207, 90, 1, 236, 17, 14, 198, 47, 220, 6,
-			4, 155, 180, 26, 101, 219, 133, 253, 115, 211, 217, 114, 167, 48,
-			220, 224, 232, 109, 58, 61, 193, 254, 157, 73, 210, 147, 60, 205,
-			58, 101, 59, 192, 150, 160, 152, 92, 152, 169, 160, 47, 34, 120,
-			46, 201, 253, 15, 206, 76, 112, 123, 84, 144, 247, 229, 206, 233,
-			68, 60, 174, 62, 155, 228, 113, 148, 190, 92, 202, 169, 26, 185,
-			77, 116, 249, 100, 131, 118, 57, 240, 93, 113, 53, 237, 65, 4,
-			65, 133, 211, 12, 29, 6, 5, 38, 44, 232, 233, 9, 118, 100,
-			76, 79, 194, 176, 204, 5, 219, 238, 150, 27, 22, 249, 92, 99,
-			37, 160, 218, 146, 215, 225, 225, 157, 115, 163, 227, 132, 142, 31,
-			187, 156, 97, 242, 26, 194, 197, 148, 251, 196, 10, 189, 146, 60,
-			198, 85, 189, 147, 24, 119, 48, 122, 228, 129, 38, 4, 186, 118,
-			203, 121, 236, 246, 60, 69, 88, 227, 156, 102, 147, 183, 73, 148,
-			43, 15, 48, 118, 23, 251, 166, 110, 244, 137, 36, 28, 81, 249,
-			131, 34, 105, 176, 164, 117, 24, 2, 164, 152, 86, 238, 91, 122,
-			230, 103, 116, 205, 46, 190, 56, 63, 160, 120, 39, 124, 139, 149,
-			251, 247, 116, 225, 158, 240, 109, 221, 188, 96, 255, 168, 14, 226,
-			152, 117, 39, 130, 221, 48, 226, 167, 72, 74, 174, 84, 219, 116,
-			107, 143, 217, 158, 209, 137, 83, 177, 192, 216, 172, 240, 221, 154,
-			27, 69, 78, 184, 3, 211, 173, 6, 76, 169, 83, 175, 167, 208,
-			240, 212, 56, 30, 162, 76, 20, 44, 248, 132, 71, 252, 40, 242,
-			83, 201, 97, 147, 57, 104, 160, 26, 26, 131, 135, 204, 130, 240,
-			156, 63, 198, 0, 36, 159, 128, 169, 95, 58, 92, 82, 218, 116,
-			147, 222, 162, 19, 55, 33, 215, 146, 140, 25, 82, 117, 183, 60,
-			104, 95, 180, 229, 175, 134, 34, 113, 139, 94, 195, 140, 165, 82,
-			137, 208, 167, 210, 213, 34, 7, 29, 164, 186, 90, 124, 91, 231,
-			92, 60, 186, 90, 124, 91, 63, 119, 158, 252, 169, 198, 99, 26,
-			153, 127, 87, 55, 47, 219, 255, 18, 205, 103, 18, 91, 206, 225,
-			104, 68, 21, 203, 113, 183, 93, 218, 105, 243, 48, 26, 221, 253,
-			46, 183, 164, 84, 159, 207, 114, 99, 43, 222, 182, 143, 58, 110,
-			199, 165, 113, 184, 243, 97, 176, 30, 113, 75, 16, 208, 42, 78,
-			140, 119, 85, 45, 216, 167, 148, 144, 173, 132, 202, 125, 55, 116,
-			9, 200, 140, 216, 70, 82, 20, 46, 109, 201, 236, 7, 128, 116,
-			86, 36, 28, 78, 46, 219, 217, 208, 36, 85, 122, 167, 104, 38,
-			52, 123, 159, 72, 230, 88, 114, 255, 9, 145, 132, 78, 177, 47,
-			136, 164, 193, 146, 195, 35, 228, 119, 117, 30, 207, 201, 252, 105,
-			221, 60, 102, 255, 186, 78, 223, 227, 33, 100, 81, 218, 132, 29,
-			229, 239, 200, 200, 163, 45, 167, 238, 10, 22, 157, 247, 79, 17,
-			214, 114, 2, 202, 200, 67, 50, 117, 107, 145, 149, 142, 117, 104,
-			221, 109, 187, 126, 29, 228, 87, 108, 117, 116, 218, 27, 33, 8,
-			231, 214, 221, 70, 16, 38, 156, 22, 87, 214, 32, 23, 237, 116,
-			226, 32, 12, 0, 100, 61, 116, 107, 94, 27, 249, 161, 153, 77,
-			55, 12, 119, 70, 219, 94, 237, 49, 206, 217, 164, 211, 33, 60,
-			74, 208, 150, 202, 97, 136, 152, 204, 134, 50, 232, 196, 98, 11,
-			18, 113, 122, 93, 220, 53, 186, 14, 124, 10, 125, 12, 92, 146,
-			192, 174, 196, 235, 116, 228, 182, 157, 16, 193, 238, 69, 4, 170,
-			253, 34, 24, 22, 235, 202, 188, 72, 106, 44, 217, 47, 102, 46,
-			187, 216, 254, 180, 126, 228, 168, 244, 10, 250, 183, 132, 220, 120,
-			65, 175, 25, 63, 136, 37, 110, 244, 158, 177, 74, 222, 35, 103,
-			19, 94, 232, 174, 176, 106, 88, 80, 62, 181, 206, 16, 146, 108,
-			163, 28, 201, 74, 121, 146, 184, 88, 232, 138, 139, 197, 103, 49,
-			117, 254, 137, 126, 140, 95, 114, 254, 153, 241, 75, 38, 95, 90,
-			58, 191, 180, 116, 254, 127, 39, 126, 201, 143, 243, 248, 37, 167,
-			50, 231, 53, 251, 135, 116, 90, 150, 238, 130, 176, 118, 56, 55,
-			239, 116, 133, 128, 5, 164, 145, 117, 215, 245, 21, 195, 44, 34,
-			28, 62, 187, 51, 95, 234, 178, 174, 64, 123, 140, 237, 46, 59,
-			69, 24, 158, 200, 105, 184, 156, 193, 84, 100, 124, 48, 219, 5,
-			89, 192, 18, 113, 3, 24, 104, 234, 218, 214, 68, 73, 241, 231,
-			144, 4, 173, 209, 153, 102, 208, 169, 211, 7, 157, 245, 177, 165,
-			206, 58, 97, 219, 154, 87, 99, 243, 226, 237, 165, 197, 133, 81,
-			69, 199, 213, 8, 194, 86, 202, 136, 249, 170, 98, 195, 124, 42,
-			127, 137, 212, 4, 70, 209, 89, 211, 182, 223, 197, 72, 124, 138,
-			164, 88, 149, 179, 8, 222, 69, 181, 170, 122, 1, 35, 226, 148,
-			93, 240, 89, 147, 40, 118, 193, 103, 165, 17, 93, 198, 176, 140,
-			179, 67, 39, 200, 188, 48, 181, 60, 103, 30, 181, 223, 76, 246,
-			130, 48, 209, 183, 6, 219, 126, 180, 91, 211, 63, 155, 138, 114,
-			53, 85, 243, 10, 137, 73, 99, 150, 21, 71, 20, 147, 198, 115,
-			28, 236, 17, 77, 26, 207, 29, 62, 146, 4, 216, 56, 68, 78,
-			118, 59, 67, 66, 124, 145, 189, 226, 107, 244, 145, 44, 184, 162,
-			78, 63, 237, 29, 62, 129, 192, 91, 17, 61, 161, 244, 130, 209,
-			19, 160, 202, 79, 21, 60, 225, 63, 26, 196, 224, 9, 147, 131,
-			47, 131, 39, 188, 12, 158, 240, 50, 120, 194, 203, 224, 9, 47,
-			131, 39, 188, 12, 158, 240, 3, 12, 158, 48, 43, 88, 47, 246,
-			83, 4, 79, 144, 113, 18, 206, 203, 144, 8, 23, 50, 87, 68,
-			156, 4, 246, 83, 4, 79, 144, 113, 18, 46, 202, 56, 9, 151,
-			146, 56, 9, 236, 167, 8, 158, 32, 163, 52, 176, 159, 191, 137,
-			60, 158, 49, 153, 25, 180, 255, 61, 198, 226, 109, 184, 190, 27,
-			122, 53, 12, 10, 150, 14, 202, 198, 238, 0, 53, 199, 151, 66,
-			206, 128, 58, 91, 129, 87, 231, 240, 71, 108, 251, 19, 114, 251,
-			58, 73, 127, 15, 219, 239, 78, 208, 9, 105, 249, 65, 5, 64,
-			238, 227, 180, 101, 27, 55, 16, 133, 243, 43, 22, 34, 204, 16,
-			205, 76, 9, 229, 187, 154, 196, 53, 3, 184, 145, 0, 65, 90,
-			30, 84, 216, 225, 179, 25, 212, 49, 132, 130, 231, 71, 49, 187,
-			129, 136, 211, 72, 152, 43, 222, 13, 2, 46, 89, 161, 148, 134,
-			237, 26, 157, 118, 194, 225, 110, 55, 116, 96, 53, 70, 164, 221,
-			239, 30, 239, 81, 162, 66, 159, 18, 53, 136, 193, 126, 201, 1,
-			253, 213, 91, 132, 118, 115, 64, 8, 41, 209, 114, 162, 199, 123,
-			177, 65, 175, 144, 126, 8, 15, 118, 223, 137, 30, 179, 43, 35,
-			8, 212, 56, 16, 45, 38, 166, 191, 161, 245, 230, 144, 14, 200,
-			15, 5, 151, 52, 249, 130, 92, 18, 144, 197, 168, 250, 84, 156,
-			210, 119, 111, 146, 62, 43, 123, 38, 243, 167, 218, 203, 56, 83,
-			47, 89, 165, 151, 172, 210, 75, 86, 233, 37, 171, 244, 146, 85,
-			250, 65, 178, 74, 146, 43, 58, 39, 185, 162, 243, 153, 49, 193,
-			21, 177, 159, 130, 85, 146, 92, 209, 5, 201, 21, 93, 76, 184,
-			162, 139, 146, 43, 186, 148, 169, 8, 246, 139, 253, 236, 201, 42,
-			253, 195, 34, 176, 74, 185, 63, 209, 216, 209, 103, 255, 116, 145,
-			174, 201, 147, 183, 59, 216, 20, 194, 32, 70, 59, 173, 245, 160,
-			233, 213, 184, 19, 12, 28, 227, 69, 16, 229, 113, 182, 71, 28,
-			4, 240, 102, 138, 22, 26, 37, 167, 208, 253, 100, 189, 84, 47,
-			16, 66, 231, 220, 208, 165, 107, 141, 174, 138, 184, 119, 13, 143,
-			67, 21, 6, 65, 44, 88, 174, 34, 93, 115, 214, 96, 223, 93,
-			91, 95, 35, 34, 172, 43, 223, 105, 101, 68, 91, 8, 56, 226,
-			249, 172, 96, 84, 212, 175, 213, 215, 100, 177, 242, 45, 236, 178,
-			82, 200, 198, 114, 151, 214, 215, 64, 35, 11, 249, 90, 232, 131,
-			22, 38, 91, 37, 87, 247, 179, 158, 232, 172, 243, 206, 224, 20,
-			164, 13, 234, 8, 103, 182, 68, 112, 172, 13, 150, 183, 45, 176,
-			67, 1, 138, 25, 61, 113, 225, 181, 79, 59, 224, 247, 147, 100,
-			41, 117, 209, 0, 222, 21, 28, 155, 174, 19, 197, 65, 11, 77,
-			225, 93, 191, 22, 64, 44, 219, 225, 200, 117, 1, 207, 111, 155,
-			171, 187, 241, 235, 251, 240, 181, 231, 51, 14, 230, 67, 23, 112,
-			211, 34, 66, 208, 227, 20, 93, 221, 125, 105, 223, 229, 62, 137,
-			81, 231, 210, 150, 121, 49, 202, 19, 103, 78, 69, 63, 5, 33,
-			97, 173, 31, 85, 132, 147, 8, 115, 43, 76, 215, 93, 96, 94,
-			227, 0, 181, 83, 105, 80, 79, 222, 89, 78, 68, 20, 253, 150,
-			24, 60, 198, 70, 238, 66, 128, 22, 111, 18, 135, 118, 119, 203,
-			11, 58, 96, 65, 35, 89, 108, 161, 162, 3, 36, 195, 93, 20,
-			59, 145, 0, 87, 20, 243, 178, 145, 240, 204, 14, 157, 162, 147,
-			147, 34, 181, 158, 188, 160, 180, 78, 167, 232, 68, 146, 124, 194,
-			114, 138, 228, 83, 241, 99, 135, 101, 186, 74, 212, 135, 31, 79,
-			209, 215, 185, 4, 151, 187, 42, 130, 242, 156, 171, 145, 160, 55,
-			228, 153, 137, 241, 103, 96, 237, 240, 190, 121, 82, 220, 129, 25,
-			251, 49, 1, 117, 133, 23, 138, 80, 93, 82, 1, 31, 11, 197,
-			154, 196, 142, 100, 249, 57, 74, 1, 235, 39, 140, 109, 198, 70,
-			148, 112, 143, 238, 145, 41, 242, 217, 155, 254, 84, 94, 12, 104,
-			185, 59, 134, 15, 87, 143, 9, 223, 67, 174, 52, 225, 204, 98,
-			211, 137, 226, 148, 101, 150, 67, 184, 70, 29, 221, 173, 185, 145,
-			130, 67, 229, 126, 35, 156, 59, 120, 177, 194, 243, 19, 244, 42,
-			169, 53, 84, 196, 197, 155, 172, 41, 225, 132, 16, 131, 211, 158,
-			12, 247, 236, 68, 8, 35, 174, 212, 208, 80, 50, 16, 186, 233,
-			212, 81, 170, 47, 231, 227, 72, 26, 199, 92, 236, 26, 48, 9,
-			165, 243, 166, 80, 161, 123, 205, 157, 4, 220, 18, 72, 138, 131,
-			54, 247, 41, 239, 158, 136, 16, 88, 15, 66, 104, 195, 58, 171,
-			206, 46, 45, 247, 108, 148, 82, 159, 104, 149, 180, 239, 227, 35,
-			159, 0, 98, 174, 119, 80, 71, 149, 42, 86, 132, 95, 75, 21,
-			44, 150, 17, 132, 173, 86, 251, 203, 117, 106, 155, 96, 67, 184,
-			229, 213, 59, 78, 83, 144, 75, 18, 59, 23, 190, 149, 73, 231,
-			48, 192, 59, 236, 209, 26, 190, 55, 225, 197, 181, 72, 56, 147,
-			155, 192, 8, 167, 120, 207, 18, 219, 135, 184, 201, 92, 170, 177,
-			124, 166, 19, 90, 107, 186, 78, 216, 220, 145, 12, 180, 18, 201,
-			15, 217, 100, 0, 159, 112, 107, 77, 135, 79, 2, 78, 112, 249,
-			65, 165, 68, 105, 5, 92, 155, 119, 128, 70, 236, 0, 183, 209,
-			96, 147, 43, 216, 163, 89, 99, 226, 71, 132, 58, 92, 84, 186,
-			17, 42, 32, 19, 97, 149, 194, 69, 191, 215, 22, 139, 174, 155,
-			116, 81, 244, 121, 4, 203, 69, 25, 75, 111, 247, 54, 159, 24,
-			119, 9, 135, 22, 62, 111, 81, 203, 66, 104, 236, 132, 27, 110,
-			172, 118, 61, 59, 142, 16, 118, 24, 117, 70, 88, 100, 29, 131,
-			34, 178, 141, 55, 69, 186, 220, 133, 241, 110, 138, 216, 246, 176,
-			227, 112, 61, 142, 220, 144, 123, 239, 199, 104, 79, 215, 116, 5,
-			240, 37, 12, 104, 68, 59, 126, 28, 116, 106, 155, 172, 90, 88,
-			191, 146, 58, 47, 162, 109, 39, 18, 39, 75, 64, 164, 186, 159,
-			251, 214, 0, 173, 156, 132, 162, 60, 43, 208, 83, 44, 234, 34,
-			207, 105, 54, 229, 249, 142, 123, 231, 150, 122, 200, 192, 113, 33,
-			54, 144, 221, 187, 82, 210, 28, 110, 138, 213, 221, 247, 69, 0,
-			5, 225, 213, 177, 41, 71, 192, 18, 175, 221, 70, 24, 0, 190,
-			208, 164, 119, 114, 119, 13, 28, 32, 23, 198, 71, 182, 191, 148,
-			236, 27, 164, 39, 85, 104, 91, 201, 55, 75, 94, 198, 238, 93,
-			146, 174, 193, 46, 185, 214, 189, 77, 118, 29, 187, 187, 70, 108,
-			87, 73, 114, 132, 113, 6, 98, 79, 144, 30, 93, 1, 136, 191,
-			178, 63, 228, 89, 211, 114, 195, 141, 4, 54, 64, 233, 13, 133,
-			18, 178, 87, 95, 116, 91, 182, 161, 175, 188, 146, 147, 23, 208,
-			227, 80, 78, 159, 69, 233, 83, 184, 215, 33, 92, 155, 162, 15,
-			39, 30, 41, 199, 148, 95, 87, 134, 252, 83, 212, 51, 222, 179,
-			232, 73, 165, 104, 232, 41, 111, 87, 215, 122, 200, 91, 112, 246,
-			246, 33, 227, 111, 11, 69, 198, 230, 214, 10, 143, 196, 87, 113,
-			23, 67, 176, 254, 233, 72, 122, 86, 219, 139, 116, 50, 213, 124,
-			208, 184, 187, 137, 208, 129, 109, 187, 92, 194, 64, 57, 244, 229,
-			238, 152, 12, 2, 25, 73, 221, 239, 72, 50, 137, 217, 78, 32,
-			25, 108, 88, 150, 48, 49, 25, 59, 91, 71, 192, 41, 118, 90,
-			203, 3, 243, 146, 128, 85, 234, 102, 86, 148, 158, 235, 68, 224,
-			77, 167, 238, 56, 210, 114, 41, 253, 153, 128, 104, 146, 168, 70,
-			40, 44, 73, 38, 27, 157, 115, 253, 26, 132, 152, 221, 69, 79,
-			194, 14, 4, 234, 102, 85, 148, 61, 226, 36, 193, 39, 132, 156,
-			117, 151, 63, 180, 32, 75, 41, 77, 161, 26, 162, 158, 214, 3,
-			194, 185, 22, 81, 44, 54, 40, 82, 140, 158, 128, 71, 151, 11,
-			58, 53, 129, 82, 28, 79, 32, 102, 47, 246, 87, 47, 62, 135,
-			244, 100, 116, 148, 34, 83, 156, 78, 98, 190, 160, 50, 58, 10,
-			159, 147, 240, 215, 50, 210, 106, 84, 219, 116, 91, 14, 117, 183,
-			130, 102, 71, 236, 19, 30, 158, 225, 45, 215, 241, 249, 62, 39,
-			47, 64, 46, 55, 203, 76, 184, 164, 199, 126, 176, 45, 163, 180,
-			128, 165, 24, 152, 109, 177, 87, 13, 175, 217, 228, 91, 11, 81,
-			165, 226, 114, 239, 193, 193, 195, 89, 224, 133, 98, 132, 74, 18,
-			228, 198, 99, 199, 208, 182, 227, 199, 234, 17, 13, 193, 106, 5,
-			111, 45, 100, 228, 140, 94, 126, 36, 178, 251, 19, 210, 8, 124,
-			226, 182, 179, 35, 182, 209, 29, 146, 234, 188, 34, 151, 129, 129,
-			173, 24, 15, 45, 203, 58, 216, 15, 98, 48, 222, 139, 144, 1,
-			73, 177, 111, 252, 68, 107, 10, 195, 136, 238, 41, 4, 39, 124,
-			114, 36, 70, 61, 206, 196, 132, 229, 194, 206, 16, 235, 13, 237,
-			224, 34, 206, 186, 168, 124, 239, 99, 207, 175, 3, 43, 214, 155,
-			213, 233, 177, 81, 145, 196, 158, 8, 89, 136, 205, 0, 28, 181,
-			149, 219, 27, 48, 56, 231, 232, 12, 55, 122, 19, 33, 8, 130,
-			144, 206, 45, 47, 63, 64, 22, 19, 47, 56, 144, 22, 177, 113,
-			123, 156, 178, 156, 173, 225, 113, 92, 20, 58, 228, 218, 231, 163,
-			252, 160, 188, 60, 51, 39, 185, 211, 160, 65, 31, 172, 44, 167,
-			22, 179, 8, 219, 3, 53, 70, 110, 203, 241, 99, 175, 22, 17,
-			58, 204, 50, 130, 44, 23, 14, 87, 33, 216, 132, 27, 85, 167,
-			217, 228, 20, 69, 252, 94, 12, 215, 230, 89, 113, 109, 14, 26,
-			42, 23, 135, 187, 25, 203, 81, 220, 181, 54, 225, 166, 205, 35,
-			81, 243, 56, 174, 2, 51, 10, 29, 63, 240, 94, 131, 22, 61,
-			220, 232, 143, 223, 253, 107, 65, 171, 229, 148, 176, 162, 8, 101,
-			182, 158, 143, 76, 55, 24, 60, 51, 142, 174, 22, 248, 91, 174,
-			240, 99, 29, 3, 67, 105, 198, 35, 132, 163, 53, 167, 229, 2,
-			66, 3, 23, 185, 111, 185, 190, 196, 96, 41, 99, 212, 99, 113,
-			190, 10, 19, 197, 46, 129, 116, 50, 133, 36, 159, 44, 239, 193,
-			226, 221, 131, 48, 0, 155, 55, 121, 244, 172, 68, 110, 8, 168,
-			21, 137, 77, 46, 165, 244, 193, 38, 187, 87, 182, 225, 223, 91,
-			116, 242, 102, 234, 214, 43, 202, 130, 79, 101, 65, 188, 143, 234,
-			94, 212, 110, 58, 59, 171, 208, 122, 181, 72, 254, 222, 169, 215,
-			193, 86, 76, 45, 21, 70, 3, 175, 178, 78, 55, 23, 179, 198,
-			41, 94, 131, 197, 221, 12, 2, 216, 102, 163, 78, 109, 83, 54,
-			141, 101, 149, 100, 8, 177, 19, 107, 82, 73, 37, 166, 208, 157,
-			3, 90, 87, 72, 209, 128, 51, 66, 26, 151, 139, 41, 209, 21,
-			160, 28, 118, 118, 81, 187, 172, 184, 133, 88, 22, 106, 189, 11,
-			78, 203, 45, 118, 87, 147, 190, 78, 176, 45, 115, 17, 140, 167,
-			113, 214, 116, 137, 164, 0, 108, 70, 57, 133, 192, 206, 58, 162,
-			31, 178, 69, 224, 36, 152, 254, 252, 144, 150, 203, 152, 223, 46,
-			187, 103, 70, 247, 100, 88, 130, 249, 116, 159, 167, 100, 75, 208,
-			152, 27, 240, 155, 241, 167, 194, 167, 240, 81, 228, 163, 123, 237,
-			102, 242, 102, 169, 179, 46, 74, 138, 58, 235, 171, 162, 142, 91,
-			244, 198, 205, 30, 226, 133, 229, 244, 94, 133, 94, 144, 207, 30,
-			81, 101, 16, 89, 9, 139, 225, 179, 179, 43, 68, 40, 95, 37,
-			167, 32, 111, 229, 78, 91, 104, 80, 134, 11, 73, 147, 11, 210,
-			23, 157, 109, 180, 35, 140, 62, 14, 172, 199, 133, 105, 92, 188,
-			193, 183, 207, 100, 68, 233, 187, 110, 40, 149, 108, 188, 157, 93,
-			28, 26, 15, 108, 158, 232, 125, 249, 206, 185, 9, 59, 78, 34,
-			190, 0, 210, 212, 59, 8, 145, 135, 5, 151, 62, 110, 177, 186,
-			118, 184, 37, 28, 247, 88, 73, 9, 106, 17, 85, 139, 93, 126,
-			225, 54, 176, 86, 89, 120, 183, 60, 95, 185, 179, 90, 174, 222,
-			91, 185, 63, 187, 176, 188, 150, 28, 119, 140, 36, 225, 149, 209,
-			241, 91, 78, 187, 205, 93, 130, 7, 80, 67, 108, 254, 137, 150,
-			63, 68, 138, 2, 145, 244, 127, 211, 244, 83, 246, 25, 142, 167,
-			145, 72, 71, 113, 44, 68, 215, 112, 136, 79, 19, 178, 203, 100,
-			150, 37, 7, 36, 0, 168, 198, 146, 214, 113, 145, 52, 88, 82,
-			137, 182, 252, 71, 23, 200, 153, 110, 101, 116, 189, 19, 170, 70,
-			207, 187, 84, 209, 83, 36, 127, 135, 103, 177, 134, 72, 95, 228,
-			214, 2, 191, 142, 136, 212, 70, 85, 36, 173, 35, 36, 235, 59,
-			126, 128, 65, 86, 179, 85, 76, 76, 127, 109, 15, 29, 245, 126,
-			81, 162, 80, 81, 79, 188, 160, 138, 90, 16, 251, 169, 52, 212,
-			127, 124, 14, 109, 249, 162, 151, 10, 234, 151, 10, 234, 151, 10,
-			234, 151, 10, 234, 151, 10, 234, 151, 10, 234, 31, 164, 130, 90,
-			234, 141, 217, 79, 161, 160, 158, 19, 26, 102, 246, 83, 40, 168,
-			165, 42, 251, 130, 84, 101, 95, 204, 148, 132, 42, 155, 253, 20,
-			10, 106, 169, 202, 190, 36, 85, 217, 195, 137, 42, 155, 253, 252,
-			123, 131, 232, 175, 209, 200, 68, 154, 253, 99, 131, 180, 76, 197,
-			169, 219, 165, 157, 6, 247, 213, 34, 109, 120, 79, 220, 250, 104,
-			211, 245, 55, 226, 77, 26, 181, 29, 95, 66, 102, 40, 44, 59,
-			193, 171, 28, 130, 253, 177, 221, 31, 207, 127, 196, 204, 10, 157,
-			90, 114, 44, 136, 23, 49, 5, 94, 0, 146, 128, 212, 193, 165,
-			34, 37, 238, 65, 236, 249, 194, 91, 45, 22, 220, 92, 205, 105,
-			186, 126, 221, 65, 60, 102, 14, 139, 207, 182, 233, 199, 46, 45,
-			212, 157, 157, 2, 152, 0, 22, 90, 129, 31, 111, 22, 68, 49,
-			2, 171, 43, 14, 20, 92, 91, 207, 79, 78, 56, 225, 77, 93,
-			147, 64, 164, 4, 156, 128, 147, 220, 66, 150, 192, 26, 40, 187,
-			138, 145, 224, 201, 48, 191, 78, 157, 109, 229, 65, 200, 184, 242,
-			152, 53, 87, 98, 35, 56, 73, 65, 37, 90, 5, 142, 1, 53,
-			172, 97, 240, 196, 107, 161, 47, 219, 149, 209, 137, 241, 226, 248,
-			248, 56, 221, 113, 157, 144, 171, 67, 102, 57, 150, 31, 33, 226,
-			39, 157, 152, 2, 152, 253, 78, 236, 38, 100, 32, 254, 130, 74,
-			46, 156, 123, 237, 200, 237, 212, 3, 56, 124, 75, 252, 144, 78,
-			218, 19, 197, 78, 24, 211, 91, 180, 84, 42, 221, 236, 126, 231,
-			250, 245, 212, 27, 89, 145, 224, 176, 196, 91, 124, 45, 153, 68,
-			49, 172, 183, 88, 9, 50, 53, 138, 117, 137, 244, 205, 174, 143,
-			96, 2, 240, 79, 240, 183, 248, 0, 82, 162, 18, 175, 65, 135,
-			119, 85, 244, 6, 29, 167, 23, 47, 118, 151, 117, 155, 142, 143,
-			36, 183, 148, 93, 31, 93, 81, 239, 202, 93, 159, 142, 222, 162,
-			19, 227, 226, 143, 184, 53, 83, 183, 201, 248, 171, 94, 4, 220,
-			238, 73, 192, 27, 207, 38, 96, 244, 25, 4, 92, 233, 69, 128,
-			50, 252, 147, 201, 240, 39, 227, 5, 227, 159, 36, 175, 36, 3,
-			246, 233, 103, 193, 158, 99, 189, 247, 28, 193, 87, 234, 144, 223,
-			74, 15, 57, 189, 178, 171, 19, 110, 38, 31, 137, 9, 160, 12,
-			186, 250, 193, 174, 89, 144, 124, 147, 238, 231, 212, 156, 83, 187,
-			56, 249, 160, 103, 239, 38, 195, 155, 100, 188, 173, 102, 220, 163,
-			142, 43, 189, 235, 232, 57, 133, 148, 17, 188, 186, 215, 2, 174,
-			59, 177, 11, 168, 63, 236, 159, 186, 219, 132, 43, 6, 125, 176,
-			19, 111, 34, 55, 197, 254, 196, 172, 211, 119, 103, 28, 174, 59,
-			59, 209, 173, 171, 69, 218, 242, 252, 78, 236, 70, 183, 38, 198,
-			71, 210, 203, 140, 222, 146, 181, 13, 119, 189, 42, 221, 13, 131,
-			214, 178, 44, 42, 174, 143, 36, 82, 189, 251, 78, 187, 237, 249,
-			27, 137, 204, 134, 223, 116, 80, 116, 35, 233, 135, 11, 244, 46,
-			137, 30, 10, 48, 66, 135, 179, 174, 14, 94, 141, 209, 26, 161,
-			168, 96, 187, 240, 140, 32, 140, 21, 40, 195, 157, 70, 195, 123,
-			66, 11, 81, 129, 14, 115, 252, 39, 80, 145, 97, 215, 143, 160,
-			203, 34, 134, 197, 172, 185, 245, 68, 206, 234, 119, 90, 235, 136,
-			218, 207, 179, 242, 187, 79, 114, 202, 68, 194, 135, 18, 200, 36,
-			242, 92, 114, 154, 226, 147, 46, 123, 153, 171, 242, 180, 130, 146,
-			198, 83, 101, 41, 198, 73, 162, 237, 94, 170, 163, 88, 87, 20,
-			174, 70, 5, 128, 99, 96, 163, 159, 58, 20, 39, 148, 194, 36,
-			128, 194, 186, 171, 144, 216, 171, 180, 146, 152, 93, 19, 172, 92,
-			86, 78, 87, 169, 132, 182, 188, 90, 152, 46, 247, 69, 139, 157,
-			136, 10, 224, 181, 40, 204, 213, 27, 249, 65, 242, 223, 137, 240,
-			40, 198, 135, 250, 17, 251, 15, 52, 186, 132, 160, 22, 162, 82,
-			46, 17, 87, 217, 130, 18, 189, 207, 193, 62, 97, 110, 143, 94,
-			157, 184, 94, 188, 254, 218, 171, 236, 128, 99, 127, 65, 139, 126,
-			165, 235, 161, 0, 185, 216, 226, 58, 223, 41, 86, 106, 228, 210,
-			245, 160, 3, 77, 11, 69, 160, 26, 126, 55, 153, 34, 244, 213,
-			113, 70, 196, 88, 203, 243, 233, 101, 150, 104, 121, 254, 216, 102,
-			72, 47, 211, 201, 107, 116, 51, 28, 171, 59, 59, 244, 50, 189,
-			250, 234, 245, 210, 228, 117, 202, 214, 200, 24, 59, 92, 233, 101,
-			92, 161, 120, 210, 170, 30, 147, 31, 202, 96, 12, 172, 233, 31,
-			230, 15, 42, 30, 147, 31, 90, 135, 201, 215, 12, 225, 50, 25,
-			234, 150, 253, 111, 116, 209, 17, 41, 230, 198, 225, 253, 146, 230,
-			110, 20, 230, 70, 237, 47, 146, 116, 152, 88, 77, 17, 5, 7,
-			101, 137, 104, 34, 74, 11, 83, 188, 22, 206, 70, 135, 142, 19,
-			186, 198, 199, 97, 141, 75, 134, 192, 13, 150, 235, 172, 183, 224,
-			138, 231, 187, 27, 14, 252, 94, 3, 130, 120, 70, 156, 232, 98,
-			27, 64, 32, 87, 165, 66, 176, 168, 11, 1, 63, 192, 15, 252,
-			209, 143, 221, 48, 224, 26, 67, 225, 96, 156, 42, 77, 160, 187,
-			74, 60, 88, 16, 236, 50, 254, 81, 248, 104, 116, 209, 217, 61,
-			69, 110, 220, 184, 81, 228, 127, 113, 122, 40, 15, 148, 169, 161,
-			122, 154, 134, 169, 224, 25, 97, 42, 120, 70, 56, 120, 72, 138,
-			182, 126, 255, 10, 153, 122, 65, 87, 127, 144, 76, 202, 136, 166,
-			123, 197, 0, 125, 177, 16, 159, 123, 70, 8, 253, 44, 33, 62,
-			11, 255, 151, 70, 44, 53, 64, 235, 29, 55, 118, 188, 166, 117,
-			60, 21, 178, 19, 34, 60, 98, 188, 206, 161, 36, 228, 32, 194,
-			9, 200, 104, 131, 199, 73, 31, 52, 211, 171, 99, 64, 193, 106,
-			142, 37, 43, 117, 235, 4, 201, 135, 110, 99, 117, 211, 137, 54,
-			121, 148, 199, 190, 208, 109, 204, 57, 209, 166, 53, 69, 120, 200,
-			196, 213, 208, 109, 240, 40, 131, 39, 247, 10, 57, 87, 117, 27,
-			213, 254, 72, 252, 180, 166, 49, 70, 161, 32, 124, 40, 11, 95,
-			247, 136, 4, 167, 54, 175, 154, 250, 166, 240, 67, 58, 217, 167,
-			190, 182, 44, 181, 221, 188, 201, 83, 36, 47, 52, 133, 60, 188,
-			222, 30, 149, 204, 243, 92, 85, 153, 223, 154, 33, 251, 215, 59,
-			27, 171, 108, 135, 9, 124, 215, 143, 161, 107, 122, 22, 48, 221,
-			217, 152, 17, 185, 170, 251, 214, 149, 20, 15, 168, 201, 145, 145,
-			87, 81, 215, 11, 157, 133, 1, 53, 249, 139, 37, 120, 222, 21,
-			239, 50, 251, 194, 241, 46, 11, 75, 216, 21, 162, 17, 172, 43,
-			66, 183, 29, 136, 174, 96, 191, 173, 147, 164, 191, 225, 53, 93,
-			80, 200, 240, 241, 207, 179, 7, 11, 172, 159, 44, 98, 66, 60,
-			83, 3, 228, 177, 240, 187, 240, 11, 26, 217, 167, 182, 204, 90,
-			32, 251, 189, 40, 234, 184, 171, 236, 46, 243, 216, 13, 121, 20,
-			192, 75, 61, 226, 190, 178, 108, 203, 152, 75, 126, 63, 151, 169,
-			238, 243, 148, 23, 86, 153, 228, 91, 129, 31, 132, 142, 215, 228,
-			131, 211, 35, 46, 227, 125, 158, 67, 45, 70, 126, 54, 157, 39,
-			57, 68, 190, 41, 76, 145, 163, 61, 107, 181, 94, 33, 251, 228,
-			16, 178, 9, 142, 162, 232, 1, 249, 172, 82, 47, 204, 144, 67,
-			187, 170, 81, 87, 139, 150, 94, 45, 71, 72, 22, 182, 61, 1,
-			202, 1, 137, 207, 2, 202, 241, 31, 23, 48, 254, 224, 215, 180,
-			103, 162, 114, 140, 191, 68, 229, 120, 137, 202, 241, 217, 227, 15,
-			94, 76, 226, 15, 142, 36, 241, 7, 111, 113, 49, 208, 177, 4,
-			182, 131, 253, 28, 71, 41, 208, 137, 204, 53, 205, 62, 79, 43,
-			62, 242, 131, 32, 218, 88, 15, 58, 49, 117, 118, 69, 248, 22,
-			124, 225, 137, 188, 77, 126, 67, 23, 124, 97, 65, 191, 109, 255,
-			162, 190, 103, 212, 188, 174, 98, 122, 68, 205, 147, 1, 85, 196,
-			254, 222, 21, 57, 143, 208, 2, 95, 143, 209, 216, 39, 15, 170,
-			139, 111, 207, 206, 44, 63, 69, 212, 254, 79, 170, 179, 119, 87,
-			231, 202, 75, 115, 79, 225, 240, 142, 198, 62, 89, 169, 206, 175,
-			206, 46, 205, 148, 31, 204, 222, 89, 93, 158, 93, 90, 94, 173,
-			220, 121, 90, 40, 17, 126, 229, 232, 241, 22, 92, 74, 241, 68,
-			164, 110, 84, 115, 218, 18, 52, 80, 168, 103, 84, 221, 211, 227,
-			141, 49, 223, 141, 199, 58, 97, 115, 236, 220, 3, 39, 222, 156,
-			133, 47, 74, 159, 57, 126, 95, 33, 21, 191, 175, 144, 138, 223,
-			87, 24, 20, 65, 71, 50, 121, 203, 40, 88, 183, 210, 225, 251,
-			10, 135, 223, 32, 84, 240, 164, 23, 244, 163, 246, 225, 100, 243,
-			72, 144, 182, 19, 134, 233, 130, 172, 138, 49, 76, 23, 250, 85,
-			104, 142, 11, 135, 143, 16, 143, 71, 27, 51, 70, 244, 163, 246,
-			23, 104, 153, 118, 124, 239, 163, 142, 203, 49, 52, 27, 30, 143,
-			136, 198, 131, 181, 251, 34, 208, 135, 172, 138, 86, 197, 144, 39,
-			40, 212, 37, 209, 179, 108, 5, 212, 129, 105, 73, 194, 172, 232,
-			169, 248, 133, 58, 196, 47, 28, 84, 2, 147, 141, 28, 62, 66,
-			190, 44, 226, 140, 141, 234, 199, 237, 144, 206, 185, 79, 156, 186,
-			91, 243, 90, 78, 83, 94, 186, 24, 199, 34, 238, 145, 130, 253,
-			148, 220, 10, 68, 28, 67, 75, 201, 228, 161, 176, 84, 98, 95,
-			162, 201, 182, 228, 89, 5, 11, 196, 195, 25, 202, 72, 100, 89,
-			70, 65, 94, 137, 68, 54, 218, 127, 72, 137, 68, 54, 122, 228,
-			24, 121, 200, 3, 145, 25, 227, 250, 73, 123, 1, 76, 251, 133,
-			92, 81, 220, 108, 133, 70, 204, 143, 195, 160, 41, 128, 220, 210,
-			64, 167, 73, 136, 124, 196, 136, 103, 252, 113, 73, 141, 245, 53,
-			174, 15, 40, 177, 190, 198, 247, 29, 83, 98, 125, 141, 159, 176,
-			201, 5, 17, 203, 235, 170, 78, 237, 33, 24, 11, 181, 76, 0,
-			153, 78, 133, 219, 186, 170, 31, 80, 194, 109, 93, 61, 120, 82,
-			9, 183, 117, 245, 204, 89, 114, 9, 80, 230, 205, 215, 50, 85,
-			205, 62, 185, 231, 62, 193, 183, 7, 54, 181, 94, 203, 31, 33,
-			23, 57, 30, 188, 113, 67, 183, 236, 19, 48, 49, 131, 208, 219,
-			240, 124, 14, 39, 9, 234, 42, 78, 6, 0, 193, 27, 55, 120,
-			247, 2, 136, 181, 113, 131, 175, 4, 64, 176, 54, 110, 12, 30,
-			34, 171, 28, 228, 221, 184, 165, 159, 178, 171, 138, 173, 59, 206,
-			199, 72, 128, 232, 243, 13, 6, 205, 180, 154, 46, 175, 72, 90,
-			145, 149, 24, 135, 68, 239, 175, 44, 45, 167, 64, 225, 36, 41,
-			90, 142, 213, 112, 64, 164, 88, 125, 7, 143, 139, 148, 97, 25,
-			183, 236, 147, 100, 135, 67, 190, 27, 101, 253, 21, 187, 137, 214,
-			7, 140, 33, 161, 156, 91, 162, 146, 227, 232, 25, 139, 128, 17,
-			92, 228, 214, 0, 37, 66, 167, 59, 27, 41, 41, 117, 50, 19,
-			80, 109, 199, 90, 193, 65, 235, 4, 145, 122, 142, 213, 45, 136,
-			100, 157, 82, 62, 120, 74, 164, 12, 203, 40, 159, 165, 228, 127,
-			209, 57, 164, 183, 81, 209, 207, 218, 255, 141, 78, 43, 98, 29,
-			115, 184, 68, 52, 70, 228, 43, 70, 141, 4, 146, 24, 133, 114,
-			166, 83, 132, 46, 4, 232, 94, 232, 216, 78, 179, 185, 51, 250,
-			81, 199, 105, 162, 189, 180, 138, 100, 36, 160, 31, 133, 10, 29,
-			181, 215, 37, 66, 221, 141, 18, 21, 60, 83, 16, 193, 206, 80,
-			114, 218, 30, 240, 228, 51, 78, 228, 178, 153, 69, 228, 169, 128,
-			118, 139, 151, 253, 32, 190, 140, 192, 165, 28, 11, 117, 23, 89,
-			216, 175, 161, 203, 134, 50, 22, 97, 90, 9, 111, 94, 137, 222,
-			9, 182, 253, 40, 14, 93, 167, 197, 215, 27, 170, 67, 55, 17,
-			41, 83, 229, 134, 88, 89, 192, 94, 128, 178, 152, 227, 141, 147,
-			221, 245, 129, 106, 34, 118, 195, 118, 232, 198, 202, 196, 97, 91,
-			68, 69, 206, 97, 182, 69, 84, 250, 109, 145, 98, 163, 112, 250,
-			12, 249, 231, 26, 199, 74, 55, 222, 209, 135, 237, 127, 250, 231,
-			18, 138, 133, 47, 198, 79, 21, 132, 165, 71, 164, 67, 177, 48,
-			138, 116, 215, 165, 6, 139, 17, 209, 152, 118, 47, 33, 182, 75,
-			189, 163, 15, 137, 148, 102, 25, 239, 156, 56, 39, 82, 134, 101,
-			188, 115, 241, 18, 41, 1, 240, 187, 185, 146, 217, 208, 236, 2,
-			157, 239, 50, 241, 132, 233, 159, 184, 185, 240, 189, 133, 205, 243,
-			149, 252, 17, 242, 199, 26, 199, 92, 55, 30, 234, 150, 253, 79,
-			52, 1, 130, 201, 78, 119, 33, 111, 80, 14, 45, 196, 73, 102,
-			203, 94, 178, 20, 240, 76, 249, 106, 74, 30, 219, 111, 108, 6,
-			81, 124, 123, 236, 13, 126, 170, 221, 78, 217, 220, 211, 194, 243,
-			35, 12, 137, 55, 99, 81, 88, 43, 16, 20, 115, 48, 182, 219,
-			245, 249, 76, 45, 148, 54, 188, 152, 113, 36, 75, 115, 139, 43,
-			243, 119, 122, 117, 33, 128, 190, 27, 15, 249, 100, 2, 204, 119,
-			227, 33, 223, 16, 1, 242, 221, 120, 56, 120, 136, 252, 137, 64,
-			124, 55, 106, 250, 113, 251, 127, 210, 82, 145, 39, 96, 235, 219,
-			222, 107, 143, 228, 40, 145, 13, 104, 63, 28, 229, 172, 123, 34,
-			15, 195, 220, 192, 240, 162, 118, 0, 41, 30, 27, 43, 72, 25,
-			54, 6, 129, 18, 251, 91, 52, 214, 118, 118, 90, 240, 163, 22,
-			132, 174, 72, 173, 114, 19, 170, 85, 118, 232, 172, 118, 98, 175,
-			185, 218, 241, 189, 24, 166, 102, 13, 186, 197, 121, 66, 81, 161,
-			56, 69, 175, 79, 76, 150, 8, 238, 200, 226, 126, 178, 238, 212,
-			30, 71, 77, 56, 157, 129, 175, 192, 139, 129, 236, 29, 198, 205,
-			212, 100, 239, 176, 61, 186, 214, 111, 137, 148, 97, 25, 181, 163,
-			199, 200, 155, 208, 57, 186, 101, 52, 244, 67, 246, 36, 93, 244,
-			221, 209, 117, 135, 241, 166, 236, 194, 43, 100, 197, 207, 232, 32,
-			94, 28, 59, 72, 27, 92, 210, 164, 67, 103, 55, 242, 251, 68,
-			202, 176, 140, 198, 193, 65, 226, 0, 78, 189, 249, 56, 19, 105,
-			246, 10, 173, 170, 42, 212, 228, 32, 224, 136, 244, 234, 49, 81,
-			130, 216, 222, 50, 3, 132, 160, 77, 2, 124, 179, 41, 10, 217,
-			35, 62, 253, 217, 150, 242, 56, 127, 4, 226, 45, 24, 249, 140,
-			101, 54, 245, 16, 35, 52, 27, 121, 54, 67, 154, 249, 3, 100,
-			146, 35, 209, 27, 126, 118, 212, 190, 0, 123, 128, 176, 21, 129,
-			154, 151, 187, 15, 40, 222, 80, 192, 144, 55, 252, 172, 45, 82,
-			154, 101, 248, 39, 135, 69, 202, 176, 12, 255, 74, 17, 14, 117,
-			131, 245, 193, 71, 217, 243, 252, 80, 23, 215, 243, 30, 37, 178,
-			147, 244, 163, 236, 49, 145, 98, 159, 29, 63, 43, 82, 134, 101,
-			124, 84, 56, 71, 190, 12, 136, 190, 230, 118, 230, 99, 205, 142,
-			210, 221, 225, 247, 164, 188, 8, 30, 204, 128, 61, 12, 214, 240,
-			32, 96, 156, 238, 108, 56, 190, 247, 177, 27, 22, 9, 117, 182,
-			28, 175, 137, 136, 128, 177, 92, 213, 208, 143, 162, 215, 19, 19,
-			43, 222, 177, 108, 135, 218, 206, 159, 38, 215, 57, 40, 176, 177,
-			163, 159, 176, 135, 95, 160, 243, 104, 229, 14, 111, 173, 9, 43,
-			118, 135, 79, 20, 128, 202, 53, 118, 96, 172, 56, 82, 174, 177,
-			115, 124, 136, 124, 145, 232, 102, 214, 202, 254, 165, 204, 215, 52,
-			205, 126, 167, 187, 185, 178, 43, 83, 147, 164, 152, 106, 81, 114,
-			41, 89, 239, 108, 68, 41, 17, 4, 111, 12, 227, 225, 254, 82,
-			254, 4, 185, 76, 76, 51, 171, 103, 44, 243, 43, 154, 126, 212,
-			62, 149, 30, 44, 190, 187, 9, 38, 108, 63, 201, 178, 188, 89,
-			200, 156, 23, 73, 141, 37, 121, 64, 156, 44, 152, 12, 126, 69,
-			59, 124, 132, 84, 160, 96, 8, 153, 167, 31, 182, 111, 238, 49,
-			11, 68, 56, 112, 132, 11, 156, 110, 122, 254, 227, 219, 229, 90,
-			205, 141, 34, 111, 221, 107, 122, 241, 78, 65, 214, 171, 169, 209,
-			248, 178, 0, 153, 251, 85, 17, 13, 39, 11, 144, 185, 95, 213,
-			14, 89, 82, 158, 251, 157, 117, 242, 250, 167, 145, 231, 226, 227,
-			61, 165, 185, 207, 149, 215, 62, 199, 46, 242, 5, 197, 193, 137,
-			209, 162, 52, 6, 248, 236, 226, 96, 251, 207, 32, 208, 182, 111,
-			190, 224, 183, 13, 199, 107, 118, 66, 118, 95, 114, 34, 41, 135,
-			254, 55, 89, 66, 146, 219, 157, 101, 167, 228, 207, 185, 239, 151,
-			141, 239, 151, 179, 92, 30, 123, 42, 17, 52, 235, 66, 60, 157,
-			149, 210, 230, 115, 164, 31, 171, 148, 130, 104, 246, 121, 246, 251,
-			101, 189, 154, 199, 23, 149, 186, 245, 58, 233, 227, 177, 67, 184,
-			208, 249, 196, 110, 161, 225, 187, 152, 1, 75, 23, 217, 173, 179,
-			36, 239, 62, 105, 3, 232, 57, 8, 87, 243, 248, 94, 62, 180,
-			110, 144, 92, 20, 59, 113, 39, 26, 202, 81, 109, 248, 192, 228,
-			169, 222, 178, 226, 37, 200, 195, 73, 199, 15, 172, 139, 100, 95,
-			212, 105, 181, 156, 112, 103, 117, 51, 110, 53, 135, 250, 146, 214,
-			13, 240, 23, 115, 113, 171, 105, 221, 38, 4, 14, 81, 8, 231,
-			50, 148, 135, 6, 216, 187, 68, 188, 82, 171, 142, 37, 244, 195,
-			39, 236, 161, 53, 69, 242, 98, 170, 13, 245, 243, 230, 119, 127,
-			45, 244, 70, 188, 121, 34, 191, 117, 157, 152, 177, 179, 17, 13,
-			17, 106, 12, 15, 244, 106, 220, 18, 220, 151, 31, 56, 94, 136,
-			159, 66, 118, 107, 132, 236, 227, 61, 168, 232, 1, 228, 184, 14,
-			240, 119, 160, 19, 152, 33, 251, 83, 179, 107, 104, 255, 167, 23,
-			236, 91, 119, 201, 129, 244, 52, 27, 58, 0, 165, 156, 221, 93,
-			202, 93, 204, 87, 133, 108, 213, 253, 13, 53, 217, 37, 77, 63,
-			248, 194, 210, 116, 139, 146, 126, 47, 2, 192, 45, 183, 62, 52,
-			40, 38, 138, 81, 205, 123, 209, 125, 120, 248, 182, 153, 31, 24,
-			220, 87, 248, 87, 58, 57, 200, 218, 49, 251, 36, 240, 185, 39,
-			206, 51, 39, 255, 241, 174, 201, 47, 231, 253, 213, 100, 74, 27,
-			207, 153, 210, 201, 108, 30, 37, 7, 220, 164, 98, 86, 168, 153,
-			170, 115, 191, 242, 182, 82, 183, 74, 100, 208, 125, 210, 110, 58,
-			62, 102, 135, 73, 154, 77, 38, 233, 65, 229, 37, 76, 212, 139,
-			93, 163, 158, 83, 38, 180, 58, 228, 111, 145, 28, 31, 165, 62,
-			88, 51, 61, 68, 248, 74, 255, 224, 208, 240, 165, 131, 223, 165,
-			187, 59, 223, 163, 187, 47, 191, 143, 251, 11, 174, 59, 235, 24,
-			177, 150, 150, 203, 203, 43, 75, 171, 43, 11, 96, 244, 120, 183,
-			50, 123, 103, 48, 99, 229, 137, 249, 160, 188, 180, 52, 168, 177,
-			95, 119, 203, 149, 249, 65, 221, 234, 39, 217, 153, 106, 121, 105,
-			110, 208, 96, 63, 203, 211, 139, 213, 229, 65, 147, 189, 95, 250,
-			92, 229, 193, 96, 246, 242, 143, 106, 228, 208, 46, 242, 172, 2,
-			57, 51, 251, 254, 226, 194, 108, 21, 97, 116, 171, 179, 229, 165,
-			197, 133, 174, 218, 142, 17, 107, 113, 102, 102, 165, 186, 180, 186,
-			184, 176, 122, 191, 92, 89, 152, 175, 44, 204, 14, 106, 214, 113,
-			114, 56, 121, 14, 86, 134, 171, 51, 243, 75, 131, 186, 53, 72,
-			246, 45, 44, 46, 175, 206, 84, 43, 203, 149, 153, 242, 252, 160,
-			97, 29, 38, 7, 87, 22, 102, 223, 127, 48, 59, 179, 60, 123,
-			103, 21, 104, 55, 63, 139, 26, 225, 239, 44, 163, 26, 225, 103,
-			245, 103, 169, 17, 38, 110, 188, 84, 35, 188, 84, 35, 124, 118,
-			53, 194, 72, 162, 70, 24, 78, 212, 8, 168, 92, 48, 44, 227,
-			40, 207, 96, 90, 198, 177, 204, 45, 248, 153, 181, 140, 227, 153,
-			59, 240, 51, 103, 25, 67, 18, 153, 210, 78, 84, 14, 236, 231,
-			15, 27, 160, 115, 200, 94, 204, 252, 148, 166, 217, 255, 86, 7,
-			33, 169, 8, 62, 239, 208, 70, 199, 23, 246, 56, 112, 31, 171,
-			57, 48, 105, 22, 27, 49, 4, 135, 231, 110, 130, 242, 21, 88,
-			29, 65, 216, 39, 183, 222, 21, 151, 68, 58, 201, 202, 199, 34,
-			168, 39, 233, 42, 40, 234, 120, 177, 155, 206, 42, 43, 128, 220,
-			126, 119, 216, 81, 252, 130, 219, 125, 202, 250, 61, 63, 9, 203,
-			35, 35, 147, 18, 58, 188, 184, 84, 164, 247, 30, 172, 20, 97,
-			97, 176, 165, 210, 104, 58, 27, 81, 145, 186, 113, 109, 68, 70,
-			20, 146, 62, 133, 194, 209, 87, 0, 35, 37, 87, 211, 58, 132,
-			106, 83, 12, 122, 146, 107, 65, 23, 88, 19, 72, 143, 220, 214,
-			186, 11, 78, 196, 73, 233, 41, 120, 242, 137, 215, 20, 125, 206,
-			197, 188, 69, 78, 178, 223, 253, 150, 113, 73, 63, 80, 56, 136,
-			10, 17, 33, 50, 37, 100, 128, 152, 102, 166, 63, 99, 25, 151,
-			6, 184, 130, 162, 159, 125, 167, 164, 116, 76, 253, 182, 208, 10,
-			153, 19, 250, 53, 211, 254, 247, 247, 86, 11, 97, 100, 186, 4,
-			211, 189, 135, 94, 232, 158, 27, 39, 188, 230, 110, 157, 208, 51,
-			160, 208, 159, 165, 12, 226, 219, 43, 232, 142, 150, 86, 230, 127,
-			48, 10, 162, 207, 172, 31, 154, 72, 233, 135, 38, 82, 250, 161,
-			137, 65, 233, 222, 149, 183, 204, 9, 235, 154, 145, 86, 16, 77,
-			154, 163, 202, 3, 205, 50, 174, 154, 87, 200, 119, 179, 66, 101,
-			52, 167, 191, 105, 255, 205, 44, 42, 8, 188, 122, 145, 58, 189,
-			21, 62, 201, 20, 236, 169, 242, 217, 112, 159, 76, 209, 47, 62,
-			124, 56, 53, 213, 14, 61, 63, 158, 154, 122, 244, 232, 147, 137,
-			226, 245, 137, 201, 167, 231, 185, 92, 113, 59, 224, 241, 216, 4,
-			224, 22, 220, 100, 196, 180, 166, 237, 208, 109, 120, 79, 248, 172,
-			149, 166, 118, 14, 1, 195, 35, 167, 217, 222, 116, 252, 78, 11,
-			160, 102, 107, 155, 14, 88, 86, 135, 60, 234, 80, 18, 67, 132,
-			58, 180, 237, 132, 124, 23, 217, 8, 131, 78, 187, 36, 237, 167,
-			33, 124, 114, 193, 25, 91, 31, 171, 21, 228, 207, 122, 242, 211,
-			157, 122, 162, 38, 118, 68, 162, 81, 224, 139, 19, 69, 66, 81,
-			151, 219, 15, 212, 130, 133, 151, 155, 77, 234, 129, 100, 121, 221,
-			133, 208, 90, 128, 18, 227, 98, 22, 186, 238, 214, 156, 78, 36,
-			5, 115, 188, 245, 188, 213, 5, 103, 12, 234, 123, 207, 139, 55,
-			133, 77, 58, 124, 38, 96, 29, 194, 40, 166, 215, 208, 125, 9,
-			241, 74, 158, 81, 40, 59, 52, 147, 114, 215, 247, 44, 89, 54,
-			27, 54, 55, 217, 238, 103, 213, 194, 138, 238, 73, 61, 251, 180,
-			32, 226, 105, 215, 148, 181, 238, 249, 116, 165, 82, 34, 56, 97,
-			228, 190, 38, 5, 231, 98, 248, 81, 252, 232, 239, 112, 247, 40,
-			140, 224, 149, 232, 44, 231, 82, 58, 203, 185, 148, 206, 114, 238,
-			240, 17, 145, 202, 91, 198, 220, 209, 219, 124, 178, 99, 112, 94,
-			99, 238, 216, 45, 178, 201, 85, 154, 230, 188, 190, 104, 218, 159,
-			87, 85, 32, 142, 186, 249, 112, 64, 44, 80, 19, 42, 145, 24,
-			96, 239, 151, 187, 175, 156, 235, 206, 232, 199, 227, 163, 55, 190,
-			48, 186, 90, 98, 83, 253, 42, 204, 244, 68, 163, 57, 159, 210,
-			104, 206, 115, 193, 36, 106, 52, 231, 143, 30, 227, 43, 86, 207,
-			91, 230, 252, 241, 69, 177, 98, 49, 170, 175, 113, 223, 188, 162,
-			60, 208, 44, 99, 193, 188, 76, 182, 132, 10, 116, 73, 127, 203,
-			246, 232, 29, 128, 59, 104, 11, 113, 57, 24, 237, 9, 126, 96,
-			219, 1, 102, 42, 236, 248, 190, 136, 168, 3, 202, 38, 130, 155,
-			171, 130, 229, 128, 17, 3, 139, 24, 50, 208, 13, 185, 221, 96,
-			114, 190, 37, 154, 207, 28, 171, 184, 95, 209, 124, 46, 17, 85,
-			243, 185, 116, 68, 132, 150, 48, 242, 150, 177, 116, 236, 77, 222,
-			0, 3, 91, 180, 116, 252, 54, 249, 105, 67, 104, 70, 191, 160,
-			223, 182, 255, 150, 161, 198, 58, 82, 206, 255, 228, 92, 79, 98,
-			57, 194, 9, 143, 87, 118, 8, 55, 200, 104, 228, 136, 208, 51,
-			156, 111, 166, 51, 243, 69, 122, 227, 198, 133, 43, 178, 12, 53,
-			72, 136, 242, 245, 74, 228, 134, 248, 144, 23, 1, 177, 158, 98,
-			55, 196, 144, 161, 60, 246, 19, 130, 72, 248, 226, 59, 81, 88,
-			9, 97, 96, 118, 189, 160, 175, 220, 82, 8, 231, 183, 82, 0,
-			143, 226, 81, 251, 19, 102, 130, 195, 159, 49, 46, 83, 22, 18,
-			7, 240, 205, 88, 244, 216, 107, 143, 213, 66, 39, 218, 68, 224,
-			41, 140, 59, 239, 132, 62, 227, 9, 25, 231, 224, 8, 60, 39,
-			167, 22, 119, 156, 38, 69, 89, 4, 73, 120, 108, 89, 100, 224,
-			187, 195, 104, 37, 221, 165, 210, 233, 234, 109, 92, 205, 109, 39,
-			244, 34, 208, 176, 112, 45, 114, 150, 141, 82, 78, 209, 48, 127,
-			161, 239, 160, 162, 97, 254, 130, 117, 88, 164, 242, 150, 241, 133,
-			35, 194, 38, 193, 196, 225, 254, 194, 209, 55, 32, 34, 46, 40,
-			160, 215, 244, 105, 123, 158, 222, 119, 106, 155, 158, 239, 142, 134,
-			174, 83, 7, 118, 5, 73, 79, 29, 45, 156, 209, 3, 29, 192,
-			194, 34, 104, 102, 119, 223, 251, 82, 74, 235, 53, 125, 159, 162,
-			180, 94, 219, 127, 88, 81, 90, 175, 29, 59, 46, 82, 121, 203,
-			88, 27, 42, 115, 26, 179, 72, 227, 218, 137, 183, 200, 159, 226,
-			148, 204, 89, 70, 160, 223, 177, 255, 149, 65, 231, 58, 45, 199,
-			79, 104, 84, 174, 200, 10, 236, 8, 176, 41, 158, 79, 231, 150,
-			239, 207, 11, 114, 215, 25, 107, 232, 123, 49, 40, 217, 120, 24,
-			173, 208, 101, 124, 54, 70, 76, 132, 111, 215, 195, 96, 59, 114,
-			67, 169, 150, 243, 62, 78, 212, 165, 40, 49, 146, 170, 57, 247,
-			35, 54, 188, 236, 4, 9, 105, 212, 114, 32, 236, 22, 152, 3,
-			39, 10, 59, 112, 140, 95, 89, 190, 59, 250, 58, 198, 146, 134,
-			32, 115, 145, 12, 70, 141, 188, 31, 224, 113, 96, 60, 57, 142,
-			34, 23, 59, 27, 236, 188, 186, 76, 223, 136, 221, 39, 241, 168,
-			200, 126, 155, 147, 27, 9, 227, 128, 136, 67, 143, 200, 242, 32,
-			140, 222, 147, 24, 60, 82, 87, 16, 225, 0, 60, 83, 151, 3,
-			172, 74, 204, 42, 68, 233, 19, 159, 77, 117, 213, 195, 190, 17,
-			191, 71, 189, 250, 173, 194, 27, 34, 181, 234, 213, 111, 23, 110,
-			119, 149, 169, 108, 195, 127, 166, 114, 89, 65, 136, 31, 120, 91,
-			204, 159, 92, 150, 13, 187, 216, 165, 115, 154, 101, 4, 253, 98,
-			19, 203, 25, 150, 17, 12, 157, 16, 169, 188, 101, 4, 246, 12,
-			159, 63, 57, 156, 63, 193, 201, 105, 50, 7, 211, 167, 207, 50,
-			67, 61, 26, 183, 167, 96, 84, 219, 129, 135, 130, 124, 112, 141,
-			219, 22, 8, 85, 201, 238, 0, 162, 61, 238, 130, 139, 215, 6,
-			57, 165, 251, 114, 150, 17, 234, 194, 242, 162, 79, 179, 140, 240,
-			212, 69, 145, 50, 44, 35, 28, 185, 44, 82, 121, 203, 136, 114,
-			99, 156, 164, 62, 36, 41, 234, 43, 241, 101, 151, 183, 204, 109,
-			253, 201, 184, 253, 185, 196, 65, 163, 123, 161, 37, 219, 171, 50,
-			135, 213, 105, 183, 1, 1, 75, 249, 180, 27, 151, 52, 230, 115,
-			150, 177, 173, 219, 34, 165, 89, 198, 246, 201, 115, 34, 101, 88,
-			198, 246, 197, 75, 34, 149, 183, 140, 39, 146, 198, 60, 210, 248,
-			164, 175, 68, 190, 142, 206, 4, 253, 150, 241, 84, 127, 219, 222,
-			166, 66, 16, 200, 111, 182, 233, 27, 1, 94, 120, 90, 32, 211,
-			80, 96, 239, 0, 18, 47, 140, 189, 26, 96, 114, 40, 97, 127,
-			195, 93, 251, 73, 153, 62, 102, 108, 34, 114, 37, 2, 119, 75,
-			182, 167, 223, 100, 100, 200, 84, 206, 50, 158, 202, 48, 73, 253,
-			154, 101, 60, 61, 38, 218, 218, 111, 88, 198, 211, 211, 103, 69,
-			42, 111, 25, 79, 105, 133, 183, 174, 31, 91, 247, 244, 149, 57,
-			242, 9, 52, 142, 88, 185, 175, 105, 250, 95, 209, 76, 251, 49,
-			157, 115, 162, 77, 209, 255, 50, 10, 54, 221, 116, 159, 12, 71,
-			155, 206, 228, 245, 87, 135, 35, 136, 79, 53, 124, 233, 82, 233,
-			195, 192, 243, 135, 47, 93, 136, 166, 46, 68, 95, 240, 47, 65,
-			135, 60, 46, 210, 45, 54, 159, 196, 151, 192, 99, 14, 143, 176,
-			63, 187, 175, 12, 200, 86, 144, 172, 101, 126, 77, 232, 82, 50,
-			58, 209, 88, 178, 255, 168, 72, 26, 44, 57, 100, 147, 131, 152,
-			204, 51, 82, 79, 254, 21, 205, 32, 131, 208, 22, 2, 109, 49,
-			191, 174, 153, 163, 202, 19, 205, 50, 191, 161, 153, 87, 248, 156,
-			31, 176, 204, 31, 210, 244, 130, 61, 213, 195, 18, 72, 246, 63,
-			247, 172, 196, 176, 234, 224, 145, 174, 78, 58, 78, 205, 64, 14,
-			138, 58, 32, 146, 26, 75, 30, 60, 37, 146, 6, 75, 158, 125,
-			133, 44, 67, 181, 251, 44, 243, 135, 53, 253, 188, 125, 247, 89,
-			213, 202, 227, 119, 145, 157, 229, 18, 236, 180, 145, 206, 33, 99,
-			254, 103, 244, 125, 57, 40, 246, 160, 72, 106, 44, 57, 120, 70,
-			36, 13, 150, 124, 229, 28, 249, 14, 78, 219, 253, 150, 249, 55,
-			52, 125, 196, 254, 107, 127, 110, 118, 27, 114, 230, 127, 58, 243,
-			13, 36, 119, 127, 14, 232, 27, 18, 73, 141, 37, 79, 156, 19,
-			73, 131, 37, 47, 14, 147, 223, 196, 43, 250, 1, 203, 252, 174,
-			166, 79, 219, 191, 152, 10, 61, 153, 98, 138, 37, 194, 25, 10,
-			108, 101, 108, 125, 47, 78, 98, 61, 3, 215, 148, 152, 136, 242,
-			48, 107, 98, 85, 35, 162, 14, 26, 96, 36, 87, 121, 198, 3,
-			193, 65, 228, 59, 45, 151, 253, 159, 223, 174, 217, 79, 169, 20,
-			98, 9, 193, 211, 176, 223, 130, 225, 185, 76, 19, 165, 10, 75,
-			9, 149, 7, 251, 173, 74, 177, 89, 122, 151, 10, 203, 3, 173,
-			13, 160, 177, 72, 232, 156, 225, 56, 236, 248, 53, 97, 124, 53,
-			113, 109, 60, 185, 103, 70, 35, 123, 44, 179, 3, 89, 232, 189,
-			156, 72, 106, 44, 217, 55, 40, 146, 6, 75, 30, 22, 171, 238,
-			64, 158, 37, 143, 149, 249, 146, 58, 128, 139, 236, 187, 218, 241,
-			183, 200, 13, 162, 103, 51, 86, 238, 103, 181, 204, 111, 107, 154,
-			125, 229, 89, 188, 146, 211, 53, 65, 6, 136, 145, 205, 104, 150,
-			249, 179, 90, 246, 32, 249, 138, 70, 204, 44, 136, 93, 126, 94,
-			211, 79, 216, 33, 69, 249, 57, 68, 145, 75, 196, 73, 40, 84,
-			92, 8, 98, 1, 117, 202, 239, 105, 156, 165, 84, 121, 231, 155,
-			0, 246, 230, 70, 232, 205, 151, 198, 12, 148, 168, 151, 126, 228,
-			198, 194, 52, 140, 181, 53, 139, 56, 51, 63, 175, 233, 71, 68,
-			82, 103, 201, 227, 67, 100, 4, 232, 211, 44, 243, 23, 53, 125,
-			192, 62, 153, 240, 184, 112, 32, 177, 137, 134, 48, 167, 178, 32,
-			13, 243, 230, 68, 82, 103, 201, 126, 66, 190, 139, 45, 213, 45,
-			243, 87, 88, 73, 127, 67, 235, 81, 148, 88, 228, 116, 169, 179,
-			177, 1, 146, 7, 233, 235, 13, 96, 36, 92, 10, 202, 45, 62,
-			60, 191, 22, 132, 33, 184, 37, 2, 246, 47, 60, 4, 81, 141,
-			8, 97, 77, 146, 239, 119, 125, 4, 2, 91, 252, 132, 54, 154,
-			206, 99, 87, 182, 128, 181, 246, 87, 146, 22, 32, 201, 253, 132,
-			252, 50, 182, 192, 176, 204, 95, 213, 244, 125, 246, 255, 191, 87,
-			11, 224, 38, 224, 214, 217, 228, 6, 239, 72, 229, 192, 6, 75,
-			202, 78, 92, 11, 90, 174, 32, 68, 184, 71, 77, 245, 108, 34,
-			30, 160, 65, 200, 127, 32, 192, 18, 161, 169, 70, 139, 166, 93,
-			129, 143, 213, 246, 201, 230, 24, 26, 16, 220, 39, 146, 58, 75,
-			146, 1, 178, 1, 173, 49, 45, 243, 55, 88, 107, 62, 232, 209,
-			24, 206, 246, 96, 77, 108, 70, 58, 235, 24, 204, 149, 115, 203,
-			13, 207, 247, 162, 77, 192, 101, 69, 147, 136, 22, 0, 17, 176,
-			37, 59, 5, 71, 72, 208, 73, 200, 48, 53, 168, 73, 144, 97,
-			234, 44, 73, 6, 200, 79, 233, 64, 71, 214, 50, 255, 17, 155,
-			23, 255, 15, 123, 223, 30, 30, 87, 113, 229, 217, 85, 117, 213,
-			106, 149, 36, 75, 42, 75, 45, 249, 74, 182, 175, 219, 54, 150,
-			101, 189, 108, 192, 128, 141, 131, 133, 100, 27, 129, 1, 227, 71,
-			8, 97, 136, 105, 73, 87, 118, 199, 173, 110, 77, 119, 203, 70,
-			153, 47, 153, 25, 66, 152, 25, 54, 36, 36, 217, 100, 66, 190,
-			176, 48, 67, 216, 89, 242, 216, 33, 144, 199, 240, 237, 102, 194,
-			76, 102, 216, 47, 36, 147, 47, 11, 179, 236, 102, 200, 78, 30,
-			76, 18, 146, 1, 50, 64, 18, 54, 225, 227, 99, 191, 58, 85,
-			167, 110, 221, 219, 15, 61, 108, 96, 146, 224, 191, 124, 90, 221,
-			247, 214, 227, 212, 121, 213, 57, 191, 243, 33, 26, 25, 200, 84,
-			102, 74, 165, 97, 161, 225, 21, 142, 15, 29, 62, 110, 123, 157,
-			198, 61, 202, 102, 21, 112, 123, 200, 61, 45, 246, 3, 48, 154,
-			58, 26, 37, 104, 60, 228, 105, 156, 153, 254, 208, 252, 102, 11,
-			254, 76, 90, 42, 128, 172, 66, 32, 15, 189, 0, 128, 248, 45,
-			231, 240, 84, 186, 40, 159, 35, 29, 193, 89, 96, 222, 129, 74,
-			142, 96, 110, 58, 115, 12, 237, 186, 0, 174, 54, 204, 158, 48,
-			95, 109, 215, 133, 30, 169, 215, 172, 142, 192, 34, 33, 99, 214,
-			81, 73, 54, 112, 254, 143, 4, 18, 125, 227, 143, 144, 216, 31,
-			80, 226, 254, 61, 241, 198, 85, 245, 173, 246, 60, 117, 112, 64,
-			169, 186, 94, 29, 80, 24, 221, 191, 25, 152, 127, 162, 152, 207,
-			34, 110, 140, 55, 145, 5, 193, 142, 64, 193, 101, 62, 115, 32,
-			206, 2, 163, 40, 84, 119, 107, 94, 167, 255, 14, 71, 186, 232,
-			77, 228, 75, 199, 117, 120, 80, 103, 211, 73, 245, 47, 253, 255,
-			98, 94, 159, 194, 92, 190, 196, 189, 209, 253, 155, 164, 20, 48,
-			130, 210, 145, 210, 228, 17, 146, 232, 228, 223, 161, 58, 47, 57,
-			254, 24, 161, 143, 19, 199, 253, 198, 98, 34, 212, 214, 245, 170,
-			9, 83, 243, 178, 56, 181, 117, 185, 24, 141, 85, 47, 55, 84,
-			109, 189, 184, 56, 244, 123, 246, 69, 37, 244, 251, 172, 25, 173,
-			198, 165, 125, 141, 67, 215, 205, 152, 208, 237, 60, 134, 118, 40,
-			100, 116, 59, 143, 145, 134, 102, 36, 153, 36, 91, 219, 192, 14,
-			37, 52, 150, 144, 219, 33, 30, 215, 118, 40, 209, 241, 107, 231,
-			31, 209, 14, 37, 58, 128, 237, 252, 47, 105, 135, 158, 175, 19,
-			193, 157, 111, 17, 218, 225, 246, 97, 0, 27, 99, 214, 253, 94,
-			209, 247, 43, 148, 32, 152, 177, 145, 58, 248, 41, 142, 141, 192,
-			147, 116, 158, 19, 228, 120, 59, 223, 34, 43, 219, 249, 247, 136,
-			206, 242, 118, 254, 153, 208, 164, 251, 40, 137, 70, 222, 44, 163,
-			222, 182, 225, 212, 251, 245, 29, 62, 84, 199, 15, 194, 221, 100,
-			230, 68, 104, 84, 254, 13, 165, 66, 26, 55, 233, 232, 108, 58,
-			83, 40, 106, 212, 169, 124, 46, 176, 244, 210, 10, 115, 51, 72,
-			133, 229, 145, 55, 247, 171, 142, 185, 25, 171, 177, 246, 204, 68,
-			38, 135, 233, 230, 65, 83, 234, 193, 137, 116, 209, 63, 26, 226,
-			12, 92, 16, 26, 135, 57, 54, 32, 73, 36, 201, 219, 144, 100,
-			146, 108, 239, 144, 146, 30, 242, 201, 227, 223, 39, 244, 7, 196,
-			113, 175, 14, 133, 83, 115, 246, 41, 89, 84, 60, 85, 193, 179,
-			128, 157, 81, 24, 128, 118, 114, 218, 61, 107, 198, 124, 106, 231,
-			251, 193, 54, 73, 253, 243, 125, 210, 208, 137, 36, 147, 164, 219,
-			173, 89, 136, 37, 228, 176, 122, 126, 96, 88, 72, 133, 31, 157,
-			39, 3, 22, 98, 138, 133, 254, 69, 178, 80, 78, 167, 97, 59,
-			63, 38, 244, 18, 247, 122, 79, 37, 3, 72, 85, 59, 225, 31,
-			207, 232, 27, 191, 208, 169, 63, 189, 168, 143, 26, 179, 83, 7,
-			47, 196, 25, 73, 85, 246, 99, 210, 176, 10, 73, 38, 201, 158,
-			213, 72, 38, 36, 185, 102, 159, 30, 189, 138, 175, 57, 63, 38,
-			107, 247, 242, 183, 195, 232, 235, 132, 243, 12, 161, 99, 238, 239,
-			188, 170, 94, 166, 26, 76, 157, 122, 25, 142, 92, 106, 144, 103,
-			208, 173, 36, 180, 142, 73, 178, 11, 39, 82, 151, 144, 164, 59,
-			170, 71, 174, 162, 110, 206, 51, 164, 251, 98, 254, 97, 37, 126,
-			227, 0, 85, 56, 238, 222, 76, 23, 183, 242, 51, 81, 179, 88,
-			117, 91, 246, 142, 104, 145, 157, 46, 22, 51, 197, 146, 55, 21,
-			164, 250, 167, 115, 233, 236, 124, 88, 85, 207, 149, 242, 210, 107,
-			156, 244, 38, 230, 142, 13, 76, 103, 178, 202, 224, 128, 123, 11,
-			0, 144, 247, 166, 252, 82, 160, 237, 65, 202, 79, 22, 50, 37,
-			136, 53, 171, 251, 43, 169, 132, 244, 246, 74, 223, 114, 110, 98,
-			38, 3, 161, 245, 147, 6, 193, 124, 30, 66, 190, 19, 190, 50,
-			218, 242, 154, 165, 205, 61, 10, 124, 67, 62, 197, 147, 47, 1,
-			159, 107, 116, 127, 209, 172, 114, 92, 173, 203, 74, 36, 1, 179,
-			177, 221, 69, 18, 48, 27, 87, 175, 65, 50, 33, 201, 181, 151,
-			232, 85, 86, 177, 41, 231, 5, 226, 237, 227, 207, 43, 201, 85,
-			47, 156, 87, 8, 29, 113, 159, 36, 229, 30, 158, 125, 86, 23,
-			235, 230, 241, 192, 207, 91, 192, 205, 179, 53, 97, 13, 95, 47,
-			156, 211, 84, 201, 129, 139, 166, 49, 41, 15, 81, 114, 76, 21,
-			189, 83, 95, 7, 147, 142, 35, 73, 36, 89, 143, 178, 189, 158,
-			73, 114, 101, 59, 146, 9, 73, 118, 236, 214, 75, 168, 98, 105,
-			206, 43, 36, 121, 17, 56, 102, 68, 196, 223, 77, 99, 247, 80,
-			233, 152, 41, 54, 245, 78, 29, 159, 143, 88, 46, 96, 240, 5,
-			91, 173, 29, 51, 169, 83, 222, 77, 235, 146, 252, 61, 210, 216,
-			151, 246, 134, 243, 71, 148, 110, 116, 79, 153, 7, 45, 217, 49,
-			179, 77, 129, 5, 188, 51, 174, 172, 174, 168, 119, 166, 212, 240,
-			31, 81, 234, 33, 73, 37, 185, 126, 3, 191, 143, 194, 40, 137,
-			112, 222, 71, 233, 42, 247, 207, 168, 119, 40, 51, 147, 201, 166,
-			11, 21, 46, 66, 188, 83, 126, 193, 247, 242, 19, 10, 196, 18,
-			82, 96, 188, 153, 116, 38, 7, 105, 245, 19, 133, 116, 110, 242,
-			56, 247, 122, 65, 41, 165, 143, 165, 51, 185, 98, 201, 75, 171,
-			91, 38, 99, 176, 205, 229, 212, 217, 145, 79, 85, 24, 154, 166,
-			227, 208, 230, 65, 238, 245, 74, 83, 16, 58, 134, 34, 112, 252,
-			84, 126, 110, 162, 164, 181, 163, 97, 73, 48, 6, 165, 203, 149,
-			81, 230, 53, 135, 3, 92, 152, 145, 222, 68, 73, 95, 239, 228,
-			39, 39, 231, 10, 32, 146, 43, 220, 232, 108, 230, 222, 72, 208,
-			180, 165, 194, 76, 237, 115, 62, 52, 122, 149, 87, 152, 203, 21,
-			45, 118, 171, 83, 182, 195, 251, 168, 118, 119, 9, 120, 169, 239,
-			163, 157, 93, 252, 105, 181, 160, 84, 56, 31, 161, 212, 117, 255,
-			239, 18, 22, 212, 126, 169, 124, 99, 111, 113, 51, 156, 44, 16,
-			23, 253, 210, 235, 198, 82, 177, 209, 253, 189, 197, 205, 131, 94,
-			239, 97, 141, 222, 94, 84, 222, 46, 192, 126, 232, 166, 242, 144,
-			72, 174, 196, 145, 142, 134, 113, 15, 186, 23, 224, 126, 205, 89,
-			118, 67, 110, 58, 83, 152, 81, 0, 62, 37, 115, 125, 133, 62,
-			48, 252, 61, 63, 151, 155, 202, 228, 142, 113, 111, 58, 61, 89,
-			202, 75, 131, 5, 172, 26, 40, 149, 2, 9, 9, 56, 37, 115,
-			57, 249, 142, 209, 253, 69, 239, 100, 177, 226, 223, 120, 148, 97,
-			252, 51, 178, 25, 146, 127, 63, 66, 105, 7, 146, 176, 250, 93,
-			171, 248, 223, 51, 216, 12, 38, 156, 59, 41, 93, 233, 126, 129,
-			25, 199, 74, 13, 10, 102, 111, 36, 125, 190, 154, 175, 35, 61,
-			157, 89, 169, 105, 2, 189, 49, 137, 185, 56, 40, 224, 103, 164,
-			29, 160, 0, 98, 139, 122, 221, 181, 19, 169, 20, 21, 114, 168,
-			239, 101, 166, 185, 121, 145, 26, 70, 177, 164, 110, 158, 114, 249,
-			185, 99, 199, 181, 32, 152, 73, 79, 249, 193, 216, 160, 39, 65,
-			209, 203, 67, 58, 20, 212, 166, 169, 75, 71, 240, 156, 102, 253,
-			66, 6, 48, 71, 179, 214, 45, 110, 81, 158, 167, 241, 105, 93,
-			211, 166, 4, 50, 244, 28, 1, 116, 61, 68, 86, 10, 143, 35,
-			192, 233, 15, 118, 129, 155, 244, 41, 147, 15, 85, 101, 253, 84,
-			32, 63, 93, 210, 98, 186, 159, 67, 174, 128, 175, 224, 100, 130,
-			235, 103, 245, 215, 162, 87, 158, 87, 233, 229, 11, 94, 133, 172,
-			74, 179, 203, 210, 14, 188, 147, 170, 168, 177, 36, 169, 36, 219,
-			4, 255, 174, 146, 180, 142, 112, 62, 65, 105, 210, 253, 159, 86,
-			88, 5, 123, 132, 164, 139, 17, 119, 84, 110, 231, 160, 215, 27,
-			160, 85, 43, 99, 33, 98, 202, 22, 85, 23, 10, 99, 64, 128,
-			100, 153, 44, 232, 78, 52, 249, 66, 244, 137, 210, 234, 152, 203,
-			105, 28, 31, 108, 15, 2, 143, 177, 60, 246, 188, 118, 31, 15,
-			101, 114, 39, 240, 234, 2, 171, 50, 102, 210, 185, 57, 235, 37,
-			224, 17, 108, 54, 11, 32, 205, 198, 79, 80, 218, 134, 36, 149,
-			100, 123, 71, 208, 87, 184, 196, 207, 93, 100, 138, 127, 193, 159,
-			132, 118, 0, 203, 47, 142, 232, 142, 150, 55, 64, 59, 103, 253,
-			199, 5, 219, 27, 187, 139, 29, 40, 222, 232, 233, 159, 157, 183,
-			200, 159, 5, 174, 135, 254, 225, 178, 11, 71, 82, 119, 17, 222,
-			57, 10, 219, 17, 184, 84, 7, 85, 145, 155, 232, 229, 205, 193,
-			155, 16, 119, 4, 18, 168, 233, 193, 166, 224, 47, 227, 83, 226,
-			66, 206, 3, 90, 3, 161, 84, 72, 206, 183, 94, 97, 125, 95,
-			172, 230, 28, 235, 234, 12, 122, 79, 131, 254, 100, 124, 42, 245,
-			30, 194, 87, 95, 156, 46, 77, 30, 143, 142, 179, 136, 3, 221,
-			195, 19, 250, 235, 170, 101, 116, 227, 182, 205, 229, 47, 175, 50,
-			203, 131, 230, 167, 145, 113, 208, 10, 227, 88, 83, 109, 28, 170,
-			203, 154, 120, 19, 111, 180, 66, 34, 122, 44, 181, 23, 194, 254,
-			129, 88, 207, 155, 85, 27, 138, 163, 165, 252, 9, 63, 87, 236,
-			162, 208, 0, 187, 73, 125, 120, 24, 62, 75, 221, 78, 120, 167,
-			106, 34, 86, 190, 101, 35, 161, 141, 32, 11, 111, 132, 218, 77,
-			123, 55, 118, 242, 70, 61, 6, 201, 208, 122, 51, 203, 235, 59,
-			12, 210, 252, 65, 174, 190, 46, 255, 159, 58, 135, 175, 218, 155,
-			201, 193, 221, 78, 249, 224, 202, 80, 157, 168, 170, 42, 72, 221,
-			79, 184, 135, 51, 82, 64, 244, 21, 54, 121, 59, 111, 87, 54,
-			82, 38, 119, 236, 104, 100, 146, 250, 105, 43, 205, 23, 130, 223,
-			139, 77, 188, 37, 61, 53, 117, 212, 222, 23, 181, 170, 43, 210,
-			83, 246, 123, 196, 0, 23, 5, 127, 38, 127, 210, 15, 125, 151,
-			193, 119, 219, 212, 95, 172, 175, 167, 254, 204, 156, 156, 32, 250,
-			129, 99, 93, 95, 182, 13, 13, 229, 11, 125, 49, 111, 180, 206,
-			99, 245, 83, 19, 60, 94, 63, 163, 20, 20, 42, 45, 112, 116,
-			62, 25, 62, 58, 193, 147, 138, 75, 26, 169, 125, 190, 104, 237,
-			243, 85, 182, 22, 85, 207, 87, 217, 96, 211, 161, 227, 21, 26,
-			171, 62, 94, 23, 41, 204, 45, 189, 98, 53, 206, 151, 53, 138,
-			198, 96, 181, 138, 169, 207, 19, 222, 19, 60, 62, 84, 53, 177,
-			132, 229, 184, 138, 183, 194, 48, 44, 109, 170, 119, 111, 93, 229,
-			161, 88, 47, 82, 79, 107, 41, 69, 106, 110, 22, 88, 154, 207,
-			17, 158, 138, 172, 141, 245, 235, 165, 109, 230, 165, 101, 155, 57,
-			88, 107, 51, 203, 23, 105, 241, 59, 58, 199, 215, 215, 28, 181,
-			222, 214, 43, 120, 91, 116, 61, 113, 111, 23, 94, 208, 131, 173,
-			145, 181, 44, 166, 126, 151, 119, 168, 55, 142, 104, 245, 138, 235,
-			211, 205, 227, 179, 233, 130, 159, 43, 217, 107, 163, 63, 18, 59,
-			121, 2, 213, 177, 17, 122, 101, 47, 199, 39, 170, 159, 154, 31,
-			164, 38, 120, 183, 53, 83, 252, 150, 217, 152, 209, 178, 53, 175,
-			128, 56, 86, 113, 204, 193, 98, 167, 222, 194, 123, 42, 191, 67,
-			47, 227, 249, 188, 1, 199, 131, 203, 87, 99, 6, 7, 131, 47,
-			111, 251, 113, 130, 39, 14, 106, 155, 73, 92, 199, 91, 163, 10,
-			78, 44, 94, 157, 186, 53, 181, 77, 42, 38, 222, 201, 147, 149,
-			149, 168, 24, 170, 128, 74, 87, 75, 237, 187, 195, 139, 255, 129,
-			90, 162, 84, 76, 206, 46, 170, 59, 43, 205, 174, 138, 126, 93,
-			112, 118, 105, 46, 202, 245, 159, 216, 82, 161, 236, 175, 154, 150,
-			92, 240, 21, 25, 190, 170, 170, 174, 20, 219, 170, 79, 165, 154,
-			98, 117, 147, 101, 186, 125, 143, 52, 118, 213, 98, 69, 133, 113,
-			117, 86, 40, 147, 252, 149, 102, 18, 124, 169, 140, 21, 44, 129,
-			191, 0, 43, 148, 171, 177, 5, 88, 161, 130, 46, 73, 197, 68,
-			22, 197, 68, 180, 0, 114, 137, 242, 208, 93, 88, 72, 165, 98,
-			226, 189, 36, 36, 34, 162, 194, 80, 156, 179, 224, 12, 42, 72,
-			124, 247, 220, 37, 254, 202, 76, 254, 20, 111, 175, 36, 76, 196,
-			64, 205, 7, 70, 5, 155, 91, 97, 169, 106, 201, 168, 84, 108,
-			57, 197, 129, 127, 252, 22, 85, 28, 248, 192, 27, 197, 129, 111,
-			20, 7, 190, 22, 197, 129, 27, 131, 226, 192, 190, 160, 56, 240,
-			162, 160, 56, 112, 36, 40, 14, 188, 88, 87, 4, 174, 10, 42,
-			2, 229, 127, 127, 64, 57, 141, 199, 132, 179, 62, 118, 45, 113,
-			255, 15, 245, 14, 233, 30, 147, 112, 17, 47, 53, 109, 40, 45,
-			73, 50, 106, 84, 107, 5, 109, 193, 77, 104, 56, 29, 220, 77,
-			156, 240, 231, 189, 148, 114, 188, 6, 192, 87, 76, 169, 155, 71,
-			188, 197, 211, 221, 149, 117, 151, 115, 221, 163, 103, 174, 100, 110,
-			241, 173, 27, 164, 168, 140, 28, 228, 222, 149, 242, 251, 167, 50,
-			26, 21, 43, 212, 113, 19, 174, 121, 128, 201, 143, 92, 49, 114,
-			228, 240, 37, 123, 174, 56, 60, 62, 58, 114, 120, 207, 152, 110,
-			6, 134, 152, 252, 242, 29, 42, 248, 4, 175, 245, 1, 37, 198,
-			158, 28, 244, 21, 219, 59, 50, 190, 127, 207, 216, 209, 3, 7,
-			247, 24, 86, 177, 158, 163, 111, 159, 236, 60, 3, 15, 14, 159,
-			210, 157, 83, 170, 212, 47, 30, 35, 130, 173, 79, 180, 242, 95,
-			80, 238, 196, 1, 186, 113, 136, 238, 115, 111, 167, 122, 102, 114,
-			221, 114, 254, 41, 235, 33, 131, 216, 116, 95, 247, 67, 51, 189,
-			181, 85, 47, 180, 224, 93, 83, 186, 27, 70, 144, 67, 62, 24,
-			52, 236, 87, 155, 115, 220, 79, 75, 246, 10, 33, 209, 65, 154,
-			100, 120, 119, 130, 19, 170, 26, 78, 150, 230, 228, 193, 211, 61,
-			39, 251, 61, 227, 184, 226, 228, 244, 56, 117, 148, 211, 140, 200,
-			72, 23, 117, 7, 61, 62, 230, 165, 179, 5, 63, 61, 53, 175,
-			195, 159, 253, 122, 97, 139, 222, 200, 254, 131, 123, 70, 198, 174,
-			57, 186, 231, 45, 227, 135, 14, 31, 226, 161, 221, 217, 118, 185,
-			183, 107, 151, 117, 135, 94, 244, 118, 157, 198, 63, 200, 52, 142,
-			171, 66, 190, 161, 120, 18, 41, 42, 216, 80, 231, 32, 82, 76,
-			176, 161, 11, 70, 249, 109, 106, 139, 136, 112, 182, 211, 243, 182,
-			186, 127, 24, 236, 145, 169, 82, 181, 156, 111, 117, 221, 174, 171,
-			90, 11, 179, 147, 139, 95, 252, 180, 122, 222, 0, 220, 29, 77,
-			69, 246, 130, 87, 218, 140, 5, 247, 66, 197, 93, 33, 4, 19,
-			116, 158, 199, 84, 128, 160, 148, 86, 245, 33, 77, 23, 189, 138,
-			166, 168, 214, 155, 131, 104, 212, 15, 226, 226, 17, 34, 216, 246,
-			120, 15, 82, 84, 176, 237, 171, 119, 32, 197, 4, 59, 175, 101,
-			128, 95, 8, 107, 71, 5, 219, 65, 247, 185, 67, 58, 98, 162,
-			83, 20, 116, 191, 237, 92, 62, 55, 96, 142, 71, 136, 227, 245,
-			179, 40, 17, 108, 135, 217, 36, 120, 152, 217, 36, 202, 4, 219,
-			113, 193, 40, 223, 7, 239, 97, 130, 237, 162, 151, 185, 59, 188,
-			195, 133, 116, 174, 168, 219, 132, 5, 172, 103, 49, 37, 226, 76,
-			194, 49, 223, 59, 126, 197, 200, 254, 241, 183, 98, 89, 75, 92,
-			213, 86, 237, 138, 119, 33, 69, 5, 219, 181, 106, 27, 82, 242,
-			45, 187, 246, 241, 139, 225, 149, 142, 112, 118, 211, 145, 94, 247,
-			28, 51, 55, 13, 145, 142, 93, 101, 211, 11, 79, 208, 33, 130,
-			237, 142, 175, 69, 138, 10, 182, 219, 219, 141, 20, 19, 108, 164,
-			101, 3, 255, 32, 129, 215, 213, 9, 182, 151, 238, 115, 183, 123,
-			35, 179, 170, 107, 110, 184, 118, 14, 48, 94, 171, 191, 79, 29,
-			162, 195, 118, 97, 214, 25, 56, 68, 117, 68, 176, 189, 102, 127,
-			234, 168, 96, 123, 205, 254, 212, 49, 193, 246, 94, 48, 202, 175,
-			129, 209, 199, 133, 115, 9, 29, 223, 234, 94, 230, 141, 148, 242,
-			51, 58, 124, 159, 54, 51, 153, 144, 236, 87, 86, 58, 182, 192,
-			156, 244, 139, 226, 68, 176, 75, 12, 51, 198, 169, 96, 151, 24,
-			102, 140, 51, 193, 198, 91, 6, 248, 56, 12, 162, 94, 56, 251,
-			233, 229, 41, 119, 103, 116, 13, 237, 155, 134, 197, 189, 180, 158,
-			8, 182, 63, 222, 141, 20, 21, 108, 127, 207, 249, 72, 49, 193,
-			46, 111, 89, 195, 143, 194, 75, 19, 194, 57, 64, 175, 218, 238,
-			94, 181, 152, 153, 219, 65, 133, 10, 35, 225, 149, 134, 146, 32,
-			130, 29, 136, 175, 67, 138, 10, 118, 32, 53, 134, 20, 19, 236,
-			170, 150, 109, 252, 59, 138, 135, 26, 132, 115, 13, 125, 235, 144,
-			251, 135, 68, 75, 178, 64, 144, 25, 255, 90, 215, 216, 153, 186,
-			35, 44, 177, 87, 70, 80, 240, 126, 85, 206, 3, 183, 38, 126,
-			177, 52, 160, 246, 76, 125, 168, 237, 34, 52, 168, 7, 1, 152,
-			114, 90, 119, 51, 128, 107, 48, 100, 72, 99, 115, 159, 150, 76,
-			15, 24, 178, 129, 8, 118, 77, 220, 69, 138, 10, 118, 77, 247,
-			118, 164, 152, 96, 111, 109, 217, 194, 111, 38, 10, 114, 249, 250,
-			88, 158, 184, 155, 1, 252, 32, 220, 150, 90, 142, 52, 42, 10,
-			7, 249, 182, 43, 207, 164, 26, 146, 67, 198, 194, 255, 235, 19,
-			107, 249, 79, 76, 131, 143, 105, 186, 215, 253, 54, 177, 94, 20,
-			202, 170, 155, 240, 21, 32, 109, 80, 106, 29, 182, 2, 6, 53,
-			208, 42, 56, 22, 8, 181, 121, 249, 200, 53, 250, 22, 43, 164,
-			175, 84, 95, 150, 204, 164, 159, 157, 215, 109, 72, 164, 197, 60,
-			62, 22, 88, 91, 220, 75, 65, 210, 192, 192, 214, 109, 103, 159,
-			115, 238, 246, 243, 206, 191, 96, 56, 53, 8, 149, 214, 202, 70,
-			83, 93, 199, 139, 202, 142, 147, 238, 198, 190, 35, 227, 99, 192,
-			65, 118, 153, 238, 117, 186, 86, 247, 232, 239, 12, 92, 215, 183,
-			33, 84, 88, 63, 29, 42, 172, 159, 110, 72, 90, 133, 245, 211,
-			171, 92, 11, 120, 121, 186, 123, 79, 184, 174, 126, 122, 245, 40,
-			223, 140, 85, 244, 25, 218, 227, 246, 216, 107, 6, 10, 22, 92,
-			136, 162, 95, 176, 16, 152, 227, 242, 187, 77, 86, 53, 115, 166,
-			185, 211, 170, 102, 206, 184, 221, 252, 139, 4, 33, 152, 115, 180,
-			203, 189, 151, 84, 196, 96, 54, 165, 82, 154, 121, 6, 189, 131,
-			190, 244, 58, 240, 142, 249, 236, 237, 222, 200, 161, 209, 241, 113,
-			171, 140, 1, 18, 171, 11, 233, 220, 84, 126, 198, 59, 114, 68,
-			229, 136, 74, 235, 122, 102, 198, 207, 77, 129, 51, 113, 216, 122,
-			32, 220, 49, 67, 49, 236, 148, 63, 51, 155, 47, 233, 26, 154,
-			180, 119, 125, 16, 195, 188, 94, 53, 224, 81, 29, 44, 67, 128,
-			206, 185, 80, 249, 115, 174, 97, 165, 85, 254, 156, 75, 118, 242,
-			29, 10, 77, 184, 16, 123, 23, 113, 7, 171, 28, 129, 202, 209,
-			169, 0, 96, 184, 144, 216, 168, 49, 120, 99, 130, 157, 162, 195,
-			110, 22, 31, 83, 188, 54, 115, 221, 96, 48, 204, 160, 188, 77,
-			59, 82, 51, 179, 165, 121, 41, 56, 76, 185, 155, 245, 221, 76,
-			142, 151, 225, 101, 28, 153, 149, 95, 58, 119, 120, 216, 11, 91,
-			34, 242, 213, 142, 124, 183, 161, 226, 130, 157, 106, 76, 89, 8,
-			198, 167, 214, 111, 177, 16, 140, 79, 13, 14, 241, 103, 9, 66,
-			24, 191, 147, 118, 185, 223, 253, 117, 217, 222, 32, 187, 154, 123,
-			197, 82, 33, 159, 59, 150, 157, 183, 159, 47, 71, 53, 155, 159,
-			157, 203, 42, 132, 222, 140, 129, 11, 70, 212, 228, 58, 57, 225,
-			132, 133, 168, 252, 78, 205, 21, 10, 81, 249, 157, 201, 78, 126,
-			49, 192, 193, 198, 111, 36, 177, 91, 9, 113, 207, 81, 184, 48,
-			202, 118, 93, 152, 49, 84, 182, 53, 36, 122, 43, 92, 194, 196,
-			89, 124, 131, 134, 136, 117, 110, 34, 244, 44, 55, 25, 146, 158,
-			250, 238, 94, 39, 141, 81, 104, 157, 124, 19, 182, 78, 166, 114,
-			43, 157, 155, 72, 99, 7, 146, 68, 146, 201, 117, 72, 50, 73,
-			110, 216, 200, 31, 162, 26, 126, 85, 21, 166, 125, 150, 122, 87,
-			230, 180, 225, 43, 253, 108, 213, 144, 30, 211, 4, 162, 62, 85,
-			81, 103, 215, 164, 177, 35, 83, 201, 54, 215, 173, 174, 253, 233,
-			162, 133, 156, 143, 105, 38, 217, 116, 225, 152, 207, 149, 2, 87,
-			198, 95, 193, 79, 107, 39, 212, 174, 45, 142, 216, 255, 69, 111,
-			194, 159, 135, 118, 66, 217, 108, 254, 20, 64, 161, 206, 72, 159,
-			13, 34, 1, 234, 201, 186, 98, 74, 247, 84, 156, 44, 76, 204,
-			29, 3, 84, 219, 173, 195, 219, 207, 57, 231, 130, 237, 232, 137,
-			233, 107, 215, 107, 51, 215, 169, 58, 18, 249, 154, 169, 162, 146,
-			122, 102, 157, 229, 81, 228, 94, 223, 88, 30, 66, 66, 217, 252,
-			49, 221, 210, 73, 117, 83, 236, 51, 203, 79, 28, 88, 66, 67,
-			214, 73, 82, 119, 174, 6, 116, 87, 231, 189, 68, 172, 69, 18,
-			106, 242, 82, 235, 249, 78, 0, 93, 141, 127, 128, 196, 254, 148,
-			16, 119, 160, 138, 36, 137, 198, 167, 45, 86, 97, 68, 56, 31,
-			32, 137, 181, 0, 152, 206, 36, 171, 124, 136, 208, 125, 110, 167,
-			45, 203, 75, 121, 61, 97, 61, 88, 128, 73, 149, 223, 107, 66,
-			146, 72, 178, 185, 19, 73, 38, 73, 183, 27, 201, 132, 36, 123,
-			246, 66, 130, 33, 195, 180, 246, 15, 145, 53, 99, 124, 171, 134,
-			82, 117, 62, 66, 104, 191, 187, 30, 156, 38, 108, 55, 172, 243,
-			41, 117, 46, 32, 188, 127, 202, 12, 128, 196, 225, 55, 248, 10,
-			2, 143, 232, 217, 132, 36, 147, 100, 223, 22, 190, 11, 128, 85,
-			227, 31, 35, 177, 59, 8, 113, 135, 170, 44, 79, 121, 116, 221,
-			90, 32, 41, 166, 63, 70, 18, 235, 248, 217, 26, 23, 213, 249,
-			56, 161, 187, 220, 141, 33, 156, 225, 176, 123, 131, 230, 162, 30,
-			45, 160, 162, 202, 95, 37, 144, 36, 146, 212, 117, 0, 128, 139,
-			234, 124, 156, 104, 20, 27, 7, 150, 235, 227, 68, 92, 8, 203,
-			229, 224, 114, 125, 156, 180, 239, 224, 99, 128, 156, 26, 191, 139,
-			196, 62, 75, 136, 244, 68, 106, 238, 118, 89, 8, 223, 154, 85,
-			29, 17, 206, 93, 36, 209, 203, 255, 7, 65, 132, 212, 123, 8,
-			189, 220, 253, 43, 82, 99, 94, 233, 169, 169, 33, 117, 179, 109,
-			123, 90, 165, 252, 208, 116, 33, 63, 211, 207, 33, 227, 127, 188,
-			204, 18, 10, 149, 180, 216, 16, 233, 96, 217, 40, 4, 14, 157,
-			26, 105, 191, 14, 18, 145, 20, 204, 147, 142, 104, 1, 102, 213,
-			169, 116, 97, 6, 178, 181, 210, 197, 19, 97, 99, 60, 128, 111,
-			189, 39, 12, 223, 122, 15, 105, 88, 109, 193, 183, 222, 67, 188,
-			117, 72, 38, 36, 153, 218, 15, 75, 93, 135, 75, 125, 15, 217,
-			112, 41, 255, 12, 65, 128, 215, 79, 74, 209, 249, 159, 212, 186,
-			20, 203, 23, 70, 159, 119, 24, 97, 127, 149, 37, 24, 87, 125,
-			91, 213, 111, 139, 225, 95, 75, 1, 136, 81, 25, 108, 172, 175,
-			145, 125, 116, 220, 192, 43, 102, 178, 126, 174, 148, 157, 231, 94,
-			230, 88, 46, 143, 129, 8, 163, 184, 38, 231, 3, 16, 89, 7,
-			198, 107, 99, 202, 126, 18, 133, 136, 194, 148, 253, 36, 17, 235,
-			44, 76, 217, 79, 74, 25, 254, 247, 106, 174, 84, 56, 247, 17,
-			186, 217, 253, 66, 100, 174, 179, 5, 255, 100, 38, 63, 87, 204,
-			6, 3, 140, 206, 95, 113, 5, 76, 159, 47, 99, 254, 10, 19,
-			71, 63, 90, 47, 198, 178, 215, 128, 58, 48, 15, 67, 214, 73,
-			210, 172, 129, 220, 209, 251, 136, 216, 128, 36, 147, 228, 166, 94,
-			16, 164, 113, 17, 255, 28, 137, 125, 173, 134, 32, 141, 6, 63,
-			173, 19, 21, 39, 194, 249, 156, 20, 164, 163, 220, 113, 226, 242,
-			64, 125, 129, 208, 81, 247, 220, 208, 121, 82, 151, 199, 214, 252,
-			171, 112, 12, 140, 46, 14, 204, 252, 5, 100, 230, 56, 48, 243,
-			23, 72, 195, 74, 36, 161, 120, 50, 217, 137, 100, 66, 146, 93,
-			23, 3, 51, 199, 145, 153, 191, 64, 220, 221, 252, 22, 10, 99,
-			34, 194, 249, 18, 161, 151, 184, 47, 149, 103, 5, 150, 242, 90,
-			73, 75, 197, 172, 33, 169, 210, 57, 83, 170, 150, 81, 205, 247,
-			176, 56, 108, 202, 159, 154, 155, 53, 17, 209, 162, 170, 190, 225,
-			202, 126, 10, 229, 26, 98, 252, 49, 128, 141, 43, 127, 110, 40,
-			34, 169, 205, 79, 63, 180, 68, 37, 4, 145, 192, 23, 74, 247,
-			104, 210, 247, 167, 32, 245, 59, 63, 59, 155, 55, 69, 107, 42,
-			154, 169, 138, 187, 194, 49, 77, 21, 210, 52, 75, 43, 21, 200,
-			151, 80, 131, 197, 225, 104, 124, 137, 52, 119, 33, 201, 36, 217,
-			221, 131, 100, 66, 146, 171, 247, 233, 165, 85, 208, 74, 206, 151,
-			200, 218, 61, 252, 151, 14, 44, 45, 21, 206, 35, 132, 118, 185,
-			63, 113, 126, 227, 205, 89, 111, 124, 102, 54, 43, 143, 44, 84,
-			218, 218, 208, 58, 200, 30, 186, 69, 165, 135, 29, 30, 115, 126,
-			65, 7, 43, 204, 182, 67, 51, 208, 201, 227, 92, 157, 111, 213,
-			195, 198, 43, 205, 205, 102, 125, 175, 55, 24, 113, 63, 116, 191,
-			190, 225, 104, 126, 26, 161, 244, 55, 167, 116, 219, 84, 238, 13,
-			132, 188, 22, 233, 145, 235, 213, 218, 7, 171, 53, 13, 82, 5,
-			167, 139, 221, 81, 231, 138, 126, 65, 254, 52, 250, 92, 249, 128,
-			97, 105, 129, 86, 58, 225, 253, 112, 229, 36, 127, 17, 152, 148,
-			240, 43, 238, 121, 242, 55, 53, 47, 155, 77, 25, 20, 196, 153,
-			84, 69, 228, 225, 43, 199, 174, 236, 125, 251, 228, 241, 76, 46,
-			235, 251, 155, 119, 104, 241, 169, 51, 251, 113, 117, 21, 118, 138,
-			42, 105, 155, 204, 231, 78, 250, 5, 101, 66, 151, 76, 39, 99,
-			197, 156, 82, 192, 61, 18, 8, 9, 10, 69, 158, 70, 72, 72,
-			1, 247, 136, 20, 18, 31, 36, 156, 58, 245, 34, 254, 77, 18,
-			123, 146, 16, 247, 236, 133, 157, 78, 107, 46, 106, 220, 42, 2,
-			115, 166, 98, 152, 187, 118, 41, 193, 89, 79, 132, 243, 77, 146,
-			216, 8, 130, 179, 94, 10, 206, 71, 79, 83, 112, 214, 131, 224,
-			124, 20, 215, 164, 30, 4, 231, 163, 184, 38, 245, 32, 56, 31,
-			69, 193, 89, 15, 130, 243, 81, 20, 156, 245, 40, 56, 31, 149,
-			130, 243, 5, 2, 99, 34, 194, 121, 130, 208, 97, 247, 95, 136,
-			119, 16, 69, 144, 145, 152, 145, 171, 185, 144, 15, 110, 25, 52,
-			11, 250, 224, 246, 77, 82, 78, 183, 111, 49, 62, 248, 107, 234,
-			217, 171, 101, 145, 182, 196, 19, 168, 71, 235, 65, 96, 62, 65,
-			26, 83, 72, 194, 154, 172, 223, 130, 36, 147, 228, 224, 16, 255,
-			55, 181, 98, 20, 170, 22, 187, 220, 239, 253, 230, 187, 247, 122,
-			5, 168, 93, 168, 89, 15, 199, 240, 251, 1, 203, 81, 40, 212,
-			212, 46, 126, 66, 196, 127, 72, 98, 63, 94, 164, 139, 95, 118,
-			12, 225, 212, 36, 136, 112, 126, 40, 93, 124, 233, 183, 37, 228,
-			169, 121, 138, 208, 77, 110, 103, 248, 120, 134, 125, 252, 4, 248,
-			248, 79, 225, 158, 38, 192, 141, 123, 10, 125, 252, 4, 28, 147,
-			167, 72, 50, 133, 36, 147, 228, 198, 179, 248, 110, 78, 157, 6,
-			17, 127, 154, 196, 254, 31, 33, 238, 182, 5, 109, 163, 178, 18,
-			112, 24, 113, 3, 17, 206, 211, 36, 177, 1, 206, 121, 131, 28,
-			241, 179, 167, 121, 206, 27, 224, 156, 63, 139, 139, 222, 0, 19,
-			120, 22, 23, 189, 1, 38, 240, 44, 158, 243, 6, 56, 231, 207,
-			226, 57, 111, 192, 115, 254, 172, 60, 231, 195, 48, 36, 34, 226,
-			207, 17, 250, 60, 217, 234, 122, 96, 32, 69, 231, 98, 25, 73,
-			250, 145, 242, 80, 60, 71, 84, 21, 130, 36, 137, 36, 69, 10,
-			73, 38, 201, 141, 103, 33, 153, 16, 206, 243, 36, 62, 172, 223,
-			175, 173, 136, 231, 73, 98, 144, 255, 21, 129, 1, 80, 225, 188,
-			72, 126, 205, 98, 158, 106, 110, 146, 251, 95, 12, 54, 66, 114,
-			255, 139, 193, 70, 72, 238, 127, 81, 110, 196, 94, 78, 29, 46,
-			226, 47, 145, 216, 173, 148, 184, 231, 47, 78, 7, 217, 57, 73,
-			22, 63, 113, 34, 156, 151, 72, 162, 15, 248, 137, 75, 126, 122,
-			249, 52, 249, 137, 3, 63, 189, 140, 211, 224, 192, 79, 47, 227,
-			52, 56, 240, 211, 203, 200, 79, 28, 248, 233, 101, 228, 39, 142,
-			252, 244, 178, 228, 167, 23, 9, 140, 137, 8, 231, 102, 74, 207,
-			117, 159, 170, 168, 55, 162, 115, 251, 141, 209, 29, 28, 116, 199,
-			205, 148, 26, 50, 46, 201, 198, 77, 72, 194, 186, 244, 14, 35,
-			201, 36, 121, 246, 57, 252, 167, 106, 213, 168, 112, 222, 75, 127,
-			59, 66, 195, 106, 1, 228, 225, 121, 47, 53, 92, 7, 193, 84,
-			106, 184, 78, 30, 158, 247, 210, 100, 39, 223, 199, 169, 211, 40,
-			226, 183, 209, 216, 135, 40, 113, 47, 88, 164, 234, 168, 114, 122,
-			26, 137, 112, 110, 163, 137, 45, 208, 33, 167, 81, 158, 158, 15,
-			82, 186, 213, 237, 81, 250, 35, 116, 13, 26, 86, 34, 141, 160,
-			68, 62, 136, 155, 219, 8, 74, 228, 131, 180, 209, 69, 146, 72,
-			178, 187, 31, 73, 38, 201, 161, 97, 126, 171, 180, 63, 155, 68,
-			252, 163, 52, 246, 9, 90, 253, 210, 163, 98, 90, 51, 154, 158,
-			103, 234, 182, 18, 77, 207, 38, 34, 156, 143, 210, 196, 106, 254,
-			136, 228, 188, 38, 185, 10, 119, 80, 122, 145, 251, 223, 72, 37,
-			33, 82, 208, 13, 206, 172, 126, 89, 230, 146, 22, 195, 20, 193,
-			90, 65, 220, 171, 236, 182, 214, 220, 242, 246, 107, 203, 62, 18,
-			101, 179, 98, 102, 101, 183, 186, 149, 127, 108, 185, 42, 150, 40,
-			107, 2, 81, 118, 7, 50, 85, 19, 108, 203, 29, 180, 161, 5,
-			73, 38, 73, 177, 18, 201, 132, 36, 219, 223, 4, 162, 172, 9,
-			69, 217, 29, 52, 121, 33, 191, 83, 45, 13, 17, 206, 221, 148,
-			142, 186, 183, 17, 179, 11, 42, 46, 156, 205, 167, 167, 116, 134,
-			148, 106, 101, 134, 171, 22, 92, 96, 35, 160, 38, 10, 156, 11,
-			119, 121, 231, 110, 221, 118, 89, 230, 226, 65, 110, 190, 53, 104,
-			193, 85, 6, 224, 18, 126, 201, 254, 10, 4, 15, 113, 165, 117,
-			24, 200, 204, 87, 202, 152, 187, 145, 43, 155, 64, 198, 220, 77,
-			27, 113, 130, 82, 198, 220, 77, 181, 232, 110, 2, 85, 124, 55,
-			213, 162, 187, 9, 85, 241, 221, 212, 221, 13, 6, 90, 179, 136,
-			255, 5, 141, 253, 87, 138, 6, 90, 77, 21, 21, 205, 114, 213,
-			7, 172, 153, 8, 231, 47, 104, 98, 61, 255, 27, 185, 128, 205,
-			146, 183, 62, 67, 233, 160, 123, 127, 69, 93, 48, 18, 220, 254,
-			43, 204, 187, 185, 153, 0, 127, 9, 150, 79, 175, 110, 104, 21,
-			183, 14, 95, 14, 139, 120, 216, 48, 169, 2, 73, 203, 102, 195,
-			225, 18, 196, 81, 153, 242, 11, 25, 3, 79, 100, 226, 50, 161,
-			40, 106, 117, 169, 222, 12, 7, 255, 51, 184, 196, 205, 112, 240,
-			63, 67, 27, 61, 36, 137, 36, 215, 109, 70, 146, 73, 178, 127,
-			128, 55, 113, 234, 172, 16, 241, 251, 40, 100, 228, 202, 165, 89,
-			65, 132, 115, 31, 77, 108, 224, 41, 238, 56, 43, 228, 202, 220,
-			79, 105, 202, 109, 183, 206, 119, 88, 230, 172, 128, 87, 223, 143,
-			175, 94, 1, 175, 190, 31, 119, 119, 5, 188, 250, 126, 218, 190,
-			26, 73, 38, 73, 111, 157, 41, 6, 253, 197, 38, 126, 193, 82,
-			106, 30, 17, 4, 100, 217, 5, 161, 175, 121, 59, 171, 229, 151,
-			116, 62, 204, 120, 163, 148, 33, 26, 170, 199, 238, 217, 67, 170,
-			245, 236, 161, 139, 238, 217, 179, 46, 210, 84, 71, 21, 253, 132,
-			250, 233, 236, 52, 61, 168, 156, 106, 253, 116, 172, 241, 41, 72,
-			63, 211, 133, 234, 66, 94, 143, 229, 93, 117, 80, 195, 146, 170,
-			89, 17, 55, 151, 155, 202, 250, 7, 241, 39, 98, 15, 111, 10,
-			85, 17, 197, 23, 91, 69, 20, 250, 89, 121, 19, 168, 250, 101,
-			52, 129, 90, 176, 41, 144, 88, 205, 185, 82, 64, 69, 185, 57,
-			13, 170, 124, 74, 127, 50, 62, 149, 186, 132, 183, 70, 103, 42,
-			206, 225, 113, 93, 47, 88, 181, 184, 211, 42, 196, 208, 223, 77,
-			29, 226, 237, 214, 138, 31, 40, 248, 10, 255, 204, 218, 41, 178,
-			228, 157, 234, 187, 139, 240, 182, 178, 191, 138, 245, 124, 45, 32,
-			118, 189, 121, 228, 224, 248, 200, 21, 135, 143, 86, 236, 115, 180,
-			130, 243, 160, 109, 80, 43, 23, 93, 188, 61, 160, 247, 95, 115,
-			244, 208, 101, 227, 7, 14, 236, 25, 107, 109, 23, 13, 188, 110,
-			239, 254, 145, 203, 174, 105, 93, 35, 127, 132, 72, 97, 123, 198,
-			90, 123, 35, 189, 135, 46, 31, 57, 116, 89, 235, 128, 104, 226,
-			9, 243, 220, 109, 203, 41, 54, 248, 143, 107, 121, 92, 56, 43,
-			98, 185, 90, 181, 6, 219, 182, 190, 81, 107, 240, 70, 173, 193,
-			25, 169, 53, 216, 21, 212, 26, 140, 5, 181, 6, 88, 85, 208,
-			25, 84, 21, 200, 255, 142, 170, 60, 59, 55, 118, 25, 113, 207,
-			11, 119, 100, 157, 145, 246, 11, 220, 163, 134, 80, 124, 36, 135,
-			100, 164, 17, 161, 160, 53, 139, 86, 119, 28, 55, 177, 146, 255,
-			7, 147, 36, 183, 150, 118, 184, 239, 170, 214, 28, 127, 89, 189,
-			82, 182, 157, 187, 29, 122, 165, 44, 181, 125, 126, 172, 78, 142,
-			198, 206, 98, 91, 107, 250, 99, 196, 152, 96, 107, 87, 182, 235,
-			222, 17, 68, 176, 245, 52, 249, 218, 247, 142, 32, 113, 249, 226,
-			6, 43, 231, 109, 189, 233, 29, 65, 152, 96, 235, 219, 59, 120,
-			6, 83, 222, 54, 81, 247, 85, 198, 50, 11, 146, 212, 54, 133,
-			146, 212, 54, 25, 244, 119, 202, 4, 219, 212, 165, 154, 167, 66,
-			102, 119, 31, 93, 235, 174, 70, 52, 93, 123, 131, 3, 12, 189,
-			160, 77, 70, 31, 93, 105, 181, 201, 232, 107, 119, 173, 54, 25,
-			125, 171, 215, 240, 126, 108, 131, 49, 64, 123, 221, 181, 222, 149,
-			154, 213, 106, 62, 215, 129, 175, 219, 253, 255, 7, 26, 187, 173,
-			238, 12, 3, 61, 235, 173, 238, 12, 3, 103, 109, 130, 160, 16,
-			228, 108, 15, 211, 126, 119, 123, 5, 175, 86, 53, 158, 15, 243,
-			127, 38, 2, 98, 165, 219, 39, 56, 242, 41, 118, 219, 133, 225,
-			70, 215, 106, 187, 48, 220, 189, 201, 106, 187, 48, 220, 183, 133,
-			255, 1, 197, 190, 10, 231, 209, 117, 238, 243, 228, 52, 64, 194,
-			33, 217, 51, 148, 148, 141, 29, 19, 204, 149, 42, 142, 94, 103,
-			75, 20, 252, 217, 124, 161, 20, 254, 78, 24, 180, 44, 3, 129,
-			0, 175, 224, 3, 208, 80, 62, 167, 195, 41, 115, 89, 95, 223,
-			205, 170, 91, 84, 133, 155, 107, 224, 205, 10, 249, 25, 238, 245,
-			165, 17, 242, 187, 47, 210, 69, 163, 226, 190, 197, 97, 9, 86,
-			88, 61, 6, 206, 211, 29, 250, 85, 106, 248, 121, 107, 61, 254,
-			16, 209, 93, 4, 216, 110, 58, 34, 221, 35, 11, 166, 13, 244,
-			206, 64, 120, 36, 59, 189, 124, 1, 63, 183, 176, 39, 3, 112,
-			72, 235, 11, 128, 142, 101, 70, 103, 230, 34, 103, 89, 254, 59,
-			174, 90, 51, 217, 32, 112, 198, 81, 138, 204, 174, 98, 163, 170,
-			250, 58, 57, 133, 184, 213, 189, 96, 119, 125, 171, 213, 189, 96,
-			183, 233, 212, 83, 159, 16, 108, 119, 199, 238, 112, 247, 130, 221,
-			201, 139, 248, 103, 153, 110, 95, 192, 46, 165, 93, 238, 221, 10,
-			163, 73, 9, 215, 210, 124, 224, 16, 78, 249, 158, 182, 251, 96,
-			96, 254, 148, 222, 213, 241, 49, 187, 249, 16, 151, 82, 52, 155,
-			207, 159, 152, 155, 53, 62, 185, 110, 155, 162, 127, 156, 201, 121,
-			87, 205, 249, 133, 121, 203, 46, 51, 197, 151, 131, 250, 75, 203,
-			226, 193, 9, 105, 53, 148, 164, 133, 48, 101, 234, 61, 0, 249,
-			14, 223, 220, 123, 50, 147, 150, 31, 103, 10, 16, 196, 242, 39,
-			1, 176, 43, 240, 68, 123, 139, 155, 55, 151, 179, 234, 220, 108,
-			30, 163, 155, 54, 163, 226, 67, 97, 183, 210, 185, 197, 51, 169,
-			106, 248, 95, 113, 69, 77, 94, 138, 105, 48, 215, 31, 185, 237,
-			158, 200, 166, 115, 39, 130, 182, 16, 117, 114, 207, 18, 86, 91,
-			136, 75, 77, 210, 111, 130, 9, 118, 105, 178, 19, 114, 76, 136,
-			112, 174, 136, 93, 69, 220, 161, 144, 224, 131, 162, 156, 8, 238,
-			114, 100, 172, 152, 245, 123, 69, 162, 139, 159, 131, 89, 191, 7,
-			104, 151, 187, 73, 99, 40, 85, 250, 93, 72, 148, 4, 41, 186,
-			7, 116, 6, 182, 74, 209, 61, 208, 188, 210, 74, 209, 61, 144,
-			236, 228, 155, 0, 144, 221, 57, 28, 59, 70, 220, 110, 75, 238,
-			71, 225, 138, 229, 160, 234, 228, 35, 14, 215, 37, 249, 148, 6,
-			95, 103, 87, 211, 179, 220, 171, 163, 0, 129, 16, 130, 144, 235,
-			9, 103, 12, 86, 82, 57, 6, 24, 124, 197, 160, 43, 36, 17,
-			192, 213, 247, 116, 38, 91, 82, 72, 162, 216, 72, 80, 77, 66,
-			1, 172, 179, 171, 233, 58, 164, 168, 96, 87, 111, 216, 200, 15,
-			107, 120, 117, 118, 13, 21, 238, 190, 32, 199, 5, 199, 112, 28,
-			224, 6, 67, 106, 64, 33, 121, 165, 179, 217, 80, 187, 164, 0,
-			202, 202, 188, 145, 192, 99, 155, 144, 162, 130, 93, 211, 210, 198,
-			223, 166, 97, 216, 217, 181, 180, 199, 189, 234, 140, 188, 49, 59,
-			111, 65, 85, 55, 33, 134, 58, 187, 150, 38, 145, 146, 175, 91,
-			213, 205, 39, 53, 128, 58, 123, 27, 109, 118, 223, 188, 132, 119,
-			3, 210, 165, 20, 140, 6, 175, 75, 126, 90, 1, 230, 204, 12,
-			64, 42, 242, 183, 169, 174, 238, 10, 244, 156, 189, 141, 55, 241,
-			139, 53, 230, 57, 187, 158, 10, 247, 220, 202, 3, 144, 182, 21,
-			214, 157, 148, 21, 216, 152, 231, 75, 21, 126, 189, 89, 92, 135,
-			10, 118, 125, 75, 27, 255, 54, 209, 96, 230, 108, 138, 118, 186,
-			223, 32, 222, 136, 50, 190, 210, 89, 13, 242, 168, 234, 69, 117,
-			250, 111, 153, 50, 135, 4, 19, 232, 185, 150, 219, 100, 216, 206,
-			55, 29, 20, 208, 239, 211, 82, 38, 168, 154, 213, 193, 179, 244,
-			68, 254, 36, 126, 219, 47, 14, 122, 227, 58, 200, 155, 243, 79,
-			250, 5, 168, 153, 213, 141, 53, 164, 226, 80, 146, 40, 192, 233,
-			235, 247, 50, 165, 77, 154, 161, 1, 27, 13, 146, 222, 228, 79,
-			52, 148, 184, 153, 186, 52, 32, 166, 104, 27, 82, 84, 176, 169,
-			246, 36, 63, 8, 51, 143, 11, 54, 77, 91, 221, 61, 167, 193,
-			87, 101, 124, 44, 117, 240, 52, 53, 20, 21, 108, 186, 185, 133,
-			79, 64, 226, 185, 115, 34, 150, 35, 238, 155, 195, 158, 2, 118,
-			35, 245, 44, 37, 225, 13, 188, 201, 155, 200, 231, 179, 131, 220,
-			3, 240, 7, 19, 26, 53, 155, 129, 10, 195, 58, 185, 92, 37,
-			166, 179, 19, 137, 30, 16, 96, 84, 202, 138, 25, 186, 214, 221,
-			228, 141, 84, 144, 21, 229, 114, 2, 132, 20, 36, 166, 179, 25,
-			109, 99, 66, 94, 58, 155, 209, 54, 38, 164, 165, 179, 153, 213,
-			107, 76, 224, 239, 174, 175, 145, 37, 192, 192, 233, 48, 198, 178,
-			163, 126, 255, 254, 144, 222, 182, 47, 242, 135, 134, 49, 79, 55,
-			156, 232, 46, 63, 206, 122, 58, 241, 207, 212, 16, 111, 223, 231,
-			151, 150, 0, 34, 166, 126, 80, 142, 197, 85, 11, 117, 44, 185,
-			63, 83, 44, 45, 23, 21, 171, 155, 55, 204, 166, 143, 249, 71,
-			139, 153, 119, 248, 16, 64, 173, 59, 152, 144, 31, 28, 202, 188,
-			195, 23, 171, 57, 135, 63, 66, 65, 1, 34, 35, 201, 79, 0,
-			196, 77, 156, 199, 27, 10, 126, 90, 113, 18, 68, 73, 107, 67,
-			172, 37, 228, 151, 1, 96, 237, 70, 194, 59, 203, 6, 125, 134,
-			224, 177, 196, 89, 188, 37, 231, 223, 80, 58, 106, 141, 92, 161,
-			224, 53, 203, 143, 15, 224, 232, 83, 67, 124, 85, 121, 111, 0,
-			92, 59, 97, 175, 183, 94, 234, 119, 241, 110, 28, 244, 178, 113,
-			171, 78, 99, 185, 83, 31, 33, 188, 167, 242, 0, 94, 29, 8,
-			170, 69, 175, 228, 207, 9, 239, 52, 6, 123, 132, 9, 189, 114,
-			48, 193, 134, 48, 92, 224, 40, 111, 48, 231, 92, 135, 240, 55,
-			214, 218, 109, 19, 248, 61, 24, 252, 46, 188, 174, 78, 205, 117,
-			173, 171, 201, 198, 241, 37, 176, 241, 187, 9, 239, 42, 159, 247,
-			107, 205, 199, 95, 35, 188, 199, 140, 162, 18, 99, 46, 188, 5,
-			151, 151, 111, 65, 223, 130, 12, 99, 246, 65, 113, 249, 153, 217,
-			140, 212, 159, 18, 190, 186, 202, 108, 94, 103, 46, 31, 227, 94,
-			100, 179, 165, 7, 2, 112, 214, 139, 95, 234, 212, 85, 124, 93,
-			141, 167, 232, 41, 246, 115, 81, 202, 151, 210, 217, 163, 17, 14,
-			34, 189, 236, 96, 43, 252, 197, 226, 184, 212, 0, 23, 251, 252,
-			82, 20, 38, 174, 170, 198, 200, 243, 118, 41, 69, 202, 224, 221,
-			106, 226, 202, 157, 142, 220, 154, 231, 29, 145, 23, 158, 46, 214,
-			219, 162, 247, 236, 175, 8, 239, 128, 229, 46, 155, 237, 194, 135,
-			98, 164, 252, 80, 84, 184, 89, 194, 7, 159, 105, 169, 148, 122,
-			7, 79, 70, 71, 254, 154, 45, 219, 199, 168, 37, 216, 130, 8,
-			76, 197, 149, 163, 229, 43, 55, 102, 175, 156, 18, 168, 103, 213,
-			188, 147, 171, 184, 120, 235, 120, 147, 238, 55, 7, 119, 19, 112,
-			249, 88, 119, 176, 81, 125, 182, 95, 126, 116, 230, 164, 126, 253,
-			18, 164, 254, 253, 148, 175, 170, 26, 158, 18, 23, 235, 75, 87,
-			244, 48, 244, 6, 173, 174, 57, 127, 117, 231, 138, 207, 90, 236,
-			54, 137, 131, 188, 94, 71, 135, 0, 224, 181, 113, 219, 249, 229,
-			111, 169, 30, 72, 59, 164, 126, 186, 39, 87, 42, 204, 31, 196,
-			7, 185, 71, 120, 147, 253, 7, 209, 202, 217, 9, 127, 94, 219,
-			65, 242, 191, 98, 136, 215, 129, 159, 91, 253, 186, 93, 63, 224,
-			160, 250, 222, 14, 122, 62, 73, 221, 75, 53, 112, 156, 54, 185,
-			162, 76, 181, 166, 220, 120, 10, 217, 77, 199, 163, 203, 170, 0,
-			40, 71, 171, 32, 183, 85, 126, 139, 189, 228, 166, 59, 78, 33,
-			178, 248, 81, 182, 99, 101, 108, 231, 94, 199, 59, 42, 62, 73,
-			244, 68, 146, 20, 180, 12, 213, 153, 10, 103, 69, 146, 14, 104,
-			240, 21, 59, 243, 32, 117, 27, 213, 24, 153, 101, 179, 56, 131,
-			60, 118, 36, 224, 29, 181, 148, 59, 23, 187, 148, 175, 7, 251,
-			252, 119, 219, 210, 50, 153, 10, 154, 119, 86, 243, 122, 125, 143,
-			103, 175, 58, 126, 38, 246, 148, 203, 241, 10, 184, 165, 246, 131,
-			23, 150, 229, 172, 166, 172, 113, 162, 178, 252, 86, 98, 137, 140,
-			96, 248, 122, 59, 47, 225, 161, 148, 11, 189, 155, 27, 106, 143,
-			113, 12, 238, 27, 35, 201, 26, 139, 20, 28, 219, 254, 173, 145,
-			39, 176, 65, 154, 184, 154, 55, 135, 124, 84, 81, 65, 86, 87,
-			114, 98, 23, 196, 248, 84, 15, 182, 80, 55, 43, 63, 120, 233,
-			144, 155, 199, 121, 75, 196, 123, 20, 189, 229, 63, 169, 236, 21,
-			187, 21, 160, 63, 171, 184, 162, 169, 152, 152, 6, 211, 42, 10,
-			173, 89, 1, 9, 181, 170, 39, 185, 56, 92, 205, 83, 202, 38,
-			43, 195, 211, 172, 0, 100, 89, 195, 5, 173, 4, 100, 89, 203,
-			97, 76, 197, 196, 9, 222, 26, 245, 96, 42, 129, 163, 86, 241,
-			238, 220, 10, 94, 66, 53, 135, 40, 21, 19, 239, 208, 198, 88,
-			217, 52, 43, 140, 187, 150, 71, 227, 86, 64, 86, 173, 233, 51,
-			164, 98, 226, 102, 251, 8, 70, 13, 239, 74, 136, 179, 11, 217,
-			250, 238, 217, 75, 250, 141, 25, 200, 85, 188, 209, 178, 214, 69,
-			133, 99, 94, 110, 204, 187, 53, 236, 187, 84, 76, 76, 240, 230,
-			144, 129, 93, 233, 160, 85, 50, 249, 221, 10, 114, 176, 162, 165,
-			158, 138, 9, 159, 175, 8, 155, 163, 162, 194, 143, 43, 154, 218,
-			110, 133, 179, 89, 217, 178, 77, 197, 68, 142, 183, 149, 89, 44,
-			162, 22, 151, 69, 84, 188, 91, 225, 108, 86, 53, 129, 44, 4,
-			217, 136, 150, 171, 138, 32, 91, 217, 176, 168, 138, 32, 91, 69,
-			121, 70, 38, 106, 228, 119, 173, 137, 70, 180, 94, 205, 137, 70,
-			85, 204, 242, 16, 107, 159, 191, 131, 40, 200, 218, 219, 156, 55,
-			32, 107, 223, 72, 35, 123, 13, 210, 200, 250, 130, 52, 178, 139,
-			130, 52, 178, 145, 0, 178, 118, 183, 13, 89, 43, 255, 27, 23,
-			172, 43, 54, 10, 255, 173, 23, 108, 85, 108, 76, 167, 156, 117,
-			7, 41, 103, 242, 191, 91, 20, 142, 237, 154, 216, 49, 226, 174,
-			13, 195, 216, 166, 203, 64, 108, 17, 141, 117, 77, 162, 149, 111,
-			66, 48, 86, 143, 142, 184, 174, 119, 208, 47, 21, 50, 254, 73,
-			133, 87, 89, 1, 115, 79, 254, 204, 139, 175, 180, 208, 67, 189,
-			246, 94, 11, 61, 212, 59, 123, 23, 63, 169, 193, 67, 217, 198,
-			232, 35, 195, 77, 196, 95, 29, 104, 70, 34, 95, 108, 70, 40,
-			55, 99, 163, 25, 33, 97, 130, 109, 60, 123, 23, 255, 11, 162,
-			49, 58, 157, 45, 180, 191, 207, 253, 40, 177, 6, 25, 202, 183,
-			80, 104, 150, 101, 197, 108, 242, 248, 95, 1, 133, 248, 166, 18,
-			104, 42, 239, 155, 78, 102, 112, 24, 163, 121, 27, 149, 32, 60,
-			84, 19, 201, 104, 212, 173, 136, 8, 76, 33, 56, 208, 45, 241,
-			14, 11, 14, 116, 75, 178, 223, 130, 3, 237, 111, 57, 139, 15,
-			104, 56, 80, 103, 136, 14, 51, 119, 109, 249, 178, 91, 225, 196,
-			16, 232, 231, 80, 8, 244, 115, 40, 4, 250, 57, 180, 107, 63,
-			255, 44, 65, 212, 207, 237, 244, 188, 33, 247, 207, 203, 22, 43,
-			148, 222, 245, 26, 175, 88, 168, 202, 42, 186, 108, 14, 160, 181,
-			186, 22, 200, 232, 118, 3, 138, 232, 0, 90, 235, 22, 254, 0,
-			130, 140, 58, 187, 232, 155, 182, 184, 159, 168, 206, 10, 58, 201,
-			197, 46, 105, 44, 248, 147, 115, 133, 98, 230, 164, 159, 157, 135,
-			126, 239, 179, 179, 249, 2, 108, 159, 85, 21, 136, 120, 61, 246,
-			23, 210, 83, 39, 165, 72, 159, 10, 82, 45, 236, 142, 224, 222,
-			177, 188, 209, 90, 3, 133, 217, 201, 245, 191, 43, 103, 43, 7,
-			163, 199, 98, 163, 144, 238, 10, 161, 144, 238, 10, 161, 144, 190,
-			169, 101, 19, 127, 23, 162, 144, 142, 210, 177, 97, 119, 182, 246,
-			222, 149, 77, 241, 180, 103, 101, 65, 149, 142, 26, 212, 208, 56,
-			21, 108, 212, 160, 134, 198, 153, 96, 99, 45, 253, 252, 211, 4,
-			177, 74, 199, 233, 165, 231, 184, 119, 132, 118, 34, 2, 155, 82,
-			52, 134, 167, 206, 226, 9, 13, 121, 116, 174, 80, 0, 208, 26,
-			175, 136, 99, 131, 152, 178, 151, 155, 155, 153, 208, 169, 178, 246,
-			214, 78, 248, 217, 124, 238, 24, 38, 76, 89, 27, 44, 21, 88,
-			193, 159, 44, 233, 14, 234, 153, 28, 146, 33, 56, 212, 113, 131,
-			99, 91, 79, 5, 27, 55, 56, 182, 245, 76, 176, 75, 91, 134,
-			121, 81, 195, 161, 178, 43, 233, 78, 183, 43, 44, 98, 205, 133,
-			51, 223, 182, 255, 12, 86, 218, 217, 16, 169, 87, 198, 219, 44,
-			136, 212, 43, 197, 6, 11, 34, 245, 202, 161, 243, 248, 63, 32,
-			68, 42, 59, 66, 247, 187, 95, 182, 151, 221, 4, 86, 171, 29,
-			235, 161, 146, 133, 71, 142, 39, 60, 19, 46, 1, 46, 70, 79,
-			77, 8, 27, 46, 42, 5, 184, 245, 210, 106, 50, 32, 16, 1,
-			193, 114, 69, 207, 126, 3, 17, 236, 136, 81, 3, 13, 84, 176,
-			35, 70, 13, 52, 48, 193, 142, 156, 125, 9, 84, 136, 197, 99,
-			148, 11, 118, 45, 189, 210, 125, 160, 202, 204, 23, 60, 247, 163,
-			105, 0, 74, 135, 95, 134, 71, 31, 226, 50, 107, 42, 17, 62,
-			179, 216, 76, 1, 158, 24, 46, 91, 240, 244, 233, 25, 113, 34,
-			216, 181, 241, 118, 164, 168, 96, 215, 118, 244, 33, 197, 4, 187,
-			246, 220, 203, 248, 113, 152, 108, 163, 96, 71, 233, 91, 220, 107,
-			163, 130, 0, 67, 109, 103, 64, 206, 233, 247, 54, 18, 193, 142,
-			198, 59, 145, 162, 130, 29, 237, 26, 70, 138, 9, 118, 116, 231,
-			97, 62, 3, 99, 106, 18, 108, 146, 166, 161, 17, 118, 173, 49,
-			33, 196, 184, 61, 178, 32, 159, 62, 48, 228, 32, 3, 83, 254,
-			126, 124, 172, 136, 137, 87, 199, 253, 96, 96, 77, 68, 176, 73,
-			163, 22, 154, 168, 96, 147, 70, 45, 52, 49, 193, 38, 71, 174,
-			227, 135, 96, 96, 205, 130, 77, 211, 183, 184, 123, 163, 3, 11,
-			37, 231, 70, 82, 237, 35, 99, 10, 50, 60, 240, 245, 205, 68,
-			176, 105, 179, 46, 205, 84, 176, 105, 179, 46, 205, 76, 176, 233,
-			157, 135, 249, 118, 85, 65, 240, 246, 216, 12, 113, 251, 170, 148,
-			66, 134, 66, 86, 186, 194, 24, 139, 6, 222, 158, 232, 225, 151,
-			98, 205, 64, 150, 238, 114, 119, 129, 11, 147, 171, 10, 49, 167,
-			95, 80, 173, 74, 63, 72, 249, 207, 134, 82, 254, 179, 13, 205,
-			86, 202, 127, 182, 181, 205, 2, 174, 205, 138, 11, 195, 192, 181,
-			217, 246, 29, 48, 49, 34, 156, 217, 88, 177, 230, 196, 202, 144,
-			190, 48, 11, 115, 54, 209, 195, 47, 195, 44, 204, 2, 221, 229,
-			190, 169, 108, 98, 17, 120, 173, 208, 204, 172, 218, 6, 107, 102,
-			4, 102, 86, 48, 32, 161, 114, 102, 5, 61, 51, 149, 156, 89,
-			208, 51, 35, 48, 179, 130, 158, 25, 193, 153, 21, 218, 119, 240,
-			11, 32, 149, 171, 238, 100, 236, 125, 132, 184, 253, 85, 166, 22,
-			9, 196, 89, 147, 147, 38, 222, 201, 196, 26, 126, 17, 102, 104,
-			221, 64, 71, 221, 109, 85, 64, 1, 251, 85, 81, 68, 202, 18,
-			138, 67, 191, 151, 153, 122, 103, 42, 72, 214, 170, 147, 79, 72,
-			88, 201, 90, 55, 52, 172, 180, 146, 181, 110, 72, 118, 34, 149,
-			16, 236, 134, 174, 139, 97, 66, 20, 39, 116, 131, 187, 91, 33,
-			202, 128, 233, 249, 251, 52, 233, 126, 95, 97, 151, 205, 164, 111,
-			200, 204, 204, 205, 84, 211, 164, 6, 11, 12, 123, 0, 20, 181,
-			39, 34, 189, 84, 221, 76, 98, 218, 63, 5, 94, 83, 90, 231,
-			191, 67, 16, 92, 129, 213, 205, 229, 204, 17, 238, 247, 210, 37,
-			111, 38, 95, 44, 121, 91, 135, 135, 195, 47, 193, 74, 100, 76,
-			254, 211, 133, 184, 56, 52, 149, 163, 152, 41, 202, 31, 14, 239,
-			212, 120, 159, 58, 173, 80, 126, 20, 212, 140, 231, 253, 194, 164,
-			242, 94, 229, 231, 102, 237, 72, 157, 156, 114, 61, 82, 114, 1,
-			18, 109, 72, 49, 193, 126, 191, 189, 67, 1, 77, 64, 147, 223,
-			155, 9, 237, 114, 159, 34, 222, 136, 55, 43, 119, 25, 130, 210,
-			32, 49, 253, 160, 228, 55, 109, 176, 252, 188, 235, 35, 44, 112,
-			189, 55, 153, 206, 102, 7, 185, 119, 64, 129, 26, 168, 53, 81,
-			235, 168, 52, 10, 72, 182, 185, 137, 162, 100, 167, 92, 9, 222,
-			34, 87, 247, 234, 227, 126, 78, 18, 153, 92, 90, 121, 221, 65,
-			7, 145, 217, 116, 33, 61, 227, 151, 252, 66, 0, 149, 32, 159,
-			88, 254, 234, 203, 143, 28, 58, 204, 149, 188, 82, 249, 216, 42,
-			9, 51, 109, 225, 119, 41, 77, 142, 19, 51, 168, 169, 210, 96,
-			190, 25, 1, 59, 40, 64, 39, 220, 140, 128, 29, 20, 160, 19,
-			110, 38, 201, 78, 254, 79, 106, 153, 152, 112, 110, 37, 180, 207,
-			253, 26, 241, 246, 26, 116, 81, 173, 15, 163, 240, 179, 138, 19,
-			160, 59, 185, 95, 82, 198, 2, 182, 24, 159, 1, 236, 201, 162,
-			73, 85, 46, 64, 189, 246, 76, 186, 48, 15, 237, 217, 85, 115,
-			224, 244, 49, 200, 192, 228, 158, 127, 131, 210, 183, 136, 172, 167,
-			69, 66, 14, 32, 22, 129, 7, 210, 217, 83, 233, 249, 34, 20,
-			181, 163, 110, 198, 110, 250, 41, 200, 36, 192, 238, 212, 6, 116,
-			92, 97, 228, 65, 98, 33, 174, 4, 139, 195, 228, 186, 145, 36,
-			146, 236, 217, 136, 36, 76, 189, 119, 179, 5, 24, 251, 49, 131,
-			115, 88, 1, 68, 162, 178, 128, 8, 1, 198, 94, 100, 1, 198,
-			110, 114, 183, 70, 33, 5, 139, 86, 149, 185, 105, 248, 91, 6,
-			216, 201, 160, 178, 251, 67, 8, 73, 132, 200, 178, 26, 146, 8,
-			145, 101, 53, 36, 17, 34, 203, 110, 60, 139, 63, 67, 16, 57,
-			246, 118, 66, 87, 187, 255, 44, 249, 94, 179, 188, 202, 251, 213,
-			117, 16, 144, 161, 155, 46, 122, 215, 7, 55, 53, 215, 151, 113,
-			117, 206, 191, 1, 249, 89, 85, 4, 216, 105, 254, 121, 213, 162,
-			189, 95, 119, 3, 135, 54, 229, 185, 124, 244, 36, 20, 35, 117,
-			60, 6, 116, 101, 208, 20, 25, 132, 187, 210, 200, 237, 52, 109,
-			5, 250, 85, 130, 52, 46, 156, 20, 82, 233, 217, 89, 63, 93,
-			240, 178, 233, 146, 95, 8, 80, 111, 235, 96, 190, 9, 11, 245,
-			246, 118, 210, 208, 101, 161, 222, 222, 78, 186, 123, 16, 245, 246,
-			14, 18, 187, 171, 6, 234, 109, 249, 77, 74, 4, 245, 246, 14,
-			146, 88, 199, 223, 140, 168, 183, 119, 18, 42, 220, 75, 42, 107,
-			185, 72, 251, 134, 50, 85, 103, 189, 195, 198, 219, 81, 192, 184,
-			119, 134, 129, 113, 239, 12, 3, 227, 222, 73, 90, 219, 248, 69,
-			10, 247, 246, 110, 18, 251, 28, 33, 238, 214, 5, 148, 90, 21,
-			196, 147, 58, 34, 156, 187, 73, 98, 61, 223, 109, 33, 222, 46,
-			79, 183, 213, 130, 154, 93, 25, 130, 154, 213, 136, 19, 8, 53,
-			171, 17, 39, 44, 168, 89, 119, 55, 127, 201, 64, 205, 126, 154,
-			208, 164, 251, 116, 77, 13, 23, 109, 80, 241, 234, 169, 185, 208,
-			155, 94, 61, 93, 23, 32, 213, 126, 154, 208, 122, 11, 169, 246,
-			211, 36, 209, 102, 33, 213, 126, 154, 180, 119, 240, 63, 164, 136,
-			84, 251, 128, 84, 119, 207, 47, 93, 221, 217, 204, 241, 122, 232,
-			188, 208, 251, 185, 2, 243, 88, 186, 226, 83, 176, 182, 15, 4,
-			204, 39, 185, 231, 129, 128, 249, 164, 166, 123, 64, 50, 223, 110,
-			5, 107, 251, 69, 18, 123, 200, 64, 183, 213, 16, 247, 85, 142,
-			78, 156, 8, 231, 139, 36, 177, 129, 143, 33, 182, 237, 131, 132,
-			110, 117, 183, 7, 50, 191, 60, 126, 179, 128, 224, 143, 131, 224,
-			127, 16, 5, 127, 28, 4, 255, 131, 68, 195, 8, 41, 172, 219,
-			7, 137, 134, 17, 82, 88, 183, 15, 146, 161, 97, 37, 248, 1,
-			202, 246, 203, 191, 53, 130, 63, 14, 199, 227, 203, 1, 198, 39,
-			129, 233, 55, 216, 104, 181, 95, 150, 130, 127, 167, 130, 248, 252,
-			10, 137, 125, 191, 6, 136, 113, 89, 152, 55, 216, 232, 122, 34,
-			156, 175, 72, 229, 254, 3, 130, 96, 156, 95, 37, 52, 229, 62,
-			30, 196, 36, 106, 6, 19, 194, 216, 206, 253, 85, 226, 9, 92,
-			149, 255, 89, 238, 29, 62, 198, 238, 165, 189, 89, 158, 180, 67,
-			192, 65, 243, 210, 139, 173, 220, 242, 171, 232, 249, 191, 59, 151,
-			57, 153, 206, 202, 13, 41, 229, 61, 136, 81, 202, 175, 231, 115,
-			225, 208, 6, 156, 42, 83, 229, 3, 99, 13, 224, 66, 29, 152,
-			167, 141, 30, 250, 85, 132, 140, 86, 232, 161, 95, 37, 98, 181,
-			133, 30, 250, 85, 226, 173, 3, 199, 22, 176, 65, 191, 78, 232,
-			6, 247, 66, 172, 98, 65, 140, 219, 72, 55, 3, 40, 106, 41,
-			166, 75, 153, 226, 244, 188, 226, 52, 219, 37, 15, 48, 54, 191,
-			78, 104, 135, 133, 177, 249, 117, 146, 92, 107, 97, 108, 126, 157,
-			164, 214, 243, 231, 12, 198, 230, 99, 228, 183, 197, 37, 10, 64,
-			54, 31, 67, 53, 161, 64, 54, 31, 67, 53, 161, 64, 54, 31,
-			147, 106, 226, 151, 106, 129, 152, 112, 254, 9, 64, 153, 23, 175,
-			38, 162, 103, 227, 181, 84, 17, 229, 239, 94, 174, 95, 84, 79,
-			89, 29, 204, 29, 209, 72, 165, 209, 254, 79, 1, 26, 41, 131,
-			149, 65, 191, 168, 158, 58, 194, 249, 222, 111, 166, 95, 84, 47,
-			213, 159, 156, 92, 55, 146, 68, 146, 218, 47, 170, 151, 190, 144,
-			243, 61, 233, 23, 93, 168, 112, 89, 127, 64, 98, 207, 18, 68,
-			167, 171, 160, 40, 107, 200, 206, 4, 17, 206, 15, 72, 194, 227,
-			111, 11, 33, 178, 30, 240, 46, 135, 162, 184, 232, 237, 167, 119,
-			101, 97, 202, 215, 23, 218, 101, 241, 108, 111, 124, 172, 31, 99,
-			135, 54, 94, 250, 248, 216, 105, 66, 185, 222, 75, 96, 112, 68,
-			56, 207, 72, 245, 249, 177, 127, 199, 234, 83, 15, 93, 170, 190,
-			103, 144, 147, 19, 32, 19, 159, 65, 213, 151, 0, 153, 248, 140,
-			84, 125, 26, 163, 246, 57, 18, 187, 133, 86, 199, 168, 173, 124,
-			95, 23, 198, 168, 125, 78, 26, 58, 63, 33, 8, 82, 251, 162,
-			212, 127, 79, 68, 245, 95, 24, 74, 98, 209, 74, 144, 91, 90,
-			240, 223, 151, 18, 108, 0, 134, 122, 17, 25, 74, 65, 235, 190,
-			136, 74, 80, 65, 235, 190, 136, 74, 80, 65, 235, 190, 40, 149,
-			224, 85, 136, 156, 251, 43, 66, 95, 34, 91, 221, 17, 84, 131,
-			182, 79, 184, 116, 93, 168, 160, 117, 127, 69, 168, 107, 65, 235,
-			254, 138, 116, 111, 176, 160, 117, 127, 69, 54, 245, 90, 208, 186,
-			47, 149, 65, 235, 190, 68, 18, 131, 202, 187, 2, 104, 221, 27,
-			233, 111, 167, 119, 165, 208, 121, 111, 164, 90, 109, 42, 116, 222,
-			27, 105, 162, 205, 66, 231, 189, 145, 182, 119, 240, 119, 83, 88,
-			42, 38, 156, 63, 161, 180, 203, 253, 217, 50, 212, 230, 235, 229,
-			94, 85, 30, 192, 178, 253, 171, 6, 80, 160, 127, 66, 13, 160,
-			177, 84, 160, 127, 66, 13, 160, 49, 131, 53, 74, 118, 242, 17,
-			5, 104, 124, 43, 141, 125, 132, 34, 168, 126, 45, 181, 81, 3,
-			203, 248, 86, 154, 216, 200, 127, 15, 177, 140, 111, 163, 116, 171,
-			59, 83, 197, 193, 170, 118, 179, 179, 52, 157, 98, 159, 80, 163,
-			88, 56, 200, 129, 219, 2, 236, 94, 169, 88, 110, 67, 120, 87,
-			5, 137, 124, 27, 194, 187, 42, 72, 228, 219, 232, 208, 176, 82,
-			44, 128, 120, 252, 97, 250, 235, 160, 88, 56, 40, 150, 15, 7,
-			168, 187, 4, 134, 174, 21, 139, 2, 37, 254, 48, 237, 238, 129,
-			158, 75, 141, 34, 126, 59, 141, 221, 69, 171, 247, 92, 170, 154,
-			144, 26, 129, 220, 189, 157, 38, 122, 249, 243, 4, 49, 119, 239,
-			164, 52, 229, 62, 105, 41, 151, 112, 66, 129, 237, 79, 132, 146,
-			3, 34, 42, 166, 218, 197, 237, 171, 168, 98, 244, 34, 44, 77,
-			201, 40, 236, 224, 59, 45, 236, 224, 58, 73, 106, 37, 163, 176,
-			131, 239, 164, 90, 201, 40, 236, 224, 59, 169, 183, 142, 239, 81,
-			208, 193, 119, 211, 216, 127, 166, 196, 61, 111, 145, 198, 89, 197,
-			77, 104, 2, 92, 216, 196, 102, 62, 128, 136, 191, 247, 80, 186,
-			214, 93, 235, 29, 174, 145, 153, 17, 2, 213, 189, 7, 5, 169,
-			2, 213, 189, 135, 38, 92, 11, 84, 247, 30, 186, 122, 13, 220,
-			46, 54, 139, 248, 189, 52, 246, 105, 74, 220, 222, 234, 1, 88,
-			3, 165, 27, 12, 176, 153, 8, 231, 94, 249, 204, 125, 8, 27,
-			251, 41, 74, 119, 185, 23, 148, 69, 94, 211, 22, 12, 111, 40,
-			226, 26, 106, 251, 26, 128, 183, 214, 193, 147, 18, 22, 90, 235,
-			167, 168, 14, 181, 42, 180, 214, 79, 81, 221, 131, 172, 25, 162,
-			149, 159, 162, 186, 7, 89, 51, 70, 43, 63, 69, 219, 119, 240,
-			243, 21, 156, 235, 95, 210, 216, 223, 208, 234, 119, 167, 161, 236,
-			102, 107, 118, 43, 136, 112, 254, 146, 38, 122, 248, 156, 5, 253,
-			122, 145, 123, 172, 2, 222, 178, 14, 192, 134, 27, 193, 247, 86,
-			184, 23, 222, 12, 134, 85, 184, 243, 80, 111, 133, 91, 214, 205,
-			1, 154, 108, 29, 188, 55, 17, 130, 143, 213, 216, 200, 8, 31,
-			43, 12, 184, 108, 2, 192, 101, 21, 54, 242, 10, 92, 139, 251,
-			105, 242, 66, 254, 175, 4, 102, 65, 132, 243, 160, 180, 45, 190,
-			93, 205, 182, 8, 146, 49, 94, 45, 155, 34, 120, 195, 171, 107,
-			75, 172, 0, 177, 249, 32, 30, 129, 21, 32, 54, 31, 68, 91,
-			98, 5, 136, 205, 7, 165, 45, 241, 130, 90, 26, 42, 156, 135,
-			164, 45, 241, 47, 75, 139, 212, 26, 214, 121, 173, 67, 180, 214,
-			139, 151, 235, 124, 175, 0, 115, 235, 161, 128, 189, 36, 127, 60,
-			132, 182, 195, 10, 48, 183, 30, 146, 182, 195, 5, 156, 58, 45,
-			34, 254, 119, 52, 246, 15, 148, 184, 91, 106, 197, 102, 43, 29,
-			165, 22, 34, 156, 191, 163, 137, 213, 252, 124, 238, 56, 45, 242,
-			40, 61, 44, 181, 73, 31, 108, 119, 36, 111, 40, 28, 140, 85,
-			231, 75, 15, 183, 5, 100, 242, 195, 40, 147, 91, 64, 225, 63,
-			140, 216, 202, 45, 112, 56, 30, 70, 108, 229, 22, 56, 28, 15,
-			75, 153, 12, 129, 216, 22, 8, 127, 209, 223, 150, 64, 108, 11,
-			112, 255, 215, 113, 107, 91, 84, 132, 14, 141, 134, 22, 21, 161,
-			147, 70, 131, 220, 218, 86, 17, 255, 38, 141, 61, 101, 182, 182,
-			138, 209, 80, 105, 107, 91, 137, 112, 190, 41, 183, 22, 238, 59,
-			90, 229, 222, 62, 46, 247, 246, 121, 203, 82, 72, 91, 41, 103,
-			175, 90, 12, 86, 33, 229, 101, 210, 152, 21, 23, 137, 98, 188,
-			102, 222, 105, 43, 48, 233, 227, 200, 164, 173, 32, 193, 31, 71,
-			195, 161, 21, 152, 244, 113, 52, 28, 90, 129, 73, 31, 151, 76,
-			122, 9, 44, 31, 17, 206, 183, 228, 242, 237, 8, 245, 85, 95,
-			186, 83, 218, 10, 78, 233, 183, 40, 93, 137, 36, 60, 185, 29,
-			95, 43, 183, 255, 91, 242, 181, 160, 23, 90, 165, 240, 251, 238,
-			111, 137, 94, 104, 5, 161, 247, 93, 212, 11, 173, 32, 244, 190,
-			139, 122, 161, 21, 132, 222, 119, 165, 94, 248, 185, 90, 26, 38,
-			156, 31, 73, 189, 240, 195, 37, 250, 152, 175, 139, 98, 136, 190,
-			121, 185, 154, 161, 21, 188, 202, 31, 161, 248, 104, 5, 175, 242,
-			71, 168, 25, 90, 193, 171, 252, 17, 213, 221, 193, 219, 68, 252,
-			95, 105, 236, 231, 20, 147, 184, 170, 217, 187, 149, 228, 71, 27,
-			17, 206, 191, 210, 196, 26, 254, 231, 114, 181, 219, 164, 252, 248,
-			169, 60, 0, 31, 34, 38, 24, 153, 182, 26, 14, 236, 205, 20,
-			138, 165, 154, 189, 42, 84, 7, 198, 234, 13, 41, 184, 151, 95,
-			138, 231, 105, 206, 160, 113, 59, 219, 224, 128, 255, 20, 15, 120,
-			27, 104, 161, 159, 162, 22, 106, 131, 3, 254, 83, 60, 105, 109,
-			112, 192, 127, 42, 79, 218, 189, 106, 130, 68, 56, 63, 251, 181,
-			112, 59, 219, 64, 131, 252, 12, 89, 160, 13, 68, 200, 207, 80,
-			131, 180, 129, 8, 249, 153, 212, 32, 227, 156, 58, 66, 196, 127,
-			73, 99, 159, 100, 196, 221, 185, 144, 219, 137, 133, 111, 186, 193,
-			224, 21, 114, 38, 153, 169, 29, 222, 5, 154, 35, 4, 17, 206,
-			47, 105, 194, 227, 63, 148, 11, 38, 36, 71, 188, 34, 57, 226,
-			127, 71, 3, 155, 38, 215, 245, 55, 225, 102, 79, 0, 87, 189,
-			130, 92, 37, 64, 109, 188, 130, 106, 67, 0, 87, 189, 130, 106,
-			67, 0, 87, 189, 34, 185, 106, 39, 172, 17, 17, 206, 141, 140,
-			110, 132, 123, 212, 114, 124, 186, 154, 154, 66, 128, 166, 184, 145,
-			209, 36, 146, 240, 176, 78, 15, 73, 38, 201, 245, 27, 248, 87,
-			40, 188, 138, 10, 231, 22, 70, 87, 185, 159, 163, 139, 188, 202,
-			179, 239, 68, 160, 226, 174, 2, 178, 105, 186, 28, 51, 48, 100,
-			228, 104, 77, 226, 171, 90, 50, 204, 135, 159, 73, 103, 160, 226,
-			12, 107, 60, 52, 234, 97, 190, 20, 81, 30, 213, 181, 78, 217,
-			109, 96, 173, 145, 86, 85, 66, 229, 58, 168, 138, 10, 50, 75,
-			46, 53, 208, 45, 76, 107, 32, 1, 26, 232, 22, 150, 104, 71,
-			146, 73, 178, 179, 75, 93, 14, 10, 73, 126, 128, 209, 164, 251,
-			147, 154, 1, 97, 115, 28, 94, 213, 96, 176, 121, 203, 226, 149,
-			116, 127, 37, 53, 221, 95, 85, 81, 247, 15, 91, 11, 37, 181,
-			208, 7, 130, 133, 130, 140, 62, 150, 192, 67, 193, 96, 101, 218,
-			59, 84, 228, 92, 80, 71, 56, 31, 101, 180, 203, 125, 122, 25,
-			225, 96, 20, 75, 175, 75, 40, 56, 120, 249, 114, 21, 182, 160,
-			78, 29, 204, 62, 129, 36, 145, 164, 86, 216, 2, 110, 15, 63,
-			202, 146, 157, 252, 1, 6, 75, 85, 39, 156, 123, 25, 237, 115,
-			63, 193, 206, 244, 61, 170, 28, 176, 110, 136, 159, 46, 4, 39,
-			10, 153, 113, 58, 159, 205, 230, 79, 201, 99, 171, 191, 148, 201,
-			153, 216, 85, 159, 46, 93, 86, 140, 113, 197, 158, 55, 239, 57,
-			88, 126, 165, 122, 234, 184, 159, 227, 129, 139, 184, 131, 123, 125,
-			158, 70, 88, 145, 255, 181, 225, 84, 44, 218, 124, 11, 11, 17,
-			228, 104, 242, 69, 31, 249, 82, 43, 223, 233, 252, 92, 206, 148,
-			155, 104, 235, 32, 36, 154, 242, 19, 111, 247, 193, 20, 169, 50,
-			27, 24, 249, 200, 254, 171, 71, 174, 57, 84, 121, 232, 222, 21,
-			87, 30, 94, 252, 232, 53, 4, 172, 222, 196, 186, 56, 236, 90,
-			55, 146, 68, 146, 250, 130, 88, 208, 58, 38, 201, 222, 205, 144,
-			84, 185, 82, 196, 63, 195, 98, 95, 102, 152, 84, 89, 43, 6,
-			25, 214, 200, 160, 132, 87, 18, 225, 124, 134, 37, 214, 241, 235,
-			185, 227, 172, 148, 58, 248, 62, 70, 55, 187, 7, 195, 55, 196,
-			1, 100, 170, 29, 206, 47, 107, 157, 210, 143, 51, 212, 214, 89,
-			104, 146, 48, 248, 149, 160, 253, 238, 99, 212, 144, 113, 73, 54,
-			38, 145, 36, 146, 236, 220, 128, 36, 147, 228, 166, 94, 101, 83,
-			173, 148, 226, 243, 243, 236, 215, 193, 166, 90, 9, 54, 213, 231,
-			241, 148, 174, 4, 101, 251, 121, 166, 109, 170, 149, 160, 108, 63,
-			207, 186, 123, 248, 247, 24, 76, 140, 10, 231, 175, 25, 93, 239,
-			62, 170, 224, 213, 43, 129, 127, 235, 110, 209, 6, 214, 55, 188,
-			49, 74, 254, 235, 95, 200, 227, 120, 194, 159, 247, 167, 56, 148,
-			166, 75, 99, 87, 47, 83, 90, 114, 49, 24, 36, 51, 90, 101,
-			79, 22, 242, 197, 226, 64, 193, 159, 246, 11, 190, 170, 80, 148,
-			146, 211, 218, 218, 193, 160, 193, 143, 209, 51, 214, 123, 204, 120,
-			20, 122, 122, 166, 232, 205, 164, 103, 177, 228, 76, 234, 172, 192,
-			42, 83, 135, 205, 122, 52, 160, 193, 167, 79, 230, 51, 83, 80,
-			214, 95, 44, 102, 78, 90, 206, 111, 49, 243, 14, 127, 208, 219,
-			147, 158, 60, 174, 95, 104, 152, 122, 18, 1, 221, 231, 160, 49,
-			88, 90, 106, 57, 238, 157, 200, 100, 243, 19, 243, 37, 95, 69,
-			8, 212, 246, 169, 14, 241, 115, 233, 108, 118, 94, 238, 162, 194,
-			124, 150, 202, 48, 237, 29, 79, 231, 166, 166, 231, 178, 106, 136,
-			211, 48, 253, 18, 206, 140, 227, 162, 103, 114, 209, 122, 175, 162,
-			110, 53, 159, 149, 182, 149, 190, 239, 154, 241, 224, 101, 5, 127,
-			106, 78, 46, 75, 120, 6, 200, 16, 52, 14, 123, 140, 156, 46,
-			121, 249, 175, 89, 231, 26, 36, 153, 36, 215, 165, 32, 175, 184,
-			93, 196, 255, 150, 197, 190, 205, 170, 231, 21, 87, 2, 152, 176,
-			78, 117, 59, 17, 206, 223, 178, 196, 122, 190, 130, 59, 78, 59,
-			139, 137, 248, 87, 24, 253, 26, 99, 240, 178, 118, 38, 79, 217,
-			87, 24, 95, 203, 223, 67, 120, 92, 210, 16, 169, 99, 206, 197,
-			238, 28, 236, 239, 82, 59, 168, 24, 248, 0, 213, 94, 82, 225,
-			79, 87, 233, 149, 50, 61, 151, 205, 122, 83, 249, 201, 57, 249,
-			69, 204, 190, 108, 225, 245, 106, 24, 117, 48, 14, 235, 3, 34,
-			63, 104, 20, 193, 7, 76, 126, 208, 145, 12, 62, 72, 200, 15,
-			58, 71, 184, 224, 92, 127, 0, 193, 240, 135, 217, 170, 139, 248,
-			219, 245, 244, 136, 112, 30, 97, 206, 62, 247, 173, 21, 187, 152,
-			84, 24, 190, 61, 122, 91, 138, 153, 41, 240, 170, 115, 144, 7,
-			255, 17, 123, 14, 4, 222, 222, 216, 25, 124, 192, 228, 7, 110,
-			119, 240, 65, 66, 126, 208, 179, 215, 204, 65, 39, 11, 60, 194,
-			214, 140, 241, 61, 114, 11, 229, 6, 125, 131, 177, 85, 238, 121,
-			85, 82, 195, 149, 233, 16, 197, 218, 71, 205, 172, 153, 176, 29,
-			22, 248, 27, 140, 53, 32, 73, 36, 201, 219, 145, 100, 146, 236,
-			236, 226, 95, 36, 240, 82, 34, 156, 199, 24, 61, 219, 253, 47,
-			82, 220, 102, 51, 197, 146, 177, 65, 177, 124, 208, 50, 228, 143,
-			251, 197, 126, 100, 153, 121, 188, 8, 214, 188, 171, 197, 21, 183,
-			228, 213, 161, 204, 59, 192, 108, 4, 67, 95, 153, 73, 231, 14,
-			15, 15, 122, 35, 185, 121, 195, 241, 211, 6, 166, 93, 30, 217,
-			115, 135, 135, 205, 239, 181, 11, 160, 141, 220, 116, 206, 243, 11,
-			133, 124, 193, 76, 147, 56, 48, 114, 67, 198, 37, 169, 187, 2,
-			182, 195, 134, 60, 198, 214, 13, 34, 201, 36, 185, 117, 155, 114,
-			124, 218, 165, 44, 126, 226, 13, 199, 231, 180, 28, 159, 118, 112,
-			124, 158, 64, 123, 190, 29, 164, 221, 19, 232, 248, 180, 131, 180,
-			123, 66, 50, 218, 110, 78, 157, 14, 17, 255, 14, 139, 61, 195,
-			106, 228, 130, 47, 32, 238, 58, 136, 112, 190, 195, 18, 27, 248,
-			163, 146, 111, 59, 228, 97, 121, 82, 90, 49, 95, 33, 42, 141,
-			207, 156, 134, 80, 146, 130, 233, 37, 9, 60, 23, 120, 85, 242,
-			32, 73, 181, 159, 219, 84, 210, 102, 34, 228, 6, 106, 195, 32,
-			184, 188, 48, 86, 179, 55, 154, 205, 0, 50, 189, 234, 52, 34,
-			127, 87, 240, 3, 133, 7, 97, 40, 8, 18, 76, 235, 70, 33,
-			96, 108, 244, 123, 10, 48, 127, 54, 93, 40, 101, 38, 231, 178,
-			233, 130, 250, 166, 92, 242, 99, 115, 233, 66, 58, 87, 242, 77,
-			183, 201, 14, 176, 155, 158, 68, 150, 238, 0, 187, 233, 73, 180,
-			155, 58, 224, 32, 63, 137, 118, 83, 7, 28, 228, 39, 165, 221,
-			4, 230, 69, 135, 92, 253, 167, 223, 48, 47, 126, 131, 205, 139,
-			14, 144, 113, 79, 163, 121, 209, 1, 50, 238, 105, 52, 47, 58,
-			64, 198, 61, 45, 205, 139, 11, 57, 117, 146, 34, 254, 28, 139,
-			189, 199, 169, 222, 241, 184, 12, 76, 202, 58, 108, 73, 34, 156,
-			231, 88, 194, 131, 86, 92, 73, 121, 214, 94, 96, 244, 77, 110,
-			79, 0, 4, 117, 64, 153, 5, 38, 64, 166, 199, 152, 4, 237,
-			243, 2, 218, 196, 73, 96, 218, 23, 88, 67, 43, 146, 76, 146,
-			43, 219, 145, 76, 72, 178, 99, 23, 220, 113, 39, 81, 173, 191,
-			192, 146, 23, 66, 153, 95, 82, 50, 245, 47, 24, 61, 203, 221,
-			234, 237, 5, 80, 13, 144, 197, 233, 217, 217, 236, 60, 194, 85,
-			132, 153, 217, 192, 243, 227, 112, 228, 146, 253, 130, 209, 78, 36,
-			225, 129, 93, 235, 144, 100, 146, 220, 176, 145, 223, 68, 225, 117,
-			84, 56, 47, 51, 154, 116, 127, 94, 51, 56, 99, 202, 223, 253,
-			156, 244, 56, 94, 181, 32, 205, 112, 149, 215, 45, 225, 74, 229,
-			178, 114, 169, 126, 89, 21, 177, 126, 153, 89, 51, 41, 215, 95,
-			70, 185, 158, 4, 185, 254, 50, 198, 105, 146, 32, 215, 95, 54,
-			113, 154, 164, 36, 111, 114, 150, 25, 167, 65, 214, 123, 93, 226,
-			52, 193, 203, 151, 27, 167, 73, 66, 72, 235, 38, 199, 112, 59,
-			35, 146, 212, 113, 154, 36, 132, 180, 110, 114, 146, 157, 224, 196,
-			119, 138, 248, 31, 59, 177, 219, 156, 197, 56, 241, 21, 142, 100,
-			39, 17, 206, 31, 59, 137, 117, 124, 51, 119, 156, 78, 121, 36,
-			111, 113, 232, 144, 219, 173, 183, 62, 112, 228, 47, 15, 31, 129,
-			78, 208, 42, 183, 56, 212, 144, 113, 73, 54, 174, 70, 146, 72,
-			114, 77, 31, 146, 76, 146, 3, 131, 202, 27, 239, 148, 123, 255,
-			126, 231, 215, 193, 27, 239, 4, 163, 252, 253, 184, 23, 157, 112,
-			212, 223, 239, 104, 111, 188, 19, 142, 250, 251, 157, 238, 30, 236,
-			209, 242, 255, 3, 0, 0, 255, 255, 243, 162, 11, 8, 61, 181,
-			2, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 253, 11, 120, 92, 215,
+			117, 31, 142, 226, 60, 102, 0, 108, 128, 224, 224, 128, 146, 192,
+			161, 36, 110, 13, 73, 17, 160, 6, 3, 18, 164, 36, 139, 180,
+			172, 128, 192, 144, 28, 25, 4, 144, 193, 64, 178, 164, 79, 134,
+			14, 102, 14, 128, 35, 14, 206, 25, 159, 115, 134, 16, 100, 187,
+			73, 227, 218, 137, 157, 235, 186, 118, 108, 203, 215, 113, 226, 198,
+			121, 217, 206, 87, 39, 141, 19, 223, 164, 233, 151, 54, 233, 237,
+			245, 117, 155, 71, 157, 54, 189, 105, 218, 123, 111, 175, 147, 246,
+			58, 105, 30, 126, 196, 77, 98, 199, 245, 255, 251, 173, 253, 56,
+			103, 0, 80, 146, 31, 74, 191, 126, 255, 210, 242, 135, 217, 251,
+			236, 199, 218, 107, 175, 189, 246, 90, 107, 175, 189, 54, 251, 157,
+			183, 91, 140, 111, 134, 225, 102, 219, 155, 238, 68, 97, 18, 174,
+			119, 55, 166, 91, 94, 220, 140, 252, 78, 18, 70, 21, 202, 115,
+			14, 139, 18, 21, 85, 162, 116, 157, 141, 94, 241, 219, 222, 188,
+			46, 184, 226, 37, 206, 171, 152, 189, 225, 183, 189, 113, 131, 91,
+			19, 67, 51, 39, 43, 123, 42, 85, 122, 107, 44, 35, 187, 78,
+			53, 74, 127, 104, 179, 177, 3, 190, 58, 14, 179, 3, 119, 27,
+			45, 26, 19, 131, 117, 250, 237, 140, 179, 254, 142, 219, 188, 225,
+			110, 122, 227, 38, 101, 171, 164, 115, 55, 99, 45, 175, 227, 5,
+			45, 47, 104, 238, 142, 91, 220, 154, 24, 172, 103, 114, 156, 251,
+			216, 104, 167, 187, 222, 246, 155, 107, 153, 98, 140, 91, 19, 185,
+			122, 65, 124, 152, 79, 11, 159, 102, 135, 119, 60, 247, 70, 182,
+			232, 16, 21, 29, 65, 118, 166, 224, 28, 27, 222, 246, 226, 216,
+			221, 244, 214, 146, 221, 142, 55, 110, 211, 232, 249, 190, 209, 239,
+			29, 249, 144, 172, 213, 216, 237, 120, 206, 44, 27, 244, 130, 238,
+			182, 104, 33, 119, 11, 252, 85, 131, 238, 246, 222, 86, 6, 80,
+			77, 54, 209, 31, 123, 209, 77, 191, 233, 141, 231, 169, 129, 211,
+			251, 26, 88, 17, 223, 247, 182, 161, 234, 57, 115, 108, 208, 123,
+			46, 241, 130, 216, 15, 131, 241, 126, 106, 228, 212, 190, 70, 174,
+			248, 94, 187, 181, 183, 137, 180, 158, 243, 0, 235, 15, 59, 137,
+			31, 6, 241, 248, 0, 55, 38, 134, 102, 238, 60, 160, 137, 182,
+			183, 36, 202, 212, 85, 97, 167, 198, 10, 113, 216, 141, 154, 222,
+			90, 51, 108, 121, 107, 126, 176, 17, 142, 15, 82, 3, 199, 247,
+			53, 176, 66, 5, 231, 194, 150, 87, 11, 54, 194, 250, 72, 220,
+			147, 118, 110, 103, 249, 120, 55, 72, 220, 231, 198, 135, 137, 66,
+			100, 170, 244, 203, 121, 118, 248, 229, 144, 216, 37, 150, 219, 192,
+			40, 199, 205, 111, 6, 7, 162, 78, 47, 18, 243, 223, 34, 18,
+			103, 217, 80, 224, 197, 137, 215, 18, 20, 97, 189, 76, 154, 98,
+			162, 210, 126, 146, 178, 191, 37, 146, 122, 29, 59, 172, 65, 90,
+			139, 220, 96, 83, 209, 230, 244, 75, 65, 82, 169, 170, 122, 117,
+			84, 171, 143, 232, 118, 40, 237, 204, 51, 22, 6, 94, 184, 177,
+			214, 242, 154, 237, 241, 129, 91, 96, 105, 9, 69, 246, 130, 55,
+			72, 21, 231, 189, 102, 219, 121, 40, 37, 181, 254, 91, 80, 202,
+			117, 177, 200, 246, 81, 219, 42, 27, 137, 60, 208, 189, 215, 146,
+			35, 27, 36, 32, 42, 47, 57, 178, 186, 172, 70, 3, 169, 31,
+			82, 173, 80, 210, 57, 193, 116, 198, 26, 184, 21, 177, 151, 193,
+			250, 176, 202, 92, 116, 183, 189, 226, 243, 108, 164, 23, 61, 206,
+			17, 150, 139, 19, 55, 74, 136, 209, 229, 234, 34, 225, 20, 152,
+			229, 5, 45, 226, 114, 185, 58, 126, 58, 223, 149, 14, 216, 162,
+			1, 223, 187, 15, 220, 222, 150, 247, 142, 187, 248, 32, 59, 212,
+			51, 128, 151, 219, 117, 233, 77, 236, 182, 3, 155, 118, 94, 199,
+			142, 116, 3, 63, 72, 188, 168, 19, 121, 160, 88, 1, 225, 248,
+			255, 191, 255, 22, 52, 183, 154, 45, 45, 90, 169, 143, 245, 52,
+			33, 50, 207, 12, 14, 124, 190, 191, 240, 189, 223, 251, 189, 223,
+			107, 150, 126, 45, 207, 142, 28, 180, 102, 14, 92, 190, 183, 179,
+			124, 208, 221, 94, 247, 34, 66, 82, 174, 46, 83, 206, 44, 203,
+			181, 221, 117, 175, 61, 110, 115, 99, 98, 100, 230, 190, 151, 181,
+			42, 43, 11, 168, 82, 23, 53, 157, 215, 48, 91, 178, 104, 180,
+			112, 230, 229, 181, 128, 229, 88, 167, 122, 206, 49, 54, 136, 191,
+			130, 54, 242, 4, 243, 0, 50, 64, 23, 78, 145, 13, 208, 50,
+			105, 121, 106, 107, 211, 105, 16, 86, 203, 219, 112, 187, 237, 100,
+			237, 166, 219, 238, 122, 68, 240, 131, 245, 97, 153, 249, 24, 242,
+			156, 227, 108, 136, 22, 199, 154, 31, 180, 188, 231, 136, 123, 230,
+			234, 98, 161, 213, 144, 131, 238, 159, 141, 195, 64, 145, 38, 90,
+			24, 64, 6, 117, 255, 96, 74, 92, 130, 113, 223, 117, 240, 240,
+			246, 210, 148, 115, 154, 29, 166, 18, 231, 229, 212, 187, 237, 241,
+			81, 110, 76, 12, 212, 71, 68, 246, 146, 204, 45, 125, 202, 100,
+			54, 144, 225, 28, 102, 67, 141, 39, 150, 171, 107, 243, 75, 171,
+			151, 23, 170, 5, 195, 25, 97, 140, 50, 174, 44, 44, 205, 54,
+			10, 166, 78, 215, 22, 27, 15, 92, 40, 88, 186, 194, 170, 200,
+			176, 179, 5, 206, 207, 20, 114, 78, 129, 13, 83, 250, 74, 237,
+			117, 213, 249, 7, 46, 20, 242, 189, 57, 231, 103, 10, 253, 206,
+			33, 54, 72, 57, 151, 151, 150, 22, 10, 3, 186, 205, 149, 70,
+			189, 182, 120, 181, 48, 168, 219, 188, 90, 95, 90, 93, 46, 48,
+			221, 194, 245, 234, 202, 202, 236, 213, 106, 97, 72, 151, 184, 252,
+			68, 163, 186, 82, 24, 238, 1, 235, 252, 76, 225, 144, 238, 162,
+			186, 184, 122, 189, 48, 226, 140, 178, 67, 148, 92, 81, 64, 28,
+			222, 147, 245, 192, 133, 66, 33, 5, 68, 180, 50, 218, 147, 241,
+			192, 133, 130, 83, 154, 99, 57, 34, 67, 199, 97, 35, 11, 179,
+			151, 171, 11, 107, 75, 203, 141, 218, 210, 226, 236, 66, 193, 72,
+			243, 234, 213, 239, 94, 173, 213, 171, 243, 5, 51, 155, 183, 92,
+			157, 109, 84, 231, 11, 86, 169, 201, 142, 28, 196, 80, 15, 92,
+			66, 25, 90, 48, 111, 65, 11, 212, 214, 94, 90, 40, 253, 129,
+			201, 198, 14, 216, 84, 14, 236, 228, 17, 150, 19, 180, 44, 182,
+			217, 201, 125, 93, 160, 33, 162, 236, 61, 173, 213, 69, 189, 172,
+			168, 97, 221, 66, 212, 64, 19, 251, 8, 246, 233, 125, 204, 95,
+			236, 143, 15, 28, 88, 125, 79, 231, 148, 247, 205, 109, 2, 185,
+			3, 54, 129, 75, 108, 116, 95, 67, 47, 155, 25, 191, 197, 96,
+			227, 183, 66, 206, 75, 176, 68, 179, 135, 37, 94, 218, 139, 193,
+			123, 14, 68, 1, 245, 179, 111, 174, 127, 218, 96, 183, 31, 44,
+			82, 30, 8, 195, 107, 88, 126, 219, 75, 182, 66, 37, 86, 237,
+			223, 187, 174, 211, 231, 61, 109, 213, 101, 173, 236, 110, 111, 221,
+			98, 183, 151, 208, 236, 131, 244, 237, 38, 187, 237, 192, 198, 15,
+			4, 244, 46, 198, 252, 160, 211, 77, 132, 232, 4, 132, 13, 214,
+			7, 41, 135, 152, 23, 184, 108, 55, 209, 223, 45, 250, 206, 68,
+			22, 21, 120, 85, 10, 168, 77, 128, 222, 125, 139, 145, 238, 133,
+			211, 57, 203, 10, 205, 182, 239, 5, 201, 90, 156, 68, 158, 187,
+			237, 7, 155, 180, 213, 12, 92, 204, 109, 184, 237, 216, 171, 31,
+			22, 159, 87, 212, 87, 212, 32, 2, 138, 50, 53, 242, 61, 53,
+			196, 103, 93, 163, 244, 238, 65, 54, 148, 17, 192, 157, 123, 216,
+			240, 179, 238, 77, 119, 77, 41, 85, 6, 41, 85, 67, 200, 91,
+			150, 138, 213, 89, 118, 4, 201, 181, 176, 155, 120, 209, 90, 179,
+			237, 198, 49, 16, 69, 242, 253, 96, 221, 193, 183, 37, 124, 154,
+			83, 95, 156, 251, 217, 24, 114, 215, 182, 187, 237, 196, 239, 180,
+			189, 53, 168, 121, 241, 56, 203, 66, 54, 138, 18, 215, 101, 1,
+			64, 20, 59, 243, 236, 46, 100, 174, 109, 122, 129, 23, 185, 137,
+			183, 230, 189, 161, 235, 182, 227, 53, 55, 104, 173, 109, 185, 241,
+			214, 248, 17, 52, 112, 217, 28, 55, 234, 71, 81, 240, 170, 44,
+			87, 165, 98, 179, 65, 235, 154, 27, 111, 57, 23, 217, 237, 248,
+			8, 140, 248, 193, 230, 90, 115, 203, 107, 222, 88, 235, 38, 27,
+			175, 26, 63, 150, 237, 159, 32, 92, 161, 50, 115, 40, 178, 154,
+			108, 188, 202, 89, 97, 195, 152, 187, 109, 255, 121, 111, 109, 35,
+			140, 104, 15, 29, 153, 153, 124, 49, 21, 166, 178, 36, 43, 92,
+			15, 91, 222, 197, 220, 202, 114, 181, 58, 95, 31, 82, 173, 92,
+			9, 35, 231, 46, 198, 54, 67, 141, 224, 33, 194, 218, 224, 102,
+			168, 208, 123, 63, 27, 107, 54, 197, 152, 253, 230, 154, 84, 198,
+			226, 241, 66, 15, 178, 154, 77, 26, 172, 223, 148, 52, 30, 59,
+			15, 177, 219, 82, 100, 101, 43, 142, 102, 43, 142, 105, 60, 101,
+			170, 222, 207, 198, 58, 187, 251, 43, 58, 61, 61, 118, 118, 247,
+			86, 123, 144, 29, 233, 108, 117, 246, 215, 59, 147, 173, 231, 116,
+			182, 58, 123, 43, 158, 34, 205, 60, 242, 154, 110, 226, 181, 198,
+			239, 200, 22, 207, 124, 112, 42, 172, 208, 108, 174, 121, 129, 187,
+			222, 246, 214, 220, 200, 11, 220, 120, 252, 56, 21, 182, 147, 168,
+			235, 213, 71, 154, 205, 42, 125, 156, 165, 111, 206, 25, 54, 26,
+			174, 63, 219, 20, 20, 185, 214, 137, 188, 13, 255, 185, 241, 147,
+			132, 222, 195, 248, 64, 244, 184, 76, 217, 206, 36, 43, 52, 227,
+			45, 55, 234, 16, 75, 142, 59, 110, 211, 27, 63, 37, 138, 138,
+			252, 69, 149, 141, 21, 17, 239, 248, 27, 137, 106, 241, 52, 21,
+			27, 162, 60, 217, 218, 4, 43, 0, 19, 61, 29, 79, 80, 177,
+			145, 206, 86, 39, 219, 239, 9, 118, 168, 179, 149, 237, 116, 146,
+			138, 13, 119, 182, 50, 61, 94, 96, 183, 163, 208, 182, 151, 184,
+			45, 55, 113, 51, 165, 203, 84, 26, 104, 191, 46, 63, 246, 192,
+			25, 117, 215, 119, 53, 97, 77, 81, 217, 33, 228, 41, 210, 122,
+			197, 132, 243, 210, 69, 54, 156, 165, 123, 103, 144, 9, 202, 47,
+			24, 16, 130, 230, 150, 230, 171, 107, 43, 181, 39, 171, 5, 19,
+			98, 212, 66, 173, 81, 93, 171, 175, 46, 54, 106, 215, 171, 5,
+			43, 35, 216, 63, 106, 15, 220, 91, 56, 13, 169, 97, 164, 87,
+			83, 115, 94, 205, 238, 80, 102, 149, 216, 75, 214, 118, 252, 136,
+			22, 228, 182, 43, 54, 71, 77, 63, 71, 100, 169, 21, 47, 121,
+			220, 143, 188, 43, 97, 180, 237, 38, 206, 2, 59, 30, 132, 107,
+			113, 226, 6, 45, 55, 106, 173, 165, 6, 173, 53, 183, 217, 244,
+			226, 56, 140, 198, 205, 108, 43, 119, 6, 225, 138, 44, 156, 238,
+			16, 179, 178, 232, 30, 242, 181, 110, 69, 190, 199, 216, 224, 182,
+			219, 89, 243, 130, 36, 218, 37, 249, 124, 160, 62, 176, 237, 118,
+			170, 72, 255, 173, 168, 73, 143, 218, 3, 118, 33, 247, 168, 61,
+			144, 43, 228, 31, 181, 7, 242, 133, 254, 71, 237, 129, 129, 194,
+			224, 163, 246, 192, 96, 129, 149, 222, 99, 179, 225, 172, 4, 239,
+			204, 178, 92, 147, 246, 48, 96, 116, 100, 230, 196, 139, 202, 251,
+			149, 57, 108, 110, 23, 243, 66, 92, 174, 139, 154, 16, 44, 64,
+			126, 30, 54, 117, 136, 252, 50, 229, 92, 101, 249, 103, 99, 148,
+			160, 221, 104, 100, 230, 228, 139, 183, 253, 232, 10, 53, 62, 248,
+			232, 202, 218, 226, 82, 253, 250, 236, 66, 93, 86, 119, 142, 50,
+			187, 237, 62, 191, 219, 187, 13, 82, 150, 83, 97, 135, 187, 193,
+			77, 47, 242, 55, 124, 175, 181, 134, 172, 241, 195, 217, 82, 35,
+			233, 215, 5, 148, 127, 153, 211, 120, 148, 217, 48, 241, 245, 110,
+			86, 148, 245, 10, 46, 167, 105, 150, 35, 252, 58, 140, 73, 12,
+			23, 250, 156, 1, 102, 207, 45, 213, 231, 11, 6, 214, 144, 200,
+			93, 91, 174, 85, 231, 170, 5, 179, 116, 63, 203, 11, 164, 97,
+			185, 105, 180, 21, 250, 100, 82, 182, 97, 168, 175, 171, 215, 47,
+			87, 235, 5, 115, 31, 177, 148, 98, 54, 156, 149, 228, 255, 86,
+			232, 180, 244, 207, 13, 54, 148, 145, 204, 161, 184, 186, 237, 118,
+			184, 179, 230, 182, 125, 55, 150, 164, 196, 40, 107, 22, 57, 47,
+			119, 234, 254, 54, 128, 23, 203, 171, 244, 163, 6, 43, 236, 21,
+			141, 247, 128, 105, 252, 207, 4, 179, 244, 33, 131, 141, 200, 13,
+			248, 96, 240, 238, 249, 159, 10, 222, 127, 54, 217, 161, 30, 41,
+			248, 229, 66, 247, 6, 54, 234, 183, 188, 237, 78, 152, 192, 252,
+			190, 214, 246, 110, 122, 237, 241, 18, 49, 153, 233, 23, 151, 179,
+			43, 181, 180, 222, 2, 170, 93, 28, 171, 205, 87, 175, 47, 47,
+			53, 170, 139, 115, 79, 172, 173, 46, 190, 118, 113, 233, 241, 197,
+			122, 33, 211, 60, 21, 123, 229, 16, 82, 90, 102, 133, 189, 64,
+			57, 119, 176, 131, 192, 42, 244, 57, 99, 236, 240, 226, 210, 218,
+			74, 109, 190, 186, 86, 189, 114, 165, 58, 215, 88, 17, 150, 19,
+			93, 186, 209, 179, 192, 75, 31, 180, 216, 216, 1, 144, 56, 179,
+			82, 231, 17, 106, 216, 212, 203, 129, 190, 2, 169, 99, 217, 141,
+			18, 169, 34, 77, 50, 96, 41, 72, 192, 122, 35, 105, 145, 178,
+			200, 34, 117, 56, 205, 167, 165, 225, 148, 153, 211, 9, 99, 63,
+			241, 111, 194, 168, 175, 204, 87, 80, 140, 236, 122, 65, 125, 169,
+			5, 137, 46, 29, 120, 155, 238, 158, 210, 96, 254, 86, 189, 160,
+			190, 232, 210, 247, 176, 225, 86, 216, 133, 180, 40, 90, 197, 94,
+			99, 212, 135, 68, 158, 46, 34, 245, 128, 212, 110, 54, 92, 31,
+			18, 121, 162, 200, 105, 118, 216, 221, 220, 140, 208, 184, 106, 72,
+			104, 54, 35, 58, 155, 10, 22, 31, 101, 3, 10, 15, 48, 165,
+			1, 19, 107, 29, 161, 174, 155, 48, 165, 5, 234, 227, 61, 108,
+			216, 143, 215, 180, 205, 123, 220, 228, 230, 196, 64, 125, 200, 143,
+			181, 9, 181, 244, 211, 38, 27, 233, 61, 198, 112, 230, 217, 64,
+			59, 108, 186, 192, 183, 60, 67, 155, 120, 137, 147, 143, 202, 130,
+			44, 95, 215, 53, 139, 191, 101, 176, 1, 149, 237, 220, 206, 236,
+			142, 155, 108, 81, 115, 185, 203, 102, 193, 168, 83, 26, 249, 113,
+			199, 13, 198, 205, 52, 31, 105, 72, 201, 109, 207, 109, 145, 218,
+			20, 110, 111, 123, 65, 34, 148, 237, 193, 250, 97, 153, 63, 39,
+			179, 113, 154, 150, 68, 174, 223, 238, 41, 107, 83, 217, 130, 250,
+			160, 11, 95, 100, 71, 85, 187, 45, 47, 113, 155, 91, 94, 43,
+			173, 132, 67, 146, 193, 250, 29, 178, 192, 188, 252, 174, 234, 150,
+			62, 107, 176, 81, 165, 232, 181, 52, 178, 174, 51, 230, 6, 65,
+			152, 100, 209, 181, 159, 148, 247, 213, 171, 204, 234, 74, 245, 76,
+			3, 197, 109, 198, 210, 47, 183, 68, 219, 113, 54, 36, 207, 168,
+			160, 207, 74, 211, 0, 19, 89, 87, 252, 54, 25, 112, 214, 189,
+			77, 63, 144, 150, 103, 145, 80, 6, 28, 91, 27, 112, 46, 255,
+			29, 54, 214, 12, 183, 247, 130, 123, 185, 176, 199, 60, 17, 95,
+			51, 158, 156, 146, 133, 54, 195, 182, 27, 108, 86, 194, 104, 51,
+			61, 168, 133, 128, 21, 103, 142, 107, 59, 235, 127, 101, 24, 31,
+			49, 173, 171, 203, 151, 63, 110, 22, 175, 138, 138, 203, 178, 116,
+			165, 238, 109, 180, 189, 38, 6, 248, 232, 127, 252, 77, 147, 245,
+			59, 185, 211, 125, 31, 232, 55, 216, 199, 15, 51, 99, 216, 177,
+			78, 247, 57, 51, 191, 54, 204, 169, 120, 51, 108, 243, 203, 221,
+			141, 13, 47, 138, 249, 20, 23, 13, 157, 142, 57, 148, 19, 78,
+			12, 165, 185, 5, 83, 23, 23, 18, 57, 227, 115, 97, 103, 55,
+			242, 55, 183, 18, 62, 115, 246, 236, 171, 100, 5, 94, 11, 154,
+			21, 206, 103, 219, 109, 78, 223, 98, 174, 44, 96, 21, 198, 183,
+			146, 164, 19, 95, 156, 158, 110, 129, 231, 133, 29, 47, 138, 21,
+			54, 154, 225, 182, 24, 97, 51, 108, 79, 173, 11, 32, 166, 25,
+			227, 117, 175, 229, 99, 217, 174, 119, 49, 4, 238, 6, 45, 222,
+			141, 61, 238, 7, 92, 76, 0, 229, 172, 251, 129, 27, 237, 18,
+			92, 113, 153, 239, 248, 201, 22, 15, 35, 250, 27, 118, 19, 198,
+			183, 195, 150, 191, 225, 139, 197, 81, 230, 110, 228, 241, 142, 23,
+			109, 251, 73, 226, 181, 120, 39, 10, 111, 250, 45, 175, 197, 147,
+			45, 55, 225, 201, 150, 199, 55, 66, 72, 32, 126, 176, 201, 155,
+			97, 208, 242, 81, 41, 70, 37, 198, 183, 189, 228, 34, 99, 28,
+			255, 206, 236, 1, 44, 230, 225, 134, 130, 8, 39, 153, 124, 187,
+			27, 39, 60, 242, 18, 215, 15, 168, 85, 119, 61, 188, 137, 79,
+			18, 99, 140, 7, 97, 226, 55, 189, 50, 79, 182, 252, 152, 183,
+			253, 56, 65, 11, 217, 30, 131, 214, 30, 112, 90, 126, 220, 108,
+			187, 254, 182, 23, 85, 110, 5, 132, 31, 100, 113, 161, 128, 232,
+			68, 97, 171, 219, 244, 82, 56, 88, 10, 200, 183, 5, 7, 227,
+			114, 116, 173, 176, 217, 197, 178, 118, 213, 36, 77, 135, 17, 15,
+			147, 45, 47, 226, 219, 110, 226, 69, 190, 219, 142, 83, 84, 99,
+			98, 208, 38, 227, 89, 232, 245, 160, 22, 61, 159, 106, 162, 97,
+			48, 87, 0, 148, 165, 173, 32, 76, 191, 17, 222, 253, 36, 198,
+			136, 2, 209, 84, 24, 197, 124, 219, 221, 229, 235, 30, 40, 165,
+			197, 147, 144, 123, 65, 43, 140, 98, 15, 68, 209, 137, 194, 237,
+			48, 241, 0, 76, 171, 219, 76, 98, 222, 242, 34, 255, 166, 215,
+			226, 27, 81, 184, 205, 4, 22, 226, 112, 35, 217, 1, 153, 72,
+			10, 226, 113, 199, 107, 130, 130, 120, 39, 242, 65, 88, 17, 104,
+			39, 16, 84, 20, 227, 188, 179, 194, 24, 111, 92, 171, 173, 240,
+			149, 165, 43, 141, 199, 103, 235, 85, 94, 91, 225, 203, 245, 165,
+			199, 106, 243, 213, 121, 126, 249, 9, 222, 184, 86, 229, 115, 75,
+			203, 79, 212, 107, 87, 175, 53, 248, 181, 165, 133, 249, 106, 125,
+			133, 207, 46, 206, 243, 185, 165, 197, 70, 189, 118, 121, 181, 177,
+			84, 95, 97, 188, 52, 187, 194, 107, 43, 37, 250, 50, 187, 248,
+			4, 175, 190, 110, 185, 94, 93, 89, 225, 75, 117, 94, 187, 190,
+			188, 80, 171, 206, 243, 199, 103, 235, 245, 217, 197, 70, 173, 186,
+			82, 230, 181, 197, 185, 133, 213, 249, 218, 226, 213, 50, 191, 188,
+			218, 224, 139, 75, 13, 198, 23, 106, 215, 107, 141, 234, 60, 111,
+			44, 149, 169, 219, 253, 245, 248, 210, 21, 126, 189, 90, 159, 187,
+			54, 187, 216, 152, 189, 92, 91, 168, 53, 158, 160, 14, 175, 212,
+			26, 139, 232, 236, 202, 82, 157, 241, 89, 190, 60, 91, 111, 212,
+			230, 86, 23, 102, 235, 124, 121, 181, 190, 188, 180, 82, 229, 24,
+			217, 124, 109, 101, 110, 97, 182, 118, 189, 58, 95, 225, 181, 69,
+			190, 184, 196, 171, 143, 85, 23, 27, 124, 229, 218, 236, 194, 66,
+			239, 64, 25, 95, 122, 124, 177, 90, 7, 244, 217, 97, 242, 203,
+			85, 190, 80, 155, 189, 188, 80, 229, 87, 150, 234, 52, 206, 249,
+			90, 189, 58, 215, 192, 128, 210, 95, 115, 181, 249, 234, 98, 99,
+			118, 161, 204, 248, 202, 114, 117, 174, 54, 187, 80, 230, 213, 215,
+			85, 175, 47, 47, 204, 214, 159, 40, 203, 70, 87, 170, 223, 189,
+			90, 93, 108, 212, 102, 23, 248, 252, 236, 245, 217, 171, 213, 21,
+			62, 241, 82, 88, 89, 174, 47, 205, 173, 214, 171, 215, 1, 245,
+			210, 21, 190, 178, 122, 121, 165, 81, 107, 172, 54, 170, 252, 234,
+			210, 210, 60, 33, 123, 165, 90, 127, 172, 54, 87, 93, 185, 196,
+			23, 150, 128, 254, 43, 124, 117, 165, 90, 102, 124, 126, 182, 49,
+			75, 93, 47, 215, 151, 174, 212, 26, 43, 151, 240, 251, 242, 234,
+			74, 141, 16, 87, 91, 108, 84, 235, 245, 85, 58, 165, 153, 228,
+			215, 150, 30, 175, 62, 86, 173, 243, 185, 217, 213, 149, 234, 60,
+			97, 120, 105, 17, 163, 5, 173, 84, 151, 234, 79, 160, 89, 224,
+			129, 102, 160, 204, 31, 191, 86, 109, 92, 171, 214, 129, 84, 194,
+			214, 44, 208, 0, 149, 110, 174, 145, 45, 182, 84, 231, 141, 165,
+			122, 131, 101, 198, 201, 23, 171, 87, 23, 106, 87, 171, 139, 115,
+			85, 192, 179, 132, 102, 30, 175, 173, 84, 39, 249, 108, 189, 182,
+			130, 2, 53, 234, 152, 63, 62, 251, 4, 95, 90, 165, 81, 99,
+			162, 86, 87, 170, 76, 252, 206, 144, 110, 153, 230, 147, 215, 174,
+			240, 217, 249, 199, 106, 128, 92, 150, 94, 94, 90, 89, 169, 73,
+			114, 33, 180, 205, 93, 147, 56, 175, 176, 153, 255, 104, 242, 217,
+			110, 178, 21, 70, 23, 249, 13, 47, 72, 194, 224, 187, 82, 198,
+			206, 39, 94, 75, 89, 252, 49, 55, 106, 185, 147, 140, 243, 203,
+			46, 86, 102, 24, 240, 48, 242, 55, 253, 192, 109, 239, 223, 128,
+			90, 94, 236, 111, 6, 124, 125, 151, 113, 190, 226, 6, 207, 186,
+			187, 252, 234, 150, 183, 237, 238, 184, 73, 153, 63, 234, 109, 108,
+			240, 121, 207, 13, 202, 196, 159, 136, 211, 196, 180, 8, 183, 60,
+			46, 109, 67, 196, 13, 105, 61, 99, 219, 230, 98, 191, 92, 23,
+			92, 176, 229, 109, 248, 129, 100, 112, 27, 97, 55, 104, 161, 172,
+			16, 32, 168, 116, 92, 193, 2, 184, 233, 182, 253, 86, 54, 155,
+			55, 221, 128, 163, 137, 200, 13, 226, 54, 52, 22, 222, 242, 35,
+			175, 153, 180, 119, 193, 102, 92, 126, 128, 59, 19, 211, 92, 196,
+			13, 118, 37, 79, 132, 155, 11, 182, 80, 48, 203, 9, 175, 178,
+			89, 209, 101, 34, 33, 14, 113, 63, 137, 185, 191, 221, 9, 163,
+			36, 158, 172, 48, 54, 192, 12, 211, 177, 38, 251, 198, 241, 107,
+			192, 177, 238, 235, 155, 103, 131, 204, 28, 24, 18, 63, 69, 102,
+			185, 175, 76, 153, 134, 248, 41, 50, 167, 250, 206, 81, 166, 252,
+			41, 50, 43, 125, 15, 82, 230, 41, 241, 83, 100, 78, 247, 221,
+			67, 153, 39, 197, 79, 145, 121, 182, 239, 56, 101, 30, 23, 63,
+			69, 230, 133, 190, 59, 217, 247, 48, 115, 96, 144, 126, 22, 19,
+			190, 215, 117, 76, 108, 60, 235, 30, 87, 246, 240, 22, 246, 35,
+			176, 81, 175, 197, 215, 189, 166, 139, 45, 60, 210, 130, 201, 212,
+			58, 200, 129, 113, 183, 189, 25, 70, 126, 178, 181, 29, 243, 86,
+			24, 156, 78, 248, 78, 24, 221, 224, 173, 46, 132, 118, 190, 30,
+			134, 73, 156, 68, 110, 167, 227, 7, 155, 21, 198, 158, 101, 166,
+			221, 231, 216, 175, 234, 187, 104, 20, 95, 207, 27, 91, 196, 210,
+			5, 9, 53, 195, 237, 142, 223, 246, 34, 154, 46, 113, 68, 179,
+			111, 110, 86, 188, 132, 182, 12, 215, 15, 208, 58, 136, 66, 76,
+			52, 19, 4, 192, 253, 132, 119, 220, 40, 246, 64, 86, 140, 89,
+			118, 159, 225, 88, 175, 26, 56, 202, 134, 152, 109, 247, 153, 125,
+			142, 245, 144, 57, 193, 134, 89, 14, 9, 27, 41, 166, 82, 121,
+			199, 122, 104, 232, 110, 149, 50, 28, 235, 161, 227, 39, 84, 202,
+			114, 172, 135, 238, 61, 205, 166, 153, 105, 27, 142, 253, 112, 223,
+			147, 70, 241, 4, 159, 151, 164, 25, 115, 151, 96, 111, 123, 137,
+			130, 134, 128, 145, 16, 24, 134, 99, 61, 60, 112, 140, 61, 196,
+			108, 219, 0, 4, 175, 49, 143, 149, 202, 84, 130, 246, 194, 50,
+			143, 188, 54, 41, 81, 32, 198, 40, 12, 147, 140, 80, 146, 68,
+			158, 39, 32, 52, 8, 222, 215, 72, 120, 13, 179, 47, 231, 88,
+			175, 25, 26, 85, 41, 195, 177, 94, 227, 220, 174, 82, 150, 99,
+			189, 230, 104, 145, 157, 161, 46, 13, 199, 122, 196, 188, 187, 116,
+			23, 39, 146, 45, 109, 132, 97, 169, 76, 127, 42, 235, 110, 84,
+			42, 115, 47, 105, 86, 84, 171, 134, 141, 194, 58, 149, 115, 172,
+			71, 116, 31, 24, 200, 35, 206, 81, 149, 178, 28, 235, 145, 59,
+			239, 98, 23, 168, 15, 211, 177, 46, 155, 247, 20, 79, 243, 69,
+			181, 187, 203, 233, 160, 197, 0, 242, 217, 77, 23, 181, 238, 205,
+			180, 81, 77, 167, 114, 142, 117, 89, 247, 102, 26, 142, 117, 217,
+			185, 83, 165, 44, 199, 186, 124, 156, 179, 239, 166, 222, 44, 199,
+			154, 55, 39, 138, 243, 156, 60, 37, 68, 127, 32, 5, 225, 24,
+			152, 118, 42, 97, 144, 194, 142, 118, 3, 20, 114, 27, 137, 118,
+			26, 20, 203, 70, 155, 58, 149, 115, 172, 249, 161, 130, 74, 25,
+			142, 53, 63, 90, 82, 41, 244, 126, 234, 52, 123, 158, 64, 177,
+			29, 235, 170, 121, 111, 113, 123, 47, 40, 48, 65, 190, 60, 64,
+			42, 140, 95, 9, 35, 41, 42, 77, 145, 192, 14, 206, 186, 237,
+			111, 70, 130, 213, 132, 65, 123, 183, 194, 231, 67, 200, 124, 144,
+			141, 52, 204, 54, 117, 174, 83, 57, 199, 186, 170, 97, 182, 13,
+			199, 186, 58, 202, 85, 202, 114, 172, 171, 39, 78, 177, 7, 8,
+			230, 156, 99, 61, 106, 150, 139, 147, 36, 237, 39, 97, 103, 138,
+			236, 50, 61, 220, 53, 203, 131, 117, 127, 57, 27, 21, 117, 42,
+			239, 88, 143, 14, 21, 85, 202, 112, 172, 71, 143, 157, 86, 41,
+			203, 177, 30, 61, 115, 31, 173, 58, 195, 204, 59, 214, 107, 205,
+			41, 249, 41, 111, 35, 165, 26, 201, 227, 155, 92, 117, 134, 153,
+			55, 28, 235, 181, 199, 39, 84, 202, 114, 172, 215, 222, 87, 150,
+			141, 244, 59, 214, 130, 89, 145, 159, 250, 109, 164, 84, 35, 253,
+			121, 199, 90, 24, 186, 71, 165, 12, 199, 90, 40, 77, 170, 148,
+			229, 88, 11, 229, 41, 217, 200, 128, 99, 93, 215, 141, 12, 216,
+			72, 169, 70, 6, 242, 142, 117, 125, 232, 184, 74, 25, 142, 117,
+			157, 171, 70, 6, 44, 199, 186, 174, 27, 25, 116, 172, 37, 243,
+			132, 108, 100, 208, 70, 74, 53, 50, 152, 119, 172, 165, 33, 181,
+			12, 7, 13, 199, 90, 186, 67, 13, 110, 208, 114, 172, 165, 123,
+			74, 236, 47, 12, 106, 133, 57, 214, 170, 57, 93, 252, 175, 6,
+			111, 8, 68, 123, 237, 150, 98, 109, 49, 87, 206, 57, 61, 123,
+			142, 187, 14, 169, 22, 212, 165, 247, 223, 140, 238, 82, 97, 252,
+			137, 176, 75, 50, 116, 236, 110, 120, 237, 93, 30, 121, 219, 208,
+			94, 104, 221, 193, 206, 19, 121, 178, 27, 181, 109, 109, 185, 17,
+			14, 161, 121, 212, 13, 18, 127, 219, 99, 124, 163, 27, 16, 107,
+			119, 219, 126, 178, 171, 72, 57, 221, 38, 98, 62, 53, 69, 36,
+			156, 133, 202, 143, 121, 224, 121, 80, 16, 64, 168, 180, 243, 75,
+			61, 17, 170, 5, 79, 194, 176, 29, 107, 18, 98, 54, 134, 173,
+			83, 121, 199, 90, 29, 82, 28, 133, 25, 142, 181, 90, 60, 163,
+			82, 150, 99, 173, 78, 85, 216, 211, 132, 173, 33, 199, 122, 194,
+			188, 187, 184, 76, 59, 134, 240, 16, 85, 224, 101, 25, 174, 248,
+			220, 237, 200, 101, 71, 246, 33, 210, 2, 121, 137, 138, 205, 148,
+			72, 240, 16, 137, 243, 37, 13, 214, 144, 141, 246, 117, 42, 231,
+			88, 79, 104, 70, 52, 100, 56, 214, 19, 206, 184, 74, 89, 142,
+			245, 196, 177, 187, 216, 105, 102, 218, 166, 99, 63, 221, 247, 102,
+			163, 120, 172, 103, 43, 144, 114, 12, 135, 190, 47, 183, 0, 240,
+			178, 167, 7, 238, 32, 250, 49, 177, 5, 188, 222, 60, 70, 237,
+			153, 196, 212, 95, 47, 123, 54, 137, 169, 191, 94, 246, 108, 18,
+			83, 127, 189, 100, 234, 38, 49, 245, 215, 31, 45, 202, 70, 12,
+			199, 122, 198, 60, 35, 27, 1, 215, 126, 70, 55, 98, 228, 29,
+			235, 25, 73, 201, 38, 113, 237, 103, 248, 41, 149, 178, 28, 235,
+			153, 137, 73, 217, 136, 233, 88, 174, 92, 14, 38, 49, 99, 87,
+			55, 130, 69, 235, 234, 70, 48, 0, 87, 46, 7, 147, 152, 177,
+			43, 151, 3, 37, 154, 230, 125, 242, 19, 216, 104, 83, 55, 98,
+			229, 29, 171, 41, 89, 132, 73, 108, 180, 121, 236, 94, 149, 66,
+			189, 201, 51, 178, 17, 219, 177, 90, 146, 69, 152, 196, 215, 90,
+			186, 17, 59, 239, 88, 45, 201, 34, 76, 236, 191, 86, 75, 178,
+			8, 147, 248, 90, 235, 190, 50, 27, 70, 35, 86, 159, 99, 111,
+			152, 55, 44, 241, 205, 2, 246, 54, 216, 56, 59, 198, 242, 72,
+			1, 237, 155, 246, 93, 165, 97, 104, 163, 237, 110, 236, 223, 244,
+			42, 140, 141, 176, 126, 241, 209, 198, 215, 225, 52, 157, 115, 172,
+			205, 67, 78, 154, 54, 28, 107, 115, 108, 60, 77, 91, 142, 181,
+			121, 236, 78, 221, 184, 225, 88, 91, 246, 177, 210, 48, 175, 62,
+			183, 191, 113, 76, 207, 86, 166, 113, 35, 231, 88, 91, 153, 198,
+			49, 69, 91, 99, 183, 167, 105, 203, 177, 182, 142, 22, 217, 33,
+			217, 184, 233, 88, 207, 218, 211, 250, 51, 38, 234, 217, 76, 115,
+			152, 170, 103, 15, 149, 210, 180, 225, 88, 207, 158, 56, 147, 166,
+			45, 199, 122, 118, 170, 34, 49, 157, 115, 172, 182, 158, 115, 112,
+			244, 182, 198, 116, 46, 239, 88, 109, 185, 28, 77, 226, 232, 237,
+			162, 154, 243, 156, 229, 88, 109, 61, 231, 121, 199, 10, 204, 105,
+			249, 9, 28, 61, 208, 141, 128, 163, 7, 154, 112, 192, 209, 3,
+			174, 232, 52, 111, 57, 86, 160, 33, 233, 119, 172, 142, 169, 200,
+			1, 28, 189, 163, 27, 1, 71, 239, 104, 72, 250, 13, 199, 234,
+			20, 239, 145, 144, 244, 91, 142, 213, 57, 121, 138, 125, 28, 124,
+			20, 68, 101, 119, 205, 231, 172, 226, 15, 27, 188, 78, 102, 174,
+			112, 67, 155, 174, 120, 226, 110, 114, 225, 75, 21, 87, 120, 253,
+			128, 92, 98, 151, 216, 87, 149, 217, 1, 236, 139, 152, 100, 12,
+			179, 131, 182, 10, 243, 8, 109, 235, 45, 60, 118, 183, 181, 194,
+			146, 105, 88, 22, 218, 118, 119, 201, 80, 196, 195, 155, 94, 212,
+			118, 59, 146, 205, 152, 22, 38, 186, 203, 238, 144, 84, 67, 194,
+			224, 205, 91, 144, 164, 16, 247, 110, 234, 105, 22, 2, 223, 77,
+			77, 53, 6, 145, 228, 77, 77, 146, 6, 145, 228, 77, 77, 146,
+			6, 150, 236, 206, 45, 72, 82, 200, 121, 59, 153, 198, 65, 146,
+			59, 153, 198, 1, 233, 142, 38, 73, 131, 72, 114, 71, 51, 159,
+			1, 199, 218, 53, 203, 146, 249, 96, 27, 221, 213, 51, 135, 109,
+			116, 119, 104, 92, 165, 12, 199, 218, 61, 122, 90, 165, 44, 199,
+			218, 61, 115, 31, 251, 62, 154, 57, 115, 208, 177, 222, 100, 158,
+			42, 118, 83, 252, 17, 226, 73, 40, 134, 61, 112, 203, 111, 110,
+			29, 48, 63, 106, 122, 14, 154, 10, 168, 127, 155, 254, 77, 47,
+			160, 70, 168, 178, 216, 148, 188, 148, 38, 194, 160, 169, 68, 26,
+			147, 182, 239, 55, 105, 224, 7, 115, 142, 245, 38, 205, 126, 177,
+			125, 191, 201, 81, 148, 140, 237, 251, 77, 165, 147, 108, 136, 129,
+			235, 228, 190, 167, 239, 109, 134, 65, 204, 29, 108, 237, 123, 6,
+			238, 98, 75, 204, 182, 45, 179, 207, 177, 255, 174, 97, 94, 44,
+			206, 210, 86, 68, 170, 72, 196, 227, 36, 140, 60, 181, 169, 147,
+			142, 210, 10, 189, 24, 170, 82, 228, 53, 195, 205, 192, 127, 222,
+			227, 91, 94, 228, 85, 248, 138, 39, 109, 125, 21, 198, 14, 177,
+			28, 26, 180, 169, 69, 157, 204, 35, 57, 116, 183, 74, 26, 72,
+			30, 63, 175, 146, 22, 146, 15, 60, 196, 158, 4, 96, 57, 199,
+			126, 171, 97, 30, 45, 94, 231, 115, 228, 137, 22, 147, 102, 69,
+			98, 158, 199, 155, 221, 56, 9, 183, 83, 152, 130, 148, 216, 165,
+			16, 235, 199, 41, 137, 103, 225, 2, 179, 181, 114, 125, 104, 252,
+			208, 184, 232, 56, 215, 103, 80, 114, 84, 37, 77, 36, 111, 27,
+			103, 231, 25, 184, 121, 254, 237, 70, 223, 159, 26, 70, 241, 84,
+			207, 70, 153, 202, 34, 126, 144, 238, 155, 21, 198, 134, 152, 101,
+			67, 207, 122, 187, 49, 112, 39, 27, 97, 182, 109, 219, 125, 78,
+			254, 29, 134, 249, 49, 195, 162, 14, 108, 168, 117, 246, 59, 140,
+			254, 33, 182, 194, 242, 248, 12, 188, 191, 211, 176, 143, 20, 231,
+			248, 89, 238, 167, 198, 107, 82, 96, 189, 40, 10, 35, 216, 6,
+			150, 162, 22, 212, 248, 152, 239, 120, 126, 36, 148, 219, 45, 31,
+			147, 227, 55, 221, 54, 143, 60, 55, 198, 201, 35, 99, 135, 89,
+			191, 104, 212, 160, 86, 15, 167, 25, 38, 50, 156, 49, 54, 34,
+			187, 53, 28, 251, 239, 27, 246, 152, 46, 96, 136, 140, 145, 52,
+			195, 68, 198, 168, 195, 118, 100, 13, 211, 177, 223, 109, 216, 99,
+			197, 77, 190, 24, 38, 252, 73, 127, 243, 73, 119, 147, 123, 65,
+			51, 108, 121, 173, 10, 231, 139, 242, 216, 76, 51, 168, 196, 189,
+			225, 241, 115, 103, 249, 250, 110, 226, 197, 21, 206, 87, 99, 143,
+			103, 28, 142, 185, 191, 193, 184, 58, 107, 203, 10, 60, 109, 255,
+			134, 215, 222, 205, 12, 198, 52, 168, 231, 20, 52, 1, 202, 168,
+			163, 7, 99, 57, 246, 123, 12, 251, 136, 46, 0, 222, 250, 158,
+			236, 240, 45, 19, 25, 206, 152, 30, 140, 237, 216, 239, 251, 142,
+			13, 230, 252, 204, 203, 31, 12, 200, 227, 125, 217, 193, 64, 24,
+			123, 95, 118, 48, 57, 199, 126, 191, 97, 223, 166, 11, 228, 12,
+			202, 40, 164, 25, 38, 50, 198, 142, 232, 26, 121, 199, 126, 33,
+			91, 35, 111, 80, 70, 90, 35, 111, 34, 35, 83, 163, 223, 177,
+			63, 96, 216, 142, 46, 208, 111, 80, 198, 161, 52, 195, 68, 70,
+			97, 84, 215, 24, 112, 236, 255, 107, 22, 197, 3, 6, 101, 164,
+			40, 30, 48, 145, 225, 140, 177, 207, 25, 178, 202, 160, 99, 255,
+			40, 40, 251, 179, 6, 111, 184, 155, 83, 45, 175, 237, 111, 251,
+			80, 42, 245, 129, 103, 133, 241, 171, 81, 216, 237, 144, 200, 9,
+			242, 78, 79, 224, 73, 220, 197, 86, 148, 10, 197, 126, 32, 76,
+			46, 231, 43, 252, 90, 184, 227, 221, 244, 162, 178, 48, 227, 157,
+			103, 220, 135, 21, 67, 159, 8, 196, 60, 222, 10, 187, 237, 22,
+			143, 19, 191, 221, 6, 19, 133, 63, 31, 172, 20, 196, 215, 72,
+			250, 222, 164, 142, 225, 241, 37, 207, 151, 208, 35, 227, 240, 96,
+			77, 228, 71, 201, 175, 221, 152, 119, 131, 27, 65, 184, 19, 72,
+			14, 158, 153, 206, 65, 131, 6, 153, 78, 231, 160, 137, 140, 209,
+			49, 54, 37, 177, 192, 28, 251, 195, 134, 125, 123, 233, 46, 190,
+			224, 5, 155, 201, 214, 193, 120, 208, 245, 153, 65, 229, 211, 185,
+			99, 38, 50, 198, 110, 99, 39, 100, 131, 67, 142, 253, 17, 160,
+			117, 140, 47, 122, 59, 96, 127, 55, 189, 8, 204, 143, 207, 100,
+			154, 25, 50, 168, 84, 10, 215, 144, 137, 140, 209, 148, 1, 12,
+			59, 246, 143, 103, 137, 102, 216, 160, 140, 116, 66, 135, 77, 100,
+			56, 41, 209, 28, 114, 236, 159, 200, 178, 140, 67, 6, 101, 164,
+			68, 115, 200, 68, 70, 33, 37, 229, 17, 199, 254, 73, 195, 190,
+			67, 23, 24, 49, 40, 99, 52, 205, 48, 145, 113, 228, 118, 93,
+			227, 176, 99, 255, 84, 182, 198, 97, 131, 50, 210, 26, 135, 77,
+			100, 28, 185, 157, 157, 150, 53, 10, 142, 253, 211, 134, 125, 91,
+			233, 14, 48, 152, 184, 103, 41, 11, 195, 157, 170, 89, 48, 168,
+			100, 58, 192, 130, 137, 12, 231, 136, 110, 106, 212, 177, 63, 250,
+			178, 154, 26, 53, 168, 100, 218, 212, 168, 137, 12, 194, 21, 152,
+			190, 225, 228, 127, 198, 48, 127, 78, 51, 125, 48, 215, 159, 49,
+			250, 135, 217, 25, 234, 9, 242, 147, 253, 143, 12, 251, 142, 98,
+			241, 150, 76, 95, 117, 70, 226, 146, 253, 143, 210, 197, 106, 16,
+			47, 255, 71, 198, 109, 10, 105, 16, 152, 236, 79, 164, 72, 51,
+			160, 57, 217, 159, 200, 214, 48, 76, 199, 254, 68, 182, 134, 233,
+			216, 63, 155, 173, 129, 38, 126, 54, 91, 3, 44, 246, 103, 81,
+			3, 123, 166, 13, 120, 127, 222, 48, 143, 137, 225, 208, 206, 254,
+			243, 106, 103, 183, 33, 223, 217, 63, 111, 12, 141, 170, 164, 129,
+			194, 206, 237, 42, 105, 33, 121, 180, 40, 91, 50, 28, 251, 147,
+			134, 121, 167, 252, 104, 216, 148, 84, 45, 25, 57, 36, 135, 10,
+			42, 73, 133, 71, 239, 80, 73, 11, 201, 226, 49, 217, 146, 233,
+			216, 191, 144, 194, 100, 218, 148, 84, 45, 153, 121, 36, 117, 75,
+			24, 223, 47, 24, 163, 10, 38, 211, 66, 242, 104, 145, 125, 8,
+			2, 157, 141, 228, 47, 65, 222, 120, 167, 193, 107, 27, 196, 140,
+			200, 57, 22, 83, 19, 123, 137, 60, 171, 132, 173, 64, 73, 116,
+			177, 151, 84, 56, 202, 174, 135, 116, 180, 232, 203, 115, 75, 85,
+			147, 17, 243, 79, 235, 106, 251, 116, 224, 65, 68, 209, 183, 119,
+			202, 60, 123, 247, 7, 130, 123, 122, 55, 72, 10, 82, 182, 105,
+			217, 4, 158, 78, 230, 145, 28, 58, 172, 146, 6, 146, 133, 35,
+			42, 73, 99, 185, 99, 156, 253, 223, 76, 26, 154, 237, 216, 255,
+			204, 48, 121, 241, 163, 38, 25, 235, 164, 172, 66, 208, 226, 226,
+			38, 13, 54, 150, 80, 250, 177, 62, 231, 84, 54, 10, 124, 22,
+			35, 85, 95, 24, 167, 203, 35, 49, 29, 33, 112, 151, 159, 174,
+			156, 46, 67, 48, 132, 41, 168, 219, 110, 239, 78, 189, 161, 235,
+			182, 225, 177, 3, 161, 96, 41, 217, 242, 162, 29, 63, 246, 202,
+			124, 238, 190, 251, 166, 176, 181, 243, 184, 25, 194, 164, 206, 120,
+			212, 109, 203, 93, 82, 157, 155, 110, 248, 242, 240, 23, 221, 242,
+			9, 191, 226, 85, 248, 134, 31, 197, 194, 118, 36, 174, 157, 10,
+			136, 149, 244, 5, 184, 89, 58, 42, 66, 186, 27, 193, 243, 3,
+			99, 242, 130, 180, 156, 7, 243, 186, 23, 36, 101, 30, 6, 28,
+			91, 78, 136, 2, 100, 182, 102, 92, 187, 66, 79, 106, 172, 219,
+			2, 115, 58, 153, 67, 82, 19, 57, 182, 241, 127, 102, 56, 138,
+			250, 108, 11, 201, 187, 143, 179, 191, 67, 72, 207, 57, 246, 191,
+			48, 204, 227, 197, 14, 191, 146, 213, 197, 94, 2, 207, 124, 221,
+			131, 77, 75, 222, 198, 3, 250, 106, 64, 43, 131, 76, 24, 182,
+			111, 122, 173, 94, 205, 193, 13, 2, 47, 226, 110, 156, 82, 157,
+			134, 61, 103, 19, 0, 58, 73, 240, 104, 216, 33, 80, 252, 11,
+			195, 41, 170, 164, 133, 228, 93, 119, 179, 119, 11, 138, 201, 59,
+			246, 103, 12, 243, 100, 241, 251, 4, 197, 4, 221, 109, 248, 225,
+			43, 66, 209, 134, 190, 30, 107, 94, 226, 61, 7, 149, 160, 3,
+			70, 166, 78, 229, 229, 200, 72, 24, 146, 166, 226, 245, 48, 108,
+			123, 110, 16, 151, 121, 41, 137, 186, 94, 9, 4, 95, 34, 231,
+			187, 146, 44, 1, 231, 130, 96, 115, 111, 63, 242, 254, 161, 232,
+			6, 16, 144, 78, 48, 129, 197, 232, 197, 77, 183, 35, 80, 131,
+			243, 174, 29, 119, 119, 82, 117, 182, 155, 236, 3, 120, 78, 151,
+			23, 96, 9, 191, 17, 18, 234, 248, 107, 30, 230, 231, 102, 94,
+			69, 52, 36, 11, 105, 124, 230, 109, 194, 137, 78, 230, 144, 212,
+			248, 132, 184, 245, 25, 195, 57, 174, 146, 22, 146, 165, 19, 236,
+			239, 9, 230, 210, 239, 216, 191, 105, 152, 247, 20, 111, 98, 21,
+			17, 75, 128, 162, 23, 75, 115, 100, 203, 123, 14, 107, 205, 229,
+			33, 252, 99, 213, 12, 75, 160, 65, 14, 192, 251, 233, 152, 167,
+			23, 153, 25, 157, 2, 84, 120, 214, 252, 10, 254, 195, 183, 61,
+			200, 170, 104, 141, 124, 75, 168, 134, 30, 66, 191, 77, 96, 232,
+			100, 14, 73, 205, 31, 33, 255, 253, 166, 49, 170, 216, 114, 191,
+			133, 228, 113, 206, 190, 42, 134, 48, 224, 216, 255, 22, 67, 248,
+			111, 6, 127, 116, 101, 105, 49, 67, 185, 10, 130, 10, 105, 141,
+			132, 84, 201, 53, 161, 240, 238, 59, 33, 171, 72, 78, 194, 96,
+			179, 136, 248, 150, 43, 138, 186, 188, 164, 111, 140, 150, 164, 102,
+			199, 67, 109, 201, 247, 218, 173, 178, 28, 19, 125, 58, 29, 11,
+			125, 1, 71, 157, 66, 188, 3, 3, 169, 100, 185, 141, 159, 192,
+			145, 200, 107, 117, 155, 210, 213, 130, 208, 77, 200, 58, 29, 11,
+			248, 215, 119, 121, 51, 132, 239, 118, 66, 220, 200, 79, 192, 18,
+			154, 238, 182, 215, 158, 115, 227, 116, 45, 13, 216, 52, 120, 157,
+			204, 33, 169, 231, 30, 98, 240, 191, 77, 249, 192, 128, 133, 228,
+			221, 92, 110, 81, 131, 142, 253, 187, 134, 121, 82, 126, 28, 180,
+			41, 169, 90, 26, 204, 35, 57, 164, 118, 55, 72, 146, 191, 107,
+			140, 43, 42, 26, 180, 144, 44, 157, 96, 159, 28, 164, 41, 96,
+			142, 253, 39, 134, 121, 170, 248, 19, 131, 132, 194, 168, 171, 92,
+			104, 104, 234, 9, 209, 231, 121, 73, 89, 223, 75, 21, 254, 248,
+			150, 23, 164, 95, 52, 153, 168, 18, 64, 17, 164, 93, 183, 121,
+			3, 158, 50, 88, 183, 77, 216, 26, 54, 221, 168, 213, 246, 98,
+			121, 18, 134, 74, 210, 28, 44, 26, 220, 115, 5, 23, 115, 157,
+			129, 69, 244, 162, 54, 187, 117, 175, 29, 130, 128, 67, 77, 221,
+			56, 237, 196, 41, 59, 56, 70, 200, 195, 118, 75, 129, 215, 148,
+			106, 62, 77, 178, 134, 134, 26, 39, 191, 127, 18, 140, 210, 46,
+			64, 109, 96, 140, 114, 205, 196, 92, 72, 231, 46, 80, 81, 130,
+			145, 125, 203, 75, 252, 102, 73, 44, 154, 178, 116, 41, 218, 7,
+			31, 206, 188, 227, 176, 237, 49, 181, 114, 38, 60, 183, 185, 165,
+			64, 210, 67, 20, 131, 218, 244, 112, 66, 158, 196, 28, 29, 233,
+			46, 68, 15, 147, 21, 190, 162, 114, 36, 80, 49, 247, 158, 195,
+			89, 157, 31, 236, 57, 137, 32, 179, 142, 0, 169, 37, 14, 198,
+			212, 53, 52, 226, 95, 179, 203, 181, 131, 26, 83, 32, 135, 176,
+			3, 64, 115, 216, 72, 192, 251, 219, 109, 94, 138, 60, 183, 45,
+			71, 10, 11, 64, 207, 46, 79, 160, 199, 229, 125, 179, 166, 140,
+			56, 77, 40, 59, 162, 227, 216, 219, 118, 3, 140, 72, 248, 220,
+			149, 49, 81, 65, 211, 227, 65, 24, 76, 69, 94, 7, 229, 90,
+			123, 218, 229, 110, 123, 199, 221, 149, 115, 164, 105, 72, 43, 103,
+			16, 10, 72, 255, 130, 215, 86, 203, 135, 95, 53, 38, 216, 75,
+			187, 106, 193, 93, 173, 13, 74, 219, 217, 242, 180, 11, 22, 113,
+			134, 157, 40, 76, 188, 12, 61, 99, 167, 8, 194, 132, 14, 74,
+			252, 88, 249, 69, 116, 99, 111, 163, 219, 38, 226, 136, 224, 85,
+			49, 149, 68, 126, 167, 211, 123, 190, 78, 231, 213, 21, 66, 75,
+			51, 12, 98, 63, 38, 39, 104, 190, 227, 49, 226, 195, 251, 198,
+			180, 119, 114, 185, 219, 142, 195, 50, 247, 96, 155, 195, 225, 211,
+			230, 150, 148, 118, 48, 119, 145, 247, 134, 174, 31, 193, 151, 32,
+			9, 247, 225, 161, 33, 151, 167, 71, 238, 92, 110, 187, 189, 43,
+			207, 85, 221, 32, 209, 94, 9, 82, 48, 193, 152, 155, 110, 112,
+			58, 97, 60, 241, 218, 109, 238, 111, 112, 183, 151, 183, 11, 185,
+			45, 140, 184, 27, 144, 228, 86, 230, 113, 8, 72, 136, 52, 228,
+			76, 168, 249, 100, 123, 7, 1, 194, 88, 6, 54, 102, 246, 208,
+			117, 172, 168, 16, 108, 88, 172, 145, 182, 187, 89, 206, 130, 183,
+			203, 221, 54, 220, 68, 118, 245, 52, 178, 180, 17, 136, 87, 252,
+			153, 222, 219, 226, 207, 104, 222, 201, 108, 226, 90, 58, 153, 67,
+			82, 75, 174, 80, 117, 255, 196, 40, 40, 142, 199, 44, 36, 75,
+			39, 89, 137, 153, 118, 206, 201, 127, 193, 232, 251, 178, 97, 20,
+			143, 244, 152, 222, 212, 104, 96, 105, 131, 28, 243, 5, 88, 218,
+			192, 108, 115, 208, 81, 190, 168, 244, 129, 28, 233, 40, 95, 84,
+			93, 231, 72, 71, 249, 162, 98, 219, 57, 210, 169, 190, 168, 116,
+			148, 28, 233, 40, 95, 84, 58, 74, 14, 202, 194, 151, 20, 219,
+			206, 193, 226, 108, 127, 41, 109, 201, 200, 227, 171, 100, 219, 57,
+			210, 81, 190, 164, 216, 118, 142, 116, 148, 47, 25, 165, 19, 116,
+			204, 150, 119, 242, 255, 221, 232, 251, 7, 166, 81, 60, 154, 29,
+			132, 152, 63, 197, 87, 49, 18, 72, 16, 255, 221, 24, 16, 154,
+			77, 30, 35, 249, 75, 53, 146, 60, 141, 228, 47, 85, 255, 121,
+			26, 201, 95, 170, 145, 228, 105, 36, 127, 169, 70, 146, 167, 145,
+			252, 165, 26, 73, 30, 35, 249, 107, 195, 172, 200, 143, 24, 201,
+			95, 167, 45, 97, 36, 127, 109, 12, 157, 80, 73, 42, 124, 114,
+			82, 37, 45, 36, 203, 83, 178, 37, 211, 177, 191, 102, 152, 170,
+			44, 180, 173, 175, 165, 45, 65, 219, 250, 154, 49, 164, 128, 64,
+			183, 95, 51, 238, 184, 91, 118, 11, 245, 234, 107, 198, 61, 37,
+			246, 179, 16, 48, 243, 86, 159, 147, 127, 139, 105, 190, 205, 180,
+			138, 63, 106, 30, 112, 244, 161, 4, 78, 218, 226, 179, 167, 31,
+			210, 172, 118, 208, 193, 7, 110, 195, 249, 123, 78, 57, 128, 228,
+			3, 143, 56, 246, 156, 112, 192, 156, 233, 41, 119, 92, 177, 98,
+			225, 38, 234, 7, 205, 68, 72, 14, 123, 28, 172, 42, 170, 73,
+			1, 58, 113, 120, 55, 161, 237, 20, 181, 213, 129, 8, 143, 187,
+			205, 45, 245, 137, 24, 150, 219, 233, 68, 97, 39, 242, 221, 68,
+			156, 113, 75, 105, 25, 107, 76, 157, 114, 251, 65, 114, 126, 134,
+			241, 86, 184, 237, 250, 129, 92, 70, 121, 28, 8, 218, 111, 49,
+			217, 49, 118, 39, 203, 219, 121, 28, 226, 57, 246, 223, 51, 247,
+			157, 191, 64, 119, 167, 175, 54, 125, 30, 78, 51, 114, 200, 56,
+			228, 164, 25, 6, 50, 198, 198, 211, 12, 11, 25, 199, 238, 212,
+			61, 192, 42, 110, 218, 199, 110, 209, 3, 8, 233, 173, 217, 30,
+			160, 184, 191, 53, 219, 3, 150, 197, 91, 205, 177, 219, 211, 12,
+			11, 25, 71, 139, 226, 244, 43, 15, 154, 248, 65, 211, 60, 123,
+			240, 233, 215, 45, 73, 160, 247, 195, 222, 51, 22, 6, 153, 19,
+			211, 174, 190, 239, 37, 7, 222, 242, 154, 109, 55, 34, 253, 228,
+			150, 164, 193, 50, 180, 33, 8, 24, 10, 248, 15, 154, 154, 218,
+			173, 60, 146, 67, 119, 170, 164, 129, 228, 93, 247, 169, 36, 141,
+			172, 50, 205, 218, 32, 118, 44, 148, 119, 153, 230, 201, 226, 235,
+			211, 238, 82, 8, 111, 121, 98, 20, 121, 24, 79, 229, 224, 67,
+			33, 118, 208, 169, 144, 232, 28, 74, 235, 187, 82, 72, 237, 28,
+			146, 154, 87, 64, 105, 125, 151, 41, 21, 149, 60, 41, 173, 239,
+			50, 75, 39, 216, 57, 102, 218, 253, 78, 254, 135, 204, 190, 15,
+			152, 123, 253, 195, 8, 147, 74, 139, 150, 172, 75, 114, 45, 40,
+			13, 63, 100, 14, 112, 226, 16, 253, 32, 202, 247, 154, 146, 107,
+			245, 19, 215, 122, 175, 130, 164, 159, 72, 240, 189, 10, 146, 126,
+			226, 90, 239, 53, 37, 215, 234, 39, 242, 123, 175, 41, 185, 86,
+			63, 216, 199, 251, 76, 105, 35, 234, 39, 254, 251, 190, 180, 37,
+			144, 218, 251, 76, 169, 185, 244, 19, 215, 122, 159, 41, 109, 68,
+			253, 68, 102, 239, 51, 165, 141, 168, 31, 86, 172, 23, 76, 115,
+			66, 126, 196, 100, 188, 144, 182, 4, 174, 245, 130, 57, 164, 32,
+			70, 183, 47, 152, 119, 158, 144, 221, 130, 66, 95, 48, 239, 61,
+			205, 78, 50, 211, 30, 112, 242, 63, 108, 246, 253, 67, 211, 40,
+			222, 222, 131, 29, 121, 57, 93, 34, 4, 202, 192, 15, 155, 3,
+			56, 241, 183, 237, 1, 32, 228, 67, 10, 33, 3, 132, 144, 15,
+			169, 206, 7, 8, 33, 31, 82, 8, 25, 32, 132, 124, 72, 33,
+			100, 128, 16, 242, 33, 133, 144, 1, 64, 246, 35, 166, 89, 150,
+			31, 129, 144, 31, 73, 91, 2, 27, 255, 17, 115, 136, 171, 36,
+			21, 190, 231, 180, 74, 90, 72, 158, 185, 79, 182, 4, 99, 178,
+			105, 222, 43, 63, 2, 33, 31, 78, 91, 2, 66, 62, 108, 14,
+			29, 85, 73, 152, 162, 205, 226, 61, 42, 105, 33, 121, 242, 20,
+			59, 203, 76, 123, 208, 201, 255, 184, 217, 247, 143, 77, 163, 88,
+			234, 65, 136, 136, 162, 1, 126, 190, 23, 57, 208, 111, 126, 220,
+			28, 184, 139, 0, 25, 4, 114, 126, 66, 33, 103, 144, 144, 243,
+			19, 10, 144, 65, 66, 206, 79, 40, 228, 12, 18, 114, 126, 66,
+			33, 103, 144, 144, 243, 19, 64, 206, 11, 96, 37, 131, 192, 206,
+			71, 77, 243, 158, 226, 15, 24, 188, 134, 248, 25, 36, 92, 75,
+			55, 76, 108, 178, 180, 122, 112, 104, 211, 216, 242, 98, 143, 20,
+			252, 3, 45, 42, 59, 238, 46, 119, 99, 198, 15, 12, 175, 164,
+			141, 44, 101, 190, 222, 149, 82, 87, 228, 109, 64, 102, 13, 51,
+			242, 153, 220, 212, 5, 160, 152, 168, 143, 166, 163, 2, 229, 126,
+			52, 29, 21, 40, 247, 163, 166, 115, 167, 74, 90, 72, 30, 231,
+			18, 63, 166, 99, 127, 204, 52, 75, 242, 35, 38, 234, 99, 105,
+			75, 102, 14, 73, 221, 18, 48, 240, 49, 211, 185, 75, 37, 45,
+			36, 249, 61, 178, 37, 203, 177, 127, 198, 52, 79, 201, 143, 224,
+			101, 63, 147, 182, 4, 94, 246, 51, 230, 208, 184, 74, 194, 80,
+			109, 30, 229, 42, 73, 117, 79, 156, 100, 85, 66, 180, 237, 216,
+			159, 48, 205, 7, 139, 15, 242, 154, 186, 126, 23, 67, 82, 21,
+			218, 27, 23, 177, 65, 160, 172, 136, 104, 27, 42, 95, 226, 38,
+			86, 125, 130, 73, 125, 34, 5, 1, 76, 234, 19, 166, 148, 10,
+			7, 225, 39, 99, 127, 194, 44, 40, 16, 192, 164, 62, 97, 158,
+			56, 169, 146, 3, 72, 158, 122, 64, 37, 251, 145, 60, 123, 191,
+			4, 48, 231, 216, 63, 119, 16, 128, 160, 68, 47, 218, 15, 160,
+			204, 223, 11, 96, 206, 166, 118, 116, 146, 154, 213, 0, 66, 236,
+			252, 185, 20, 192, 156, 133, 164, 6, 48, 55, 128, 164, 6, 48,
+			215, 143, 228, 217, 251, 217, 207, 142, 48, 211, 102, 78, 254, 223,
+			153, 125, 191, 109, 25, 51, 139, 252, 225, 111, 255, 31, 227, 242,
+			206, 41, 155, 249, 55, 135, 120, 21, 202, 171, 52, 186, 101, 61,
+			34, 233, 244, 155, 182, 202, 45, 247, 166, 214, 169, 226, 18, 119,
+			19, 113, 7, 46, 187, 50, 24, 127, 150, 244, 9, 125, 75, 45,
+			206, 108, 78, 66, 99, 193, 129, 47, 136, 126, 221, 211, 170, 107,
+			139, 199, 109, 92, 238, 105, 239, 242, 150, 15, 119, 122, 47, 72,
+			176, 79, 133, 145, 168, 39, 172, 89, 124, 203, 135, 142, 15, 109,
+			77, 180, 1, 101, 127, 219, 13, 252, 78, 23, 190, 237, 113, 106,
+			46, 82, 19, 2, 233, 76, 121, 0, 160, 161, 131, 61, 0, 220,
+			120, 159, 7, 128, 199, 207, 72, 212, 104, 234, 35, 213, 17, 235,
+			63, 83, 86, 237, 184, 187, 30, 237, 186, 210, 138, 32, 28, 177,
+			125, 152, 46, 124, 120, 57, 199, 225, 30, 45, 141, 132, 4, 156,
+			190, 123, 169, 133, 83, 9, 27, 219, 48, 219, 6, 113, 226, 185,
+			45, 200, 125, 210, 129, 34, 217, 242, 182, 81, 64, 235, 116, 65,
+			15, 144, 169, 5, 187, 233, 182, 219, 94, 139, 31, 116, 17, 88,
+			42, 148, 25, 27, 6, 205, 167, 102, 95, 242, 220, 161, 25, 133,
+			113, 76, 166, 129, 253, 40, 224, 143, 19, 196, 1, 4, 231, 172,
+			69, 4, 7, 163, 161, 152, 5, 124, 103, 89, 28, 237, 192, 236,
+			179, 227, 241, 245, 174, 223, 110, 113, 55, 99, 202, 40, 115, 55,
+			145, 212, 209, 9, 253, 32, 161, 78, 105, 14, 99, 1, 218, 186,
+			231, 5, 140, 144, 41, 207, 115, 227, 144, 202, 100, 90, 7, 51,
+			38, 180, 99, 170, 245, 189, 85, 125, 236, 78, 180, 210, 59, 219,
+			242, 170, 85, 115, 43, 140, 97, 224, 138, 229, 69, 177, 248, 34,
+			227, 103, 72, 203, 87, 5, 5, 100, 59, 56, 0, 86, 174, 52,
+			16, 170, 180, 44, 3, 3, 199, 102, 219, 227, 110, 167, 211, 150,
+			151, 245, 120, 24, 225, 82, 88, 24, 109, 186, 129, 255, 188, 188,
+			191, 23, 70, 100, 96, 240, 158, 235, 120, 145, 79, 71, 204, 109,
+			5, 76, 153, 16, 41, 157, 127, 36, 200, 247, 159, 61, 123, 246,
+			44, 90, 73, 182, 34, 178, 22, 60, 132, 127, 202, 152, 47, 79,
+			30, 118, 195, 46, 150, 144, 23, 196, 221, 72, 46, 4, 100, 73,
+			93, 92, 42, 222, 104, 68, 204, 44, 53, 77, 80, 104, 214, 37,
+			33, 168, 188, 200, 168, 215, 165, 95, 121, 188, 37, 209, 79, 195,
+			167, 172, 38, 240, 177, 171, 25, 33, 122, 130, 41, 90, 248, 153,
+			39, 164, 141, 36, 62, 36, 83, 111, 106, 27, 38, 26, 117, 23,
+			116, 106, 179, 29, 174, 187, 237, 41, 61, 131, 83, 145, 183, 137,
+			59, 117, 187, 153, 235, 55, 104, 13, 23, 2, 132, 188, 155, 210,
+			146, 66, 81, 133, 175, 224, 184, 126, 87, 93, 208, 227, 187, 97,
+			55, 66, 226, 89, 175, 153, 208, 102, 45, 46, 169, 160, 157, 165,
+			117, 100, 250, 55, 189, 169, 57, 222, 105, 119, 55, 253, 96, 146,
+			134, 210, 83, 101, 199, 91, 143, 253, 196, 227, 19, 176, 154, 220,
+			116, 253, 54, 78, 251, 39, 165, 203, 111, 228, 193, 224, 27, 162,
+			49, 58, 193, 3, 218, 159, 235, 180, 113, 79, 114, 43, 220, 65,
+			223, 116, 215, 20, 7, 79, 161, 92, 23, 222, 118, 133, 175, 198,
+			93, 178, 216, 224, 59, 145, 15, 85, 14, 3, 15, 13, 237, 29,
+			82, 133, 28, 152, 133, 143, 18, 20, 12, 111, 223, 60, 201, 75,
+			162, 104, 40, 12, 50, 56, 65, 107, 114, 122, 97, 47, 239, 38,
+			137, 180, 94, 73, 118, 17, 119, 215, 167, 228, 242, 21, 190, 76,
+			88, 238, 115, 98, 69, 168, 229, 29, 3, 67, 184, 219, 180, 129,
+			214, 228, 245, 200, 88, 18, 173, 11, 199, 8, 44, 14, 254, 205,
+			94, 134, 69, 35, 34, 247, 132, 28, 4, 26, 169, 73, 171, 127,
+			210, 141, 192, 108, 33, 98, 209, 86, 32, 248, 71, 84, 230, 46,
+			223, 241, 214, 149, 236, 167, 9, 17, 230, 165, 110, 71, 82, 134,
+			219, 77, 194, 109, 55, 129, 239, 82, 27, 34, 23, 76, 194, 18,
+			81, 18, 25, 177, 20, 26, 97, 34, 250, 119, 230, 192, 152, 240,
+			61, 103, 144, 26, 127, 223, 52, 79, 20, 255, 139, 193, 87, 96,
+			144, 197, 96, 31, 117, 111, 186, 92, 70, 39, 226, 59, 240, 70,
+			227, 20, 49, 201, 139, 51, 251, 147, 60, 2, 240, 99, 101, 21,
+			148, 128, 49, 222, 105, 187, 77, 218, 3, 47, 239, 170, 115, 166,
+			114, 198, 61, 91, 53, 140, 165, 27, 227, 84, 113, 189, 155, 218,
+			9, 194, 141, 4, 28, 206, 15, 50, 170, 189, 54, 157, 245, 212,
+			215, 118, 182, 0, 190, 38, 32, 44, 58, 69, 21, 100, 177, 238,
+			54, 111, 236, 184, 81, 43, 86, 154, 191, 148, 88, 133, 0, 194,
+			72, 58, 254, 125, 37, 143, 48, 146, 142, 127, 95, 73, 127, 140,
+			164, 227, 223, 55, 157, 187, 85, 210, 2, 142, 238, 41, 177, 47,
+			194, 218, 194, 32, 27, 126, 206, 52, 239, 43, 126, 206, 228, 115,
+			97, 144, 68, 97, 123, 255, 233, 227, 14, 174, 57, 121, 145, 64,
+			37, 33, 47, 139, 58, 121, 253, 181, 178, 199, 95, 221, 77, 4,
+			158, 197, 28, 43, 11, 164, 220, 234, 247, 84, 56, 141, 185, 72,
+			82, 153, 122, 98, 82, 105, 10, 16, 184, 119, 188, 118, 27, 156,
+			28, 118, 232, 244, 86, 135, 94, 35, 177, 220, 247, 91, 124, 127,
+			195, 21, 134, 227, 144, 3, 162, 192, 73, 51, 14, 56, 129, 58,
+			10, 198, 214, 3, 184, 196, 157, 56, 69, 35, 234, 112, 136, 101,
+			155, 213, 116, 43, 207, 156, 253, 32, 6, 163, 66, 109, 185, 107,
+			40, 148, 81, 180, 58, 129, 8, 41, 247, 51, 210, 88, 63, 151,
+			206, 23, 228, 254, 207, 165, 243, 101, 208, 140, 56, 247, 170, 164,
+			133, 228, 228, 25, 246, 83, 22, 205, 151, 233, 216, 127, 108, 154,
+			151, 138, 47, 88, 24, 153, 23, 100, 71, 160, 201, 157, 228, 39,
+			57, 69, 184, 32, 12, 104, 213, 140, 129, 107, 120, 29, 88, 57,
+			60, 94, 1, 102, 224, 23, 220, 198, 197, 238, 136, 211, 1, 71,
+			138, 98, 201, 89, 202, 210, 160, 12, 198, 170, 22, 238, 129, 40,
+			103, 210, 152, 206, 27, 91, 93, 58, 204, 134, 60, 165, 206, 227,
+			33, 113, 4, 97, 114, 230, 96, 180, 41, 124, 17, 166, 196, 129,
+			123, 11, 91, 208, 65, 33, 255, 42, 60, 117, 240, 218, 87, 87,
+			12, 86, 57, 118, 177, 12, 161, 38, 97, 15, 249, 189, 20, 221,
+			101, 201, 142, 189, 44, 186, 147, 119, 138, 196, 188, 65, 37, 251,
+			227, 116, 146, 161, 146, 253, 177, 210, 98, 24, 233, 206, 127, 108,
+			22, 78, 168, 164, 133, 175, 247, 78, 168, 175, 3, 72, 78, 94,
+			84, 201, 126, 36, 47, 60, 196, 38, 137, 2, 44, 199, 254, 83,
+			211, 172, 22, 143, 9, 129, 79, 50, 70, 156, 217, 128, 131, 108,
+			9, 175, 38, 81, 19, 218, 220, 159, 166, 64, 88, 57, 36, 53,
+			16, 112, 131, 252, 83, 179, 48, 165, 146, 212, 240, 217, 25, 149,
+			28, 64, 242, 252, 60, 185, 17, 49, 211, 194, 217, 229, 159, 154,
+			23, 230, 216, 127, 21, 140, 195, 118, 236, 175, 152, 230, 107, 138,
+			255, 47, 83, 30, 92, 235, 147, 190, 12, 37, 206, 188, 56, 41,
+			106, 57, 31, 55, 221, 163, 112, 7, 107, 156, 123, 207, 53, 61,
+			49, 38, 200, 150, 152, 102, 228, 186, 73, 226, 109, 119, 72, 70,
+			218, 118, 81, 43, 84, 59, 131, 75, 135, 80, 171, 141, 43, 83,
+			175, 98, 116, 170, 207, 99, 239, 13, 93, 58, 33, 196, 102, 2,
+			181, 14, 155, 38, 137, 97, 21, 198, 101, 136, 183, 204, 125, 79,
+			1, 84, 43, 212, 170, 126, 133, 165, 4, 230, 102, 252, 135, 165,
+			56, 44, 136, 11, 49, 220, 58, 73, 156, 118, 222, 219, 119, 92,
+			97, 61, 243, 179, 229, 162, 40, 247, 54, 54, 32, 197, 200, 193,
+			165, 18, 39, 245, 221, 134, 148, 34, 239, 44, 233, 57, 132, 58,
+			252, 149, 116, 14, 161, 14, 127, 37, 157, 67, 168, 195, 95, 49,
+			11, 138, 91, 64, 29, 254, 138, 57, 121, 159, 74, 14, 32, 89,
+			126, 88, 37, 251, 145, 124, 240, 213, 108, 1, 51, 8, 223, 227,
+			191, 54, 205, 255, 97, 90, 197, 87, 115, 29, 163, 68, 51, 62,
+			121, 134, 118, 208, 149, 89, 165, 161, 197, 254, 243, 154, 224, 201,
+			115, 249, 175, 205, 254, 49, 54, 15, 122, 177, 201, 10, 253, 85,
+			211, 30, 41, 93, 208, 141, 167, 183, 73, 105, 226, 209, 160, 84,
+			165, 202, 144, 8, 124, 183, 173, 164, 106, 97, 75, 102, 210, 85,
+			249, 171, 166, 61, 152, 102, 152, 142, 253, 85, 115, 248, 16, 187,
+			42, 251, 49, 28, 251, 111, 76, 219, 41, 230, 197, 133, 207, 210,
+			52, 60, 253, 120, 26, 103, 100, 169, 19, 131, 90, 180, 11, 168,
+			228, 75, 98, 131, 81, 94, 209, 76, 250, 56, 255, 141, 105, 31,
+			74, 51, 76, 100, 20, 70, 117, 87, 166, 99, 127, 221, 180, 111,
+			43, 61, 152, 29, 82, 11, 138, 3, 40, 76, 82, 214, 2, 102,
+			81, 133, 169, 216, 51, 165, 170, 101, 172, 255, 175, 155, 118, 65,
+			119, 5, 83, 228, 215, 205, 177, 35, 100, 148, 97, 224, 22, 223,
+			48, 205, 75, 114, 222, 96, 112, 248, 70, 74, 2, 185, 60, 146,
+			242, 136, 137, 225, 62, 139, 253, 13, 115, 252, 164, 74, 90, 248,
+			122, 90, 241, 18, 24, 28, 190, 145, 242, 18, 24, 28, 190, 97,
+			94, 120, 136, 125, 78, 172, 226, 188, 99, 191, 205, 50, 75, 197,
+			127, 107, 166, 18, 211, 213, 84, 172, 17, 242, 82, 156, 68, 20,
+			155, 226, 155, 145, 151, 106, 27, 60, 220, 70, 92, 138, 86, 121,
+			95, 163, 114, 243, 204, 134, 186, 232, 141, 233, 65, 242, 232, 20,
+			20, 114, 190, 238, 198, 94, 86, 22, 81, 141, 136, 163, 82, 222,
+			113, 147, 173, 50, 108, 56, 82, 89, 104, 81, 216, 142, 169, 172,
+			175, 71, 182, 86, 156, 184, 137, 32, 2, 201, 179, 37, 248, 96,
+			221, 178, 25, 58, 116, 57, 184, 149, 189, 176, 244, 84, 86, 119,
+			15, 53, 183, 208, 43, 24, 238, 65, 111, 179, 244, 244, 229, 115,
+			72, 234, 253, 30, 135, 123, 111, 179, 164, 157, 143, 145, 123, 208,
+			219, 172, 227, 247, 176, 127, 103, 211, 4, 245, 59, 246, 251, 44,
+			243, 82, 241, 95, 218, 124, 69, 56, 45, 203, 200, 167, 92, 69,
+			62, 237, 181, 180, 248, 129, 216, 197, 17, 167, 167, 235, 110, 122,
+			143, 112, 94, 146, 145, 80, 75, 186, 10, 131, 45, 7, 187, 69,
+			26, 74, 4, 172, 50, 216, 133, 54, 158, 248, 77, 136, 234, 188,
+			190, 60, 199, 227, 221, 56, 129, 186, 131, 169, 216, 165, 74, 105,
+			79, 116, 243, 25, 222, 17, 208, 149, 122, 217, 124, 188, 15, 12,
+			62, 161, 240, 227, 182, 90, 62, 150, 165, 219, 150, 26, 27, 34,
+			10, 240, 171, 123, 7, 181, 227, 73, 243, 9, 105, 87, 55, 224,
+			249, 23, 110, 168, 207, 170, 39, 48, 214, 212, 1, 156, 78, 250,
+			220, 168, 189, 171, 92, 158, 99, 76, 148, 212, 97, 148, 138, 90,
+			97, 7, 116, 134, 129, 5, 225, 14, 228, 3, 8, 114, 145, 215,
+			202, 186, 154, 251, 1, 223, 112, 111, 194, 116, 176, 33, 87, 186,
+			4, 28, 187, 150, 155, 241, 208, 32, 36, 100, 49, 42, 212, 208,
+			91, 163, 52, 242, 54, 194, 200, 43, 51, 201, 146, 180, 31, 91,
+			200, 201, 235, 13, 30, 146, 116, 121, 182, 229, 73, 197, 93, 232,
+			224, 112, 247, 218, 79, 6, 194, 167, 29, 198, 25, 24, 44, 124,
+			4, 129, 160, 93, 25, 202, 34, 185, 31, 116, 83, 233, 4, 238,
+			94, 239, 75, 73, 178, 31, 135, 38, 150, 222, 84, 250, 113, 104,
+			98, 105, 233, 4, 238, 94, 239, 179, 180, 116, 210, 63, 128, 164,
+			230, 40, 253, 68, 161, 23, 30, 146, 156, 107, 192, 177, 223, 111,
+			153, 106, 199, 129, 119, 212, 251, 211, 126, 6, 112, 121, 33, 237,
+			7, 7, 34, 239, 183, 10, 167, 84, 210, 66, 114, 226, 140, 74,
+			82, 83, 247, 189, 90, 37, 251, 145, 124, 224, 146, 236, 103, 208,
+			177, 95, 176, 52, 135, 132, 239, 212, 11, 105, 63, 131, 57, 36,
+			117, 63, 56, 91, 120, 33, 29, 15, 124, 167, 94, 72, 199, 51,
+			56, 128, 164, 30, 207, 96, 63, 146, 122, 60, 204, 177, 63, 96,
+			153, 10, 8, 102, 83, 82, 245, 195, 114, 72, 234, 126, 160, 142,
+			126, 192, 42, 40, 78, 12, 143, 133, 15, 88, 167, 39, 85, 114,
+			0, 201, 51, 10, 100, 134, 75, 22, 214, 253, 23, 217, 151, 133,
+			238, 58, 228, 216, 63, 106, 153, 51, 197, 63, 52, 120, 45, 86,
+			214, 183, 182, 151, 33, 196, 71, 24, 23, 79, 254, 96, 179, 33,
+			247, 55, 143, 39, 110, 180, 233, 37, 208, 82, 19, 92, 83, 144,
+			142, 86, 216, 187, 189, 109, 63, 225, 243, 186, 114, 214, 102, 203,
+			104, 235, 133, 124, 179, 75, 242, 98, 86, 128, 45, 195, 149, 198,
+			79, 52, 147, 86, 91, 54, 188, 83, 54, 131, 48, 242, 90, 151,
+			84, 113, 212, 103, 188, 237, 185, 177, 242, 99, 38, 95, 20, 192,
+			161, 119, 114, 234, 73, 13, 1, 93, 201, 112, 38, 18, 9, 67,
+			54, 141, 90, 225, 115, 40, 135, 164, 198, 231, 144, 129, 100, 161,
+			168, 146, 22, 146, 119, 29, 87, 201, 1, 36, 249, 57, 149, 236,
+			71, 242, 190, 179, 236, 123, 8, 157, 195, 142, 253, 17, 203, 124,
+			176, 248, 6, 94, 37, 29, 41, 86, 206, 67, 88, 197, 240, 244,
+			117, 99, 173, 186, 18, 111, 56, 56, 78, 139, 180, 170, 146, 17,
+			208, 35, 151, 50, 17, 91, 69, 173, 249, 86, 170, 40, 134, 17,
+			156, 152, 245, 208, 134, 109, 130, 64, 39, 115, 72, 234, 161, 225,
+			58, 197, 71, 44, 121, 74, 192, 204, 97, 11, 201, 19, 106, 37,
+			12, 15, 32, 121, 239, 3, 42, 217, 143, 228, 185, 251, 217, 123,
+			4, 169, 28, 114, 236, 159, 182, 204, 201, 226, 91, 50, 102, 142,
+			80, 89, 194, 120, 83, 42, 66, 34, 34, 180, 100, 28, 62, 204,
+			231, 68, 60, 66, 19, 130, 178, 155, 169, 194, 14, 26, 208, 158,
+			61, 30, 168, 0, 83, 38, 223, 38, 197, 171, 244, 112, 15, 217,
+			4, 148, 78, 230, 144, 212, 155, 28, 238, 130, 252, 180, 229, 168,
+			21, 120, 200, 66, 242, 222, 9, 86, 163, 241, 140, 56, 246, 199,
+			45, 115, 162, 120, 137, 235, 184, 210, 132, 206, 125, 48, 93, 82,
+			221, 198, 202, 63, 91, 110, 236, 26, 140, 17, 155, 218, 210, 201,
+			28, 146, 26, 12, 92, 48, 249, 184, 229, 148, 84, 210, 66, 242,
+			212, 105, 225, 218, 204, 204, 195, 142, 253, 115, 150, 112, 109, 78,
+			173, 61, 124, 5, 33, 183, 21, 126, 176, 183, 209, 210, 160, 27,
+			95, 251, 237, 64, 144, 251, 230, 148, 147, 40, 57, 125, 68, 30,
+			68, 34, 144, 254, 233, 202, 105, 97, 208, 233, 6, 45, 47, 138,
+			155, 56, 32, 80, 1, 228, 104, 35, 129, 157, 93, 76, 154, 242,
+			249, 142, 167, 227, 221, 237, 245, 176, 29, 51, 165, 108, 74, 207,
+			205, 36, 85, 45, 104, 217, 41, 241, 7, 11, 209, 219, 21, 171,
+			87, 91, 241, 165, 223, 173, 56, 118, 96, 47, 214, 141, 238, 69,
+			33, 240, 176, 77, 56, 209, 201, 28, 146, 26, 159, 184, 126, 243,
+			115, 150, 182, 45, 29, 198, 89, 151, 117, 207, 9, 114, 115, 103,
+			102, 193, 177, 127, 1, 211, 218, 73, 169, 180, 179, 213, 121, 185,
+			212, 137, 162, 251, 8, 128, 29, 64, 149, 243, 114, 158, 16, 12,
+			98, 187, 147, 236, 106, 90, 40, 224, 206, 71, 10, 123, 33, 135,
+			164, 134, 29, 247, 125, 126, 33, 165, 133, 2, 238, 124, 88, 167,
+			78, 179, 95, 21, 107, 108, 212, 177, 127, 217, 50, 79, 21, 127,
+			214, 32, 117, 34, 131, 111, 0, 40, 35, 15, 42, 67, 25, 133,
+			66, 7, 99, 57, 16, 104, 13, 34, 75, 97, 220, 55, 139, 250,
+			83, 175, 192, 138, 214, 53, 47, 38, 109, 113, 3, 135, 16, 45,
+			47, 65, 176, 55, 29, 43, 72, 3, 161, 7, 63, 106, 211, 0,
+			116, 50, 135, 164, 30, 60, 110, 40, 253, 178, 37, 93, 61, 152,
+			57, 106, 33, 89, 58, 201, 254, 149, 24, 188, 227, 216, 191, 106,
+			153, 149, 226, 175, 124, 27, 131, 87, 113, 228, 53, 22, 152, 66,
+			195, 55, 129, 5, 45, 95, 247, 32, 130, 220, 195, 91, 114, 107,
+			121, 41, 68, 56, 54, 13, 70, 39, 115, 72, 106, 68, 56, 6,
+			146, 142, 146, 5, 28, 11, 201, 251, 166, 216, 175, 11, 68, 140,
+			57, 246, 191, 0, 71, 248, 228, 75, 33, 66, 205, 23, 220, 148,
+			186, 235, 187, 223, 6, 17, 72, 111, 203, 111, 137, 12, 16, 117,
+			95, 213, 209, 8, 24, 195, 109, 143, 20, 1, 99, 184, 237, 145,
+			34, 96, 12, 183, 61, 210, 37, 60, 134, 219, 30, 88, 194, 111,
+			162, 241, 31, 113, 236, 255, 167, 101, 94, 44, 6, 223, 210, 173,
+			111, 166, 143, 36, 122, 3, 50, 170, 125, 183, 36, 143, 40, 74,
+			250, 140, 34, 123, 69, 156, 153, 71, 108, 234, 94, 39, 243, 72,
+			202, 43, 226, 204, 60, 98, 32, 41, 175, 136, 51, 243, 136, 133,
+			228, 3, 15, 177, 239, 55, 152, 101, 67, 50, 251, 13, 203, 60,
+			90, 124, 254, 219, 190, 35, 254, 173, 143, 130, 36, 85, 92, 40,
+			255, 13, 75, 94, 40, 103, 57, 152, 73, 126, 195, 58, 36, 209,
+			79, 23, 202, 127, 195, 186, 109, 156, 28, 132, 216, 160, 99, 255,
+			150, 101, 142, 8, 209, 115, 176, 15, 169, 161, 67, 162, 228, 96,
+			159, 209, 155, 52, 101, 114, 152, 153, 246, 144, 147, 255, 172, 213,
+			247, 67, 182, 65, 205, 64, 108, 250, 172, 53, 112, 59, 251, 189,
+			28, 179, 237, 33, 24, 98, 254, 208, 50, 95, 83, 252, 76, 14,
+			140, 152, 20, 129, 204, 97, 86, 234, 103, 127, 78, 89, 48, 80,
+			42, 123, 243, 117, 163, 231, 150, 146, 50, 108, 101, 118, 29, 20,
+			76, 143, 41, 166, 32, 51, 186, 137, 191, 238, 83, 180, 29, 109,
+			222, 218, 211, 58, 147, 205, 87, 56, 29, 143, 201, 43, 185, 233,
+			1, 39, 4, 224, 48, 202, 132, 169, 19, 247, 216, 47, 226, 168,
+			244, 116, 204, 113, 11, 129, 193, 158, 230, 55, 225, 1, 80, 166,
+			219, 34, 27, 222, 142, 23, 241, 13, 207, 77, 186, 145, 23, 195,
+			122, 215, 194, 114, 219, 198, 86, 11, 168, 232, 36, 215, 107, 237,
+			9, 205, 167, 253, 231, 229, 238, 199, 189, 231, 92, 10, 162, 215,
+			115, 124, 204, 117, 241, 43, 97, 200, 223, 8, 11, 1, 151, 36,
+			164, 190, 236, 125, 37, 130, 63, 76, 216, 190, 36, 202, 102, 104,
+			236, 2, 38, 96, 219, 125, 142, 190, 188, 185, 215, 199, 52, 5,
+			171, 73, 50, 187, 56, 44, 199, 225, 135, 2, 143, 204, 144, 241,
+			165, 204, 116, 197, 210, 243, 143, 138, 102, 167, 138, 241, 217, 222,
+			19, 245, 112, 3, 103, 154, 17, 185, 23, 235, 113, 67, 169, 37,
+			157, 95, 246, 28, 95, 18, 49, 213, 72, 150, 80, 30, 13, 235,
+			210, 255, 20, 199, 190, 65, 119, 59, 38, 77, 97, 175, 239, 63,
+			68, 123, 126, 89, 30, 113, 105, 157, 64, 29, 223, 149, 211, 152,
+			175, 60, 217, 161, 51, 224, 36, 242, 155, 210, 79, 67, 154, 36,
+			60, 196, 96, 106, 74, 125, 94, 239, 2, 61, 215, 132, 4, 71,
+			24, 162, 147, 175, 63, 84, 12, 98, 136, 78, 190, 254, 80, 201,
+			216, 67, 100, 58, 252, 67, 171, 112, 90, 37, 45, 172, 131, 51,
+			247, 169, 228, 0, 146, 210, 54, 58, 100, 246, 245, 35, 249, 224,
+			171, 217, 127, 3, 231, 31, 130, 129, 238, 143, 44, 243, 74, 241,
+			63, 25, 124, 222, 143, 83, 13, 66, 50, 246, 204, 13, 54, 245,
+			192, 7, 47, 165, 190, 15, 19, 147, 37, 174, 30, 249, 80, 254,
+			155, 77, 55, 160, 176, 176, 27, 109, 31, 135, 210, 88, 21, 202,
+			223, 35, 220, 232, 245, 211, 80, 183, 183, 64, 186, 30, 174, 3,
+			16, 181, 220, 240, 210, 224, 105, 82, 16, 146, 235, 214, 115, 99,
+			223, 139, 46, 241, 192, 219, 145, 150, 89, 177, 152, 220, 155, 161,
+			175, 200, 69, 158, 169, 100, 128, 44, 73, 70, 59, 68, 39, 82,
+			127, 148, 226, 17, 39, 82, 127, 148, 226, 17, 118, 209, 63, 178,
+			10, 211, 42, 105, 33, 57, 115, 94, 37, 7, 144, 188, 80, 85,
+			201, 126, 36, 31, 153, 103, 127, 38, 240, 104, 58, 246, 159, 91,
+			230, 185, 226, 255, 55, 85, 107, 37, 169, 188, 114, 154, 109, 102,
+			13, 125, 147, 234, 172, 212, 102, 217, 203, 86, 103, 101, 55, 74,
+			163, 29, 66, 180, 41, 251, 207, 83, 84, 194, 146, 251, 231, 41,
+			42, 65, 86, 127, 174, 52, 218, 33, 114, 197, 251, 115, 235, 174,
+			187, 85, 114, 0, 95, 143, 159, 85, 201, 126, 36, 207, 76, 11,
+			166, 62, 232, 216, 95, 180, 204, 49, 218, 27, 134, 176, 55, 124,
+			209, 26, 26, 22, 37, 105, 111, 200, 38, 77, 153, 20, 101, 233,
+			227, 136, 4, 97, 208, 216, 147, 52, 101, 82, 148, 165, 212, 168,
+			35, 63, 154, 70, 111, 82, 125, 253, 135, 121, 20, 6, 244, 63,
+			96, 155, 119, 23, 223, 157, 231, 143, 103, 110, 232, 72, 156, 200,
+			149, 223, 123, 154, 159, 202, 69, 219, 110, 7, 142, 36, 209, 174,
+			96, 69, 114, 222, 96, 78, 236, 72, 191, 35, 125, 93, 73, 231,
+			8, 71, 5, 142, 50, 175, 126, 173, 183, 219, 216, 237, 120, 101,
+			78, 97, 241, 241, 243, 53, 200, 95, 163, 130, 252, 97, 126, 238,
+			18, 75, 133, 150, 86, 246, 138, 85, 59, 12, 111, 32, 182, 244,
+			13, 79, 53, 39, 1, 190, 238, 118, 200, 185, 147, 30, 203, 81,
+			28, 62, 203, 229, 213, 195, 58, 189, 124, 61, 45, 225, 182, 185,
+			4, 139, 223, 240, 118, 37, 16, 251, 138, 104, 128, 165, 247, 244,
+			195, 124, 70, 22, 123, 179, 248, 163, 153, 106, 47, 64, 123, 70,
+			199, 120, 109, 79, 84, 11, 248, 62, 53, 183, 194, 48, 22, 140,
+			52, 99, 166, 16, 243, 162, 192, 127, 24, 155, 146, 26, 53, 185,
+			44, 144, 68, 205, 93, 30, 136, 8, 37, 152, 27, 191, 103, 25,
+			106, 59, 110, 18, 242, 173, 16, 215, 254, 182, 104, 140, 152, 98,
+			117, 245, 66, 134, 169, 203, 28, 172, 205, 46, 215, 200, 156, 66,
+			119, 29, 252, 61, 208, 138, 99, 52, 229, 116, 67, 177, 85, 113,
+			218, 239, 111, 72, 91, 148, 190, 220, 180, 119, 143, 73, 169, 99,
+			113, 169, 81, 189, 168, 34, 72, 74, 99, 167, 196, 243, 190, 8,
+			186, 124, 86, 184, 32, 40, 177, 135, 232, 12, 247, 160, 220, 231,
+			152, 210, 141, 201, 196, 161, 26, 144, 60, 84, 57, 135, 249, 219,
+			61, 102, 213, 245, 221, 140, 134, 162, 54, 38, 233, 205, 150, 110,
+			80, 56, 128, 253, 1, 91, 115, 3, 28, 192, 254, 128, 173, 185,
+			1, 14, 96, 127, 192, 46, 28, 85, 73, 90, 79, 119, 222, 197,
+			78, 202, 245, 254, 14, 219, 60, 84, 186, 131, 206, 192, 113, 142,
+			180, 166, 207, 200, 214, 219, 20, 60, 21, 11, 214, 114, 236, 119,
+			216, 122, 229, 91, 70, 111, 210, 148, 201, 227, 178, 197, 31, 68,
+			139, 14, 181, 24, 184, 65, 184, 230, 198, 107, 104, 89, 53, 134,
+			139, 12, 105, 109, 219, 232, 77, 154, 50, 137, 128, 80, 67, 224,
+			115, 239, 178, 191, 115, 1, 161, 134, 16, 79, 201, 126, 87, 138,
+			43, 59, 143, 14, 164, 180, 63, 100, 2, 152, 119, 217, 82, 218,
+			31, 18, 151, 19, 108, 25, 16, 10, 102, 195, 247, 216, 175, 76,
+			64, 168, 33, 200, 239, 239, 177, 165, 252, 62, 68, 242, 251, 123,
+			108, 41, 191, 15, 145, 252, 254, 30, 251, 54, 196, 5, 51, 237,
+			97, 39, 255, 62, 187, 239, 107, 82, 12, 135, 137, 239, 125, 246,
+			192, 17, 246, 227, 176, 46, 13, 67, 12, 255, 160, 109, 86, 138,
+			239, 53, 9, 99, 77, 98, 123, 154, 88, 213, 25, 27, 72, 115,
+			238, 190, 251, 246, 158, 155, 75, 121, 221, 77, 29, 104, 217, 45,
+			46, 212, 75, 151, 205, 45, 55, 224, 126, 198, 159, 104, 7, 196,
+			92, 225, 90, 151, 81, 199, 20, 234, 178, 94, 204, 215, 189, 118,
+			184, 163, 36, 15, 5, 87, 172, 29, 96, 245, 242, 77, 29, 30,
+			194, 142, 167, 222, 61, 0, 52, 216, 59, 61, 62, 53, 197, 227,
+			48, 138, 118, 203, 124, 199, 59, 13, 67, 25, 56, 124, 40, 46,
+			62, 181, 60, 192, 68, 158, 107, 27, 93, 136, 232, 234, 164, 230,
+			30, 49, 235, 195, 36, 209, 125, 80, 17, 193, 48, 130, 23, 219,
+			31, 180, 229, 109, 142, 97, 146, 232, 62, 104, 203, 56, 29, 195,
+			228, 233, 255, 65, 155, 2, 10, 82, 225, 1, 96, 248, 216, 148,
+			74, 246, 35, 121, 111, 153, 130, 174, 12, 227, 180, 251, 135, 109,
+			243, 35, 182, 8, 186, 50, 108, 163, 173, 31, 182, 251, 135, 217,
+			113, 150, 183, 135, 113, 176, 236, 216, 63, 98, 219, 135, 139, 135,
+			181, 157, 98, 155, 162, 143, 210, 25, 237, 176, 60, 138, 254, 17,
+			219, 206, 100, 152, 200, 56, 52, 66, 46, 18, 200, 192, 141, 8,
+			91, 30, 32, 15, 203, 19, 229, 15, 219, 246, 64, 154, 129, 11,
+			22, 88, 97, 170, 134, 233, 216, 63, 102, 203, 48, 59, 195, 242,
+			96, 248, 199, 108, 121, 48, 60, 44, 15, 134, 127, 204, 30, 59,
+			194, 126, 95, 80, 17, 110, 5, 216, 230, 177, 226, 191, 54, 229,
+			186, 163, 251, 207, 114, 186, 228, 153, 189, 244, 10, 34, 245, 73,
+			51, 207, 78, 132, 176, 66, 224, 238, 82, 30, 132, 167, 35, 21,
+			100, 184, 160, 0, 13, 73, 43, 83, 251, 72, 75, 204, 55, 52,
+			155, 10, 175, 187, 114, 167, 119, 3, 189, 83, 65, 247, 216, 137,
+			124, 229, 157, 72, 145, 8, 85, 196, 147, 212, 179, 200, 19, 187,
+			85, 57, 123, 165, 205, 141, 34, 119, 23, 116, 38, 163, 107, 209,
+			21, 11, 87, 185, 81, 181, 247, 198, 68, 90, 111, 135, 235, 21,
+			94, 83, 55, 205, 17, 173, 163, 189, 171, 143, 188, 192, 153, 9,
+			6, 186, 173, 207, 196, 41, 154, 116, 64, 35, 81, 88, 30, 223,
+			137, 75, 227, 153, 200, 61, 68, 18, 36, 6, 127, 52, 37, 62,
+			136, 193, 31, 85, 220, 122, 152, 110, 206, 124, 212, 46, 40, 226,
+			195, 85, 162, 143, 130, 248, 62, 8, 45, 123, 24, 243, 244, 139,
+			182, 121, 190, 248, 131, 57, 154, 24, 241, 114, 156, 90, 71, 202,
+			80, 35, 149, 8, 56, 105, 173, 144, 20, 46, 132, 31, 109, 206,
+			146, 190, 226, 161, 140, 130, 32, 111, 179, 103, 119, 37, 63, 206,
+			188, 22, 66, 131, 7, 122, 31, 184, 192, 215, 105, 101, 37, 222,
+			102, 228, 182, 105, 83, 222, 240, 159, 83, 225, 80, 24, 159, 240,
+			131, 228, 129, 11, 101, 222, 149, 127, 99, 249, 151, 10, 225, 67,
+			44, 127, 77, 86, 56, 159, 149, 76, 132, 116, 20, 57, 16, 253,
+			156, 27, 25, 49, 53, 125, 64, 252, 142, 179, 227, 17, 222, 54,
+			74, 231, 33, 5, 36, 230, 109, 248, 156, 195, 66, 24, 121, 77,
+			31, 235, 93, 58, 253, 128, 94, 183, 224, 188, 37, 221, 97, 92,
+			222, 134, 184, 33, 229, 34, 136, 251, 34, 2, 131, 180, 10, 243,
+			141, 118, 40, 196, 110, 225, 80, 158, 118, 91, 97, 124, 133, 24,
+			218, 46, 190, 234, 183, 230, 180, 54, 32, 7, 65, 106, 105, 143,
+			30, 231, 181, 178, 192, 203, 187, 11, 76, 11, 9, 153, 111, 37,
+			225, 122, 90, 82, 49, 111, 48, 39, 235, 222, 150, 123, 19, 79,
+			112, 72, 222, 171, 142, 12, 196, 172, 51, 174, 159, 196, 3, 206,
+			122, 229, 31, 29, 45, 59, 169, 176, 94, 79, 33, 63, 115, 7,
+			56, 148, 147, 157, 61, 53, 71, 255, 177, 116, 171, 117, 91, 100,
+			243, 135, 178, 206, 232, 164, 187, 178, 237, 38, 91, 149, 90, 144,
+			120, 155, 90, 79, 30, 38, 165, 228, 23, 83, 194, 198, 69, 174,
+			95, 180, 165, 9, 112, 152, 156, 209, 126, 209, 118, 198, 85, 210,
+			194, 215, 99, 119, 170, 175, 3, 72, 222, 53, 163, 146, 253, 72,
+			78, 156, 147, 92, 213, 112, 242, 159, 178, 205, 127, 166, 185, 42,
+			22, 201, 167, 236, 254, 67, 20, 52, 107, 24, 33, 219, 29, 251,
+			151, 108, 219, 41, 222, 33, 13, 168, 153, 83, 110, 224, 81, 49,
+			83, 17, 199, 234, 151, 82, 230, 41, 226, 88, 253, 146, 93, 24,
+			101, 147, 178, 41, 195, 177, 127, 5, 77, 29, 165, 166, 50, 83,
+			35, 163, 194, 100, 26, 3, 24, 191, 146, 109, 12, 33, 174, 126,
+			37, 219, 152, 233, 216, 191, 122, 96, 99, 169, 135, 177, 170, 11,
+			252, 252, 106, 182, 49, 172, 245, 95, 69, 99, 255, 158, 144, 0,
+			61, 232, 15, 108, 243, 190, 226, 167, 71, 148, 143, 134, 60, 63,
+			196, 78, 188, 174, 85, 144, 182, 251, 188, 223, 222, 125, 132, 115,
+			60, 4, 169, 142, 20, 245, 137, 162, 148, 64, 166, 48, 189, 42,
+			84, 171, 184, 22, 176, 141, 152, 56, 180, 98, 196, 42, 1, 18,
+			133, 95, 169, 172, 67, 212, 133, 251, 60, 180, 211, 139, 222, 136,
+			201, 66, 176, 13, 210, 114, 167, 105, 65, 37, 36, 32, 17, 83,
+			148, 215, 73, 37, 124, 112, 93, 150, 130, 176, 136, 236, 73, 27,
+			189, 228, 203, 12, 107, 73, 186, 45, 244, 182, 42, 249, 107, 51,
+			17, 30, 241, 105, 123, 4, 172, 12, 253, 68, 81, 154, 132, 69,
+			68, 153, 223, 164, 143, 52, 134, 239, 210, 221, 31, 140, 182, 103,
+			141, 136, 232, 79, 27, 17, 68, 150, 80, 105, 54, 58, 22, 4,
+			73, 68, 240, 248, 216, 68, 84, 155, 136, 227, 237, 77, 229, 79,
+			182, 39, 82, 137, 190, 134, 163, 229, 61, 117, 103, 70, 59, 250,
+			49, 189, 119, 100, 237, 241, 164, 40, 197, 221, 205, 77, 47, 86,
+			225, 71, 122, 44, 82, 46, 189, 49, 2, 209, 201, 247, 68, 208,
+			30, 55, 97, 210, 73, 164, 7, 158, 158, 104, 53, 24, 247, 78,
+			24, 73, 179, 104, 102, 105, 175, 135, 225, 141, 27, 158, 135, 16,
+			21, 226, 154, 241, 22, 230, 34, 217, 237, 72, 237, 89, 198, 35,
+			239, 241, 102, 243, 247, 49, 16, 229, 10, 202, 93, 225, 108, 152,
+			125, 65, 0, 81, 248, 55, 228, 121, 13, 76, 138, 41, 27, 196,
+			44, 95, 194, 163, 23, 109, 229, 3, 11, 191, 123, 97, 72, 69,
+			196, 117, 229, 55, 75, 14, 146, 156, 95, 233, 70, 16, 3, 96,
+			89, 5, 169, 33, 2, 196, 20, 194, 179, 235, 240, 234, 44, 211,
+			153, 20, 35, 5, 60, 153, 71, 19, 4, 192, 151, 64, 149, 113,
+			34, 187, 213, 157, 161, 53, 98, 198, 24, 187, 48, 119, 233, 107,
+			19, 162, 67, 34, 231, 102, 55, 130, 56, 140, 168, 41, 59, 91,
+			116, 82, 20, 6, 83, 189, 13, 130, 232, 253, 0, 1, 129, 232,
+			222, 9, 197, 228, 128, 155, 169, 188, 137, 47, 200, 178, 194, 122,
+			238, 250, 251, 47, 169, 91, 211, 147, 222, 58, 196, 135, 146, 170,
+			196, 245, 33, 136, 84, 52, 255, 61, 23, 52, 132, 87, 188, 143,
+			240, 85, 110, 27, 79, 211, 241, 90, 92, 19, 235, 214, 127, 222,
+			107, 77, 76, 242, 240, 128, 213, 13, 147, 8, 226, 1, 224, 74,
+			133, 32, 72, 10, 54, 34, 245, 228, 222, 165, 8, 27, 56, 189,
+			1, 21, 108, 238, 133, 44, 99, 182, 15, 60, 12, 216, 141, 118,
+			245, 101, 132, 80, 57, 186, 29, 208, 38, 105, 14, 194, 8, 77,
+			155, 142, 190, 178, 229, 227, 189, 28, 4, 158, 197, 129, 178, 23,
+			129, 63, 80, 117, 66, 76, 25, 124, 222, 115, 181, 68, 216, 233,
+			70, 29, 24, 38, 194, 13, 90, 168, 76, 173, 12, 136, 27, 193,
+			222, 189, 81, 26, 76, 201, 148, 17, 191, 40, 190, 153, 182, 97,
+			235, 232, 45, 137, 124, 79, 192, 79, 178, 24, 87, 71, 9, 25,
+			111, 172, 204, 220, 40, 110, 217, 11, 6, 53, 45, 95, 255, 58,
+			35, 110, 48, 156, 145, 96, 32, 56, 208, 30, 80, 200, 212, 120,
+			38, 0, 55, 57, 195, 94, 172, 88, 47, 111, 82, 252, 76, 68,
+			176, 233, 49, 160, 109, 65, 22, 198, 45, 57, 137, 113, 44, 244,
+			89, 98, 104, 51, 103, 103, 206, 149, 9, 145, 106, 181, 243, 102,
+			24, 69, 94, 51, 9, 192, 242, 168, 243, 88, 81, 147, 240, 75,
+			166, 219, 183, 242, 245, 154, 44, 246, 137, 17, 131, 194, 240, 244,
+			33, 209, 117, 228, 198, 91, 226, 98, 44, 196, 72, 226, 226, 80,
+			38, 3, 88, 33, 90, 210, 203, 89, 180, 134, 32, 75, 27, 126,
+			144, 217, 118, 120, 183, 19, 6, 233, 138, 226, 141, 165, 249, 165,
+			137, 245, 233, 153, 115, 231, 30, 58, 251, 192, 185, 115, 231, 39,
+			47, 114, 233, 144, 3, 33, 207, 111, 105, 253, 130, 198, 162, 40,
+			85, 202, 26, 176, 158, 252, 65, 42, 182, 192, 122, 242, 7, 169,
+			60, 14, 91, 199, 31, 216, 50, 178, 225, 48, 185, 175, 255, 129,
+			125, 135, 146, 98, 172, 1, 124, 61, 122, 70, 125, 237, 71, 242,
+			228, 36, 251, 89, 152, 165, 135, 33, 16, 125, 222, 54, 31, 44,
+			254, 67, 131, 239, 121, 190, 249, 155, 67, 168, 244, 15, 82, 190,
+			119, 189, 55, 9, 97, 233, 16, 147, 4, 174, 0, 93, 87, 191,
+			251, 39, 79, 207, 182, 252, 117, 169, 147, 193, 23, 90, 172, 33,
+			55, 16, 234, 180, 10, 218, 44, 6, 0, 235, 200, 231, 83, 92,
+			192, 13, 252, 243, 41, 46, 96, 29, 249, 188, 45, 99, 229, 12,
+			147, 117, 228, 243, 118, 233, 164, 74, 14, 224, 171, 188, 116, 60,
+			76, 110, 224, 159, 183, 207, 222, 207, 62, 47, 112, 145, 115, 236,
+			63, 179, 205, 115, 197, 255, 144, 245, 60, 195, 54, 245, 138, 25,
+			232, 213, 209, 72, 252, 173, 153, 231, 101, 88, 199, 151, 229, 109,
+			214, 67, 80, 112, 164, 254, 179, 20, 137, 57, 26, 185, 70, 34,
+			28, 169, 255, 204, 150, 198, 249, 97, 10, 124, 248, 103, 182, 52,
+			206, 15, 155, 185, 1, 124, 149, 198, 249, 97, 114, 164, 254, 51,
+			251, 204, 52, 123, 132, 112, 152, 119, 236, 47, 218, 102, 185, 120,
+			238, 155, 127, 243, 70, 192, 146, 71, 64, 162, 20, 52, 56, 9,
+			127, 49, 157, 95, 56, 9, 127, 49, 165, 245, 60, 2, 18, 217,
+			119, 28, 85, 201, 1, 36, 139, 247, 169, 100, 63, 146, 167, 206,
+			80, 228, 246, 97, 19, 78, 255, 223, 65, 67, 221, 48, 57, 143,
+			126, 37, 5, 181, 63, 143, 164, 52, 212, 13, 227, 153, 26, 251,
+			43, 202, 80, 55, 76, 206, 163, 95, 81, 134, 186, 225, 156, 99,
+			255, 213, 43, 100, 168, 27, 134, 161, 238, 175, 148, 161, 110, 152,
+			12, 117, 127, 165, 12, 117, 195, 100, 168, 251, 43, 24, 234, 238,
+			132, 105, 110, 208, 177, 191, 10, 83, 232, 97, 249, 150, 76, 139,
+			63, 75, 114, 183, 104, 9, 167, 43, 95, 85, 134, 207, 97, 58,
+			93, 201, 38, 77, 153, 132, 201, 239, 144, 147, 255, 186, 221, 247,
+			150, 156, 48, 249, 193, 205, 237, 235, 48, 249, 1, 243, 135, 160,
+			251, 124, 227, 59, 136, 249, 67, 100, 29, 251, 134, 194, 252, 33,
+			178, 142, 125, 67, 97, 254, 16, 105, 80, 223, 80, 152, 63, 68,
+			214, 177, 111, 40, 204, 31, 202, 57, 246, 247, 229, 94, 25, 204,
+			31, 2, 230, 191, 47, 39, 49, 127, 136, 48, 255, 125, 57, 137,
+			249, 67, 132, 249, 239, 203, 73, 19, 233, 136, 147, 127, 107, 174,
+			239, 3, 18, 95, 240, 199, 123, 107, 110, 96, 140, 61, 195, 108,
+			123, 4, 248, 122, 123, 206, 228, 197, 186, 240, 83, 200, 136, 180,
+			210, 127, 89, 250, 134, 133, 59, 56, 203, 128, 148, 156, 154, 67,
+			201, 228, 132, 35, 78, 237, 26, 8, 65, 149, 169, 248, 162, 4,
+			203, 8, 33, 240, 237, 57, 137, 192, 17, 58, 48, 126, 123, 78,
+			174, 178, 17, 66, 224, 219, 115, 133, 99, 42, 105, 1, 158, 187,
+			143, 179, 255, 2, 62, 57, 2, 53, 240, 93, 57, 243, 92, 241,
+			223, 167, 124, 82, 70, 13, 122, 133, 216, 36, 170, 163, 135, 87,
+			154, 75, 162, 15, 197, 36, 71, 200, 10, 246, 174, 20, 71, 176,
+			130, 189, 43, 197, 17, 52, 235, 119, 229, 36, 147, 28, 33, 43,
+			216, 187, 114, 146, 73, 142, 152, 198, 0, 146, 146, 73, 142, 208,
+			97, 240, 187, 114, 103, 166, 217, 113, 76, 246, 160, 99, 191, 59,
+			247, 34, 231, 15, 35, 88, 119, 239, 206, 201, 133, 54, 66, 235,
+			46, 155, 52, 101, 18, 139, 107, 4, 58, 248, 123, 115, 223, 185,
+			197, 53, 66, 70, 146, 247, 166, 227, 134, 145, 228, 189, 57, 185,
+			184, 70, 200, 72, 242, 222, 156, 92, 92, 35, 116, 114, 251, 222,
+			156, 92, 92, 35, 112, 55, 127, 133, 22, 215, 8, 22, 215, 11,
+			106, 113, 141, 208, 226, 122, 65, 45, 174, 17, 90, 92, 47, 168,
+			197, 117, 216, 201, 127, 48, 215, 247, 83, 114, 113, 193, 57, 243,
+			131, 185, 129, 113, 246, 151, 32, 223, 195, 88, 93, 31, 6, 249,
+			126, 126, 15, 249, 210, 10, 121, 229, 137, 88, 172, 196, 87, 250,
+			60, 62, 237, 73, 17, 244, 97, 90, 244, 31, 86, 19, 123, 152,
+			22, 253, 135, 21, 65, 31, 166, 69, 255, 97, 69, 208, 135, 105,
+			209, 127, 88, 17, 244, 97, 179, 111, 0, 88, 147, 4, 125, 152,
+			188, 68, 62, 12, 130, 6, 13, 30, 6, 77, 124, 228, 59, 72,
+			131, 135, 105, 237, 125, 36, 5, 21, 177, 155, 62, 162, 104, 240,
+			48, 89, 160, 63, 162, 104, 240, 48, 173, 189, 143, 40, 26, 60,
+			156, 115, 236, 159, 124, 133, 104, 240, 48, 104, 240, 39, 21, 13,
+			30, 38, 26, 252, 73, 69, 131, 135, 137, 6, 127, 82, 209, 96,
+			193, 201, 127, 52, 215, 247, 79, 36, 13, 194, 201, 246, 163, 185,
+			129, 219, 217, 127, 176, 152, 109, 23, 64, 131, 159, 204, 153, 51,
+			89, 111, 144, 244, 70, 241, 43, 72, 128, 178, 147, 87, 154, 250,
+			100, 55, 113, 133, 205, 124, 222, 32, 99, 194, 69, 46, 226, 69,
+			169, 192, 5, 252, 156, 14, 252, 113, 126, 70, 197, 154, 74, 131,
+			251, 235, 71, 206, 181, 232, 88, 95, 158, 131, 83, 192, 70, 228,
+			110, 123, 56, 35, 175, 112, 68, 105, 113, 59, 97, 59, 220, 4,
+			55, 67, 173, 173, 208, 141, 90, 82, 209, 142, 51, 143, 146, 132,
+			60, 236, 70, 177, 215, 190, 9, 87, 56, 58, 229, 231, 124, 7,
+			79, 56, 70, 158, 14, 218, 41, 108, 85, 20, 128, 134, 194, 241,
+			174, 211, 149, 36, 20, 107, 121, 77, 95, 90, 155, 212, 217, 158,
+			122, 21, 23, 16, 201, 135, 113, 37, 233, 22, 104, 149, 125, 82,
+			145, 110, 129, 86, 217, 39, 213, 42, 43, 208, 42, 251, 164, 90,
+			101, 5, 90, 101, 159, 204, 201, 171, 28, 5, 90, 101, 159, 204,
+			201, 171, 28, 5, 90, 101, 159, 204, 221, 119, 150, 4, 216, 2,
+			86, 217, 167, 190, 131, 171, 172, 64, 171, 236, 83, 41, 168, 88,
+			101, 159, 82, 171, 172, 64, 171, 236, 83, 106, 149, 21, 104, 149,
+			125, 74, 173, 178, 2, 220, 168, 95, 161, 85, 86, 192, 42, 251,
+			101, 181, 202, 10, 180, 202, 126, 89, 173, 178, 2, 173, 178, 95,
+			86, 171, 108, 212, 201, 255, 211, 92, 223, 127, 144, 171, 12, 222,
+			220, 255, 52, 55, 112, 27, 251, 247, 88, 101, 163, 88, 101, 159,
+			198, 42, 251, 79, 233, 42, 147, 38, 189, 87, 116, 145, 137, 62,
+			94, 233, 53, 38, 141, 122, 255, 231, 91, 98, 163, 180, 196, 62,
+			173, 232, 118, 148, 150, 216, 167, 213, 18, 27, 165, 37, 246, 105,
+			181, 196, 70, 105, 137, 125, 90, 45, 177, 81, 90, 98, 159, 86,
+			75, 108, 148, 150, 216, 167, 177, 196, 190, 0, 225, 96, 20, 167,
+			227, 191, 145, 51, 255, 77, 206, 234, 117, 212, 147, 118, 224, 150,
+			55, 37, 46, 174, 79, 145, 49, 126, 2, 145, 248, 97, 157, 245,
+			3, 126, 173, 209, 88, 134, 244, 213, 134, 217, 98, 82, 76, 126,
+			203, 219, 238, 132, 176, 196, 149, 25, 210, 129, 48, 161, 61, 34,
+			202, 226, 18, 109, 11, 56, 223, 103, 109, 75, 45, 172, 87, 171,
+			13, 16, 199, 58, 161, 30, 118, 96, 166, 166, 93, 184, 244, 46,
+			175, 102, 190, 167, 221, 169, 66, 250, 20, 35, 220, 232, 57, 113,
+			90, 94, 90, 105, 40, 100, 210, 249, 255, 111, 228, 250, 239, 160,
+			195, 251, 81, 28, 239, 59, 246, 111, 230, 236, 59, 233, 12, 105,
+			84, 30, 247, 255, 102, 206, 190, 35, 205, 48, 145, 81, 60, 198,
+			78, 202, 26, 134, 99, 255, 86, 206, 30, 47, 29, 161, 161, 80,
+			180, 59, 13, 11, 211, 213, 192, 79, 126, 43, 39, 31, 198, 25,
+			149, 78, 0, 191, 149, 187, 253, 14, 246, 160, 108, 199, 116, 236,
+			223, 206, 217, 99, 165, 211, 89, 212, 209, 113, 140, 14, 203, 22,
+			251, 45, 79, 6, 15, 136, 211, 166, 193, 26, 127, 59, 39, 95,
+			139, 26, 149, 222, 2, 191, 157, 163, 215, 162, 192, 8, 12, 39,
+			255, 217, 156, 249, 59, 185, 123, 229, 172, 131, 241, 125, 54, 37,
+			32, 48, 190, 207, 230, 100, 132, 203, 81, 98, 124, 159, 205, 221,
+			57, 165, 146, 22, 190, 202, 120, 16, 163, 36, 218, 255, 78, 46,
+			127, 74, 37, 251, 29, 251, 119, 114, 163, 39, 137, 71, 143, 162,
+			223, 223, 253, 14, 242, 232, 81, 146, 198, 127, 55, 5, 21, 210,
+			248, 239, 42, 30, 61, 74, 210, 248, 239, 42, 30, 61, 74, 210,
+			248, 239, 42, 30, 61, 154, 115, 236, 223, 123, 133, 120, 244, 40,
+			120, 244, 239, 41, 30, 61, 74, 60, 250, 247, 20, 143, 30, 37,
+			30, 253, 123, 224, 209, 63, 104, 33, 64, 134, 147, 255, 92, 174,
+			239, 191, 231, 140, 226, 223, 152, 124, 86, 53, 154, 250, 86, 128,
+			157, 184, 18, 18, 121, 219, 131, 208, 38, 13, 144, 25, 44, 73,
+			43, 35, 172, 139, 12, 7, 135, 158, 27, 17, 232, 106, 20, 116,
+			30, 165, 226, 123, 171, 155, 217, 218, 93, 250, 226, 197, 101, 25,
+			155, 14, 87, 81, 42, 76, 70, 228, 132, 47, 228, 114, 24, 182,
+			213, 251, 33, 177, 228, 109, 100, 240, 165, 112, 110, 0, 48, 45,
+			43, 239, 11, 198, 149, 158, 155, 203, 123, 64, 240, 3, 222, 218,
+			87, 67, 62, 42, 47, 142, 48, 196, 241, 79, 218, 236, 197, 139,
+			178, 137, 137, 73, 193, 46, 58, 81, 216, 234, 54, 247, 23, 155,
+			11, 59, 187, 141, 112, 98, 114, 82, 88, 11, 201, 208, 46, 142,
+			37, 86, 179, 225, 240, 228, 109, 12, 38, 55, 153, 109, 25, 42,
+			10, 23, 125, 62, 151, 27, 56, 198, 254, 53, 252, 104, 28, 68,
+			153, 254, 124, 206, 252, 111, 57, 171, 248, 79, 132, 39, 77, 246,
+			98, 127, 79, 124, 61, 57, 196, 10, 23, 33, 20, 101, 56, 17,
+			61, 139, 216, 177, 98, 111, 83, 6, 21, 192, 193, 79, 43, 76,
+			166, 84, 136, 157, 150, 242, 231, 246, 227, 53, 77, 104, 234, 89,
+			4, 238, 111, 108, 100, 106, 103, 155, 12, 88, 74, 151, 124, 162,
+			229, 5, 97, 162, 34, 133, 136, 119, 106, 32, 233, 245, 208, 0,
+			28, 186, 226, 189, 94, 143, 147, 21, 198, 171, 149, 205, 74, 249,
+			141, 252, 41, 249, 64, 58, 249, 196, 60, 93, 230, 79, 149, 214,
+			221, 168, 178, 238, 62, 95, 42, 147, 109, 133, 178, 182, 51, 69,
+			248, 155, 51, 16, 49, 241, 176, 250, 132, 172, 51, 89, 65, 73,
+			185, 88, 29, 138, 64, 253, 249, 28, 3, 219, 193, 163, 169, 196,
+			75, 255, 40, 103, 151, 136, 47, 57, 50, 230, 244, 31, 229, 100,
+			68, 104, 71, 198, 156, 254, 163, 220, 161, 177, 52, 3, 78, 230,
+			185, 35, 119, 165, 25, 112, 51, 207, 241, 123, 116, 155, 8, 153,
+			147, 179, 79, 232, 2, 96, 102, 127, 156, 109, 19, 150, 138, 63,
+			198, 98, 212, 25, 84, 197, 185, 59, 205, 64, 156, 157, 220, 61,
+			37, 90, 203, 14, 160, 252, 147, 156, 41, 226, 162, 58, 180, 187,
+			254, 137, 226, 56, 14, 25, 215, 254, 36, 55, 52, 166, 146, 136,
+			70, 159, 59, 50, 174, 146, 136, 70, 159, 59, 118, 167, 120, 34,
+			202, 1, 63, 250, 66, 206, 60, 93, 124, 167, 145, 121, 233, 228,
+			69, 168, 169, 140, 153, 218, 217, 114, 19, 162, 98, 216, 30, 169,
+			104, 18, 222, 240, 2, 255, 121, 120, 146, 250, 42, 68, 105, 11,
+			210, 148, 27, 203, 163, 155, 244, 228, 166, 42, 47, 169, 32, 96,
+			156, 232, 41, 214, 142, 255, 242, 13, 42, 53, 22, 160, 234, 11,
+			233, 208, 128, 168, 47, 228, 164, 255, 135, 67, 124, 255, 11, 57,
+			121, 19, 210, 33, 36, 125, 33, 119, 234, 94, 137, 36, 248, 124,
+			231, 204, 73, 249, 17, 108, 249, 139, 105, 75, 112, 111, 255, 98,
+			218, 18, 208, 240, 197, 156, 115, 82, 37, 97, 166, 206, 157, 158,
+			144, 45, 33, 246, 125, 78, 70, 91, 118, 232, 217, 170, 47, 165,
+			45, 225, 112, 231, 75, 57, 233, 233, 231, 208, 179, 85, 95, 202,
+			141, 158, 80, 73, 170, 123, 239, 105, 217, 146, 237, 216, 95, 206,
+			201, 104, 243, 14, 57, 142, 126, 57, 109, 9, 71, 35, 95, 78,
+			97, 194, 209, 200, 151, 115, 242, 130, 155, 67, 71, 35, 95, 78,
+			73, 32, 231, 216, 127, 145, 147, 113, 116, 29, 58, 31, 248, 139,
+			180, 37, 156, 15, 252, 69, 10, 19, 206, 7, 254, 34, 55, 170,
+			232, 5, 231, 3, 127, 1, 2, 21, 45, 193, 10, 158, 147, 161,
+			147, 29, 50, 231, 127, 37, 109, 9, 230, 252, 175, 164, 48, 193,
+			156, 255, 149, 156, 115, 143, 74, 90, 72, 158, 60, 197, 126, 223,
+			96, 166, 61, 230, 228, 191, 158, 235, 251, 31, 121, 163, 248, 44,
+			175, 6, 77, 183, 19, 203, 248, 167, 47, 239, 201, 116, 172, 123,
+			113, 90, 46, 93, 176, 16, 177, 184, 237, 165, 156, 148, 36, 76,
+			190, 227, 102, 226, 185, 84, 216, 204, 51, 223, 201, 136, 179, 41,
+			52, 240, 42, 32, 192, 5, 23, 198, 109, 195, 175, 195, 30, 240,
+			125, 163, 204, 182, 199, 176, 2, 63, 150, 55, 239, 41, 126, 185,
+			192, 103, 249, 66, 168, 206, 198, 20, 233, 131, 183, 118, 124, 79,
+			184, 43, 244, 182, 200, 221, 44, 163, 19, 238, 102, 184, 94, 19,
+			69, 94, 220, 9, 17, 35, 3, 182, 226, 236, 113, 111, 26, 244,
+			86, 157, 122, 238, 121, 237, 29, 60, 63, 104, 201, 215, 58, 210,
+			231, 67, 146, 144, 215, 230, 171, 56, 180, 23, 61, 183, 188, 231,
+			188, 40, 46, 239, 185, 13, 40, 113, 73, 103, 90, 16, 81, 99,
+			127, 219, 111, 187, 17, 211, 239, 196, 203, 183, 190, 40, 224, 98,
+			153, 199, 238, 46, 244, 4, 218, 190, 112, 11, 168, 237, 165, 23,
+			18, 110, 121, 205, 204, 109, 235, 96, 86, 97, 168, 111, 23, 188,
+			153, 241, 5, 15, 239, 25, 225, 94, 3, 98, 174, 82, 136, 222,
+			212, 21, 56, 29, 247, 69, 246, 18, 77, 189, 94, 94, 85, 120,
+			253, 235, 245, 159, 215, 227, 207, 235, 81, 209, 149, 31, 215, 155,
+			244, 167, 229, 113, 190, 193, 249, 230, 150, 207, 96, 145, 208, 193,
+			102, 117, 16, 31, 222, 150, 243, 25, 83, 191, 113, 199, 13, 56,
+			167, 128, 61, 178, 33, 245, 191, 236, 46, 195, 249, 83, 110, 217,
+			159, 228, 252, 41, 126, 161, 204, 207, 150, 249, 76, 153, 159, 229,
+			79, 163, 24, 49, 214, 157, 173, 176, 189, 127, 96, 21, 89, 113,
+			125, 79, 197, 50, 191, 128, 186, 168, 216, 118, 215, 189, 54, 159,
+			80, 163, 199, 198, 200, 249, 83, 205, 114, 107, 95, 149, 251, 85,
+			21, 226, 202, 19, 2, 77, 178, 188, 87, 222, 216, 87, 254, 156,
+			42, 143, 157, 158, 79, 108, 132, 161, 44, 188, 89, 222, 218, 87,
+			248, 188, 46, 76, 202, 42, 159, 56, 55, 169, 222, 152, 0, 154,
+			166, 248, 172, 70, 155, 116, 251, 208, 49, 196, 181, 39, 175, 244,
+			33, 74, 98, 175, 189, 33, 95, 193, 147, 46, 18, 20, 170, 142,
+			103, 137, 158, 200, 85, 197, 180, 245, 147, 73, 77, 250, 177, 62,
+			163, 150, 33, 214, 176, 97, 96, 153, 73, 39, 93, 242, 189, 66,
+			99, 80, 130, 225, 129, 131, 167, 19, 154, 237, 80, 58, 126, 104,
+			15, 93, 90, 224, 82, 130, 169, 240, 94, 34, 135, 121, 152, 30,
+			174, 96, 234, 246, 35, 57, 240, 54, 111, 240, 137, 78, 24, 199,
+			254, 122, 91, 7, 127, 6, 189, 104, 239, 158, 84, 242, 73, 167,
+			56, 22, 98, 31, 90, 146, 247, 33, 165, 151, 140, 70, 215, 206,
+			22, 84, 71, 162, 47, 138, 188, 174, 15, 130, 74, 186, 189, 184,
+			164, 61, 150, 209, 80, 234, 229, 10, 102, 74, 62, 14, 45, 239,
+			185, 10, 166, 225, 186, 130, 69, 53, 31, 167, 186, 152, 58, 92,
+			34, 90, 86, 8, 21, 110, 172, 50, 30, 178, 194, 78, 6, 127,
+			50, 244, 171, 102, 54, 113, 7, 30, 76, 24, 131, 138, 201, 172,
+			135, 143, 120, 17, 42, 32, 61, 223, 14, 99, 50, 42, 132, 235,
+			55, 253, 176, 27, 43, 228, 170, 71, 2, 197, 216, 90, 37, 137,
+			87, 119, 211, 245, 3, 29, 217, 86, 5, 67, 86, 45, 239, 157,
+			6, 21, 148, 90, 92, 60, 196, 195, 139, 94, 89, 120, 106, 135,
+			27, 217, 72, 193, 7, 140, 186, 151, 84, 79, 199, 98, 121, 43,
+			63, 47, 114, 149, 129, 100, 219, 93, 151, 84, 5, 127, 25, 33,
+			184, 202, 178, 130, 86, 4, 68, 146, 92, 50, 227, 241, 240, 248,
+			223, 70, 111, 240, 189, 44, 2, 149, 14, 36, 46, 204, 162, 149,
+			117, 111, 211, 15, 232, 34, 124, 184, 145, 109, 73, 99, 38, 160,
+			219, 68, 241, 150, 27, 9, 213, 98, 79, 164, 105, 229, 183, 37,
+			34, 228, 82, 29, 34, 132, 71, 193, 76, 149, 155, 148, 123, 208,
+			136, 179, 195, 140, 195, 109, 21, 64, 116, 79, 73, 180, 172, 21,
+			187, 109, 207, 13, 164, 155, 25, 185, 71, 138, 176, 217, 94, 208,
+			114, 15, 88, 68, 188, 68, 207, 13, 151, 164, 6, 27, 163, 37,
+			122, 87, 212, 37, 87, 100, 50, 136, 100, 2, 135, 235, 149, 153,
+			193, 87, 54, 36, 167, 30, 2, 53, 148, 110, 152, 64, 157, 92,
+			48, 186, 89, 209, 168, 140, 101, 231, 35, 234, 223, 118, 39, 12,
+			82, 255, 81, 253, 18, 10, 159, 226, 115, 105, 148, 42, 241, 216,
+			14, 188, 20, 149, 220, 155, 118, 170, 36, 212, 78, 20, 174, 187,
+			96, 0, 116, 237, 26, 174, 134, 100, 7, 3, 76, 194, 76, 200,
+			19, 90, 207, 10, 75, 202, 114, 32, 98, 167, 224, 202, 109, 25,
+			158, 231, 240, 63, 148, 30, 209, 225, 70, 166, 151, 38, 196, 96,
+			52, 70, 111, 161, 52, 225, 167, 150, 198, 233, 164, 171, 39, 82,
+			121, 25, 35, 185, 255, 99, 121, 41, 170, 141, 145, 220, 255, 177,
+			188, 148, 251, 199, 72, 238, 255, 88, 254, 200, 157, 42, 105, 65,
+			98, 57, 206, 201, 192, 50, 6, 45, 242, 227, 121, 243, 235, 121,
+			225, 254, 60, 70, 138, 208, 199, 243, 204, 97, 111, 239, 103, 121,
+			123, 12, 106, 140, 99, 255, 122, 222, 46, 23, 255, 42, 159, 125,
+			116, 64, 134, 69, 71, 136, 95, 73, 174, 183, 146, 209, 212, 157,
+			111, 249, 252, 16, 211, 99, 196, 182, 81, 205, 220, 170, 144, 182,
+			211, 140, 37, 84, 190, 6, 70, 91, 128, 10, 225, 70, 78, 122,
+			136, 135, 156, 108, 145, 144, 200, 244, 43, 167, 7, 74, 137, 146,
+			32, 136, 35, 73, 127, 38, 105, 80, 147, 108, 153, 135, 205, 102,
+			151, 222, 160, 239, 33, 218, 4, 44, 17, 157, 208, 214, 79, 123,
+			249, 121, 218, 203, 31, 44, 243, 115, 252, 105, 150, 114, 104, 42,
+			0, 249, 167, 34, 197, 158, 53, 204, 230, 196, 249, 73, 206, 167,
+			167, 169, 158, 186, 65, 89, 33, 106, 156, 120, 112, 82, 166, 169,
+			0, 154, 212, 5, 176, 1, 79, 164, 159, 169, 192, 185, 212, 237,
+			81, 173, 226, 3, 6, 218, 211, 185, 136, 36, 144, 69, 228, 5,
+			130, 82, 111, 192, 123, 177, 212, 83, 249, 97, 126, 1, 110, 180,
+			193, 190, 98, 2, 254, 253, 141, 207, 244, 54, 126, 208, 243, 34,
+			178, 130, 184, 40, 234, 6, 7, 23, 162, 225, 239, 111, 254, 220,
+			129, 82, 31, 149, 149, 215, 72, 83, 214, 64, 7, 226, 130, 56,
+			210, 71, 72, 21, 193, 129, 191, 105, 2, 19, 70, 141, 218, 6,
+			132, 88, 233, 66, 35, 72, 169, 237, 194, 103, 82, 144, 228, 190,
+			201, 199, 204, 107, 210, 72, 105, 128, 39, 251, 36, 187, 148, 213,
+			79, 232, 240, 144, 154, 197, 51, 181, 104, 132, 80, 39, 27, 16,
+			55, 108, 212, 65, 220, 182, 223, 12, 219, 97, 48, 41, 253, 248,
+			199, 164, 17, 226, 215, 243, 210, 96, 48, 38, 141, 16, 191, 158,
+			151, 207, 82, 141, 73, 35, 196, 175, 231, 199, 238, 72, 51, 44,
+			100, 20, 143, 165, 25, 3, 200, 184, 243, 62, 86, 96, 3, 50,
+			195, 68, 206, 93, 103, 216, 239, 152, 114, 201, 227, 189, 215, 188,
+			93, 46, 254, 154, 169, 238, 156, 98, 82, 84, 76, 137, 100, 11,
+			38, 116, 122, 53, 160, 27, 41, 76, 197, 23, 101, 192, 239, 182,
+			31, 120, 101, 249, 187, 25, 182, 187, 219, 65, 25, 207, 151, 181,
+			232, 67, 42, 190, 150, 51, 30, 187, 110, 28, 119, 183, 189, 150,
+			216, 156, 221, 56, 211, 208, 36, 34, 53, 192, 179, 27, 237, 232,
+			7, 46, 220, 72, 95, 27, 243, 131, 36, 76, 55, 12, 49, 181,
+			48, 185, 171, 139, 96, 205, 221, 10, 207, 248, 68, 163, 77, 65,
+			127, 162, 73, 73, 98, 36, 42, 242, 231, 189, 40, 156, 18, 198,
+			253, 169, 169, 140, 207, 58, 34, 228, 67, 114, 227, 59, 50, 144,
+			129, 219, 106, 49, 156, 209, 132, 66, 212, 144, 167, 33, 45, 63,
+			238, 180, 221, 93, 185, 11, 185, 144, 78, 163, 204, 220, 193, 130,
+			241, 153, 236, 220, 193, 134, 241, 153, 236, 220, 193, 138, 241, 153,
+			236, 220, 193, 142, 241, 153, 236, 220, 193, 130, 253, 153, 236, 220,
+			25, 3, 38, 114, 238, 58, 195, 126, 114, 88, 206, 29, 124, 187,
+			242, 246, 100, 241, 189, 195, 58, 148, 253, 10, 233, 157, 216, 222,
+			106, 193, 70, 152, 209, 86, 184, 171, 15, 183, 178, 20, 171, 2,
+			95, 3, 41, 36, 144, 55, 195, 109, 41, 81, 147, 212, 226, 167,
+			71, 64, 64, 165, 120, 171, 51, 217, 234, 105, 67, 110, 16, 66,
+			204, 81, 110, 215, 234, 65, 22, 69, 240, 153, 242, 216, 12, 102,
+			17, 10, 210, 151, 27, 33, 168, 229, 128, 126, 113, 71, 48, 12,
+			98, 175, 217, 37, 175, 83, 76, 104, 92, 38, 245, 128, 7, 161,
+			160, 41, 104, 172, 55, 188, 96, 79, 45, 177, 25, 235, 242, 226,
+			104, 47, 145, 246, 102, 55, 78, 137, 72, 118, 10, 128, 224, 80,
+			236, 7, 155, 107, 120, 199, 19, 112, 175, 105, 128, 136, 30, 112,
+			209, 1, 170, 138, 187, 25, 185, 157, 45, 2, 91, 23, 32, 114,
+			19, 0, 48, 133, 172, 9, 28, 136, 64, 209, 105, 134, 65, 32,
+			238, 17, 36, 225, 36, 144, 205, 229, 29, 0, 181, 154, 42, 194,
+			82, 171, 219, 46, 51, 29, 242, 156, 68, 63, 138, 190, 180, 119,
+			48, 97, 138, 49, 213, 142, 18, 24, 20, 111, 150, 215, 114, 48,
+			182, 165, 160, 173, 141, 236, 84, 84, 94, 172, 201, 70, 197, 185,
+			164, 63, 110, 187, 209, 13, 176, 58, 97, 7, 159, 158, 158, 196,
+			138, 193, 75, 233, 120, 143, 20, 54, 193, 174, 18, 251, 72, 240,
+			84, 245, 226, 178, 194, 33, 232, 33, 145, 145, 182, 136, 104, 240,
+			180, 73, 226, 69, 126, 124, 35, 125, 229, 88, 55, 183, 159, 99,
+			2, 185, 180, 218, 48, 104, 41, 160, 38, 91, 174, 10, 251, 24,
+			197, 73, 133, 241, 69, 111, 7, 159, 197, 114, 150, 247, 117, 211,
+			187, 190, 244, 96, 22, 134, 94, 213, 143, 70, 244, 108, 44, 20,
+			27, 70, 91, 19, 104, 239, 157, 147, 195, 207, 18, 46, 172, 200,
+			140, 223, 242, 51, 236, 203, 7, 52, 187, 238, 70, 98, 247, 59,
+			104, 47, 91, 119, 159, 231, 15, 243, 243, 151, 94, 180, 217, 231,
+			85, 175, 179, 129, 148, 207, 129, 137, 125, 101, 94, 164, 141, 109,
+			13, 249, 75, 181, 164, 74, 106, 64, 91, 97, 23, 30, 239, 219,
+			132, 156, 11, 151, 152, 108, 96, 94, 46, 12, 77, 39, 224, 189,
+			205, 48, 218, 204, 188, 14, 11, 130, 87, 68, 128, 115, 246, 200,
+			245, 233, 230, 136, 34, 17, 217, 148, 128, 143, 171, 250, 90, 214,
+			73, 72, 102, 195, 132, 174, 183, 221, 224, 6, 193, 26, 171, 213,
+			128, 118, 252, 68, 202, 129, 212, 12, 244, 138, 202, 75, 131, 151,
+			46, 45, 62, 83, 97, 61, 67, 149, 115, 34, 138, 61, 204, 239,
+			23, 179, 114, 134, 95, 206, 18, 182, 198, 22, 62, 242, 51, 192,
+			153, 28, 54, 95, 144, 99, 85, 228, 29, 203, 34, 138, 200, 165,
+			176, 81, 225, 103, 166, 95, 180, 101, 169, 75, 240, 51, 124, 51,
+			194, 225, 175, 170, 160, 1, 21, 244, 42, 62, 242, 135, 249, 3,
+			122, 86, 164, 151, 2, 87, 108, 75, 53, 173, 174, 4, 98, 231,
+			128, 25, 252, 171, 217, 237, 8, 134, 240, 175, 230, 229, 121, 6,
+			101, 192, 105, 56, 127, 228, 100, 154, 97, 33, 227, 244, 4, 157,
+			103, 160, 4, 158, 61, 205, 219, 103, 116, 1, 24, 196, 191, 150,
+			109, 19, 38, 241, 175, 101, 219, 132, 81, 252, 107, 249, 35, 167,
+			210, 12, 106, 99, 98, 82, 183, 105, 59, 246, 223, 228, 237, 25,
+			93, 192, 22, 25, 105, 155, 48, 142, 255, 77, 182, 77, 219, 64,
+			198, 145, 169, 52, 195, 66, 198, 217, 115, 8, 224, 99, 218, 71,
+			156, 252, 91, 250, 251, 62, 208, 111, 192, 47, 64, 8, 180, 235,
+			82, 46, 140, 188, 54, 237, 61, 241, 150, 223, 225, 235, 94, 178,
+			227, 121, 218, 12, 42, 239, 147, 145, 172, 0, 213, 127, 143, 145,
+			90, 61, 20, 49, 171, 227, 168, 183, 244, 254, 42, 133, 105, 208,
+			190, 27, 199, 97, 211, 119, 245, 201, 151, 126, 31, 71, 247, 194,
+			148, 85, 24, 237, 165, 71, 230, 234, 157, 7, 146, 48, 41, 26,
+			63, 72, 44, 245, 21, 150, 149, 50, 6, 100, 245, 162, 12, 130,
+			202, 189, 165, 127, 224, 40, 123, 142, 217, 246, 17, 104, 111, 111,
+			235, 55, 79, 21, 159, 197, 37, 165, 89, 237, 244, 162, 182, 32,
+			60, 16, 190, 237, 145, 126, 15, 14, 155, 120, 207, 225, 6, 229,
+			94, 36, 64, 230, 9, 212, 158, 194, 148, 53, 68, 22, 194, 26,
+			204, 192, 33, 181, 210, 35, 164, 149, 190, 173, 95, 106, 165, 71,
+			72, 43, 125, 91, 255, 208, 109, 42, 137, 160, 225, 253, 183, 115,
+			149, 68, 208, 240, 254, 19, 39, 73, 43, 61, 2, 173, 244, 251,
+			251, 205, 23, 250, 133, 86, 122, 132, 180, 210, 239, 239, 103, 71,
+			216, 187, 12, 150, 183, 143, 8, 173, 244, 29, 253, 118, 185, 248,
+			61, 89, 165, 20, 147, 186, 103, 231, 219, 123, 184, 144, 133, 52,
+			243, 222, 24, 221, 9, 167, 155, 24, 116, 45, 93, 155, 138, 220,
+			189, 210, 83, 69, 217, 248, 43, 80, 50, 228, 130, 58, 34, 101,
+			243, 119, 244, 75, 66, 61, 34, 101, 243, 119, 244, 75, 249, 238,
+			136, 148, 205, 223, 209, 47, 229, 187, 35, 82, 54, 127, 71, 191,
+			148, 239, 142, 72, 217, 252, 29, 253, 82, 190, 59, 162, 100, 243,
+			119, 244, 223, 133, 155, 16, 98, 220, 134, 99, 191, 179, 223, 62,
+			89, 124, 100, 239, 184, 65, 63, 34, 50, 183, 208, 77, 146, 240,
+			69, 198, 159, 129, 27, 114, 233, 59, 179, 112, 67, 46, 125, 103,
+			191, 92, 96, 71, 164, 92, 250, 206, 254, 35, 199, 211, 12, 11,
+			25, 165, 19, 108, 71, 2, 5, 191, 230, 126, 251, 174, 226, 230,
+			94, 160, 72, 146, 7, 129, 132, 27, 27, 176, 163, 249, 1, 93,
+			61, 210, 102, 187, 94, 58, 147, 209, 200, 105, 89, 166, 94, 240,
+			153, 99, 68, 113, 26, 159, 1, 30, 92, 236, 221, 89, 224, 193,
+			197, 222, 157, 69, 58, 14, 244, 222, 221, 47, 95, 2, 62, 34,
+			185, 216, 187, 251, 143, 221, 201, 254, 163, 34, 37, 4, 237, 238,
+			183, 143, 21, 255, 149, 177, 23, 124, 229, 92, 246, 114, 128, 151,
+			55, 103, 95, 4, 120, 106, 5, 251, 34, 53, 172, 90, 77, 207,
+			59, 33, 191, 117, 220, 56, 201, 168, 161, 240, 169, 186, 9, 173,
+			3, 88, 227, 19, 242, 161, 62, 17, 83, 66, 169, 144, 180, 102,
+			31, 166, 38, 167, 132, 188, 164, 84, 198, 35, 146, 39, 191, 63,
+			139, 33, 240, 228, 247, 103, 49, 4, 158, 252, 254, 126, 249, 146,
+			241, 17, 201, 147, 223, 223, 127, 180, 200, 62, 114, 55, 59, 142,
+			43, 249, 109, 111, 218, 237, 248, 211, 180, 80, 214, 84, 196, 0,
+			65, 72, 14, 147, 209, 233, 221, 142, 95, 228, 178, 176, 138, 84,
+			63, 157, 58, 82, 136, 210, 103, 126, 202, 96, 135, 72, 243, 191,
+			44, 91, 113, 238, 102, 197, 43, 181, 234, 194, 252, 218, 229, 234,
+			181, 217, 199, 106, 75, 245, 181, 213, 197, 149, 229, 234, 92, 237,
+			74, 173, 58, 95, 232, 115, 134, 217, 128, 122, 118, 189, 96, 32,
+			85, 175, 126, 247, 106, 173, 94, 157, 47, 152, 206, 97, 54, 180,
+			180, 218, 88, 94, 109, 172, 45, 45, 46, 60, 81, 176, 156, 17,
+			198, 106, 139, 58, 109, 59, 135, 216, 96, 237, 250, 245, 213, 198,
+			236, 229, 133, 106, 33, 231, 56, 108, 100, 117, 113, 169, 62, 95,
+			173, 87, 231, 215, 22, 106, 43, 141, 66, 222, 185, 141, 141, 46,
+			46, 45, 174, 85, 175, 47, 55, 158, 88, 155, 175, 94, 153, 93,
+			93, 104, 20, 250, 47, 62, 195, 70, 122, 135, 235, 220, 85, 217,
+			27, 135, 159, 6, 34, 157, 53, 198, 63, 52, 192, 173, 137, 145,
+			153, 163, 170, 148, 219, 241, 43, 61, 35, 173, 31, 218, 200, 38,
+			47, 119, 216, 72, 51, 220, 206, 20, 191, 236, 244, 148, 39, 139,
+			203, 178, 241, 228, 172, 44, 177, 25, 34, 130, 85, 37, 140, 54,
+			167, 55, 189, 128, 208, 57, 45, 62, 185, 29, 63, 166, 9, 202,
+			248, 52, 94, 202, 252, 254, 136, 105, 95, 157, 93, 174, 61, 250,
+			175, 139, 44, 239, 216, 35, 125, 79, 24, 236, 159, 218, 204, 24,
+			118, 172, 145, 62, 103, 230, 31, 219, 28, 126, 41, 17, 158, 183,
+			228, 51, 103, 207, 189, 74, 250, 23, 242, 133, 133, 57, 198, 248,
+			130, 223, 244, 130, 152, 94, 108, 108, 73, 45, 111, 182, 3, 169,
+			66, 125, 41, 243, 199, 68, 4, 28, 62, 83, 57, 203, 39, 80,
+			160, 36, 63, 149, 38, 47, 49, 196, 106, 212, 15, 63, 167, 97,
+			49, 113, 196, 43, 158, 201, 193, 106, 34, 37, 212, 135, 255, 93,
+			250, 148, 140, 108, 163, 194, 40, 182, 38, 90, 8, 215, 233, 233,
+			35, 232, 172, 29, 117, 71, 91, 21, 227, 110, 66, 210, 143, 120,
+			4, 238, 226, 244, 244, 206, 206, 78, 197, 37, 64, 9, 101, 109,
+			81, 44, 158, 94, 168, 205, 85, 23, 87, 170, 83, 51, 149, 179,
+			140, 241, 213, 128, 46, 177, 234, 11, 174, 235, 50, 170, 65, 19,
+			81, 101, 120, 219, 221, 129, 60, 234, 110, 70, 50, 196, 23, 60,
+			30, 68, 140, 24, 60, 154, 185, 145, 236, 144, 18, 132, 199, 212,
+			35, 127, 189, 155, 244, 96, 73, 1, 230, 199, 61, 5, 112, 183,
+			52, 224, 165, 217, 21, 94, 91, 41, 241, 203, 179, 43, 181, 149,
+			50, 227, 143, 215, 26, 215, 150, 86, 27, 252, 241, 217, 122, 125,
+			118, 177, 81, 171, 174, 240, 165, 58, 159, 91, 90, 156, 175, 129,
+			246, 87, 248, 210, 21, 62, 187, 248, 4, 127, 109, 109, 113, 190,
+			172, 46, 244, 122, 207, 65, 143, 143, 121, 24, 73, 239, 189, 86,
+			38, 210, 171, 234, 94, 251, 143, 171, 71, 29, 116, 28, 180, 77,
+			216, 190, 73, 181, 162, 8, 29, 177, 124, 175, 50, 104, 49, 78,
+			177, 106, 164, 29, 122, 223, 136, 42, 140, 13, 48, 195, 116, 172,
+			66, 223, 24, 27, 100, 166, 213, 231, 88, 78, 223, 25, 100, 14,
+			56, 214, 145, 190, 215, 33, 115, 96, 72, 252, 20, 153, 183, 245,
+			149, 40, 147, 137, 159, 34, 243, 246, 190, 243, 148, 41, 127, 138,
+			204, 59, 250, 78, 83, 166, 33, 126, 138, 204, 113, 89, 253, 164,
+			250, 137, 91, 57, 197, 190, 9, 131, 253, 190, 197, 204, 254, 62,
+			199, 58, 109, 94, 44, 254, 150, 197, 103, 165, 33, 62, 99, 237,
+			211, 143, 89, 168, 88, 22, 50, 222, 201, 132, 154, 243, 178, 84,
+			12, 233, 98, 99, 153, 94, 224, 153, 100, 32, 74, 181, 206, 149,
+			113, 52, 222, 175, 58, 238, 49, 69, 242, 167, 38, 210, 229, 92,
+			233, 229, 31, 147, 252, 97, 174, 88, 215, 211, 164, 82, 172, 224,
+			37, 23, 216, 186, 146, 151, 85, 57, 195, 233, 68, 253, 189, 236,
+			104, 190, 43, 45, 47, 73, 210, 70, 131, 40, 195, 95, 170, 213,
+			218, 226, 139, 55, 218, 240, 183, 189, 56, 113, 183, 59, 136, 80,
+			228, 71, 222, 90, 226, 111, 123, 47, 187, 245, 12, 204, 101, 105,
+			227, 126, 9, 112, 20, 183, 126, 250, 18, 99, 140, 89, 253, 125,
+			166, 99, 21, 251, 79, 136, 223, 54, 38, 90, 230, 231, 29, 235,
+			244, 144, 204, 55, 28, 235, 244, 201, 25, 241, 219, 114, 172, 211,
+			247, 63, 196, 254, 216, 100, 38, 60, 29, 207, 246, 61, 97, 20,
+			255, 223, 38, 132, 95, 63, 104, 225, 234, 114, 26, 235, 70, 117,
+			140, 180, 43, 223, 161, 39, 144, 112, 4, 222, 115, 20, 0, 83,
+			78, 70, 78, 212, 236, 130, 4, 244, 55, 116, 17, 90, 163, 140,
+			101, 40, 218, 112, 99, 69, 82, 144, 230, 149, 50, 230, 66, 232,
+			233, 116, 147, 73, 21, 198, 224, 204, 25, 117, 154, 118, 230, 76,
+			54, 16, 183, 6, 75, 209, 32, 2, 199, 202, 55, 43, 229, 153,
+			249, 37, 156, 39, 129, 94, 17, 71, 29, 142, 117, 113, 111, 77,
+			40, 45, 224, 152, 50, 124, 6, 158, 1, 157, 93, 174, 145, 35,
+			7, 214, 251, 150, 27, 180, 84, 52, 216, 52, 18, 33, 221, 245,
+			104, 232, 75, 85, 103, 206, 108, 187, 187, 103, 206, 240, 200, 107,
+			122, 176, 180, 33, 50, 107, 207, 67, 192, 233, 41, 20, 99, 86,
+			174, 207, 112, 172, 179, 57, 135, 61, 194, 236, 28, 164, 115, 107,
+			198, 188, 167, 56, 131, 23, 25, 111, 66, 124, 35, 133, 85, 121,
+			49, 19, 118, 233, 198, 94, 230, 30, 49, 29, 14, 145, 147, 106,
+			14, 13, 24, 142, 53, 99, 222, 169, 82, 166, 99, 205, 28, 231,
+			236, 199, 12, 106, 221, 112, 172, 7, 205, 195, 197, 31, 50, 100,
+			200, 36, 105, 167, 85, 168, 80, 38, 126, 87, 57, 238, 186, 237,
+			10, 227, 143, 83, 104, 13, 156, 153, 202, 56, 23, 7, 161, 23,
+			102, 6, 85, 71, 158, 1, 201, 19, 104, 201, 71, 100, 24, 25,
+			132, 120, 223, 114, 99, 24, 56, 54, 120, 230, 109, 76, 13, 191,
+			65, 48, 234, 148, 233, 88, 15, 30, 26, 97, 255, 92, 192, 111,
+			58, 214, 171, 205, 195, 197, 159, 135, 62, 186, 15, 100, 69, 92,
+			58, 218, 133, 32, 91, 25, 154, 37, 157, 51, 126, 230, 12, 162,
+			59, 156, 161, 231, 8, 117, 24, 107, 55, 238, 57, 147, 147, 212,
+			89, 22, 54, 239, 13, 215, 111, 119, 113, 0, 22, 226, 177, 206,
+			88, 190, 121, 37, 15, 134, 3, 238, 69, 17, 24, 99, 87, 62,
+			12, 251, 76, 109, 241, 177, 217, 133, 218, 252, 218, 108, 253, 234,
+			234, 245, 234, 98, 227, 153, 73, 61, 60, 211, 192, 16, 116, 10,
+			3, 58, 52, 194, 190, 46, 134, 103, 57, 214, 101, 211, 41, 254,
+			249, 129, 195, 203, 48, 219, 151, 28, 97, 54, 62, 55, 45, 53,
+			248, 69, 197, 48, 121, 210, 194, 34, 251, 158, 188, 152, 32, 13,
+			155, 32, 77, 87, 141, 90, 159, 86, 211, 11, 49, 19, 234, 22,
+			149, 23, 113, 129, 57, 117, 76, 11, 231, 68, 236, 122, 148, 75,
+			235, 145, 174, 95, 236, 164, 56, 33, 243, 112, 228, 197, 221, 182,
+			198, 44, 117, 119, 58, 150, 207, 109, 53, 189, 20, 55, 150, 129,
+			241, 31, 82, 41, 211, 177, 46, 23, 70, 217, 143, 10, 220, 216,
+			142, 117, 213, 28, 45, 254, 131, 3, 113, 227, 7, 223, 58, 106,
+			20, 23, 146, 71, 221, 89, 71, 50, 29, 70, 64, 89, 227, 178,
+			150, 81, 109, 21, 21, 224, 218, 6, 0, 28, 150, 192, 219, 166,
+			99, 93, 61, 92, 96, 63, 46, 128, 207, 57, 214, 130, 89, 40,
+			190, 239, 96, 224, 183, 183, 187, 9, 196, 166, 151, 132, 93, 173,
+			40, 56, 28, 64, 228, 235, 153, 51, 24, 210, 200, 40, 207, 93,
+			156, 177, 10, 173, 53, 181, 139, 200, 120, 79, 130, 93, 210, 72,
+			35, 143, 206, 31, 244, 8, 114, 6, 160, 28, 82, 41, 211, 177,
+			22, 70, 14, 179, 31, 50, 105, 4, 121, 199, 170, 155, 183, 21,
+			223, 106, 234, 17, 72, 230, 62, 161, 44, 228, 147, 18, 72, 24,
+			111, 2, 222, 13, 112, 238, 14, 246, 221, 246, 227, 228, 69, 70,
+			166, 238, 0, 2, 70, 57, 49, 89, 107, 132, 116, 204, 199, 249,
+			3, 244, 59, 140, 56, 192, 107, 100, 235, 126, 18, 33, 138, 141,
+			8, 67, 83, 230, 81, 38, 50, 33, 170, 203, 232, 52, 112, 193,
+			133, 251, 187, 82, 230, 193, 248, 213, 244, 87, 248, 172, 14, 191,
+			212, 150, 175, 78, 160, 151, 211, 177, 172, 13, 144, 194, 40, 139,
+			189, 88, 206, 147, 196, 81, 222, 0, 86, 10, 42, 101, 58, 86,
+			125, 236, 8, 251, 187, 2, 99, 253, 142, 245, 58, 115, 188, 248,
+			229, 116, 206, 229, 152, 117, 140, 13, 225, 20, 31, 203, 87, 44,
+			197, 201, 132, 226, 244, 50, 12, 222, 134, 10, 111, 123, 11, 12,
+			202, 72, 64, 56, 49, 83, 227, 146, 10, 56, 181, 38, 91, 201,
+			144, 73, 153, 245, 116, 39, 10, 164, 6, 86, 0, 4, 204, 52,
+			84, 163, 217, 88, 97, 46, 164, 118, 204, 7, 156, 151, 217, 190,
+			86, 16, 84, 242, 185, 142, 48, 51, 8, 140, 244, 27, 192, 193,
+			152, 74, 153, 142, 245, 186, 219, 239, 88, 207, 147, 124, 120, 158,
+			125, 250, 156, 86, 146, 149, 212, 52, 157, 40, 169, 73, 42, 201,
+			135, 247, 136, 85, 165, 75, 108, 80, 75, 86, 206, 56, 235, 143,
+			189, 38, 60, 62, 199, 13, 110, 76, 88, 117, 149, 116, 142, 176,
+			92, 224, 6, 97, 60, 110, 114, 99, 34, 87, 23, 137, 203, 111,
+			53, 216, 88, 70, 117, 84, 141, 94, 30, 209, 77, 42, 221, 113,
+			102, 191, 238, 168, 74, 79, 227, 168, 63, 158, 166, 167, 233, 83,
+			120, 59, 235, 127, 101, 24, 31, 49, 173, 171, 203, 151, 63, 110,
+			222, 45, 20, 193, 202, 178, 172, 82, 121, 220, 107, 183, 95, 139,
+			10, 13, 212, 125, 244, 95, 78, 179, 126, 39, 119, 119, 223, 123,
+			12, 131, 253, 198, 48, 41, 148, 119, 247, 57, 51, 191, 54, 172,
+			175, 128, 169, 251, 95, 124, 42, 189, 180, 70, 47, 170, 192, 81,
+			53, 146, 50, 143, 176, 186, 177, 30, 45, 244, 172, 214, 66, 107,
+			65, 19, 1, 150, 218, 109, 78, 26, 106, 172, 175, 194, 85, 216,
+			55, 251, 222, 55, 99, 188, 238, 105, 93, 12, 26, 130, 122, 187,
+			200, 87, 65, 96, 177, 17, 240, 117, 63, 192, 154, 4, 92, 234,
+			104, 145, 222, 125, 37, 39, 59, 198, 183, 195, 150, 14, 130, 83,
+			166, 147, 166, 52, 156, 165, 90, 148, 41, 99, 72, 157, 73, 49,
+			195, 190, 14, 10, 71, 247, 193, 132, 22, 129, 195, 129, 94, 192,
+			226, 189, 222, 194, 180, 51, 69, 158, 126, 250, 87, 184, 57, 52,
+			21, 198, 64, 197, 9, 221, 14, 38, 145, 5, 203, 31, 45, 100,
+			123, 12, 90, 123, 192, 105, 249, 113, 179, 237, 250, 219, 8, 178,
+			120, 11, 32, 252, 32, 139, 11, 5, 132, 188, 221, 146, 194, 193,
+			82, 64, 190, 45, 56, 212, 133, 151, 61, 126, 201, 110, 208, 154,
+			134, 144, 78, 156, 113, 219, 197, 161, 138, 219, 206, 108, 127, 202,
+			98, 144, 81, 196, 149, 67, 45, 48, 187, 40, 181, 101, 245, 182,
+			14, 0, 202, 210, 86, 32, 21, 100, 124, 139, 165, 105, 27, 35,
+			10, 4, 34, 194, 72, 139, 128, 228, 110, 10, 246, 16, 180, 194,
+			8, 129, 192, 232, 166, 207, 54, 156, 11, 4, 78, 146, 184, 231,
+			69, 80, 233, 41, 162, 12, 5, 218, 77, 83, 171, 164, 157, 8,
+			194, 250, 78, 4, 218, 9, 50, 90, 56, 20, 205, 198, 181, 218,
+			10, 95, 89, 186, 210, 120, 124, 182, 94, 229, 181, 21, 190, 92,
+			95, 122, 172, 54, 95, 157, 231, 151, 159, 224, 141, 107, 85, 62,
+			183, 180, 252, 68, 189, 118, 245, 90, 131, 95, 91, 90, 152, 175,
+			214, 87, 248, 236, 226, 60, 76, 6, 141, 122, 237, 242, 106, 99,
+			169, 190, 194, 180, 153, 1, 95, 96, 62, 168, 190, 110, 185, 94,
+			93, 33, 219, 66, 237, 250, 242, 66, 173, 58, 159, 177, 56, 148,
+			121, 109, 113, 110, 97, 117, 190, 182, 120, 181, 204, 47, 175, 54,
+			248, 226, 82, 131, 241, 133, 218, 245, 90, 163, 58, 207, 27, 75,
+			101, 234, 118, 127, 61, 216, 38, 174, 87, 235, 115, 215, 102, 23,
+			27, 179, 151, 107, 11, 181, 198, 19, 4, 202, 149, 90, 99, 17,
+			157, 93, 89, 170, 227, 104, 127, 121, 182, 222, 168, 205, 173, 46,
+			204, 214, 249, 242, 106, 125, 121, 105, 165, 202, 49, 178, 249, 218,
+			202, 220, 194, 108, 237, 122, 117, 190, 194, 107, 139, 124, 113, 137,
+			87, 31, 171, 46, 54, 248, 202, 181, 217, 133, 133, 222, 129, 50,
+			190, 244, 248, 98, 181, 46, 45, 35, 122, 152, 252, 114, 149, 47,
+			212, 160, 52, 242, 43, 75, 117, 50, 147, 204, 215, 234, 213, 185,
+			6, 6, 148, 254, 154, 171, 205, 87, 23, 27, 179, 11, 101, 198,
+			201, 190, 56, 187, 80, 230, 213, 215, 85, 175, 47, 47, 204, 214,
+			159, 40, 203, 70, 87, 170, 223, 189, 90, 93, 108, 212, 102, 23,
+			248, 252, 236, 245, 217, 171, 213, 21, 62, 241, 82, 88, 89, 174,
+			47, 205, 173, 214, 171, 144, 144, 129, 138, 149, 213, 203, 43, 141,
+			90, 99, 181, 81, 229, 87, 151, 150, 230, 9, 217, 43, 213, 250,
+			99, 181, 185, 234, 202, 37, 190, 176, 4, 244, 95, 225, 171, 43,
+			213, 50, 227, 243, 179, 141, 89, 234, 122, 185, 190, 116, 165, 214,
+			88, 185, 132, 223, 151, 87, 87, 106, 132, 184, 218, 98, 163, 90,
+			175, 175, 146, 245, 115, 146, 95, 91, 122, 188, 250, 88, 181, 206,
+			231, 102, 87, 87, 170, 152, 206, 121, 190, 180, 136, 209, 130, 86,
+			170, 75, 245, 39, 208, 44, 240, 64, 51, 80, 230, 143, 95, 171,
+			54, 174, 85, 235, 64, 42, 97, 107, 22, 104, 88, 105, 212, 107,
+			115, 141, 108, 177, 165, 58, 111, 44, 213, 27, 44, 51, 78, 190,
+			88, 189, 186, 80, 187, 90, 93, 156, 171, 2, 158, 37, 52, 243,
+			120, 109, 165, 58, 201, 103, 235, 181, 21, 20, 168, 81, 199, 252,
+			241, 217, 39, 56, 172, 86, 75, 87, 0, 2, 198, 196, 0, 68,
+			15, 233, 150, 105, 62, 121, 237, 10, 159, 157, 127, 172, 6, 200,
+			101, 233, 229, 165, 149, 149, 154, 36, 23, 66, 219, 220, 53, 137,
+			115, 109, 96, 226, 125, 227, 210, 2, 84, 234, 187, 68, 22, 160,
+			83, 226, 167, 200, 60, 209, 119, 156, 50, 143, 139, 159, 34, 243,
+			100, 95, 77, 217, 159, 240, 83, 100, 158, 234, 43, 43, 171, 18,
+			126, 138, 204, 123, 251, 166, 149, 253, 9, 63, 69, 230, 233, 212,
+			82, 117, 90, 91, 170, 38, 250, 238, 81, 246, 39, 252, 252, 255,
+			220, 197, 76, 92, 55, 254, 126, 3, 91, 95, 241, 183, 239, 226,
+			179, 92, 111, 189, 189, 222, 53, 34, 202, 47, 216, 26, 204, 38,
+			126, 160, 67, 230, 170, 232, 149, 216, 121, 249, 243, 176, 252, 211,
+			179, 14, 77, 183, 205, 16, 90, 17, 142, 180, 81, 57, 19, 81,
+			154, 60, 117, 186, 1, 169, 31, 82, 58, 32, 94, 186, 17, 185,
+			242, 241, 153, 236, 135, 132, 113, 18, 21, 40, 141, 29, 51, 108,
+			19, 75, 23, 18, 145, 104, 136, 236, 24, 109, 241, 90, 130, 60,
+			223, 235, 132, 240, 216, 73, 248, 106, 99, 142, 111, 251, 173, 0,
+			27, 46, 167, 40, 192, 110, 208, 197, 54, 112, 174, 204, 207, 61,
+			244, 224, 217, 178, 98, 212, 157, 40, 108, 227, 165, 243, 38, 191,
+			26, 121, 155, 97, 228, 187, 129, 134, 94, 58, 0, 145, 131, 50,
+			238, 178, 128, 65, 31, 80, 74, 191, 185, 4, 173, 116, 215, 115,
+			35, 28, 143, 170, 87, 127, 182, 253, 160, 155, 72, 119, 142, 7,
+			206, 234, 241, 225, 14, 122, 5, 231, 236, 157, 116, 200, 145, 199,
+			75, 241, 182, 7, 223, 232, 18, 167, 115, 18, 23, 190, 54, 112,
+			63, 233, 48, 89, 140, 147, 220, 75, 90, 144, 231, 169, 71, 159,
+			180, 135, 175, 220, 208, 69, 56, 9, 151, 63, 53, 115, 97, 106,
+			11, 239, 12, 193, 225, 0, 78, 60, 212, 250, 211, 19, 47, 46,
+			116, 96, 62, 167, 169, 228, 164, 122, 162, 41, 34, 41, 7, 226,
+			114, 20, 110, 243, 179, 103, 207, 158, 155, 162, 255, 26, 103, 207,
+			94, 164, 255, 158, 196, 208, 31, 122, 232, 161, 135, 166, 206, 205,
+			76, 157, 63, 215, 152, 57, 127, 241, 254, 135, 46, 222, 255, 80,
+			229, 33, 245, 239, 201, 10, 191, 188, 203, 210, 199, 134, 148, 163,
+			51, 14, 203, 208, 58, 34, 213, 115, 47, 136, 73, 185, 135, 228,
+			177, 67, 79, 47, 169, 224, 211, 40, 44, 136, 37, 220, 230, 79,
+			213, 175, 204, 49, 126, 254, 252, 249, 135, 210, 177, 192, 88, 238,
+			123, 201, 6, 153, 202, 163, 141, 230, 116, 180, 209, 68, 137, 74,
+			242, 92, 50, 201, 91, 208, 198, 210, 8, 197, 252, 132, 182, 130,
+			166, 6, 81, 126, 238, 34, 159, 11, 183, 59, 221, 196, 203, 172,
+			5, 234, 112, 121, 105, 165, 246, 58, 254, 12, 48, 51, 49, 249,
+			76, 69, 138, 60, 105, 33, 45, 124, 202, 39, 68, 116, 186, 18,
+			123, 201, 154, 156, 224, 9, 228, 78, 44, 174, 46, 44, 76, 78,
+			30, 88, 142, 232, 125, 226, 236, 228, 165, 12, 76, 51, 47, 5,
+			211, 38, 2, 230, 110, 123, 225, 70, 203, 221, 205, 192, 38, 94,
+			50, 160, 53, 123, 211, 109, 243, 228, 166, 236, 177, 167, 248, 189,
+			201, 205, 50, 39, 128, 46, 125, 171, 67, 186, 89, 73, 110, 98,
+			128, 47, 54, 34, 81, 168, 27, 123, 77, 126, 134, 159, 59, 123,
+			182, 119, 132, 231, 111, 57, 194, 199, 253, 224, 252, 12, 127, 230,
+			170, 151, 172, 208, 161, 48, 62, 207, 198, 112, 28, 110, 244, 78,
+			196, 149, 218, 66, 181, 81, 187, 94, 229, 27, 137, 4, 227, 86,
+			117, 238, 221, 72, 20, 164, 171, 181, 197, 198, 3, 23, 120, 226,
+			55, 111, 196, 252, 97, 62, 49, 49, 33, 114, 38, 55, 146, 74,
+			107, 231, 154, 191, 185, 53, 239, 38, 84, 107, 146, 191, 250, 213,
+			252, 252, 204, 36, 127, 19, 167, 111, 11, 225, 142, 250, 164, 240,
+			6, 71, 36, 254, 184, 31, 180, 194, 157, 152, 154, 196, 10, 61,
+			119, 246, 108, 134, 135, 197, 21, 93, 64, 112, 169, 115, 15, 236,
+			95, 70, 186, 53, 84, 63, 247, 192, 133, 11, 23, 30, 60, 255,
+			192, 217, 148, 109, 72, 47, 188, 213, 192, 127, 78, 242, 58, 48,
+			179, 189, 173, 84, 190, 181, 201, 156, 16, 227, 231, 19, 19, 24,
+			65, 204, 167, 105, 178, 240, 223, 36, 159, 202, 130, 243, 18, 20,
+			140, 118, 206, 207, 164, 237, 156, 202, 180, 67, 4, 48, 217, 67,
+			0, 23, 110, 73, 0, 8, 8, 206, 159, 17, 147, 95, 145, 238,
+			133, 40, 114, 221, 111, 183, 253, 56, 67, 0, 224, 166, 124, 155,
+			114, 249, 195, 252, 214, 21, 94, 132, 204, 249, 195, 105, 110, 37,
+			240, 118, 46, 227, 238, 189, 23, 77, 76, 98, 96, 43, 18, 67,
+			178, 11, 129, 152, 73, 229, 242, 206, 57, 202, 44, 18, 173, 35,
+			196, 63, 70, 46, 75, 138, 161, 203, 97, 3, 5, 147, 147, 21,
+			186, 213, 79, 176, 164, 56, 184, 255, 37, 112, 80, 11, 240, 192,
+			89, 82, 9, 194, 157, 204, 176, 101, 46, 189, 154, 254, 48, 239,
+			41, 243, 162, 35, 77, 1, 127, 233, 33, 7, 225, 78, 101, 211,
+			75, 170, 32, 54, 145, 55, 49, 153, 25, 121, 239, 232, 101, 97,
+			36, 38, 110, 49, 210, 7, 110, 57, 82, 57, 95, 74, 206, 224,
+			203, 187, 201, 22, 148, 160, 61, 132, 150, 157, 168, 137, 201, 61,
+			31, 43, 87, 189, 100, 46, 157, 247, 137, 73, 226, 245, 143, 174,
+			44, 45, 242, 235, 34, 88, 33, 99, 120, 184, 130, 114, 160, 17,
+			186, 242, 33, 140, 20, 22, 24, 21, 122, 159, 194, 224, 174, 220,
+			58, 164, 204, 192, 104, 3, 250, 166, 246, 31, 245, 200, 162, 142,
+			132, 76, 205, 200, 247, 9, 253, 152, 151, 222, 8, 185, 225, 205,
+			83, 111, 220, 14, 131, 100, 235, 205, 83, 111, 108, 185, 187, 111,
+			110, 188, 17, 155, 247, 155, 47, 190, 113, 219, 15, 222, 124, 241,
+			141, 177, 215, 124, 243, 83, 149, 55, 66, 92, 194, 146, 125, 243,
+			211, 79, 150, 152, 188, 14, 34, 106, 3, 106, 121, 235, 75, 158,
+			171, 122, 234, 177, 141, 13, 72, 1, 45, 127, 19, 110, 96, 34,
+			64, 183, 236, 169, 204, 169, 171, 50, 227, 162, 179, 50, 167, 222,
+			132, 17, 150, 186, 76, 253, 202, 59, 244, 208, 1, 118, 98, 60,
+			27, 40, 91, 131, 67, 43, 198, 133, 16, 241, 66, 142, 131, 252,
+			39, 89, 74, 230, 213, 61, 190, 25, 242, 110, 7, 149, 31, 82,
+			85, 197, 173, 73, 145, 121, 238, 96, 105, 15, 209, 40, 178, 7,
+			26, 162, 167, 210, 147, 37, 30, 119, 55, 240, 116, 113, 214, 10,
+			231, 17, 29, 144, 36, 58, 81, 90, 109, 204, 149, 38, 47, 245,
+			228, 246, 28, 124, 193, 239, 141, 12, 73, 231, 5, 49, 168, 103,
+			157, 16, 49, 69, 184, 179, 72, 84, 194, 184, 178, 218, 152, 227,
+			19, 110, 106, 243, 131, 3, 51, 227, 165, 39, 75, 147, 152, 0,
+			28, 194, 248, 129, 14, 178, 189, 135, 148, 128, 72, 183, 167, 43,
+			21, 152, 69, 121, 205, 48, 78, 50, 29, 36, 156, 38, 185, 19,
+			192, 1, 148, 36, 88, 212, 149, 126, 194, 114, 12, 241, 62, 56,
+			32, 246, 10, 63, 156, 201, 125, 23, 156, 75, 51, 103, 207, 61,
+			56, 117, 246, 220, 212, 185, 251, 27, 103, 207, 93, 60, 127, 246,
+			226, 185, 251, 43, 103, 207, 61, 89, 146, 212, 29, 115, 74, 235,
+			237, 69, 184, 238, 80, 73, 234, 63, 12, 82, 185, 249, 254, 50,
+			92, 43, 30, 172, 200, 5, 164, 223, 106, 192, 235, 47, 189, 162,
+			154, 203, 177, 61, 202, 184, 35, 152, 92, 245, 140, 40, 30, 227,
+			212, 113, 152, 152, 126, 179, 145, 241, 167, 146, 176, 182, 178, 180,
+			66, 139, 108, 98, 242, 0, 1, 181, 178, 29, 62, 239, 183, 219,
+			46, 73, 119, 94, 48, 181, 186, 50, 221, 10, 155, 241, 244, 227,
+			222, 250, 116, 10, 202, 116, 93, 185, 26, 78, 95, 109, 135, 235,
+			110, 123, 109, 137, 96, 136, 167, 1, 208, 116, 166, 147, 73, 21,
+			203, 168, 2, 110, 32, 56, 13, 110, 249, 41, 144, 248, 51, 144,
+			24, 65, 78, 21, 245, 227, 25, 53, 32, 12, 117, 221, 83, 163,
+			149, 55, 213, 247, 13, 145, 241, 167, 158, 137, 147, 104, 131, 170,
+			102, 70, 20, 54, 227, 74, 135, 250, 163, 177, 204, 76, 183, 253,
+			117, 216, 207, 201, 128, 89, 217, 74, 182, 219, 39, 232, 151, 170,
+			139, 27, 183, 201, 22, 211, 132, 172, 58, 129, 5, 134, 159, 62,
+			245, 196, 212, 169, 237, 169, 83, 173, 198, 169, 107, 23, 79, 93,
+			191, 120, 106, 165, 114, 106, 227, 201, 211, 21, 190, 224, 223, 240,
+			112, 47, 133, 212, 28, 32, 40, 157, 37, 122, 175, 1, 173, 61,
+			26, 182, 92, 98, 165, 167, 99, 254, 212, 51, 181, 149, 37, 37,
+			212, 92, 161, 30, 104, 224, 82, 208, 122, 122, 130, 101, 157, 82,
+			158, 13, 91, 98, 38, 240, 99, 10, 80, 194, 119, 135, 38, 68,
+			229, 210, 112, 166, 5, 172, 211, 251, 219, 166, 113, 170, 14, 78,
+			205, 204, 159, 154, 153, 103, 124, 18, 180, 162, 61, 100, 148, 39,
+			99, 196, 155, 110, 135, 22, 72, 184, 161, 252, 213, 136, 132, 212,
+			50, 147, 135, 185, 26, 255, 120, 67, 128, 226, 28, 244, 25, 142,
+			253, 253, 198, 192, 40, 251, 17, 132, 7, 193, 137, 174, 253, 131,
+			134, 121, 164, 248, 247, 13, 94, 79, 53, 92, 69, 251, 225, 6,
+			145, 60, 224, 230, 177, 31, 52, 179, 82, 22, 59, 88, 204, 226,
+			215, 101, 216, 251, 23, 83, 139, 216, 65, 122, 209, 147, 226, 41,
+			46, 188, 124, 32, 157, 77, 133, 179, 229, 15, 26, 102, 191, 74,
+			226, 225, 55, 99, 224, 176, 74, 90, 72, 58, 99, 34, 14, 46,
+			57, 81, 190, 219, 48, 29, 196, 193, 93, 12, 131, 169, 192, 219,
+			20, 122, 176, 226, 194, 177, 244, 29, 161, 209, 65, 35, 62, 144,
+			191, 86, 248, 162, 172, 168, 21, 76, 121, 64, 14, 170, 203, 52,
+			70, 134, 83, 241, 88, 32, 93, 68, 14, 178, 125, 82, 211, 242,
+			100, 93, 58, 64, 10, 5, 125, 35, 140, 160, 24, 43, 235, 193,
+			94, 132, 73, 165, 177, 44, 255, 207, 14, 64, 10, 60, 57, 223,
+			157, 34, 5, 126, 156, 239, 54, 6, 14, 169, 164, 133, 100, 97,
+			84, 159, 100, 124, 233, 40, 187, 127, 51, 172, 52, 183, 162, 112,
+			219, 239, 110, 19, 145, 182, 187, 77, 127, 90, 156, 124, 182, 214,
+			167, 169, 224, 244, 205, 115, 211, 240, 234, 220, 112, 155, 137, 60,
+			223, 40, 160, 88, 69, 21, 171, 220, 60, 87, 124, 41, 191, 65,
+			93, 32, 61, 143, 80, 36, 41, 10, 148, 126, 202, 100, 3, 179,
+			178, 27, 199, 97, 54, 236, 179, 116, 62, 50, 88, 167, 223, 206,
+			113, 54, 164, 192, 88, 243, 91, 116, 68, 50, 88, 103, 42, 171,
+			214, 114, 142, 177, 193, 13, 47, 105, 110, 173, 117, 163, 246, 184,
+			69, 159, 7, 40, 99, 53, 106, 59, 11, 236, 136, 254, 184, 70,
+			190, 45, 164, 255, 143, 219, 220, 152, 24, 154, 41, 42, 157, 126,
+			191, 35, 76, 221, 81, 109, 84, 117, 45, 231, 30, 54, 44, 175,
+			207, 208, 253, 203, 241, 28, 245, 54, 36, 243, 112, 92, 226, 220,
+			197, 88, 236, 63, 239, 173, 145, 11, 233, 120, 158, 14, 122, 6,
+			145, 115, 25, 25, 206, 113, 54, 32, 75, 199, 227, 253, 220, 152,
+			24, 190, 108, 253, 231, 89, 187, 174, 51, 157, 59, 88, 255, 102,
+			51, 94, 235, 70, 254, 248, 0, 181, 158, 223, 108, 198, 171, 145,
+			127, 249, 220, 147, 211, 47, 111, 214, 46, 137, 9, 234, 172, 63,
+			250, 189, 183, 11, 79, 192, 71, 13, 246, 127, 79, 61, 1, 127,
+			185, 215, 19, 112, 230, 44, 201, 11, 11, 171, 115, 53, 62, 75,
+			175, 196, 144, 246, 255, 191, 156, 67, 224, 255, 246, 8, 252, 91,
+			244, 8, 60, 154, 122, 4, 78, 210, 79, 195, 177, 198, 250, 38,
+			165, 33, 245, 182, 190, 43, 202, 56, 139, 159, 191, 98, 146, 33,
+			213, 190, 187, 239, 81, 163, 248, 9, 68, 152, 35, 50, 208, 225,
+			211, 100, 188, 40, 151, 147, 214, 55, 157, 224, 224, 55, 115, 47,
+			211, 229, 200, 225, 106, 197, 203, 199, 91, 69, 140, 6, 237, 58,
+			0, 108, 75, 236, 184, 188, 225, 197, 73, 93, 186, 113, 192, 75,
+			138, 215, 130, 155, 202, 157, 31, 38, 71, 56, 137, 169, 140, 169,
+			54, 78, 21, 117, 235, 120, 1, 97, 139, 246, 43, 225, 225, 77,
+			179, 159, 104, 207, 47, 95, 21, 8, 194, 164, 140, 189, 148, 41,
+			65, 18, 174, 90, 123, 14, 144, 40, 198, 183, 24, 20, 143, 19,
+			175, 195, 219, 225, 166, 140, 187, 33, 222, 228, 192, 20, 196, 221,
+			78, 39, 140, 96, 167, 101, 120, 59, 141, 14, 60, 23, 225, 234,
+			237, 183, 46, 242, 135, 104, 159, 182, 16, 2, 210, 186, 123, 160,
+			192, 190, 215, 150, 155, 180, 53, 105, 58, 197, 47, 89, 124, 206,
+			13, 178, 61, 234, 32, 44, 36, 106, 101, 48, 38, 196, 21, 138,
+			219, 130, 203, 123, 183, 28, 62, 66, 187, 112, 94, 74, 191, 199,
+			211, 111, 172, 45, 62, 182, 52, 55, 139, 211, 137, 181, 218, 252,
+			155, 245, 158, 16, 79, 191, 17, 71, 61, 87, 102, 231, 26, 200,
+			47, 85, 84, 227, 192, 214, 148, 224, 65, 223, 124, 235, 168, 28,
+			79, 191, 113, 181, 190, 176, 86, 93, 153, 155, 93, 174, 206, 175,
+			53, 170, 43, 212, 131, 228, 116, 241, 244, 27, 235, 213, 149, 213,
+			133, 198, 75, 65, 35, 212, 190, 3, 90, 82, 33, 73, 208, 215,
+			154, 223, 226, 94, 220, 116, 59, 242, 132, 49, 61, 109, 206, 30,
+			166, 223, 216, 156, 14, 188, 100, 186, 27, 181, 167, 79, 44, 187,
+			201, 86, 149, 106, 240, 137, 24, 81, 23, 219, 113, 168, 43, 185,
+			126, 167, 210, 242, 110, 78, 159, 155, 153, 153, 148, 222, 88, 25,
+			176, 208, 177, 58, 244, 132, 94, 66, 239, 152, 106, 181, 63, 190,
+			200, 75, 113, 51, 242, 188, 32, 222, 10, 147, 74, 39, 216, 44,
+			149, 121, 41, 137, 16, 99, 101, 218, 133, 141, 22, 239, 160, 15,
+			43, 57, 200, 154, 52, 7, 84, 202, 112, 172, 201, 193, 67, 42,
+			101, 57, 214, 100, 97, 20, 23, 31, 64, 44, 134, 99, 77, 155,
+			71, 139, 191, 105, 200, 48, 40, 237, 244, 178, 130, 118, 145, 84,
+			88, 44, 35, 4, 220, 27, 186, 130, 27, 43, 227, 127, 239, 66,
+			171, 48, 126, 125, 246, 9, 33, 224, 196, 109, 55, 222, 82, 126,
+			90, 215, 87, 87, 232, 44, 81, 94, 235, 70, 11, 144, 173, 80,
+			4, 254, 202, 215, 150, 86, 23, 230, 245, 102, 128, 227, 0, 89,
+			187, 130, 227, 250, 77, 239, 185, 139, 252, 245, 79, 61, 117, 113,
+			39, 140, 90, 23, 159, 126, 122, 226, 169, 167, 46, 146, 194, 120,
+			241, 233, 167, 223, 120, 182, 60, 115, 255, 133, 55, 167, 31, 39,
+			31, 57, 169, 240, 96, 228, 48, 58, 133, 7, 120, 227, 77, 15,
+			30, 81, 41, 203, 177, 166, 239, 24, 103, 1, 173, 25, 211, 177,
+			206, 155, 119, 20, 93, 62, 203, 101, 12, 143, 120, 43, 140, 146,
+			169, 54, 157, 227, 174, 214, 23, 176, 126, 104, 203, 167, 81, 171,
+			253, 120, 47, 134, 36, 163, 165, 73, 63, 72, 176, 208, 51, 100,
+			230, 208, 161, 130, 12, 142, 116, 231, 7, 29, 149, 178, 28, 235,
+			252, 109, 183, 179, 5, 130, 204, 114, 172, 7, 204, 251, 139, 143,
+			224, 105, 242, 32, 109, 84, 122, 226, 198, 21, 94, 219, 144, 191,
+			91, 120, 146, 105, 74, 187, 80, 97, 141, 43, 209, 73, 247, 107,
+			229, 209, 220, 49, 149, 50, 28, 235, 129, 59, 207, 170, 20, 186,
+			58, 127, 129, 253, 93, 65, 25, 182, 99, 93, 50, 139, 197, 132,
+			95, 247, 90, 190, 140, 209, 178, 127, 184, 11, 96, 90, 176, 47,
+			164, 28, 185, 132, 187, 40, 211, 157, 182, 235, 7, 37, 50, 130,
+			164, 100, 187, 175, 168, 191, 237, 110, 122, 211, 32, 230, 74, 26,
+			116, 77, 67, 107, 231, 0, 131, 194, 18, 188, 210, 46, 13, 222,
+			166, 82, 150, 99, 93, 26, 63, 202, 222, 45, 160, 205, 57, 214,
+			119, 153, 119, 20, 223, 98, 240, 21, 255, 121, 13, 40, 118, 146,
+			10, 235, 225, 131, 126, 192, 87, 225, 4, 43, 131, 22, 235, 27,
+			127, 217, 139, 251, 153, 33, 114, 63, 192, 209, 15, 184, 59, 92,
+			242, 24, 108, 14, 59, 224, 204, 46, 238, 160, 222, 128, 231, 167,
+			120, 26, 48, 9, 67, 241, 234, 167, 134, 62, 71, 48, 245, 171,
+			148, 225, 88, 223, 53, 160, 230, 56, 103, 57, 214, 119, 221, 118,
+			59, 251, 127, 8, 232, 243, 142, 117, 197, 188, 92, 252, 37, 131,
+			207, 221, 154, 178, 212, 85, 218, 212, 91, 91, 58, 250, 185, 55,
+			60, 220, 168, 226, 151, 221, 164, 185, 53, 71, 126, 115, 106, 226,
+			227, 9, 120, 26, 227, 20, 13, 199, 22, 94, 132, 128, 197, 113,
+			89, 60, 100, 238, 198, 124, 193, 143, 147, 76, 81, 4, 131, 163,
+			69, 216, 9, 59, 20, 172, 47, 245, 245, 133, 22, 150, 113, 60,
+			84, 23, 202, 133, 47, 166, 30, 114, 62, 135, 129, 168, 33, 195,
+			165, 236, 202, 64, 65, 165, 44, 199, 186, 50, 166, 150, 95, 126,
+			192, 177, 174, 220, 54, 203, 14, 179, 1, 212, 27, 248, 208, 64,
+			159, 99, 93, 185, 227, 17, 54, 75, 8, 233, 119, 172, 154, 121,
+			91, 241, 2, 109, 230, 87, 231, 86, 248, 106, 189, 182, 23, 37,
+			2, 247, 167, 227, 204, 35, 143, 87, 231, 86, 52, 44, 253, 57,
+			180, 161, 136, 7, 238, 91, 181, 65, 5, 75, 191, 229, 88, 181,
+			177, 35, 90, 233, 249, 205, 87, 177, 243, 47, 83, 233, 193, 45,
+			218, 48, 120, 73, 149, 231, 150, 26, 205, 14, 235, 127, 204, 197,
+			25, 105, 226, 92, 96, 86, 203, 219, 24, 55, 184, 53, 49, 52,
+			83, 170, 236, 109, 171, 34, 203, 85, 230, 189, 13, 122, 57, 191,
+			142, 226, 197, 7, 216, 128, 202, 112, 10, 204, 186, 225, 237, 74,
+			133, 8, 63, 225, 44, 70, 115, 38, 53, 33, 145, 184, 104, 190,
+			202, 40, 93, 96, 76, 152, 135, 150, 93, 63, 122, 185, 53, 75,
+			255, 23, 131, 29, 186, 234, 39, 184, 86, 136, 11, 233, 62, 105,
+			97, 91, 97, 156, 200, 170, 244, 27, 206, 107, 157, 40, 132, 161,
+			72, 214, 86, 73, 244, 19, 121, 27, 82, 241, 178, 34, 111, 3,
+			26, 27, 112, 232, 39, 107, 91, 110, 188, 69, 170, 214, 96, 157,
+			137, 172, 107, 110, 188, 229, 20, 217, 64, 39, 140, 201, 85, 136,
+			84, 40, 171, 174, 211, 165, 14, 27, 190, 234, 69, 145, 159, 204,
+			145, 219, 216, 55, 9, 204, 237, 44, 47, 220, 205, 8, 30, 171,
+			46, 83, 212, 35, 214, 78, 236, 37, 4, 143, 85, 215, 233, 82,
+			155, 141, 136, 129, 47, 75, 24, 190, 109, 4, 100, 199, 167, 122,
+			147, 233, 210, 59, 13, 54, 214, 219, 93, 29, 240, 58, 175, 102,
+			3, 158, 27, 225, 161, 85, 129, 248, 161, 25, 190, 159, 94, 246,
+			84, 212, 53, 156, 87, 177, 60, 86, 115, 44, 128, 123, 57, 117,
+			101, 249, 210, 142, 112, 81, 20, 64, 60, 176, 15, 136, 23, 211,
+			144, 211, 238, 103, 246, 116, 255, 98, 181, 84, 199, 75, 108, 80,
+			92, 185, 173, 123, 27, 206, 171, 88, 255, 166, 160, 65, 217, 239,
+			157, 251, 7, 47, 137, 180, 238, 109, 92, 235, 171, 171, 226, 151,
+			7, 88, 94, 92, 135, 45, 45, 48, 150, 22, 249, 118, 231, 240,
+			91, 81, 183, 255, 127, 228, 40, 57, 210, 247, 147, 198, 139, 233,
+			219, 231, 30, 250, 223, 250, 246, 255, 154, 250, 246, 255, 193, 222,
+			191, 6, 199, 145, 101, 119, 98, 120, 229, 163, 138, 133, 11, 62,
+			128, 228, 11, 76, 190, 110, 131, 47, 128, 4, 10, 124, 116, 247,
+			52, 209, 77, 78, 3, 32, 216, 68, 15, 95, 13, 128, 211, 61,
+			219, 221, 3, 38, 170, 178, 128, 108, 22, 170, 208, 153, 85, 36,
+			209, 61, 173, 153, 255, 60, 52, 127, 189, 119, 165, 145, 86, 33,
+			173, 118, 53, 122, 172, 173, 144, 180, 150, 86, 97, 41, 52, 107,
+			217, 17, 90, 141, 45, 75, 187, 178, 188, 218, 149, 37, 133, 229,
+			29, 107, 87, 235, 80, 132, 245, 65, 94, 109, 216, 14, 71, 172,
+			28, 191, 115, 207, 189, 153, 89, 40, 144, 236, 214, 206, 200, 31,
+			216, 17, 195, 193, 173, 204, 188, 247, 220, 115, 95, 231, 158, 199,
+			239, 252, 127, 229, 190, 173, 35, 240, 204, 37, 27, 127, 254, 169,
+			80, 151, 236, 179, 133, 231, 44, 255, 15, 224, 137, 119, 47, 220,
+			24, 167, 253, 30, 57, 200, 24, 236, 124, 25, 84, 180, 154, 192,
+			125, 166, 147, 7, 39, 46, 223, 175, 171, 1, 181, 72, 147, 147,
+			98, 226, 205, 175, 90, 155, 31, 62, 4, 28, 143, 58, 135, 83,
+			136, 2, 24, 128, 232, 42, 108, 110, 194, 112, 37, 74, 159, 223,
+			90, 24, 147, 175, 220, 190, 163, 93, 108, 211, 7, 140, 250, 110,
+			112, 193, 91, 177, 140, 59, 77, 232, 64, 101, 189, 17, 172, 0,
+			170, 109, 202, 208, 153, 1, 114, 171, 6, 235, 128, 197, 75, 178,
+			253, 160, 119, 83, 130, 245, 229, 67, 15, 69, 143, 90, 40, 43,
+			84, 163, 129, 123, 51, 62, 51, 245, 8, 249, 74, 39, 66, 26,
+			127, 224, 203, 160, 87, 61, 190, 85, 209, 135, 116, 155, 38, 145,
+			45, 238, 52, 66, 185, 130, 207, 112, 189, 15, 218, 242, 94, 184,
+			145, 168, 11, 209, 114, 200, 161, 35, 6, 169, 32, 173, 135, 92,
+			16, 166, 52, 30, 145, 242, 70, 15, 107, 153, 0, 20, 29, 173,
+			150, 165, 16, 211, 60, 108, 82, 210, 16, 196, 80, 32, 151, 44,
+			246, 139, 136, 176, 55, 215, 3, 149, 81, 119, 68, 165, 153, 136,
+			72, 127, 82, 111, 4, 247, 162, 198, 198, 40, 93, 36, 148, 40,
+			27, 173, 65, 231, 128, 94, 101, 209, 38, 34, 200, 131, 10, 227,
+			19, 149, 5, 178, 26, 198, 180, 19, 212, 162, 53, 134, 182, 84,
+			184, 64, 58, 19, 236, 131, 112, 153, 184, 167, 132, 126, 51, 61,
+			54, 185, 82, 75, 153, 68, 240, 158, 13, 99, 216, 248, 170, 97,
+			146, 200, 40, 105, 53, 104, 222, 143, 142, 129, 43, 36, 109, 198,
+			161, 225, 89, 0, 22, 178, 150, 92, 15, 55, 227, 103, 118, 19,
+			19, 168, 184, 76, 210, 150, 131, 152, 165, 164, 19, 181, 67, 24,
+			144, 24, 59, 247, 150, 6, 32, 131, 241, 10, 28, 194, 72, 100,
+			215, 0, 69, 13, 172, 70, 53, 192, 102, 234, 216, 41, 172, 11,
+			70, 205, 97, 71, 181, 199, 143, 245, 205, 91, 79, 52, 222, 236,
+			15, 207, 143, 211, 85, 10, 148, 0, 216, 163, 245, 184, 161, 154,
+			118, 170, 169, 208, 243, 98, 53, 130, 52, 188, 65, 149, 229, 12,
+			153, 81, 93, 190, 114, 83, 6, 49, 95, 215, 130, 53, 56, 124,
+			232, 62, 166, 205, 172, 7, 17, 160, 159, 137, 233, 200, 232, 221,
+			92, 65, 8, 142, 164, 192, 63, 245, 61, 71, 41, 38, 25, 100,
+			3, 147, 44, 59, 63, 23, 113, 57, 97, 50, 97, 172, 54, 164,
+			145, 249, 115, 134, 207, 206, 177, 44, 17, 68, 27, 173, 156, 229,
+			78, 245, 94, 216, 158, 164, 70, 72, 249, 172, 126, 24, 147, 52,
+			197, 134, 171, 209, 176, 122, 139, 92, 76, 114, 175, 209, 47, 250,
+			189, 70, 212, 236, 60, 28, 143, 195, 6, 189, 158, 78, 128, 201,
+			236, 196, 16, 124, 92, 65, 175, 83, 77, 242, 167, 186, 46, 76,
+			36, 113, 149, 116, 143, 81, 115, 101, 130, 154, 93, 110, 181, 39,
+			186, 102, 84, 170, 142, 59, 91, 222, 37, 62, 171, 181, 113, 207,
+			218, 71, 252, 88, 46, 230, 70, 91, 51, 140, 59, 95, 17, 242,
+			19, 225, 134, 12, 82, 235, 145, 206, 7, 77, 233, 133, 101, 42,
+			185, 51, 155, 204, 139, 99, 18, 106, 38, 194, 53, 37, 135, 245,
+			164, 29, 7, 81, 179, 13, 169, 129, 21, 62, 37, 80, 160, 175,
+			205, 32, 238, 217, 125, 7, 116, 201, 241, 156, 103, 15, 29, 22,
+			159, 20, 4, 160, 243, 66, 225, 227, 150, 255, 42, 116, 32, 212,
+			92, 215, 180, 168, 200, 69, 115, 113, 167, 171, 52, 246, 191, 118,
+			176, 178, 162, 206, 225, 48, 204, 42, 82, 219, 193, 74, 162, 216,
+			1, 181, 203, 11, 101, 79, 124, 66, 184, 174, 5, 118, 188, 104,
+			15, 250, 151, 83, 173, 78, 48, 254, 222, 219, 111, 6, 227, 239,
+			157, 29, 191, 184, 244, 246, 233, 145, 137, 174, 31, 70, 79, 31,
+			23, 242, 70, 240, 144, 241, 46, 38, 229, 243, 207, 114, 215, 44,
+			187, 144, 209, 15, 88, 164, 231, 122, 177, 111, 187, 46, 65, 63,
+			176, 107, 64, 28, 165, 102, 45, 207, 185, 108, 239, 246, 189, 92,
+			77, 231, 159, 123, 222, 84, 5, 85, 209, 101, 83, 21, 104, 190,
+			220, 183, 83, 151, 28, 207, 185, 60, 232, 137, 191, 13, 77, 181,
+			237, 185, 175, 20, 62, 109, 249, 95, 176, 37, 203, 147, 74, 236,
+			214, 199, 9, 251, 81, 104, 137, 94, 143, 51, 11, 164, 176, 105,
+			32, 211, 96, 144, 85, 50, 11, 25, 7, 77, 133, 121, 12, 221,
+			54, 71, 194, 81, 13, 173, 120, 227, 148, 249, 168, 209, 90, 169,
+			200, 27, 208, 26, 235, 163, 11, 99, 129, 125, 43, 14, 235, 230,
+			53, 1, 69, 50, 142, 234, 185, 182, 210, 62, 230, 9, 131, 168,
+			59, 193, 130, 237, 68, 28, 34, 46, 99, 13, 177, 46, 156, 189,
+			133, 3, 86, 116, 85, 225, 195, 40, 105, 227, 254, 63, 6, 149,
+			9, 71, 91, 96, 154, 65, 199, 195, 234, 103, 104, 175, 94, 41,
+			239, 21, 159, 22, 174, 107, 99, 128, 95, 181, 61, 255, 53, 185,
+			216, 99, 123, 208, 92, 0, 17, 102, 41, 235, 229, 166, 46, 5,
+			172, 71, 172, 182, 214, 160, 51, 190, 1, 103, 2, 222, 53, 48,
+			24, 54, 141, 249, 171, 60, 80, 54, 141, 249, 171, 172, 219, 180,
+			105, 58, 191, 58, 48, 72, 83, 13, 185, 117, 156, 27, 246, 94,
+			154, 106, 154, 151, 28, 251, 209, 52, 156, 232, 38, 2, 203, 124,
+			184, 210, 163, 89, 204, 143, 27, 166, 89, 204, 143, 27, 172, 77,
+			176, 105, 126, 220, 216, 77, 176, 68, 224, 0, 66, 231, 236, 65,
+			255, 255, 7, 101, 14, 184, 8, 128, 80, 221, 78, 28, 214, 147,
+			137, 213, 48, 168, 37, 19, 107, 164, 31, 203, 100, 56, 72, 249,
+			78, 89, 13, 72, 213, 167, 20, 254, 156, 79, 125, 57, 14, 154,
+			213, 85, 234, 195, 24, 233, 73, 179, 213, 169, 135, 195, 189, 88,
+			102, 23, 65, 145, 166, 29, 124, 153, 231, 101, 98, 147, 178, 113,
+			126, 215, 128, 184, 72, 44, 115, 60, 231, 142, 125, 192, 31, 211,
+			148, 95, 155, 125, 3, 49, 24, 231, 42, 164, 66, 106, 180, 30,
+			132, 49, 9, 130, 61, 26, 113, 138, 248, 86, 55, 130, 240, 215,
+			59, 172, 107, 181, 109, 7, 245, 238, 31, 18, 255, 21, 114, 214,
+			32, 37, 146, 243, 182, 189, 23, 182, 158, 43, 144, 118, 224, 218,
+			45, 219, 173, 118, 208, 224, 88, 69, 70, 80, 140, 218, 38, 83,
+			121, 28, 214, 73, 134, 163, 113, 140, 238, 135, 99, 114, 173, 213,
+			108, 181, 91, 77, 222, 142, 162, 38, 114, 6, 145, 112, 3, 79,
+			238, 149, 48, 174, 208, 252, 139, 67, 48, 148, 179, 33, 60, 128,
+			177, 176, 206, 246, 66, 188, 170, 83, 181, 45, 103, 114, 155, 201,
+			21, 0, 35, 241, 253, 20, 190, 220, 43, 81, 123, 92, 225, 131,
+			134, 177, 144, 74, 249, 32, 215, 27, 157, 149, 168, 89, 81, 194,
+			131, 212, 174, 0, 137, 22, 133, 105, 119, 12, 18, 249, 32, 108,
+			52, 128, 17, 66, 222, 149, 2, 56, 207, 6, 82, 42, 169, 174,
+			134, 107, 161, 193, 175, 79, 179, 254, 67, 36, 195, 101, 135, 251,
+			188, 153, 209, 80, 138, 190, 205, 58, 54, 27, 219, 182, 243, 54,
+			235, 216, 108, 82, 138, 190, 189, 123, 143, 144, 2, 127, 186, 65,
+			225, 29, 203, 223, 35, 167, 12, 217, 172, 212, 224, 133, 139, 65,
+			10, 202, 123, 196, 53, 225, 186, 14, 22, 110, 213, 246, 252, 23,
+			245, 203, 88, 30, 106, 170, 229, 151, 200, 120, 28, 222, 143, 194,
+			7, 189, 150, 43, 81, 225, 208, 18, 173, 242, 84, 112, 104, 137,
+			86, 121, 137, 58, 180, 68, 171, 3, 131, 226, 2, 181, 105, 121,
+			78, 104, 239, 245, 79, 26, 2, 213, 190, 212, 221, 164, 90, 149,
+			186, 122, 44, 197, 208, 84, 143, 165, 24, 242, 82, 116, 104, 41,
+			134, 187, 247, 136, 81, 170, 222, 246, 156, 21, 123, 183, 127, 72,
+			42, 109, 17, 227, 188, 114, 237, 231, 206, 95, 120, 246, 57, 83,
+			41, 214, 200, 10, 115, 213, 161, 189, 99, 165, 188, 83, 151, 28,
+			207, 89, 25, 244, 196, 8, 85, 234, 120, 78, 100, 239, 245, 15,
+			202, 219, 224, 38, 226, 89, 187, 234, 53, 117, 98, 73, 68, 166,
+			78, 112, 59, 226, 145, 114, 104, 73, 68, 187, 247, 136, 103, 5,
+			229, 125, 89, 43, 60, 176, 252, 145, 76, 106, 194, 73, 121, 39,
+			9, 187, 78, 24, 78, 7, 199, 163, 135, 145, 95, 43, 239, 83,
+			187, 142, 139, 225, 91, 183, 61, 236, 58, 139, 185, 200, 58, 141,
+			115, 64, 59, 113, 125, 131, 110, 13, 237, 204, 241, 194, 184, 235,
+			56, 9, 216, 172, 99, 118, 35, 129, 142, 133, 49, 214, 88, 122,
+			158, 145, 89, 74, 87, 23, 133, 93, 144, 169, 145, 54, 53, 184,
+			52, 11, 214, 121, 152, 92, 154, 5, 235, 60, 11, 92, 154, 5,
+			235, 3, 131, 162, 159, 72, 183, 60, 231, 93, 22, 80, 92, 26,
+			221, 119, 205, 103, 24, 221, 119, 121, 116, 93, 26, 221, 119, 119,
+			239, 225, 207, 108, 207, 137, 237, 65, 126, 132, 241, 139, 205, 103,
+			24, 191, 152, 247, 56, 151, 246, 184, 120, 215, 128, 88, 162, 207,
+			28, 207, 185, 111, 239, 245, 231, 117, 250, 138, 238, 14, 182, 234,
+			217, 157, 152, 229, 240, 70, 107, 197, 92, 225, 187, 79, 80, 145,
+			73, 173, 134, 142, 99, 216, 239, 243, 176, 187, 100, 99, 185, 207,
+			195, 238, 210, 176, 223, 223, 189, 71, 156, 20, 182, 91, 244, 138,
+			239, 21, 62, 103, 89, 254, 129, 220, 184, 167, 96, 24, 60, 208,
+			136, 101, 127, 175, 124, 80, 76, 11, 215, 45, 98, 156, 63, 99,
+			31, 241, 159, 35, 242, 177, 35, 227, 86, 171, 104, 53, 93, 32,
+			21, 9, 69, 247, 107, 250, 41, 252, 132, 41, 44, 2, 133, 207,
+			249, 140, 61, 160, 75, 150, 231, 124, 102, 240, 128, 46, 57, 158,
+			243, 153, 67, 135, 197, 34, 181, 102, 121, 206, 103, 237, 67, 254,
+			43, 136, 146, 164, 250, 96, 4, 233, 110, 47, 209, 114, 3, 130,
+			232, 33, 157, 80, 124, 74, 99, 131, 200, 51, 113, 236, 26, 215,
+			3, 181, 150, 80, 173, 110, 31, 99, 252, 217, 193, 253, 186, 228,
+			120, 206, 103, 253, 131, 98, 82, 216, 110, 201, 43, 125, 201, 42,
+			124, 151, 101, 249, 99, 114, 10, 66, 210, 10, 93, 189, 140, 74,
+			29, 53, 74, 118, 86, 134, 80, 218, 196, 94, 202, 8, 136, 200,
+			77, 244, 37, 184, 174, 93, 18, 174, 91, 130, 231, 218, 151, 45,
+			123, 212, 159, 32, 190, 181, 26, 53, 92, 84, 76, 69, 143, 224,
+			24, 220, 164, 74, 224, 152, 251, 101, 203, 86, 41, 6, 75, 152,
+			205, 238, 151, 173, 67, 199, 117, 209, 65, 237, 167, 70, 196, 171,
+			212, 150, 229, 185, 223, 105, 217, 167, 252, 151, 242, 92, 75, 201,
+			238, 193, 42, 80, 148, 103, 149, 170, 26, 185, 13, 191, 51, 109,
+			24, 222, 91, 223, 105, 29, 26, 214, 69, 7, 197, 19, 39, 197,
+			180, 176, 221, 109, 94, 233, 251, 172, 194, 15, 91, 150, 255, 108,
+			214, 59, 47, 72, 225, 35, 57, 175, 130, 14, 41, 110, 182, 227,
+			86, 67, 42, 253, 40, 115, 109, 27, 210, 162, 131, 107, 127, 130,
+			93, 101, 91, 185, 224, 149, 190, 98, 217, 127, 215, 114, 252, 255,
+			81, 109, 44, 61, 63, 198, 100, 133, 160, 66, 104, 194, 90, 198,
+			139, 18, 237, 189, 144, 194, 254, 203, 181, 22, 172, 219, 164, 107,
+			80, 215, 103, 213, 186, 138, 197, 210, 0, 209, 233, 103, 81, 147,
+			241, 101, 248, 54, 15, 159, 57, 93, 255, 74, 212, 134, 33, 107,
+			249, 190, 242, 51, 26, 99, 212, 34, 137, 75, 102, 176, 18, 10,
+			190, 49, 38, 74, 133, 176, 161, 207, 102, 242, 162, 160, 116, 141,
+			138, 197, 219, 202, 24, 204, 175, 88, 229, 157, 98, 2, 93, 198,
+			76, 249, 33, 203, 61, 232, 63, 35, 167, 180, 196, 21, 53, 77,
+			175, 210, 141, 147, 135, 104, 27, 205, 141, 31, 178, 220, 157, 186,
+			8, 36, 61, 107, 215, 62, 93, 4, 176, 160, 117, 192, 23, 47,
+			9, 219, 45, 123, 165, 31, 181, 160, 1, 246, 43, 249, 33, 74,
+			27, 10, 122, 55, 5, 111, 204, 178, 229, 185, 63, 106, 149, 61,
+			49, 35, 92, 183, 12, 66, 127, 204, 178, 61, 222, 10, 62, 172,
+			104, 77, 228, 149, 177, 75, 187, 63, 102, 217, 101, 93, 180, 80,
+			105, 223, 14, 93, 116, 80, 28, 24, 20, 179, 212, 162, 229, 185,
+			63, 110, 217, 123, 253, 143, 81, 139, 188, 5, 106, 25, 237, 81,
+			4, 232, 3, 92, 213, 10, 143, 196, 31, 79, 219, 196, 156, 254,
+			113, 171, 111, 64, 23, 29, 20, 119, 239, 17, 95, 192, 20, 44,
+			35, 37, 231, 79, 89, 246, 160, 223, 249, 27, 17, 167, 153, 40,
+			32, 74, 254, 84, 74, 178, 109, 161, 216, 183, 93, 23, 29, 20,
+			119, 13, 24, 123, 226, 111, 239, 16, 47, 62, 161, 61, 145, 85,
+			74, 75, 144, 95, 183, 182, 43, 14, 207, 136, 29, 87, 213, 155,
+			243, 244, 162, 119, 94, 236, 93, 143, 163, 181, 32, 222, 88, 34,
+			120, 154, 37, 6, 40, 99, 147, 198, 110, 126, 56, 139, 103, 55,
+			212, 163, 143, 98, 181, 248, 191, 251, 149, 147, 224, 137, 71, 58,
+			9, 158, 123, 106, 180, 120, 106, 180, 248, 208, 70, 11, 101, 169,
+			240, 82, 119, 64, 252, 249, 69, 118, 7, 28, 42, 156, 176, 252,
+			255, 211, 146, 115, 153, 228, 118, 42, 91, 224, 131, 85, 227, 245,
+			135, 197, 163, 32, 94, 186, 210, 224, 177, 175, 29, 59, 117, 112,
+			132, 65, 26, 172, 203, 64, 82, 80, 152, 226, 46, 131, 126, 220,
+			153, 35, 250, 21, 26, 22, 233, 77, 244, 69, 14, 138, 244, 70,
+			39, 105, 227, 254, 199, 233, 240, 180, 34, 24, 110, 31, 43, 164,
+			237, 238, 153, 57, 134, 234, 210, 32, 206, 65, 146, 132, 49, 17,
+			199, 31, 27, 72, 65, 106, 55, 105, 7, 213, 123, 192, 69, 175,
+			134, 9, 11, 126, 144, 153, 135, 202, 123, 197, 111, 27, 191, 190,
+			227, 246, 49, 255, 215, 92, 90, 108, 180, 232, 117, 21, 16, 28,
+			218, 178, 211, 104, 71, 128, 198, 128, 132, 6, 56, 175, 90, 170,
+			146, 133, 247, 82, 16, 53, 152, 83, 42, 110, 70, 40, 177, 100,
+			89, 141, 121, 190, 62, 162, 136, 222, 202, 98, 69, 17, 94, 81,
+			158, 80, 184, 75, 114, 167, 229, 3, 141, 97, 139, 250, 116, 77,
+			180, 35, 7, 77, 57, 75, 110, 189, 232, 62, 173, 67, 68, 84,
+			144, 182, 184, 66, 218, 96, 124, 1, 205, 2, 75, 70, 217, 209,
+			149, 181, 14, 197, 166, 155, 188, 98, 202, 20, 194, 89, 213, 121,
+			28, 224, 9, 179, 33, 0, 63, 5, 103, 37, 98, 65, 61, 128,
+			70, 65, 243, 218, 132, 10, 33, 55, 119, 18, 54, 9, 169, 47,
+			77, 62, 98, 242, 7, 136, 94, 213, 87, 132, 201, 162, 97, 112,
+			161, 8, 226, 136, 243, 151, 232, 75, 214, 72, 22, 141, 12, 137,
+			38, 76, 6, 131, 212, 231, 116, 84, 116, 59, 130, 242, 228, 162,
+			247, 52, 185, 233, 85, 143, 6, 59, 201, 248, 52, 105, 198, 106,
+			5, 113, 248, 110, 7, 225, 201, 45, 116, 40, 89, 11, 26, 13,
+			45, 71, 158, 59, 123, 254, 89, 131, 85, 44, 228, 157, 197, 171,
+			227, 47, 176, 8, 78, 161, 18, 206, 113, 190, 47, 41, 173, 240,
+			241, 190, 35, 186, 228, 120, 206, 241, 103, 134, 205, 105, 246, 87,
+			195, 226, 80, 183, 91, 139, 138, 142, 222, 10, 217, 232, 135, 45,
+			81, 90, 160, 55, 188, 23, 69, 73, 113, 135, 253, 92, 142, 85,
+			186, 94, 174, 168, 23, 21, 78, 110, 162, 28, 93, 248, 19, 255,
+			53, 209, 159, 249, 185, 135, 211, 202, 88, 214, 105, 165, 255, 252,
+			190, 77, 149, 127, 18, 234, 107, 118, 102, 33, 55, 152, 127, 108,
+			139, 34, 253, 232, 189, 40, 68, 179, 211, 104, 44, 209, 51, 58,
+			54, 119, 246, 112, 249, 191, 217, 105, 52, 232, 253, 107, 133, 249,
+			190, 166, 46, 120, 199, 196, 118, 117, 235, 231, 207, 209, 190, 117,
+			173, 48, 223, 175, 126, 53, 47, 97, 243, 110, 174, 240, 75, 228,
+			64, 128, 151, 212, 175, 234, 165, 163, 66, 44, 183, 90, 154, 12,
+			56, 132, 148, 209, 20, 126, 83, 47, 188, 36, 182, 43, 110, 243,
+			43, 240, 137, 233, 63, 191, 127, 19, 165, 138, 143, 92, 125, 167,
+			218, 54, 189, 4, 2, 15, 127, 91, 218, 194, 1, 3, 142, 96,
+			166, 151, 13, 93, 152, 46, 9, 247, 94, 212, 172, 1, 169, 202,
+			188, 225, 85, 68, 137, 42, 211, 35, 186, 21, 211, 249, 173, 211,
+			7, 69, 159, 97, 34, 192, 153, 17, 46, 191, 244, 201, 169, 235,
+			119, 102, 7, 10, 211, 159, 237, 141, 98, 213, 175, 58, 163, 33,
+			172, 38, 158, 16, 194, 74, 117, 252, 67, 225, 87, 253, 200, 97,
+			8, 56, 71, 10, 159, 126, 10, 95, 245, 20, 190, 234, 41, 124,
+			213, 83, 248, 170, 167, 240, 85, 79, 225, 171, 254, 198, 225, 171,
+			204, 149, 232, 120, 225, 234, 163, 225, 171, 198, 82, 248, 170, 177,
+			15, 1, 95, 245, 75, 142, 186, 102, 157, 135, 67, 216, 63, 116,
+			228, 93, 117, 224, 222, 205, 35, 87, 37, 244, 99, 7, 183, 91,
+			58, 242, 232, 68, 31, 211, 214, 42, 78, 200, 165, 100, 53, 193,
+			106, 23, 120, 147, 225, 174, 181, 209, 12, 214, 216, 58, 135, 19,
+			90, 187, 43, 84, 32, 237, 39, 173, 53, 92, 144, 21, 4, 125,
+			50, 102, 26, 23, 28, 62, 181, 28, 102, 116, 128, 184, 86, 75,
+			216, 167, 239, 135, 41, 113, 116, 112, 85, 242, 87, 46, 164, 189,
+			85, 153, 38, 64, 152, 169, 158, 18, 146, 203, 87, 23, 76, 119,
+			112, 171, 54, 21, 49, 60, 69, 83, 112, 108, 122, 133, 253, 52,
+			218, 65, 212, 96, 39, 252, 160, 157, 190, 206, 187, 121, 28, 106,
+			143, 57, 218, 77, 213, 21, 80, 164, 10, 8, 146, 171, 116, 31,
+			204, 53, 90, 147, 164, 165, 122, 130, 50, 232, 170, 26, 239, 154,
+			177, 136, 18, 133, 172, 96, 50, 158, 232, 91, 225, 249, 242, 78,
+			113, 49, 117, 47, 145, 254, 152, 188, 99, 64, 106, 49, 2, 173,
+			250, 214, 35, 96, 174, 0, 228, 24, 162, 29, 242, 81, 237, 179,
+			123, 117, 100, 8, 12, 52, 207, 30, 57, 42, 126, 3, 183, 113,
+			203, 115, 47, 21, 110, 88, 254, 175, 216, 242, 46, 73, 113, 93,
+			179, 100, 139, 150, 120, 66, 176, 222, 85, 233, 29, 96, 82, 130,
+			77, 52, 48, 54, 51, 141, 12, 130, 223, 32, 243, 134, 1, 4,
+			18, 0, 142, 119, 98, 4, 119, 235, 65, 227, 169, 7, 149, 138,
+			48, 135, 178, 158, 83, 83, 124, 120, 41, 227, 49, 35, 197, 38,
+			124, 149, 163, 33, 98, 160, 97, 125, 139, 66, 4, 63, 187, 222,
+			36, 21, 57, 181, 156, 0, 242, 64, 195, 177, 241, 3, 131, 17,
+			145, 24, 12, 232, 199, 142, 28, 179, 71, 15, 28, 81, 194, 227,
+			6, 51, 199, 165, 242, 14, 49, 12, 135, 148, 114, 193, 115, 63,
+			110, 95, 119, 252, 61, 52, 223, 32, 100, 27, 202, 121, 128, 44,
+			40, 166, 157, 143, 151, 183, 139, 83, 218, 115, 102, 202, 61, 236,
+			251, 121, 197, 49, 216, 153, 255, 12, 250, 104, 103, 202, 221, 161,
+			75, 150, 231, 76, 237, 28, 210, 37, 199, 115, 166, 14, 30, 34,
+			251, 168, 5, 43, 217, 140, 123, 200, 63, 148, 175, 146, 179, 188,
+			229, 43, 133, 89, 110, 198, 53, 37, 124, 217, 191, 95, 151, 28,
+			207, 153, 241, 15, 114, 165, 182, 231, 204, 110, 174, 148, 61, 138,
+			242, 149, 218, 69, 188, 107, 74, 150, 231, 204, 154, 74, 97, 180,
+			155, 245, 15, 138, 211, 84, 169, 227, 57, 175, 184, 67, 254, 225,
+			124, 165, 60, 95, 186, 250, 239, 20, 241, 178, 118, 229, 129, 253,
+			237, 149, 190, 221, 186, 132, 138, 246, 237, 23, 227, 84, 171, 235,
+			57, 115, 238, 33, 95, 202, 249, 45, 54, 189, 124, 197, 110, 9,
+			239, 155, 18, 34, 74, 12, 185, 176, 188, 207, 249, 7, 185, 226,
+			162, 231, 124, 194, 61, 220, 93, 177, 70, 151, 214, 211, 196, 84,
+			92, 44, 225, 125, 61, 98, 176, 247, 125, 194, 140, 24, 34, 133,
+			62, 113, 240, 144, 248, 25, 75, 229, 50, 88, 40, 124, 210, 242,
+			255, 158, 37, 239, 154, 59, 213, 93, 206, 222, 77, 137, 43, 219,
+			45, 36, 171, 135, 85, 147, 61, 122, 50, 153, 62, 49, 241, 51,
+			19, 70, 239, 75, 194, 76, 91, 44, 94, 196, 248, 193, 25, 81,
+			200, 71, 207, 244, 124, 243, 180, 32, 238, 162, 110, 64, 43, 233,
+			84, 0, 11, 197, 157, 194, 215, 169, 0, 238, 216, 131, 254, 14,
+			121, 179, 107, 190, 50, 202, 255, 29, 131, 54, 142, 92, 15, 119,
+			118, 13, 136, 207, 35, 109, 155, 237, 185, 111, 194, 217, 234, 190,
+			188, 107, 238, 159, 220, 221, 7, 49, 242, 106, 198, 50, 136, 91,
+			157, 102, 45, 203, 93, 58, 143, 204, 106, 74, 30, 187, 102, 243,
+			53, 83, 79, 130, 56, 14, 54, 120, 221, 98, 149, 188, 89, 30,
+			20, 147, 218, 189, 233, 109, 251, 144, 63, 46, 231, 55, 53, 247,
+			152, 13, 215, 70, 46, 52, 231, 109, 219, 148, 74, 158, 243, 118,
+			191, 246, 220, 0, 187, 222, 102, 35, 168, 114, 100, 122, 219, 63,
+			104, 52, 48, 95, 63, 43, 158, 127, 66, 123, 194, 122, 28, 42,
+			60, 159, 173, 76, 9, 254, 71, 137, 116, 26, 254, 19, 91, 236,
+			78, 149, 88, 183, 117, 35, 222, 113, 177, 147, 3, 101, 151, 98,
+			120, 244, 173, 179, 118, 102, 59, 126, 157, 171, 145, 151, 223, 186,
+			247, 146, 216, 198, 155, 42, 43, 106, 182, 142, 118, 50, 85, 207,
+			235, 79, 188, 219, 66, 176, 82, 174, 89, 221, 160, 208, 157, 157,
+			231, 207, 110, 174, 160, 7, 121, 149, 89, 243, 221, 124, 166, 14,
+			111, 92, 120, 225, 67, 168, 234, 194, 165, 240, 97, 139, 19, 120,
+			81, 232, 79, 121, 126, 144, 159, 204, 154, 7, 195, 117, 33, 210,
+			138, 188, 109, 194, 153, 186, 126, 125, 160, 224, 29, 23, 242, 147,
+			83, 243, 115, 83, 55, 23, 23, 150, 160, 132, 95, 186, 115, 115,
+			246, 141, 219, 179, 51, 139, 179, 87, 150, 84, 16, 242, 194, 128,
+			229, 141, 138, 19, 249, 183, 16, 185, 215, 235, 85, 123, 248, 219,
+			196, 16, 58, 161, 91, 142, 90, 205, 111, 41, 163, 135, 191, 199,
+			18, 3, 221, 79, 189, 11, 162, 68, 42, 70, 29, 123, 115, 96,
+			203, 26, 175, 21, 230, 249, 85, 239, 99, 10, 200, 2, 30, 141,
+			67, 246, 227, 63, 51, 47, 79, 247, 139, 62, 51, 131, 135, 191,
+			228, 136, 65, 29, 158, 152, 18, 52, 47, 182, 171, 155, 247, 82,
+			88, 91, 49, 33, 65, 19, 155, 235, 223, 244, 105, 101, 182, 182,
+			18, 46, 110, 172, 135, 11, 97, 123, 190, 95, 85, 130, 159, 18,
+			239, 83, 98, 47, 38, 236, 146, 250, 124, 201, 144, 192, 196, 159,
+			120, 162, 217, 54, 191, 187, 189, 249, 71, 175, 34, 118, 103, 81,
+			65, 244, 232, 57, 52, 122, 131, 252, 8, 68, 241, 16, 142, 9,
+			79, 71, 56, 102, 6, 219, 165, 193, 30, 208, 79, 244, 128, 251,
+			85, 209, 159, 233, 148, 119, 78, 236, 97, 93, 125, 109, 41, 19,
+			72, 79, 60, 42, 207, 239, 214, 207, 82, 71, 221, 4, 168, 37,
+			153, 174, 43, 148, 249, 242, 124, 127, 218, 149, 100, 248, 188, 216,
+			139, 238, 222, 8, 219, 1, 174, 1, 105, 223, 14, 136, 50, 79,
+			74, 12, 131, 131, 192, 63, 148, 231, 106, 201, 71, 177, 53, 254,
+			79, 39, 85, 132, 212, 151, 158, 70, 72, 61, 141, 144, 250, 166,
+			69, 72, 93, 234, 29, 33, 245, 171, 150, 138, 144, 58, 88, 152,
+			177, 252, 159, 203, 225, 91, 5, 178, 222, 105, 18, 138, 83, 22,
+			59, 100, 252, 50, 201, 159, 21, 33, 103, 97, 10, 74, 237, 50,
+			112, 11, 132, 95, 92, 163, 145, 141, 204, 32, 57, 228, 70, 11,
+			222, 90, 141, 136, 170, 85, 230, 50, 157, 78, 30, 247, 19, 125,
+			95, 209, 107, 145, 146, 255, 230, 15, 143, 205, 231, 6, 139, 41,
+			16, 32, 14, 150, 15, 138, 31, 180, 244, 189, 240, 136, 125, 200,
+			255, 178, 165, 35, 159, 216, 220, 73, 250, 79, 130, 103, 96, 19,
+			106, 84, 83, 20, 27, 135, 216, 56, 92, 233, 52, 2, 51, 104,
+			228, 159, 14, 223, 193, 56, 132, 175, 57, 1, 14, 130, 199, 233,
+			99, 14, 25, 106, 68, 213, 168, 221, 216, 96, 193, 140, 85, 154,
+			159, 198, 48, 201, 227, 44, 2, 41, 179, 211, 145, 156, 217, 233,
+			72, 223, 254, 140, 217, 233, 136, 127, 144, 188, 183, 9, 152, 226,
+			25, 251, 168, 127, 249, 17, 244, 243, 177, 37, 147, 160, 29, 37,
+			117, 14, 221, 167, 116, 65, 188, 63, 153, 102, 173, 146, 231, 60,
+			99, 235, 224, 123, 220, 196, 158, 217, 237, 235, 146, 227, 57, 207,
+			28, 62, 66, 94, 176, 5, 168, 68, 142, 219, 207, 59, 254, 164,
+			188, 26, 53, 218, 97, 156, 100, 91, 79, 228, 114, 0, 179, 106,
+			110, 34, 84, 204, 45, 83, 103, 209, 162, 122, 9, 149, 229, 248,
+			182, 65, 74, 143, 90, 64, 103, 61, 231, 148, 187, 221, 95, 37,
+			59, 64, 174, 82, 166, 159, 29, 191, 176, 180, 110, 206, 221, 124,
+			101, 210, 56, 37, 67, 255, 26, 173, 52, 161, 110, 13, 154, 237,
+			212, 221, 153, 220, 145, 84, 4, 190, 178, 16, 130, 215, 141, 160,
+			29, 54, 171, 16, 94, 119, 138, 109, 110, 129, 185, 124, 202, 221,
+			150, 150, 109, 207, 57, 37, 250, 197, 223, 117, 152, 50, 203, 115,
+			206, 185, 163, 254, 151, 157, 94, 188, 94, 14, 225, 188, 140, 25,
+			202, 19, 70, 115, 157, 172, 3, 171, 72, 230, 164, 224, 17, 214,
+			90, 216, 98, 58, 77, 195, 14, 238, 94, 69, 206, 25, 196, 63,
+			173, 125, 38, 103, 28, 5, 210, 152, 249, 0, 228, 115, 56, 154,
+			144, 155, 170, 225, 236, 100, 169, 85, 194, 12, 51, 38, 32, 69,
+			214, 104, 71, 179, 48, 79, 41, 193, 204, 116, 27, 232, 163, 122,
+			119, 135, 84, 139, 184, 74, 221, 158, 90, 88, 160, 137, 139, 238,
+			113, 251, 66, 190, 121, 117, 106, 238, 250, 152, 84, 255, 226, 149,
+			183, 117, 156, 84, 163, 209, 99, 44, 179, 4, 114, 26, 115, 145,
+			13, 249, 225, 87, 77, 192, 136, 126, 57, 51, 114, 152, 168, 231,
+			220, 227, 105, 217, 246, 156, 115, 167, 70, 204, 148, 178, 61, 231,
+			57, 183, 226, 175, 202, 5, 118, 75, 104, 183, 30, 43, 145, 42,
+			56, 150, 110, 30, 9, 169, 211, 94, 241, 226, 106, 110, 244, 26,
+			1, 77, 8, 86, 231, 115, 238, 104, 90, 6, 37, 99, 227, 226,
+			55, 45, 141, 166, 242, 162, 125, 200, 255, 90, 207, 205, 135, 118,
+			155, 174, 133, 250, 68, 235, 234, 81, 187, 39, 78, 136, 56, 170,
+			153, 83, 59, 108, 212, 178, 185, 227, 117, 42, 34, 145, 193, 30,
+			161, 17, 193, 104, 19, 106, 71, 126, 37, 153, 141, 195, 46, 33,
+			94, 105, 187, 46, 89, 158, 243, 226, 14, 189, 95, 65, 67, 241,
+			162, 127, 80, 124, 221, 210, 64, 45, 211, 246, 17, 255, 87, 44,
+			57, 87, 151, 237, 184, 19, 142, 193, 85, 9, 134, 125, 216, 75,
+			210, 107, 70, 142, 239, 88, 239, 55, 58, 109, 149, 107, 141, 46,
+			30, 164, 243, 162, 189, 51, 189, 114, 84, 166, 174, 95, 199, 241,
+			49, 87, 151, 245, 160, 1, 191, 41, 6, 55, 65, 237, 81, 66,
+			184, 58, 36, 13, 112, 194, 184, 20, 33, 55, 207, 254, 77, 57,
+			160, 82, 170, 76, 143, 161, 61, 153, 182, 75, 186, 132, 52, 102,
+			219, 116, 184, 24, 188, 151, 167, 15, 29, 22, 239, 40, 165, 224,
+			43, 133, 155, 150, 255, 233, 71, 156, 148, 153, 139, 204, 147, 31,
+			151, 154, 38, 28, 242, 124, 182, 97, 29, 188, 82, 150, 226, 71,
+			44, 173, 9, 123, 213, 62, 228, 127, 183, 153, 94, 153, 79, 254,
+			102, 15, 56, 43, 23, 158, 100, 113, 120, 146, 214, 17, 225, 128,
+			123, 213, 63, 40, 110, 107, 229, 219, 13, 251, 168, 63, 243, 184,
+			78, 240, 76, 121, 236, 41, 103, 145, 91, 245, 13, 62, 229, 148,
+			142, 238, 6, 159, 114, 74, 71, 119, 227, 240, 17, 138, 55, 176,
+			61, 247, 53, 100, 196, 28, 217, 98, 232, 248, 94, 150, 14, 153,
+			209, 131, 188, 86, 134, 90, 202, 117, 109, 232, 47, 231, 237, 55,
+			28, 170, 221, 38, 77, 229, 124, 121, 80, 124, 92, 235, 72, 22,
+			221, 253, 254, 249, 76, 128, 118, 151, 179, 202, 170, 222, 177, 149,
+			203, 125, 248, 48, 168, 182, 27, 105, 136, 11, 52, 152, 139, 110,
+			191, 46, 89, 158, 179, 184, 221, 211, 37, 199, 115, 22, 247, 238,
+			35, 87, 107, 138, 211, 120, 221, 61, 224, 95, 74, 155, 58, 149,
+			116, 133, 45, 114, 40, 37, 223, 48, 217, 49, 71, 227, 157, 170,
+			232, 93, 85, 51, 216, 247, 186, 105, 21, 236, 123, 125, 187, 142,
+			96, 2, 251, 94, 223, 63, 36, 158, 83, 129, 53, 111, 21, 62,
+			176, 252, 209, 45, 216, 167, 175, 157, 93, 252, 131, 34, 242, 173,
+			242, 1, 210, 35, 57, 78, 193, 115, 63, 109, 87, 29, 114, 108,
+			135, 232, 215, 170, 103, 162, 39, 145, 181, 173, 211, 94, 105, 97,
+			172, 113, 199, 197, 174, 101, 244, 72, 142, 3, 110, 127, 90, 236,
+			21, 183, 69, 201, 117, 144, 31, 222, 115, 238, 186, 195, 254, 20,
+			169, 185, 240, 126, 222, 190, 177, 188, 145, 13, 204, 236, 117, 61,
+			212, 59, 44, 237, 231, 84, 99, 17, 85, 150, 211, 178, 229, 57,
+			119, 251, 14, 167, 101, 199, 115, 238, 202, 103, 196, 107, 76, 129,
+			229, 57, 203, 174, 239, 79, 103, 176, 247, 198, 171, 171, 81, 163,
+			198, 217, 69, 90, 205, 100, 53, 90, 55, 88, 75, 41, 57, 116,
+			202, 102, 246, 252, 148, 4, 40, 156, 151, 51, 36, 96, 60, 150,
+			251, 246, 166, 101, 199, 115, 150, 135, 14, 136, 223, 182, 117, 244,
+			210, 154, 125, 212, 255, 25, 91, 103, 3, 165, 0, 108, 4, 178,
+			40, 134, 192, 1, 142, 238, 251, 10, 97, 47, 14, 219, 113, 20,
+			222, 7, 131, 107, 81, 28, 98, 246, 77, 64, 221, 175, 254, 100,
+			24, 85, 248, 64, 232, 11, 119, 210, 37, 63, 80, 56, 49, 116,
+			171, 84, 15, 123, 248, 111, 13, 156, 71, 241, 122, 176, 230, 191,
+			223, 139, 255, 147, 116, 94, 124, 80, 233, 89, 233, 35, 0, 243,
+			168, 86, 249, 126, 246, 250, 110, 170, 18, 114, 218, 100, 224, 27,
+			227, 190, 171, 75, 9, 49, 164, 34, 134, 61, 253, 152, 184, 51,
+			213, 208, 184, 91, 14, 89, 16, 214, 108, 19, 178, 133, 64, 163,
+			157, 190, 46, 57, 158, 179, 118, 248, 136, 248, 183, 150, 142, 224,
+			74, 236, 179, 192, 208, 157, 131, 241, 196, 32, 142, 177, 220, 72,
+			85, 103, 33, 23, 89, 100, 218, 116, 52, 117, 18, 179, 195, 137,
+			77, 251, 27, 167, 165, 205, 255, 172, 18, 123, 234, 163, 111, 3,
+			13, 109, 205, 127, 28, 139, 72, 233, 71, 188, 7, 9, 107, 169,
+			111, 158, 62, 249, 21, 55, 179, 170, 37, 195, 16, 108, 13, 9,
+			135, 40, 57, 180, 179, 38, 251, 206, 232, 146, 227, 57, 73, 101,
+			66, 252, 172, 165, 99, 206, 30, 218, 207, 248, 63, 102, 193, 51,
+			82, 183, 159, 219, 208, 89, 229, 67, 139, 250, 91, 114, 52, 201,
+			43, 153, 113, 30, 174, 156, 30, 54, 253, 130, 1, 230, 33, 159,
+			86, 42, 234, 237, 97, 223, 161, 76, 212, 219, 195, 163, 82, 252,
+			132, 165, 195, 222, 62, 99, 75, 255, 43, 91, 246, 171, 41, 231,
+			174, 60, 182, 59, 93, 189, 17, 31, 161, 59, 114, 235, 238, 64,
+			118, 249, 140, 233, 14, 54, 220, 207, 244, 29, 212, 37, 116, 224,
+			200, 81, 17, 82, 192, 93, 233, 243, 86, 225, 75, 150, 229, 191,
+			190, 197, 30, 158, 85, 119, 61, 185, 232, 178, 198, 95, 112, 188,
+			6, 100, 164, 207, 91, 229, 195, 20, 175, 225, 34, 94, 227, 139,
+			150, 125, 212, 127, 78, 78, 229, 95, 207, 112, 209, 172, 139, 136,
+			65, 214, 216, 3, 137, 99, 39, 92, 216, 16, 220, 47, 90, 182,
+			41, 22, 81, 105, 255, 160, 46, 90, 40, 122, 190, 46, 58, 40,
+			30, 62, 98, 236, 8, 63, 176, 42, 62, 246, 132, 218, 255, 116,
+			45, 61, 22, 235, 108, 75, 120, 231, 71, 122, 141, 62, 22, 42,
+			237, 35, 25, 42, 252, 143, 104, 39, 25, 254, 23, 37, 33, 210,
+			115, 201, 243, 179, 88, 211, 211, 165, 111, 76, 57, 223, 152, 42,
+			50, 230, 244, 11, 162, 72, 9, 219, 73, 85, 186, 179, 151, 138,
+			61, 173, 168, 66, 9, 222, 231, 213, 7, 222, 140, 232, 199, 205,
+			189, 173, 50, 168, 63, 30, 102, 218, 52, 44, 212, 103, 192, 201,
+			242, 206, 10, 23, 232, 10, 67, 69, 233, 244, 134, 194, 74, 193,
+			34, 230, 233, 77, 239, 138, 216, 81, 71, 198, 88, 32, 79, 83,
+			195, 165, 199, 54, 236, 124, 99, 202, 153, 223, 174, 191, 194, 239,
+			222, 243, 162, 92, 11, 131, 26, 176, 111, 134, 182, 61, 174, 130,
+			121, 243, 238, 150, 58, 233, 50, 41, 140, 123, 234, 164, 63, 33,
+			6, 150, 163, 149, 119, 59, 33, 162, 91, 30, 194, 137, 34, 25,
+			234, 147, 78, 111, 232, 178, 233, 104, 229, 53, 188, 57, 75, 47,
+			206, 239, 210, 95, 170, 114, 226, 13, 11, 230, 94, 109, 105, 121,
+			99, 72, 208, 128, 82, 247, 250, 248, 231, 233, 13, 239, 140, 24,
+			212, 30, 4, 75, 26, 203, 116, 168, 31, 175, 206, 15, 232, 7,
+			243, 252, 59, 144, 242, 226, 48, 104, 172, 13, 109, 167, 23, 84,
+			193, 155, 19, 187, 24, 225, 100, 137, 49, 139, 134, 118, 108, 133,
+			182, 118, 77, 189, 168, 0, 47, 147, 249, 157, 171, 185, 178, 247,
+			49, 33, 214, 99, 248, 130, 182, 163, 48, 25, 218, 249, 72, 127,
+			223, 249, 204, 171, 222, 37, 209, 175, 104, 92, 130, 127, 222, 208,
+			174, 173, 192, 210, 22, 232, 37, 72, 74, 243, 34, 49, 127, 15,
+			207, 137, 34, 77, 87, 111, 175, 24, 92, 88, 156, 90, 156, 93,
+			186, 115, 147, 210, 36, 94, 157, 155, 189, 50, 80, 240, 132, 40,
+			77, 205, 44, 206, 125, 114, 118, 192, 130, 35, 239, 213, 185, 155,
+			83, 215, 231, 254, 214, 220, 205, 87, 6, 108, 111, 135, 232, 227,
+			242, 236, 149, 1, 231, 85, 183, 236, 12, 184, 195, 255, 204, 17,
+			59, 243, 195, 227, 29, 78, 81, 214, 44, 61, 20, 118, 10, 181,
+			118, 88, 108, 195, 150, 8, 36, 62, 59, 243, 152, 127, 243, 14,
+			136, 98, 27, 26, 239, 33, 39, 125, 168, 126, 241, 94, 235, 178,
+			99, 168, 197, 53, 246, 184, 249, 146, 49, 228, 36, 215, 10, 57,
+			187, 135, 247, 58, 140, 155, 15, 219, 75, 250, 184, 75, 120, 225,
+			84, 158, 160, 210, 135, 41, 176, 230, 181, 194, 252, 142, 118, 246,
+			7, 127, 94, 244, 103, 154, 245, 102, 50, 70, 175, 33, 235, 195,
+			88, 156, 210, 239, 252, 121, 177, 35, 215, 170, 55, 181, 185, 214,
+			99, 155, 107, 213, 239, 247, 170, 115, 122, 135, 232, 103, 139, 24,
+			132, 149, 225, 47, 90, 98, 103, 126, 234, 122, 47, 136, 161, 78,
+			18, 102, 214, 245, 146, 217, 197, 217, 232, 180, 175, 147, 132, 233,
+			218, 54, 27, 4, 64, 9, 97, 97, 142, 180, 165, 242, 9, 64,
+			9, 213, 251, 195, 111, 10, 145, 78, 96, 239, 130, 216, 166, 166,
+			240, 35, 12, 148, 234, 245, 100, 94, 191, 9, 172, 191, 168, 185,
+			26, 198, 220, 122, 121, 94, 23, 135, 255, 145, 37, 182, 241, 235,
+			222, 85, 177, 147, 163, 26, 151, 152, 84, 213, 194, 209, 205, 45,
+			228, 34, 245, 231, 119, 172, 228, 32, 51, 95, 22, 253, 202, 79,
+			28, 126, 75, 176, 176, 97, 39, 59, 210, 163, 146, 12, 180, 229,
+			124, 246, 19, 239, 128, 40, 71, 201, 82, 45, 138, 219, 202, 0,
+			14, 130, 147, 43, 40, 126, 20, 67, 219, 23, 223, 80, 134, 182,
+			159, 178, 159, 26, 218, 158, 26, 218, 190, 89, 134, 182, 12, 244,
+			255, 73, 250, 211, 134, 165, 77, 253, 234, 0, 251, 255, 18, 253,
+			233, 122, 206, 190, 194, 203, 108, 148, 27, 74, 141, 114, 248, 243,
+			75, 112, 63, 44, 120, 69, 89, 248, 207, 44, 138, 6, 156, 130,
+			239, 41, 38, 9, 212, 72, 172, 210, 129, 134, 181, 206, 23, 73,
+			224, 97, 175, 173, 117, 232, 68, 144, 120, 83, 106, 17, 6, 156,
+			152, 203, 120, 114, 38, 57, 181, 61, 133, 42, 172, 133, 100, 224,
+			237, 40, 131, 226, 139, 250, 174, 159, 76, 202, 228, 65, 16, 175,
+			129, 47, 237, 32, 185, 55, 134, 208, 114, 0, 128, 81, 124, 185,
+			250, 123, 76, 206, 188, 38, 145, 107, 103, 109, 189, 13, 192, 233,
+			214, 218, 122, 43, 1, 17, 147, 100, 250, 96, 25, 135, 253, 255,
+			51, 66, 144, 66, 239, 226, 164, 45, 90, 134, 173, 136, 12, 200,
+			255, 185, 231, 88, 127, 4, 173, 143, 44, 123, 226, 60, 140, 125,
+			125, 176, 103, 237, 24, 62, 73, 38, 72, 21, 137, 18, 119, 214,
+			219, 97, 109, 76, 86, 227, 229, 206, 10, 5, 143, 156, 59, 251,
+			177, 23, 158, 191, 8, 140, 43, 0, 102, 20, 250, 10, 158, 243,
+			76, 63, 235, 178, 251, 10, 86, 174, 100, 171, 210, 47, 106, 235,
+			161, 59, 106, 143, 185, 254, 79, 88, 57, 236, 236, 77, 57, 4,
+			210, 158, 112, 68, 118, 212, 148, 234, 136, 186, 50, 93, 121, 37,
+			108, 167, 251, 190, 0, 242, 116, 154, 106, 64, 62, 2, 229, 63,
+			131, 161, 222, 3, 56, 31, 204, 185, 213, 105, 175, 119, 218, 164,
+			30, 249, 80, 152, 247, 156, 7, 168, 236, 185, 163, 222, 152, 195,
+			216, 211, 5, 133, 61, 125, 218, 29, 207, 252, 96, 121, 206, 25,
+			23, 55, 124, 182, 9, 86, 236, 75, 78, 198, 174, 87, 217, 182,
+			93, 220, 98, 35, 76, 193, 115, 206, 186, 190, 255, 178, 6, 120,
+			195, 213, 148, 61, 220, 84, 180, 165, 113, 71, 37, 30, 70, 117,
+			90, 57, 116, 31, 192, 78, 208, 82, 81, 55, 93, 246, 186, 179,
+			238, 222, 156, 189, 238, 236, 208, 1, 113, 133, 27, 180, 60, 231,
+			130, 187, 139, 195, 254, 83, 62, 18, 104, 20, 75, 180, 164, 86,
+			83, 169, 208, 0, 173, 240, 160, 135, 9, 7, 74, 140, 11, 110,
+			166, 12, 8, 252, 29, 59, 197, 63, 183, 184, 25, 219, 115, 94,
+			112, 119, 251, 191, 102, 117, 183, 19, 113, 186, 168, 208, 192, 53,
+			98, 231, 141, 131, 166, 58, 166, 25, 132, 169, 37, 141, 56, 8,
+			204, 127, 82, 227, 44, 154, 160, 216, 85, 216, 136, 155, 50, 232,
+			180, 91, 136, 216, 85, 78, 115, 73, 11, 91, 97, 29, 70, 13,
+			172, 77, 21, 198, 98, 244, 115, 18, 233, 52, 82, 29, 93, 170,
+			162, 75, 73, 67, 118, 207, 42, 124, 203, 163, 38, 82, 56, 221,
+			207, 218, 210, 160, 220, 120, 193, 221, 153, 150, 209, 191, 65, 79,
+			220, 231, 238, 58, 158, 243, 146, 235, 249, 43, 189, 122, 107, 118,
+			20, 176, 181, 169, 224, 156, 194, 184, 23, 131, 101, 83, 81, 201,
+			235, 57, 71, 125, 134, 248, 12, 93, 208, 82, 188, 228, 238, 72,
+			203, 182, 231, 188, 52, 48, 168, 244, 107, 100, 6, 159, 85, 57,
+			170, 24, 231, 68, 49, 83, 31, 118, 41, 161, 88, 23, 83, 109,
+			53, 1, 64, 55, 196, 48, 217, 6, 195, 217, 246, 10, 45, 115,
+			58, 42, 136, 128, 80, 43, 150, 3, 91, 115, 93, 22, 228, 79,
+			138, 205, 143, 117, 48, 113, 22, 242, 210, 132, 73, 71, 237, 36,
+			108, 212, 181, 49, 73, 197, 148, 209, 84, 78, 81, 53, 185, 114,
+			156, 34, 178, 149, 46, 91, 163, 113, 102, 27, 124, 201, 115, 102,
+			25, 50, 71, 217, 231, 103, 203, 122, 241, 66, 245, 62, 59, 48,
+			40, 46, 179, 105, 209, 157, 179, 175, 187, 254, 89, 249, 186, 214,
+			35, 246, 94, 2, 149, 222, 219, 4, 12, 122, 115, 38, 1, 2,
+			216, 59, 119, 232, 148, 46, 193, 135, 247, 244, 25, 206, 140, 101,
+			151, 61, 119, 110, 236, 186, 222, 38, 108, 181, 77, 188, 106, 182,
+			9, 91, 109, 19, 159, 112, 207, 136, 91, 218, 0, 120, 219, 62,
+			234, 79, 203, 185, 110, 53, 100, 79, 56, 69, 104, 148, 167, 240,
+			155, 30, 32, 237, 192, 106, 104, 117, 92, 212, 104, 74, 37, 207,
+			185, 221, 191, 87, 151, 44, 207, 185, 189, 79, 251, 48, 192, 48,
+			119, 251, 240, 17, 241, 127, 233, 212, 13, 238, 27, 246, 167, 158,
+			245, 127, 196, 218, 146, 75, 230, 88, 212, 106, 69, 179, 126, 195,
+			90, 102, 154, 100, 22, 239, 166, 8, 232, 4, 112, 156, 74, 204,
+			72, 82, 171, 124, 166, 157, 40, 211, 204, 166, 109, 251, 252, 13,
+			121, 233, 146, 188, 170, 158, 19, 179, 228, 165, 143, 254, 159, 102,
+			18, 92, 180, 223, 48, 195, 11, 136, 172, 55, 14, 141, 234, 146,
+			227, 57, 111, 140, 141, 235, 82, 217, 115, 62, 85, 186, 192, 131,
+			233, 170, 209, 253, 84, 249, 156, 248, 188, 73, 40, 241, 182, 61,
+			234, 119, 50, 201, 40, 31, 244, 226, 37, 118, 51, 228, 175, 107,
+			197, 213, 176, 222, 193, 54, 150, 233, 179, 62, 61, 85, 254, 115,
+			173, 201, 188, 179, 142, 188, 125, 233, 44, 145, 157, 102, 59, 106,
+			228, 120, 197, 89, 34, 74, 240, 54, 214, 189, 129, 95, 248, 219,
+			135, 142, 235, 18, 160, 222, 78, 141, 136, 63, 40, 235, 12, 18,
+			247, 237, 51, 254, 111, 149, 229, 77, 19, 3, 104, 26, 72, 178,
+			155, 165, 62, 187, 97, 250, 146, 183, 238, 135, 113, 151, 28, 132,
+			5, 43, 178, 93, 36, 119, 235, 59, 55, 231, 110, 221, 204, 200,
+			89, 178, 215, 62, 188, 89, 44, 16, 26, 4, 150, 54, 71, 163,
+			114, 207, 124, 163, 223, 76, 198, 210, 152, 143, 198, 70, 151, 181,
+			69, 6, 114, 186, 91, 228, 202, 124, 75, 150, 109, 174, 51, 91,
+			101, 26, 9, 73, 182, 168, 156, 20, 151, 48, 101, 44, 14, 202,
+			86, 142, 19, 122, 127, 85, 194, 29, 28, 160, 170, 193, 122, 130,
+			156, 24, 26, 39, 144, 100, 174, 102, 208, 72, 195, 5, 114, 223,
+			104, 175, 243, 149, 56, 88, 95, 197, 57, 175, 248, 197, 6, 250,
+			48, 14, 245, 170, 15, 218, 114, 13, 126, 13, 173, 102, 72, 150,
+			25, 99, 36, 211, 240, 206, 64, 228, 237, 26, 78, 78, 114, 149,
+			172, 6, 105, 78, 22, 213, 16, 217, 68, 176, 31, 175, 65, 24,
+			141, 43, 242, 117, 120, 203, 168, 2, 109, 195, 180, 249, 130, 74,
+			28, 7, 173, 58, 210, 201, 7, 213, 85, 58, 220, 178, 13, 200,
+			197, 213, 78, 34, 171, 27, 85, 32, 250, 32, 238, 51, 128, 85,
+			68, 11, 22, 113, 40, 155, 173, 214, 58, 250, 72, 154, 183, 140,
+			46, 3, 22, 177, 78, 220, 236, 114, 155, 50, 3, 175, 183, 25,
+			4, 254, 54, 90, 137, 226, 90, 118, 182, 177, 187, 190, 217, 103,
+			152, 75, 160, 86, 221, 55, 231, 195, 42, 5, 58, 85, 102, 226,
+			48, 183, 138, 146, 121, 157, 249, 198, 96, 121, 49, 126, 41, 134,
+			44, 211, 130, 246, 17, 82, 88, 84, 121, 214, 154, 243, 19, 70,
+			167, 240, 65, 238, 51, 45, 91, 177, 240, 67, 48, 142, 203, 48,
+			1, 200, 145, 251, 81, 32, 82, 202, 50, 121, 88, 54, 147, 55,
+			202, 236, 76, 90, 154, 167, 149, 204, 113, 57, 214, 181, 199, 18,
+			34, 150, 108, 107, 169, 46, 233, 58, 211, 96, 212, 162, 99, 55,
+			183, 12, 77, 147, 202, 162, 101, 232, 210, 251, 206, 38, 125, 43,
+			225, 67, 96, 242, 171, 251, 9, 128, 56, 180, 237, 171, 96, 151,
+			92, 108, 45, 166, 4, 112, 187, 254, 65, 93, 178, 60, 231, 190,
+			119, 82, 151, 0, 110, 55, 122, 90, 36, 58, 133, 203, 123, 246,
+			132, 95, 151, 221, 154, 220, 76, 92, 21, 193, 161, 107, 213, 153,
+			164, 91, 219, 72, 50, 170, 99, 238, 212, 132, 138, 154, 155, 183,
+			36, 70, 243, 80, 21, 74, 186, 49, 113, 98, 23, 23, 173, 154,
+			82, 201, 115, 222, 235, 215, 222, 43, 72, 1, 243, 158, 127, 90,
+			151, 28, 207, 121, 111, 188, 162, 143, 204, 50, 76, 53, 246, 43,
+			254, 159, 89, 74, 225, 97, 144, 107, 71, 72, 74, 26, 238, 36,
+			97, 60, 249, 82, 184, 22, 68, 141, 203, 148, 179, 184, 165, 5,
+			13, 222, 13, 52, 109, 21, 145, 194, 36, 143, 201, 64, 213, 134,
+			104, 255, 168, 10, 248, 116, 149, 213, 147, 42, 21, 92, 107, 53,
+			30, 207, 220, 39, 95, 14, 214, 215, 147, 245, 86, 187, 178, 194,
+			31, 241, 55, 184, 209, 13, 143, 42, 239, 45, 44, 10, 13, 81,
+			19, 96, 140, 99, 193, 0, 105, 170, 198, 119, 90, 171, 205, 90,
+			43, 124, 153, 183, 79, 245, 233, 230, 139, 147, 146, 115, 202, 69,
+			234, 122, 89, 23, 201, 104, 213, 183, 91, 23, 29, 20, 247, 13,
+			233, 34, 241, 233, 192, 85, 49, 64, 199, 102, 153, 142, 77, 247,
+			243, 214, 193, 43, 226, 171, 48, 196, 23, 236, 62, 207, 253, 14,
+			203, 150, 254, 247, 219, 242, 42, 98, 125, 116, 184, 58, 91, 90,
+			73, 179, 166, 6, 152, 245, 234, 181, 238, 145, 206, 51, 243, 81,
+			119, 193, 99, 56, 112, 199, 117, 173, 227, 104, 42, 49, 236, 79,
+			175, 239, 48, 171, 47, 100, 247, 254, 73, 57, 60, 97, 144, 189,
+			199, 233, 88, 168, 104, 182, 227, 226, 140, 119, 146, 9, 24, 48,
+			150, 195, 176, 78, 208, 225, 155, 142, 31, 85, 71, 110, 232, 114,
+			117, 208, 239, 201, 4, 161, 140, 62, 255, 177, 23, 46, 158, 213,
+			248, 103, 5, 187, 175, 72, 60, 210, 28, 239, 179, 80, 236, 243,
+			117, 209, 65, 241, 240, 81, 241, 43, 106, 102, 10, 207, 253, 30,
+			203, 222, 227, 255, 231, 240, 73, 14, 26, 107, 61, 69, 45, 198,
+			105, 166, 45, 146, 153, 166, 249, 101, 52, 131, 221, 32, 112, 19,
+			81, 179, 30, 7, 202, 62, 134, 127, 198, 215, 55, 38, 206, 76,
+			228, 32, 213, 146, 118, 24, 79, 208, 85, 109, 37, 106, 134, 19,
+			65, 167, 189, 186, 196, 211, 146, 213, 138, 100, 251, 72, 150, 170,
+			173, 102, 61, 90, 81, 234, 11, 221, 77, 81, 36, 202, 117, 55,
+			133, 133, 98, 223, 78, 93, 116, 80, 28, 220, 205, 114, 125, 191,
+			231, 254, 109, 203, 62, 233, 159, 205, 96, 97, 86, 36, 133, 101,
+			37, 90, 83, 197, 184, 125, 180, 129, 70, 43, 77, 36, 125, 50,
+			60, 237, 47, 81, 5, 3, 186, 104, 161, 56, 40, 117, 209, 65,
+			241, 216, 9, 241, 247, 20, 79, 183, 3, 147, 207, 30, 245, 191,
+			199, 146, 83, 241, 114, 212, 70, 250, 232, 108, 168, 173, 206, 192,
+			75, 250, 165, 36, 19, 17, 136, 12, 89, 107, 65, 212, 28, 55,
+			202, 179, 212, 250, 34, 244, 52, 207, 206, 95, 62, 169, 117, 106,
+			244, 154, 114, 212, 213, 238, 80, 47, 93, 146, 207, 158, 189, 248,
+			188, 66, 233, 49, 125, 217, 78, 128, 130, 182, 94, 115, 219, 9,
+			80, 240, 192, 49, 93, 36, 64, 193, 147, 35, 226, 95, 23, 169,
+			47, 59, 60, 247, 31, 90, 246, 17, 255, 119, 139, 212, 84, 181,
+			85, 211, 40, 141, 218, 3, 230, 1, 152, 134, 243, 88, 197, 13,
+			108, 94, 99, 139, 124, 71, 211, 74, 158, 168, 89, 11, 31, 118,
+			157, 224, 156, 186, 81, 163, 248, 3, 112, 169, 6, 169, 23, 18,
+			4, 124, 142, 33, 26, 4, 141, 141, 36, 76, 120, 43, 170, 118,
+			26, 235, 49, 65, 157, 227, 231, 40, 145, 19, 82, 105, 180, 215,
+			91, 81, 83, 255, 28, 38, 163, 134, 71, 76, 115, 247, 96, 67,
+			249, 26, 106, 193, 165, 69, 201, 1, 140, 149, 124, 147, 48, 170,
+			85, 130, 169, 245, 60, 237, 40, 145, 140, 207, 17, 227, 219, 75,
+			124, 213, 47, 106, 190, 65, 232, 32, 96, 125, 166, 141, 140, 106,
+			21, 54, 20, 144, 111, 62, 124, 109, 64, 255, 12, 59, 165, 242,
+			185, 180, 12, 233, 46, 198, 117, 0, 220, 12, 155, 36, 231, 128,
+			232, 206, 122, 163, 21, 160, 49, 198, 69, 65, 133, 96, 124, 10,
+			255, 44, 140, 252, 215, 147, 48, 154, 151, 170, 150, 84, 8, 233,
+			118, 40, 253, 100, 24, 215, 162, 42, 39, 219, 11, 146, 164, 85,
+			5, 36, 22, 95, 62, 218, 25, 86, 183, 234, 242, 116, 208, 220,
+			56, 205, 19, 55, 138, 161, 93, 77, 218, 81, 187, 67, 148, 100,
+			89, 76, 48, 214, 81, 157, 19, 113, 96, 186, 52, 13, 209, 213,
+			141, 71, 18, 61, 38, 122, 34, 226, 223, 87, 84, 178, 202, 38,
+			33, 45, 2, 0, 179, 181, 43, 183, 89, 14, 59, 74, 52, 195,
+			183, 235, 162, 133, 226, 14, 189, 58, 118, 56, 40, 30, 60, 44,
+			62, 67, 78, 169, 165, 159, 181, 10, 255, 189, 101, 249, 77, 35,
+			86, 204, 42, 33, 33, 21, 58, 122, 73, 23, 61, 132, 11, 114,
+			143, 197, 167, 140, 30, 167, 235, 99, 13, 21, 223, 214, 244, 50,
+			7, 62, 39, 128, 44, 127, 214, 42, 239, 227, 32, 224, 130, 231,
+			254, 188, 101, 191, 236, 31, 165, 43, 153, 238, 182, 169, 134, 45,
+			158, 220, 77, 242, 28, 197, 251, 101, 93, 180, 240, 57, 163, 98,
+			146, 239, 168, 251, 243, 214, 238, 61, 186, 88, 198, 211, 189, 31,
+			167, 115, 216, 98, 29, 166, 251, 243, 214, 254, 75, 220, 184, 229,
+			185, 191, 240, 136, 198, 175, 40, 123, 170, 105, 28, 144, 156, 191,
+			144, 54, 142, 158, 252, 66, 218, 184, 229, 160, 104, 26, 183, 202,
+			40, 154, 198, 45, 213, 248, 47, 160, 241, 51, 28, 2, 238, 254,
+			146, 101, 95, 246, 15, 247, 110, 124, 17, 114, 158, 105, 26, 208,
+			154, 191, 148, 54, 109, 91, 40, 242, 49, 97, 217, 182, 131, 226,
+			160, 167, 139, 101, 20, 119, 95, 226, 166, 109, 213, 244, 47, 89,
+			123, 95, 20, 55, 208, 180, 83, 240, 74, 191, 108, 217, 95, 179,
+			28, 255, 82, 198, 235, 44, 43, 115, 210, 240, 231, 118, 143, 205,
+			3, 174, 137, 131, 171, 165, 251, 203, 150, 216, 43, 2, 81, 66,
+			209, 46, 120, 238, 175, 90, 238, 73, 255, 53, 194, 231, 54, 150,
+			82, 172, 115, 114, 87, 200, 239, 75, 212, 214, 230, 234, 105, 239,
+			48, 236, 104, 51, 59, 118, 137, 109, 170, 137, 18, 181, 177, 63,
+			253, 193, 194, 15, 67, 207, 164, 63, 56, 248, 225, 248, 9, 241,
+			26, 245, 217, 242, 74, 191, 102, 217, 255, 212, 114, 224, 2, 154,
+			53, 1, 111, 238, 245, 195, 52, 83, 242, 35, 251, 141, 9, 240,
+			107, 150, 216, 47, 126, 220, 162, 142, 195, 233, 218, 253, 117, 203,
+			61, 238, 127, 159, 181, 85, 207, 211, 138, 63, 84, 183, 229, 66,
+			103, 121, 92, 41, 149, 76, 165, 149, 30, 17, 147, 57, 111, 69,
+			149, 99, 84, 249, 126, 41, 174, 88, 196, 183, 95, 183, 220, 189,
+			233, 15, 150, 231, 254, 186, 181, 239, 104, 250, 131, 131, 31, 134,
+			143, 137, 157, 140, 193, 80, 250, 186, 101, 255, 166, 229, 168, 78,
+			195, 139, 217, 253, 186, 85, 222, 77, 106, 127, 11, 51, 239, 191,
+			181, 220, 103, 120, 230, 57, 37, 42, 238, 210, 69, 11, 197, 129,
+			67, 186, 72, 47, 31, 149, 252, 169, 235, 185, 255, 157, 229, 158,
+			224, 135, 110, 137, 138, 131, 186, 104, 161, 232, 73, 93, 116, 80,
+			60, 118, 92, 252, 146, 69, 190, 217, 165, 223, 177, 10, 191, 111,
+			89, 254, 79, 89, 50, 111, 107, 207, 140, 230, 106, 235, 65, 151,
+			164, 145, 29, 77, 58, 190, 33, 171, 36, 45, 30, 119, 28, 98,
+			194, 204, 74, 190, 83, 99, 212, 112, 204, 66, 243, 33, 131, 60,
+			188, 182, 108, 101, 211, 48, 84, 68, 14, 161, 156, 147, 132, 118,
+			146, 144, 55, 62, 172, 217, 223, 193, 198, 247, 41, 246, 255, 118,
+			127, 215, 178, 143, 251, 159, 144, 11, 33, 227, 62, 167, 66, 133,
+			186, 6, 208, 186, 91, 222, 96, 144, 94, 58, 179, 243, 183, 244,
+			83, 250, 190, 77, 254, 4, 60, 47, 41, 251, 7, 234, 46, 233,
+			162, 229, 185, 191, 107, 109, 59, 170, 139, 14, 138, 195, 199, 148,
+			207, 34, 252, 25, 221, 127, 101, 217, 135, 252, 31, 176, 122, 80,
+			178, 188, 9, 199, 28, 74, 206, 246, 169, 68, 118, 8, 193, 134,
+			72, 3, 9, 45, 147, 8, 57, 37, 79, 71, 236, 181, 87, 115,
+			167, 55, 244, 252, 247, 195, 88, 72, 16, 205, 201, 178, 122, 159,
+			211, 166, 71, 86, 137, 136, 28, 208, 69, 162, 121, 112, 191, 46,
+			58, 40, 250, 7, 17, 250, 0, 5, 102, 233, 143, 172, 194, 255,
+			223, 182, 252, 239, 178, 50, 126, 206, 233, 145, 174, 164, 63, 26,
+			115, 88, 131, 88, 230, 139, 154, 249, 124, 51, 198, 92, 172, 133,
+			32, 65, 26, 140, 156, 104, 64, 11, 114, 52, 111, 181, 80, 111,
+			177, 252, 179, 196, 111, 142, 242, 28, 112, 44, 207, 253, 35, 128,
+			83, 63, 100, 127, 108, 247, 143, 45, 103, 191, 255, 78, 111, 58,
+			141, 48, 208, 69, 43, 124, 44, 53, 212, 25, 180, 85, 113, 119,
+			115, 26, 199, 140, 148, 207, 184, 11, 67, 108, 64, 32, 25, 51,
+			148, 156, 151, 209, 180, 41, 90, 158, 251, 199, 86, 191, 167, 139,
+			14, 138, 123, 247, 137, 191, 179, 131, 253, 151, 221, 47, 219, 206,
+			30, 255, 243, 59, 114, 132, 6, 237, 158, 212, 110, 158, 7, 36,
+			58, 16, 133, 16, 19, 140, 158, 139, 211, 98, 103, 95, 108, 179,
+			212, 164, 229, 35, 108, 125, 199, 104, 47, 133, 190, 37, 39, 132,
+			67, 112, 4, 156, 95, 212, 238, 240, 226, 126, 64, 178, 176, 150,
+			123, 213, 82, 169, 199, 65, 170, 123, 172, 133, 181, 14, 252, 102,
+			177, 61, 64, 237, 21, 86, 59, 134, 98, 202, 193, 6, 18, 72,
+			167, 129, 171, 49, 33, 226, 69, 70, 84, 87, 129, 134, 90, 61,
+			122, 42, 33, 5, 169, 200, 212, 137, 154, 234, 97, 208, 238, 196,
+			33, 198, 91, 94, 15, 219, 64, 71, 15, 187, 185, 1, 215, 130,
+			32, 111, 45, 215, 226, 86, 53, 140, 238, 107, 49, 62, 96, 217,
+			146, 129, 249, 2, 240, 112, 189, 211, 102, 156, 66, 250, 42, 223,
+			118, 216, 196, 97, 1, 221, 41, 90, 158, 222, 178, 229, 229, 77,
+			74, 98, 37, 201, 227, 111, 26, 81, 97, 192, 0, 49, 82, 122,
+			78, 141, 224, 227, 182, 150, 74, 55, 100, 35, 12, 146, 182, 188,
+			7, 195, 105, 58, 11, 146, 81, 165, 204, 110, 199, 209, 202, 10,
+			1, 45, 101, 154, 159, 194, 222, 145, 22, 167, 245, 64, 231, 4,
+			203, 41, 246, 202, 79, 194, 54, 34, 179, 229, 212, 169, 132, 235,
+			166, 155, 5, 246, 20, 158, 75, 116, 85, 225, 107, 47, 71, 252,
+			24, 50, 0, 219, 155, 173, 117, 122, 83, 160, 23, 26, 149, 83,
+			198, 52, 209, 117, 3, 16, 217, 202, 50, 87, 235, 77, 181, 146,
+			185, 67, 31, 18, 106, 229, 167, 143, 141, 154, 150, 148, 152, 137,
+			172, 119, 226, 110, 223, 6, 57, 125, 126, 76, 78, 95, 144, 149,
+			74, 69, 78, 223, 100, 85, 166, 86, 154, 169, 113, 129, 98, 7,
+			231, 210, 184, 142, 211, 219, 220, 11, 172, 113, 211, 3, 144, 190,
+			70, 189, 192, 166, 157, 68, 203, 141, 141, 52, 65, 161, 48, 157,
+			218, 68, 249, 244, 121, 77, 197, 136, 182, 71, 170, 188, 106, 156,
+			155, 199, 164, 230, 209, 44, 102, 221, 20, 166, 20, 205, 151, 241,
+			116, 237, 164, 11, 112, 189, 211, 166, 213, 112, 76, 222, 208, 216,
+			196, 198, 242, 139, 92, 59, 171, 9, 14, 20, 52, 198, 196, 146,
+			153, 40, 191, 23, 203, 41, 102, 76, 102, 103, 224, 67, 146, 245,
+			242, 57, 197, 167, 210, 242, 111, 174, 101, 6, 159, 33, 2, 23,
+			203, 77, 229, 47, 124, 64, 8, 54, 121, 51, 202, 131, 144, 246,
+			79, 184, 154, 79, 194, 57, 106, 10, 190, 237, 211, 231, 240, 239,
+			76, 90, 62, 207, 101, 48, 229, 65, 75, 245, 4, 71, 160, 233,
+			28, 199, 34, 78, 1, 84, 203, 236, 127, 232, 7, 238, 195, 99,
+			89, 228, 125, 179, 115, 6, 9, 146, 98, 178, 122, 1, 224, 148,
+			185, 145, 214, 83, 53, 9, 27, 42, 228, 19, 211, 237, 116, 171,
+			25, 234, 171, 105, 15, 34, 192, 151, 118, 75, 206, 200, 17, 50,
+			96, 210, 214, 59, 125, 14, 71, 198, 244, 121, 26, 150, 107, 173,
+			7, 225, 253, 48, 30, 227, 185, 134, 26, 48, 22, 166, 141, 72,
+			221, 57, 87, 58, 65, 28, 52, 219, 33, 11, 76, 81, 93, 135,
+			152, 114, 76, 44, 15, 76, 122, 140, 103, 216, 110, 48, 169, 113,
+			222, 39, 156, 104, 66, 163, 107, 175, 5, 247, 194, 158, 167, 8,
+			58, 7, 109, 59, 40, 226, 121, 23, 24, 25, 135, 242, 27, 225,
+			76, 218, 166, 139, 200, 53, 98, 151, 119, 233, 34, 114, 141, 216,
+			222, 110, 74, 144, 226, 122, 165, 239, 178, 11, 63, 101, 91, 254,
+			216, 147, 203, 3, 124, 96, 35, 58, 225, 187, 80, 239, 223, 135,
+			226, 150, 194, 19, 190, 98, 187, 39, 252, 239, 179, 73, 29, 179,
+			12, 52, 110, 206, 173, 1, 190, 231, 244, 74, 213, 213, 176, 122,
+			15, 123, 70, 167, 157, 75, 150, 134, 208, 215, 102, 136, 20, 158,
+			1, 84, 68, 15, 64, 0, 46, 1, 65, 173, 150, 67, 195, 203,
+			230, 241, 208, 117, 210, 9, 7, 67, 132, 202, 248, 49, 198, 167,
+			82, 128, 201, 12, 247, 44, 152, 161, 97, 176, 169, 200, 89, 82,
+			158, 243, 207, 148, 63, 68, 190, 143, 217, 219, 149, 46, 41, 239,
+			186, 41, 47, 201, 115, 47, 210, 91, 11, 38, 103, 200, 124, 120,
+			63, 130, 143, 155, 76, 238, 55, 151, 98, 93, 184, 36, 159, 85,
+			47, 86, 42, 21, 33, 63, 48, 161, 22, 37, 207, 253, 138, 237,
+			102, 67, 45, 190, 98, 179, 20, 79, 25, 141, 220, 175, 216, 199,
+			142, 139, 191, 180, 56, 167, 145, 251, 163, 182, 123, 218, 255, 83,
+			229, 62, 147, 250, 114, 194, 190, 145, 81, 203, 113, 216, 174, 236,
+			172, 115, 26, 141, 110, 190, 51, 123, 68, 158, 231, 179, 236, 108,
+			197, 125, 123, 183, 19, 118, 66, 217, 142, 55, 222, 105, 45, 39,
+			236, 9, 66, 86, 197, 115, 103, 187, 154, 214, 226, 147, 217, 246,
+			193, 246, 10, 198, 13, 219, 119, 40, 72, 103, 132, 141, 100, 76,
+			135, 180, 165, 179, 159, 0, 210, 229, 185, 179, 234, 112, 10, 33,
+			208, 40, 151, 84, 158, 186, 174, 109, 185, 212, 237, 237, 186, 88,
+			66, 113, 199, 1, 93, 36, 166, 248, 39, 116, 209, 65, 113, 100,
+			84, 252, 166, 205, 249, 156, 220, 159, 180, 221, 125, 254, 175, 218,
+			240, 90, 96, 33, 16, 147, 66, 49, 170, 185, 193, 93, 73, 228,
+			90, 128, 89, 221, 202, 76, 244, 100, 140, 214, 114, 10, 202, 200,
+			41, 153, 186, 173, 200, 25, 198, 6, 178, 22, 174, 35, 53, 92,
+			179, 186, 65, 18, 104, 103, 125, 37, 38, 229, 220, 114, 88, 111,
+			177, 198, 20, 27, 61, 27, 107, 148, 20, 13, 143, 165, 184, 69,
+			32, 235, 113, 88, 141, 214, 149, 60, 52, 179, 26, 198, 241, 198,
+			248, 122, 84, 189, 135, 47, 130, 12, 211, 113, 90, 183, 91, 235,
+			122, 31, 163, 225, 165, 161, 68, 84, 56, 111, 65, 58, 79, 47,
+			68, 200, 181, 224, 94, 215, 129, 15, 83, 123, 123, 3, 71, 20,
+			114, 128, 81, 108, 167, 186, 78, 39, 225, 122, 0, 128, 170, 198,
+			134, 201, 64, 181, 67, 39, 195, 2, 43, 203, 204, 104, 8, 185,
+			63, 105, 247, 233, 153, 139, 139, 237, 79, 218, 123, 246, 154, 168,
+			160, 255, 40, 196, 197, 39, 140, 154, 1, 52, 114, 61, 122, 116,
+			92, 208, 240, 235, 226, 104, 42, 11, 177, 135, 71, 88, 187, 153,
+			249, 212, 59, 34, 68, 186, 141, 50, 146, 85, 230, 151, 52, 196,
+			194, 206, 132, 88, 124, 20, 87, 231, 31, 233, 3, 188, 247, 206,
+			194, 241, 71, 121, 58, 159, 63, 255, 52, 127, 201, 211, 252, 37,
+			255, 105, 242, 151, 252, 16, 231, 47, 57, 84, 56, 110, 249, 223,
+			97, 203, 41, 19, 46, 72, 107, 135, 165, 249, 160, 43, 5, 44,
+			33, 141, 44, 67, 131, 110, 188, 128, 198, 132, 14, 248, 236, 126,
+			249, 84, 151, 119, 5, 182, 236, 102, 235, 65, 151, 159, 34, 13,
+			79, 18, 212, 67, 22, 48, 51, 58, 62, 154, 237, 154, 172, 40,
+			145, 198, 1, 134, 186, 122, 247, 254, 185, 74, 218, 216, 146, 33,
+			232, 174, 156, 105, 180, 58, 53, 121, 187, 179, 60, 177, 208, 89,
+			134, 82, 124, 61, 170, 98, 94, 192, 114, 54, 158, 177, 113, 33,
+			99, 75, 206, 137, 249, 66, 198, 135, 249, 80, 249, 148, 168, 106,
+			140, 162, 163, 174, 239, 127, 82, 46, 102, 96, 205, 187, 238, 117,
+			70, 118, 49, 100, 60, 153, 19, 177, 241, 8, 0, 32, 195, 81,
+			6, 237, 84, 88, 56, 71, 141, 19, 29, 0, 25, 142, 14, 29,
+			16, 215, 181, 171, 229, 49, 119, 175, 255, 241, 116, 47, 136, 83,
+			123, 107, 235, 65, 51, 233, 162, 13, 186, 138, 92, 150, 171, 201,
+			106, 52, 108, 218, 69, 252, 250, 49, 211, 46, 92, 26, 143, 49,
+			216, 163, 114, 105, 60, 182, 123, 143, 217, 128, 255, 106, 80, 28,
+			236, 14, 134, 164, 252, 34, 91, 229, 215, 216, 38, 138, 179, 120,
+			62, 253, 65, 239, 244, 9, 130, 158, 234, 236, 9, 149, 39, 204,
+			158, 64, 77, 126, 168, 228, 9, 255, 245, 128, 74, 158, 112, 126,
+			224, 105, 242, 132, 167, 201, 19, 158, 38, 79, 120, 154, 60, 225,
+			105, 242, 132, 167, 201, 19, 190, 133, 201, 19, 102, 181, 232, 117,
+			172, 48, 203, 63, 30, 79, 243, 36, 28, 55, 121, 18, 78, 20,
+			206, 232, 60, 9, 248, 83, 39, 79, 48, 121, 18, 78, 154, 60,
+			9, 167, 210, 60, 9, 248, 83, 39, 79, 48, 89, 26, 240, 231,
+			63, 81, 50, 158, 115, 190, 48, 224, 255, 23, 16, 241, 86, 194,
+			38, 210, 8, 203, 48, 135, 15, 65, 130, 3, 238, 0, 48, 184,
+			105, 37, 103, 75, 6, 247, 91, 81, 141, 225, 143, 176, 253, 177,
+			158, 27, 151, 191, 220, 247, 180, 253, 110, 180, 58, 177, 156, 186,
+			61, 7, 148, 122, 13, 94, 166, 47, 180, 236, 32, 138, 155, 5,
+			252, 108, 148, 124, 2, 132, 208, 48, 105, 11, 217, 138, 115, 232,
+			38, 248, 152, 17, 238, 81, 31, 50, 247, 172, 182, 106, 42, 133,
+			66, 212, 76, 128, 139, 28, 234, 211, 136, 93, 188, 228, 213, 86,
+			139, 53, 43, 82, 202, 120, 189, 42, 167, 131, 120, 164, 75, 214,
+			168, 144, 168, 49, 106, 252, 126, 183, 120, 174, 52, 42, 208, 166,
+			24, 65, 240, 124, 121, 135, 145, 128, 190, 243, 146, 144, 221, 18,
+			16, 153, 160, 150, 214, 130, 228, 222, 86, 98, 208, 51, 162, 239,
+			42, 94, 186, 17, 36, 247, 112, 101, 36, 133, 26, 3, 209, 170,
+			194, 244, 151, 172, 222, 18, 210, 78, 243, 161, 150, 146, 206, 63,
+			161, 148, 68, 100, 129, 170, 15, 37, 41, 125, 245, 69, 132, 220,
+			30, 41, 252, 165, 245, 52, 207, 212, 211, 60, 83, 79, 243, 76,
+			61, 205, 51, 245, 52, 207, 212, 211, 60, 83, 223, 202, 60, 83,
+			70, 42, 58, 102, 164, 162, 227, 133, 9, 45, 21, 225, 79, 45,
+			42, 25, 169, 232, 132, 145, 138, 78, 166, 82, 209, 73, 35, 21,
+			157, 42, 204, 105, 241, 11, 127, 246, 20, 149, 254, 241, 24, 137,
+			74, 165, 191, 176, 112, 244, 249, 63, 57, 38, 239, 154, 147, 183,
+			43, 141, 16, 195, 32, 38, 27, 107, 203, 173, 70, 84, 229, 32,
+			24, 58, 198, 1, 96, 103, 176, 247, 244, 65, 64, 79, 38, 229,
+			112, 189, 18, 12, 119, 255, 178, 92, 169, 13, 195, 4, 8, 203,
+			193, 221, 122, 87, 67, 28, 93, 131, 43, 255, 90, 40, 227, 22,
+			194, 165, 148, 50, 108, 76, 222, 13, 238, 98, 19, 149, 119, 151,
+			239, 10, 157, 214, 149, 119, 90, 126, 71, 214, 41, 225, 72, 212,
+			148, 119, 235, 119, 149, 161, 254, 110, 237, 174, 169, 214, 60, 165,
+			93, 86, 127, 67, 111, 87, 150, 145, 28, 69, 82, 255, 37, 36,
+			8, 58, 220, 204, 86, 201, 230, 126, 112, 162, 179, 204, 204, 96,
+			10, 242, 14, 117, 130, 133, 45, 214, 217, 202, 21, 188, 187, 174,
+			177, 67, 91, 49, 159, 186, 252, 184, 41, 59, 20, 247, 147, 190,
+			82, 233, 162, 1, 145, 36, 26, 155, 174, 147, 180, 91, 107, 164,
+			208, 67, 204, 91, 171, 134, 83, 103, 36, 9, 1, 97, 220, 104,
+			61, 96, 115, 183, 234, 193, 13, 234, 65, 212, 132, 178, 7, 78,
+			243, 56, 6, 133, 128, 237, 166, 169, 250, 196, 124, 195, 241, 16,
+			62, 164, 238, 4, 218, 235, 85, 137, 3, 169, 112, 170, 249, 4,
+			127, 242, 164, 179, 60, 110, 248, 150, 48, 204, 173, 118, 93, 15,
+			73, 120, 5, 226, 111, 179, 177, 209, 5, 234, 201, 204, 10, 18,
+			161, 143, 141, 148, 8, 176, 187, 146, 183, 6, 113, 36, 62, 158,
+			232, 183, 214, 97, 4, 108, 117, 18, 161, 223, 130, 136, 173, 77,
+			116, 237, 86, 47, 138, 161, 154, 36, 177, 37, 209, 243, 178, 158,
+			202, 204, 129, 156, 148, 231, 207, 235, 210, 114, 250, 64, 202, 154,
+			156, 148, 231, 210, 226, 67, 188, 169, 139, 31, 232, 63, 54, 240,
+			210, 5, 45, 58, 227, 95, 249, 222, 164, 124, 129, 53, 184, 74,
+			255, 171, 252, 60, 216, 140, 68, 66, 143, 57, 51, 9, 121, 0,
+			244, 197, 154, 55, 15, 199, 48, 35, 106, 242, 61, 65, 25, 16,
+			162, 152, 209, 9, 82, 3, 124, 91, 27, 214, 12, 118, 36, 222,
+			103, 148, 2, 240, 137, 132, 98, 9, 255, 75, 193, 17, 221, 163,
+			147, 226, 163, 119, 157, 187, 245, 1, 140, 150, 221, 57, 124, 216,
+			60, 166, 99, 15, 217, 104, 194, 194, 98, 35, 72, 50, 254, 5,
+			152, 92, 130, 45, 234, 144, 13, 155, 43, 236, 164, 16, 72, 179,
+			223, 232, 224, 14, 174, 150, 119, 4, 244, 169, 107, 13, 65, 36,
+			11, 69, 90, 54, 65, 8, 152, 2, 141, 134, 102, 230, 8, 76,
+			114, 93, 45, 212, 51, 47, 8, 185, 26, 96, 189, 134, 102, 72,
+			194, 26, 173, 160, 20, 199, 92, 239, 26, 52, 9, 77, 240, 166,
+			54, 161, 71, 176, 236, 105, 112, 75, 34, 169, 221, 90, 231, 152,
+			242, 238, 137, 72, 137, 245, 224, 73, 166, 214, 217, 252, 236, 194,
+			98, 207, 78, 101, 218, 211, 189, 50, 254, 125, 60, 242, 186, 106,
+			21, 108, 22, 117, 87, 171, 211, 175, 229, 42, 214, 203, 40, 105,
+			135, 65, 45, 203, 175, 48, 168, 174, 194, 227, 50, 186, 31, 213,
+			0, 218, 194, 228, 26, 249, 213, 108, 101, 38, 56, 12, 181, 247,
+			234, 13, 239, 77, 234, 226, 58, 38, 88, 200, 77, 97, 132, 115,
+			178, 39, 34, 95, 181, 203, 92, 174, 179, 60, 211, 133, 172, 54,
+			194, 32, 110, 108, 24, 1, 58, 147, 201, 79, 137, 201, 17, 137,
+			168, 213, 70, 192, 147, 128, 9, 158, 186, 61, 87, 145, 114, 14,
+			17, 147, 48, 73, 7, 9, 123, 188, 132, 245, 58, 38, 87, 107,
+			139, 110, 77, 232, 63, 40, 12, 87, 27, 221, 132, 212, 144, 137,
+			180, 74, 233, 162, 223, 107, 139, 85, 161, 155, 242, 150, 230, 121,
+			66, 203, 37, 51, 150, 209, 230, 109, 62, 13, 165, 209, 1, 45,
+			60, 111, 149, 149, 69, 200, 54, 128, 207, 25, 224, 157, 111, 116,
+			49, 76, 99, 216, 243, 149, 205, 72, 85, 73, 9, 246, 213, 198,
+			155, 35, 221, 236, 194, 74, 141, 15, 38, 240, 142, 195, 118, 28,
+			110, 47, 200, 6, 245, 48, 23, 65, 52, 56, 88, 131, 179, 29,
+			3, 95, 210, 128, 34, 143, 71, 187, 213, 169, 174, 34, 175, 63,
+			173, 95, 67, 29, 28, 169, 144, 69, 95, 85, 210, 18, 38, 7,
+			35, 125, 205, 180, 50, 9, 99, 230, 172, 80, 145, 98, 73, 23,
+			121, 65, 163, 97, 206, 119, 181, 119, 222, 207, 30, 50, 32, 207,
+			108, 32, 155, 119, 165, 180, 59, 236, 138, 213, 205, 251, 49, 2,
+			5, 225, 230, 48, 229, 48, 208, 152, 131, 112, 56, 168, 233, 45,
+			214, 68, 39, 119, 183, 192, 0, 185, 52, 62, 102, 116, 42, 105,
+			254, 3, 209, 147, 42, 114, 216, 208, 155, 101, 212, 220, 98, 151,
+			148, 119, 105, 151, 188, 219, 189, 77, 118, 29, 187, 155, 70, 108,
+			83, 77, 102, 132, 213, 218, 87, 156, 16, 61, 88, 65, 136, 191,
+			134, 31, 198, 47, 113, 45, 140, 87, 82, 216, 128, 12, 55, 50,
+			148, 152, 109, 162, 155, 23, 221, 158, 109, 42, 86, 62, 243, 38,
+			87, 208, 227, 80, 206, 159, 69, 249, 83, 184, 215, 33, 92, 157,
+			148, 111, 158, 123, 59, 115, 76, 53, 107, 153, 33, 255, 16, 237,
+			156, 237, 89, 245, 249, 76, 213, 196, 169, 104, 19, 107, 35, 37,
+			91, 176, 120, 251, 230, 112, 189, 178, 60, 60, 6, 49, 183, 58,
+			252, 182, 254, 170, 221, 37, 16, 44, 127, 56, 146, 30, 213, 247,
+			49, 153, 165, 113, 170, 73, 73, 124, 194, 84, 233, 128, 109, 151,
+			53, 12, 146, 161, 47, 55, 231, 100, 96, 113, 34, 183, 223, 137,
+			116, 18, 99, 39, 96, 86, 242, 178, 164, 229, 7, 113, 150, 124,
+			47, 128, 60, 149, 132, 230, 192, 60, 165, 97, 149, 120, 238, 112,
+			237, 58, 49, 2, 77, 202, 14, 180, 160, 203, 140, 245, 174, 79,
+			1, 246, 92, 202, 127, 198, 117, 241, 139, 70, 89, 146, 78, 54,
+			121, 13, 249, 65, 145, 98, 118, 19, 61, 169, 56, 208, 202, 110,
+			86, 99, 134, 35, 129, 110, 69, 24, 61, 171, 94, 61, 250, 101,
+			67, 86, 70, 184, 200, 80, 61, 6, 149, 90, 173, 37, 88, 106,
+			209, 213, 170, 14, 37, 153, 76, 180, 36, 163, 155, 5, 157, 155,
+			64, 57, 137, 167, 165, 103, 175, 226, 151, 89, 170, 153, 115, 91,
+			244, 20, 116, 50, 85, 230, 36, 157, 212, 125, 193, 108, 27, 163,
+			149, 172, 156, 99, 88, 27, 154, 76, 171, 73, 117, 53, 92, 11,
+			100, 120, 191, 213, 232, 232, 125, 34, 82, 103, 248, 90, 24, 52,
+			121, 159, 227, 250, 65, 166, 114, 203, 76, 165, 36, 24, 173, 77,
+			150, 22, 28, 29, 202, 109, 11, 29, 173, 71, 141, 6, 111, 45,
+			130, 57, 77, 90, 113, 179, 247, 196, 97, 58, 11, 162, 88, 143,
+			16, 251, 193, 177, 31, 126, 19, 169, 153, 178, 71, 52, 28, 200,
+			184, 127, 85, 163, 35, 7, 189, 124, 36, 226, 254, 164, 104, 36,
+			57, 241, 65, 176, 161, 183, 209, 13, 145, 99, 222, 24, 235, 192,
+			112, 208, 234, 212, 178, 144, 36, 155, 173, 54, 70, 111, 42, 81,
+			2, 72, 78, 124, 227, 124, 30, 13, 118, 62, 216, 52, 133, 232,
+			132, 79, 143, 196, 164, 199, 153, 168, 87, 130, 102, 134, 94, 111,
+			202, 15, 46, 97, 209, 37, 43, 247, 34, 47, 45, 137, 98, 189,
+			69, 157, 30, 27, 149, 48, 242, 1, 139, 16, 171, 173, 102, 43,
+			115, 176, 66, 122, 130, 128, 115, 76, 206, 176, 211, 27, 203, 42,
+			56, 62, 174, 45, 46, 222, 38, 89, 143, 47, 56, 84, 214, 185,
+			113, 123, 156, 178, 44, 214, 112, 30, 151, 12, 29, 102, 237, 243,
+			40, 223, 158, 90, 156, 185, 102, 164, 211, 86, 93, 222, 190, 179,
+			152, 91, 204, 12, 61, 175, 90, 76, 194, 181, 0, 129, 18, 137,
+			144, 35, 120, 17, 181, 41, 73, 71, 43, 54, 65, 44, 176, 7,
+			152, 162, 132, 239, 197, 116, 109, 158, 213, 215, 230, 86, 61, 43,
+			197, 97, 85, 42, 79, 153, 177, 60, 173, 81, 162, 110, 218, 156,
+			137, 154, 243, 184, 242, 233, 204, 129, 31, 180, 243, 67, 89, 32,
+			140, 211, 31, 223, 253, 225, 0, 26, 84, 84, 67, 9, 153, 176,
+			209, 47, 18, 186, 241, 17, 190, 129, 74, 249, 126, 8, 215, 31,
+			184, 236, 76, 64, 219, 42, 33, 35, 196, 227, 213, 96, 45, 36,
+			132, 6, 86, 185, 223, 15, 155, 6, 131, 101, 10, 250, 231, 244,
+			124, 213, 46, 138, 93, 10, 233, 116, 10, 25, 57, 217, 220, 131,
+			245, 179, 219, 113, 139, 210, 232, 153, 163, 231, 78, 18, 198, 224,
+			99, 156, 250, 228, 74, 41, 111, 175, 226, 94, 185, 78, 255, 94,
+			146, 231, 141, 193, 40, 87, 23, 125, 106, 42, 98, 30, 213, 162,
+			100, 189, 17, 108, 44, 81, 239, 179, 85, 242, 243, 160, 86, 35,
+			95, 177, 108, 173, 52, 26, 234, 42, 155, 27, 13, 140, 236, 93,
+			166, 248, 46, 109, 70, 141, 86, 235, 30, 70, 38, 233, 84, 87,
+			77, 215, 176, 245, 26, 50, 180, 218, 9, 93, 170, 100, 137, 25,
+			238, 126, 131, 122, 55, 156, 163, 65, 205, 8, 227, 92, 174, 167,
+			132, 81, 88, 169, 105, 65, 59, 187, 110, 221, 52, 140, 151, 187,
+			218, 69, 192, 237, 88, 119, 51, 249, 235, 4, 182, 204, 91, 228,
+			60, 77, 191, 38, 93, 42, 41, 2, 155, 209, 251, 46, 214, 8,
+			94, 77, 228, 59, 88, 4, 148, 14, 75, 97, 250, 243, 33, 109,
+			150, 49, 38, 134, 216, 60, 51, 38, 69, 126, 0, 23, 72, 173,
+			114, 131, 75, 166, 39, 202, 153, 27, 30, 183, 75, 234, 79, 243,
+			196, 140, 34, 143, 46, 251, 102, 75, 246, 228, 214, 53, 37, 157,
+			229, 37, 221, 198, 37, 121, 241, 197, 46, 73, 230, 3, 222, 81,
+			50, 35, 173, 162, 32, 31, 61, 162, 153, 65, 68, 13, 183, 226,
+			71, 191, 158, 33, 34, 243, 85, 122, 10, 114, 47, 97, 136, 69,
+			205, 137, 28, 25, 78, 187, 60, 108, 98, 209, 177, 209, 142, 2,
+			31, 10, 71, 153, 222, 114, 224, 25, 79, 237, 240, 246, 153, 142,
+			40, 242, 90, 26, 35, 27, 247, 179, 75, 66, 227, 196, 230, 169,
+			221, 151, 119, 78, 28, 219, 89, 245, 5, 217, 136, 179, 119, 16,
+			97, 14, 11, 214, 62, 222, 71, 91, 27, 236, 9, 199, 17, 43,
+			57, 69, 45, 102, 151, 186, 252, 210, 109, 224, 238, 220, 205, 79,
+			78, 93, 159, 187, 178, 52, 53, 255, 202, 29, 168, 253, 239, 166,
+			199, 29, 14, 21, 29, 149, 209, 105, 174, 5, 235, 235, 28, 18,
+			140, 120, 194, 130, 229, 185, 127, 97, 149, 7, 197, 152, 70, 36,
+			253, 247, 8, 229, 60, 194, 120, 26, 169, 118, 148, 68, 36, 195,
+			26, 134, 248, 116, 233, 117, 83, 44, 162, 216, 111, 0, 64, 45,
+			20, 189, 253, 186, 232, 160, 152, 201, 182, 252, 7, 39, 196, 145,
+			110, 99, 116, 173, 195, 186, 211, 45, 76, 209, 147, 162, 124, 133,
+			95, 1, 184, 116, 18, 86, 91, 205, 154, 66, 164, 118, 230, 117,
+			17, 54, 234, 102, 208, 108, 1, 2, 218, 26, 41, 206, 171, 194,
+			244, 23, 182, 176, 81, 239, 208, 53, 106, 19, 245, 185, 39, 52,
+			81, 107, 98, 63, 148, 133, 250, 143, 142, 41, 95, 190, 228, 169,
+			129, 250, 169, 129, 250, 169, 129, 250, 169, 129, 250, 169, 129, 250,
+			169, 129, 250, 91, 105, 160, 54, 118, 99, 252, 169, 13, 212, 215,
+			180, 133, 25, 127, 106, 3, 181, 49, 101, 159, 48, 166, 236, 147,
+			133, 138, 54, 101, 227, 79, 109, 160, 54, 166, 236, 83, 198, 148,
+			61, 146, 154, 178, 241, 231, 223, 31, 32, 3, 181, 91, 47, 36,
+			150, 255, 119, 6, 228, 148, 212, 167, 110, 222, 104, 172, 194, 87,
+			145, 211, 243, 97, 88, 27, 111, 132, 205, 149, 246, 170, 76, 214,
+			131, 166, 129, 204, 200, 136, 236, 2, 50, 59, 242, 127, 3, 236,
+			15, 187, 191, 58, 255, 177, 251, 201, 122, 28, 84, 211, 99, 65,
+			63, 104, 75, 146, 5, 168, 8, 161, 43, 97, 173, 72, 133, 35,
+			136, 129, 233, 129, 91, 58, 180, 11, 44, 205, 85, 131, 70, 216,
+			172, 5, 208, 186, 214, 52, 44, 62, 92, 174, 239, 133, 114, 184,
+			22, 108, 12, 11, 28, 116, 195, 107, 173, 102, 123, 117, 88, 87,
+			163, 177, 186, 218, 173, 12, 174, 109, 196, 97, 22, 216, 56, 117,
+			52, 117, 213, 0, 145, 10, 10, 2, 78, 223, 214, 186, 4, 116,
+			208, 176, 10, 36, 68, 38, 205, 111, 80, 195, 169, 217, 138, 97,
+			200, 110, 163, 187, 6, 27, 33, 72, 155, 173, 200, 121, 146, 24,
+			148, 133, 53, 110, 61, 140, 112, 28, 52, 54, 228, 153, 241, 115,
+			103, 199, 206, 158, 61, 43, 55, 194, 32, 102, 115, 200, 44, 99,
+			249, 9, 161, 255, 148, 231, 38, 9, 102, 191, 211, 14, 83, 50,
+			168, 141, 28, 185, 116, 133, 89, 79, 194, 78, 173, 69, 142, 244,
+			21, 62, 164, 205, 11, 8, 44, 141, 219, 242, 146, 172, 84, 42,
+			47, 118, 63, 11, 155, 181, 220, 19, 211, 144, 150, 176, 244, 83,
+			245, 161, 254, 181, 162, 135, 245, 18, 92, 197, 77, 105, 92, 181,
+			165, 203, 47, 118, 125, 68, 19, 128, 63, 81, 127, 235, 15, 168,
+			164, 27, 137, 234, 114, 100, 83, 67, 47, 201, 179, 242, 228, 201,
+			238, 186, 46, 203, 179, 163, 233, 45, 101, 211, 71, 103, 178, 119,
+			229, 174, 79, 199, 47, 201, 115, 103, 245, 127, 252, 210, 7, 50,
+			108, 36, 97, 111, 2, 46, 247, 36, 224, 165, 71, 19, 48, 254,
+			8, 2, 206, 244, 34, 32, 51, 252, 231, 211, 225, 79, 199, 139,
+			198, 63, 45, 158, 73, 103, 198, 135, 159, 5, 91, 142, 245, 214,
+			115, 68, 125, 152, 29, 242, 75, 249, 33, 151, 103, 210, 110, 242,
+			79, 92, 95, 58, 232, 250, 19, 102, 67, 250, 193, 166, 89, 144,
+			126, 147, 231, 115, 110, 206, 101, 89, 156, 126, 112, 230, 209, 195,
+			155, 190, 120, 57, 251, 226, 22, 109, 156, 233, 221, 198, 248, 99,
+			70, 240, 194, 86, 11, 24, 58, 75, 236, 168, 21, 252, 83, 11,
+			27, 116, 197, 144, 183, 55, 218, 171, 240, 93, 81, 21, 181, 177,
+			48, 55, 191, 56, 82, 11, 54, 146, 75, 23, 198, 228, 90, 212,
+			236, 180, 195, 228, 210, 185, 179, 163, 249, 101, 38, 47, 153, 214,
+			70, 186, 30, 85, 174, 198, 173, 181, 69, 83, 85, 187, 54, 154,
+			106, 245, 110, 4, 235, 235, 81, 115, 37, 213, 217, 240, 77, 7,
+			194, 113, 134, 126, 186, 64, 111, 210, 232, 41, 53, 84, 28, 176,
+			232, 10, 244, 214, 166, 96, 111, 132, 49, 86, 241, 161, 26, 214,
+			116, 132, 205, 84, 255, 159, 116, 234, 245, 232, 161, 28, 78, 134,
+			229, 8, 227, 63, 161, 46, 102, 253, 40, 246, 126, 129, 189, 120,
+			29, 8, 43, 181, 84, 207, 218, 236, 172, 45, 171, 196, 40, 252,
+			42, 223, 125, 210, 83, 38, 209, 49, 148, 68, 166, 48, 231, 18,
+			32, 173, 213, 39, 93, 254, 50, 23, 244, 239, 170, 166, 179, 153,
+			19, 43, 131, 62, 37, 76, 223, 163, 28, 163, 192, 138, 225, 11,
+			201, 48, 250, 11, 109, 96, 90, 27, 206, 142, 115, 153, 202, 184,
+			46, 193, 65, 139, 76, 98, 175, 218, 42, 122, 118, 157, 67, 189,
+			168, 167, 171, 86, 33, 215, 162, 106, 156, 175, 247, 73, 171, 61,
+			151, 32, 152, 47, 117, 87, 175, 151, 7, 196, 255, 172, 211, 163,
+			56, 239, 216, 123, 252, 223, 177, 228, 2, 73, 5, 166, 81, 214,
+			136, 103, 197, 130, 138, 188, 193, 96, 159, 180, 57, 141, 95, 56,
+			247, 220, 216, 115, 31, 123, 30, 7, 28, 254, 7, 149, 172, 60,
+			211, 245, 163, 6, 185, 184, 31, 86, 76, 234, 211, 48, 9, 229,
+			50, 124, 203, 232, 194, 201, 137, 106, 248, 110, 50, 41, 228, 243,
+			103, 65, 196, 196, 90, 212, 148, 167, 81, 88, 139, 154, 19, 171,
+			177, 60, 45, 207, 63, 43, 87, 227, 137, 90, 176, 33, 79, 203,
+			11, 207, 63, 87, 57, 255, 156, 196, 26, 153, 192, 225, 42, 79,
+			171, 21, 170, 78, 90, 29, 171, 136, 136, 201, 119, 76, 50, 6,
+			116, 253, 157, 242, 46, 93, 114, 60, 231, 29, 111, 183, 248, 130,
+			163, 67, 38, 99, 219, 243, 255, 131, 173, 25, 145, 19, 110, 2,
+			230, 75, 94, 186, 201, 8, 55, 218, 130, 0, 126, 137, 148, 97,
+			122, 53, 37, 178, 1, 181, 173, 65, 52, 209, 181, 197, 57, 89,
+			75, 205, 198, 64, 158, 21, 242, 46, 143, 195, 93, 214, 12, 97,
+			14, 4, 108, 179, 70, 58, 221, 88, 54, 195, 149, 128, 208, 199,
+			239, 18, 65, 252, 162, 154, 232, 122, 27, 72, 136, 148, 76, 131,
+			228, 81, 7, 68, 132, 128, 128, 43, 222, 11, 227, 22, 91, 12,
+			117, 128, 113, 174, 54, 141, 238, 42, 76, 247, 160, 185, 132, 252,
+			168, 99, 52, 186, 232, 236, 158, 34, 23, 47, 94, 28, 227, 255,
+			169, 233, 145, 249, 33, 51, 53, 76, 108, 105, 17, 163, 144, 77,
+			158, 17, 231, 146, 103, 196, 3, 131, 70, 181, 245, 207, 207, 136,
+			201, 39, 12, 245, 39, 205, 164, 78, 81, 186, 85, 172, 255, 19,
+			166, 248, 220, 50, 67, 232, 71, 73, 241, 57, 252, 255, 88, 194,
+			203, 38, 104, 189, 130, 176, 200, 134, 183, 63, 151, 178, 147, 50,
+			60, 210, 15, 208, 199, 177, 219, 33, 195, 9, 232, 162, 183, 95,
+			108, 163, 110, 70, 53, 202, 170, 214, 55, 95, 66, 113, 174, 134,
+			124, 107, 113, 88, 95, 90, 13, 146, 85, 206, 242, 184, 45, 14,
+			235, 215, 130, 100, 213, 155, 20, 156, 50, 113, 41, 14, 235, 156,
+			194, 243, 224, 230, 92, 110, 42, 135, 220, 124, 88, 159, 239, 75,
+			244, 159, 222, 180, 202, 81, 168, 9, 31, 42, 74, 171, 119, 38,
+			184, 108, 247, 230, 115, 223, 12, 127, 135, 45, 182, 103, 31, 123,
+			94, 182, 223, 156, 162, 116, 82, 148, 181, 165, 112, 200, 126, 84,
+			35, 215, 249, 173, 121, 243, 190, 55, 35, 118, 44, 119, 86, 150,
+			176, 195, 180, 154, 97, 179, 61, 228, 108, 85, 193, 116, 103, 101,
+			70, 191, 53, 191, 125, 57, 83, 226, 132, 154, 140, 140, 188, 164,
+			108, 189, 196, 172, 190, 249, 129, 244, 193, 2, 253, 222, 149, 239,
+			178, 248, 196, 249, 46, 135, 23, 196, 246, 108, 39, 192, 10, 160,
+			99, 104, 86, 224, 111, 239, 160, 232, 131, 249, 137, 172, 67, 60,
+			254, 101, 252, 0, 75, 9, 62, 160, 124, 166, 232, 98, 113, 158,
+			254, 30, 254, 89, 75, 108, 207, 246, 204, 187, 41, 118, 68, 73,
+			210, 9, 151, 112, 151, 185, 23, 198, 84, 125, 255, 249, 83, 155,
+			25, 50, 135, 215, 22, 213, 91, 230, 251, 107, 133, 249, 237, 81,
+			230, 129, 55, 37, 202, 107, 176, 138, 6, 81, 131, 7, 167, 71,
+			94, 198, 27, 252, 70, 182, 26, 243, 217, 116, 89, 148, 20, 242,
+			205, 240, 164, 216, 219, 179, 85, 239, 25, 177, 221, 12, 225, 82,
+			84, 99, 85, 116, 191, 249, 109, 174, 54, 60, 35, 6, 55, 53,
+			147, 93, 45, 86, 126, 181, 236, 17, 69, 218, 246, 152, 139, 170,
+			240, 81, 64, 57, 254, 155, 97, 4, 67, 237, 44, 124, 193, 122,
+			36, 42, 199, 217, 167, 249, 7, 159, 230, 31, 252, 232, 249, 7,
+			79, 166, 249, 7, 71, 211, 252, 131, 151, 88, 13, 180, 47, 133,
+			237, 192, 159, 103, 149, 22, 232, 64, 225, 89, 203, 63, 46, 231,
+			154, 74, 30, 196, 36, 10, 150, 161, 230, 13, 20, 228, 134, 57,
+			15, 83, 185, 240, 64, 217, 23, 95, 179, 181, 92, 56, 108, 95,
+			246, 127, 206, 214, 121, 127, 54, 101, 205, 235, 170, 166, 71, 214,
+			60, 147, 80, 69, 239, 239, 93, 153, 243, 132, 28, 230, 245, 152,
+			76, 188, 127, 123, 254, 214, 171, 179, 51, 139, 31, 40, 212, 254,
+			247, 231, 103, 175, 46, 93, 155, 90, 184, 246, 193, 4, 90, 73,
+			38, 222, 191, 51, 127, 125, 105, 118, 97, 102, 234, 246, 236, 149,
+			165, 197, 217, 133, 69, 32, 99, 12, 87, 4, 95, 57, 122, 60,
+			197, 220, 224, 19, 81, 134, 73, 53, 208, 217, 215, 83, 243, 76,
+			22, 68, 226, 222, 202, 68, 51, 108, 79, 116, 226, 198, 196, 177,
+			219, 65, 123, 117, 150, 190, 168, 60, 50, 103, 195, 230, 52, 20,
+			44, 89, 22, 61, 103, 56, 151, 191, 111, 56, 151, 191, 111, 120,
+			64, 39, 29, 41, 64, 133, 232, 93, 226, 204, 77, 5, 130, 128,
+			118, 134, 119, 191, 36, 164, 150, 73, 79, 216, 123, 253, 221, 233,
+			230, 193, 252, 50, 77, 89, 69, 188, 162, 155, 130, 192, 116, 162,
+			47, 11, 205, 113, 98, 247, 30, 17, 113, 182, 49, 103, 212, 222,
+			235, 191, 37, 167, 100, 167, 25, 189, 11, 31, 177, 26, 124, 36,
+			234, 145, 186, 91, 241, 112, 210, 30, 144, 111, 74, 206, 235, 33,
+			79, 81, 168, 43, 154, 179, 117, 248, 115, 145, 208, 146, 166, 89,
+			177, 115, 249, 11, 109, 202, 95, 168, 137, 66, 106, 177, 209, 221,
+			123, 196, 103, 117, 158, 177, 113, 123, 191, 31, 203, 107, 225, 195,
+			160, 22, 86, 163, 181, 160, 97, 46, 93, 144, 88, 244, 61, 82,
+			139, 159, 70, 90, 161, 140, 99, 202, 83, 50, 253, 81, 123, 42,
+			225, 203, 48, 209, 14, 117, 228, 140, 160, 69, 32, 78, 103, 168,
+			73, 117, 138, 160, 64, 147, 138, 4, 118, 227, 125, 122, 112, 144,
+			137, 108, 124, 207, 62, 241, 38, 39, 34, 115, 206, 218, 7, 253,
+			155, 228, 218, 175, 245, 138, 250, 102, 171, 45, 98, 205, 118, 220,
+			106, 104, 32, 183, 244, 50, 156, 91, 40, 184, 239, 0, 149, 54,
+			110, 173, 25, 142, 33, 215, 215, 89, 187, 95, 151, 44, 207, 57,
+			187, 125, 159, 46, 57, 158, 115, 246, 128, 47, 78, 232, 92, 94,
+			23, 108, 233, 15, 201, 197, 174, 58, 9, 100, 218, 84, 88, 44,
+			225, 189, 157, 186, 100, 121, 206, 133, 93, 38, 249, 150, 227, 57,
+			23, 142, 28, 21, 167, 8, 101, 222, 253, 88, 97, 222, 242, 15,
+			110, 185, 79, 208, 197, 209, 113, 49, 181, 62, 86, 222, 35, 78,
+			50, 30, 188, 115, 209, 246, 252, 3, 52, 49, 91, 113, 180, 2,
+			68, 27, 122, 29, 46, 56, 154, 189, 4, 4, 239, 92, 100, 246,
+			18, 14, 188, 115, 145, 87, 2, 193, 192, 59, 23, 7, 6, 197,
+			18, 131, 188, 59, 151, 236, 67, 254, 124, 198, 215, 157, 170, 67,
+			186, 41, 184, 197, 135, 53, 222, 96, 192, 79, 200, 60, 220, 144,
+			212, 178, 94, 5, 18, 146, 188, 113, 103, 97, 49, 7, 10, 103,
+			72, 177, 74, 158, 115, 137, 57, 66, 168, 240, 206, 165, 93, 251,
+			117, 201, 241, 156, 75, 254, 65, 177, 193, 144, 239, 206, 148, 253,
+			140, 223, 160, 206, 145, 180, 35, 89, 90, 146, 70, 226, 72, 113,
+			60, 83, 59, 31, 245, 127, 140, 189, 1, 42, 66, 78, 119, 86,
+			114, 90, 106, 211, 39, 54, 219, 161, 23, 12, 90, 167, 137, 68,
+			238, 179, 41, 67, 36, 152, 50, 181, 235, 144, 46, 57, 158, 51,
+			117, 84, 138, 255, 205, 102, 72, 111, 103, 206, 62, 234, 255, 161,
+			45, 231, 244, 58, 86, 55, 50, 118, 70, 228, 21, 147, 205, 4,
+			194, 179, 53, 21, 58, 249, 206, 38, 8, 186, 23, 223, 194, 17,
+			108, 99, 252, 221, 78, 208, 64, 125, 53, 99, 151, 204, 76, 114,
+			109, 189, 150, 202, 122, 93, 17, 50, 68, 134, 12, 150, 153, 90,
+			9, 237, 12, 149, 96, 61, 162, 108, 228, 51, 1, 50, 102, 215,
+			91, 194, 156, 10, 202, 111, 241, 116, 179, 213, 62, 141, 181, 24,
+			65, 241, 213, 147, 44, 197, 87, 4, 112, 128, 118, 206, 199, 38,
+			184, 123, 21, 121, 5, 48, 70, 112, 240, 89, 227, 245, 166, 204,
+			161, 171, 10, 41, 51, 43, 13, 161, 46, 18, 47, 112, 16, 106,
+			188, 113, 177, 185, 61, 50, 77, 180, 195, 120, 61, 14, 211, 212,
+			133, 22, 109, 17, 115, 102, 14, 99, 139, 152, 235, 243, 117, 9,
+			163, 112, 248, 136, 248, 99, 139, 177, 210, 157, 215, 236, 17, 255,
+			127, 248, 166, 164, 98, 225, 197, 248, 161, 146, 176, 24, 39, 80,
+			211, 67, 179, 48, 198, 228, 166, 75, 141, 170, 70, 103, 99, 218,
+			188, 132, 176, 75, 189, 102, 15, 233, 146, 229, 57, 175, 29, 56,
+			166, 75, 142, 231, 188, 118, 242, 148, 168, 16, 240, 187, 123, 167,
+			176, 98, 249, 195, 82, 223, 102, 178, 125, 96, 184, 8, 78, 54,
+			1, 209, 3, 243, 252, 78, 121, 143, 248, 35, 139, 49, 215, 157,
+			55, 109, 207, 255, 103, 150, 6, 193, 148, 119, 230, 175, 107, 125,
+			67, 230, 208, 170, 67, 122, 132, 250, 164, 101, 68, 10, 104, 50,
+			179, 95, 77, 154, 99, 251, 165, 213, 86, 210, 190, 60, 241, 18,
+			159, 106, 151, 115, 62, 247, 114, 88, 191, 182, 117, 134, 33, 253,
+			100, 34, 137, 171, 195, 66, 169, 57, 32, 118, 195, 174, 66, 51,
+			117, 184, 178, 18, 181, 33, 145, 44, 92, 187, 117, 231, 250, 149,
+			94, 44, 36, 208, 119, 231, 77, 158, 76, 54, 109, 136, 111, 242,
+			134, 104, 211, 134, 248, 230, 192, 160, 248, 11, 141, 248, 238, 84,
+			237, 253, 254, 191, 177, 114, 153, 39, 176, 105, 200, 7, 91, 237,
+			145, 140, 18, 137, 151, 48, 153, 153, 61, 73, 164, 210, 220, 208,
+			240, 42, 235, 128, 162, 120, 98, 98, 216, 232, 176, 85, 18, 40,
+			189, 191, 37, 19, 235, 193, 6, 220, 226, 147, 137, 106, 43, 14,
+			117, 105, 137, 93, 168, 150, 32, 205, 45, 117, 218, 81, 99, 169,
+			211, 68, 56, 93, 210, 174, 84, 137, 45, 193, 67, 169, 12, 138,
+			147, 242, 185, 115, 231, 43, 66, 237, 200, 250, 126, 178, 28, 84,
+			239, 37, 13, 58, 157, 73, 174, 80, 238, 181, 134, 59, 144, 102,
+			170, 134, 59, 216, 163, 171, 125, 158, 46, 57, 158, 83, 221, 187,
+			79, 124, 156, 152, 99, 123, 78, 221, 30, 244, 207, 203, 91, 205,
+			112, 28, 64, 148, 53, 137, 11, 175, 214, 21, 63, 130, 65, 92,
+			29, 14, 210, 58, 107, 154, 108, 98, 118, 189, 188, 93, 151, 28,
+			207, 169, 239, 26, 16, 129, 192, 180, 118, 239, 193, 156, 122, 71,
+			206, 107, 61, 29, 244, 224, 233, 65, 0, 46, 55, 243, 199, 4,
+			240, 77, 50, 47, 36, 240, 152, 230, 85, 207, 211, 150, 94, 79,
+			120, 250, 99, 75, 185, 87, 222, 67, 249, 22, 156, 114, 193, 115,
+			27, 118, 172, 50, 52, 59, 200, 227, 224, 52, 202, 59, 197, 121,
+			70, 162, 119, 154, 197, 113, 255, 4, 237, 1, 218, 87, 4, 85,
+			73, 190, 50, 167, 205, 114, 71, 29, 187, 80, 242, 156, 102, 209,
+			215, 37, 203, 115, 154, 7, 71, 116, 201, 241, 156, 230, 153, 49,
+			58, 212, 29, 240, 224, 221, 226, 113, 62, 212, 245, 245, 188, 71,
+			141, 56, 73, 223, 45, 238, 211, 37, 124, 182, 255, 168, 46, 57,
+			158, 243, 238, 240, 49, 241, 89, 66, 244, 117, 31, 20, 222, 179,
+			252, 36, 207, 142, 102, 79, 202, 1, 88, 188, 22, 66, 105, 154,
+			144, 55, 124, 19, 11, 126, 186, 179, 18, 52, 163, 247, 194, 120,
+			76, 200, 224, 126, 16, 53, 232, 226, 24, 180, 205, 170, 38, 62,
+			106, 174, 167, 46, 86, 204, 88, 228, 76, 125, 80, 62, 44, 158,
+			35, 76, 214, 130, 231, 108, 216, 7, 252, 145, 39, 96, 158, 156,
+			187, 194, 189, 117, 105, 197, 110, 240, 68, 33, 168, 92, 103, 131,
+			198, 138, 145, 114, 157, 141, 253, 67, 226, 211, 194, 118, 139, 94,
+			241, 219, 160, 5, 240, 95, 235, 238, 174, 97, 101, 110, 146, 140,
+			229, 122, 148, 94, 74, 150, 59, 43, 73, 78, 5, 193, 157, 65,
+			202, 212, 111, 43, 31, 16, 167, 133, 235, 22, 225, 67, 248, 57,
+			203, 222, 235, 31, 202, 15, 22, 239, 110, 90, 8, 131, 83, 96,
+			17, 61, 112, 63, 167, 179, 210, 20, 209, 5, 247, 115, 58, 33,
+			78, 17, 115, 192, 253, 28, 178, 241, 204, 81, 197, 22, 146, 226,
+			217, 187, 253, 23, 183, 152, 5, 44, 63, 51, 92, 224, 116, 35,
+			106, 222, 187, 60, 85, 173, 134, 0, 5, 143, 26, 81, 123, 67,
+			231, 134, 43, 218, 86, 54, 27, 95, 17, 34, 23, 178, 241, 237,
+			212, 69, 7, 79, 7, 61, 163, 207, 253, 177, 101, 241, 194, 19,
+			170, 80, 177, 168, 151, 148, 178, 106, 75, 109, 238, 99, 245, 181,
+			143, 241, 139, 124, 66, 117, 176, 121, 218, 214, 86, 212, 191, 134,
+			58, 216, 255, 107, 40, 180, 253, 23, 159, 240, 219, 122, 16, 53,
+			58, 49, 46, 81, 65, 162, 27, 30, 254, 15, 69, 33, 210, 219,
+			157, 231, 231, 244, 207, 165, 111, 76, 57, 223, 152, 42, 178, 62,
+			246, 80, 170, 104, 182, 181, 122, 186, 104, 180, 205, 199, 68, 159,
+			34, 215, 40, 162, 167, 75, 223, 152, 42, 126, 99, 202, 158, 47,
+			171, 7, 115, 53, 239, 5, 177, 141, 115, 135, 176, 210, 249, 192,
+			102, 165, 225, 39, 213, 11, 170, 118, 253, 186, 119, 84, 148, 195,
+			135, 235, 4, 172, 78, 26, 231, 178, 122, 110, 126, 244, 46, 138,
+			18, 178, 138, 119, 146, 161, 146, 180, 70, 118, 158, 63, 180, 185,
+			102, 244, 115, 129, 222, 97, 210, 213, 7, 200, 253, 153, 116, 214,
+			214, 130, 120, 99, 105, 181, 189, 214, 24, 218, 150, 246, 174, 159,
+			31, 92, 107, 175, 53, 188, 203, 66, 208, 33, 74, 233, 92, 134,
+			202, 212, 1, 95, 239, 62, 122, 62, 84, 140, 85, 93, 213, 208,
+			71, 159, 224, 71, 111, 82, 148, 245, 84, 27, 234, 227, 238, 119,
+			127, 173, 237, 70, 220, 61, 253, 190, 247, 156, 112, 219, 193, 74,
+			50, 36, 164, 51, 210, 223, 171, 115, 11, 116, 95, 190, 29, 68,
+			177, 250, 148, 94, 247, 70, 197, 118, 230, 96, 198, 14, 96, 198,
+			181, 159, 159, 145, 77, 96, 70, 236, 200, 205, 174, 161, 29, 143,
+			210, 185, 247, 86, 236, 123, 87, 197, 206, 252, 52, 27, 218, 73,
+			181, 28, 221, 92, 203, 85, 245, 222, 60, 189, 54, 191, 131, 63,
+			83, 197, 46, 109, 250, 174, 39, 214, 166, 123, 82, 244, 69, 201,
+			18, 220, 169, 195, 218, 208, 128, 158, 40, 206, 124, 57, 74, 110,
+			208, 143, 175, 186, 229, 254, 129, 237, 195, 255, 206, 22, 187, 208,
+			143, 217, 135, 173, 38, 71, 226, 60, 114, 242, 103, 172, 44, 118,
+			206, 202, 114, 33, 157, 210, 206, 99, 166, 116, 58, 155, 199, 197,
+			206, 48, 109, 24, 43, 198, 213, 43, 134, 218, 220, 145, 121, 58,
+			87, 243, 42, 98, 32, 124, 184, 222, 8, 154, 244, 131, 154, 164,
+			197, 116, 146, 238, 202, 60, 164, 137, 122, 178, 107, 212, 75, 52,
+			234, 155, 135, 252, 101, 81, 226, 81, 194, 148, 223, 217, 75, 133,
+			159, 225, 143, 26, 26, 94, 58, 234, 187, 60, 187, 203, 61, 216,
+			125, 250, 13, 181, 191, 168, 117, 231, 237, 19, 222, 194, 226, 212,
+			226, 157, 133, 165, 59, 55, 9, 192, 240, 234, 220, 236, 149, 129,
+			130, 87, 22, 238, 237, 169, 133, 133, 1, 11, 127, 93, 157, 154,
+			187, 62, 96, 123, 125, 162, 56, 51, 63, 181, 112, 109, 192, 193,
+			159, 83, 211, 183, 230, 23, 7, 92, 60, 95, 248, 196, 220, 237,
+			129, 226, 233, 239, 179, 196, 224, 38, 242, 188, 97, 113, 100, 246,
+			141, 91, 55, 103, 231, 167, 224, 165, 184, 52, 63, 59, 181, 112,
+			235, 102, 87, 107, 251, 132, 119, 107, 102, 230, 206, 252, 194, 210,
+			173, 155, 75, 55, 166, 230, 110, 94, 159, 187, 57, 59, 96, 121,
+			251, 197, 238, 244, 119, 66, 12, 92, 154, 185, 190, 48, 96, 123,
+			3, 98, 251, 205, 91, 139, 75, 51, 243, 115, 139, 115, 51, 83,
+			215, 7, 28, 111, 183, 216, 117, 231, 230, 236, 27, 183, 103, 103,
+			22, 103, 175, 44, 17, 237, 238, 71, 49, 35, 252, 240, 162, 50,
+			35, 252, 180, 253, 40, 51, 194, 185, 139, 79, 205, 8, 79, 205,
+			8, 31, 221, 140, 48, 154, 154, 17, 70, 82, 51, 130, 50, 46,
+			56, 158, 179, 151, 95, 112, 97, 71, 184, 68, 127, 22, 61, 103,
+			127, 225, 10, 253, 89, 242, 156, 33, 131, 76, 233, 167, 38, 7,
+			252, 249, 221, 14, 217, 28, 138, 39, 11, 63, 97, 89, 254, 127,
+			4, 142, 36, 7, 112, 147, 239, 68, 189, 211, 212, 254, 56, 56,
+			38, 8, 105, 2, 169, 194, 235, 128, 138, 53, 97, 130, 230, 17,
+			134, 67, 165, 125, 10, 107, 93, 121, 73, 76, 144, 172, 249, 153,
+			145, 195, 199, 68, 87, 69, 73, 39, 106, 135, 249, 87, 77, 3,
+			201, 152, 48, 14, 255, 105, 58, 51, 245, 5, 251, 125, 154, 246,
+			163, 102, 154, 150, 199, 100, 38, 21, 114, 228, 214, 194, 152, 124,
+			229, 246, 29, 132, 19, 174, 173, 99, 169, 212, 27, 193, 74, 50,
+			38, 195, 118, 149, 243, 192, 34, 2, 152, 195, 184, 76, 160, 175,
+			6, 70, 74, 175, 166, 152, 15, 115, 25, 68, 164, 204, 181, 128,
+			245, 117, 186, 18, 210, 30, 133, 107, 203, 33, 5, 17, 167, 181,
+			231, 224, 201, 207, 125, 140, 239, 11, 184, 169, 156, 44, 123, 226,
+			32, 254, 238, 243, 156, 83, 246, 206, 225, 93, 212, 209, 37, 173,
+			50, 69, 242, 20, 215, 45, 244, 21, 60, 231, 84, 63, 27, 40,
+			250, 10, 86, 174, 100, 171, 210, 111, 104, 171, 144, 123, 206, 126,
+			214, 245, 255, 203, 173, 205, 66, 180, 119, 100, 48, 221, 123, 216,
+			133, 94, 9, 219, 169, 172, 185, 217, 38, 244, 8, 40, 244, 71,
+			25, 131, 120, 123, 77, 38, 222, 159, 159, 93, 184, 115, 253, 91,
+			99, 32, 250, 200, 246, 161, 115, 172, 230, 80, 56, 238, 231, 88,
+			9, 84, 192, 117, 204, 57, 55, 96, 194, 187, 202, 158, 123, 206,
+			123, 214, 201, 27, 136, 206, 187, 227, 153, 31, 44, 207, 185, 224,
+			158, 17, 95, 45, 106, 147, 209, 53, 251, 227, 254, 15, 20, 149,
+			129, 32, 170, 141, 201, 160, 183, 193, 39, 157, 130, 61, 77, 62,
+			43, 225, 195, 73, 249, 233, 55, 223, 156, 156, 92, 143, 163, 102,
+			123, 114, 242, 237, 183, 223, 63, 55, 246, 220, 185, 243, 31, 28,
+			103, 189, 226, 131, 22, 231, 99, 211, 128, 91, 116, 147, 209, 211,
+			26, 1, 242, 112, 247, 83, 179, 214, 184, 218, 5, 130, 28, 143,
+			130, 198, 250, 106, 208, 236, 172, 133, 113, 84, 69, 134, 17, 248,
+			90, 33, 191, 17, 101, 29, 74, 115, 136, 200, 64, 174, 67, 93,
+			69, 187, 200, 74, 220, 234, 172, 87, 180, 190, 74, 229, 66, 31,
+			14, 38, 150, 39, 170, 195, 230, 207, 90, 250, 103, 56, 249, 48,
+			91, 216, 208, 5, 36, 65, 167, 116, 95, 74, 37, 148, 116, 133,
+			253, 80, 43, 170, 114, 0, 181, 71, 164, 89, 94, 14, 41, 181,
+			22, 161, 196, 132, 234, 21, 36, 251, 13, 112, 88, 50, 43, 185,
+			247, 220, 235, 225, 0, 170, 181, 113, 249, 122, 212, 94, 213, 62,
+			233, 244, 153, 134, 117, 64, 250, 153, 103, 161, 11, 90, 99, 188,
+			146, 71, 84, 138, 67, 51, 173, 119, 121, 203, 154, 77, 183, 105,
+			115, 51, 253, 126, 84, 43, 168, 186, 39, 245, 96, 217, 176, 206,
+			167, 205, 123, 34, 7, 111, 202, 59, 115, 200, 94, 7, 33, 196,
+			236, 107, 70, 113, 174, 135, 95, 169, 31, 155, 27, 28, 30, 165,
+			50, 120, 177, 93, 178, 136, 57, 170, 167, 63, 180, 124, 215, 114,
+			54, 203, 107, 187, 247, 232, 82, 217, 115, 174, 237, 189, 204, 147,
+			93, 37, 231, 117, 174, 237, 187, 36, 86, 217, 164, 233, 94, 183,
+			111, 185, 254, 223, 202, 154, 64, 216, 142, 201, 199, 15, 136, 96,
+			64, 149, 116, 91, 33, 246, 48, 157, 217, 185, 30, 140, 191, 119,
+			118, 252, 226, 91, 227, 75, 21, 76, 245, 11, 52, 211, 217, 106,
+			89, 244, 156, 235, 134, 100, 44, 178, 235, 172, 152, 84, 22, 205,
+			235, 123, 247, 241, 138, 181, 203, 158, 123, 125, 255, 45, 189, 98,
+			85, 86, 95, 231, 134, 123, 38, 243, 131, 229, 57, 55, 221, 211,
+			226, 190, 54, 129, 46, 216, 47, 251, 145, 188, 66, 112, 7, 235,
+			90, 93, 222, 106, 102, 228, 129, 7, 1, 9, 83, 113, 167, 217,
+			212, 25, 117, 64, 255, 152, 80, 155, 107, 6, 203, 65, 101, 12,
+			4, 220, 85, 212, 128, 48, 136, 174, 50, 75, 232, 124, 51, 163,
+			224, 148, 208, 112, 159, 46, 89, 158, 179, 32, 178, 150, 207, 133,
+			61, 58, 181, 132, 83, 246, 156, 133, 125, 31, 231, 14, 56, 170,
+			71, 11, 251, 47, 139, 159, 84, 158, 147, 174, 231, 188, 101, 95,
+			246, 127, 208, 201, 230, 58, 202, 156, 255, 233, 185, 158, 230, 114,
+			164, 19, 94, 221, 227, 113, 254, 97, 148, 52, 34, 244, 12, 203,
+			205, 114, 230, 250, 152, 188, 120, 241, 196, 25, 83, 7, 111, 240,
+			228, 53, 153, 249, 26, 129, 244, 202, 147, 148, 171, 160, 84, 232,
+			237, 48, 166, 52, 156, 58, 247, 19, 17, 213, 105, 234, 239, 152,
+			62, 146, 159, 167, 154, 155, 31, 200, 103, 46, 101, 4, 18, 190,
+			149, 18, 120, 20, 103, 237, 55, 15, 19, 134, 63, 3, 162, 129,
+			169, 164, 221, 162, 111, 38, 146, 123, 209, 250, 68, 53, 14, 146,
+			85, 250, 150, 243, 206, 7, 113, 19, 222, 160, 144, 28, 2, 141,
+			231, 20, 84, 219, 192, 20, 83, 186, 8, 145, 202, 216, 166, 202,
+			86, 51, 68, 194, 45, 12, 104, 222, 164, 211, 197, 109, 181, 154,
+			215, 131, 56, 130, 122, 71, 15, 183, 91, 196, 40, 149, 116, 201,
+			242, 156, 183, 182, 105, 79, 88, 215, 241, 156, 183, 188, 221, 186,
+			84, 246, 156, 183, 246, 104, 159, 4, 87, 13, 247, 91, 123, 95,
+			18, 159, 210, 6, 232, 187, 246, 180, 127, 93, 222, 8, 170, 171,
+			81, 51, 28, 143, 195, 160, 70, 226, 138, 34, 93, 211, 96, 248,
+			163, 109, 0, 55, 111, 45, 66, 188, 217, 124, 239, 51, 52, 194,
+			104, 125, 215, 222, 158, 49, 90, 223, 221, 161, 169, 42, 58, 158,
+			115, 119, 223, 126, 93, 42, 123, 206, 221, 161, 41, 166, 177, 168,
+			104, 188, 123, 224, 101, 241, 151, 106, 74, 150, 60, 167, 101, 95,
+			241, 255, 157, 35, 175, 117, 214, 130, 102, 74, 99, 230, 138, 172,
+			9, 213, 98, 74, 212, 148, 215, 22, 111, 92, 215, 228, 194, 124,
+			19, 0, 141, 237, 189, 52, 141, 86, 140, 168, 37, 232, 87, 244,
+			124, 90, 142, 91, 15, 18, 24, 65, 177, 81, 194, 49, 246, 61,
+			179, 117, 179, 198, 200, 152, 230, 66, 88, 85, 33, 32, 33, 150,
+			104, 45, 160, 180, 91, 228, 14, 156, 26, 236, 40, 48, 254, 206,
+			226, 213, 241, 23, 80, 225, 66, 103, 29, 89, 110, 18, 147, 140,
+			90, 201, 126, 4, 99, 169, 242, 201, 49, 138, 28, 52, 60, 147,
+			66, 158, 150, 47, 1, 201, 112, 92, 191, 126, 153, 201, 77, 180,
+			115, 64, 194, 208, 35, 166, 62, 152, 212, 194, 135, 109, 10, 179,
+			189, 3, 73, 146, 18, 41, 158, 150, 139, 45, 213, 148, 158, 85,
+			10, 165, 79, 127, 54, 217, 213, 14, 190, 209, 127, 143, 71, 181,
+			75, 195, 47, 233, 210, 82, 84, 187, 60, 124, 185, 171, 206, 204,
+			54, 252, 215, 170, 23, 217, 107, 198, 169, 134, 203, 122, 254, 148,
+			138, 24, 118, 189, 75, 151, 44, 207, 105, 245, 233, 77, 172, 228,
+			120, 78, 107, 232, 128, 46, 149, 61, 167, 229, 207, 240, 252, 41,
+			169, 249, 211, 58, 56, 45, 174, 209, 244, 217, 230, 185, 177, 157,
+			156, 245, 39, 105, 84, 215, 91, 145, 82, 228, 67, 223, 7, 113,
+			178, 153, 159, 224, 42, 200, 133, 67, 112, 105, 135, 211, 6, 253,
+			130, 189, 173, 228, 57, 177, 173, 61, 47, 182, 89, 158, 19, 31,
+			58, 169, 75, 112, 104, 30, 61, 173, 75, 101, 207, 73, 74, 19,
+			76, 210, 54, 69, 82, 178, 173, 194, 203, 174, 236, 185, 15, 236,
+			135, 103, 253, 79, 164, 1, 26, 173, 122, 23, 29, 102, 123, 205,
+			204, 225, 236, 180, 91, 1, 28, 134, 158, 118, 103, 13, 141, 229,
+			146, 231, 60, 176, 125, 166, 17, 201, 6, 30, 28, 60, 166, 75,
+			142, 231, 60, 56, 121, 74, 151, 202, 158, 243, 208, 208, 88, 86,
+			52, 62, 220, 86, 17, 95, 132, 201, 178, 96, 247, 121, 206, 7,
+			246, 171, 254, 3, 169, 21, 129, 124, 179, 205, 223, 8, 176, 225,
+			183, 229, 26, 226, 195, 13, 198, 143, 122, 9, 178, 94, 84, 37,
+			76, 142, 244, 168, 104, 197, 249, 110, 146, 231, 207, 61, 136, 137,
+			74, 42, 209, 184, 91, 166, 63, 125, 46, 200, 48, 165, 146, 231,
+			124, 96, 210, 36, 245, 89, 158, 243, 193, 62, 221, 215, 62, 199,
+			115, 62, 56, 124, 84, 151, 202, 158, 243, 129, 156, 227, 222, 245,
+			169, 222, 125, 240, 204, 53, 241, 62, 117, 78, 120, 165, 47, 88,
+			246, 183, 91, 174, 127, 79, 66, 83, 170, 249, 207, 119, 194, 138,
+			144, 171, 225, 195, 145, 100, 53, 56, 255, 220, 243, 35, 73, 11,
+			51, 98, 228, 212, 169, 202, 59, 173, 168, 57, 114, 234, 68, 50,
+			121, 34, 121, 171, 121, 138, 24, 114, 111, 76, 222, 199, 124, 210,
+			95, 146, 140, 57, 50, 138, 255, 54, 95, 25, 148, 88, 33, 138,
+			158, 251, 5, 109, 75, 41, 216, 194, 66, 177, 111, 175, 46, 58,
+			40, 14, 249, 98, 151, 42, 150, 65, 234, 193, 111, 183, 28, 49,
+			64, 125, 17, 212, 23, 247, 139, 150, 59, 158, 249, 197, 242, 220,
+			47, 89, 238, 25, 158, 243, 253, 158, 251, 29, 150, 61, 236, 79,
+			246, 240, 4, 50, 252, 231, 200, 74, 90, 8, 42, 34, 61, 61,
+			211, 13, 173, 253, 37, 170, 106, 167, 46, 90, 40, 238, 58, 164,
+			139, 14, 138, 71, 159, 17, 139, 212, 236, 118, 207, 253, 110, 36,
+			88, 191, 250, 168, 102, 205, 241, 123, 11, 103, 57, 155, 97, 101,
+			84, 207, 191, 97, 114, 254, 23, 236, 237, 37, 170, 118, 151, 46,
+			90, 40, 14, 28, 209, 69, 7, 197, 103, 142, 137, 31, 83, 211,
+			118, 135, 231, 126, 191, 101, 143, 250, 223, 251, 77, 243, 219, 48,
+			51, 255, 195, 185, 111, 40, 114, 119, 148, 136, 190, 33, 93, 180,
+			80, 60, 112, 76, 23, 29, 20, 79, 142, 136, 127, 162, 174, 232,
+			59, 61, 247, 171, 150, 61, 237, 255, 92, 46, 245, 100, 150, 138,
+			20, 225, 76, 233, 199, 77, 110, 253, 200, 100, 136, 5, 128, 86,
+			99, 195, 184, 151, 209, 61, 32, 76, 189, 205, 24, 81, 167, 14,
+			189, 91, 198, 41, 16, 50, 16, 29, 68, 80, 149, 227, 255, 249,
+			118, 141, 63, 141, 81, 8, 5, 45, 211, 224, 111, 45, 240, 156,
+			150, 169, 81, 5, 37, 109, 242, 192, 223, 188, 80, 200, 118, 129,
+			114, 222, 182, 80, 89, 143, 35, 178, 218, 16, 26, 139, 129, 206,
+			25, 105, 199, 157, 102, 85, 59, 95, 157, 123, 246, 108, 122, 207,
+			76, 70, 183, 88, 102, 59, 139, 196, 189, 146, 46, 90, 40, 110,
+			27, 208, 69, 7, 197, 221, 122, 213, 237, 44, 163, 184, 111, 138,
+			151, 212, 78, 181, 200, 190, 106, 237, 127, 89, 92, 20, 118, 177,
+			224, 149, 126, 218, 42, 252, 134, 101, 249, 103, 30, 37, 43, 229,
+			238, 43, 10, 48, 166, 88, 176, 60, 247, 167, 173, 226, 46, 241,
+			57, 75, 184, 69, 82, 187, 252, 140, 101, 31, 240, 99, 169, 244,
+			231, 148, 69, 46, 85, 39, 41, 165, 226, 205, 86, 91, 67, 157,
+			242, 61, 141, 69, 202, 76, 253, 201, 139, 112, 29, 66, 242, 114,
+			10, 234, 102, 172, 184, 76, 4, 16, 130, 151, 58, 77, 64, 141,
+			177, 107, 24, 250, 10, 10, 44, 207, 253, 25, 203, 222, 163, 139,
+			54, 138, 251, 135, 196, 40, 209, 103, 121, 238, 207, 89, 118, 191,
+			127, 48, 149, 113, 177, 83, 211, 68, 83, 48, 167, 166, 34, 88,
+			129, 127, 142, 57, 140, 162, 141, 98, 159, 16, 95, 85, 61, 181,
+			61, 247, 23, 81, 211, 247, 91, 61, 170, 210, 139, 92, 46, 116,
+			86, 86, 72, 243, 96, 98, 189, 17, 166, 167, 181, 160, 236, 241,
+			17, 53, 171, 173, 24, 104, 192, 140, 253, 75, 63, 146, 115, 147,
+			78, 97, 45, 210, 88, 241, 77, 31, 225, 188, 196, 122, 0, 155,
+			234, 141, 224, 30, 155, 211, 139, 5, 248, 138, 184, 191, 152, 246,
+			0, 183, 208, 95, 180, 250, 132, 248, 71, 170, 7, 142, 231, 254,
+			178, 101, 111, 247, 255, 65, 175, 30, 208, 77, 32, 172, 33, 76,
+			51, 106, 174, 100, 247, 78, 122, 185, 213, 105, 195, 57, 84, 19,
+			162, 195, 163, 38, 123, 118, 81, 29, 160, 8, 232, 210, 0, 39,
+			20, 168, 149, 235, 180, 238, 218, 25, 250, 56, 219, 63, 211, 29,
+			199, 34, 130, 183, 233, 162, 141, 162, 232, 23, 43, 212, 27, 215,
+			115, 191, 134, 222, 124, 170, 71, 103, 88, 236, 81, 236, 197, 140,
+			12, 150, 233, 212, 211, 210, 50, 192, 140, 147, 85, 194, 101, 85,
+			46, 17, 208, 14, 169, 37, 59, 41, 177, 204, 91, 157, 148, 12,
+			120, 162, 126, 45, 37, 3, 62, 100, 95, 179, 68, 191, 248, 9,
+			155, 232, 40, 122, 238, 63, 197, 188, 248, 1, 187, 107, 94, 212,
+			162, 26, 117, 157, 245, 181, 21, 227, 207, 164, 84, 56, 120, 217,
+			112, 89, 239, 200, 85, 36, 189, 173, 154, 95, 116, 93, 201, 24,
+			1, 163, 193, 241, 68, 45, 74, 72, 160, 10, 103, 102, 44, 215,
+			191, 245, 56, 92, 11, 144, 216, 185, 161, 16, 200, 115, 13, 192,
+			141, 37, 83, 39, 62, 68, 61, 184, 8, 174, 211, 228, 29, 79,
+			123, 144, 94, 4, 155, 245, 104, 69, 203, 117, 102, 81, 119, 77,
+			79, 212, 167, 229, 186, 92, 149, 204, 179, 162, 69, 76, 210, 19,
+			179, 104, 163, 216, 39, 196, 191, 180, 200, 209, 183, 244, 91, 86,
+			225, 115, 182, 229, 127, 221, 146, 115, 42, 250, 150, 111, 158, 172,
+			28, 80, 71, 221, 8, 43, 20, 102, 174, 143, 98, 26, 6, 203,
+			73, 171, 161, 113, 99, 228, 114, 131, 54, 118, 13, 20, 188, 233,
+			206, 156, 110, 103, 90, 180, 233, 130, 182, 53, 205, 241, 115, 58,
+			183, 19, 185, 220, 106, 175, 170, 27, 111, 192, 222, 116, 136, 83,
+			192, 253, 31, 89, 204, 53, 202, 185, 144, 51, 215, 79, 97, 23,
+			48, 27, 37, 188, 146, 221, 223, 178, 202, 251, 197, 191, 182, 217,
+			47, 185, 244, 123, 150, 253, 251, 150, 235, 255, 206, 147, 104, 168,
+			51, 230, 85, 163, 166, 78, 61, 85, 181, 158, 58, 99, 92, 236,
+			214, 85, 127, 84, 85, 117, 166, 225, 100, 226, 253, 172, 161, 114,
+			238, 202, 7, 21, 241, 72, 109, 181, 102, 237, 183, 88, 117, 141,
+			51, 143, 28, 186, 221, 223, 211, 114, 168, 69, 199, 194, 239, 89,
+			125, 59, 116, 209, 241, 220, 223, 179, 6, 6, 73, 14, 181, 236,
+			66, 217, 43, 253, 158, 229, 253, 62, 203, 161, 22, 235, 175, 221,
+			127, 169, 229, 80, 139, 21, 216, 238, 191, 130, 28, 250, 2, 59,
+			130, 187, 127, 8, 207, 166, 211, 90, 129, 205, 154, 189, 120, 76,
+			34, 119, 195, 230, 16, 4, 67, 27, 252, 141, 254, 48, 165, 13,
+			115, 227, 15, 181, 159, 147, 5, 205, 162, 251, 135, 240, 115, 250,
+			95, 45, 246, 242, 118, 255, 23, 203, 222, 231, 255, 11, 171, 91,
+			243, 150, 17, 234, 117, 17, 188, 86, 237, 179, 13, 159, 82, 144,
+			97, 160, 154, 4, 82, 146, 161, 42, 124, 216, 142, 131, 37, 254,
+			126, 105, 61, 136, 226, 132, 81, 167, 90, 205, 84, 210, 11, 20,
+			230, 102, 234, 10, 43, 186, 90, 30, 83, 25, 115, 205, 228, 199,
+			254, 185, 12, 23, 79, 181, 78, 210, 164, 212, 21, 120, 63, 46,
+			229, 102, 134, 102, 136, 93, 162, 62, 246, 233, 162, 133, 162, 24,
+			212, 69, 7, 197, 61, 123, 177, 211, 195, 177, 217, 43, 253, 137,
+			101, 255, 169, 229, 250, 175, 231, 212, 169, 205, 236, 42, 121, 34,
+			125, 170, 130, 103, 33, 57, 35, 30, 167, 116, 114, 124, 61, 219,
+			161, 253, 169, 221, 63, 73, 135, 9, 231, 207, 159, 88, 125, 251,
+			117, 209, 65, 209, 63, 200, 83, 200, 41, 131, 172, 67, 127, 106,
+			166, 144, 82, 63, 186, 255, 38, 157, 66, 142, 154, 66, 255, 22,
+			83, 168, 201, 110, 216, 238, 159, 89, 246, 53, 255, 174, 84, 190,
+			10, 56, 106, 151, 195, 213, 136, 45, 126, 153, 254, 252, 117, 181,
+			62, 138, 102, 183, 232, 185, 127, 150, 246, 8, 71, 217, 159, 89,
+			125, 7, 116, 209, 65, 241, 208, 97, 93, 44, 163, 120, 228, 21,
+			166, 94, 233, 215, 220, 63, 179, 142, 94, 21, 239, 16, 245, 69,
+			207, 253, 115, 203, 190, 226, 191, 245, 77, 189, 101, 42, 98, 138,
+			170, 49, 77, 57, 78, 144, 63, 215, 215, 74, 203, 46, 58, 40,
+			14, 233, 142, 20, 203, 40, 250, 51, 76, 185, 210, 186, 185, 127,
+			110, 29, 156, 22, 63, 168, 182, 223, 18, 208, 7, 237, 57, 255,
+			219, 237, 39, 227, 252, 90, 183, 88, 12, 75, 5, 86, 21, 111,
+			217, 65, 146, 0, 169, 173, 150, 186, 250, 7, 205, 160, 177, 145,
+			63, 170, 59, 237, 22, 110, 141, 85, 185, 220, 89, 25, 175, 71,
+			13, 37, 112, 144, 221, 130, 0, 228, 17, 158, 148, 158, 246, 180,
+			203, 87, 227, 168, 77, 217, 7, 177, 108, 112, 162, 208, 87, 160,
+			8, 119, 203, 206, 242, 90, 68, 170, 245, 251, 6, 193, 124, 3,
+			87, 27, 185, 28, 226, 45, 221, 9, 82, 11, 42, 59, 10, 89,
+			173, 80, 139, 68, 35, 164, 2, 159, 185, 174, 47, 112, 150, 93,
+			82, 124, 217, 173, 139, 132, 217, 184, 199, 215, 69, 194, 108, 60,
+			124, 68, 23, 203, 40, 30, 189, 198, 92, 86, 186, 41, 247, 223,
+			91, 242, 21, 241, 127, 168, 157, 107, 155, 231, 254, 149, 101, 79,
+			193, 51, 124, 211, 13, 47, 195, 225, 39, 190, 230, 137, 244, 158,
+			247, 152, 107, 94, 246, 36, 124, 196, 93, 47, 67, 4, 255, 210,
+			125, 129, 203, 168, 97, 201, 141, 9, 191, 41, 201, 112, 139, 115,
+			103, 91, 145, 58, 93, 210, 69, 11, 69, 190, 152, 89, 208, 164,
+			185, 127, 133, 189, 157, 139, 101, 60, 221, 251, 50, 179, 80, 233,
+			210, 220, 191, 178, 246, 125, 156, 46, 102, 150, 87, 250, 130, 93,
+			248, 105, 27, 23, 51, 53, 77, 229, 131, 213, 141, 46, 201, 5,
+			114, 91, 102, 168, 249, 98, 134, 51, 229, 11, 118, 113, 159, 248,
+			18, 132, 125, 200, 27, 238, 151, 109, 251, 132, 255, 192, 84, 244,
+			161, 47, 102, 25, 102, 61, 238, 118, 6, 167, 133, 30, 183, 51,
+			117, 12, 127, 217, 182, 165, 46, 218, 158, 251, 101, 251, 216, 113,
+			241, 11, 16, 158, 233, 72, 253, 94, 219, 62, 224, 127, 21, 216,
+			22, 107, 17, 212, 107, 155, 100, 183, 68, 62, 8, 227, 80, 182,
+			150, 105, 223, 86, 46, 48, 18, 186, 13, 114, 171, 95, 142, 131,
+			102, 117, 85, 200, 17, 58, 148, 130, 149, 0, 248, 211, 50, 80,
+			86, 38, 35, 176, 117, 154, 106, 237, 64, 34, 84, 24, 154, 38,
+			227, 16, 32, 212, 71, 32, 10, 6, 247, 91, 81, 77, 3, 199,
+			215, 90, 157, 229, 54, 159, 142, 102, 74, 146, 48, 136, 43, 23,
+			165, 140, 1, 38, 76, 45, 108, 3, 130, 176, 25, 37, 109, 54,
+			239, 180, 170, 213, 78, 76, 91, 242, 230, 142, 84, 70, 133, 156,
+			50, 224, 239, 189, 122, 154, 93, 231, 19, 51, 175, 201, 184, 211,
+			76, 50, 211, 173, 168, 100, 135, 239, 181, 249, 186, 107, 209, 45,
+			245, 123, 237, 253, 67, 226, 127, 87, 12, 181, 61, 247, 135, 108,
+			219, 247, 255, 248, 67, 48, 52, 219, 40, 90, 132, 45, 167, 174,
+			225, 35, 199, 112, 235, 214, 161, 98, 51, 215, 71, 146, 209, 138,
+			28, 161, 45, 12, 33, 59, 234, 182, 11, 168, 15, 62, 12, 148,
+			35, 185, 218, 142, 88, 27, 38, 48, 98, 233, 120, 117, 50, 114,
+			67, 179, 30, 197, 107, 132, 140, 35, 219, 198, 124, 165, 239, 192,
+			244, 28, 88, 44, 81, 115, 69, 72, 104, 215, 91, 16, 88, 72,
+			170, 161, 80, 41, 218, 33, 177, 251, 197, 29, 0, 40, 203, 153,
+			235, 137, 188, 159, 244, 124, 38, 186, 39, 76, 248, 159, 100, 48,
+			48, 127, 127, 200, 182, 247, 234, 34, 113, 127, 232, 128, 248, 186,
+			67, 131, 225, 120, 238, 63, 176, 237, 221, 254, 47, 59, 230, 98,
+			5, 155, 137, 66, 207, 79, 119, 250, 214, 86, 119, 29, 220, 116,
+			160, 164, 200, 156, 27, 85, 237, 139, 163, 55, 248, 53, 220, 103,
+			177, 67, 130, 171, 138, 239, 124, 137, 84, 7, 149, 158, 161, 161,
+			140, 234, 194, 52, 164, 200, 72, 218, 100, 29, 11, 155, 173, 206,
+			202, 42, 111, 4, 107, 65, 45, 76, 105, 163, 156, 4, 137, 108,
+			145, 59, 84, 123, 21, 151, 248, 128, 189, 194, 192, 174, 56, 66,
+			232, 141, 222, 47, 200, 138, 11, 148, 118, 57, 87, 231, 152, 54,
+			218, 60, 84, 206, 17, 76, 110, 131, 172, 148, 167, 35, 197, 233,
+			79, 71, 65, 232, 97, 24, 51, 254, 80, 237, 48, 249, 127, 217,
+			123, 247, 40, 185, 138, 235, 94, 184, 171, 234, 76, 79, 79, 205,
+			83, 165, 121, 233, 104, 36, 29, 181, 94, 163, 209, 204, 104, 36,
+			64, 128, 132, 64, 35, 141, 36, 6, 9, 16, 35, 1, 198, 54,
+			150, 90, 154, 150, 232, 104, 166, 123, 232, 238, 1, 203, 94, 120,
+			217, 132, 152, 207, 49, 54, 14, 54, 132, 240, 25, 199, 49, 98,
+			197, 38, 159, 29, 192, 15, 136, 191, 152, 36, 215, 118, 226, 152,
+			21, 108, 200, 202, 189, 172, 75, 174, 237, 36, 54, 151, 248, 2,
+			55, 16, 199, 143, 139, 157, 220, 181, 119, 213, 174, 83, 167, 95,
+			211, 26, 33, 17, 219, 240, 15, 170, 158, 58, 245, 216, 181, 107,
+			239, 93, 85, 123, 255, 118, 37, 250, 193, 96, 80, 123, 104, 49,
+			61, 40, 129, 155, 33, 44, 24, 73, 99, 196, 221, 17, 35, 196,
+			11, 65, 185, 95, 37, 92, 168, 84, 240, 170, 180, 171, 12, 118,
+			224, 239, 115, 222, 70, 69, 14, 197, 5, 74, 126, 79, 75, 90,
+			79, 121, 39, 57, 239, 246, 159, 118, 174, 85, 204, 169, 19, 36,
+			117, 244, 56, 10, 203, 57, 28, 244, 135, 104, 213, 250, 92, 95,
+			98, 202, 22, 192, 21, 165, 24, 26, 16, 40, 89, 142, 228, 77,
+			38, 154, 92, 100, 79, 67, 139, 96, 117, 204, 102, 13, 142, 15,
+			64, 247, 219, 102, 172, 180, 135, 229, 213, 231, 128, 253, 153, 236,
+			113, 122, 186, 48, 110, 53, 193, 116, 42, 59, 235, 116, 2, 108,
+			158, 54, 182, 88, 131, 54, 27, 79, 114, 190, 128, 138, 28, 138,
+			157, 93, 54, 62, 226, 183, 139, 242, 188, 58, 93, 252, 243, 233,
+			35, 152, 14, 96, 254, 193, 17, 139, 75, 195, 27, 48, 157, 179,
+			249, 227, 156, 233, 141, 253, 122, 7, 74, 47, 122, 230, 179, 243,
+			235, 252, 44, 60, 122, 152, 15, 231, 29, 56, 146, 252, 36, 147,
+			61, 59, 112, 57, 194, 35, 213, 68, 250, 198, 217, 116, 161, 168,
+			250, 101, 107, 216, 19, 225, 142, 160, 3, 53, 159, 104, 9, 255,
+			50, 62, 169, 46, 146, 50, 44, 27, 32, 148, 10, 206, 249, 78,
+			23, 78, 125, 181, 68, 74, 138, 171, 179, 232, 61, 77, 230, 151,
+			241, 201, 228, 111, 49, 185, 100, 123, 170, 120, 228, 134, 210, 113,
+			22, 104, 160, 59, 1, 226, 7, 255, 169, 83, 70, 55, 111, 92,
+			91, 222, 121, 149, 89, 78, 216, 79, 75, 198, 193, 43, 140, 99,
+			105, 181, 113, 232, 44, 107, 234, 98, 217, 28, 206, 139, 198, 82,
+			155, 16, 238, 7, 106, 133, 108, 213, 105, 40, 14, 22, 115, 199,
+			211, 89, 0, 23, 23, 253, 77, 19, 45, 250, 199, 3, 248, 91,
+			242, 247, 152, 236, 209, 73, 196, 202, 151, 108, 52, 178, 16, 108,
+			238, 133, 208, 171, 233, 174, 198, 22, 217, 108, 198, 0, 118, 115,
+			47, 175, 18, 223, 97, 145, 230, 39, 164, 174, 126, 121, 170, 112,
+			60, 121, 174, 92, 180, 11, 98, 225, 51, 239, 170, 48, 184, 50,
+			84, 39, 174, 163, 10, 146, 143, 50, 105, 210, 162, 141, 27, 32,
+			250, 10, 139, 188, 73, 118, 106, 27, 41, 147, 61, 118, 176, 100,
+			146, 166, 181, 133, 182, 66, 248, 189, 90, 35, 219, 83, 147, 147,
+			206, 23, 68, 213, 182, 212, 164, 219, 143, 26, 146, 42, 159, 158,
+			206, 221, 148, 142, 212, 21, 152, 130, 124, 129, 254, 139, 83, 61,
+			249, 7, 118, 231, 132, 183, 31, 52, 214, 21, 82, 86, 30, 161,
+			75, 232, 237, 178, 217, 217, 143, 213, 119, 77, 216, 188, 105, 163,
+			104, 127, 152, 107, 235, 60, 20, 221, 58, 97, 75, 133, 83, 26,
+			169, 187, 191, 120, 237, 253, 85, 70, 139, 170, 251, 171, 108, 176,
+			41, 185, 180, 218, 88, 205, 246, 186, 68, 182, 56, 20, 171, 177,
+			191, 156, 81, 52, 135, 212, 42, 36, 191, 200, 100, 95, 216, 188,
+			115, 156, 59, 37, 114, 92, 37, 59, 160, 209, 131, 142, 54, 53,
+			171, 183, 188, 242, 80, 156, 142, 52, 113, 219, 139, 209, 95, 231,
+			34, 205, 23, 152, 76, 150, 208, 198, 249, 250, 212, 22, 243, 178,
+			178, 197, 28, 46, 31, 117, 45, 34, 213, 191, 162, 179, 114, 69,
+			205, 81, 155, 101, 189, 66, 46, 40, 165, 39, 173, 237, 220, 4,
+			157, 232, 40, 161, 101, 33, 121, 163, 236, 210, 227, 31, 53, 234,
+			149, 232, 179, 88, 198, 103, 82, 224, 200, 238, 210, 198, 252, 164,
+			182, 200, 4, 169, 99, 179, 154, 126, 121, 231, 212, 162, 254, 212,
+			126, 144, 60, 44, 23, 59, 51, 165, 90, 118, 97, 118, 148, 209,
+			188, 2, 226, 88, 197, 49, 135, 196, 78, 190, 69, 246, 85, 238,
+			195, 144, 241, 2, 217, 68, 227, 33, 242, 213, 152, 193, 68, 88,
+			121, 227, 63, 39, 100, 98, 194, 216, 76, 234, 122, 217, 81, 170,
+			224, 84, 253, 234, 212, 175, 176, 27, 195, 74, 201, 152, 186, 69,
+			118, 59, 179, 8, 255, 84, 80, 235, 203, 191, 172, 92, 147, 186,
+			26, 169, 255, 3, 77, 162, 100, 12, 102, 87, 170, 59, 43, 205,
+			174, 180, 78, 189, 179, 75, 73, 85, 174, 255, 212, 186, 242, 175,
+			170, 106, 201, 57, 187, 200, 200, 69, 85, 117, 165, 218, 88, 125,
+			42, 213, 20, 171, 223, 93, 166, 219, 119, 130, 177, 171, 137, 21,
+			110, 93, 163, 113, 170, 178, 130, 35, 115, 171, 207, 36, 172, 84,
+			198, 10, 225, 159, 230, 98, 5, 167, 102, 125, 172, 16, 249, 192,
+			178, 194, 20, 137, 137, 18, 137, 162, 78, 81, 30, 250, 115, 11,
+			169, 100, 76, 221, 193, 34, 34, 162, 164, 66, 65, 157, 59, 231,
+			12, 220, 234, 212, 245, 121, 167, 248, 149, 157, 252, 205, 178, 179,
+			146, 48, 81, 67, 53, 27, 180, 245, 168, 255, 225, 122, 171, 83,
+			199, 243, 9, 14, 252, 127, 222, 162, 131, 3, 63, 255, 102, 112,
+			224, 155, 193, 129, 103, 35, 56, 112, 85, 24, 28, 56, 16, 6,
+			7, 94, 18, 6, 7, 142, 134, 193, 129, 219, 77, 68, 224, 162,
+			48, 34, 16, 254, 249, 3, 46, 121, 60, 166, 188, 21, 177, 183,
+			49, 255, 89, 30, 236, 55, 57, 38, 241, 33, 30, 52, 173, 123,
+			133, 3, 79, 37, 65, 169, 214, 178, 185, 187, 195, 215, 138, 84,
+			248, 54, 1, 14, 160, 73, 125, 240, 26, 194, 179, 34, 4, 171,
+			140, 23, 237, 43, 158, 201, 174, 108, 178, 156, 227, 37, 107, 48,
+			61, 107, 50, 247, 76, 236, 219, 225, 188, 32, 133, 178, 194, 60,
+			225, 202, 224, 74, 168, 127, 115, 166, 144, 142, 36, 153, 68, 228,
+			42, 124, 230, 65, 38, 191, 250, 138, 209, 171, 15, 92, 186, 243,
+			10, 136, 5, 62, 176, 115, 204, 36, 3, 35, 76, 126, 232, 67,
+			95, 62, 97, 183, 105, 184, 126, 10, 173, 80, 202, 43, 6, 49,
+			206, 16, 56, 60, 177, 211, 178, 138, 211, 142, 121, 125, 114, 253,
+			12, 224, 2, 242, 168, 209, 157, 224, 250, 33, 165, 136, 67, 4,
+			217, 138, 68, 135, 252, 49, 151, 94, 28, 188, 197, 196, 122, 190,
+			219, 255, 61, 110, 72, 10, 116, 131, 148, 197, 97, 35, 198, 221,
+			136, 230, 69, 215, 87, 6, 97, 201, 233, 11, 178, 3, 67, 54,
+			140, 208, 135, 156, 92, 24, 237, 226, 220, 144, 78, 1, 123, 217,
+			133, 177, 110, 146, 209, 213, 9, 119, 40, 220, 36, 30, 157, 5,
+			247, 25, 74, 176, 92, 24, 52, 75, 156, 201, 30, 163, 201, 153,
+			113, 154, 91, 78, 59, 34, 43, 93, 244, 27, 244, 248, 88, 144,
+			154, 2, 231, 254, 19, 230, 250, 115, 208, 16, 182, 16, 140, 238,
+			157, 216, 57, 58, 118, 221, 193, 157, 111, 25, 223, 127, 96, 191,
+			140, 172, 206, 198, 203, 131, 173, 91, 157, 55, 244, 66, 176, 245,
+			52, 254, 67, 79, 99, 160, 59, 83, 98, 125, 188, 155, 74, 92,
+			137, 245, 61, 195, 84, 18, 74, 172, 191, 112, 135, 252, 136, 94,
+			34, 166, 188, 77, 252, 252, 13, 254, 251, 194, 53, 178, 81, 170,
+			225, 108, 13, 132, 147, 137, 106, 205, 207, 28, 169, 159, 248, 41,
+			29, 245, 58, 132, 158, 125, 147, 37, 59, 69, 86, 90, 140, 57,
+			215, 2, 89, 6, 23, 179, 64, 185, 103, 165, 117, 5, 8, 67,
+			105, 209, 136, 134, 183, 6, 71, 15, 134, 148, 54, 122, 115, 216,
+			176, 158, 193, 140, 140, 235, 48, 176, 77, 241, 62, 42, 113, 37,
+			54, 45, 217, 76, 37, 161, 196, 249, 237, 67, 242, 34, 164, 29,
+			87, 98, 51, 223, 237, 175, 55, 55, 38, 198, 69, 193, 228, 219,
+			134, 216, 66, 51, 234, 72, 172, 129, 237, 7, 98, 183, 54, 219,
+			69, 194, 198, 236, 34, 113, 161, 196, 230, 11, 119, 200, 221, 216,
+			143, 0, 188, 192, 61, 16, 3, 144, 79, 101, 11, 38, 77, 88,
+			200, 122, 97, 219, 244, 182, 0, 62, 157, 233, 96, 215, 248, 21,
+			163, 123, 199, 223, 74, 97, 45, 208, 16, 83, 98, 107, 188, 151,
+			74, 92, 137, 173, 139, 54, 82, 9, 122, 217, 186, 91, 110, 199,
+			46, 61, 229, 109, 227, 163, 253, 254, 185, 118, 110, 6, 34, 29,
+			120, 1, 246, 77, 106, 238, 9, 2, 232, 209, 182, 248, 50, 42,
+			113, 37, 182, 5, 219, 168, 4, 16, 130, 237, 43, 229, 93, 12,
+			187, 107, 80, 98, 23, 223, 237, 111, 130, 23, 26, 76, 212, 144,
+			138, 220, 171, 23, 115, 53, 251, 211, 155, 232, 128, 35, 197, 79,
+			103, 23, 209, 240, 33, 234, 103, 151, 93, 159, 6, 174, 196, 46,
+			187, 62, 16, 3, 180, 235, 194, 29, 16, 17, 17, 135, 24, 31,
+			239, 82, 62, 190, 193, 223, 19, 140, 22, 115, 211, 230, 250, 62,
+			101, 103, 114, 24, 216, 175, 44, 116, 108, 142, 57, 153, 142, 32,
+			116, 228, 82, 203, 140, 113, 174, 196, 165, 150, 25, 33, 144, 100,
+			188, 125, 72, 142, 227, 32, 26, 149, 183, 151, 95, 158, 244, 183,
+			148, 210, 208, 57, 203, 207, 69, 72, 211, 48, 4, 135, 236, 141,
+			47, 166, 18, 87, 98, 111, 223, 5, 84, 18, 74, 92, 222, 190,
+			20, 176, 50, 227, 49, 8, 49, 220, 199, 175, 218, 228, 95, 85,
+			207, 204, 221, 75, 133, 10, 35, 145, 149, 134, 2, 49, 32, 251,
+			226, 203, 169, 196, 149, 216, 151, 28, 163, 146, 80, 226, 170, 246,
+			141, 242, 187, 154, 135, 154, 148, 119, 29, 127, 235, 122, 255, 125,
+			204, 72, 178, 80, 144, 217, 243, 181, 137, 177, 163, 178, 13, 177,
+			215, 70, 80, 216, 191, 14, 231, 9, 192, 222, 73, 23, 138, 67,
+			218, 54, 208, 63, 26, 187, 136, 12, 234, 97, 184, 54, 69, 225,
+			133, 207, 79, 240, 12, 70, 12, 105, 109, 238, 211, 146, 233, 33,
+			67, 66, 196, 200, 117, 113, 159, 74, 92, 137, 235, 22, 111, 162,
+			146, 80, 226, 173, 237, 235, 228, 251, 193, 195, 50, 166, 188, 67,
+			177, 28, 243, 215, 6, 163, 214, 98, 32, 95, 116, 24, 105, 169,
+			40, 28, 150, 27, 175, 124, 61, 213, 208, 214, 173, 97, 224, 255,
+			161, 196, 50, 249, 67, 155, 224, 227, 40, 223, 229, 255, 61, 115,
+			58, 138, 120, 213, 29, 78, 131, 207, 113, 193, 9, 181, 142, 90,
+			1, 72, 107, 10, 248, 37, 168, 205, 203, 71, 175, 51, 175, 88,
+			78, 77, 19, 226, 157, 205, 28, 73, 79, 157, 48, 105, 72, 192,
+			164, 30, 31, 11, 173, 45, 25, 36, 209, 105, 96, 104, 195, 198,
+			115, 206, 61, 111, 211, 249, 23, 92, 56, 146, 28, 198, 52, 154,
+			218, 70, 211, 89, 199, 11, 218, 142, 131, 167, 215, 221, 87, 143,
+			143, 129, 154, 136, 132, 233, 94, 111, 98, 117, 15, 190, 125, 232,
+			250, 129, 149, 54, 242, 7, 176, 218, 142, 218, 0, 48, 32, 196,
+			209, 38, 130, 209, 133, 192, 250, 163, 139, 40, 242, 39, 150, 80,
+			226, 232, 226, 157, 209, 184, 250, 163, 75, 118, 128, 67, 59, 132,
+			26, 43, 145, 225, 125, 126, 159, 75, 51, 84, 176, 136, 47, 82,
+			72, 231, 9, 13, 47, 134, 104, 120, 25, 27, 180, 8, 106, 44,
+			211, 74, 97, 138, 128, 192, 156, 241, 23, 203, 199, 152, 137, 87,
+			22, 89, 222, 235, 127, 134, 85, 196, 96, 182, 161, 82, 134, 121,
+			134, 193, 17, 181, 152, 207, 208, 27, 243, 57, 155, 130, 209, 253,
+			59, 198, 199, 157, 48, 6, 12, 133, 202, 167, 178, 147, 185, 233,
+			224, 234, 171, 181, 143, 40, 188, 253, 77, 79, 131, 203, 218, 36,
+			61, 103, 19, 55, 102, 76, 88, 71, 102, 50, 61, 61, 147, 3,
+			188, 96, 48, 48, 83, 193, 33, 83, 225, 96, 102, 242, 16, 52,
+			65, 25, 44, 237, 28, 121, 131, 18, 89, 75, 87, 160, 78, 182,
+			137, 2, 51, 65, 105, 102, 187, 123, 228, 102, 116, 50, 246, 242,
+			177, 247, 48, 127, 184, 202, 22, 168, 104, 18, 20, 66, 128, 225,
+			124, 98, 149, 193, 224, 141, 65, 72, 218, 136, 63, 69, 205, 20,
+			222, 150, 185, 158, 140, 6, 112, 122, 37, 51, 159, 14, 82, 112,
+			97, 3, 130, 195, 134, 187, 57, 117, 33, 170, 178, 20, 47, 227,
+			234, 25, 32, 233, 121, 35, 35, 182, 3, 51, 89, 198, 99, 30,
+			244, 109, 75, 16, 28, 215, 156, 164, 18, 4, 199, 173, 88, 71,
+			37, 8, 142, 27, 94, 47, 95, 102, 4, 97, 124, 11, 239, 245,
+			191, 247, 203, 178, 188, 161, 119, 181, 4, 152, 237, 92, 246, 216,
+			212, 9, 183, 125, 24, 213, 76, 110, 102, 22, 188, 82, 232, 212,
+			175, 99, 66, 12, 106, 114, 3, 196, 217, 17, 48, 46, 172, 223,
+			45, 134, 43, 208, 219, 86, 220, 210, 221, 35, 183, 35, 28, 108,
+			252, 86, 22, 251, 16, 99, 254, 185, 193, 104, 104, 187, 206, 205,
+			24, 218, 219, 26, 28, 175, 60, 240, 1, 185, 149, 37, 86, 203,
+			149, 6, 34, 214, 187, 141, 241, 213, 126, 183, 179, 65, 33, 186,
+			130, 2, 1, 193, 15, 140, 195, 74, 66, 53, 91, 140, 43, 239,
+			54, 214, 220, 69, 69, 6, 197, 238, 229, 84, 20, 80, 92, 185,
+			74, 254, 57, 55, 240, 171, 16, 65, 182, 210, 127, 132, 7, 87,
+			102, 141, 225, 11, 231, 108, 157, 144, 158, 220, 4, 28, 49, 9,
+			155, 13, 50, 27, 161, 175, 69, 138, 50, 50, 153, 8, 99, 58,
+			200, 218, 172, 253, 169, 130, 181, 218, 7, 173, 155, 201, 84, 42,
+			127, 44, 45, 181, 2, 215, 198, 31, 248, 83, 152, 132, 90, 78,
+			108, 177, 109, 80, 31, 190, 192, 37, 229, 4, 38, 39, 2, 127,
+			199, 244, 164, 134, 6, 50, 55, 1, 218, 129, 197, 68, 76, 153,
+			156, 138, 71, 242, 135, 103, 143, 33, 170, 237, 134, 145, 77, 231,
+			158, 123, 225, 38, 25, 68, 158, 93, 223, 150, 185, 62, 192, 56,
+			17, 232, 6, 208, 102, 114, 174, 148, 135, 173, 40, 131, 129, 177,
+			28, 94, 9, 77, 229, 142, 153, 148, 78, 120, 214, 40, 12, 88,
+			242, 51, 15, 194, 236, 44, 249, 193, 61, 251, 14, 202, 92, 205,
+			193, 224, 247, 238, 96, 106, 25, 21, 49, 38, 47, 185, 66, 110,
+			65, 208, 213, 248, 239, 176, 216, 239, 50, 230, 15, 85, 145, 36,
+			116, 169, 75, 131, 114, 88, 69, 48, 229, 253, 14, 75, 44, 67,
+			192, 116, 1, 172, 242, 81, 198, 119, 251, 61, 174, 44, 47, 230,
+			204, 132, 205, 96, 17, 38, 213, 251, 40, 227, 45, 84, 100, 240,
+			89, 107, 15, 21, 5, 20, 253, 197, 84, 76, 64, 177, 111, 23,
+			58, 24, 10, 114, 107, 255, 40, 91, 58, 38, 55, 24, 40, 85,
+			239, 30, 198, 7, 253, 21, 192, 19, 54, 221, 176, 241, 167, 52,
+			190, 128, 51, 147, 14, 179, 10, 208, 35, 240, 13, 117, 1, 228,
+			185, 135, 245, 173, 161, 162, 128, 226, 192, 58, 185, 21, 129, 85,
+			227, 247, 177, 216, 253, 140, 249, 235, 171, 144, 167, 252, 118, 221,
+			33, 16, 136, 233, 251, 88, 98, 185, 60, 199, 224, 162, 122, 31,
+			103, 124, 171, 191, 42, 130, 51, 28, 174, 55, 172, 62, 153, 139,
+			102, 180, 30, 198, 1, 124, 156, 28, 135, 17, 22, 213, 251, 56,
+			197, 1, 32, 46, 170, 247, 113, 136, 3, 48, 197, 132, 242, 62,
+			206, 212, 69, 72, 46, 143, 200, 245, 113, 214, 185, 89, 142, 33,
+			114, 106, 252, 147, 44, 246, 8, 99, 254, 166, 42, 211, 169, 122,
+			133, 239, 204, 170, 129, 41, 239, 147, 44, 209, 47, 255, 138, 17,
+			66, 234, 131, 140, 95, 238, 63, 206, 106, 204, 43, 53, 57, 185,
+			94, 191, 108, 83, 50, 42, 104, 180, 152, 91, 15, 225, 46, 131,
+			18, 61, 254, 199, 203, 44, 161, 72, 72, 139, 11, 145, 142, 150,
+			141, 70, 224, 48, 174, 145, 110, 119, 55, 164, 44, 204, 147, 185,
+			209, 66, 204, 170, 155, 83, 224, 57, 118, 44, 40, 66, 238, 247,
+			176, 190, 33, 181, 134, 111, 125, 144, 72, 173, 225, 91, 31, 100,
+			77, 75, 28, 248, 214, 7, 89, 176, 156, 138, 9, 40, 38, 247,
+			34, 169, 27, 136, 212, 15, 178, 149, 151, 201, 207, 50, 2, 120,
+			125, 8, 68, 231, 39, 52, 93, 44, 94, 67, 216, 179, 217, 239,
+			56, 194, 193, 42, 36, 24, 7, 36, 1, 186, 195, 45, 68, 191,
+			6, 1, 72, 183, 50, 166, 153, 73, 131, 236, 99, 238, 13, 130,
+			66, 102, 42, 157, 45, 78, 157, 144, 65, 230, 88, 54, 71, 23,
+			17, 86, 113, 29, 49, 206, 127, 128, 71, 235, 41, 239, 33, 18,
+			34, 26, 83, 246, 33, 18, 34, 26, 83, 246, 33, 166, 104, 246,
+			76, 64, 113, 229, 42, 249, 117, 61, 87, 174, 188, 135, 33, 176,
+			247, 75, 37, 115, 157, 201, 167, 111, 202, 228, 102, 11, 83, 225,
+			0, 35, 51, 64, 251, 1, 184, 2, 167, 47, 231, 49, 127, 144,
+			140, 182, 105, 67, 140, 121, 211, 0, 2, 26, 30, 14, 105, 192,
+			27, 160, 104, 105, 0, 194, 230, 97, 166, 86, 82, 81, 64, 113,
+			77, 63, 10, 210, 184, 138, 127, 129, 197, 158, 172, 33, 72, 75,
+			47, 63, 157, 29, 21, 103, 202, 251, 2, 8, 210, 29, 210, 243,
+			226, 32, 39, 190, 196, 248, 14, 255, 188, 200, 126, 210, 143, 199,
+			206, 252, 171, 112, 12, 142, 46, 142, 204, 252, 37, 98, 230, 56,
+			50, 243, 151, 88, 211, 66, 42, 10, 232, 163, 187, 135, 138, 9,
+			40, 246, 110, 71, 102, 142, 19, 51, 127, 137, 249, 219, 228, 111,
+			131, 146, 142, 195, 212, 191, 2, 145, 30, 175, 149, 123, 5, 22,
+			115, 198, 30, 0, 51, 201, 64, 82, 193, 101, 166, 249, 51, 148,
+			242, 97, 112, 216, 100, 122, 114, 118, 198, 222, 136, 194, 133, 228,
+			48, 44, 115, 166, 36, 62, 55, 124, 221, 8, 97, 227, 202, 219,
+			141, 220, 72, 26, 243, 211, 101, 17, 100, 4, 50, 13, 116, 135,
+			112, 60, 58, 146, 6, 100, 183, 84, 33, 200, 205, 204, 228, 204,
+			184, 232, 54, 19, 250, 149, 37, 119, 154, 250, 74, 211, 146, 22,
+			20, 200, 87, 72, 131, 197, 113, 107, 124, 133, 181, 246, 82, 81,
+			192, 95, 23, 247, 209, 95, 19, 80, 92, 178, 219, 144, 86, 67,
+			43, 121, 95, 97, 203, 118, 202, 159, 121, 72, 90, 174, 188, 111,
+			50, 222, 235, 255, 208, 251, 149, 55, 103, 131, 241, 233, 153, 41,
+			48, 102, 210, 155, 157, 63, 192, 0, 136, 61, 76, 138, 74, 243,
+			14, 147, 202, 102, 211, 121, 115, 89, 97, 151, 29, 147, 129, 130,
+			143, 60, 202, 56, 157, 56, 52, 40, 206, 194, 69, 72, 127, 56,
+			98, 184, 93, 157, 76, 191, 243, 96, 238, 40, 65, 233, 175, 77,
+			154, 180, 169, 16, 174, 26, 86, 132, 222, 83, 68, 173, 221, 72,
+			173, 163, 48, 68, 59, 93, 202, 142, 58, 91, 72, 231, 225, 211,
+			210, 118, 161, 129, 17, 184, 153, 173, 180, 195, 7, 225, 172, 132,
+			95, 208, 62, 54, 95, 65, 92, 105, 38, 235, 218, 227, 225, 135,
+			244, 232, 74, 102, 45, 154, 169, 38, 34, 242, 192, 149, 99, 87,
+			246, 255, 6, 68, 243, 76, 165, 211, 107, 55, 27, 241, 105, 60,
+			251, 137, 186, 48, 207, 172, 9, 105, 59, 146, 203, 222, 148, 134,
+			232, 37, 128, 106, 178, 153, 140, 53, 115, 130, 128, 251, 102, 40,
+			36, 96, 151, 127, 51, 20, 18, 92, 64, 177, 187, 71, 222, 5,
+			183, 46, 141, 42, 254, 109, 22, 251, 39, 198, 252, 115, 170, 72,
+			184, 202, 115, 209, 227, 222, 120, 229, 235, 121, 135, 9, 55, 48,
+			112, 88, 105, 100, 202, 251, 54, 75, 172, 66, 193, 217, 8, 130,
+			243, 153, 211, 20, 156, 141, 40, 56, 159, 33, 154, 52, 162, 224,
+			124, 134, 104, 210, 136, 130, 243, 25, 18, 156, 141, 104, 159, 62,
+			67, 130, 179, 145, 4, 231, 51, 32, 56, 255, 21, 52, 99, 35,
+			144, 244, 57, 198, 71, 252, 239, 179, 192, 172, 106, 33, 148, 152,
+			174, 196, 131, 67, 168, 161, 42, 158, 193, 195, 241, 206, 125, 6,
+			119, 234, 34, 203, 192, 65, 211, 172, 76, 73, 171, 103, 250, 100,
+			175, 201, 2, 7, 146, 231, 72, 143, 54, 162, 197, 253, 28, 107,
+			78, 82, 17, 105, 178, 98, 29, 21, 5, 20, 135, 215, 203, 127,
+			209, 20, 227, 16, 151, 200, 123, 253, 127, 248, 213, 63, 222, 27,
+			10, 112, 55, 80, 179, 17, 79, 196, 255, 24, 178, 28, 108, 195,
+			127, 100, 230, 136, 159, 80, 241, 231, 89, 236, 159, 235, 60, 226,
+			151, 109, 67, 52, 55, 18, 76, 121, 207, 195, 17, 31, 206, 109,
+			9, 216, 53, 47, 48, 190, 198, 239, 137, 110, 207, 232, 25, 63,
+			129, 103, 252, 23, 104, 77, 19, 120, 140, 123, 129, 206, 248, 9,
+			220, 38, 47, 176, 238, 36, 21, 5, 84, 94, 181, 90, 110, 147,
+			220, 107, 82, 241, 23, 89, 236, 167, 140, 249, 27, 231, 180, 141,
+			28, 39, 18, 103, 196, 77, 76, 121, 47, 178, 196, 74, 220, 231,
+			77, 48, 226, 151, 79, 115, 159, 55, 225, 62, 127, 153, 136, 222,
+			132, 19, 120, 153, 136, 222, 132, 251, 252, 101, 218, 231, 77, 184,
+			207, 95, 166, 125, 222, 68, 251, 252, 101, 216, 231, 35, 56, 36,
+			166, 226, 175, 48, 254, 42, 219, 224, 7, 104, 32, 149, 206, 197,
+			49, 146, 76, 147, 176, 41, 94, 97, 58, 10, 1, 138, 12, 138,
+			42, 73, 69, 1, 197, 85, 171, 169, 152, 80, 222, 171, 44, 62,
+			98, 250, 55, 86, 196, 171, 44, 49, 44, 31, 135, 93, 211, 4,
+			224, 24, 63, 97, 191, 100, 119, 158, 122, 110, 192, 253, 63, 9,
+			23, 2, 184, 255, 39, 225, 66, 0, 247, 255, 4, 22, 98, 151,
+			228, 158, 84, 241, 215, 88, 236, 67, 156, 249, 23, 212, 167, 131,
+			156, 37, 112, 119, 0, 160, 58, 189, 198, 18, 3, 200, 79, 18,
+			248, 233, 23, 167, 201, 79, 18, 249, 233, 23, 52, 13, 137, 252,
+			244, 11, 154, 134, 68, 126, 250, 5, 241, 147, 68, 126, 250, 5,
+			241, 147, 36, 126, 250, 5, 240, 211, 79, 96, 61, 37, 80, 225,
+			253, 156, 159, 231, 191, 80, 81, 111, 148, 206, 237, 87, 70, 119,
+			72, 60, 135, 190, 159, 27, 57, 35, 81, 119, 188, 159, 55, 175,
+			161, 34, 210, 165, 127, 132, 138, 2, 138, 231, 156, 43, 255, 183,
+			166, 26, 87, 222, 29, 252, 215, 227, 106, 88, 19, 0, 54, 207,
+			29, 220, 114, 29, 176, 205, 29, 220, 114, 29, 108, 158, 59, 120,
+			119, 143, 220, 45, 185, 215, 172, 226, 31, 225, 177, 143, 114, 230,
+			95, 88, 167, 234, 168, 178, 123, 154, 153, 242, 62, 194, 19, 235,
+			48, 67, 78, 51, 236, 158, 187, 56, 223, 0, 25, 114, 202, 158,
+			65, 163, 74, 164, 25, 149, 200, 93, 180, 184, 205, 168, 68, 238,
+			226, 205, 62, 21, 25, 52, 181, 120, 144, 138, 0, 184, 197, 215,
+			143, 200, 15, 129, 253, 217, 162, 226, 247, 242, 216, 73, 94, 253,
+			209, 35, 234, 10, 104, 118, 13, 153, 158, 175, 215, 107, 37, 153,
+			158, 45, 76, 121, 247, 242, 196, 18, 249, 77, 224, 188, 22, 160,
+			194, 253, 156, 95, 226, 255, 255, 172, 146, 16, 201, 155, 4, 103,
+			78, 66, 49, 251, 72, 75, 215, 20, 33, 173, 0, 246, 164, 252,
+			181, 150, 62, 128, 51, 115, 145, 224, 72, 157, 221, 107, 46, 76,
+			118, 85, 122, 213, 173, 252, 113, 104, 30, 184, 121, 140, 90, 80,
+			148, 221, 79, 76, 213, 130, 162, 236, 126, 222, 212, 78, 69, 1,
+			83, 85, 11, 169, 152, 128, 98, 231, 197, 168, 154, 90, 72, 148,
+			221, 207, 187, 47, 146, 191, 175, 73, 195, 148, 247, 0, 231, 59,
+			252, 143, 48, 187, 10, 192, 214, 179, 51, 83, 185, 212, 164, 241,
+			144, 210, 169, 204, 136, 106, 52, 90, 235, 12, 101, 5, 206, 69,
+			91, 33, 219, 217, 158, 204, 246, 97, 105, 107, 13, 211, 63, 92,
+			201, 84, 72, 23, 221, 42, 48, 63, 123, 33, 100, 174, 129, 236,
+			124, 65, 198, 60, 64, 92, 217, 130, 50, 230, 1, 222, 76, 19,
+			132, 187, 174, 7, 184, 17, 221, 45, 168, 138, 31, 224, 70, 116,
+			183, 208, 129, 254, 1, 238, 111, 195, 55, 152, 86, 21, 255, 67,
+			30, 251, 28, 39, 3, 173, 166, 138, 178, 76, 105, 153, 21, 55,
+			88, 43, 83, 222, 31, 242, 196, 10, 249, 23, 64, 192, 86, 224,
+			173, 207, 114, 62, 236, 63, 90, 81, 23, 216, 70, 12, 41, 11,
+			179, 211, 68, 71, 67, 62, 67, 93, 243, 214, 171, 169, 184, 97,
+			228, 114, 36, 226, 1, 203, 164, 120, 105, 151, 154, 154, 138, 94,
+			151, 16, 142, 10, 0, 115, 88, 120, 34, 123, 47, 19, 178, 95,
+			77, 169, 222, 138, 27, 255, 179, 68, 226, 86, 220, 248, 159, 229,
+			205, 1, 21, 25, 204, 112, 249, 90, 42, 10, 40, 14, 14, 201,
+			22, 201, 189, 54, 21, 127, 152, 163, 71, 46, 144, 166, 13, 238,
+			224, 120, 98, 165, 76, 74, 207, 107, 3, 202, 60, 202, 121, 210,
+			239, 116, 246, 119, 84, 230, 180, 97, 215, 143, 82, 215, 109, 216,
+			245, 163, 180, 186, 109, 60, 198, 160, 137, 206, 37, 84, 20, 80,
+			12, 150, 219, 96, 208, 31, 175, 145, 23, 214, 231, 70, 188, 62,
+			2, 13, 51, 239, 128, 208, 179, 158, 206, 106, 254, 33, 157, 127,
+			41, 100, 51, 200, 16, 3, 213, 227, 230, 236, 97, 213, 114, 246,
+			240, 186, 115, 246, 44, 47, 73, 170, 163, 195, 184, 34, 249, 116,
+			182, 216, 28, 84, 30, 230, 160, 90, 81, 57, 7, 149, 105, 90,
+			67, 250, 77, 152, 79, 212, 69, 178, 209, 156, 117, 122, 27, 48,
+			82, 38, 89, 51, 34, 110, 54, 59, 57, 149, 158, 160, 79, 212,
+			78, 217, 226, 106, 186, 222, 120, 189, 81, 68, 145, 207, 202, 147,
+			64, 53, 206, 35, 9, 212, 156, 73, 129, 32, 124, 74, 43, 160,
+			2, 68, 215, 54, 33, 37, 155, 204, 47, 227, 147, 201, 75, 101,
+			71, 233, 76, 213, 185, 144, 171, 8, 202, 213, 131, 59, 195, 111,
+			38, 76, 221, 228, 126, 217, 233, 80, 124, 95, 62, 141, 49, 242,
+			105, 103, 165, 216, 41, 175, 212, 192, 39, 153, 92, 80, 246, 87,
+			181, 66, 46, 195, 140, 20, 215, 140, 78, 140, 143, 94, 113, 224,
+			96, 197, 60, 71, 109, 82, 134, 105, 131, 58, 164, 234, 149, 157,
+			97, 121, 239, 117, 7, 33, 181, 209, 190, 157, 99, 29, 157, 144,
+			242, 104, 215, 222, 209, 61, 215, 117, 44, 85, 109, 82, 18, 82,
+			216, 206, 177, 142, 254, 146, 220, 67, 151, 143, 238, 223, 211, 49,
+			164, 90, 100, 130, 126, 234, 216, 56, 159, 96, 131, 143, 45, 147,
+			113, 229, 181, 197, 178, 181, 98, 13, 54, 110, 120, 51, 214, 224,
+			205, 88, 131, 215, 37, 214, 96, 107, 24, 107, 48, 22, 198, 26,
+			80, 84, 65, 79, 24, 85, 0, 255, 220, 161, 253, 236, 252, 216,
+			30, 230, 159, 31, 205, 200, 58, 13, 71, 108, 24, 95, 20, 197,
+			7, 56, 4, 124, 33, 12, 180, 38, 229, 92, 5, 223, 48, 63,
+			177, 80, 126, 208, 58, 201, 45, 227, 93, 254, 123, 42, 30, 207,
+			230, 155, 43, 101, 227, 121, 155, 32, 131, 196, 41, 167, 207, 7,
+			47, 182, 101, 198, 175, 38, 6, 150, 128, 88, 102, 243, 99, 128,
+			23, 219, 178, 133, 157, 38, 119, 4, 248, 253, 243, 238, 179, 159,
+			59, 2, 124, 222, 86, 216, 220, 17, 224, 249, 179, 194, 230, 142,
+			0, 207, 159, 21, 157, 93, 50, 67, 46, 111, 107, 184, 127, 134,
+			177, 204, 140, 35, 90, 3, 244, 229, 58, 169, 173, 177, 232, 239,
+			224, 164, 182, 166, 87, 39, 79, 5, 136, 86, 49, 192, 151, 249,
+			75, 8, 77, 215, 93, 96, 59, 54, 243, 37, 164, 201, 24, 224,
+			228, 236, 6, 225, 49, 3, 157, 228, 68, 8, 97, 49, 3, 75,
+			150, 202, 65, 74, 131, 49, 196, 251, 253, 101, 128, 95, 117, 36,
+			55, 157, 174, 221, 174, 135, 213, 109, 41, 174, 196, 80, 51, 193,
+			196, 131, 11, 247, 80, 31, 65, 176, 67, 118, 134, 161, 213, 107,
+			240, 82, 40, 6, 158, 120, 35, 124, 208, 223, 84, 225, 84, 11,
+			247, 90, 217, 19, 145, 238, 64, 158, 80, 29, 199, 159, 175, 193,
+			131, 86, 108, 41, 174, 196, 72, 51, 77, 10, 28, 176, 71, 22,
+			19, 226, 59, 184, 92, 143, 12, 172, 147, 239, 229, 148, 87, 225,
+			124, 190, 220, 127, 149, 157, 6, 72, 56, 58, 123, 186, 47, 12,
+			68, 167, 240, 73, 149, 70, 111, 188, 37, 242, 105, 72, 132, 16,
+			173, 19, 5, 45, 203, 224, 53, 26, 36, 219, 135, 40, 22, 116,
+			182, 128, 11, 145, 89, 200, 173, 139, 111, 179, 250, 21, 21, 62,
+			180, 223, 225, 153, 65, 6, 3, 41, 130, 252, 38, 144, 234, 154,
+			235, 22, 71, 18, 180, 81, 137, 65, 100, 66, 31, 149, 32, 78,
+			97, 89, 32, 255, 92, 11, 149, 70, 37, 182, 241, 81, 255, 209,
+			8, 76, 27, 234, 157, 161, 232, 72, 182, 4, 128, 237, 165, 127,
+			15, 183, 135, 3, 14, 233, 84, 64, 116, 44, 59, 58, 59, 23,
+			120, 217, 46, 255, 78, 234, 212, 76, 46, 8, 156, 61, 40, 149,
+			204, 174, 4, 117, 205, 228, 36, 104, 128, 41, 80, 210, 16, 112,
+			80, 223, 214, 72, 146, 168, 81, 40, 177, 205, 102, 234, 105, 76,
+			40, 177, 173, 107, 91, 52, 123, 193, 182, 238, 75, 228, 35, 194,
+			164, 47, 16, 151, 241, 94, 255, 1, 141, 209, 164, 133, 107, 209,
+			234, 101, 120, 253, 11, 140, 221, 135, 164, 73, 79, 154, 85, 29,
+			31, 35, 111, 113, 120, 138, 149, 32, 69, 167, 114, 185, 227, 179,
+			51, 244, 169, 129, 82, 163, 143, 51, 217, 224, 170, 217, 116, 254,
+			132, 99, 151, 217, 224, 203, 97, 83, 105, 94, 60, 120, 24, 34,
+			20, 139, 96, 33, 76, 218, 120, 15, 4, 195, 165, 158, 251, 111,
+			202, 164, 224, 231, 76, 30, 218, 4, 216, 160, 204, 77, 238, 73,
+			180, 191, 176, 118, 109, 57, 171, 206, 206, 24, 102, 149, 17, 70,
+			165, 70, 113, 181, 82, 217, 250, 153, 52, 24, 175, 74, 81, 235,
+			151, 98, 19, 204, 13, 150, 188, 118, 31, 158, 74, 101, 143, 219,
+			197, 79, 52, 40, 113, 153, 149, 167, 16, 18, 112, 153, 117, 250,
+			77, 8, 37, 46, 235, 238, 65, 31, 19, 166, 188, 43, 98, 87,
+			129, 47, 154, 43, 248, 82, 217, 112, 211, 87, 25, 43, 121, 253,
+			94, 145, 232, 149, 231, 146, 215, 239, 62, 222, 235, 175, 9, 38,
+			170, 206, 49, 34, 74, 140, 27, 110, 28, 62, 107, 49, 158, 167,
+			160, 47, 247, 153, 180, 49, 12, 206, 236, 98, 95, 119, 143, 92,
+			131, 128, 236, 222, 129, 216, 49, 230, 47, 118, 228, 126, 42, 210,
+			184, 30, 84, 3, 52, 113, 160, 161, 91, 78, 26, 240, 117, 113,
+			45, 95, 237, 95, 91, 82, 87, 67, 185, 130, 161, 138, 123, 12,
+			41, 169, 15, 6, 116, 249, 74, 151, 174, 192, 185, 112, 195, 150,
+			5, 75, 182, 152, 206, 131, 22, 54, 141, 24, 165, 15, 189, 48,
+			37, 174, 229, 203, 169, 196, 149, 184, 118, 229, 42, 200, 145, 208,
+			128, 222, 232, 215, 113, 229, 239, 14, 125, 92, 104, 12, 0, 241,
+			152, 205, 69, 212, 128, 70, 242, 130, 11, 19, 98, 109, 88, 247,
+			16, 202, 202, 246, 8, 164, 191, 78, 59, 174, 67, 9, 2, 27,
+			218, 23, 200, 119, 24, 24, 118, 241, 54, 222, 231, 95, 245, 186,
+			244, 56, 117, 194, 129, 170, 110, 33, 12, 117, 241, 54, 222, 109,
+			250, 198, 238, 22, 45, 150, 71, 176, 111, 161, 196, 59, 120, 171,
+			127, 205, 41, 244, 13, 127, 64, 193, 72, 93, 226, 175, 21, 96,
+			206, 236, 0, 64, 145, 191, 67, 103, 117, 135, 18, 87, 226, 29,
+			178, 69, 110, 55, 152, 231, 226, 16, 87, 254, 121, 149, 7, 144,
+			203, 66, 140, 155, 142, 59, 41, 11, 176, 177, 237, 123, 204, 166,
+			50, 130, 18, 87, 226, 80, 251, 2, 249, 247, 204, 128, 153, 139,
+			73, 222, 227, 63, 5, 175, 1, 104, 124, 165, 166, 12, 200, 163,
+			142, 23, 53, 238, 191, 101, 202, 28, 29, 76, 48, 231, 90, 118,
+			141, 101, 59, 202, 37, 32, 3, 58, 247, 25, 41, 99, 220, 207,
+			10, 246, 242, 44, 117, 24, 92, 50, 116, 237, 116, 97, 24, 146,
+			176, 224, 213, 99, 54, 125, 83, 58, 15, 34, 214, 88, 211, 136,
+			228, 167, 37, 81, 136, 211, 7, 23, 180, 107, 12, 67, 35, 54,
+			26, 28, 86, 16, 186, 207, 64, 137, 219, 169, 131, 1, 49, 201,
+			23, 80, 137, 43, 49, 217, 217, 45, 39, 112, 230, 113, 37, 142,
+			242, 14, 127, 231, 105, 240, 21, 173, 169, 237, 15, 130, 181, 142,
+			114, 91, 226, 74, 28, 109, 109, 151, 135, 209, 241, 220, 59, 30,
+			203, 50, 255, 154, 232, 73, 129, 178, 145, 6, 142, 146, 8, 134,
+			46, 14, 14, 231, 114, 83, 128, 31, 143, 175, 70, 116, 53, 106,
+			23, 99, 106, 42, 50, 96, 58, 72, 0, 39, 31, 79, 244, 161,
+			0, 227, 32, 43, 166, 249, 50, 127, 77, 48, 26, 157, 29, 202,
+			138, 114, 57, 1, 99, 6, 215, 243, 56, 124, 182, 144, 74, 76,
+			137, 105, 99, 99, 114, 20, 96, 211, 75, 150, 218, 139, 191, 247,
+			125, 135, 157, 2, 12, 156, 185, 198, 152, 247, 173, 223, 25, 67,
+			122, 155, 215, 117, 226, 188, 225, 225, 54, 213, 249, 161, 229, 230,
+			211, 189, 131, 244, 231, 127, 57, 123, 58, 151, 166, 201, 245, 178,
+			115, 119, 186, 24, 190, 5, 155, 123, 249, 234, 200, 99, 250, 3,
+			231, 198, 108, 174, 15, 30, 101, 178, 123, 111, 166, 80, 44, 119,
+			11, 171, 15, 125, 105, 177, 108, 154, 73, 29, 75, 31, 44, 100,
+			222, 149, 70, 152, 161, 134, 137, 4, 252, 176, 63, 243, 174, 52,
+			220, 7, 194, 191, 117, 20, 2, 193, 41, 193, 47, 7, 224, 7,
+			117, 190, 108, 2, 207, 74, 100, 191, 94, 111, 78, 92, 182, 4,
+			84, 70, 84, 182, 91, 153, 236, 41, 27, 244, 235, 132, 169, 165,
+			86, 203, 246, 108, 250, 157, 197, 131, 206, 200, 57, 142, 188, 21,
+			126, 222, 71, 163, 79, 174, 151, 139, 202, 19, 10, 16, 237, 148,
+			75, 111, 67, 234, 247, 200, 197, 52, 104, 231, 139, 179, 70, 238,
+			228, 61, 76, 246, 85, 30, 192, 153, 193, 173, 170, 155, 146, 255,
+			198, 100, 143, 181, 242, 237, 122, 106, 66, 6, 229, 8, 132, 77,
+			81, 140, 193, 29, 178, 201, 238, 115, 3, 116, 181, 170, 214, 106,
+			219, 219, 226, 137, 240, 187, 40, 93, 189, 154, 116, 109, 168, 201,
+			198, 241, 83, 96, 227, 223, 100, 178, 183, 124, 222, 103, 155, 143,
+			159, 100, 178, 207, 142, 162, 18, 99, 206, 189, 4, 151, 151, 47,
+			193, 64, 229, 129, 58, 237, 219, 117, 208, 92, 254, 250, 44, 70,
+			242, 119, 153, 92, 82, 101, 54, 111, 48, 151, 143, 201, 240, 40,
+			107, 48, 101, 1, 190, 4, 48, 176, 235, 39, 117, 242, 42, 185,
+			188, 70, 43, 102, 138, 131, 82, 21, 115, 197, 212, 212, 193, 18,
+			14, 98, 253, 98, 162, 3, 255, 18, 126, 94, 72, 14, 73, 181,
+			59, 93, 164, 151, 205, 57, 53, 70, 78, 118, 130, 20, 177, 47,
+			161, 117, 129, 209, 157, 142, 220, 58, 33, 187, 74, 58, 60, 93,
+			128, 184, 186, 215, 236, 113, 38, 187, 144, 220, 78, 231, 245, 110,
+			138, 209, 242, 77, 177, 162, 250, 232, 94, 111, 169, 148, 124, 151,
+			236, 46, 29, 249, 89, 35, 219, 125, 92, 246, 86, 184, 182, 169,
+			72, 57, 94, 78, 185, 49, 151, 114, 90, 160, 174, 46, 31, 160,
+			211, 118, 69, 226, 45, 151, 45, 38, 73, 29, 134, 79, 226, 139,
+			101, 195, 68, 179, 254, 109, 47, 252, 244, 250, 73, 253, 198, 83,
+			144, 250, 143, 114, 185, 168, 2, 113, 204, 226, 108, 151, 173, 174,
+			13, 73, 11, 180, 164, 230, 252, 39, 90, 138, 97, 161, 238, 101,
+			82, 19, 178, 209, 92, 41, 33, 42, 108, 243, 198, 11, 202, 123,
+			169, 58, 210, 225, 253, 250, 211, 157, 217, 98, 254, 196, 4, 53,
+			228, 95, 45, 91, 220, 63, 168, 14, 41, 142, 167, 79, 24, 59,
+			8, 254, 169, 214, 203, 6, 60, 28, 87, 127, 163, 55, 13, 76,
+			232, 122, 155, 249, 5, 44, 249, 25, 110, 208, 230, 140, 201, 21,
+			142, 70, 51, 213, 210, 114, 227, 41, 98, 55, 221, 80, 74, 86,
+			142, 100, 221, 81, 222, 121, 141, 94, 92, 146, 219, 148, 58, 249,
+			18, 226, 151, 178, 157, 40, 99, 59, 255, 122, 217, 85, 177, 37,
+			213, 87, 226, 217, 96, 100, 168, 113, 111, 88, 93, 226, 169, 192,
+			195, 42, 174, 187, 66, 242, 35, 92, 246, 85, 158, 197, 235, 200,
+			99, 87, 135, 188, 163, 73, 185, 165, 94, 82, 190, 17, 236, 243,
+			167, 174, 165, 101, 221, 27, 12, 239, 44, 145, 141, 230, 241, 207,
+			165, 58, 253, 166, 118, 150, 203, 241, 53, 181, 253, 38, 230, 150,
+			229, 162, 166, 44, 247, 74, 101, 249, 135, 152, 92, 84, 97, 248,
+			102, 57, 47, 149, 17, 63, 13, 179, 154, 43, 107, 143, 113, 12,
+			31, 41, 75, 60, 60, 234, 149, 239, 143, 184, 6, 251, 165, 153,
+			66, 49, 151, 63, 65, 59, 177, 54, 15, 47, 146, 13, 249, 116,
+			106, 106, 218, 101, 94, 253, 75, 61, 142, 56, 167, 33, 175, 147,
+			183, 185, 44, 96, 199, 108, 72, 184, 77, 38, 74, 54, 67, 21,
+			242, 25, 54, 166, 239, 19, 55, 157, 162, 204, 77, 158, 100, 82,
+			149, 55, 84, 54, 121, 86, 62, 249, 121, 121, 55, 109, 10, 189,
+			143, 68, 29, 7, 8, 170, 188, 241, 127, 180, 0, 130, 46, 124,
+			56, 182, 93, 93, 43, 91, 35, 183, 16, 170, 130, 54, 174, 116,
+			77, 49, 39, 244, 171, 110, 56, 236, 191, 74, 195, 206, 0, 171,
+			55, 28, 86, 74, 198, 212, 13, 178, 157, 78, 186, 116, 28, 234,
+			47, 255, 164, 164, 10, 141, 122, 109, 29, 53, 9, 254, 83, 29,
+			69, 227, 185, 228, 148, 160, 214, 85, 157, 135, 83, 139, 250, 155,
+			251, 212, 161, 241, 77, 105, 16, 206, 31, 10, 106, 168, 250, 96,
+			221, 122, 53, 240, 77, 43, 87, 183, 19, 60, 46, 59, 236, 182,
+			49, 211, 175, 4, 48, 92, 90, 135, 58, 28, 168, 167, 170, 237,
+			236, 93, 198, 220, 46, 29, 143, 26, 174, 209, 76, 116, 224, 186,
+			219, 245, 117, 215, 183, 125, 191, 223, 21, 178, 165, 71, 43, 181,
+			177, 70, 131, 229, 231, 48, 61, 136, 115, 78, 233, 27, 59, 144,
+			171, 100, 179, 115, 30, 83, 21, 36, 81, 249, 113, 205, 175, 97,
+			193, 39, 99, 234, 176, 108, 141, 28, 161, 42, 109, 180, 146, 51,
+			150, 158, 194, 154, 57, 235, 217, 97, 167, 101, 91, 244, 192, 161,
+			42, 124, 92, 122, 36, 209, 189, 244, 207, 93, 209, 118, 147, 149,
+			11, 44, 17, 141, 184, 43, 168, 90, 92, 70, 149, 168, 179, 117,
+			117, 213, 181, 253, 17, 176, 176, 217, 191, 84, 163, 42, 176, 112,
+			73, 189, 26, 27, 175, 114, 245, 138, 19, 181, 26, 186, 214, 68,
+			169, 82, 61, 19, 13, 235, 86, 220, 232, 164, 150, 106, 109, 116,
+			83, 135, 122, 27, 168, 167, 234, 233, 160, 38, 127, 235, 143, 152,
+			134, 77, 190, 219, 123, 19, 54, 249, 77, 216, 228, 179, 0, 155,
+			60, 16, 194, 38, 87, 198, 74, 222, 134, 255, 140, 43, 209, 27,
+			219, 142, 255, 108, 4, 176, 228, 29, 248, 207, 132, 18, 126, 108,
+			204, 184, 61, 246, 133, 110, 143, 240, 207, 117, 26, 75, 121, 89,
+			108, 138, 249, 203, 162, 80, 202, 169, 50, 32, 101, 66, 4, 94,
+			150, 232, 144, 107, 8, 16, 120, 57, 31, 245, 253, 96, 34, 93,
+			204, 103, 210, 152, 96, 48, 91, 9, 247, 17, 62, 91, 30, 95,
+			72, 37, 174, 196, 242, 206, 126, 42, 9, 37, 150, 159, 179, 85,
+			222, 100, 0, 108, 197, 234, 210, 38, 221, 129, 16, 26, 99, 36,
+			118, 151, 66, 149, 78, 3, 141, 17, 156, 22, 86, 219, 17, 2,
+			134, 245, 106, 59, 66, 240, 60, 92, 125, 206, 86, 249, 135, 204,
+			224, 196, 122, 131, 124, 104, 192, 191, 151, 57, 131, 116, 105, 101,
+			16, 85, 203, 2, 42, 193, 63, 8, 82, 135, 109, 14, 163, 209,
+			224, 205, 157, 178, 233, 225, 102, 140, 180, 147, 59, 26, 98, 189,
+			132, 205, 64, 140, 222, 213, 133, 116, 233, 37, 46, 248, 40, 33,
+			10, 152, 165, 58, 16, 115, 48, 222, 69, 37, 174, 196, 96, 247,
+			32, 149, 192, 249, 175, 125, 181, 28, 50, 144, 180, 222, 8, 223,
+			32, 252, 101, 229, 100, 119, 110, 167, 109, 195, 224, 228, 48, 18,
+			1, 158, 29, 137, 0, 207, 142, 108, 221, 43, 31, 97, 132, 60,
+			123, 62, 191, 96, 189, 255, 169, 50, 98, 185, 215, 222, 103, 155,
+			98, 174, 229, 85, 70, 54, 112, 177, 56, 223, 2, 115, 122, 92,
+			137, 243, 45, 48, 39, 248, 76, 94, 208, 190, 78, 126, 158, 128,
+			110, 189, 139, 249, 37, 235, 252, 147, 213, 89, 193, 56, 90, 133,
+			131, 1, 36, 233, 35, 179, 121, 200, 191, 15, 30, 113, 193, 254,
+			217, 25, 240, 66, 44, 56, 227, 117, 48, 163, 220, 10, 169, 201,
+			155, 64, 164, 79, 134, 238, 62, 110, 86, 250, 224, 88, 206, 106,
+			173, 161, 252, 204, 145, 21, 55, 194, 108, 97, 48, 102, 44, 52,
+			63, 240, 163, 184, 56, 130, 132, 123, 113, 4, 9, 247, 146, 246,
+			53, 242, 61, 132, 132, 59, 198, 119, 142, 248, 51, 181, 215, 174,
+			108, 138, 167, 61, 43, 51, 26, 240, 192, 24, 179, 200, 181, 0,
+			151, 59, 102, 145, 107, 1, 46, 119, 103, 251, 160, 252, 255, 24,
+			225, 229, 94, 198, 247, 156, 235, 223, 31, 89, 9, 114, 6, 49,
+			174, 109, 5, 107, 229, 26, 79, 178, 200, 144, 119, 204, 230, 97,
+			195, 78, 157, 8, 10, 52, 54, 124, 162, 8, 178, 179, 211, 135,
+			211, 249, 50, 200, 223, 195, 233, 169, 28, 184, 133, 231, 74, 81,
+			120, 0, 247, 63, 159, 62, 82, 52, 89, 252, 33, 113, 162, 46,
+			218, 137, 129, 199, 227, 101, 22, 75, 185, 145, 43, 113, 153, 197,
+			82, 6, 255, 199, 61, 237, 35, 178, 96, 32, 121, 197, 62, 190,
+			197, 239, 117, 102, 149, 10, 17, 111, 135, 229, 198, 189, 175, 99,
+			180, 39, 13, 15, 124, 242, 246, 197, 23, 80, 9, 96, 122, 213,
+			74, 42, 129, 227, 219, 250, 243, 229, 223, 16, 76, 175, 184, 134,
+			239, 245, 255, 204, 37, 187, 189, 167, 175, 182, 173, 215, 135, 207,
+			131, 225, 14, 207, 68, 195, 208, 75, 84, 201, 160, 9, 211, 171,
+			34, 5, 194, 208, 199, 234, 50, 32, 20, 1, 33, 185, 74, 247,
+			62, 128, 242, 94, 99, 213, 64, 19, 87, 226, 26, 171, 6, 154,
+			132, 18, 215, 156, 115, 169, 252, 11, 173, 6, 164, 18, 111, 231,
+			87, 250, 159, 175, 50, 243, 57, 247, 253, 142, 84, 22, 252, 135,
+			113, 81, 163, 163, 143, 112, 153, 51, 149, 18, 62, 115, 216, 44,
+			151, 143, 112, 217, 156, 187, 207, 204, 72, 50, 37, 222, 30, 239,
+			164, 18, 87, 226, 237, 93, 3, 84, 18, 74, 188, 253, 188, 61,
+			242, 6, 156, 108, 51, 120, 157, 189, 197, 127, 155, 51, 215, 136,
+			211, 82, 29, 243, 173, 115, 76, 205, 224, 224, 22, 239, 161, 18,
+			56, 184, 245, 142, 80, 73, 40, 113, 104, 203, 1, 57, 141, 99,
+			106, 1, 119, 176, 148, 127, 104, 142, 49, 17, 204, 189, 59, 178,
+			48, 166, 35, 52, 228, 192, 155, 15, 231, 52, 62, 86, 32, 231,
+			191, 27, 200, 253, 12, 186, 3, 247, 51, 171, 22, 90, 184, 18,
+			147, 86, 45, 180, 8, 37, 38, 71, 175, 151, 251, 113, 96, 173,
+			74, 28, 227, 111, 241, 119, 149, 14, 204, 58, 85, 155, 129, 185,
+			225, 30, 37, 99, 178, 215, 181, 182, 251, 86, 166, 196, 49, 75,
+			151, 86, 174, 196, 49, 75, 151, 86, 161, 196, 177, 45, 7, 228,
+			38, 29, 197, 146, 139, 229, 153, 63, 80, 37, 28, 55, 114, 63,
+			102, 162, 220, 41, 112, 37, 151, 232, 147, 151, 81, 220, 202, 141,
+			124, 171, 191, 21, 143, 48, 46, 18, 161, 179, 120, 33, 236, 65,
+			53, 164, 8, 19, 90, 210, 0, 141, 145, 191, 47, 244, 115, 99,
+			83, 43, 149, 132, 18, 55, 118, 80, 136, 7, 128, 39, 223, 168,
+			46, 138, 130, 39, 223, 216, 185, 25, 39, 198, 148, 55, 27, 123,
+			103, 205, 137, 133, 198, 144, 51, 49, 176, 236, 102, 19, 125, 114,
+			15, 121, 2, 223, 204, 183, 250, 23, 151, 77, 204, 217, 116, 14,
+			160, 131, 158, 89, 216, 174, 59, 51, 6, 129, 227, 226, 102, 158,
+			112, 49, 124, 205, 204, 180, 131, 240, 205, 102, 102, 12, 0, 48,
+			196, 205, 102, 102, 140, 102, 118, 115, 231, 102, 200, 27, 238, 113,
+			213, 240, 238, 216, 71, 25, 243, 7, 171, 76, 141, 110, 198, 200,
+			208, 11, 39, 7, 38, 222, 187, 19, 75, 229, 37, 228, 37, 120,
+			11, 223, 225, 111, 12, 174, 168, 184, 98, 131, 58, 48, 39, 25,
+			254, 82, 88, 255, 238, 204, 228, 45, 73, 51, 33, 206, 99, 14,
+			242, 174, 118, 24, 36, 228, 93, 237, 48, 8, 200, 187, 166, 148,
+			80, 226, 150, 222, 237, 56, 33, 78, 19, 186, 197, 223, 38, 95,
+			97, 132, 114, 123, 27, 227, 221, 254, 63, 106, 0, 189, 233, 212,
+			59, 51, 211, 179, 211, 213, 84, 169, 5, 164, 163, 68, 20, 5,
+			115, 20, 129, 99, 170, 201, 104, 114, 52, 125, 51, 30, 155, 82,
+			38, 8, 3, 31, 85, 52, 98, 228, 108, 214, 238, 225, 193, 0,
+			28, 93, 115, 133, 98, 176, 97, 100, 36, 218, 9, 133, 195, 147,
+			7, 234, 176, 140, 12, 13, 219, 131, 195, 229, 134, 145, 145, 145,
+			45, 218, 111, 182, 0, 33, 40, 55, 165, 161, 173, 17, 251, 253,
+			145, 92, 58, 15, 246, 74, 49, 135, 191, 155, 192, 107, 142, 16,
+			146, 183, 49, 222, 72, 69, 36, 65, 194, 194, 210, 34, 12, 112,
+			103, 151, 6, 60, 225, 0, 96, 243, 65, 0, 176, 121, 1, 156,
+			117, 225, 178, 94, 35, 1, 163, 117, 152, 14, 67, 207, 83, 22,
+			83, 50, 56, 84, 194, 6, 135, 2, 0, 232, 31, 150, 193, 62,
+			13, 49, 163, 201, 162, 73, 137, 98, 7, 151, 191, 48, 123, 184,
+			0, 44, 149, 45, 6, 51, 6, 141, 228, 90, 112, 25, 159, 73,
+			29, 203, 100, 83, 144, 219, 98, 208, 201, 100, 51, 147, 202, 167,
+			166, 33, 231, 116, 8, 217, 1, 187, 161, 188, 107, 8, 173, 135,
+			180, 220, 128, 212, 6, 221, 192, 80, 96, 113, 28, 28, 57, 248,
+			57, 156, 152, 37, 19, 64, 120, 124, 144, 128, 99, 56, 114, 202,
+			7, 9, 56, 134, 195, 185, 196, 251, 32, 0, 199, 252, 119, 77,
+			38, 192, 196, 96, 124, 192, 127, 146, 5, 187, 44, 202, 173, 209,
+			137, 164, 18, 201, 42, 208, 204, 0, 86, 65, 33, 141, 217, 223,
+			211, 54, 213, 61, 120, 246, 89, 220, 61, 13, 74, 81, 152, 157,
+			158, 78, 229, 79, 28, 188, 161, 56, 61, 133, 242, 190, 152, 58,
+			6, 26, 60, 45, 33, 255, 20, 154, 15, 132, 240, 104, 196, 2,
+			236, 125, 195, 70, 169, 169, 155, 83, 39, 0, 30, 57, 52, 53,
+			210, 152, 188, 227, 104, 144, 132, 106, 73, 202, 146, 110, 193, 239,
+			1, 20, 6, 248, 185, 112, 220, 82, 66, 0, 28, 8, 33, 243,
+			114, 56, 235, 123, 119, 177, 190, 85, 84, 196, 169, 247, 175, 37,
+			224, 226, 123, 88, 236, 247, 45, 222, 166, 49, 131, 230, 22, 18,
+			4, 92, 124, 15, 75, 44, 67, 41, 129, 192, 197, 247, 50, 190,
+			198, 223, 16, 58, 75, 211, 22, 177, 65, 60, 118, 63, 57, 226,
+			195, 140, 91, 32, 194, 192, 189, 4, 141, 165, 17, 142, 239, 37,
+			104, 44, 1, 82, 195, 187, 151, 160, 177, 52, 194, 241, 189, 108,
+			213, 106, 249, 18, 35, 4, 227, 79, 48, 190, 196, 255, 14, 240,
+			189, 97, 121, 237, 127, 110, 226, 113, 192, 155, 26, 210, 188, 28,
+			10, 95, 176, 14, 149, 113, 53, 188, 101, 25, 126, 134, 53, 143,
+			134, 155, 228, 166, 51, 197, 162, 129, 134, 205, 167, 117, 186, 252,
+			108, 174, 116, 39, 20, 74, 226, 201, 44, 248, 15, 53, 25, 81,
+			118, 102, 57, 109, 122, 139, 65, 237, 168, 79, 132, 3, 57, 5,
+			137, 50, 82, 249, 0, 160, 107, 242, 150, 86, 32, 20, 62, 65,
+			220, 46, 80, 40, 124, 130, 53, 245, 82, 81, 192, 95, 23, 247,
+			17, 250, 242, 167, 88, 236, 193, 26, 232, 203, 70, 197, 57, 167,
+			87, 103, 149, 1, 125, 249, 83, 128, 190, 124, 13, 161, 47, 159,
+			100, 92, 249, 151, 86, 214, 116, 206, 9, 174, 162, 186, 115, 250,
+			32, 157, 103, 48, 151, 27, 176, 97, 23, 160, 249, 100, 20, 160,
+			249, 36, 0, 52, 95, 162, 241, 151, 63, 205, 98, 127, 194, 152,
+			191, 97, 14, 197, 230, 244, 230, 50, 110, 3, 83, 222, 167, 89,
+			98, 133, 220, 70, 200, 203, 15, 177, 121, 234, 55, 131, 98, 220,
+			128, 77, 184, 144, 199, 15, 145, 236, 209, 144, 199, 15, 49, 131,
+			124, 2, 24, 199, 80, 236, 221, 30, 133, 60, 126, 8, 64, 171,
+			94, 179, 144, 199, 143, 128, 146, 123, 177, 166, 146, 139, 28, 150,
+			207, 168, 166, 139, 244, 116, 102, 213, 157, 70, 76, 126, 132, 212,
+			157, 70, 76, 126, 132, 212, 157, 70, 76, 126, 132, 117, 118, 201,
+			247, 113, 66, 76, 126, 28, 212, 221, 171, 167, 174, 238, 92, 230,
+			120, 35, 116, 94, 164, 127, 137, 154, 111, 30, 138, 175, 1, 21,
+			223, 227, 33, 243, 129, 226, 123, 60, 100, 62, 80, 124, 143, 3,
+			243, 1, 132, 96, 92, 197, 255, 148, 197, 190, 110, 33, 4, 107,
+			136, 251, 42, 91, 7, 48, 150, 255, 20, 32, 4, 199, 8, 99,
+			249, 9, 198, 55, 248, 155, 66, 153, 31, 97, 150, 122, 4, 127,
+			28, 5, 255, 19, 36, 248, 227, 40, 248, 159, 96, 6, 206, 74,
+			99, 46, 63, 193, 12, 156, 85, 28, 119, 211, 19, 108, 253, 136,
+			22, 252, 8, 169, 252, 181, 95, 27, 193, 31, 199, 237, 241, 53,
+			90, 109, 141, 154, 252, 53, 18, 252, 113, 220, 30, 95, 99, 139,
+			251, 80, 185, 55, 170, 248, 55, 88, 236, 127, 90, 229, 94, 46,
+			39, 203, 174, 122, 195, 133, 6, 76, 216, 111, 128, 114, 255, 1,
+			35, 80, 216, 167, 24, 79, 250, 255, 53, 188, 151, 136, 234, 120,
+			107, 176, 100, 178, 229, 24, 227, 131, 85, 238, 20, 164, 14, 67,
+			117, 142, 120, 212, 140, 155, 211, 125, 45, 88, 151, 251, 145, 131,
+			78, 192, 51, 136, 205, 216, 228, 212, 1, 130, 194, 227, 208, 77,
+			169, 41, 88, 239, 98, 46, 192, 123, 74, 168, 158, 203, 186, 53,
+			193, 96, 74, 89, 176, 115, 188, 204, 3, 19, 207, 160, 132, 2,
+			43, 62, 69, 172, 168, 81, 108, 159, 34, 232, 114, 141, 98, 251,
+			20, 83, 75, 28, 20, 219, 167, 0, 203, 254, 50, 194, 168, 125,
+			26, 50, 112, 92, 20, 140, 70, 142, 125, 37, 230, 164, 14, 196,
+			44, 192, 149, 225, 81, 128, 68, 206, 20, 34, 199, 114, 221, 52,
+			139, 43, 239, 105, 198, 187, 76, 79, 176, 204, 79, 179, 238, 101,
+			84, 20, 80, 76, 174, 208, 167, 162, 70, 48, 250, 159, 253, 181,
+			58, 21, 53, 162, 212, 123, 150, 212, 132, 6, 123, 125, 150, 212,
+			132, 6, 123, 125, 22, 212, 196, 207, 52, 129, 132, 242, 190, 11,
+			106, 226, 135, 245, 171, 137, 210, 189, 113, 54, 85, 68, 121, 223,
+			243, 61, 23, 53, 114, 209, 128, 115, 39, 84, 92, 48, 218, 191,
+			75, 234, 161, 17, 79, 3, 223, 181, 231, 162, 70, 64, 238, 127,
+			254, 87, 243, 92, 212, 8, 234, 207, 123, 158, 206, 69, 141, 152,
+			253, 233, 121, 58, 23, 53, 66, 18, 23, 239, 121, 214, 191, 86,
+			94, 164, 241, 129, 127, 200, 98, 255, 202, 8, 37, 177, 130, 162,
+			44, 93, 35, 71, 73, 2, 50, 240, 15, 89, 34, 128, 224, 100,
+			47, 1, 74, 242, 37, 56, 24, 237, 11, 46, 7, 245, 158, 158,
+			140, 236, 148, 97, 25, 92, 9, 41, 40, 117, 46, 224, 178, 59,
+			237, 96, 124, 108, 144, 238, 15, 77, 200, 61, 124, 22, 140, 143,
+			153, 121, 105, 72, 225, 151, 72, 102, 105, 72, 225, 151, 162, 144,
+			194, 47, 209, 185, 73, 67, 10, 191, 4, 231, 166, 207, 192, 206,
+			72, 192, 190, 121, 21, 212, 231, 125, 255, 137, 213, 167, 25, 58,
+			168, 190, 87, 137, 147, 19, 168, 250, 94, 37, 213, 151, 64, 153,
+			248, 42, 168, 62, 131, 149, 252, 99, 22, 187, 147, 87, 199, 74,
+			182, 203, 87, 197, 208, 1, 172, 228, 31, 131, 161, 131, 105, 233,
+			154, 96, 17, 127, 206, 120, 210, 127, 174, 84, 255, 69, 236, 157,
+			250, 149, 160, 116, 180, 224, 127, 46, 37, 216, 132, 12, 245, 115,
+			98, 40, 13, 241, 252, 115, 82, 130, 26, 226, 249, 231, 164, 4,
+			53, 196, 243, 207, 65, 9, 94, 69, 8, 206, 255, 193, 248, 123,
+			249, 6, 127, 52, 24, 45, 163, 208, 60, 116, 97, 19, 98, 215,
+			254, 7, 227, 62, 21, 153, 242, 254, 131, 45, 94, 73, 69, 1,
+			197, 53, 253, 84, 76, 40, 239, 189, 188, 20, 226, 249, 189, 60,
+			49, 44, 95, 179, 16, 207, 183, 243, 95, 207, 211, 149, 70, 137,
+			190, 157, 27, 181, 169, 81, 162, 111, 231, 70, 109, 54, 161, 218,
+			188, 157, 119, 118, 201, 223, 132, 211, 85, 19, 20, 63, 12, 56,
+			192, 63, 154, 135, 218, 124, 163, 142, 87, 149, 7, 48, 239, 243,
+			85, 19, 42, 208, 15, 19, 140, 107, 19, 94, 167, 125, 152, 176,
+			129, 155, 80, 129, 126, 24, 96, 77, 71, 53, 176, 246, 93, 60,
+			246, 255, 114, 74, 238, 80, 75, 109, 84, 145, 59, 128, 169, 125,
+			23, 79, 172, 146, 239, 38, 76, 237, 187, 1, 21, 120, 186, 202,
+			1, 171, 218, 235, 206, 169, 233, 20, 167, 201, 80, 177, 72, 148,
+			3, 119, 19, 228, 167, 68, 197, 114, 55, 193, 12, 107, 104, 238,
+			187, 9, 102, 88, 67, 115, 223, 13, 48, 195, 159, 177, 200, 219,
+			247, 241, 95, 6, 197, 34, 241, 76, 117, 31, 173, 176, 68, 197,
+			114, 31, 55, 138, 69, 162, 98, 185, 143, 47, 238, 195, 220, 95,
+			205, 42, 254, 9, 30, 123, 144, 87, 207, 253, 85, 213, 3, 214,
+			89, 228, 102, 166, 188, 79, 240, 68, 191, 124, 149, 17, 246, 243,
+			73, 192, 95, 253, 39, 71, 185, 68, 157, 10, 28, 139, 33, 234,
+			32, 80, 162, 98, 170, 61, 222, 158, 65, 21, 99, 136, 112, 106,
+			74, 70, 99, 88, 159, 36, 230, 106, 70, 37, 115, 146, 27, 37,
+			163, 49, 172, 79, 114, 163, 100, 154, 145, 185, 78, 242, 96, 185,
+			220, 169, 33, 172, 63, 205, 99, 127, 196, 1, 82, 175, 62, 227,
+			172, 226, 34, 180, 192, 45, 32, 79, 172, 5, 95, 37, 192, 99,
+			86, 222, 67, 156, 47, 243, 151, 5, 7, 106, 120, 103, 152, 225,
+			107, 112, 231, 135, 72, 144, 182, 224, 120, 31, 226, 9, 159, 138,
+			144, 216, 139, 47, 89, 138, 47, 140, 173, 42, 254, 57, 30, 123,
+			132, 51, 191, 191, 10, 207, 56, 254, 206, 206, 0, 1, 191, 248,
+			115, 208, 230, 110, 130, 47, 126, 152, 243, 173, 254, 133, 101, 55,
+			175, 244, 202, 95, 118, 227, 74, 141, 186, 87, 173, 173, 56, 244,
+			135, 137, 221, 53, 106, 240, 195, 220, 92, 181, 182, 34, 169, 31,
+			230, 38, 23, 94, 43, 222, 86, 62, 204, 77, 46, 188, 86, 202,
+			133, 247, 48, 239, 220, 44, 47, 208, 176, 194, 95, 224, 177, 191,
+			228, 213, 223, 79, 35, 238, 212, 206, 236, 0, 130, 248, 11, 60,
+			209, 39, 103, 9, 130, 248, 49, 0, 254, 62, 22, 185, 132, 213,
+			162, 203, 92, 192, 70, 220, 0, 130, 254, 10, 111, 195, 107, 17,
+			119, 44, 226, 216, 167, 235, 133, 172, 160, 235, 25, 90, 180, 33,
+			45, 30, 35, 90, 104, 24, 227, 199, 8, 163, 91, 195, 24, 63,
+			70, 24, 221, 109, 72, 139, 199, 8, 163, 187, 141, 48, 186, 31,
+			3, 140, 238, 255, 5, 27, 185, 13, 132, 222, 19, 96, 91, 252,
+			125, 53, 219, 34, 116, 200, 56, 83, 54, 69, 216, 195, 153, 181,
+			37, 218, 80, 108, 62, 65, 91, 160, 13, 197, 230, 19, 100, 75,
+			180, 161, 216, 124, 2, 108, 9, 204, 224, 211, 6, 102, 215, 215,
+			193, 150, 248, 126, 253, 182, 68, 132, 117, 206, 166, 13, 81, 210,
+			241, 124, 15, 223, 109, 104, 110, 125, 61, 100, 47, 224, 143, 175,
+			147, 237, 208, 134, 230, 214, 215, 193, 118, 128, 215, 250, 118, 21,
+			255, 107, 30, 123, 134, 51, 127, 93, 53, 169, 86, 109, 43, 181,
+			51, 229, 253, 53, 79, 44, 145, 23, 72, 207, 107, 135, 173, 244,
+			36, 104, 147, 1, 92, 238, 144, 31, 42, 92, 198, 234, 253, 101,
+			134, 219, 142, 50, 249, 73, 146, 201, 237, 168, 240, 159, 36, 140,
+			239, 118, 220, 28, 79, 18, 198, 119, 59, 110, 142, 39, 65, 38,
+			227, 69, 108, 59, 204, 237, 105, 254, 235, 114, 17, 219, 142, 220,
+			255, 52, 45, 109, 59, 114, 255, 211, 100, 52, 180, 35, 247, 63,
+			13, 70, 3, 44, 109, 135, 138, 255, 29, 143, 189, 100, 151, 182,
+			92, 72, 150, 184, 143, 133, 75, 219, 193, 148, 247, 119, 176, 180,
+			248, 222, 209, 1, 107, 251, 28, 172, 237, 171, 142, 165, 16, 174,
+			112, 253, 199, 207, 83, 190, 131, 197, 187, 7, 168, 103, 60, 227,
+			74, 110, 49, 206, 218, 233, 180, 3, 153, 244, 57, 98, 210, 14,
+			148, 224, 207, 145, 225, 208, 129, 76, 250, 28, 25, 14, 29, 200,
+			164, 207, 1, 147, 94, 138, 228, 99, 202, 251, 14, 144, 111, 115,
+			36, 191, 255, 169, 31, 74, 59, 240, 80, 250, 29, 206, 23, 82,
+			17, 91, 54, 123, 163, 3, 151, 255, 59, 208, 45, 234, 133, 14,
+			16, 126, 63, 248, 53, 209, 11, 29, 40, 244, 126, 64, 122, 161,
+			3, 207, 152, 63, 32, 189, 208, 129, 66, 239, 7, 160, 23, 254,
+			77, 147, 70, 40, 239, 69, 208, 11, 207, 159, 226, 25, 243, 13,
+			81, 12, 165, 61, 207, 87, 51, 116, 224, 169, 242, 69, 18, 31,
+			29, 120, 170, 124, 145, 52, 67, 7, 158, 42, 95, 228, 38, 75,
+			253, 2, 21, 255, 23, 30, 251, 63, 156, 28, 185, 42, 104, 134,
+			232, 184, 28, 213, 176, 128, 41, 239, 95, 120, 98, 169, 252, 20,
+			80, 123, 1, 200, 143, 31, 193, 6, 248, 40, 179, 151, 145, 150,
+			49, 32, 235, 73, 38, 95, 40, 214, 204, 153, 162, 51, 129, 86,
+			79, 140, 34, 131, 220, 169, 156, 60, 233, 187, 240, 216, 185, 0,
+			55, 248, 143, 104, 131, 47, 64, 45, 244, 35, 210, 66, 11, 112,
+			131, 255, 136, 180, 208, 2, 220, 224, 63, 130, 157, 134, 199, 206,
+			5, 192, 108, 63, 251, 165, 56, 118, 46, 64, 13, 242, 51, 98,
+			129, 5, 168, 65, 126, 70, 26, 100, 1, 138, 144, 159, 129, 6,
+			25, 151, 220, 83, 42, 254, 239, 60, 246, 199, 130, 249, 91, 106,
+			105, 16, 55, 210, 206, 36, 186, 188, 2, 14, 208, 153, 201, 205,
+			193, 133, 134, 35, 20, 83, 222, 191, 243, 68, 32, 159, 7, 130,
+			41, 224, 136, 219, 4, 79, 250, 255, 205, 209, 40, 81, 127, 215,
+			51, 168, 85, 206, 154, 218, 80, 200, 85, 183, 9, 195, 85, 10,
+			213, 198, 109, 194, 168, 13, 133, 92, 117, 155, 48, 106, 67, 33,
+			87, 221, 38, 130, 229, 114, 11, 210, 136, 41, 239, 118, 193, 87,
+			225, 59, 170, 75, 155, 58, 52, 133, 66, 77, 113, 187, 224, 221,
+			84, 196, 198, 122, 2, 42, 194, 149, 155, 88, 177, 82, 126, 21,
+			20, 188, 2, 77, 113, 167, 224, 139, 252, 47, 240, 42, 154, 194,
+			213, 186, 64, 12, 247, 77, 4, 163, 238, 42, 32, 236, 70, 127,
+			68, 236, 202, 136, 145, 99, 52, 73, 90, 199, 147, 145, 79, 252,
+			116, 42, 147, 133, 69, 49, 181, 76, 166, 88, 48, 150, 162, 202,
+			163, 186, 214, 177, 29, 144, 202, 168, 53, 210, 170, 74, 168, 92,
+			7, 85, 81, 65, 150, 228, 160, 129, 238, 20, 70, 3, 41, 212,
+			64, 119, 138, 68, 39, 21, 5, 252, 181, 167, 87, 63, 14, 42,
+			80, 72, 247, 8, 222, 237, 255, 176, 154, 114, 118, 199, 121, 230,
+			20, 116, 180, 151, 250, 149, 244, 96, 37, 53, 61, 88, 85, 81,
+			15, 134, 170, 90, 161, 22, 186, 39, 36, 20, 104, 161, 123, 132,
+			81, 213, 10, 181, 208, 61, 162, 179, 75, 223, 156, 43, 120, 28,
+			188, 95, 240, 94, 255, 197, 83, 84, 213, 174, 88, 58, 235, 218,
+			58, 218, 249, 124, 21, 182, 2, 183, 51, 239, 126, 97, 164, 181,
+			194, 215, 195, 251, 133, 81, 216, 10, 95, 15, 239, 23, 221, 61,
+			242, 243, 128, 31, 174, 128, 1, 63, 39, 248, 128, 127, 82, 188,
+			222, 239, 168, 48, 109, 84, 69, 248, 120, 106, 119, 20, 49, 227,
+			209, 220, 212, 84, 238, 102, 216, 182, 166, 82, 38, 75, 187, 112,
+			120, 192, 68, 47, 107, 198, 184, 98, 231, 53, 59, 39, 34, 155,
+			18, 159, 84, 1, 248, 89, 134, 71, 196, 205, 50, 24, 8, 12,
+			224, 9, 252, 211, 48, 232, 65, 136, 98, 112, 202, 182, 22, 5,
+			35, 192, 104, 114, 133, 52, 241, 165, 81, 190, 71, 115, 179, 89,
+			210, 35, 100, 29, 184, 124, 31, 228, 14, 67, 212, 66, 161, 234,
+			108, 112, 228, 163, 123, 175, 29, 189, 110, 127, 229, 161, 7, 87,
+			92, 121, 160, 254, 209, 27, 40, 98, 179, 136, 13, 113, 92, 181,
+			197, 84, 132, 251, 55, 97, 30, 136, 21, 196, 176, 121, 159, 19,
+			253, 107, 209, 169, 114, 161, 138, 63, 42, 98, 95, 19, 228, 84,
+			89, 205, 38, 115, 185, 207, 49, 203, 22, 66, 186, 44, 145, 88,
+			46, 15, 73, 207, 91, 8, 58, 248, 139, 130, 175, 245, 39, 162,
+			47, 196, 102, 180, 133, 232, 117, 190, 211, 36, 220, 107, 206, 130,
+			81, 70, 201, 186, 209, 58, 139, 76, 18, 7, 191, 16, 181, 223,
+			23, 73, 251, 45, 68, 155, 234, 139, 162, 185, 155, 138, 12, 6,
+			208, 179, 146, 138, 2, 138, 107, 250, 181, 77, 181, 16, 196, 231,
+			151, 197, 47, 131, 77, 181, 16, 109, 170, 47, 211, 46, 93, 136,
+			202, 246, 203, 194, 216, 84, 11, 81, 217, 126, 89, 44, 238, 147,
+			255, 0, 187, 116, 33, 40, 219, 175, 10, 190, 194, 127, 70, 195,
+			252, 87, 2, 161, 55, 89, 203, 73, 12, 151, 44, 12, 126, 70,
+			95, 192, 118, 60, 158, 62, 1, 1, 63, 135, 33, 68, 15, 108,
+			92, 77, 166, 20, 112, 49, 94, 128, 79, 27, 149, 125, 36, 159,
+			43, 20, 134, 242, 144, 105, 37, 173, 99, 47, 65, 114, 58, 75,
+			75, 224, 255, 7, 51, 147, 180, 25, 220, 126, 236, 120, 192, 200,
+			66, 82, 78, 167, 102, 40, 236, 12, 116, 86, 104, 149, 101, 109,
+			146, 66, 211, 52, 102, 37, 72, 221, 148, 203, 192, 195, 207, 145,
+			116, 1, 66, 169, 66, 22, 6, 236, 161, 225, 96, 103, 234, 200,
+			13, 134, 20, 150, 169, 143, 80, 98, 129, 217, 25, 108, 1, 142,
+			161, 50, 56, 158, 153, 202, 29, 62, 81, 4, 245, 12, 78, 29,
+			184, 124, 48, 194, 217, 194, 108, 106, 106, 10, 240, 4, 12, 246,
+			248, 13, 16, 96, 24, 220, 144, 202, 78, 30, 157, 157, 210, 67,
+			60, 138, 211, 47, 18, 205, 37, 17, 61, 147, 141, 94, 246, 98,
+			122, 207, 201, 244, 228, 236, 204, 20, 156, 194, 205, 123, 215, 116,
+			128, 157, 229, 211, 147, 179, 64, 150, 232, 12, 136, 33, 32, 216,
+			244, 171, 100, 125, 45, 68, 83, 224, 171, 162, 103, 41, 21, 5,
+			252, 117, 121, 18, 253, 138, 59, 85, 252, 175, 68, 236, 31, 68,
+			117, 191, 226, 74, 136, 22, 206, 174, 238, 100, 202, 251, 43, 145,
+			88, 33, 219, 164, 231, 117, 138, 152, 138, 127, 67, 240, 111, 11,
+			129, 157, 117, 10, 176, 49, 191, 33, 228, 50, 249, 91, 76, 198,
+			225, 239, 176, 239, 159, 20, 222, 118, 127, 22, 215, 247, 84, 51,
+			249, 88, 8, 1, 157, 230, 20, 114, 97, 72, 247, 166, 219, 72,
+			5, 20, 70, 71, 103, 167, 166, 130, 201, 220, 145, 89, 168, 72,
+			222, 151, 237, 178, 81, 15, 163, 1, 199, 225, 252, 0, 55, 125,
+			162, 89, 133, 63, 192, 93, 159, 232, 234, 14, 127, 72, 192, 15,
+			61, 163, 82, 73, 105, 126, 192, 203, 240, 39, 197, 162, 75, 228,
+			111, 152, 233, 49, 229, 125, 75, 120, 187, 253, 183, 86, 204, 166,
+			83, 97, 248, 238, 232, 77, 53, 84, 52, 118, 10, 178, 234, 28,
+			96, 227, 127, 203, 157, 3, 108, 253, 111, 137, 230, 158, 240, 7,
+			1, 63, 248, 139, 195, 31, 18, 240, 67, 223, 46, 59, 7, 227,
+			44, 240, 45, 177, 116, 76, 238, 132, 37, 132, 5, 250, 91, 33,
+			22, 249, 231, 87, 113, 13, 215, 166, 131, 93, 35, 51, 102, 210,
+			204, 134, 9, 59, 145, 192, 127, 43, 68, 19, 21, 25, 52, 43,
+			59, 169, 40, 160, 216, 211, 43, 31, 3, 113, 219, 9, 44, 250,
+			172, 224, 231, 248, 159, 6, 113, 59, 149, 41, 20, 173, 13, 74,
+			33, 132, 166, 31, 19, 74, 56, 72, 44, 115, 130, 30, 130, 13,
+			239, 26, 113, 37, 169, 126, 97, 56, 0, 36, 49, 144, 183, 104,
+			232, 195, 223, 49, 209, 228, 112, 48, 154, 61, 97, 57, 254, 168,
+			77, 23, 0, 91, 246, 188, 145, 17, 251, 189, 57, 2, 24, 35,
+			23, 82, 102, 228, 243, 57, 186, 18, 237, 196, 156, 195, 207, 146,
+			146, 233, 196, 131, 207, 179, 194, 100, 167, 236, 68, 89, 252, 172,
+			88, 62, 76, 69, 1, 211, 220, 176, 81, 31, 124, 58, 65, 22,
+			127, 239, 205, 131, 207, 105, 29, 124, 58, 241, 234, 237, 123, 100,
+			207, 119, 162, 180, 251, 30, 29, 124, 58, 81, 218, 125, 79, 244,
+			244, 162, 139, 84, 151, 138, 127, 95, 196, 94, 21, 53, 124, 193,
+			231, 16, 119, 93, 76, 121, 223, 23, 137, 149, 242, 25, 224, 219,
+			46, 216, 44, 47, 128, 21, 243, 85, 22, 28, 112, 38, 88, 226,
+			164, 96, 184, 172, 160, 121, 206, 214, 193, 141, 4, 87, 41, 144,
+			42, 66, 155, 137, 232, 27, 104, 12, 131, 240, 241, 130, 70, 58,
+			28, 236, 152, 202, 164, 225, 168, 164, 51, 222, 192, 119, 249, 116,
+			168, 240, 240, 26, 10, 54, 4, 176, 14, 38, 172, 65, 19, 114,
+			16, 236, 148, 108, 14, 140, 207, 98, 230, 200, 236, 84, 42, 175,
+			47, 172, 128, 228, 199, 102, 83, 249, 84, 182, 152, 182, 89, 79,
+			187, 208, 110, 122, 129, 88, 186, 11, 237, 166, 23, 200, 110, 234,
+			194, 141, 252, 2, 217, 77, 93, 184, 145, 95, 16, 107, 250, 181,
+			121, 209, 5, 212, 127, 229, 77, 243, 226, 87, 216, 188, 232, 66,
+			25, 247, 10, 153, 23, 93, 40, 227, 94, 33, 243, 162, 11, 101,
+			220, 43, 98, 121, 18, 125, 74, 187, 85, 252, 199, 34, 246, 219,
+			30, 249, 148, 214, 184, 195, 35, 244, 42, 103, 179, 117, 131, 63,
+			162, 72, 4, 152, 18, 174, 27, 246, 218, 79, 5, 191, 216, 239,
+			11, 193, 160, 246, 233, 136, 111, 123, 65, 102, 198, 216, 141, 218,
+			231, 167, 100, 19, 119, 35, 211, 254, 84, 52, 117, 80, 81, 64,
+			83, 11, 59, 169, 152, 128, 98, 215, 86, 124, 239, 239, 166, 247,
+			254, 159, 138, 238, 139, 48, 204, 175, 27, 152, 250, 53, 193, 87,
+			251, 27, 130, 93, 8, 23, 130, 178, 56, 53, 51, 51, 117, 130,
+			32, 43, 162, 204, 76, 7, 66, 59, 28, 32, 217, 107, 130, 247,
+			80, 17, 27, 236, 93, 78, 69, 1, 197, 149, 171, 228, 109, 160,
+			22, 186, 65, 45, 220, 234, 241, 110, 255, 223, 106, 94, 206, 80,
+			39, 65, 58, 11, 39, 142, 51, 118, 73, 51, 82, 165, 59, 18,
+			203, 115, 223, 214, 140, 236, 41, 151, 234, 123, 170, 136, 245, 61,
+			150, 102, 32, 215, 111, 245, 140, 92, 239, 70, 185, 126, 171, 103,
+			238, 105, 186, 81, 174, 223, 234, 209, 61, 77, 55, 20, 63, 224,
+			205, 243, 158, 134, 88, 239, 13, 185, 167, 9, 59, 159, 239, 61,
+			77, 55, 94, 105, 125, 192, 179, 220, 14, 87, 90, 31, 240, 204,
+			61, 77, 55, 94, 105, 125, 192, 235, 238, 193, 67, 124, 143, 138,
+			127, 200, 139, 221, 237, 213, 115, 136, 175, 176, 37, 123, 152, 242,
+			62, 228, 37, 150, 203, 181, 210, 243, 122, 96, 75, 222, 233, 241,
+			245, 254, 98, 179, 244, 225, 65, 158, 190, 53, 99, 236, 65, 173,
+			114, 167, 103, 180, 74, 15, 106, 149, 59, 189, 230, 37, 84, 132,
+			235, 74, 111, 233, 0, 21, 225, 186, 210, 27, 26, 214, 167, 241,
+			30, 88, 251, 143, 121, 191, 12, 167, 241, 30, 60, 141, 127, 140,
+			214, 162, 7, 165, 227, 199, 60, 115, 26, 239, 193, 173, 254, 49,
+			111, 113, 95, 152, 69, 117, 171, 188, 91, 131, 160, 180, 41, 113,
+			156, 95, 227, 223, 30, 190, 73, 224, 233, 57, 184, 65, 99, 242,
+			57, 153, 208, 64, 150, 7, 8, 176, 59, 24, 100, 134, 211, 195,
+			152, 190, 145, 172, 47, 253, 176, 43, 29, 81, 111, 19, 231, 153,
+			79, 112, 99, 195, 182, 200, 130, 11, 64, 30, 48, 39, 142, 229,
+			115, 179, 51, 90, 53, 27, 69, 60, 44, 49, 158, 170, 87, 197,
+			239, 245, 98, 15, 123, 117, 196, 83, 25, 64, 64, 195, 44, 144,
+			107, 169, 23, 248, 227, 62, 143, 95, 236, 175, 14, 93, 57, 75,
+			23, 37, 156, 222, 176, 148, 215, 227, 87, 224, 41, 232, 241, 139,
+			252, 43, 241, 43, 28, 117, 249, 49, 164, 80, 181, 165, 140, 137,
+			94, 149, 65, 146, 192, 8, 55, 31, 201, 64, 228, 234, 1, 108,
+			158, 43, 239, 15, 60, 238, 251, 187, 128, 9, 240, 250, 17, 51,
+			102, 25, 67, 139, 176, 175, 220, 44, 143, 6, 248, 47, 83, 192,
+			211, 71, 196, 90, 24, 150, 218, 161, 177, 23, 36, 208, 167, 65,
+			106, 255, 83, 77, 169, 77, 61, 156, 89, 97, 77, 189, 156, 57,
+			25, 141, 239, 8, 189, 112, 61, 254, 199, 222, 60, 131, 140, 12,
+			195, 188, 33, 82, 215, 246, 61, 31, 161, 11, 150, 205, 34, 21,
+			255, 188, 23, 251, 51, 107, 217, 212, 146, 163, 209, 157, 241, 93,
+			160, 220, 34, 216, 26, 127, 226, 241, 117, 254, 183, 53, 187, 132,
+			219, 192, 229, 60, 203, 137, 200, 59, 200, 134, 180, 180, 25, 195,
+			20, 32, 149, 6, 35, 47, 208, 196, 181, 192, 171, 96, 232, 142,
+			90, 62, 118, 28, 123, 50, 217, 160, 0, 57, 229, 82, 83, 56,
+			55, 76, 53, 23, 17, 34, 41, 252, 29, 121, 61, 55, 53, 153,
+			206, 163, 103, 107, 182, 130, 168, 9, 151, 22, 62, 40, 12, 75,
+			45, 180, 23, 129, 208, 126, 226, 63, 189, 208, 62, 71, 114, 207,
+			87, 241, 255, 226, 197, 158, 242, 152, 191, 170, 116, 45, 82, 150,
+			118, 86, 252, 14, 75, 185, 82, 122, 158, 15, 43, 248, 53, 144,
+			35, 221, 149, 19, 39, 203, 117, 88, 11, 238, 199, 64, 44, 44,
+			41, 77, 0, 29, 173, 252, 151, 64, 51, 31, 76, 191, 191, 241,
+			120, 210, 127, 140, 25, 217, 103, 177, 248, 156, 234, 21, 68, 56,
+			172, 243, 149, 36, 197, 192, 221, 115, 208, 124, 139, 87, 228, 148,
+			143, 111, 208, 92, 254, 227, 255, 243, 197, 131, 72, 15, 56, 99,
+			76, 206, 230, 205, 115, 178, 121, 115, 128, 101, 47, 164, 139, 26,
+			232, 43, 10, 7, 84, 186, 64, 122, 199, 233, 239, 134, 229, 225,
+			248, 76, 62, 87, 204, 157, 243, 127, 7, 0, 88, 152, 125, 241,
+			158, 190, 2, 0},
 	)
 }
 
@@ -234,6 +234,26 @@ func (mr *MockResultDBClientMockRecorder) QueryTestExonerations(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTestExonerations", reflect.TypeOf((*MockResultDBClient)(nil).QueryTestExonerations), varargs...)
 }
 
+// QueryTestHistory mocks base method.
+func (m *MockResultDBClient) QueryTestHistory(ctx context.Context, in *QueryTestHistoryRequest, opts ...grpc.CallOption) (*QueryTestHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QueryTestHistory", varargs...)
+	ret0, _ := ret[0].(*QueryTestHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTestHistory indicates an expected call of QueryTestHistory.
+func (mr *MockResultDBClientMockRecorder) QueryTestHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTestHistory", reflect.TypeOf((*MockResultDBClient)(nil).QueryTestHistory), varargs...)
+}
+
 // QueryTestMetadata mocks base method.
 func (m *MockResultDBClient) QueryTestMetadata(ctx context.Context, in *QueryTestMetadataRequest, opts ...grpc.CallOption) (*QueryTestMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -487,6 +507,21 @@ func (mr *MockResultDBServerMockRecorder) QueryTestExonerations(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTestExonerations", reflect.TypeOf((*MockResultDBServer)(nil).QueryTestExonerations), arg0, arg1)
 }
 
+// QueryTestHistory mocks base method.
+func (m *MockResultDBServer) QueryTestHistory(arg0 context.Context, arg1 *QueryTestHistoryRequest) (*QueryTestHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTestHistory", arg0, arg1)
+	ret0, _ := ret[0].(*QueryTestHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTestHistory indicates an expected call of QueryTestHistory.
+func (mr *MockResultDBServerMockRecorder) QueryTestHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTestHistory", reflect.TypeOf((*MockResultDBServer)(nil).QueryTestHistory), arg0, arg1)
+}
+
 // QueryTestMetadata mocks base method.
 func (m *MockResultDBServer) QueryTestMetadata(arg0 context.Context, arg1 *QueryTestMetadataRequest) (*QueryTestMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -260,3 +260,20 @@ func (s *DecoratedResultDB) QueryTestMetadata(ctx context.Context, req *QueryTes
 	}
 	return
 }
+
+func (s *DecoratedResultDB) QueryTestHistory(ctx context.Context, req *QueryTestHistoryRequest) (rsp *QueryTestHistoryResponse, err error) {
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "QueryTestHistory", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		rsp, err = s.Service.QueryTestHistory(ctx, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "QueryTestHistory", rsp, err)
+	}
+	return
+}
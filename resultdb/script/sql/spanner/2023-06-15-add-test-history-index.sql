@@ -0,0 +1,13 @@
+-- Schema update script to add Realm to TestResults and the
+-- TestResultsByTestIdAndRealm index, used by the QueryTestHistory RPC.
+--
+-- The test results created before this update have no realm, so they are not
+-- part of the test history.
+--
+-- Last statement intentionally does not end with ';' otherwise it won't work
+-- when passed in through ddl update --ddl-file.
+ALTER TABLE TestResults ADD COLUMN Realm STRING(64);
+
+CREATE NULL_FILTERED INDEX TestResultsByTestIdAndRealm
+  ON TestResults (TestId, Realm, CommitTimestamp DESC)
+  STORING (VariantHash, Variant, IsUnexpected, Status, StartTime, RunDurationUsec)
@@ -0,0 +1,144 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/metadata"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/lucictx"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+const (
+	// maxPrimaryErrorBytes is the maximum size of
+	// FailureReason.primary_error_message.
+	maxPrimaryErrorBytes = 1024
+
+	// reportBatchSize is the maximum number of test results sent in a single
+	// ReportTestResults request by ReportResultFile.
+	reportBatchSize = 500
+)
+
+// Adapter converts test results in a native format into TestResults.
+type Adapter func(r io.Reader) ([]*sinkpb.TestResult, error)
+
+// Adapters are the built-in Adapters, keyed by the name of their format.
+var Adapters = map[string]Adapter{
+	"junit":  ConvertJUnit,
+	"gtest":  ConvertGTest,
+	"gotest": ConvertGoTest,
+}
+
+// AdapterNames returns the sorted names of the built-in Adapters.
+func AdapterNames() []string {
+	names := make([]string, 0, len(Adapters))
+	for name := range Adapters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ReportResultFile converts the test results in the file at path with the
+// named Adapter, and reports them to the ResultSink exported in the context.
+func ReportResultFile(ctx context.Context, adapter, path string) error {
+	convert, ok := Adapters[adapter]
+	if !ok {
+		return errors.Reason("unknown adapter %q; must be one of %s", adapter, strings.Join(AdapterNames(), ", ")).Err()
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	trs, err := convert(f)
+	if err != nil {
+		return errors.Annotate(err, "failed to convert %q", path).Err()
+	}
+
+	rs := lucictx.GetResultSink(ctx)
+	if rs == nil {
+		return errors.Reason("no ResultSink in the context").Err()
+	}
+	sinkClient := sinkpb.NewSinkPRPCClient(&prpc.Client{
+		Host:    rs.Address,
+		Options: &prpc.Options{Insecure: true},
+	})
+	ctx = metadata.AppendToOutgoingContext(ctx, AuthTokenKey, authTokenValue(rs.AuthToken))
+	for len(trs) > 0 {
+		n := len(trs)
+		if n > reportBatchSize {
+			n = reportBatchSize
+		}
+		if _, err := sinkClient.ReportTestResults(ctx, &sinkpb.ReportTestResultsRequest{TestResults: trs[:n]}); err != nil {
+			return errors.Annotate(err, "failed to report test results").Err()
+		}
+		trs = trs[n:]
+	}
+	return nil
+}
+
+// failureReason returns a FailureReason with msg as the primary error message,
+// truncated to the maximum allowed size.
+func failureReason(msg string) *pb.FailureReason {
+	msg = strings.TrimSpace(msg)
+	if msg == "" {
+		return nil
+	}
+	if len(msg) > maxPrimaryErrorBytes {
+		const ellipsis = "..."
+		n := maxPrimaryErrorBytes - len(ellipsis)
+		for n > 0 && !utf8.RuneStart(msg[n]) {
+			n--
+		}
+		msg = msg[:n] + ellipsis
+	}
+	return &pb.FailureReason{PrimaryErrorMessage: msg}
+}
+
+// textArtifact returns a text/plain artifact with the given contents, or nil
+// if they are empty.
+func textArtifact(contents string) *sinkpb.Artifact {
+	if strings.TrimSpace(contents) == "" {
+		return nil
+	}
+	return &sinkpb.Artifact{
+		Body:        &sinkpb.Artifact_Contents{Contents: []byte(contents)},
+		ContentType: "text/plain",
+	}
+}
+
+// addArtifact adds a to the artifacts of tr, if it's not nil.
+func addArtifact(tr *sinkpb.TestResult, id string, a *sinkpb.Artifact) {
+	if a == nil {
+		return
+	}
+	if tr.Artifacts == nil {
+		tr.Artifacts = map[string]*sinkpb.Artifact{}
+	}
+	tr.Artifacts[id] = a
+}
@@ -0,0 +1,197 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/errors"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+// goTestEvent is an event written by `go test -json`, see
+// https://pkg.go.dev/cmd/test2json.
+type goTestEvent struct {
+	Time    time.Time
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// goTest is the state of a test, or of a package if test is empty.
+type goTest struct {
+	pkg, test string
+	start     time.Time
+	output    strings.Builder
+}
+
+// ConvertGoTest converts the output of `go test -json`.
+//
+// The test ID is the package path and the test name, joined with a dot.
+// Subtests are reported separately. The output of each test is attached as
+// the "output" artifact.
+//
+// A package which failed without any failed test, e.g. because it didn't
+// build, is reported as a failed test with the package path as the test ID.
+// Tests which started but never finished, e.g. because the test binary timed
+// out, are reported as ABORT.
+func ConvertGoTest(r io.Reader) ([]*sinkpb.TestResult, error) {
+	var trs []*sinkpb.TestResult
+	running := map[string]*goTest{}
+	failedPkgs := map[string]bool{}
+
+	get := func(e *goTestEvent) *goTest {
+		key := e.Package + "\x00" + e.Test
+		t := running[key]
+		if t == nil {
+			t = &goTest{pkg: e.Package, test: e.Test, start: e.Time}
+			running[key] = t
+		}
+		return t
+	}
+	finish := func(e *goTestEvent) {
+		delete(running, e.Package+"\x00"+e.Test)
+	}
+	// abort reports the unfinished tests of pkg, or of all packages if pkg is
+	// empty, as aborted.
+	abort := func(pkg string) {
+		keys := make([]string, 0, len(running))
+		for key, t := range running {
+			if pkg == "" || t.pkg == pkg {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if t := running[key]; t.test != "" {
+				trs = append(trs, t.toTestResult("abort", 0))
+			}
+			delete(running, key)
+		}
+	}
+
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		line = bytes.TrimSpace(line)
+
+		// Skip anything which isn't an event, e.g. build output.
+		if len(line) > 0 && line[0] == '{' {
+			e := &goTestEvent{}
+			if err := json.Unmarshal(line, e); err != nil {
+				return nil, errors.Annotate(err, "failed to parse the go test event %q", line).Err()
+			}
+
+			switch e.Action {
+			case "output":
+				get(e).output.WriteString(e.Output)
+
+			case "run":
+				get(e)
+
+			case "pass", "fail", "skip":
+				t := get(e)
+				finish(e)
+				if e.Test != "" {
+					if e.Action == "fail" {
+						failedPkgs[e.Package] = true
+					}
+					trs = append(trs, t.toTestResult(e.Action, e.Elapsed))
+					break
+				}
+
+				// The package has finished. Report its failure if none of its tests
+				// failed, and abort its tests which haven't finished.
+				if e.Action == "fail" && !failedPkgs[e.Package] {
+					trs = append(trs, t.toTestResult(e.Action, e.Elapsed))
+				}
+				abort(e.Package)
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+	}
+
+	// Abort the tests of packages which haven't finished.
+	abort("")
+	return trs, nil
+}
+
+func (t *goTest) toTestResult(action string, elapsed float64) *sinkpb.TestResult {
+	testID := t.pkg
+	if t.test != "" {
+		testID += "." + t.test
+	}
+	tr := &sinkpb.TestResult{
+		TestId:   testID,
+		Status:   pb.TestStatus_PASS,
+		Expected: true,
+	}
+	if !t.start.IsZero() {
+		tr.StartTime = timestamppb.New(t.start)
+	}
+	if elapsed > 0 {
+		tr.Duration = durationpb.New(time.Duration(elapsed * float64(time.Second)))
+	}
+
+	output := t.output.String()
+	switch action {
+	case "fail":
+		tr.Status = pb.TestStatus_FAIL
+		tr.Expected = false
+		tr.FailureReason = failureReason(goTestFailureMessage(output))
+	case "abort":
+		tr.Status = pb.TestStatus_ABORT
+		tr.Expected = false
+	case "skip":
+		tr.Status = pb.TestStatus_SKIP
+	}
+	addArtifact(tr, "output", textArtifact(output))
+	return tr
+}
+
+// goTestFailureMessage returns the first line of the output of a failed test
+// which isn't written by the test framework itself.
+func goTestFailureMessage(output string) string {
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "", line == "FAIL", line == "PASS",
+			strings.HasPrefix(line, "=== "), strings.HasPrefix(line, "--- "),
+			strings.HasPrefix(line, "FAIL\t"):
+			continue
+		}
+		return line
+	}
+	return ""
+}
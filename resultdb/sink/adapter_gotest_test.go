@@ -0,0 +1,121 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestConvertGoTest(t *testing.T) {
+	t.Parallel()
+
+	Convey("ConvertGoTest", t, func() {
+		convert := func(lines ...string) []*sinkpb.TestResult {
+			trs, err := ConvertGoTest(strings.NewReader(strings.Join(lines, "\n")))
+			So(err, ShouldBeNil)
+			return trs
+		}
+		start := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+		Convey("converts tests", func() {
+			trs := convert(
+				`{"Time":"2023-05-01T10:00:00Z","Action":"run","Package":"example.com/pkg","Test":"TestPass"}`,
+				`{"Time":"2023-05-01T10:00:00Z","Action":"output","Package":"example.com/pkg","Test":"TestPass","Output":"=== RUN   TestPass\n"}`,
+				`{"Time":"2023-05-01T10:00:01Z","Action":"pass","Package":"example.com/pkg","Test":"TestPass","Elapsed":1}`,
+				`{"Time":"2023-05-01T10:00:01Z","Action":"run","Package":"example.com/pkg","Test":"TestFail"}`,
+				`{"Time":"2023-05-01T10:00:01Z","Action":"output","Package":"example.com/pkg","Test":"TestFail","Output":"=== RUN   TestFail\n"}`,
+				`{"Time":"2023-05-01T10:00:01Z","Action":"output","Package":"example.com/pkg","Test":"TestFail","Output":"    pkg_test.go:10: got 1, want 2\n"}`,
+				`{"Time":"2023-05-01T10:00:01Z","Action":"output","Package":"example.com/pkg","Test":"TestFail","Output":"--- FAIL: TestFail (0.50s)\n"}`,
+				`{"Time":"2023-05-01T10:00:02Z","Action":"fail","Package":"example.com/pkg","Test":"TestFail","Elapsed":0.5}`,
+				`{"Time":"2023-05-01T10:00:02Z","Action":"run","Package":"example.com/pkg","Test":"TestSkip"}`,
+				`{"Time":"2023-05-01T10:00:02Z","Action":"skip","Package":"example.com/pkg","Test":"TestSkip"}`,
+				`{"Time":"2023-05-01T10:00:02Z","Action":"output","Package":"example.com/pkg","Output":"FAIL\n"}`,
+				`{"Time":"2023-05-01T10:00:02Z","Action":"fail","Package":"example.com/pkg","Elapsed":2}`,
+			)
+
+			So(trs, ShouldResembleProto, []*sinkpb.TestResult{
+				{
+					TestId:    "example.com/pkg.TestPass",
+					Status:    pb.TestStatus_PASS,
+					Expected:  true,
+					StartTime: timestamppb.New(start),
+					Duration:  durationpb.New(time.Second),
+					Artifacts: map[string]*sinkpb.Artifact{
+						"output": testArtifactWithContents([]byte("=== RUN   TestPass\n")),
+					},
+				},
+				{
+					TestId:        "example.com/pkg.TestFail",
+					Status:        pb.TestStatus_FAIL,
+					StartTime:     timestamppb.New(start.Add(time.Second)),
+					Duration:      durationpb.New(500 * time.Millisecond),
+					FailureReason: &pb.FailureReason{PrimaryErrorMessage: "pkg_test.go:10: got 1, want 2"},
+					Artifacts: map[string]*sinkpb.Artifact{
+						"output": testArtifactWithContents([]byte(
+							"=== RUN   TestFail\n    pkg_test.go:10: got 1, want 2\n--- FAIL: TestFail (0.50s)\n")),
+					},
+				},
+				{
+					TestId:    "example.com/pkg.TestSkip",
+					Status:    pb.TestStatus_SKIP,
+					Expected:  true,
+					StartTime: timestamppb.New(start.Add(2 * time.Second)),
+				},
+			})
+		})
+
+		Convey("reports packages failing without failed tests", func() {
+			trs := convert(
+				`# example.com/pkg`,
+				`{"Action":"output","Package":"example.com/pkg","Output":"FAIL\texample.com/pkg [build failed]\n"}`,
+				`{"Action":"fail","Package":"example.com/pkg","Elapsed":0}`,
+			)
+			So(trs, ShouldHaveLength, 1)
+			So(trs[0].TestId, ShouldEqual, "example.com/pkg")
+			So(trs[0].Status, ShouldEqual, pb.TestStatus_FAIL)
+		})
+
+		Convey("aborts unfinished tests", func() {
+			trs := convert(
+				`{"Action":"run","Package":"example.com/pkg","Test":"TestHang"}`,
+				`{"Action":"output","Package":"example.com/pkg","Test":"TestHang","Output":"=== RUN   TestHang\n"}`,
+				`{"Action":"run","Package":"example.com/other","Test":"TestHang"}`,
+				`{"Action":"fail","Package":"example.com/pkg","Elapsed":600}`,
+			)
+			So(trs, ShouldHaveLength, 3)
+			So(trs[0].TestId, ShouldEqual, "example.com/pkg")
+			So(trs[1].TestId, ShouldEqual, "example.com/pkg.TestHang")
+			So(trs[1].Status, ShouldEqual, pb.TestStatus_ABORT)
+			So(trs[2].TestId, ShouldEqual, "example.com/other.TestHang")
+			So(trs[2].Status, ShouldEqual, pb.TestStatus_ABORT)
+		})
+
+		Convey("fails with an invalid event", func() {
+			_, err := ConvertGoTest(strings.NewReader("{not json}\n"))
+			So(err, ShouldErrLike, "failed to parse the go test event")
+		})
+	})
+}
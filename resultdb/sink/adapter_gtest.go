@@ -0,0 +1,122 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/errors"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+// gtestReport is a report written by a GoogleTest binary with
+// --gtest_output=json.
+type gtestReport struct {
+	TestSuites []*gtestSuite `json:"testsuites"`
+}
+
+type gtestSuite struct {
+	Name  string       `json:"name"`
+	Tests []*gtestTest `json:"testsuite"`
+}
+
+type gtestTest struct {
+	Name      string          `json:"name"`
+	Classname string          `json:"classname"`
+	Status    string          `json:"status"`
+	Result    string          `json:"result"`
+	Timestamp string          `json:"timestamp"`
+	Time      string          `json:"time"`
+	Failures  []*gtestFailure `json:"failures"`
+}
+
+type gtestFailure struct {
+	Failure string `json:"failure"`
+}
+
+// ConvertGTest converts a GoogleTest JSON report, written with
+// --gtest_output=json.
+//
+// The test ID is the test suite and test names, joined with a dot, like in
+// --gtest_filter. Tests which weren't run or were skipped are reported as SKIP.
+// All failure messages are attached as the "failure" artifact.
+func ConvertGTest(r io.Reader) ([]*sinkpb.TestResult, error) {
+	report := &gtestReport{}
+	if err := json.NewDecoder(r).Decode(report); err != nil {
+		return nil, errors.Annotate(err, "failed to parse the GoogleTest JSON report").Err()
+	}
+
+	var trs []*sinkpb.TestResult
+	for _, s := range report.TestSuites {
+		for _, t := range s.Tests {
+			tr, err := t.toTestResult(s.Name)
+			if err != nil {
+				return nil, errors.Annotate(err, "test %s.%s", s.Name, t.Name).Err()
+			}
+			trs = append(trs, tr)
+		}
+	}
+	return trs, nil
+}
+
+func (t *gtestTest) toTestResult(suite string) (*sinkpb.TestResult, error) {
+	class := t.Classname
+	if class == "" {
+		class = suite
+	}
+	tr := &sinkpb.TestResult{
+		TestId:   class + "." + t.Name,
+		Status:   pb.TestStatus_PASS,
+		Expected: true,
+	}
+
+	if t.Time != "" {
+		d, err := time.ParseDuration(t.Time)
+		if err != nil {
+			return nil, errors.Annotate(err, "invalid time %q", t.Time).Err()
+		}
+		tr.Duration = durationpb.New(d)
+	}
+	// Older GoogleTest versions write the local time without a time zone, which
+	// can't be converted reliably.
+	if ts, err := time.Parse(time.RFC3339Nano, t.Timestamp); err == nil {
+		tr.StartTime = timestamppb.New(ts)
+	}
+
+	switch {
+	case len(t.Failures) > 0:
+		tr.Status = pb.TestStatus_FAIL
+		tr.Expected = false
+		tr.FailureReason = failureReason(t.Failures[0].Failure)
+
+		texts := make([]string, len(t.Failures))
+		for i, f := range t.Failures {
+			texts[i] = strings.TrimSpace(f.Failure)
+		}
+		addArtifact(tr, "failure", textArtifact(strings.Join(texts, "\n\n")))
+
+	case t.Status == "NOTRUN" || t.Result == "SKIPPED" || t.Result == "SUPPRESSED":
+		tr.Status = pb.TestStatus_SKIP
+	}
+	return tr, nil
+}
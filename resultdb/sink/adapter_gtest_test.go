@@ -0,0 +1,106 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestConvertGTest(t *testing.T) {
+	t.Parallel()
+
+	Convey("ConvertGTest", t, func() {
+		Convey("converts tests", func() {
+			trs, err := ConvertGTest(strings.NewReader(`{
+  "tests": 3,
+  "testsuites": [
+    {
+      "name": "FooTest",
+      "testsuite": [
+        {
+          "name": "Passes",
+          "status": "RUN",
+          "result": "COMPLETED",
+          "timestamp": "2023-05-01T10:00:00Z",
+          "time": "0.5s",
+          "classname": "FooTest"
+        },
+        {
+          "name": "Fails",
+          "status": "RUN",
+          "result": "COMPLETED",
+          "time": "0s",
+          "classname": "FooTest",
+          "failures": [
+            {"failure": "foo_test.cc:10\nExpected equality", "type": ""},
+            {"failure": "foo_test.cc:11\nAnother failure", "type": ""}
+          ]
+        },
+        {
+          "name": "DISABLED_Skipped",
+          "status": "NOTRUN",
+          "result": "SUPPRESSED",
+          "time": "0s",
+          "classname": "FooTest"
+        }
+      ]
+    }
+  ]
+}`))
+			So(err, ShouldBeNil)
+			So(trs, ShouldResembleProto, []*sinkpb.TestResult{
+				{
+					TestId:    "FooTest.Passes",
+					Status:    pb.TestStatus_PASS,
+					Expected:  true,
+					StartTime: timestamppb.New(time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)),
+					Duration:  durationpb.New(500 * time.Millisecond),
+				},
+				{
+					TestId:        "FooTest.Fails",
+					Status:        pb.TestStatus_FAIL,
+					Duration:      durationpb.New(0),
+					FailureReason: &pb.FailureReason{PrimaryErrorMessage: "foo_test.cc:10\nExpected equality"},
+					Artifacts: map[string]*sinkpb.Artifact{
+						"failure": testArtifactWithContents([]byte(
+							"foo_test.cc:10\nExpected equality\n\nfoo_test.cc:11\nAnother failure")),
+					},
+				},
+				{
+					TestId:   "FooTest.DISABLED_Skipped",
+					Status:   pb.TestStatus_SKIP,
+					Expected: true,
+					Duration: durationpb.New(0),
+				},
+			})
+		})
+
+		Convey("fails with invalid JSON", func() {
+			_, err := ConvertGTest(strings.NewReader("{"))
+			So(err, ShouldErrLike, "failed to parse the GoogleTest JSON report")
+		})
+	})
+}
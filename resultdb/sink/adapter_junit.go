@@ -0,0 +1,143 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.chromium.org/luci/common/errors"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+// junitSuite is a <testsuite> or the root <testsuites> element of a JUnit XML
+// report.
+type junitSuite struct {
+	Name   string        `xml:"name,attr"`
+	Suites []*junitSuite `xml:"testsuite"`
+	Cases  []*junitCase  `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string          `xml:"name,attr"`
+	Classname string          `xml:"classname,attr"`
+	Time      string          `xml:"time,attr"`
+	Failures  []*junitFailure `xml:"failure"`
+	Errors    []*junitFailure `xml:"error"`
+	Skipped   *junitFailure   `xml:"skipped"`
+	SystemOut string          `xml:"system-out"`
+	SystemErr string          `xml:"system-err"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// ConvertJUnit converts a JUnit XML report.
+//
+// The test ID is the class name (or the suite name, if the test case has no
+// class name) and the test case name, joined with a dot. Failures and errors
+// are reported as FAIL, skipped test cases as SKIP. The output of the test
+// case and its failure details are attached as the "stdout", "stderr" and
+// "failure" artifacts.
+func ConvertJUnit(r io.Reader) ([]*sinkpb.TestResult, error) {
+	root := &junitSuite{}
+	if err := xml.NewDecoder(r).Decode(root); err != nil {
+		return nil, errors.Annotate(err, "failed to parse the JUnit XML report").Err()
+	}
+
+	var trs []*sinkpb.TestResult
+	var walk func(s *junitSuite) error
+	walk = func(s *junitSuite) error {
+		for _, c := range s.Cases {
+			tr, err := c.toTestResult(s.Name)
+			if err != nil {
+				return errors.Annotate(err, "test case %q", c.Name).Err()
+			}
+			trs = append(trs, tr)
+		}
+		for _, child := range s.Suites {
+			if err := walk(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(root); err != nil {
+		return nil, err
+	}
+	return trs, nil
+}
+
+func (c *junitCase) toTestResult(suite string) (*sinkpb.TestResult, error) {
+	class := c.Classname
+	if class == "" {
+		class = suite
+	}
+	testID := c.Name
+	if class != "" {
+		testID = class + "." + c.Name
+	}
+	tr := &sinkpb.TestResult{
+		TestId:   testID,
+		Status:   pb.TestStatus_PASS,
+		Expected: true,
+	}
+
+	if c.Time != "" {
+		secs, err := strconv.ParseFloat(strings.ReplaceAll(c.Time, ",", ""), 64)
+		if err != nil {
+			return nil, errors.Annotate(err, "invalid time %q", c.Time).Err()
+		}
+		tr.Duration = durationpb.New(time.Duration(secs * float64(time.Second)))
+	}
+
+	failures := append(append([]*junitFailure(nil), c.Failures...), c.Errors...)
+	switch {
+	case len(failures) > 0:
+		tr.Status = pb.TestStatus_FAIL
+		tr.Expected = false
+
+		msg := failures[0].Message
+		if msg == "" {
+			msg, _, _ = strings.Cut(strings.TrimSpace(failures[0].Text), "\n")
+		}
+		tr.FailureReason = failureReason(msg)
+
+		texts := make([]string, 0, len(failures))
+		for _, f := range failures {
+			if t := strings.TrimSpace(f.Text); t != "" {
+				texts = append(texts, t)
+			}
+		}
+		addArtifact(tr, "failure", textArtifact(strings.Join(texts, "\n\n")))
+
+	case c.Skipped != nil:
+		tr.Status = pb.TestStatus_SKIP
+	}
+
+	addArtifact(tr, "stdout", textArtifact(c.SystemOut))
+	addArtifact(tr, "stderr", textArtifact(c.SystemErr))
+	return tr, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestConvertJUnit(t *testing.T) {
+	t.Parallel()
+
+	Convey("ConvertJUnit", t, func() {
+		convert := func(report string) []*sinkpb.TestResult {
+			trs, err := ConvertJUnit(strings.NewReader(report))
+			So(err, ShouldBeNil)
+			return trs
+		}
+
+		Convey("converts test cases", func() {
+			trs := convert(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="suite">
+    <testcase classname="com.example.FooTest" name="testPass" time="1.5">
+      <system-out>some output</system-out>
+    </testcase>
+    <testcase classname="com.example.FooTest" name="testFail" time="0.25">
+      <failure message="expected 1 but was 2" type="AssertionError">stack trace</failure>
+    </testcase>
+    <testcase name="testSkip">
+      <skipped/>
+    </testcase>
+  </testsuite>
+</testsuites>`)
+
+			So(trs, ShouldResembleProto, []*sinkpb.TestResult{
+				{
+					TestId:   "com.example.FooTest.testPass",
+					Status:   pb.TestStatus_PASS,
+					Expected: true,
+					Duration: durationpb.New(1500 * time.Millisecond),
+					Artifacts: map[string]*sinkpb.Artifact{
+						"stdout": testArtifactWithContents([]byte("some output")),
+					},
+				},
+				{
+					TestId:        "com.example.FooTest.testFail",
+					Status:        pb.TestStatus_FAIL,
+					Duration:      durationpb.New(250 * time.Millisecond),
+					FailureReason: &pb.FailureReason{PrimaryErrorMessage: "expected 1 but was 2"},
+					Artifacts: map[string]*sinkpb.Artifact{
+						"failure": testArtifactWithContents([]byte("stack trace")),
+					},
+				},
+				{
+					TestId:   "suite.testSkip",
+					Status:   pb.TestStatus_SKIP,
+					Expected: true,
+				},
+			})
+		})
+
+		Convey("accepts a single test suite as the root", func() {
+			trs := convert(`<testsuite name="suite"><testcase name="a"><error>boom
+at line 1</error></testcase></testsuite>`)
+			So(trs, ShouldHaveLength, 1)
+			So(trs[0].TestId, ShouldEqual, "suite.a")
+			So(trs[0].Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(trs[0].FailureReason.PrimaryErrorMessage, ShouldEqual, "boom")
+		})
+
+		Convey("fails with invalid XML", func() {
+			_, err := ConvertJUnit(strings.NewReader("<testsuite"))
+			So(err, ShouldErrLike, "failed to parse the JUnit XML report")
+		})
+
+		Convey("fails with an invalid time", func() {
+			_, err := ConvertJUnit(strings.NewReader(`<testsuite><testcase name="a" time="x"/></testsuite>`))
+			So(err, ShouldErrLike, "invalid time")
+		})
+	})
+}
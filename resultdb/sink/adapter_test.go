@@ -0,0 +1,95 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestReportResultFile(t *testing.T) {
+	t.Parallel()
+
+	Convey("ReportResultFile", t, func() {
+		ctx := context.Background()
+		cfg := testServerConfig("", "secret")
+
+		var mu sync.Mutex
+		var testIDs []string
+		cfg.Recorder.(*mockRecorder).batchCreateTestResults = func(ctx context.Context, in *pb.BatchCreateTestResultsRequest) (*pb.BatchCreateTestResultsResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			for _, r := range in.Requests {
+				testIDs = append(testIDs, r.TestResult.TestId)
+			}
+			return nil, nil
+		}
+
+		path := filepath.Join(t.TempDir(), "results.json")
+		So(os.WriteFile(path, []byte(strings.Join([]string{
+			`{"Action":"run","Package":"example.com/pkg","Test":"TestA"}`,
+			`{"Action":"pass","Package":"example.com/pkg","Test":"TestA","Elapsed":0.1}`,
+			`{"Action":"run","Package":"example.com/pkg","Test":"TestB"}`,
+			`{"Action":"fail","Package":"example.com/pkg","Test":"TestB","Elapsed":0.1}`,
+			`{"Action":"fail","Package":"example.com/pkg","Elapsed":0.2}`,
+		}, "\n")), 0644), ShouldBeNil)
+
+		report := func(adapter, path string) error {
+			return Run(ctx, cfg, func(ctx context.Context, cfg ServerConfig) error {
+				return ReportResultFile(ctx, adapter, path)
+			})
+		}
+
+		Convey("reports the converted test results", func() {
+			So(report("gotest", path), ShouldBeNil)
+			So(testIDs, ShouldResemble, []string{"example.com/pkg.TestA", "example.com/pkg.TestB"})
+		})
+
+		Convey("fails with an unknown adapter", func() {
+			So(report("unknown", path), ShouldErrLike, "unknown adapter")
+		})
+
+		Convey("fails with an invalid file", func() {
+			invalid := filepath.Join(t.TempDir(), "results.xml")
+			So(os.WriteFile(invalid, []byte("<testsuite"), 0644), ShouldBeNil)
+			So(report("junit", invalid), ShouldErrLike, "failed to convert")
+		})
+	})
+}
+
+func TestFailureReason(t *testing.T) {
+	t.Parallel()
+
+	Convey("failureReason", t, func() {
+		Convey("returns nil for an empty message", func() {
+			So(failureReason(" \n"), ShouldBeNil)
+		})
+
+		Convey("truncates long messages", func() {
+			fr := failureReason(strings.Repeat("é", maxPrimaryErrorBytes))
+			So(len(fr.PrimaryErrorMessage), ShouldBeLessThanOrEqualTo, maxPrimaryErrorBytes)
+			So(fr.PrimaryErrorMessage, ShouldEndWith, "é...")
+		})
+	})
+}
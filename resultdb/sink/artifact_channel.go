@@ -184,6 +184,9 @@ func newArtifactChannel(ctx context.Context, cfg *ServerConfig) *artifactChannel
 			FullBehavior:  &buffer.BlockNewItems{MaxItems: 8000},
 		},
 	}
+	if cfg.spool != nil {
+		bcOpts.DropFn = c.spoolDropFn(ctx)
+	}
 	c.batchChannel, err = dispatcher.NewChannel(ctx, bcOpts, func(b *buffer.Batch) error {
 		return errors.Annotate(au.BatchUpload(ctx, b), "BatchUpload").Err()
 	})
@@ -200,6 +203,9 @@ func newArtifactChannel(ctx context.Context, cfg *ServerConfig) *artifactChannel
 			FullBehavior:  &buffer.BlockNewItems{MaxItems: 4000},
 		},
 	}
+	if cfg.spool != nil {
+		stOpts.DropFn = c.spoolDropFn(ctx)
+	}
 	c.streamChannel, err = dispatcher.NewChannel(ctx, stOpts, func(b *buffer.Batch) error {
 		return errors.Annotate(
 			au.StreamUpload(ctx, b.Data[0].Item.(*uploadTask), cfg.UpdateToken),
@@ -211,6 +217,16 @@ func newArtifactChannel(ctx context.Context, cfg *ServerConfig) *artifactChannel
 	return c
 }
 
+// spoolDropFn returns a dispatcher.Options.DropFn persisting the dropped
+// artifacts in the spool.
+func (c *artifactChannel) spoolDropFn(ctx context.Context) func(*buffer.Batch, bool) {
+	return func(b *buffer.Batch, flush bool) {
+		if !flush {
+			c.cfg.spool.writeArtifacts(ctx, b)
+		}
+	}
+}
+
 func (c *artifactChannel) closeAndDrain(ctx context.Context) {
 	// mark the channel as closed, so that schedule() won't accept new tasks.
 	if !atomic.CompareAndSwapInt32(&c.closed, 0, 1) {
@@ -143,6 +143,20 @@ type ServerConfig struct {
 	// ExonerateUnexpectedPass is a flag to control if an unexpected pass should
 	// be exonerated.
 	ExonerateUnexpectedPass bool
+
+	// SpoolDir, if not empty, is the directory where the requests which couldn't
+	// be sent to the Recorder are persisted, e.g. because it's unreachable.
+	//
+	// The requests persisted by previous servers are replayed when the server
+	// starts. Use ReplaySpool to replay them without a server.
+	SpoolDir string
+
+	// SpoolAfter is how long shutting down the server waits for the pending
+	// requests to be sent before persisting them in SpoolDir. If zero,
+	// DefaultSpoolAfter is used.
+	SpoolAfter time.Duration
+	// spool is the spool in SpoolDir, if any.
+	spool *spool
 }
 
 // Validate validates all the config fields.
@@ -172,6 +186,8 @@ func (c *ServerConfig) Validate() error {
 		return errors.Annotate(err, "TestLocationBase").Err()
 	case c.MaxBatchableArtifactSize > 10*1024*1024:
 		return errors.Reason("MaxBatchableArtifactSize: %d is greater than 10MiB", c.MaxBatchableArtifactSize).Err()
+	case c.SpoolAfter < 0:
+		return errors.Reason("SpoolAfter: %s is negative", c.SpoolAfter).Err()
 	}
 	return nil
 }
@@ -219,6 +235,17 @@ func NewServer(ctx context.Context, cfg ServerConfig) (*Server, error) {
 	// parse the name to extract ID repeatedly.
 	cfg.invocationID, _ = pbutil.ParseInvocationName(cfg.Invocation)
 
+	if cfg.SpoolDir != "" {
+		if cfg.SpoolAfter == 0 {
+			cfg.SpoolAfter = DefaultSpoolAfter
+		}
+		cfg.spool = &spool{
+			dir:         cfg.SpoolDir,
+			invocation:  cfg.invocationID,
+			updateToken: cfg.UpdateToken,
+		}
+	}
+
 	s := &Server{
 		cfg:   cfg,
 		doneC: make(chan struct{}),
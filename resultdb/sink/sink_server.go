@@ -22,6 +22,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
@@ -58,6 +59,12 @@ type sinkServer struct {
 	// error AlreadyExists.
 	invocationArtifactIDs stringset.Set
 	mu                    sync.Mutex
+
+	// cancelUploads cancels the uploads to the Recorder, making the channels drop
+	// the pending requests.
+	cancelUploads context.CancelFunc
+	// replayDone is closed when the spooled requests have been replayed.
+	replayDone chan struct{}
 }
 
 func newSinkServer(ctx context.Context, cfg ServerConfig) (sinkpb.SinkServer, error) {
@@ -68,13 +75,34 @@ func newSinkServer(ctx context.Context, cfg ServerConfig) (sinkpb.SinkServer, er
 		return nil, err
 	}
 
-	ctx = metadata.AppendToOutgoingContext(ctx, pb.UpdateTokenMetadataKey, cfg.UpdateToken)
+	// Replay the requests spooled by previous servers. They carry the update
+	// tokens of their invocations.
+	replayDone := make(chan struct{})
+	replayCtx, cancelUploads := context.WithCancel(ctx)
+	if cfg.spool != nil {
+		go func() {
+			defer close(replayDone)
+			sent, kept, err := ReplaySpool(replayCtx, cfg.Recorder, cfg.SpoolDir)
+			if err != nil {
+				logging.Errorf(ctx, "SinkServer: failed to replay the spool: %s", err)
+			}
+			if sent > 0 || kept > 0 {
+				logging.Infof(ctx, "SinkServer: replayed %d spooled request(s), %d remaining", sent, kept)
+			}
+		}()
+	} else {
+		close(replayDone)
+	}
+
+	ctx = metadata.AppendToOutgoingContext(replayCtx, pb.UpdateTokenMetadataKey, cfg.UpdateToken)
 	ss := &sinkServer{
 		cfg:                   cfg,
 		ac:                    newArtifactChannel(ctx, &cfg),
 		tc:                    newTestResultChannel(ctx, &cfg),
 		resultIDBase:          hex.EncodeToString(bytes),
 		invocationArtifactIDs: stringset.New(0),
+		cancelUploads:         cancelUploads,
+		replayDone:            replayDone,
 	}
 
 	if cfg.ExonerateUnexpectedPass {
@@ -91,6 +119,17 @@ func newSinkServer(ctx context.Context, cfg ServerConfig) (sinkpb.SinkServer, er
 // or the context is cancelled.
 func closeSinkServer(ctx context.Context, s sinkpb.SinkServer) {
 	ss := s.(*sinkpb.DecoratedSink).Service.(*sinkServer)
+	defer ss.cancelUploads()
+
+	// With a spool, stop waiting for the Recorder after a while, and persist
+	// the pending requests instead.
+	if ss.cfg.spool != nil {
+		timer := time.AfterFunc(ss.cfg.SpoolAfter, func() {
+			logging.Warningf(ctx, "SinkServer: spooling the requests which weren't sent in %s", ss.cfg.SpoolAfter)
+			ss.cancelUploads()
+		})
+		defer timer.Stop()
+	}
 
 	logging.Infof(ctx, "SinkServer: draining TestResult channel started")
 	ss.tc.closeAndDrain(ctx)
@@ -105,6 +144,11 @@ func closeSinkServer(ctx context.Context, s sinkpb.SinkServer) {
 		ss.ec.closeAndDrain(ctx)
 		logging.Infof(ctx, "SinkServer: draining TestExoneration channel ended")
 	}
+
+	select {
+	case <-ss.replayDone:
+	case <-ctx.Done():
+	}
 }
 
 // authTokenValue returns the value of the Authorization HTTP header that all requests must
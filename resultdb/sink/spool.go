@@ -0,0 +1,332 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/common/sync/dispatcher/buffer"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+)
+
+const (
+	// DefaultSpoolAfter is the default value of ServerConfig.SpoolAfter.
+	DefaultSpoolAfter = time.Minute
+
+	// spoolTokenFile is the name of the file with the update token of the
+	// invocation, in the spool directory of the invocation.
+	spoolTokenFile = "update_token"
+
+	// Extensions of the spooled request files, by request type.
+	spoolTestResultsExt      = ".test_results.pb"
+	spoolArtifactsExt        = ".artifacts.pb"
+	spoolTestExonerationsExt = ".test_exonerations.pb"
+
+	// maxSpooledArtifactsSize is the maximum size of the artifact contents in a
+	// spooled BatchCreateArtifactsRequest.
+	maxSpooledArtifactsSize = 10 * 1024 * 1024
+)
+
+// spool persists the requests which couldn't be sent to the Recorder in a
+// directory, to be replayed by ReplaySpool.
+//
+// The requests of each invocation are stored in a subdirectory named after the
+// invocation ID, with one file per request, along with the update token of
+// the invocation. The files are only readable by the current user.
+type spool struct {
+	dir         string
+	invocation  string
+	updateToken string
+}
+
+// write persists a request.
+func (s *spool) write(ctx context.Context, req proto.Message) {
+	var ext string
+	switch req.(type) {
+	case *pb.BatchCreateTestResultsRequest:
+		ext = spoolTestResultsExt
+	case *pb.BatchCreateArtifactsRequest:
+		ext = spoolArtifactsExt
+	case *pb.BatchCreateTestExonerationsRequest:
+		ext = spoolTestExonerationsExt
+	default:
+		panic(fmt.Sprintf("unexpected request type %T", req))
+	}
+
+	err := func() error {
+		data, err := proto.Marshal(req)
+		if err != nil {
+			return err
+		}
+		dir := filepath.Join(s.dir, s.invocation)
+		// Prefix the file name with the time, to replay requests in order.
+		name := fmt.Sprintf("%020d-%s%s", time.Now().UnixNano(), uuid.New(), ext)
+		for attempt := 0; ; attempt++ {
+			err := os.MkdirAll(dir, 0700)
+			if err == nil {
+				err = os.WriteFile(filepath.Join(dir, spoolTokenFile), []byte(s.updateToken), 0600)
+			}
+			if err == nil {
+				err = writeFileAtomic(filepath.Join(dir, name), data)
+			}
+			// ReplaySpool may remove the directory concurrently, if it's empty.
+			if err == nil || !os.IsNotExist(err) || attempt > 0 {
+				return err
+			}
+		}
+	}()
+	if err != nil {
+		logging.Errorf(ctx, "SinkServer: failed to spool a request: %s", err)
+		return
+	}
+	logging.Warningf(ctx, "SinkServer: spooled a request to %s", s.dir)
+}
+
+// writeTestResults persists the test results in a dropped batch of the
+// TestResult channel.
+func (s *spool) writeTestResults(ctx context.Context, c *testResultChannel, b *buffer.Batch) {
+	if b.Meta == nil {
+		b.Meta = c.newRequest(b)
+	}
+	s.write(ctx, b.Meta.(*pb.BatchCreateTestResultsRequest))
+}
+
+// writeArtifacts persists the artifacts in a dropped batch of the Artifact
+// channels.
+func (s *spool) writeArtifacts(ctx context.Context, b *buffer.Batch) {
+	// The items of the batch which were sent already were removed from b.Data.
+	data := b.Data
+	for len(data) > 0 {
+		if t := data[0].Item.(*uploadTask); t.size > maxSpooledArtifactsSize {
+			logging.Errorf(ctx, "SinkServer: dropping artifact %q, which is too large to be spooled", t.artName)
+			data = data[1:]
+			continue
+		}
+		req, err := newBatchCreateArtifactsRequest(maxSpooledArtifactsSize, data)
+		if err != nil {
+			logging.Errorf(ctx, "SinkServer: failed to spool artifacts: %s", err)
+			return
+		}
+		s.write(ctx, req)
+		data = data[len(req.Requests):]
+	}
+}
+
+// writeTestExonerations persists the test exonerations in a dropped batch of
+// the TestExoneration channel.
+func (s *spool) writeTestExonerations(ctx context.Context, c *unexpectedPassChannel, b *buffer.Batch) {
+	if b.Meta == nil {
+		b.Meta = c.newRequest(b)
+	}
+	s.write(ctx, b.Meta.(*pb.BatchCreateTestExonerationsRequest))
+}
+
+// writeFileAtomic writes a file, so that it's either complete or absent.
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// ReplaySpool sends the requests persisted in the spool directory dir to the
+// Recorder.
+//
+// The requests which were sent, or failed with a non-transient error, are
+// removed from the spool. The others are kept to be replayed later. Test
+// results and test exonerations are deduplicated by the Recorder with the
+// request IDs assigned when they were first sent. Artifacts which already
+// exist are skipped.
+//
+// Returns the number of requests sent and the number of requests kept in the
+// spool.
+func ReplaySpool(ctx context.Context, recorder pb.RecorderClient, dir string) (sent, kept int, err error) {
+	invs, err := os.ReadDir(dir)
+	switch {
+	case os.IsNotExist(err):
+		return 0, 0, nil
+	case err != nil:
+		return 0, 0, err
+	}
+
+	for _, inv := range invs {
+		if !inv.IsDir() {
+			continue
+		}
+		s, k, err := replayInvocationSpool(ctx, recorder, filepath.Join(dir, inv.Name()))
+		sent += s
+		kept += k
+		if err != nil {
+			return sent, kept, errors.Annotate(err, "invocation %q", inv.Name()).Err()
+		}
+	}
+	return sent, kept, nil
+}
+
+func replayInvocationSpool(ctx context.Context, recorder pb.RecorderClient, dir string) (sent, kept int, err error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, 0, err
+	}
+	var names []string
+	for _, e := range entries {
+		if n := e.Name(); strings.HasSuffix(n, ".pb") {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+
+	token, err := os.ReadFile(filepath.Join(dir, spoolTokenFile))
+	switch {
+	case err != nil && (len(names) > 0 || !os.IsNotExist(err)):
+		return 0, 0, errors.Annotate(err, "reading the update token").Err()
+	case err == nil:
+		ctx = metadata.AppendToOutgoingContext(ctx, pb.UpdateTokenMetadataKey, string(token))
+	}
+
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		switch keep, err := replaySpooledRequest(ctx, recorder, path); {
+		case err == nil:
+			sent++
+		case keep:
+			logging.Warningf(ctx, "failed to replay %q, keeping it: %s", path, err)
+			kept++
+			continue
+		default:
+			logging.Errorf(ctx, "failed to replay %q, dropping it: %s", path, err)
+		}
+		if err := os.Remove(path); err != nil {
+			return sent, kept, err
+		}
+	}
+
+	if kept == 0 {
+		// All the requests of the invocation were replayed.
+		if err := removeInvocationSpool(dir, token); err != nil {
+			return sent, kept, err
+		}
+	}
+	return sent, kept, nil
+}
+
+// removeInvocationSpool removes the spool directory of an invocation, whose
+// requests were replayed.
+//
+// A server may be spooling new requests of the invocation to the directory
+// concurrently, so the directory is removed only if it's empty. Otherwise the
+// update token is kept for the new requests.
+func removeInvocationSpool(dir string, token []byte) error {
+	tokenPath := filepath.Join(dir, spoolTokenFile)
+	if err := os.Remove(tokenPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	err := os.Remove(dir)
+	if err == nil || os.IsNotExist(err) {
+		return nil
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) == 0 {
+		return err
+	}
+	// The directory is not empty, i.e. new requests were spooled meanwhile.
+	if token == nil {
+		return nil
+	}
+	return os.WriteFile(tokenPath, token, 0600)
+}
+
+// replaySpooledRequest sends the request spooled in the file at path.
+//
+// Returns true if the request should be kept in the spool.
+func replaySpooledRequest(ctx context.Context, recorder pb.RecorderClient, path string) (keep bool, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case strings.HasSuffix(path, spoolTestResultsExt):
+		req := &pb.BatchCreateTestResultsRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return false, err
+		}
+		_, err = recorder.BatchCreateTestResults(ctx, req)
+
+	case strings.HasSuffix(path, spoolArtifactsExt):
+		req := &pb.BatchCreateArtifactsRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return false, err
+		}
+		err = replaySpooledArtifacts(ctx, recorder, req)
+
+	case strings.HasSuffix(path, spoolTestExonerationsExt):
+		req := &pb.BatchCreateTestExonerationsRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return false, err
+		}
+		_, err = recorder.BatchCreateTestExonerations(ctx, req)
+
+	default:
+		return false, errors.Reason("unknown spooled request type").Err()
+	}
+
+	if err != nil {
+		keep = ctx.Err() != nil || transient.Tag.In(err) ||
+			grpcutil.IsTransientCode(grpcutil.Code(err)) || grpcutil.Code(err) == codes.DeadlineExceeded
+	}
+	return keep, err
+}
+
+// replaySpooledArtifacts sends a spooled BatchCreateArtifactsRequest.
+//
+// Some of the artifacts may have been uploaded before they were spooled, in
+// which case the Recorder rejects the whole batch with AlreadyExists. The
+// artifacts are then sent one at a time, skipping the existing ones.
+func replaySpooledArtifacts(ctx context.Context, recorder pb.RecorderClient, req *pb.BatchCreateArtifactsRequest) error {
+	_, err := recorder.BatchCreateArtifacts(ctx, req)
+	if grpcutil.Code(err) != codes.AlreadyExists {
+		return err
+	}
+	if len(req.Requests) == 1 {
+		return nil
+	}
+
+	for _, r := range req.Requests {
+		_, err := recorder.BatchCreateArtifacts(ctx, &pb.BatchCreateArtifactsRequest{
+			Requests: []*pb.CreateArtifactRequest{r},
+		})
+		if err != nil && grpcutil.Code(err) != codes.AlreadyExists {
+			return err
+		}
+	}
+	return nil
+}
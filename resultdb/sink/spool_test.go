@@ -0,0 +1,234 @@
+// Copyright 2023 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/retry/transient"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestSpool(t *testing.T) {
+	t.Parallel()
+
+	Convey("Spool", t, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(AuthTokenKey, authTokenValue("secret")))
+
+		dir := t.TempDir()
+		cfg := testServerConfig("", "secret")
+		cfg.SpoolDir = dir
+		cfg.SpoolAfter = 100 * time.Millisecond
+		cfg.spool = &spool{dir: dir, invocation: cfg.invocationID, updateToken: cfg.UpdateToken}
+		invDir := filepath.Join(dir, cfg.invocationID)
+
+		tr, cleanup := validTestResult()
+		defer cleanup()
+		tr.Artifacts = nil
+
+		// spoolResult reports a test result to a server which can't reach the
+		// Recorder, and returns the spooled request.
+		spoolResult := func() *pb.BatchCreateTestResultsRequest {
+			cfg.Recorder.(*mockRecorder).batchCreateTestResults = func(ctx context.Context, in *pb.BatchCreateTestResultsRequest) (*pb.BatchCreateTestResultsResponse, error) {
+				return nil, transient.Tag.Apply(status.Errorf(codes.Unavailable, "unreachable"))
+			}
+			sink, err := newSinkServer(ctx, cfg)
+			So(err, ShouldBeNil)
+			_, err = sink.ReportTestResults(ctx, &sinkpb.ReportTestResultsRequest{TestResults: []*sinkpb.TestResult{tr}})
+			So(err, ShouldBeNil)
+			closeSinkServer(ctx, sink)
+
+			files, err := filepath.Glob(filepath.Join(invDir, "*"+spoolTestResultsExt))
+			So(err, ShouldBeNil)
+			So(files, ShouldHaveLength, 1)
+			data, err := os.ReadFile(files[0])
+			So(err, ShouldBeNil)
+			req := &pb.BatchCreateTestResultsRequest{}
+			So(proto.Unmarshal(data, req), ShouldBeNil)
+			return req
+		}
+
+		Convey("persists the requests which couldn't be sent", func() {
+			req := spoolResult()
+			So(req.Invocation, ShouldEqual, cfg.Invocation)
+			So(req.RequestId, ShouldNotBeEmpty)
+			So(req.Requests, ShouldHaveLength, 1)
+			So(req.Requests[0].TestResult.TestId, ShouldEqual, tr.TestId)
+
+			token, err := os.ReadFile(filepath.Join(invDir, spoolTokenFile))
+			So(err, ShouldBeNil)
+			So(string(token), ShouldEqual, cfg.UpdateToken)
+		})
+
+		Convey("ReplaySpool", func() {
+			spooled := spoolResult()
+			recorder := &mockRecorder{}
+
+			Convey("sends the requests with their request IDs", func() {
+				var sent *pb.BatchCreateTestResultsRequest
+				var token []string
+				recorder.batchCreateTestResults = func(ctx context.Context, in *pb.BatchCreateTestResultsRequest) (*pb.BatchCreateTestResultsResponse, error) {
+					sent = in
+					md, _ := metadata.FromOutgoingContext(ctx)
+					token = md.Get(pb.UpdateTokenMetadataKey)
+					return nil, nil
+				}
+				s, k, err := ReplaySpool(ctx, recorder, dir)
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, 1)
+				So(k, ShouldEqual, 0)
+				So(sent, ShouldResembleProto, spooled)
+				So(token, ShouldResemble, []string{cfg.UpdateToken})
+
+				_, err = os.Stat(invDir)
+				So(os.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("keeps the requests failing with transient errors", func() {
+				recorder.batchCreateTestResults = func(ctx context.Context, in *pb.BatchCreateTestResultsRequest) (*pb.BatchCreateTestResultsResponse, error) {
+					return nil, status.Errorf(codes.Unavailable, "unreachable")
+				}
+				s, k, err := ReplaySpool(ctx, recorder, dir)
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, 0)
+				So(k, ShouldEqual, 1)
+
+				files, err := filepath.Glob(filepath.Join(invDir, "*"+spoolTestResultsExt))
+				So(err, ShouldBeNil)
+				So(files, ShouldHaveLength, 1)
+			})
+
+			Convey("drops the requests failing with other errors", func() {
+				recorder.batchCreateTestResults = func(ctx context.Context, in *pb.BatchCreateTestResultsRequest) (*pb.BatchCreateTestResultsResponse, error) {
+					return nil, status.Errorf(codes.PermissionDenied, "invocation is finalized")
+				}
+				s, k, err := ReplaySpool(ctx, recorder, dir)
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, 0)
+				So(k, ShouldEqual, 0)
+
+				_, err = os.Stat(invDir)
+				So(os.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("keeps the requests spooled while replaying", func() {
+				recorder.batchCreateTestResults = func(ctx context.Context, in *pb.BatchCreateTestResultsRequest) (*pb.BatchCreateTestResultsResponse, error) {
+					cfg.spool.write(ctx, in)
+					return nil, nil
+				}
+				s, k, err := ReplaySpool(ctx, recorder, dir)
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, 1)
+				So(k, ShouldEqual, 0)
+
+				files, err := filepath.Glob(filepath.Join(invDir, "*"+spoolTestResultsExt))
+				So(err, ShouldBeNil)
+				So(files, ShouldHaveLength, 1)
+				token, err := os.ReadFile(filepath.Join(invDir, spoolTokenFile))
+				So(err, ShouldBeNil)
+				So(string(token), ShouldEqual, cfg.UpdateToken)
+			})
+		})
+
+		Convey("ReplaySpool with artifacts", func() {
+			artifact := func(id string) *pb.CreateArtifactRequest {
+				return &pb.CreateArtifactRequest{
+					Parent:   cfg.Invocation,
+					Artifact: &pb.Artifact{ArtifactId: id, Contents: []byte(id)},
+				}
+			}
+			cfg.spool.write(ctx, &pb.BatchCreateArtifactsRequest{
+				Requests: []*pb.CreateArtifactRequest{artifact("a"), artifact("b"), artifact("c")},
+			})
+
+			// The Recorder has "a" already and rejects batches including it.
+			var created []string
+			recorder := &mockRecorder{}
+			recorder.batchCreateArtifacts = func(ctx context.Context, in *pb.BatchCreateArtifactsRequest) (*pb.BatchCreateArtifactsResponse, error) {
+				for _, r := range in.Requests {
+					if r.Artifact.ArtifactId == "a" {
+						return nil, status.Errorf(codes.AlreadyExists, "artifact a exists")
+					}
+				}
+				for _, r := range in.Requests {
+					created = append(created, r.Artifact.ArtifactId)
+				}
+				return &pb.BatchCreateArtifactsResponse{}, nil
+			}
+
+			Convey("sends the other artifacts of a batch with an existing one", func() {
+				s, k, err := ReplaySpool(ctx, recorder, dir)
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, 1)
+				So(k, ShouldEqual, 0)
+				So(created, ShouldResemble, []string{"b", "c"})
+
+				_, err = os.Stat(invDir)
+				So(os.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("keeps the batch if the other artifacts fail", func() {
+				sendOne := recorder.batchCreateArtifacts
+				recorder.batchCreateArtifacts = func(ctx context.Context, in *pb.BatchCreateArtifactsRequest) (*pb.BatchCreateArtifactsResponse, error) {
+					if len(in.Requests) == 1 && in.Requests[0].Artifact.ArtifactId == "c" {
+						return nil, status.Errorf(codes.Unavailable, "unreachable")
+					}
+					return sendOne(ctx, in)
+				}
+				s, k, err := ReplaySpool(ctx, recorder, dir)
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, 0)
+				So(k, ShouldEqual, 1)
+				So(created, ShouldResemble, []string{"b"})
+
+				files, err := filepath.Glob(filepath.Join(invDir, "*"+spoolArtifactsExt))
+				So(err, ShouldBeNil)
+				So(files, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("a new server replays the spool", func() {
+			spoolResult()
+			sent := make(chan *pb.BatchCreateTestResultsRequest, 2)
+			cfg.Recorder.(*mockRecorder).batchCreateTestResults = func(ctx context.Context, in *pb.BatchCreateTestResultsRequest) (*pb.BatchCreateTestResultsResponse, error) {
+				sent <- in
+				return nil, nil
+			}
+			sink, err := newSinkServer(ctx, cfg)
+			So(err, ShouldBeNil)
+			closeSinkServer(ctx, sink)
+
+			So(sent, ShouldHaveLength, 1)
+			_, err = os.Stat(invDir)
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+	})
+}
@@ -59,6 +59,13 @@ func newTestExonerationChannel(ctx context.Context, cfg *ServerConfig) *unexpect
 			FullBehavior:  &buffer.BlockNewItems{MaxItems: 8000},
 		},
 	}
+	if cfg.spool != nil {
+		opts.DropFn = func(b *buffer.Batch, flush bool) {
+			if !flush {
+				cfg.spool.writeTestExonerations(ctx, c, b)
+			}
+		}
+	}
 	c.ch, err = dispatcher.NewChannel(ctx, opts, func(b *buffer.Batch) error {
 		return c.report(ctx, b)
 	})
@@ -90,26 +97,31 @@ func (c *unexpectedPassChannel) schedule(trs ...*sinkpb.TestResult) {
 	}
 }
 
+// newRequest returns the BatchCreateTestExonerationsRequest for a batch.
+func (c *unexpectedPassChannel) newRequest(b *buffer.Batch) *pb.BatchCreateTestExonerationsRequest {
+	reqs := make([]*pb.CreateTestExonerationRequest, len(b.Data))
+	for i, d := range b.Data {
+		tr := d.Item.(*sinkpb.TestResult)
+		reqs[i] = &pb.CreateTestExonerationRequest{
+			TestExoneration: &pb.TestExoneration{
+				TestId:          tr.GetTestId(),
+				Variant:         c.cfg.BaseVariant,
+				ExplanationHtml: "Unexpected passes are exonerated",
+				Reason:          pb.ExonerationReason_UNEXPECTED_PASS,
+			},
+		}
+	}
+	return &pb.BatchCreateTestExonerationsRequest{
+		Invocation: c.cfg.Invocation,
+		// a random UUID
+		RequestId: uuid.New().String(),
+		Requests:  reqs,
+	}
+}
+
 func (c *unexpectedPassChannel) report(ctx context.Context, b *buffer.Batch) error {
 	if b.Meta == nil {
-		reqs := make([]*pb.CreateTestExonerationRequest, len(b.Data))
-		for i, d := range b.Data {
-			tr := d.Item.(*sinkpb.TestResult)
-			reqs[i] = &pb.CreateTestExonerationRequest{
-				TestExoneration: &pb.TestExoneration{
-					TestId:          tr.GetTestId(),
-					Variant:         c.cfg.BaseVariant,
-					ExplanationHtml: "Unexpected passes are exonerated",
-					Reason:          pb.ExonerationReason_UNEXPECTED_PASS,
-				},
-			}
-		}
-		b.Meta = &pb.BatchCreateTestExonerationsRequest{
-			Invocation: c.cfg.Invocation,
-			// a random UUID
-			RequestId: uuid.New().String(),
-			Requests:  reqs,
-		}
+		b.Meta = c.newRequest(b)
 	}
 	_, err := c.cfg.Recorder.BatchCreateTestExonerations(ctx, b.Meta.(*pb.BatchCreateTestExonerationsRequest))
 	return err
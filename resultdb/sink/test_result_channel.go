@@ -64,6 +64,13 @@ func newTestResultChannel(ctx context.Context, cfg *ServerConfig) *testResultCha
 			FullBehavior:  &buffer.BlockNewItems{MaxItems: 8000},
 		},
 	}
+	if cfg.spool != nil {
+		opts.DropFn = func(b *buffer.Batch, flush bool) {
+			if !flush {
+				cfg.spool.writeTestResults(ctx, c, b)
+			}
+		}
+	}
 	c.ch, err = dispatcher.NewChannel(ctx, opts, func(b *buffer.Batch) error {
 		return c.report(ctx, b)
 	})
@@ -149,43 +156,48 @@ func (c *testResultChannel) setTestTags(tr *sinkpb.TestResult) {
 	}
 }
 
+// newRequest returns the BatchCreateTestResultsRequest for a batch.
+func (c *testResultChannel) newRequest(b *buffer.Batch) *pb.BatchCreateTestResultsRequest {
+	reqs := make([]*pb.CreateTestResultRequest, len(b.Data))
+	for i, d := range b.Data {
+		tr := d.Item.(*sinkpb.TestResult)
+		c.setTestTags(tr)
+		tags := append(tr.GetTags(), c.cfg.BaseTags...)
+
+		// The test result variant will overwrite the value for the
+		// duplicate key in the base variant.
+		variant := pbutil.CombineVariant(c.cfg.BaseVariant, tr.GetVariant())
+
+		pbutil.SortStringPairs(tags)
+		reqs[i] = &pb.CreateTestResultRequest{
+			TestResult: &pb.TestResult{
+				TestId:        tr.GetTestId(),
+				ResultId:      tr.GetResultId(),
+				Variant:       variant,
+				Expected:      tr.GetExpected(),
+				Status:        tr.GetStatus(),
+				SummaryHtml:   tr.GetSummaryHtml(),
+				StartTime:     tr.GetStartTime(),
+				Duration:      tr.GetDuration(),
+				Tags:          tags,
+				TestMetadata:  tr.GetTestMetadata(),
+				FailureReason: tr.GetFailureReason(),
+				Properties:    tr.GetProperties(),
+			},
+		}
+	}
+	return &pb.BatchCreateTestResultsRequest{
+		Invocation: c.cfg.Invocation,
+		// a random UUID
+		RequestId: uuid.New().String(),
+		Requests:  reqs,
+	}
+}
+
 func (c *testResultChannel) report(ctx context.Context, b *buffer.Batch) error {
 	// retried batch?
 	if b.Meta == nil {
-		reqs := make([]*pb.CreateTestResultRequest, len(b.Data))
-		for i, d := range b.Data {
-			tr := d.Item.(*sinkpb.TestResult)
-			c.setTestTags(tr)
-			tags := append(tr.GetTags(), c.cfg.BaseTags...)
-
-			// The test result variant will overwrite the value for the
-			// duplicate key in the base variant.
-			variant := pbutil.CombineVariant(c.cfg.BaseVariant, tr.GetVariant())
-
-			pbutil.SortStringPairs(tags)
-			reqs[i] = &pb.CreateTestResultRequest{
-				TestResult: &pb.TestResult{
-					TestId:        tr.GetTestId(),
-					ResultId:      tr.GetResultId(),
-					Variant:       variant,
-					Expected:      tr.GetExpected(),
-					Status:        tr.GetStatus(),
-					SummaryHtml:   tr.GetSummaryHtml(),
-					StartTime:     tr.GetStartTime(),
-					Duration:      tr.GetDuration(),
-					Tags:          tags,
-					TestMetadata:  tr.GetTestMetadata(),
-					FailureReason: tr.GetFailureReason(),
-					Properties:    tr.GetProperties(),
-				},
-			}
-		}
-		b.Meta = &pb.BatchCreateTestResultsRequest{
-			Invocation: c.cfg.Invocation,
-			// a random UUID
-			RequestId: uuid.New().String(),
-			Requests:  reqs,
-		}
+		b.Meta = c.newRequest(b)
 	}
 	_, err := c.cfg.Recorder.BatchCreateTestResults(ctx, b.Meta.(*pb.BatchCreateTestResultsRequest))
 	return err